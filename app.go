@@ -16,6 +16,7 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
@@ -24,18 +25,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	authutils "github.com/cosmos/cosmos-sdk/x/auth"
-	"github.com/cosmos/cosmos-sdk/x/params"
 	abci "github.com/tendermint/tendermint/abci/types"
 	cmn "github.com/tendermint/tendermint/libs/common"
 	"github.com/tendermint/tendermint/libs/log"
 	tmtypes "github.com/tendermint/tendermint/types"
 	dbm "github.com/tendermint/tm-db"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/metrics"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/storestats"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/txtimeout"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/audit"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliancetest"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/genutil"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator"
 )
 
@@ -58,6 +66,11 @@ var ModuleBasics = module.NewBasicManager(
 	compliance.AppModuleBasic{},
 	compliancetest.AppModuleBasic{},
 	pki.AppModuleBasic{},
+	ota.AppModuleBasic{},
+	upgrade.AppModuleBasic{},
+	params.AppModuleBasic{},
+	audit.AppModuleBasic{},
+	grant.AppModuleBasic{},
 )
 
 // MakeCodec generates the necessary codecs for Amino.
@@ -80,15 +93,24 @@ type dcLedgerApp struct {
 	tkeys map[string]*sdk.TransientStoreKey
 
 	// Keepers
+	paramsKeeper         params.Keeper
 	authKeeper           auth.Keeper
 	validatorKeeper      validator.Keeper
 	modelinfoKeeper      modelinfo.Keeper
 	pkiKeeper            pki.Keeper
 	complianceKeeper     compliance.Keeper
 	compliancetestKeeper compliancetest.Keeper
+	otaKeeper            ota.Keeper
+	upgradeKeeper        upgrade.Keeper
+	auditKeeper          audit.Keeper
+	grantKeeper          grant.Keeper
 
 	// Module Manager
 	mm *module.Manager
+
+	txDecoder sdk.TxDecoder
+	// nil if the metrics are disabled
+	metrics *metrics.AppMetrics
 }
 
 // NewDcLedgerApp is a constructor function for dcLedgerApp.
@@ -96,38 +118,55 @@ func NewDcLedgerApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 	// First define the top level codec that will be shared by the different modules
 	cdc := MakeCodec()
 
+	txDecoder := authutils.DefaultTxDecoder(cdc)
+
 	// BaseApp handles interactions with Tendermint through the ABCI protocol
-	bApp := bam.NewBaseApp(appName, logger, db, authutils.DefaultTxDecoder(cdc), baseAppOptions...)
+	bApp := bam.NewBaseApp(appName, logger, db, txDecoder, baseAppOptions...)
 
 	bApp.SetAppVersion(version.Version)
 
 	keys := sdk.NewKVStoreKeys(bam.MainStoreKey, auth.StoreKey, validator.StoreKey,
-		modelinfo.StoreKey, compliance.StoreKey, compliancetest.StoreKey, pki.StoreKey)
+		modelinfo.StoreKey, compliance.StoreKey, compliancetest.StoreKey, pki.StoreKey, ota.StoreKey, upgrade.StoreKey,
+		audit.StoreKey, grant.StoreKey, params.StoreKey)
 
-	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey, auth.TStoreKey)
 
 	// Here you initialize your application with the store keys it requires.
 	app := &dcLedgerApp{
-		BaseApp: bApp,
-		cdc:     cdc,
-		keys:    keys,
-		tkeys:   tkeys,
+		BaseApp:   bApp,
+		cdc:       cdc,
+		keys:      keys,
+		tkeys:     tkeys,
+		txDecoder: txDecoder,
 	}
 
-	InitKeepers(app, keys)
+	InitKeepers(app, keys, tkeys)
 
 	InitModuleManager(app)
 
+	app.registerMigrations()
+	app.setUpgradeHandlers()
+
 	// The initChainer handles translating the genesis.json file into initial state for the network.
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
 	// The AnteHandler handles signature verification and transaction pre-processing.
+	// The transactions of the signers exceeding their per-block limits are rejected after that,
+	// then messages executed on behalf of other accounts are checked against the grants (and the frozen accounts).
 	app.SetAnteHandler(
-		auth.NewAnteHandler(
+		grant.NewAnteHandler(
+			app.grantKeeper,
 			app.authKeeper,
-			auth.DefaultSigVerificationGasConsumer,
+			auth.NewTxLimitAnteHandler(
+				app.authKeeper,
+				tkeys[auth.TStoreKey],
+				auth.NewAnteHandler(
+					app.authKeeper,
+					auth.DefaultSigVerificationGasConsumer,
+				),
+			),
 		),
 	)
 
@@ -152,26 +191,44 @@ func InitModuleManager(app *dcLedgerApp) {
 		compliance.NewAppModule(app.complianceKeeper, app.modelinfoKeeper, app.compliancetestKeeper, app.authKeeper),
 		compliancetest.NewAppModule(app.compliancetestKeeper, app.authKeeper, app.modelinfoKeeper),
 		pki.NewAppModule(app.pkiKeeper, app.authKeeper),
+		ota.NewAppModule(app.otaKeeper, app.authKeeper, app.modelinfoKeeper, app.complianceKeeper),
+		upgrade.NewAppModule(app.upgradeKeeper, app.authKeeper),
+		audit.NewAppModule(app.auditKeeper),
+		grant.NewAppModule(app.grantKeeper, app.authKeeper, app.Router()),
+		params.NewAppModule(app.paramsKeeper, app.authKeeper),
 	)
 
-	app.mm.SetOrderBeginBlockers(validator.ModuleName)
-	app.mm.SetOrderEndBlockers(validator.ModuleName)
+	// upgrade must be applied before any other module processes the block
+	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, validator.ModuleName)
+	app.mm.SetOrderEndBlockers(validator.ModuleName, pki.ModuleName, compliance.ModuleName)
 
+	// params must be initialized before the modules reading them
 	app.mm.SetOrderInitGenesis(
+		params.ModuleName,
 		auth.ModuleName,
 		validator.ModuleName,
 		modelinfo.ModuleName,
 		compliance.ModuleName,
 		compliancetest.ModuleName,
 		pki.ModuleName,
+		ota.ModuleName,
+		upgrade.ModuleName,
+		grant.ModuleName,
+		// audit entries must be restored before the genesis transactions are recorded
+		audit.ModuleName,
 		genutil.ModuleName,
 	)
 
 	// register all module routes and module queriers
-	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	router := senderEventsRouter{referenceEventsRouter{app.Router(), authutils.DefaultTxDecoder(app.cdc)}}
+	queryRouter := params.NewQueryRouter(app.QueryRouter(), app.paramsKeeper)
+	app.mm.RegisterRoutes(audit.NewRouter(router, app.auditKeeper), queryRouter)
 }
 
-func InitKeepers(app *dcLedgerApp, keys map[string]*sdk.KVStoreKey) {
+func InitKeepers(app *dcLedgerApp, keys map[string]*sdk.KVStoreKey, tkeys map[string]*sdk.TransientStoreKey) {
+	// The Params keeper (must be created before the keepers of the parametrized modules)
+	app.paramsKeeper = MakeParamsKeeper(keys, tkeys, app)
+
 	// The Validator keeper
 	app.validatorKeeper = MakeValidatorKeeper(keys, app)
 
@@ -187,13 +244,37 @@ func InitKeepers(app *dcLedgerApp, keys map[string]*sdk.KVStoreKey) {
 	// The PKI keeper
 	app.pkiKeeper = MakePkiKeeper(keys, app)
 
+	// The OTA keeper
+	app.otaKeeper = MakeOtaKeeper(keys, app)
+
+	// The Upgrade keeper
+	app.upgradeKeeper = MakeUpgradeKeeper(keys, app)
+
 	// The AuthKeeper keeper
 	app.authKeeper = MakeAuthKeeper(keys, app)
+
+	// The Audit keeper
+	app.auditKeeper = MakeAuditKeeper(keys, app)
+
+	// The Grant keeper
+	app.grantKeeper = MakeGrantKeeper(keys, app)
+}
+
+func MakeParamsKeeper(keys map[string]*sdk.KVStoreKey, tkeys map[string]*sdk.TransientStoreKey,
+	app *dcLedgerApp) params.Keeper {
+	return params.NewKeeper(
+		keys[params.StoreKey],
+		tkeys[params.TStoreKey],
+		app.cdc,
+	)
 }
 
 func MakeAuthKeeper(keys map[string]*sdk.KVStoreKey, app *dcLedgerApp) auth.Keeper {
+	authDefaults := auth.DefaultParams()
+
 	return auth.NewKeeper(
 		keys[auth.StoreKey],
+		app.paramsKeeper.Subspace(auth.DefaultParamspace, &authDefaults),
 		app.cdc,
 	)
 }
@@ -206,8 +287,11 @@ func MakeModelinfoKeeper(keys map[string]*sdk.KVStoreKey, app *dcLedgerApp) mode
 }
 
 func MakeComplianceKeeper(keys map[string]*sdk.KVStoreKey, app *dcLedgerApp) compliance.Keeper {
+	complianceDefaults := compliance.DefaultParams()
+
 	return compliance.NewKeeper(
 		keys[compliance.StoreKey],
+		app.paramsKeeper.Subspace(compliance.DefaultParamspace, &complianceDefaults),
 		app.cdc,
 	)
 }
@@ -226,13 +310,108 @@ func MakePkiKeeper(keys map[string]*sdk.KVStoreKey, app *dcLedgerApp) pki.Keeper
 	)
 }
 
+func MakeOtaKeeper(keys map[string]*sdk.KVStoreKey, app *dcLedgerApp) ota.Keeper {
+	return ota.NewKeeper(
+		keys[ota.StoreKey],
+		app.cdc,
+	)
+}
+
+func MakeUpgradeKeeper(keys map[string]*sdk.KVStoreKey, app *dcLedgerApp) upgrade.Keeper {
+	upgradeDefaults := upgrade.DefaultParams()
+
+	return upgrade.NewKeeper(
+		keys[upgrade.StoreKey],
+		app.paramsKeeper.Subspace(upgrade.DefaultParamspace, &upgradeDefaults),
+		app.cdc,
+	)
+}
+
+func MakeAuditKeeper(keys map[string]*sdk.KVStoreKey, app *dcLedgerApp) audit.Keeper {
+	return audit.NewKeeper(
+		keys[audit.StoreKey],
+		app.cdc,
+	)
+}
+
+func MakeGrantKeeper(keys map[string]*sdk.KVStoreKey, app *dcLedgerApp) grant.Keeper {
+	return grant.NewKeeper(
+		keys[grant.StoreKey],
+		app.cdc,
+	)
+}
+
 func MakeValidatorKeeper(keys map[string]*sdk.KVStoreKey, app *dcLedgerApp) validator.Keeper {
+	validatorDefaults := validator.DefaultParams()
+
 	return validator.NewKeeper(
 		keys[validator.StoreKey],
+		app.paramsKeeper.Subspace(validator.DefaultParamspace, &validatorDefaults),
 		app.cdc,
 	)
 }
 
+// senderEventsRouter adds the signers of every message to the message events (`message.sender`),
+// so the transactions signed by an account can be found in the Tendermint transactions index.
+type senderEventsRouter struct {
+	sdk.Router
+}
+
+func (r senderEventsRouter) AddRoute(path string, handler sdk.Handler) sdk.Router {
+	r.Router.AddRoute(path, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		res := handler(ctx, msg)
+
+		for _, signer := range msg.GetSigners() {
+			res.Events = res.Events.AppendEvent(
+				sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeySender, signer.String())),
+			)
+		}
+
+		return res
+	})
+
+	return r
+}
+
+// referenceEventsRouter adds the memo of the transaction (without the timeout height) to the events
+// of every its message (`reference.id`), so the transactions can be found by the reference ID attached by the caller.
+type referenceEventsRouter struct {
+	sdk.Router
+	txDecoder sdk.TxDecoder
+}
+
+func (r referenceEventsRouter) AddRoute(path string, handler sdk.Handler) sdk.Router {
+	r.Router.AddRoute(path, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		res := handler(ctx, msg)
+
+		// transactions delivered in genesis do not have bytes
+		if len(ctx.TxBytes()) == 0 {
+			return res
+		}
+
+		tx, err := r.txDecoder(ctx.TxBytes())
+		if err != nil {
+			return res
+		}
+
+		stdTx, ok := tx.(authutils.StdTx)
+		if !ok {
+			return res
+		}
+
+		// the timeout height attached to the memo is not a part of the reference ID
+		if _, referenceID, err := txtimeout.Parse(stdTx.Memo); err == nil && len(referenceID) != 0 {
+			res.Events = res.Events.AppendEvent(
+				sdk.NewEvent(auth.EventTypeReference, sdk.NewAttribute(auth.AttributeKeyReferenceID, referenceID)),
+			)
+		}
+
+		return res
+	})
+
+	return r
+}
+
 // GenesisState represents chain state at the start of the chain. Any initial state (account balances) are stored here.
 type GenesisState map[string]json.RawMessage
 
@@ -248,7 +427,12 @@ func (app *dcLedgerApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain)
 		panic(err)
 	}
 
-	return app.mm.InitGenesis(ctx, genesisState)
+	res := app.mm.InitGenesis(ctx, genesisState)
+
+	// the genesis state is always in the store schemas of the running binary
+	app.initModuleVersions(ctx)
+
+	return res
 }
 
 func (app *dcLedgerApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
@@ -259,6 +443,143 @@ func (app *dcLedgerApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 	return app.mm.EndBlock(ctx, req)
 }
 
+// SetMetrics enables the metrics of the application-level operations.
+func (app *dcLedgerApp) SetMetrics(appMetrics *metrics.AppMetrics) {
+	app.metrics = appMetrics
+}
+
+// EnableStoreStats serves the query of the statistics of all the stores (see storestats package).
+// The main kinds of the records are reported by name.
+func (app *dcLedgerApp) EnableStoreStats() {
+	names := map[string]map[byte]string{
+		modelinfo.StoreKey: {
+			modelinfo.ModelInfoPrefix[0]:    "models",
+			modelinfo.ModelVersionPrefix[0]: "model_versions",
+		},
+		compliance.StoreKey: {
+			compliance.ComplianceInfoPrefix[0]:           "compliance_infos",
+			compliance.CertificationDeclarationPrefix[0]: "certification_declarations",
+		},
+		compliancetest.StoreKey: {
+			compliancetest.TestingResultsPrefix[0]: "testing_results",
+		},
+		pki.StoreKey: {
+			pki.ProposedCertificatePrefix[0]: "proposed_certificates",
+			pki.ApprovedCertificatePrefix[0]: "certificates",
+			pki.RevokedCertificatePrefix[0]:  "revoked_certificates",
+		},
+		auth.StoreKey: {
+			auth.PendingAccountPrefix[0]: "pending_accounts",
+			auth.AccountPrefix[0]:        "accounts",
+		},
+		validator.StoreKey: {
+			validator.ValidatorPrefix[0]:        "validators",
+			validator.PendingValidatorPrefix[0]: "pending_validators",
+		},
+		audit.StoreKey: {
+			audit.AuditEntryPrefix[0]: "audit_entries",
+		},
+	}
+
+	stores := []storestats.Store{}
+	for name, key := range app.keys {
+		stores = append(stores, storestats.NewStore(key, names[name]))
+	}
+
+	// the store size metrics are updated by the query rather than on commit, as it iterates over the whole state
+	observe := func(stats storestats.StoreStats) {
+		if app.metrics != nil {
+			app.metrics.ObserveStore(stats.Store, stats.Records, stats.Bytes)
+		}
+	}
+
+	app.QueryRouter().AddRoute(storestats.QuerierRoute, storestats.NewQuerier(app.cdc, observe, stores...))
+}
+
+// CheckTx records the transactions rejected from the mempool.
+func (app *dcLedgerApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	res := app.BaseApp.CheckTx(req)
+
+	if app.metrics != nil && req.Type == abci.CheckTxType_New {
+		app.metrics.ObserveTx(metrics.StageCheckTx, nil, res.Code, res.Codespace)
+	}
+
+	return res
+}
+
+// DeliverTx records the delivered messages and rejected transactions.
+func (app *dcLedgerApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+
+	if app.metrics != nil {
+		tx, err := app.txDecoder(req.Tx)
+		if err != nil {
+			tx = nil
+		}
+
+		app.metrics.ObserveTx(metrics.StageDeliverTx, tx, res.Code, res.Codespace)
+	}
+
+	return res
+}
+
+// setUpgradeHandlers registers the handlers of the upgrades supported by this binary.
+// A handler is executed once at the height of the approved upgrade plan and performs
+// store migrations of the modules changed by the upgrade, e.g.:
+//
+//	app.upgradeKeeper.SetUpgradeHandler("v0.2.0", func(ctx sdk.Context, plan upgrade.Plan) {
+//		if err := app.RunMigrations(ctx); err != nil {
+//			panic(err)
+//		}
+//	})
+func (app *dcLedgerApp) setUpgradeHandlers() {}
+
+// registerMigrations collects the store migrations provided by the modules.
+func (app *dcLedgerApp) registerMigrations() {
+	for _, m := range app.mm.Modules {
+		if migrations, ok := m.(upgrade.HasMigrations); ok {
+			migrations.RegisterMigrations(app.upgradeKeeper)
+		}
+	}
+
+	// auth version 1 -> 2: the pending accounts proposed before their proposal height was tracked
+	// are considered proposed at the migration height
+	app.upgradeKeeper.RegisterMigration(auth.ModuleName, 1, func(ctx sdk.Context) error {
+		app.authKeeper.SetPendingAccountsProposedAt(ctx)
+
+		return nil
+	})
+}
+
+// moduleVersions returns the consensus versions of the module store schemas supported by this binary.
+func (app *dcLedgerApp) moduleVersions() map[string]uint64 {
+	versions := make(map[string]uint64, len(app.mm.Modules))
+
+	for name, m := range app.mm.Modules {
+		versions[name] = upgrade.DefaultModuleVersion
+
+		if versioned, ok := m.(upgrade.HasConsensusVersion); ok {
+			versions[name] = versioned.ConsensusVersion()
+		}
+	}
+
+	return versions
+}
+
+// initModuleVersions records the versions supported by this binary for the modules having no version recorded.
+func (app *dcLedgerApp) initModuleVersions(ctx sdk.Context) {
+	for name, version := range app.moduleVersions() {
+		if !app.upgradeKeeper.IsModuleVersionPresent(ctx, name) {
+			app.upgradeKeeper.SetModuleVersion(ctx, name, version)
+		}
+	}
+}
+
+// RunMigrations migrates the module stores to the versions supported by this binary.
+func (app *dcLedgerApp) RunMigrations(ctx sdk.Context) error {
+	return app.upgradeKeeper.RunMigrations(ctx, app.moduleVersions())
+}
+
 func (app *dcLedgerApp) LoadHeight(height int64) error {
 	return app.LoadVersion(height, app.keys[bam.MainStoreKey])
 }
@@ -270,6 +591,136 @@ func (app *dcLedgerApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhit
 	// as if they could withdraw from the start of the next block
 	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
 
+	if forZeroHeight {
+		app.prepForZeroHeightGenesis(ctx)
+	}
+
+	return app.exportAppStateAndValidators(ctx)
+}
+
+// MigrateGenesis converts the application state exported by a previous version of the binary
+// to the store schemas supported by this binary. The exported states of the modules are converted
+// by the registered genesis migrations first (so they can be decoded by the genesis types of this binary),
+// then the state is loaded into the in-memory application, the registered store migrations are run
+// starting from the module versions recorded in the state (the modules without a recorded version
+// are considered to be of upgrade.DefaultModuleVersion), and the resulting state is exported.
+func MigrateGenesis(logger log.Logger, chainID string, appState json.RawMessage,
+) (json.RawMessage, []tmtypes.GenesisValidator, error) {
+	app := NewDcLedgerApp(logger, dbm.NewMemDB())
+
+	var genesisState GenesisState
+	if err := app.cdc.UnmarshalJSON(appState, &genesisState); err != nil {
+		return nil, nil, err
+	}
+
+	// only the module versions are decoded, the rest of the upgrade state may be of the previous schema as well
+	var upgradeGenesis struct {
+		ModuleVersions []upgrade.ModuleVersion `json:"module_versions"`
+	}
+
+	if state, ok := genesisState[upgrade.ModuleName]; ok {
+		if err := app.cdc.UnmarshalJSON(state, &upgradeGenesis); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	versions := make(map[string]uint64, len(app.mm.Modules))
+	for name := range app.mm.Modules {
+		versions[name] = upgrade.DefaultModuleVersion
+	}
+
+	for _, record := range upgradeGenesis.ModuleVersions {
+		versions[record.Module] = record.Version
+	}
+
+	if err := app.migrateGenesisState(genesisState, versions); err != nil {
+		return nil, nil, err
+	}
+
+	migratedAppState, err := codec.MarshalJSONIndent(app.cdc, genesisState)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	app.InitChain(abci.RequestInitChain{ChainId: chainID, AppStateBytes: migratedAppState})
+
+	ctx := app.NewContext(false, abci.Header{ChainID: chainID})
+
+	// restore the versions of the state overridden on init
+	for name, version := range versions {
+		app.upgradeKeeper.SetModuleVersion(ctx, name, version)
+	}
+
+	if err := app.RunMigrations(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	return app.exportAppStateAndValidators(ctx)
+}
+
+// genesisMigrations collects the genesis migrations provided by the modules (by module name and version
+// to migrate from).
+type genesisMigrations map[string]map[uint64]upgrade.GenesisMigration
+
+func (m genesisMigrations) RegisterGenesisMigration(module string, fromVersion uint64,
+	migration upgrade.GenesisMigration) {
+	if _, ok := m[module]; !ok {
+		m[module] = map[uint64]upgrade.GenesisMigration{}
+	}
+
+	if _, ok := m[module][fromVersion]; ok {
+		panic(fmt.Sprintf("genesis migration of module %v from version %v is already registered", module, fromVersion))
+	}
+
+	m[module][fromVersion] = migration
+}
+
+// migrateGenesisState converts the exported states of the modules from the given versions to the versions
+// supported by this binary. The versions without a registered genesis migration do not change the genesis schema.
+func (app *dcLedgerApp) migrateGenesisState(genesisState GenesisState, versions map[string]uint64) error {
+	migrations := genesisMigrations{}
+
+	for _, m := range app.mm.Modules {
+		if migrator, ok := m.(upgrade.HasGenesisMigrations); ok {
+			migrator.RegisterGenesisMigrations(migrations)
+		}
+	}
+
+	for name, toVersion := range app.moduleVersions() {
+		state, ok := genesisState[name]
+		if !ok {
+			continue
+		}
+
+		fromVersion := versions[name]
+		if fromVersion > toVersion {
+			return fmt.Errorf("state of module %v has version %v which is newer than the supported version %v",
+				name, fromVersion, toVersion)
+		}
+
+		for version := fromVersion; version < toVersion; version++ {
+			migration, ok := migrations[name][version]
+			if !ok {
+				continue
+			}
+
+			migrated, err := migration(state)
+			if err != nil {
+				return fmt.Errorf("failed to migrate genesis state of module %v from version %v: %w",
+					name, version, err)
+			}
+
+			state = migrated
+		}
+
+		genesisState[name] = state
+	}
+
+	return nil
+}
+
+func (app *dcLedgerApp) exportAppStateAndValidators(ctx sdk.Context,
+) (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
 	genState := app.mm.ExportGenesis(ctx)
 
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
@@ -281,3 +732,15 @@ func (app *dcLedgerApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhit
 
 	return appState, validators, nil
 }
+
+// prepare the state for export for a chain which will be started from zero height:
+// liveness tracking is reset, so validators are not punished for blocks missed before the restart.
+func (app *dcLedgerApp) prepForZeroHeightGenesis(ctx sdk.Context) {
+	app.validatorKeeper.IterateValidatorSigningInfos(ctx, func(info validator.ValidatorSigningInfo) (stop bool) {
+		app.validatorKeeper.ClearValidatorMissedBlockBitArray(ctx, info.Address)
+
+		app.validatorKeeper.SetValidatorSigningInfo(ctx, validator.NewValidatorSigningInfo(info.Address, 0))
+
+		return false
+	})
+}
@@ -0,0 +1,49 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+)
+
+func (c *Client) ProposeAddAccount(msg auth.MsgProposeAddAccount) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+func (c *Client) ApproveAddAccount(msg auth.MsgApproveAddAccount) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+func (c *Client) ProposeRevokeAccount(msg auth.MsgProposeRevokeAccount) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+func (c *Client) ApproveRevokeAccount(msg auth.MsgApproveRevokeAccount) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+// The account is returned without amino type prefix, so it is decoded as an unregistered type.
+type accountResult auth.Account
+
+func (c *Client) GetAccount(address sdk.AccAddress) (auth.Account, error) {
+	var res accountResult
+
+	err := c.get(fmt.Sprintf("/%s/accounts/%s", auth.RouterKey, address), &res)
+
+	return auth.Account(res), err
+}
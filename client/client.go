@@ -0,0 +1,293 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package client is a Go client of the ledger REST API.
+// It provides typed functions for the ledger transactions and queries, signs the transactions locally,
+// tracks the sequence of the signing account and retries the requests failed because the REST server
+// or the node is temporarily unavailable.
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	app "github.com/zigbee-alliance/distributed-compliance-ledger"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/signer"
+)
+
+const (
+	DefaultGas        = 2000000
+	DefaultMaxRetries = 3
+	DefaultRetryDelay = 500 * time.Millisecond
+	DefaultTimeout    = 30 * time.Second
+)
+
+type Config struct {
+	// URL of the REST server (e.g. `http://localhost:1317`).
+	URL     string
+	ChainID string
+
+	// Account signing the transactions and the name and passphrase of its key in the signer.
+	// Not required if the client is used for queries only.
+	From       sdk.AccAddress
+	KeyName    string
+	Passphrase string
+	// Signer of the transactions: the local keybase (`keys.NewKeyBaseFromDir`) or a signer backend.
+	Signer signer.Signer
+
+	// Gas limit of the transactions.
+	Gas uint64
+	// Transaction broadcast mode (block, sync, async).
+	BroadcastMode string
+
+	// Number of retries of the requests failed because the REST server or the node is unavailable.
+	MaxRetries int
+	// Delay before the first retry. It is doubled for every next retry.
+	RetryDelay time.Duration
+
+	HTTPClient *http.Client
+}
+
+func DefaultConfig(url string, chainID string) Config {
+	return Config{
+		URL:           url,
+		ChainID:       chainID,
+		Gas:           DefaultGas,
+		BroadcastMode: flags.BroadcastBlock,
+		MaxRetries:    DefaultMaxRetries,
+		RetryDelay:    DefaultRetryDelay,
+		HTTPClient:    &http.Client{Timeout: DefaultTimeout},
+	}
+}
+
+type Client struct {
+	config Config
+	cdc    *codec.Codec
+
+	// Serializes the transactions of the client to keep the account sequence consistent.
+	mu sync.Mutex
+	// Account number and sequence used for the next transaction (nil if they must be fetched from the ledger).
+	account *accountState
+}
+
+type accountState struct {
+	number   uint64
+	sequence uint64
+}
+
+func New(config Config) (*Client, error) {
+	if len(config.URL) == 0 {
+		return nil, fmt.Errorf("URL of the REST server must be specified")
+	}
+
+	if len(config.ChainID) == 0 {
+		return nil, fmt.Errorf("chain ID must be specified")
+	}
+
+	if config.HTTPClient == nil {
+		config.HTTPClient = &http.Client{Timeout: DefaultTimeout}
+	}
+
+	if config.Gas == 0 {
+		config.Gas = DefaultGas
+	}
+
+	if len(config.BroadcastMode) == 0 {
+		config.BroadcastMode = flags.BroadcastBlock
+	}
+
+	config.URL = strings.TrimSuffix(config.URL, "/")
+
+	return &Client{config: config, cdc: app.MakeCodec()}, nil
+}
+
+// Address of the account signing the transactions.
+func (c *Client) Address() sdk.AccAddress {
+	return c.config.From
+}
+
+// Error is returned when the request is rejected by the REST server or the ledger.
+type Error struct {
+	// HTTP status of the response (200 for the transactions rejected by the ledger,
+	// 0 for the messages failed the validation before sending).
+	StatusCode int
+	// Stable code of the error (see `errcodes` package). Empty if the error has no code.
+	Codespace sdk.CodespaceType
+	Code      sdk.CodeType
+	Name      string
+	Message   string
+}
+
+func (e *Error) Error() string {
+	if e.Code == sdk.CodeOK {
+		return fmt.Sprintf("request failed with status %d: %s", e.StatusCode, e.Message)
+	}
+
+	return fmt.Sprintf("request failed with %s/%d (%s): %s", e.Codespace, e.Code, e.Name, e.Message)
+}
+
+// IsErrorCode checks whether the request is rejected with the given code.
+func IsErrorCode(err error, codespace sdk.CodespaceType, code sdk.CodeType) bool {
+	e, ok := err.(*Error)
+
+	return ok && e.Codespace == codespace && e.Code == code
+}
+
+// IsNotFound checks whether the requested entity does not exist.
+func IsNotFound(err error) bool {
+	e, ok := err.(*Error)
+
+	return ok && e.StatusCode == http.StatusNotFound
+}
+
+// Sends GET request and decodes the result of the response.
+func (c *Client) get(path string, out interface{}) error {
+	body, err := c.do(http.MethodGet, path, nil)
+	if err != nil {
+		return err
+	}
+
+	return c.decodeResult(body, out)
+}
+
+// Sends the request retrying it if the REST server or the node is unavailable.
+func (c *Client) do(method string, path string, body []byte) ([]byte, error) {
+	delay := c.config.RetryDelay
+
+	for attempt := 0; ; attempt++ {
+		res, err := c.send(method, path, body)
+		if err == nil || !isTemporary(err) || attempt >= c.config.MaxRetries {
+			return res, unwrap(err)
+		}
+
+		time.Sleep(delay)
+		delay *= 2
+	}
+}
+
+func (c *Client) send(method string, path string, body []byte) ([]byte, error) {
+	//nolint:noctx
+	req, err := http.NewRequest(method, c.config.URL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.config.HTTPClient.Do(req)
+	if err != nil {
+		return nil, temporaryError{err}
+	}
+
+	defer resp.Body.Close()
+
+	res, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, temporaryError{err}
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		return res, nil
+	}
+
+	var errResp rest.ErrorResponse
+	if json.Unmarshal(res, &errResp) != nil || len(errResp.Error) == 0 {
+		errResp.Error = strings.TrimSpace(string(res))
+	}
+
+	restErr := &Error{
+		StatusCode: resp.StatusCode,
+		Codespace:  errResp.Codespace,
+		Code:       errResp.Code,
+		Name:       errResp.Name,
+		Message:    errResp.Error,
+	}
+
+	// the node behind the REST server is not reachable
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests {
+		return nil, temporaryError{restErr}
+	}
+
+	return nil, restErr
+}
+
+// Decodes `result` of the response. Depending on the endpoint it is encoded either by amino or by encoding/json.
+func (c *Client) decodeResult(body []byte, out interface{}) error {
+	var resp struct {
+		Result json.RawMessage `json:"result"`
+	}
+
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return err
+	}
+
+	if json.Unmarshal(resp.Result, out) == nil {
+		return nil
+	}
+
+	return c.cdc.UnmarshalJSON(resp.Result, out)
+}
+
+// Error which can be fixed by retrying the request.
+type temporaryError struct {
+	err error
+}
+
+func (e temporaryError) Error() string {
+	return e.err.Error()
+}
+
+func isTemporary(err error) bool {
+	_, ok := err.(temporaryError)
+
+	return ok
+}
+
+// Returns the original error of the request, so the callers can inspect it.
+func unwrap(err error) error {
+	if tmp, ok := err.(temporaryError); ok {
+		return tmp.err
+	}
+
+	return err
+}
+
+func txError(res sdk.TxResponse) error {
+	return newError(http.StatusOK, sdk.CodespaceType(res.Codespace), sdk.CodeType(res.Code), res.RawLog)
+}
+
+func newError(status int, codespace sdk.CodespaceType, code sdk.CodeType, message string) *Error {
+	errorCode, _ := errcodes.Lookup(codespace, code)
+
+	return &Error{
+		StatusCode: status,
+		Codespace:  codespace,
+		Code:       code,
+		Name:       errorCode.Name,
+		Message:    message,
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	app "github.com/zigbee-alliance/distributed-compliance-ledger"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+)
+
+const chainID = "test-chain"
+
+type keySigner struct {
+	key secp256k1.PrivKeySecp256k1
+}
+
+func (s keySigner) Sign(_ string, _ string, msg []byte) ([]byte, crypto.PubKey, error) {
+	signature, err := s.key.Sign(msg)
+
+	return signature, s.key.PubKey(), err
+}
+
+// REST server emulating the ledger: it verifies the signatures and the sequences of the transactions.
+type ledger struct {
+	t       *testing.T
+	account auth.Account
+	// number of the account queries
+	accountQueries int
+	// broadcasted transactions
+	txs []authtypes.StdTx
+	// number of the next requests failed with 503 status
+	unavailable int
+}
+
+func (l *ledger) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	cdc := app.MakeCodec()
+
+	if l.unavailable > 0 {
+		l.unavailable--
+
+		rest.WriteError(w, http.StatusServiceUnavailable, fmt.Errorf("node is not reachable"))
+
+		return
+	}
+
+	switch r.URL.Path {
+	case fmt.Sprintf("/auth/accounts/%s", l.account.Address):
+		l.accountQueries++
+
+		writeResult(l.t, w, cdc.MustMarshalJSON(accountResult(l.account)))
+	case "/tx/broadcast":
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(l.t, err)
+
+		var tx authtypes.StdTx
+		require.NoError(l.t, cdc.UnmarshalJSON(body, &tx))
+
+		signBytes := authtypes.StdSignBytes(chainID, l.account.AccountNumber, l.account.Sequence,
+			tx.Fee, tx.Msgs, tx.Memo)
+		res := sdk.TxResponse{Height: 1, TxHash: "ABCD"}
+
+		if !tx.Signatures[0].PubKey.VerifyBytes(signBytes, tx.Signatures[0].Signature) {
+			res = sdk.TxResponse{
+				Codespace: string(sdk.CodespaceRoot),
+				Code:      uint32(sdk.CodeUnauthorized),
+				RawLog:    "Signature verification failed; verify correct account sequence and chain-id",
+			}
+		} else {
+			l.account.Sequence++
+			l.txs = append(l.txs, tx)
+		}
+
+		writeResult(l.t, w, cdc.MustMarshalJSON(res))
+	case "/modelinfo/models/1/1":
+		writeResult(l.t, w, cdc.MustMarshalJSON(modelinfo.ModelInfo{VID: 1, PID: 1, Name: "Device"}))
+	default:
+		rest.WriteError(w, http.StatusNotFound, modelinfo.ErrModelInfoDoesNotExist(1, 2))
+	}
+}
+
+func writeResult(t *testing.T, w http.ResponseWriter, result []byte) {
+	_, err := w.Write([]byte(fmt.Sprintf(`{"height":"1","result":%s}`, result)))
+	require.NoError(t, err)
+}
+
+func setup(t *testing.T) (*Client, *ledger, func()) {
+	key := secp256k1.GenPrivKey()
+	address := sdk.AccAddress(key.PubKey().Address())
+
+	l := &ledger{
+		t:       t,
+		account: auth.Account{Address: address, PubKey: key.PubKey(), AccountNumber: 5, Sequence: 3},
+	}
+	server := httptest.NewServer(l)
+
+	config := DefaultConfig(server.URL, chainID)
+	config.From = address
+	config.KeyName = "jack"
+	config.Signer = keySigner{key: key}
+	config.RetryDelay = time.Millisecond
+
+	client, err := New(config)
+	require.NoError(t, err)
+
+	return client, l, server.Close
+}
+
+func newMsgAddModelInfo(pid uint16, signer sdk.AccAddress) modelinfo.MsgAddModelInfo {
+	return modelinfo.NewMsgAddModelInfo(1, pid, 1, "1.0", "Device", "Description", "SKU", "1.0", "2.0",
+		"", "", "", "", false, signer)
+}
+
+func TestClient_BroadcastMsgs_TracksSequence(t *testing.T) {
+	client, ledger, teardown := setup(t)
+	defer teardown()
+
+	for pid := uint16(1); pid <= 3; pid++ {
+		res, err := client.AddModel(newMsgAddModelInfo(pid, client.Address()))
+		require.NoError(t, err)
+		require.Equal(t, "ABCD", res.TxHash)
+	}
+
+	require.Equal(t, 3, len(ledger.txs))
+	require.Equal(t, uint64(6), ledger.account.Sequence)
+
+	// the account is fetched once
+	require.Equal(t, 1, ledger.accountQueries)
+}
+
+func TestClient_BroadcastMsgs_RefreshesOutdatedSequence(t *testing.T) {
+	client, ledger, teardown := setup(t)
+	defer teardown()
+
+	_, err := client.AddModel(newMsgAddModelInfo(1, client.Address()))
+	require.NoError(t, err)
+
+	// the account is used by another client
+	ledger.account.Sequence += 2
+
+	_, err = client.AddModel(newMsgAddModelInfo(2, client.Address()))
+	require.NoError(t, err)
+
+	require.Equal(t, 2, len(ledger.txs))
+	require.Equal(t, 2, ledger.accountQueries)
+}
+
+func TestClient_BroadcastMsgs_InvalidMsg(t *testing.T) {
+	client, ledger, teardown := setup(t)
+	defer teardown()
+
+	_, err := client.AddModel(newMsgAddModelInfo(0, client.Address()))
+	require.Error(t, err)
+	require.IsType(t, &Error{}, err)
+	require.Equal(t, 0, ledger.accountQueries)
+}
+
+func TestClient_RetriesUnavailable(t *testing.T) {
+	client, ledger, teardown := setup(t)
+	defer teardown()
+
+	ledger.unavailable = DefaultMaxRetries
+
+	model, err := client.GetModel(1, 1)
+	require.NoError(t, err)
+	require.Equal(t, "Device", model.Name)
+
+	ledger.unavailable = DefaultMaxRetries + 1
+
+	_, err = client.GetModel(1, 1)
+	require.Error(t, err)
+	require.Equal(t, http.StatusServiceUnavailable, err.(*Error).StatusCode)
+}
+
+func TestClient_ErrorCode(t *testing.T) {
+	client, _, teardown := setup(t)
+	defer teardown()
+
+	_, err := client.GetModel(1, 2)
+	require.Error(t, err)
+	require.True(t, IsNotFound(err))
+	require.True(t, IsErrorCode(err, modelinfo.ModuleName, modelinfo.CodeModelInfoDoesNotExist))
+	require.Equal(t, "model_info_does_not_exist", err.(*Error).Name)
+}
@@ -0,0 +1,45 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"net/url"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+)
+
+func (c *Client) CertifyModel(msg compliance.MsgCertifyModel) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+func (c *Client) RevokeModel(msg compliance.MsgRevokeModel) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+func (c *Client) ProvisionModel(msg compliance.MsgProvisionModel) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+func (c *Client) GetComplianceInfo(vid uint16, pid uint16,
+	certificationType compliance.CertificationType) (compliance.ComplianceInfo, error) {
+	var res compliance.ComplianceInfo
+
+	err := c.get(fmt.Sprintf("/%s/%v/%v/%s",
+		compliance.RouterKey, vid, pid, url.PathEscape(string(certificationType))), &res)
+
+	return res, err
+}
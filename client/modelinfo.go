@@ -0,0 +1,54 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+)
+
+func (c *Client) AddModel(msg modelinfo.MsgAddModelInfo) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+func (c *Client) UpdateModel(msg modelinfo.MsgUpdateModelInfo) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+func (c *Client) AddModelVersion(msg modelinfo.MsgAddModelVersion) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+func (c *Client) UpdateModelVersion(msg modelinfo.MsgUpdateModelVersion) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+func (c *Client) GetModel(vid uint16, pid uint16) (modelinfo.ModelInfo, error) {
+	var res modelinfo.ModelInfo
+
+	err := c.get(fmt.Sprintf("/%s/models/%v/%v", modelinfo.RouterKey, vid, pid), &res)
+
+	return res, err
+}
+
+func (c *Client) GetModelVersion(vid uint16, pid uint16, softwareVersion uint32) (modelinfo.ModelVersion, error) {
+	var res modelinfo.ModelVersion
+
+	err := c.get(fmt.Sprintf("/%s/versions/%v/%v/%v", modelinfo.RouterKey, vid, pid, softwareVersion), &res)
+
+	return res, err
+}
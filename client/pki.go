@@ -0,0 +1,65 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
+)
+
+func (c *Client) ProposeRootCert(msg pki.MsgProposeAddX509RootCert) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+func (c *Client) ApproveRootCert(msg pki.MsgApproveAddX509RootCert) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+func (c *Client) AddX509Cert(msg pki.MsgAddX509Cert) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+func (c *Client) RevokeX509Cert(msg pki.MsgRevokeX509Cert) (sdk.TxResponse, error) {
+	return c.BroadcastMsgs(msg)
+}
+
+func (c *Client) GetProposedRootCert(subject string, subjectKeyID string) (pki.ProposedCertificate, error) {
+	var res pki.ProposedCertificate
+
+	err := c.get(fmt.Sprintf("/%s/certs/proposed/root/%s/%s",
+		pki.RouterKey, url.PathEscape(subject), url.PathEscape(subjectKeyID)), &res)
+
+	return res, err
+}
+
+func (c *Client) GetX509Cert(subject string, subjectKeyID string) (pki.Certificate, error) {
+	var res pki.Certificates
+
+	err := c.get(fmt.Sprintf("/%s/certs/%s/%s",
+		pki.RouterKey, url.PathEscape(subject), url.PathEscape(subjectKeyID)), &res)
+	if err != nil {
+		return pki.Certificate{}, err
+	}
+
+	if len(res.Items) == 0 {
+		return pki.Certificate{}, &Error{StatusCode: http.StatusNotFound, Message: "certificate not found"}
+	}
+
+	return res.Items[0], nil
+}
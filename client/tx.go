@@ -0,0 +1,115 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/canonicaljson"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+)
+
+// BroadcastMsgs signs the messages as a single transaction by the client account and broadcasts it.
+// The account number and sequence are fetched from the ledger once and then tracked by the client.
+// If the transaction is rejected because of the outdated sequence (e.g. the account is used
+// by another client too), the sequence is fetched again and the transaction is resent.
+func (c *Client) BroadcastMsgs(msgs ...sdk.Msg) (sdk.TxResponse, error) {
+	if c.config.Signer == nil || c.config.From.Empty() {
+		return sdk.TxResponse{}, fmt.Errorf("signer and account must be specified to send transactions")
+	}
+
+	if len(msgs) == 0 {
+		return sdk.TxResponse{}, fmt.Errorf("transaction must contain at least one message")
+	}
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return sdk.TxResponse{}, newError(0, err.Codespace(), err.Code(), err.Error())
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for attempt := 0; ; attempt++ {
+		if c.account == nil {
+			account, err := c.GetAccount(c.config.From)
+			if err != nil {
+				return sdk.TxResponse{}, err
+			}
+
+			c.account = &accountState{number: account.AccountNumber, sequence: account.Sequence}
+		}
+
+		res, err := c.signAndBroadcast(msgs, *c.account)
+		if err != nil {
+			// the transaction may have been accepted or not
+			c.account = nil
+
+			return res, err
+		}
+
+		switch {
+		case sdk.CodeType(res.Code) == sdk.CodeOK || res.Height > 0:
+			// accepted to the mempool or delivered (maybe failed) in a block
+			c.account.sequence++
+		default:
+			c.account = nil
+
+			if attempt == 0 && rest.IsSequenceMismatch(res) {
+				continue
+			}
+		}
+
+		if sdk.CodeType(res.Code) != sdk.CodeOK {
+			return res, txError(res)
+		}
+
+		return res, nil
+	}
+}
+
+func (c *Client) signAndBroadcast(msgs []sdk.Msg, account accountState) (sdk.TxResponse, error) {
+	fee := authtypes.NewStdFee(c.config.Gas, nil)
+	signBytes := canonicaljson.SignBytes(c.config.ChainID, account.number, account.sequence, fee, msgs, "")
+
+	signature, pubKey, err := c.config.Signer.Sign(c.config.KeyName, c.config.Passphrase, signBytes)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	tx := authtypes.NewStdTx(msgs, fee, []authtypes.StdSignature{{PubKey: pubKey, Signature: signature}}, "")
+
+	body, err := c.cdc.MarshalJSON(tx)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	resp, err := c.do(http.MethodPost,
+		fmt.Sprintf("/tx/broadcast?%s=%s", rest.FlagBroadcastMode, c.config.BroadcastMode), body)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	var res sdk.TxResponse
+	if err := c.decodeResult(resp, &res); err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	return res, nil
+}
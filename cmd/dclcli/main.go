@@ -22,21 +22,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
-	"github.com/cosmos/cosmos-sdk/client/lcd"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
-	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/libs/cli"
 	app "github.com/zigbee-alliance/distributed-compliance-ledger"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/cmd/restapi"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/cmd/settings"
-	keyUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/key/rest"
-	proxyUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/proxy/rest"
-	txUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/tx/rest"
+	utilscli "github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/signer"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/storestats"
 )
 
 func main() {
@@ -51,6 +50,17 @@ func main() {
 	config.SetBech32PrefixForConsensusNode(sdk.Bech32PrefixConsAddr, sdk.Bech32PrefixConsPub)
 	config.Seal()
 
+	rootCmd := newRootCmd(cdc)
+
+	executor := cli.PrepareMainCmd(rootCmd, "NS", app.DefaultCLIHome)
+
+	err := executor.Execute()
+	if err != nil {
+		panic(err)
+	}
+}
+
+func newRootCmd(cdc *amino.Codec) *cobra.Command {
 	rootCmd := &cobra.Command{
 		Use:   "dclcli",
 		Short: "DcLedger Client",
@@ -68,37 +78,28 @@ func main() {
 
 	viper.SetDefault(client.FlagBroadcastMode, settings.DefaultBroadcastMode)
 
+	rootCmd.PersistentFlags().String(signer.FlagSigner, signer.BackendKeybase, signer.FlagSignerUsage)
+	rootCmd.PersistentFlags().String(signer.FlagSignerURL, "", signer.FlagSignerURLUsage)
+
 	// Construct Root Command
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
 		rpc.ValidatorCommand(cdc),
-		client.ConfigCmd(app.DefaultCLIHome),
+		utilscli.ConfigCmd(app.DefaultCLIHome),
 		queryCmd(cdc),
 		txCmd(cdc),
 		client.LineBreak,
-		lcd.ServeCommand(cdc, registerRoutes),
+		restapi.ServeCommand(cdc),
 		client.LineBreak,
 		keys.Commands(),
 		client.LineBreak,
 		version.Cmd,
-		client.NewCompletionCmd(rootCmd, true),
+		utilscli.CompletionCmd(rootCmd),
+		// the commands run in the shell are parsed by a new command tree every time
+		utilscli.ShellCmd(func() *cobra.Command { return newRootCmd(cdc) }, "NS", app.DefaultCLIHome),
 	)
 
-	executor := cli.PrepareMainCmd(rootCmd, "NS", app.DefaultCLIHome)
-
-	err := executor.Execute()
-	if err != nil {
-		panic(err)
-	}
-}
-
-func registerRoutes(rs *lcd.RestServer) {
-	client.RegisterRoutes(rs.CliCtx, rs.Mux)
-	authrest.RegisterTxRoutes(rs.CliCtx, rs.Mux)
-	app.ModuleBasics.RegisterRESTRoutes(rs.CliCtx, rs.Mux)
-	proxyUtils.RegisterRoutes(rs.CliCtx, rs.Mux)
-	keyUtils.RegisterRoutes(rs.CliCtx, rs.Mux)
-	txUtils.RegisterRoutes(rs.CliCtx, rs.Mux)
+	return rootCmd
 }
 
 func queryCmd(cdc *amino.Codec) *cobra.Command {
@@ -113,6 +114,7 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 		rpc.BlockCommand(),
 		authcmd.QueryTxsByEventsCmd(cdc),
 		authcmd.QueryTxCmd(cdc),
+		storestats.GetQueryCmd(cdc),
 		client.LineBreak,
 	)
 
@@ -130,8 +132,10 @@ func txCmd(cdc *amino.Codec) *cobra.Command {
 
 	txCmd.AddCommand(
 		authcmd.GetSignCommand(cdc),
-		authcmd.GetBroadcastCommand(cdc),
+		authcmd.GetMultiSignCommand(cdc),
+		utilscli.VerifyPinnedChainBefore(authcmd.GetBroadcastCommand(cdc)),
 		authcmd.GetEncodeCommand(cdc),
+		utilscli.SignBytesCmd(cdc),
 	)
 
 	// add modules' tx commands
@@ -21,7 +21,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/cli"
 	"github.com/tendermint/tendermint/libs/log"
@@ -29,6 +31,10 @@ import (
 	dbm "github.com/tendermint/tm-db"
 	app "github.com/zigbee-alliance/distributed-compliance-ledger"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/cmd/settings"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/grpc"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/logging"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/metrics"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/storestats"
 	genutilcli "github.com/zigbee-alliance/distributed-compliance-ledger/x/genutil/client/cli"
 )
 
@@ -44,11 +50,12 @@ func main() {
 	config.Seal()
 
 	ctx := server.NewDefaultContext()
+	logger := &logging.Filter{}
 
 	rootCmd := &cobra.Command{
 		Use:               "dcld",
 		Short:             "DcLedger App Daemon (server)",
-		PersistentPreRunE: server.PersistentPreRunEFn(ctx),
+		PersistentPreRunE: persistentPreRunE(ctx, logger),
 	}
 	// CLI commands to initialize the chain
 	rootCmd.AddCommand(
@@ -57,12 +64,28 @@ func main() {
 		genutilcli.GenTxCmd(
 			ctx, cdc, app.ModuleBasics, app.DefaultNodeHome, app.DefaultCLIHome,
 		),
-		genutilcli.ValidateGenesisCmd(ctx, cdc, app.ModuleBasics),
+		// ValidateGenesisCmd validates the genesis file reporting all found violations
+		ValidateGenesisCmd(ctx, cdc),
 		// AddGenesisAccountCmd allows users to add accounts to the genesis file
 		genutilcli.AddGenesisAccountCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome),
+		// MigrateGenesisCmd converts the exported genesis file to the store schemas of this binary
+		MigrateGenesisCmd(ctx, cdc),
+		// SnapshotCmd creates and restores the snapshots of the node data
+		SnapshotCmd(ctx),
+		// TestnetCmd generates the files of a test network in one shot
+		TestnetCmd(ctx, cdc, app.ModuleBasics),
 	)
 
-	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
+	server.AddCommands(ctx, cdc, rootCmd, newAppCreator(ctx), exportAppStateAndTMValidators)
+
+	// run gRPC query server and admin server along with the node
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "start" {
+			addGRPCServer(ctx, cmd)
+			addAdminServer(ctx, logger, cmd)
+			cmd.Flags().Bool(storestats.FlagStoreStats, false, storestats.FlagStoreStatsUsage)
+		}
+	}
 
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "NS", app.DefaultNodeHome)
@@ -73,8 +96,89 @@ func main() {
 	}
 }
 
-func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
-	return app.NewDcLedgerApp(logger, db, baseapp.SetPruning(settings.PruningStrategy))
+// Replaces the logger set up by Cosmos SDK with the one supporting log_format and changing
+// the module levels at runtime. Makes the node index the events required by the ledger queries.
+func persistentPreRunE(ctx *server.Context, logger *logging.Filter) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if err := server.PersistentPreRunEFn(ctx)(cmd, args); err != nil {
+			return err
+		}
+
+		if cmd.Name() == version.Cmd.Name() {
+			return nil
+		}
+
+		settings.ConfigureTxIndex(ctx.Config.TxIndex)
+
+		filter, err := logging.NewLogger(ctx.Config)
+		if err != nil {
+			return err
+		}
+
+		*logger = *filter
+
+		ctx.Logger = logger
+		if viper.GetBool(cli.TraceFlag) {
+			ctx.Logger = log.NewTracingLogger(ctx.Logger)
+		}
+
+		ctx.Logger = ctx.Logger.With("module", "main")
+
+		return nil
+	}
+}
+
+func newAppCreator(ctx *server.Context) server.AppCreator {
+	return func(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
+		dclApp := app.NewDcLedgerApp(logger, db, baseapp.SetPruning(settings.PruningStrategy))
+
+		// application metrics are exposed by Tendermint instrumentation server along with its own metrics
+		if ctx.Config.Instrumentation.Prometheus {
+			dclApp.SetMetrics(metrics.PrometheusAppMetrics())
+		}
+
+		if viper.GetBool(storestats.FlagStoreStats) {
+			dclApp.EnableStoreStats()
+		}
+
+		return dclApp
+	}
+}
+
+func addGRPCServer(ctx *server.Context, startCmd *cobra.Command) {
+	startCmd.Flags().String(grpc.FlagAddress, "", grpc.FlagAddressUsage)
+
+	runE := startCmd.RunE
+	startCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if address := viper.GetString(grpc.FlagAddress); len(address) > 0 {
+			_, err := grpc.StartServer(address, ctx.Config.RPC.ListenAddress)
+			if err != nil {
+				return err
+			}
+
+			ctx.Logger.Info("Started gRPC query server", "address", address)
+		}
+
+		return runE(cmd, args)
+	}
+}
+
+func addAdminServer(ctx *server.Context, logger *logging.Filter, startCmd *cobra.Command) {
+	startCmd.Flags().String(logging.FlagAdminAddress, "", logging.FlagAdminAddressUsage)
+
+	runE := startCmd.RunE
+	startCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if address := viper.GetString(logging.FlagAdminAddress); len(address) > 0 {
+			_, err := logging.StartAdminServer(address, logger.Levels())
+			if err != nil {
+				return err
+			}
+
+			ctx.Logger.Info("Started admin server", "address", address)
+		}
+
+		return runE(cmd, args)
+	}
 }
 
 func exportAppStateAndTMValidators(logger log.Logger, db dbm.DB, traceStore io.Writer,
@@ -0,0 +1,78 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	app "github.com/zigbee-alliance/distributed-compliance-ledger"
+)
+
+// MigrateGenesisCmd converts the genesis file exported by a previous version of the binary
+// to the store schemas supported by the running binary.
+func MigrateGenesisCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "migrate [genesis-file]",
+		Short: "Migrate the exported genesis file to the store schemas of this binary",
+		Long: `Migrate the application state of the genesis file exported by a previous version of the binary
+to the store schemas supported by this binary. The migrated genesis is printed to STDOUT.
+
+Example:
+$ dcld migrate /path/to/exported-genesis.json > /path/to/genesis.json
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			genesis := args[0]
+
+			genDoc, err := tmtypes.GenesisDocFromFile(genesis)
+			if err != nil {
+				return sdk.ErrUnknownRequest(
+					fmt.Sprintf("Error loading genesis doc from %s: %s", genesis, err.Error()))
+			}
+
+			// the migrated genesis is printed to STDOUT, so the log is written to STDERR
+			logger := log.NewTMLogger(log.NewSyncWriter(os.Stderr))
+
+			appState, validators, err := app.MigrateGenesis(logger, genDoc.ChainID, genDoc.AppState)
+			if err != nil {
+				return sdk.ErrUnknownRequest(
+					fmt.Sprintf("Error migrating genesis doc %s: %s", genesis, err.Error()))
+			}
+
+			genDoc.AppState = appState
+			genDoc.Validators = validators
+
+			if err = genDoc.ValidateAndComplete(); err != nil {
+				return err
+			}
+
+			out, err := cdc.MarshalJSONIndent(genDoc, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(sdk.MustSortJSON(out)))
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	sm "github.com/tendermint/tendermint/state"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/snapshot"
+)
+
+const (
+	FlagChunkSize      = "chunk-size"
+	FlagChunkSizeUsage = "Size of the snapshot chunks in bytes"
+)
+
+// SnapshotCmd creates and restores the snapshots of the node data, so new (observer) nodes can join the network
+// starting from the snapshot height instead of replaying the full chain.
+func SnapshotCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshot",
+		Short: "Create and restore the snapshots of the node data",
+	}
+
+	cmd.AddCommand(
+		createSnapshotCmd(ctx),
+		restoreSnapshotCmd(ctx),
+	)
+
+	return cmd
+}
+
+func createSnapshotCmd(ctx *server.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [output-dir]",
+		Short: "Create the snapshot of the node data",
+		Long: `Create the snapshot of the node data (the application state, the blocks and the transaction index)
+split into the chunks along with the manifest listing their checksums. The node must be stopped.
+
+Example:
+$ dcld snapshot create /path/to/snapshot
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			state, err := loadState(ctx)
+			if err != nil {
+				return err
+			}
+
+			if state.LastBlockHeight == 0 {
+				return fmt.Errorf("the node has no blocks committed")
+			}
+
+			manifest := snapshot.Manifest{
+				ChainID: state.ChainID,
+				Height:  state.LastBlockHeight,
+				AppHash: fmt.Sprintf("%X", state.AppHash),
+			}
+
+			manifest, err = snapshot.Create(ctx.Config.DBDir(), args[0], manifest, viper.GetInt64(FlagChunkSize))
+			if err != nil {
+				return err
+			}
+
+			ctx.Logger.Info("Created snapshot",
+				"chain-id", manifest.ChainID, "height", manifest.Height, "chunks", len(manifest.Chunks))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagChunkSize, snapshot.DefaultChunkSize, FlagChunkSizeUsage)
+
+	return cmd
+}
+
+func restoreSnapshotCmd(ctx *server.Context) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore [snapshot-dir]",
+		Short: "Restore the node data from the snapshot",
+		Long: `Verify the checksums of the snapshot chunks and restore the node data from the snapshot.
+The node must be initialized with the genesis file of the network and must not have any blocks.
+Once the node is started it catches up with the network starting from the snapshot height.
+
+Example:
+$ dcld snapshot restore /path/to/snapshot
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			genDoc, err := tmtypes.GenesisDocFromFile(ctx.Config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			manifest, err := snapshot.ReadManifest(args[0])
+			if err != nil {
+				return err
+			}
+
+			if manifest.ChainID != genDoc.ChainID {
+				return fmt.Errorf("snapshot of chain %v can not be restored for the node of chain %v",
+					manifest.ChainID, genDoc.ChainID)
+			}
+
+			manifest, err = snapshot.Restore(args[0], ctx.Config.DBDir())
+			if err != nil {
+				return err
+			}
+
+			ctx.Logger.Info("Restored snapshot",
+				"chain-id", manifest.ChainID, "height", manifest.Height, "app-hash", manifest.AppHash)
+
+			return nil
+		},
+	}
+}
+
+// Loads the latest Tendermint state of the node. The database is locked by the running node.
+func loadState(ctx *server.Context) (state sm.State, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("failed to open the node state (make sure the node is stopped): %v", r)
+		}
+	}()
+
+	db := dbm.NewDB("state", dbm.DBBackendType(ctx.Config.DBBackend), ctx.Config.DBDir())
+	defer db.Close()
+
+	return sm.LoadState(db), nil
+}
@@ -0,0 +1,431 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/cosmos/cosmos-sdk/codec"
+	kbkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/genutil"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator"
+)
+
+const (
+	flagValidatorsCount   = "v"
+	flagTrusteesCount     = "trustees"
+	flagOutputDir         = "output-dir"
+	flagNodeDirPrefix     = "node-dir-prefix"
+	flagKeyNames          = "key-names"
+	flagPassphrase        = "passphrase"
+	flagStartingIPAddress = "starting-ip-address"
+
+	testnetP2PPort = 26656
+	testnetRPCPort = 26657
+)
+
+type testnetNode struct {
+	Name      string
+	Dir       string
+	IP        string
+	NodeID    string
+	ValPubKey crypto.PubKey
+	Key       kbkeys.Info
+	P2PPort   int
+	RPCPort   int
+}
+
+// TestnetCmd generates the homes of the validator nodes of a test network along with their keys, genesis
+// transactions, the collected genesis and the docker-compose and systemd assets to run the network.
+//nolint:funlen
+func TestnetCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "testnet",
+		Short: "Initialize the files of a test network with the given number of validator nodes",
+		Long: `Initialize the files of a test network with the given number of validator nodes in one shot.
+The output directory contains:
+  - <node-dir-prefix><i>: the home of the i-th node (mounted as ~/.dcld or copied there) with its dcld.service
+  - client: the home of the CLI (dclcli --home) containing the keys of the node owners
+  - gentxs: the genesis transactions collected into the genesis file of every node
+  - docker-compose.yml: the network of the nodes running in Docker
+
+The owner accounts of the nodes get the NodeAdmin role, the first of them get the Trustee role as well.
+
+Example:
+$ dcld testnet --v 4 --output-dir ./localnet --chain-id dclchain
+`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			outputDir := viper.GetString(flagOutputDir)
+			chainID := viper.GetString(client.FlagChainID)
+			count := viper.GetInt(flagValidatorsCount)
+			trustees := viper.GetInt(flagTrusteesCount)
+
+			if count <= 0 {
+				return sdk.ErrUnknownRequest("the number of validators must be positive")
+			}
+
+			if trustees <= 0 || trustees > count {
+				return sdk.ErrUnknownRequest(
+					fmt.Sprintf("the number of trustees must be between 1 and the number of validators %d", count))
+			}
+
+			ip := net.ParseIP(viper.GetString(flagStartingIPAddress)).To4()
+			if ip == nil {
+				return sdk.ErrUnknownRequest(fmt.Sprintf("invalid starting IPv4 address %q",
+					viper.GetString(flagStartingIPAddress)))
+			}
+
+			if _, err := os.Stat(outputDir); err == nil {
+				return sdk.ErrUnknownRequest(fmt.Sprintf("output directory %s already exists", outputDir))
+			}
+
+			nodes, err := initTestnetNodes(ctx, count, ip, outputDir)
+			if err != nil {
+				return err
+			}
+
+			if err = createTestnetKeys(nodes, outputDir); err != nil {
+				return err
+			}
+
+			genDoc, err := testnetGenesisDoc(cdc, mbm, chainID, nodes, trustees)
+			if err != nil {
+				return err
+			}
+
+			gentxsDir := filepath.Join(outputDir, "gentxs")
+			if err = writeTestnetGenTxs(cdc, chainID, nodes, gentxsDir); err != nil {
+				return err
+			}
+
+			// every node gets the other nodes as persistent peers
+			for _, node := range nodes {
+				config := ctx.Config
+				config.SetRoot(node.Dir)
+				config.Moniker = node.Name
+				config.RPC.ListenAddress = fmt.Sprintf("tcp://0.0.0.0:%d", testnetRPCPort)
+
+				initCfg := genutil.NewInitConfig(chainID, gentxsDir, node.Name, node.NodeID, node.ValPubKey)
+				if _, err = genutil.GenAppStateFromConfig(cdc, config, initCfg, *genDoc); err != nil {
+					return err
+				}
+			}
+
+			// the module states are ordered arbitrarily, so the genesis of the first node is copied to the others
+			genesis, err := ioutil.ReadFile(filepath.Join(nodes[0].Dir, "config", "genesis.json"))
+			if err != nil {
+				return err
+			}
+
+			for _, node := range nodes[1:] {
+				genFile := filepath.Join(node.Dir, "config", "genesis.json")
+				if err = ioutil.WriteFile(genFile, genesis, 0o644); err != nil {
+					return err
+				}
+			}
+
+			if err = writeTestnetAssets(nodes, outputDir, chainID); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(os.Stderr, "Successfully initialized %d node directories in %s\n", count, outputDir)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(flagValidatorsCount, 4, "Number of validator nodes to initialize the test network with")
+	cmd.Flags().Int(flagTrusteesCount, 3, "Number of node owners getting the Trustee role")
+	cmd.Flags().String(flagOutputDir, "./localnet", "Directory to store the initialization data of the test network")
+	cmd.Flags().String(flagNodeDirPrefix, "node", "Prefix of the node directories and monikers")
+	cmd.Flags().StringSlice(flagKeyNames, []string{"jack", "alice", "bob", "anna"},
+		"Names of the node owner keys (the node names are used for the nodes beyond the list)")
+	cmd.Flags().String(flagPassphrase, "test1234", "Passphrase of the node owner keys")
+	cmd.Flags().String(flagStartingIPAddress, "192.167.10.2",
+		"Address of the first node in the Docker network, the following nodes get the next addresses")
+	cmd.Flags().String(client.FlagChainID, "dclchain", "Chain ID of the test network")
+
+	return cmd
+}
+
+// Creates the node homes along with the node and validator keys.
+func initTestnetNodes(ctx *server.Context, count int, ip net.IP, outputDir string) ([]testnetNode, error) {
+	prefix := viper.GetString(flagNodeDirPrefix)
+	nodes := make([]testnetNode, count)
+
+	for i := range nodes {
+		name := fmt.Sprintf("%s%d", prefix, i)
+		dir := filepath.Join(outputDir, name)
+
+		if err := os.MkdirAll(filepath.Join(dir, "config"), 0o755); err != nil {
+			return nil, err
+		}
+
+		config := ctx.Config
+		config.SetRoot(dir)
+
+		nodeID, valPubKey, err := genutil.InitializeNodeValidatorFiles(config)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes[i] = testnetNode{
+			Name:      name,
+			Dir:       dir,
+			IP:        ip.String(),
+			NodeID:    nodeID,
+			ValPubKey: valPubKey,
+			P2PPort:   testnetP2PPort + 2*i,
+			RPCPort:   testnetRPCPort + 2*i,
+		}
+
+		ip = nextIP(ip)
+	}
+
+	return nodes, nil
+}
+
+// Creates the keys of the node owners in the client home, the mnemonics are saved in the node homes.
+func createTestnetKeys(nodes []testnetNode, outputDir string) error {
+	kb, err := keys.NewKeyBaseFromDir(filepath.Join(outputDir, "client"))
+	if err != nil {
+		return err
+	}
+
+	names := viper.GetStringSlice(flagKeyNames)
+
+	for i := range nodes {
+		name := nodes[i].Name
+		if i < len(names) {
+			name = names[i]
+		}
+
+		info, seed, err := kb.CreateMnemonic(name, kbkeys.English, viper.GetString(flagPassphrase), kbkeys.Secp256k1)
+		if err != nil {
+			return err
+		}
+
+		nodes[i].Key = info
+
+		seedFile := filepath.Join(nodes[i].Dir, "key_seed.json")
+		if err = ioutil.WriteFile(seedFile, []byte(fmt.Sprintf("{\"secret\":%q}\n", seed)), 0o600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Builds the default genesis containing the node owner accounts.
+func testnetGenesisDoc(cdc *codec.Codec, mbm module.BasicManager, chainID string,
+	nodes []testnetNode, trustees int) (*tmtypes.GenesisDoc, error) {
+	appState := mbm.DefaultGenesis()
+
+	genesisState := genutil.GetGenesisStateFromAppState(cdc, appState)
+
+	for i, node := range nodes {
+		roles := auth.AccountRoles{auth.NodeAdmin}
+		if i < trustees {
+			roles = auth.AccountRoles{auth.Trustee, auth.NodeAdmin}
+		}
+
+		genesisState.Accounts = append(genesisState.Accounts,
+			auth.NewAccount(node.Key.GetAddress(), node.Key.GetPubKey(), roles))
+	}
+
+	appState = genutil.SetGenesisStateInAppState(cdc, appState, genesisState)
+
+	appStateJSON, err := codec.MarshalJSONIndent(cdc, appState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tmtypes.GenesisDoc{
+		ChainID:     chainID,
+		GenesisTime: tmtime.Now(),
+		AppState:    appStateJSON,
+	}, nil
+}
+
+// Writes the genesis transactions creating the validators signed by the node owners.
+func writeTestnetGenTxs(cdc *codec.Codec, chainID string, nodes []testnetNode, gentxsDir string) error {
+	if err := os.MkdirAll(gentxsDir, 0o755); err != nil {
+		return err
+	}
+
+	kb, err := keys.NewKeyBaseFromDir(filepath.Join(filepath.Dir(gentxsDir), "client"))
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		msg := validator.NewMsgCreateValidator(
+			sdk.ConsAddress(node.ValPubKey.Address()),
+			sdk.MustBech32ifyConsPub(node.ValPubKey),
+			validator.NewDescription(node.Name, "", "", ""),
+			node.Key.GetAddress(),
+		)
+
+		// genesis transactions are signed with zero account number and sequence
+		txBldr := authtypes.NewTxBuilder(utils.GetTxEncoder(cdc), 0, 0, flags.DefaultGasLimit, 0, false,
+			chainID, fmt.Sprintf("%s@%s:%d", node.NodeID, node.IP, testnetP2PPort), nil, nil).
+			WithKeybase(kb)
+
+		signMsg, err := txBldr.BuildSignMsg([]sdk.Msg{msg})
+		if err != nil {
+			return err
+		}
+
+		stdTx := authtypes.NewStdTx(signMsg.Msgs, signMsg.Fee, nil, signMsg.Memo)
+
+		signedTx, err := txBldr.SignStdTx(node.Key.GetName(), viper.GetString(flagPassphrase), stdTx, false)
+		if err != nil {
+			return err
+		}
+
+		bytes, err := cdc.MarshalJSON(signedTx)
+		if err != nil {
+			return err
+		}
+
+		if err = ioutil.WriteFile(filepath.Join(gentxsDir, node.Name+".json"), bytes, 0o644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+const testnetClientConfig = `chain-id = "{{ .ChainID }}"
+indent = true
+node = "tcp://localhost:{{ .RPCPort }}"
+output = "json"
+trust-node = false
+`
+
+const testnetDockerCompose = `version: '3.2'
+
+services:
+{{- range .Nodes }}
+  {{ .Name }}:
+    image: dcledger
+    container_name: {{ .Name }}
+    ports:
+      - "{{ .P2PPort }}-{{ .RPCPort }}:26656-26657"
+    volumes:
+      - ./{{ .Name }}:/root/.dcld:Z
+    networks:
+      localnet:
+        ipv4_address: {{ .IP }}
+    command: dcld start
+{{ end }}
+networks:
+  localnet:
+    driver: bridge
+    ipam:
+      driver: default
+      config:
+        - subnet: {{ .Subnet }}
+`
+
+const testnetSystemdService = `[Unit]
+Description=DC Ledger service ({{ .Name }})
+After=network.target
+StartLimitIntervalSec=0
+
+[Service]
+Type=simple
+Restart=always
+RestartSec=1
+User=ubuntu
+ExecStart=/usr/bin/dcld start
+
+[Install]
+WantedBy=multi-user.target
+`
+
+// Writes the CLI configuration, the docker-compose file of the network and the systemd service of every node.
+func writeTestnetAssets(nodes []testnetNode, outputDir string, chainID string) error {
+	err := writeTemplate(filepath.Join(outputDir, "client", "config", "config.toml"), testnetClientConfig,
+		map[string]interface{}{"ChainID": chainID, "RPCPort": testnetRPCPort})
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(nodes[0].IP).To4()
+	subnet := fmt.Sprintf("%d.%d.0.0/16", ip[0], ip[1])
+
+	err = writeTemplate(filepath.Join(outputDir, "docker-compose.yml"), testnetDockerCompose,
+		map[string]interface{}{"Nodes": nodes, "Subnet": subnet})
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		if err = writeTemplate(filepath.Join(node.Dir, "dcld.service"), testnetSystemdService, node); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeTemplate(file string, text string, data interface{}) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	if err := template.Must(template.New(filepath.Base(file)).Parse(text)).Execute(&out, data); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(file, []byte(out.String()), 0o644)
+}
+
+func nextIP(ip net.IP) net.IP {
+	next := make(net.IP, len(ip))
+	copy(next, ip)
+
+	for i := len(next) - 1; i >= 0; i-- {
+		next[i]++
+		if next[i] != 0 {
+			break
+		}
+	}
+
+	return next
+}
@@ -12,27 +12,36 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package cli
+package main
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/spf13/cobra"
 	tmtypes "github.com/tendermint/tendermint/types"
+	app "github.com/zigbee-alliance/distributed-compliance-ledger"
 )
 
-// Validate genesis command takes.
-func ValidateGenesisCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager) *cobra.Command {
+// ValidateGenesisCmd validates the genesis file deeply: the state of every module along with the consistency
+// of the records of different modules. All found violations are reported.
+func ValidateGenesisCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
 		Use:   "validate-genesis [file]",
 		Args:  cobra.RangeArgs(0, 1),
 		Short: "validates the genesis file at the default location or at the location passed as an arg",
+		Long: `Validate the genesis file at the default location or at the location passed as an arg.
+Besides the state of every module, the consistency of the records of different modules is checked:
+compliance info records must reference existing models, approved certificates must chain to their issuers,
+accounts must have valid roles and a VendorID must be bound to a single Vendor account.
+All found violations are reported instead of the first one.
+
+Example:
+$ dcld validate-genesis /path/to/genesis.json
+`,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			// Load default if passed no args, otherwise load passed file
 			var genesis string
@@ -50,15 +59,19 @@ func ValidateGenesisCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicM
 					fmt.Sprintf("Error loading genesis doc from %s: %s", genesis, err.Error()))
 			}
 
-			var genState map[string]json.RawMessage
+			var genState app.GenesisState
 			if err = cdc.UnmarshalJSON(genDoc.AppState, &genState); err != nil {
 				return sdk.ErrUnknownRequest(
 					fmt.Sprintf("Error unmarshaling genesis doc %s: %s", genesis, err.Error()))
 			}
 
-			if err = mbm.ValidateGenesis(genState); err != nil {
+			if violations := app.ValidateGenesisState(cdc, genState); len(violations) != 0 {
+				for _, violation := range violations {
+					fmt.Fprintln(os.Stderr, violation)
+				}
+
 				return sdk.ErrUnknownRequest(
-					fmt.Sprintf("Error validating genesis file %s: %s", genesis, err.Error()))
+					fmt.Sprintf("Error validating genesis file %s: %d violation(s) found", genesis, len(violations)))
 			}
 
 			fmt.Printf("File at %s is a valid genesis file\n", genesis)
@@ -0,0 +1,90 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"os"
+	"path"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/cli"
+	app "github.com/zigbee-alliance/distributed-compliance-ledger"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/cmd/restapi"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/failover"
+)
+
+// DefaultGatewayHome is the folder where the gateway configuration and the light client data are stored.
+var DefaultGatewayHome = os.ExpandEnv("$HOME/.dclgw")
+
+func main() {
+	cobra.EnableCommandSorting = false
+
+	cdc := app.MakeCodec()
+
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount(sdk.Bech32PrefixAccAddr, sdk.Bech32PrefixAccPub)
+	config.SetBech32PrefixForValidator(sdk.Bech32PrefixValAddr, sdk.Bech32PrefixValPub)
+	config.SetBech32PrefixForConsensusNode(sdk.Bech32PrefixConsAddr, sdk.Bech32PrefixConsPub)
+	config.Seal()
+
+	rootCmd := &cobra.Command{
+		Use:   "dclgw",
+		Short: "DcLedger REST Gateway balancing the requests between several full nodes",
+	}
+
+	rootCmd.PersistentFlags().String(client.FlagChainID, "", "Chain ID of tendermint node")
+
+	startCmd := failover.ServeCommand(restapi.ServeCommand(cdc))
+	startCmd.Use = "start"
+	startCmd.Short = "Start the REST server proxying the requests to the nodes listed in --node (comma-separated)"
+	rootCmd.PersistentPreRunE = func(_ *cobra.Command, _ []string) error {
+		return initConfig(rootCmd)
+	}
+
+	rootCmd.AddCommand(
+		startCmd,
+		version.Cmd,
+	)
+
+	executor := cli.PrepareMainCmd(rootCmd, "DCLGW", DefaultGatewayHome)
+
+	err := executor.Execute()
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Reads the gateway configuration file (`<home>/config/config.toml`) if it exists, the flags take precedence.
+func initConfig(cmd *cobra.Command) error {
+	home, err := cmd.PersistentFlags().GetString(cli.HomeFlag)
+	if err != nil {
+		return err
+	}
+
+	cfgFile := path.Join(home, "config", "config.toml")
+	if _, err := os.Stat(cfgFile); err == nil {
+		viper.SetConfigFile(cfgFile)
+
+		if err := viper.ReadInConfig(); err != nil {
+			return err
+		}
+	}
+
+	return viper.BindPFlag(client.FlagChainID, cmd.PersistentFlags().Lookup(client.FlagChainID))
+}
@@ -0,0 +1,112 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package restapi is the ledger REST API served by the CLI (`dclcli rest-server`) and the REST gateway (`dclgw`).
+package restapi
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/lcd"
+	"github.com/cosmos/cosmos-sdk/version"
+	authrest "github.com/cosmos/cosmos-sdk/x/auth/client/rest"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/go-amino"
+	app "github.com/zigbee-alliance/distributed-compliance-ledger"
+	deviceUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/device/rest"
+	errorsUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/errors/rest"
+	eventsUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/events/rest"
+	graphqlUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/graphql/rest"
+	keyUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/key/rest"
+	matterUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/matter/rest"
+	notifyUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/notify/rest"
+	ocspUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/ocsp/rest"
+	proxyUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/proxy/rest"
+	trusteeUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/trustee/rest"
+	txUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/tx/rest"
+	webhookUtils "github.com/zigbee-alliance/distributed-compliance-ledger/restext/webhook/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/apiversion"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/compression"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/headers"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/metrics"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/openapi"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/ratelimit"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/restserver"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/storestats"
+)
+
+// ServeCommand returns the command starting the REST server of the ledger API.
+func ServeCommand(cdc *amino.Codec) *cobra.Command {
+	return rest.AddFlags(metrics.AddFlags(headers.AddFlags(ratelimit.AddFlags(compression.AddFlags(ocspUtils.AddFlags(
+		matterUtils.AddFlags(webhookUtils.AddFlags(notifyUtils.AddFlags(
+			restserver.ServeCommand(cdc, RegisterRoutes))))))))))
+}
+
+// RegisterRoutes registers the middlewares and the routes of all versions of the REST API.
+func RegisterRoutes(rs *lcd.RestServer) {
+	headers.RegisterMiddlewares(rs.Mux, headers.ConfigFromFlags())
+	metrics.RegisterRESTMetrics(rs.Mux)
+	rest.EnableTxQueue()
+	rest.EnableQueryCache()
+	rest.EnableIdempotency()
+	rest.EnableReadOnly()
+	rest.EnableMaxBodySize()
+	webhookUtils.Enable(rs.CliCtx)
+	notifyUtils.Enable(rs.CliCtx)
+
+	rs.Mux.Use(rest.ReadOnlyMiddleware)
+	rs.Mux.Use(rest.MaxBodySizeMiddleware)
+
+	if middleware := compression.NewMiddleware(compression.ConfigFromFlags()); middleware != nil {
+		rs.Mux.Use(middleware)
+	}
+
+	if middleware := ratelimit.NewMiddleware(ratelimit.ConfigFromFlags()); middleware != nil {
+		rs.Mux.Use(middleware)
+	}
+
+	// the routes are served under `/v1/` prefix and without it for the clients of the unversioned API
+	apiversion.RegisterRoutes(rs.Mux,
+		apiversion.Version{Name: "v1", RegisterRoutes: func(r *mux.Router) { registerV1Routes(rs.CliCtx, r) }},
+	)
+
+	openapi.RegisterRoutes(rs.Mux, openapi.Info{Title: "DC Ledger REST API", Version: version.Version})
+}
+
+// Registers the routes of the first version of the REST API.
+// The breaking changes of the routes must be registered as a new version (see `apiversion` package).
+func registerV1Routes(cliCtx context.CLIContext, r *mux.Router) {
+	client.RegisterRoutes(cliCtx, r)
+	authrest.RegisterTxRoutes(cliCtx, r)
+	app.ModuleBasics.RegisterRESTRoutes(cliCtx, r)
+	proxyUtils.RegisterRoutes(cliCtx, r)
+
+	// the keybase is not available on the read-only REST server
+	if !rest.IsReadOnly() {
+		keyUtils.RegisterRoutes(cliCtx, r)
+	}
+
+	txUtils.RegisterRoutes(cliCtx, r)
+	eventsUtils.RegisterRoutes(cliCtx, r)
+	errorsUtils.RegisterRoutes(cliCtx, r)
+	deviceUtils.RegisterRoutes(cliCtx, r)
+	graphqlUtils.RegisterRoutes(cliCtx, r)
+	ocspUtils.RegisterRoutes(cliCtx, r)
+	matterUtils.RegisterRoutes(cliCtx, r)
+	trusteeUtils.RegisterRoutes(cliCtx, r)
+	storestats.RegisterRoutes(cliCtx, r)
+	webhookUtils.RegisterRoutes(cliCtx, r)
+}
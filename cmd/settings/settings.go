@@ -15,8 +15,12 @@
 package settings
 
 import (
+	"strings"
+
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	cfg "github.com/tendermint/tendermint/config"
 )
 
 const (
@@ -26,3 +30,40 @@ const (
 
 // PruningStrategy of the application: Store every state. Keep last two states.
 var PruningStrategy = types.NewPruningOptions(2, 1)
+
+// IndexedEvents are the transaction events required by the ledger queries (e.g. transactions of an account).
+var IndexedEvents = []string{
+	sdk.EventTypeMessage + "." + sdk.AttributeKeySender,
+	sdk.EventTypeMessage + "." + sdk.AttributeKeyAction,
+}
+
+// ConfigureTxIndex adds the events required by the ledger queries to the events indexed by the node
+// unless the node indexes all events.
+func ConfigureTxIndex(config *cfg.TxIndexConfig) {
+	if config.IndexAllTags && len(config.IndexTags) == 0 {
+		return
+	}
+
+	tags := []string{}
+	if len(config.IndexTags) != 0 {
+		tags = strings.Split(config.IndexTags, ",")
+	}
+
+	for _, event := range IndexedEvents {
+		if !contains(tags, event) {
+			tags = append(tags, event)
+		}
+	}
+
+	config.IndexTags = strings.Join(tags, ",")
+}
+
+func contains(tags []string, tag string) bool {
+	for _, t := range tags {
+		if strings.TrimSpace(t) == tag {
+			return true
+		}
+	}
+
+	return false
+}
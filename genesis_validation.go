@@ -0,0 +1,147 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
+)
+
+// ValidateGenesisState performs the deep validation of the application state: the genesis state of every module
+// is validated along with the consistency of the records of different modules.
+// All found violations are returned instead of the first one.
+func ValidateGenesisState(cdc *codec.Codec, genesisState GenesisState) []error {
+	var violations []error
+
+	// modules missing in the genesis are not initialized, so they are not validated
+	names := make([]string, 0, len(genesisState))
+	for name := range ModuleBasics {
+		if _, ok := genesisState[name]; ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := ModuleBasics[name].ValidateGenesis(genesisState[name]); err != nil {
+			violations = append(violations, fmt.Errorf("%s: %v", name, errorMessage(err)))
+		}
+	}
+
+	var (
+		authGenesis       auth.GenesisState
+		modelinfoGenesis  modelinfo.GenesisState
+		complianceGenesis compliance.GenesisState
+		pkiGenesis        pki.GenesisState
+	)
+
+	// the states which cannot be decoded have already been reported above
+	if decodeModuleGenesis(cdc, genesisState, auth.ModuleName, &authGenesis) {
+		violations = append(violations, validateGenesisAccounts(authGenesis)...)
+	}
+
+	if decodeModuleGenesis(cdc, genesisState, modelinfo.ModuleName, &modelinfoGenesis) &&
+		decodeModuleGenesis(cdc, genesisState, compliance.ModuleName, &complianceGenesis) {
+		violations = append(violations, validateGenesisComplianceInfos(complianceGenesis, modelinfoGenesis)...)
+	}
+
+	if decodeModuleGenesis(cdc, genesisState, pki.ModuleName, &pkiGenesis) {
+		for _, err := range pki.ValidateGenesisCertificateChains(pkiGenesis) {
+			violations = append(violations, fmt.Errorf("%s: %v", pki.ModuleName, err))
+		}
+	}
+
+	return violations
+}
+
+func decodeModuleGenesis(cdc *codec.Codec, genesisState GenesisState, name string, state interface{}) bool {
+	raw, ok := genesisState[name]
+	if !ok {
+		return true
+	}
+
+	return cdc.UnmarshalJSON(raw, state) == nil
+}
+
+// Every account must have valid roles, a VendorID must be bound to a single Vendor account.
+func validateGenesisAccounts(data auth.GenesisState) []error {
+	var violations []error
+
+	addresses := make(map[string]bool)
+	vendors := make(map[uint16]string)
+
+	for _, account := range data.Accounts {
+		if addresses[account.Address.String()] {
+			violations = append(violations, fmt.Errorf("%s: duplicate account %s", auth.ModuleName, account.Address))
+		}
+
+		addresses[account.Address.String()] = true
+
+		if err := account.Validate(); err != nil {
+			violations = append(violations,
+				fmt.Errorf("%s: invalid account %s: %v", auth.ModuleName, account.Address, errorMessage(err)))
+		}
+
+		if account.VendorID == 0 || !account.HasRole(auth.Vendor) {
+			continue
+		}
+
+		if other, ok := vendors[account.VendorID]; ok {
+			violations = append(violations, fmt.Errorf("%s: VendorID %v is bound to several accounts: %s and %s",
+				auth.ModuleName, account.VendorID, other, account.Address))
+		} else {
+			vendors[account.VendorID] = account.Address.String()
+		}
+	}
+
+	return violations
+}
+
+// Every compliance info record must reference an existing model.
+func validateGenesisComplianceInfos(data compliance.GenesisState, models modelinfo.GenesisState) []error {
+	var violations []error
+
+	present := make(map[[2]uint16]bool)
+	for _, model := range models.ModelInfoRecords {
+		present[[2]uint16{model.VID, model.PID}] = true
+	}
+
+	for _, record := range data.ComplianceInfoRecords {
+		if !present[[2]uint16{record.VID, record.PID}] {
+			violations = append(violations, fmt.Errorf("%s: compliance info (certification_type=%v) references "+
+				"unknown model with vid=%v, pid=%v",
+				compliance.ModuleName, record.CertificationType, record.VID, record.PID))
+		}
+	}
+
+	return violations
+}
+
+// Returns the message of the error without the codespace and code of sdk.Error.
+func errorMessage(err error) string {
+	if sdkErr, ok := err.(sdk.Error); ok {
+		return fmt.Sprint(sdkErr.Data())
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,173 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
+)
+
+func TestValidateGenesisState_Default(t *testing.T) {
+	cdc := MakeCodec()
+
+	require.Empty(t, ValidateGenesisState(cdc, NewDefaultGenesisState()))
+}
+
+func TestValidateGenesisState_Valid(t *testing.T) {
+	cdc := MakeCodec()
+	genesisState := NewDefaultGenesisState()
+
+	authGenesis := auth.DefaultGenesisState()
+	authGenesis.Accounts = []auth.Account{vendorAccount(testconstants.Address1, testconstants.VID)}
+	genesisState[auth.ModuleName] = cdc.MustMarshalJSON(authGenesis)
+
+	modelinfoGenesis := modelinfo.DefaultGenesisState()
+	modelinfoGenesis.ModelInfoRecords = []modelinfo.ModelInfo{modelInfo(testconstants.VID, testconstants.PID)}
+	genesisState[modelinfo.ModuleName] = cdc.MustMarshalJSON(modelinfoGenesis)
+
+	complianceGenesis := compliance.DefaultGenesisState()
+	complianceGenesis.ComplianceInfoRecords = []compliance.ComplianceInfo{
+		complianceInfo(testconstants.VID, testconstants.PID),
+	}
+	genesisState[compliance.ModuleName] = cdc.MustMarshalJSON(complianceGenesis)
+
+	pkiGenesis := pki.DefaultGenesisState()
+	pkiGenesis.ApprovedCertificatesRecords = []pki.Certificates{
+		{Items: []pki.Certificate{rootCertificate()}},
+		{Items: []pki.Certificate{intermediateCertificate()}},
+	}
+	genesisState[pki.ModuleName] = cdc.MustMarshalJSON(pkiGenesis)
+
+	require.Empty(t, ValidateGenesisState(cdc, genesisState))
+}
+
+func TestValidateGenesisState_ReportsAllViolations(t *testing.T) {
+	cdc := MakeCodec()
+	genesisState := NewDefaultGenesisState()
+
+	// VendorID bound to two vendors
+	authGenesis := auth.DefaultGenesisState()
+	authGenesis.Accounts = []auth.Account{
+		vendorAccount(testconstants.Address1, testconstants.VID),
+		vendorAccount(testconstants.Address2, testconstants.VID),
+	}
+	genesisState[auth.ModuleName] = cdc.MustMarshalJSON(authGenesis)
+
+	// compliance info records of unknown models
+	complianceGenesis := compliance.DefaultGenesisState()
+	complianceGenesis.ComplianceInfoRecords = []compliance.ComplianceInfo{
+		complianceInfo(testconstants.VID, testconstants.PID),
+		complianceInfo(testconstants.VID, testconstants.PID+1),
+	}
+	genesisState[compliance.ModuleName] = cdc.MustMarshalJSON(complianceGenesis)
+
+	// intermediate certificate without the root one
+	pkiGenesis := pki.DefaultGenesisState()
+	pkiGenesis.ApprovedCertificatesRecords = []pki.Certificates{
+		{Items: []pki.Certificate{intermediateCertificate()}},
+	}
+	genesisState[pki.ModuleName] = cdc.MustMarshalJSON(pkiGenesis)
+
+	violations := ValidateGenesisState(cdc, genesisState)
+	require.Len(t, violations, 4)
+
+	require.Contains(t, violations[0].Error(), "VendorID 1 is bound to several accounts")
+	require.Contains(t, violations[1].Error(), "unknown model with vid=1, pid=22")
+	require.Contains(t, violations[2].Error(), "unknown model with vid=1, pid=23")
+	require.Contains(t, violations[3].Error(), "no approved issuer certificate found")
+}
+
+func TestValidateGenesisState_InvalidAccountRoles(t *testing.T) {
+	cdc := MakeCodec()
+	genesisState := NewDefaultGenesisState()
+
+	authGenesis := auth.DefaultGenesisState()
+	authGenesis.Accounts = []auth.Account{
+		auth.NewAccount(testconstants.Address1, testconstants.PubKey1, auth.AccountRoles{"Unknown"}),
+		auth.NewAccount(testconstants.Address2, testconstants.PubKey2, auth.AccountRoles{"Other"}),
+	}
+	genesisState[auth.ModuleName] = cdc.MustMarshalJSON(authGenesis)
+
+	// the module validation fails on the first account, the deep validation reports both
+	violations := ValidateGenesisState(cdc, genesisState)
+	require.Len(t, violations, 3)
+
+	for _, violation := range violations {
+		require.Contains(t, violation.Error(), "Invalid Account Role")
+	}
+}
+
+func vendorAccount(address []byte, vid uint16) auth.Account {
+	account := auth.NewAccount(address, testconstants.PubKey1, auth.AccountRoles{auth.Vendor})
+	account.VendorID = vid
+
+	return account
+}
+
+func modelInfo(vid uint16, pid uint16) modelinfo.ModelInfo {
+	return modelinfo.ModelInfo{
+		VID:             vid,
+		PID:             pid,
+		Name:            testconstants.Name,
+		Description:     testconstants.Description,
+		SKU:             testconstants.SKU,
+		HardwareVersion: testconstants.HardwareVersion,
+		FirmwareVersion: testconstants.FirmwareVersion,
+		Owner:           testconstants.Owner,
+	}
+}
+
+func complianceInfo(vid uint16, pid uint16) compliance.ComplianceInfo {
+	return compliance.ComplianceInfo{
+		VID:               vid,
+		PID:               pid,
+		State:             compliance.CertifiedState,
+		Date:              testconstants.CertificationDate,
+		CertificationType: compliance.ZbCertificationType,
+		Owner:             testconstants.Owner,
+	}
+}
+
+func rootCertificate() pki.Certificate {
+	return pki.Certificate{
+		PemCert:      testconstants.RootCertPem,
+		Subject:      testconstants.RootSubject,
+		SubjectKeyID: testconstants.RootSubjectKeyID,
+		SerialNumber: testconstants.RootSerialNumber,
+		IsRoot:       true,
+		Owner:        testconstants.Owner,
+	}
+}
+
+func intermediateCertificate() pki.Certificate {
+	return pki.Certificate{
+		PemCert:          testconstants.IntermediateCertPem,
+		Subject:          testconstants.IntermediateSubject,
+		SubjectKeyID:     testconstants.IntermediateSubjectKeyID,
+		SerialNumber:     testconstants.IntermediateSerialNumber,
+		Issuer:           testconstants.RootSubject,
+		AuthorityKeyID:   testconstants.RootSubjectKeyID,
+		RootSubject:      testconstants.RootSubject,
+		RootSubjectKeyID: testconstants.RootSubjectKeyID,
+		Owner:            testconstants.Owner,
+	}
+}
@@ -135,7 +135,7 @@ echo "$result"
 
 echo "Again Certify Model with VID: $vid PID: $pid"
 certification_date="2020-03-03T00:00:00Z"
-result=$(echo "test1234" | dclcli tx compliance certify-model --vid=$vid --pid=$pid --certification-type="$certification_type" --certification-date="$certification_date" --from $zb_account --yes)
+result=$(echo "test1234" | dclcli tx compliance certify-model --vid=$vid --pid=$pid --certification-type="$certification_type" --certification-date="$certification_date" --expected-sequence=1 --from $zb_account --yes)
 check_response "$result" "\"success\": true"
 echo "$result"
 
@@ -61,10 +61,22 @@ docker exec $container /bin/sh -c "
   dclcli config trust-node false &&
   dclcli config node $node0"
 
-echo "$account Add Node \"$node\" to validator set"
+echo "$account Propose Node \"$node\" to validator set"
 vaddress=$(docker exec $container dcld tendermint show-address)
 vpubkey=$(docker exec $container dcld tendermint show-validator)
-result=$(docker exec $container /bin/sh -c "echo test1234 | dclcli tx validator add-node --validator-address=$vaddress --validator-pubkey=$vpubkey --name=$node --from=$account --yes")
+result=$(docker exec $container /bin/sh -c "echo test1234 | dclcli tx validator propose-add-node --validator-address=$vaddress --validator-pubkey=$vpubkey --name=$node --from=$account --yes")
+check_response "$result" "\"success\": true"
+echo "$result"
+
+echo "Check node \"$node\" is proposed"
+result=$(dclcli query validator proposed-node --validator-address=$vaddress)
+check_response "$result" "\"validator_address\": \"$vaddress\""
+echo "$result"
+
+echo "Trustees approve Node \"$node\""
+result=$(echo $passphrase | dclcli tx validator approve-add-node --validator-address=$vaddress --from jack --yes)
+check_response "$result" "\"success\": true"
+result=$(echo $passphrase | dclcli tx validator approve-add-node --validator-address=$vaddress --from alice --yes)
 check_response "$result" "\"success\": true"
 echo "$result"
 
@@ -50,17 +50,39 @@ var (
 	TisOrTrpTestingCompleted        = true
 	Owner                           = Address1
 
+	// Model Version.
+	SoftwareVersion              uint32 = 1
+	SoftwareVersionString               = "1.0"
+	FirmwareDigest                      = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	ReleaseNotesURL                     = "http://release.notes.com"
+	MinApplicableSoftwareVersion uint32 = 0
+	MaxApplicableSoftwareVersion uint32 = 1
+
+	// OTA.
+	FirmwareImageSize uint64 = 1024
+	ReleaseDate              = time.Date(2020, 4, 4, 4, 0, 0, 0, time.UTC)
+
 	// Compliance.
-	CertificationDate = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
-	RevocationDate    = time.Date(2020, 3, 3, 3, 30, 0, 0, time.UTC)
-	Reason            = "Some Reason"
-	RevocationReason  = "Some Reason"
-	CertificationType = "zb"
+	CertificationDate    = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	RevocationDate       = time.Date(2020, 3, 3, 3, 30, 0, 0, time.UTC)
+	Reason               = "Some Reason"
+	RevocationReason     = "Some Reason"
+	RevocationReasonCode = "security_vulnerability"
+	CertificationType    = "zb"
 
 	// Testing Result.
 	TestResult = "http://test.result.com"
 	TestDate   = time.Date(2020, 2, 2, 2, 0, 0, 0, time.UTC)
 
+	// Grant.
+	GrantMsgType    = "modelinfo/add_model_version"
+	GrantExpiration = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// Upgrade.
+	UpgradePlanName         = "v0.2.0"
+	UpgradePlanHeight int64 = 100
+	UpgradePlanInfo         = "https://github.com/zigbee-alliance/distributed-compliance-ledger/releases/tag/v0.2.0"
+
 	//
 	Address1, _       = sdk.AccAddressFromBech32("cosmos1p72j8mgkf39qjzcmr283w8l8y9qv30qpj056uz")
 	Address2, _       = sdk.AccAddressFromBech32("cosmos1j8x9urmqs7p44va5p4cu29z6fc3g0cx2c2vxx2")
@@ -145,6 +167,100 @@ zj0EAwIDSQAwRgIhAPq8sXrMDueq9XplZBcbS/3VlTakULzdOlo7PzquUdDnAiEA
 	LeafSerialNumber   = "143290473708569835418599774898811724528308722063"
 )
 
+/*
+	Certificates for verification of Basic Constraints and path length constraints.
+	`PathLenRoot` allows no intermediate certificates below it,
+	`NonCA` is issued by `PathLenRoot` but is not allowed to issue certificates.
+*/
+
+const (
+	PathLenRootCertPem = `
+-----BEGIN CERTIFICATE-----
+MIIB0zCCAXigAwIBAgICA+kwCgYIKoZIzj0EAwIwPTELMAkGA1UEBhMCQVUxEzAR
+BgNVBAgTCnNvbWUtc3RhdGUxGTAXBgNVBAoTEHBhdGgtbGVuLXJvb3QtY2EwIBcN
+MjAwOTExMDk0MDM4WhgPNDc1ODA4MDgwOTQwMzhaMD0xCzAJBgNVBAYTAkFVMRMw
+EQYDVQQIEwpzb21lLXN0YXRlMRkwFwYDVQQKExBwYXRoLWxlbi1yb290LWNhMFkw
+EwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEofRhyL7Pp3FrawhaOZkxWMVBvzVyabfn
+2acD8/fzR1M6QFloxkOwy2SiG/XAUdkeKjDwZ1J9/kIHJeUpJAWlnaNmMGQwDgYD
+VR0PAQH/BAQDAgGGMBIGA1UdEwEB/wQIMAYBAf8CAQAwHQYDVR0OBBYEFM8LHGsp
+3lJLdpEL4Xee2+28KfmJMB8GA1UdIwQYMBaAFM8LHGsp3lJLdpEL4Xee2+28KfmJ
+MAoGCCqGSM49BAMCA0kAMEYCIQDPK2hi44WuBfmjvtNbk1B40CBpAaZYHLjm0dDO
+02TY0QIhAOue1UxMX3P76oIeehN36BszNYEn9mSp+jiNFJu3Aq5P
+-----END CERTIFICATE-----`
+
+	PathLenIntermediateCertPem = `
+-----BEGIN CERTIFICATE-----
+MIIB2DCCAX2gAwIBAgICA+owCgYIKoZIzj0EAwIwPTELMAkGA1UEBhMCQVUxEzAR
+BgNVBAgTCnNvbWUtc3RhdGUxGTAXBgNVBAoTEHBhdGgtbGVuLXJvb3QtY2EwIBcN
+MjAwOTExMDk0MDM4WhgPNDc1ODA4MDgwOTQwMzhaMEUxCzAJBgNVBAYTAkFVMRMw
+EQYDVQQIEwpzb21lLXN0YXRlMSEwHwYDVQQKExhwYXRoLWxlbi1pbnRlcm1lZGlh
+dGUtY2EwWTATBgcqhkjOPQIBBggqhkjOPQMBBwNCAARcqzwud+cMQ333+yAmvQmt
+/OTJqWZxwiOIwL/jJrJXjy5xqJVXsfKRStkp9vPc5wDEkD9tkdNEh2g3v4tfQvZp
+o2MwYTAOBgNVHQ8BAf8EBAMCAYYwDwYDVR0TAQH/BAUwAwEB/zAdBgNVHQ4EFgQU
+b1fkAF/+woQhAWy6YbNtLCNOqh8wHwYDVR0jBBgwFoAUzwscayneUkt2kQvhd57b
+7bwp+YkwCgYIKoZIzj0EAwIDSQAwRgIhALaZ420da9KHS2T8yNxiIqUtqnWExt2c
+KbOuCqYyHXoJAiEA+ynNsKT147femS824fyQXI8rrBIkmYLH3GPfpdfl6fg=
+-----END CERTIFICATE-----`
+
+	PathLenLeafCertPem = `
+-----BEGIN CERTIFICATE-----
+MIIB0TCCAXegAwIBAgICA+swCgYIKoZIzj0EAwIwRTELMAkGA1UEBhMCQVUxEzAR
+BgNVBAgTCnNvbWUtc3RhdGUxITAfBgNVBAoTGHBhdGgtbGVuLWludGVybWVkaWF0
+ZS1jYTAgFw0yMDA5MTEwOTQwMzhaGA80NzU4MDgwODA5NDAzOFowOjELMAkGA1UE
+BhMCQVUxEzARBgNVBAgTCnNvbWUtc3RhdGUxFjAUBgNVBAoTDXBhdGgtbGVuLWxl
+YWYwWTATBgcqhkjOPQIBBggqhkjOPQMBBwNCAAQkcwosWL4AoTonKb9fs8UFT+K3
+mR+Wr2f6KFwlvoyUkdheZKXD13xk8ABan+rpsezWuVeJaAg4FCneOzdM0ES6o2Aw
+XjAOBgNVHQ8BAf8EBAMCBaAwDAYDVR0TAQH/BAIwADAdBgNVHQ4EFgQUcTc0cB8Z
+nHFGnLhqOYFKjIKQuOgwHwYDVR0jBBgwFoAUb1fkAF/+woQhAWy6YbNtLCNOqh8w
+CgYIKoZIzj0EAwIDSAAwRQIhAMXGYhbllJMAashYsElaNZzjjQ5e7uUi3fMO6TTc
+J71zAiAVmPBxknYHkcJNAGJmGqz7Vb4pSwhjtC76xXD3l5VqYQ==
+-----END CERTIFICATE-----`
+
+	NonCACertPem = `
+-----BEGIN CERTIFICATE-----
+MIIBwjCCAWigAwIBAgICA+wwCgYIKoZIzj0EAwIwPTELMAkGA1UEBhMCQVUxEzAR
+BgNVBAgTCnNvbWUtc3RhdGUxGTAXBgNVBAoTEHBhdGgtbGVuLXJvb3QtY2EwIBcN
+MjAwOTExMDk0MDM4WhgPNDc1ODA4MDgwOTQwMzhaMDMxCzAJBgNVBAYTAkFVMRMw
+EQYDVQQIEwpzb21lLXN0YXRlMQ8wDQYDVQQKEwZub24tY2EwWTATBgcqhkjOPQIB
+BggqhkjOPQMBBwNCAAQBhLRSOj6RC4Ma8mDy6oKMcoWHVD9sz0XjouQ1giHGZssV
+nQgSxHceTnEI/AGn3Ily2WJDutyFn2GFLW3Hjbm6o2AwXjAOBgNVHQ8BAf8EBAMC
+BaAwDAYDVR0TAQH/BAIwADAdBgNVHQ4EFgQUA2quSyhX3KHV54P7EBhCmOxYO8Aw
+HwYDVR0jBBgwFoAUzwscayneUkt2kQvhd57b7bwp+YkwCgYIKoZIzj0EAwIDSAAw
+RQIhAMZ976N9vEZwG514paWMRg+j9NnFQ1PJF0t0FvCcO/E3AiAOLvcgAE1/ZIXP
+5mviQsovQFEFxp53HQbOpjgNDDZSBw==
+-----END CERTIFICATE-----`
+
+	NonCALeafCertPem = `
+-----BEGIN CERTIFICATE-----
+MIIBvjCCAWOgAwIBAgICA+0wCgYIKoZIzj0EAwIwMzELMAkGA1UEBhMCQVUxEzAR
+BgNVBAgTCnNvbWUtc3RhdGUxDzANBgNVBAoTBm5vbi1jYTAgFw0yMDA5MTEwOTQw
+MzhaGA80NzU4MDgwODA5NDAzOFowODELMAkGA1UEBhMCQVUxEzARBgNVBAgTCnNv
+bWUtc3RhdGUxFDASBgNVBAoTC25vbi1jYS1sZWFmMFkwEwYHKoZIzj0CAQYIKoZI
+zj0DAQcDQgAEIHw02BIpWDXZGDamagXk+VCMU6CjZCX62fKAwXGOjf1bETiw3JJo
+2+DoTrmNTDk3iggLlq2z+SjWcD8vvQQa/aNgMF4wDgYDVR0PAQH/BAQDAgWgMAwG
+A1UdEwEB/wQCMAAwHQYDVR0OBBYEFGSwx1Mfz1wk7uglhHTCgnUU78UrMB8GA1Ud
+IwQYMBaAFANqrksoV9yh1eeD+xAYQpjsWDvAMAoGCCqGSM49BAMCA0kAMEYCIQCH
+j72cryijDg3XUqmnZx5neZiTA8OVEKgULIH25VoGfgIhAJ3bDoI+FjK/RpoqrxdC
+0bOeJ2z3vn2N6imswGxQV5J8
+-----END CERTIFICATE-----`
+
+	PathLenRootSubject      = "O=path-len-root-ca,ST=some-state,C=AU"
+	PathLenRootSubjectKeyID = "CF:B:1C:6B:29:DE:52:4B:76:91:B:E1:77:9E:DB:ED:BC:29:F9:89"
+	PathLenRootSerialNumber = "1001"
+
+	PathLenIntermediateSubject      = "O=path-len-intermediate-ca,ST=some-state,C=AU"
+	PathLenIntermediateSubjectKeyID = "6F:57:E4:0:5F:FE:C2:84:21:1:6C:BA:61:B3:6D:2C:23:4E:AA:1F"
+
+	PathLenLeafSubject      = "O=path-len-leaf,ST=some-state,C=AU"
+	PathLenLeafSubjectKeyID = "71:37:34:70:1F:19:9C:71:46:9C:B8:6A:39:81:4A:8C:82:90:B8:E8"
+
+	NonCASubject      = "O=non-ca,ST=some-state,C=AU"
+	NonCASubjectKeyID = "3:6A:AE:4B:28:57:DC:A1:D5:E7:83:FB:10:18:42:98:EC:58:3B:C0"
+
+	NonCALeafSubject      = "O=non-ca-leaf,ST=some-state,C=AU"
+	NonCALeafSubjectKeyID = "64:B0:C7:53:1F:CF:5C:24:EE:E8:25:84:74:C2:82:75:14:EF:C5:2B"
+)
+
 func TestAddress() (sdk.AccAddress, crypto.PubKey, string) {
 	key := secp256k1.GenPrivKey()
 	pub := key.PubKey()
@@ -15,6 +15,9 @@
 package rest_test
 
 import (
+	"encoding/hex"
+	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,6 +28,7 @@ import (
 	"github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/utils"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
 )
 
 //nolint:godox
@@ -100,7 +104,8 @@ func TestComplianceDemo_KeepTrackCompliance(t *testing.T) {
 	// Revoke model certification
 	revocationTime := certifyModelMsg.CertificationDate.AddDate(0, 0, 1)
 	revokeModelMsg := compliance.NewMsgRevokeModel(modelInfo.VID, modelInfo.PID, revocationTime,
-		compliance.CertificationType(testconstants.CertificationType), testconstants.RevocationReason, zb.Address)
+		compliance.CertificationType(testconstants.CertificationType),
+		compliance.RevocationReasonCode(testconstants.RevocationReasonCode), testconstants.RevocationReason, zb.Address)
 	_, _ = utils.PublishRevokedModel(revokeModelMsg, zb)
 
 	// Check model is revoked
@@ -127,6 +132,14 @@ func TestComplianceDemo_KeepTrackCompliance(t *testing.T) {
 	require.Equal(t, complianceInfo.State, compliance.RevokedState)
 	require.Equal(t, 1, len(complianceInfo.History))
 	require.Equal(t, complianceInfo.History[0].State, compliance.CertifiedState)
+	require.Equal(t, complianceInfo.ReasonCode, revokeModelMsg.ReasonCode)
+
+	// Get compliance history
+	complianceHistory, _ := utils.GetComplianceHistory(modelInfo.VID, modelInfo.PID, certifyModelMsg.CertificationType)
+	require.Equal(t, 2, len(complianceHistory.Items))
+	require.Equal(t, compliance.CertifiedState, complianceHistory.Items[0].State)
+	require.Equal(t, compliance.RevokedState, complianceHistory.Items[1].State)
+	require.Equal(t, revokeModelMsg.ReasonCode, complianceHistory.Items[1].ReasonCode)
 }
 
 func TestComplianceDemo_KeepTrackRevocation(t *testing.T) {
@@ -140,7 +153,8 @@ func TestComplianceDemo_KeepTrackRevocation(t *testing.T) {
 	// Revoke non-existent model
 	revocationTime := time.Now().UTC()
 	revokeModelMsg := compliance.NewMsgRevokeModel(common.RandUint16(), common.RandUint16(), revocationTime,
-		compliance.CertificationType(testconstants.CertificationType), testconstants.RevocationReason, zb.Address)
+		compliance.CertificationType(testconstants.CertificationType),
+		compliance.RevocationReasonCode(testconstants.RevocationReasonCode), testconstants.RevocationReason, zb.Address)
 	_, _ = utils.PublishRevokedModel(revokeModelMsg, zb)
 
 	// Check non-existent model is revoked
@@ -151,7 +165,8 @@ func TestComplianceDemo_KeepTrackRevocation(t *testing.T) {
 	// Revoke model
 	revocationTime = time.Now().UTC()
 	revokeModelMsg = compliance.NewMsgRevokeModel(vid, pid, revocationTime,
-		compliance.CertificationType(testconstants.CertificationType), testconstants.RevocationReason, zb.Address)
+		compliance.CertificationType(testconstants.CertificationType),
+		compliance.RevocationReasonCode(testconstants.RevocationReasonCode), testconstants.RevocationReason, zb.Address)
 	_, _ = utils.PublishRevokedModel(revokeModelMsg, zb)
 
 	// Check model is revoked
@@ -190,3 +205,147 @@ func TestComplianceDemo_KeepTrackRevocation(t *testing.T) {
 	revokedModels, _ = utils.GetAllRevokedModels()
 	require.Equal(t, utils.ParseUint(inputRevokedModels.Total), utils.ParseUint(revokedModels.Total))
 }
+
+func TestComplianceDemo_DelegatedComplianceAuthority(t *testing.T) {
+	// Register new Vendor account
+	vendor := utils.CreateNewAccount(auth.AccountRoles{auth.Vendor})
+
+	// Register new TestHouse account
+	testHouse := utils.CreateNewAccount(auth.AccountRoles{auth.TestHouse})
+
+	// Register new ZBCertificationCenter account
+	zb := utils.CreateNewAccount(auth.AccountRoles{auth.ZBCertificationCenter})
+
+	// Register account the compliance authority is delegated to
+	delegate := utils.CreateNewAccount(auth.AccountRoles{auth.TestHouse})
+
+	// Publish model info and testing result
+	modelInfo := utils.NewMsgAddModelInfo(vendor.Address)
+	_, _ = utils.AddModelInfo(modelInfo, vendor)
+
+	testingResult := utils.NewMsgAddTestingResult(modelInfo.VID, modelInfo.PID, testHouse.Address)
+	_, _ = utils.PublishTestingResult(testingResult, testHouse)
+
+	// Grant compliance authority for the vendor
+	grantMsg := compliance.NewMsgGrantComplianceAuthority(modelInfo.VID, delegate.Address,
+		time.Now().UTC().Add(time.Hour).Truncate(time.Second), zb.Address)
+	_, _ = utils.GrantComplianceAuthority(grantMsg, zb)
+
+	// Check grant is created
+	grant, _ := utils.GetComplianceAuthorityGrant(modelInfo.VID, delegate.Address)
+	require.Equal(t, zb.Address, grant.Granter)
+	require.Equal(t, grantMsg.ExpirationDate, grant.ExpirationDate)
+
+	// Certify model by the delegate
+	certifyModelMsg := compliance.NewMsgCertifyModel(modelInfo.VID, modelInfo.PID, time.Now().UTC(),
+		compliance.CertificationType(testconstants.CertificationType), testconstants.EmptyString, delegate.Address)
+	_, _ = utils.PublishCertifiedModel(certifyModelMsg, delegate)
+
+	modelIsCertified, _ := utils.GetCertifiedModel(modelInfo.VID, modelInfo.PID, certifyModelMsg.CertificationType)
+	require.True(t, modelIsCertified.Value)
+
+	// Revoke compliance authority
+	revokeMsg := compliance.NewMsgRevokeComplianceAuthority(modelInfo.VID, delegate.Address, zb.Address)
+	_, _ = utils.RevokeComplianceAuthority(revokeMsg, zb)
+
+	_, code := utils.GetComplianceAuthorityGrant(modelInfo.VID, delegate.Address)
+	require.Equal(t, http.StatusNotFound, code)
+
+	// Delegate can not revoke model anymore
+	revokeModelMsg := compliance.NewMsgRevokeModel(modelInfo.VID, modelInfo.PID,
+		certifyModelMsg.CertificationDate.AddDate(0, 0, 1), certifyModelMsg.CertificationType,
+		compliance.RevocationReasonCode(testconstants.RevocationReasonCode), testconstants.RevocationReason,
+		delegate.Address)
+	result, _ := utils.PublishRevokedModel(revokeModelMsg, delegate)
+	require.Equal(t, auth.CodeMissingRole, sdk.CodeType(result.Code))
+}
+
+func TestComplianceDemo_CertificationDeclaration(t *testing.T) {
+	// Register new Vendor account
+	vendor := utils.CreateNewAccount(auth.AccountRoles{auth.Vendor})
+
+	// Register new TestHouse account
+	testHouse := utils.CreateNewAccount(auth.AccountRoles{auth.TestHouse})
+
+	// Register new ZBCertificationCenter account
+	zb := utils.CreateNewAccount(auth.AccountRoles{auth.ZBCertificationCenter})
+
+	// Publish model info, model version and testing result
+	modelInfo := utils.NewMsgAddModelInfo(vendor.Address)
+	_, _ = utils.AddModelInfo(modelInfo, vendor)
+
+	addModelVersionMsg := modelinfo.NewMsgAddModelVersion(modelInfo.VID, modelInfo.PID,
+		testconstants.SoftwareVersion, testconstants.SoftwareVersionString, "", "",
+		testconstants.MinApplicableSoftwareVersion, testconstants.MaxApplicableSoftwareVersion, vendor.Address)
+	_, _ = utils.Exec([]sdk.Msg{addModelVersionMsg}, vendor)
+
+	testingResult := utils.NewMsgAddTestingResult(modelInfo.VID, modelInfo.PID, testHouse.Address)
+	_, _ = utils.PublishTestingResult(testingResult, testHouse)
+
+	// Certify model
+	certifyModelMsg := compliance.NewMsgCertifyModel(modelInfo.VID, modelInfo.PID, time.Now().UTC(),
+		compliance.CertificationType(testconstants.CertificationType), testconstants.EmptyString, zb.Address)
+	_, _ = utils.PublishCertifiedModel(certifyModelMsg, zb)
+
+	// Add certification declaration of the model version
+	declarationMsg := compliance.NewMsgAddCertificationDeclaration(modelInfo.VID, modelInfo.PID,
+		testconstants.SoftwareVersion, certifyModelMsg.CertificationType, []byte("signed declaration"),
+		testconstants.EmptyString, testconstants.EmptyString, zb.Address)
+	_, _ = utils.AddCertificationDeclaration(declarationMsg, zb)
+
+	// Check declaration is stored
+	declaration, _ := utils.GetCertificationDeclaration(modelInfo.VID, modelInfo.PID, testconstants.SoftwareVersion)
+	require.Equal(t, declarationMsg.Content, declaration.Content)
+	require.Equal(t, zb.Address, declaration.Owner)
+
+	_, code := utils.GetCertificationDeclaration(modelInfo.VID, modelInfo.PID, testconstants.SoftwareVersion+1)
+	require.Equal(t, http.StatusNotFound, code)
+}
+
+func TestComplianceDemo_ComplianceByFirmwareDigest(t *testing.T) {
+	// Register new Vendor account
+	vendor := utils.CreateNewAccount(auth.AccountRoles{auth.Vendor})
+
+	// Register new TestHouse account
+	testHouse := utils.CreateNewAccount(auth.AccountRoles{auth.TestHouse})
+
+	// Register new ZBCertificationCenter account
+	zb := utils.CreateNewAccount(auth.AccountRoles{auth.ZBCertificationCenter})
+
+	// Publish model info and model version with a unique firmware digest
+	firmwareDigest := hex.EncodeToString([]byte(utils.RandString()))
+
+	modelInfo := utils.NewMsgAddModelInfo(vendor.Address)
+	_, _ = utils.AddModelInfo(modelInfo, vendor)
+
+	addModelVersionMsg := modelinfo.NewMsgAddModelVersion(modelInfo.VID, modelInfo.PID,
+		testconstants.SoftwareVersion, testconstants.SoftwareVersionString, firmwareDigest, "",
+		testconstants.MinApplicableSoftwareVersion, testconstants.MaxApplicableSoftwareVersion, vendor.Address)
+	_, _ = utils.Exec([]sdk.Msg{addModelVersionMsg}, vendor)
+
+	// Check the model version is found but not certified
+	firmwareCompliance, _ := utils.GetComplianceByFirmwareDigest(firmwareDigest)
+	require.False(t, firmwareCompliance.Certified)
+	require.Equal(t, 1, len(firmwareCompliance.Items))
+	require.Equal(t, testconstants.SoftwareVersion, firmwareCompliance.Items[0].SoftwareVersion)
+	require.Empty(t, firmwareCompliance.Items[0].ComplianceInfos)
+
+	// Publish testing result and certify model
+	testingResult := utils.NewMsgAddTestingResult(modelInfo.VID, modelInfo.PID, testHouse.Address)
+	_, _ = utils.PublishTestingResult(testingResult, testHouse)
+
+	certifyModelMsg := compliance.NewMsgCertifyModel(modelInfo.VID, modelInfo.PID, time.Now().UTC(),
+		compliance.CertificationType(testconstants.CertificationType), testconstants.EmptyString, zb.Address)
+	_, _ = utils.PublishCertifiedModel(certifyModelMsg, zb)
+
+	// Check the model version is certified (hex digests are case-insensitive)
+	firmwareCompliance, _ = utils.GetComplianceByFirmwareDigest(strings.ToUpper(firmwareDigest))
+	require.True(t, firmwareCompliance.Certified)
+	require.Equal(t, 1, len(firmwareCompliance.Items[0].ComplianceInfos))
+
+	// Unknown digest is not certified
+	firmwareCompliance, code := utils.GetComplianceByFirmwareDigest(utils.RandString())
+	require.Equal(t, http.StatusOK, code)
+	require.False(t, firmwareCompliance.Certified)
+	require.Empty(t, firmwareCompliance.Items)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/utils"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliancetest"
 )
 
 //nolint:godox
@@ -87,3 +88,78 @@ func TestCompliancetestDemo(t *testing.T) {
 	require.Equal(t, receivedTestingResult.Results[1].Owner, thirdTestingResult.Signer)
 	require.Equal(t, receivedTestingResult.Results[1].TestResult, thirdTestingResult.TestResult)
 }
+
+func TestCompliancetestDemo_Report(t *testing.T) {
+	// Register new Vendor account
+	vendor := utils.CreateNewAccount(auth.AccountRoles{auth.Vendor})
+
+	// Register new TestHouse account
+	testHouse := utils.CreateNewAccount(auth.AccountRoles{auth.TestHouse})
+
+	// Publish model info
+	modelInfo := utils.NewMsgAddModelInfo(vendor.Address)
+	_, _ = utils.AddModelInfo(modelInfo, vendor)
+
+	// Publish testing result with structured report
+	testingResult := utils.NewMsgAddTestingResult(modelInfo.VID, modelInfo.PID, testHouse.Address)
+	testingResult.TestResult = ""
+	testingResult.Report = &compliancetest.TestReport{
+		TestPlanID:  "test-plan-1",
+		TestHouseID: "test-house-1",
+		TestCases: []compliancetest.TestCase{
+			{ID: "TC-1", Result: compliancetest.TestCasePassed},
+			{ID: "TC-2", Result: compliancetest.TestCaseFailed},
+		},
+		Attachments: []compliancetest.Attachment{{Name: "log", URL: "https://test.house.com/log", Hash: "abcd"}},
+	}
+	_, _ = utils.PublishTestingResult(testingResult, testHouse)
+
+	// Check the report is returned parsed
+	receivedTestingResult, _ := utils.GetTestingResult(testingResult.VID, testingResult.PID)
+	require.Equal(t, 1, len(receivedTestingResult.Results))
+	require.Equal(t, testingResult.Report, receivedTestingResult.Results[0].Report)
+
+	// Check filtering by test case result
+	receivedTestingResult, _ = utils.GetTestingResultByTestCase(testingResult.VID, testingResult.PID,
+		"", compliancetest.TestCaseFailed)
+	require.Equal(t, 1, len(receivedTestingResult.Results))
+	require.Equal(t, []compliancetest.TestCase{testingResult.Report.TestCases[1]},
+		receivedTestingResult.Results[0].Report.TestCases)
+
+	// Check filtering by unknown test case
+	receivedTestingResult, _ = utils.GetTestingResultByTestCase(testingResult.VID, testingResult.PID, "TC-3", "")
+	require.Equal(t, 0, len(receivedTestingResult.Results))
+}
+
+func TestCompliancetestDemo_LatestPerTestHouse(t *testing.T) {
+	// Register new Vendor account
+	vendor := utils.CreateNewAccount(auth.AccountRoles{auth.Vendor})
+
+	// Register two new TestHouse accounts
+	testHouse := utils.CreateNewAccount(auth.AccountRoles{auth.TestHouse})
+	secondTestHouse := utils.CreateNewAccount(auth.AccountRoles{auth.TestHouse})
+
+	// Publish model info
+	modelInfo := utils.NewMsgAddModelInfo(vendor.Address)
+	_, _ = utils.AddModelInfo(modelInfo, vendor)
+
+	// Publish two testing results by first test house and one by second
+	firstTestingResult := utils.NewMsgAddTestingResult(modelInfo.VID, modelInfo.PID, testHouse.Address)
+	_, _ = utils.PublishTestingResult(firstTestingResult, testHouse)
+
+	secondTestingResult := utils.NewMsgAddTestingResult(modelInfo.VID, modelInfo.PID, testHouse.Address)
+	_, _ = utils.PublishTestingResult(secondTestingResult, testHouse)
+
+	thirdTestingResult := utils.NewMsgAddTestingResult(modelInfo.VID, modelInfo.PID, secondTestHouse.Address)
+	_, _ = utils.PublishTestingResult(thirdTestingResult, secondTestHouse)
+
+	// Check all testing results are returned
+	receivedTestingResult, _ := utils.GetTestingResult(modelInfo.VID, modelInfo.PID)
+	require.Equal(t, 3, len(receivedTestingResult.Results))
+
+	// Check only the latest testing result of every test house is returned
+	receivedTestingResult, _ = utils.GetLatestTestingResult(modelInfo.VID, modelInfo.PID)
+	require.Equal(t, 2, len(receivedTestingResult.Results))
+	require.Equal(t, secondTestingResult.TestResult, receivedTestingResult.Results[0].TestResult)
+	require.Equal(t, thirdTestingResult.TestResult, receivedTestingResult.Results[1].TestResult)
+}
@@ -0,0 +1,75 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/utils"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+)
+
+/*
+	To Run test you need:
+		* Run LocalNet with: `make install && make localnet_init && make localnet_start`
+		* run RPC service with `dclcli rest-server --chain-id dclchain`
+*/
+
+func TestGrantDemo_PartnerPublishesModelVersion(t *testing.T) {
+	// Register new Vendor account
+	vendor := utils.CreateNewAccount(auth.AccountRoles{auth.Vendor})
+
+	// Register manufacturing partner account without roles
+	partner := utils.CreateNewAccount(auth.AccountRoles{})
+
+	// Publish model info
+	modelInfo := utils.NewMsgAddModelInfo(vendor.Address)
+	_, _ = utils.AddModelInfo(modelInfo, vendor)
+
+	addModelVersionMsg := modelinfo.NewMsgAddModelVersion(modelInfo.VID, modelInfo.PID,
+		testconstants.SoftwareVersion, testconstants.SoftwareVersionString, "", "",
+		testconstants.MinApplicableSoftwareVersion, testconstants.MaxApplicableSoftwareVersion, vendor.Address)
+
+	// Partner can not publish model version without grant
+	result, _ := utils.Exec([]sdk.Msg{addModelVersionMsg}, partner)
+	require.Equal(t, grant.CodeNoAuthorization, sdk.CodeType(result.Code))
+
+	// Vendor grants partner to publish model versions
+	grantMsg := grant.NewMsgGrant(vendor.Address, partner.Address, grant.MsgTypeOf(addModelVersionMsg),
+		time.Now().UTC().Add(time.Hour).Truncate(time.Second))
+	_, _ = utils.Grant(grantMsg, vendor)
+
+	receivedGrant, _ := utils.GetGrant(vendor.Address, partner.Address, grantMsg.MsgType)
+	require.Equal(t, grantMsg.Expiration, receivedGrant.Expiration)
+
+	// Partner publishes model version on behalf of vendor
+	_, _ = utils.Exec([]sdk.Msg{addModelVersionMsg}, partner)
+
+	modelVersion, _ := utils.GetModelVersion(modelInfo.VID, modelInfo.PID, testconstants.SoftwareVersion)
+	require.Equal(t, testconstants.SoftwareVersionString, modelVersion.SoftwareVersionString)
+
+	// Vendor revokes the grant
+	_, _ = utils.RevokeGrant(grant.NewMsgRevoke(vendor.Address, partner.Address, grantMsg.MsgType), vendor)
+
+	_, code := utils.GetGrant(vendor.Address, partner.Address, grantMsg.MsgType)
+	require.Equal(t, http.StatusNotFound, code)
+}
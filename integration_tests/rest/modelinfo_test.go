@@ -112,8 +112,59 @@ func TestModelinfoDemo_Prepare_Sign_Broadcast(t *testing.T) {
 	require.Equal(t, receivedModelInfo.Name, modelInfo.Name)
 }
 
+func TestModelinfoDemo_Prepare_SignOffline_Broadcast(t *testing.T) {
+	// Register new Vendor account
+	vendor := utils.CreateNewAccount(auth.AccountRoles{auth.Vendor})
+
+	// Prepare model info
+	modelInfo := utils.NewMsgAddModelInfo(vendor.Address)
+
+	// Prepare transaction
+	addModelTransaction, _ := utils.PrepareAddModelInfoTransaction(modelInfo)
+
+	// Get bytes to sign and sign them offline
+	signBytesResponse, code := utils.GetSignBytes(vendor, addModelTransaction)
+	require.Equal(t, http.StatusOK, code)
+
+	signature := utils.SignBytesOffline(vendor, signBytesResponse.SignBytes)
+
+	// Assemble and Broadcast
+	_, code = utils.AssembleAndBroadcastTransaction(vendor, addModelTransaction, signature)
+	require.Equal(t, http.StatusOK, code)
+
+	// Check model is created
+	receivedModelInfo, _ := utils.GetModelInfo(modelInfo.VID, modelInfo.PID)
+	require.Equal(t, receivedModelInfo.VID, modelInfo.VID)
+	require.Equal(t, receivedModelInfo.PID, modelInfo.PID)
+}
+
 /* Error cases */
 
+func TestModelinfoDemo_AccountTxs(t *testing.T) {
+	// Register new Vendor account
+	vendor := utils.CreateNewAccount(auth.AccountRoles{auth.Vendor})
+
+	// Publish two model infos
+	firstModelInfo := utils.NewMsgAddModelInfo(vendor.Address)
+	_, _ = utils.AddModelInfo(firstModelInfo, vendor)
+
+	secondModelInfo := utils.NewMsgAddModelInfo(vendor.Address)
+	_, _ = utils.AddModelInfo(secondModelInfo, vendor)
+
+	// Get all transactions of the vendor
+	txs, code := utils.GetAccountTxs(vendor.Address, "")
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, 2, txs.TotalCount)
+
+	// Get transactions of the vendor containing add_model_info messages
+	txs, _ = utils.GetAccountTxs(vendor.Address, modelinfo.MsgAddModelInfo{}.Type())
+	require.Equal(t, 2, txs.TotalCount)
+
+	// Get transactions of the vendor containing update_model_info messages
+	txs, _ = utils.GetAccountTxs(vendor.Address, modelinfo.MsgUpdateModelInfo{}.Type())
+	require.Equal(t, 0, txs.TotalCount)
+}
+
 func Test_AddModelinfo_ByNonVendor(t *testing.T) {
 	// register new account
 	testAccount := utils.CreateNewAccount(auth.AccountRoles{})
@@ -121,7 +172,7 @@ func Test_AddModelinfo_ByNonVendor(t *testing.T) {
 	// try to publish model info
 	modelInfo := utils.NewMsgAddModelInfo(testAccount.Address)
 	res, _ := utils.SignAndBroadcastMessage(testAccount, modelInfo)
-	require.Equal(t, sdk.CodeUnauthorized, sdk.CodeType(res.Code))
+	require.Equal(t, auth.CodeMissingRole, sdk.CodeType(res.Code))
 }
 
 func Test_AddModelinfo_Twice(t *testing.T) {
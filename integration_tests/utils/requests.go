@@ -15,9 +15,11 @@
 package utils
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	keyUtil "github.com/cosmos/cosmos-sdk/client/keys"
@@ -37,6 +39,8 @@ import (
 	complianceRest "github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance/client/rest"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliancetest"
 	compliancetestRest "github.com/zigbee-alliance/distributed-compliance-ledger/x/compliancetest/client/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant"
+	grantRest "github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/client/rest"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
 	modelinfoRest "github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/client/rest"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
@@ -161,6 +165,21 @@ func GetAccount(address sdk.AccAddress) (AccountInfo, int) {
 	return result, code
 }
 
+func GetAccountTxs(address sdk.AccAddress, msgType string) (sdk.SearchTxsResult, int) {
+	println("Get Transactions of Account: ", address)
+
+	uri := fmt.Sprintf("%s/accounts/%s/txs?msg_type=%s&limit=%d", auth.RouterKey, address.String(), msgType, 100)
+	response, code := SendGetRequest(uri)
+
+	var result sdk.SearchTxsResult
+
+	if code == http.StatusOK {
+		_ = app.MakeCodec().UnmarshalJSON(response, &result)
+	}
+
+	return result, code
+}
+
 func GetAccounts() (AccountHeadersResult, int) {
 	println("Get Accounts")
 
@@ -256,6 +275,66 @@ func SignMessage(sender KeyInfo, txn types.StdTx) (json.RawMessage, int) {
 	return removeResponseWrapper(response), code
 }
 
+func GetSignBytes(signer KeyInfo, txn types.StdTx) (extRest.SignBytesResponse, int) {
+	println("Get Sign Bytes of prepared transaction")
+
+	request := extRest.SignBytesRequest{
+		BaseReq: restTypes.BaseReq{
+			ChainID: constants.ChainID,
+			From:    signer.Address.String(),
+		},
+		Txn: extRest.Txn{
+			Value: txn,
+		},
+	}
+
+	body, _ := codec.MarshalJSONIndent(app.MakeCodec(), request)
+
+	uri := fmt.Sprintf("%s/%s", "tx", "sign-bytes")
+	response, code := SendPostRequest(uri, body, "", "")
+
+	var result extRest.SignBytesResponse
+
+	parseGetReqResponse(response, &result, code)
+
+	return result, code
+}
+
+// Signs the bytes by the local key the same way as it is done on an air-gapped machine.
+func SignBytesOffline(signer KeyInfo, signBytes string) string {
+	println("Sign Bytes offline")
+
+	kb, _ := keyUtil.NewKeyBaseFromDir(app.DefaultCLIHome)
+
+	bytes, _ := base64.StdEncoding.DecodeString(signBytes)
+	signature, _, _ := kb.Sign(signer.Name, constants.Passphrase, bytes)
+
+	return base64.StdEncoding.EncodeToString(signature)
+}
+
+func AssembleAndBroadcastTransaction(signer KeyInfo, txn types.StdTx, signature string) (TxnResponse, int) {
+	println("Assemble and Broadcast transaction signed offline")
+
+	request := extRest.AssembleTxRequest{
+		BaseReq: restTypes.BaseReq{
+			ChainID: constants.ChainID,
+			From:    signer.Address.String(),
+		},
+		Txn: extRest.Txn{
+			Value: txn,
+		},
+		PubKey:    signer.PublicKey,
+		Signature: signature,
+	}
+
+	body, _ := codec.MarshalJSONIndent(app.MakeCodec(), request)
+
+	uri := fmt.Sprintf("%s/%s?%s=true", "tx", "assemble", extRest.FlagBroadcast)
+	response, code := SendPostRequest(uri, body, "", "")
+
+	return parseWriteTxnResponse(response, code)
+}
+
 func BroadcastMessage(message interface{}) (TxnResponse, int) {
 	println("Broadcast Message")
 
@@ -341,6 +420,8 @@ func SendUpdateModelInfoRequest(model modelinfo.MsgUpdateModelInfo, account stri
 		OtaURL:                   model.OtaURL,
 		Custom:                   model.Custom,
 		TisOrTrpTestingCompleted: model.TisOrTrpTestingCompleted,
+		Unset:                    model.Unset,
+		ExpectedSequence:         model.ExpectedSequence,
 	}
 
 	body, _ := codec.MarshalJSONIndent(app.MakeCodec(), request)
@@ -424,10 +505,12 @@ func SendTestingResultRequest(testingResult compliancetest.MsgAddTestingResult,
 			ChainID: constants.ChainID,
 			From:    testingResult.Signer.String(),
 		},
-		VID:        testingResult.VID,
-		PID:        testingResult.PID,
-		TestResult: testingResult.TestResult,
-		TestDate:   testingResult.TestDate,
+		VID:             testingResult.VID,
+		PID:             testingResult.PID,
+		TestResult:      testingResult.TestResult,
+		Report:          testingResult.Report,
+		TestDate:        testingResult.TestDate,
+		SoftwareVersion: testingResult.SoftwareVersion,
 	}
 
 	body, _ := codec.MarshalJSONIndent(app.MakeCodec(), request)
@@ -450,6 +533,35 @@ func GetTestingResult(vid uint16, pid uint16) (compliancetest.TestingResults, in
 	return result, code
 }
 
+func GetLatestTestingResult(vid uint16, pid uint16) (compliancetest.TestingResults, int) {
+	println(fmt.Sprintf("Get Latest Testing Result per Test House for Model with VID:%v PID:%v", vid, pid))
+
+	uri := fmt.Sprintf("%s/%s/%v/%v?latest=true", compliancetest.RouterKey, "testresults", vid, pid)
+	response, code := SendGetRequest(uri)
+
+	var result compliancetest.TestingResults
+
+	parseGetReqResponse(removeResponseWrapper(response), &result, code)
+
+	return result, code
+}
+
+func GetTestingResultByTestCase(vid uint16, pid uint16, testCaseID string,
+	testCaseResult string) (compliancetest.TestingResults, int) {
+	println(fmt.Sprintf("Get Testing Result for Model with VID:%v PID:%v filtered by test case ID:%v Result:%v",
+		vid, pid, testCaseID, testCaseResult))
+
+	uri := fmt.Sprintf("%s/%s/%v/%v?test_case_id=%s&test_case_result=%s", compliancetest.RouterKey, "testresults",
+		vid, pid, url.QueryEscape(testCaseID), url.QueryEscape(testCaseResult))
+	response, code := SendGetRequest(uri)
+
+	var result compliancetest.TestingResults
+
+	parseGetReqResponse(removeResponseWrapper(response), &result, code)
+
+	return result, code
+}
+
 func PublishCertifiedModel(certifyModel compliance.MsgCertifyModel, sender KeyInfo) (TxnResponse, int) {
 	println("Publish Certified Model")
 
@@ -473,6 +585,7 @@ func SendCertifiedModelRequest(certifyModel compliance.MsgCertifyModel, name str
 			From:    certifyModel.Signer.String(),
 		},
 		CertificationDate: certifyModel.CertificationDate,
+		ExpectedSequence:  certifyModel.ExpectedSequence,
 	}
 
 	body, _ := codec.MarshalJSONIndent(app.MakeCodec(), request)
@@ -505,8 +618,10 @@ func SendRevokedModelRequest(revokeModel compliance.MsgRevokeModel, name string)
 			ChainID: constants.ChainID,
 			From:    revokeModel.Signer.String(),
 		},
-		RevocationDate: revokeModel.RevocationDate,
-		Reason:         revokeModel.Reason,
+		RevocationDate:   revokeModel.RevocationDate,
+		ReasonCode:       revokeModel.ReasonCode,
+		Reason:           revokeModel.Reason,
+		ExpectedSequence: revokeModel.ExpectedSequence,
 	}
 
 	body, _ := codec.MarshalJSONIndent(app.MakeCodec(), request)
@@ -524,6 +639,227 @@ func GetComplianceInfo(vid uint16, pid uint16,
 	return getComplianceInfo(vid, pid, certificationType)
 }
 
+func GrantComplianceAuthority(grant compliance.MsgGrantComplianceAuthority, sender KeyInfo) (TxnResponse, int) {
+	println("Grant Compliance Authority")
+
+	request := complianceRest.GrantComplianceAuthorityRequest{
+		BaseReq: restTypes.BaseReq{
+			ChainID: constants.ChainID,
+			From:    grant.Signer.String(),
+		},
+		ExpirationDate: grant.ExpirationDate,
+	}
+
+	body, _ := codec.MarshalJSONIndent(app.MakeCodec(), request)
+
+	uri := fmt.Sprintf("%s/%s/%v/%v", compliance.RouterKey, "grants", grant.VID, grant.Grantee)
+
+	response, code := SendPutRequest(uri, body, sender.Name, constants.Passphrase)
+
+	return parseWriteTxnResponse(response, code)
+}
+
+func RevokeComplianceAuthority(revoke compliance.MsgRevokeComplianceAuthority, sender KeyInfo) (TxnResponse, int) {
+	println("Revoke Compliance Authority")
+
+	request := complianceRest.RevokeComplianceAuthorityRequest{
+		BaseReq: restTypes.BaseReq{
+			ChainID: constants.ChainID,
+			From:    revoke.Signer.String(),
+		},
+	}
+
+	body, _ := codec.MarshalJSONIndent(app.MakeCodec(), request)
+
+	uri := fmt.Sprintf("%s/%s/%v/%v", compliance.RouterKey, "grants", revoke.VID, revoke.Grantee)
+
+	response, code := SendDeleteRequest(uri, body, sender.Name, constants.Passphrase)
+
+	return parseWriteTxnResponse(response, code)
+}
+
+func GetComplianceAuthorityGrant(vid uint16, grantee sdk.AccAddress) (compliance.ComplianceAuthorityGrant, int) {
+	println(fmt.Sprintf("Get Compliance Authority Grant for VID:%v to %v", vid, grantee))
+
+	uri := fmt.Sprintf("%s/%s/%v/%v", compliance.RouterKey, "grants", vid, grantee)
+	response, code := SendGetRequest(uri)
+
+	var result compliance.ComplianceAuthorityGrant
+
+	parseGetReqResponse(removeResponseWrapper(response), &result, code)
+
+	return result, code
+}
+
+func AddCertificationDeclaration(msg compliance.MsgAddCertificationDeclaration,
+	sender KeyInfo) (TxnResponse, int) {
+	println(fmt.Sprintf("Add Certification Declaration for Model with VID:%v PID:%v SoftwareVersion:%v",
+		msg.VID, msg.PID, msg.SoftwareVersion))
+
+	request := complianceRest.AddCertificationDeclarationRequest{
+		BaseReq: restTypes.BaseReq{
+			ChainID: constants.ChainID,
+			From:    msg.Signer.String(),
+		},
+		CertificationType: msg.CertificationType,
+		Content:           msg.Content,
+		ContentHash:       msg.ContentHash,
+		URL:               msg.URL,
+	}
+
+	body, _ := codec.MarshalJSONIndent(app.MakeCodec(), request)
+
+	uri := fmt.Sprintf("%s/%s/%v/%v/%v", compliance.RouterKey, "declarations", msg.VID, msg.PID, msg.SoftwareVersion)
+
+	response, code := SendPutRequest(uri, body, sender.Name, constants.Passphrase)
+
+	return parseWriteTxnResponse(response, code)
+}
+
+func GetCertificationDeclaration(vid uint16, pid uint16,
+	softwareVersion uint32) (compliance.CertificationDeclaration, int) {
+	println(fmt.Sprintf("Get Certification Declaration for Model with VID:%v PID:%v SoftwareVersion:%v",
+		vid, pid, softwareVersion))
+
+	uri := fmt.Sprintf("%s/%s/%v/%v/%v", compliance.RouterKey, "declarations", vid, pid, softwareVersion)
+	response, code := SendGetRequest(uri)
+
+	var result compliance.CertificationDeclaration
+
+	parseGetReqResponse(removeResponseWrapper(response), &result, code)
+
+	return result, code
+}
+
+func GetComplianceByFirmwareDigest(digest string) (compliance.FirmwareCompliance, int) {
+	println(fmt.Sprintf("Get Compliance of Model Versions with FirmwareDigest:%v", digest))
+
+	uri := fmt.Sprintf("%s/%s/%v", compliance.RouterKey, "by-digest", digest)
+	response, code := SendGetRequest(uri)
+
+	var result compliance.FirmwareCompliance
+
+	parseGetReqResponse(removeResponseWrapper(response), &result, code)
+
+	return result, code
+}
+
+func Grant(msg grant.MsgGrant, sender KeyInfo) (TxnResponse, int) {
+	println(fmt.Sprintf("Grant %v permission to execute %v messages", msg.Grantee, msg.MsgType))
+
+	request := grantRest.GrantRequest{
+		BaseReq: restTypes.BaseReq{
+			ChainID: constants.ChainID,
+			From:    msg.Granter.String(),
+		},
+		Grantee:    msg.Grantee,
+		MsgType:    msg.MsgType,
+		Expiration: msg.Expiration,
+	}
+
+	body, _ := codec.MarshalJSONIndent(app.MakeCodec(), request)
+
+	uri := fmt.Sprintf("%s/%s", grant.RouterKey, "grants")
+
+	response, code := SendPostRequest(uri, body, sender.Name, constants.Passphrase)
+
+	return parseWriteTxnResponse(response, code)
+}
+
+func RevokeGrant(msg grant.MsgRevoke, sender KeyInfo) (TxnResponse, int) {
+	println(fmt.Sprintf("Revoke %v permission to execute %v messages", msg.Grantee, msg.MsgType))
+
+	request := grantRest.RevokeRequest{
+		BaseReq: restTypes.BaseReq{
+			ChainID: constants.ChainID,
+			From:    msg.Granter.String(),
+		},
+		Grantee: msg.Grantee,
+		MsgType: msg.MsgType,
+	}
+
+	body, _ := codec.MarshalJSONIndent(app.MakeCodec(), request)
+
+	uri := fmt.Sprintf("%s/%s", grant.RouterKey, "grants")
+
+	response, code := SendDeleteRequest(uri, body, sender.Name, constants.Passphrase)
+
+	return parseWriteTxnResponse(response, code)
+}
+
+func Exec(msgs []sdk.Msg, sender KeyInfo) (TxnResponse, int) {
+	println(fmt.Sprintf("Execute messages on behalf by %v", sender.Address))
+
+	request := grantRest.ExecRequest{
+		BaseReq: restTypes.BaseReq{
+			ChainID: constants.ChainID,
+			From:    sender.Address.String(),
+		},
+		Msgs: msgs,
+	}
+
+	body, _ := codec.MarshalJSONIndent(app.MakeCodec(), request)
+
+	uri := fmt.Sprintf("%s/%s", grant.RouterKey, "exec")
+
+	response, code := SendPostRequest(uri, body, sender.Name, constants.Passphrase)
+
+	return parseWriteTxnResponse(response, code)
+}
+
+func GetGrant(granter sdk.AccAddress, grantee sdk.AccAddress, msgType string) (grant.Grant, int) {
+	println(fmt.Sprintf("Get Grant issued by %v to %v for %v messages", granter, grantee, msgType))
+
+	uri := fmt.Sprintf("%s/%s/%v/%v/%v", grant.RouterKey, "grants", granter, grantee, msgType)
+	response, code := SendGetRequest(uri)
+
+	var result grant.Grant
+
+	parseGetReqResponse(removeResponseWrapper(response), &result, code)
+
+	return result, code
+}
+
+func GetModelVersion(vid uint16, pid uint16, softwareVersion uint32) (modelinfo.ModelVersion, int) {
+	println(fmt.Sprintf("Get Model Version with VID:%v PID:%v SoftwareVersion:%v", vid, pid, softwareVersion))
+
+	uri := fmt.Sprintf("%s/%s/%v/%v/%v", modelinfo.RouterKey, "versions", vid, pid, softwareVersion)
+	response, code := SendGetRequest(uri)
+
+	var result modelinfo.ModelVersion
+
+	parseGetReqResponse(removeResponseWrapper(response), &result, code)
+
+	return result, code
+}
+
+func GetComplianceHistory(vid uint16, pid uint16,
+	certificationType compliance.CertificationType) (compliance.ComplianceHistory, int) {
+	println(fmt.Sprintf("Get Compliance History for Model with VID:%v PID:%v", vid, pid))
+
+	uri := fmt.Sprintf("%s/%v/%v/history?certification_type=%v", compliance.RouterKey, vid, pid, certificationType)
+	response, code := SendGetRequest(uri)
+
+	var result compliance.ComplianceHistory
+
+	parseGetReqResponse(removeResponseWrapper(response), &result, code)
+
+	return result, code
+}
+
+func GetModelComplianceInfos(vid uint16, pid uint16) (ComplianceInfosHeadersResult, int) {
+	println(fmt.Sprintf("Get Compliance Info records of all certification types for Model with VID:%v PID:%v", vid, pid))
+
+	uri := fmt.Sprintf("%s/%v/%v", compliance.RouterKey, vid, pid)
+	response, code := SendGetRequest(uri)
+
+	var result ComplianceInfosHeadersResult
+
+	parseGetReqResponse(removeResponseWrapper(response), &result, code)
+
+	return result, code
+}
+
 func GetCertifiedModel(vid uint16, pid uint16,
 	certificationType compliance.CertificationType) (compliance.ComplianceInfoInState, int) {
 	println(fmt.Sprintf("Get if Model with VID:%v PID:%v Certified", vid, pid))
@@ -726,8 +1062,9 @@ func SendProposeRevokeX509RootCertRequest(proposeRevokeX509RootCert pki.MsgPropo
 			ChainID: constants.ChainID,
 			From:    proposeRevokeX509RootCert.Signer.String(),
 		},
-		Subject:      proposeRevokeX509RootCert.Subject,
-		SubjectKeyID: proposeRevokeX509RootCert.SubjectKeyID,
+		Subject:          proposeRevokeX509RootCert.Subject,
+		SubjectKeyID:     proposeRevokeX509RootCert.SubjectKeyID,
+		ExpectedSequence: proposeRevokeX509RootCert.ExpectedSequence,
 	}
 
 	body, _ := codec.MarshalJSONIndent(app.MakeCodec(), request)
@@ -790,11 +1127,12 @@ func PrepareRevokeX509CertTransaction(revokeX509Cert pki.MsgRevokeX509Cert) (typ
 }
 
 func SendRevokeX509CertRequest(revokeX509Cert pki.MsgRevokeX509Cert, account string, passphrase string) ([]byte, int) {
-	request := rest.BasicReq{
+	request := pkiRest.RevokeCertificateRequest{
 		BaseReq: restTypes.BaseReq{
 			ChainID: constants.ChainID,
 			From:    revokeX509Cert.Signer.String(),
 		},
+		ExpectedSequence: revokeX509Cert.ExpectedSequence,
 	}
 
 	body, _ := codec.MarshalJSONIndent(app.MakeCodec(), request)
@@ -958,7 +1296,9 @@ func NewMsgAddTestingResult(vid uint16, pid uint16, owner sdk.AccAddress) compli
 	return compliancetest.NewMsgAddTestingResult(
 		vid,
 		pid,
+		0,
 		RandString(),
+		nil,
 		time.Now().UTC(),
 		owner,
 	)
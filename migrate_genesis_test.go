@@ -0,0 +1,85 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade"
+)
+
+func TestMigrateGenesisState_ConvertsExportedStateBeforeLoading(t *testing.T) {
+	app := NewDcLedgerApp(log.NewNopLogger(), dbm.NewMemDB())
+	genesisState := exportedGenesisState(app)
+
+	versions := map[string]uint64{pki.ModuleName: 3}
+	require.NoError(t, app.migrateGenesisState(genesisState, versions))
+
+	// the class is set in the exported state itself
+	var pkiGenesis pki.GenesisState
+
+	app.cdc.MustUnmarshalJSON(genesisState[pki.ModuleName], &pkiGenesis)
+	require.Equal(t, pki.DefaultCertificateClass, pkiGenesis.ApprovedCertificatesRecords[0].Items[0].Class)
+}
+
+func TestMigrateGenesisState_NewerVersion(t *testing.T) {
+	app := NewDcLedgerApp(log.NewNopLogger(), dbm.NewMemDB())
+	genesisState := exportedGenesisState(app)
+
+	versions := map[string]uint64{pki.ModuleName: pki.ConsensusVersion + 1}
+	require.Error(t, app.migrateGenesisState(genesisState, versions))
+}
+
+func TestMigrateGenesis(t *testing.T) {
+	app := NewDcLedgerApp(log.NewNopLogger(), dbm.NewMemDB())
+	genesisState := exportedGenesisState(app)
+
+	upgradeGenesis := upgrade.DefaultGenesisState()
+	upgradeGenesis.ModuleVersions = []upgrade.ModuleVersion{upgrade.NewModuleVersion(pki.ModuleName, 3)}
+	genesisState[upgrade.ModuleName] = app.cdc.MustMarshalJSON(upgradeGenesis)
+
+	appState, _, err := MigrateGenesis(log.NewNopLogger(), testconstants.ChainID, app.cdc.MustMarshalJSON(genesisState))
+	require.NoError(t, err)
+
+	var migratedState GenesisState
+
+	app.cdc.MustUnmarshalJSON(appState, &migratedState)
+
+	var pkiGenesis pki.GenesisState
+
+	app.cdc.MustUnmarshalJSON(migratedState[pki.ModuleName], &pkiGenesis)
+	require.Equal(t, pki.DefaultCertificateClass, pkiGenesis.ApprovedCertificatesRecords[0].Items[0].Class)
+
+	app.cdc.MustUnmarshalJSON(migratedState[upgrade.ModuleName], &upgradeGenesis)
+	require.Contains(t, upgradeGenesis.ModuleVersions,
+		upgrade.NewModuleVersion(pki.ModuleName, pki.ConsensusVersion))
+}
+
+// Returns the state exported before the certificate classes were introduced.
+func exportedGenesisState(app *dcLedgerApp) GenesisState {
+	genesisState := NewDefaultGenesisState()
+
+	pkiGenesis := pki.DefaultGenesisState()
+	pkiGenesis.ApprovedCertificatesRecords = []pki.Certificates{{Items: []pki.Certificate{rootCertificate()}}}
+	genesisState[pki.ModuleName] = app.cdc.MustMarshalJSON(pkiGenesis)
+
+	return genesisState
+}
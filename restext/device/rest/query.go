@@ -0,0 +1,188 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliancetest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
+)
+
+// Returns the combined view of the device model: the model info, the compliance state for every certification type,
+// the summary of the testing results and, if `pai_subject` and `pai_subject_key_id` are passed,
+// the chain of the PAI certificate up to the PAA one. All the data is read at the same height.
+func DeviceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		vid, err_ := conversions.ParseVID(vars[vid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		pid, err_ := conversions.ParsePID(vars[pid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		subject, subjectKeyID := r.FormValue(paiSubject), r.FormValue(paiSubjectKeyID)
+		if (len(subject) == 0) != (len(subjectKeyID) == 0) {
+			restCtx.WriteError(http.StatusBadRequest, sdk.ErrUnknownRequest(
+				fmt.Sprintf("Invalid request: both %v and %v must be passed", paiSubject, paiSubjectKeyID)))
+
+			return
+		}
+
+		info := DeviceInfo{VID: vid, PID: pid}
+
+		// the model is read at the latest height, the rest is read at the same height
+		modelPath := fmt.Sprintf("custom/%s/model/%v/%v", modelinfo.StoreKey, vid, pid)
+
+		res, height, err := restCtx.QueryWithData(modelPath, nil)
+		if err != nil {
+			restCtx.WriteError(http.StatusNotFound, err)
+
+			return
+		}
+
+		restCtx = restCtx.WithHeight(height)
+
+		restCtx.Codec().MustUnmarshalJSON(res, &info.Model)
+
+		info.Compliance, err = queryCompliance(restCtx, vid, pid)
+		if err != nil {
+			restCtx.WriteError(http.StatusInternalServerError, err)
+
+			return
+		}
+
+		info.TestResults, err = queryTestResults(restCtx, vid, pid)
+		if err != nil {
+			restCtx.WriteError(http.StatusInternalServerError, err)
+
+			return
+		}
+
+		if len(subject) != 0 {
+			info.CertificateChain, err = queryCertificateChain(restCtx, subject, subjectKeyID)
+			if err != nil {
+				restCtx.WriteError(http.StatusNotFound, err)
+
+				return
+			}
+		}
+
+		restCtx.EncodeAndRespondWithHeight(info, height)
+	}
+}
+
+func queryCompliance(restCtx rest.RestContext, vid uint16, pid uint16) ([]compliance.ComplianceInfo, error) {
+	res, _, err := restCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/model_compliance_info_records/%v/%v", compliance.StoreKey, vid, pid), nil)
+	if isNotFound(err, compliance.ModuleName, compliance.CodeComplianceInfoDoesNotExist) {
+		return []compliance.ComplianceInfo{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var list compliance.ListComplianceInfoItems
+
+	restCtx.Codec().MustUnmarshalJSON(res, &list)
+
+	// only the current state is returned, the history can be requested separately
+	for i := range list.Items {
+		list.Items[i].History = nil
+	}
+
+	return list.Items, nil
+}
+
+func queryTestResults(restCtx rest.RestContext, vid uint16, pid uint16) (TestResultsSummary, error) {
+	res, _, err := restCtx.QueryWithData(fmt.Sprintf("custom/%s/testresult/%v/%v", compliancetest.StoreKey, vid, pid),
+		compliancetest.NewTestCaseFilter("", ""))
+	if isNotFound(err, compliancetest.ModuleName, compliancetest.CodeTestingResultDoesNotExist) {
+		return NewTestResultsSummary(compliancetest.TestingResults{}), nil
+	}
+
+	if err != nil {
+		return TestResultsSummary{}, err
+	}
+
+	var results compliancetest.TestingResults
+
+	restCtx.Codec().MustUnmarshalJSON(res, &results)
+
+	return NewTestResultsSummary(results), nil
+}
+
+// Returns the chain of the certificate: the certificate itself, its issuer and so on up to the root certificate.
+func queryCertificateChain(restCtx rest.RestContext, subject string, subjectKeyID string) ([]pki.Certificate, error) {
+	var chain []pki.Certificate
+
+	for len(chain) < maxChainLength {
+		res, _, err := restCtx.QueryStore(pki.GetApprovedCertificateKey(subject, subjectKeyID), pki.StoreKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if res == nil {
+			return nil, sdk.NewError(pki.ModuleName, pki.CodeCertificateDoesNotExist,
+				fmt.Sprintf("No X509 certificate associated with the combination of subject=%v and subjectKeyID=%v "+
+					"on the ledger", subject, subjectKeyID))
+		}
+
+		var certificates pki.Certificates
+
+		restCtx.Codec().MustUnmarshalBinaryBare(res, &certificates)
+
+		certificate := certificates.Items[len(certificates.Items)-1]
+		chain = append(chain, certificate)
+
+		if certificate.IsRoot {
+			return chain, nil
+		}
+
+		subject, subjectKeyID = certificate.Issuer, certificate.AuthorityKeyID
+	}
+
+	return nil, sdk.ErrInternal(fmt.Sprintf("Certificate chain is longer than %v certificates", maxChainLength))
+}
+
+func isNotFound(err error, module string, code sdk.CodeType) bool {
+	if err == nil {
+		return false
+	}
+
+	errorCode, ok := errcodes.Of(err)
+
+	return ok && errorCode.Codespace == sdk.CodespaceType(module) && errorCode.Code == code
+}
@@ -0,0 +1,89 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliancetest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
+)
+
+// Combined view of a device model.
+type DeviceInfo struct {
+	VID   uint16              `json:"vid"`
+	PID   uint16              `json:"pid"`
+	Model modelinfo.ModelInfo `json:"model"`
+	// the current compliance state of the model for every certification type it has been certified or revoked for
+	Compliance  []compliance.ComplianceInfo `json:"compliance"`
+	TestResults TestResultsSummary          `json:"test_results"`
+	// the chain of the certificate identified by `pai_subject` and `pai_subject_key_id` up to the root (PAA) one
+	CertificateChain []pki.Certificate `json:"certificate_chain,omitempty"`
+}
+
+type TestResultsSummary struct {
+	// number of testing results submitted for the model
+	Total int `json:"total"`
+	// the latest result of every test house
+	Latest []TestResultSummaryItem `json:"latest"`
+}
+
+type TestResultSummaryItem struct {
+	Owner           sdk.AccAddress `json:"owner"`
+	TestResult      string         `json:"test_result"`
+	TestDate        time.Time      `json:"test_date"`
+	SoftwareVersion uint32         `json:"software_version,omitempty"`
+	TestPlanID      string         `json:"test_plan_id,omitempty"`
+	TestHouseID     string         `json:"test_house_id,omitempty"`
+	PassedTestCases int            `json:"passed_test_cases"`
+	FailedTestCases int            `json:"failed_test_cases"`
+}
+
+func NewTestResultsSummary(results compliancetest.TestingResults) TestResultsSummary {
+	summary := TestResultsSummary{
+		Total:  len(results.Results),
+		Latest: []TestResultSummaryItem{},
+	}
+
+	for _, result := range results.LatestPerTestHouse().Results {
+		item := TestResultSummaryItem{
+			Owner:           result.Owner,
+			TestResult:      result.TestResult,
+			TestDate:        result.TestDate,
+			SoftwareVersion: result.SoftwareVersion,
+		}
+
+		if result.Report != nil {
+			item.TestPlanID = result.Report.TestPlanID
+			item.TestHouseID = result.Report.TestHouseID
+
+			for _, testCase := range result.Report.TestCases {
+				switch testCase.Result {
+				case compliancetest.TestCasePassed:
+					item.PassedTestCases++
+				case compliancetest.TestCaseFailed:
+					item.FailedTestCases++
+				}
+			}
+		}
+
+		summary.Latest = append(summary.Latest, item)
+	}
+
+	return summary
+}
@@ -0,0 +1,34 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+)
+
+// Lists all stable error codes the ledger can return.
+func ErrorCodesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		codes := errcodes.All()
+
+		restCtx.PostProcessResponseBare(resultErrorCodes{Total: len(codes), Items: codes})
+	}
+}
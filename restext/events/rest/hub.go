@@ -0,0 +1,154 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	hubSubscriber = "events"
+	// number of the transactions buffered for a subscriber not keeping up with the ledger before it is dropped.
+	// The shared subscription to the node buffers the same number of transactions: the node client drops
+	// the transactions not fitting the buffer (e.g. a burst of transactions committed in one block).
+	subscriberBufferSize = 100
+)
+
+// subscriptions is shared by all the WebSocket subscribers of the REST server.
+var subscriptions = newHub()
+
+// hub shares one subscription to the committed transactions of the node between all the WebSocket subscribers.
+// The subscription is started by the first subscriber and stopped after the last one leaves.
+type hub struct {
+	mtx         sync.Mutex
+	client      *rpcclient.HTTP
+	quit        chan struct{}
+	subscribers map[chan tmtypes.EventDataTx]struct{}
+}
+
+func newHub() *hub {
+	return &hub{subscribers: make(map[chan tmtypes.EventDataTx]struct{})}
+}
+
+// Adds a subscriber to the committed transactions (starting the subscription to the node if needed).
+// The channel is closed if the subscriber is dropped as it does not keep up with the ledger.
+func (h *hub) subscribe(nodeURI string) (chan tmtypes.EventDataTx, error) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.client == nil {
+		client := rpcclient.NewHTTP(nodeURI, "/websocket")
+		if err := client.Start(); err != nil {
+			return nil, err
+		}
+
+		query := fmt.Sprintf("%s='%s'", tmtypes.EventTypeKey, tmtypes.EventTx)
+
+		results, err := client.Subscribe(context.Background(), hubSubscriber, query, subscriberBufferSize)
+		if err != nil {
+			_ = client.Stop()
+
+			return nil, err
+		}
+
+		h.client, h.quit = client, make(chan struct{})
+
+		go h.forward(results, h.quit)
+	}
+
+	ch := make(chan tmtypes.EventDataTx, subscriberBufferSize)
+	h.subscribers[ch] = struct{}{}
+
+	return ch, nil
+}
+
+// Removes the subscriber (stopping the subscription to the node after the last one).
+func (h *hub) unsubscribe(ch chan tmtypes.EventDataTx) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	// the subscriber is already dropped
+	if _, ok := h.subscribers[ch]; !ok {
+		return
+	}
+
+	delete(h.subscribers, ch)
+
+	if len(h.subscribers) == 0 {
+		h.stop()
+	}
+}
+
+// Forwards the committed transactions to the subscribers until the subscription is stopped.
+// The node client never closes the results channel (it resubscribes after reconnecting to the node).
+func (h *hub) forward(results <-chan ctypes.ResultEvent, quit chan struct{}) {
+	for {
+		select {
+		case <-quit:
+			return
+		case result := <-results:
+			data, ok := result.Data.(tmtypes.EventDataTx)
+			if !ok {
+				continue
+			}
+
+			if !h.broadcast(data, quit) {
+				return
+			}
+		}
+	}
+}
+
+// Sends the transaction to the subscribers. Returns false if the subscription is stopped.
+func (h *hub) broadcast(data tmtypes.EventDataTx, quit chan struct{}) bool {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	if h.quit != quit {
+		return false
+	}
+
+	for ch := range h.subscribers {
+		select {
+		case ch <- data:
+		default:
+			// the slow subscriber is dropped rather than blocking the others
+			delete(h.subscribers, ch)
+			close(ch)
+		}
+	}
+
+	if len(h.subscribers) == 0 {
+		h.stop()
+
+		return false
+	}
+
+	return true
+}
+
+// Stops the subscription to the node. The caller must hold the lock.
+func (h *hub) stop() {
+	close(h.quit)
+	_ = h.client.Stop()
+
+	h.client, h.quit = nil, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+	amino "github.com/tendermint/go-amino"
+	abci "github.com/tendermint/tendermint/abci/types"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// Starts a node mock accepting one WebSocket subscription and publishing the events sent to the returned channel.
+// The returned `subscribed` channel is closed once the subscription request is received.
+func startNodeMock() (server *httptest.Server, publish chan []tmtypes.EventDataTx,
+	subscribed chan struct{}) {
+	cdc := amino.NewCodec()
+	ctypes.RegisterAmino(cdc)
+
+	publish = make(chan []tmtypes.EventDataTx)
+	subscribed = make(chan struct{})
+
+	upgrader := websocket.Upgrader{}
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		defer conn.Close()
+
+		var request rpctypes.RPCRequest
+		if err := conn.ReadJSON(&request); err != nil {
+			return
+		}
+
+		var params struct {
+			Query string `json:"query"`
+		}
+
+		if err := cdc.UnmarshalJSON(request.Params, &params); err != nil {
+			return
+		}
+
+		response := rpctypes.NewRPCSuccessResponse(cdc, request.ID, &ctypes.ResultSubscribe{})
+		if err := conn.WriteJSON(response); err != nil {
+			return
+		}
+
+		close(subscribed)
+
+		// the client messages are only read to process the control frames
+		go func() {
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					return
+				}
+			}
+		}()
+
+		for events := range publish {
+			for _, data := range events {
+				result := ctypes.ResultEvent{Query: params.Query, Data: data}
+
+				if err := conn.WriteJSON(rpctypes.NewRPCSuccessResponse(cdc, request.ID, result)); err != nil {
+					return
+				}
+			}
+		}
+	}))
+
+	return server, publish, subscribed
+}
+
+func receiveTxs(t *testing.T, ch chan tmtypes.EventDataTx, count int) []tmtypes.EventDataTx {
+	var received []tmtypes.EventDataTx
+
+	for len(received) < count {
+		select {
+		case data, ok := <-ch:
+			require.True(t, ok, "the subscriber is dropped")
+
+			received = append(received, data)
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "not all the transactions are received", "received %v of %v", len(received), count)
+		}
+	}
+
+	return received
+}
+
+func TestHub_BurstOfEventsReachesAllSubscribers(t *testing.T) {
+	server, publish, subscribed := startNodeMock()
+	defer server.Close()
+	defer close(publish)
+
+	h := newHub()
+	nodeURI := strings.Replace(server.URL, "http://", "tcp://", 1)
+
+	subscriber1, err := h.subscribe(nodeURI)
+	require.NoError(t, err)
+
+	defer h.unsubscribe(subscriber1)
+
+	subscriber2, err := h.subscribe(nodeURI)
+	require.NoError(t, err)
+
+	defer h.unsubscribe(subscriber2)
+
+	<-subscribed
+
+	// the transactions of one block
+	txs := make([]tmtypes.EventDataTx, subscriberBufferSize/2)
+	for i := range txs {
+		txs[i] = tmtypes.EventDataTx{TxResult: tmtypes.TxResult{
+			Height: 10,
+			Index:  uint32(i),
+			Tx:     tmtypes.Tx{byte(i)},
+			Result: abci.ResponseDeliverTx{},
+		}}
+	}
+
+	// the hub is blocked while the burst is published, so the transactions are buffered by the node client
+	h.mtx.Lock()
+	publish <- txs
+	time.Sleep(100 * time.Millisecond)
+	h.mtx.Unlock()
+
+	require.Equal(t, txs, receiveTxs(t, subscriber1, len(txs)))
+	require.Equal(t, txs, receiveTxs(t, subscriber2, len(txs)))
+}
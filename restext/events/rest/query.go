@@ -0,0 +1,199 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	clientCtx "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/gorilla/websocket"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant"
+)
+
+// The browsers do not apply CORS to the WebSocket handshake, so its origin is checked against the origins allowed
+// to send cross-domain requests (see headers package). The same-origin requests and the requests without
+// the origin (non-browser clients) are always allowed.
+func newUpgrader(allowedOrigins []string) websocket.Upgrader {
+	return websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			if len(origin) == 0 {
+				return true
+			}
+
+			if u, err := url.Parse(origin); err == nil && strings.EqualFold(u.Host, r.Host) {
+				return true
+			}
+
+			for _, allowed := range allowedOrigins {
+				if isOriginAllowed(strings.ToLower(origin), strings.ToLower(allowed)) {
+					return true
+				}
+			}
+
+			return false
+		},
+	}
+}
+
+// The allowed origin can contain one wildcard (e.g. `*` or `https://*.example.com`) like the CORS allowed origins.
+func isOriginAllowed(origin string, allowed string) bool {
+	i := strings.Index(allowed, "*")
+	if i < 0 {
+		return origin == allowed
+	}
+
+	return len(origin) >= len(allowed)-1 &&
+		strings.HasPrefix(origin, allowed[:i]) && strings.HasSuffix(origin, allowed[i+1:])
+}
+
+// SubscribeEventsHandlerFn returns the WebSocket REST handler pushing ledger events to the client.
+// Events can be filtered by `module` (e.g. `modelinfo`) and `action` (message type, e.g. `add_model_info`).
+// All the clients share one subscription to the committed transactions of the node.
+func SubscribeEventsHandlerFn(cliCtx clientCtx.CLIContext, upgrader websocket.Upgrader) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		err := restCtx.Request().ParseForm()
+		if err != nil {
+			restCtx.WriteErrorResponse(http.StatusBadRequest,
+				sdk.AppendMsgToErr("could not parse query parameters", err.Error()))
+
+			return
+		}
+
+		moduleName := r.FormValue(module)
+		actionName := r.FormValue(action)
+
+		// checked before subscribing to the node (the upgrader checks it again)
+		if !upgrader.CheckOrigin(r) {
+			restCtx.WriteErrorResponse(http.StatusForbidden, "Origin not allowed")
+
+			return
+		}
+
+		txs, err := subscriptions.subscribe(cliCtx.NodeURI)
+		if err != nil {
+			restCtx.WriteError(http.StatusInternalServerError, err)
+
+			return
+		}
+
+		defer subscriptions.unsubscribe(txs)
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			// upgrader has already responded with an HTTP error
+			return
+		}
+
+		defer conn.Close()
+
+		// the client is not expected to send anything, reading only detects the closed connection
+		closed := make(chan struct{})
+
+		go func() {
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					close(closed)
+
+					return
+				}
+			}
+		}()
+
+		for {
+			select {
+			case <-closed:
+				return
+			case data, ok := <-txs:
+				// the client is dropped
+				if !ok {
+					return
+				}
+
+				for _, event := range ExtractEvents(cliCtx.Codec, data, moduleName, actionName) {
+					bytes, err := cliCtx.Codec.MarshalJSON(event)
+					if err != nil {
+						continue
+					}
+
+					if err := conn.WriteMessage(websocket.TextMessage, bytes); err != nil {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// ExtractEvents returns the events of the messages of the successful transaction matching the module and the action
+// (any if empty). The messages executed by a grantee are extracted instead of the executing message.
+func ExtractEvents(cdc *codec.Codec, data tmtypes.EventDataTx, moduleName string, actionName string) []Event {
+	var tx auth.StdTx
+
+	// skip failed transactions
+	if data.Result.IsErr() {
+		return nil
+	}
+
+	if err := cdc.UnmarshalBinaryLengthPrefixed(data.Tx, &tx); err != nil {
+		return nil
+	}
+
+	var events []Event
+
+	for _, msg := range unwrapExecutedMsgs(tx.Msgs) {
+		if len(moduleName) > 0 && msg.Route() != moduleName {
+			continue
+		}
+
+		if len(actionName) > 0 && msg.Type() != actionName {
+			continue
+		}
+
+		events = append(events, Event{
+			Height: data.Height,
+			TxHash: fmt.Sprintf("%X", data.Tx.Hash()),
+			Module: msg.Route(),
+			Action: msg.Type(),
+			Msg:    msg,
+		})
+	}
+
+	return events
+}
+
+func unwrapExecutedMsgs(msgs []sdk.Msg) []sdk.Msg {
+	var unwrapped []sdk.Msg
+
+	for _, msg := range msgs {
+		if exec, ok := msg.(grant.MsgExec); ok {
+			unwrapped = append(unwrapped, exec.Msgs...)
+		} else {
+			unwrapped = append(unwrapped, msg)
+		}
+	}
+
+	return unwrapped
+}
@@ -0,0 +1,187 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/graphql"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+)
+
+// Executes GraphQL query over the read model of the ledger (see the schema in `schema.go`).
+// All the values are read at the same height: the requested one (`at_height` or `at_time`)
+// or the latest height of the first value read. The height is returned in `extensions` of the response.
+func GraphQLHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		req, err := graphql.ParseRequest(r)
+		if err != nil {
+			writeResponse(w, http.StatusBadRequest, graphql.ErrorResponse(err))
+
+			return
+		}
+
+		restCtx, err = restCtx.WithRequestedHeight()
+		if err != nil {
+			writeResponse(w, http.StatusBadRequest, graphql.ErrorResponse(err))
+
+			return
+		}
+
+		resolver := &resolver{restCtx: restCtx}
+
+		res, err := newSchema(resolver).Execute(req)
+		if err != nil {
+			writeResponse(w, http.StatusBadRequest, graphql.ErrorResponse(err))
+
+			return
+		}
+
+		if resolver.height != 0 {
+			res.Extensions = map[string]interface{}{"height": resolver.height}
+			w.Header().Set(rest.HeaderHeight, strconv.FormatInt(resolver.height, 10))
+		}
+
+		writeResponse(w, http.StatusOK, res)
+	}
+}
+
+func writeResponse(w http.ResponseWriter, status int, res graphql.Response) {
+	output, err := json.Marshal(res)
+	if err != nil {
+		rest.WriteError(w, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(output)
+}
+
+// Queries the ledger values at the same height.
+type resolver struct {
+	restCtx rest.RestContext
+	height  int64
+}
+
+// Returns the JSON decoded result of the query or nil if the value does not exist
+// (the query fails with the given not found error).
+func (r *resolver) query(path string, data interface{}, notFound ...notFoundError) (interface{}, error) {
+	res, height, err := r.restCtx.QueryWithData(path, data)
+
+	for _, e := range notFound {
+		if isNotFound(err, e.module, e.code) {
+			return nil, nil
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// the rest is read at the same height
+	if r.height == 0 {
+		r.height = height
+		r.restCtx = r.restCtx.WithHeight(height)
+	}
+
+	return graphql.DecodeValue(res)
+}
+
+// Same as query, but returns the items of the list result (empty list if the value does not exist).
+func (r *resolver) queryItems(path string, data interface{}, itemsAttribute string,
+	notFound notFoundError) (interface{}, error) {
+	res, err := r.query(path, data, notFound)
+	if err != nil {
+		return nil, err
+	}
+
+	if items, ok := attribute(res, itemsAttribute).([]interface{}); ok {
+		return items, nil
+	}
+
+	return []interface{}{}, nil
+}
+
+func isNotFound(err error, module string, code sdk.CodeType) bool {
+	if err == nil {
+		return false
+	}
+
+	errorCode, ok := errcodes.Of(err)
+
+	return ok && errorCode.Codespace == sdk.CodespaceType(module) && errorCode.Code == code
+}
+
+// Returns the attribute of the JSON object value (nil if it is missing).
+func attribute(value interface{}, name string) interface{} {
+	if object, ok := value.(map[string]interface{}); ok {
+		return object[name]
+	}
+
+	return nil
+}
+
+// Returns the attributes of the source object identifying the related values (e.g. vid and pid).
+func identifiers(source interface{}, names ...string) ([]interface{}, error) {
+	values := make([]interface{}, 0, len(names))
+
+	for _, name := range names {
+		value := attribute(source, name)
+		if value == nil {
+			return nil, fmt.Errorf("the value has no %q attribute", name)
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// The pagination arguments of the list fields.
+var paginationArgs = map[string]bool{skip: false, take: false, key: false}
+
+func paginationParams(args graphql.Args) (pagination.PaginationParams, error) {
+	skipValue, err := args.Int(skip, 0)
+	if err != nil {
+		return pagination.PaginationParams{}, err
+	}
+
+	takeValue, err := args.Int(take, 0)
+	if err != nil {
+		return pagination.PaginationParams{}, err
+	}
+
+	keyValue, err := args.String(key, "")
+	if err != nil {
+		return pagination.PaginationParams{}, err
+	}
+
+	if skipValue < 0 || takeValue < 0 {
+		return pagination.PaginationParams{}, fmt.Errorf("arguments %q and %q must be non-negative", skip, take)
+	}
+
+	return pagination.PaginationParams{Skip: int(skipValue), Take: int(takeValue), Key: keyValue}, nil
+}
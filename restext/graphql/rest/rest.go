@@ -0,0 +1,49 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+)
+
+// the arguments of the fields.
+const (
+	vid               = "vid"
+	pid               = "pid"
+	certificationType = "certification_type"
+	softwareVersion   = "software_version"
+	latestOnly        = "latest_only"
+	includeArchived   = "include_archived"
+	subject           = "subject"
+	subjectKeyID      = "subject_key_id"
+	address           = "address"
+	skip              = "skip"
+	take              = "take"
+	key               = "key"
+)
+
+const (
+	// the limits of the query protecting the REST server from the queries fanning out to the whole ledger.
+	maxDepth    = 8
+	maxResolves = 500
+)
+
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/graphql", GraphQLHandlerFn(cliCtx)).Methods("GET")
+	// the query does not change the state, so it is served by the read-only REST server
+	rest.MarkQueryRoute(r.HandleFunc("/graphql", GraphQLHandlerFn(cliCtx)).Methods("POST"))
+}
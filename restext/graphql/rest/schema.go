@@ -0,0 +1,423 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/graphql"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliancetest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
+)
+
+// the error of the query returned if the value does not exist.
+type notFoundError struct {
+	module string
+	code   sdk.CodeType
+}
+
+var (
+	modelNotFound       = notFoundError{modelinfo.ModuleName, modelinfo.CodeModelInfoDoesNotExist}
+	complianceNotFound  = notFoundError{compliance.ModuleName, compliance.CodeComplianceInfoDoesNotExist}
+	testResultsNotFound = notFoundError{compliancetest.ModuleName, compliancetest.CodeTestingResultDoesNotExist}
+	certificateNotFound = notFoundError{pki.ModuleName, pki.CodeCertificateDoesNotExist}
+	accountNotFound     = notFoundError{auth.ModuleName, auth.CodeAccountDoesNotExist}
+)
+
+// Builds the schema of the read model. The objects have the attributes of the values returned by the REST API
+// along with the fields joining the related values (e.g. the versions, the compliance and the testing results
+// of the model).
+func newSchema(r *resolver) graphql.Schema {
+	var (
+		model          = &graphql.Object{Name: "Model", Open: true}
+		modelVersion   = &graphql.Object{Name: "ModelVersion", Open: true}
+		complianceInfo = &graphql.Object{Name: "ComplianceInfo", Open: true}
+		testResult     = &graphql.Object{Name: "TestResult", Open: true}
+		certificate    = &graphql.Object{Name: "Certificate", Open: true}
+		account        = &graphql.Object{Name: "Account", Open: true}
+	)
+
+	ownerAccount := &graphql.Field{Type: account, Resolve: r.ownerAccount}
+	sourceModel := &graphql.Field{Type: model, Resolve: r.sourceModel}
+
+	model.Fields = map[string]*graphql.Field{
+		"versions": {Type: modelVersion, Resolve: r.modelVersions},
+		"compliance": {
+			Type: complianceInfo, Args: map[string]bool{certificationType: false}, Resolve: r.modelCompliance,
+		},
+		"test_results":  {Type: testResult, Args: map[string]bool{latestOnly: false}, Resolve: r.sourceTestResults},
+		"owner_account": ownerAccount,
+	}
+
+	modelVersion.Fields = map[string]*graphql.Field{
+		"model":        sourceModel,
+		"test_results": {Type: testResult, Args: map[string]bool{latestOnly: false}, Resolve: r.sourceTestResults},
+	}
+
+	complianceInfo.Fields = map[string]*graphql.Field{
+		"model":         sourceModel,
+		"owner_account": ownerAccount,
+	}
+
+	testResult.Fields = map[string]*graphql.Field{
+		"owner_account": ownerAccount,
+	}
+
+	certificate.Fields = map[string]*graphql.Field{
+		"issuer_certificate": {Type: certificate, Resolve: r.issuerCertificate},
+		"owner_account":      ownerAccount,
+	}
+
+	query := &graphql.Object{
+		Name: "Query",
+		Fields: map[string]*graphql.Field{
+			"model": {Type: model, Args: map[string]bool{vid: true, pid: true}, Resolve: r.model},
+			"models": {
+				Type:    listOf("ModelList", model),
+				Args:    withPaginationArgs(map[string]bool{includeArchived: false}),
+				Resolve: r.models,
+			},
+			"compliance_info": {
+				Type:    complianceInfo,
+				Args:    map[string]bool{vid: true, pid: true, certificationType: true},
+				Resolve: r.complianceInfo,
+			},
+			"test_results": {
+				Type:    testResult,
+				Args:    map[string]bool{vid: true, pid: true, softwareVersion: false, latestOnly: false},
+				Resolve: r.testResults,
+			},
+			"certificate": {
+				Type:    certificate,
+				Args:    map[string]bool{subject: true, subjectKeyID: true},
+				Resolve: r.certificate,
+			},
+			"certificates": {
+				Type:    listOf("CertificateList", certificate),
+				Args:    withPaginationArgs(nil),
+				Resolve: r.certificates,
+			},
+			"account": {Type: account, Args: map[string]bool{address: true}, Resolve: r.account},
+			"accounts": {
+				Type:    listOf("AccountList", account),
+				Args:    withPaginationArgs(nil),
+				Resolve: r.accounts,
+			},
+		},
+	}
+
+	return graphql.Schema{Query: query, MaxDepth: maxDepth, MaxResolves: maxResolves}
+}
+
+// The paginated list of the values (`total`, `items`, `next_key` and `prev_key`).
+func listOf(name string, item *graphql.Object) *graphql.Object {
+	return &graphql.Object{Name: name, Open: true, Fields: map[string]*graphql.Field{"items": {Type: item}}}
+}
+
+func withPaginationArgs(args map[string]bool) map[string]bool {
+	res := map[string]bool{}
+
+	for name, required := range paginationArgs {
+		res[name] = required
+	}
+
+	for name, required := range args {
+		res[name] = required
+	}
+
+	return res
+}
+
+/*
+	Models
+*/
+
+func (r *resolver) model(_ interface{}, args graphql.Args) (interface{}, error) {
+	ids, err := intArgs(args, vid, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queryModel(ids[0], ids[1])
+}
+
+// The model of the source value (model version or compliance info).
+func (r *resolver) sourceModel(source interface{}, _ graphql.Args) (interface{}, error) {
+	ids, err := identifiers(source, vid, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queryModel(ids[0], ids[1])
+}
+
+func (r *resolver) queryModel(vid interface{}, pid interface{}) (interface{}, error) {
+	return r.query(fmt.Sprintf("custom/%s/model/%v/%v", modelinfo.StoreKey, vid, pid), nil, modelNotFound)
+}
+
+func (r *resolver) models(_ interface{}, args graphql.Args) (interface{}, error) {
+	paginationParams, err := paginationParams(args)
+	if err != nil {
+		return nil, err
+	}
+
+	archived, err := args.Bool(includeArchived, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.query(fmt.Sprintf("custom/%s/all_models", modelinfo.StoreKey),
+		modelinfo.NewListModelsQueryParams(paginationParams, archived))
+}
+
+func (r *resolver) modelVersions(source interface{}, _ graphql.Args) (interface{}, error) {
+	ids, err := identifiers(source, vid, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queryItems(fmt.Sprintf("custom/%s/model_versions/%v/%v", modelinfo.StoreKey, ids[0], ids[1]),
+		pagination.NewPaginationParams(0, 0), "items", modelNotFound)
+}
+
+/*
+	Compliance
+*/
+
+func (r *resolver) complianceInfo(_ interface{}, args graphql.Args) (interface{}, error) {
+	ids, err := intArgs(args, vid, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	certification, err := args.String(certificationType, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.query(fmt.Sprintf("custom/%s/compliance_info/%v/%v/%v", compliance.StoreKey, ids[0], ids[1],
+		certification), nil, complianceNotFound)
+}
+
+// The current compliance state of the model for every certification type (or for the given one).
+func (r *resolver) modelCompliance(source interface{}, args graphql.Args) (interface{}, error) {
+	ids, err := identifiers(source, vid, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	certification, err := args.String(certificationType, "")
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.queryItems(fmt.Sprintf("custom/%s/model_compliance_info_records/%v/%v", compliance.StoreKey,
+		ids[0], ids[1]), nil, "items", complianceNotFound)
+	if err != nil || len(certification) == 0 {
+		return res, err
+	}
+
+	items := []interface{}{}
+
+	for _, item := range res.([]interface{}) {
+		if attribute(item, certificationType) == certification {
+			items = append(items, item)
+		}
+	}
+
+	return items, nil
+}
+
+/*
+	Testing results
+*/
+
+func (r *resolver) testResults(_ interface{}, args graphql.Args) (interface{}, error) {
+	ids, err := intArgs(args, vid, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	var version interface{}
+
+	if _, ok := args[softwareVersion]; ok {
+		if version, err = args.Int(softwareVersion, 0); err != nil {
+			return nil, err
+		}
+	}
+
+	return r.queryTestResults(args, ids[0], ids[1], version)
+}
+
+// The testing results of the source model or model version.
+func (r *resolver) sourceTestResults(source interface{}, args graphql.Args) (interface{}, error) {
+	ids, err := identifiers(source, vid, pid)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queryTestResults(args, ids[0], ids[1], attribute(source, softwareVersion))
+}
+
+// Returns the testing results of the model (of the software version if it is not nil).
+func (r *resolver) queryTestResults(args graphql.Args, vid interface{}, pid interface{},
+	version interface{}) (interface{}, error) {
+	latest, err := args.Bool(latestOnly, false)
+	if err != nil {
+		return nil, err
+	}
+
+	query := "testresult"
+	if latest {
+		query = "latest_testresults"
+	}
+
+	path := fmt.Sprintf("custom/%s/%s/%v/%v", compliancetest.StoreKey, query, vid, pid)
+	if version != nil {
+		path = fmt.Sprintf("%s/%v", path, version)
+	}
+
+	return r.queryItems(path, compliancetest.NewTestCaseFilter("", ""), "results", testResultsNotFound)
+}
+
+/*
+	Certificates
+*/
+
+func (r *resolver) certificate(_ interface{}, args graphql.Args) (interface{}, error) {
+	subjectValue, err := args.String(subject, "")
+	if err != nil {
+		return nil, err
+	}
+
+	subjectKeyIDValue, err := args.String(subjectKeyID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queryCertificate(subjectValue, subjectKeyIDValue)
+}
+
+// The certificate the source certificate is issued by (null for the root certificates).
+func (r *resolver) issuerCertificate(source interface{}, _ graphql.Args) (interface{}, error) {
+	if isRoot, _ := attribute(source, "is_root").(bool); isRoot {
+		return nil, nil
+	}
+
+	ids, err := identifiers(source, "issuer", "authority_key_id")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queryCertificate(ids[0], ids[1])
+}
+
+// Returns the latest certificate with the subject and the subject key ID.
+func (r *resolver) queryCertificate(subject interface{}, subjectKeyID interface{}) (interface{}, error) {
+	items, err := r.queryItems(fmt.Sprintf("custom/%s/x509_cert/%v/%v", pki.StoreKey, subject, subjectKeyID),
+		nil, "items", certificateNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	if certificates := items.([]interface{}); len(certificates) != 0 {
+		return certificates[len(certificates)-1], nil
+	}
+
+	return nil, nil
+}
+
+func (r *resolver) certificates(_ interface{}, args graphql.Args) (interface{}, error) {
+	paginationParams, err := paginationParams(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.query(fmt.Sprintf("custom/%s/all_x509_certs", pki.StoreKey),
+		pki.NewPkiQueryParams(paginationParams, "", ""))
+}
+
+/*
+	Accounts
+*/
+
+func (r *resolver) account(_ interface{}, args graphql.Args) (interface{}, error) {
+	addressValue, err := args.String(address, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queryAccount(addressValue)
+}
+
+// The account of the owner of the source value.
+func (r *resolver) ownerAccount(source interface{}, _ graphql.Args) (interface{}, error) {
+	owner, _ := attribute(source, "owner").(string)
+	if len(owner) == 0 {
+		return nil, nil
+	}
+
+	return r.queryAccount(owner)
+}
+
+func (r *resolver) queryAccount(addressValue string) (interface{}, error) {
+	accAddress, err := sdk.AccAddressFromBech32(addressValue)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address %q: %v", addressValue, err)
+	}
+
+	res, err := r.query(fmt.Sprintf("custom/%s/account", auth.StoreKey), auth.NewQueryAccountParams(accAddress),
+		accountNotFound)
+	if err != nil {
+		return nil, err
+	}
+
+	// the account is returned as amino registered type: `{"type": "cosmos-sdk/Account", "value": {...}}`
+	if value := attribute(res, "value"); value != nil {
+		return value, nil
+	}
+
+	return res, nil
+}
+
+func (r *resolver) accounts(_ interface{}, args graphql.Args) (interface{}, error) {
+	paginationParams, err := paginationParams(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.query(fmt.Sprintf("custom/%s/all_accounts", auth.StoreKey), paginationParams)
+}
+
+// Returns the values of the required integer arguments.
+func intArgs(args graphql.Args, names ...string) ([]interface{}, error) {
+	values := make([]interface{}, 0, len(names))
+
+	for _, name := range names {
+		value, err := args.Int(name, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
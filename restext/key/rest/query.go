@@ -31,21 +31,21 @@ func KeysHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		kb, err := keys.NewKeyBaseFromHomeFlag()
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
 
 		infos, err := kb.List()
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
 
 		outputs, err := keys2.Bech32KeysOutput(infos)
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
@@ -69,21 +69,21 @@ func KeyHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		kb, err := keys.NewKeyBaseFromHomeFlag()
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
 
 		keyInfo, err := kb.Get(keyName)
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
 
 		keyOutput, err := keys2.Bech32KeyOutput(keyInfo)
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
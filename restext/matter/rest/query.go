@@ -0,0 +1,346 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+)
+
+// The gRPC status codes returned by the Matter DCL REST API.
+const (
+	codeInvalidArgument = 3
+	codeNotFound        = 5
+	codeInternal        = 13
+)
+
+// The errors of the values missing on the ledger.
+var notFoundErrors = []struct {
+	codespace string
+	code      sdk.CodeType
+}{
+	{modelinfo.ModuleName, modelinfo.CodeModelInfoDoesNotExist},
+	{modelinfo.ModuleName, modelinfo.CodeVendorProductsDoNotExist},
+	{modelinfo.ModuleName, modelinfo.CodeModelVersionDoesNotExist},
+	{compliance.ModuleName, compliance.CodeComplianceInfoDoesNotExist},
+}
+
+func ModelHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vid, pid, err := parseModelID(restCtx.Variables())
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		modelInfo, height, err := queryModel(restCtx, vid, pid)
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		writeResponse(w, ModelResponse{Model: NewModel(modelInfo)}, height)
+	}
+}
+
+func VendorProductsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vid, err_ := conversions.ParseVID(restCtx.Variables()[vid])
+		if err_ != nil {
+			writeError(w, err_)
+
+			return
+		}
+
+		res, height, err := restCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/vendor_models/%v", modelinfo.StoreKey, vid), nil)
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		var vendorProducts modelinfo.VendorProducts
+
+		restCtx.Codec().MustUnmarshalJSON(res, &vendorProducts)
+
+		writeResponse(w, VendorProductsResponse{VendorProducts: NewVendorProducts(vendorProducts)}, height)
+	}
+}
+
+func ModelVersionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vid, pid, err := parseModelID(restCtx.Variables())
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		res, height, err := restCtx.QueryWithData(
+			fmt.Sprintf("custom/%s/model_versions/%v/%v", modelinfo.StoreKey, vid, pid),
+			pagination.NewPaginationParams(0, 0))
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		var list modelinfo.ListModelVersions
+
+		restCtx.Codec().MustUnmarshalJSON(res, &list)
+
+		// the Matter DCL returns "not found" for the models without versions
+		if len(list.Items) == 0 {
+			writeError(w, modelinfo.ErrModelVersionDoesNotExist(vid, pid, "any"))
+
+			return
+		}
+
+		versions := ModelVersions{VID: vid, PID: pid, SoftwareVersions: []uint32{}}
+		for _, version := range list.Items {
+			versions.SoftwareVersions = append(versions.SoftwareVersions, version.SoftwareVersion)
+		}
+
+		writeResponse(w, ModelVersionsResponse{ModelVersions: versions}, height)
+	}
+}
+
+func ModelVersionHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		vid, pid, err := parseModelID(vars)
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		softwareVersion, err := conversions.ParseUInt32FromString(vars[softwareVersion])
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		modelVersion, height, err := queryModelVersion(restCtx, vid, pid, softwareVersion)
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		writeResponse(w, ModelVersionResponse{ModelVersion: NewModelVersion(modelVersion)}, height)
+	}
+}
+
+func ComplianceInfoHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return complianceHandlerFn(cliCtx, func(info ComplianceInfo) interface{} {
+		return ComplianceInfoResponse{ComplianceInfo: info}
+	})
+}
+
+func CertifiedModelHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return complianceHandlerFn(cliCtx, func(info ComplianceInfo) interface{} {
+		return CertifiedModelResponse{CertifiedModel: newModelInState(info, certificationStatusCertified)}
+	})
+}
+
+func RevokedModelHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return complianceHandlerFn(cliCtx, func(info ComplianceInfo) interface{} {
+		return RevokedModelResponse{RevokedModel: newModelInState(info, certificationStatusRevoked)}
+	})
+}
+
+func ProvisionalModelHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return complianceHandlerFn(cliCtx, func(info ComplianceInfo) interface{} {
+		return ProvisionalModelResponse{ProvisionalModel: newModelInState(info, certificationStatusProvisional)}
+	})
+}
+
+func newModelInState(info ComplianceInfo, status uint32) ModelInState {
+	return ModelInState{
+		VID:               info.VID,
+		PID:               info.PID,
+		SoftwareVersion:   info.SoftwareVersion,
+		CertificationType: info.CertificationType,
+		Value:             info.SoftwareVersionCertificationStatus == status,
+	}
+}
+
+// Returns the compliance info of the model version (which must be present on the ledger) in the form
+// built by the response function.
+func complianceHandlerFn(cliCtx context.CLIContext, response func(info ComplianceInfo) interface{}) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		vid, pid, err := parseModelID(vars)
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		softwareVersion, err := conversions.ParseUInt32FromString(vars[softwareVersion])
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		// the model version is read at the latest height, the compliance info is read at the same height
+		modelVersion, height, err := queryModelVersion(restCtx, vid, pid, softwareVersion)
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		res, _, err := restCtx.WithHeight(height).QueryWithData(fmt.Sprintf("custom/%s/compliance_info/%v/%v/%v",
+			compliance.StoreKey, vid, pid, vars[certificationType]), nil)
+		if err != nil {
+			writeError(w, err)
+
+			return
+		}
+
+		var complianceInfo compliance.ComplianceInfo
+
+		restCtx.Codec().MustUnmarshalJSON(res, &complianceInfo)
+
+		writeResponse(w, response(NewComplianceInfo(complianceInfo, modelVersion)), height)
+	}
+}
+
+func parseModelID(vars map[string]string) (uint16, uint16, error) {
+	vid, err := conversions.ParseVID(vars[vid])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	pid, err := conversions.ParsePID(vars[pid])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return vid, pid, nil
+}
+
+func queryModel(restCtx rest.RestContext, vid uint16, pid uint16) (modelinfo.ModelInfo, int64, error) {
+	res, height, err := restCtx.QueryWithData(fmt.Sprintf("custom/%s/model/%v/%v", modelinfo.StoreKey, vid, pid), nil)
+	if err != nil {
+		return modelinfo.ModelInfo{}, 0, err
+	}
+
+	var modelInfo modelinfo.ModelInfo
+
+	restCtx.Codec().MustUnmarshalJSON(res, &modelInfo)
+
+	return modelInfo, height, nil
+}
+
+func queryModelVersion(restCtx rest.RestContext, vid uint16, pid uint16,
+	softwareVersion uint32) (modelinfo.ModelVersion, int64, error) {
+	res, height, err := restCtx.QueryStore(modelinfo.GetModelVersionKey(vid, pid, softwareVersion), modelinfo.StoreKey)
+	if err != nil {
+		return modelinfo.ModelVersion{}, 0, err
+	}
+
+	if res == nil {
+		return modelinfo.ModelVersion{}, 0, modelinfo.ErrModelVersionDoesNotExist(vid, pid, softwareVersion)
+	}
+
+	var modelVersion modelinfo.ModelVersion
+
+	restCtx.Codec().MustUnmarshalBinaryBare(res, &modelVersion)
+
+	return modelVersion, height, nil
+}
+
+func writeResponse(w http.ResponseWriter, response interface{}, height int64) {
+	output, err := json.Marshal(response)
+	if err != nil {
+		writeError(w, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set(rest.HeaderHeight, strconv.FormatInt(height, 10))
+	_, _ = w.Write(output)
+}
+
+// Writes the error in the format of the Matter DCL REST API.
+func writeError(w http.ResponseWriter, err error) {
+	status, code := http.StatusInternalServerError, codeInternal
+
+	if errorCode, ok := errcodes.Of(err); ok {
+		status, code = http.StatusBadRequest, codeInvalidArgument
+
+		for _, notFound := range notFoundErrors {
+			if errorCode.Codespace == sdk.CodespaceType(notFound.codespace) && errorCode.Code == notFound.code {
+				status, code = http.StatusNotFound, codeNotFound
+			}
+		}
+	}
+
+	output, _ := json.Marshal(ErrorResponse{Code: code, Message: errorMessage(err), Details: []interface{}{}})
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(output)
+}
+
+// Returns the message of the error without the codes (passed in the response code).
+func errorMessage(err error) string {
+	log := err.Error()
+	if sdkErr, ok := err.(sdk.Error); ok {
+		log = sdkErr.ABCILog()
+	}
+
+	var parsed struct {
+		Message string `json:"message"`
+	}
+
+	if json.Unmarshal([]byte(log), &parsed) == nil && len(parsed.Message) != 0 {
+		return parsed.Message
+	}
+
+	return err.Error()
+}
@@ -0,0 +1,66 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	FlagMatterCompat      = "matter-compat"
+	FlagMatterCompatUsage = "Serve the queries of the Matter DCL REST API (`/dcl/...`) " +
+		"for the devices and tools built for the Matter ecosystem"
+	vid               = "vid"
+	pid               = "pid"
+	softwareVersion   = "softwareVersion"
+	certificationType = "certificationType"
+)
+
+// Adds the Matter compatibility flag to the REST server command.
+func AddFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().Bool(FlagMatterCompat, false, FlagMatterCompatUsage)
+
+	return cmd
+}
+
+// Registers the routes of the Matter DCL REST API if the compatibility mode is enabled.
+// The routes return the ledger values in the Matter DCL schema (see `types.go`).
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	if !viper.GetBool(FlagMatterCompat) {
+		return
+	}
+
+	r.HandleFunc(fmt.Sprintf("/dcl/model/models/{%s}", vid),
+		VendorProductsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/dcl/model/models/{%s}/{%s}", vid, pid),
+		ModelHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/dcl/model/versions/{%s}/{%s}", vid, pid),
+		ModelVersionsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/dcl/model/versions/{%s}/{%s}/{%s}", vid, pid, softwareVersion),
+		ModelVersionHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/dcl/compliance/compliance-info/{%s}/{%s}/{%s}/{%s}",
+		vid, pid, softwareVersion, certificationType), ComplianceInfoHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/dcl/compliance/certified-models/{%s}/{%s}/{%s}/{%s}",
+		vid, pid, softwareVersion, certificationType), CertifiedModelHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/dcl/compliance/revoked-models/{%s}/{%s}/{%s}/{%s}",
+		vid, pid, softwareVersion, certificationType), RevokedModelHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/dcl/compliance/provisional-models/{%s}/{%s}/{%s}/{%s}",
+		vid, pid, softwareVersion, certificationType), ProvisionalModelHandlerFn(cliCtx)).Methods("GET")
+}
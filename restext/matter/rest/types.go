@@ -0,0 +1,246 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"time"
+
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+)
+
+// The certification statuses of the Matter DCL schema.
+const (
+	certificationStatusProvisional = 1
+	certificationStatusCertified   = 2
+	certificationStatusRevoked     = 3
+)
+
+// Model in the Matter DCL schema. The models without the Matter fields have the product label
+// and the part number taken from the description and the SKU.
+type Model struct {
+	VID                                        uint16 `json:"vid"`
+	PID                                        uint16 `json:"pid"`
+	DeviceTypeID                               uint32 `json:"deviceTypeId"`
+	ProductName                                string `json:"productName"`
+	ProductLabel                               string `json:"productLabel"`
+	PartNumber                                 string `json:"partNumber"`
+	CommissioningCustomFlow                    uint8  `json:"commissioningCustomFlow"`
+	CommissioningCustomFlowURL                 string `json:"commissioningCustomFlowUrl"`
+	CommissioningModeInitialStepsHint          uint32 `json:"commissioningModeInitialStepsHint"`
+	CommissioningModeInitialStepsInstruction   string `json:"commissioningModeInitialStepsInstruction"`
+	CommissioningModeSecondaryStepsHint        uint32 `json:"commissioningModeSecondaryStepsHint"`
+	CommissioningModeSecondaryStepsInstruction string `json:"commissioningModeSecondaryStepsInstruction"`
+	UserManualURL                              string `json:"userManualUrl"`
+	SupportURL                                 string `json:"supportUrl"`
+	ProductURL                                 string `json:"productUrl"`
+	Creator                                    string `json:"creator"`
+}
+
+func NewModel(modelInfo modelinfo.ModelInfo) Model {
+	model := Model{
+		VID:          modelInfo.VID,
+		PID:          modelInfo.PID,
+		ProductName:  modelInfo.Name,
+		ProductLabel: modelInfo.Description,
+		PartNumber:   modelInfo.SKU,
+		Creator:      modelInfo.Owner.String(),
+	}
+
+	if matter := modelInfo.Matter; matter != nil {
+		model.DeviceTypeID = matter.DeviceTypeID
+		model.CommissioningCustomFlow = matter.CommissioningCustomFlow
+		model.CommissioningCustomFlowURL = matter.CommissioningCustomFlowURL
+		model.CommissioningModeInitialStepsHint = matter.CommissioningModeInitialStepsHint
+		model.CommissioningModeInitialStepsInstruction = matter.CommissioningModeInitialStepsInstruction
+		model.CommissioningModeSecondaryStepsHint = matter.CommissioningModeSecondaryStepsHint
+		model.CommissioningModeSecondaryStepsInstruction = matter.CommissioningModeSecondaryStepsInstruction
+		model.UserManualURL = matter.UserManualURL
+		model.SupportURL = matter.SupportURL
+		model.ProductURL = matter.ProductURL
+
+		if len(matter.ProductLabel) != 0 {
+			model.ProductLabel = matter.ProductLabel
+		}
+
+		if len(matter.PartNumber) != 0 {
+			model.PartNumber = matter.PartNumber
+		}
+	}
+
+	return model
+}
+
+type ModelResponse struct {
+	Model Model `json:"model"`
+}
+
+type Product struct {
+	PID        uint16 `json:"pid"`
+	Name       string `json:"name"`
+	PartNumber string `json:"partNumber"`
+}
+
+type VendorProducts struct {
+	VID      uint16    `json:"vid"`
+	Products []Product `json:"products"`
+}
+
+func NewVendorProducts(vendorProducts modelinfo.VendorProducts) VendorProducts {
+	result := VendorProducts{VID: vendorProducts.VID, Products: []Product{}}
+
+	for _, product := range vendorProducts.Products {
+		result.Products = append(result.Products,
+			Product{PID: product.PID, Name: product.Name, PartNumber: product.SKU})
+	}
+
+	return result
+}
+
+type VendorProductsResponse struct {
+	VendorProducts VendorProducts `json:"vendorProducts"`
+}
+
+// Model version in the Matter DCL schema. Only the valid versions are stored on the ledger.
+type ModelVersion struct {
+	VID                          uint16 `json:"vid"`
+	PID                          uint16 `json:"pid"`
+	SoftwareVersion              uint32 `json:"softwareVersion"`
+	SoftwareVersionString        string `json:"softwareVersionString"`
+	FirmwareInformation          string `json:"firmwareInformation"`
+	SoftwareVersionValid         bool   `json:"softwareVersionValid"`
+	MinApplicableSoftwareVersion uint32 `json:"minApplicableSoftwareVersion"`
+	MaxApplicableSoftwareVersion uint32 `json:"maxApplicableSoftwareVersion"`
+	ReleaseNotesURL              string `json:"releaseNotesUrl"`
+}
+
+func NewModelVersion(modelVersion modelinfo.ModelVersion) ModelVersion {
+	return ModelVersion{
+		VID:                          modelVersion.VID,
+		PID:                          modelVersion.PID,
+		SoftwareVersion:              modelVersion.SoftwareVersion,
+		SoftwareVersionString:        modelVersion.SoftwareVersionString,
+		FirmwareInformation:          modelVersion.FirmwareDigest,
+		SoftwareVersionValid:         true,
+		MinApplicableSoftwareVersion: modelVersion.MinApplicableSoftwareVersion,
+		MaxApplicableSoftwareVersion: modelVersion.MaxApplicableSoftwareVersion,
+		ReleaseNotesURL:              modelVersion.ReleaseNotesURL,
+	}
+}
+
+type ModelVersionResponse struct {
+	ModelVersion ModelVersion `json:"modelVersion"`
+}
+
+type ModelVersions struct {
+	VID              uint16   `json:"vid"`
+	PID              uint16   `json:"pid"`
+	SoftwareVersions []uint32 `json:"softwareVersions"`
+}
+
+type ModelVersionsResponse struct {
+	ModelVersions ModelVersions `json:"modelVersions"`
+}
+
+type ComplianceHistoryItem struct {
+	SoftwareVersionCertificationStatus uint32    `json:"softwareVersionCertificationStatus"`
+	Date                               time.Time `json:"date"`
+	Reason                             string    `json:"reason"`
+}
+
+// Compliance info in the Matter DCL schema. The compliance is tracked per model on this ledger,
+// so the compliance info of a model version is the compliance info of the model.
+type ComplianceInfo struct {
+	VID                                uint16                  `json:"vid"`
+	PID                                uint16                  `json:"pid"`
+	SoftwareVersion                    uint32                  `json:"softwareVersion"`
+	CertificationType                  string                  `json:"certificationType"`
+	SoftwareVersionString              string                  `json:"softwareVersionString"`
+	SoftwareVersionCertificationStatus uint32                  `json:"softwareVersionCertificationStatus"`
+	Date                               time.Time               `json:"date"`
+	Reason                             string                  `json:"reason"`
+	Owner                              string                  `json:"owner"`
+	History                            []ComplianceHistoryItem `json:"history"`
+	CDCertificateID                    string                  `json:"cDCertificateId"`
+}
+
+func NewComplianceInfo(complianceInfo compliance.ComplianceInfo, modelVersion modelinfo.ModelVersion) ComplianceInfo {
+	result := ComplianceInfo{
+		VID:                                complianceInfo.VID,
+		PID:                                complianceInfo.PID,
+		SoftwareVersion:                    modelVersion.SoftwareVersion,
+		CertificationType:                  string(complianceInfo.CertificationType),
+		SoftwareVersionString:              modelVersion.SoftwareVersionString,
+		SoftwareVersionCertificationStatus: certificationStatus(complianceInfo.State),
+		Date:                               complianceInfo.Date,
+		Reason:                             complianceInfo.Reason,
+		Owner:                              complianceInfo.Owner.String(),
+		History:                            []ComplianceHistoryItem{},
+		CDCertificateID:                    complianceInfo.CDCertificateID,
+	}
+
+	for _, item := range complianceInfo.History {
+		result.History = append(result.History, ComplianceHistoryItem{
+			SoftwareVersionCertificationStatus: certificationStatus(item.State),
+			Date:                               item.Date,
+			Reason:                             item.Reason,
+		})
+	}
+
+	return result
+}
+
+func certificationStatus(state compliance.ComplianceState) uint32 {
+	switch state {
+	case compliance.CertifiedState:
+		return certificationStatusCertified
+	case compliance.ProvisionalState:
+		return certificationStatusProvisional
+	default:
+		return certificationStatusRevoked
+	}
+}
+
+type ComplianceInfoResponse struct {
+	ComplianceInfo ComplianceInfo `json:"complianceInfo"`
+}
+
+// Whether the model version is in the state (certified or revoked).
+type ModelInState struct {
+	VID               uint16 `json:"vid"`
+	PID               uint16 `json:"pid"`
+	SoftwareVersion   uint32 `json:"softwareVersion"`
+	CertificationType string `json:"certificationType"`
+	Value             bool   `json:"value"`
+}
+
+type CertifiedModelResponse struct {
+	CertifiedModel ModelInState `json:"certifiedModel"`
+}
+
+type RevokedModelResponse struct {
+	RevokedModel ModelInState `json:"revokedModel"`
+}
+
+type ProvisionalModelResponse struct {
+	ProvisionalModel ModelInState `json:"provisionalModel"`
+}
+
+// Error in the format of the Matter DCL REST API (gRPC status).
+type ErrorResponse struct {
+	Code    int           `json:"code"`
+	Message string        `json:"message"`
+	Details []interface{} `json:"details"`
+}
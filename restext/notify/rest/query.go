@@ -0,0 +1,53 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+)
+
+// Reports whether the model is not certified yet (has no compliance info in certified state).
+// The model is considered awaiting certification if its compliance can not be queried,
+// so the notification is rather delivered than lost.
+func awaitingCertification(cliCtx context.CLIContext, logger log.Logger, vid uint16, pid uint16) bool {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/model_compliance_info_records/%v/%v", compliance.StoreKey, vid, pid), nil)
+	if err != nil {
+		if code, ok := errcodes.Of(err); !ok || code.Codespace != sdk.CodespaceType(compliance.ModuleName) ||
+			code.Code != compliance.CodeComplianceInfoDoesNotExist {
+			logger.Error("Failed to query the model compliance", "vid", vid, "pid", pid, "err", err)
+		}
+
+		return true
+	}
+
+	var complianceInfos compliance.ListComplianceInfoItems
+
+	cliCtx.Codec.MustUnmarshalJSON(res, &complianceInfos)
+
+	for _, complianceInfo := range complianceInfos.Items {
+		if complianceInfo.State == compliance.CertifiedState {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,81 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+	webhookrest "github.com/zigbee-alliance/distributed-compliance-ledger/restext/webhook/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/notify"
+)
+
+const (
+	FlagConfig      = "notifications-config"
+	FlagConfigUsage = "JSON file with the notifiers (plugin, its config and event filters) notified about " +
+		"the ledger events. The notifications are disabled if empty"
+	FlagRetries         = "notifications-retries"
+	FlagRetriesUsage    = "Number of retries of a failed notification"
+	FlagRetryDelay      = "notifications-retry-delay"
+	FlagRetryDelayUsage = "Delay before the first retry of a failed notification (doubled after every retry)"
+	defaultRetries      = 5
+	defaultRetryDelay   = time.Second
+	subscriber          = "notifications"
+)
+
+// Adds the notification flags to the REST server command.
+func AddFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().String(FlagConfig, "", FlagConfigUsage)
+	cmd.Flags().Int(FlagRetries, defaultRetries, FlagRetriesUsage)
+	cmd.Flags().Duration(FlagRetryDelay, defaultRetryDelay, FlagRetryDelayUsage)
+
+	return cmd
+}
+
+// Starts delivering the notifications about the events of the committed transactions through the notification
+// plugins if they are configured (`--notifications-config`).
+func Enable(cliCtx context.CLIContext) {
+	path := viper.GetString(FlagConfig)
+	if len(path) == 0 {
+		return
+	}
+
+	notifiers, err := notify.LoadNotifiers(path)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to load the notifiers: %v", err))
+	}
+
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", subscriber)
+
+	dispatcher, err := notify.New(notify.Config{
+		Notifiers:  notifiers,
+		MaxRetries: viper.GetInt(FlagRetries),
+		RetryDelay: viper.GetDuration(FlagRetryDelay),
+		AwaitingCertification: func(vid uint16, pid uint16) bool {
+			return awaitingCertification(cliCtx, logger, vid, pid)
+		},
+		Logger: logger,
+	})
+	if err != nil {
+		panic(fmt.Sprintf("Failed to create the notifiers: %v", err))
+	}
+
+	go webhookrest.Listen(cliCtx, subscriber, dispatcher.Dispatch)
+}
@@ -0,0 +1,220 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
+	"golang.org/x/crypto/ocsp"
+)
+
+// Answers the OCSP request with the status of the certificate on the ledger: good if it is approved (or has expired
+// without being revoked), revoked if it has been revoked, unknown otherwise.
+// The issuer must be an approved certificate on the ledger, the requests for other issuers are answered
+// with the unauthorized error.
+func OCSPHandlerFn(cliCtx context.CLIContext, responder Responder) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		der, err := readRequest(r, restCtx.Variables())
+		if err != nil {
+			writeResponse(w, ocsp.MalformedRequestErrorResponse)
+
+			return
+		}
+
+		req, err := ocsp.ParseRequest(der)
+		if err != nil {
+			writeResponse(w, ocsp.MalformedRequestErrorResponse)
+
+			return
+		}
+
+		issuer, height, err := queryIssuer(restCtx, req)
+		if err != nil {
+			writeResponse(w, ocsp.InternalErrorErrorResponse)
+
+			return
+		}
+
+		if issuer == nil {
+			writeResponse(w, ocsp.UnauthorizedErrorResponse)
+
+			return
+		}
+
+		// the status is read at the same height as the issuer
+		status, err := queryStatus(restCtx.WithHeight(height), issuer.Subject, req.SerialNumber.String())
+		if err != nil {
+			writeResponse(w, ocsp.InternalErrorErrorResponse)
+
+			return
+		}
+
+		template := ocsp.Response{
+			Status:       ocsp.Unknown,
+			SerialNumber: req.SerialNumber,
+			ThisUpdate:   time.Now().UTC(),
+			IssuerHash:   req.HashAlgorithm,
+		}
+
+		switch status.Status {
+		case pki.CertificateStatusGood, pki.CertificateStatusExpired:
+			template.Status = ocsp.Good
+		case pki.CertificateStatusRevoked:
+			template.Status = ocsp.Revoked
+			template.RevocationReason = ocsp.Unspecified
+			template.RevokedAt = revocationTime(*status.Certificate)
+		}
+
+		res, err := ocsp.CreateResponse(issuer.Certificate, responder.Certificate, template, responder.Signer)
+		if err != nil {
+			writeResponse(w, ocsp.InternalErrorErrorResponse)
+
+			return
+		}
+
+		writeResponse(w, res)
+	}
+}
+
+// Reads the DER encoded OCSP request either from the body (POST) or from the path (GET) of the HTTP request.
+// The request in the path is base64 encoded and can be URL encoded (the router matches the unescaped path).
+func readRequest(r *http.Request, vars map[string]string) ([]byte, error) {
+	if r.Method == http.MethodGet {
+		return base64.StdEncoding.DecodeString(vars[request])
+	}
+
+	return ioutil.ReadAll(io.LimitReader(r.Body, maxRequestSize))
+}
+
+// Returns the approved certificate identified by the issuer name and key hashes of the request
+// or nil if there is no such certificate on the ledger.
+// The issuer is looked up by its Subject Key ID, so the key hash of the request must be computed with SHA-1
+// and the Subject Key ID of the issuer must be the SHA-1 hash of its public key (the common method of RFC 5280).
+func queryIssuer(restCtx rest.RestContext, req *ocsp.Request) (*pki.X509Certificate, int64, error) {
+	subjectKeyID := pki.BytesToHex(req.IssuerKeyHash)
+
+	res, height, err := restCtx.QueryStore(pki.GetSubjectKeyIDCertificatesKey(subjectKeyID), pki.StoreKey)
+	if err != nil || res == nil {
+		return nil, height, err
+	}
+
+	var subjectKeyIDCertificates pki.SubjectKeyIDCertificates
+
+	restCtx.Codec().MustUnmarshalBinaryBare(res, &subjectKeyIDCertificates)
+
+	restCtx = restCtx.WithHeight(height)
+
+	for _, identifier := range subjectKeyIDCertificates.CertIdentifiers {
+		res, _, err := restCtx.QueryStore(
+			pki.GetApprovedCertificateKey(identifier.Subject, identifier.SubjectKeyID), pki.StoreKey)
+		if err != nil {
+			return nil, height, err
+		}
+
+		if res == nil {
+			continue
+		}
+
+		var certificates pki.Certificates
+
+		restCtx.Codec().MustUnmarshalBinaryBare(res, &certificates)
+
+		for _, certificate := range certificates.Items {
+			x509Certificate, err := pki.DecodeX509Certificate(certificate.PemCert)
+			if err != nil {
+				continue
+			}
+
+			if matchesIssuer(x509Certificate.Certificate, req) {
+				return x509Certificate, height, nil
+			}
+		}
+	}
+
+	return nil, height, nil
+}
+
+func matchesIssuer(certificate *x509.Certificate, req *ocsp.Request) bool {
+	if !req.HashAlgorithm.Available() {
+		return false
+	}
+
+	var publicKeyInfo struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+
+	if _, err := asn1.Unmarshal(certificate.RawSubjectPublicKeyInfo, &publicKeyInfo); err != nil {
+		return false
+	}
+
+	nameHash := req.HashAlgorithm.New()
+	nameHash.Write(certificate.RawSubject)
+
+	keyHash := req.HashAlgorithm.New()
+	keyHash.Write(publicKeyInfo.PublicKey.RightAlign())
+
+	return bytes.Equal(nameHash.Sum(nil), req.IssuerNameHash) && bytes.Equal(keyHash.Sum(nil), req.IssuerKeyHash)
+}
+
+func queryStatus(restCtx rest.RestContext, issuer string, serialNumber string) (pki.CertificateStatus, error) {
+	path := fmt.Sprintf("custom/%s/%s/%s/%s", pki.StoreKey, pki.QueryX509CertStatus, issuer, serialNumber)
+
+	var status pki.CertificateStatus
+
+	res, _, err := restCtx.QueryWithData(path, nil)
+	if err != nil {
+		return status, err
+	}
+
+	restCtx.Codec().MustUnmarshalJSON(res, &status)
+
+	return status, nil
+}
+
+// Returns the time the certificate was revoked at. The certificates revoked before the revocation time was tracked
+// are reported as revoked since the start of their validity period.
+func revocationTime(certificate pki.Certificate) time.Time {
+	if certificate.RevokedAt != nil {
+		return *certificate.RevokedAt
+	}
+
+	x509Certificate, err := pki.DecodeX509Certificate(certificate.PemCert)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return x509Certificate.Certificate.NotBefore
+}
+
+func writeResponse(w http.ResponseWriter, body []byte) {
+	w.Header().Set("Content-Type", "application/ocsp-response")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
@@ -0,0 +1,80 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+)
+
+const (
+	FlagResponderCert      = "ocsp-responder-cert"
+	FlagResponderCertUsage = "PEM file with the certificate of the OCSP responder. " +
+		"The OCSP responder is disabled if empty"
+	FlagResponderKey      = "ocsp-responder-key"
+	FlagResponderKeyUsage = "PEM file with the private key (PKCS#1, PKCS#8 or SEC 1) signing the OCSP responses"
+	request               = "request"
+	maxRequestSize        = 10 * 1024
+)
+
+type Config struct {
+	ResponderCertFile string
+	ResponderKeyFile  string
+}
+
+func (c Config) Enabled() bool {
+	return len(c.ResponderCertFile) != 0
+}
+
+// Adds the OCSP responder flags to the REST server command.
+func AddFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().String(FlagResponderCert, "", FlagResponderCertUsage)
+	cmd.Flags().String(FlagResponderKey, "", FlagResponderKeyUsage)
+
+	return cmd
+}
+
+func ConfigFromFlags() Config {
+	return Config{
+		ResponderCertFile: viper.GetString(FlagResponderCert),
+		ResponderKeyFile:  viper.GetString(FlagResponderKey),
+	}
+}
+
+// Registers the OCSP responder routes (RFC 6960) if the responder is configured.
+// The requests are accepted both in the body of POST requests and base64 encoded in the path of GET requests.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	config := ConfigFromFlags()
+	if !config.Enabled() {
+		return
+	}
+
+	responder, err := LoadResponder(config)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to load the OCSP responder: %v", err))
+	}
+
+	// the OCSP requests sent with POST method are queries, so they are served by the read-only REST server too
+	rest.MarkQueryRoute(r.HandleFunc("/ocsp", OCSPHandlerFn(cliCtx, responder)).Methods("POST"))
+
+	// the base64 encoded requests can contain "//", so the path must not be cleaned (collapsing it) by the router
+	r.SkipClean(true)
+	r.HandleFunc(fmt.Sprintf("/ocsp/{%s:.+}", request), OCSPHandlerFn(cliCtx, responder)).Methods("GET")
+}
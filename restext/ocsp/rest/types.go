@@ -0,0 +1,88 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+)
+
+// The certificate and the private key signing the OCSP responses.
+// The responder signs the responses for all the certificates on the ledger,
+// so its certificate must be trusted by the clients (see "Trusted Responder" in RFC 6960).
+type Responder struct {
+	Certificate *x509.Certificate
+	Signer      crypto.Signer
+}
+
+func LoadResponder(config Config) (Responder, error) {
+	certificateBytes, err := ioutil.ReadFile(config.ResponderCertFile)
+	if err != nil {
+		return Responder{}, err
+	}
+
+	block, _ := pem.Decode(certificateBytes)
+	if block == nil {
+		return Responder{}, fmt.Errorf("could not decode pem certificate from %v", config.ResponderCertFile)
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return Responder{}, err
+	}
+
+	keyBytes, err := ioutil.ReadFile(config.ResponderKeyFile)
+	if err != nil {
+		return Responder{}, err
+	}
+
+	block, _ = pem.Decode(keyBytes)
+	if block == nil {
+		return Responder{}, fmt.Errorf("could not decode pem private key from %v", config.ResponderKeyFile)
+	}
+
+	signer, err := parsePrivateKey(block.Bytes)
+	if err != nil {
+		return Responder{}, err
+	}
+
+	return Responder{Certificate: certificate, Signer: signer}, nil
+}
+
+func parsePrivateKey(der []byte) (crypto.Signer, error) {
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(der)
+	if err != nil {
+		return nil, errors.New("unsupported private key format")
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, errors.New("unsupported private key type")
+	}
+
+	return signer, nil
+}
@@ -49,7 +49,7 @@ func BlocksHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		res, err := restCtx.BlockchainInfo(minHeight, maxHeight)
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusNotFound, err.Error())
+			restCtx.WriteError(http.StatusNotFound, err)
 
 			return
 		}
@@ -109,7 +109,7 @@ func ValidatorSetRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		chainHeight, err := restCtx.GetChainHeight()
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
@@ -127,7 +127,7 @@ func ValidatorSetRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		output, err := rpc.GetValidators(cliCtx, &height_)
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusInternalServerError, err.Error())
+			restCtx.WriteError(http.StatusInternalServerError, err)
 
 			return
 		}
@@ -0,0 +1,305 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/audit"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator"
+)
+
+// Returns the summary of the proposals awaiting the votes of trustees: the number of the pending proposals
+// of every type, the state of the quorum of every proposal and the recent approvals.
+// All the data is read at the same height, the quorums are computed by the approval policies of the modules.
+func DashboardHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		blocks := int64(defaultRecentBlocks)
+
+		if value := r.FormValue(recentBlocks); len(value) != 0 {
+			var err error
+			if blocks, err = strconv.ParseInt(value, 10, 64); err != nil || blocks <= 0 {
+				restCtx.WriteErrorResponse(http.StatusBadRequest,
+					fmt.Sprintf("Invalid query parameter `%s`: %v must be a positive number", recentBlocks, value))
+
+				return
+			}
+		}
+
+		// the trustees are read at the latest height, the rest is read at the same height
+		trustees, height, err := queryTrustees(restCtx)
+		if err != nil {
+			restCtx.WriteError(http.StatusInternalServerError, err)
+
+			return
+		}
+
+		restCtx = restCtx.WithHeight(height)
+
+		dashboard := NewDashboard(trustees)
+
+		for _, query := range []func(rest.RestContext, *Dashboard) error{
+			queryRootCertificateProposals,
+			queryAccountProposals,
+			queryValidatorProposals,
+		} {
+			if err := query(restCtx, &dashboard); err != nil {
+				restCtx.WriteError(http.StatusInternalServerError, err)
+
+				return
+			}
+		}
+
+		dashboard.RecentApprovals, err = queryRecentApprovals(restCtx, height-blocks)
+		if err != nil {
+			restCtx.WriteError(http.StatusInternalServerError, err)
+
+			return
+		}
+
+		restCtx.EncodeAndRespondWithHeight(dashboard, height)
+	}
+}
+
+func queryTrustees(restCtx rest.RestContext) (int, int64, error) {
+	trustees := 0
+
+	height, err := queryAllPages(restCtx, fmt.Sprintf("custom/%s/all_accounts", auth.StoreKey),
+		func(res []byte) string {
+			var list auth.ListAccounts
+
+			restCtx.Codec().MustUnmarshalJSON(res, &list)
+
+			for _, account := range list.Items {
+				if account.HasRole(auth.Trustee) {
+					trustees++
+				}
+			}
+
+			return list.NextKey
+		})
+
+	return trustees, height, err
+}
+
+func queryRootCertificateProposals(restCtx rest.RestContext, dashboard *Dashboard) error {
+	res, _, err := restCtx.QueryWithData(fmt.Sprintf("custom/%s/params", pki.StoreKey), nil)
+	if err != nil {
+		return err
+	}
+
+	var pkiParams pki.Params
+
+	restCtx.Codec().MustUnmarshalJSON(res, &pkiParams)
+
+	policy := pkiParams.RootCertificateApprovalPolicy()
+
+	_, err = queryAllPages(restCtx, fmt.Sprintf("custom/%s/all_proposed_x509_root_certs", pki.StoreKey),
+		func(res []byte) string {
+			var list pki.ListProposedCertificates
+
+			restCtx.Codec().MustUnmarshalJSON(res, &list)
+
+			for _, certificate := range list.Items {
+				proposal := NewProposal(ProposalRootCertificate,
+					[]string{certificate.Subject, certificate.SubjectKeyID}, certificate.Approvals,
+					policy, dashboard.Trustees)
+
+				// the proposal is rejected when the rejections reach the same quorum
+				for _, rejection := range certificate.Rejections {
+					proposal.Rejections = append(proposal.Rejections, rejection.Address)
+				}
+
+				dashboard.Add(proposal)
+			}
+
+			return list.NextKey
+		})
+	if err != nil {
+		return err
+	}
+
+	_, err = queryAllPages(restCtx, fmt.Sprintf("custom/%s/all_proposed_x509_root_cert_revocations", pki.StoreKey),
+		func(res []byte) string {
+			var list pki.ListProposedCertificateRevocations
+
+			restCtx.Codec().MustUnmarshalJSON(res, &list)
+
+			for _, revocation := range list.Items {
+				dashboard.Add(NewProposal(ProposalRootCertificateRevocation,
+					[]string{revocation.Subject, revocation.SubjectKeyID}, revocation.Approvals,
+					policy, dashboard.Trustees))
+			}
+
+			return list.NextKey
+		})
+
+	return err
+}
+
+func queryAccountProposals(restCtx rest.RestContext, dashboard *Dashboard) error {
+	res, _, err := restCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/params/%s", params.RouterKey, auth.DefaultParamspace), nil)
+	if err != nil {
+		return err
+	}
+
+	var authParams auth.Params
+
+	restCtx.Codec().MustUnmarshalJSON(res, &authParams)
+
+	policy := authParams.AccountApprovalPolicy()
+
+	_, err = queryAllPages(restCtx, fmt.Sprintf("custom/%s/all_pending_accounts", auth.StoreKey),
+		func(res []byte) string {
+			var list auth.ListPendingAccounts
+
+			restCtx.Codec().MustUnmarshalJSON(res, &list)
+
+			for _, account := range list.Items {
+				dashboard.Add(NewProposal(ProposalAccount,
+					[]string{account.Address.String()}, account.Approvals, policy, dashboard.Trustees))
+			}
+
+			return list.NextKey
+		})
+	if err != nil {
+		return err
+	}
+
+	_, err = queryAllPages(restCtx, fmt.Sprintf("custom/%s/all_pending_account_revocations", auth.StoreKey),
+		func(res []byte) string {
+			var list auth.ListPendingAccountRevocations
+
+			restCtx.Codec().MustUnmarshalJSON(res, &list)
+
+			for _, revocation := range list.Items {
+				dashboard.Add(NewProposal(ProposalAccountRevocation,
+					[]string{revocation.Address.String()}, revocation.Approvals, policy, dashboard.Trustees))
+			}
+
+			return list.NextKey
+		})
+
+	return err
+}
+
+func queryValidatorProposals(restCtx rest.RestContext, dashboard *Dashboard) error {
+	res, _, err := restCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/params/%s", params.RouterKey, validator.DefaultParamspace), nil)
+	if err != nil {
+		return err
+	}
+
+	var validatorParams validator.Params
+
+	restCtx.Codec().MustUnmarshalJSON(res, &validatorParams)
+
+	policy := validatorParams.ValidatorApprovalPolicy()
+
+	_, err = queryAllPages(restCtx, fmt.Sprintf("custom/%s/pending_validators", validator.StoreKey),
+		func(res []byte) string {
+			var list validator.ListPendingValidatorItems
+
+			restCtx.Codec().MustUnmarshalJSON(res, &list)
+
+			for _, pending := range list.Items {
+				dashboard.Add(NewProposal(ProposalValidator, []string{pending.Address.String()},
+					pending.Approvals, policy, dashboard.Trustees))
+			}
+
+			return list.NextKey
+		})
+
+	return err
+}
+
+// Returns the latest approvals made after the given height, the most recent first.
+func queryRecentApprovals(restCtx rest.RestContext, sinceHeight int64) ([]audit.Change, error) {
+	if sinceHeight < 0 {
+		sinceHeight = 0
+	}
+
+	var result []audit.Change
+
+	key := ""
+
+	for {
+		res, _, err := restCtx.QueryWithData(fmt.Sprintf("custom/%s/changes", audit.StoreKey),
+			audit.NewChangesQueryParams(pagination.NewCursorPaginationParams(key, 0), sinceHeight, ""))
+		if err != nil {
+			return nil, err
+		}
+
+		var list audit.ListChanges
+
+		restCtx.Codec().MustUnmarshalJSON(res, &list)
+
+		for _, change := range list.Items {
+			if strings.HasPrefix(change.Action, "approve_") {
+				result = append(result, change)
+			}
+		}
+
+		// only the latest approvals are kept
+		if len(result) > maxRecentApprovals {
+			result = result[len(result)-maxRecentApprovals:]
+		}
+
+		if key = list.NextKey; len(key) == 0 {
+			break
+		}
+	}
+
+	approvals := make([]audit.Change, 0, len(result))
+	for i := len(result) - 1; i >= 0; i-- {
+		approvals = append(approvals, result[i])
+	}
+
+	return approvals, nil
+}
+
+// Queries all the pages of the list (the pages are truncated to the maximum page size of the ledger).
+// The page function processes the page and returns the key of the next one.
+// The first page is read at the height of the context (the latest one if not set), the rest at the same height.
+func queryAllPages(restCtx rest.RestContext, path string, page func(res []byte) string) (int64, error) {
+	key := ""
+
+	res, height, err := restCtx.QueryWithData(path, pagination.NewCursorPaginationParams(key, 0))
+	if err != nil {
+		return 0, err
+	}
+
+	restCtx = restCtx.WithHeight(height)
+
+	for key = page(res); len(key) != 0; key = page(res) {
+		if res, _, err = restCtx.QueryWithData(path, pagination.NewCursorPaginationParams(key, 0)); err != nil {
+			return 0, err
+		}
+	}
+
+	return height, nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/audit"
+)
+
+// Types of the proposals trustees vote for.
+const (
+	ProposalRootCertificate           = "root_certificate"            // identified by Subject and Subject Key ID
+	ProposalRootCertificateRevocation = "root_certificate_revocation" // identified by Subject and Subject Key ID
+	ProposalAccount                   = "account"                     // identified by the account address
+	ProposalAccountRevocation         = "account_revocation"          // identified by the account address
+	ProposalValidator                 = "validator"                   // identified by the validator consensus address
+)
+
+// Summary of the proposals awaiting the votes of trustees.
+type Dashboard struct {
+	// number of the accounts eligible to vote for the proposals
+	Trustees int `json:"trustees"`
+	// number of the pending proposals of every type
+	Pending   map[string]int `json:"pending"`
+	Proposals []Proposal     `json:"proposals"`
+	// the latest approvals of proposals (of any type) made within the recent blocks, the most recent first
+	RecentApprovals []audit.Change `json:"recent_approvals"`
+}
+
+func NewDashboard(trustees int) Dashboard {
+	return Dashboard{
+		Trustees: trustees,
+		Pending: map[string]int{
+			ProposalRootCertificate:           0,
+			ProposalRootCertificateRevocation: 0,
+			ProposalAccount:                   0,
+			ProposalAccountRevocation:         0,
+			ProposalValidator:                 0,
+		},
+		Proposals:       []Proposal{},
+		RecentApprovals: []audit.Change{},
+	}
+}
+
+// Adds the pending proposal.
+func (d *Dashboard) Add(proposal Proposal) {
+	d.Pending[proposal.Type]++
+	d.Proposals = append(d.Proposals, proposal)
+}
+
+// Pending proposal and the state of its quorum.
+type Proposal struct {
+	Type              string           `json:"type"`
+	ID                []string         `json:"id"`
+	Approvals         []sdk.AccAddress `json:"approvals"`
+	Rejections        []sdk.AccAddress `json:"rejections,omitempty"`
+	RequiredApprovals int              `json:"required_approvals"`
+	// the proposal can have enough approvals without being accepted if the number of trustees has decreased,
+	// it is accepted by the next approval then
+	QuorumReached bool `json:"quorum_reached"`
+}
+
+func NewProposal(proposalType string, id []string, votes []sdk.AccAddress,
+	policy approvals.Policy, trustees int) Proposal {
+	if votes == nil {
+		votes = []sdk.AccAddress{}
+	}
+
+	return Proposal{
+		Type:              proposalType,
+		ID:                id,
+		Approvals:         votes,
+		RequiredApprovals: policy.RequiredVotes(trustees),
+		QuorumReached:     policy.IsReached(len(votes), trustees),
+	}
+}
@@ -15,16 +15,23 @@
 package rest
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	restTypes "github.com/cosmos/cosmos-sdk/types/rest"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/canonicaljson"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
 )
 
@@ -37,7 +44,7 @@ func DecodeTxRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		body, err := ioutil.ReadAll(r.Body)
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
@@ -46,7 +53,7 @@ func DecodeTxRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		err = restCtx.Codec().UnmarshalJSON(body, &req)
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
@@ -58,7 +65,7 @@ func DecodeTxRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		for _, base64str := range req.Txs {
 			tx, err := decodeTx(restCtx.Codec(), base64str)
 			if err != nil {
-				restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+				restCtx.WriteError(http.StatusBadRequest, err)
 
 				return
 			}
@@ -134,14 +141,14 @@ func SignMessageHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		txBldr, err := restCtx.TxnBuilder()
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
 
 		signedStdTx, err := txBldr.SignStdTx(account, passphrase, req.Txn.Value, false)
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
@@ -150,6 +157,277 @@ func SignMessageHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// SignBytesHandlerFn returns the REST handler providing the bytes to be signed offline (e.g. on an air-gapped machine)
+// by the given signer of the transaction generated by a write request sent without credentials.
+func SignBytesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req SignBytesRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, txBldr, err := offlineSigningContext(restCtx, req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		stdTx := req.Txn.Value
+
+		signBytes := canonicaljson.SignBytes(txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(),
+			stdTx.Fee, stdTx.Msgs, stdTx.Memo)
+
+		restCtx.PostProcessResponseBare(SignBytesResponse{
+			ChainID:       txBldr.ChainID(),
+			AccountNumber: txBldr.AccountNumber(),
+			Sequence:      txBldr.Sequence(),
+			SignBytes:     base64.StdEncoding.EncodeToString(signBytes),
+		})
+	}
+}
+
+// AssembleTxHandlerFn returns the REST handler attaching the signature produced offline to the transaction.
+// The signature is verified against the sign bytes of the signer, so the signer must be the next one
+// (in the order of the transaction signers) who has not signed the transaction yet.
+// The transaction is broadcasted if `broadcast` request parameter is true, otherwise the signed transaction is returned.
+func AssembleTxHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req AssembleTxRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, txBldr, err := offlineSigningContext(restCtx, req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		stdTx := req.Txn.Value
+
+		signers := stdTx.GetSigners()
+		if len(stdTx.Signatures) >= len(signers) {
+			restCtx.WriteErrorResponse(http.StatusBadRequest, "Transaction is already signed by all signers")
+
+			return
+		}
+
+		if !signers[len(stdTx.Signatures)].Equals(restCtx.Signer()) {
+			restCtx.WriteErrorResponse(http.StatusBadRequest,
+				fmt.Sprintf("Invalid signer: the next signature must be produced by %v", signers[len(stdTx.Signatures)]))
+
+			return
+		}
+
+		pubKey, err := sdk.GetAccPubKeyBech32(req.PubKey)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, sdk.ErrInvalidPubKey(err.Error()))
+
+			return
+		}
+
+		if !restCtx.Signer().Equals(sdk.AccAddress(pubKey.Address())) {
+			restCtx.WriteError(http.StatusBadRequest,
+				sdk.ErrInvalidPubKey("Public key does not match the signer address"))
+
+			return
+		}
+
+		signature, err := base64.StdEncoding.DecodeString(req.Signature)
+		if err != nil {
+			restCtx.WriteErrorResponse(http.StatusBadRequest, "Invalid signature: it must be base64 encoded string")
+
+			return
+		}
+
+		signBytes := canonicaljson.SignBytes(txBldr.ChainID(), txBldr.AccountNumber(), txBldr.Sequence(),
+			stdTx.Fee, stdTx.Msgs, stdTx.Memo)
+
+		if !pubKey.VerifyBytes(signBytes, signature) {
+			restCtx.WriteError(http.StatusBadRequest, sdk.ErrUnauthorized(
+				"Signature verification failed; verify correct account number, sequence and chain-id"))
+
+			return
+		}
+
+		stdTx.Signatures = append(stdTx.Signatures, auth.StdSignature{PubKey: pubKey, Signature: signature})
+
+		if broadcast, _ := strconv.ParseBool(r.FormValue(FlagBroadcast)); !broadcast {
+			restCtx.PostProcessResponse(stdTx)
+
+			return
+		}
+
+		restCtx, err = restCtx.WithBroadcastMode()
+		if err != nil {
+			return
+		}
+
+		txBytes, err := restCtx.Codec().MarshalBinaryLengthPrefixed(stdTx)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		res, err := restCtx.BroadcastMessage(txBytes)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		restCtx.PostProcessResponse(res)
+	}
+}
+
+// SignDocHandlerFn returns the REST handler building the transaction of the messages and returning
+// the document to be signed externally by its signer (e.g. by a browser wallet of a vendor portal).
+// The document is returned both as JSON and as the exact bytes to sign, so the signature can be produced
+// by the wallets signing the JSON document as well as by the ones signing raw bytes.
+func SignDocHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req SignDocRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, txBldr, err := offlineSigningContext(restCtx, req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		if err := validateSignerMsgs(req.Msgs, restCtx.Signer()); err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		signMsg, err := txBldr.BuildSignMsg(req.Msgs)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		signBytes := canonicaljson.SignBytes(signMsg.ChainID, signMsg.AccountNumber, signMsg.Sequence,
+			signMsg.Fee, signMsg.Msgs, signMsg.Memo)
+
+		restCtx.PostProcessResponseBare(SignDocResponse{
+			SignDoc:   signBytes,
+			SignBytes: base64.StdEncoding.EncodeToString(signBytes),
+		})
+	}
+}
+
+// SubmitTxHandlerFn returns the REST handler broadcasting the transaction built from the document
+// returned by SignDocHandlerFn and the signature produced externally by its signer.
+func SubmitTxHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req SubmitTxRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		doc, signature := req.SignDoc, req.Signature
+
+		if signature.PubKey == nil {
+			restCtx.WriteError(http.StatusBadRequest, sdk.ErrInvalidPubKey("Public key of the signer is not set"))
+
+			return
+		}
+
+		if err := validateSignerMsgs(doc.Msgs, sdk.AccAddress(signature.PubKey.Address())); err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		signBytes := canonicaljson.SignBytes(doc.ChainID, doc.AccountNumber, doc.Sequence, doc.Fee, doc.Msgs, doc.Memo)
+
+		if !signature.PubKey.VerifyBytes(signBytes, signature.Signature) {
+			restCtx.WriteError(http.StatusBadRequest, sdk.ErrUnauthorized(
+				"Signature verification failed; verify correct account number, sequence and chain-id"))
+
+			return
+		}
+
+		restCtx, err := restCtx.WithBroadcastMode()
+		if err != nil {
+			return
+		}
+
+		txBytes, err := restCtx.Codec().MarshalBinaryLengthPrefixed(
+			auth.NewStdTx(doc.Msgs, doc.Fee, []auth.StdSignature{signature}, doc.Memo))
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		res, err := restCtx.BroadcastMessage(txBytes)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		restCtx.PostProcessResponse(res)
+	}
+}
+
+// The messages sent with a single external signature must be valid and signed by the signer only.
+func validateSignerMsgs(msgs []sdk.Msg, signer sdk.AccAddress) sdk.Error {
+	if len(msgs) == 0 {
+		return sdk.ErrUnknownRequest("Invalid request: it must contain at least one message")
+	}
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		for _, msgSigner := range msg.GetSigners() {
+			if !msgSigner.Equals(signer) {
+				return sdk.ErrUnauthorized(
+					fmt.Sprintf("Invalid signer: the messages must be signed by %v only", signer))
+			}
+		}
+	}
+
+	return nil
+}
+
+// Applies the base request and builds the transaction builder holding the chain id,
+// account number and sequence of the signer (fetched from the ledger if not passed).
+func offlineSigningContext(restCtx rest.RestContext, baseReq restTypes.BaseReq,
+) (rest.RestContext, auth.TxBuilder, error) {
+	restCtx, err := restCtx.WithBaseRequest(baseReq)
+	if err != nil {
+		return restCtx, auth.TxBuilder{}, err
+	}
+
+	restCtx, err = restCtx.WithSigner()
+	if err != nil {
+		return restCtx, auth.TxBuilder{}, err
+	}
+
+	txBldr, err := restCtx.TxnBuilder()
+	if err != nil {
+		restCtx.WriteError(http.StatusBadRequest, err)
+
+		return restCtx, auth.TxBuilder{}, err
+	}
+
+	return restCtx, txBldr, nil
+}
+
 func BroadcastTxHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
@@ -159,16 +437,21 @@ func BroadcastTxHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
+		restCtx, err := restCtx.WithBroadcastMode()
+		if err != nil {
+			return
+		}
+
 		txBytes, err := restCtx.Codec().MarshalBinaryLengthPrefixed(stdTx)
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
 
 		res, err := restCtx.BroadcastMessage(txBytes)
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
@@ -176,3 +459,102 @@ func BroadcastTxHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		restCtx.PostProcessResponse(res)
 	}
 }
+
+// Maximum number of unconfirmed transactions the node returns (Tendermint does not page them).
+const unconfirmedTxsLimit = 100
+
+// TxStatusHandlerFn returns the REST handler reporting whether the transaction with the given hash
+// is committed (with height and result code), still pending in the mempool or not found by the node.
+// The status is unknown if the transaction is not among the listed transactions of a larger mempool.
+func TxStatusHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		hashStr := restCtx.Variables()[hash]
+
+		hashBytes, err := hex.DecodeString(hashStr)
+		if err != nil {
+			restCtx.WriteErrorResponse(http.StatusBadRequest, "Invalid hash: it must be hex encoded string")
+
+			return
+		}
+
+		node, err := restCtx.Context().GetNode()
+		if err != nil {
+			restCtx.WriteError(http.StatusInternalServerError, err)
+
+			return
+		}
+
+		status := TxStatusResponse{Hash: strings.ToUpper(hashStr)}
+
+		tx, found, err := queryCommittedTx(node, hashBytes)
+		if err != nil {
+			restCtx.WriteError(http.StatusInternalServerError, err)
+
+			return
+		}
+
+		if !found {
+			unconfirmedTxs, err := node.UnconfirmedTxs(unconfirmedTxsLimit)
+			if err != nil {
+				restCtx.WriteError(http.StatusInternalServerError, err)
+
+				return
+			}
+
+			for _, tx := range unconfirmedTxs.Txs {
+				if bytes.Equal(tx.Hash(), hashBytes) {
+					status.Status = TxStatusPending
+					restCtx.PostProcessResponseBare(status)
+
+					return
+				}
+			}
+
+			// the transaction could be committed (and removed from the mempool) while the mempool was listed
+			tx, found, err = queryCommittedTx(node, hashBytes)
+			if err != nil {
+				restCtx.WriteError(http.StatusInternalServerError, err)
+
+				return
+			}
+
+			if !found {
+				status.Status = TxStatusNotFound
+
+				if len(unconfirmedTxs.Txs) < unconfirmedTxs.Total {
+					status.Status = TxStatusUnknown
+					status.Log = fmt.Sprintf("The transaction is not committed and not among the first %d of %d "+
+						"transactions of the node mempool", len(unconfirmedTxs.Txs), unconfirmedTxs.Total)
+				}
+
+				restCtx.PostProcessResponseBare(status)
+
+				return
+			}
+		}
+
+		status.Status = TxStatusCommitted
+		status.Height = tx.Height
+		status.Code = tx.TxResult.Code
+		status.Log = tx.TxResult.Log
+
+		restCtx.PostProcessResponseBare(status)
+	}
+}
+
+// returns the committed transaction. The transaction is not found if the node cannot find it in the committed blocks,
+// other errors (e.g. the node is not available or does not index the transactions) are returned.
+func queryCommittedTx(node rpcclient.Client, hash []byte) (*ctypes.ResultTx, bool, error) {
+	tx, err := node.Tx(hash, false)
+	if err != nil {
+		if strings.Contains(err.Error(), fmt.Sprintf("Tx (%X) not found", hash)) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return tx, true, nil
+}
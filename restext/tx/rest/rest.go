@@ -15,12 +15,26 @@
 package rest
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/gorilla/mux"
 )
 
+const (
+	hash = "hash"
+
+	// Broadcast the assembled transaction instead of returning it.
+	FlagBroadcast = "broadcast"
+)
+
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/tx/decode", DecodeTxRequestHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/tx/sign", SignMessageHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/tx/sign-bytes", SignBytesHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/tx/assemble", AssembleTxHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/tx/sign-doc", SignDocHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/tx/submit", SubmitTxHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/tx/broadcast", BroadcastTxHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/txs/{%s}/status", hash), TxStatusHandlerFn(cliCtx)).Methods("GET")
 }
@@ -14,7 +14,13 @@
 
 package rest
 
-import auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	restTypes "github.com/cosmos/cosmos-sdk/types/rest"
+	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
 
 type DecodeTxsRequest struct {
 	Txs []string `json:"txs"`
@@ -23,3 +29,71 @@ type DecodeTxsRequest struct {
 type DecodeTxsResponse struct {
 	Txs []auth.StdTx `json:"txs"`
 }
+
+const (
+	TxStatusCommitted = "committed"
+	TxStatusPending   = "pending"
+	TxStatusNotFound  = "not_found"
+	TxStatusUnknown   = "unknown"
+)
+
+type TxStatusResponse struct {
+	Hash   string `json:"hash"`
+	Status string `json:"status"`
+	Height int64  `json:"height,omitempty"`
+	Code   uint32 `json:"code"`
+	Log    string `json:"log,omitempty"`
+}
+
+// Request for the bytes the signer (`base_req.from`) must sign offline.
+// Account number and sequence of the signer are taken from `base_req` or fetched from the ledger if they are not set.
+type SignBytesRequest struct {
+	BaseReq restTypes.BaseReq `json:"base_req"`
+	Txn     Txn               `json:"txn"`
+}
+
+type SignBytesResponse struct {
+	ChainID       string `json:"chain_id"`
+	AccountNumber uint64 `json:"account_number"`
+	Sequence      uint64 `json:"sequence"`
+	SignBytes     string `json:"sign_bytes"` // base64 encoded
+}
+
+// Request to attach the signature produced offline by the signer (`base_req.from`) to the transaction.
+type AssembleTxRequest struct {
+	BaseReq   restTypes.BaseReq `json:"base_req"`
+	Txn       Txn               `json:"txn"`
+	PubKey    string            `json:"pub_key"`   // bech32 encoded account public key
+	Signature string            `json:"signature"` // base64 encoded
+}
+
+// Request for the document the signer (`base_req.from`) must sign externally (e.g. by a browser wallet)
+// to send the messages. Account number and sequence of the signer are taken from `base_req`
+// or fetched from the ledger if they are not set.
+type SignDocRequest struct {
+	BaseReq restTypes.BaseReq `json:"base_req"`
+	Msgs    []sdk.Msg         `json:"msgs"`
+}
+
+type SignDocResponse struct {
+	SignDoc   json.RawMessage `json:"sign_doc"`   // canonical JSON of the sign document
+	SignBytes string          `json:"sign_bytes"` // base64 encoded sign document
+}
+
+// Document signed by the signer of the transaction (same as auth.StdSignDoc with the messages decoded).
+type SignDoc struct {
+	AccountNumber uint64      `json:"account_number"`
+	ChainID       string      `json:"chain_id"`
+	Fee           auth.StdFee `json:"fee"`
+	Memo          string      `json:"memo"`
+	Msgs          []sdk.Msg   `json:"msgs"`
+	Sequence      uint64      `json:"sequence"`
+}
+
+// Request to broadcast the transaction built from the sign document and the signature produced externally.
+// The signature has the form returned by the browser wallets: `{"pub_key": {"type": string, "value": string},
+// "signature": string (base64)}`.
+type SubmitTxRequest struct {
+	SignDoc   SignDoc           `json:"sign_doc"`
+	Signature auth.StdSignature `json:"signature"`
+}
@@ -0,0 +1,226 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	clientCtx "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	eventsrest "github.com/zigbee-alliance/distributed-compliance-ledger/restext/events/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/webhook"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+)
+
+const (
+	reconnectDelay = 5 * time.Second
+)
+
+// DeliveriesHandlerFn returns the REST handler of the webhook delivery log (newest first).
+// The deliveries can be filtered by the webhook `url` and the `status` (pending, delivered, failed).
+func DeliveriesHandlerFn(cliCtx clientCtx.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		statusValue := r.FormValue(status)
+		if len(statusValue) != 0 && statusValue != webhook.StatusPending &&
+			statusValue != webhook.StatusDelivered && statusValue != webhook.StatusFailed {
+			restCtx.WriteErrorResponse(http.StatusBadRequest, fmt.Sprintf(
+				"Invalid status: \"%v\". It must be one of: %v, %v, %v",
+				statusValue, webhook.StatusPending, webhook.StatusDelivered, webhook.StatusFailed))
+
+			return
+		}
+
+		restCtx.PostProcessResponseBare(dispatcher.Deliveries(r.FormValue(url), statusValue))
+	}
+}
+
+// Listen subscribes to the committed transactions and blocks of the node and dispatches the notifications about
+// the messages of the transactions and about the module events. The module events are the state changes,
+// including the ones of the block begin and end: e.g. a root certificate approved by enough trustees
+// or an expired compliance. The subscription is renewed if the node is not available.
+// The subscriber names the subscription and the logger.
+func Listen(cliCtx clientCtx.CLIContext, subscriber string, dispatch func(n webhook.Notification)) {
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", subscriber)
+	txQuery := fmt.Sprintf("%s='%s'", tmtypes.EventTypeKey, tmtypes.EventTx)
+	blockQuery := fmt.Sprintf("%s='%s'", tmtypes.EventTypeKey, tmtypes.EventNewBlock)
+
+	for ; ; time.Sleep(reconnectDelay) {
+		client := rpcclient.NewHTTP(cliCtx.NodeURI, "/websocket")
+		if err := client.Start(); err != nil {
+			logger.Error("Failed to connect to the node", "err", err)
+
+			continue
+		}
+
+		txs, err := client.Subscribe(context.Background(), subscriber, txQuery)
+		if err != nil {
+			logger.Error("Failed to subscribe to the transactions", "err", err)
+
+			_ = client.Stop()
+
+			continue
+		}
+
+		blocks, err := client.Subscribe(context.Background(), subscriber, blockQuery)
+		if err != nil {
+			logger.Error("Failed to subscribe to the blocks", "err", err)
+
+			_ = client.Stop()
+
+			continue
+		}
+
+		listen(cliCtx.Codec, txs, blocks, dispatch)
+
+		_ = client.Stop()
+	}
+}
+
+// dispatches the notifications until any of the subscriptions is closed.
+func listen(cdc *codec.Codec, txs <-chan ctypes.ResultEvent, blocks <-chan ctypes.ResultEvent,
+	dispatch func(n webhook.Notification)) {
+	for {
+		var notifications []webhook.Notification
+
+		select {
+		case result, ok := <-txs:
+			if !ok {
+				return
+			}
+
+			if data, ok := result.Data.(tmtypes.EventDataTx); ok {
+				notifications = txNotifications(cdc, data)
+			}
+		case result, ok := <-blocks:
+			if !ok {
+				return
+			}
+
+			if data, ok := result.Data.(tmtypes.EventDataNewBlock); ok {
+				notifications = blockNotifications(data)
+			}
+		}
+
+		for _, notification := range notifications {
+			dispatch(notification)
+		}
+	}
+}
+
+// returns the notifications about the messages (the ones executed by a grantee are unwrapped)
+// and about the module events of the successful transaction.
+func txNotifications(cdc *codec.Codec, data tmtypes.EventDataTx) []webhook.Notification {
+	// failed transactions do not change the state
+	if data.Result.IsErr() {
+		return nil
+	}
+
+	var notifications []webhook.Notification
+
+	for i, event := range eventsrest.ExtractEvents(cdc, data, "", "") {
+		notifications = append(notifications, newMsgNotification(cdc, event, i))
+	}
+
+	txHash := fmt.Sprintf("%X", data.Tx.Hash())
+
+	return append(notifications,
+		newEventNotifications(txHash+"-event", data.Height, txHash, data.Result.Events)...)
+}
+
+// returns the notifications about the module events of the block begin and end (e.g. an applied upgrade or
+// an expired compliance).
+func blockNotifications(data tmtypes.EventDataNewBlock) []webhook.Notification {
+	height := data.Block.Height
+
+	return append(
+		newEventNotifications(fmt.Sprintf("%d-begin_block", height), height, "", data.ResultBeginBlock.Events),
+		newEventNotifications(fmt.Sprintf("%d-end_block", height), height, "", data.ResultEndBlock.Events)...)
+}
+
+func newMsgNotification(cdc *codec.Codec, event eventsrest.Event, index int) webhook.Notification {
+	msg := cdc.MustMarshalJSON(event.Msg)
+
+	// the messages of the models and their compliance carry the model ID
+	var value struct {
+		Value struct {
+			VID uint16 `json:"vid"`
+			PID uint16 `json:"pid"`
+		} `json:"value"`
+	}
+
+	_ = json.Unmarshal(msg, &value)
+
+	return webhook.Notification{
+		ID:     fmt.Sprintf("%s-%d", event.TxHash, index),
+		Height: event.Height,
+		TxHash: event.TxHash,
+		Module: event.Module,
+		Action: event.Action,
+		VID:    value.Value.VID,
+		PID:    value.Value.PID,
+		Msg:    msg,
+	}
+}
+
+// returns the notifications about the module events: the ones carrying the module attribute.
+// The events of the messages themselves are skipped, the messages are notified separately.
+func newEventNotifications(idPrefix string, height int64, txHash string,
+	events []abci.Event) []webhook.Notification {
+	var notifications []webhook.Notification
+
+	for i, event := range events {
+		attributes := make(map[string]string, len(event.Attributes))
+		for _, attribute := range event.Attributes {
+			attributes[string(attribute.Key)] = string(attribute.Value)
+		}
+
+		module, ok := attributes[sdk.AttributeKeyModule]
+		if !ok || event.Type == sdk.EventTypeMessage {
+			continue
+		}
+
+		// the events of the models and their compliance carry the model ID
+		vid, _ := strconv.ParseUint(attributes[compliance.AttributeKeyVID], 10, 16)
+		pid, _ := strconv.ParseUint(attributes[compliance.AttributeKeyPID], 10, 16)
+
+		notifications = append(notifications, webhook.Notification{
+			ID:         fmt.Sprintf("%s-%d", idPrefix, i),
+			Height:     height,
+			TxHash:     txHash,
+			Module:     module,
+			Action:     event.Type,
+			VID:        uint16(vid),
+			PID:        uint16(pid),
+			Attributes: attributes,
+		})
+	}
+
+	return notifications
+}
@@ -0,0 +1,89 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/webhook"
+)
+
+const (
+	FlagConfig      = "webhooks-config"
+	FlagConfigUsage = "JSON file with the webhooks (URL, secret and event filters) notified about the ledger events. " +
+		"The webhooks are disabled if empty"
+	FlagRetries         = "webhooks-retries"
+	FlagRetriesUsage    = "Number of retries of a failed webhook delivery"
+	FlagRetryDelay      = "webhooks-retry-delay"
+	FlagRetryDelayUsage = "Delay before the first retry of a failed webhook delivery (doubled after every retry)"
+	FlagTimeout         = "webhooks-timeout"
+	FlagTimeoutUsage    = "Timeout of a single webhook delivery attempt"
+	defaultRetries      = 5
+	defaultRetryDelay   = time.Second
+	defaultTimeout      = 10 * time.Second
+	logSize             = 1000
+	url                 = "url"
+	status              = "status"
+)
+
+var dispatcher *webhook.Dispatcher
+
+// Adds the webhook flags to the REST server command.
+func AddFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().String(FlagConfig, "", FlagConfigUsage)
+	cmd.Flags().Int(FlagRetries, defaultRetries, FlagRetriesUsage)
+	cmd.Flags().Duration(FlagRetryDelay, defaultRetryDelay, FlagRetryDelayUsage)
+	cmd.Flags().Duration(FlagTimeout, defaultTimeout, FlagTimeoutUsage)
+
+	return cmd
+}
+
+// Starts delivering the notifications about the events of the committed transactions to the webhooks
+// if they are configured (`--webhooks-config`).
+func Enable(cliCtx context.CLIContext) {
+	path := viper.GetString(FlagConfig)
+	if len(path) == 0 {
+		return
+	}
+
+	webhooks, err := webhook.LoadWebhooks(path)
+	if err != nil {
+		panic(fmt.Sprintf("Failed to load the webhooks: %v", err))
+	}
+
+	dispatcher = webhook.New(webhook.Config{
+		Webhooks:   webhooks,
+		MaxRetries: viper.GetInt(FlagRetries),
+		RetryDelay: viper.GetDuration(FlagRetryDelay),
+		Timeout:    viper.GetDuration(FlagTimeout),
+		LogSize:    logSize,
+	})
+
+	go Listen(cliCtx, "webhooks", dispatcher.Dispatch)
+}
+
+// Registers the delivery log route if the webhooks are enabled.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	if dispatcher == nil {
+		return
+	}
+
+	r.HandleFunc("/webhooks/deliveries", DeliveriesHandlerFn(cliCtx)).Methods("GET")
+}
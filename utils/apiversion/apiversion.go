@@ -0,0 +1,70 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package apiversion serves the REST routes under the API version prefixes (`/v1/`, `/v2/`, ...),
+// so the breaking changes of the routes (e.g. of the response formats) can be rolled out under a new version
+// while the clients of the previous versions keep working.
+package apiversion
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// Path prefix of an API version.
+var prefix = regexp.MustCompile(`^/v[0-9]+(/|$)`)
+
+type Version struct {
+	// Name of the version used as the path prefix (e.g. `v1`).
+	Name string
+	// Registers the routes added or changed by the version.
+	RegisterRoutes func(r *mux.Router)
+}
+
+// RegisterRoutes registers the routes of the versions ordered from the oldest one.
+// Every version is served under `/<name>/` prefix. It serves its own routes and inherits the routes
+// of the previous versions it does not override, so a new version registers only the changed routes.
+// The routes of the first version are also served without the prefix for the clients of the unversioned API.
+func RegisterRoutes(router *mux.Router, versions ...Version) {
+	if len(versions) == 0 {
+		return
+	}
+
+	for i, version := range versions {
+		subrouter := router.PathPrefix("/" + version.Name).Subrouter()
+
+		// the routes registered first take precedence
+		for j := i; j >= 0; j-- {
+			versions[j].RegisterRoutes(subrouter)
+		}
+	}
+
+	versions[0].RegisterRoutes(router)
+}
+
+// Split returns the API version of the path (if any) and the path without the version prefix.
+func Split(path string) (string, string) {
+	if !prefix.MatchString(path) {
+		return "", path
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)
+	if len(parts) == 1 {
+		return parts[0], "/"
+	}
+
+	return parts[0], "/" + parts[1]
+}
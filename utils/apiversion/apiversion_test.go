@@ -0,0 +1,81 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package apiversion
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+func handler(name string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(name))
+	}
+}
+
+func TestRegisterRoutes(t *testing.T) {
+	router := mux.NewRouter()
+
+	RegisterRoutes(router,
+		Version{Name: "v1", RegisterRoutes: func(r *mux.Router) {
+			r.HandleFunc("/models", handler("v1 models")).Methods(http.MethodGet)
+			r.HandleFunc("/compliance", handler("v1 compliance")).Methods(http.MethodGet)
+		}},
+		Version{Name: "v2", RegisterRoutes: func(r *mux.Router) {
+			r.HandleFunc("/compliance", handler("v2 compliance")).Methods(http.MethodGet)
+		}},
+	)
+
+	cases := map[string]string{
+		"/models":        "v1 models",
+		"/compliance":    "v1 compliance",
+		"/v1/models":     "v1 models",
+		"/v1/compliance": "v1 compliance",
+		"/v2/models":     "v1 models",
+		"/v2/compliance": "v2 compliance",
+	}
+
+	for path, expected := range cases {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		require.Equal(t, http.StatusOK, w.Code, path)
+		require.Equal(t, expected, w.Body.String(), path)
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v3/models", nil))
+	require.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestSplit(t *testing.T) {
+	cases := map[string][2]string{
+		"/v1/modelinfo/models": {"v1", "/modelinfo/models"},
+		"/v12/compliance":      {"v12", "/compliance"},
+		"/v1":                  {"v1", "/"},
+		"/modelinfo/models":    {"", "/modelinfo/models"},
+		"/validators":          {"", "/validators"},
+		"/vendors/1":           {"", "/vendors/1"},
+	}
+
+	for path, expected := range cases {
+		version, rest := Split(path)
+		require.Equal(t, expected, [2]string{version, rest}, path)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package canonicaljson builds the canonical JSON form of the documents signed by the transaction signers.
+// The ledger verifies the signatures against the canonical form of the sign document, so the third-party
+// signing implementations (HSM services, JS SDKs) must produce the same bytes:
+//   - the keys of the objects are sorted (byte-wise) and there is no whitespace;
+//   - the strings are escaped as Go does: `<`, `>`, `&`, U+2028 and U+2029 are escaped as `\u003c`, `\u003e`,
+//     `\u0026`, `\u2028` and `\u2029`, invalid UTF-8 bytes are replaced by U+FFFD;
+//   - the numbers are encoded in the shortest form of their float64 value (the 64-bit integers are encoded
+//     as strings in the sign documents, so the numbers are small integers written as is).
+//
+// See `testdata` for the examples of the canonical form.
+package canonicaljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+var ErrTrailingData = errors.New("invalid JSON: trailing data after the top-level value")
+
+// The document signed by the transaction signers (same as auth.StdSignDoc).
+type signDoc struct {
+	AccountNumber uint64            `json:"account_number,string"`
+	ChainID       string            `json:"chain_id"`
+	Fee           json.RawMessage   `json:"fee"`
+	Memo          string            `json:"memo"`
+	Msgs          []json.RawMessage `json:"msgs"`
+	Sequence      uint64            `json:"sequence,string"`
+}
+
+// Returns the canonical form of the JSON document.
+func Canonicalize(bz []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	if decoder.More() {
+		return nil, ErrTrailingData
+	}
+
+	return json.Marshal(value)
+}
+
+func MustCanonicalize(bz []byte) []byte {
+	canonical, err := Canonicalize(bz)
+	if err != nil {
+		panic(err)
+	}
+
+	return canonical
+}
+
+// Returns the bytes to be signed by the signer of the transaction with the given account number and sequence.
+// The result is the same as of auth.StdSignBytes, which is used by the ledger to verify the signatures.
+func SignBytes(chainID string, accountNumber uint64, sequence uint64, fee auth.StdFee,
+	msgs []sdk.Msg, memo string) []byte {
+	doc := signDoc{
+		AccountNumber: accountNumber,
+		ChainID:       chainID,
+		Fee:           fee.Bytes(),
+		Memo:          memo,
+		Sequence:      sequence,
+	}
+
+	for _, msg := range msgs {
+		doc.Msgs = append(doc.Msgs, msg.GetSignBytes())
+	}
+
+	bz, err := json.Marshal(doc)
+	if err != nil {
+		panic(err)
+	}
+
+	return MustCanonicalize(bz)
+}
+
+// Same as auth.TxBuilder.BuildAndSign, but the sign bytes are built by SignBytes.
+func BuildAndSign(txBldr auth.TxBuilder, name string, passphrase string, msgs []sdk.Msg) ([]byte, error) {
+	signMsg, err := txBldr.BuildSignMsg(msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, pubKey, err := txBldr.Keybase().Sign(name, passphrase, SignBytes(signMsg.ChainID,
+		signMsg.AccountNumber, signMsg.Sequence, signMsg.Fee, signMsg.Msgs, signMsg.Memo))
+	if err != nil {
+		return nil, err
+	}
+
+	return txBldr.TxEncoder()(auth.NewStdTx(signMsg.Msgs, signMsg.Fee,
+		[]auth.StdSignature{{PubKey: pubKey, Signature: signature}}, signMsg.Memo))
+}
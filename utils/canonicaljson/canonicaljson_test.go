@@ -0,0 +1,131 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+
+//nolint:testpackage
+package canonicaljson
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+)
+
+// Message with the sign bytes given as is (not canonical).
+type testMsg struct {
+	Signer    sdk.AccAddress `json:"signer"`
+	SignBytes string         `json:"sign_bytes"`
+}
+
+func (m testMsg) Route() string                { return "test" }
+func (m testMsg) Type() string                 { return "test" }
+func (m testMsg) ValidateBasic() sdk.Error     { return nil }
+func (m testMsg) GetSignBytes() []byte         { return []byte(m.SignBytes) }
+func (m testMsg) GetSigners() []sdk.AccAddress { return []sdk.AccAddress{m.Signer} }
+
+func readFile(t *testing.T, path string) []byte {
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	return bz
+}
+
+// The canonical form of every `testdata/*.json` document must be equal to the content of `testdata/*.golden`.
+func TestCanonicalize_Golden(t *testing.T) {
+	inputs, err := filepath.Glob("testdata/*.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, inputs)
+
+	for _, input := range inputs {
+		bz := readFile(t, input)
+		golden := readFile(t, strings.TrimSuffix(input, ".json")+".golden")
+
+		canonical, err := Canonicalize(bz)
+		require.NoError(t, err, input)
+		require.Equal(t, string(golden), string(canonical), input)
+
+		// the same form is built by the SDK and the canonical form is not changed by canonicalization
+		require.Equal(t, string(sdk.MustSortJSON(bz)), string(canonical), input)
+		require.Equal(t, string(canonical), string(MustCanonicalize(canonical)), input)
+	}
+}
+
+func TestCanonicalize_Invalid(t *testing.T) {
+	for _, bz := range []string{"", "{", `{"a":}`, "{} {}", "[1] 2"} {
+		_, err := Canonicalize([]byte(bz))
+		require.Error(t, err, bz)
+	}
+}
+
+func TestSignBytes(t *testing.T) {
+	signer := sdk.AccAddress([]byte("signer"))
+	msgs := []sdk.Msg{
+		testMsg{Signer: signer, SignBytes: `{"type":"test","value":{"vid":1,"name":"<name>"}}`},
+		testMsg{Signer: signer, SignBytes: `{ "value" : { "b": [ 2, 1 ], "a": "é" }, "type": "test" }`},
+	}
+	fees := []auth.StdFee{
+		auth.NewStdFee(200000, nil),
+		auth.NewStdFee(1, sdk.NewCoins(sdk.NewInt64Coin("token", 10))),
+	}
+
+	for _, msgs := range [][]sdk.Msg{nil, msgs[:1], msgs} {
+		for _, fee := range fees {
+			for _, memo := range []string{"", "timeout_height=10;ERP-1234 & <co>"} {
+				require.Equal(t, string(auth.StdSignBytes("dclchain", 3, 11, fee, msgs, memo)),
+					string(SignBytes("dclchain", 3, 11, fee, msgs, memo)))
+			}
+		}
+	}
+
+	require.Equal(t, string(readFile(t, "testdata/sign_bytes.golden")),
+		string(SignBytes("dclchain", 3, 11, fees[0], msgs, "ERP-1234")))
+}
+
+func TestBuildAndSign(t *testing.T) {
+	kb := crkeys.NewInMemory()
+	info, _, err := kb.CreateMnemonic("jack", crkeys.English, "test1234", crkeys.Secp256k1)
+	require.NoError(t, err)
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	cdc.RegisterConcrete(testMsg{}, "test/Msg", nil)
+
+	msgs := []sdk.Msg{testMsg{Signer: info.GetAddress(), SignBytes: `{"b":2,"a":1}`}}
+	txBldr := auth.NewTxBuilder(auth.DefaultTxEncoder(cdc), 3, 11, 200000, 0, false, "dclchain", "memo", nil, nil).
+		WithKeybase(kb)
+
+	bz, err := BuildAndSign(txBldr, "jack", "test1234", msgs)
+	require.NoError(t, err)
+
+	var tx auth.StdTx
+	require.NoError(t, cdc.UnmarshalBinaryLengthPrefixed(bz, &tx))
+	require.Equal(t, "memo", tx.Memo)
+	require.Len(t, tx.Signatures, 1)
+
+	// the signature is verified by the ledger against the sign bytes built by the SDK
+	signBytes := auth.StdSignBytes("dclchain", 3, 11, tx.Fee, tx.Msgs, tx.Memo)
+	require.True(t, info.GetPubKey().VerifyBytes(signBytes, tx.Signatures[0].Signature))
+
+	_, err = BuildAndSign(txBldr, "jack", "wrong", msgs)
+	require.Error(t, err)
+}
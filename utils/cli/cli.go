@@ -24,11 +24,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/canonicaljson"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/signer"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/simulation"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/txtimeout"
 )
 
 const (
@@ -63,6 +68,13 @@ type CliContext struct {
 }
 
 func NewCLIContext() CliContext {
+	// the light client verifier of Cosmos SDK CLI context is created once, so the trust root is set up before
+	if err := InitTrustRoot(); err != nil {
+		fmt.Printf("Initialize trust root failed: %s\n", err.Error())
+		fmt.Printf("Please check the address of the node to connect to and the pinned trust-height and trust-hash\n")
+		os.Exit(1)
+	}
+
 	return CliContext{
 		context: context.NewCLIContext(),
 	}
@@ -146,11 +158,187 @@ func (ctx CliContext) HandleWriteMessage(msg sdk.Msg) error {
 		return err
 	}
 
-	txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(ctx.context.Codec))
+	txBldr := ctx.newTxBuilder()
+
+	kb, kbErr := signer.NewKeybaseFromConfig(txBldr.Keybase())
+	if kbErr != nil {
+		return kbErr
+	}
+
+	txBldr = txBldr.WithKeybase(kb)
+
+	if !ctx.context.GenerateOnly {
+		if ctx.context.Simulate {
+			return ctx.simulate(txBldr, []sdk.Msg{msg})
+		}
+
+		if err := ctx.verifyPinnedChain(); err != nil {
+			return err
+		}
+
+		useLedger, err := ctx.isLedgerKey(kb)
+		if err != nil {
+			return err
+		}
+
+		if useLedger {
+			return ctx.completeAndBroadcastWithLedger(txBldr, []sdk.Msg{msg})
+		}
+
+		if currentSession != nil {
+			return ctx.completeAndBroadcastInSession(txBldr, []sdk.Msg{msg})
+		}
+	}
 
 	return utils.GenerateOrBroadcastMsgs(ctx.context, txBldr, []sdk.Msg{msg})
 }
 
+// Returns the transaction builder configured by the flags. The timeout height (`--timeout-height`)
+// is attached to the memo (see `utils/txtimeout`).
+func (ctx CliContext) newTxBuilder() auth.TxBuilder {
+	txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(ctx.context.Codec))
+
+	return txBldr.WithMemo(txtimeout.Memo(viper.GetInt64(txtimeout.FlagTimeoutHeight), txBldr.Memo()))
+}
+
+// Simulates the transaction (`--dry-run`) and prints the result it would have and the estimated gas.
+// The transaction is neither signed nor broadcasted.
+func (ctx CliContext) simulate(txBldr auth.TxBuilder, msgs []sdk.Msg) error {
+	txBldr, err := utils.PrepareTxBuilder(txBldr, ctx.context)
+	if err != nil {
+		return err
+	}
+
+	res, err := simulation.Simulate(ctx.context, txBldr, msgs)
+	if err != nil {
+		return err
+	}
+
+	return ctx.context.PrintOutput(res)
+}
+
+// Checks whether the key used to sign transactions (`--from`) is stored on a Ledger device.
+// `--ledger` flag requires the key to be a Ledger one.
+func (ctx CliContext) isLedgerKey(kb crkeys.Keybase) (bool, sdk.Error) {
+	info, err := kb.Get(ctx.context.GetFromName())
+	if err != nil {
+		return false, sdk.ErrInternal(fmt.Sprintf("Could not get key: %v", err))
+	}
+
+	isLedger := info.GetType() == crkeys.TypeLedger
+
+	if viper.GetBool(flags.FlagUseLedger) && !isLedger {
+		return false, sdk.ErrUnknownRequest(
+			fmt.Sprintf("Key %v is not stored on a Ledger device", ctx.context.GetFromName()))
+	}
+
+	return isLedger, nil
+}
+
+// Signs the transaction on a Ledger device and broadcasts it.
+// The transaction is confirmed by the user on the device, so neither confirmation prompt nor passphrase is asked.
+func (ctx CliContext) completeAndBroadcastWithLedger(txBldr auth.TxBuilder, msgs []sdk.Msg) error {
+	txBldr, err := utils.PrepareTxBuilder(txBldr, ctx.context)
+	if err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintln(os.Stderr, "Please review and confirm the transaction on the Ledger device")
+
+	txBytes, err := canonicaljson.BuildAndSign(txBldr, ctx.context.GetFromName(), "", msgs)
+	if err != nil {
+		return err
+	}
+
+	res, err := ctx.context.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	return ctx.context.PrintOutput(res)
+}
+
+// Signs and broadcasts transactions of the `--from` account one by one without confirmation prompts
+// (the passphrase is asked once). The account sequence is tracked locally between the transactions.
+type Broadcaster struct {
+	ctx        CliContext
+	txBldr     auth.TxBuilder
+	passphrase string
+	useLedger  bool
+}
+
+func (ctx CliContext) NewBroadcaster() (*Broadcaster, error) {
+	if ctx.context.GenerateOnly {
+		return nil, sdk.ErrUnknownRequest("Generation of several transactions is not supported")
+	}
+
+	if err := ctx.verifyPinnedChain(); err != nil {
+		return nil, err
+	}
+
+	txBldr := ctx.newTxBuilder()
+
+	kb, err := signer.NewKeybaseFromConfig(txBldr.Keybase())
+	if err != nil {
+		return nil, err
+	}
+
+	txBldr = txBldr.WithKeybase(kb)
+
+	useLedger, err := ctx.isLedgerKey(kb)
+	if err != nil {
+		return nil, err
+	}
+
+	txBldr, err = utils.PrepareTxBuilder(txBldr, ctx.context)
+	if err != nil {
+		return nil, err
+	}
+
+	var passphrase string
+
+	if !useLedger {
+		passphrase, err = keyPassphrase(ctx.context.GetFromName())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Broadcaster{ctx: ctx, txBldr: txBldr, passphrase: passphrase, useLedger: useLedger}, nil
+}
+
+// Signs and broadcasts the messages as a single transaction.
+func (b *Broadcaster) Broadcast(msgs []sdk.Msg) (sdk.TxResponse, error) {
+	if b.useLedger {
+		_, _ = fmt.Fprintln(os.Stderr, "Please review and confirm the transaction on the Ledger device")
+	}
+
+	txBytes, err := canonicaljson.BuildAndSign(b.txBldr, b.ctx.context.GetFromName(), b.passphrase, msgs)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	if !b.useLedger {
+		unlock(b.ctx.context.GetFromName(), b.passphrase)
+	}
+
+	res, err := b.ctx.context.BroadcastTx(txBytes)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	// the transaction has incremented the account sequence if it is accepted or included in a block
+	if sdk.CodeType(res.Code) == sdk.CodeOK || res.Height > 0 {
+		b.txBldr = b.txBldr.WithSequence(b.txBldr.Sequence() + 1)
+	}
+
+	return res, nil
+}
+
+func (ctx CliContext) PrintOutput(data fmt.Stringer) error {
+	return ctx.context.PrintOutput(data)
+}
+
 func (ctx CliContext) EncodeAndPrintWithHeight(data interface{}, height int64) (err error) {
 	out, err := json.Marshal(data)
 	if err != nil {
@@ -183,6 +371,7 @@ func (ctx CliContext) ReadFromFile(target string) (string, error) {
 
 func SignedCommands(cmds ...*cobra.Command) []*cobra.Command {
 	for _, c := range cmds {
+		c.Flags().Int64(txtimeout.FlagTimeoutHeight, 0, txtimeout.FlagTimeoutHeightUsage)
 		_ = c.MarkFlagRequired(flags.FlagFrom)
 	}
 
@@ -0,0 +1,160 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	ShellBash       = "bash"
+	ShellZsh        = "zsh"
+	ShellFish       = "fish"
+	ShellPowerShell = "powershell"
+)
+
+// CompletionCmd generates the completion script of all the commands and flags of the root command.
+func CompletionCmd(rootCmd *cobra.Command) *cobra.Command {
+	name := rootCmd.Name()
+
+	return &cobra.Command{
+		Use:   fmt.Sprintf("completion [%s|%s|%s|%s]", ShellBash, ShellZsh, ShellFish, ShellPowerShell),
+		Short: "Generate the shell completion script to STDOUT",
+		Long: fmt.Sprintf(`Generate the completion script of all the commands and flags for the given shell.
+
+Bash:
+$ source <(%[1]s completion bash)
+# to load the completions for each session add the line to ~/.bashrc
+
+Zsh:
+$ %[1]s completion zsh > "${fpath[1]}/_%[1]s"
+
+Fish:
+$ %[1]s completion fish > ~/.config/fish/completions/%[1]s.fish
+
+PowerShell:
+PS> %[1]s completion powershell | Out-String | Invoke-Expression
+`, name),
+		ValidArgs: []string{ShellBash, ShellZsh, ShellFish, ShellPowerShell},
+		Args:      cobra.ExactValidArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch args[0] {
+			case ShellBash:
+				return rootCmd.GenBashCompletion(os.Stdout)
+			case ShellZsh:
+				return rootCmd.GenZshCompletion(os.Stdout)
+			case ShellFish:
+				return GenFishCompletion(rootCmd, os.Stdout)
+			default:
+				return rootCmd.GenPowerShellCompletion(os.Stdout)
+			}
+		},
+	}
+}
+
+// GenFishCompletion writes the fish completion script of the root command.
+// The subcommands are completed after the exact path of their parent,
+// the flags are completed anywhere after the path of their command.
+func GenFishCompletion(rootCmd *cobra.Command, w io.Writer) error {
+	name := rootCmd.Name()
+	helper := "__fish_" + strings.ReplaceAll(name, "-", "_") + "_command"
+
+	var buf bytes.Buffer
+
+	// prints whether the non-flag words of the command line match the arguments
+	// (exactly or as a prefix if the first argument is `-p`)
+	fmt.Fprintf(&buf, `# fish completion for %[1]s
+
+function %[2]s
+    set -l prefix 0
+    if test "$argv[1]" = "-p"
+        set prefix 1
+        set -e argv[1]
+    end
+    set -l tokens (commandline -opc)
+    set -e tokens[1]
+    set -l words
+    for word in $tokens
+        if not string match -q -- '-*' $word
+            set words $words $word
+        end
+    end
+    if test $prefix -eq 1
+        test (count $words) -ge (count $argv); and test "$words[1..(count $argv)]" = "$argv"
+    else
+        test "$words" = "$argv"
+    end
+end
+
+complete -c %[1]s -f
+`, name, helper)
+
+	genFishCommand(&buf, name, helper, rootCmd, nil)
+
+	_, err := buf.WriteTo(w)
+
+	return err
+}
+
+func genFishCommand(buf *bytes.Buffer, name string, helper string, cmd *cobra.Command, path []string) {
+	condition := strings.TrimSpace(helper + " " + strings.Join(path, " "))
+	prefixCondition := strings.TrimSpace(helper + " -p " + strings.Join(path, " "))
+
+	if len(path) == 0 {
+		// `(count $argv)` of an empty path is 0, so the prefix condition always holds
+		prefixCondition = "true"
+	}
+
+	flags := cmd.LocalNonPersistentFlags()
+	flags.AddFlagSet(cmd.PersistentFlags())
+	flags.VisitAll(func(flag *pflag.Flag) {
+		if flag.Hidden {
+			return
+		}
+
+		fmt.Fprintf(buf, "complete -c %s -n '%s' -l %s", name, prefixCondition, flag.Name)
+
+		if len(flag.Shorthand) != 0 {
+			fmt.Fprintf(buf, " -s %s", flag.Shorthand)
+		}
+
+		if flag.NoOptDefVal == "" {
+			buf.WriteString(" -r")
+		}
+
+		fmt.Fprintf(buf, " -d %s\n", fishQuote(flag.Usage))
+	})
+
+	for _, sub := range cmd.Commands() {
+		if !sub.IsAvailableCommand() {
+			continue
+		}
+
+		fmt.Fprintf(buf, "complete -c %s -n '%s' -a %s -d %s\n", name, condition, sub.Name(), fishQuote(sub.Short))
+
+		genFishCommand(buf, name, helper, sub, append(append([]string{}, path...), sub.Name()))
+	}
+}
+
+func fishQuote(s string) string {
+	return "'" + strings.ReplaceAll(strings.ReplaceAll(s, `\`, `\\`), "'", `\'`) + "'"
+}
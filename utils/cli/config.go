@@ -0,0 +1,358 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	toml "github.com/pelletier/go-toml"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+const (
+	// the height and the hash of a block of the pinned chain.
+	ConfigTrustHeight = "trust-height"
+	ConfigTrustHash   = "trust-hash"
+
+	flagGet = "get"
+)
+
+// the string configuration keys along with their defaults.
+var configDefaults = map[string]string{
+	client.FlagChainID:       "",
+	"output":                 "text",
+	client.FlagNode:          "tcp://localhost:26657",
+	client.FlagBroadcastMode: "sync",
+	ConfigTrustHash:          "",
+}
+
+var configBoolKeys = map[string]bool{
+	"trace":              true,
+	client.FlagTrustNode: true,
+	"indent":             true,
+}
+
+// ConfigCmd returns the command creating or querying the CLI configuration file.
+// Besides the Cosmos SDK keys, the configuration pins the chain: the CLI refuses to broadcast transactions to a node
+// of another chain (see PinnedChain).
+func ConfigCmd(defaultCLIHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config <key> [value]",
+		Short: "Create or query an application CLI configuration file",
+		Long: `Create or query an application CLI configuration file.
+Supported keys: chain-id, node, output, broadcast-mode, trace, trust-node, indent, trust-height and trust-hash
+(the height and the hex-encoded hash of a block of the pinned chain).
+The transactions are broadcasted only to the nodes of the configured chain-id having the block with trust-hash
+at trust-height (if set). Use "config pin" to pin the chain of the configured node.`,
+		RunE: runConfigCmd,
+		Args: cobra.RangeArgs(0, 2),
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultCLIHome, "set client's home directory for configuration")
+	cmd.Flags().Bool(flagGet, false, "print configuration value or its default if unset")
+
+	cmd.AddCommand(configPinCmd(defaultCLIHome))
+
+	return cmd
+}
+
+// Pins the chain of the configured node: stores its chain ID and the hash of a block.
+func configPinCmd(defaultCLIHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pin [height]",
+		Short: "Pin the chain ID and the block hash at the given height (1 by default) of the configured node",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height := int64(1)
+
+			if len(args) == 1 {
+				var err error
+				if height, err = strconv.ParseInt(args[0], 10, 64); err != nil || height <= 0 {
+					return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid height %q: must be a positive number", args[0]))
+				}
+			}
+
+			// the node is not verified by the light client: the pinned block is going to be its trust root
+			node := rpcclient.NewHTTP(viper.GetString(flags.FlagNode), "/websocket")
+
+			status, err := node.Status()
+			if err != nil {
+				return err
+			}
+
+			block, err := node.Block(&height)
+			if err != nil {
+				return err
+			}
+
+			cfgFile, tree, err := loadConfig(viper.GetString(flags.FlagHome))
+			if err != nil {
+				return err
+			}
+
+			tree.Set(client.FlagChainID, status.NodeInfo.Network)
+			tree.Set(ConfigTrustHeight, height)
+			tree.Set(ConfigTrustHash, block.BlockMeta.BlockID.Hash.String())
+
+			if err = saveConfigFile(cfgFile, tree); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(os.Stderr, "chain %s pinned by the block %s at height %d, configuration saved to %s\n",
+				status.NodeInfo.Network, block.BlockMeta.BlockID.Hash, height, cfgFile)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultCLIHome, "set client's home directory for configuration")
+
+	return cmd
+}
+
+func runConfigCmd(cmd *cobra.Command, args []string) error {
+	cfgFile, tree, err := loadConfig(viper.GetString(flags.FlagHome))
+	if err != nil {
+		return err
+	}
+
+	getAction := viper.GetBool(flagGet)
+	if getAction && len(args) != 1 {
+		return fmt.Errorf("wrong number of arguments")
+	}
+
+	// print the config and exit
+	if len(args) == 0 {
+		s, err := tree.ToTomlString()
+		if err != nil {
+			return err
+		}
+
+		fmt.Print(s)
+
+		return nil
+	}
+
+	key := args[0]
+
+	// get config value for a given key
+	if getAction {
+		switch {
+		case configBoolKeys[key]:
+			fmt.Println(tree.GetDefault(key, false).(bool))
+		case key == ConfigTrustHeight:
+			fmt.Println(tree.GetDefault(key, int64(0)).(int64))
+		default:
+			defaultValue, ok := configDefaults[key]
+			if !ok {
+				return errUnknownConfigKey(key)
+			}
+
+			fmt.Println(tree.GetDefault(key, defaultValue).(string))
+		}
+
+		return nil
+	}
+
+	if len(args) != 2 {
+		return fmt.Errorf("wrong number of arguments")
+	}
+
+	if err = setConfigValue(tree, key, args[1]); err != nil {
+		return err
+	}
+
+	// save configuration to disk
+	if err := saveConfigFile(cfgFile, tree); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "configuration saved to %s\n", cfgFile)
+
+	return nil
+}
+
+func setConfigValue(tree *toml.Tree, key string, value string) error {
+	switch {
+	case configBoolKeys[key]:
+		boolVal, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+
+		tree.Set(key, boolVal)
+	case key == ConfigTrustHeight:
+		height, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || height < 0 {
+			return fmt.Errorf("%s must be a non-negative number", key)
+		}
+
+		tree.Set(key, height)
+	case key == ConfigTrustHash:
+		if _, err := hex.DecodeString(value); err != nil {
+			return fmt.Errorf("%s must be a hex-encoded hash", key)
+		}
+
+		tree.Set(key, strings.ToUpper(value))
+	default:
+		if _, ok := configDefaults[key]; !ok {
+			return errUnknownConfigKey(key)
+		}
+
+		tree.Set(key, value)
+	}
+
+	return nil
+}
+
+func loadConfig(home string) (string, *toml.Tree, error) {
+	cfgPath := filepath.Join(home, "config")
+	if err := os.MkdirAll(cfgPath, os.ModePerm); err != nil {
+		return "", nil, err
+	}
+
+	cfgFile := filepath.Join(cfgPath, "config.toml")
+
+	if _, err := os.Stat(cfgFile); os.IsNotExist(err) {
+		fmt.Fprintf(os.Stderr, "%s does not exist\n", cfgFile)
+
+		tree, err := toml.Load(``)
+
+		return cfgFile, tree, err
+	}
+
+	bz, err := ioutil.ReadFile(cfgFile)
+	if err != nil {
+		return "", nil, err
+	}
+
+	tree, err := toml.LoadBytes(bz)
+
+	return cfgFile, tree, err
+}
+
+func saveConfigFile(cfgFile string, tree *toml.Tree) error {
+	fp, err := os.OpenFile(cfgFile, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0o644)
+	if err != nil {
+		return err
+	}
+	defer fp.Close()
+
+	_, err = tree.WriteTo(fp)
+
+	return err
+}
+
+func errUnknownConfigKey(key string) error {
+	return fmt.Errorf("unknown configuration key: %q", key)
+}
+
+/*
+	Pinned chain
+*/
+
+// PinnedChain is the chain the CLI is configured for: the chain ID and, optionally, the hash of a block.
+// The block hash distinguishes the networks started with the same chain ID (e.g. the reset test networks).
+type PinnedChain struct {
+	ChainID     string
+	TrustHeight int64
+	TrustHash   string
+}
+
+// PinnedChainFromConfig returns the chain pinned in the CLI configuration (or overridden by the flags).
+func PinnedChainFromConfig() PinnedChain {
+	return PinnedChain{
+		ChainID:     viper.GetString(client.FlagChainID),
+		TrustHeight: viper.GetInt64(ConfigTrustHeight),
+		TrustHash:   viper.GetString(ConfigTrustHash),
+	}
+}
+
+// the node RPC methods required to verify the chain.
+type chainNode interface {
+	Status() (*ctypes.ResultStatus, error)
+	Block(height *int64) (*ctypes.ResultBlock, error)
+}
+
+// Verify checks that the node belongs to the pinned chain.
+func (p PinnedChain) Verify(node chainNode) error {
+	if len(p.ChainID) == 0 {
+		return nil
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Could not get the node status: %v", err))
+	}
+
+	if status.NodeInfo.Network != p.ChainID {
+		return sdk.ErrUnauthorized(fmt.Sprintf("The node belongs to the chain %q but the CLI is configured for %q, "+
+			"the transaction is not broadcasted", status.NodeInfo.Network, p.ChainID))
+	}
+
+	if p.TrustHeight <= 0 || len(p.TrustHash) == 0 {
+		return nil
+	}
+
+	height := p.TrustHeight
+
+	block, err := node.Block(&height)
+	if err != nil {
+		return sdk.ErrInternal(fmt.Sprintf("Could not get the block at the trust height %d: %v", height, err))
+	}
+
+	if hash := block.BlockMeta.BlockID.Hash.String(); !strings.EqualFold(hash, p.TrustHash) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("The block of the node at the trust height %d has hash %s "+
+			"but the CLI is configured for %s, the transaction is not broadcasted", height, hash, p.TrustHash))
+	}
+
+	return nil
+}
+
+// Checks that the node the transactions are broadcasted to belongs to the pinned chain.
+func (ctx CliContext) verifyPinnedChain() error {
+	node, err := ctx.context.GetNode()
+	if err != nil {
+		return err
+	}
+
+	return PinnedChainFromConfig().Verify(node)
+}
+
+// VerifyPinnedChainBefore makes the command broadcasting transactions check the node chain first.
+func VerifyPinnedChainBefore(cmd *cobra.Command) *cobra.Command {
+	runE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if err := NewCLIContext().verifyPinnedChain(); err != nil {
+			return err
+		}
+
+		return runE(cmd, args)
+	}
+
+	return cmd
+}
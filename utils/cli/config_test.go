@@ -0,0 +1,81 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package cli
+
+import (
+	"fmt"
+	"testing"
+
+	toml "github.com/pelletier/go-toml"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/p2p"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+type testNode struct {
+	chainID string
+	hashes  map[int64][]byte
+}
+
+func (n testNode) Status() (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{NodeInfo: p2p.DefaultNodeInfo{Network: n.chainID}}, nil
+}
+
+func (n testNode) Block(height *int64) (*ctypes.ResultBlock, error) {
+	hash, ok := n.hashes[*height]
+	if !ok {
+		return nil, fmt.Errorf("height %d must be less than or equal to the current blockchain height", *height)
+	}
+
+	return &ctypes.ResultBlock{BlockMeta: &tmtypes.BlockMeta{BlockID: tmtypes.BlockID{Hash: hash}}}, nil
+}
+
+func TestPinnedChain_Verify(t *testing.T) {
+	node := testNode{chainID: "dclchain", hashes: map[int64][]byte{1: {0xAB, 0xCD}}}
+
+	// nothing is pinned
+	require.NoError(t, PinnedChain{}.Verify(node))
+
+	// chain ID only
+	require.NoError(t, PinnedChain{ChainID: "dclchain"}.Verify(node))
+	require.Error(t, PinnedChain{ChainID: "testchain"}.Verify(node))
+
+	// chain ID and block hash
+	require.NoError(t, PinnedChain{ChainID: "dclchain", TrustHeight: 1, TrustHash: "abcd"}.Verify(node))
+	require.Error(t, PinnedChain{ChainID: "dclchain", TrustHeight: 1, TrustHash: "ABCE"}.Verify(node))
+	require.Error(t, PinnedChain{ChainID: "dclchain", TrustHeight: 2, TrustHash: "ABCD"}.Verify(node))
+}
+
+func TestSetConfigValue(t *testing.T) {
+	tree, err := toml.Load(``)
+	require.NoError(t, err)
+
+	require.NoError(t, setConfigValue(tree, "chain-id", "dclchain"))
+	require.NoError(t, setConfigValue(tree, "trust-node", "true"))
+	require.NoError(t, setConfigValue(tree, ConfigTrustHeight, "10"))
+	require.NoError(t, setConfigValue(tree, ConfigTrustHash, "abcd"))
+
+	require.Equal(t, "dclchain", tree.Get("chain-id"))
+	require.Equal(t, true, tree.Get("trust-node"))
+	require.Equal(t, int64(10), tree.Get(ConfigTrustHeight))
+	require.Equal(t, "ABCD", tree.Get(ConfigTrustHash))
+
+	require.Error(t, setConfigValue(tree, "trust-node", "yes"))
+	require.Error(t, setConfigValue(tree, ConfigTrustHeight, "-1"))
+	require.Error(t, setConfigValue(tree, ConfigTrustHash, "xyz"))
+	require.Error(t, setConfigValue(tree, "unknown", "value"))
+}
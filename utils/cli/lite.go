@@ -0,0 +1,117 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/lite"
+	lclient "github.com/tendermint/tendermint/lite/client"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	dbm "github.com/tendermint/tm-db"
+)
+
+/*
+	Light client trust root
+*/
+
+// the directory of the light client verifier created by Cosmos SDK CLI context for not trusted nodes.
+const liteVerifierDir = ".lite_verifier"
+
+// the file storing the trust root the verifier is initialized from.
+const trustRootFile = "trust_root"
+
+// the trust root the verifier is checked to be initialized from in this process.
+var initializedTrustRoot string
+
+// InitTrustRoot initializes the light client verifying the responses of not trusted nodes (`trust-node = false`)
+// from the pinned block (`trust-height` and `trust-hash`) instead of trusting the first block the node returns.
+// The headers of the following blocks are verified by the light client against the validator sets starting from
+// the trust root, so the query results are verified even if the node is not trusted.
+// The verifier is reset if the pinned block changes. It is kept as is if no block is pinned.
+func InitTrustRoot() error {
+	if !viper.IsSet(flags.FlagTrustNode) || viper.GetBool(flags.FlagTrustNode) {
+		return nil
+	}
+
+	pinned := PinnedChainFromConfig()
+	if len(pinned.ChainID) == 0 || pinned.TrustHeight <= 0 || len(pinned.TrustHash) == 0 {
+		return nil
+	}
+
+	dir := filepath.Join(viper.GetString(flags.FlagHome), liteVerifierDir)
+	root := fmt.Sprintf("%s/%d/%s", pinned.ChainID, pinned.TrustHeight, strings.ToUpper(pinned.TrustHash))
+
+	if root == initializedTrustRoot {
+		return nil
+	}
+
+	if bytes, err := ioutil.ReadFile(filepath.Join(dir, trustRootFile)); err == nil && string(bytes) == root {
+		initializedTrustRoot = root
+
+		return nil
+	}
+
+	node := rpcclient.NewHTTP(viper.GetString(flags.FlagNode), "/websocket")
+
+	commit, err := lclient.NewProvider(pinned.ChainID, node).
+		LatestFullCommit(pinned.ChainID, pinned.TrustHeight, pinned.TrustHeight)
+	if err != nil {
+		return errors.Wrapf(err, "fetching full commit @ trust height %d", pinned.TrustHeight)
+	}
+
+	if err = commit.ValidateFull(pinned.ChainID); err != nil {
+		return errors.Wrapf(err, "validating full commit @ trust height %d", pinned.TrustHeight)
+	}
+
+	if hash := commit.SignedHeader.Hash().String(); !strings.EqualFold(hash, pinned.TrustHash) {
+		return fmt.Errorf("the block of the node at the trust height %d has hash %s but %s is pinned",
+			pinned.TrustHeight, hash, pinned.TrustHash)
+	}
+
+	// the verifier trusting other blocks is dropped
+	if err = os.RemoveAll(dir); err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	// the same store is opened by the verifier of Cosmos SDK CLI context which finds the trusted commit
+	db := dbm.NewDB("trust-base", dbm.GoLevelDBBackend, dir)
+	err = lite.NewDBProvider("trusted.lvl", db).SaveFullCommit(commit)
+
+	db.Close()
+
+	if err != nil {
+		return errors.Wrap(err, "saving full commit to trusted")
+	}
+
+	if err = ioutil.WriteFile(filepath.Join(dir, trustRootFile), []byte(root), 0o600); err != nil {
+		return err
+	}
+
+	initializedTrustRoot = root
+
+	return nil
+}
@@ -0,0 +1,239 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/input"
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/cli"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/canonicaljson"
+)
+
+const (
+	ShellPrompt = "> "
+	shellCmd    = "shell"
+)
+
+// Interactive session started by the shell command: the keys are unlocked once per session,
+// the passphrases are kept in memory until the session ends.
+type session struct {
+	input       *bufio.Reader
+	passphrases map[string]string
+}
+
+// Current interactive session (nil outside of the shell).
+var currentSession *session
+
+// ShellCmd runs the commands of the root command created by `newRootCmd` line by line in an interactive session.
+// The passphrase of a key is asked on its first use only.
+func ShellCmd(newRootCmd func() *cobra.Command, envPrefix string, defaultHome string) *cobra.Command {
+	return &cobra.Command{
+		Use:   shellCmd,
+		Short: "Run commands in an interactive session keeping the used keys unlocked",
+		Long: `Run commands in an interactive session. The commands are entered without the binary name,
+e.g. "tx pki approve-add-x509-root-cert --subject=... --from=jack".
+The passphrase of a key is asked once on its first use and kept in memory until the session ends.
+Every transaction is still shown for confirmation before signing (unless --yes flag is passed).
+Enter "exit" or press Ctrl+D to end the session.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			currentSession = &session{input: bufio.NewReader(os.Stdin), passphrases: map[string]string{}}
+			defer func() { currentSession = nil }()
+
+			name := cmd.Root().Name()
+
+			for {
+				_, _ = fmt.Fprint(os.Stderr, name+ShellPrompt)
+
+				line, err := currentSession.input.ReadString('\n')
+				if err != nil && (err != io.EOF || len(line) == 0) {
+					_, _ = fmt.Fprintln(os.Stderr)
+
+					if err == io.EOF {
+						return nil
+					}
+
+					return err
+				}
+
+				args, err := SplitArgs(line)
+				if err != nil {
+					_, _ = fmt.Fprintf(os.Stderr, "ERROR: %v\n", err)
+
+					continue
+				}
+
+				if len(args) > 0 && args[0] == name {
+					args = args[1:]
+				}
+
+				if len(args) == 0 {
+					continue
+				}
+
+				switch args[0] {
+				case "exit", "quit":
+					return nil
+				case shellCmd:
+					_, _ = fmt.Fprintln(os.Stderr, "ERROR: the session is already started")
+
+					continue
+				}
+
+				// the command tree is created for every line, so the flags of the previous commands are not kept
+				rootCmd := newRootCmd()
+				rootCmd.SetArgs(args)
+
+				executor := cli.PrepareMainCmd(rootCmd, envPrefix, defaultHome)
+				executor.Exit = func(int) {}
+
+				_ = executor.Execute()
+			}
+		},
+	}
+}
+
+// Returns the passphrase of the key: the one unlocked in the session or the entered one.
+func keyPassphrase(name string) (string, error) {
+	if currentSession != nil {
+		if passphrase, ok := currentSession.passphrases[name]; ok {
+			return passphrase, nil
+		}
+
+		return input.GetPassword(fmt.Sprintf("Password to sign with '%s':", name), currentSession.input)
+	}
+
+	return keys.GetPassphrase(name)
+}
+
+// Keeps the passphrase of the key (checked by a successful signing) unlocked until the session ends.
+func unlock(name string, passphrase string) {
+	if currentSession != nil {
+		currentSession.passphrases[name] = passphrase
+	}
+}
+
+// Signs the transaction with the key unlocked in the session and broadcasts it.
+// The transaction is shown for confirmation before signing unless `--yes` flag is passed.
+func (ctx CliContext) completeAndBroadcastInSession(txBldr auth.TxBuilder, msgs []sdk.Msg) error {
+	txBldr, err := utils.PrepareTxBuilder(txBldr, ctx.context)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.context.SkipConfirm {
+		signMsg, err := txBldr.BuildSignMsg(msgs)
+		if err != nil {
+			return err
+		}
+
+		out, err := ctx.context.Codec.MarshalJSONIndent(signMsg, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
+
+		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", currentSession.input)
+		if err != nil || !ok {
+			_, _ = fmt.Fprintln(os.Stderr, "cancelled transaction")
+
+			return err
+		}
+	}
+
+	name := ctx.context.GetFromName()
+
+	passphrase, err := keyPassphrase(name)
+	if err != nil {
+		return err
+	}
+
+	txBytes, err := canonicaljson.BuildAndSign(txBldr, name, passphrase, msgs)
+	if err != nil {
+		return err
+	}
+
+	unlock(name, passphrase)
+
+	res, err := ctx.context.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	return ctx.context.PrintOutput(res)
+}
+
+// SplitArgs splits the command line into the arguments separated by spaces.
+// Single and double quotes group the arguments, backslash escapes the next character (except in single quotes).
+func SplitArgs(line string) ([]string, error) {
+	var (
+		args    []string
+		current strings.Builder
+		inArg   bool
+		quote   rune
+		escaped bool
+	)
+
+	for _, r := range line {
+		switch {
+		case escaped:
+			current.WriteRune(r)
+
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inArg = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote, inArg = r, true
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+
+			inArg = true
+		}
+	}
+
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape")
+	}
+
+	if inArg {
+		args = append(args, current.String())
+	}
+
+	return args, nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitArgs(t *testing.T) {
+	cases := map[string][]string{
+		"":                                   nil,
+		"  query modelinfo model --vid=1 \n": {"query", "modelinfo", "model", "--vid=1"},
+		`tx modelinfo add-model --name="My Model"`: {"tx", "modelinfo", "add-model", "--name=My Model"},
+		`--description 'it''s' --sku=a\ b`:         {"--description", "its", "--sku=a b"},
+		`--custom '{"a": "\n"}'`:                   {"--custom", `{"a": "\n"}`},
+		`--name ""`:                                {"--name", ""},
+	}
+
+	for line, expected := range cases {
+		args, err := SplitArgs(line)
+		require.NoError(t, err, line)
+		require.Equal(t, expected, args, line)
+	}
+
+	_, err := SplitArgs(`--name "unterminated`)
+	require.Error(t, err)
+}
+
+func TestGenFishCompletion(t *testing.T) {
+	rootCmd := &cobra.Command{Use: "dclcli"}
+	rootCmd.PersistentFlags().String("home", "", "directory for config and data")
+
+	queryCmd := &cobra.Command{Use: "query", Short: "Querying subcommands"}
+	modelCmd := &cobra.Command{Use: "model", Short: "Query Model", Run: func(*cobra.Command, []string) {}}
+	modelCmd.Flags().Int16("vid", 0, "Model vendor ID")
+	modelCmd.Flags().Bool("prev-height", false, "Query data from previous height")
+	queryCmd.AddCommand(modelCmd)
+	rootCmd.AddCommand(queryCmd)
+
+	var buf bytes.Buffer
+	require.NoError(t, GenFishCompletion(rootCmd, &buf))
+
+	script := buf.String()
+	require.Contains(t, script, "function __fish_dclcli_command\n")
+	require.Contains(t, script, "complete -c dclcli -n 'true' -l home -r -d 'directory for config and data'\n")
+	require.Contains(t, script, "complete -c dclcli -n '__fish_dclcli_command' -a query -d 'Querying subcommands'\n")
+	require.Contains(t, script, "complete -c dclcli -n '__fish_dclcli_command query' -a model -d 'Query Model'\n")
+	require.Contains(t, script,
+		"complete -c dclcli -n '__fish_dclcli_command -p query model' -l vid -r -d 'Model vendor ID'\n")
+	require.Contains(t, script, "complete -c dclcli -n '__fish_dclcli_command -p query model' "+
+		"-l prev-height -d 'Query data from previous height'\n")
+}
@@ -0,0 +1,67 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/canonicaljson"
+)
+
+// SignBytesCmd prints the bytes to be signed by an external signer (e.g. HSM service or JS SDK)
+// for the transaction generated with `--generate-only` flag.
+func SignBytesCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-bytes [file]",
+		Short: "Print the canonical sign bytes of the transaction generated offline",
+		Long: `Print the bytes to be signed by the signer with the given account number and sequence
+for the transaction read from the file (generated with --generate-only flag).
+The bytes are the canonical JSON form of the sign document (see utils/canonicaljson) and are printed as is,
+without a trailing newline, so they can be passed to an external signer.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			stdTx, err := utils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			chainID := viper.GetString(client.FlagChainID)
+			if len(chainID) == 0 {
+				return fmt.Errorf("chain ID must be set with --%s flag", client.FlagChainID)
+			}
+
+			signBytes := canonicaljson.SignBytes(chainID, viper.GetUint64(flags.FlagAccountNumber),
+				viper.GetUint64(flags.FlagSequence), stdTx.Fee, stdTx.Msgs, stdTx.Memo)
+
+			_, err = os.Stdout.Write(signBytes)
+
+			return err
+		},
+	}
+
+	cmd.Flags().Uint64(flags.FlagAccountNumber, 0, "The account number of the signer")
+	cmd.Flags().Uint64(flags.FlagSequence, 0, "The sequence number of the signer")
+	_ = cmd.MarkFlagRequired(flags.FlagAccountNumber)
+	_ = cmd.MarkFlagRequired(flags.FlagSequence)
+
+	return cmd
+}
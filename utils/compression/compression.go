@@ -0,0 +1,199 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package compression compresses the REST responses (e.g. large lists of certificates or models)
+// with gzip if the client accepts it (`Accept-Encoding: gzip`).
+package compression
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	FlagCompression        = "compression"
+	FlagCompressionUsage   = "Compress the responses with gzip if the client accepts it"
+	FlagMinSize            = "compression-min-size"
+	FlagMinSizeUsage       = "Minimal size of the response in bytes to be compressed"
+	DefaultMinSize         = 1024
+	encodingGzip           = "gzip"
+	headerAcceptEncoding   = "Accept-Encoding"
+	headerContentEncoding  = "Content-Encoding"
+	headerContentLength    = "Content-Length"
+	headerVary             = "Vary"
+	headerUpgrade          = "Upgrade"
+	qualityParameterPrefix = "q="
+)
+
+type Config struct {
+	Enabled bool
+	// Smaller responses are not compressed: the compression would not reduce their size much.
+	MinSize int
+}
+
+// Adds the compression flags to the REST server command.
+func AddFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().Bool(FlagCompression, true, FlagCompressionUsage)
+	cmd.Flags().Int(FlagMinSize, DefaultMinSize, FlagMinSizeUsage)
+
+	return cmd
+}
+
+func ConfigFromFlags() Config {
+	return Config{
+		Enabled: viper.GetBool(FlagCompression),
+		MinSize: viper.GetInt(FlagMinSize),
+	}
+}
+
+// Creates the middleware compressing the responses or returns nil if the compression is disabled.
+// The WebSocket requests are never compressed.
+func NewMiddleware(config Config) mux.MiddlewareFunc {
+	if !config.Enabled {
+		return nil
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if len(r.Header.Get(headerUpgrade)) != 0 {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			w.Header().Add(headerVary, headerAcceptEncoding)
+
+			if !acceptsGzip(r.Header.Get(headerAcceptEncoding)) {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			cw := &compressWriter{ResponseWriter: w, minSize: config.MinSize, status: http.StatusOK}
+			defer cw.Close()
+
+			next.ServeHTTP(cw, r)
+		})
+	}
+}
+
+// Returns whether gzip is listed in Accept-Encoding header with a non-zero quality.
+func acceptsGzip(acceptEncoding string) bool {
+	for _, item := range strings.Split(acceptEncoding, ",") {
+		parts := strings.Split(item, ";")
+
+		encoding := strings.ToLower(strings.TrimSpace(parts[0]))
+		if encoding != encodingGzip && encoding != "*" {
+			continue
+		}
+
+		quality := 1.0
+
+		for _, param := range parts[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, qualityParameterPrefix) {
+				if q, err := strconv.ParseFloat(strings.TrimPrefix(param, qualityParameterPrefix), 64); err == nil {
+					quality = q
+				}
+			}
+		}
+
+		return quality > 0
+	}
+
+	return false
+}
+
+// Buffers the response until its size reaches the minimal one, then writes it compressed.
+// The smaller responses are written as is.
+type compressWriter struct {
+	http.ResponseWriter
+	minSize int
+
+	status  int
+	buf     []byte
+	started bool
+	gz      *gzip.Writer
+}
+
+func (w *compressWriter) WriteHeader(status int) {
+	if !w.started {
+		w.status = status
+	}
+}
+
+func (w *compressWriter) Write(p []byte) (int, error) {
+	if w.gz != nil {
+		return w.gz.Write(p)
+	}
+
+	if w.started {
+		return w.ResponseWriter.Write(p)
+	}
+
+	w.buf = append(w.buf, p...)
+
+	if len(w.buf) >= w.minSize {
+		if err := w.start(true); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(p), nil
+}
+
+// Close writes the rest of the response.
+func (w *compressWriter) Close() error {
+	if !w.started {
+		return w.start(false)
+	}
+
+	if w.gz != nil {
+		return w.gz.Close()
+	}
+
+	return nil
+}
+
+func (w *compressWriter) start(compress bool) error {
+	w.started = true
+
+	header := w.Header()
+	if compress && len(header.Get(headerContentEncoding)) == 0 {
+		header.Set(headerContentEncoding, encodingGzip)
+		header.Del(headerContentLength)
+		w.ResponseWriter.WriteHeader(w.status)
+
+		w.gz = gzip.NewWriter(w.ResponseWriter)
+		_, err := w.gz.Write(w.buf)
+
+		return err
+	}
+
+	w.ResponseWriter.WriteHeader(w.status)
+
+	if len(w.buf) == 0 {
+		return nil
+	}
+
+	_, err := w.ResponseWriter.Write(w.buf)
+
+	return err
+}
@@ -0,0 +1,89 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package compression
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func serve(t *testing.T, body string, acceptEncoding string) *httptest.ResponseRecorder {
+	handler := NewMiddleware(Config{Enabled: true, MinSize: 16})(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, err := w.Write([]byte(body))
+			require.NoError(t, err)
+		}))
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/pki/certs", nil)
+	r.Header.Set(headerAcceptEncoding, acceptEncoding)
+	handler.ServeHTTP(w, r)
+
+	return w
+}
+
+func TestMiddleware(t *testing.T) {
+	body := strings.Repeat(`{"subject":"CN=Root"}`, 100)
+
+	w := serve(t, body, "br, gzip;q=0.8")
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Equal(t, encodingGzip, w.Header().Get(headerContentEncoding))
+	require.Equal(t, headerAcceptEncoding, w.Header().Get(headerVary))
+	require.True(t, w.Body.Len() < len(body))
+
+	reader, err := gzip.NewReader(w.Body)
+	require.NoError(t, err)
+
+	decompressed, err := ioutil.ReadAll(reader)
+	require.NoError(t, err)
+	require.Equal(t, body, string(decompressed))
+
+	// not accepted
+	for _, acceptEncoding := range []string{"", "br", "gzip;q=0"} {
+		w = serve(t, body, acceptEncoding)
+		require.Equal(t, http.StatusCreated, w.Code)
+		require.Empty(t, w.Header().Get(headerContentEncoding))
+		require.Equal(t, body, w.Body.String())
+	}
+
+	// too small
+	w = serve(t, `{}`, "gzip")
+	require.Equal(t, http.StatusCreated, w.Code)
+	require.Empty(t, w.Header().Get(headerContentEncoding))
+	require.Equal(t, `{}`, w.Body.String())
+}
+
+func TestNewMiddleware_Disabled(t *testing.T) {
+	require.Nil(t, NewMiddleware(Config{Enabled: false}))
+}
+
+func TestAcceptsGzip(t *testing.T) {
+	require.True(t, acceptsGzip("gzip"))
+	require.True(t, acceptsGzip("deflate, GZIP"))
+	require.True(t, acceptsGzip("*"))
+	require.True(t, acceptsGzip("gzip;q=0.5"))
+	require.False(t, acceptsGzip("gzip;q=0"))
+	require.False(t, acceptsGzip("deflate, br"))
+	require.False(t, acceptsGzip(""))
+}
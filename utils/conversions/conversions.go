@@ -64,3 +64,21 @@ func ParseCID(str string) (uint16, sdk.Error) {
 
 	return res, nil
 }
+
+func ParseUInt32FromString(str string) (uint32, sdk.Error) {
+	val, err := strconv.ParseUint(str, 10, 32)
+	if err != nil {
+		return 0, sdk.ErrUnknownRequest(fmt.Sprintf("Parsing Error: \"%v\" must be 32 bit unsigned integer", str))
+	}
+
+	return uint32(val), nil
+}
+
+func ParseUInt64FromString(str string) (uint64, sdk.Error) {
+	val, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return 0, sdk.ErrUnknownRequest(fmt.Sprintf("Parsing Error: \"%v\" must be 64 bit unsigned integer", str))
+	}
+
+	return val, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package errcodes is the registry of the stable error codes returned by the ledger.
+// Every module registers its codes with their names, so clients can branch on
+// (codespace, code) pairs or the names instead of parsing the error messages.
+// The registered codes must never be changed or reused for another error.
+package errcodes
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type ErrorCode struct {
+	Codespace sdk.CodespaceType `json:"codespace"`
+	Code      sdk.CodeType      `json:"code"`
+	Name      string            `json:"name"` // e.g. `model_info_already_exists`
+}
+
+func (c ErrorCode) String() string {
+	return fmt.Sprintf("%s/%d (%s)", c.Codespace, c.Code, c.Name)
+}
+
+type registryKey struct {
+	codespace sdk.CodespaceType
+	code      sdk.CodeType
+}
+
+var (
+	mu       sync.RWMutex
+	registry = map[registryKey]ErrorCode{}
+)
+
+func init() {
+	Register(sdk.CodespaceRoot, sdk.CodeInternal, "internal")
+	Register(sdk.CodespaceRoot, sdk.CodeTxDecode, "tx_decode")
+	Register(sdk.CodespaceRoot, sdk.CodeInvalidSequence, "invalid_sequence")
+	Register(sdk.CodespaceRoot, sdk.CodeUnauthorized, "unauthorized")
+	Register(sdk.CodespaceRoot, sdk.CodeInsufficientFunds, "insufficient_funds")
+	Register(sdk.CodespaceRoot, sdk.CodeUnknownRequest, "unknown_request")
+	Register(sdk.CodespaceRoot, sdk.CodeInvalidAddress, "invalid_address")
+	Register(sdk.CodespaceRoot, sdk.CodeInvalidPubKey, "invalid_pub_key")
+	Register(sdk.CodespaceRoot, sdk.CodeUnknownAddress, "unknown_address")
+	Register(sdk.CodespaceRoot, sdk.CodeInsufficientCoins, "insufficient_coins")
+	Register(sdk.CodespaceRoot, sdk.CodeInvalidCoins, "invalid_coins")
+	Register(sdk.CodespaceRoot, sdk.CodeOutOfGas, "out_of_gas")
+	Register(sdk.CodespaceRoot, sdk.CodeMemoTooLarge, "memo_too_large")
+	Register(sdk.CodespaceRoot, sdk.CodeInsufficientFee, "insufficient_fee")
+	Register(sdk.CodespaceRoot, sdk.CodeTooManySignatures, "too_many_signatures")
+	Register(sdk.CodespaceRoot, sdk.CodeGasOverflow, "gas_overflow")
+	Register(sdk.CodespaceRoot, sdk.CodeNoSignatures, "no_signatures")
+}
+
+// Register adds the code to the registry. It panics if the code or the name is already registered
+// in the codespace, as it is a programming error.
+func Register(codespace sdk.CodespaceType, code sdk.CodeType, name string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	key := registryKey{codespace: codespace, code: code}
+
+	if existing, ok := registry[key]; ok {
+		panic(fmt.Sprintf("error code %v is already registered", existing))
+	}
+
+	for _, existing := range registry {
+		if existing.Codespace == codespace && existing.Name == name {
+			panic(fmt.Sprintf("error code name %v is already registered for %v", name, existing))
+		}
+	}
+
+	registry[key] = ErrorCode{Codespace: codespace, Code: code, Name: name}
+}
+
+// Lookup returns the registered code.
+func Lookup(codespace sdk.CodespaceType, code sdk.CodeType) (ErrorCode, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	errorCode, ok := registry[registryKey{codespace: codespace, code: code}]
+
+	return errorCode, ok
+}
+
+// All returns all registered codes ordered by codespace and code.
+func All() []ErrorCode {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	res := make([]ErrorCode, 0, len(registry))
+	for _, errorCode := range registry {
+		res = append(res, errorCode)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Codespace != res[j].Codespace {
+			return res[i].Codespace < res[j].Codespace
+		}
+
+		return res[i].Code < res[j].Code
+	})
+
+	return res
+}
+
+// Of returns the code carried by the error: either sdk.Error or an error returned by ABCI query
+// (its message is the JSON encoded log of sdk.Error). The name is empty for unregistered codes.
+func Of(err error) (ErrorCode, bool) {
+	var codespace sdk.CodespaceType
+
+	var code sdk.CodeType
+
+	if sdkErr, ok := err.(sdk.Error); ok {
+		codespace, code = sdkErr.Codespace(), sdkErr.Code()
+	} else {
+		var log struct {
+			Codespace sdk.CodespaceType `json:"codespace"`
+			Code      sdk.CodeType      `json:"code"`
+		}
+
+		if json.Unmarshal([]byte(err.Error()), &log) != nil {
+			return ErrorCode{}, false
+		}
+
+		codespace, code = log.Codespace, log.Code
+	}
+
+	if code == sdk.CodeOK {
+		return ErrorCode{}, false
+	}
+
+	if errorCode, ok := Lookup(codespace, code); ok {
+		return errorCode, true
+	}
+
+	return ErrorCode{Codespace: codespace, Code: code}, true
+}
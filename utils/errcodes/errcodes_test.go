@@ -0,0 +1,78 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package errcodes
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testCodespace sdk.CodespaceType = "errcodes_test"
+
+func TestRegister(t *testing.T) {
+	Register(testCodespace, 1, "first_error")
+	Register(testCodespace, 2, "second_error")
+
+	errorCode, ok := Lookup(testCodespace, 1)
+	require.True(t, ok)
+	require.Equal(t, ErrorCode{Codespace: testCodespace, Code: 1, Name: "first_error"}, errorCode)
+
+	_, ok = Lookup(testCodespace, 3)
+	require.False(t, ok)
+
+	// duplicate code
+	require.Panics(t, func() { Register(testCodespace, 1, "another_error") })
+
+	// duplicate name
+	require.Panics(t, func() { Register(testCodespace, 3, "second_error") })
+
+	// the same name in another codespace
+	require.NotPanics(t, func() { Register(testCodespace+"_other", 1, "first_error") })
+}
+
+func TestAll(t *testing.T) {
+	all := All()
+	require.NotEmpty(t, all)
+
+	for i := 1; i < len(all); i++ {
+		prev, cur := all[i-1], all[i]
+		require.True(t, prev.Codespace < cur.Codespace || (prev.Codespace == cur.Codespace && prev.Code < cur.Code))
+	}
+}
+
+func TestOf(t *testing.T) {
+	// sdk.Error
+	errorCode, ok := Of(sdk.ErrUnauthorized("no permissions"))
+	require.True(t, ok)
+	require.Equal(t, ErrorCode{Codespace: sdk.CodespaceRoot, Code: sdk.CodeUnauthorized, Name: "unauthorized"}, errorCode)
+
+	// ABCI query error
+	errorCode, ok = Of(errors.New(sdk.ErrUnknownRequest("unknown").Result().Log))
+	require.True(t, ok)
+	require.Equal(t, "unknown_request", errorCode.Name)
+
+	// unregistered code
+	errorCode, ok = Of(sdk.NewError(testCodespace, 999, "unregistered"))
+	require.True(t, ok)
+	require.Equal(t, ErrorCode{Codespace: testCodespace, Code: 999}, errorCode)
+
+	// plain error
+	_, ok = Of(errors.New("something went wrong"))
+	require.False(t, ok)
+}
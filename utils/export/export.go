@@ -0,0 +1,297 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package export converts the list query results to CSV.
+// The columns are derived from the item type: the JSON names of its fields in the declaration order,
+// so the column order is stable whatever fields are set in the particular items.
+package export
+
+import (
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+
+	ContentTypeCSV = "text/csv; charset=utf-8"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// Checks the requested format. Empty format means JSON.
+func ParseFormat(format string) (string, error) {
+	switch strings.ToLower(format) {
+	case "", FormatJSON:
+		return FormatJSON, nil
+	case FormatCSV:
+		return FormatCSV, nil
+	default:
+		return "", fmt.Errorf("invalid format \"%v\": supported formats are %v and %v", format, FormatJSON, FormatCSV)
+	}
+}
+
+// List query result: the items of the page and the pagination data.
+type List struct {
+	Items   interface{}
+	Total   int
+	NextKey string
+	PrevKey string
+}
+
+// Gets the items and the pagination data of the list query result:
+// a struct (or a pointer to it) having `items` slice and optional `total`, `next_key` and `prev_key` fields.
+func ListOf(result interface{}) (List, error) {
+	value := reflect.Indirect(reflect.ValueOf(result))
+	if value.Kind() != reflect.Struct {
+		return List{}, fmt.Errorf("list result must be a struct, got %v", value.Type())
+	}
+
+	var list List
+
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+
+		switch name, _ := jsonName(value.Type().Field(i)); name {
+		case "items":
+			if field.Kind() != reflect.Slice {
+				return List{}, fmt.Errorf("items of list result must be a slice, got %v", field.Type())
+			}
+
+			list.Items = field.Interface()
+		case "total":
+			list.Total = int(field.Int())
+		case "next_key":
+			list.NextKey = field.String()
+		case "prev_key":
+			list.PrevKey = field.String()
+		}
+	}
+
+	if list.Items == nil {
+		return List{}, fmt.Errorf("list result %v has no items", value.Type())
+	}
+
+	return list, nil
+}
+
+// Column of the item type.
+type column struct {
+	name  string
+	index []int // path to the field through the nested structs
+}
+
+// Gets the column names of the item type.
+func Columns(itemType reflect.Type) []string {
+	columns := columnsOf(itemType, "", nil)
+
+	res := make([]string, 0, len(columns))
+	for _, c := range columns {
+		res = append(res, c.name)
+	}
+
+	return res
+}
+
+// Fields of the nested structs are prefixed with the name of the struct field (e.g. `owner.address`),
+// fields of the embedded structs are inlined. Structs having custom JSON encoding (e.g. time) are single values.
+func columnsOf(t reflect.Type, prefix string, index []int) []column {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if !isComposite(t) {
+		if len(prefix) == 0 { // the items are scalars
+			return []column{{name: "value", index: index}}
+		}
+
+		return []column{{name: strings.TrimSuffix(prefix, "."), index: index}}
+	}
+
+	var res []column
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" && !field.Anonymous { // unexported
+			continue
+		}
+
+		name, ok := jsonName(field)
+		if name == "-" {
+			continue
+		}
+
+		fieldIndex := append(append([]int{}, index...), i)
+
+		if field.Anonymous && !ok && isComposite(indirect(field.Type)) {
+			res = append(res, columnsOf(field.Type, prefix, fieldIndex)...)
+
+			continue
+		}
+
+		if field.PkgPath != "" {
+			continue
+		}
+
+		res = append(res, columnsOf(field.Type, prefix+name+".", fieldIndex)...)
+	}
+
+	return res
+}
+
+// Writes the items (a slice of structs or of pointers to structs) as CSV: the header row with the column names,
+// then a row per item. Values that spreadsheet applications would evaluate as formulas are prefixed with `'`.
+func WriteCSV(w io.Writer, items interface{}) error {
+	value := reflect.ValueOf(items)
+	if value.Kind() != reflect.Slice {
+		return fmt.Errorf("items must be a slice, got %v", value.Type())
+	}
+
+	columns := columnsOf(value.Type().Elem(), "", nil)
+
+	writer := csv.NewWriter(w)
+
+	header := make([]string, 0, len(columns))
+	for _, c := range columns {
+		header = append(header, c.name)
+	}
+
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for i := 0; i < value.Len(); i++ {
+		row := make([]string, 0, len(columns))
+
+		for _, c := range columns {
+			cell, err := cellOf(value.Index(i), c.index)
+			if err != nil {
+				return err
+			}
+
+			row = append(row, escapeFormula(cell))
+		}
+
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+func cellOf(value reflect.Value, index []int) (string, error) {
+	for _, i := range index {
+		value = reflect.Indirect(value)
+		if !value.IsValid() {
+			return "", nil
+		}
+
+		value = value.Field(i)
+	}
+
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return "", nil
+		}
+
+		value = value.Elem()
+	}
+
+	return format(value)
+}
+
+// Scalars are formatted as is, values having custom JSON encoding (e.g. addresses, time) are formatted
+// as their JSON strings, other values (e.g. lists) are formatted as JSON.
+func format(value reflect.Value) (string, error) {
+	if !value.Type().Implements(jsonMarshalerType) && !value.Type().Implements(textMarshalerType) {
+		switch value.Kind() { //nolint:exhaustive
+		case reflect.String:
+			return value.String(), nil
+		case reflect.Bool:
+			return strconv.FormatBool(value.Bool()), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return strconv.FormatInt(value.Int(), 10), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return strconv.FormatUint(value.Uint(), 10), nil
+		case reflect.Float32, reflect.Float64:
+			return strconv.FormatFloat(value.Float(), 'f', -1, 64), nil
+		}
+	}
+
+	bytes, err := json.Marshal(value.Interface())
+	if err != nil {
+		return "", err
+	}
+
+	if string(bytes) == "null" {
+		return "", nil
+	}
+
+	var str string
+	if err := json.Unmarshal(bytes, &str); err == nil {
+		return str, nil
+	}
+
+	return string(bytes), nil
+}
+
+func escapeFormula(cell string) string {
+	if len(cell) == 0 || !strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return cell
+	}
+
+	if _, err := strconv.ParseFloat(cell, 64); err == nil { // negative numbers are not formulas
+		return cell
+	}
+
+	return "'" + cell
+}
+
+// Returns the JSON name of the field and whether it is set explicitly.
+func jsonName(field reflect.StructField) (string, bool) {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if len(name) == 0 {
+		return field.Name, false
+	}
+
+	return name, true
+}
+
+// Whether the type is a struct exported as several columns.
+func isComposite(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct &&
+		!t.Implements(jsonMarshalerType) && !reflect.PtrTo(t).Implements(jsonMarshalerType) &&
+		!t.Implements(textMarshalerType) && !reflect.PtrTo(t).Implements(textMarshalerType)
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+
+	return t
+}
@@ -0,0 +1,131 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package export
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type testVersion struct {
+	Major uint16 `json:"major"`
+	Minor uint16 `json:"minor"`
+}
+
+type testBase struct {
+	ID uint64 `json:"id"`
+}
+
+type testItem struct {
+	testBase
+	Name     string         `json:"name"`
+	Enabled  bool           `json:"enabled,omitempty"`
+	Version  testVersion    `json:"version"`
+	Previous *testVersion   `json:"previous,omitempty"`
+	Owner    sdk.AccAddress `json:"owner"`
+	Date     time.Time      `json:"date"`
+	Tags     []string       `json:"tags,omitempty"`
+	Internal string         `json:"-"`
+	Score    float64
+}
+
+const testHeader = "id,name,enabled,version.major,version.minor,previous.major,previous.minor,owner,date,tags,Score\n"
+
+type testList struct {
+	Total   int        `json:"total"`
+	Items   []testItem `json:"items"`
+	NextKey string     `json:"next_key"`
+	PrevKey string     `json:"prev_key"`
+}
+
+func TestParseFormat(t *testing.T) {
+	for format, expected := range map[string]string{"": FormatJSON, "json": FormatJSON, "CSV": FormatCSV} {
+		res, err := ParseFormat(format)
+		require.NoError(t, err)
+		require.Equal(t, expected, res)
+	}
+
+	_, err := ParseFormat("xml")
+	require.Error(t, err)
+}
+
+func TestColumns(t *testing.T) {
+	require.Equal(t,
+		[]string{
+			"id", "name", "enabled", "version.major", "version.minor", "previous.major", "previous.minor",
+			"owner", "date", "tags", "Score",
+		},
+		Columns(reflect.TypeOf(testItem{})))
+}
+
+func TestWriteCSV(t *testing.T) {
+	owner := sdk.AccAddress([]byte("owner_address_bytes_"))
+	date := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	items := []testItem{
+		{
+			testBase: testBase{ID: 1},
+			Name:     "Light, \"smart\"",
+			Enabled:  true,
+			Version:  testVersion{Major: 1, Minor: 2},
+			Previous: &testVersion{Major: 1, Minor: 1},
+			Owner:    owner,
+			Date:     date,
+			Tags:     []string{"a", "b"},
+			Internal: "secret",
+			Score:    0.5,
+		},
+		{
+			testBase: testBase{ID: 2},
+			Name:     "=HYPERLINK(\"http://example.com\")",
+			Score:    -1,
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, items))
+
+	expected := testHeader +
+		"1,\"Light, \"\"smart\"\"\",true,1,2,1,1," + owner.String() +
+		",2020-01-02T03:04:05Z,\"[\"\"a\"\",\"\"b\"\"]\",0.5\n" +
+		"2,\"'=HYPERLINK(\"\"http://example.com\"\")\",false,0,0,,,,0001-01-01T00:00:00Z,,-1\n"
+	require.Equal(t, expected, buf.String())
+}
+
+func TestWriteCSV_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteCSV(&buf, []testItem{}))
+	require.Equal(t, testHeader, buf.String())
+
+	require.Error(t, WriteCSV(&buf, testItem{}))
+}
+
+func TestListOf(t *testing.T) {
+	list, err := ListOf(&testList{Total: 3, Items: []testItem{{Name: "a"}}, NextKey: "next", PrevKey: "prev"})
+	require.NoError(t, err)
+	require.Equal(t, 3, list.Total)
+	require.Equal(t, "next", list.NextKey)
+	require.Equal(t, "prev", list.PrevKey)
+	require.Equal(t, []testItem{{Name: "a"}}, list.Items)
+
+	_, err = ListOf(testItem{})
+	require.Error(t, err)
+}
@@ -0,0 +1,357 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package failover balances the RPC requests of the REST server between several full nodes.
+// The balancer is served as a local RPC endpoint the CLI context of the REST server is connected to,
+// so the queries, the proof verification and the broadcasting of transactions are switched
+// to another node transparently if one fails.
+package failover
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+	rpctypes "github.com/tendermint/tendermint/rpc/lib/types"
+)
+
+const (
+	FlagHealthCheckInterval      = "health-check-interval"
+	FlagHealthCheckIntervalUsage = "Interval of checking the status of the upstream nodes"
+	DefaultHealthCheckInterval   = 5 * time.Second
+	healthCheckTimeout           = 3 * time.Second
+	statusPath                   = "/status"
+)
+
+var ErrNoUpstreamAvailable = errors.New("none of the upstream nodes is available")
+
+type upstream struct {
+	url     *url.URL
+	healthy int32 // accessed atomically
+}
+
+func (u *upstream) isHealthy() bool {
+	return atomic.LoadInt32(&u.healthy) == 1
+}
+
+// Sets the health of the upstream and returns whether it is changed.
+func (u *upstream) setHealthy(healthy bool) bool {
+	value := int32(0)
+	if healthy {
+		value = 1
+	}
+
+	return atomic.SwapInt32(&u.healthy, value) != value
+}
+
+// Balancer forwards the RPC requests to the healthy upstream nodes in turn.
+// The node failing to respond is marked unhealthy and the request is retried with the next one.
+// The unhealthy nodes are tried the last, so the requests are served while any node is available.
+type Balancer struct {
+	upstreams []*upstream
+	next      uint32
+	transport http.RoundTripper
+	logger    log.Logger
+}
+
+// NewBalancer creates the balancer of the given nodes (`tcp://<host>:<port>` or `http(s)://<host>:<port>`).
+// The nodes are considered healthy until checked.
+func NewBalancer(nodes []string, logger log.Logger) (*Balancer, error) {
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no upstream nodes specified")
+	}
+
+	upstreams := make([]*upstream, 0, len(nodes))
+
+	for _, node := range nodes {
+		nodeURL, err := parseNode(node)
+		if err != nil {
+			return nil, err
+		}
+
+		upstreams = append(upstreams, &upstream{url: nodeURL, healthy: 1})
+	}
+
+	return &Balancer{
+		upstreams: upstreams,
+		transport: &http.Transport{Proxy: http.ProxyFromEnvironment, IdleConnTimeout: 90 * time.Second},
+		logger:    logger,
+	}, nil
+}
+
+// ParseNodes splits the comma-separated list of the nodes.
+func ParseNodes(value string) []string {
+	var nodes []string
+
+	for _, node := range strings.Split(value, ",") {
+		if node = strings.TrimSpace(node); len(node) != 0 {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+func parseNode(node string) (*url.URL, error) {
+	if !strings.Contains(node, "://") {
+		node = "tcp://" + node
+	}
+
+	nodeURL, err := url.Parse(node)
+	if err != nil || len(nodeURL.Host) == 0 {
+		return nil, fmt.Errorf("invalid node address %q: must be <host>:<port>", node)
+	}
+
+	switch nodeURL.Scheme {
+	case "tcp":
+		nodeURL.Scheme = "http"
+	case "http", "https":
+	default:
+		return nil, fmt.Errorf("invalid node address %q: unsupported protocol %s", node, nodeURL.Scheme)
+	}
+
+	return nodeURL, nil
+}
+
+// Healthy returns the addresses of the nodes passed the last health check.
+func (b *Balancer) Healthy() []string {
+	var nodes []string
+
+	for _, u := range b.upstreams {
+		if u.isHealthy() {
+			nodes = append(nodes, u.url.Host)
+		}
+	}
+
+	return nodes
+}
+
+// CheckHealth requests the status of every node. The node is healthy if it responds and is not catching up.
+func (b *Balancer) CheckHealth() {
+	var wg sync.WaitGroup
+
+	for _, u := range b.upstreams {
+		wg.Add(1)
+
+		go func(u *upstream) {
+			defer wg.Done()
+
+			err := b.checkHealth(u)
+			if u.setHealthy(err == nil) {
+				if err == nil {
+					b.logger.Info("Upstream node is healthy", "node", u.url.Host)
+				} else {
+					b.logger.Error("Upstream node is unhealthy", "node", u.url.Host, "err", err)
+				}
+			}
+		}(u)
+	}
+
+	wg.Wait()
+}
+
+func (b *Balancer) checkHealth(u *upstream) error {
+	client := http.Client{Transport: b.transport, Timeout: healthCheckTimeout}
+
+	resp, err := client.Get(u.url.String() + statusPath)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("status request failed with %s", resp.Status)
+	}
+
+	var status struct {
+		Result struct {
+			SyncInfo struct {
+				CatchingUp bool `json:"catching_up"`
+			} `json:"sync_info"`
+		} `json:"result"`
+	}
+
+	if err = json.NewDecoder(resp.Body).Decode(&status); err != nil {
+		return err
+	}
+
+	if status.Result.SyncInfo.CatchingUp {
+		return fmt.Errorf("the node is catching up")
+	}
+
+	return nil
+}
+
+// StartHealthChecks checks the health of the nodes periodically until the returned function is called.
+func (b *Balancer) StartHealthChecks(interval time.Duration) (stop func()) {
+	ticker := time.NewTicker(interval)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				b.CheckHealth()
+			case <-done:
+				ticker.Stop()
+
+				return
+			}
+		}
+	}()
+
+	return func() { close(done) }
+}
+
+// Returns the healthy upstreams starting from the next one in turn followed by the unhealthy ones.
+func (b *Balancer) candidates() []*upstream {
+	start := int(atomic.AddUint32(&b.next, 1)-1) % len(b.upstreams)
+
+	healthy := make([]*upstream, 0, len(b.upstreams))
+	unhealthy := make([]*upstream, 0, len(b.upstreams))
+
+	for i := range b.upstreams {
+		u := b.upstreams[(start+i)%len(b.upstreams)]
+		if u.isHealthy() {
+			healthy = append(healthy, u)
+		} else {
+			unhealthy = append(unhealthy, u)
+		}
+	}
+
+	return append(healthy, unhealthy...)
+}
+
+func (b *Balancer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	candidates := b.candidates()
+
+	// the websocket connection (subscriptions to the events) is bound to the node it is established with
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		proxy := httputil.NewSingleHostReverseProxy(candidates[0].url)
+		proxy.Transport = b.transport
+		proxy.ServeHTTP(w, r)
+
+		return
+	}
+
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	for _, u := range candidates {
+		req := r.Clone(r.Context())
+		req.RequestURI = ""
+		req.URL.Scheme = u.url.Scheme
+		req.URL.Host = u.url.Host
+		req.Host = u.url.Host
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req.ContentLength = int64(len(body))
+
+		resp, err := b.transport.RoundTrip(req)
+		if err != nil {
+			if u.setHealthy(false) {
+				b.logger.Error("Upstream node is unhealthy", "node", u.url.Host, "err", err)
+			}
+
+			continue
+		}
+
+		for key, values := range resp.Header {
+			w.Header()[key] = values
+		}
+
+		w.WriteHeader(resp.StatusCode)
+		_, _ = io.Copy(w, resp.Body)
+		resp.Body.Close()
+
+		return
+	}
+
+	// the RPC client reports the error of JSON-RPC response
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadGateway)
+	_ = json.NewEncoder(w).Encode(
+		rpctypes.RPCInternalError(rpctypes.JSONRPCStringID(""), ErrNoUpstreamAvailable))
+}
+
+// Listen serves the balancer on a local port and returns its address to connect the CLI context to.
+func (b *Balancer) Listen() (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+
+	go func() {
+		if err := http.Serve(listener, b); err != nil {
+			b.logger.Error("Balancer stopped", "err", err)
+		}
+	}()
+
+	return "tcp://" + listener.Addr().String(), nil
+}
+
+// ServeCommand makes the REST server command accept several nodes in `--node` flag (comma-separated)
+// and balance the requests between them.
+func ServeCommand(cmd *cobra.Command) *cobra.Command {
+	serve := cmd.RunE
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		logger := log.NewTMLogger(log.NewSyncWriter(cmd.OutOrStdout())).With("module", "failover")
+
+		balancer, err := NewBalancer(ParseNodes(viper.GetString(flags.FlagNode)), logger)
+		if err != nil {
+			return err
+		}
+
+		balancer.CheckHealth()
+
+		if len(balancer.Healthy()) == 0 {
+			logger.Error("None of the upstream nodes is healthy, the requests are going to be retried with all of them")
+		}
+
+		stop := balancer.StartHealthChecks(viper.GetDuration(FlagHealthCheckInterval))
+		defer stop()
+
+		address, err := balancer.Listen()
+		if err != nil {
+			return err
+		}
+
+		viper.Set(flags.FlagNode, address)
+
+		return serve(cmd, args)
+	}
+
+	cmd.Flags().Duration(FlagHealthCheckInterval, DefaultHealthCheckInterval, FlagHealthCheckIntervalUsage)
+
+	return cmd
+}
@@ -0,0 +1,136 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package failover
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func newTestNode(name string, catchingUp bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == statusPath {
+			fmt.Fprintf(w, `{"result":{"sync_info":{"catching_up":%v}}}`, catchingUp)
+
+			return
+		}
+
+		body, _ := ioutil.ReadAll(r.Body)
+		fmt.Fprintf(w, "%s:%s", name, body)
+	}))
+}
+
+func request(b *Balancer) string {
+	w := httptest.NewRecorder()
+	b.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("req")))
+
+	if w.Code != http.StatusOK {
+		return fmt.Sprint(w.Code)
+	}
+
+	return w.Body.String()
+}
+
+func TestBalancer_RoundRobin(t *testing.T) {
+	node1 := newTestNode("node1", false)
+	defer node1.Close()
+
+	node2 := newTestNode("node2", false)
+	defer node2.Close()
+
+	b, err := NewBalancer([]string{node1.URL, node2.URL}, log.NewNopLogger())
+	require.NoError(t, err)
+
+	b.CheckHealth()
+	require.Len(t, b.Healthy(), 2)
+
+	require.Equal(t, "node1:req", request(b))
+	require.Equal(t, "node2:req", request(b))
+	require.Equal(t, "node1:req", request(b))
+}
+
+func TestBalancer_Failover(t *testing.T) {
+	node1 := newTestNode("node1", false)
+	node2 := newTestNode("node2", false)
+
+	defer node2.Close()
+
+	syncing := newTestNode("syncing", true)
+	defer syncing.Close()
+
+	b, err := NewBalancer([]string{node1.URL, syncing.URL, node2.URL}, log.NewNopLogger())
+	require.NoError(t, err)
+
+	// the node catching up is not requested while there are healthy ones
+	b.CheckHealth()
+	require.Equal(t, []string{node1.Listener.Addr().String(), node2.Listener.Addr().String()}, b.Healthy())
+
+	for i := 0; i < 3; i++ {
+		require.NotEqual(t, "syncing:req", request(b))
+	}
+
+	// the request is retried with the next node and the failed one is marked unhealthy
+	node1.Close()
+
+	for i := 0; i < 3; i++ {
+		require.Equal(t, "node2:req", request(b))
+	}
+
+	require.Equal(t, []string{node2.Listener.Addr().String()}, b.Healthy())
+
+	// the unhealthy nodes are requested if no healthy one is available
+	node2.Close()
+
+	require.Equal(t, "syncing:req", request(b))
+}
+
+func TestBalancer_NoUpstreamAvailable(t *testing.T) {
+	node := newTestNode("node", false)
+	node.Close()
+
+	b, err := NewBalancer([]string{node.URL}, log.NewNopLogger())
+	require.NoError(t, err)
+
+	b.CheckHealth()
+	require.Empty(t, b.Healthy())
+
+	w := httptest.NewRecorder()
+	b.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("req")))
+
+	require.Equal(t, http.StatusBadGateway, w.Code)
+	require.Contains(t, w.Body.String(), ErrNoUpstreamAvailable.Error())
+}
+
+func TestParseNodes(t *testing.T) {
+	require.Equal(t, []string{"tcp://node1:26657", "node2:26657"}, ParseNodes("tcp://node1:26657, node2:26657,"))
+
+	_, err := NewBalancer(nil, log.NewNopLogger())
+	require.Error(t, err)
+
+	_, err = NewBalancer([]string{"udp://node:26657"}, log.NewNopLogger())
+	require.Error(t, err)
+
+	b, err := NewBalancer([]string{"node:26657"}, log.NewNopLogger())
+	require.NoError(t, err)
+	require.Equal(t, "http://node:26657", b.upstreams[0].url.String())
+}
@@ -0,0 +1,701 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package graphql executes GraphQL queries over the JSON values of the ledger.
+// It implements the subset of GraphQL needed to compose the views of the read model:
+// the query operations with variables, aliases, fragments and `@skip`/`@include` directives.
+// The mutations, the subscriptions and the introspection (except `__typename`) are not supported.
+//
+// The schema declares the object types with their fields computed by the resolvers (e.g. the joins
+// of the related values). The other fields of an open object type are the attributes of its JSON value,
+// so the ledger values are exposed as they are returned by the REST API.
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+const typenameField = "__typename"
+
+var ErrTooComplex = errors.New("the query is too complex: it requires too many values to be resolved")
+
+// ResolveFn returns the value of the field of the source object value.
+// The values are expected to be JSON decoded: objects are `map[string]interface{}`, lists are `[]interface{}`.
+type ResolveFn func(source interface{}, args Args) (interface{}, error)
+
+type Field struct {
+	// the object type of the value (or of the items of the list value), nil for the scalar values
+	Type *Object
+	// the names of the arguments accepted by the field along with whether they are required
+	Args map[string]bool
+	// resolves the attribute of the source with the field name if not set
+	Resolve ResolveFn
+}
+
+type Object struct {
+	Name   string
+	Fields map[string]*Field
+	// whether the attributes of the value not declared as fields can be selected
+	Open bool
+}
+
+// the type of the JSON objects selected as the attributes of the open objects.
+var jsonObject = &Object{Name: "JSON", Open: true}
+
+type Schema struct {
+	Query *Object
+	// the maximum nesting of the selected fields (not limited if 0)
+	MaxDepth int
+	// the maximum number of the resolver calls executing one query (not limited if 0)
+	MaxResolves int
+}
+
+/*
+	Request and response
+*/
+
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// ParseRequest reads the request either from the query parameters of GET request
+// or from JSON body of POST request (GraphQL over HTTP).
+func ParseRequest(r *http.Request) (Request, error) {
+	var req Request
+
+	if r.Method == http.MethodGet {
+		req.Query = r.FormValue("query")
+		req.OperationName = r.FormValue("operationName")
+
+		if variables := r.FormValue("variables"); len(variables) != 0 {
+			if err := decodeJSON([]byte(variables), &req.Variables); err != nil {
+				return req, fmt.Errorf("invalid variables: %v", err)
+			}
+		}
+	} else {
+		var buf bytes.Buffer
+		if _, err := buf.ReadFrom(r.Body); err != nil {
+			return req, err
+		}
+
+		if err := decodeJSON(buf.Bytes(), &req); err != nil {
+			return req, fmt.Errorf("invalid request: %v", err)
+		}
+	}
+
+	if len(strings.TrimSpace(req.Query)) == 0 {
+		return req, fmt.Errorf("the query is empty")
+	}
+
+	return req, nil
+}
+
+// The numbers are decoded as json.Number, so the big integers are kept as they are.
+func decodeJSON(data []byte, value interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(value)
+}
+
+type Response struct {
+	Data       interface{}            `json:"data,omitempty"`
+	Errors     []Error                `json:"errors,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// ErrorResponse is the response to the request failed before the execution (e.g. the invalid query).
+func ErrorResponse(err error) Response {
+	return Response{Errors: []Error{{Message: err.Error()}}}
+}
+
+type Error struct {
+	Message string `json:"message"`
+	// the response keys and the list indexes of the field failed to resolve
+	Path []interface{} `json:"path,omitempty"`
+}
+
+// The response object keeping the order of the selected fields.
+type orderedObject struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func (o *orderedObject) set(key string, value interface{}) {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+
+	o.values[key] = value
+}
+
+func (o *orderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+
+	for i, key := range o.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		encodedKey, _ := json.Marshal(key)
+		buf.Write(encodedKey)
+		buf.WriteByte(':')
+
+		value, err := json.Marshal(o.values[key])
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+/*
+	Execution
+*/
+
+// Execute validates and executes the query. The error is returned if the query can not be executed
+// (e.g. it is invalid), the errors of the resolvers are returned in the response along with the data
+// (the values of the failed fields are null).
+func (s Schema) Execute(req Request) (Response, error) {
+	doc, err := parse(req.Query)
+	if err != nil {
+		return Response{}, err
+	}
+
+	op, err := selectOperation(doc, req.OperationName)
+	if err != nil {
+		return Response{}, err
+	}
+
+	if op.kind != "query" {
+		return Response{}, fmt.Errorf("only queries are supported, %s is requested", op.kind)
+	}
+
+	variables, err := coerceVariables(op, req.Variables)
+	if err != nil {
+		return Response{}, err
+	}
+
+	e := &executor{schema: s, doc: doc, variables: variables, defined: map[string]bool{}}
+
+	for _, definition := range op.variables {
+		e.defined[definition.name] = true
+	}
+
+	if err := e.validate(s.Query, op.selectionSet, 1, map[string]bool{}); err != nil {
+		return Response{}, err
+	}
+
+	data := e.executeSelectionSet(s.Query, nil, op.selectionSet, nil)
+
+	return Response{Data: data, Errors: e.errors}, nil
+}
+
+func selectOperation(doc *document, name string) (*operation, error) {
+	if len(name) == 0 {
+		if len(doc.operations) > 1 {
+			return nil, fmt.Errorf("the operation name must be specified for the document with several operations")
+		}
+
+		return doc.operations[0], nil
+	}
+
+	for _, op := range doc.operations {
+		if op.name == name {
+			return op, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown operation %q", name)
+}
+
+func coerceVariables(op *operation, values map[string]interface{}) (map[string]interface{}, error) {
+	variables := map[string]interface{}{}
+
+	for _, definition := range op.variables {
+		value, ok := values[definition.name]
+		if !ok {
+			value = definition.defaultValue.resolve(nil)
+		}
+
+		if value == nil && definition.nonNull {
+			return nil, fmt.Errorf("variable $%s of the required type is not provided", definition.name)
+		}
+
+		variables[definition.name] = value
+	}
+
+	return variables, nil
+}
+
+type executor struct {
+	schema    Schema
+	doc       *document
+	variables map[string]interface{}
+	// the names of the variables defined by the operation
+	defined  map[string]bool
+	resolves int
+	errors   []Error
+}
+
+// The fields selected with the same response key are merged.
+type collectedField struct {
+	key          string
+	selection    selection
+	selectionSet []selection
+}
+
+// Collects the fields of the selection set expanding the fragments.
+// The fields skipped by the directives are collected too if the directives are not evaluated (on validation).
+func (e *executor) collectFields(set []selection, evaluateDirectives bool) ([]*collectedField, error) {
+	var fields []*collectedField
+
+	byKey := map[string]*collectedField{}
+
+	var collect func(set []selection, visiting map[string]bool) error
+
+	collect = func(set []selection, visiting map[string]bool) error {
+		for _, s := range set {
+			if evaluateDirectives {
+				included, err := e.isIncluded(s.directives)
+				if err != nil {
+					return err
+				}
+
+				if !included {
+					continue
+				}
+			}
+
+			switch {
+			case len(s.fragmentSpread) != 0:
+				fragment, ok := e.doc.fragments[s.fragmentSpread]
+				if !ok {
+					return fmt.Errorf("unknown fragment %q", s.fragmentSpread)
+				}
+
+				if visiting[fragment.name] {
+					return fmt.Errorf("fragment %q spreads itself", fragment.name)
+				}
+
+				visiting[fragment.name] = true
+
+				if err := collect(fragment.selectionSet, visiting); err != nil {
+					return err
+				}
+
+				delete(visiting, fragment.name)
+			case s.inline:
+				if err := collect(s.selectionSet, visiting); err != nil {
+					return err
+				}
+			default:
+				field, ok := byKey[s.responseKey()]
+				if !ok {
+					field = &collectedField{key: s.responseKey(), selection: s}
+					byKey[field.key] = field
+					fields = append(fields, field)
+				} else if field.selection.name != s.name || !reflect.DeepEqual(field.selection.arguments, s.arguments) {
+					return fmt.Errorf("fields %q and %q conflict because they are selected with the same name %q "+
+						"but differ in field names or arguments", field.selection.name, s.name, field.key)
+				}
+
+				field.selectionSet = append(field.selectionSet, s.selectionSet...)
+			}
+		}
+
+		return nil
+	}
+
+	if err := collect(set, map[string]bool{}); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// Evaluates `@skip(if: Boolean)` and `@include(if: Boolean)` directives.
+func (e *executor) isIncluded(directives []directive) (bool, error) {
+	for _, d := range directives {
+		if len(d.arguments) != 1 || d.arguments[0].name != "if" {
+			return false, fmt.Errorf("directive @%s requires the only argument \"if\"", d.name)
+		}
+
+		condition, ok := d.arguments[0].value.resolve(e.variables).(bool)
+		if !ok {
+			return false, fmt.Errorf("argument \"if\" of directive @%s must be a boolean", d.name)
+		}
+
+		if (d.name == "skip" && condition) || (d.name == "include" && !condition) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Checks the selected fields and their arguments against the schema.
+func (e *executor) validate(object *Object, set []selection, depth int, visiting map[string]bool) error {
+	if e.schema.MaxDepth > 0 && depth > e.schema.MaxDepth {
+		return fmt.Errorf("the query is too deep: the fields can be nested up to %d levels", e.schema.MaxDepth)
+	}
+
+	if err := e.validateDirectives(set, visiting); err != nil {
+		return err
+	}
+
+	fields, err := e.collectFields(set, false)
+	if err != nil {
+		return err
+	}
+
+	for _, collected := range fields {
+		s := collected.selection
+
+		if err := e.validateArguments(object, s); err != nil {
+			return err
+		}
+
+		if s.name == typenameField {
+			if len(collected.selectionSet) != 0 {
+				return fmt.Errorf("field %q must not have a selection of subfields", s.name)
+			}
+
+			continue
+		}
+
+		field := object.Fields[s.name]
+
+		var fieldType *Object
+
+		switch {
+		case field != nil && field.Type == nil && len(collected.selectionSet) != 0:
+			return fmt.Errorf("field %q of type %q is a scalar and must not have a selection of subfields",
+				s.name, object.Name)
+		case field != nil && field.Type != nil && len(collected.selectionSet) == 0:
+			return fmt.Errorf("field %q of type %q must have a selection of subfields", s.name, object.Name)
+		case field != nil:
+			fieldType = field.Type
+		default:
+			// the attributes of the open objects are either scalars or JSON objects
+			fieldType = jsonObject
+		}
+
+		if len(collected.selectionSet) != 0 {
+			if err := e.validate(fieldType, collected.selectionSet, depth+1, visiting); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Checks the directives of the selection set including the ones of the spread fragments.
+func (e *executor) validateDirectives(set []selection, visiting map[string]bool) error {
+	for _, s := range set {
+		for _, d := range s.directives {
+			if d.name != "skip" && d.name != "include" {
+				return fmt.Errorf("unknown directive @%s", d.name)
+			}
+
+			if err := e.validateVariables(d.arguments); err != nil {
+				return err
+			}
+		}
+
+		if len(s.fragmentSpread) != 0 {
+			if fragment, ok := e.doc.fragments[s.fragmentSpread]; ok && !visiting[fragment.name] {
+				visiting[fragment.name] = true
+				err := e.validateDirectives(fragment.selectionSet, visiting)
+				delete(visiting, fragment.name)
+
+				if err != nil {
+					return err
+				}
+			}
+		} else if s.inline {
+			if err := e.validateDirectives(s.selectionSet, visiting); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (e *executor) validateArguments(object *Object, s selection) error {
+	field := object.Fields[s.name]
+
+	if field == nil && s.name != typenameField && !object.Open {
+		return fmt.Errorf("cannot query field %q on type %q", s.name, object.Name)
+	}
+
+	var accepted map[string]bool
+	if field != nil {
+		accepted = field.Args
+	}
+
+	passed := map[string]bool{}
+
+	for _, arg := range s.arguments {
+		required, ok := accepted[arg.name]
+		if !ok {
+			return fmt.Errorf("unknown argument %q of field %q", arg.name, s.name)
+		}
+
+		if passed[arg.name] {
+			return fmt.Errorf("argument %q of field %q is passed more than once", arg.name, s.name)
+		}
+
+		// the required variables are checked on coercion
+		if required && arg.value.kind == literalValue && arg.value.literal == nil {
+			return fmt.Errorf("argument %q of field %q is required", arg.name, s.name)
+		}
+
+		passed[arg.name] = true
+	}
+
+	for name, required := range accepted {
+		if required && !passed[name] {
+			return fmt.Errorf("argument %q of field %q is required", name, s.name)
+		}
+	}
+
+	return e.validateVariables(s.arguments)
+}
+
+func (e *executor) validateVariables(arguments []argument) error {
+	var check func(v value) error
+
+	check = func(v value) error {
+		switch v.kind {
+		case variableValue:
+			if !e.defined[v.variable] {
+				return fmt.Errorf("variable $%s is not defined", v.variable)
+			}
+		case listValue:
+			for _, item := range v.list {
+				if err := check(item); err != nil {
+					return err
+				}
+			}
+		case objectValue:
+			for _, field := range v.object {
+				if err := check(field.value); err != nil {
+					return err
+				}
+			}
+		case literalValue:
+		}
+
+		return nil
+	}
+
+	for _, arg := range arguments {
+		if err := check(arg.value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *executor) executeSelectionSet(object *Object, source interface{}, set []selection,
+	path []interface{}) *orderedObject {
+	result := &orderedObject{values: map[string]interface{}{}}
+
+	fields, err := e.collectFields(set, true)
+	if err != nil {
+		e.addError(err, path)
+
+		return result
+	}
+
+	for _, collected := range fields {
+		fieldPath := append(append([]interface{}{}, path...), collected.key)
+		result.set(collected.key, e.executeField(object, source, collected, fieldPath))
+	}
+
+	return result
+}
+
+func (e *executor) executeField(object *Object, source interface{}, collected *collectedField,
+	path []interface{}) interface{} {
+	name := collected.selection.name
+
+	if name == typenameField {
+		return object.Name
+	}
+
+	field := object.Fields[name]
+	if field == nil || field.Resolve == nil {
+		var fieldType *Object
+		if field != nil {
+			fieldType = field.Type
+		}
+
+		return e.completeValue(fieldType, attribute(source, name), collected.selectionSet, path)
+	}
+
+	if e.schema.MaxResolves > 0 && e.resolves >= e.schema.MaxResolves {
+		e.addError(ErrTooComplex, path)
+
+		return nil
+	}
+
+	e.resolves++
+
+	args := Args{}
+	for _, arg := range collected.selection.arguments {
+		args[arg.name] = arg.value.resolve(e.variables)
+	}
+
+	// the required arguments can still be null if passed by the nullable variables
+	for arg, required := range field.Args {
+		if required && args[arg] == nil {
+			e.addError(fmt.Errorf("argument %q of field %q is required", arg, name), path)
+
+			return nil
+		}
+	}
+
+	value, err := field.Resolve(source, args)
+	if err != nil {
+		e.addError(err, path)
+
+		return nil
+	}
+
+	return e.completeValue(field.Type, value, collected.selectionSet, path)
+}
+
+// Selects the fields of the object value or of every item of the list value.
+func (e *executor) completeValue(object *Object, value interface{}, set []selection, path []interface{}) interface{} {
+	if value == nil || len(set) == 0 {
+		return value
+	}
+
+	if object == nil {
+		object = jsonObject
+	}
+
+	switch value := value.(type) {
+	case []interface{}:
+		items := make([]interface{}, 0, len(value))
+
+		for i, item := range value {
+			itemPath := append(append([]interface{}{}, path...), i)
+			items = append(items, e.completeValue(object, item, set, itemPath))
+		}
+
+		return items
+	case map[string]interface{}:
+		return e.executeSelectionSet(object, value, set, path)
+	default:
+		e.addError(fmt.Errorf("the value is a scalar, the subfields can not be selected"), path)
+
+		return nil
+	}
+}
+
+func (e *executor) addError(err error, path []interface{}) {
+	e.errors = append(e.errors, Error{Message: err.Error(), Path: path})
+}
+
+// Returns the attribute of the JSON object (nil if it is missing or the value is not an object).
+func attribute(source interface{}, name string) interface{} {
+	if object, ok := source.(map[string]interface{}); ok {
+		return object[name]
+	}
+
+	return nil
+}
+
+/*
+	Arguments
+*/
+
+// Args are the values of the arguments passed to the field (the variables are substituted).
+// The integer literals are int64, the numbers of the variables are json.Number.
+type Args map[string]interface{}
+
+// Int returns the integer argument or the default value if the argument is not passed.
+func (a Args) Int(name string, defaultValue int64) (int64, error) {
+	switch value := a[name].(type) {
+	case nil:
+		return defaultValue, nil
+	case int64:
+		return value, nil
+	case json.Number:
+		if i, err := value.Int64(); err == nil {
+			return i, nil
+		}
+	case float64:
+		if value == float64(int64(value)) {
+			return int64(value), nil
+		}
+	}
+
+	return 0, fmt.Errorf("argument %q must be an integer", name)
+}
+
+// String returns the string argument or the default value if the argument is not passed.
+func (a Args) String(name string, defaultValue string) (string, error) {
+	switch value := a[name].(type) {
+	case nil:
+		return defaultValue, nil
+	case string:
+		return value, nil
+	default:
+		return "", fmt.Errorf("argument %q must be a string", name)
+	}
+}
+
+// Bool returns the boolean argument or the default value if the argument is not passed.
+func (a Args) Bool(name string, defaultValue bool) (bool, error) {
+	switch value := a[name].(type) {
+	case nil:
+		return defaultValue, nil
+	case bool:
+		return value, nil
+	default:
+		return false, fmt.Errorf("argument %q must be a boolean", name)
+	}
+}
+
+// DecodeValue decodes the JSON value in the form expected from the resolvers.
+func DecodeValue(data []byte) (interface{}, error) {
+	var value interface{}
+	if err := decodeJSON(data, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
@@ -0,0 +1,245 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Models with versions and compliance.
+func testSchema() Schema {
+	models := map[int64]string{
+		1: `{"vid":1,"pid":1,"name":"first","sku":"sku1"}`,
+		2: `{"vid":1,"pid":2,"name":"second","sku":"sku2"}`,
+	}
+
+	compliance := &Object{Name: "ComplianceInfo", Open: true}
+	model := &Object{Name: "Model", Open: true}
+	model.Fields = map[string]*Field{
+		"compliance": {
+			Type: compliance,
+			Resolve: func(source interface{}, args Args) (interface{}, error) {
+				pid := source.(map[string]interface{})["pid"]
+
+				return DecodeValue([]byte(fmt.Sprintf(`[{"pid":%v,"state":"certified","report":{"id":7}}]`, pid)))
+			},
+		},
+		"versions": {
+			Type: &Object{Name: "ModelVersion", Fields: map[string]*Field{"software_version": {}}},
+			Resolve: func(source interface{}, args Args) (interface{}, error) {
+				return DecodeValue([]byte(`[{"software_version":1},{"software_version":2}]`))
+			},
+		},
+	}
+
+	return Schema{
+		Query: &Object{
+			Name: "Query",
+			Fields: map[string]*Field{
+				"model": {
+					Type: model,
+					Args: map[string]bool{"pid": true},
+					Resolve: func(source interface{}, args Args) (interface{}, error) {
+						pid, err := args.Int("pid", 0)
+						if err != nil {
+							return nil, err
+						}
+
+						value, ok := models[pid]
+						if !ok {
+							return nil, nil
+						}
+
+						return DecodeValue([]byte(value))
+					},
+				},
+				"failing": {
+					Resolve: func(source interface{}, args Args) (interface{}, error) {
+						return nil, fmt.Errorf("failed")
+					},
+				},
+			},
+		},
+		MaxDepth:    5,
+		MaxResolves: 10,
+	}
+}
+
+func execute(t *testing.T, schema Schema, req Request) string {
+	res, err := schema.Execute(req)
+	require.NoError(t, err)
+
+	bytes, err := json.Marshal(res)
+	require.NoError(t, err)
+
+	return string(bytes)
+}
+
+func TestExecute(t *testing.T) {
+	schema := testSchema()
+
+	// the fields are returned in the selected order
+	require.Equal(t,
+		`{"data":{"model":{"name":"second","vid":1,"missing":null,"__typename":"Model"}}}`,
+		execute(t, schema, Request{Query: `{ model(pid: 2) { name vid missing __typename } }`}))
+
+	// the joined values, the aliases and the attributes of JSON objects
+	require.Equal(t,
+		`{"data":{"first":{"compliance":[{"state":"certified","report":{"id":7}}],`+
+			`"versions":[{"software_version":1},{"software_version":2}]},"none":null}}`,
+		execute(t, schema, Request{Query: `
+			query Models {
+				first: model(pid: 1) {
+					compliance { state report { id } }
+					versions { software_version }
+				}
+				none: model(pid: 3) { name }
+			}`}))
+
+	// the variables, the fragments and the directives
+	require.Equal(t,
+		`{"data":{"model":{"name":"first","sku":"sku1","pid":1}}}`,
+		execute(t, schema, Request{
+			Query: `
+				query ($pid: Int!, $withSKU: Boolean = false) {
+					model(pid: $pid) { ...Names ... on Model { sku @include(if: $withSKU) } vid @skip(if: true) pid }
+				}
+				fragment Names on Model { name }`,
+			Variables: map[string]interface{}{"pid": json.Number("1"), "withSKU": true},
+		}))
+
+	// the operation is selected by the name
+	require.Equal(t,
+		`{"data":{"model":{"pid":2}}}`,
+		execute(t, schema, Request{
+			Query:         `query A { model(pid: 1) { pid } } query B { model(pid: 2) { pid } }`,
+			OperationName: "B",
+		}))
+}
+
+func TestExecute_FieldErrors(t *testing.T) {
+	schema := testSchema()
+
+	// the failed field is null, the other fields are returned
+	require.Equal(t,
+		`{"data":{"failing":null,"model":{"pid":1}},"errors":[{"message":"failed","path":["failing"]}]}`,
+		execute(t, schema, Request{Query: `{ failing model(pid: 1) { pid } }`}))
+
+	// the subfields of the scalar attribute
+	require.Equal(t,
+		`{"data":{"model":{"name":null}},"errors":[{"message":"the value is a scalar, the subfields can not be `+
+			`selected","path":["model","name"]}]}`,
+		execute(t, schema, Request{Query: `{ model(pid: 1) { name { value } } }`}))
+
+	// the required argument passed by the nullable variable
+	require.Equal(t,
+		`{"data":{"model":null},"errors":[{"message":"argument \"pid\" of field \"model\" is required",`+
+			`"path":["model"]}]}`,
+		execute(t, schema, Request{Query: `query ($pid: Int) { model(pid: $pid) { name } }`}))
+
+	// too many values to resolve
+	schema.MaxResolves = 1
+	require.Equal(t,
+		`{"data":{"model":{"compliance":null}},"errors":[{"message":"`+ErrTooComplex.Error()+`",`+
+			`"path":["model","compliance"]}]}`,
+		execute(t, schema, Request{Query: `{ model(pid: 1) { compliance { state } } }`}))
+}
+
+func TestExecute_InvalidQuery(t *testing.T) {
+	schema := testSchema()
+
+	for query, message := range map[string]string{
+		`{ model(pid: 1) { name }`:                            "syntax error",
+		`{ model(pid: "1) { name } }`:                         "unterminated string",
+		`mutation { model(pid: 1) { name } }`:                 "only queries are supported",
+		`{ unknown }`:                                         `cannot query field "unknown" on type "Query"`,
+		`{ model { name } }`:                                  `argument "pid" of field "model" is required`,
+		`{ model(pid: 1, vid: 1) { name } }`:                  `unknown argument "vid"`,
+		`{ model(pid: 1) }`:                                   "must have a selection of subfields",
+		`{ failing { name } }`:                                "must not have a selection of subfields",
+		`{ model(pid: 1) { versions { name } } }`:             `cannot query field "name" on type "ModelVersion"`,
+		`{ model(pid: $pid) { name } }`:                       "variable $pid is not defined",
+		`{ model(pid: 1) { ...Missing } }`:                    `unknown fragment "Missing"`,
+		`{ model(pid: 1) { ...A } } fragment A on M { ...A }`: `fragment "A" spreads itself`,
+		`{ model(pid: 1) { name @deprecated } }`:              "unknown directive @deprecated",
+		`{ model(pid: 1) { name: sku name } }`:                "conflict",
+		`{ model(pid: 1) { a { b { c { d { e } } } } } }`:     "the query is too deep",
+		`query A { failing } query B { failing }`:             "operation name must be specified",
+	} {
+		_, err := schema.Execute(Request{Query: query})
+		require.Error(t, err, query)
+		require.Contains(t, err.Error(), message, query)
+	}
+
+	_, err := schema.Execute(Request{Query: `query ($pid: Int!) { model(pid: $pid) { name } }`})
+	require.Contains(t, err.Error(), "variable $pid of the required type is not provided")
+}
+
+func TestParseRequest(t *testing.T) {
+	req, err := ParseRequest(httptest.NewRequest(http.MethodGet,
+		`/graphql?query=%7Bmodel%7D&operationName=A&variables=%7B%22pid%22%3A1%7D`, nil))
+	require.NoError(t, err)
+	require.Equal(t, Request{Query: "{model}", OperationName: "A",
+		Variables: map[string]interface{}{"pid": json.Number("1")}}, req)
+
+	req, err = ParseRequest(httptest.NewRequest(http.MethodPost, "/graphql",
+		strings.NewReader(`{"query":"{model}","variables":{"pid":2}}`)))
+	require.NoError(t, err)
+	require.Equal(t, Request{Query: "{model}", Variables: map[string]interface{}{"pid": json.Number("2")}}, req)
+
+	_, err = ParseRequest(httptest.NewRequest(http.MethodGet, `/graphql`, nil))
+	require.Error(t, err)
+
+	_, err = ParseRequest(httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(`{"query":`)))
+	require.Error(t, err)
+}
+
+func TestArgs(t *testing.T) {
+	args := Args{"int": int64(1), "number": json.Number("2"), "float": 3.5, "string": "value", "bool": true}
+
+	i, err := args.Int("int", 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), i)
+
+	i, err = args.Int("number", 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), i)
+
+	i, err = args.Int("missing", 5)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), i)
+
+	_, err = args.Int("float", 0)
+	require.Error(t, err)
+
+	s, err := args.String("string", "")
+	require.NoError(t, err)
+	require.Equal(t, "value", s)
+
+	_, err = args.String("int", "")
+	require.Error(t, err)
+
+	b, err := args.Bool("bool", false)
+	require.NoError(t, err)
+	require.True(t, b)
+}
@@ -0,0 +1,703 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+/*
+	Document
+*/
+
+type document struct {
+	operations []*operation
+	fragments  map[string]*fragment
+}
+
+type operation struct {
+	kind         string // `query`, `mutation` or `subscription`
+	name         string
+	variables    []variableDefinition
+	selectionSet []selection
+}
+
+type variableDefinition struct {
+	name         string
+	nonNull      bool
+	defaultValue value
+}
+
+type fragment struct {
+	name         string
+	selectionSet []selection
+}
+
+// selection is either a field, a fragment spread or an inline fragment.
+type selection struct {
+	// field
+	alias        string
+	name         string
+	arguments    []argument
+	selectionSet []selection
+	// fragment spread (`...Name`) or inline fragment (`... on Type { }`) if set
+	fragmentSpread string
+	inline         bool
+	directives     []directive
+}
+
+func (s selection) responseKey() string {
+	if len(s.alias) != 0 {
+		return s.alias
+	}
+
+	return s.name
+}
+
+type argument struct {
+	name  string
+	value value
+}
+
+type directive struct {
+	name      string
+	arguments []argument
+}
+
+// value is a literal or a variable reference.
+type value struct {
+	variable string
+	literal  interface{}
+	list     []value
+	object   []argument
+	kind     valueKind
+}
+
+type valueKind int
+
+const (
+	literalValue valueKind = iota
+	variableValue
+	listValue
+	objectValue
+)
+
+// Returns the value substituting the variables.
+func (v value) resolve(variables map[string]interface{}) interface{} {
+	switch v.kind {
+	case variableValue:
+		return variables[v.variable]
+	case listValue:
+		list := make([]interface{}, 0, len(v.list))
+		for _, item := range v.list {
+			list = append(list, item.resolve(variables))
+		}
+
+		return list
+	case objectValue:
+		object := make(map[string]interface{}, len(v.object))
+		for _, field := range v.object {
+			object[field.name] = field.value.resolve(variables)
+		}
+
+		return object
+	default:
+		return v.literal
+	}
+}
+
+/*
+	Lexer
+*/
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunctuator
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of the query"
+	}
+
+	return fmt.Sprintf("%q", t.value)
+}
+
+func tokenize(source string) ([]token, error) {
+	var tokens []token
+
+	for pos := 0; pos < len(source); {
+		c := source[pos]
+
+		switch {
+		// the commas are insignificant as the white spaces
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			pos++
+		case c == '#':
+			for pos < len(source) && source[pos] != '\n' && source[pos] != '\r' {
+				pos++
+			}
+		case strings.HasPrefix(source[pos:], "..."):
+			tokens = append(tokens, token{kind: tokenPunctuator, value: "...", pos: pos})
+			pos += 3
+		case strings.IndexByte("!$():=@[]{}|", c) >= 0:
+			tokens = append(tokens, token{kind: tokenPunctuator, value: string(c), pos: pos})
+			pos++
+		case c == '_' || isLetter(c):
+			start := pos
+			for pos < len(source) && (source[pos] == '_' || isLetter(source[pos]) || isDigit(source[pos])) {
+				pos++
+			}
+
+			tokens = append(tokens, token{kind: tokenName, value: source[start:pos], pos: start})
+		case c == '-' || isDigit(c):
+			start := pos
+			kind := tokenInt
+
+			for pos++; pos < len(source); pos++ {
+				if d := source[pos]; d == '.' || d == 'e' || d == 'E' || d == '+' || d == '-' {
+					kind = tokenFloat
+				} else if !isDigit(d) {
+					break
+				}
+			}
+
+			tokens = append(tokens, token{kind: kind, value: source[start:pos], pos: start})
+		case c == '"':
+			end, err := stringEnd(source, pos)
+			if err != nil {
+				return nil, err
+			}
+
+			var unquoted string
+			if err := json.Unmarshal([]byte(source[pos:end]), &unquoted); err != nil {
+				return nil, fmt.Errorf("invalid string at position %d: %v", pos, err)
+			}
+
+			tokens = append(tokens, token{kind: tokenString, value: unquoted, pos: pos})
+			pos = end
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, pos)
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, pos: len(source)}), nil
+}
+
+// Returns the position after the closing quote of the string starting at the position.
+func stringEnd(source string, start int) (int, error) {
+	if strings.HasPrefix(source[start:], `"""`) {
+		return 0, fmt.Errorf("block strings are not supported (position %d)", start)
+	}
+
+	for pos := start + 1; pos < len(source); pos++ {
+		switch source[pos] {
+		case '\\':
+			pos++
+		case '\n', '\r':
+			return 0, fmt.Errorf("unterminated string at position %d", start)
+		case '"':
+			return pos + 1, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unterminated string at position %d", start)
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+/*
+	Parser
+*/
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+// Parses the query document (the executable definitions only: the operations and the fragments).
+func parse(source string) (*document, error) {
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	doc := &document{fragments: map[string]*fragment{}}
+
+	for p.peek().kind != tokenEOF {
+		if p.peekName("fragment") {
+			fragment, err := p.parseFragment()
+			if err != nil {
+				return nil, err
+			}
+
+			if _, ok := doc.fragments[fragment.name]; ok {
+				return nil, fmt.Errorf("fragment %q is defined more than once", fragment.name)
+			}
+
+			doc.fragments[fragment.name] = fragment
+
+			continue
+		}
+
+		operation, err := p.parseOperation()
+		if err != nil {
+			return nil, err
+		}
+
+		doc.operations = append(doc.operations, operation)
+	}
+
+	if len(doc.operations) == 0 {
+		return nil, fmt.Errorf("the document does not contain any operation")
+	}
+
+	return doc, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) peekPunctuator(value string) bool {
+	return p.peek().kind == tokenPunctuator && p.peek().value == value
+}
+
+func (p *parser) peekName(value string) bool {
+	return p.peek().kind == tokenName && p.peek().value == value
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+
+	return t
+}
+
+// Skips the punctuator if it is the next token.
+func (p *parser) skip(value string) bool {
+	if p.peekPunctuator(value) {
+		p.pos++
+
+		return true
+	}
+
+	return false
+}
+
+func (p *parser) expect(value string) error {
+	if !p.skip(value) {
+		return p.unexpected()
+	}
+
+	return nil
+}
+
+func (p *parser) expectName() (string, error) {
+	if p.peek().kind != tokenName {
+		return "", p.unexpected()
+	}
+
+	return p.next().value, nil
+}
+
+func (p *parser) unexpected() error {
+	t := p.peek()
+
+	return fmt.Errorf("syntax error: unexpected %v at position %d", t, t.pos)
+}
+
+func (p *parser) parseOperation() (*operation, error) {
+	op := &operation{kind: "query"}
+
+	if !p.peekPunctuator("{") {
+		kind, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+
+		if kind != "query" && kind != "mutation" && kind != "subscription" {
+			return nil, fmt.Errorf("syntax error: unexpected %q, expected an operation", kind)
+		}
+
+		op.kind = kind
+
+		if p.peek().kind == tokenName {
+			op.name = p.next().value
+		}
+
+		if op.variables, err = p.parseVariableDefinitions(); err != nil {
+			return nil, err
+		}
+
+		if _, err = p.parseDirectives(); err != nil {
+			return nil, err
+		}
+	}
+
+	selectionSet, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+
+	op.selectionSet = selectionSet
+
+	return op, nil
+}
+
+func (p *parser) parseFragment() (*fragment, error) {
+	p.next()
+
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.parseTypeCondition(); err != nil {
+		return nil, err
+	}
+
+	if _, err = p.parseDirectives(); err != nil {
+		return nil, err
+	}
+
+	selectionSet, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return &fragment{name: name, selectionSet: selectionSet}, nil
+}
+
+// The type conditions are parsed, but not checked: the schema has no abstract types.
+func (p *parser) parseTypeCondition() error {
+	if !p.peekName("on") {
+		return p.unexpected()
+	}
+
+	p.next()
+
+	_, err := p.expectName()
+
+	return err
+}
+
+func (p *parser) parseVariableDefinitions() ([]variableDefinition, error) {
+	if !p.skip("(") {
+		return nil, nil
+	}
+
+	var definitions []variableDefinition
+
+	for !p.skip(")") {
+		if err := p.expect("$"); err != nil {
+			return nil, err
+		}
+
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+
+		if err = p.expect(":"); err != nil {
+			return nil, err
+		}
+
+		definition := variableDefinition{name: name}
+
+		if definition.nonNull, err = p.parseType(); err != nil {
+			return nil, err
+		}
+
+		if p.skip("=") {
+			if definition.defaultValue, err = p.parseValue(true); err != nil {
+				return nil, err
+			}
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	return definitions, nil
+}
+
+// Parses the type reference and returns whether it is non-null.
+func (p *parser) parseType() (bool, error) {
+	if p.skip("[") {
+		if _, err := p.parseType(); err != nil {
+			return false, err
+		}
+
+		if err := p.expect("]"); err != nil {
+			return false, err
+		}
+	} else if _, err := p.expectName(); err != nil {
+		return false, err
+	}
+
+	return p.skip("!"), nil
+}
+
+func (p *parser) parseSelectionSet() ([]selection, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	var selections []selection
+
+	for !p.skip("}") {
+		selection, err := p.parseSelection()
+		if err != nil {
+			return nil, err
+		}
+
+		selections = append(selections, selection)
+	}
+
+	if len(selections) == 0 {
+		return nil, fmt.Errorf("syntax error: empty selection set at position %d", p.tokens[p.pos-1].pos)
+	}
+
+	return selections, nil
+}
+
+func (p *parser) parseSelection() (selection, error) {
+	var (
+		s   selection
+		err error
+	)
+
+	if p.skip("...") {
+		if p.peek().kind == tokenName && !p.peekName("on") {
+			s.fragmentSpread = p.next().value
+			s.directives, err = p.parseDirectives()
+
+			return s, err
+		}
+
+		s.inline = true
+
+		if p.peekName("on") {
+			if err = p.parseTypeCondition(); err != nil {
+				return s, err
+			}
+		}
+
+		if s.directives, err = p.parseDirectives(); err != nil {
+			return s, err
+		}
+
+		s.selectionSet, err = p.parseSelectionSet()
+
+		return s, err
+	}
+
+	if s.name, err = p.expectName(); err != nil {
+		return s, err
+	}
+
+	if p.skip(":") {
+		s.alias = s.name
+
+		if s.name, err = p.expectName(); err != nil {
+			return s, err
+		}
+	}
+
+	if s.arguments, err = p.parseArguments(false); err != nil {
+		return s, err
+	}
+
+	if s.directives, err = p.parseDirectives(); err != nil {
+		return s, err
+	}
+
+	if p.peekPunctuator("{") {
+		s.selectionSet, err = p.parseSelectionSet()
+	}
+
+	return s, err
+}
+
+func (p *parser) parseArguments(constant bool) ([]argument, error) {
+	if !p.skip("(") {
+		return nil, nil
+	}
+
+	var arguments []argument
+
+	for !p.skip(")") {
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+
+		if err = p.expect(":"); err != nil {
+			return nil, err
+		}
+
+		value, err := p.parseValue(constant)
+		if err != nil {
+			return nil, err
+		}
+
+		arguments = append(arguments, argument{name: name, value: value})
+	}
+
+	return arguments, nil
+}
+
+func (p *parser) parseDirectives() ([]directive, error) {
+	var directives []directive
+
+	for p.skip("@") {
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+
+		arguments, err := p.parseArguments(false)
+		if err != nil {
+			return nil, err
+		}
+
+		directives = append(directives, directive{name: name, arguments: arguments})
+	}
+
+	return directives, nil
+}
+
+// Parses the value. The variables are not allowed in the constant values (the defaults of the variables).
+func (p *parser) parseValue(constant bool) (value, error) {
+	t := p.peek()
+
+	switch t.kind {
+	case tokenPunctuator:
+		switch t.value {
+		case "$":
+			if constant {
+				return value{}, p.unexpected()
+			}
+
+			p.next()
+
+			name, err := p.expectName()
+
+			return value{kind: variableValue, variable: name}, err
+		case "[":
+			p.next()
+
+			v := value{kind: listValue, list: []value{}}
+
+			for !p.skip("]") {
+				item, err := p.parseValue(constant)
+				if err != nil {
+					return value{}, err
+				}
+
+				v.list = append(v.list, item)
+			}
+
+			return v, nil
+		case "{":
+			p.next()
+
+			v := value{kind: objectValue}
+
+			for !p.skip("}") {
+				name, err := p.expectName()
+				if err != nil {
+					return value{}, err
+				}
+
+				if err = p.expect(":"); err != nil {
+					return value{}, err
+				}
+
+				field, err := p.parseValue(constant)
+				if err != nil {
+					return value{}, err
+				}
+
+				v.object = append(v.object, argument{name: name, value: field})
+			}
+
+			return v, nil
+		}
+	case tokenInt:
+		p.next()
+
+		i, err := strconv.ParseInt(t.value, 10, 64)
+		if err != nil {
+			return value{}, fmt.Errorf("invalid integer %s at position %d", t.value, t.pos)
+		}
+
+		return value{literal: i}, nil
+	case tokenFloat:
+		p.next()
+
+		f, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return value{}, fmt.Errorf("invalid number %s at position %d", t.value, t.pos)
+		}
+
+		return value{literal: f}, nil
+	case tokenString:
+		p.next()
+
+		return value{literal: t.value}, nil
+	case tokenName:
+		p.next()
+
+		switch t.value {
+		case "true":
+			return value{literal: true}, nil
+		case "false":
+			return value{literal: false}, nil
+		case "null":
+			return value{literal: nil}, nil
+		default:
+			// the enum values are passed as strings
+			return value{literal: t.value}, nil
+		}
+	case tokenEOF:
+	}
+
+	return value{}, p.unexpected()
+}
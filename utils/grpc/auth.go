@@ -0,0 +1,142 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	AuthQueryServiceName      = "dcl.auth.Query"
+	accountMethodFullName     = "/" + AuthQueryServiceName + "/Account"
+	allAccountsMethodFullName = "/" + AuthQueryServiceName + "/AllAccounts"
+)
+
+// AccountRequest is the request of the `Account` method.
+type AccountRequest struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// AuthQueryServer is the server API for the `dcl.auth.Query` gRPC service.
+type AuthQueryServer interface {
+	Account(context.Context, *AccountRequest) (*auth.Account, error)
+	AllAccounts(context.Context, *pagination.PaginationParams) (*auth.ListAccounts, error)
+}
+
+// AuthQueryClient is the client API for the `dcl.auth.Query` gRPC service.
+type AuthQueryClient interface {
+	Account(ctx context.Context, in *AccountRequest, opts ...grpc.CallOption) (*auth.Account, error)
+	AllAccounts(ctx context.Context, in *pagination.PaginationParams,
+		opts ...grpc.CallOption) (*auth.ListAccounts, error)
+}
+
+/*
+	Server
+*/
+
+func (s queryServer) Account(ctx context.Context, req *AccountRequest) (*auth.Account, error) {
+	if req.Address.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "Invalid Address: it cannot be empty")
+	}
+
+	path := fmt.Sprintf("custom/%s/%s", auth.StoreKey, auth.QueryAccount)
+
+	var result auth.Account
+	if err := s.query(ctx, path, auth.NewQueryAccountParams(req.Address), &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s queryServer) AllAccounts(ctx context.Context, req *pagination.PaginationParams) (*auth.ListAccounts, error) {
+	path := fmt.Sprintf("custom/%s/%s", auth.StoreKey, auth.QueryAllAccounts)
+
+	var result auth.ListAccounts
+	if err := s.query(ctx, path, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RegisterAuthQueryServer registers the `dcl.auth.Query` service implementation on the gRPC server.
+func RegisterAuthQueryServer(s *grpc.Server, srv AuthQueryServer) {
+	s.RegisterService(&authQueryServiceDesc, srv)
+}
+
+var authQueryServiceDesc = grpc.ServiceDesc{
+	ServiceName: AuthQueryServiceName,
+	HandlerType: (*AuthQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Account",
+			Handler: unaryHandler(accountMethodFullName, func() interface{} { return new(AccountRequest) },
+				func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(AuthQueryServer).Account(ctx, req.(*AccountRequest))
+				}),
+		},
+		{
+			MethodName: "AllAccounts",
+			Handler: unaryHandler(allAccountsMethodFullName,
+				func() interface{} { return new(pagination.PaginationParams) },
+				func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(AuthQueryServer).AllAccounts(ctx, req.(*pagination.PaginationParams))
+				}),
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+/*
+	Client
+*/
+
+type authQueryClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewAuthQueryClient creates a client for the `dcl.auth.Query` gRPC service.
+func NewAuthQueryClient(cc *grpc.ClientConn) AuthQueryClient {
+	return authQueryClient{cc: cc}
+}
+
+func (c authQueryClient) Account(ctx context.Context, in *AccountRequest,
+	opts ...grpc.CallOption) (*auth.Account, error) {
+	out := new(auth.Account)
+	if err := invoke(ctx, c.cc, accountMethodFullName, in, out, opts); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (c authQueryClient) AllAccounts(ctx context.Context, in *pagination.PaginationParams,
+	opts ...grpc.CallOption) (*auth.ListAccounts, error) {
+	out := new(auth.ListAccounts)
+	if err := invoke(ctx, c.cc, allAccountsMethodFullName, in, out, opts); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"google.golang.org/grpc/encoding"
+)
+
+// CodecName is the content-subtype of the module query services: their messages are encoded in amino JSON
+// (the same as the REST API responses), so the clients send the `application/grpc+amino-json` content type.
+const CodecName = "amino-json"
+
+var cdc = codec.New()
+
+func init() {
+	codec.RegisterCrypto(cdc)
+	encoding.RegisterCodec(aminoJSONCodec{})
+}
+
+type aminoJSONCodec struct{}
+
+func (aminoJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return cdc.MarshalJSON(v)
+}
+
+func (aminoJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return cdc.UnmarshalJSON(data, v)
+}
+
+func (aminoJSONCodec) Name() string {
+	return CodecName
+}
@@ -0,0 +1,147 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ComplianceQueryServiceName             = "dcl.compliance.Query"
+	complianceInfoMethodFullName           = "/" + ComplianceQueryServiceName + "/ComplianceInfo"
+	allComplianceInfoRecordsMethodFullName = "/" + ComplianceQueryServiceName + "/AllComplianceInfoRecords"
+)
+
+// ComplianceInfoRequest is the request of the `ComplianceInfo` method.
+type ComplianceInfoRequest struct {
+	VID               uint16                       `json:"vid"`
+	PID               uint16                       `json:"pid"`
+	CertificationType compliance.CertificationType `json:"certification_type"`
+}
+
+// ComplianceQueryServer is the server API for the `dcl.compliance.Query` gRPC service.
+type ComplianceQueryServer interface {
+	ComplianceInfo(context.Context, *ComplianceInfoRequest) (*compliance.ComplianceInfo, error)
+	AllComplianceInfoRecords(context.Context, *compliance.ListQueryParams) (*compliance.ListComplianceInfoItems, error)
+}
+
+// ComplianceQueryClient is the client API for the `dcl.compliance.Query` gRPC service.
+type ComplianceQueryClient interface {
+	ComplianceInfo(ctx context.Context, in *ComplianceInfoRequest,
+		opts ...grpc.CallOption) (*compliance.ComplianceInfo, error)
+	AllComplianceInfoRecords(ctx context.Context, in *compliance.ListQueryParams,
+		opts ...grpc.CallOption) (*compliance.ListComplianceInfoItems, error)
+}
+
+/*
+	Server
+*/
+
+func (s queryServer) ComplianceInfo(ctx context.Context,
+	req *ComplianceInfoRequest) (*compliance.ComplianceInfo, error) {
+	if len(req.CertificationType) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid CertificationType: it cannot be empty")
+	}
+
+	path := fmt.Sprintf("custom/%s/%s/%v/%v/%v", compliance.StoreKey, compliance.QueryComplianceInfo,
+		req.VID, req.PID, req.CertificationType)
+
+	var result compliance.ComplianceInfo
+	if err := s.query(ctx, path, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s queryServer) AllComplianceInfoRecords(ctx context.Context,
+	req *compliance.ListQueryParams) (*compliance.ListComplianceInfoItems, error) {
+	path := fmt.Sprintf("custom/%s/%s", compliance.StoreKey, compliance.QueryAllComplianceInfoRecords)
+
+	var result compliance.ListComplianceInfoItems
+	if err := s.query(ctx, path, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RegisterComplianceQueryServer registers the `dcl.compliance.Query` service implementation on the gRPC server.
+func RegisterComplianceQueryServer(s *grpc.Server, srv ComplianceQueryServer) {
+	s.RegisterService(&complianceQueryServiceDesc, srv)
+}
+
+var complianceQueryServiceDesc = grpc.ServiceDesc{
+	ServiceName: ComplianceQueryServiceName,
+	HandlerType: (*ComplianceQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ComplianceInfo",
+			Handler: unaryHandler(complianceInfoMethodFullName,
+				func() interface{} { return new(ComplianceInfoRequest) },
+				func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(ComplianceQueryServer).ComplianceInfo(ctx, req.(*ComplianceInfoRequest))
+				}),
+		},
+		{
+			MethodName: "AllComplianceInfoRecords",
+			Handler: unaryHandler(allComplianceInfoRecordsMethodFullName,
+				func() interface{} { return new(compliance.ListQueryParams) },
+				func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(ComplianceQueryServer).AllComplianceInfoRecords(ctx, req.(*compliance.ListQueryParams))
+				}),
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+/*
+	Client
+*/
+
+type complianceQueryClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewComplianceQueryClient creates a client for the `dcl.compliance.Query` gRPC service.
+func NewComplianceQueryClient(cc *grpc.ClientConn) ComplianceQueryClient {
+	return complianceQueryClient{cc: cc}
+}
+
+func (c complianceQueryClient) ComplianceInfo(ctx context.Context, in *ComplianceInfoRequest,
+	opts ...grpc.CallOption) (*compliance.ComplianceInfo, error) {
+	out := new(compliance.ComplianceInfo)
+	if err := invoke(ctx, c.cc, complianceInfoMethodFullName, in, out, opts); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (c complianceQueryClient) AllComplianceInfoRecords(ctx context.Context, in *compliance.ListQueryParams,
+	opts ...grpc.CallOption) (*compliance.ListComplianceInfoItems, error) {
+	out := new(compliance.ListComplianceInfoItems)
+	if err := invoke(ctx, c.cc, allComplianceInfoRecordsMethodFullName, in, out, opts); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
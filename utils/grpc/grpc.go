@@ -0,0 +1,253 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	FlagAddress         = "grpc-laddr"
+	FlagAddressUsage    = "Address the gRPC query server listens on (empty value disables the server)"
+	QueryServiceName    = "dcl.Query"
+	QueryMethodName     = "Query"
+	queryMethodFullName = "/" + QueryServiceName + "/" + QueryMethodName
+
+	// HeightHeader is the response header of the module query services containing the height
+	// the result is queried at.
+	HeightHeader = "x-dcl-height"
+)
+
+// QueryServer is the server API for the `dcl.Query` gRPC service.
+// It exposes every ledger query endpoint using the same paths as ABCI queries:
+//   - `custom/<module>/<query>` for module queriers (e.g. `custom/modelinfo/all_models`)
+//   - `store/<module>/key` for raw store reads (e.g. model info, compliance info, accounts)
+//
+// The typed queries of the modules are exposed by the module services (e.g. `dcl.modelinfo.Query`).
+type QueryServer interface {
+	Query(context.Context, *abci.RequestQuery) (*abci.ResponseQuery, error)
+}
+
+// QueryClient is the client API for the `dcl.Query` gRPC service.
+type QueryClient interface {
+	Query(ctx context.Context, in *abci.RequestQuery, opts ...grpc.CallOption) (*abci.ResponseQuery, error)
+}
+
+/*
+	Server
+*/
+
+// The part of the node RPC client the queries are forwarded to.
+type nodeQuerier interface {
+	ABCIQueryWithOptions(path string, data cmn.HexBytes,
+		opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error)
+}
+
+// queryServer implements `dcl.Query` and all the module query services.
+type queryServer struct {
+	node nodeQuerier
+}
+
+// NewQueryServer creates a QueryServer forwarding all requests to the node RPC listening on nodeURI.
+func NewQueryServer(nodeURI string) QueryServer {
+	return queryServer{node: rpcclient.NewHTTP(nodeURI, "/websocket")}
+}
+
+func (s queryServer) Query(ctx context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error) {
+	if len(req.Path) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Invalid Path: it cannot be empty")
+	}
+
+	opts := rpcclient.ABCIQueryOptions{
+		Height: req.Height,
+		Prove:  req.Prove,
+	}
+
+	result, err := s.node.ABCIQueryWithOptions(req.Path, req.Data, opts)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	return &result.Response, nil
+}
+
+// Queries the module querier on the given path (with JSON encoded params if any) at the latest height
+// and decodes the result into the given pointer. The height of the result is sent in the response header.
+func (s queryServer) query(ctx context.Context, path string, params interface{}, result interface{}) error {
+	var data []byte
+
+	if params != nil {
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		data = bz
+	}
+
+	res, err := s.node.ABCIQueryWithOptions(path, data, rpcclient.ABCIQueryOptions{})
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+
+	if !res.Response.IsOK() {
+		return queryError(res.Response)
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(HeightHeader, strconv.FormatInt(res.Response.Height, 10)))
+
+	if err := cdc.UnmarshalJSON(res.Response.Value, result); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+// The malformed requests are rejected by the queriers with the SDK codes, the missing records
+// are reported with the module codes.
+func queryError(res abci.ResponseQuery) error {
+	code := codes.NotFound
+
+	if res.Codespace == string(sdk.CodespaceRoot) {
+		switch sdk.CodeType(res.Code) {
+		case sdk.CodeUnknownRequest, sdk.CodeInvalidAddress, sdk.CodeInvalidPubKey:
+			code = codes.InvalidArgument
+		default:
+			code = codes.Internal
+		}
+	}
+
+	return status.Errorf(code, "%s (codespace: %s, code: %d)", res.Log, res.Codespace, res.Code)
+}
+
+// RegisterQueryServer registers the `dcl.Query` service implementation on the gRPC server.
+func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
+	s.RegisterService(&queryServiceDesc, srv)
+}
+
+// StartServer starts the gRPC query server on the given address in the background.
+func StartServer(address string, nodeURI string) (*grpc.Server, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	server := grpc.NewServer()
+	registerServices(server, rpcclient.NewHTTP(nodeURI, "/websocket"))
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	return server, nil
+}
+
+func registerServices(server *grpc.Server, node nodeQuerier) {
+	srv := queryServer{node: node}
+
+	RegisterQueryServer(server, srv)
+	RegisterModelInfoQueryServer(server, srv)
+	RegisterComplianceQueryServer(server, srv)
+	RegisterPkiQueryServer(server, srv)
+	RegisterAuthQueryServer(server, srv)
+	RegisterValidatorQueryServer(server, srv)
+}
+
+var queryServiceDesc = grpc.ServiceDesc{
+	ServiceName: QueryServiceName,
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: QueryMethodName,
+			Handler: unaryHandler(queryMethodFullName, func() interface{} { return new(abci.RequestQuery) },
+				func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(QueryServer).Query(ctx, req.(*abci.RequestQuery))
+				}),
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+type methodHandler = func(srv interface{}, ctx context.Context, dec func(interface{}) error,
+	interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+type methodCall = func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error)
+
+// Returns the handler of the unary method decoding the request created by newRequest and passing it to call.
+func unaryHandler(fullMethod string, newRequest func() interface{}, call methodCall) methodHandler {
+	return func(srv interface{}, ctx context.Context, dec func(interface{}) error,
+		interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+		in := newRequest()
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+
+		if interceptor == nil {
+			return call(srv, ctx, in)
+		}
+
+		info := &grpc.UnaryServerInfo{
+			Server:     srv,
+			FullMethod: fullMethod,
+		}
+
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			return call(srv, ctx, req)
+		}
+
+		return interceptor(ctx, in, info, handler)
+	}
+}
+
+/*
+	Client
+*/
+
+type queryClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewQueryClient creates a client for the `dcl.Query` gRPC service.
+func NewQueryClient(cc *grpc.ClientConn) QueryClient {
+	return queryClient{cc: cc}
+}
+
+func (c queryClient) Query(ctx context.Context, in *abci.RequestQuery,
+	opts ...grpc.CallOption) (*abci.ResponseQuery, error) {
+	out := new(abci.ResponseQuery)
+	if err := c.cc.Invoke(ctx, queryMethodFullName, in, out, opts...); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// Invokes the module query method, the messages are encoded in amino JSON.
+func invoke(ctx context.Context, cc *grpc.ClientConn, method string, in interface{}, out interface{},
+	opts []grpc.CallOption) error {
+	return cc.Invoke(ctx, method, in, out, append([]grpc.CallOption{grpc.CallContentSubtype(CodecName)}, opts...)...)
+}
@@ -0,0 +1,174 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	cmn "github.com/tendermint/tendermint/libs/common"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Answers the queries on the known paths, the received query data is recorded by path.
+type nodeMock struct {
+	responses map[string]abci.ResponseQuery
+	data      map[string][]byte
+}
+
+func newNodeMock() *nodeMock {
+	return &nodeMock{
+		responses: map[string]abci.ResponseQuery{},
+		data:      map[string][]byte{},
+	}
+}
+
+func (m *nodeMock) ABCIQueryWithOptions(path string, data cmn.HexBytes,
+	opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	m.data[path] = data
+
+	response, ok := m.responses[path]
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "unexpected query")
+	}
+
+	return &ctypes.ResultABCIQuery{Response: response}, nil
+}
+
+func (m *nodeMock) respond(path string, result interface{}, height int64) {
+	m.responses[path] = abci.ResponseQuery{Value: cdc.MustMarshalJSON(result), Height: height}
+}
+
+// Starts the server forwarding the queries to the node on an in-memory listener and connects to it.
+func startServer(t *testing.T, node nodeQuerier) (conn *grpc.ClientConn, stop func()) {
+	listener := bufconn.Listen(1024 * 1024)
+
+	server := grpc.NewServer()
+	registerServices(server, node)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithInsecure())
+	require.NoError(t, err)
+
+	return conn, func() {
+		conn.Close()
+		server.Stop()
+	}
+}
+
+func TestModelInfoQuery_Model(t *testing.T) {
+	node := newNodeMock()
+	conn, stop := startServer(t, node)
+
+	defer stop()
+
+	model := modelinfo.ModelInfo{
+		VID:         1,
+		PID:         2,
+		Name:        "Device",
+		Description: "Device Description",
+		SKU:         "RCU2205A",
+		Owner:       sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()),
+	}
+	node.respond("custom/modelinfo/model/1/2", model, 7)
+	node.responses["custom/modelinfo/model/1/3"] = modelinfo.ErrModelInfoDoesNotExist(1, 3).QueryResult()
+
+	client := NewModelInfoQueryClient(conn)
+
+	var header metadata.MD
+
+	received, err := client.Model(context.Background(), &ModelRequest{VID: 1, PID: 2}, grpc.Header(&header))
+	require.NoError(t, err)
+	require.Equal(t, model, *received)
+	require.Equal(t, []string{"7"}, header.Get(HeightHeader))
+
+	_, err = client.Model(context.Background(), &ModelRequest{VID: 1, PID: 3})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAuthQuery_Accounts(t *testing.T) {
+	node := newNodeMock()
+	conn, stop := startServer(t, node)
+
+	defer stop()
+
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	account := auth.NewAccount(sdk.AccAddress(pubKey.Address()), pubKey, auth.AccountRoles{auth.Vendor})
+	account.AccountNumber = 1
+	account.VendorID = 1000
+
+	node.respond("custom/acc/account", account, 3)
+	node.respond("custom/acc/all_accounts", auth.ListAccounts{Total: 1, Items: []auth.Account{account}}, 3)
+
+	client := NewAuthQueryClient(conn)
+
+	received, err := client.Account(context.Background(), &AccountRequest{Address: account.Address})
+	require.NoError(t, err)
+	require.Equal(t, account, *received)
+	require.Equal(t, cdc.MustMarshalJSON(auth.NewQueryAccountParams(account.Address)), node.data["custom/acc/account"])
+
+	list, err := client.AllAccounts(context.Background(), &pagination.PaginationParams{Skip: 0, Take: 10})
+	require.NoError(t, err)
+	require.Equal(t, []auth.Account{account}, list.Items)
+	require.Equal(t, cdc.MustMarshalJSON(pagination.PaginationParams{Skip: 0, Take: 10}),
+		node.data["custom/acc/all_accounts"])
+
+	// the request is checked before querying the node
+	_, err = client.Account(context.Background(), &AccountRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQuery_RawQuery(t *testing.T) {
+	node := newNodeMock()
+	conn, stop := startServer(t, node)
+
+	defer stop()
+
+	node.responses["store/modelinfo/key"] = abci.ResponseQuery{Value: []byte{0x01, 0x02}, Height: 5}
+
+	client := NewQueryClient(conn)
+
+	response, err := client.Query(context.Background(),
+		&abci.RequestQuery{Path: "store/modelinfo/key", Data: []byte{0x03}})
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01, 0x02}, response.Value)
+	require.Equal(t, int64(5), response.Height)
+	require.Equal(t, []byte{0x03}, node.data["store/modelinfo/key"])
+
+	_, err = client.Query(context.Background(), &abci.RequestQuery{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
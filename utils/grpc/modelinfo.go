@@ -0,0 +1,136 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+	"google.golang.org/grpc"
+)
+
+const (
+	ModelInfoQueryServiceName = "dcl.modelinfo.Query"
+	modelMethodFullName       = "/" + ModelInfoQueryServiceName + "/Model"
+	allModelsMethodFullName   = "/" + ModelInfoQueryServiceName + "/AllModels"
+)
+
+// ModelRequest is the request of the `Model` method.
+type ModelRequest struct {
+	VID uint16 `json:"vid"`
+	PID uint16 `json:"pid"`
+}
+
+// ModelInfoQueryServer is the server API for the `dcl.modelinfo.Query` gRPC service.
+type ModelInfoQueryServer interface {
+	Model(context.Context, *ModelRequest) (*modelinfo.ModelInfo, error)
+	AllModels(context.Context, *modelinfo.ListModelsQueryParams) (*modelinfo.ListModelInfoItems, error)
+}
+
+// ModelInfoQueryClient is the client API for the `dcl.modelinfo.Query` gRPC service.
+type ModelInfoQueryClient interface {
+	Model(ctx context.Context, in *ModelRequest, opts ...grpc.CallOption) (*modelinfo.ModelInfo, error)
+	AllModels(ctx context.Context, in *modelinfo.ListModelsQueryParams,
+		opts ...grpc.CallOption) (*modelinfo.ListModelInfoItems, error)
+}
+
+/*
+	Server
+*/
+
+func (s queryServer) Model(ctx context.Context, req *ModelRequest) (*modelinfo.ModelInfo, error) {
+	path := fmt.Sprintf("custom/%s/%s/%v/%v", modelinfo.StoreKey, modelinfo.QueryModel, req.VID, req.PID)
+
+	var result modelinfo.ModelInfo
+	if err := s.query(ctx, path, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s queryServer) AllModels(ctx context.Context,
+	req *modelinfo.ListModelsQueryParams) (*modelinfo.ListModelInfoItems, error) {
+	path := fmt.Sprintf("custom/%s/%s", modelinfo.StoreKey, modelinfo.QueryAllModels)
+
+	var result modelinfo.ListModelInfoItems
+	if err := s.query(ctx, path, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RegisterModelInfoQueryServer registers the `dcl.modelinfo.Query` service implementation on the gRPC server.
+func RegisterModelInfoQueryServer(s *grpc.Server, srv ModelInfoQueryServer) {
+	s.RegisterService(&modelInfoQueryServiceDesc, srv)
+}
+
+var modelInfoQueryServiceDesc = grpc.ServiceDesc{
+	ServiceName: ModelInfoQueryServiceName,
+	HandlerType: (*ModelInfoQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Model",
+			Handler: unaryHandler(modelMethodFullName, func() interface{} { return new(ModelRequest) },
+				func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(ModelInfoQueryServer).Model(ctx, req.(*ModelRequest))
+				}),
+		},
+		{
+			MethodName: "AllModels",
+			Handler: unaryHandler(allModelsMethodFullName,
+				func() interface{} { return new(modelinfo.ListModelsQueryParams) },
+				func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(ModelInfoQueryServer).AllModels(ctx, req.(*modelinfo.ListModelsQueryParams))
+				}),
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+/*
+	Client
+*/
+
+type modelInfoQueryClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewModelInfoQueryClient creates a client for the `dcl.modelinfo.Query` gRPC service.
+func NewModelInfoQueryClient(cc *grpc.ClientConn) ModelInfoQueryClient {
+	return modelInfoQueryClient{cc: cc}
+}
+
+func (c modelInfoQueryClient) Model(ctx context.Context, in *ModelRequest,
+	opts ...grpc.CallOption) (*modelinfo.ModelInfo, error) {
+	out := new(modelinfo.ModelInfo)
+	if err := invoke(ctx, c.cc, modelMethodFullName, in, out, opts); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (c modelInfoQueryClient) AllModels(ctx context.Context, in *modelinfo.ListModelsQueryParams,
+	opts ...grpc.CallOption) (*modelinfo.ListModelInfoItems, error) {
+	out := new(modelinfo.ListModelInfoItems)
+	if err := invoke(ctx, c.cc, allModelsMethodFullName, in, out, opts); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,141 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	PkiQueryServiceName            = "dcl.pki.Query"
+	x509CertMethodFullName         = "/" + PkiQueryServiceName + "/X509Cert"
+	allX509RootCertsMethodFullName = "/" + PkiQueryServiceName + "/AllX509RootCerts"
+)
+
+// CertificateRequest is the request of the `X509Cert` method.
+type CertificateRequest struct {
+	Subject      string `json:"subject"`
+	SubjectKeyID string `json:"subject_key_id"`
+}
+
+// PkiQueryServer is the server API for the `dcl.pki.Query` gRPC service.
+type PkiQueryServer interface {
+	X509Cert(context.Context, *CertificateRequest) (*pki.Certificates, error)
+	AllX509RootCerts(context.Context, *pki.PkiQueryParams) (*pki.ListCertificates, error)
+}
+
+// PkiQueryClient is the client API for the `dcl.pki.Query` gRPC service.
+type PkiQueryClient interface {
+	X509Cert(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*pki.Certificates, error)
+	AllX509RootCerts(ctx context.Context, in *pki.PkiQueryParams,
+		opts ...grpc.CallOption) (*pki.ListCertificates, error)
+}
+
+/*
+	Server
+*/
+
+func (s queryServer) X509Cert(ctx context.Context, req *CertificateRequest) (*pki.Certificates, error) {
+	if len(req.Subject) == 0 || len(req.SubjectKeyID) == 0 {
+		return nil, status.Error(codes.InvalidArgument,
+			"Invalid certificate: subject and subject key id cannot be empty")
+	}
+
+	path := fmt.Sprintf("custom/%s/%s/%s/%s", pki.StoreKey, pki.QueryX509Cert, req.Subject, req.SubjectKeyID)
+
+	var result pki.Certificates
+	if err := s.query(ctx, path, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s queryServer) AllX509RootCerts(ctx context.Context, req *pki.PkiQueryParams) (*pki.ListCertificates, error) {
+	path := fmt.Sprintf("custom/%s/%s", pki.StoreKey, pki.QueryAllX509RootCerts)
+
+	var result pki.ListCertificates
+	if err := s.query(ctx, path, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RegisterPkiQueryServer registers the `dcl.pki.Query` service implementation on the gRPC server.
+func RegisterPkiQueryServer(s *grpc.Server, srv PkiQueryServer) {
+	s.RegisterService(&pkiQueryServiceDesc, srv)
+}
+
+var pkiQueryServiceDesc = grpc.ServiceDesc{
+	ServiceName: PkiQueryServiceName,
+	HandlerType: (*PkiQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "X509Cert",
+			Handler: unaryHandler(x509CertMethodFullName, func() interface{} { return new(CertificateRequest) },
+				func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(PkiQueryServer).X509Cert(ctx, req.(*CertificateRequest))
+				}),
+		},
+		{
+			MethodName: "AllX509RootCerts",
+			Handler: unaryHandler(allX509RootCertsMethodFullName, func() interface{} { return new(pki.PkiQueryParams) },
+				func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(PkiQueryServer).AllX509RootCerts(ctx, req.(*pki.PkiQueryParams))
+				}),
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+/*
+	Client
+*/
+
+type pkiQueryClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewPkiQueryClient creates a client for the `dcl.pki.Query` gRPC service.
+func NewPkiQueryClient(cc *grpc.ClientConn) PkiQueryClient {
+	return pkiQueryClient{cc: cc}
+}
+
+func (c pkiQueryClient) X509Cert(ctx context.Context, in *CertificateRequest,
+	opts ...grpc.CallOption) (*pki.Certificates, error) {
+	out := new(pki.Certificates)
+	if err := invoke(ctx, c.cc, x509CertMethodFullName, in, out, opts); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (c pkiQueryClient) AllX509RootCerts(ctx context.Context, in *pki.PkiQueryParams,
+	opts ...grpc.CallOption) (*pki.ListCertificates, error) {
+	out := new(pki.ListCertificates)
+	if err := invoke(ctx, c.cc, allX509RootCertsMethodFullName, in, out, opts); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	ValidatorQueryServiceName   = "dcl.validator.Query"
+	validatorMethodFullName     = "/" + ValidatorQueryServiceName + "/Validator"
+	allValidatorsMethodFullName = "/" + ValidatorQueryServiceName + "/AllValidators"
+)
+
+// ValidatorRequest is the request of the `Validator` method.
+type ValidatorRequest struct {
+	Address sdk.ConsAddress `json:"validator_address"`
+}
+
+// ValidatorQueryServer is the server API for the `dcl.validator.Query` gRPC service.
+type ValidatorQueryServer interface {
+	Validator(context.Context, *ValidatorRequest) (*validator.Validator, error)
+	AllValidators(context.Context, *validator.ListValidatorsParams) (*validator.ListValidatorItems, error)
+}
+
+// ValidatorQueryClient is the client API for the `dcl.validator.Query` gRPC service.
+type ValidatorQueryClient interface {
+	Validator(ctx context.Context, in *ValidatorRequest, opts ...grpc.CallOption) (*validator.Validator, error)
+	AllValidators(ctx context.Context, in *validator.ListValidatorsParams,
+		opts ...grpc.CallOption) (*validator.ListValidatorItems, error)
+}
+
+/*
+	Server
+*/
+
+func (s queryServer) Validator(ctx context.Context, req *ValidatorRequest) (*validator.Validator, error) {
+	if req.Address.Empty() {
+		return nil, status.Error(codes.InvalidArgument, "Invalid Address: it cannot be empty")
+	}
+
+	path := fmt.Sprintf("custom/%s/%s/%s", validator.StoreKey, validator.QueryValidator, req.Address)
+
+	var result validator.Validator
+	if err := s.query(ctx, path, nil, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (s queryServer) AllValidators(ctx context.Context,
+	req *validator.ListValidatorsParams) (*validator.ListValidatorItems, error) {
+	path := fmt.Sprintf("custom/%s/%s", validator.StoreKey, validator.QueryValidators)
+
+	var result validator.ListValidatorItems
+	if err := s.query(ctx, path, req, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RegisterValidatorQueryServer registers the `dcl.validator.Query` service implementation on the gRPC server.
+func RegisterValidatorQueryServer(s *grpc.Server, srv ValidatorQueryServer) {
+	s.RegisterService(&validatorQueryServiceDesc, srv)
+}
+
+var validatorQueryServiceDesc = grpc.ServiceDesc{
+	ServiceName: ValidatorQueryServiceName,
+	HandlerType: (*ValidatorQueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Validator",
+			Handler: unaryHandler(validatorMethodFullName, func() interface{} { return new(ValidatorRequest) },
+				func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(ValidatorQueryServer).Validator(ctx, req.(*ValidatorRequest))
+				}),
+		},
+		{
+			MethodName: "AllValidators",
+			Handler: unaryHandler(allValidatorsMethodFullName,
+				func() interface{} { return new(validator.ListValidatorsParams) },
+				func(srv interface{}, ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(ValidatorQueryServer).AllValidators(ctx, req.(*validator.ListValidatorsParams))
+				}),
+		},
+	},
+	Streams: []grpc.StreamDesc{},
+}
+
+/*
+	Client
+*/
+
+type validatorQueryClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewValidatorQueryClient creates a client for the `dcl.validator.Query` gRPC service.
+func NewValidatorQueryClient(cc *grpc.ClientConn) ValidatorQueryClient {
+	return validatorQueryClient{cc: cc}
+}
+
+func (c validatorQueryClient) Validator(ctx context.Context, in *ValidatorRequest,
+	opts ...grpc.CallOption) (*validator.Validator, error) {
+	out := new(validator.Validator)
+	if err := invoke(ctx, c.cc, validatorMethodFullName, in, out, opts); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (c validatorQueryClient) AllValidators(ctx context.Context, in *validator.ListValidatorsParams,
+	opts ...grpc.CallOption) (*validator.ListValidatorItems, error) {
+	out := new(validator.ListValidatorItems)
+	if err := invoke(ctx, c.cc, allValidatorsMethodFullName, in, out, opts); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
@@ -0,0 +1,144 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package headers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+	"github.com/rs/cors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	FlagCORSAllowedOrigins      = "cors-allowed-origins"
+	FlagCORSAllowedOriginsUsage = "Comma-separated list of origins a cross-domain request can be executed from " +
+		"(* allows any origin). CORS is disabled if empty"
+	FlagCORSAllowedMethods      = "cors-allowed-methods"
+	FlagCORSAllowedMethodsUsage = "Comma-separated list of methods the client is allowed to use with cross-domain requests"
+	FlagCORSAllowedHeaders      = "cors-allowed-headers"
+	FlagCORSAllowedHeadersUsage = "Comma-separated list of non simple headers the client is allowed to use " +
+		"with cross-domain requests"
+	FlagCORSAllowCredentials      = "cors-allow-credentials"
+	FlagCORSAllowCredentialsUsage = "Allow cross-domain requests to include the basic authentication " +
+		"(Trusted REST API mode)"
+	FlagCORSMaxAge           = "cors-max-age"
+	FlagCORSMaxAgeUsage      = "How long (in seconds) the results of a preflight request can be cached by the browser"
+	FlagSecurityHeaders      = "security-headers"
+	FlagSecurityHeadersUsage = "Add standard security headers " +
+		"(X-Content-Type-Options, X-Frame-Options, Referrer-Policy) to the responses"
+	FlagHSTSMaxAge      = "hsts-max-age"
+	FlagHSTSMaxAgeUsage = "Add Strict-Transport-Security header with the given max-age in seconds " +
+		"(0 disables; use only if the server is available over HTTPS)"
+	defaultCORSMaxAge = 600
+)
+
+var (
+	DefaultCORSAllowedMethods = []string{
+		http.MethodHead, http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete,
+	}
+	DefaultCORSAllowedHeaders = []string{
+		"Origin", "Accept", "Content-Type", "Authorization", "X-Requested-With", "Idempotency-Key",
+	}
+)
+
+type Config struct {
+	CORSAllowedOrigins   []string
+	CORSAllowedMethods   []string
+	CORSAllowedHeaders   []string
+	CORSAllowCredentials bool
+	CORSMaxAge           int
+	SecurityHeaders      bool
+	HSTSMaxAge           int
+}
+
+func (c Config) CORSEnabled() bool {
+	return len(c.CORSAllowedOrigins) != 0
+}
+
+// Adds the CORS and security headers flags to the REST server command.
+func AddFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().StringSlice(FlagCORSAllowedOrigins, []string{}, FlagCORSAllowedOriginsUsage)
+	cmd.Flags().StringSlice(FlagCORSAllowedMethods, DefaultCORSAllowedMethods, FlagCORSAllowedMethodsUsage)
+	cmd.Flags().StringSlice(FlagCORSAllowedHeaders, DefaultCORSAllowedHeaders, FlagCORSAllowedHeadersUsage)
+	cmd.Flags().Bool(FlagCORSAllowCredentials, false, FlagCORSAllowCredentialsUsage)
+	cmd.Flags().Int(FlagCORSMaxAge, defaultCORSMaxAge, FlagCORSMaxAgeUsage)
+	cmd.Flags().Bool(FlagSecurityHeaders, true, FlagSecurityHeadersUsage)
+	cmd.Flags().Int(FlagHSTSMaxAge, 0, FlagHSTSMaxAgeUsage)
+
+	return cmd
+}
+
+func ConfigFromFlags() Config {
+	return Config{
+		CORSAllowedOrigins:   viper.GetStringSlice(FlagCORSAllowedOrigins),
+		CORSAllowedMethods:   viper.GetStringSlice(FlagCORSAllowedMethods),
+		CORSAllowedHeaders:   viper.GetStringSlice(FlagCORSAllowedHeaders),
+		CORSAllowCredentials: viper.GetBool(FlagCORSAllowCredentials),
+		CORSMaxAge:           viper.GetInt(FlagCORSMaxAge),
+		SecurityHeaders:      viper.GetBool(FlagSecurityHeaders),
+		HSTSMaxAge:           viper.GetInt(FlagHSTSMaxAge),
+	}
+}
+
+// Registers the middlewares adding CORS and security headers to the responses of the router.
+func RegisterMiddlewares(r *mux.Router, config Config) {
+	if config.SecurityHeaders || config.HSTSMaxAge > 0 {
+		r.Use(NewSecurityHeadersMiddleware(config))
+	}
+
+	if config.CORSEnabled() {
+		r.Use(NewCORSMiddleware(config))
+
+		// the middlewares are applied to the matched routes only,
+		// so the preflight requests need a route as the API routes are bound to the other methods.
+		r.Methods(http.MethodOptions).HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+}
+
+// Creates the middleware handling preflight requests and adding CORS headers to the responses
+// for the requests from the allowed origins.
+func NewCORSMiddleware(config Config) mux.MiddlewareFunc {
+	return cors.New(cors.Options{
+		AllowedOrigins:   config.CORSAllowedOrigins,
+		AllowedMethods:   config.CORSAllowedMethods,
+		AllowedHeaders:   config.CORSAllowedHeaders,
+		AllowCredentials: config.CORSAllowCredentials,
+		MaxAge:           config.CORSMaxAge,
+	}).Handler
+}
+
+// Creates the middleware adding standard security headers to the responses.
+func NewSecurityHeadersMiddleware(config Config) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if config.SecurityHeaders {
+				w.Header().Set("X-Content-Type-Options", "nosniff")
+				w.Header().Set("X-Frame-Options", "DENY")
+				w.Header().Set("Referrer-Policy", "no-referrer")
+			}
+
+			if config.HSTSMaxAge > 0 {
+				w.Header().Set("Strict-Transport-Security", "max-age="+strconv.Itoa(config.HSTSMaxAge))
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
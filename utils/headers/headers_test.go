@@ -0,0 +1,108 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package headers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+)
+
+const allowedOrigin = "https://portal.example.com"
+
+func TestRegisterMiddlewares_CORS(t *testing.T) {
+	router := newRouter(Config{
+		CORSAllowedOrigins: []string{allowedOrigin},
+		CORSAllowedMethods: DefaultCORSAllowedMethods,
+		CORSAllowedHeaders: DefaultCORSAllowedHeaders,
+		CORSMaxAge:         600,
+	})
+
+	// allowed origin
+	resp := serve(router, http.MethodGet, allowedOrigin, nil)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, allowedOrigin, resp.Header().Get("Access-Control-Allow-Origin"))
+
+	// not allowed origin
+	resp = serve(router, http.MethodGet, "https://evil.example.com", nil)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Empty(t, resp.Header().Get("Access-Control-Allow-Origin"))
+
+	// preflight request
+	resp = serve(router, http.MethodOptions, allowedOrigin, map[string]string{
+		"Access-Control-Request-Method":  http.MethodPost,
+		"Access-Control-Request-Headers": "Content-Type",
+	})
+	require.Equal(t, allowedOrigin, resp.Header().Get("Access-Control-Allow-Origin"))
+	require.Equal(t, http.MethodPost, resp.Header().Get("Access-Control-Allow-Methods"))
+	require.Equal(t, "600", resp.Header().Get("Access-Control-Max-Age"))
+}
+
+func TestRegisterMiddlewares_CORSDisabled(t *testing.T) {
+	router := newRouter(Config{})
+
+	resp := serve(router, http.MethodGet, allowedOrigin, nil)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Empty(t, resp.Header().Get("Access-Control-Allow-Origin"))
+	require.Empty(t, resp.Header().Get("X-Content-Type-Options"))
+
+	resp = serve(router, http.MethodOptions, allowedOrigin, map[string]string{
+		"Access-Control-Request-Method": http.MethodPost,
+	})
+	require.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+}
+
+func TestRegisterMiddlewares_SecurityHeaders(t *testing.T) {
+	router := newRouter(Config{SecurityHeaders: true, HSTSMaxAge: 31536000})
+
+	resp := serve(router, http.MethodGet, "", nil)
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Equal(t, "nosniff", resp.Header().Get("X-Content-Type-Options"))
+	require.Equal(t, "DENY", resp.Header().Get("X-Frame-Options"))
+	require.Equal(t, "no-referrer", resp.Header().Get("Referrer-Policy"))
+	require.Equal(t, "max-age=31536000", resp.Header().Get("Strict-Transport-Security"))
+}
+
+func newRouter(config Config) *mux.Router {
+	router := mux.NewRouter()
+	RegisterMiddlewares(router, config)
+
+	router.HandleFunc("/model", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}).Methods(http.MethodGet, http.MethodPost)
+
+	return router
+}
+
+func serve(handler http.Handler, method string, origin string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/model", nil)
+
+	if len(origin) != 0 {
+		req.Header.Set("Origin", origin)
+	}
+
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+
+	return resp
+}
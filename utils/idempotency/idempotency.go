@@ -0,0 +1,147 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package idempotency remembers the results of the write requests sent with `Idempotency-Key` header
+// to the REST server, so a retried request (e.g. after a client timeout) returns the result of the original one
+// instead of broadcasting a duplicate transaction. A request retried while the original one is still
+// being processed waits for its result. The failed requests are not remembered, so they can be retried.
+package idempotency
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"errors"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var ErrKeyReused = errors.New("idempotency key is already used for another request")
+
+type Config struct {
+	// Maximal number of remembered keys (0 disables the cache).
+	Size int
+	// Time the result of a request is remembered for.
+	TTL time.Duration
+}
+
+type Cache struct {
+	config Config
+	now    func() time.Time
+
+	mu sync.Mutex
+	// Oldest entries are at the back.
+	entries *list.List
+	index   map[string]*list.Element
+}
+
+type entry struct {
+	key         string
+	fingerprint [sha256.Size]byte
+	// Closed once the request is processed.
+	done    chan struct{}
+	res     sdk.TxResponse
+	err     error
+	expires time.Time
+}
+
+// New returns the cache or nil if it is disabled. The nil cache can be used: it never remembers a result.
+func New(config Config) *Cache {
+	if config.Size <= 0 {
+		return nil
+	}
+
+	return &Cache{
+		config:  config,
+		now:     time.Now,
+		entries: list.New(),
+		index:   map[string]*list.Element{},
+	}
+}
+
+// Do broadcasts the transaction once per key. The fingerprint identifies the request: the key can not be reused
+// for a request with another fingerprint. Returns whether the result is the one of the original request.
+func (c *Cache) Do(key string, fingerprint []byte,
+	broadcast func() (sdk.TxResponse, error)) (res sdk.TxResponse, replayed bool, err error) {
+	if c == nil {
+		res, err = broadcast()
+
+		return res, false, err
+	}
+
+	hash := sha256.Sum256(fingerprint)
+
+	c.mu.Lock()
+
+	if element, ok := c.index[key]; ok {
+		e := element.Value.(*entry)
+
+		if !c.isExpired(e) {
+			c.mu.Unlock()
+
+			if e.fingerprint != hash {
+				return sdk.TxResponse{}, false, ErrKeyReused
+			}
+
+			<-e.done
+
+			return e.res, true, e.err
+		}
+
+		c.remove(element)
+	}
+
+	e := &entry{key: key, fingerprint: hash, done: make(chan struct{})}
+	element := c.entries.PushFront(e)
+	c.index[key] = element
+
+	for c.entries.Len() > c.config.Size {
+		c.remove(c.entries.Back())
+	}
+
+	c.mu.Unlock()
+
+	e.res, e.err = broadcast()
+
+	c.mu.Lock()
+
+	if e.err != nil {
+		if c.index[key] == element {
+			c.remove(element)
+		}
+	} else {
+		e.expires = c.now().Add(c.config.TTL)
+	}
+
+	c.mu.Unlock()
+	close(e.done)
+
+	return e.res, false, e.err
+}
+
+// Whether the request is processed and its result is not remembered anymore.
+func (c *Cache) isExpired(e *entry) bool {
+	select {
+	case <-e.done:
+		return e.err == nil && !c.now().Before(e.expires)
+	default:
+		return false
+	}
+}
+
+func (c *Cache) remove(element *list.Element) {
+	c.entries.Remove(element)
+	delete(c.index, element.Value.(*entry).key)
+}
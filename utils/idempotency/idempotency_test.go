@@ -0,0 +1,167 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package idempotency
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func newCache(size int) (*Cache, *time.Time) {
+	now := time.Unix(1000, 0)
+
+	cache := New(Config{Size: size, TTL: time.Minute})
+	cache.now = func() time.Time { return now }
+
+	return cache, &now
+}
+
+// Returns the broadcast function counting its calls and returning the next transaction hash.
+func counter(calls *int) func() (sdk.TxResponse, error) {
+	return func() (sdk.TxResponse, error) {
+		*calls++
+
+		return sdk.TxResponse{TxHash: string(rune('A' + *calls - 1))}, nil
+	}
+}
+
+func TestCache_Disabled(t *testing.T) {
+	cache := New(Config{Size: 0})
+	require.Nil(t, cache)
+
+	calls := 0
+
+	for i := 0; i < 2; i++ {
+		_, replayed, err := cache.Do("key", []byte("tx"), counter(&calls))
+		require.NoError(t, err)
+		require.False(t, replayed)
+	}
+
+	require.Equal(t, 2, calls)
+}
+
+func TestCache_Replay(t *testing.T) {
+	cache, now := newCache(10)
+	calls := 0
+
+	res, replayed, err := cache.Do("key", []byte("tx"), counter(&calls))
+	require.NoError(t, err)
+	require.False(t, replayed)
+	require.Equal(t, "A", res.TxHash)
+
+	// retry
+	res, replayed, err = cache.Do("key", []byte("tx"), counter(&calls))
+	require.NoError(t, err)
+	require.True(t, replayed)
+	require.Equal(t, "A", res.TxHash)
+	require.Equal(t, 1, calls)
+
+	// another key
+	res, replayed, err = cache.Do("other", []byte("tx"), counter(&calls))
+	require.NoError(t, err)
+	require.False(t, replayed)
+	require.Equal(t, "B", res.TxHash)
+
+	// another request with the same key
+	_, _, err = cache.Do("key", []byte("other tx"), counter(&calls))
+	require.Equal(t, ErrKeyReused, err)
+	require.Equal(t, 2, calls)
+
+	// expired
+	*now = now.Add(time.Minute)
+
+	res, replayed, err = cache.Do("key", []byte("other tx"), counter(&calls))
+	require.NoError(t, err)
+	require.False(t, replayed)
+	require.Equal(t, "C", res.TxHash)
+}
+
+func TestCache_FailedRequestIsNotRemembered(t *testing.T) {
+	cache, _ := newCache(10)
+	calls := 0
+
+	_, _, err := cache.Do("key", []byte("tx"), func() (sdk.TxResponse, error) {
+		calls++
+
+		return sdk.TxResponse{}, errors.New("node is unavailable")
+	})
+	require.Error(t, err)
+
+	res, replayed, err := cache.Do("key", []byte("tx"), counter(&calls))
+	require.NoError(t, err)
+	require.False(t, replayed)
+	require.Equal(t, "B", res.TxHash)
+}
+
+func TestCache_Eviction(t *testing.T) {
+	cache, _ := newCache(1)
+	calls := 0
+
+	_, _, _ = cache.Do("key1", []byte("tx"), counter(&calls))
+	_, _, _ = cache.Do("key2", []byte("tx"), counter(&calls))
+
+	_, replayed, _ := cache.Do("key2", []byte("tx"), counter(&calls))
+	require.True(t, replayed)
+
+	_, replayed, _ = cache.Do("key1", []byte("tx"), counter(&calls))
+	require.False(t, replayed)
+	require.Equal(t, 3, calls)
+}
+
+func TestCache_ConcurrentRetryWaits(t *testing.T) {
+	cache, _ := newCache(10)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	go func() {
+		_, _, _ = cache.Do("key", []byte("tx"), func() (sdk.TxResponse, error) {
+			close(started)
+			<-release
+
+			return sdk.TxResponse{TxHash: "A"}, nil
+		})
+	}()
+
+	<-started
+
+	var (
+		wg       sync.WaitGroup
+		res      sdk.TxResponse
+		replayed bool
+	)
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		res, replayed, _ = cache.Do("key", []byte("tx"), func() (sdk.TxResponse, error) {
+			return sdk.TxResponse{TxHash: "B"}, nil
+		})
+	}()
+
+	close(release)
+	wg.Wait()
+
+	require.True(t, replayed)
+	require.Equal(t, "A", res.TxHash)
+}
@@ -0,0 +1,496 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package jsonschema validates JSON documents against the subset of JSON Schema (draft 7)
+// which is enough to describe the structure of custom data stored on the ledger.
+// References (`$ref`) and combinators (`allOf`, `anyOf`, etc.) are not supported,
+// so the validation does not depend on anything but the schema and the document,
+// and it reports the violations in a deterministic order as required for transaction processing.
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"unicode/utf8"
+)
+
+const (
+	TypeNull    = "null"
+	TypeBoolean = "boolean"
+	TypeObject  = "object"
+	TypeArray   = "array"
+	TypeNumber  = "number"
+	TypeInteger = "integer"
+	TypeString  = "string"
+)
+
+// Keywords which do not affect the validation.
+var annotations = map[string]bool{
+	"$schema":     true,
+	"$id":         true,
+	"$comment":    true,
+	"title":       true,
+	"description": true,
+	"default":     true,
+	"examples":    true,
+}
+
+// Schema is a parsed JSON schema.
+type Schema struct {
+	// the schema is either `true` (accepts everything) or `false` (rejects everything) when set
+	boolean *bool
+
+	types                []string
+	enum                 []interface{}
+	properties           map[string]*Schema
+	required             []string
+	additionalProperties *Schema
+	items                *Schema
+	minItems             *int
+	maxItems             *int
+	minLength            *int
+	maxLength            *int
+	pattern              *regexp.Regexp
+	minimum              *float64
+	maximum              *float64
+	exclusiveMinimum     *float64
+	exclusiveMaximum     *float64
+}
+
+// Parse parses the JSON schema failing on the keywords which are not supported.
+func Parse(schema []byte) (*Schema, error) {
+	value, err := decode(schema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %v", err)
+	}
+
+	return parseSchema(value, "#")
+}
+
+// Validate checks that the JSON document conforms to the schema.
+// The error describes the first violation found along with its location (JSON pointer) in the document.
+func (s *Schema) Validate(document []byte) error {
+	value, err := decode(document)
+	if err != nil {
+		return fmt.Errorf("invalid JSON: %v", err)
+	}
+
+	return s.validate(value, "#")
+}
+
+func decode(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after the top-level value")
+	}
+
+	return value, nil
+}
+
+//nolint:gocyclo,funlen
+func parseSchema(value interface{}, path string) (*Schema, error) {
+	if boolean, ok := value.(bool); ok {
+		return &Schema{boolean: &boolean}, nil
+	}
+
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: schema must be an object or a boolean", path)
+	}
+
+	schema := &Schema{}
+
+	for _, keyword := range sortedKeys(object) {
+		value := object[keyword]
+		keywordPath := path + "/" + keyword
+
+		var err error
+
+		switch keyword {
+		case "type":
+			schema.types, err = parseTypes(value, keywordPath)
+		case "enum":
+			enum, ok := value.([]interface{})
+			if !ok || len(enum) == 0 {
+				return nil, fmt.Errorf("%s: must be a non-empty array", keywordPath)
+			}
+
+			schema.enum = enum
+		case "const":
+			schema.enum = []interface{}{value}
+		case "properties":
+			schema.properties, err = parseProperties(value, keywordPath)
+		case "required":
+			schema.required, err = parseStrings(value, keywordPath)
+		case "additionalProperties":
+			schema.additionalProperties, err = parseSchema(value, keywordPath)
+		case "items":
+			schema.items, err = parseSchema(value, keywordPath)
+		case "minItems":
+			schema.minItems, err = parseCount(value, keywordPath)
+		case "maxItems":
+			schema.maxItems, err = parseCount(value, keywordPath)
+		case "minLength":
+			schema.minLength, err = parseCount(value, keywordPath)
+		case "maxLength":
+			schema.maxLength, err = parseCount(value, keywordPath)
+		case "pattern":
+			schema.pattern, err = parsePattern(value, keywordPath)
+		case "minimum":
+			schema.minimum, err = parseLimit(value, keywordPath)
+		case "maximum":
+			schema.maximum, err = parseLimit(value, keywordPath)
+		case "exclusiveMinimum":
+			schema.exclusiveMinimum, err = parseLimit(value, keywordPath)
+		case "exclusiveMaximum":
+			schema.exclusiveMaximum, err = parseLimit(value, keywordPath)
+		default:
+			if !annotations[keyword] {
+				return nil, fmt.Errorf("%s: unsupported keyword", keywordPath)
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return schema, nil
+}
+
+func parseTypes(value interface{}, path string) ([]string, error) {
+	var types []string
+
+	if name, ok := value.(string); ok {
+		types = []string{name}
+	} else {
+		names, err := parseStrings(value, path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: must be a string or an array of strings", path)
+		}
+
+		types = names
+	}
+
+	for _, name := range types {
+		switch name {
+		case TypeNull, TypeBoolean, TypeObject, TypeArray, TypeNumber, TypeInteger, TypeString:
+		default:
+			return nil, fmt.Errorf("%s: unknown type %q", path, name)
+		}
+	}
+
+	return types, nil
+}
+
+func parseProperties(value interface{}, path string) (map[string]*Schema, error) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: must be an object", path)
+	}
+
+	properties := make(map[string]*Schema, len(object))
+
+	for _, name := range sortedKeys(object) {
+		property, err := parseSchema(object[name], path+"/"+name)
+		if err != nil {
+			return nil, err
+		}
+
+		properties[name] = property
+	}
+
+	return properties, nil
+}
+
+func parseStrings(value interface{}, path string) ([]string, error) {
+	array, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: must be an array of strings", path)
+	}
+
+	res := make([]string, 0, len(array))
+
+	for _, item := range array {
+		str, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: must be an array of strings", path)
+		}
+
+		res = append(res, str)
+	}
+
+	return res, nil
+}
+
+func parseCount(value interface{}, path string) (*int, error) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a non-negative integer", path)
+	}
+
+	count, err := strconv.Atoi(number.String())
+	if err != nil || count < 0 {
+		return nil, fmt.Errorf("%s: must be a non-negative integer", path)
+	}
+
+	return &count, nil
+}
+
+func parseLimit(value interface{}, path string) (*float64, error) {
+	number, ok := value.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a number", path)
+	}
+
+	limit, err := number.Float64()
+	if err != nil {
+		return nil, fmt.Errorf("%s: must be a number", path)
+	}
+
+	return &limit, nil
+}
+
+func parsePattern(value interface{}, path string) (*regexp.Regexp, error) {
+	str, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a string", path)
+	}
+
+	pattern, err := regexp.Compile(str)
+	if err != nil {
+		return nil, fmt.Errorf("%s: invalid regular expression: %v", path, err)
+	}
+
+	return pattern, nil
+}
+
+//nolint:gocyclo,gocognit,funlen
+func (s *Schema) validate(value interface{}, path string) error {
+	if s.boolean != nil {
+		if !*s.boolean {
+			return fmt.Errorf("%s: no value is allowed", path)
+		}
+
+		return nil
+	}
+
+	if len(s.types) > 0 && !hasType(value, s.types) {
+		return fmt.Errorf("%s: expected %v, but got %s", path, typesString(s.types), typeOf(value))
+	}
+
+	if s.enum != nil && !contains(s.enum, value) {
+		return fmt.Errorf("%s: value is not one of the allowed values", path)
+	}
+
+	switch value := value.(type) {
+	case map[string]interface{}:
+		for _, name := range s.required {
+			if _, ok := value[name]; !ok {
+				return fmt.Errorf("%s: missing required property %q", path, name)
+			}
+		}
+
+		for _, name := range sortedKeys(value) {
+			property, ok := s.properties[name]
+			if !ok {
+				property = s.additionalProperties
+			}
+
+			if property == nil {
+				continue
+			}
+
+			if err := property.validate(value[name], path+"/"+name); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		if s.minItems != nil && len(value) < *s.minItems {
+			return fmt.Errorf("%s: expected at least %d items", path, *s.minItems)
+		}
+
+		if s.maxItems != nil && len(value) > *s.maxItems {
+			return fmt.Errorf("%s: expected at most %d items", path, *s.maxItems)
+		}
+
+		if s.items != nil {
+			for i, item := range value {
+				if err := s.items.validate(item, fmt.Sprintf("%s/%d", path, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case string:
+		length := utf8.RuneCountInString(value)
+
+		if s.minLength != nil && length < *s.minLength {
+			return fmt.Errorf("%s: expected at least %d characters", path, *s.minLength)
+		}
+
+		if s.maxLength != nil && length > *s.maxLength {
+			return fmt.Errorf("%s: expected at most %d characters", path, *s.maxLength)
+		}
+
+		if s.pattern != nil && !s.pattern.MatchString(value) {
+			return fmt.Errorf("%s: does not match pattern %q", path, s.pattern.String())
+		}
+	case json.Number:
+		number, err := value.Float64()
+		if err != nil {
+			return fmt.Errorf("%s: invalid number", path)
+		}
+
+		if s.minimum != nil && number < *s.minimum {
+			return fmt.Errorf("%s: must be greater than or equal to %v", path, *s.minimum)
+		}
+
+		if s.maximum != nil && number > *s.maximum {
+			return fmt.Errorf("%s: must be less than or equal to %v", path, *s.maximum)
+		}
+
+		if s.exclusiveMinimum != nil && number <= *s.exclusiveMinimum {
+			return fmt.Errorf("%s: must be greater than %v", path, *s.exclusiveMinimum)
+		}
+
+		if s.exclusiveMaximum != nil && number >= *s.exclusiveMaximum {
+			return fmt.Errorf("%s: must be less than %v", path, *s.exclusiveMaximum)
+		}
+	}
+
+	return nil
+}
+
+func hasType(value interface{}, types []string) bool {
+	actual := typeOf(value)
+
+	for _, expected := range types {
+		if expected == actual || (expected == TypeNumber && actual == TypeInteger) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func typeOf(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return TypeNull
+	case bool:
+		return TypeBoolean
+	case map[string]interface{}:
+		return TypeObject
+	case []interface{}:
+		return TypeArray
+	case string:
+		return TypeString
+	case json.Number:
+		// a number without fractional part (e.g. 1.0) is an integer
+		if number, err := value.Float64(); err == nil && number == math.Trunc(number) {
+			return TypeInteger
+		}
+
+		return TypeNumber
+	default:
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func typesString(types []string) string {
+	if len(types) == 1 {
+		return types[0]
+	}
+
+	return fmt.Sprintf("one of %v", types)
+}
+
+func contains(values []interface{}, value interface{}) bool {
+	for _, allowed := range values {
+		if equal(allowed, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Compares the decoded JSON values: numbers are compared by their values rather than representations.
+func equal(a interface{}, b interface{}) bool {
+	switch a := a.(type) {
+	case json.Number:
+		b, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+
+		x, errA := a.Float64()
+		y, errB := b.Float64()
+
+		return errA == nil && errB == nil && x == y
+	case map[string]interface{}:
+		b, ok := b.(map[string]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for key, value := range a {
+			other, ok := b[key]
+			if !ok || !equal(value, other) {
+				return false
+			}
+		}
+
+		return true
+	case []interface{}:
+		b, ok := b.([]interface{})
+		if !ok || len(a) != len(b) {
+			return false
+		}
+
+		for i := range a {
+			if !equal(a[i], b[i]) {
+				return false
+			}
+		}
+
+		return true
+	default:
+		return a == b
+	}
+}
+
+func sortedKeys(object map[string]interface{}) []string {
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
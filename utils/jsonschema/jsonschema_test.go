@@ -0,0 +1,121 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package jsonschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const deviceSchema = `{
+	"$schema": "http://json-schema.org/draft-07/schema#",
+	"title": "Device extension",
+	"type": "object",
+	"required": ["color", "ports"],
+	"properties": {
+		"color": {"type": "string", "enum": ["white", "black"]},
+		"ports": {"type": "integer", "minimum": 1, "maximum": 8},
+		"tags": {"type": "array", "items": {"type": "string", "pattern": "^[a-z]+$"}, "maxItems": 2},
+		"label": {"type": ["string", "null"], "minLength": 2, "maxLength": 4}
+	},
+	"additionalProperties": false
+}`
+
+func TestValidate(t *testing.T) {
+	schema, err := Parse([]byte(deviceSchema))
+	require.NoError(t, err)
+
+	positive := []string{
+		`{"color": "white", "ports": 2}`,
+		`{"color": "black", "ports": 8.0, "tags": ["a", "b"], "label": "abc"}`,
+		`{"color": "black", "ports": 1, "label": null}`,
+	}
+
+	for _, document := range positive {
+		require.NoError(t, schema.Validate([]byte(document)), document)
+	}
+
+	negative := []struct {
+		document string
+		error    string
+	}{
+		{`not json`, "invalid JSON"},
+		{`{"color": "white", "ports": 2} {}`, "invalid JSON"},
+		{`[]`, "#: expected object, but got array"},
+		{`{"ports": 2}`, `#: missing required property "color"`},
+		{`{"color": "red", "ports": 2}`, "#/color: value is not one of the allowed values"},
+		{`{"color": "white", "ports": 1.5}`, "#/ports: expected integer, but got number"},
+		{`{"color": "white", "ports": 0}`, "#/ports: must be greater than or equal to 1"},
+		{`{"color": "white", "ports": 9}`, "#/ports: must be less than or equal to 8"},
+		{`{"color": "white", "ports": 2, "tags": ["a", "B"]}`, `#/tags/1: does not match pattern "^[a-z]+$"`},
+		{`{"color": "white", "ports": 2, "tags": ["a", "b", "c"]}`, "#/tags: expected at most 2 items"},
+		{`{"color": "white", "ports": 2, "label": "a"}`, "#/label: expected at least 2 characters"},
+		{`{"color": "white", "ports": 2, "label": 1}`, "#/label: expected one of [string null], but got integer"},
+		{`{"color": "white", "ports": 2, "size": 1}`, "#/size: no value is allowed"},
+	}
+
+	for _, tc := range negative {
+		err := schema.Validate([]byte(tc.document))
+		require.Error(t, err, tc.document)
+		require.Contains(t, err.Error(), tc.error)
+	}
+}
+
+func TestValidate_ReportsFirstViolationInKeyOrder(t *testing.T) {
+	schema, err := Parse([]byte(`{"additionalProperties": {"type": "string"}}`))
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		err := schema.Validate([]byte(`{"d": 1, "b": 2, "c": 3, "a": 4}`))
+		require.EqualError(t, err, "#/a: expected string, but got integer")
+	}
+}
+
+func TestValidate_BooleanSchema(t *testing.T) {
+	schema, err := Parse([]byte(`true`))
+	require.NoError(t, err)
+	require.NoError(t, schema.Validate([]byte(`{"any": ["thing"]}`)))
+
+	schema, err = Parse([]byte(`false`))
+	require.NoError(t, err)
+	require.Error(t, schema.Validate([]byte(`{}`)))
+}
+
+func TestParse_InvalidSchema(t *testing.T) {
+	negative := []struct {
+		schema string
+		error  string
+	}{
+		{`{`, "invalid schema"},
+		{`1`, "#: schema must be an object or a boolean"},
+		{`{"type": "date"}`, `#/type: unknown type "date"`},
+		{`{"type": 1}`, "#/type: must be a string or an array of strings"},
+		{`{"enum": []}`, "#/enum: must be a non-empty array"},
+		{`{"required": "a"}`, "#/required: must be an array of strings"},
+		{`{"minLength": -1}`, "#/minLength: must be a non-negative integer"},
+		{`{"maximum": "1"}`, "#/maximum: must be a number"},
+		{`{"pattern": "("}`, "#/pattern: invalid regular expression"},
+		{`{"properties": {"a": {"$ref": "#/definitions/a"}}}`, "#/properties/a/$ref: unsupported keyword"},
+		{`{"anyOf": [{"type": "string"}]}`, "#/anyOf: unsupported keyword"},
+	}
+
+	for _, tc := range negative {
+		_, err := Parse([]byte(tc.schema))
+		require.Error(t, err, tc.schema)
+		require.Contains(t, err.Error(), tc.error)
+	}
+}
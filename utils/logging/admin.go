@@ -0,0 +1,87 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+)
+
+const (
+	FlagAdminAddress      = "admin-laddr"
+	FlagAdminAddressUsage = "Address the admin HTTP server (changing log levels at runtime) listens on " +
+		"(empty value disables the server)"
+	LogLevelPath = "/log_level"
+)
+
+type LogLevel struct {
+	LogLevel string `json:"log_level"`
+}
+
+// NewAdminHandler creates the handler of the admin endpoints:
+//   - GET /log_level returns the current levels, e.g. {"log_level": "pki:debug,*:info"}
+//   - PUT /log_level with the same body replaces the levels
+func NewAdminHandler(levels *Levels) http.Handler {
+	router := mux.NewRouter()
+
+	router.HandleFunc(LogLevelPath, func(w http.ResponseWriter, r *http.Request) {
+		writeLogLevel(w, levels)
+	}).Methods(http.MethodGet)
+
+	router.HandleFunc(LogLevelPath, func(w http.ResponseWriter, r *http.Request) {
+		var req LogLevel
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+
+			return
+		}
+
+		if err := levels.Set(req.LogLevel); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+
+			return
+		}
+
+		writeLogLevel(w, levels)
+	}).Methods(http.MethodPut)
+
+	return router
+}
+
+// StartAdminServer starts the admin HTTP server on the given address in the background.
+// The server must not be exposed publicly as it is not authenticated.
+func StartAdminServer(address string, levels *Levels) (*http.Server, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+
+	server := &http.Server{Handler: NewAdminHandler(levels)}
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	return server, nil
+}
+
+func writeLogLevel(w http.ResponseWriter, levels *Levels) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(LogLevel{LogLevel: levels.String()})
+}
@@ -0,0 +1,235 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package logging
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	LevelDebug = "debug"
+	LevelInfo  = "info"
+	LevelError = "error"
+	LevelNone  = "none"
+
+	// Key of the level applied to the modules not listed explicitly.
+	DefaultModuleKey = "*"
+	// Prefix of the application modules which can be omitted in the level specification (pki instead of x/pki).
+	appModulePrefix = "x/"
+	moduleKey       = "module"
+)
+
+type level int
+
+const (
+	levelNone level = iota
+	levelError
+	levelInfo
+	levelDebug
+)
+
+var levelNames = map[string]level{
+	LevelDebug: levelDebug,
+	LevelInfo:  levelInfo,
+	LevelError: levelError,
+	LevelNone:  levelNone,
+}
+
+func (l level) String() string {
+	for name, value := range levelNames {
+		if value == l {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// Levels keeps the log levels per module. The levels can be changed at runtime
+// and are applied to all loggers created from the root one.
+type Levels struct {
+	mtx      sync.RWMutex
+	byModule map[string]level
+	fallback level
+}
+
+// ParseLevels parses comma-separated list of module:level pairs with an optional *:level pair
+// (the level of all other modules), e.g. "pki:debug,compliance:info,*:error".
+// Single level (e.g. "info") is applied to all modules.
+func ParseLevels(spec string) (*Levels, error) {
+	levels := &Levels{}
+	if err := levels.Set(spec); err != nil {
+		return nil, err
+	}
+
+	return levels, nil
+}
+
+// Set replaces the levels with the given specification.
+func (l *Levels) Set(spec string) error {
+	byModule, fallback, err := parse(spec)
+	if err != nil {
+		return err
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	l.byModule = byModule
+	l.fallback = fallback
+
+	return nil
+}
+
+// String returns the specification of the current levels.
+func (l *Levels) String() string {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	pairs := make([]string, 0, len(l.byModule)+1)
+	for module, lvl := range l.byModule {
+		pairs = append(pairs, module+":"+lvl.String())
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(append(pairs, DefaultModuleKey+":"+l.fallback.String()), ",")
+}
+
+func (l *Levels) allowed(module string, lvl level) bool {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+
+	if moduleLevel, ok := l.byModule[module]; ok {
+		return lvl <= moduleLevel
+	}
+
+	if moduleLevel, ok := l.byModule[strings.TrimPrefix(module, appModulePrefix)]; ok {
+		return lvl <= moduleLevel
+	}
+
+	return lvl <= l.fallback
+}
+
+func parse(spec string) (map[string]level, level, error) {
+	byModule := map[string]level{}
+	fallback := levelInfo
+
+	if len(spec) == 0 {
+		return nil, fallback, fmt.Errorf("empty log level")
+	}
+
+	if !strings.Contains(spec, ":") {
+		spec = DefaultModuleKey + ":" + spec
+	}
+
+	for _, pair := range strings.Split(spec, ",") {
+		moduleAndLevel := strings.Split(strings.TrimSpace(pair), ":")
+		if len(moduleAndLevel) != 2 || len(moduleAndLevel[0]) == 0 {
+			return nil, fallback, fmt.Errorf("expected list of \"module:level\" pairs, given pair %q", pair)
+		}
+
+		lvl, ok := levelNames[moduleAndLevel[1]]
+		if !ok {
+			return nil, fallback, fmt.Errorf(
+				"expected either \"debug\", \"info\", \"error\" or \"none\" log level, given %q", moduleAndLevel[1])
+		}
+
+		if moduleAndLevel[0] == DefaultModuleKey {
+			fallback = lvl
+		} else {
+			byModule[strings.TrimPrefix(moduleAndLevel[0], appModulePrefix)] = lvl
+		}
+	}
+
+	return byModule, fallback, nil
+}
+
+// Filter is a logger writing the messages allowed by the levels of its module.
+type Filter struct {
+	next   log.Logger
+	module string
+	levels *Levels
+}
+
+var _ log.Logger = (*Filter)(nil)
+
+func NewFilter(next log.Logger, levels *Levels) *Filter {
+	return &Filter{next: next, levels: levels}
+}
+
+func (f *Filter) Levels() *Levels {
+	return f.levels
+}
+
+func (f *Filter) Debug(msg string, keyvals ...interface{}) {
+	if f.levels.allowed(f.module, levelDebug) {
+		f.next.Debug(msg, keyvals...)
+	}
+}
+
+func (f *Filter) Info(msg string, keyvals ...interface{}) {
+	if f.levels.allowed(f.module, levelInfo) {
+		f.next.Info(msg, keyvals...)
+	}
+}
+
+func (f *Filter) Error(msg string, keyvals ...interface{}) {
+	if f.levels.allowed(f.module, levelError) {
+		f.next.Error(msg, keyvals...)
+	}
+}
+
+func (f *Filter) With(keyvals ...interface{}) log.Logger {
+	module := f.module
+
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		if keyvals[i] == moduleKey {
+			module = fmt.Sprint(keyvals[i+1])
+		}
+	}
+
+	return &Filter{next: f.next.With(keyvals...), module: module, levels: f.levels}
+}
+
+// NewLogger creates the node logger writing to stdout in the format (plain or json) and with
+// the module levels (log_level) configured in config.toml.
+func NewLogger(config *cfg.Config) (*Filter, error) {
+	levels, err := ParseLevels(config.LogLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	var logger log.Logger
+
+	switch config.LogFormat {
+	case cfg.LogFormatJSON:
+		logger = log.NewTMJSONLogger(log.NewSyncWriter(os.Stdout))
+	case cfg.LogFormatPlain, "":
+		logger = log.NewTMLogger(log.NewSyncWriter(os.Stdout))
+	default:
+		return nil, fmt.Errorf("unknown log_format %q (must be %q or %q)",
+			config.LogFormat, cfg.LogFormatPlain, cfg.LogFormatJSON)
+	}
+
+	return NewFilter(logger, levels), nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestParseLevels(t *testing.T) {
+	levels, err := ParseLevels("x/pki:debug,compliance:info,consensus:none,*:error")
+	require.NoError(t, err)
+	require.Equal(t, "compliance:info,consensus:none,pki:debug,*:error", levels.String())
+
+	levels, err = ParseLevels("debug")
+	require.NoError(t, err)
+	require.Equal(t, "*:debug", levels.String())
+
+	for _, spec := range []string{"", "pki", "pki:trace", "pki:debug:info", ":debug"} {
+		_, err = ParseLevels(spec)
+		require.Error(t, err, spec)
+	}
+}
+
+func TestFilter(t *testing.T) {
+	var buf bytes.Buffer
+
+	levels, err := ParseLevels("pki:debug,consensus:none,*:error")
+	require.NoError(t, err)
+
+	root := NewFilter(log.NewTMJSONLogger(&buf), levels)
+	pki := root.With("module", "x/pki")
+	compliance := root.With("module", "x/compliance")
+	consensus := root.With("module", "consensus")
+
+	pki.Debug("pki debug")
+	compliance.Info("compliance info")
+	compliance.Error("compliance error")
+	consensus.Error("consensus error")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, 2, len(lines))
+
+	var entry map[string]interface{}
+
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	require.Equal(t, "pki debug", entry["_msg"])
+	require.Equal(t, "x/pki", entry["module"])
+	require.Equal(t, "debug", entry["level"])
+
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &entry))
+	require.Equal(t, "compliance error", entry["_msg"])
+
+	// the levels are changed for the existing loggers
+	buf.Reset()
+	require.NoError(t, levels.Set("compliance:info,*:none"))
+
+	pki.Error("pki error")
+	compliance.Info("compliance info")
+
+	require.Equal(t, 1, strings.Count(buf.String(), "\n"))
+	require.Contains(t, buf.String(), "compliance info")
+}
+
+func TestAdminHandler(t *testing.T) {
+	levels, err := ParseLevels("*:info")
+	require.NoError(t, err)
+
+	handler := NewAdminHandler(levels)
+
+	// get
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, LogLevelPath, nil))
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.JSONEq(t, `{"log_level":"*:info"}`, resp.Body.String())
+
+	// set
+	resp = httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodPut, LogLevelPath,
+		strings.NewReader(`{"log_level":"pki:debug,*:error"}`)))
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.JSONEq(t, `{"log_level":"pki:debug,*:error"}`, resp.Body.String())
+	require.Equal(t, "pki:debug,*:error", levels.String())
+
+	// invalid level
+	resp = httptest.NewRecorder()
+	handler.ServeHTTP(resp, httptest.NewRequest(http.MethodPut, LogLevelPath,
+		strings.NewReader(`{"log_level":"pki:trace"}`)))
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+	require.Equal(t, "pki:debug,*:error", levels.String())
+}
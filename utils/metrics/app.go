@@ -0,0 +1,114 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"strconv"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Namespace of all metrics exposed by the ledger.
+	Namespace = "dcl"
+	// AppSubsystem is a subsystem of the metrics exposed by the application.
+	AppSubsystem = "app"
+	StageCheckTx   = "check_tx"
+	StageDeliverTx = "deliver_tx"
+)
+
+// AppMetrics contains metrics of the application-level operations.
+type AppMetrics struct {
+	// Number of messages in the successfully delivered transactions.
+	// Labels: route, type.
+	Msgs metrics.Counter
+	// Number of rejected transactions.
+	// Labels: stage (check_tx, deliver_tx), codespace, code.
+	RejectedTxs metrics.Counter
+	// Number of entries in the module store.
+	// Labels: module.
+	StoreEntries metrics.Gauge
+	// Size of the keys and values in the module store in bytes.
+	// Labels: module.
+	StoreSizeBytes metrics.Gauge
+}
+
+var (
+	appMetrics     *AppMetrics
+	appMetricsOnce sync.Once
+)
+
+// PrometheusAppMetrics returns AppMetrics registered in the default Prometheus registry,
+// so they are exposed by Tendermint instrumentation server along with the Tendermint metrics.
+// The metrics are registered once and shared by all application instances of the process.
+func PrometheusAppMetrics() *AppMetrics {
+	appMetricsOnce.Do(func() {
+		appMetrics = &AppMetrics{
+			Msgs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: AppSubsystem,
+				Name:      "msgs",
+				Help:      "Number of messages in the successfully delivered transactions.",
+			}, []string{"route", "type"}),
+			RejectedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: AppSubsystem,
+				Name:      "rejected_txs",
+				Help:      "Number of rejected transactions.",
+			}, []string{"stage", "codespace", "code"}),
+			StoreEntries: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+				Namespace: Namespace,
+				Subsystem: AppSubsystem,
+				Name:      "store_entries",
+				Help:      "Number of entries in the module store.",
+			}, []string{"module"}),
+			StoreSizeBytes: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+				Namespace: Namespace,
+				Subsystem: AppSubsystem,
+				Name:      "store_size_bytes",
+				Help:      "Size of the keys and values in the module store in bytes.",
+			}, []string{"module"}),
+		}
+	})
+
+	return appMetrics
+}
+
+// ObserveTx records the result of the transaction processing.
+func (m *AppMetrics) ObserveTx(stage string, tx sdk.Tx, code uint32, codespace string) {
+	if code != uint32(sdk.CodeOK) {
+		m.RejectedTxs.With("stage", stage, "codespace", codespace, "code", strconv.FormatUint(uint64(code), 10)).Add(1)
+
+		return
+	}
+
+	if stage != StageDeliverTx || tx == nil {
+		return
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		m.Msgs.With("route", msg.Route(), "type", msg.Type()).Add(1)
+	}
+}
+
+// ObserveStore records the size of the module store.
+func (m *AppMetrics) ObserveStore(module string, entries int, size int) {
+	m.StoreEntries.With("module", module).Set(float64(entries))
+	m.StoreSizeBytes.With("module", module).Set(float64(size))
+}
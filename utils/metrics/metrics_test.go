@@ -0,0 +1,144 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/require"
+)
+
+type testMsg struct {
+	route string
+	typ   string
+}
+
+func (m testMsg) Route() string                { return m.route }
+func (m testMsg) Type() string                 { return m.typ }
+func (m testMsg) ValidateBasic() sdk.Error     { return nil }
+func (m testMsg) GetSignBytes() []byte         { return nil }
+func (m testMsg) GetSigners() []sdk.AccAddress { return nil }
+
+type testTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx testTx) GetMsgs() []sdk.Msg       { return tx.msgs }
+func (tx testTx) ValidateBasic() sdk.Error { return nil }
+
+// returns the value of the metric with the given labels.
+func metricValue(t *testing.T, name string, labels map[string]string) float64 {
+	families, err := stdprometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+
+		for _, metric := range family.GetMetric() {
+			if hasLabels(metric, labels) {
+				switch {
+				case metric.Counter != nil:
+					return metric.Counter.GetValue()
+				case metric.Gauge != nil:
+					return metric.Gauge.GetValue()
+				case metric.Histogram != nil:
+					return float64(metric.Histogram.GetSampleCount())
+				}
+			}
+		}
+	}
+
+	return 0
+}
+
+func hasLabels(metric *dto.Metric, labels map[string]string) bool {
+	found := 0
+
+	for _, pair := range metric.GetLabel() {
+		if value, ok := labels[pair.GetName()]; ok && value == pair.GetValue() {
+			found++
+		}
+	}
+
+	return found == len(labels)
+}
+
+func TestAppMetrics_ObserveTx(t *testing.T) {
+	m := PrometheusAppMetrics()
+	require.Same(t, m, PrometheusAppMetrics())
+
+	tx := testTx{msgs: []sdk.Msg{
+		testMsg{route: "modelinfo", typ: "add_model_info"},
+		testMsg{route: "modelinfo", typ: "add_model_info"},
+		testMsg{route: "pki", typ: "propose_add_x509_root_cert"},
+	}}
+
+	m.ObserveTx(StageDeliverTx, tx, uint32(sdk.CodeOK), "")
+	m.ObserveTx(StageCheckTx, tx, uint32(sdk.CodeOK), "")
+	m.ObserveTx(StageDeliverTx, tx, 501, "modelinfo")
+	m.ObserveTx(StageCheckTx, nil, 4, "sdk")
+
+	require.Equal(t, 2.0,
+		metricValue(t, "dcl_app_msgs", map[string]string{"route": "modelinfo", "type": "add_model_info"}))
+	require.Equal(t, 1.0,
+		metricValue(t, "dcl_app_msgs", map[string]string{"route": "pki", "type": "propose_add_x509_root_cert"}))
+	require.Equal(t, 1.0, metricValue(t, "dcl_app_rejected_txs",
+		map[string]string{"stage": StageDeliverTx, "codespace": "modelinfo", "code": "501"}))
+	require.Equal(t, 1.0, metricValue(t, "dcl_app_rejected_txs",
+		map[string]string{"stage": StageCheckTx, "codespace": "sdk", "code": "4"}))
+}
+
+func TestAppMetrics_ObserveStore(t *testing.T) {
+	PrometheusAppMetrics().ObserveStore("compliance", 2, 19)
+
+	require.Equal(t, 2.0, metricValue(t, "dcl_app_store_entries", map[string]string{"module": "compliance"}))
+	require.Equal(t, 19.0, metricValue(t, "dcl_app_store_size_bytes", map[string]string{"module": "compliance"}))
+}
+
+func TestRESTMetrics(t *testing.T) {
+	m := EnableRESTMetrics()
+	require.Same(t, m, REST())
+
+	router := mux.NewRouter()
+	router.Use(m.Middleware)
+	router.HandleFunc("/modelinfo/models/{vid}/{pid}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}).Methods(http.MethodGet)
+
+	for _, path := range []string{"/modelinfo/models/1/1", "/modelinfo/models/1/2"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	require.Equal(t, 2.0, metricValue(t, "dcl_rest_request_duration_seconds",
+		map[string]string{"method": "GET", "route": "/modelinfo/models/{vid}/{pid}", "status": "404"}))
+
+	m.ObserveBroadcastError()
+	m.ObserveBroadcastResult(0, "")
+	m.ObserveBroadcastResult(401, "compliance")
+
+	require.Equal(t, 1.0, metricValue(t, "dcl_rest_broadcast_failures",
+		map[string]string{"reason": BroadcastErrorReason}))
+	require.Equal(t, 1.0, metricValue(t, "dcl_rest_broadcast_failures",
+		map[string]string{"reason": "compliance", "code": "401"}))
+}
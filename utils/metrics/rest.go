@@ -0,0 +1,178 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metrics
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	"github.com/gorilla/mux"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	// RESTSubsystem is a subsystem of the metrics exposed by the REST server.
+	RESTSubsystem = "rest"
+
+	FlagMetrics      = "metrics"
+	FlagMetricsUsage = "Expose Prometheus metrics of the REST server at /metrics endpoint"
+	MetricsPath      = "/metrics"
+
+	// Reason of the broadcast failure if the node is not reachable.
+	BroadcastErrorReason = "error"
+)
+
+// RESTMetrics contains metrics of the REST server.
+type RESTMetrics struct {
+	// Latency of the REST requests in seconds.
+	// Labels: method, route, status.
+	RequestDuration metrics.Histogram
+	// Number of failed transaction broadcasts.
+	// Labels: reason (error or codespace of the rejected transaction), code.
+	BroadcastFailures metrics.Counter
+}
+
+var (
+	restMetrics     = NopRESTMetrics()
+	restMetricsOnce sync.Once
+)
+
+// EnableRESTMetrics switches REST metrics of the process to the ones registered in the default Prometheus registry.
+func EnableRESTMetrics() *RESTMetrics {
+	restMetricsOnce.Do(func() {
+		restMetrics = &RESTMetrics{
+			RequestDuration: prometheus.NewHistogramFrom(stdprometheus.HistogramOpts{
+				Namespace: Namespace,
+				Subsystem: RESTSubsystem,
+				Name:      "request_duration_seconds",
+				Help:      "Latency of the REST requests in seconds.",
+				Buckets:   stdprometheus.DefBuckets,
+			}, []string{"method", "route", "status"}),
+			BroadcastFailures: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+				Namespace: Namespace,
+				Subsystem: RESTSubsystem,
+				Name:      "broadcast_failures",
+				Help:      "Number of failed transaction broadcasts.",
+			}, []string{"reason", "code"}),
+		}
+	})
+
+	return restMetrics
+}
+
+// NopRESTMetrics returns no-op RESTMetrics.
+func NopRESTMetrics() *RESTMetrics {
+	return &RESTMetrics{
+		RequestDuration:   discard.NewHistogram(),
+		BroadcastFailures: discard.NewCounter(),
+	}
+}
+
+// REST returns REST metrics of the process (no-op unless enabled).
+func REST() *RESTMetrics {
+	return restMetrics
+}
+
+// ObserveBroadcastError records the broadcast failed to be sent to the node.
+func (m *RESTMetrics) ObserveBroadcastError() {
+	m.BroadcastFailures.With("reason", BroadcastErrorReason, "code", "").Add(1)
+}
+
+// ObserveBroadcastResult records the broadcast transaction rejected by the node.
+func (m *RESTMetrics) ObserveBroadcastResult(code uint32, codespace string) {
+	if code == 0 {
+		return
+	}
+
+	m.BroadcastFailures.With("reason", codespace, "code", strconv.FormatUint(uint64(code), 10)).Add(1)
+}
+
+// AddFlags adds the metrics flag to the REST server command.
+func AddFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().Bool(FlagMetrics, false, FlagMetricsUsage)
+
+	return cmd
+}
+
+// RegisterRESTMetrics enables REST metrics, measures the latency of the router requests
+// and exposes the metrics at /metrics endpoint if the metrics flag is set.
+func RegisterRESTMetrics(r *mux.Router) {
+	if !viper.GetBool(FlagMetrics) {
+		return
+	}
+
+	m := EnableRESTMetrics()
+
+	r.Use(m.Middleware)
+	r.Handle(MetricsPath, promhttp.Handler()).Methods(http.MethodGet)
+}
+
+// Middleware measures the latency of the requests.
+// The route is labeled with the path template to keep the number of series bounded.
+func (m *RESTMetrics) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		route := "unknown"
+		if current := mux.CurrentRoute(r); current != nil {
+			if template, err := current.GetPathTemplate(); err == nil {
+				route = template
+			}
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+
+		next.ServeHTTP(recorder, r)
+
+		m.RequestDuration.With("method", r.Method, "route", route, "status", strconv.Itoa(recorder.status)).
+			Observe(time.Since(start).Seconds())
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Hijack lets the websocket handlers take over the connection.
+func (r *statusRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+
+	return hijacker.Hijack()
+}
+
+func (r *statusRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/webhook"
+)
+
+const (
+	KafkaPluginName     = "kafka"
+	kafkaContentType    = "application/vnd.kafka.json.v2+json"
+	kafkaAccept         = "application/vnd.kafka.v2+json"
+	defaultKafkaTimeout = 10 * time.Second
+)
+
+type KafkaConfig struct {
+	RestProxyURL string `json:"rest_proxy_url"` // URL of the Kafka REST Proxy (API v2)
+	Topic        string `json:"topic"`
+	Timeout      string `json:"timeout,omitempty"` // e.g. "10s"
+}
+
+// KafkaPlugin produces the notifications to the Kafka topic through the Kafka REST Proxy.
+// The record key is the notification ID and the value is the notification.
+type KafkaPlugin struct {
+	topicURL string
+	client   *http.Client
+}
+
+func NewKafkaPlugin(config json.RawMessage) (Plugin, error) {
+	var kafkaConfig KafkaConfig
+
+	if err := json.Unmarshal(config, &kafkaConfig); err != nil {
+		return nil, err
+	}
+
+	proxyURL, err := url.ParseRequestURI(kafkaConfig.RestProxyURL)
+	if err != nil || (proxyURL.Scheme != "http" && proxyURL.Scheme != "https") || len(proxyURL.Host) == 0 {
+		return nil, fmt.Errorf("invalid rest_proxy_url: \"%s\". It must be an absolute http(s) URL",
+			kafkaConfig.RestProxyURL)
+	}
+
+	if len(kafkaConfig.Topic) == 0 {
+		return nil, errors.New("topic must not be empty")
+	}
+
+	timeout := defaultKafkaTimeout
+
+	if len(kafkaConfig.Timeout) != 0 {
+		if timeout, err = time.ParseDuration(kafkaConfig.Timeout); err != nil {
+			return nil, fmt.Errorf("invalid timeout: %w", err)
+		}
+	}
+
+	return &KafkaPlugin{
+		topicURL: strings.TrimSuffix(kafkaConfig.RestProxyURL, "/") + "/topics/" + url.PathEscape(kafkaConfig.Topic),
+		client:   &http.Client{Timeout: timeout},
+	}, nil
+}
+
+type kafkaRecord struct {
+	Key   string               `json:"key"`
+	Value webhook.Notification `json:"value"`
+}
+
+type kafkaProduceResponse struct {
+	Offsets []struct {
+		ErrorCode *int   `json:"error_code"`
+		Error     string `json:"error"`
+	} `json:"offsets"`
+}
+
+func (p *KafkaPlugin) Notify(n webhook.Notification) error {
+	body, err := json.Marshal(struct {
+		Records []kafkaRecord `json:"records"`
+	}{[]kafkaRecord{{Key: n.ID, Value: n}}})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, p.topicURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", kafkaContentType)
+	req.Header.Set("Accept", kafkaAccept)
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("kafka rest proxy responded with status %v", resp.StatusCode)
+	}
+
+	// the records are produced independently, so the errors are reported per record
+	var result kafkaProduceResponse
+
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("invalid kafka rest proxy response: %w", err)
+	}
+
+	for _, offset := range result.Offsets {
+		if offset.ErrorCode != nil {
+			return fmt.Errorf("failed to produce the record (error code %v): %s", *offset.ErrorCode, offset.Error)
+		}
+	}
+
+	return nil
+}
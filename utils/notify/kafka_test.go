@@ -0,0 +1,78 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package notify
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewKafkaPlugin(t *testing.T) {
+	plugin, err := NewKafkaPlugin(json.RawMessage(`{"rest_proxy_url": "http://localhost:8082/",
+		"topic": "dcl events", "timeout": "5s"}`))
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:8082/topics/dcl%20events", plugin.(*KafkaPlugin).topicURL)
+
+	_, err = NewKafkaPlugin(json.RawMessage(`{"rest_proxy_url": "localhost:8082", "topic": "dcl"}`))
+	require.EqualError(t, err, "invalid rest_proxy_url: \"localhost:8082\". It must be an absolute http(s) URL")
+
+	_, err = NewKafkaPlugin(json.RawMessage(`{"rest_proxy_url": "http://localhost:8082"}`))
+	require.EqualError(t, err, "topic must not be empty")
+
+	_, err = NewKafkaPlugin(json.RawMessage(`{"rest_proxy_url": "http://localhost:8082", "topic": "dcl",
+		"timeout": "5"}`))
+	require.Error(t, err)
+}
+
+func TestKafkaPlugin_Notify(t *testing.T) {
+	var (
+		request *http.Request
+		body    []byte
+		status  = http.StatusOK
+		result  = `{"offsets": [{"partition": 0, "offset": 1, "error_code": null, "error": null}]}`
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+		body, _ = ioutil.ReadAll(r.Body)
+
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(result))
+	}))
+	defer server.Close()
+
+	plugin, err := NewKafkaPlugin(json.RawMessage(`{"rest_proxy_url": "` + server.URL + `", "topic": "dcl"}`))
+	require.NoError(t, err)
+
+	n := notification("AB-0", "compliancetest", "add_testing_result", 1, 2)
+
+	require.NoError(t, plugin.Notify(n))
+	require.Equal(t, "/topics/dcl", request.URL.Path)
+	require.Equal(t, kafkaContentType, request.Header.Get("Content-Type"))
+	require.JSONEq(t, `{"records": [{"key": "AB-0", "value": {"id": "AB-0", "height": 1, "tx_hash": "AB",
+		"module": "compliancetest", "action": "add_testing_result", "vid": 1, "pid": 2, "msg": {}}}]}`, string(body))
+
+	result = `{"offsets": [{"partition": null, "offset": null, "error_code": 50003, "error": "timeout"}]}`
+	require.EqualError(t, plugin.Notify(n), "failed to produce the record (error code 50003): timeout")
+
+	status = http.StatusNotFound
+	require.EqualError(t, plugin.Notify(n), "kafka rest proxy responded with status 404")
+}
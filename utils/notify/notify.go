@@ -0,0 +1,263 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package notify delivers the notifications about the ledger events through the notification plugins
+// (e.g. e-mail or message queue). A plugin is created by the constructor registered under its name
+// from the plugin specific JSON config. The reference plugins are `smtp` and `kafka`.
+// The notifiers can be limited to the events about the models awaiting certification
+// (e.g. to alert the certification centers about the new test results).
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/webhook"
+)
+
+var ErrNoNotifiers = errors.New("invalid notifications config: it must contain at least one notifier")
+
+// Plugin delivers the notifications to an external system.
+type Plugin interface {
+	Notify(n webhook.Notification) error
+}
+
+// Constructor creates the plugin from its JSON config.
+type Constructor func(config json.RawMessage) (Plugin, error)
+
+var (
+	pluginsMu sync.RWMutex
+	plugins   = map[string]Constructor{
+		SMTPPluginName:  NewSMTPPlugin,
+		KafkaPluginName: NewKafkaPlugin,
+	}
+)
+
+// Registers the plugin constructor under the name (replaces the registered one).
+func RegisterPlugin(name string, constructor Constructor) {
+	pluginsMu.Lock()
+	defer pluginsMu.Unlock()
+
+	plugins[name] = constructor
+}
+
+// Returns the names of the registered plugins.
+func Plugins() []string {
+	pluginsMu.RLock()
+	defer pluginsMu.RUnlock()
+
+	names := make([]string, 0, len(plugins))
+	for name := range plugins {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Creates the registered plugin.
+func NewPlugin(name string, config json.RawMessage) (Plugin, error) {
+	pluginsMu.RLock()
+	constructor, ok := plugins[name]
+	pluginsMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown notification plugin: \"%s\". Supported: %v", name, Plugins())
+	}
+
+	plugin, err := constructor(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s notification plugin config: %w", name, err)
+	}
+
+	return plugin, nil
+}
+
+// Event filter of a notifier. The empty fields match any value.
+type Filter struct {
+	webhook.Filter
+	// Matches only the events about the models which are not certified yet.
+	AwaitingCertification bool `json:"awaiting_certification,omitempty"`
+}
+
+type Notifier struct {
+	Plugin string          `json:"plugin"`
+	Config json.RawMessage `json:"config"`
+	Events []Filter        `json:"events"` // all the events are delivered if empty
+}
+
+// Reads the notifiers from the JSON file: `{"notifiers": [{"plugin": ..., "config": {...}, "events": [...]}]}`.
+func LoadNotifiers(path string) ([]Notifier, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file struct {
+		Notifiers []Notifier `json:"notifiers"`
+	}
+
+	if err := json.Unmarshal(bytes, &file); err != nil {
+		return nil, fmt.Errorf("invalid notifications config: %w", err)
+	}
+
+	if len(file.Notifiers) == 0 {
+		return nil, ErrNoNotifiers
+	}
+
+	return file.Notifiers, nil
+}
+
+type Config struct {
+	Notifiers []Notifier
+	// Number of retries of a failed notification.
+	MaxRetries int
+	// Delay before the first retry, doubled after every failed retry.
+	RetryDelay time.Duration
+	// Reports whether the model is awaiting certification. Required by the `awaiting_certification` filters.
+	AwaitingCertification func(vid uint16, pid uint16) bool
+	Logger                log.Logger
+}
+
+type notifier struct {
+	name   string
+	plugin Plugin
+	events []Filter
+}
+
+type Dispatcher struct {
+	config    Config
+	notifiers []notifier
+	logger    log.Logger
+	sleep     func(time.Duration)
+	wg        sync.WaitGroup
+}
+
+// New creates the plugins of the notifiers and returns the dispatcher or nil if there are no notifiers.
+// The nil dispatcher can be used: it notifies nothing.
+func New(config Config) (*Dispatcher, error) {
+	if len(config.Notifiers) == 0 {
+		return nil, nil
+	}
+
+	logger := config.Logger
+	if logger == nil {
+		logger = log.NewNopLogger()
+	}
+
+	d := &Dispatcher{
+		config: config,
+		logger: logger,
+		sleep:  time.Sleep,
+	}
+
+	for _, n := range config.Notifiers {
+		plugin, err := NewPlugin(n.Plugin, n.Config)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, filter := range n.Events {
+			if filter.AwaitingCertification && config.AwaitingCertification == nil {
+				return nil, fmt.Errorf("%s notifier: awaiting_certification filters are not supported", n.Plugin)
+			}
+		}
+
+		d.notifiers = append(d.notifiers, notifier{name: n.Plugin, plugin: plugin, events: n.Events})
+	}
+
+	return d, nil
+}
+
+// Dispatch delivers the notification through the matching notifiers in background.
+func (d *Dispatcher) Dispatch(n webhook.Notification) {
+	if d == nil {
+		return
+	}
+
+	// the model is checked at most once and only if required by a filter
+	var awaiting *bool
+
+	awaitingCertification := func() bool {
+		if awaiting == nil {
+			value := d.config.AwaitingCertification(n.VID, n.PID)
+			awaiting = &value
+		}
+
+		return *awaiting
+	}
+
+	for _, entry := range d.notifiers {
+		if !entry.matches(n, awaitingCertification) {
+			continue
+		}
+
+		d.wg.Add(1)
+
+		go func(entry notifier) {
+			defer d.wg.Done()
+
+			d.notify(entry, n)
+		}(entry)
+	}
+}
+
+// Wait blocks until the notifications in progress are finished (delivered or failed).
+func (d *Dispatcher) Wait() {
+	if d != nil {
+		d.wg.Wait()
+	}
+}
+
+func (d *Dispatcher) notify(entry notifier, n webhook.Notification) {
+	delay := d.config.RetryDelay
+
+	for attempt := 1; ; attempt++ {
+		err := entry.plugin.Notify(n)
+		if err == nil {
+			return
+		}
+
+		if attempt > d.config.MaxRetries {
+			d.logger.Error("Failed to deliver the notification", "plugin", entry.name,
+				"notification", n.ID, "attempts", attempt, "err", err)
+
+			return
+		}
+
+		d.sleep(delay)
+		delay *= 2
+	}
+}
+
+func (n notifier) matches(notification webhook.Notification, awaitingCertification func() bool) bool {
+	if len(n.events) == 0 {
+		return true
+	}
+
+	for _, filter := range n.events {
+		if filter.Matches(notification) && (!filter.AwaitingCertification || awaitingCertification()) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,216 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/webhook"
+)
+
+// Plugin recording the notifications and failing the given number of first ones.
+type recorder struct {
+	mu            sync.Mutex
+	failures      int
+	attempts      int
+	notifications []webhook.Notification
+}
+
+func (r *recorder) Notify(n webhook.Notification) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.attempts++
+
+	if r.failures > 0 {
+		r.failures--
+
+		return errors.New("unavailable")
+	}
+
+	r.notifications = append(r.notifications, n)
+
+	return nil
+}
+
+// Registers the recorder plugin. Returns the function unregistering it.
+func registerRecorder(name string, r *recorder) func() {
+	RegisterPlugin(name, func(config json.RawMessage) (Plugin, error) {
+		if string(config) == `"invalid"` {
+			return nil, errors.New("invalid")
+		}
+
+		return r, nil
+	})
+
+	return func() {
+		pluginsMu.Lock()
+		defer pluginsMu.Unlock()
+
+		delete(plugins, name)
+	}
+}
+
+func notification(id string, module string, action string, vid uint16, pid uint16) webhook.Notification {
+	return webhook.Notification{ID: id, Height: 1, TxHash: "AB", Module: module, Action: action,
+		VID: vid, PID: pid, Msg: []byte(`{}`)}
+}
+
+func TestNewPlugin(t *testing.T) {
+	defer registerRecorder("recorder", &recorder{})()
+
+	require.Equal(t, []string{KafkaPluginName, "recorder", SMTPPluginName}, Plugins())
+
+	_, err := NewPlugin("recorder", json.RawMessage(`{}`))
+	require.NoError(t, err)
+
+	_, err = NewPlugin("recorder", json.RawMessage(`"invalid"`))
+	require.EqualError(t, err, "invalid recorder notification plugin config: invalid")
+
+	_, err = NewPlugin("unknown", json.RawMessage(`{}`))
+	require.EqualError(t, err, "unknown notification plugin: \"unknown\". Supported: [kafka recorder smtp]")
+}
+
+func TestLoadNotifiers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "notify")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "notifications.json")
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"notifiers": [{"plugin": "smtp", "config": {"from": "a"},
+		"events": [{"module": "compliancetest", "vid": 1, "awaiting_certification": true}]}]}`), 0600))
+
+	notifiers, err := LoadNotifiers(path)
+	require.NoError(t, err)
+	require.Equal(t, []Notifier{{
+		Plugin: SMTPPluginName,
+		Config: json.RawMessage(`{"from": "a"}`),
+		Events: []Filter{{Filter: webhook.Filter{Module: "compliancetest", VID: 1}, AwaitingCertification: true}},
+	}}, notifiers)
+
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"notifiers": []}`), 0600))
+
+	_, err = LoadNotifiers(path)
+	require.Equal(t, ErrNoNotifiers, err)
+}
+
+func TestNew(t *testing.T) {
+	defer registerRecorder("recorder", &recorder{})()
+
+	d, err := New(Config{})
+	require.NoError(t, err)
+	require.Nil(t, d)
+
+	// the nil dispatcher notifies nothing
+	d.Dispatch(notification("1", "compliancetest", "add_testing_result", 1, 1))
+	d.Wait()
+
+	_, err = New(Config{Notifiers: []Notifier{{Plugin: "recorder", Config: json.RawMessage(`"invalid"`)}}})
+	require.Error(t, err)
+
+	_, err = New(Config{Notifiers: []Notifier{{Plugin: "recorder", Events: []Filter{{AwaitingCertification: true}}}}})
+	require.EqualError(t, err, "recorder notifier: awaiting_certification filters are not supported")
+}
+
+func TestDispatcher_Filters(t *testing.T) {
+	all := &recorder{}
+	testResults := &recorder{}
+	defer registerRecorder("all", all)()
+	defer registerRecorder("test_results", testResults)()
+
+	var checked []uint16
+
+	d, err := New(Config{
+		Notifiers: []Notifier{
+			{Plugin: "all"},
+			{Plugin: "test_results", Events: []Filter{
+				{
+					Filter:                webhook.Filter{Module: "compliancetest", Action: "add_testing_result"},
+					AwaitingCertification: true,
+				},
+				{Filter: webhook.Filter{Module: "compliance"}},
+			}},
+		},
+		AwaitingCertification: func(vid uint16, pid uint16) bool {
+			checked = append(checked, pid)
+
+			return pid != 2
+		},
+	})
+	require.NoError(t, err)
+
+	d.Dispatch(notification("1", "compliancetest", "add_testing_result", 1, 1))
+	d.Dispatch(notification("2", "compliancetest", "add_testing_result", 1, 2))
+	d.Dispatch(notification("3", "compliance", "certify_model", 1, 2))
+	d.Dispatch(notification("4", "modelinfo", "add_model_info", 1, 3))
+	d.Wait()
+
+	require.Len(t, all.notifications, 4)
+
+	require.Len(t, testResults.notifications, 2)
+	require.ElementsMatch(t, []string{"1", "3"},
+		[]string{testResults.notifications[0].ID, testResults.notifications[1].ID})
+
+	// only the test results are checked
+	require.Equal(t, []uint16{1, 2}, checked)
+}
+
+func TestDispatcher_Retries(t *testing.T) {
+	flaky := &recorder{failures: 2}
+	broken := &recorder{failures: 10}
+	defer registerRecorder("flaky", flaky)()
+	defer registerRecorder("broken", broken)()
+
+	d, err := New(Config{
+		Notifiers:  []Notifier{{Plugin: "flaky"}, {Plugin: "broken"}},
+		MaxRetries: 2,
+		RetryDelay: time.Second,
+	})
+	require.NoError(t, err)
+
+	var (
+		mu     sync.Mutex
+		delays []time.Duration
+	)
+
+	d.sleep = func(delay time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		delays = append(delays, delay)
+	}
+
+	d.Dispatch(notification("1", "compliancetest", "add_testing_result", 1, 1))
+	d.Wait()
+
+	require.Equal(t, 3, flaky.attempts)
+	require.Len(t, flaky.notifications, 1)
+
+	require.Equal(t, 3, broken.attempts)
+	require.Empty(t, broken.notifications)
+
+	require.ElementsMatch(t, []time.Duration{time.Second, 2 * time.Second, time.Second, 2 * time.Second}, delays)
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/webhook"
+)
+
+const SMTPPluginName = "smtp"
+
+type SMTPConfig struct {
+	Address  string   `json:"address"` // host:port of the SMTP server
+	Username string   `json:"username,omitempty"`
+	Password string   `json:"password,omitempty"`
+	From     string   `json:"from"`
+	To       []string `json:"to"`
+}
+
+// SMTPPlugin e-mails the notifications to the recipients.
+// The server must support STARTTLS if the credentials are set (see smtp.PlainAuth).
+type SMTPPlugin struct {
+	config SMTPConfig
+	auth   smtp.Auth
+	now    func() time.Time
+	send   func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewSMTPPlugin(config json.RawMessage) (Plugin, error) {
+	var smtpConfig SMTPConfig
+
+	if err := json.Unmarshal(config, &smtpConfig); err != nil {
+		return nil, err
+	}
+
+	host, _, err := net.SplitHostPort(smtpConfig.Address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: \"%s\". It must be host:port", smtpConfig.Address)
+	}
+
+	if len(smtpConfig.From) == 0 || len(smtpConfig.To) == 0 {
+		return nil, errors.New("from and to must not be empty")
+	}
+
+	plugin := &SMTPPlugin{
+		config: smtpConfig,
+		now:    time.Now,
+		send:   smtp.SendMail,
+	}
+
+	if len(smtpConfig.Username) != 0 {
+		plugin.auth = smtp.PlainAuth("", smtpConfig.Username, smtpConfig.Password, host)
+	}
+
+	return plugin, nil
+}
+
+func (p *SMTPPlugin) Notify(n webhook.Notification) error {
+	return p.send(p.config.Address, p.auth, p.config.From, p.config.To, p.message(n))
+}
+
+// Composes the plain text e-mail about the notification.
+func (p *SMTPPlugin) message(n webhook.Notification) []byte {
+	subject := fmt.Sprintf("DCL: %s/%s", n.Module, n.Action)
+	if n.VID != 0 {
+		subject += fmt.Sprintf(" (vid %v, pid %v)", n.VID, n.PID)
+	}
+
+	body := n.Msg
+	if len(body) == 0 {
+		// the module events carry the attributes instead of the message
+		body, _ = json.Marshal(n.Attributes)
+	}
+
+	var msg bytes.Buffer
+
+	_ = json.Indent(&msg, body, "", "  ")
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "From: %s\r\n", p.config.From)
+	fmt.Fprintf(&b, "To: %s\r\n", strings.Join(p.config.To, ", "))
+	fmt.Fprintf(&b, "Subject: %s\r\n", subject)
+	fmt.Fprintf(&b, "Date: %s\r\n", p.now().Format(time.RFC1123Z))
+	fmt.Fprintf(&b, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&b, "Content-Type: text/plain; charset=UTF-8\r\n")
+	fmt.Fprintf(&b, "\r\n")
+	fmt.Fprintf(&b, "Event: %s/%s\r\n", n.Module, n.Action)
+	fmt.Fprintf(&b, "Height: %v\r\n", n.Height)
+
+	if len(n.TxHash) != 0 {
+		fmt.Fprintf(&b, "Transaction: %s\r\n", n.TxHash)
+	}
+
+	fmt.Fprintf(&b, "\r\n")
+	fmt.Fprintf(&b, "%s\r\n", strings.ReplaceAll(msg.String(), "\n", "\r\n"))
+
+	return []byte(b.String())
+}
@@ -0,0 +1,128 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package notify
+
+import (
+	"encoding/json"
+	"net/smtp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/webhook"
+)
+
+func TestNewSMTPPlugin(t *testing.T) {
+	plugin, err := NewSMTPPlugin(json.RawMessage(`{"address": "mail.example.com:587", "username": "user",
+		"password": "pass", "from": "dcl@example.com", "to": ["lab@example.com"]}`))
+	require.NoError(t, err)
+	require.NotNil(t, plugin.(*SMTPPlugin).auth)
+
+	plugin, err = NewSMTPPlugin(json.RawMessage(`{"address": "localhost:25", "from": "dcl@example.com",
+		"to": ["lab@example.com"]}`))
+	require.NoError(t, err)
+	require.Nil(t, plugin.(*SMTPPlugin).auth)
+
+	_, err = NewSMTPPlugin(json.RawMessage(`{"address": "localhost", "from": "a", "to": ["b"]}`))
+	require.EqualError(t, err, "invalid address: \"localhost\". It must be host:port")
+
+	_, err = NewSMTPPlugin(json.RawMessage(`{"address": "localhost:25", "from": "a"}`))
+	require.EqualError(t, err, "from and to must not be empty")
+}
+
+func TestSMTPPlugin_Notify(t *testing.T) {
+	plugin, err := NewSMTPPlugin(json.RawMessage(`{"address": "localhost:25", "from": "dcl@example.com",
+		"to": ["lab1@example.com", "lab2@example.com"]}`))
+	require.NoError(t, err)
+
+	var (
+		sentAddr string
+		sentFrom string
+		sentTo   []string
+		sentMsg  string
+	)
+
+	smtpPlugin := plugin.(*SMTPPlugin)
+	smtpPlugin.now = func() time.Time { return time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC) }
+	smtpPlugin.send = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		sentAddr, sentFrom, sentTo, sentMsg = addr, from, to, string(msg)
+
+		return nil
+	}
+
+	n := notification("AB-0", "compliancetest", "add_testing_result", 1, 2)
+	n.Msg = []byte(`{"type":"compliancetest/AddTestingResult","value":{"pid":2,"vid":1}}`)
+
+	require.NoError(t, plugin.Notify(n))
+	require.Equal(t, "localhost:25", sentAddr)
+	require.Equal(t, "dcl@example.com", sentFrom)
+	require.Equal(t, []string{"lab1@example.com", "lab2@example.com"}, sentTo)
+	require.Equal(t, "From: dcl@example.com\r\n"+
+		"To: lab1@example.com, lab2@example.com\r\n"+
+		"Subject: DCL: compliancetest/add_testing_result (vid 1, pid 2)\r\n"+
+		"Date: Thu, 02 Jan 2020 03:04:05 +0000\r\n"+
+		"MIME-Version: 1.0\r\n"+
+		"Content-Type: text/plain; charset=UTF-8\r\n"+
+		"\r\n"+
+		"Event: compliancetest/add_testing_result\r\n"+
+		"Height: 1\r\n"+
+		"Transaction: AB\r\n"+
+		"\r\n"+
+		"{\r\n"+
+		"  \"type\": \"compliancetest/AddTestingResult\",\r\n"+
+		"  \"value\": {\r\n"+
+		"    \"pid\": 2,\r\n"+
+		"    \"vid\": 1\r\n"+
+		"  }\r\n"+
+		"}\r\n", sentMsg)
+}
+
+func TestSMTPPlugin_NotifyModuleEvent(t *testing.T) {
+	plugin, err := NewSMTPPlugin(json.RawMessage(`{"address": "localhost:25", "from": "dcl@example.com",
+		"to": ["lab1@example.com"]}`))
+	require.NoError(t, err)
+
+	var sentMsg string
+
+	smtpPlugin := plugin.(*SMTPPlugin)
+	smtpPlugin.now = func() time.Time { return time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC) }
+	smtpPlugin.send = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		sentMsg = string(msg)
+
+		return nil
+	}
+
+	// the module events of the block end carry the attributes instead of the message and the transaction
+	n := webhook.Notification{ID: "1-end_block-0", Height: 1, Module: "compliance", Action: "expire_compliance",
+		VID: 1, PID: 2, Attributes: map[string]string{"module": "compliance", "vid": "1", "pid": "2"}}
+
+	require.NoError(t, plugin.Notify(n))
+	require.Equal(t, "From: dcl@example.com\r\n"+
+		"To: lab1@example.com\r\n"+
+		"Subject: DCL: compliance/expire_compliance (vid 1, pid 2)\r\n"+
+		"Date: Thu, 02 Jan 2020 03:04:05 +0000\r\n"+
+		"MIME-Version: 1.0\r\n"+
+		"Content-Type: text/plain; charset=UTF-8\r\n"+
+		"\r\n"+
+		"Event: compliance/expire_compliance\r\n"+
+		"Height: 1\r\n"+
+		"\r\n"+
+		"{\r\n"+
+		"  \"module\": \"compliance\",\r\n"+
+		"  \"pid\": \"2\",\r\n"+
+		"  \"vid\": \"1\"\r\n"+
+		"}\r\n", sentMsg)
+}
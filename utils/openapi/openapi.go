@@ -0,0 +1,339 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package openapi generates OpenAPI 3.0 specification of the REST server from its registered routes,
+// so the specification is always in sync with the route registration code.
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+	"unicode"
+
+	"github.com/gorilla/mux"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/apiversion"
+)
+
+const (
+	Version = "3.0.3"
+
+	// Schema of the error responses (see rest.ErrorResponse).
+	ErrorResponseSchema = "ErrorResponse"
+)
+
+type Spec struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// Operations of the path by lower-case HTTP method.
+type PathItem map[string]Operation
+
+type Operation struct {
+	Tags        []string            `json:"tags,omitempty"`
+	Summary     string              `json:"summary,omitempty"`
+	OperationID string              `json:"operationId"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name     string `json:"name"`
+	In       string `json:"in"`
+	Required bool   `json:"required"`
+	Schema   Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema Schema `json:"schema"`
+}
+
+type Schema struct {
+	Ref        string            `json:"$ref,omitempty"`
+	Type       string            `json:"type,omitempty"`
+	Properties map[string]Schema `json:"properties,omitempty"`
+}
+
+type Components struct {
+	Schemas map[string]Schema `json:"schemas"`
+}
+
+// Generate builds the specification of all routes of the router having HTTP methods.
+// The operations are named after the handler functions (e.g. `getModelHandler` -> `getModel`).
+func Generate(router *mux.Router, info Info) (Spec, error) {
+	if len(info.Version) == 0 {
+		info.Version = "unknown"
+	}
+
+	spec := Spec{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   map[string]PathItem{},
+		Components: Components{
+			Schemas: map[string]Schema{
+				ErrorResponseSchema: {
+					Type: "object",
+					Properties: map[string]Schema{
+						"codespace": {Type: "string"},
+						"code":      {Type: "integer"},
+						"name":      {Type: "string"},
+						"error":     {Type: "string"},
+					},
+				},
+			},
+		},
+	}
+
+	operationIDs := map[string]bool{}
+
+	err := router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		template, err := route.GetPathTemplate()
+		if err != nil {
+			return nil // route matching something else than a path
+		}
+
+		methods, err := route.GetMethods()
+		if err != nil {
+			return nil // path prefix serving static files (e.g. swagger UI)
+		}
+
+		path, params, err := parsePathTemplate(template)
+		if err != nil {
+			return err
+		}
+
+		if path == SpecPath || path == UIPath {
+			return nil
+		}
+
+		item, ok := spec.Paths[path]
+		if !ok {
+			item = PathItem{}
+			spec.Paths[path] = item
+		}
+
+		for _, method := range methods {
+			// the route registered first takes precedence (e.g. the one overridden by an API version)
+			if _, ok := item[strings.ToLower(method)]; ok {
+				continue
+			}
+
+			operation := newOperation(path, method, params, handlerName(route.GetHandler()))
+			operation.OperationID = uniqueOperationID(operationIDs, operation)
+			item[strings.ToLower(method)] = operation
+		}
+
+		return nil
+	})
+
+	return spec, err
+}
+
+// The operations of the versioned routes (`/v1/...`) are tagged by the path without the version
+// and their IDs are prefixed by the version.
+func newOperation(path string, method string, params []string, name string) Operation {
+	version, unversioned := apiversion.Split(path)
+	tag := strings.SplitN(strings.TrimPrefix(unversioned, "/"), "/", 2)[0]
+
+	if len(name) == 0 {
+		name = strings.ToLower(method) + strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return r
+			}
+
+			return '_'
+		}, path)
+	}
+
+	id := name
+	if len(version) != 0 {
+		id = version + "_" + name
+	}
+
+	operation := Operation{
+		Tags:        []string{tag},
+		Summary:     summary(name),
+		OperationID: id,
+		Responses: map[string]Response{
+			"200": {
+				Description: "OK",
+				Content:     jsonContent(Schema{Type: "object"}),
+			},
+			"default": {
+				Description: "Error",
+				Content:     jsonContent(Schema{Ref: "#/components/schemas/" + ErrorResponseSchema}),
+			},
+		},
+	}
+
+	for _, param := range params {
+		operation.Parameters = append(operation.Parameters, Parameter{
+			Name:     param,
+			In:       "path",
+			Required: true,
+			Schema:   Schema{Type: "string"},
+		})
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		operation.RequestBody = &RequestBody{
+			Required: method != http.MethodDelete,
+			Content:  jsonContent(Schema{Type: "object"}),
+		}
+	}
+
+	return operation
+}
+
+// Operation IDs must be unique, so the ones of the same handlers are prefixed by the tag.
+func uniqueOperationID(used map[string]bool, operation Operation) string {
+	id := operation.OperationID
+
+	if used[id] {
+		id = operation.Tags[0] + "_" + operation.OperationID
+	}
+
+	for i := 2; used[id]; i++ {
+		id = fmt.Sprintf("%s_%s%d", operation.Tags[0], operation.OperationID, i)
+	}
+
+	used[id] = true
+
+	return id
+}
+
+// Replaces `{name:pattern}` path variables by `{name}` and returns their names.
+func parsePathTemplate(template string) (string, []string, error) {
+	var (
+		path   strings.Builder
+		params []string
+	)
+
+	for i := 0; i < len(template); i++ {
+		if template[i] != '{' {
+			path.WriteByte(template[i])
+			continue
+		}
+
+		end, level := i, 0
+
+		for ; end < len(template); end++ {
+			if template[end] == '{' {
+				level++
+			} else if template[end] == '}' {
+				level--
+			}
+
+			if level == 0 {
+				break
+			}
+		}
+
+		if level != 0 {
+			return "", nil, fmt.Errorf("unbalanced braces in route %s", template)
+		}
+
+		name := strings.SplitN(template[i+1:end], ":", 2)[0]
+		params = append(params, name)
+		path.WriteString("{" + name + "}")
+
+		i = end
+	}
+
+	return path.String(), params, nil
+}
+
+// Returns the name of the function creating the handler: `getModelHandler(cliCtx).func1` -> `getModel`.
+func handlerName(handler http.Handler) string {
+	value := reflect.ValueOf(handler)
+	if !value.IsValid() || value.Kind() != reflect.Func {
+		return ""
+	}
+
+	fn := runtime.FuncForPC(value.Pointer())
+	if fn == nil {
+		return ""
+	}
+
+	name := fn.Name()
+	name = name[strings.LastIndex(name, "/")+1:]
+
+	parts := strings.Split(name, ".")
+	if len(parts) < 2 {
+		return ""
+	}
+
+	name = strings.TrimSuffix(parts[1], "-fm")
+	name = strings.TrimSuffix(name, "Fn")
+	name = strings.TrimSuffix(name, "Handler")
+
+	if len(name) == 0 || !unicode.IsLetter(rune(name[0])) {
+		return ""
+	}
+
+	return string(unicode.ToLower(rune(name[0]))) + name[1:]
+}
+
+// Splits the camel case name into words: `getAllModels` -> `Get all models`, `vendorIDUpdates` -> `Vendor id updates`.
+func summary(name string) string {
+	var words []string
+
+	runes := []rune(name)
+	start := 0
+
+	for i := 1; i < len(runes); i++ {
+		if !unicode.IsUpper(runes[i]) {
+			continue
+		}
+
+		// beginning of a word or the last letter of an acronym followed by a word
+		if !unicode.IsUpper(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+			words = append(words, strings.ToLower(string(runes[start:i])))
+			start = i
+		}
+	}
+
+	words = append(words, strings.ToLower(string(runes[start:])))
+	res := strings.Join(words, " ")
+
+	return strings.ToUpper(res[:1]) + res[1:]
+}
+
+func jsonContent(schema Schema) map[string]MediaType {
+	return map[string]MediaType{"application/json": {Schema: schema}}
+}
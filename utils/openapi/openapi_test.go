@@ -0,0 +1,161 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/require"
+	app "github.com/zigbee-alliance/distributed-compliance-ledger"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/apiversion"
+)
+
+func getModelHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {}
+}
+
+func addModelHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {}
+}
+
+func getModelInfoHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {}
+}
+
+func TestGenerate(t *testing.T) {
+	router := mux.NewRouter()
+	router.HandleFunc("/modelinfo/models", addModelHandler()).Methods(http.MethodPost)
+	router.HandleFunc("/modelinfo/models/{vid}/{pid:[0-9]+}", getModelHandler()).Methods(http.MethodGet)
+	router.HandleFunc("/pki/models/{vid}", getModelHandler()).Methods(http.MethodGet)
+	router.PathPrefix("/swagger-ui/").Handler(http.NotFoundHandler())
+
+	spec, err := Generate(router, Info{Title: "Test"})
+	require.NoError(t, err)
+	require.Equal(t, Version, spec.OpenAPI)
+	require.Equal(t, "unknown", spec.Info.Version)
+	require.Equal(t, 3, len(spec.Paths))
+
+	post := spec.Paths["/modelinfo/models"]["post"]
+	require.Equal(t, "addModel", post.OperationID)
+	require.Equal(t, "Add model", post.Summary)
+	require.Equal(t, []string{"modelinfo"}, post.Tags)
+	require.NotNil(t, post.RequestBody)
+	require.True(t, post.RequestBody.Required)
+	require.Empty(t, post.Parameters)
+
+	get := spec.Paths["/modelinfo/models/{vid}/{pid}"]["get"]
+	require.Equal(t, "getModel", get.OperationID)
+	require.Nil(t, get.RequestBody)
+	require.Equal(t, 2, len(get.Parameters))
+	require.Equal(t, "vid", get.Parameters[0].Name)
+	require.Equal(t, "pid", get.Parameters[1].Name)
+	require.Equal(t, "path", get.Parameters[1].In)
+
+	// the same handler of another module
+	require.Equal(t, "pki_getModel", spec.Paths["/pki/models/{vid}"]["get"].OperationID)
+}
+
+func TestGenerate_Versions(t *testing.T) {
+	router := mux.NewRouter()
+	apiversion.RegisterRoutes(router,
+		apiversion.Version{Name: "v1", RegisterRoutes: func(r *mux.Router) {
+			r.HandleFunc("/modelinfo/models", addModelHandler()).Methods(http.MethodPost)
+			r.HandleFunc("/modelinfo/models/{vid}", getModelHandler()).Methods(http.MethodGet)
+		}},
+		apiversion.Version{Name: "v2", RegisterRoutes: func(r *mux.Router) {
+			r.HandleFunc("/modelinfo/models/{vid}", getModelInfoHandler()).Methods(http.MethodGet)
+		}},
+	)
+
+	spec, err := Generate(router, Info{Title: "Test"})
+	require.NoError(t, err)
+	require.Equal(t, 6, len(spec.Paths))
+
+	require.Equal(t, "addModel", spec.Paths["/modelinfo/models"]["post"].OperationID)
+	require.Equal(t, "v1_addModel", spec.Paths["/v1/modelinfo/models"]["post"].OperationID)
+	require.Equal(t, []string{"modelinfo"}, spec.Paths["/v1/modelinfo/models"]["post"].Tags)
+	require.Equal(t, "v2_addModel", spec.Paths["/v2/modelinfo/models"]["post"].OperationID)
+
+	// overridden by v2
+	require.Equal(t, "v1_getModel", spec.Paths["/v1/modelinfo/models/{vid}"]["get"].OperationID)
+	require.Equal(t, "v2_getModelInfo", spec.Paths["/v2/modelinfo/models/{vid}"]["get"].OperationID)
+}
+
+func TestSummary(t *testing.T) {
+	require.Equal(t, "Get all x509 certs", summary("getAllX509Certs"))
+	require.Equal(t, "Propose update vendor id", summary("proposeUpdateVendorID"))
+	require.Equal(t, "Approve vendor id updates", summary("approveVendorIDUpdates"))
+	require.Equal(t, "Exec", summary("exec"))
+}
+
+func TestGenerate_AllModuleRoutes(t *testing.T) {
+	router := mux.NewRouter()
+	app.ModuleBasics.RegisterRESTRoutes(context.NewCLIContext().WithCodec(app.MakeCodec()), router)
+
+	spec, err := Generate(router, Info{Title: "Test"})
+	require.NoError(t, err)
+
+	operationIDs := map[string]bool{}
+	total := 0
+
+	err = router.Walk(func(route *mux.Route, _ *mux.Router, _ []*mux.Route) error {
+		template, _ := route.GetPathTemplate()
+		methods, _ := route.GetMethods()
+
+		path, _, err := parsePathTemplate(template)
+		require.NoError(t, err)
+
+		for _, method := range methods {
+			operation, ok := spec.Paths[path][strings.ToLower(method)]
+			require.True(t, ok, "%s %s", method, path)
+			require.False(t, operationIDs[operation.OperationID], operation.OperationID)
+
+			operationIDs[operation.OperationID] = true
+			total++
+		}
+
+		return nil
+	})
+	require.NoError(t, err)
+	require.NotZero(t, total)
+}
+
+func TestRegisterRoutes(t *testing.T) {
+	router := mux.NewRouter()
+	router.HandleFunc("/modelinfo/models", addModelHandler()).Methods(http.MethodPost)
+	RegisterRoutes(router, Info{Title: "Test", Version: "1.0"})
+
+	resp := httptest.NewRecorder()
+	router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, SpecPath, nil))
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	var spec Spec
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &spec))
+	require.Equal(t, "1.0", spec.Info.Version)
+	require.Equal(t, 1, len(spec.Paths))
+	require.Contains(t, spec.Paths["/modelinfo/models"], "post")
+
+	resp = httptest.NewRecorder()
+	router.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, UIPath, nil))
+	require.Equal(t, http.StatusOK, resp.Code)
+	require.Contains(t, resp.Body.String(), SpecPath)
+}
@@ -0,0 +1,92 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/mux"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+)
+
+const (
+	SpecPath = "/swagger.json"
+	UIPath   = "/swagger/"
+
+	// Swagger UI assets served by Cosmos SDK REST server (embedded into the binary).
+	swaggerUIAssetsPath = "/swagger-ui/"
+)
+
+const uiTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="UTF-8">
+  <title>%[1]s</title>
+  <link rel="stylesheet" type="text/css" href="%[2]sswagger-ui.css">
+</head>
+<body>
+<div id="swagger-ui"></div>
+<script src="%[2]sswagger-ui-bundle.js"></script>
+<script src="%[2]sswagger-ui-standalone-preset.js"></script>
+<script>
+  window.onload = function() {
+    window.ui = SwaggerUIBundle({
+      url: "%[3]s",
+      dom_id: "#swagger-ui",
+      presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+      layout: "StandaloneLayout"
+    });
+  };
+</script>
+</body>
+</html>
+`
+
+// RegisterRoutes serves the specification of the router at /swagger.json and Swagger UI at /swagger/.
+// The specification is generated on the first request, when all the routes are registered.
+func RegisterRoutes(router *mux.Router, info Info) {
+	var (
+		once sync.Once
+		spec []byte
+		err  error
+	)
+
+	router.HandleFunc(SpecPath, func(w http.ResponseWriter, r *http.Request) {
+		once.Do(func() {
+			var res Spec
+
+			if res, err = Generate(router, info); err == nil {
+				spec, err = json.MarshalIndent(res, "", "  ")
+			}
+		})
+
+		if err != nil {
+			rest.WriteError(w, http.StatusInternalServerError, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(spec)
+	}).Methods(http.MethodGet)
+
+	router.HandleFunc(UIPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = fmt.Fprintf(w, uiTemplate, html.EscapeString(info.Title), swaggerUIAssetsPath, SpecPath)
+	}).Methods(http.MethodGet)
+}
@@ -0,0 +1,158 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pagination
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Paginator selects items of the requested page during iteration over the records.
+// Items must be passed in the iteration (ascending or, if requested, descending key) order. Every item is identified
+// by a key which is used as a page cursor, so the pages stay stable while new records are inserted.
+// The records sorted by a field are iterated over the index keyed by the field value (see Iterator).
+type Paginator struct {
+	take       int
+	skip       int
+	startKey   []byte
+	descending bool
+
+	skipped  int
+	taken    int
+	prevKeys [][]byte
+	nextKey  []byte
+}
+
+type maxPageSizeKey struct{}
+
+// Returns the context limiting the size of the pages selected by the paginators created with it.
+// Zero size means no limit.
+func WithMaxPageSize(ctx sdk.Context, size int) sdk.Context {
+	return ctx.WithValue(maxPageSizeKey{}, size)
+}
+
+// Returns the maximum page size set to the context (or zero if the page size is not limited).
+func MaxPageSize(ctx sdk.Context) int {
+	size, _ := ctx.Value(maxPageSizeKey{}).(int)
+
+	return size
+}
+
+// NewPaginator creates the paginator of the query supporting sorting by the given fields (if any).
+func NewPaginator(ctx sdk.Context, params PaginationParams, sortFields ...string) (*Paginator, sdk.Error) {
+	if err := params.ValidateSort(sortFields...); err != nil {
+		return nil, err
+	}
+
+	paginator := &Paginator{
+		take:       params.Take,
+		skip:       params.Skip,
+		descending: params.Descending(),
+	}
+
+	// the requested page (or the whole list if take is not set) is truncated to the maximum page size,
+	// the rest of the items can be fetched using `next_key` cursor
+	if maxSize := MaxPageSize(ctx); maxSize > 0 && (paginator.take == 0 || paginator.take > maxSize) {
+		paginator.take = maxSize
+	}
+
+	if len(params.Key) > 0 {
+		startKey, err := hex.DecodeString(params.Key)
+		if err != nil {
+			return nil, sdk.ErrUnknownRequest(
+				fmt.Sprintf("Invalid pagination key: Parsing Error: %v must be hex encoded string", params.Key))
+		}
+
+		paginator.startKey = startKey
+	}
+
+	return paginator, nil
+}
+
+// Registers the next item and returns true if the item belongs to the requested page.
+func (p *Paginator) Add(key []byte) bool {
+	if p.isBeforePage(key) {
+		p.skipped++
+
+		if p.take > 0 {
+			// remember keys of the last `take` items preceding the page to build the previous page cursor
+			p.prevKeys = append(p.prevKeys, key)
+			if len(p.prevKeys) > p.take {
+				p.prevKeys = p.prevKeys[1:]
+			}
+		}
+
+		return false
+	}
+
+	if p.take == 0 || p.taken < p.take {
+		p.taken++
+
+		return true
+	}
+
+	if p.nextKey == nil {
+		p.nextKey = key
+	}
+
+	return false
+}
+
+// Returns true if the page is already filled and the cursor of the next page is found.
+func (p *Paginator) Done() bool {
+	return p.nextKey != nil
+}
+
+// Returns the cursor of the next page or empty string if the page is the last one.
+func (p *Paginator) NextKey() string {
+	return hex.EncodeToString(p.nextKey)
+}
+
+// Returns the cursor of the previous page or empty string if the page is the first one.
+func (p *Paginator) PrevKey() string {
+	if len(p.prevKeys) == 0 {
+		return ""
+	}
+
+	return hex.EncodeToString(p.prevKeys[0])
+}
+
+func (p *Paginator) isBeforePage(key []byte) bool {
+	if p.taken > 0 {
+		return false
+	}
+
+	if p.startKey != nil {
+		if p.descending {
+			return bytes.Compare(key, p.startKey) > 0
+		}
+
+		return bytes.Compare(key, p.startKey) < 0
+	}
+
+	return p.skipped < p.skip
+}
+
+// Iterator returns the iterator over the records with the given prefix in the requested order.
+func Iterator(store sdk.KVStore, prefix []byte, params PaginationParams) sdk.Iterator {
+	if params.Descending() {
+		return sdk.KVStoreReversePrefixIterator(store, prefix)
+	}
+
+	return sdk.KVStorePrefixIterator(store, prefix)
+}
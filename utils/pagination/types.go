@@ -20,6 +20,7 @@ import (
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
@@ -28,23 +29,45 @@ const (
 	FlagSkipUsage = "amount of records to skip"
 	FlagTake      = "take"
 	FlagTakeUsage = "take of records to take"
+	FlagKey       = "key"
+	FlagKeyUsage  = "key of the first record of the page (`next_key` or `prev_key` of the previous query), " +
+		"it is used instead of skip"
+	FlagSort       = "sort"
+	FlagOrder      = "order"
+	FlagOrderUsage = "order of the records: asc or desc"
+
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
 )
 
 // request Payload for a list query with pagination.
 type PaginationParams struct {
 	Skip int
 	Take int
+	Key  string
+	// the field the records are sorted by (the default order of the query if empty) and the order (asc or desc)
+	Sort  string
+	Order string
 }
 
 func NewPaginationParams(skip int, take int) PaginationParams {
 	return PaginationParams{Skip: skip, Take: take}
 }
 
+func NewCursorPaginationParams(key string, take int) PaginationParams {
+	return PaginationParams{Take: take, Key: key}
+}
+
 func ParsePaginationParamsFromFlags() PaginationParams {
-	return NewPaginationParams(
+	params := NewPaginationParams(
 		viper.GetInt(FlagSkip),
 		viper.GetInt(FlagTake),
 	)
+	params.Key = viper.GetString(FlagKey)
+	params.Sort = viper.GetString(FlagSort)
+	params.Order = viper.GetString(FlagOrder)
+
+	return params
 }
 
 func ParsePaginationParamsFromRequest(r *http.Request) (PaginationParams, error) {
@@ -72,5 +95,51 @@ func ParsePaginationParamsFromRequest(r *http.Request) (PaginationParams, error)
 		take = val_
 	}
 
-	return NewPaginationParams(skip, take), nil
+	params := NewPaginationParams(skip, take)
+	params.Key = r.FormValue("key")
+	params.Sort = r.FormValue(FlagSort)
+	params.Order = r.FormValue(FlagOrder)
+
+	return params, nil
+}
+
+// Descending returns whether the records are requested in the descending order.
+func (p PaginationParams) Descending() bool {
+	return p.Order == OrderDesc
+}
+
+// ValidateSort checks that the records are sorted by one of the fields supported by the query (if any)
+// and the order is valid. The order of the query not supporting sorting can not be changed.
+func (p PaginationParams) ValidateSort(sortFields ...string) sdk.Error {
+	if len(sortFields) == 0 {
+		if len(p.Sort) != 0 || len(p.Order) != 0 {
+			return sdk.ErrUnknownRequest("Invalid query parameter `sort`: the query does not support sorting")
+		}
+
+		return nil
+	}
+
+	if p.Order != "" && p.Order != OrderAsc && p.Order != OrderDesc {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid query parameter `order`: %v must be either %s or %s", p.Order, OrderAsc, OrderDesc))
+	}
+
+	if len(p.Sort) == 0 {
+		return nil
+	}
+
+	for _, field := range sortFields {
+		if p.Sort == field {
+			return nil
+		}
+	}
+
+	return sdk.ErrUnknownRequest(
+		fmt.Sprintf("Invalid query parameter `sort`: %v must be one of %v", p.Sort, sortFields))
+}
+
+// AddSortFlags adds the flags sorting the records of the list query by one of the given fields.
+func AddSortFlags(cmd *cobra.Command, sortFields ...string) {
+	cmd.Flags().String(FlagSort, "", fmt.Sprintf("field to sort the records by (one of %v)", sortFields))
+	cmd.Flags().String(FlagOrder, "", FlagOrderUsage)
 }
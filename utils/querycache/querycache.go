@@ -0,0 +1,156 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package querycache caches the results of the ledger queries made by the REST server.
+// The results are keyed by the query path, data and the requested height.
+// The results of the queries on the latest height are invalidated once a newer block height is observed
+// (or their time to live expires, so they are not served for long if no new height is observed),
+// the results of the queries on an explicit height never change and are only evicted when the cache is full.
+package querycache
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+)
+
+type Config struct {
+	// Maximal number of cached results (0 disables the cache).
+	Size int
+	// Time to live of the results of the queries on the latest height.
+	TTL time.Duration
+}
+
+type Cache struct {
+	config Config
+	now    func() time.Time
+
+	mu sync.Mutex
+	// Latest observed block height.
+	height int64
+	// Least recently used entries are at the back.
+	entries *list.List
+	index   map[string]*list.Element
+}
+
+type entry struct {
+	key     string
+	value   []byte
+	height  int64
+	latest  bool
+	expires time.Time
+}
+
+// New returns the cache or nil if it is disabled. The nil cache can be used: it never returns a result.
+func New(config Config) *Cache {
+	if config.Size <= 0 {
+		return nil
+	}
+
+	return &Cache{
+		config:  config,
+		now:     time.Now,
+		entries: list.New(),
+		index:   map[string]*list.Element{},
+	}
+}
+
+func key(path string, data []byte, height int64) string {
+	return fmt.Sprintf("%s/%d/%x", path, height, data)
+}
+
+// Get returns the cached result of the query on the given height (0 for the latest one) and its height.
+func (c *Cache) Get(path string, data []byte, height int64) ([]byte, int64, bool) {
+	if c == nil {
+		return nil, 0, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.index[key(path, data, height)]
+	if !ok {
+		return nil, 0, false
+	}
+
+	e := element.Value.(*entry)
+
+	if e.latest && (e.height < c.height || !c.now().Before(e.expires)) {
+		c.remove(element)
+
+		return nil, 0, false
+	}
+
+	c.entries.MoveToFront(element)
+
+	return e.value, e.height, true
+}
+
+// Set caches the result of the query on the given height (0 for the latest one) returned at resultHeight.
+func (c *Cache) Set(path string, data []byte, height int64, result []byte, resultHeight int64) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.observeHeight(resultHeight)
+
+	if height == 0 && resultHeight < c.height {
+		return // already outdated
+	}
+
+	k := key(path, data, height)
+
+	if element, ok := c.index[k]; ok {
+		c.remove(element)
+	}
+
+	c.index[k] = c.entries.PushFront(&entry{
+		key:     k,
+		value:   result,
+		height:  resultHeight,
+		latest:  height == 0,
+		expires: c.now().Add(c.config.TTL),
+	})
+
+	for c.entries.Len() > c.config.Size {
+		c.remove(c.entries.Back())
+	}
+}
+
+// ObserveHeight invalidates the results of the queries on the latest height if the given height is newer.
+func (c *Cache) ObserveHeight(height int64) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.observeHeight(height)
+}
+
+func (c *Cache) observeHeight(height int64) {
+	if height > c.height {
+		c.height = height
+	}
+}
+
+func (c *Cache) remove(element *list.Element) {
+	c.entries.Remove(element)
+	delete(c.index, element.Value.(*entry).key)
+}
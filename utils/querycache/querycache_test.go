@@ -0,0 +1,124 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package querycache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const path = "custom/modelinfo/model"
+
+func newCache(size int) (*Cache, *time.Time) {
+	now := time.Unix(1000, 0)
+
+	cache := New(Config{Size: size, TTL: 5 * time.Second})
+	cache.now = func() time.Time { return now }
+
+	return cache, &now
+}
+
+func TestCache_Disabled(t *testing.T) {
+	cache := New(Config{Size: 0})
+	require.Nil(t, cache)
+
+	cache.Set(path, []byte("1"), 0, []byte("model"), 10)
+	cache.ObserveHeight(11)
+
+	_, _, ok := cache.Get(path, []byte("1"), 0)
+	require.False(t, ok)
+}
+
+func TestCache_LatestHeight(t *testing.T) {
+	cache, now := newCache(10)
+
+	cache.Set(path, []byte("1"), 0, []byte("model"), 10)
+
+	res, height, ok := cache.Get(path, []byte("1"), 0)
+	require.True(t, ok)
+	require.Equal(t, []byte("model"), res)
+	require.Equal(t, int64(10), height)
+
+	// other data
+	_, _, ok = cache.Get(path, []byte("2"), 0)
+	require.False(t, ok)
+
+	// the same height is observed
+	cache.ObserveHeight(10)
+
+	_, _, ok = cache.Get(path, []byte("1"), 0)
+	require.True(t, ok)
+
+	// new block
+	cache.ObserveHeight(11)
+
+	_, _, ok = cache.Get(path, []byte("1"), 0)
+	require.False(t, ok)
+
+	// outdated result is not cached
+	cache.Set(path, []byte("1"), 0, []byte("model"), 10)
+
+	_, _, ok = cache.Get(path, []byte("1"), 0)
+	require.False(t, ok)
+
+	// time to live expired
+	cache.Set(path, []byte("1"), 0, []byte("model"), 11)
+	*now = now.Add(5 * time.Second)
+
+	_, _, ok = cache.Get(path, []byte("1"), 0)
+	require.False(t, ok)
+}
+
+func TestCache_ExplicitHeight(t *testing.T) {
+	cache, now := newCache(10)
+
+	cache.Set(path, []byte("1"), 7, []byte("old model"), 7)
+	cache.ObserveHeight(20)
+	*now = now.Add(time.Hour)
+
+	res, height, ok := cache.Get(path, []byte("1"), 7)
+	require.True(t, ok)
+	require.Equal(t, []byte("old model"), res)
+	require.Equal(t, int64(7), height)
+
+	// the latest height is cached separately
+	_, _, ok = cache.Get(path, []byte("1"), 0)
+	require.False(t, ok)
+}
+
+func TestCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	cache, _ := newCache(2)
+
+	cache.Set(path, []byte("1"), 0, []byte("model 1"), 10)
+	cache.Set(path, []byte("2"), 0, []byte("model 2"), 10)
+
+	// 1 is used recently
+	_, _, ok := cache.Get(path, []byte("1"), 0)
+	require.True(t, ok)
+
+	cache.Set(path, []byte("3"), 0, []byte("model 3"), 10)
+
+	_, _, ok = cache.Get(path, []byte("2"), 0)
+	require.False(t, ok)
+
+	_, _, ok = cache.Get(path, []byte("1"), 0)
+	require.True(t, ok)
+
+	_, _, ok = cache.Get(path, []byte("3"), 0)
+	require.True(t, ok)
+}
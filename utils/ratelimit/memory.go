@@ -0,0 +1,91 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Interval of removing the buckets which have been refilled completely.
+const cleanupInterval = time.Minute
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	limit  Limit
+}
+
+// In-memory token buckets of a single REST server.
+type MemoryStore struct {
+	mtx         sync.Mutex
+	buckets     map[string]*bucket
+	lastCleanup time.Time
+	now         func() time.Time
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets:     map[string]*bucket{},
+		lastCleanup: time.Now(),
+		now:         time.Now,
+	}
+}
+
+func (s *MemoryStore) Take(key string, limit Limit) (bool, time.Duration, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	now := s.now()
+
+	if now.Sub(s.lastCleanup) >= cleanupInterval {
+		s.cleanup(now)
+	}
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), last: now, limit: limit}
+		s.buckets[key] = b
+	}
+
+	b.tokens = refill(b, now)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+
+		return true, 0, nil
+	}
+
+	retryAfter := time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second))
+
+	return false, retryAfter, nil
+}
+
+// Removes the full buckets as they are identical to absent ones.
+func (s *MemoryStore) cleanup(now time.Time) {
+	for key, b := range s.buckets {
+		if refill(b, now) >= float64(b.limit.Burst) {
+			delete(s.buckets, key)
+		}
+	}
+
+	s.lastCleanup = now
+}
+
+func refill(b *bucket, now time.Time) float64 {
+	return math.Min(float64(b.limit.Burst), b.tokens+now.Sub(b.last).Seconds()*b.limit.Rate)
+}
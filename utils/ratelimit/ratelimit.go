@@ -0,0 +1,165 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	FlagIPRate            = "rate-limit"
+	FlagIPRateUsage       = "Number of requests per second allowed from one IP address (0 disables the limit)"
+	FlagIPBurst           = "rate-limit-burst"
+	FlagIPBurstUsage      = "Number of requests allowed from one IP address at once"
+	FlagAccountRate       = "account-rate-limit"
+	FlagAccountRateUsage  = "Number of requests per second allowed for one signing account (0 disables the limit)"
+	FlagAccountBurst      = "account-rate-limit-burst"
+	FlagAccountBurstUsage = "Number of requests allowed for one signing account at once"
+	FlagRedisAddress      = "rate-limit-redis"
+	FlagRedisAddressUsage = "Address of Redis server keeping the limits shared by several REST servers " +
+		"(in-memory if empty)"
+	FlagBehindProxy              = "rate-limit-behind-proxy"
+	FlagBehindProxyUsage         = "Take the client IP address from X-Forwarded-For header set by the reverse proxy"
+	defaultBurst                 = 10
+	headerForwardedFor           = "X-Forwarded-For"
+	headerRetryAfter             = "Retry-After"
+	ipKeyPrefix                  = "ip:"
+	accountKeyPrefix             = "account:"
+	tooManyRequestsErrorTemplate = "Too many requests: retry after %v seconds"
+)
+
+// Store keeps the token buckets.
+type Store interface {
+	// Takes a token from the bucket with the given key.
+	// If the bucket is empty, the duration after which the token will be available is returned.
+	Take(key string, limit Limit) (allowed bool, retryAfter time.Duration, err error)
+}
+
+// Limit of the token bucket.
+type Limit struct {
+	Rate  float64 // tokens added per second
+	Burst int     // capacity of the bucket
+}
+
+func (l Limit) Enabled() bool {
+	return l.Rate > 0 && l.Burst > 0
+}
+
+type Config struct {
+	IPLimit      Limit
+	AccountLimit Limit
+	RedisAddress string
+	BehindProxy  bool
+}
+
+// Adds the rate limiting flags to the REST server command.
+func AddFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().Float64(FlagIPRate, 0, FlagIPRateUsage)
+	cmd.Flags().Int(FlagIPBurst, defaultBurst, FlagIPBurstUsage)
+	cmd.Flags().Float64(FlagAccountRate, 0, FlagAccountRateUsage)
+	cmd.Flags().Int(FlagAccountBurst, defaultBurst, FlagAccountBurstUsage)
+	cmd.Flags().String(FlagRedisAddress, "", FlagRedisAddressUsage)
+	cmd.Flags().Bool(FlagBehindProxy, false, FlagBehindProxyUsage)
+
+	return cmd
+}
+
+func ConfigFromFlags() Config {
+	return Config{
+		IPLimit:      Limit{Rate: viper.GetFloat64(FlagIPRate), Burst: viper.GetInt(FlagIPBurst)},
+		AccountLimit: Limit{Rate: viper.GetFloat64(FlagAccountRate), Burst: viper.GetInt(FlagAccountBurst)},
+		RedisAddress: viper.GetString(FlagRedisAddress),
+		BehindProxy:  viper.GetBool(FlagBehindProxy),
+	}
+}
+
+// Creates the middleware limiting the requests per client IP address and per signing account
+// (the account passed with basic authentication to sign a transaction on the server).
+// The requests exceeding the limit are rejected with 429 status and Retry-After header.
+// If the store is not available the requests are let through, so the node keeps serving them.
+// Nil is returned if no limit is enabled.
+func NewMiddleware(config Config) mux.MiddlewareFunc {
+	if !config.IPLimit.Enabled() && !config.AccountLimit.Enabled() {
+		return nil
+	}
+
+	var store Store = NewMemoryStore()
+	if len(config.RedisAddress) != 0 {
+		store = NewRedisStore(config.RedisAddress)
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if config.IPLimit.Enabled() {
+				if !allow(w, store, ipKeyPrefix+clientIP(r, config.BehindProxy), config.IPLimit) {
+					return
+				}
+			}
+
+			if account, _, ok := r.BasicAuth(); ok && config.AccountLimit.Enabled() {
+				if !allow(w, store, accountKeyPrefix+account, config.AccountLimit) {
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// Takes the token and writes 429 response if it is not available.
+func allow(w http.ResponseWriter, store Store, key string, limit Limit) bool {
+	allowed, retryAfter, err := store.Take(key, limit)
+	if err != nil || allowed {
+		return true
+	}
+
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+
+	w.Header().Set(headerRetryAfter, strconv.Itoa(seconds))
+	rest.WriteErrorResponse(w, http.StatusTooManyRequests, fmt.Sprintf(tooManyRequestsErrorTemplate, seconds))
+
+	return false
+}
+
+func clientIP(r *http.Request, behindProxy bool) string {
+	if behindProxy {
+		// the first address is the client one, the others are added by proxies
+		if forwarded := r.Header.Get(headerForwardedFor); len(forwarded) != 0 {
+			return strings.TrimSpace(strings.Split(forwarded, ",")[0])
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
@@ -0,0 +1,134 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package ratelimit
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore_Take(t *testing.T) {
+	now := time.Now()
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+
+	limit := Limit{Rate: 2, Burst: 3}
+
+	// burst is available at once
+	for i := 0; i < 3; i++ {
+		allowed, _, err := store.Take("key", limit)
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+
+	allowed, retryAfter, err := store.Take("key", limit)
+	require.NoError(t, err)
+	require.False(t, allowed)
+	require.Equal(t, 500*time.Millisecond, retryAfter)
+
+	// other key has own bucket
+	allowed, _, _ = store.Take("other", limit)
+	require.True(t, allowed)
+
+	// a token is added in 1/rate seconds
+	now = now.Add(500 * time.Millisecond)
+
+	allowed, _, _ = store.Take("key", limit)
+	require.True(t, allowed)
+
+	allowed, _, _ = store.Take("key", limit)
+	require.False(t, allowed)
+}
+
+func TestMemoryStore_Cleanup(t *testing.T) {
+	now := time.Now()
+	store := NewMemoryStore()
+	store.now = func() time.Time { return now }
+	store.lastCleanup = now
+
+	_, _, _ = store.Take("key", Limit{Rate: 1, Burst: 1})
+	require.Len(t, store.buckets, 1)
+
+	now = now.Add(cleanupInterval)
+
+	_, _, _ = store.Take("other", Limit{Rate: 1, Burst: 1})
+	require.Len(t, store.buckets, 1)
+	require.Contains(t, store.buckets, "other")
+}
+
+func TestNewMiddleware_Disabled(t *testing.T) {
+	require.Nil(t, NewMiddleware(Config{}))
+}
+
+func TestNewMiddleware_LimitByIP(t *testing.T) {
+	handler := NewMiddleware(Config{IPLimit: Limit{Rate: 0.1, Burst: 1}})(okHandler())
+
+	require.Equal(t, http.StatusOK, serve(handler, "10.0.0.1:1000", "", "").Code)
+
+	resp := serve(handler, "10.0.0.1:2000", "", "")
+	require.Equal(t, http.StatusTooManyRequests, resp.Code)
+	require.Equal(t, "10", resp.Header().Get(headerRetryAfter))
+
+	require.Equal(t, http.StatusOK, serve(handler, "10.0.0.2:1000", "", "").Code)
+}
+
+func TestNewMiddleware_LimitByForwardedIP(t *testing.T) {
+	handler := NewMiddleware(Config{IPLimit: Limit{Rate: 1, Burst: 1}, BehindProxy: true})(okHandler())
+
+	require.Equal(t, http.StatusOK, serve(handler, "10.0.0.1:1000", "1.2.3.4, 10.0.0.1", "").Code)
+	require.Equal(t, http.StatusTooManyRequests, serve(handler, "10.0.0.1:1000", "1.2.3.4", "").Code)
+	require.Equal(t, http.StatusOK, serve(handler, "10.0.0.1:1000", "5.6.7.8", "").Code)
+}
+
+func TestNewMiddleware_LimitByAccount(t *testing.T) {
+	handler := NewMiddleware(Config{AccountLimit: Limit{Rate: 1, Burst: 1}})(okHandler())
+
+	require.Equal(t, http.StatusOK, serve(handler, "10.0.0.1:1000", "", "jack").Code)
+	require.Equal(t, http.StatusTooManyRequests, serve(handler, "10.0.0.2:1000", "", "jack").Code)
+	require.Equal(t, http.StatusOK, serve(handler, "10.0.0.1:1000", "", "alice").Code)
+
+	// requests without account are not limited
+	require.Equal(t, http.StatusOK, serve(handler, "10.0.0.1:1000", "", "").Code)
+	require.Equal(t, http.StatusOK, serve(handler, "10.0.0.1:1000", "", "").Code)
+}
+
+func okHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func serve(handler http.Handler, remoteAddr string, forwardedFor string, account string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.RemoteAddr = remoteAddr
+
+	if len(forwardedFor) != 0 {
+		req.Header.Set(headerForwardedFor, forwardedFor)
+	}
+
+	if len(account) != 0 {
+		req.SetBasicAuth(account, "test1234")
+	}
+
+	resp := httptest.NewRecorder()
+	handler.ServeHTTP(resp, req)
+
+	return resp
+}
@@ -0,0 +1,190 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const redisTimeout = 2 * time.Second
+
+// Token bucket kept in Redis hash. Takes the token atomically and returns {allowed, wait in milliseconds}.
+// KEYS[1] - bucket key, ARGV[1] - rate per second, ARGV[2] - burst.
+const tokenBucketScript = `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local time = redis.call('TIME')
+local now = tonumber(time[1]) * 1000 + math.floor(tonumber(time[2]) / 1000)
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'last')
+local tokens = tonumber(state[1]) or burst
+local last = tonumber(state[2]) or now
+tokens = math.min(burst, tokens + (now - last) * rate / 1000)
+local allowed = 0
+local wait = 0
+if tokens >= 1 then
+  tokens = tokens - 1
+  allowed = 1
+else
+  wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+redis.call('HMSET', KEYS[1], 'tokens', tostring(tokens), 'last', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+return {allowed, wait}
+`
+
+// Token buckets shared by several REST servers through Redis.
+// Talks to the server with the minimal RESP client over a single connection.
+type RedisStore struct {
+	address string
+	mtx     sync.Mutex
+	conn    net.Conn
+	reader  *bufio.Reader
+}
+
+func NewRedisStore(address string) *RedisStore {
+	return &RedisStore{address: address}
+}
+
+func (s *RedisStore) Take(key string, limit Limit) (bool, time.Duration, error) {
+	reply, err := s.do("EVAL", tokenBucketScript, "1", "ratelimit:"+key,
+		strconv.FormatFloat(limit.Rate, 'f', -1, 64), strconv.Itoa(limit.Burst))
+	if err != nil {
+		return false, 0, err
+	}
+
+	values, ok := reply.([]interface{})
+	if !ok || len(values) != 2 {
+		return false, 0, fmt.Errorf("unexpected redis reply: %v", reply)
+	}
+
+	allowed, ok1 := values[0].(int64)
+	wait, ok2 := values[1].(int64)
+
+	if !ok1 || !ok2 {
+		return false, 0, fmt.Errorf("unexpected redis reply: %v", reply)
+	}
+
+	return allowed == 1, time.Duration(wait) * time.Millisecond, nil
+}
+
+// Sends the command and reads the reply. The connection is dropped on failure and re-established next time.
+func (s *RedisStore) do(args ...string) (interface{}, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.conn == nil {
+		conn, err := net.DialTimeout("tcp", s.address, redisTimeout)
+		if err != nil {
+			return nil, err
+		}
+
+		s.conn = conn
+		s.reader = bufio.NewReader(conn)
+	}
+
+	reply, err := s.roundTrip(args)
+	if err != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+		s.reader = nil
+
+		return nil, err
+	}
+
+	if replyErr, ok := reply.(redisError); ok {
+		return nil, replyErr
+	}
+
+	return reply, nil
+}
+
+func (s *RedisStore) roundTrip(args []string) (interface{}, error) {
+	if err := s.conn.SetDeadline(time.Now().Add(redisTimeout)); err != nil {
+		return nil, err
+	}
+
+	cmd := fmt.Sprintf("*%d\r\n", len(args))
+	for _, arg := range args {
+		cmd += fmt.Sprintf("$%d\r\n%s\r\n", len(arg), arg)
+	}
+
+	if _, err := s.conn.Write([]byte(cmd)); err != nil {
+		return nil, err
+	}
+
+	return readReply(s.reader)
+}
+
+type redisError string
+
+func (e redisError) Error() string {
+	return "redis: " + string(e)
+}
+
+func readReply(r *bufio.Reader) (interface{}, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("malformed redis reply: %q", line)
+	}
+
+	payload := line[1 : len(line)-2]
+
+	switch line[0] {
+	case '+':
+		return payload, nil
+	case '-':
+		return redisError(payload), nil
+	case ':':
+		return strconv.ParseInt(payload, 10, 64)
+	case '$':
+		size, err := strconv.Atoi(payload)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+
+		buf := make([]byte, size+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+
+		return string(buf[:size]), nil
+	case '*':
+		size, err := strconv.Atoi(payload)
+		if err != nil || size < 0 {
+			return nil, err
+		}
+
+		values := make([]interface{}, size)
+		for i := range values {
+			if values[i], err = readReply(r); err != nil {
+				return nil, err
+			}
+		}
+
+		return values, nil
+	default:
+		return nil, fmt.Errorf("malformed redis reply: %q", line)
+	}
+}
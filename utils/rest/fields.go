@@ -0,0 +1,173 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// the attribute of the list result containing its items.
+const listItemsField = "items"
+
+// ParseFields parses the list of the selected result attributes. Nil is returned if all attributes are selected.
+func ParseFields(value string) []string {
+	var fields []string
+
+	for _, field := range strings.Split(value, ",") {
+		if field = strings.TrimSpace(field); len(field) != 0 {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// SelectFields keeps only the given attributes of the query result (sparse fieldset).
+// The attributes of every item are selected in the list results (the pagination data is kept)
+// and in the array results. The attributes missing in the result are ignored.
+func SelectFields(result []byte, fields []string) ([]byte, error) {
+	if len(fields) == 0 {
+		return result, nil
+	}
+
+	selected := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		selected[field] = true
+	}
+
+	switch trimmed := bytes.TrimSpace(result); {
+	case bytes.HasPrefix(trimmed, []byte("[")):
+		return selectItemsFields(trimmed, selected)
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		if !isListResult(trimmed, selected) {
+			return selectObjectFields(trimmed, keepSelected(selected))
+		}
+
+		return selectObjectFields(trimmed, func(key string, value json.RawMessage) (json.RawMessage, bool, error) {
+			if key != listItemsField {
+				return value, true, nil
+			}
+
+			items, err := selectItemsFields(value, selected)
+
+			return items, true, err
+		})
+	default:
+		return nil, fmt.Errorf("the fields can not be selected from a result that is not an object")
+	}
+}
+
+// Checks whether the result is a list (has the array of items) and its items are not selected as a whole.
+func isListResult(result []byte, selected map[string]bool) bool {
+	if selected[listItemsField] {
+		return false
+	}
+
+	var list map[string]json.RawMessage
+	if err := json.Unmarshal(result, &list); err != nil {
+		return false
+	}
+
+	items, ok := list[listItemsField]
+
+	return ok && bytes.HasPrefix(bytes.TrimSpace(items), []byte("["))
+}
+
+// Selects the attributes of every object of the array.
+func selectItemsFields(result []byte, selected map[string]bool) ([]byte, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(result, &items); err != nil {
+		return nil, err
+	}
+
+	for i, item := range items {
+		if !bytes.HasPrefix(bytes.TrimSpace(item), []byte("{")) {
+			continue
+		}
+
+		filtered, err := selectObjectFields(item, keepSelected(selected))
+		if err != nil {
+			return nil, err
+		}
+
+		items[i] = filtered
+	}
+
+	return json.Marshal(items)
+}
+
+func keepSelected(selected map[string]bool) func(string, json.RawMessage) (json.RawMessage, bool, error) {
+	return func(key string, value json.RawMessage) (json.RawMessage, bool, error) {
+		return value, selected[key], nil
+	}
+}
+
+// Rebuilds the object keeping the order of its attributes. `keep` returns the new value of the attribute
+// and whether it is kept.
+func selectObjectFields(object []byte,
+	keep func(key string, value json.RawMessage) (json.RawMessage, bool, error)) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(object))
+
+	// the opening brace
+	if _, err := decoder.Token(); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		key, ok := token.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected token %v in object", token)
+		}
+
+		var value json.RawMessage
+		if err := decoder.Decode(&value); err != nil {
+			return nil, err
+		}
+
+		value, ok, err = keep(key, value)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok {
+			continue
+		}
+
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+
+		encodedKey, _ := json.Marshal(key)
+		buf.Write(encodedKey)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
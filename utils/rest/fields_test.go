@@ -0,0 +1,87 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+func TestParseFields(t *testing.T) {
+	require.Nil(t, ParseFields(""))
+	require.Equal(t, []string{"vid", "pid", "name"}, ParseFields("vid, pid,,name"))
+}
+
+func TestSelectFields(t *testing.T) {
+	selectFields := func(result string, fields ...string) string {
+		res, err := SelectFields([]byte(result), fields)
+		require.NoError(t, err)
+
+		return string(res)
+	}
+
+	model := `{"vid":1,"pid":2,"name":"model","sku":"sku"}`
+
+	// all fields
+	require.Equal(t, model, selectFields(model))
+
+	// the order of the attributes is kept, the missing ones are ignored
+	require.Equal(t, `{"vid":1,"name":"model"}`, selectFields(model, "name", "vid", "cid"))
+
+	// the items of the list
+	require.Equal(t, `{"total":"2","items":[{"vid":1},{"vid":3}],"next_key":"k"}`,
+		selectFields(`{"total":"2","items":[{"vid":1,"pid":2},{"vid":3,"pid":4}],"next_key":"k"}`, "vid"))
+
+	// the items attribute itself is selected
+	require.Equal(t, `{"items":[{"vid":1,"pid":2}]}`,
+		selectFields(`{"total":"1","items":[{"vid":1,"pid":2}]}`, "items"))
+
+	// the array result
+	require.Equal(t, `[{"pid":2},"value"]`, selectFields(`[{"vid":1,"pid":2},"value"]`, "pid"))
+
+	_, err := SelectFields([]byte(`"value"`), []string{"vid"})
+	require.Error(t, err)
+}
+
+func TestRespondWithHeight_Fields(t *testing.T) {
+	respond := func(path string, proof *merkle.Proof) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		ctx := NewRestContext(w, httptest.NewRequest(http.MethodGet, path, nil)).WithCodec(codec.New())
+		ctx.proof.proof = proof
+		ctx.RespondWithHeight([]byte(`{"vid":1,"pid":2,"name":"model"}`), 5)
+
+		return w
+	}
+
+	w := respond("/?fields=vid,name", nil)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var response map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	require.Equal(t, `{"vid":1,"name":"model"}`, string(response["result"]))
+
+	// the selected fields have their own entity tag
+	require.NotEqual(t, respond("/", nil).Header().Get(HeaderETag), w.Header().Get(HeaderETag))
+
+	// the proof verifies the whole value
+	require.Equal(t, http.StatusBadRequest, respond("/?fields=vid", &merkle.Proof{}).Code)
+}
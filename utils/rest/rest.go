@@ -15,28 +15,256 @@
 package rest
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/canonicaljson"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/export"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/idempotency"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/metrics"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/querycache"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/signer"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/simulation"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/txqueue"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/txtimeout"
 )
 
 const (
-	FlagPreviousHeight = "prev_height" // Query data from previous height to avoid delay linked to state proof verification
+	FlagPreviousHeight = "prev_height"    // Query data from previous height to avoid delay linked to state proof verification
+	FlagBroadcastMode  = "broadcast_mode" // Transaction broadcast mode to use (block, sync, async)
+	FlagProof          = "proof"          // Return merkle proof verified against the trusted validator set along with value
+	FlagFormat         = "format"         // Format of the list query result (json, csv)
+	FlagAtHeight       = "at_height"      // Query data as of the given height
+	FlagAtTime         = "at_time"        // Query data as of the given time (RFC3339)
+	FlagSimulate       = "simulate"       // Return the would-be result of the transaction without broadcasting
+	FlagFields         = "fields"         // Comma-separated list of the result attributes to return (all if empty)
+	FlagTimeoutHeight  = "timeout_height" // Block height after which the transaction is rejected by the ledger
+
+	// Pagination data of the list query result exported in CSV.
+	HeaderTotalCount = "X-Total-Count"
+	HeaderNextKey    = "X-Next-Key"
+	HeaderPrevKey    = "X-Prev-Key"
+	HeaderHeight     = "X-Height"
+
+	HeaderETag        = "ETag"
+	HeaderIfNoneMatch = "If-None-Match"
+
+	// Key of the write request: the retries of the request with the same key return the original result.
+	HeaderIdempotencyKey = "Idempotency-Key"
+	// Set if the result of the original request is returned.
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	FlagSequenceRetries      = "sequence-retries"
+	FlagSequenceRetriesUsage = "Number of times the transaction signed by the REST server is re-signed " +
+		"with the refetched account sequence if it is rejected because of the outdated one"
+	DefaultSequenceRetries = 3
+
+	FlagTxQueue      = "tx-queue"
+	FlagTxQueueUsage = "Sign and broadcast the transactions of every account one by one " +
+		"with the sequences assigned locally"
+	FlagTxQueueSize      = "tx-queue-size"
+	FlagTxQueueSizeUsage = "Maximal number of pending transactions of an account in the transaction queue"
+	DefaultTxQueueSize   = 100
+
+	FlagQueryCacheSize      = "query-cache-size"
+	FlagQueryCacheSizeUsage = "Maximal number of cached query results (0 disables the cache)"
+	FlagQueryCacheTTL       = "query-cache-ttl"
+	FlagQueryCacheTTLUsage  = "Time to live of the cached results of the queries on the latest height " +
+		"(they are invalidated earlier once a new block height is observed)"
+	DefaultQueryCacheTTL = 5 * time.Second
+
+	FlagIdempotencyCacheSize      = "idempotency-cache-size"
+	FlagIdempotencyCacheSizeUsage = "Maximal number of remembered idempotency keys of the write requests " +
+		"(0 disables them)"
+	DefaultIdempotencyCacheSize = 10000
+	FlagIdempotencyTTL          = "idempotency-ttl"
+	FlagIdempotencyTTLUsage     = "Time the results of the write requests sent with Idempotency-Key header " +
+		"are remembered for"
+	DefaultIdempotencyTTL = 24 * time.Hour
+
+	FlagReadOnly      = "read-only"
+	FlagReadOnlyUsage = "Serve only the queries: the transactions can not be signed or broadcasted " +
+		"and the keybase is not available (safe to expose publicly)"
+
+	FlagMaxBodySize      = "max-body-size"
+	FlagMaxBodySizeUsage = "Maximal size of the request body in bytes (0 disables the limit)"
+	DefaultMaxBodySize   = 1 << 20
 )
 
+// Error returned by the write requests to the read-only REST server.
+var ErrReadOnly = errors.New("read-only node: the transactions can not be signed or broadcasted by this REST server")
+
+// Error returned by the requests with the body exceeding the limit set with `--max-body-size` flag.
+var ErrRequestBodyTooLarge = errors.New("request body too large")
+
+// Whether the REST server is read-only.
+var readOnly bool
+
+// Maximal size of the request body (0 if not limited).
+var maxBodySize int64
+
+// Routes served by the read-only REST server whatever the method of the request is (see `MarkQueryRoute`).
+var queryRoutes = map[*mux.Route]bool{}
+
+// Queue of the transactions signed by the REST server (nil if disabled).
+var txQueue *txqueue.Queue
+
+// Cache of the query results (nil if disabled).
+var queryCache *querycache.Cache
+
+// Results of the write requests sent with `Idempotency-Key` header (nil if disabled).
+var idempotencyCache *idempotency.Cache
+
+// EnableQueryCache enables the cache of the query results if its size is set with `--query-cache-size` flag.
+func EnableQueryCache() {
+	queryCache = querycache.New(querycache.Config{
+		Size: viper.GetInt(FlagQueryCacheSize),
+		TTL:  viper.GetDuration(FlagQueryCacheTTL),
+	})
+}
+
+// EnableIdempotency enables `Idempotency-Key` header of the write requests
+// if the number of remembered keys is set with `--idempotency-cache-size` flag.
+func EnableIdempotency() {
+	idempotencyCache = idempotency.New(idempotency.Config{
+		Size: viper.GetInt(FlagIdempotencyCacheSize),
+		TTL:  viper.GetDuration(FlagIdempotencyTTL),
+	})
+}
+
+// EnableTxQueue enables the transaction queue if it is requested with `--tx-queue` flag.
+func EnableTxQueue() {
+	if viper.GetBool(FlagTxQueue) {
+		txQueue = txqueue.New(viper.GetInt(FlagTxQueueSize))
+	}
+}
+
+// EnableReadOnly makes the REST server read-only if it is requested with `--read-only` flag.
+func EnableReadOnly() {
+	readOnly = viper.GetBool(FlagReadOnly)
+}
+
+// EnableMaxBodySize limits the size of the request bodies to the one set with `--max-body-size` flag.
+func EnableMaxBodySize() {
+	maxBodySize = viper.GetInt64(FlagMaxBodySize)
+}
+
+// IsReadOnly returns whether the REST server is read-only.
+func IsReadOnly() bool {
+	return readOnly
+}
+
+// MarkQueryRoute marks the route as not changing the state though it is requested with POST method
+// (e.g. because the query does not fit in the URL), so it is served by the read-only REST server.
+// The routes must be marked before the server starts.
+func MarkQueryRoute(route *mux.Route) *mux.Route {
+	queryRoutes[route] = true
+
+	return route
+}
+
+// ReadOnlyMiddleware rejects the requests changing the state (all except GET, HEAD and OPTIONS ones,
+// the simulations of the transactions and the query routes) if the REST server is read-only.
+func ReadOnlyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if readOnly && r.Method != http.MethodGet && r.Method != http.MethodHead && r.Method != http.MethodOptions &&
+			!isSimulationRequested(r) && !queryRoutes[mux.CurrentRoute(r)] {
+			WriteError(w, http.StatusForbidden, ErrReadOnly)
+
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// MaxBodySizeMiddleware rejects the requests with the body exceeding the limit with 413 status.
+// The size of the chunked bodies is not known in advance, so reading them fails with ErrRequestBodyTooLarge
+// once the limit is exceeded (see `ReadRESTReq`).
+func MaxBodySizeMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if maxBodySize > 0 && r.Body != nil {
+			if r.ContentLength > maxBodySize {
+				WriteError(w, http.StatusRequestEntityTooLarge, ErrRequestBodyTooLarge)
+
+				return
+			}
+
+			r.Body = &limitedBody{ReadCloser: r.Body, remaining: maxBodySize}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Request body failing with ErrRequestBodyTooLarge once more than the remaining bytes are read.
+type limitedBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, ErrRequestBodyTooLarge
+	}
+
+	// one byte more than remaining is read to find out whether the body exceeds the limit
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+
+	if b.remaining < 0 {
+		return n + int(b.remaining), ErrRequestBodyTooLarge
+	}
+
+	return n, err
+}
+
+// AddFlags adds the flags configuring the transactions signed by the REST server to the command.
+func AddFlags(cmd *cobra.Command) *cobra.Command {
+	cmd.Flags().Int(FlagSequenceRetries, DefaultSequenceRetries, FlagSequenceRetriesUsage)
+	cmd.Flags().Bool(FlagTxQueue, false, FlagTxQueueUsage)
+	cmd.Flags().Int(FlagTxQueueSize, DefaultTxQueueSize, FlagTxQueueSizeUsage)
+	cmd.Flags().Int(FlagQueryCacheSize, 0, FlagQueryCacheSizeUsage)
+	cmd.Flags().Duration(FlagQueryCacheTTL, DefaultQueryCacheTTL, FlagQueryCacheTTLUsage)
+	cmd.Flags().Int(FlagIdempotencyCacheSize, DefaultIdempotencyCacheSize, FlagIdempotencyCacheSizeUsage)
+	cmd.Flags().Duration(FlagIdempotencyTTL, DefaultIdempotencyTTL, FlagIdempotencyTTLUsage)
+	cmd.Flags().Bool(FlagReadOnly, false, FlagReadOnlyUsage)
+	cmd.Flags().Int64(FlagMaxBodySize, DefaultMaxBodySize, FlagMaxBodySizeUsage)
+
+	return cmd
+}
+
 type BasicReq struct {
 	BaseReq rest.BaseReq `json:"base_req"`
 }
@@ -47,6 +275,38 @@ type RestContext struct {
 	request        *http.Request
 	baseReq        rest.BaseReq
 	signer         sdk.AccAddress
+	proof          *queryProof // shared between context copies to attach proof of queried value to the response
+}
+
+type queryProof struct {
+	proof *merkle.Proof
+}
+
+// Error response. The stable code of the error (see `errcodes` package) is included if the error carries it.
+type ErrorResponse struct {
+	Codespace sdk.CodespaceType `json:"codespace,omitempty"`
+	Code      sdk.CodeType      `json:"code,omitempty"`
+	Name      string            `json:"name,omitempty"`
+	Error     string            `json:"error"`
+}
+
+func NewErrorResponse(err error) ErrorResponse {
+	res := ErrorResponse{Error: err.Error()}
+
+	if errorCode, ok := errcodes.Of(err); ok {
+		res.Codespace, res.Code, res.Name = errorCode.Codespace, errorCode.Code, errorCode.Name
+	}
+
+	return res
+}
+
+// Envelope of all the query responses: the result along with the height and the chain it is read from
+// and the merkle proof of the result (if requested by `proof=true`).
+type Response struct {
+	Height  int64           `json:"height"`
+	ChainID string          `json:"chain_id"`
+	Result  json.RawMessage `json:"result"`
+	Proof   *merkle.Proof   `json:"proof,omitempty"`
 }
 
 func NewRestContext(w http.ResponseWriter, r *http.Request) RestContext {
@@ -54,6 +314,7 @@ func NewRestContext(w http.ResponseWriter, r *http.Request) RestContext {
 		context:        context.NewCLIContext(),
 		responseWriter: w,
 		request:        r,
+		proof:          &queryProof{},
 	}
 }
 
@@ -88,14 +349,14 @@ func (ctx RestContext) ResponseWriter() *http.ResponseWriter {
 func (ctx RestContext) NodeStatus() (*ctypes.ResultStatus, error) {
 	node, err := ctx.context.GetNode()
 	if err != nil {
-		rest.WriteErrorResponse(ctx.responseWriter, http.StatusInternalServerError, err.Error())
+		ctx.WriteError(http.StatusInternalServerError, err)
 
 		return nil, err
 	}
 
 	status, err := node.Status()
 	if err != nil {
-		rest.WriteErrorResponse(ctx.responseWriter, http.StatusInternalServerError, err.Error())
+		ctx.WriteError(http.StatusInternalServerError, err)
 
 		return nil, err
 	}
@@ -147,6 +408,122 @@ func (ctx RestContext) WithFormerHeight() (RestContext, error) {
 	return ctx, nil
 }
 
+// Applies the height requested by `at_height` or `at_time` parameter (if any) unless the height is already set,
+// so the state of the ledger as of the given height or time is queried.
+// The time is resolved to the height of the last block committed not later than the time.
+func (ctx RestContext) WithRequestedHeight() (RestContext, error) {
+	if ctx.context.Height != 0 {
+		return ctx, nil
+	}
+
+	atHeight, atTime := ctx.request.FormValue(FlagAtHeight), ctx.request.FormValue(FlagAtTime)
+	if len(atHeight) == 0 && len(atTime) == 0 {
+		return ctx, nil
+	}
+
+	if len(atHeight) != 0 && len(atTime) != 0 {
+		return RestContext{}, sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid request: only one of %v and %v can be passed", FlagAtHeight, FlagAtTime))
+	}
+
+	latestHeight, err := ctx.latestHeight()
+	if err != nil {
+		return RestContext{}, err
+	}
+
+	var height int64
+
+	if len(atHeight) != 0 {
+		height, err = strconv.ParseInt(atHeight, 10, 64)
+		if err != nil || height <= 0 {
+			return RestContext{}, sdk.ErrUnknownRequest(
+				fmt.Sprintf("Invalid %v: \"%v\". It must be a positive integer", FlagAtHeight, atHeight))
+		}
+
+		if height > latestHeight {
+			return RestContext{}, sdk.ErrUnknownRequest(
+				fmt.Sprintf("Invalid %v: %v. The latest height is %v", FlagAtHeight, height, latestHeight))
+		}
+	} else {
+		time_, err := time.Parse(time.RFC3339, atTime)
+		if err != nil {
+			return RestContext{}, sdk.ErrUnknownRequest(
+				fmt.Sprintf("Invalid %v: \"%v\". It must be RFC3339 encoded time", FlagAtTime, atTime))
+		}
+
+		height, err = HeightAt(time_, latestHeight, ctx.blockTime)
+		if err != nil {
+			return RestContext{}, err
+		}
+	}
+
+	ctx.context = ctx.context.WithHeight(height)
+
+	return ctx, nil
+}
+
+func (ctx RestContext) latestHeight() (int64, error) {
+	node, err := ctx.context.GetNode()
+	if err != nil {
+		return 0, err
+	}
+
+	status, err := node.Status()
+	if err != nil {
+		return 0, err
+	}
+
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+func (ctx RestContext) blockTime(height int64) (time.Time, error) {
+	info, err := ctx.BlockchainInfo(height, height)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if len(info.BlockMetas) == 0 {
+		return time.Time{}, sdk.ErrInternal(fmt.Sprintf("No block at height %v", height))
+	}
+
+	return info.BlockMetas[0].Header.Time, nil
+}
+
+// HeightAt returns the height of the last block committed not later than the given time.
+// It performs the binary search over the heights from 1 to `latestHeight` relying on the block times increasing.
+func HeightAt(at time.Time, latestHeight int64, blockTime func(height int64) (time.Time, error)) (int64, error) {
+	low, high := int64(1), latestHeight
+
+	// invariant: the block at `low` is not later than the time (checked below), the block after `high` is later
+	first, err := blockTime(low)
+	if err != nil {
+		return 0, err
+	}
+
+	if first.After(at) {
+		return 0, sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid %v: %v. The first block is committed at %v",
+				FlagAtTime, at.Format(time.RFC3339), first.Format(time.RFC3339)))
+	}
+
+	for low < high {
+		middle := low + (high-low+1)/2
+
+		middleTime, err := blockTime(middle)
+		if err != nil {
+			return 0, err
+		}
+
+		if middleTime.After(at) {
+			high = middle - 1
+		} else {
+			low = middle
+		}
+	}
+
+	return low, nil
+}
+
 func (ctx RestContext) WithSigner() (RestContext, error) {
 	from, err := sdk.AccAddressFromBech32(ctx.baseReq.From)
 	if err != nil {
@@ -161,23 +538,80 @@ func (ctx RestContext) WithSigner() (RestContext, error) {
 	return ctx, nil
 }
 
+// Applies broadcast mode passed as `broadcast_mode` request parameter (if any).
+func (ctx RestContext) WithBroadcastMode() (RestContext, error) {
+	mode := ctx.request.FormValue(FlagBroadcastMode)
+	if len(mode) == 0 {
+		return ctx, nil
+	}
+
+	if mode != flags.BroadcastBlock && mode != flags.BroadcastSync && mode != flags.BroadcastAsync {
+		err := sdk.ErrUnknownRequest(fmt.Sprintf("Invalid broadcast mode: \"%v\". It must be one of: %v, %v, %v",
+			mode, flags.BroadcastBlock, flags.BroadcastSync, flags.BroadcastAsync))
+		ctx.WriteError(http.StatusBadRequest, err)
+
+		return RestContext{}, err
+	}
+
+	ctx.context = ctx.context.WithBroadcastMode(mode)
+
+	return ctx, nil
+}
+
 func (ctx RestContext) WithBaseRequest(baseReq rest.BaseReq) (RestContext, error) {
 	ctx.baseReq = baseReq.Sanitize()
 	if !baseReq.ValidateBasic(ctx.responseWriter) {
 		return RestContext{}, sdk.ErrUnknownRequest("Base request validation failed")
 	}
 
+	// the timeout height is attached to the memo (see `utils/txtimeout`)
+	if flag := ctx.request.FormValue(FlagTimeoutHeight); len(flag) > 0 {
+		timeoutHeight, err := strconv.ParseInt(flag, 10, 64)
+		if err != nil || timeoutHeight <= 0 {
+			err := sdk.ErrUnknownRequest(fmt.Sprintf("Invalid timeout height: \"%v\". It must be a positive integer",
+				flag))
+			ctx.WriteError(http.StatusBadRequest, err)
+
+			return RestContext{}, err
+		}
+
+		ctx.baseReq.Memo = txtimeout.Memo(timeoutHeight, ctx.baseReq.Memo)
+	}
+
 	return ctx, nil
 }
 
+// ReadRESTReq reads the request body into the request. The errors are written to the response:
+// 413 if the body exceeds the limit set with `--max-body-size` flag and 400 if it is malformed.
 func (ctx RestContext) ReadRESTReq(req interface{}) bool {
-	return rest.ReadRESTReq(ctx.responseWriter, ctx.request, ctx.Codec(), req)
+	body, err := ioutil.ReadAll(ctx.request.Body)
+	if err != nil {
+		status := http.StatusBadRequest
+		if errors.Is(err, ErrRequestBodyTooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+
+		WriteError(ctx.responseWriter, status, err)
+
+		return false
+	}
+
+	if err := ctx.Codec().UnmarshalJSON(body, req); err != nil {
+		WriteError(ctx.responseWriter, http.StatusBadRequest, fmt.Errorf("failed to decode JSON payload: %w", err))
+
+		return false
+	}
+
+	return true
 }
 
 func (ctx RestContext) QueryStore(key []byte, storeName string) ([]byte, int64, error) {
-	requestPrevState := false
+	ctx, err := ctx.WithRequestedHeight()
+	if err != nil {
+		return nil, 0, err
+	}
 
-	var err error
+	requestPrevState := false
 
 	if flag := ctx.request.FormValue(FlagPreviousHeight); len(flag) > 0 {
 		requestPrevState, err = strconv.ParseBool(flag)
@@ -187,6 +621,21 @@ func (ctx RestContext) QueryStore(key []byte, storeName string) ([]byte, int64,
 		}
 	}
 
+	requestProof := false
+
+	if flag := ctx.request.FormValue(FlagProof); len(flag) > 0 {
+		requestProof, err = strconv.ParseBool(flag)
+
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	// the historical state is requested
+	if ctx.context.Height != 0 {
+		return ctx.queryStore(key, storeName, requestProof)
+	}
+
 	// Try to query row on `height-1` to avoid delay related to waiting of committing block with height + 1.
 	if requestPrevState {
 		ctx, err := ctx.WithFormerHeight()
@@ -194,7 +643,7 @@ func (ctx RestContext) QueryStore(key []byte, storeName string) ([]byte, int64,
 			return nil, 0, err
 		}
 
-		res, height, err := ctx.context.QueryStore(key, storeName)
+		res, height, err := ctx.queryStore(key, storeName, requestProof)
 		if res != nil {
 			return res, height, err
 		}
@@ -202,17 +651,110 @@ func (ctx RestContext) QueryStore(key []byte, storeName string) ([]byte, int64,
 	// request on the current height
 	ctx.context = ctx.context.WithHeight(0)
 
-	return ctx.context.QueryStore(key, storeName)
+	return ctx.queryStore(key, storeName, requestProof)
+}
+
+func (ctx RestContext) queryStore(key []byte, storeName string, requestProof bool) ([]byte, int64, error) {
+	if !requestProof {
+		return ctx.cachedQuery(fmt.Sprintf("store/%s/key", storeName), key, func() ([]byte, int64, error) {
+			return ctx.context.QueryStore(key, storeName)
+		})
+	}
+
+	return ctx.QueryStoreWithProof(key, storeName)
+}
+
+// Queries the store requesting merkle proof and verifies it against the trusted validator set.
+// The proof is attached to the response sent by `RespondWithHeight`.
+func (ctx RestContext) QueryStoreWithProof(key []byte, storeName string) ([]byte, int64, error) {
+	if ctx.context.Verifier == nil {
+		return nil, 0, sdk.ErrInternal("Proof verification is not available: " +
+			"rest-server must be started with `--trust-node=false` flag")
+	}
+
+	node, err := ctx.context.GetNode()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	opts := rpcclient.ABCIQueryOptions{
+		Height: ctx.context.Height,
+		Prove:  true,
+	}
+
+	result, err := node.ABCIQueryWithOptions(fmt.Sprintf("/store/%s/key", storeName), key, opts)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp := result.Response
+	if !resp.IsOK() {
+		return nil, 0, errors.New(resp.Log)
+	}
+
+	// the AppHash for height H is in header H+1
+	commit, err := ctx.context.Verify(resp.Height + 1)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(resp.Key, merkle.KeyEncodingURL)
+
+	proofRuntime := rootmulti.DefaultProofRuntime()
+
+	if resp.Value == nil {
+		err = proofRuntime.VerifyAbsence(resp.Proof, commit.Header.AppHash, keyPath.String())
+	} else {
+		err = proofRuntime.VerifyValue(resp.Proof, commit.Header.AppHash, keyPath.String(), resp.Value)
+	}
+
+	if err != nil {
+		return nil, 0, sdk.ErrInternal(fmt.Sprintf("Failed to verify merkle proof: %v", err))
+	}
+
+	if ctx.proof != nil {
+		ctx.proof.proof = resp.Proof
+	}
+
+	return resp.Value, resp.Height, nil
 }
 
 func (ctx RestContext) QueryWithData(path string, data interface{}) ([]byte, int64, error) {
-	return ctx.context.QueryWithData(path, ctx.context.Codec.MustMarshalJSON(data))
+	ctx, err := ctx.WithRequestedHeight()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bz := ctx.context.Codec.MustMarshalJSON(data)
+
+	return ctx.cachedQuery(path, bz, func() ([]byte, int64, error) {
+		return ctx.context.QueryWithData(path, bz)
+	})
+}
+
+// Returns the cached result of the query (if the cache is enabled) or queries the node and caches the result.
+func (ctx RestContext) cachedQuery(path string, data []byte,
+	query func() ([]byte, int64, error)) ([]byte, int64, error) {
+	if res, height, ok := queryCache.Get(path, data, ctx.context.Height); ok {
+		return res, height, nil
+	}
+
+	res, height, err := query()
+	if err != nil {
+		return res, height, err
+	}
+
+	queryCache.Set(path, data, ctx.context.Height, res, height)
+
+	return res, height, nil
 }
 
 func (ctx RestContext) QueryList(path string, params interface{}) {
 	res, height, err := ctx.QueryWithData(path, params)
 	if err != nil {
-		rest.WriteErrorResponse(ctx.responseWriter, http.StatusNotFound, err.Error())
+		ctx.WriteError(http.StatusNotFound, err)
 
 		return
 	}
@@ -220,10 +762,63 @@ func (ctx RestContext) QueryList(path string, params interface{}) {
 	ctx.RespondWithHeight(res, height)
 }
 
+// Same as QueryList, but the result can also be exported in CSV (`format=csv`).
+// `result` is a pointer to the list response type the result is decoded into: its items are written as rows
+// (see `export` package for the columns) and its pagination data is passed in the response headers.
+func (ctx RestContext) QueryExportableList(path string, params interface{}, result interface{}) {
+	format, err := export.ParseFormat(ctx.request.FormValue(FlagFormat))
+	if err != nil {
+		ctx.WriteError(http.StatusBadRequest, sdk.ErrUnknownRequest(err.Error()))
+
+		return
+	}
+
+	if format != export.FormatCSV {
+		ctx.QueryList(path, params)
+
+		return
+	}
+
+	res, height, err := ctx.QueryWithData(path, params)
+	if err != nil {
+		ctx.WriteError(http.StatusNotFound, err)
+
+		return
+	}
+
+	if err := ctx.Codec().UnmarshalJSON(res, result); err != nil {
+		ctx.WriteError(http.StatusInternalServerError, err)
+
+		return
+	}
+
+	list, err := export.ListOf(result)
+	if err != nil {
+		ctx.WriteError(http.StatusInternalServerError, err)
+
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := export.WriteCSV(&buf, list.Items); err != nil {
+		ctx.WriteError(http.StatusInternalServerError, err)
+
+		return
+	}
+
+	ctx.responseWriter.Header().Set("Content-Type", export.ContentTypeCSV)
+	ctx.responseWriter.Header().Set(HeaderTotalCount, strconv.Itoa(list.Total))
+	ctx.responseWriter.Header().Set(HeaderNextKey, list.NextKey)
+	ctx.responseWriter.Header().Set(HeaderPrevKey, list.PrevKey)
+	ctx.responseWriter.Header().Set(HeaderHeight, strconv.FormatInt(height, 10))
+	ctx.responseWriter.WriteHeader(http.StatusOK)
+	_, _ = ctx.responseWriter.Write(buf.Bytes())
+}
+
 func (ctx RestContext) EncodeAndRespondWithHeight(data interface{}, height int64) {
 	out, err := json.Marshal(data)
 	if err != nil {
-		rest.WriteErrorResponse(ctx.responseWriter, http.StatusInternalServerError, err.Error())
+		ctx.WriteError(http.StatusInternalServerError, err)
 
 		return
 	}
@@ -234,7 +829,7 @@ func (ctx RestContext) EncodeAndRespondWithHeight(data interface{}, height int64
 func (ctx RestContext) ParsePaginationParams() (pagination.PaginationParams, error) {
 	paginationParams, err := pagination.ParsePaginationParamsFromRequest(ctx.request)
 	if err != nil {
-		rest.WriteErrorResponse(ctx.responseWriter, http.StatusBadRequest, err.Error())
+		ctx.WriteError(http.StatusBadRequest, err)
 
 		return pagination.PaginationParams{}, err
 	}
@@ -246,49 +841,232 @@ func (ctx RestContext) PostProcessResponseBare(body interface{}) {
 	rest.PostProcessResponseBare(ctx.responseWriter, ctx.context, body)
 }
 
+// Same as RespondWithHeight for the height of the context.
 func (ctx RestContext) PostProcessResponse(body interface{}) {
-	rest.PostProcessResponse(ctx.responseWriter, ctx.context, body)
+	ctx.RespondWithHeight(body, ctx.context.Height)
 }
 
 func (ctx RestContext) BasicAuth() (username, password string, ok bool) {
 	return ctx.request.BasicAuth()
 }
 
-func (ctx RestContext) HandleWriteRequest(msg sdk.Msg) {
-	err := msg.ValidateBasic()
-	if err != nil {
-		ctx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+// Validates messages and either generates a transaction containing them (no credentials passed)
+// or signs and broadcasts them as a single transaction.
+func (ctx RestContext) HandleWriteRequest(msgs ...sdk.Msg) {
+	simulate := ctx.baseReq.Simulate || isSimulationRequested(ctx.request)
+
+	if readOnly && !simulate {
+		ctx.WriteError(http.StatusForbidden, ErrReadOnly)
+
+		return
+	}
+
+	if len(msgs) == 0 {
+		ctx.WriteErrorResponse(http.StatusBadRequest, "Invalid request: it must contain at least one message")
+
+		return
+	}
+
+	for _, msg := range msgs {
+		err := msg.ValidateBasic()
+		if err != nil {
+			ctx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+	}
+
+	if simulate { // Simulation is requested - neither sign nor broadcast message
+		ctx.simulate(msgs)
 
 		return
 	}
 
 	account, passphrase, ok := ctx.BasicAuth()
 	if !ok { // No credentials - just generate request message
-		utils.WriteGenerateStdTxResponse(ctx.responseWriter, ctx.context, ctx.baseReq, []sdk.Msg{msg})
+		utils.WriteGenerateStdTxResponse(ctx.responseWriter, ctx.context, ctx.baseReq, msgs)
 
 		return
 	}
 
 	// Credentials are found - sign and broadcast message
-	res, err_ := ctx.SignAndBroadcastMessage(account, passphrase, []sdk.Msg{msg})
+	ctx, err := ctx.WithBroadcastMode()
+	if err != nil {
+		return
+	}
+
+	fingerprint := types.StdSignBytes(ctx.baseReq.ChainID, 0, 0, types.StdFee{}, msgs, ctx.baseReq.Memo)
+
+	res, err_ := ctx.idempotent(account, fingerprint, func() (sdk.TxResponse, error) {
+		return ctx.SignAndBroadcast(account, passphrase, msgs)
+	})
+	if err_ == txqueue.ErrQueueFull {
+		ctx.WriteError(http.StatusTooManyRequests, err_)
+
+		return
+	}
+
+	if err_ == idempotency.ErrKeyReused {
+		ctx.WriteError(http.StatusUnprocessableEntity, err_)
+
+		return
+	}
+
 	if err_ != nil {
-		rest.WriteErrorResponse(ctx.responseWriter, http.StatusInternalServerError, err_.Error())
+		ctx.WriteError(http.StatusInternalServerError, err_)
 
 		return
 	}
 
-	rest.PostProcessResponse(ctx.responseWriter, ctx.context, res)
+	ctx.PostProcessResponse(res)
 }
 
+// Whether the simulation of the transaction is requested with `simulate` request parameter.
+func isSimulationRequested(r *http.Request) bool {
+	simulate, err := strconv.ParseBool(r.URL.Query().Get(FlagSimulate))
+
+	return err == nil && simulate
+}
+
+// Simulates the transaction of the signer (`base_req.from`) and writes the result it would have
+// and the estimated gas. The transaction is neither signed nor broadcasted, so no credentials are needed.
+func (ctx RestContext) simulate(msgs []sdk.Msg) {
+	txBldr, err := ctx.TxnBuilder()
+	if err != nil {
+		ctx.WriteError(http.StatusInternalServerError, err)
+
+		return
+	}
+
+	res, err := simulation.Simulate(ctx.context, txBldr, msgs)
+	if err != nil {
+		ctx.WriteError(http.StatusInternalServerError, err)
+
+		return
+	}
+
+	ctx.PostProcessResponse(res)
+}
+
+// Writes the query result in the response envelope (see Response). The result is either JSON bytes
+// or a value encoded by the codec.
 func (ctx RestContext) RespondWithHeight(out interface{}, height int64) {
-	ctx.context = ctx.context.WithHeight(height)
-	rest.PostProcessResponse(ctx.responseWriter, ctx.context, out)
+	if height < 0 {
+		ctx.WriteErrorResponse(http.StatusInternalServerError, "negative height in response")
+
+		return
+	}
+
+	result, ok := out.([]byte)
+	if !ok {
+		var err error
+
+		result, err = ctx.marshalJSON(out)
+		if err != nil {
+			ctx.WriteError(http.StatusInternalServerError, err)
+
+			return
+		}
+	}
+
+	if fields := ParseFields(ctx.request.FormValue(FlagFields)); len(fields) != 0 {
+		// the proof verifies the whole value
+		if ctx.proof != nil && ctx.proof.proof != nil {
+			ctx.WriteError(http.StatusBadRequest,
+				sdk.ErrUnknownRequest("The fields can not be selected from the result returned with the proof"))
+
+			return
+		}
+
+		var err error
+
+		if result, err = SelectFields(result, fields); err != nil {
+			ctx.WriteError(http.StatusBadRequest, sdk.ErrUnknownRequest(err.Error()))
+
+			return
+		}
+	}
+
+	response := Response{Height: height, ChainID: viper.GetString(flags.FlagChainID), Result: result}
+	if ctx.proof != nil {
+		response.Proof = ctx.proof.proof
+	}
+
+	// the proof is bound to the height, so only the responses without it can be reused by the clients
+	if ctx.request.Method == http.MethodGet && response.Proof == nil {
+		tag := ETag(result)
+
+		ctx.responseWriter.Header().Set(HeaderETag, tag)
+		ctx.responseWriter.Header().Set(HeaderHeight, strconv.FormatInt(height, 10))
+
+		if MatchesETag(ctx.request.Header.Get(HeaderIfNoneMatch), tag) {
+			ctx.responseWriter.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+	}
+
+	output, err := ctx.marshalJSON(response)
+	if err != nil {
+		ctx.WriteError(http.StatusInternalServerError, err)
+
+		return
+	}
+
+	ctx.responseWriter.Header().Set("Content-Type", "application/json")
+	_, _ = ctx.responseWriter.Write(output)
+}
+
+// ETag returns the weak entity tag of the query result. It depends only on the result (not on the height),
+// so the clients polling the same value get `304 Not Modified` until the value is changed.
+func ETag(result []byte) string {
+	hash := sha256.Sum256(result)
+
+	return fmt.Sprintf(`W/"%x"`, hash[:16])
+}
+
+// MatchesETag returns whether `If-None-Match` header value matches the entity tag (using the weak comparison).
+func MatchesETag(ifNoneMatch string, etag string) bool {
+	if len(ifNoneMatch) == 0 {
+		return false
+	}
+
+	if strings.TrimSpace(ifNoneMatch) == "*" {
+		return true
+	}
+
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (ctx RestContext) marshalJSON(value interface{}) ([]byte, error) {
+	if ctx.context.Indent {
+		return ctx.Codec().MarshalJSONIndent(value, "", "  ")
+	}
+
+	return ctx.Codec().MarshalJSON(value)
 }
 
 func (ctx RestContext) WriteErrorResponse(status int, err string) {
 	rest.WriteErrorResponse(ctx.responseWriter, status, err)
 }
 
+// Writes the error response including the stable code of the error (if any).
+func (ctx RestContext) WriteError(status int, err error) {
+	WriteError(ctx.responseWriter, status, err)
+}
+
+func WriteError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(codec.Cdc.MustMarshalJSON(NewErrorResponse(err)))
+}
+
 func (ctx RestContext) TxnBuilder() (types.TxBuilder, error) {
 	txBldr := auth.NewTxBuilderFromCLI()
 
@@ -305,26 +1083,39 @@ func (ctx RestContext) TxnBuilder() (types.TxBuilder, error) {
 		sequence = acc.GetSequence()
 	}
 
+	kb, err := signer.NewKeybaseFromConfig(txBldr.Keybase())
+	if err != nil {
+		return txBldr, err
+	}
+
 	txBldr = txBldr.
+		WithKeybase(kb).
 		WithTxEncoder(utils.GetTxEncoder(ctx.Codec())).
 		WithAccountNumber(accountNumber).
 		WithSequence(sequence).
-		WithChainID(ctx.baseReq.ChainID)
+		WithChainID(ctx.baseReq.ChainID).
+		WithMemo(ctx.baseReq.Memo)
 
 	return txBldr, nil
 }
 
 func (ctx RestContext) SignMessage(name string, passphrase string, msg []sdk.Msg) ([]byte, error) {
+	if readOnly {
+		return nil, ErrReadOnly
+	}
+
 	txBldr, err := ctx.TxnBuilder()
 	if err != nil {
 		return nil, err
 	}
 
-	return txBldr.BuildAndSign(name, passphrase, msg)
+	return canonicaljson.BuildAndSign(txBldr, name, passphrase, msg)
 }
 
 func (ctx RestContext) BroadcastMessage(message []byte) ([]byte, error) {
-	res, err := ctx.context.BroadcastTx(message)
+	res, err := ctx.idempotent("", message, func() (sdk.TxResponse, error) {
+		return ctx.broadcast(message)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -337,11 +1128,155 @@ func (ctx RestContext) BroadcastMessage(message []byte) ([]byte, error) {
 	return txBytes, nil
 }
 
+func (ctx RestContext) broadcast(message []byte) (sdk.TxResponse, error) {
+	if readOnly {
+		return sdk.TxResponse{}, ErrReadOnly
+	}
+
+	res, err := ctx.context.BroadcastTx(message)
+	if err != nil {
+		metrics.REST().ObserveBroadcastError()
+
+		return sdk.TxResponse{}, err
+	}
+
+	metrics.REST().ObserveBroadcastResult(res.Code, res.Codespace)
+	queryCache.ObserveHeight(res.Height)
+
+	return res, nil
+}
+
+// Broadcasts the transaction once per `Idempotency-Key` header value of the account (if the header is set).
+// The retries of the request return the original result and have `Idempotent-Replayed` header set.
+func (ctx RestContext) idempotent(account string, fingerprint []byte,
+	broadcast func() (sdk.TxResponse, error)) (sdk.TxResponse, error) {
+	key := ctx.request.Header.Get(HeaderIdempotencyKey)
+	if len(key) == 0 {
+		return broadcast()
+	}
+
+	res, replayed, err := idempotencyCache.Do(account+"/"+key, fingerprint, broadcast)
+	if replayed {
+		ctx.responseWriter.Header().Set(HeaderIdempotentReplayed, "true")
+	}
+
+	return res, err
+}
+
+// Signs and broadcasts the messages as a single transaction.
+// If the transaction is rejected because of the outdated account sequence (e.g. concurrent requests
+// of the same account), it is signed again with the refetched sequence at most `--sequence-retries` times.
+// The sequence passed explicitly in `base_req` is never changed.
+// If the transaction queue is enabled, the transactions of the account are signed and broadcasted one by one
+// with the sequences assigned locally.
 func (ctx RestContext) SignAndBroadcastMessage(account string, passphrase string, msg []sdk.Msg) ([]byte, error) {
-	signedMsg, err := ctx.SignMessage(account, passphrase, msg)
+	res, err := ctx.SignAndBroadcast(account, passphrase, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	return ctx.BroadcastMessage(signedMsg)
+	return ctx.Codec().MarshalJSON(res)
+}
+
+// Same as SignAndBroadcastMessage but returns the broadcast result as is.
+func (ctx RestContext) SignAndBroadcast(account string, passphrase string, msg []sdk.Msg) (sdk.TxResponse, error) {
+	if txQueue == nil || ctx.baseReq.Sequence != 0 {
+		return ctx.signAndBroadcast(account, passphrase, msg, nil)
+	}
+
+	var (
+		res sdk.TxResponse
+		err error
+	)
+
+	err_ := txQueue.Do(ctx.signer, func(sequence *txqueue.Sequence) {
+		res, err = ctx.signAndBroadcast(account, passphrase, msg, sequence)
+	})
+	if err_ != nil {
+		return sdk.TxResponse{}, err_
+	}
+
+	return res, err
+}
+
+// The locally tracked sequence (if any) is used instead of the one fetched from the ledger and updated.
+func (ctx RestContext) signAndBroadcast(account string, passphrase string, msg []sdk.Msg,
+	sequence *txqueue.Sequence) (sdk.TxResponse, error) {
+	if readOnly {
+		return sdk.TxResponse{}, ErrReadOnly
+	}
+
+	txBldr, err := ctx.TxnBuilder()
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	if sequence != nil {
+		if value, ok := sequence.Get(); ok {
+			txBldr = txBldr.WithSequence(value)
+		}
+	}
+
+	retries := viper.GetInt(FlagSequenceRetries)
+	if ctx.baseReq.Sequence != 0 {
+		retries = 0
+	}
+
+	for attempt := 0; ; attempt++ {
+		signedMsg, err := canonicaljson.BuildAndSign(txBldr, account, passphrase, msg)
+		if err != nil {
+			return sdk.TxResponse{}, err
+		}
+
+		res, err := ctx.broadcast(signedMsg)
+		if err != nil {
+			if sequence != nil {
+				sequence.Reset()
+			}
+
+			return sdk.TxResponse{}, err
+		}
+
+		if sequence != nil {
+			if IsAccepted(res) {
+				sequence.Set(txBldr.Sequence() + 1)
+			} else {
+				sequence.Reset()
+			}
+		}
+
+		if attempt >= retries || !IsSequenceMismatch(res) {
+			return res, nil
+		}
+
+		acc, err := auth.NewAccountRetriever(ctx.context).GetAccount(ctx.signer)
+		if err != nil {
+			return sdk.TxResponse{}, err
+		}
+
+		txBldr = txBldr.WithSequence(nextSequence(txBldr.Sequence(), acc.GetSequence()))
+	}
+}
+
+// Checks whether the transaction has incremented the account sequence:
+// it is accepted to the mempool or included in a block (even if its messages have failed).
+func IsAccepted(res sdk.TxResponse) bool {
+	return sdk.CodeType(res.Code) == sdk.CodeOK || res.Height > 0
+}
+
+// Checks whether the transaction is rejected because it is signed for a wrong account sequence.
+func IsSequenceMismatch(res sdk.TxResponse) bool {
+	return sdk.CodespaceType(res.Codespace) == sdk.CodespaceRoot &&
+		(sdk.CodeType(res.Code) == sdk.CodeInvalidSequence ||
+			(sdk.CodeType(res.Code) == sdk.CodeUnauthorized && strings.Contains(res.RawLog, "account sequence")))
+}
+
+// Returns the sequence to retry the transaction with. The fetched sequence is the committed one,
+// so if it is not ahead of the rejected one, the account has transactions pending in the mempool.
+func nextSequence(rejected uint64, fetched uint64) uint64 {
+	if fetched > rejected {
+		return fetched
+	}
+
+	return rejected + 1
 }
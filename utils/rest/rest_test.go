@@ -0,0 +1,246 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+func TestIsSequenceMismatch(t *testing.T) {
+	res := sdk.TxResponse{
+		Codespace: string(sdk.CodespaceRoot),
+		Code:      uint32(sdk.CodeUnauthorized),
+		RawLog:    "Signature verification failed; verify correct account sequence and chain-id",
+	}
+	require.True(t, IsSequenceMismatch(res))
+
+	res = sdk.TxResponse{Codespace: string(sdk.CodespaceRoot), Code: uint32(sdk.CodeInvalidSequence)}
+	require.True(t, IsSequenceMismatch(res))
+
+	// not enough permissions
+	res = sdk.TxResponse{Codespace: string(sdk.CodespaceRoot), Code: uint32(sdk.CodeUnauthorized), RawLog: "no role"}
+	require.False(t, IsSequenceMismatch(res))
+
+	// accepted
+	require.False(t, IsSequenceMismatch(sdk.TxResponse{}))
+}
+
+func TestNextSequence(t *testing.T) {
+	// transactions of the account are committed
+	require.Equal(t, uint64(7), nextSequence(5, 7))
+
+	// transactions of the account are pending in the mempool
+	require.Equal(t, uint64(6), nextSequence(5, 5))
+	require.Equal(t, uint64(6), nextSequence(5, 3))
+}
+
+func TestHeightAt(t *testing.T) {
+	genesis := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// blocks are committed every 5 seconds
+	blockTime := func(height int64) (time.Time, error) {
+		return genesis.Add(time.Duration(height-1) * 5 * time.Second), nil
+	}
+
+	cases := map[time.Duration]int64{
+		0:                1,
+		4 * time.Second:  1,
+		5 * time.Second:  2,
+		47 * time.Second: 10,
+		time.Hour:        100,
+	}
+
+	for offset, expected := range cases {
+		height, err := HeightAt(genesis.Add(offset), 100, blockTime)
+		require.NoError(t, err)
+		require.Equal(t, expected, height)
+	}
+
+	// before the first block
+	_, err := HeightAt(genesis.Add(-time.Second), 100, blockTime)
+	require.Error(t, err)
+}
+
+func TestReadOnlyMiddleware(t *testing.T) {
+	handler := ReadOnlyMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	serve := func(method string) int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, "/modelinfo/models", nil))
+
+		return w.Code
+	}
+
+	require.Equal(t, http.StatusOK, serve(http.MethodPost))
+
+	readOnly = true
+	defer func() { readOnly = false }()
+
+	require.Equal(t, http.StatusOK, serve(http.MethodGet))
+	require.Equal(t, http.StatusOK, serve(http.MethodOptions))
+	require.Equal(t, http.StatusForbidden, serve(http.MethodPost))
+	require.Equal(t, http.StatusForbidden, serve(http.MethodPut))
+	require.Equal(t, http.StatusForbidden, serve(http.MethodDelete))
+
+	// the simulation of a transaction is allowed
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/modelinfo/models?simulate=true", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// the query routes are allowed
+	router := mux.NewRouter()
+	router.Use(ReadOnlyMiddleware)
+	router.HandleFunc("/modelinfo/models", func(w http.ResponseWriter, r *http.Request) {}).Methods(http.MethodPost)
+	MarkQueryRoute(router.HandleFunc("/ocsp", func(w http.ResponseWriter, r *http.Request) {}).Methods(http.MethodPost))
+
+	for path, expected := range map[string]int{"/modelinfo/models": http.StatusForbidden, "/ocsp": http.StatusOK} {
+		w = httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, nil))
+		require.Equal(t, expected, w.Code)
+	}
+}
+
+func TestRespondWithHeight(t *testing.T) {
+	viper.Set(flags.FlagChainID, "test-chain")
+	defer viper.Set(flags.FlagChainID, "")
+
+	respond := func(out interface{}, proof *merkle.Proof) map[string]json.RawMessage {
+		w := httptest.NewRecorder()
+		ctx := NewRestContext(w, httptest.NewRequest(http.MethodGet, "/", nil)).WithCodec(codec.New())
+		ctx.proof.proof = proof
+		ctx.RespondWithHeight(out, 5)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+		var response map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+
+		return response
+	}
+
+	// encoded result
+	response := respond(struct {
+		Name string `json:"name"`
+	}{Name: "model"}, nil)
+	require.Equal(t, `"5"`, string(response["height"]))
+	require.Equal(t, `"test-chain"`, string(response["chain_id"]))
+	require.Equal(t, `{"name":"model"}`, string(response["result"]))
+	require.NotContains(t, response, "proof")
+
+	// raw result with proof
+	response = respond([]byte(`[1,2]`), &merkle.Proof{})
+	require.Equal(t, `[1,2]`, string(response["result"]))
+	require.Contains(t, response, "proof")
+}
+
+func TestRespondWithHeight_ETag(t *testing.T) {
+	respond := func(result string, height int64, ifNoneMatch string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.Header.Set(HeaderIfNoneMatch, ifNoneMatch)
+
+		NewRestContext(w, r).WithCodec(codec.New()).RespondWithHeight([]byte(result), height)
+
+		return w
+	}
+
+	w := respond(`{"state":"certified"}`, 5, "")
+	require.Equal(t, http.StatusOK, w.Code)
+
+	tag := w.Header().Get(HeaderETag)
+	require.NotEmpty(t, tag)
+
+	// the same value on a newer height
+	w = respond(`{"state":"certified"}`, 6, tag)
+	require.Equal(t, http.StatusNotModified, w.Code)
+	require.Empty(t, w.Body.Bytes())
+	require.Equal(t, tag, w.Header().Get(HeaderETag))
+	require.Equal(t, "6", w.Header().Get(HeaderHeight))
+
+	// the value is changed
+	w = respond(`{"state":"revoked"}`, 7, tag)
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotEqual(t, tag, w.Header().Get(HeaderETag))
+}
+
+func TestMatchesETag(t *testing.T) {
+	tag := ETag([]byte("value"))
+
+	require.True(t, MatchesETag(tag, tag))
+	require.True(t, MatchesETag(strings.TrimPrefix(tag, "W/"), tag))
+	require.True(t, MatchesETag(`"other", `+tag, tag))
+	require.True(t, MatchesETag("*", tag))
+	require.False(t, MatchesETag("", tag))
+	require.False(t, MatchesETag(ETag([]byte("other")), tag))
+}
+
+func TestMaxBodySizeMiddleware(t *testing.T) {
+	maxBodySize = 12
+	defer func() { maxBodySize = 0 }()
+
+	handler := MaxBodySizeMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Name string `json:"name"`
+		}
+
+		restCtx := NewRestContext(w, r).WithCodec(codec.New())
+		if restCtx.ReadRESTReq(&req) {
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+
+	serve := func(body string, chunked bool) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/modelinfo/models", strings.NewReader(body))
+		if chunked {
+			r.ContentLength = -1
+		}
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		return w
+	}
+
+	require.Equal(t, http.StatusOK, serve(`{"name":"a"}`, false).Code)
+	require.Equal(t, http.StatusOK, serve(`{"name":"a"}`, true).Code)
+	require.Equal(t, http.StatusBadRequest, serve(`{"name":`, false).Code)
+
+	// the oversized body is rejected whether its size is known in advance or not
+	for _, chunked := range []bool{false, true} {
+		w := serve(`{"name":"ab"}`, chunked)
+		require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+		var res ErrorResponse
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Equal(t, ErrRequestBodyTooLarge.Error(), res.Error)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package restserver
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/lcd"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/rakyll/statik/fs"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/log"
+	rpcserver "github.com/tendermint/tendermint/rpc/lib/server"
+)
+
+const (
+	FlagTLSCert          = "tls-cert"
+	FlagTLSCertUsage     = "Path to PEM encoded certificate (chain) the REST server uses to serve HTTPS"
+	FlagTLSKey           = "tls-key"
+	FlagTLSKeyUsage      = "Path to PEM encoded private key of the certificate"
+	FlagTLSClientCA      = "tls-client-ca"
+	FlagTLSClientCAUsage = "Path to PEM encoded CA certificates verifying the client certificates. " +
+		"If set, the clients must authenticate with a certificate issued by one of them (mutual TLS)"
+)
+
+type TLSConfig struct {
+	CertFile     string
+	KeyFile      string
+	ClientCAFile string
+}
+
+func (c TLSConfig) Enabled() bool {
+	return len(c.CertFile) != 0 || len(c.KeyFile) != 0
+}
+
+func TLSConfigFromFlags() TLSConfig {
+	return TLSConfig{
+		CertFile:     viper.GetString(FlagTLSCert),
+		KeyFile:      viper.GetString(FlagTLSKey),
+		ClientCAFile: viper.GetString(FlagTLSClientCA),
+	}
+}
+
+// Builds the TLS configuration of the server.
+func (c TLSConfig) Build() (*tls.Config, error) {
+	if len(c.CertFile) == 0 || len(c.KeyFile) == 0 {
+		return nil, fmt.Errorf("both --%s and --%s must be specified to serve HTTPS", FlagTLSCert, FlagTLSKey)
+	}
+
+	cert, err := tls.LoadX509KeyPair(c.CertFile, c.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+
+	if len(c.ClientCAFile) != 0 {
+		pem, err := ioutil.ReadFile(c.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA certificates: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no client CA certificates found in %s", c.ClientCAFile)
+		}
+
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return config, nil
+}
+
+// ServeCommand extends the light-client daemon command of Cosmos SDK with serving HTTPS.
+// The plain HTTP server of Cosmos SDK is started if no TLS certificate is configured.
+func ServeCommand(cdc *codec.Codec, registerRoutesFn func(*lcd.RestServer)) *cobra.Command {
+	cmd := lcd.ServeCommand(cdc, registerRoutesFn)
+	serveHTTP := cmd.RunE
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		tlsConfig := TLSConfigFromFlags()
+		if !tlsConfig.Enabled() {
+			return serveHTTP(cmd, args)
+		}
+
+		config, err := tlsConfig.Build()
+		if err != nil {
+			return err
+		}
+
+		rs := lcd.NewRestServer(cdc)
+
+		registerRoutesFn(rs)
+
+		if err := registerSwaggerUI(rs); err != nil {
+			return err
+		}
+
+		return start(rs, config)
+	}
+
+	cmd.Flags().String(FlagTLSCert, "", FlagTLSCertUsage)
+	cmd.Flags().String(FlagTLSKey, "", FlagTLSKeyUsage)
+	cmd.Flags().String(FlagTLSClientCA, "", FlagTLSClientCAUsage)
+
+	return cmd
+}
+
+// Starts the REST server accepting TLS connections only.
+func start(rs *lcd.RestServer, config *tls.Config) error {
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout)).With("module", "rest-server")
+
+	cfg := rpcserver.DefaultConfig()
+	cfg.MaxOpenConnections = viper.GetInt(flags.FlagMaxOpenConnections)
+	cfg.ReadTimeout = time.Duration(viper.GetInt(flags.FlagRPCReadTimeout)) * time.Second
+	cfg.WriteTimeout = time.Duration(viper.GetInt(flags.FlagRPCWriteTimeout)) * time.Second
+
+	listener, err := rpcserver.Listen(viper.GetString(flags.FlagListenAddr), cfg)
+	if err != nil {
+		return err
+	}
+
+	server.TrapSignal(func() {
+		err := listener.Close()
+		logger.Error("error closing listener", "err", err)
+	})
+
+	logger.Info(fmt.Sprintf("Starting application REST service over HTTPS (chain-id: %q, client authentication: %v)...",
+		viper.GetString(flags.FlagChainID), config.ClientAuth == tls.RequireAndVerifyClientCert))
+
+	return rpcserver.StartHTTPServer(tls.NewListener(listener, config), rs.Mux, logger, cfg)
+}
+
+// Same as the one registered by Cosmos SDK for HTTP server.
+func registerSwaggerUI(rs *lcd.RestServer) error {
+	statikFS, err := fs.New()
+	if err != nil {
+		return err
+	}
+
+	staticServer := http.FileServer(statikFS)
+	rs.Mux.PathPrefix("/swagger-ui/").Handler(http.StripPrefix("/swagger-ui/", staticServer))
+
+	return nil
+}
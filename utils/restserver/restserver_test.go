@@ -0,0 +1,190 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package restserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	tlsCert tls.Certificate
+}
+
+// issues the certificate signed by the parent one (self-signed if parent is nil).
+func issueCert(t *testing.T, commonName string, parent *testCert) testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  parent == nil,
+	}
+
+	issuer, signer := template, key
+	if parent != nil {
+		issuer, signer = parent.cert, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, &key.PublicKey, signer)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return testCert{cert: cert, key: key, tlsCert: tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}}
+}
+
+func writePEM(t *testing.T, dir string, name string, cert testCert) (string, string) {
+	keyDer, err := x509.MarshalECPrivateKey(cert.key)
+	require.NoError(t, err)
+
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+
+	require.NoError(t, ioutil.WriteFile(certFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.cert.Raw}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile,
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+
+	return certFile, keyFile
+}
+
+func startServer(t *testing.T, config *tls.Config) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = config
+	server.StartTLS()
+
+	return server
+}
+
+func get(server *httptest.Server, serverCA testCert, clientCert *testCert) (*http.Response, error) {
+	pool := x509.NewCertPool()
+	pool.AddCert(serverCA.cert)
+
+	config := &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	if clientCert != nil {
+		config.Certificates = []tls.Certificate{clientCert.tlsCert}
+	}
+
+	client := &http.Client{Transport: &http.Transport{TLSClientConfig: config}}
+
+	return client.Get(server.URL)
+}
+
+func TestTLSConfig_Build(t *testing.T) {
+	dir, err := ioutil.TempDir("", "restserver")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	serverCert := issueCert(t, "server", nil)
+	certFile, keyFile := writePEM(t, dir, "server", serverCert)
+
+	config, err := TLSConfig{CertFile: certFile, KeyFile: keyFile}.Build()
+	require.NoError(t, err)
+
+	server := startServer(t, config)
+	defer server.Close()
+
+	resp, err := get(server, serverCert, nil)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+}
+
+func TestTLSConfig_Build_MutualTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "restserver")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	serverCert := issueCert(t, "server", nil)
+	certFile, keyFile := writePEM(t, dir, "server", serverCert)
+
+	clientCA := issueCert(t, "client-ca", nil)
+	clientCAFile, _ := writePEM(t, dir, "client-ca", clientCA)
+	clientCert := issueCert(t, "client", &clientCA)
+	otherClientCert := issueCert(t, "other-client", nil)
+
+	config, err := TLSConfig{CertFile: certFile, KeyFile: keyFile, ClientCAFile: clientCAFile}.Build()
+	require.NoError(t, err)
+
+	server := startServer(t, config)
+	defer server.Close()
+
+	// client certificate issued by the CA
+	resp, err := get(server, serverCert, &clientCert)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+
+	// no client certificate
+	_, err = get(server, serverCert, nil)
+	require.Error(t, err)
+
+	// client certificate issued by other CA
+	_, err = get(server, serverCert, &otherClientCert)
+	require.Error(t, err)
+}
+
+func TestTLSConfig_Build_Invalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "restserver")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	certFile, keyFile := writePEM(t, dir, "server", issueCert(t, "server", nil))
+
+	// key is missing
+	_, err = TLSConfig{CertFile: certFile}.Build()
+	require.Error(t, err)
+
+	// files are not found
+	_, err = TLSConfig{CertFile: filepath.Join(dir, "unknown.crt"), KeyFile: keyFile}.Build()
+	require.Error(t, err)
+
+	// client CA file is not PEM
+	_, err = TLSConfig{CertFile: certFile, KeyFile: keyFile, ClientCAFile: keyFile}.Build()
+	require.Error(t, err)
+}
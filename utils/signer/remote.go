@@ -0,0 +1,120 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signer
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// Timeout of a request to the signing service.
+const remoteSignerTimeout = 30 * time.Second
+
+// Request to the signing service.
+type SignRequest struct {
+	KeyName   string `json:"key_name"`
+	SignBytes string `json:"sign_bytes"` // base64 encoded
+}
+
+// Response of the signing service.
+type SignResponse struct {
+	Signature string `json:"signature"` // base64 encoded
+	PubKey    string `json:"pub_key"`   // bech32 encoded account public key
+}
+
+// RemoteSigner signs by the keys kept in HSM (PKCS#11) or cloud KMS behind a signing service,
+// so that the private keys never leave the secure hardware.
+// The signing service accepts `POST` of SignRequest and responds with SignResponse.
+// The passphrase (if any) is passed as the password of HTTP basic authentication along with the key name.
+type RemoteSigner struct {
+	url    string
+	client *http.Client
+}
+
+func NewRemoteSigner(url string) (RemoteSigner, error) {
+	if len(url) == 0 {
+		return RemoteSigner{}, fmt.Errorf("signing service URL is not specified (use --%v flag)", FlagSignerURL)
+	}
+
+	return RemoteSigner{
+		url:    url,
+		client: &http.Client{Timeout: remoteSignerTimeout},
+	}, nil
+}
+
+func (s RemoteSigner) Sign(name string, passphrase string, msg []byte) ([]byte, crypto.PubKey, error) {
+	body, err := json.Marshal(SignRequest{
+		KeyName:   name,
+		SignBytes: base64.StdEncoding.EncodeToString(msg),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	if len(passphrase) != 0 {
+		req.SetBasicAuth(name, passphrase)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("signing service responded with status %v: %s", resp.StatusCode, respBody)
+	}
+
+	var res SignResponse
+	if err := json.Unmarshal(respBody, &res); err != nil {
+		return nil, nil, fmt.Errorf("invalid response of signing service: %v", err)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(res.Signature)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid signature returned by signing service: %v", err)
+	}
+
+	pubKey, err := sdk.GetAccPubKeyBech32(res.PubKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid public key returned by signing service: %v", err)
+	}
+
+	if !pubKey.VerifyBytes(msg, signature) {
+		return nil, nil, fmt.Errorf("signature returned by signing service does not match the public key")
+	}
+
+	return signature, pubKey, nil
+}
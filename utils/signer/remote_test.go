@@ -0,0 +1,91 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package signer
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// starts the signing service holding the given key.
+func startSigningService(t *testing.T, keyName string, signKey secp256k1.PrivKeySecp256k1,
+	responseKey secp256k1.PrivKeySecp256k1) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SignRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		if req.KeyName != keyName {
+			http.Error(w, "unknown key", http.StatusNotFound)
+
+			return
+		}
+
+		msg, err := base64.StdEncoding.DecodeString(req.SignBytes)
+		require.NoError(t, err)
+
+		signature, err := signKey.Sign(msg)
+		require.NoError(t, err)
+
+		_ = json.NewEncoder(w).Encode(SignResponse{
+			Signature: base64.StdEncoding.EncodeToString(signature),
+			PubKey:    sdk.MustBech32ifyAccPub(responseKey.PubKey()),
+		})
+	}))
+}
+
+func TestRemoteSigner_Sign(t *testing.T) {
+	key := secp256k1.GenPrivKey()
+
+	service := startSigningService(t, "jack", key, key)
+	defer service.Close()
+
+	signer, err := NewRemoteSigner(service.URL)
+	require.NoError(t, err)
+
+	msg := []byte("bytes to sign")
+
+	signature, pubKey, err := signer.Sign("jack", "", msg)
+	require.NoError(t, err)
+	require.Equal(t, key.PubKey(), pubKey)
+	require.True(t, pubKey.VerifyBytes(msg, signature))
+
+	// unknown key
+	_, _, err = signer.Sign("alice", "", msg)
+	require.Error(t, err)
+}
+
+func TestRemoteSigner_SignatureDoesNotMatchPubKey(t *testing.T) {
+	service := startSigningService(t, "jack", secp256k1.GenPrivKey(), secp256k1.GenPrivKey())
+	defer service.Close()
+
+	signer, err := NewRemoteSigner(service.URL)
+	require.NoError(t, err)
+
+	_, _, err = signer.Sign("jack", "", []byte("bytes to sign"))
+	require.Error(t, err)
+}
+
+func TestNewRemoteSigner_WithoutURL(t *testing.T) {
+	_, err := NewRemoteSigner("")
+	require.Error(t, err)
+}
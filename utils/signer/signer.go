@@ -0,0 +1,107 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package signer
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/keys"
+	crkeys "github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+const (
+	FlagSigner         = "signer"
+	FlagSignerUsage    = "Signer backend to use for signing transactions (keybase, remote)"
+	FlagSignerURL      = "signer-url"
+	FlagSignerURLUsage = "URL of the signing service used by the remote signer backend"
+
+	BackendKeybase = "keybase" // keys stored in the local keybase
+	BackendRemote  = "remote"  // keys stored in HSM or cloud KMS behind a signing service
+)
+
+// Signer signs transaction bytes by the key with the given name.
+// The keybase (crkeys.Keybase) satisfies the interface.
+type Signer interface {
+	Sign(name string, passphrase string, msg []byte) ([]byte, crypto.PubKey, error)
+}
+
+// Constructor of the signer backend.
+type Constructor func() (Signer, error)
+
+// Signer backends by name. The local keybase is handled separately as it is the default one.
+var backends = map[string]Constructor{
+	BackendRemote: func() (Signer, error) {
+		return NewRemoteSigner(viper.GetString(FlagSignerURL))
+	},
+}
+
+// Registers the signer backend (e.g. bound to a PKCS#11 library) which can be selected with `signer` flag.
+func RegisterBackend(name string, constructor Constructor) {
+	if _, ok := backends[name]; ok || name == BackendKeybase {
+		panic(fmt.Sprintf("signer backend %v is already registered", name))
+	}
+
+	backends[name] = constructor
+}
+
+// Returns the signer of the backend selected with `signer` flag (the local keybase by default).
+func NewSignerFromConfig() (Signer, error) {
+	backend := viper.GetString(FlagSigner)
+	if len(backend) == 0 || backend == BackendKeybase {
+		return keys.NewKeyBaseFromHomeFlag()
+	}
+
+	constructor, ok := backends[backend]
+	if !ok {
+		return nil, fmt.Errorf("unknown signer backend: %v", backend)
+	}
+
+	return constructor()
+}
+
+// Returns the keybase which signs with the backend selected with `signer` flag.
+// The given local keybase still provides the public information about the keys
+// (the keys signed by the backend must be added to it with their public keys: `keys add <name> --pubkey`).
+func NewKeybaseFromConfig(local crkeys.Keybase) (crkeys.Keybase, error) {
+	backend := viper.GetString(FlagSigner)
+	if len(backend) == 0 || backend == BackendKeybase {
+		return local, nil
+	}
+
+	signer, err := NewSignerFromConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	if local == nil {
+		if local, err = keys.NewKeyBaseFromHomeFlag(); err != nil {
+			return nil, err
+		}
+	}
+
+	return keybase{Keybase: local, signer: signer}, nil
+}
+
+// Keybase delegating signing to the signer backend.
+type keybase struct {
+	crkeys.Keybase
+	signer Signer
+}
+
+func (kb keybase) Sign(name string, passphrase string, msg []byte) ([]byte, crypto.PubKey, error) {
+	return kb.signer.Sign(name, passphrase, msg)
+}
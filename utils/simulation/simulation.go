@@ -0,0 +1,84 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simulation runs the transactions through the ante handler and the message handlers
+// of the node without committing them (`/app/simulate` query), so the messages can be validated
+// against the current state and the gas can be estimated without broadcasting.
+package simulation
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+const QueryPath = "/app/simulate"
+
+// Result of the simulated transaction: the result it would have if it was broadcasted now.
+type Result struct {
+	Success     bool             `json:"success"`
+	Codespace   string           `json:"codespace,omitempty"`
+	Code        uint32           `json:"code,omitempty"`
+	Log         string           `json:"log,omitempty"`
+	GasUsed     uint64           `json:"gas_used"`
+	GasEstimate uint64           `json:"gas_estimate"` // gas used multiplied by the gas adjustment
+	Events      sdk.StringEvents `json:"events,omitempty"`
+}
+
+// Implement fmt.Stringer.
+func (r Result) String() string {
+	res, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
+
+// Simulate simulates the transaction with the messages built by the transaction builder
+// (the account number and the sequence must be set). The transaction is not signed.
+func Simulate(cliCtx context.CLIContext, txBldr auth.TxBuilder, msgs []sdk.Msg) (Result, error) {
+	txBytes, err := txBldr.BuildTxForSim(msgs)
+	if err != nil {
+		return Result{}, err
+	}
+
+	res, _, err := cliCtx.QueryWithData(QueryPath, txBytes)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return ParseResult(cliCtx.Codec, res, txBldr.GasAdjustment())
+}
+
+// ParseResult decodes the response of the simulation query.
+func ParseResult(cdc *codec.Codec, res []byte, gasAdjustment float64) (Result, error) {
+	var result sdk.Result
+	if err := cdc.UnmarshalBinaryLengthPrefixed(res, &result); err != nil {
+		return Result{}, err
+	}
+
+	return Result{
+		Success:     result.IsOK(),
+		Codespace:   string(result.Codespace),
+		Code:        uint32(result.Code),
+		Log:         result.Log,
+		GasUsed:     result.GasUsed,
+		GasEstimate: uint64(gasAdjustment * float64(result.GasUsed)),
+		Events:      sdk.StringifyEvents(result.Events.ToABCIEvents()),
+	}, nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package simulation
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResult(t *testing.T) {
+	cdc := codec.New()
+
+	// successful transaction
+	res := cdc.MustMarshalBinaryLengthPrefixed(sdk.Result{
+		GasUsed: 1000,
+		Events:  sdk.Events{sdk.NewEvent("message", sdk.NewAttribute("action", "add_model_info"))},
+	})
+
+	result, err := ParseResult(cdc, res, 1.5)
+	require.NoError(t, err)
+	require.True(t, result.Success)
+	require.Equal(t, uint64(1000), result.GasUsed)
+	require.Equal(t, uint64(1500), result.GasEstimate)
+	require.Equal(t, "message", result.Events[0].Type)
+	require.Equal(t, "add_model_info", result.Events[0].Attributes[0].Value)
+
+	// rejected transaction
+	res = cdc.MustMarshalBinaryLengthPrefixed(
+		sdk.NewError("modelinfo", 501, "model info already exists").Result())
+
+	result, err = ParseResult(cdc, res, 1)
+	require.NoError(t, err)
+	require.False(t, result.Success)
+	require.Equal(t, "modelinfo", result.Codespace)
+	require.Equal(t, uint32(501), result.Code)
+	require.Contains(t, result.Log, "model info already exists")
+
+	// malformed response
+	_, err = ParseResult(cdc, []byte("invalid"), 1)
+	require.Error(t, err)
+}
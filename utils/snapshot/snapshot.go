@@ -0,0 +1,401 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package snapshot creates and restores the snapshots of the node data directory,
+// so a new node can join the network starting from the snapshot height instead of replaying the full chain.
+// The snapshot is a gzipped tar archive of the node databases (application, block store, state, tx index)
+// split into the chunks of the fixed size. The manifest lists the chunks along with their SHA256 checksums,
+// so the snapshot can be distributed over untrusted channels and verified before the restoration.
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	ManifestFile     = "manifest.json"
+	DefaultChunkSize = 64 << 20
+
+	chunkFileFormat = "chunk-%06d"
+)
+
+// Files of the data directory that are specific to the node, so they are not included into the snapshot.
+var excluded = map[string]bool{
+	"priv_validator_state.json": true,
+	"cs.wal":                    true,
+}
+
+// Databases that must be absent in the data directory the snapshot is restored into.
+var databases = []string{"application.db", "blockstore.db", "state.db"}
+
+type Chunk struct {
+	File   string `json:"file"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+type Manifest struct {
+	ChainID string  `json:"chain_id"`
+	Height  int64   `json:"height"`
+	AppHash string  `json:"app_hash"`
+	Chunks  []Chunk `json:"chunks"`
+}
+
+// Create writes the snapshot of the data directory into the output directory (which must not exist or be empty).
+// The given manifest describes the state of the data directory, its chunks are filled by Create.
+func Create(dataDir string, outputDir string, manifest Manifest, chunkSize int64) (Manifest, error) {
+	if chunkSize <= 0 {
+		return Manifest{}, fmt.Errorf("invalid chunk size: %v", chunkSize)
+	}
+
+	if err := ensureEmpty(outputDir); err != nil {
+		return Manifest{}, err
+	}
+
+	chunks := &chunkWriter{dir: outputDir, size: chunkSize}
+
+	if err := archive(dataDir, chunks); err != nil {
+		return Manifest{}, err
+	}
+
+	if err := chunks.Close(); err != nil {
+		return Manifest{}, err
+	}
+
+	manifest.Chunks = chunks.chunks
+
+	out, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(outputDir, ManifestFile), out, 0600); err != nil {
+		return Manifest{}, err
+	}
+
+	return manifest, nil
+}
+
+// ReadManifest reads the manifest of the snapshot.
+func ReadManifest(snapshotDir string) (Manifest, error) {
+	var manifest Manifest
+
+	bz, err := ioutil.ReadFile(filepath.Join(snapshotDir, ManifestFile))
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	if err := json.Unmarshal(bz, &manifest); err != nil {
+		return Manifest{}, fmt.Errorf("invalid snapshot manifest: %v", err)
+	}
+
+	if len(manifest.Chunks) == 0 {
+		return Manifest{}, fmt.Errorf("invalid snapshot manifest: no chunks")
+	}
+
+	return manifest, nil
+}
+
+// Verify checks the sizes and the checksums of the snapshot chunks.
+func Verify(snapshotDir string, manifest Manifest) error {
+	for _, chunk := range manifest.Chunks {
+		if err := verifyChunk(snapshotDir, chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Restore verifies the snapshot and extracts it into the data directory of the new node
+// (which must not contain the node databases).
+func Restore(snapshotDir string, dataDir string) (Manifest, error) {
+	manifest, err := ReadManifest(snapshotDir)
+	if err != nil {
+		return Manifest{}, err
+	}
+
+	if err := Verify(snapshotDir, manifest); err != nil {
+		return Manifest{}, err
+	}
+
+	for _, db := range databases {
+		if _, err := os.Stat(filepath.Join(dataDir, db)); err == nil {
+			return Manifest{}, fmt.Errorf("data directory %v already contains %v: "+
+				"the snapshot can be restored only for a new node", dataDir, db)
+		}
+	}
+
+	readers := make([]io.Reader, 0, len(manifest.Chunks))
+
+	for _, chunk := range manifest.Chunks {
+		file, err := os.Open(filepath.Join(snapshotDir, chunk.File))
+		if err != nil {
+			return Manifest{}, err
+		}
+		defer file.Close()
+
+		readers = append(readers, file)
+	}
+
+	if err := extract(io.MultiReader(readers...), dataDir); err != nil {
+		return Manifest{}, err
+	}
+
+	return manifest, nil
+}
+
+func archive(dataDir string, w io.Writer) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	err := filepath.Walk(dataDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		name, err := filepath.Rel(dataDir, path)
+		if err != nil || name == "." {
+			return err
+		}
+
+		if excluded[name] {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+
+		header.Name = filepath.ToSlash(name)
+
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		_, err = io.Copy(tw, file)
+
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
+
+func extract(r io.Reader, dataDir string) error {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(gz)
+
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		name := filepath.Clean(filepath.FromSlash(header.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("invalid snapshot entry: %v", header.Name)
+		}
+
+		path := filepath.Join(dataDir, name)
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0700); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := extractFile(tr, path, os.FileMode(header.Mode).Perm()); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported snapshot entry type: %v", header.Name)
+		}
+	}
+}
+
+func extractFile(r io.Reader, path string, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(file, r); err != nil {
+		file.Close()
+
+		return err
+	}
+
+	return file.Close()
+}
+
+func verifyChunk(snapshotDir string, chunk Chunk) error {
+	if filepath.Base(chunk.File) != chunk.File {
+		return fmt.Errorf("invalid snapshot chunk name: %v", chunk.File)
+	}
+
+	file, err := os.Open(filepath.Join(snapshotDir, chunk.File))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	h := sha256.New()
+
+	size, err := io.Copy(h, file)
+	if err != nil {
+		return err
+	}
+
+	if size != chunk.Size || hex.EncodeToString(h.Sum(nil)) != chunk.SHA256 {
+		return fmt.Errorf("snapshot chunk %v is corrupted: checksum mismatch", chunk.File)
+	}
+
+	return nil
+}
+
+func ensureEmpty(dir string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	if len(files) != 0 {
+		return fmt.Errorf("output directory %v is not empty", dir)
+	}
+
+	return nil
+}
+
+// Splits the written data into the chunk files of the fixed size computing their checksums.
+type chunkWriter struct {
+	dir    string
+	size   int64
+	chunks []Chunk
+
+	file    *os.File
+	hash    hash.Hash
+	written int64
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	total := 0
+
+	for len(p) > 0 {
+		if w.file == nil || w.written == w.size {
+			if err := w.next(); err != nil {
+				return total, err
+			}
+		}
+
+		n := int64(len(p))
+		if left := w.size - w.written; n > left {
+			n = left
+		}
+
+		if _, err := w.file.Write(p[:n]); err != nil {
+			return total, err
+		}
+
+		w.hash.Write(p[:n])
+		w.written += n
+		total += int(n)
+		p = p[n:]
+	}
+
+	return total, nil
+}
+
+// Close closes the current chunk.
+func (w *chunkWriter) Close() error {
+	if w.file == nil {
+		return nil
+	}
+
+	w.chunks = append(w.chunks, Chunk{
+		File:   filepath.Base(w.file.Name()),
+		Size:   w.written,
+		SHA256: hex.EncodeToString(w.hash.Sum(nil)),
+	})
+
+	err := w.file.Close()
+	w.file = nil
+
+	return err
+}
+
+func (w *chunkWriter) next() error {
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	file, err := os.Create(filepath.Join(w.dir, fmt.Sprintf(chunkFileFormat, len(w.chunks))))
+	if err != nil {
+		return err
+	}
+
+	w.file = file
+	w.hash = sha256.New()
+	w.written = 0
+
+	return nil
+}
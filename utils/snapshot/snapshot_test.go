@@ -0,0 +1,102 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package snapshot
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateRestore(t *testing.T) {
+	root, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(root)
+
+	dataDir := filepath.Join(root, "data")
+	writeFile(t, filepath.Join(dataDir, "application.db", "000001.log"), "application")
+	writeFile(t, filepath.Join(dataDir, "blockstore.db", "000001.log"), "blocks")
+	writeFile(t, filepath.Join(dataDir, "priv_validator_state.json"), "{}")
+	writeFile(t, filepath.Join(dataDir, "cs.wal", "wal"), "wal")
+
+	snapshotDir := filepath.Join(root, "snapshot")
+	manifest, err := Create(dataDir, snapshotDir, Manifest{ChainID: "test", Height: 10, AppHash: "AA"}, 16)
+	require.NoError(t, err)
+	require.True(t, len(manifest.Chunks) > 1)
+
+	read, err := ReadManifest(snapshotDir)
+	require.NoError(t, err)
+	require.Equal(t, manifest, read)
+
+	// the snapshot is created only into an empty directory
+	_, err = Create(dataDir, snapshotDir, Manifest{}, 16)
+	require.Error(t, err)
+
+	newDataDir := filepath.Join(root, "new")
+	writeFile(t, filepath.Join(newDataDir, "priv_validator_state.json"), "new")
+
+	restored, err := Restore(snapshotDir, newDataDir)
+	require.NoError(t, err)
+	require.Equal(t, manifest, restored)
+
+	requireFile(t, filepath.Join(newDataDir, "application.db", "000001.log"), "application")
+	requireFile(t, filepath.Join(newDataDir, "blockstore.db", "000001.log"), "blocks")
+	// node specific files are not included
+	requireFile(t, filepath.Join(newDataDir, "priv_validator_state.json"), "new")
+	_, err = os.Stat(filepath.Join(newDataDir, "cs.wal"))
+	require.True(t, os.IsNotExist(err))
+
+	// the snapshot is restored only into a new node
+	_, err = Restore(snapshotDir, newDataDir)
+	require.Error(t, err)
+}
+
+func TestRestoreCorruptedChunk(t *testing.T) {
+	root, err := ioutil.TempDir("", "snapshot")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(root)
+
+	dataDir := filepath.Join(root, "data")
+	writeFile(t, filepath.Join(dataDir, "application.db", "000001.log"), "application")
+
+	snapshotDir := filepath.Join(root, "snapshot")
+	manifest, err := Create(dataDir, snapshotDir, Manifest{}, DefaultChunkSize)
+	require.NoError(t, err)
+
+	writeFile(t, filepath.Join(snapshotDir, manifest.Chunks[0].File), "corrupted")
+
+	_, err = Restore(snapshotDir, filepath.Join(root, "new"))
+	require.Error(t, err)
+
+	_, err = os.Stat(filepath.Join(root, "new", "application.db"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func writeFile(t *testing.T, path string, content string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0700))
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+}
+
+func requireFile(t *testing.T, path string, content string) {
+	bz, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, content, string(bz))
+}
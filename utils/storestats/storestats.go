@@ -0,0 +1,154 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package storestats reports the number of records and the approximate size of the stores of the modules,
+// so the node operators can plan the capacity and detect a runaway growth of the state.
+// The statistics are computed by iterating over the whole state, so the query is served only by the nodes
+// started with `--store-stats` flag (i.e. the nodes of the operators rather than the public ones).
+package storestats
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+)
+
+const (
+	QuerierRoute        = "storestats"
+	FlagStoreStats      = "store-stats"
+	FlagStoreStatsUsage = "Serve the query of the statistics of the module stores " +
+		"(it iterates over the whole state, so it should not be enabled on the public nodes)"
+)
+
+// Store of a module along with the names of the kinds of its records (by the prefix byte of their keys).
+type Store struct {
+	Key   sdk.StoreKey
+	Names map[byte]string
+}
+
+func NewStore(key sdk.StoreKey, names map[byte]string) Store {
+	return Store{Key: key, Names: names}
+}
+
+// Statistics of the records with the same prefix byte of the keys.
+// The size is the total size of the keys and the values, the overhead of the underlying tree is not included.
+type PrefixStats struct {
+	Prefix  string `json:"prefix"` // hex encoded
+	Name    string `json:"name,omitempty"`
+	Records int    `json:"records"`
+	Bytes   int    `json:"bytes"`
+}
+
+type StoreStats struct {
+	Store    string        `json:"store"`
+	Records  int           `json:"records"`
+	Bytes    int           `json:"bytes"`
+	Prefixes []PrefixStats `json:"prefixes"`
+}
+
+type Stats struct {
+	Records int          `json:"records"`
+	Bytes   int          `json:"bytes"`
+	Stores  []StoreStats `json:"stores"`
+}
+
+// Computes the statistics of the store grouping its records by the prefix byte of the keys.
+func ComputeStoreStats(ctx sdk.Context, store Store) StoreStats {
+	stats := StoreStats{Store: store.Key.Name(), Prefixes: []PrefixStats{}}
+
+	iter := ctx.KVStore(store.Key).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		size := len(iter.Key()) + len(iter.Value())
+
+		// the keys are iterated in order, so the records with the same prefix are adjacent
+		prefix := hex.EncodeToString(iter.Key()[:1])
+		if last := len(stats.Prefixes) - 1; last < 0 || stats.Prefixes[last].Prefix != prefix {
+			stats.Prefixes = append(stats.Prefixes, PrefixStats{Prefix: prefix, Name: store.Names[iter.Key()[0]]})
+		}
+
+		stats.Prefixes[len(stats.Prefixes)-1].Records++
+		stats.Prefixes[len(stats.Prefixes)-1].Bytes += size
+		stats.Records++
+		stats.Bytes += size
+	}
+
+	return stats
+}
+
+// Observer is notified about the statistics of every store computed by the query (e.g. to update the metrics).
+type Observer func(stats StoreStats)
+
+// NewQuerier serves the query of the statistics of the stores. The observer is optional.
+func NewQuerier(cdc *codec.Codec, observe Observer, stores ...Store) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		stats := Stats{Stores: []StoreStats{}}
+
+		for _, store := range stores {
+			storeStats := ComputeStoreStats(ctx, store)
+
+			if observe != nil {
+				observe(storeStats)
+			}
+
+			stats.Records += storeStats.Records
+			stats.Bytes += storeStats.Bytes
+			stats.Stores = append(stats.Stores, storeStats)
+		}
+
+		sort.Slice(stats.Stores, func(i, j int) bool { return stats.Stores[i].Store < stats.Stores[j].Store })
+
+		res = codec.MustMarshalJSONIndent(cdc, stats)
+
+		return res, nil
+	}
+}
+
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-stats",
+		Short: "Query the number of records and the approximate size of the stores of the modules",
+		Long: "Query the number of records and the approximate size of the stores of the modules. " +
+			"The query is served only by the nodes started with --" + FlagStoreStats + " flag",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s", QuerierRoute), nil)
+		},
+	}
+
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	return cmd
+}
+
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/store-stats", func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s", QuerierRoute), nil)
+	}).Methods("GET")
+}
@@ -0,0 +1,77 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package storestats
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestQuerier(t *testing.T) {
+	db := dbm.NewMemDB()
+	dbStore := store.NewCommitMultiStore(db)
+	firstKey, secondKey := sdk.NewKVStoreKey("first"), sdk.NewKVStoreKey("second")
+	dbStore.MountStoreWithDB(firstKey, sdk.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(secondKey, sdk.StoreTypeIAVL, nil)
+	_ = dbStore.LoadLatestVersion()
+
+	ctx := sdk.NewContext(dbStore, abci.Header{}, false, log.NewNopLogger())
+
+	first := ctx.KVStore(firstKey)
+	first.Set([]byte{0x01, 0x01}, []byte("value"))
+	first.Set([]byte{0x01, 0x02}, []byte("longer value"))
+	first.Set([]byte{0x03, 0x01}, []byte("v"))
+
+	cdc := codec.New()
+	observed := map[string]int{}
+	observe := func(stats StoreStats) { observed[stats.Store] = stats.Records }
+	querier := NewQuerier(cdc, observe, NewStore(secondKey, nil), NewStore(firstKey, map[byte]string{0x01: "records"}))
+
+	res, err := querier(ctx, []string{}, abci.RequestQuery{})
+	require.Nil(t, err)
+
+	var stats Stats
+
+	cdc.MustUnmarshalJSON(res, &stats)
+
+	require.Equal(t, 3, stats.Records)
+	require.Equal(t, 2+5+2+12+2+1, stats.Bytes)
+
+	// the stores are sorted by name
+	require.Equal(t, 2, len(stats.Stores))
+	require.Equal(t, "first", stats.Stores[0].Store)
+	require.Equal(t, "second", stats.Stores[1].Store)
+
+	// the records are grouped by the prefix byte of the keys
+	require.Equal(t, []PrefixStats{
+		{Prefix: "01", Name: "records", Records: 2, Bytes: 21},
+		{Prefix: "03", Records: 1, Bytes: 3},
+	}, stats.Stores[0].Prefixes)
+
+	// empty store
+	require.Equal(t, 0, stats.Stores[1].Records)
+	require.Empty(t, stats.Stores[1].Prefixes)
+
+	// the statistics of every store are observed
+	require.Equal(t, map[string]int{"first": 3, "second": 0}, observed)
+}
@@ -0,0 +1,128 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package txqueue serializes the transactions signed by the REST server per signer account.
+// The transactions of an account are processed one by one in the order of submission,
+// and the sequence of the next transaction is tracked locally, so a burst of requests
+// of the same account does not lead to sequence collisions.
+package txqueue
+
+import (
+	"errors"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var ErrQueueFull = errors.New("too many pending transactions of the account")
+
+// Sequence of the next transaction of the account assigned locally.
+type Sequence struct {
+	value uint64
+	known bool
+}
+
+// Get returns the sequence of the next transaction if it is known.
+func (s *Sequence) Get() (uint64, bool) {
+	return s.value, s.known
+}
+
+// Set records the sequence of the next transaction (after the transaction is accepted).
+func (s *Sequence) Set(value uint64) {
+	s.value, s.known = value, true
+}
+
+// Reset forgets the sequence, so it is fetched from the ledger for the next transaction.
+func (s *Sequence) Reset() {
+	s.value, s.known = 0, false
+}
+
+type Queue struct {
+	// Maximal number of pending transactions of an account.
+	size int
+
+	mu sync.Mutex
+	// Pending transactions of the accounts being processed.
+	pending map[string]chan func()
+	// Sequences of the accounts (kept when there is no pending transactions).
+	sequences map[string]*Sequence
+}
+
+func New(size int) *Queue {
+	if size < 1 {
+		size = 1
+	}
+
+	return &Queue{
+		size:      size,
+		pending:   map[string]chan func(){},
+		sequences: map[string]*Sequence{},
+	}
+}
+
+// Do runs the function once all the previously submitted functions of the account are completed.
+// The function gets the sequence of the account it can use and update.
+// ErrQueueFull is returned if the account already has the maximal number of pending transactions.
+func (q *Queue) Do(address sdk.AccAddress, fn func(sequence *Sequence)) error {
+	key := address.String()
+	done := make(chan struct{})
+
+	q.mu.Lock()
+
+	sequence, ok := q.sequences[key]
+	if !ok {
+		sequence = &Sequence{}
+		q.sequences[key] = sequence
+	}
+
+	jobs, ok := q.pending[key]
+	if !ok {
+		jobs = make(chan func(), q.size)
+		q.pending[key] = jobs
+
+		go q.process(key, jobs)
+	}
+
+	select {
+	case jobs <- func() { fn(sequence); close(done) }:
+	default:
+		q.mu.Unlock()
+
+		return ErrQueueFull
+	}
+
+	q.mu.Unlock()
+
+	<-done
+
+	return nil
+}
+
+// Runs the pending functions of the account until there is no more.
+func (q *Queue) process(key string, jobs chan func()) {
+	for {
+		q.mu.Lock()
+
+		select {
+		case job := <-jobs:
+			q.mu.Unlock()
+			job()
+		default:
+			delete(q.pending, key)
+			q.mu.Unlock()
+
+			return
+		}
+	}
+}
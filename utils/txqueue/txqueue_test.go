@@ -0,0 +1,143 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package txqueue
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	address1 = sdk.AccAddress([]byte("address1____________"))
+	address2 = sdk.AccAddress([]byte("address2____________"))
+)
+
+func TestQueue_AssignsSequencesOneByOne(t *testing.T) {
+	queue := New(100)
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		assigned = map[uint64]bool{}
+	)
+
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			err := queue.Do(address1, func(sequence *Sequence) {
+				value, ok := sequence.Get()
+				if !ok {
+					value = 10 // fetched from the ledger
+				}
+
+				mu.Lock()
+				require.False(t, assigned[value])
+				assigned[value] = true
+				mu.Unlock()
+
+				sequence.Set(value + 1)
+			})
+			require.NoError(t, err)
+		}()
+	}
+
+	wg.Wait()
+
+	require.Equal(t, 50, len(assigned))
+	require.True(t, assigned[10])
+	require.True(t, assigned[59])
+
+	// the sequence is kept when there is no pending transactions
+	require.NoError(t, queue.Do(address1, func(sequence *Sequence) {
+		value, ok := sequence.Get()
+		require.True(t, ok)
+		require.Equal(t, uint64(60), value)
+
+		sequence.Reset()
+	}))
+
+	require.NoError(t, queue.Do(address1, func(sequence *Sequence) {
+		_, ok := sequence.Get()
+		require.False(t, ok)
+	}))
+}
+
+func TestQueue_AccountsAreIndependent(t *testing.T) {
+	queue := New(100)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	go func() {
+		_ = queue.Do(address1, func(sequence *Sequence) {
+			close(started)
+			<-release
+		})
+	}()
+
+	<-started
+
+	// the transaction of another account is not blocked
+	require.NoError(t, queue.Do(address2, func(sequence *Sequence) {
+		_, ok := sequence.Get()
+		require.False(t, ok)
+	}))
+
+	close(release)
+}
+
+func TestQueue_Full(t *testing.T) {
+	queue := New(1)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan error)
+
+	go func() {
+		done <- queue.Do(address1, func(sequence *Sequence) {
+			close(started)
+			<-release
+		})
+	}()
+
+	<-started
+
+	// one pending transaction
+	go func() {
+		done <- queue.Do(address1, func(sequence *Sequence) {})
+	}()
+
+	require.Eventually(t, func() bool {
+		queue.mu.Lock()
+		defer queue.mu.Unlock()
+
+		return len(queue.pending[address1.String()]) == 1
+	}, time.Second, time.Millisecond)
+
+	require.Equal(t, ErrQueueFull, queue.Do(address1, func(sequence *Sequence) {}))
+
+	close(release)
+
+	require.NoError(t, <-done)
+	require.NoError(t, <-done)
+}
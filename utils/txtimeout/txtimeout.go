@@ -0,0 +1,65 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package txtimeout attaches the timeout height to the memo of a transaction. The transactions
+// of this ledger (StdTx) have no timeout field, so the height is prepended to the memo, which is signed
+// along with the messages: `timeout_height=<height>;<reference ID>`. The ledger rejects the transactions
+// included in a block above their timeout height, so a transaction signed offline (e.g. in a signing ceremony
+// of trustees) cannot be broadcasted long after it was signed.
+package txtimeout
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	FlagTimeoutHeight      = "timeout-height"
+	FlagTimeoutHeightUsage = "Block height after which the transaction is rejected by the ledger (0 means no timeout)"
+	memoPrefix             = "timeout_height="
+	memoSeparator          = ";"
+)
+
+var ErrInvalidTimeoutHeight = errors.New("invalid timeout height in memo: " +
+	"it must be a positive integer followed by \"" + memoSeparator + "\"")
+
+// Returns the memo with the timeout height (if it is positive) followed by the reference ID.
+func Memo(timeoutHeight int64, referenceID string) string {
+	if timeoutHeight <= 0 {
+		return referenceID
+	}
+
+	return fmt.Sprintf("%s%d%s%s", memoPrefix, timeoutHeight, memoSeparator, referenceID)
+}
+
+// Returns the timeout height (0 if the memo has none) and the reference ID of the memo.
+func Parse(memo string) (int64, string, error) {
+	if !strings.HasPrefix(memo, memoPrefix) {
+		return 0, memo, nil
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(memo, memoPrefix), memoSeparator, 2)
+	if len(parts) != 2 {
+		return 0, "", ErrInvalidTimeoutHeight
+	}
+
+	timeoutHeight, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || timeoutHeight <= 0 {
+		return 0, "", ErrInvalidTimeoutHeight
+	}
+
+	return timeoutHeight, parts[1], nil
+}
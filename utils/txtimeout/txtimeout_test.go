@@ -0,0 +1,52 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package txtimeout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemo(t *testing.T) {
+	require.Equal(t, "ref", Memo(0, "ref"))
+	require.Equal(t, "", Memo(0, ""))
+	require.Equal(t, "timeout_height=100;ref", Memo(100, "ref"))
+	require.Equal(t, "timeout_height=100;", Memo(100, ""))
+}
+
+func TestParse(t *testing.T) {
+	for _, memo := range []string{"", "ref", "timeout_height=100;ref", "timeout_height=100;", "timeout_height=1;a;b"} {
+		timeoutHeight, referenceID, err := Parse(memo)
+		require.NoError(t, err)
+
+		// the memo is built back from the parsed values
+		require.Equal(t, memo, Memo(timeoutHeight, referenceID))
+	}
+
+	timeoutHeight, referenceID, err := Parse("timeout_height=100;ref")
+	require.NoError(t, err)
+	require.Equal(t, int64(100), timeoutHeight)
+	require.Equal(t, "ref", referenceID)
+
+	for _, memo := range []string{
+		"timeout_height=100", "timeout_height=;ref", "timeout_height=0;ref", "timeout_height=-1;ref",
+		"timeout_height=abc;ref",
+	} {
+		_, _, err := Parse(memo)
+		require.Equal(t, ErrInvalidTimeoutHeight, err, memo)
+	}
+}
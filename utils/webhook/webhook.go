@@ -0,0 +1,360 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package webhook delivers the notifications about the ledger events to the registered webhooks.
+// A notification is POSTed as JSON to every webhook with a matching event filter. The body is signed
+// with HMAC-SHA256 keyed by the secret of the webhook (`X-DCL-Signature: sha256=<hex>`), so the receiver can
+// verify it comes from the REST server. The failed deliveries are retried with exponential backoff.
+// The recent deliveries are kept in the delivery log.
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+const (
+	HeaderSignature = "X-DCL-Signature"
+	HeaderDelivery  = "X-DCL-Delivery"
+	HeaderEvent     = "X-DCL-Event"
+
+	StatusPending   = "pending"
+	StatusDelivered = "delivered"
+	StatusFailed    = "failed"
+
+	signaturePrefix = "sha256="
+)
+
+var ErrNoWebhooks = errors.New("invalid webhooks config: it must contain at least one webhook")
+
+// Event filter of a webhook. The empty fields match any value.
+type Filter struct {
+	Module string `json:"module,omitempty"`
+	Action string `json:"action,omitempty"`
+	VID    uint16 `json:"vid,omitempty"`
+}
+
+func (f Filter) Matches(n Notification) bool {
+	return (len(f.Module) == 0 || f.Module == n.Module) &&
+		(len(f.Action) == 0 || f.Action == n.Action) &&
+		(f.VID == 0 || f.VID == n.VID)
+}
+
+type Webhook struct {
+	URL    string   `json:"url"`
+	Secret string   `json:"secret"`
+	Events []Filter `json:"events"` // all the events are delivered if empty
+}
+
+func (w Webhook) Matches(n Notification) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+
+	for _, filter := range w.Events {
+		if filter.Matches(n) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (w Webhook) Validate() error {
+	parsedURL, err := url.ParseRequestURI(w.URL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || len(parsedURL.Host) == 0 {
+		return fmt.Errorf("invalid webhook URL: \"%s\". It must be an absolute http(s) URL", w.URL)
+	}
+
+	if len(w.Secret) == 0 {
+		return fmt.Errorf("invalid webhook %s: secret must not be empty", w.URL)
+	}
+
+	return nil
+}
+
+// Reads the webhooks from the JSON file: `{"webhooks": [{"url": ..., "secret": ..., "events": [...]}]}`.
+func LoadWebhooks(path string) ([]Webhook, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file struct {
+		Webhooks []Webhook `json:"webhooks"`
+	}
+
+	if err := json.Unmarshal(bytes, &file); err != nil {
+		return nil, fmt.Errorf("invalid webhooks config: %w", err)
+	}
+
+	if len(file.Webhooks) == 0 {
+		return nil, ErrNoWebhooks
+	}
+
+	for _, webhook := range file.Webhooks {
+		if err := webhook.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return file.Webhooks, nil
+}
+
+// Notification about a single message of a committed transaction or about a module event
+// (the action is the event type, the message is absent, the attributes are present).
+type Notification struct {
+	// <tx hash>-<message index>, <tx hash>-event-<event index> or <height>-<begin_block|end_block>-<event index>
+	ID         string            `json:"id"`
+	Height     int64             `json:"height"`
+	TxHash     string            `json:"tx_hash,omitempty"`
+	Module     string            `json:"module"`
+	Action     string            `json:"action"`
+	VID        uint16            `json:"vid,omitempty"`
+	PID        uint16            `json:"pid,omitempty"`
+	Msg        json.RawMessage   `json:"msg,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// Delivery of a notification to a webhook.
+type Delivery struct {
+	ID             uint64    `json:"id"`
+	URL            string    `json:"url"`
+	NotificationID string    `json:"notification_id"`
+	Module         string    `json:"module"`
+	Action         string    `json:"action"`
+	Status         string    `json:"status"`
+	Attempts       int       `json:"attempts"`
+	ResponseCode   int       `json:"response_code,omitempty"`
+	Error          string    `json:"error,omitempty"`
+	Created        time.Time `json:"created"`
+	Updated        time.Time `json:"updated"`
+}
+
+type Config struct {
+	Webhooks []Webhook
+	// Number of retries of a failed delivery.
+	MaxRetries int
+	// Delay before the first retry, doubled after every failed retry.
+	RetryDelay time.Duration
+	// Timeout of a single delivery attempt.
+	Timeout time.Duration
+	// Number of the recent deliveries kept in the log.
+	LogSize int
+}
+
+type Dispatcher struct {
+	config Config
+	client *http.Client
+	now    func() time.Time
+	sleep  func(time.Duration)
+	wg     sync.WaitGroup
+
+	mu sync.Mutex
+	// Oldest deliveries are first.
+	log    []*Delivery
+	nextID uint64
+}
+
+// New returns the dispatcher or nil if there are no webhooks. The nil dispatcher can be used: it delivers nothing.
+func New(config Config) *Dispatcher {
+	if len(config.Webhooks) == 0 {
+		return nil
+	}
+
+	return &Dispatcher{
+		config: config,
+		client: &http.Client{Timeout: config.Timeout},
+		now:    time.Now,
+		sleep:  time.Sleep,
+	}
+}
+
+// Dispatch delivers the notification to the matching webhooks in background.
+func (d *Dispatcher) Dispatch(n Notification) {
+	if d == nil {
+		return
+	}
+
+	body, err := json.Marshal(n)
+	if err != nil {
+		return
+	}
+
+	for _, webhook := range d.config.Webhooks {
+		if !webhook.Matches(n) {
+			continue
+		}
+
+		delivery := d.newDelivery(webhook, n)
+
+		d.wg.Add(1)
+
+		go func(webhook Webhook, id uint64) {
+			defer d.wg.Done()
+
+			d.deliver(webhook, body, id, n)
+		}(webhook, delivery.ID)
+	}
+}
+
+// Wait blocks until the deliveries in progress are finished (delivered or failed).
+func (d *Dispatcher) Wait() {
+	if d != nil {
+		d.wg.Wait()
+	}
+}
+
+// Returns the logged deliveries (newest first) to the webhook with the given URL and in the given status
+// (any if empty).
+func (d *Dispatcher) Deliveries(url string, status string) []Delivery {
+	deliveries := []Delivery{}
+
+	if d == nil {
+		return deliveries
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i := len(d.log) - 1; i >= 0; i-- {
+		delivery := *d.log[i]
+
+		if (len(url) == 0 || delivery.URL == url) && (len(status) == 0 || delivery.Status == status) {
+			deliveries = append(deliveries, delivery)
+		}
+	}
+
+	return deliveries
+}
+
+func (d *Dispatcher) deliver(webhook Webhook, body []byte, id uint64, n Notification) {
+	delay := d.config.RetryDelay
+
+	for attempt := 1; ; attempt++ {
+		code, err := d.post(webhook, body, id, n)
+
+		status := StatusPending
+
+		switch {
+		case err == nil:
+			status = StatusDelivered
+		case attempt > d.config.MaxRetries:
+			status = StatusFailed
+		}
+
+		d.update(id, func(delivery *Delivery) {
+			delivery.Status = status
+			delivery.Attempts = attempt
+			delivery.ResponseCode = code
+			delivery.Error = ""
+
+			if err != nil {
+				delivery.Error = err.Error()
+			}
+		})
+
+		if status != StatusPending {
+			return
+		}
+
+		d.sleep(delay)
+		delay *= 2
+	}
+}
+
+// Posts the notification to the webhook. Returns the response code (if any) and the error unless it is 2xx.
+func (d *Dispatcher) post(webhook Webhook, body []byte, id uint64, n Notification) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderSignature, Sign(webhook.Secret, body))
+	req.Header.Set(HeaderDelivery, fmt.Sprint(id))
+	req.Header.Set(HeaderEvent, n.Module+"/"+n.Action)
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	_ = resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %v", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+func (d *Dispatcher) newDelivery(webhook Webhook, n Notification) Delivery {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.nextID++
+
+	now := d.now()
+	delivery := &Delivery{
+		ID:             d.nextID,
+		URL:            webhook.URL,
+		NotificationID: n.ID,
+		Module:         n.Module,
+		Action:         n.Action,
+		Status:         StatusPending,
+		Created:        now,
+		Updated:        now,
+	}
+
+	d.log = append(d.log, delivery)
+	if len(d.log) > d.config.LogSize {
+		d.log = d.log[len(d.log)-d.config.LogSize:]
+	}
+
+	return *delivery
+}
+
+// Updates the delivery if it is still kept in the log.
+func (d *Dispatcher) update(id uint64, apply func(delivery *Delivery)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, delivery := range d.log {
+		if delivery.ID == id {
+			apply(delivery)
+			delivery.Updated = d.now()
+
+			return
+		}
+	}
+}
+
+// Sign returns the value of the signature header of the body: `sha256=<hex encoded HMAC-SHA256>`.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,229 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package webhook
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Webhook receiver failing the given number of first requests.
+type receiver struct {
+	mu       sync.Mutex
+	failures int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func (r *receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.requests = append(r.requests, req)
+	r.bodies = append(r.bodies, body)
+
+	if r.failures > 0 {
+		r.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+}
+
+func newDispatcher(webhooks ...Webhook) (*Dispatcher, *[]time.Duration) {
+	var (
+		mu     sync.Mutex
+		delays []time.Duration
+	)
+
+	d := New(Config{Webhooks: webhooks, MaxRetries: 2, RetryDelay: time.Second, Timeout: time.Second, LogSize: 3})
+	d.sleep = func(delay time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		delays = append(delays, delay)
+	}
+
+	return d, &delays
+}
+
+func notification(id string, module string, action string, vid uint16) Notification {
+	return Notification{ID: id, Height: 1, TxHash: "AB", Module: module, Action: action, VID: vid, Msg: []byte(`{}`)}
+}
+
+func TestFilter_Matches(t *testing.T) {
+	revoked := notification("1", "compliance", "revoke_model", 1)
+
+	require.True(t, Filter{}.Matches(revoked))
+	require.True(t, Filter{Module: "compliance", Action: "revoke_model", VID: 1}.Matches(revoked))
+	require.False(t, Filter{Module: "compliance", Action: "revoke_model", VID: 2}.Matches(revoked))
+	require.False(t, Filter{Module: "pki"}.Matches(revoked))
+	require.False(t, Filter{Action: "certify_model"}.Matches(revoked))
+
+	require.True(t, Webhook{}.Matches(revoked))
+	require.True(t, Webhook{Events: []Filter{{Module: "pki"}, {VID: 1}}}.Matches(revoked))
+	require.False(t, Webhook{Events: []Filter{{Module: "pki"}}}.Matches(revoked))
+}
+
+func TestDispatcher_Deliver(t *testing.T) {
+	r := &receiver{}
+	server := httptest.NewServer(r)
+
+	defer server.Close()
+
+	d, delays := newDispatcher(Webhook{URL: server.URL, Secret: "secret", Events: []Filter{{Module: "compliance"}}})
+
+	d.Dispatch(notification("1", "compliance", "revoke_model", 1))
+	d.Dispatch(notification("2", "pki", "approve_add_x509_root_cert", 0))
+	d.Wait()
+
+	require.Len(t, r.requests, 1)
+	require.Empty(t, *delays)
+
+	req, body := r.requests[0], r.bodies[0]
+	require.Equal(t, "application/json", req.Header.Get("Content-Type"))
+	require.Equal(t, Sign("secret", body), req.Header.Get(HeaderSignature))
+	require.Equal(t, "1", req.Header.Get(HeaderDelivery))
+	require.Equal(t, "compliance/revoke_model", req.Header.Get(HeaderEvent))
+
+	var received Notification
+	require.NoError(t, json.Unmarshal(body, &received))
+	require.Equal(t, notification("1", "compliance", "revoke_model", 1), received)
+
+	deliveries := d.Deliveries("", "")
+	require.Len(t, deliveries, 1)
+	require.Equal(t, StatusDelivered, deliveries[0].Status)
+	require.Equal(t, 1, deliveries[0].Attempts)
+	require.Equal(t, http.StatusOK, deliveries[0].ResponseCode)
+	require.Equal(t, "1", deliveries[0].NotificationID)
+}
+
+func TestDispatcher_Retries(t *testing.T) {
+	r := &receiver{failures: 2}
+	server := httptest.NewServer(r)
+
+	defer server.Close()
+
+	d, delays := newDispatcher(Webhook{URL: server.URL, Secret: "secret"})
+
+	// delivered on the last retry
+	d.Dispatch(notification("1", "compliance", "revoke_model", 1))
+	d.Wait()
+
+	require.Len(t, r.requests, 3)
+	require.Equal(t, []time.Duration{time.Second, 2 * time.Second}, *delays)
+
+	delivery := d.Deliveries("", "")[0]
+	require.Equal(t, StatusDelivered, delivery.Status)
+	require.Equal(t, 3, delivery.Attempts)
+	require.Empty(t, delivery.Error)
+
+	// failed after all the retries
+	r.failures = 3
+
+	d.Dispatch(notification("2", "compliance", "revoke_model", 1))
+	d.Wait()
+
+	delivery = d.Deliveries("", StatusFailed)[0]
+	require.Equal(t, "2", delivery.NotificationID)
+	require.Equal(t, 3, delivery.Attempts)
+	require.Equal(t, http.StatusServiceUnavailable, delivery.ResponseCode)
+	require.NotEmpty(t, delivery.Error)
+}
+
+func TestDispatcher_Log(t *testing.T) {
+	r := &receiver{}
+	first, second := httptest.NewServer(r), httptest.NewServer(r)
+
+	defer first.Close()
+	defer second.Close()
+
+	d, _ := newDispatcher(Webhook{URL: first.URL, Secret: "a"}, Webhook{URL: second.URL, Secret: "b"})
+
+	d.Dispatch(notification("1", "compliance", "revoke_model", 1))
+	d.Wait()
+	d.Dispatch(notification("2", "compliance", "revoke_model", 1))
+	d.Wait()
+
+	// only the recent deliveries are kept, newest first
+	deliveries := d.Deliveries("", "")
+	require.Len(t, deliveries, 3)
+	require.Equal(t, []uint64{4, 3, 2}, []uint64{deliveries[0].ID, deliveries[1].ID, deliveries[2].ID})
+
+	deliveries = d.Deliveries(first.URL, StatusDelivered)
+	require.Len(t, deliveries, 1)
+	require.Equal(t, "2", deliveries[0].NotificationID)
+
+	require.Empty(t, d.Deliveries("", StatusFailed))
+}
+
+func TestDispatcher_Nil(t *testing.T) {
+	d := New(Config{})
+	require.Nil(t, d)
+
+	d.Dispatch(notification("1", "compliance", "revoke_model", 1))
+	d.Wait()
+	require.Empty(t, d.Deliveries("", ""))
+}
+
+func TestLoadWebhooks(t *testing.T) {
+	dir, err := ioutil.TempDir("", "webhooks")
+	require.NoError(t, err)
+
+	defer os.RemoveAll(dir)
+
+	write := func(content string) string {
+		path := filepath.Join(dir, "webhooks.json")
+		require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+
+		return path
+	}
+
+	webhooks, err := LoadWebhooks(write(`{"webhooks": [{"url": "https://portal.example.com/dcl", "secret": "s",
+		"events": [{"module": "compliance", "action": "revoke_model", "vid": 1}]}]}`))
+	require.NoError(t, err)
+	require.Equal(t, []Webhook{{URL: "https://portal.example.com/dcl", Secret: "s",
+		Events: []Filter{{Module: "compliance", Action: "revoke_model", VID: 1}}}}, webhooks)
+
+	for _, content := range []string{
+		`{"webhooks": []}`,
+		`{"webhooks": [{"url": "ftp://portal.example.com", "secret": "s"}]}`,
+		`{"webhooks": [{"url": "/dcl", "secret": "s"}]}`,
+		`{"webhooks": [{"url": "https://portal.example.com"}]}`,
+		`{"webhooks": `,
+	} {
+		_, err := LoadWebhooks(write(content))
+		require.Error(t, err, content)
+	}
+
+	_, err = LoadWebhooks(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
+
+func TestSign(t *testing.T) {
+	// HMAC-SHA256 test case 2 of RFC 4231
+	require.Equal(t, "sha256=5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843",
+		Sign("Jefe", []byte("what do ya want for nothing?")))
+}
@@ -0,0 +1,46 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package approvals contains the voting primitives shared by the modules which require several trustees
+// to approve an operation (adding of root certificates, creating of accounts, admission of validators, etc.):
+// the approval tracking, the quorum policy and the store of proposals expiring after the policy period.
+// The proposed root certificates, pending accounts and pending validators are kept in the ProposalStore,
+// the other proposals (revocations, upgrades, parameter changes, etc.) do not expire and are kept
+// by their modules.
+package approvals
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasApprovalFrom checks whether the given list of approvals contains the approval from the address.
+func HasApprovalFrom(approvals []sdk.AccAddress, address sdk.Address) bool {
+	for _, approval := range approvals {
+		if approval.Equals(address) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AddApproval appends the approval from the address to the list of approvals.
+// The second returned value is false if the list already contains the approval from the address.
+func AddApproval(approvals []sdk.AccAddress, address sdk.AccAddress) ([]sdk.AccAddress, bool) {
+	if HasApprovalFrom(approvals, address) {
+		return approvals, false
+	}
+
+	return append(approvals, address), true
+}
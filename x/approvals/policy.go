@@ -0,0 +1,79 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package approvals
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NoExpiration is used for proposals which stay pending until they are approved or rejected.
+const NoExpiration int64 = 0
+
+// Quorum defines how many votes are required to accept a proposal.
+type Quorum interface {
+	// Required returns the number of votes required to accept a proposal
+	// when there are `voters` accounts eligible to vote.
+	Required(voters int) int
+}
+
+// FixedQuorum requires the fixed number of votes regardless of the number of voters.
+type FixedQuorum int
+
+func (q FixedQuorum) Required(voters int) int {
+	return int(q)
+}
+
+// DecQuorum requires the given share (from 0 to 1) of voters to vote. The share is a decimal
+// to be stored on the ledger (e.g. in the module params).
+type DecQuorum sdk.Dec
+
+func (q DecQuorum) Required(voters int) int {
+	// round half away from zero
+	return int(sdk.Dec(q).MulInt64(int64(voters)).Add(sdk.NewDecWithPrec(5, 1)).TruncateInt64())
+}
+
+// Policy combines the quorum and the expiration period (in blocks) of proposals.
+// The proposals without expiration stay pending until they are accepted or rejected.
+type Policy struct {
+	Quorum           Quorum
+	ExpirationPeriod int64
+}
+
+func NewPolicy(quorum Quorum, expirationPeriod int64) Policy {
+	return Policy{
+		Quorum:           quorum,
+		ExpirationPeriod: expirationPeriod,
+	}
+}
+
+// RequiredVotes returns the number of votes required to accept a proposal.
+func (p Policy) RequiredVotes(voters int) int {
+	return p.Quorum.Required(voters)
+}
+
+// IsSingleVoteEnough checks whether a proposal can be accepted right away by its proposer.
+func (p Policy) IsSingleVoteEnough(voters int) bool {
+	return p.RequiredVotes(voters) <= 1
+}
+
+// IsReached checks whether the given number of votes is enough to accept a proposal.
+func (p Policy) IsReached(votes int, voters int) bool {
+	return votes >= p.RequiredVotes(voters)
+}
+
+// IsExpired checks whether a proposal created at `proposedAt` height is expired at `height`.
+func (p Policy) IsExpired(proposedAt int64, height int64) bool {
+	return p.ExpirationPeriod != NoExpiration && height-proposedAt > p.ExpirationPeriod
+}
@@ -0,0 +1,78 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package approvals
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+)
+
+func TestFixedQuorum(t *testing.T) {
+	policy := NewPolicy(FixedQuorum(2), NoExpiration)
+
+	require.Equal(t, 2, policy.RequiredVotes(0))
+	require.Equal(t, 2, policy.RequiredVotes(10))
+	require.False(t, policy.IsSingleVoteEnough(10))
+	require.False(t, policy.IsReached(1, 10))
+	require.True(t, policy.IsReached(2, 10))
+	require.True(t, policy.IsReached(3, 10))
+}
+
+func TestDecQuorum(t *testing.T) {
+	policy := NewPolicy(DecQuorum(sdk.NewDecWithPrec(66, 2)), NoExpiration)
+
+	require.Equal(t, 1, policy.RequiredVotes(1))
+	require.True(t, policy.IsSingleVoteEnough(1))
+	require.Equal(t, 2, policy.RequiredVotes(3))
+	require.False(t, policy.IsSingleVoteEnough(3))
+	require.Equal(t, 3, policy.RequiredVotes(4))
+	require.False(t, policy.IsReached(2, 4))
+	require.True(t, policy.IsReached(3, 4))
+	require.Equal(t, 66, policy.RequiredVotes(100))
+
+	require.Equal(t, 0, DecQuorum(sdk.OneDec()).Required(0))
+	require.Equal(t, 1, DecQuorum(sdk.OneDec()).Required(1))
+	require.Equal(t, 3, DecQuorum(sdk.NewDecWithPrec(5, 1)).Required(5))
+}
+
+func TestPolicy_IsExpired(t *testing.T) {
+	policy := NewPolicy(FixedQuorum(1), NoExpiration)
+	require.False(t, policy.IsExpired(1, 1000000))
+
+	policy = NewPolicy(FixedQuorum(1), 10)
+	require.False(t, policy.IsExpired(5, 15))
+	require.True(t, policy.IsExpired(5, 16))
+}
+
+func TestAddApproval(t *testing.T) {
+	var approvals []sdk.AccAddress
+
+	approvals, added := AddApproval(approvals, testconstants.Address1)
+	require.True(t, added)
+	require.True(t, HasApprovalFrom(approvals, testconstants.Address1))
+	require.False(t, HasApprovalFrom(approvals, testconstants.Address2))
+
+	approvals, added = AddApproval(approvals, testconstants.Address1)
+	require.False(t, added)
+	require.Equal(t, []sdk.AccAddress{testconstants.Address1}, approvals)
+
+	approvals, added = AddApproval(approvals, testconstants.Address2)
+	require.True(t, added)
+	require.Equal(t, []sdk.AccAddress{testconstants.Address1, testconstants.Address2}, approvals)
+}
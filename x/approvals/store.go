@@ -0,0 +1,162 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package approvals
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Proposal is a pending record waiting for the approvals.
+type Proposal interface {
+	// GetProposedAt returns the height the proposal was created at.
+	GetProposedAt() int64
+}
+
+// ProposalStore keeps the pending proposals of a module in its KVStore together with the index
+// of the proposals by the height they were created at, so that the expired ones can be found.
+//
+// The proposals are stored under the keys built by the module (all starting with `prefix`).
+// The index entries are stored under `expirationPrefix` followed by the big-endian encoded height and the key
+// of the proposal.
+type ProposalStore struct {
+	storeKey         sdk.StoreKey
+	cdc              *codec.Codec
+	prefix           []byte
+	expirationPrefix []byte
+	newProposal      func() Proposal
+}
+
+// NewProposalStore creates a new ProposalStore. `newProposal` must return a pointer to an empty proposal
+// the stored ones are decoded into.
+func NewProposalStore(storeKey sdk.StoreKey, cdc *codec.Codec, prefix []byte, expirationPrefix []byte,
+	newProposal func() Proposal) ProposalStore {
+	return ProposalStore{
+		storeKey:         storeKey,
+		cdc:              cdc,
+		prefix:           prefix,
+		expirationPrefix: expirationPrefix,
+		newProposal:      newProposal,
+	}
+}
+
+// Has checks if the proposal is present in the store or not.
+func (s ProposalStore) Has(ctx sdk.Context, key []byte) bool {
+	return ctx.KVStore(s.storeKey).Has(key)
+}
+
+// Get decodes the proposal into the given pointer. Returns false if the proposal does not exist.
+func (s ProposalStore) Get(ctx sdk.Context, key []byte, proposal Proposal) bool {
+	bz := ctx.KVStore(s.storeKey).Get(key)
+	if bz == nil {
+		return false
+	}
+
+	s.cdc.MustUnmarshalBinaryBare(bz, proposal)
+
+	return true
+}
+
+// Set stores the proposal and indexes it by the height it was created at.
+func (s ProposalStore) Set(ctx sdk.Context, key []byte, proposal Proposal) {
+	store := ctx.KVStore(s.storeKey)
+
+	if bz := store.Get(key); bz != nil {
+		existing := s.newProposal()
+		s.cdc.MustUnmarshalBinaryBare(bz, existing)
+		store.Delete(s.expirationKey(existing.GetProposedAt(), key))
+	}
+
+	store.Set(key, s.cdc.MustMarshalBinaryBare(proposal))
+	store.Set(s.expirationKey(proposal.GetProposedAt(), key), key)
+}
+
+// Delete deletes the proposal and its index entry. Does nothing if the proposal does not exist.
+func (s ProposalStore) Delete(ctx sdk.Context, key []byte) {
+	store := ctx.KVStore(s.storeKey)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return
+	}
+
+	existing := s.newProposal()
+	s.cdc.MustUnmarshalBinaryBare(bz, existing)
+
+	store.Delete(s.expirationKey(existing.GetProposedAt(), key))
+	store.Delete(key)
+}
+
+// Iterate iterates over all the proposals in the order of their keys.
+// The passed proposals are the pointers returned by `newProposal`.
+func (s ProposalStore) Iterate(ctx sdk.Context, process func(proposal Proposal) (stop bool)) {
+	store := ctx.KVStore(s.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, s.prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		proposal := s.newProposal()
+		s.cdc.MustUnmarshalBinaryBare(iter.Value(), proposal)
+
+		if process(proposal) {
+			return
+		}
+	}
+}
+
+// DeleteExpired deletes up to `limit` proposals expired at the current height according to the policy
+// (the oldest go first) and returns them.
+func (s ProposalStore) DeleteExpired(ctx sdk.Context, policy Policy, limit int) []Proposal {
+	if policy.ExpirationPeriod == NoExpiration || ctx.BlockHeight()-policy.ExpirationPeriod <= 0 {
+		return nil
+	}
+
+	store := ctx.KVStore(s.storeKey)
+
+	// the proposals created before `end` height are expired
+	end := ctx.BlockHeight() - policy.ExpirationPeriod
+
+	iter := store.Iterator(s.expirationKey(0, nil), s.expirationKey(end, nil))
+
+	var indexKeys [][]byte
+
+	for ; iter.Valid() && len(indexKeys) < limit; iter.Next() {
+		indexKeys = append(indexKeys, iter.Key())
+	}
+
+	iter.Close()
+
+	expired := make([]Proposal, 0, len(indexKeys))
+
+	for _, indexKey := range indexKeys {
+		key := store.Get(indexKey)
+
+		proposal := s.newProposal()
+		if s.Get(ctx, key, proposal) {
+			expired = append(expired, proposal)
+			s.Delete(ctx, key)
+		}
+
+		// in case of a stale index entry
+		store.Delete(indexKey)
+	}
+
+	return expired
+}
+
+func (s ProposalStore) expirationKey(proposedAt int64, key []byte) []byte {
+	return append(append(append([]byte{}, s.expirationPrefix...), sdk.Uint64ToBigEndian(uint64(proposedAt))...), key...)
+}
@@ -0,0 +1,140 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package approvals
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var (
+	testProposalPrefix   = []byte{0x01}
+	testExpirationPrefix = []byte{0x02}
+)
+
+type testProposal struct {
+	ID         string `json:"id"`
+	ProposedAt int64  `json:"proposed_at"`
+}
+
+func (p testProposal) GetProposedAt() int64 {
+	return p.ProposedAt
+}
+
+func testProposalKey(id string) []byte {
+	return append(append([]byte{}, testProposalPrefix...), []byte(id)...)
+}
+
+func setupProposalStore() (sdk.Context, ProposalStore) {
+	db := dbm.NewMemDB()
+	dbStore := store.NewCommitMultiStore(db)
+	key := sdk.NewKVStoreKey("test")
+	dbStore.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
+	_ = dbStore.LoadLatestVersion()
+
+	ctx := sdk.NewContext(dbStore, abci.Header{ChainID: "dcl-test-chain-id"}, false, log.NewNopLogger())
+
+	proposals := NewProposalStore(key, codec.New(), testProposalPrefix, testExpirationPrefix,
+		func() Proposal { return &testProposal{} })
+
+	return ctx, proposals
+}
+
+func setTestProposal(ctx sdk.Context, proposals ProposalStore, id string, proposedAt int64) {
+	proposals.Set(ctx, testProposalKey(id), testProposal{ID: id, ProposedAt: proposedAt})
+}
+
+func proposalIDs(ctx sdk.Context, proposals ProposalStore) []string {
+	var ids []string
+
+	proposals.Iterate(ctx, func(proposal Proposal) (stop bool) {
+		ids = append(ids, proposal.(*testProposal).ID)
+
+		return false
+	})
+
+	return ids
+}
+
+func TestProposalStore_SetGetDelete(t *testing.T) {
+	ctx, proposals := setupProposalStore()
+
+	require.False(t, proposals.Has(ctx, testProposalKey("a")))
+	require.False(t, proposals.Get(ctx, testProposalKey("a"), &testProposal{}))
+
+	setTestProposal(ctx, proposals, "a", 5)
+	setTestProposal(ctx, proposals, "b", 3)
+
+	var proposal testProposal
+
+	require.True(t, proposals.Has(ctx, testProposalKey("a")))
+	require.True(t, proposals.Get(ctx, testProposalKey("a"), &proposal))
+	require.Equal(t, testProposal{ID: "a", ProposedAt: 5}, proposal)
+	require.Equal(t, []string{"a", "b"}, proposalIDs(ctx, proposals))
+
+	proposals.Delete(ctx, testProposalKey("a"))
+	require.False(t, proposals.Has(ctx, testProposalKey("a")))
+	require.Equal(t, []string{"b"}, proposalIDs(ctx, proposals))
+
+	// the index entry is deleted too
+	ctx = ctx.WithBlockHeight(100)
+	expired := proposals.DeleteExpired(ctx, NewPolicy(FixedQuorum(1), 10), 10)
+	require.Equal(t, []Proposal{&testProposal{ID: "b", ProposedAt: 3}}, expired)
+	require.Empty(t, proposalIDs(ctx, proposals))
+}
+
+func TestProposalStore_DeleteExpired(t *testing.T) {
+	ctx, proposals := setupProposalStore()
+
+	setTestProposal(ctx, proposals, "a", 5)
+	setTestProposal(ctx, proposals, "b", 3)
+	setTestProposal(ctx, proposals, "c", 4)
+	setTestProposal(ctx, proposals, "d", 10)
+
+	policy := NewPolicy(FixedQuorum(1), 10)
+
+	// nothing is expired yet
+	require.Empty(t, proposals.DeleteExpired(ctx.WithBlockHeight(13), policy, 10))
+	require.Empty(t, proposals.DeleteExpired(ctx.WithBlockHeight(100), NewPolicy(FixedQuorum(1), NoExpiration), 10))
+
+	// the oldest go first, up to the limit
+	expired := proposals.DeleteExpired(ctx.WithBlockHeight(16), policy, 2)
+	require.Equal(t, []Proposal{&testProposal{ID: "b", ProposedAt: 3}, &testProposal{ID: "c", ProposedAt: 4}}, expired)
+
+	expired = proposals.DeleteExpired(ctx.WithBlockHeight(16), policy, 2)
+	require.Equal(t, []Proposal{&testProposal{ID: "a", ProposedAt: 5}}, expired)
+
+	require.Equal(t, []string{"d"}, proposalIDs(ctx, proposals))
+}
+
+func TestProposalStore_SetReindexesChangedHeight(t *testing.T) {
+	ctx, proposals := setupProposalStore()
+
+	setTestProposal(ctx, proposals, "a", 0)
+	setTestProposal(ctx, proposals, "a", 20)
+
+	require.Empty(t, proposals.DeleteExpired(ctx.WithBlockHeight(25), NewPolicy(FixedQuorum(1), 10), 10))
+
+	expired := proposals.DeleteExpired(ctx.WithBlockHeight(31), NewPolicy(FixedQuorum(1), 10), 10)
+	require.Equal(t, []Proposal{&testProposal{ID: "a", ProposedAt: 20}}, expired)
+}
@@ -0,0 +1,60 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/audit/internal/keeper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/audit/internal/types"
+)
+
+const (
+	ModuleName                 = types.ModuleName
+	RouterKey                  = types.RouterKey
+	StoreKey                   = types.StoreKey
+	CodeAuditEntryDoesNotExist = types.CodeAuditEntryDoesNotExist
+	CodeInvalidEntity          = types.CodeInvalidEntity
+
+	EntityModel       = types.EntityModel
+	EntityCertificate = types.EntityCertificate
+	EntityAccount     = types.EntityAccount
+	EntityValidator   = types.EntityValidator
+	EntityUpgrade     = types.EntityUpgrade
+
+	ChangeCreate = types.ChangeCreate
+	ChangeUpdate = types.ChangeUpdate
+	ChangeDelete = types.ChangeDelete
+)
+
+var (
+	NewKeeper                 = keeper.NewKeeper
+	NewQuerier                = keeper.NewQuerier
+	AuditEntryPrefix          = types.AuditEntryPrefix
+	NewEntity                 = types.NewEntity
+	NewChange                 = types.NewChange
+	NewChangesQueryParams     = types.NewChangesQueryParams
+	ModuleCdc                 = types.ModuleCdc
+	RegisterCodec             = types.RegisterCodec
+	ErrAuditEntryDoesNotExist = types.ErrAuditEntryDoesNotExist
+)
+
+type (
+	Keeper             = keeper.Keeper
+	AuditEntry         = types.AuditEntry
+	Entity             = types.Entity
+	ListAuditEntries   = types.ListAuditEntries
+	Change             = types.Change
+	ChangesQueryParams = types.ChangesQueryParams
+	ListChanges        = types.ListChanges
+)
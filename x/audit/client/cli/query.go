@@ -0,0 +1,296 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/audit/internal/types"
+)
+
+func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	auditQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the audit module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	auditQueryCmd.AddCommand(client.GetCommands(
+		GetCmdAuditEntry(storeKey, cdc),
+		GetCmdAllAuditEntries(storeKey, cdc),
+		GetCmdModelAuditEntries(storeKey, cdc),
+		GetCmdCertificateAuditEntries(storeKey, cdc),
+		GetCmdAccountAuditEntries(storeKey, cdc),
+		GetCmdValidatorAuditEntries(storeKey, cdc),
+		GetCmdUpgradeAuditEntries(storeKey, cdc),
+		GetCmdSignerAuditEntries(storeKey, cdc),
+		GetCmdChanges(storeKey, cdc),
+	)...)
+
+	return auditQueryCmd
+}
+
+func GetCmdAuditEntry(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "entry",
+		Short: "Query Audit Entry by its identifier",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			id, err_ := conversions.ParseUInt64FromString(viper.GetString(FlagID))
+			if err_ != nil {
+				return err_
+			}
+
+			res, height, err := cliCtx.QueryStore(types.GetAuditEntryKey(id), queryRoute)
+			if err != nil || res == nil {
+				return types.ErrAuditEntryDoesNotExist(id)
+			}
+
+			var entry types.AuditEntry
+			cdc.MustUnmarshalBinaryBare(res, &entry)
+
+			return cliCtx.EncodeAndPrintWithHeight(entry, height)
+		},
+	}
+
+	cmd.Flags().String(FlagID, "", "Identifier of the audit entry")
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	_ = cmd.MarkFlagRequired(FlagID)
+
+	return cmd
+}
+
+func GetCmdAllAuditEntries(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-entries",
+		Short: "Query the list of all Audit Entries in the order they were recorded",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryAuditEntries(cdc, fmt.Sprintf("custom/%s/all_audit_entries", queryRoute))
+		},
+	}
+
+	addPaginationFlags(cmd)
+
+	return cmd
+}
+
+func GetCmdModelAuditEntries(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "model-entries",
+		Short: "Query the list of Audit Entries of the given Model (or of all Models of the vendor if PID is omitted)",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			vid, err_ := conversions.ParseVID(viper.GetString(FlagVID))
+			if err_ != nil {
+				return err_
+			}
+
+			id := []string{fmt.Sprint(vid)}
+
+			if viper.GetString(FlagPID) != "" {
+				pid, err_ := conversions.ParsePID(viper.GetString(FlagPID))
+				if err_ != nil {
+					return err_
+				}
+
+				id = append(id, fmt.Sprint(pid))
+			}
+
+			return queryEntityAuditEntries(cdc, queryRoute, types.EntityModel, id...)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID (optional)")
+	addPaginationFlags(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+
+	return cmd
+}
+
+func GetCmdCertificateAuditEntries(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "certificate-entries",
+		Short: "Query the list of Audit Entries of the given certificate " +
+			"(or of all certificates with the subject if Subject Key ID is omitted)",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id := []string{viper.GetString(FlagSubject)}
+
+			if subjectKeyID := viper.GetString(FlagSubjectKeyID); subjectKeyID != "" {
+				id = append(id, subjectKeyID)
+			}
+
+			return queryEntityAuditEntries(cdc, queryRoute, types.EntityCertificate, id...)
+		},
+	}
+
+	cmd.Flags().String(FlagSubject, "", "Certificate's subject")
+	cmd.Flags().String(FlagSubjectKeyID, "", "Certificate's subject key id (hex) (optional)")
+	addPaginationFlags(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagSubject)
+
+	return cmd
+}
+
+func GetCmdAccountAuditEntries(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-entries",
+		Short: "Query the list of Audit Entries of the given account",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			return queryEntityAuditEntries(cdc, queryRoute, types.EntityAccount, address.String())
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "Bech32 encoded account address")
+	addPaginationFlags(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
+func GetCmdValidatorAuditEntries(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-entries",
+		Short: "Query the list of Audit Entries of the given validator",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := sdk.ConsAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			return queryEntityAuditEntries(cdc, queryRoute, types.EntityValidator, address.String())
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "Bech32 encoded validator consensus address")
+	addPaginationFlags(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
+func GetCmdUpgradeAuditEntries(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-entries",
+		Short: "Query the list of Audit Entries of the given upgrade plan",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryEntityAuditEntries(cdc, queryRoute, types.EntityUpgrade, viper.GetString(FlagName))
+		},
+	}
+
+	cmd.Flags().String(FlagName, "", "Name of the upgrade plan")
+	addPaginationFlags(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagName)
+
+	return cmd
+}
+
+func GetCmdSignerAuditEntries(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signer-entries",
+		Short: "Query the list of Audit Entries of the messages signed by the given account",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			return queryAuditEntries(cdc, fmt.Sprintf("custom/%s/signer_audit_entries/%s", queryRoute, address))
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "Bech32 encoded account address")
+	addPaginationFlags(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
+func GetCmdChanges(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "changes",
+		Short: "Query the feed of the entities created, updated and deleted after the given height",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			params := types.NewChangesQueryParams(pagination.ParsePaginationParamsFromFlags(),
+				viper.GetInt64(FlagSinceHeight), viper.GetString(FlagModule))
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/changes", queryRoute), params)
+		},
+	}
+
+	cmd.Flags().Int64(FlagSinceHeight, 0, "return the changes made after this height (the last one synced)")
+	cmd.Flags().String(FlagModule, "", "return the changes made by the messages of this module only (e.g. compliance)")
+	addPaginationFlags(cmd)
+
+	return cmd
+}
+
+func queryEntityAuditEntries(cdc *codec.Codec, queryRoute string, entityType string, id ...string) error {
+	for _, component := range id {
+		if !types.IsValidEntityIDComponent(component) {
+			return types.ErrInvalidEntity(entityType, id)
+		}
+	}
+
+	return queryAuditEntries(cdc, fmt.Sprintf("custom/%s/entity_audit_entries/%s/%s",
+		queryRoute, entityType, strings.Join(id, "/")))
+}
+
+func queryAuditEntries(cdc *codec.Codec, path string) error {
+	cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+	params := pagination.ParsePaginationParamsFromFlags()
+
+	return cliCtx.QueryList(path, params)
+}
+
+func addPaginationFlags(cmd *cobra.Command) {
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of entries to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of entries to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+}
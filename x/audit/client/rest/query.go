@@ -0,0 +1,203 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/audit/internal/types"
+)
+
+func getAllAuditEntriesHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		params, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/all_audit_entries", storeName), params)
+	}
+}
+
+func getAuditEntryHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		id, err_ := conversions.ParseUInt64FromString(vars[id])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		res, height, err := restCtx.QueryStore(types.GetAuditEntryKey(id), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrAuditEntryDoesNotExist(id))
+
+			return
+		}
+
+		var entry types.AuditEntry
+
+		cliCtx.Codec.MustUnmarshalBinaryBare(res, &entry)
+
+		restCtx.EncodeAndRespondWithHeight(entry, height)
+	}
+}
+
+// Returns the entries of the entity which identifier is built by parseID from the path variables.
+func getEntityAuditEntriesHandler(cliCtx context.CLIContext, storeName string, entityType string,
+	parseID func(vars map[string]string) ([]string, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		id, err := parseID(restCtx.Variables())
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		for _, component := range id {
+			if !types.IsValidEntityIDComponent(component) {
+				restCtx.WriteError(http.StatusBadRequest, types.ErrInvalidEntity(entityType, id))
+
+				return
+			}
+		}
+
+		params, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/entity_audit_entries/%s/%s",
+			storeName, entityType, strings.Join(id, "/")), params)
+	}
+}
+
+// VID and optional PID.
+func parseModelID(vars map[string]string) ([]string, error) {
+	vid, err := conversions.ParseVID(vars[vid])
+	if err != nil {
+		return nil, err
+	}
+
+	id := []string{fmt.Sprint(vid)}
+
+	if _, ok := vars[pid]; ok {
+		pid, err := conversions.ParsePID(vars[pid])
+		if err != nil {
+			return nil, err
+		}
+
+		id = append(id, fmt.Sprint(pid))
+	}
+
+	return id, nil
+}
+
+// Subject and optional Subject Key ID.
+func parseCertificateID(vars map[string]string) ([]string, error) {
+	id := []string{vars[subject]}
+
+	if subjectKeyID, ok := vars[subjectKeyID]; ok {
+		id = append(id, subjectKeyID)
+	}
+
+	return id, nil
+}
+
+func parseAccountID(vars map[string]string) ([]string, error) {
+	address, err := sdk.AccAddressFromBech32(vars[address])
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{address.String()}, nil
+}
+
+func parseValidatorID(vars map[string]string) ([]string, error) {
+	address, err := sdk.ConsAddressFromBech32(vars[address])
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{address.String()}, nil
+}
+
+func parseUpgradeID(vars map[string]string) ([]string, error) {
+	return []string{vars[name]}, nil
+}
+
+func getSignerAuditEntriesHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		signer, err := sdk.AccAddressFromBech32(vars[address])
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		params, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/signer_audit_entries/%s", storeName, signer), params)
+	}
+}
+
+func getChangesHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var height int64
+
+		if value := r.FormValue(sinceHeight); len(value) != 0 {
+			var err error
+			if height, err = strconv.ParseInt(value, 10, 64); err != nil || height < 0 {
+				restCtx.WriteErrorResponse(http.StatusBadRequest,
+					fmt.Sprintf("Invalid query parameter `%s`: %v must be a non-negative number", sinceHeight, value))
+
+				return
+			}
+		}
+
+		paginationParams, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		params := types.NewChangesQueryParams(paginationParams, height, r.FormValue(module))
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/changes", storeName), params)
+	}
+}
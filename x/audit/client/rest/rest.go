@@ -0,0 +1,83 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/audit/internal/types"
+)
+
+const (
+	id           = "id"
+	vid          = "vid"
+	pid          = "pid"
+	subject      = "subject"
+	subjectKeyID = "subject_key_id"
+	address      = "address"
+	name         = "name"
+	sinceHeight  = "since_height"
+	module       = "module"
+)
+
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
+	r.HandleFunc(
+		fmt.Sprintf("/%s/entries", storeName),
+		getAllAuditEntriesHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/entries/{%s}", storeName, id),
+		getAuditEntryHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/models/{%s}", storeName, vid),
+		getEntityAuditEntriesHandler(cliCtx, storeName, types.EntityModel, parseModelID),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/models/{%s}/{%s}", storeName, vid, pid),
+		getEntityAuditEntriesHandler(cliCtx, storeName, types.EntityModel, parseModelID),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/certs/{%s}", storeName, subject),
+		getEntityAuditEntriesHandler(cliCtx, storeName, types.EntityCertificate, parseCertificateID),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/certs/{%s}/{%s}", storeName, subject, subjectKeyID),
+		getEntityAuditEntriesHandler(cliCtx, storeName, types.EntityCertificate, parseCertificateID),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/accounts/{%s}", storeName, address),
+		getEntityAuditEntriesHandler(cliCtx, storeName, types.EntityAccount, parseAccountID),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/validators/{%s}", storeName, address),
+		getEntityAuditEntriesHandler(cliCtx, storeName, types.EntityValidator, parseValidatorID),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/upgrades/{%s}", storeName, name),
+		getEntityAuditEntriesHandler(cliCtx, storeName, types.EntityUpgrade, parseUpgradeID),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/signers/{%s}", storeName, address),
+		getSignerAuditEntriesHandler(cliCtx, storeName),
+	).Methods("GET")
+	// the feed of the changes of all modules is served at the root
+	r.HandleFunc(
+		"/changes",
+		getChangesHandler(cliCtx, storeName),
+	).Methods("GET")
+}
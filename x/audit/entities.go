@@ -0,0 +1,189 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliancetest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator"
+)
+
+// Returns the ledger entities changed by the message. The entries of the messages
+// unknown to the module are recorded without entities (and can be found by signer only).
+//
+//nolint:funlen,gocyclo
+func EntitiesOf(msg sdk.Msg) []Entity {
+	switch msg := msg.(type) {
+	// models
+	case modelinfo.MsgAddModelInfo:
+		return modelEntities(msg.VID, msg.PID)
+	case modelinfo.MsgUpdateModelInfo:
+		return modelEntities(msg.VID, msg.PID)
+	case modelinfo.MsgDeleteModelInfo:
+		return modelEntities(msg.VID, msg.PID)
+	case modelinfo.MsgAddModelVersion:
+		return modelEntities(msg.VID, msg.PID)
+	case modelinfo.MsgUpdateModelVersion:
+		return modelEntities(msg.VID, msg.PID)
+	case compliancetest.MsgAddTestingResult:
+		return modelEntities(msg.VID, msg.PID)
+	case compliance.MsgCertifyModel:
+		return modelEntities(msg.VID, msg.PID)
+	case compliance.MsgRevokeModel:
+		return modelEntities(msg.VID, msg.PID)
+	case compliance.MsgProvisionModel:
+		return modelEntities(msg.VID, msg.PID)
+	case compliance.MsgGrantComplianceAuthority:
+		return accountEntities(msg.Grantee)
+	case compliance.MsgRevokeComplianceAuthority:
+		return accountEntities(msg.Grantee)
+	case ota.MsgAddFirmwareImage:
+		return modelEntities(msg.VID, msg.PID)
+	case ota.MsgUpdateFirmwareImage:
+		return modelEntities(msg.VID, msg.PID)
+
+	// certificates
+	case pki.MsgProposeAddX509RootCert:
+		return pemCertificateEntities(msg.Cert)
+	case pki.MsgAddX509Cert:
+		return pemCertificateEntities(msg.Cert)
+	case pki.MsgApproveAddX509RootCert:
+		return certificateEntities(msg.Subject, msg.SubjectKeyID)
+	case pki.MsgRejectAddX509RootCert:
+		return certificateEntities(msg.Subject, msg.SubjectKeyID)
+	case pki.MsgProposeRevokeX509RootCert:
+		return certificateEntities(msg.Subject, msg.SubjectKeyID)
+	case pki.MsgApproveRevokeX509RootCert:
+		return certificateEntities(msg.Subject, msg.SubjectKeyID)
+	case pki.MsgRevokeX509Cert:
+		return certificateEntities(msg.Subject, msg.SubjectKeyID)
+	case pki.MsgAddCrlDistributionPoint:
+		return certificateEntities(msg.Subject, msg.SubjectKeyID)
+	case pki.MsgRemoveCrlDistributionPoint:
+		return certificateEntities(msg.Subject, msg.SubjectKeyID)
+
+	// accounts
+	case auth.MsgProposeAddAccount:
+		return accountEntities(msg.Address)
+	case auth.MsgApproveAddAccount:
+		return accountEntities(msg.Address)
+	case auth.MsgProposeRevokeAccount:
+		return accountEntities(msg.Address)
+	case auth.MsgApproveRevokeAccount:
+		return accountEntities(msg.Address)
+	case auth.MsgProposeUpdateVendorID:
+		return accountEntities(msg.Address)
+	case auth.MsgApproveUpdateVendorID:
+		return accountEntities(msg.Address)
+	case auth.MsgRotateKey:
+		return accountEntities(msg.Address)
+	case auth.MsgApproveRotateKey:
+		return accountEntities(msg.Address)
+	case auth.MsgFreezeAccount:
+		return accountEntities(msg.Address)
+	case auth.MsgUnfreezeAccount:
+		return accountEntities(msg.Address)
+
+	// validators
+	case validator.MsgCreateValidator:
+		return validatorEntities(msg.Address)
+	case validator.MsgProposeAddValidator:
+		return validatorEntities(msg.Address)
+	case validator.MsgApproveAddValidator:
+		return validatorEntities(msg.Address)
+	case validator.MsgRejectAddValidator:
+		return validatorEntities(msg.Address)
+	case validator.MsgDisableValidator:
+		return validatorEntities(msg.Address)
+	case validator.MsgEnableValidator:
+		return validatorEntities(msg.Address)
+
+	// upgrades
+	case upgrade.MsgProposeUpgrade:
+		return []Entity{NewEntity(EntityUpgrade, msg.Plan.Name)}
+	case upgrade.MsgApproveUpgrade:
+		return []Entity{NewEntity(EntityUpgrade, msg.Name)}
+	case upgrade.MsgCancelUpgrade:
+		return []Entity{NewEntity(EntityUpgrade, msg.Name)}
+
+	// grants (the messages executed on behalf are recorded by their own routes)
+	case grant.MsgGrant:
+		return accountEntities(msg.Grantee)
+	case grant.MsgRevoke:
+		return accountEntities(msg.Grantee)
+
+	default:
+		return []Entity{}
+	}
+}
+
+// Returns the kind of the change of the entities made by the message (see EntitiesOf).
+// The proposals are reported as created entities, the revocations of certificates and accounts as deleted ones.
+func ChangeOf(msg sdk.Msg) string {
+	switch msg.(type) {
+	case modelinfo.MsgAddModelInfo,
+		pki.MsgProposeAddX509RootCert,
+		pki.MsgAddX509Cert,
+		auth.MsgProposeAddAccount,
+		validator.MsgCreateValidator,
+		validator.MsgProposeAddValidator,
+		upgrade.MsgProposeUpgrade:
+		return ChangeCreate
+	case modelinfo.MsgDeleteModelInfo,
+		pki.MsgRejectAddX509RootCert,
+		pki.MsgApproveRevokeX509RootCert,
+		pki.MsgRevokeX509Cert,
+		auth.MsgApproveRevokeAccount,
+		validator.MsgRejectAddValidator,
+		upgrade.MsgCancelUpgrade:
+		return ChangeDelete
+	default:
+		return ChangeUpdate
+	}
+}
+
+func modelEntities(vid uint16, pid uint16) []Entity {
+	return []Entity{NewEntity(EntityModel, fmt.Sprint(vid), fmt.Sprint(pid))}
+}
+
+func certificateEntities(subject string, subjectKeyID string) []Entity {
+	return []Entity{NewEntity(EntityCertificate, subject, subjectKeyID)}
+}
+
+func pemCertificateEntities(pemCert string) []Entity {
+	certificate, err := pki.DecodeX509Certificate(pemCert)
+	if err != nil {
+		return []Entity{}
+	}
+
+	return certificateEntities(certificate.Subject, certificate.SubjectKeyID)
+}
+
+func accountEntities(address sdk.AccAddress) []Entity {
+	return []Entity{NewEntity(EntityAccount, address.String())}
+}
+
+func validatorEntities(address sdk.ConsAddress) []Entity {
+	return []Entity{NewEntity(EntityValidator, address.String())}
+}
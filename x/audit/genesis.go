@@ -0,0 +1,86 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/audit/internal/types"
+)
+
+type GenesisState struct {
+	AuditEntryRecords []AuditEntry `json:"audit_entry_records"`
+}
+
+func NewGenesisState() GenesisState {
+	return GenesisState{AuditEntryRecords: []AuditEntry{}}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	ids := make(map[uint64]bool, len(data.AuditEntryRecords))
+
+	for _, record := range data.AuditEntryRecords {
+		if record.ID == 0 || ids[record.ID] {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid AuditEntry: Invalid or duplicated ID. Value: %v", record))
+		}
+
+		ids[record.ID] = true
+
+		if record.Module == "" || record.Action == "" {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid AuditEntry: The fields Module and Action "+
+				"must be specified. Value: %v", record))
+		}
+
+		for _, entity := range record.Entities {
+			if !types.IsValidEntityIDComponent(entity.Type) {
+				return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid AuditEntry: Invalid entity type. Value: %v", record))
+			}
+
+			for _, component := range entity.ID {
+				if !types.IsValidEntityIDComponent(component) {
+					return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid AuditEntry: Invalid entity ID. Value: %v", record))
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState()
+}
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.ValidatorUpdate {
+	for _, record := range data.AuditEntryRecords {
+		keeper.SetAuditEntry(ctx, record)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	var records []AuditEntry
+
+	k.IterateAuditEntries(ctx, func(entry types.AuditEntry) (stop bool) {
+		records = append(records, entry)
+
+		return false
+	})
+
+	return GenesisState{AuditEntryRecords: records}
+}
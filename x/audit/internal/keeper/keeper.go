@@ -0,0 +1,214 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/audit/internal/types"
+)
+
+type Keeper struct {
+	// Unexposed key to access store from sdk.Context.
+	storeKey sdk.StoreKey
+
+	// The wire codec for binary encoding/decoding.
+	cdc *codec.Codec
+}
+
+func NewKeeper(storeKey sdk.StoreKey, cdc *codec.Codec) Keeper {
+	return Keeper{storeKey: storeKey, cdc: cdc}
+}
+
+// Records a new Audit Entry assigning it the next identifier. Returns the identifier of the entry.
+// The entries are never changed or removed afterwards.
+func (k Keeper) AppendAuditEntry(ctx sdk.Context, entry types.AuditEntry) uint64 {
+	entry.ID = k.GetLastAuditEntryID(ctx) + 1
+
+	k.SetAuditEntry(ctx, entry)
+
+	return entry.ID
+}
+
+// Sets the entire AuditEntry struct together with its index records (used for the genesis import).
+func (k Keeper) SetAuditEntry(ctx sdk.Context, entry types.AuditEntry) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetAuditEntryKey(entry.ID), k.cdc.MustMarshalBinaryBare(entry))
+
+	for _, entity := range entry.Entities {
+		store.Set(types.GetEntityIndexKey(entity, entry.ID), []byte{})
+	}
+
+	for _, signer := range entry.Signers {
+		store.Set(types.GetSignerIndexKey(signer, entry.ID), []byte{})
+	}
+
+	if entry.ID > k.GetLastAuditEntryID(ctx) {
+		store.Set(types.LastEntryIDKey, types.EncodeID(entry.ID))
+	}
+}
+
+// Gets the entire AuditEntry struct by its identifier.
+func (k Keeper) GetAuditEntry(ctx sdk.Context, id uint64) types.AuditEntry {
+	if !k.IsAuditEntryPresent(ctx, id) {
+		panic("AuditEntry does not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAuditEntryKey(id))
+
+	var entry types.AuditEntry
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &entry)
+
+	return entry
+}
+
+// Check if the AuditEntry is present in the store or not.
+func (k Keeper) IsAuditEntryPresent(ctx sdk.Context, id uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetAuditEntryKey(id))
+}
+
+// Gets the identifier of the last recorded Audit Entry (0 if there are no entries).
+func (k Keeper) GetLastAuditEntryID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.LastEntryIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return types.DecodeID(bz)
+}
+
+// Iterate over all AuditEntries in the order they were recorded.
+func (k Keeper) IterateAuditEntries(ctx sdk.Context, process func(entry types.AuditEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.AuditEntryPrefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		var entry types.AuditEntry
+
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &entry)
+
+		if process(entry) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+// Iterate over the AuditEntries referenced by the keys with the given prefix (the entries themselves
+// or index records of them). The key of the record is passed along with the entry.
+func (k Keeper) IterateAuditEntriesByPrefix(ctx sdk.Context, prefix []byte,
+	process func(key []byte, entry types.AuditEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		if process(iter.Key(), k.GetAuditEntry(ctx, types.GetIDFromKey(iter.Key()))) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+// Iterate over the AuditEntries recorded after the given height in the order they were recorded.
+// The key of the entry is passed along with it.
+func (k Keeper) IterateAuditEntriesSince(ctx sdk.Context, height int64,
+	process func(key []byte, entry types.AuditEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	start := types.GetAuditEntryKey(k.firstAuditEntryIDAfter(ctx, height))
+
+	iter := store.Iterator(start, sdk.PrefixEndBytes(types.AuditEntryPrefix))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var entry types.AuditEntry
+
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &entry)
+
+		if process(iter.Key(), entry) {
+			return
+		}
+	}
+}
+
+// Finds the identifier of the first AuditEntry recorded after the given height.
+// The entries are recorded in the order of the blocks, so their heights are searched by bisection.
+func (k Keeper) firstAuditEntryIDAfter(ctx sdk.Context, height int64) uint64 {
+	last := k.GetLastAuditEntryID(ctx)
+
+	index := sort.Search(int(last), func(i int) bool {
+		entry, found := k.nextAuditEntry(ctx, uint64(i)+1)
+
+		return !found || entry.Height > height
+	})
+
+	return uint64(index) + 1
+}
+
+// Gets the AuditEntry with the given identifier or the next recorded one
+// (the identifiers restored on the genesis import may have gaps).
+func (k Keeper) nextAuditEntry(ctx sdk.Context, id uint64) (types.AuditEntry, bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := store.Iterator(types.GetAuditEntryKey(id), sdk.PrefixEndBytes(types.AuditEntryPrefix))
+	defer iter.Close()
+
+	var entry types.AuditEntry
+
+	if !iter.Valid() {
+		return entry, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(iter.Value(), &entry)
+
+	return entry, true
+}
+
+func (k Keeper) CountTotalAuditEntries(ctx sdk.Context, prefix []byte) int {
+	store := ctx.KVStore(k.storeKey)
+	res := 0
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		res++
+	}
+
+	return res
+}
@@ -0,0 +1,106 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/audit/internal/types"
+)
+
+func TestKeeper_AuditEntryAppendGet(t *testing.T) {
+	setup := Setup()
+
+	// no entries before they are recorded
+	require.Equal(t, uint64(0), setup.AuditKeeper.GetLastAuditEntryID(setup.Ctx))
+	require.False(t, setup.AuditKeeper.IsAuditEntryPresent(setup.Ctx, 1))
+	require.Panics(t, func() {
+		setup.AuditKeeper.GetAuditEntry(setup.Ctx, 1)
+	})
+
+	// append entries
+	require.Equal(t, uint64(1), setup.AuditKeeper.AppendAuditEntry(setup.Ctx, DefaultAuditEntry()))
+	require.Equal(t, uint64(2), setup.AuditKeeper.AppendAuditEntry(setup.Ctx, DefaultAuditEntry()))
+
+	// check
+	require.Equal(t, uint64(2), setup.AuditKeeper.GetLastAuditEntryID(setup.Ctx))
+	require.True(t, setup.AuditKeeper.IsAuditEntryPresent(setup.Ctx, 1))
+
+	expected := DefaultAuditEntry()
+	expected.ID = 2
+	require.Equal(t, expected, setup.AuditKeeper.GetAuditEntry(setup.Ctx, 2))
+}
+
+func TestKeeper_SetAuditEntryContinuesIdentifiers(t *testing.T) {
+	setup := Setup()
+
+	// restore entry as on genesis import
+	entry := DefaultAuditEntry()
+	entry.ID = 5
+	setup.AuditKeeper.SetAuditEntry(setup.Ctx, entry)
+
+	// the next entry gets the following identifier
+	require.Equal(t, uint64(6), setup.AuditKeeper.AppendAuditEntry(setup.Ctx, DefaultAuditEntry()))
+
+	// restoring an older entry does not move the last identifier back
+	entry.ID = 3
+	setup.AuditKeeper.SetAuditEntry(setup.Ctx, entry)
+	require.Equal(t, uint64(6), setup.AuditKeeper.GetLastAuditEntryID(setup.Ctx))
+}
+
+func TestKeeper_AuditEntryIndexes(t *testing.T) {
+	setup := Setup()
+
+	// entries of two models of the same vendor and of another vendor
+	entry := DefaultAuditEntry()
+	setup.AuditKeeper.AppendAuditEntry(setup.Ctx, entry)
+
+	entry.Entities = []types.Entity{ModelEntity(testconstants.VID, testconstants.PID+1)}
+	setup.AuditKeeper.AppendAuditEntry(setup.Ctx, entry)
+
+	entry.Entities = []types.Entity{ModelEntity(testconstants.VID+1, testconstants.PID)}
+	entry.Signers = []sdk.AccAddress{testconstants.Address2}
+	setup.AuditKeeper.AppendAuditEntry(setup.Ctx, entry)
+
+	// check
+	vid := fmt.Sprint(testconstants.VID)
+	pid := fmt.Sprint(testconstants.PID)
+
+	require.Equal(t, 3, setup.AuditKeeper.CountTotalAuditEntries(setup.Ctx, types.AuditEntryPrefix))
+	require.Equal(t, 3, setup.AuditKeeper.CountTotalAuditEntries(setup.Ctx,
+		types.GetEntityIndexPrefix(types.EntityModel)))
+	require.Equal(t, 2, setup.AuditKeeper.CountTotalAuditEntries(setup.Ctx,
+		types.GetEntityIndexPrefix(types.EntityModel, vid)))
+	require.Equal(t, 1, setup.AuditKeeper.CountTotalAuditEntries(setup.Ctx,
+		types.GetEntityIndexPrefix(types.EntityModel, vid, pid)))
+	require.Equal(t, 2, setup.AuditKeeper.CountTotalAuditEntries(setup.Ctx,
+		types.GetSignerIndexPrefix(testconstants.Signer)))
+
+	var ids []uint64
+
+	setup.AuditKeeper.IterateAuditEntriesByPrefix(setup.Ctx, types.GetEntityIndexPrefix(types.EntityModel, vid),
+		func(key []byte, entry types.AuditEntry) (stop bool) {
+			ids = append(ids, entry.ID)
+
+			return false
+		})
+
+	require.Equal(t, []uint64{1, 2}, ids)
+}
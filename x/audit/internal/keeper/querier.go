@@ -0,0 +1,153 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/audit/internal/types"
+)
+
+const (
+	QueryAllAuditEntries    = "all_audit_entries"
+	QueryEntityAuditEntries = "entity_audit_entries"
+	QuerySignerAuditEntries = "signer_audit_entries"
+	QueryChanges            = "changes"
+)
+
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryAllAuditEntries:
+			return queryAuditEntries(ctx, types.AuditEntryPrefix, req, keeper)
+		case QueryEntityAuditEntries:
+			return queryEntityAuditEntries(ctx, path[1:], req, keeper)
+		case QuerySignerAuditEntries:
+			return querySignerAuditEntries(ctx, path[1:], req, keeper)
+		case QueryChanges:
+			return queryChanges(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown audit query endpoint")
+		}
+	}
+}
+
+// Returns the entries of the entity with the given type and identifier (or its leading components,
+// e.g. only VID for all Models of a vendor).
+func queryEntityAuditEntries(ctx sdk.Context, path []string, req abci.RequestQuery,
+	keeper Keeper) (res []byte, err sdk.Error) {
+	if len(path) == 0 {
+		return nil, types.ErrInvalidEntity("", "")
+	}
+
+	for _, component := range path {
+		if !types.IsValidEntityIDComponent(component) {
+			return nil, types.ErrInvalidEntity(path[0], path[1:])
+		}
+	}
+
+	return queryAuditEntries(ctx, types.GetEntityIndexPrefix(path[0], path[1:]...), req, keeper)
+}
+
+func querySignerAuditEntries(ctx sdk.Context, path []string, req abci.RequestQuery,
+	keeper Keeper) (res []byte, err sdk.Error) {
+	signer, err_ := sdk.AccAddressFromBech32(path[0])
+	if err_ != nil {
+		return nil, sdk.ErrInvalidAddress(fmt.Sprintf("Invalid signer address: %v", err_))
+	}
+
+	return queryAuditEntries(ctx, types.GetSignerIndexPrefix(signer), req, keeper)
+}
+
+func queryAuditEntries(ctx sdk.Context, prefix []byte, req abci.RequestQuery,
+	keeper Keeper) (res []byte, err sdk.Error) {
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := types.ListAuditEntries{
+		Total: keeper.CountTotalAuditEntries(ctx, prefix),
+		Items: []types.AuditEntry{},
+	}
+
+	keeper.IterateAuditEntriesByPrefix(ctx, prefix, func(key []byte, entry types.AuditEntry) (stop bool) {
+		if paginator.Add(key) {
+			result.Items = append(result.Items, entry)
+		}
+
+		return paginator.Done()
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+// Returns the changes made after the given height (optionally, by the messages of the given module only)
+// in the order they were made.
+func queryChanges(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params types.ChangesQueryParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	if params.SinceHeight < 0 {
+		return nil, sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid query parameter `since_height`: %v must be non-negative", params.SinceHeight))
+	}
+
+	paginator, err := pagination.NewPaginator(ctx, params.PaginationParams())
+	if err != nil {
+		return nil, err
+	}
+
+	result := types.ListChanges{
+		Items: []types.Change{},
+	}
+
+	keeper.IterateAuditEntriesSince(ctx, params.SinceHeight, func(key []byte, entry types.AuditEntry) (stop bool) {
+		if len(params.Module) != 0 && entry.Module != params.Module {
+			return false
+		}
+
+		result.Total++
+
+		if paginator.Add(key) {
+			result.Items = append(result.Items, types.NewChange(entry))
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
@@ -0,0 +1,216 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/audit/internal/types"
+)
+
+func TestQuerier_QueryAllAuditEntries(t *testing.T) {
+	setup := Setup()
+
+	// add 5 entries
+	count := 5
+	for i := 1; i <= count; i++ {
+		setup.AuditKeeper.AppendAuditEntry(setup.Ctx, DefaultAuditEntry())
+	}
+
+	// query entries skip=1 take=2
+	entries := getAuditEntries(setup, []string{QueryAllAuditEntries}, pagination.NewPaginationParams(1, 2))
+
+	// check
+	require.Equal(t, count, entries.Total)
+	require.Equal(t, 2, len(entries.Items))
+	require.Equal(t, uint64(2), entries.Items[0].ID)
+	require.Equal(t, uint64(3), entries.Items[1].ID)
+	require.NotEmpty(t, entries.NextKey)
+
+	// query the next page by key
+	entries = getAuditEntries(setup, []string{QueryAllAuditEntries},
+		pagination.NewCursorPaginationParams(entries.NextKey, 2))
+	require.Equal(t, uint64(4), entries.Items[0].ID)
+	require.Equal(t, uint64(5), entries.Items[1].ID)
+	require.Empty(t, entries.NextKey)
+}
+
+func TestQuerier_QueryEntityAuditEntries(t *testing.T) {
+	setup := Setup()
+
+	// entries of two models of the same vendor
+	entry := DefaultAuditEntry()
+	setup.AuditKeeper.AppendAuditEntry(setup.Ctx, entry)
+
+	entry.Entities = []types.Entity{ModelEntity(testconstants.VID, testconstants.PID+1)}
+	setup.AuditKeeper.AppendAuditEntry(setup.Ctx, entry)
+
+	vid := fmt.Sprint(testconstants.VID)
+
+	// query entries of the model
+	entries := getAuditEntries(setup,
+		[]string{QueryEntityAuditEntries, types.EntityModel, vid, fmt.Sprint(testconstants.PID + 1)},
+		pagination.NewPaginationParams(0, 0))
+	require.Equal(t, 1, entries.Total)
+	require.Equal(t, uint64(2), entries.Items[0].ID)
+
+	// query entries of all models of the vendor
+	entries = getAuditEntries(setup, []string{QueryEntityAuditEntries, types.EntityModel, vid},
+		pagination.NewPaginationParams(0, 0))
+	require.Equal(t, 2, entries.Total)
+
+	// query entries of unknown entity
+	entries = getAuditEntries(setup, []string{QueryEntityAuditEntries, types.EntityAccount, vid},
+		pagination.NewPaginationParams(0, 0))
+	require.Equal(t, 0, entries.Total)
+	require.Empty(t, entries.Items)
+
+	// query without entity type
+	_, err := setup.Querier(setup.Ctx, []string{QueryEntityAuditEntries}, abci.RequestQuery{})
+	require.Equal(t, types.CodeInvalidEntity, err.Code())
+}
+
+func TestQuerier_QuerySignerAuditEntries(t *testing.T) {
+	setup := Setup()
+
+	// entries signed by two accounts
+	entry := DefaultAuditEntry()
+	setup.AuditKeeper.AppendAuditEntry(setup.Ctx, entry)
+
+	entry.Signers = []sdk.AccAddress{testconstants.Address2}
+	setup.AuditKeeper.AppendAuditEntry(setup.Ctx, entry)
+
+	// query
+	entries := getAuditEntries(setup, []string{QuerySignerAuditEntries, testconstants.Address2.String()},
+		pagination.NewPaginationParams(0, 0))
+
+	// check
+	require.Equal(t, 1, entries.Total)
+	require.Equal(t, uint64(2), entries.Items[0].ID)
+}
+
+func TestQuerier_QueryChanges(t *testing.T) {
+	setup := Setup()
+
+	// entries recorded at heights 1, 2, 2, 4 by modelinfo and compliance modules
+	for i, height := range []int64{1, 2, 2, 4} {
+		entry := DefaultAuditEntry()
+		entry.Height = height
+		entry.Change = types.ChangeCreate
+
+		if i%2 == 1 {
+			entry.Module = "compliance"
+			entry.Action = "certify_model"
+			entry.Change = ""
+		}
+
+		setup.AuditKeeper.AppendAuditEntry(setup.Ctx, entry)
+	}
+
+	// query all changes
+	changes := getChanges(setup, types.NewChangesQueryParams(pagination.NewPaginationParams(0, 0), 0, ""))
+
+	// check
+	require.Equal(t, 4, changes.Total)
+	require.Equal(t, uint64(1), changes.Items[0].ID)
+	require.Equal(t, types.ChangeCreate, changes.Items[0].Change)
+	require.Equal(t, types.ChangeUpdate, changes.Items[1].Change) // entry without change kind
+	require.Equal(t, DefaultAuditEntry().Entities, changes.Items[0].Entities)
+
+	// query changes after the height
+	for height, expected := range map[int64][]uint64{1: {2, 3, 4}, 2: {4}, 3: {4}, 4: {}} {
+		changes = getChanges(setup, types.NewChangesQueryParams(pagination.NewPaginationParams(0, 0), height, ""))
+
+		require.Equal(t, len(expected), changes.Total)
+
+		for i, id := range expected {
+			require.Equal(t, id, changes.Items[i].ID)
+		}
+	}
+
+	// query changes of the module page by page
+	params := types.NewChangesQueryParams(pagination.NewPaginationParams(0, 1), 1, "compliance")
+	changes = getChanges(setup, params)
+
+	require.Equal(t, 2, changes.Total)
+	require.Equal(t, uint64(2), changes.Items[0].ID)
+	require.NotEmpty(t, changes.NextKey)
+
+	params.Key = changes.NextKey
+	changes = getChanges(setup, params)
+
+	require.Equal(t, uint64(4), changes.Items[0].ID)
+	require.Empty(t, changes.NextKey)
+
+	// query with negative height
+	_, err := setup.Querier(setup.Ctx, []string{QueryChanges}, abci.RequestQuery{
+		Data: setup.Cdc.MustMarshalJSON(types.NewChangesQueryParams(pagination.NewPaginationParams(0, 0), -1, "")),
+	})
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+}
+
+func TestQuerier_QueryChangesWithIdentifierGaps(t *testing.T) {
+	setup := Setup()
+
+	// entries restored on genesis import with gaps in identifiers
+	for id, height := range map[uint64]int64{2: 1, 5: 3, 6: 3, 9: 7} {
+		entry := DefaultAuditEntry()
+		entry.ID = id
+		entry.Height = height
+		setup.AuditKeeper.SetAuditEntry(setup.Ctx, entry)
+	}
+
+	for height, expected := range map[int64][]uint64{0: {2, 5, 6, 9}, 1: {5, 6, 9}, 4: {9}, 7: {}} {
+		changes := getChanges(setup, types.NewChangesQueryParams(pagination.NewPaginationParams(0, 0), height, ""))
+
+		require.Equal(t, len(expected), changes.Total)
+
+		for i, id := range expected {
+			require.Equal(t, id, changes.Items[i].ID)
+		}
+	}
+}
+
+func getChanges(setup TestSetup, params types.ChangesQueryParams) types.ListChanges {
+	result, err := setup.Querier(setup.Ctx, []string{QueryChanges},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)})
+	if err != nil {
+		panic(err)
+	}
+
+	var changes types.ListChanges
+	setup.Cdc.MustUnmarshalJSON(result, &changes)
+
+	return changes
+}
+
+func getAuditEntries(setup TestSetup, path []string, params pagination.PaginationParams) types.ListAuditEntries {
+	result, err := setup.Querier(setup.Ctx, path, abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)})
+	if err != nil {
+		panic(err)
+	}
+
+	var entries types.ListAuditEntries
+	setup.Cdc.MustUnmarshalJSON(result, &entries)
+
+	return entries
+}
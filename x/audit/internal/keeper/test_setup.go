@@ -0,0 +1,81 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/audit/internal/types"
+)
+
+type TestSetup struct {
+	Cdc         *codec.Codec
+	Ctx         sdk.Context
+	AuditKeeper Keeper
+	Querier     sdk.Querier
+}
+
+func Setup() TestSetup {
+	// Init Codec
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+
+	// Init KVSore
+	db := dbm.NewMemDB()
+	dbStore := store.NewCommitMultiStore(db)
+	auditKey := sdk.NewKVStoreKey(types.StoreKey)
+	dbStore.MountStoreWithDB(auditKey, sdk.StoreTypeIAVL, nil)
+	_ = dbStore.LoadLatestVersion()
+
+	// Init Keepers
+	auditKeeper := NewKeeper(auditKey, cdc)
+
+	// Init Querier
+	querier := NewQuerier(auditKeeper)
+
+	// Create context
+	ctx := sdk.NewContext(dbStore, abci.Header{ChainID: testconstants.ChainID}, false, log.NewNopLogger())
+
+	setup := TestSetup{
+		Cdc:         cdc,
+		Ctx:         ctx,
+		AuditKeeper: auditKeeper,
+		Querier:     querier,
+	}
+
+	return setup
+}
+
+func DefaultAuditEntry() types.AuditEntry {
+	return types.AuditEntry{
+		Height:   1,
+		Time:     testconstants.ReleaseDate,
+		Signers:  []sdk.AccAddress{testconstants.Signer},
+		Module:   "modelinfo",
+		Action:   "add_model_info",
+		Entities: []types.Entity{ModelEntity(testconstants.VID, testconstants.PID)},
+	}
+}
+
+func ModelEntity(vid uint16, pid uint16) types.Entity {
+	return types.NewEntity(types.EntityModel, fmt.Sprint(vid), fmt.Sprint(pid))
+}
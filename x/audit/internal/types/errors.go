@@ -0,0 +1,44 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
+)
+
+const (
+	Codespace sdk.CodespaceType = ModuleName
+
+	CodeAuditEntryDoesNotExist sdk.CodeType = 901
+	CodeInvalidEntity          sdk.CodeType = 902
+)
+
+func init() {
+	errcodes.Register(Codespace, CodeAuditEntryDoesNotExist, "audit_entry_does_not_exist")
+	errcodes.Register(Codespace, CodeInvalidEntity, "invalid_entity")
+}
+
+func ErrAuditEntryDoesNotExist(id interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeAuditEntryDoesNotExist,
+		fmt.Sprintf("No audit entry with id=%v exists on the ledger", id))
+}
+
+func ErrInvalidEntity(entityType interface{}, id interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeInvalidEntity,
+		fmt.Sprintf("Invalid entity: type=%v, id=%v", entityType, id))
+}
@@ -0,0 +1,99 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/binary"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module.
+	ModuleName = "audit"
+
+	// StoreKey to be used when creating the KVStore.
+	StoreKey = ModuleName
+
+	// RouterKey to be used for routing queries (the module does not handle any messages).
+	RouterKey = ModuleName
+)
+
+var (
+	AuditEntryPrefix  = []byte{0x01} // prefix for each key to an audit entry
+	EntityIndexPrefix = []byte{0x02} // prefix for each key to an index record of the entries by entity
+	SignerIndexPrefix = []byte{0x03} // prefix for each key to an index record of the entries by signer
+	LastEntryIDKey    = []byte{0x04} // key to the identifier of the last recorded entry
+)
+
+// separator of the entity identifier components in the index keys.
+const entitySeparator = byte(0x00)
+
+// Key builder for Audit Entry.
+// Identifier is encoded in big endian so that the entries are iterated in the order they were recorded.
+func GetAuditEntryKey(id uint64) []byte {
+	return append(AuditEntryPrefix, EncodeID(id)...)
+}
+
+// Key builder for an index record of Audit Entry by entity.
+func GetEntityIndexKey(entity Entity, id uint64) []byte {
+	return append(GetEntityIndexPrefix(entity.Type, entity.ID...), EncodeID(id)...)
+}
+
+// Key prefix for the index records of all Audit Entries of the entities with the given type
+// and leading identifier components (e.g. all Models of a vendor).
+func GetEntityIndexPrefix(entityType string, id ...string) []byte {
+	prefix := append(append([]byte{}, EntityIndexPrefix...), []byte(entityType)...)
+	prefix = append(prefix, entitySeparator)
+
+	for _, component := range id {
+		prefix = append(prefix, []byte(component)...)
+		prefix = append(prefix, entitySeparator)
+	}
+
+	return prefix
+}
+
+// Key builder for an index record of Audit Entry by signer.
+func GetSignerIndexKey(signer sdk.AccAddress, id uint64) []byte {
+	return append(GetSignerIndexPrefix(signer), EncodeID(id)...)
+}
+
+// Key prefix for the index records of all Audit Entries of the messages signed by the given account.
+func GetSignerIndexPrefix(signer sdk.AccAddress) []byte {
+	return append(append([]byte{}, SignerIndexPrefix...), signer.Bytes()...)
+}
+
+// Extracts the Audit Entry identifier from a key of Audit Entry or of any of its index records.
+func GetIDFromKey(key []byte) uint64 {
+	return DecodeID(key[len(key)-8:])
+}
+
+func EncodeID(id uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+
+	return bz
+}
+
+func DecodeID(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// Checks that the entity identifier component can be stored in the index keys.
+func IsValidEntityIDComponent(component string) bool {
+	return component != "" && !strings.ContainsRune(component, rune(entitySeparator))
+}
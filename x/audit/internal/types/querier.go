@@ -0,0 +1,108 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+)
+
+// Response Payload for a list query with pagination.
+type ListAuditEntries struct {
+	Total   int          `json:"total"`
+	Items   []AuditEntry `json:"items"`
+	NextKey string       `json:"next_key"`
+	PrevKey string       `json:"prev_key"`
+}
+
+// Implement fmt.Stringer.
+func (n ListAuditEntries) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
+
+// Request Payload for QueryChanges query.
+type ChangesQueryParams struct {
+	Skip        int
+	Take        int
+	Key         string
+	SinceHeight int64
+	Module      string
+}
+
+func NewChangesQueryParams(pagination pagination.PaginationParams, sinceHeight int64,
+	module string) ChangesQueryParams {
+	return ChangesQueryParams{
+		Skip:        pagination.Skip,
+		Take:        pagination.Take,
+		Key:         pagination.Key,
+		SinceHeight: sinceHeight,
+		Module:      module,
+	}
+}
+
+func (p ChangesQueryParams) PaginationParams() pagination.PaginationParams {
+	return pagination.PaginationParams{Skip: p.Skip, Take: p.Take, Key: p.Key}
+}
+
+// Compact record about the entities changed by a message (the Audit Entry without the signers and the hashes).
+type Change struct {
+	ID       uint64   `json:"id"`
+	Height   int64    `json:"height"`
+	Module   string   `json:"module"`
+	Action   string   `json:"action"`
+	Change   string   `json:"change"`
+	Entities []Entity `json:"entities"`
+}
+
+// The entries recorded before the kind of the change was tracked are reported as updates.
+func NewChange(entry AuditEntry) Change {
+	change := entry.Change
+	if len(change) == 0 {
+		change = ChangeUpdate
+	}
+
+	return Change{
+		ID:       entry.ID,
+		Height:   entry.Height,
+		Module:   entry.Module,
+		Action:   entry.Action,
+		Change:   change,
+		Entities: entry.Entities,
+	}
+}
+
+// Response Payload for QueryChanges query.
+type ListChanges struct {
+	Total   int      `json:"total"`
+	Items   []Change `json:"items"`
+	NextKey string   `json:"next_key"`
+	PrevKey string   `json:"prev_key"`
+}
+
+// Implement fmt.Stringer.
+func (n ListChanges) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
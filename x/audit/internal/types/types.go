@@ -0,0 +1,76 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Types of the ledger entities changed by the messages.
+const (
+	EntityModel       = "model"       // identified by VID and PID
+	EntityCertificate = "certificate" // identified by Subject and Subject Key ID
+	EntityAccount     = "account"     // identified by the account address
+	EntityValidator   = "validator"   // identified by the validator consensus address
+	EntityUpgrade     = "upgrade"     // identified by the upgrade plan name
+)
+
+// Kinds of the changes of the ledger entities made by the messages.
+const (
+	ChangeCreate = "create" // the entity is added to the ledger (e.g. a new Model or a proposed certificate)
+	ChangeUpdate = "update" // the entity is changed
+	ChangeDelete = "delete" // the entity is removed from the ledger (or from the list of active ones, e.g. revoked)
+)
+
+// Ledger entity changed by a message.
+type Entity struct {
+	Type string   `json:"type"`
+	ID   []string `json:"id"`
+}
+
+func NewEntity(entityType string, id ...string) Entity {
+	return Entity{Type: entityType, ID: id}
+}
+
+// Immutable record about a state-changing message successfully processed by the ledger.
+type AuditEntry struct {
+	ID       uint64           `json:"id"`
+	Height   int64            `json:"height"`
+	Time     time.Time        `json:"time"`
+	TxHash   string           `json:"tx_hash"`
+	Signers  []sdk.AccAddress `json:"signers"`
+	Module   string           `json:"module"`
+	Action   string           `json:"action"`
+	Entities []Entity         `json:"entities"`
+	// kind of the change of the entities (empty for the entries recorded before it was tracked)
+	Change string `json:"change,omitempty"`
+	// hex encoded SHA-256 hash of the message sign bytes
+	MsgHash string `json:"msg_hash"`
+	// hex encoded SHA-256 hash of the values the message has overwritten in the store (see HashPrevValues)
+	PrevValueHash string `json:"prev_value_hash"`
+}
+
+// Implement fmt.Stringer.
+func (e AuditEntry) String() string {
+	bytes, err := json.Marshal(e)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
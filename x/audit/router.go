@@ -0,0 +1,148 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// auditRouter records an Audit Entry for every message successfully processed by the registered handlers.
+type auditRouter struct {
+	sdk.Router
+	keeper Keeper
+}
+
+func NewRouter(router sdk.Router, keeper Keeper) sdk.Router {
+	return auditRouter{Router: router, keeper: keeper}
+}
+
+func (r auditRouter) AddRoute(path string, handler sdk.Handler) sdk.Router {
+	r.Router.AddRoute(path, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		store := newRecordingMultiStore(ctx.MultiStore())
+
+		res := handler(ctx.WithMultiStore(store), msg)
+		if !res.IsOK() {
+			return res
+		}
+
+		r.keeper.AppendAuditEntry(ctx, NewAuditEntry(ctx, msg, store.prevValueHash()))
+
+		return res
+	})
+
+	return r
+}
+
+func NewAuditEntry(ctx sdk.Context, msg sdk.Msg, prevValueHash string) AuditEntry {
+	msgHash := sha256.Sum256(msg.GetSignBytes())
+
+	entry := AuditEntry{
+		Height:        ctx.BlockHeight(),
+		Time:          ctx.BlockHeader().Time,
+		Signers:       msg.GetSigners(),
+		Module:        msg.Route(),
+		Action:        msg.Type(),
+		Entities:      EntitiesOf(msg),
+		Change:        ChangeOf(msg),
+		MsgHash:       hex.EncodeToString(msgHash[:]),
+		PrevValueHash: prevValueHash,
+	}
+
+	// transactions delivered in genesis do not have bytes
+	if len(ctx.TxBytes()) > 0 {
+		entry.TxHash = fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes()))
+	}
+
+	return entry
+}
+
+// recordingMultiStore remembers the values the message overwrites (or deletes) in the stores.
+type recordingMultiStore struct {
+	sdk.MultiStore
+	prevValues map[string][]byte
+}
+
+func newRecordingMultiStore(store sdk.MultiStore) *recordingMultiStore {
+	return &recordingMultiStore{MultiStore: store, prevValues: map[string][]byte{}}
+}
+
+func (s *recordingMultiStore) GetKVStore(key sdk.StoreKey) sdk.KVStore {
+	return recordingKVStore{KVStore: s.MultiStore.GetKVStore(key), name: key.Name(), multiStore: s}
+}
+
+func (s *recordingMultiStore) record(name string, key []byte, prevValue []byte) {
+	id := recordKey(name, key)
+
+	// only the value preceding the first change matters
+	if _, ok := s.prevValues[id]; !ok {
+		s.prevValues[id] = prevValue
+	}
+}
+
+// Returns hex encoded SHA-256 hash of the store name, key and previous value (empty for new keys)
+// of every changed key, length-prefixed and ordered by store name and key.
+func (s *recordingMultiStore) prevValueHash() string {
+	ids := make([]string, 0, len(s.prevValues))
+	for id := range s.prevValues {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	hash := sha256.New()
+
+	for _, id := range ids {
+		writeLengthPrefixed(hash, []byte(id))
+		writeLengthPrefixed(hash, s.prevValues[id])
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func recordKey(name string, key []byte) string {
+	return name + "/" + string(key)
+}
+
+func writeLengthPrefixed(w io.Writer, bz []byte) {
+	length := make([]byte, 8)
+	binary.BigEndian.PutUint64(length, uint64(len(bz)))
+
+	_, _ = w.Write(length)
+	_, _ = w.Write(bz)
+}
+
+type recordingKVStore struct {
+	sdk.KVStore
+	name       string
+	multiStore *recordingMultiStore
+}
+
+func (s recordingKVStore) Set(key, value []byte) {
+	s.multiStore.record(s.name, key, s.KVStore.Get(key))
+	s.KVStore.Set(key, value)
+}
+
+func (s recordingKVStore) Delete(key []byte) {
+	s.multiStore.record(s.name, key, s.KVStore.Get(key))
+	s.KVStore.Delete(key)
+}
@@ -0,0 +1,157 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package audit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
+)
+
+type TestSetup struct {
+	Ctx         sdk.Context
+	AuditKeeper Keeper
+	// store changed by the test handler
+	ModelKey sdk.StoreKey
+	Handler  sdk.Handler
+}
+
+// The test handler stores the PID of the deleted model under its VID and fails for zero PID.
+func Setup() TestSetup {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+
+	db := dbm.NewMemDB()
+	dbStore := store.NewCommitMultiStore(db)
+	auditKey := sdk.NewKVStoreKey(StoreKey)
+	modelKey := sdk.NewKVStoreKey(modelinfo.StoreKey)
+	dbStore.MountStoreWithDB(auditKey, sdk.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(modelKey, sdk.StoreTypeIAVL, nil)
+	_ = dbStore.LoadLatestVersion()
+
+	auditKeeper := NewKeeper(auditKey, cdc)
+
+	baseRouter := baseapp.NewRouter()
+	NewRouter(baseRouter, auditKeeper).AddRoute(modelinfo.RouterKey, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		deleteMsg := msg.(modelinfo.MsgDeleteModelInfo)
+		if deleteMsg.PID == 0 {
+			return sdk.ErrUnknownRequest("zero PID").Result()
+		}
+
+		ctx.KVStore(modelKey).Set([]byte(fmt.Sprint(deleteMsg.VID)), []byte(fmt.Sprint(deleteMsg.PID)))
+
+		return sdk.Result{}
+	})
+
+	ctx := sdk.NewContext(dbStore, abci.Header{ChainID: testconstants.ChainID, Height: 3}, false, log.NewNopLogger())
+
+	return TestSetup{
+		Ctx:         ctx,
+		AuditKeeper: auditKeeper,
+		ModelKey:    modelKey,
+		Handler:     baseRouter.Route(modelinfo.RouterKey),
+	}
+}
+
+func TestRouter_RecordsAuditEntry(t *testing.T) {
+	setup := Setup()
+
+	msg := modelinfo.MsgDeleteModelInfo{VID: testconstants.VID, PID: testconstants.PID, Signer: testconstants.Signer}
+
+	result := setup.Handler(setup.Ctx.WithTxBytes([]byte("tx")), msg)
+	require.True(t, result.IsOK())
+
+	// check
+	entry := setup.AuditKeeper.GetAuditEntry(setup.Ctx, 1)
+	require.Equal(t, int64(3), entry.Height)
+	require.Equal(t, fmt.Sprintf("%X", tmhash.Sum([]byte("tx"))), entry.TxHash)
+	require.Equal(t, []sdk.AccAddress{testconstants.Signer}, entry.Signers)
+	require.Equal(t, msg.Route(), entry.Module)
+	require.Equal(t, msg.Type(), entry.Action)
+	require.Equal(t, []Entity{NewEntity(EntityModel, fmt.Sprint(testconstants.VID), fmt.Sprint(testconstants.PID))},
+		entry.Entities)
+	require.Equal(t, ChangeDelete, entry.Change)
+	require.NotEmpty(t, entry.MsgHash)
+	require.NotEmpty(t, entry.PrevValueHash)
+}
+
+func TestRouter_PrevValueHash(t *testing.T) {
+	setup := Setup()
+
+	msg := modelinfo.MsgDeleteModelInfo{VID: testconstants.VID, PID: testconstants.PID, Signer: testconstants.Signer}
+
+	// the same message changes the value on the first processing only
+	setup.Handler(setup.Ctx, msg)
+	setup.Handler(setup.Ctx, msg)
+
+	msg.PID++
+	setup.Handler(setup.Ctx, msg)
+
+	first := setup.AuditKeeper.GetAuditEntry(setup.Ctx, 1)
+	second := setup.AuditKeeper.GetAuditEntry(setup.Ctx, 2)
+	third := setup.AuditKeeper.GetAuditEntry(setup.Ctx, 3)
+
+	require.NotEqual(t, first.PrevValueHash, second.PrevValueHash)
+	require.Equal(t, second.PrevValueHash, third.PrevValueHash)
+	require.NotEqual(t, second.MsgHash, third.MsgHash)
+
+	// the value is actually written
+	require.Equal(t, []byte(fmt.Sprint(msg.PID)),
+		setup.Ctx.KVStore(setup.ModelKey).Get([]byte(fmt.Sprint(testconstants.VID))))
+}
+
+func TestRouter_FailedMessageIsNotRecorded(t *testing.T) {
+	setup := Setup()
+
+	msg := modelinfo.MsgDeleteModelInfo{VID: testconstants.VID, PID: 0, Signer: testconstants.Signer}
+
+	result := setup.Handler(setup.Ctx, msg)
+	require.False(t, result.IsOK())
+	require.Equal(t, uint64(0), setup.AuditKeeper.GetLastAuditEntryID(setup.Ctx))
+}
+
+func TestEntitiesOf_PemCertificate(t *testing.T) {
+	msg := pki.MsgProposeAddX509RootCert{Cert: testconstants.RootCertPem, Signer: testconstants.Signer}
+
+	require.Equal(t,
+		[]Entity{NewEntity(EntityCertificate, testconstants.RootSubject, testconstants.RootSubjectKeyID)},
+		EntitiesOf(msg))
+
+	// invalid certificates are not recorded as entities
+	msg.Cert = "invalid"
+	require.Empty(t, EntitiesOf(msg))
+}
+
+func TestChangeOf(t *testing.T) {
+	require.Equal(t, ChangeCreate, ChangeOf(modelinfo.MsgAddModelInfo{}))
+	require.Equal(t, ChangeUpdate, ChangeOf(modelinfo.MsgUpdateModelInfo{}))
+	require.Equal(t, ChangeDelete, ChangeOf(modelinfo.MsgDeleteModelInfo{}))
+	require.Equal(t, ChangeCreate, ChangeOf(pki.MsgProposeAddX509RootCert{}))
+	require.Equal(t, ChangeUpdate, ChangeOf(pki.MsgApproveAddX509RootCert{}))
+	require.Equal(t, ChangeDelete, ChangeOf(pki.MsgRevokeX509Cert{}))
+}
@@ -20,29 +20,66 @@ import (
 )
 
 const (
-	ModuleName = types.ModuleName
-	RouterKey  = types.RouterKey
-	StoreKey   = types.StoreKey
+	ModuleName        = types.ModuleName
+	RouterKey         = types.RouterKey
+	StoreKey          = types.StoreKey
+	TStoreKey         = types.TStoreKey
+	DefaultParamspace = types.DefaultParamspace
 
 	Vendor                = types.Vendor
 	TestHouse             = types.TestHouse
 	ZBCertificationCenter = types.ZBCertificationCenter
 	Trustee               = types.Trustee
 	NodeAdmin             = types.NodeAdmin
+
+	EventTypeReference      = types.EventTypeReference
+	AttributeKeyReferenceID = types.AttributeKeyReferenceID
+
+	QueryAccount     = keeper.QueryAccount
+	QueryAllAccounts = keeper.QueryAllAccounts
 )
 
 var (
-	NewKeeper     = keeper.NewKeeper
-	NewQuerier    = keeper.NewQuerier
-	NewAccount    = types.NewAccount
-	ModuleCdc     = types.ModuleCdc
-	RegisterCodec = types.RegisterCodec
-	Roles         = types.Roles
+	NewKeeper            = keeper.NewKeeper
+	NewQuerier           = keeper.NewQuerier
+	PendingAccountPrefix = types.PendingAccountPrefix
+	AccountPrefix        = types.AccountPrefix
+	NewAccount           = types.NewAccount
+	NewParams            = types.NewParams
+	DefaultParams        = types.DefaultParams
+	ModuleCdc            = types.ModuleCdc
+	RegisterCodec        = types.RegisterCodec
+	Roles                = types.Roles
+
+	NewQueryAccountParams = types.NewQueryAccountParams
+
+	NewMsgProposeAddAccount = types.NewMsgProposeAddAccount
+	NewMsgApproveAddAccount = types.NewMsgApproveAddAccount
+
+	RegisterMsgRoles = types.RegisterMsgRoles
+	GetMsgRoles      = types.GetMsgRoles
+	ErrMissingRole   = types.ErrMissingRole
+	CodeMissingRole  = types.CodeMissingRole
+
+	ErrVendorIDMismatch  = types.ErrVendorIDMismatch
+	CodeVendorIDMismatch = types.CodeVendorIDMismatch
+
+	ErrAccountDoesNotExist  = types.ErrAccountDoesNotExist
+	CodeAccountDoesNotExist = types.CodeAccountDoesNotExist
+	ErrAccountFrozen        = types.ErrAccountFrozen
+	CodeAccountFrozen       = types.CodeAccountFrozen
+
+	ErrTxLimitExceeded  = types.ErrTxLimitExceeded
+	CodeTxLimitExceeded = types.CodeTxLimitExceeded
+	ErrTxTimedOut       = types.ErrTxTimedOut
+	CodeTxTimedOut      = types.CodeTxTimedOut
 )
 
 type (
 	Keeper                        = keeper.Keeper
 	Account                       = types.Account
+	Params                        = types.Params
+	RoleTxLimit                   = types.RoleTxLimit
 	PendingAccount                = types.PendingAccount
 	PendingAccountRevocation      = types.PendingAccountRevocation
 	AccountRole                   = types.AccountRole
@@ -50,4 +87,21 @@ type (
 	ListAccounts                  = types.ListAccounts
 	ListPendingAccounts           = types.ListPendingAccounts
 	ListPendingAccountRevocations = types.ListPendingAccountRevocations
+	PendingVendorIDUpdate         = types.PendingVendorIDUpdate
+	ListPendingVendorIDUpdates    = types.ListPendingVendorIDUpdates
+	PendingKeyRotation            = types.PendingKeyRotation
+	ListPendingKeyRotations       = types.ListPendingKeyRotations
+	PendingAccountFreeze          = types.PendingAccountFreeze
+	ListPendingAccountFreezes     = types.ListPendingAccountFreezes
+	VendorAccounts                = types.VendorAccounts
+	MsgProposeAddAccount          = types.MsgProposeAddAccount
+	MsgApproveAddAccount          = types.MsgApproveAddAccount
+	MsgProposeRevokeAccount       = types.MsgProposeRevokeAccount
+	MsgApproveRevokeAccount       = types.MsgApproveRevokeAccount
+	MsgProposeUpdateVendorID      = types.MsgProposeUpdateVendorID
+	MsgApproveUpdateVendorID      = types.MsgApproveUpdateVendorID
+	MsgRotateKey                  = types.MsgRotateKey
+	MsgApproveRotateKey           = types.MsgApproveRotateKey
+	MsgFreezeAccount              = types.MsgFreezeAccount
+	MsgUnfreezeAccount            = types.MsgUnfreezeAccount
 )
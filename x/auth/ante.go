@@ -15,13 +15,16 @@
 package auth
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/txtimeout"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth/internal/types"
 )
 
@@ -77,7 +80,11 @@ func NewAnteHandler(ak Keeper, sigGasConsumer SignatureVerificationGasConsumer)
 
 		newCtx.GasMeter().ConsumeGas(types.TxSizeCostPerByte*sdk.Gas(len(newCtx.TxBytes())), "txSize")
 
-		if res := ValidateMemo(stdTx); !res.IsOK() {
+		if res := ValidateMemo(stdTx, ak.GetParams(newCtx).MaxMemoCharacters); !res.IsOK() {
+			return newCtx, res, true
+		}
+
+		if res := ValidateTimeoutHeight(newCtx, stdTx); !res.IsOK() {
 			return newCtx, res, true
 		}
 
@@ -93,6 +100,11 @@ func NewAnteHandler(ak Keeper, sigGasConsumer SignatureVerificationGasConsumer)
 				return newCtx, res, true
 			}
 
+			// frozen accounts can not change the state of the ledger until they are unfrozen by trustees.
+			if account.Frozen {
+				return newCtx, types.ErrAccountFrozen(account.Address).Result(), true
+			}
+
 			// check signature, return account with incremented nonce.
 			signBytes := GetSignBytes(newCtx.ChainID(), stdTx, account, isGenesis)
 			account, res = processSig(newCtx, account, signature, signBytes, simulate, sigGasConsumer)
@@ -108,6 +120,51 @@ func NewAnteHandler(ak Keeper, sigGasConsumer SignatureVerificationGasConsumer)
 	}
 }
 
+// NewTxLimitAnteHandler wraps the given AnteHandler (which verifies the signatures of the tx signers)
+// and additionally rejects the transactions of the accounts which have already signed the maximum number
+// of transactions allowed for their roles in the current block (see TxLimits param).
+// The ledger is feeless, so the limits protect the network from a compromised account flooding it.
+// The transactions are counted in the transient store, which is reset on every commit,
+// so the transactions accepted to the mempool are limited per block as well.
+func NewTxLimitAnteHandler(ak Keeper, tkey sdk.StoreKey, next sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		newCtx, res, abort = next(ctx, tx, simulate)
+		if abort || ctx.BlockHeight() == 0 {
+			return newCtx, res, abort
+		}
+
+		// the wrapped handler has already rejected the transactions of the other types
+		stdTx := tx.(auth.StdTx)
+		params := ak.GetParams(newCtx)
+		store := newCtx.TransientStore(tkey)
+
+		for _, signer := range stdTx.GetSigners() {
+			maxTxs, limited := params.MaxTxsPerBlock(ak.GetAccount(newCtx, signer))
+			if !limited {
+				continue
+			}
+
+			key := types.GetTxCountKey(signer)
+
+			var count uint64
+			if bz := store.Get(key); bz != nil {
+				count = binary.BigEndian.Uint64(bz)
+			}
+
+			if count >= maxTxs {
+				return newCtx, types.ErrTxLimitExceeded(signer, maxTxs).Result(), true
+			}
+
+			// the simulated transactions are checked against the limit but not counted
+			if !simulate {
+				store.Set(key, sdk.Uint64ToBigEndian(count+1))
+			}
+		}
+
+		return newCtx, res, false
+	}
+}
+
 // GetSignerAcc returns an account for a given address that is expected to sign a transaction.
 func GetSignerAcc(ctx sdk.Context, keeper Keeper, address sdk.AccAddress) (acc types.Account, res sdk.Result) {
 	if !keeper.IsAccountPresent(ctx, address) {
@@ -120,13 +177,13 @@ func GetSignerAcc(ctx sdk.Context, keeper Keeper, address sdk.AccAddress) (acc t
 }
 
 // ValidateMemo validates the memo size.
-func ValidateMemo(stdTx auth.StdTx) sdk.Result {
+func ValidateMemo(stdTx auth.StdTx, maxMemoCharacters uint64) sdk.Result {
 	memoLength := len(stdTx.GetMemo())
-	if uint64(memoLength) > types.MaxMemoCharacters {
+	if uint64(memoLength) > maxMemoCharacters {
 		return sdk.ErrMemoTooLarge(
 			fmt.Sprintf(
 				"maximum number of characters is %d but received %d characters",
-				types.MaxMemoCharacters, memoLength,
+				maxMemoCharacters, memoLength,
 			),
 		).Result()
 	}
@@ -134,6 +191,21 @@ func ValidateMemo(stdTx auth.StdTx) sdk.Result {
 	return sdk.Result{}
 }
 
+// ValidateTimeoutHeight rejects the transaction if the current block is above the timeout height
+// attached to its memo (see `utils/txtimeout`).
+func ValidateTimeoutHeight(ctx sdk.Context, stdTx auth.StdTx) sdk.Result {
+	timeoutHeight, _, err := txtimeout.Parse(stdTx.GetMemo())
+	if err != nil {
+		return sdk.ErrUnknownRequest(err.Error()).Result()
+	}
+
+	if timeoutHeight != 0 && ctx.BlockHeight() > timeoutHeight {
+		return types.ErrTxTimedOut(timeoutHeight, ctx.BlockHeight()).Result()
+	}
+
+	return sdk.Result{}
+}
+
 // verify the signature and increment the sequence.
 func processSig(
 	ctx sdk.Context, acc types.Account, sig auth.StdSignature, signBytes []byte, simulate bool,
@@ -168,6 +240,16 @@ func DefaultSigVerificationGasConsumer(meter sdk.GasMeter, pubkey crypto.PubKey)
 
 		return sdk.Result{}
 
+	case multisig.PubKeyMultisigThreshold:
+		// gas is consumed for every key the multisig key is composed of.
+		for _, subKey := range pubkey.PubKeys {
+			if res := DefaultSigVerificationGasConsumer(meter, subKey); !res.IsOK() {
+				return res
+			}
+		}
+
+		return sdk.Result{}
+
 	default:
 		return sdk.ErrInvalidPubKey(fmt.Sprintf("unrecognized public key type: %T", pubkey)).Result()
 	}
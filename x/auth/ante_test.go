@@ -0,0 +1,224 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package auth
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/multisig"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/txtimeout"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth/internal/types"
+)
+
+func TestAnteHandler_MultisigAccount(t *testing.T) {
+	setup := Setup()
+	ctx := setup.Ctx.WithBlockHeight(1)
+	anteHandler := NewAnteHandler(setup.Keeper, DefaultSigVerificationGasConsumer)
+
+	// store 2 of 3 multisig trustee account
+	privKeys := []crypto.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	pubKeys := []crypto.PubKey{privKeys[0].PubKey(), privKeys[1].PubKey(), privKeys[2].PubKey()}
+	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pubKeys).(multisig.PubKeyMultisigThreshold)
+	address := sdk.AccAddress(multisigKey.Address())
+
+	setup.Keeper.SetAccount(ctx, NewAccount(address, multisigKey, AccountRoles{Trustee}))
+
+	msg := types.NewMsgApproveAddAccount(testconstants.Address1, address)
+	fee := auth.NewStdFee(1000000, sdk.Coins{})
+	signBytes := auth.StdSignBytes(ctx.ChainID(), 0, 0, fee, []sdk.Msg{msg}, "")
+
+	// signed by one key only
+	tx := multisigTx(t, setup, msg, fee, multisigKey, signBytes, privKeys[0])
+	_, result, abort := anteHandler(ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// signed by two keys
+	tx = multisigTx(t, setup, msg, fee, multisigKey, signBytes, privKeys[0], privKeys[2])
+	_, result, abort = anteHandler(ctx, tx, false)
+	require.False(t, abort)
+	require.True(t, result.IsOK())
+
+	// sequence is incremented
+	require.Equal(t, uint64(1), setup.Keeper.GetAccount(ctx, address).Sequence)
+}
+
+func TestAnteHandler_FrozenAccount(t *testing.T) {
+	setup := Setup()
+	ctx := setup.Ctx.WithBlockHeight(1)
+	anteHandler := NewAnteHandler(setup.Keeper, DefaultSigVerificationGasConsumer)
+
+	privKey := secp256k1.GenPrivKey()
+	address := sdk.AccAddress(privKey.PubKey().Address())
+	account := NewAccount(address, privKey.PubKey(), AccountRoles{Vendor})
+	account.VendorID = testconstants.VID
+	account.Frozen = true
+	setup.Keeper.SetAccount(ctx, account)
+
+	msg := types.NewMsgApproveAddAccount(testconstants.Address1, address)
+	fee := auth.NewStdFee(1000000, sdk.Coins{})
+	signBytes := auth.StdSignBytes(ctx.ChainID(), 0, 0, fee, []sdk.Msg{msg}, "")
+
+	signature, err := privKey.Sign(signBytes)
+	require.NoError(t, err)
+
+	tx := auth.NewStdTx([]sdk.Msg{msg}, fee,
+		[]auth.StdSignature{{PubKey: privKey.PubKey(), Signature: signature}}, "")
+
+	// transaction of the frozen account is rejected
+	_, result, abort := anteHandler(ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, types.CodeAccountFrozen, result.Code)
+
+	// transaction is accepted once the account is unfrozen
+	account.Frozen = false
+	setup.Keeper.SetAccount(ctx, account)
+
+	_, result, abort = anteHandler(ctx, tx, false)
+	require.False(t, abort)
+	require.True(t, result.IsOK())
+}
+
+func TestTxLimitAnteHandler(t *testing.T) {
+	setup := Setup()
+	ctx := setup.Ctx.WithBlockHeight(1)
+	anteHandler := NewTxLimitAnteHandler(setup.Keeper, setup.TKey,
+		NewAnteHandler(setup.Keeper, DefaultSigVerificationGasConsumer))
+
+	vendorKey, observerKey, trusteeKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+
+	vendor := NewAccount(sdk.AccAddress(vendorKey.PubKey().Address()), vendorKey.PubKey(), AccountRoles{Vendor})
+	vendor.VendorID = testconstants.VID
+	setup.Keeper.SetAccount(ctx, vendor)
+	setup.Keeper.SetAccount(ctx, NewAccount(sdk.AccAddress(observerKey.PubKey().Address()),
+		observerKey.PubKey(), AccountRoles{}))
+	setup.Keeper.SetAccount(ctx, NewAccount(sdk.AccAddress(trusteeKey.PubKey().Address()),
+		trusteeKey.PubKey(), AccountRoles{Trustee}))
+
+	params := setup.Keeper.GetParams(ctx)
+	params.TxLimits = []RoleTxLimit{{Role: Vendor, MaxTxsPerBlock: 2}, {Role: "", MaxTxsPerBlock: 0}}
+	setup.Keeper.SetParams(ctx, params)
+
+	send := func(privKey crypto.PrivKey, simulate bool) sdk.Result {
+		account := setup.Keeper.GetAccount(ctx, sdk.AccAddress(privKey.PubKey().Address()))
+		msg := types.NewMsgApproveAddAccount(testconstants.Address1, account.Address)
+		fee := auth.NewStdFee(1000000, sdk.Coins{})
+
+		signature, err := privKey.Sign(auth.StdSignBytes(ctx.ChainID(), account.AccountNumber, account.Sequence,
+			fee, []sdk.Msg{msg}, ""))
+		require.NoError(t, err)
+
+		_, result, _ := anteHandler(ctx, auth.NewStdTx([]sdk.Msg{msg}, fee,
+			[]auth.StdSignature{{PubKey: privKey.PubKey(), Signature: signature}}, ""), simulate)
+
+		return result
+	}
+
+	// the simulations are not counted
+	require.True(t, send(vendorKey, true).IsOK())
+
+	// the vendor can sign two transactions in the block
+	require.True(t, send(vendorKey, false).IsOK())
+	require.True(t, send(vendorKey, false).IsOK())
+	require.Equal(t, types.CodeTxLimitExceeded, send(vendorKey, false).Code)
+	require.Equal(t, types.CodeTxLimitExceeded, send(vendorKey, true).Code)
+
+	// the accounts without roles can not sign any transaction
+	require.Equal(t, types.CodeTxLimitExceeded, send(observerKey, false).Code)
+
+	// the trustee role is not limited
+	for i := 0; i < 5; i++ {
+		require.True(t, send(trusteeKey, false).IsOK())
+	}
+
+	// the counts are reset on commit
+	ctx.MultiStore().(sdk.CommitMultiStore).Commit()
+	require.True(t, send(vendorKey, false).IsOK())
+}
+
+func TestAnteHandler_TimeoutHeight(t *testing.T) {
+	setup := Setup()
+	anteHandler := NewAnteHandler(setup.Keeper, DefaultSigVerificationGasConsumer)
+
+	privKey := secp256k1.GenPrivKey()
+	address := sdk.AccAddress(privKey.PubKey().Address())
+	setup.Keeper.SetAccount(setup.Ctx, NewAccount(address, privKey.PubKey(), AccountRoles{Trustee}))
+
+	send := func(height int64, memo string) sdk.Result {
+		ctx := setup.Ctx.WithBlockHeight(height)
+		account := setup.Keeper.GetAccount(ctx, address)
+		msg := types.NewMsgApproveAddAccount(testconstants.Address1, address)
+		fee := auth.NewStdFee(1000000, sdk.Coins{})
+
+		signature, err := privKey.Sign(auth.StdSignBytes(ctx.ChainID(), account.AccountNumber, account.Sequence,
+			fee, []sdk.Msg{msg}, memo))
+		require.NoError(t, err)
+
+		_, result, _ := anteHandler(ctx, auth.NewStdTx([]sdk.Msg{msg}, fee,
+			[]auth.StdSignature{{PubKey: privKey.PubKey(), Signature: signature}}, memo), false)
+
+		return result
+	}
+
+	// the transaction is accepted up to its timeout height
+	require.True(t, send(5, txtimeout.Memo(10, "ref")).IsOK())
+	require.True(t, send(10, txtimeout.Memo(10, "ref")).IsOK())
+	require.Equal(t, types.CodeTxTimedOut, send(11, txtimeout.Memo(10, "ref")).Code)
+
+	// the transactions without timeout height are never timed out
+	require.True(t, send(100, "ref").IsOK())
+
+	// malformed timeout height is rejected
+	require.Equal(t, sdk.CodeUnknownRequest, send(5, "timeout_height=abc;ref").Code)
+}
+
+func TestDefaultSigVerificationGasConsumer_Multisig(t *testing.T) {
+	meter := sdk.NewInfiniteGasMeter()
+
+	secpKeys := []crypto.PubKey{secp256k1.GenPrivKey().PubKey(), secp256k1.GenPrivKey().PubKey()}
+	result := DefaultSigVerificationGasConsumer(meter, multisig.NewPubKeyMultisigThreshold(1, secpKeys))
+	require.True(t, result.IsOK())
+	require.Equal(t, 2*types.DefaultSigVerifyCostSecp256k1, meter.GasConsumed())
+
+	// multisig key composed of unsupported keys is rejected
+	mixedKeys := []crypto.PubKey{secp256k1.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()}
+	result = DefaultSigVerificationGasConsumer(meter, multisig.NewPubKeyMultisigThreshold(1, mixedKeys))
+	require.Equal(t, sdk.CodeInvalidPubKey, result.Code)
+}
+
+func multisigTx(t *testing.T, setup TestSetup, msg sdk.Msg, fee auth.StdFee,
+	multisigKey multisig.PubKeyMultisigThreshold, signBytes []byte, privKeys ...crypto.PrivKey) auth.StdTx {
+	multisignature := multisig.NewMultisig(len(multisigKey.PubKeys))
+
+	for _, privKey := range privKeys {
+		signature, err := privKey.Sign(signBytes)
+		require.NoError(t, err)
+
+		err = multisignature.AddSignatureFromPubKey(signature, privKey.PubKey(), multisigKey.PubKeys)
+		require.NoError(t, err)
+	}
+
+	stdSignature := auth.StdSignature{PubKey: multisigKey, Signature: setup.Cdc.MustMarshalBinaryBare(multisignature)}
+
+	return auth.NewStdTx([]sdk.Msg{msg}, fee, []auth.StdSignature{stdSignature}, "")
+}
@@ -20,4 +20,15 @@ const (
 	FlagPubKey       = "pubkey"
 	FlagRoles        = "roles"
 	FlagRolesUsage   = "amount of accounts to take"
+	FlagVID          = "vid"
+	FlagVIDUsage     = "Vendor ID the account is bound to"
+	FlagMsgType      = "msg-type"
+	FlagMsgTypeUsage = "Type of the messages to filter the transactions by (e.g. add_model_info)"
+	FlagPage         = "page"
+	FlagPageUsage    = "Query a specific page of paginated results"
+	FlagLimit        = "limit"
+	FlagLimitUsage   = "Query number of transactions results per page returned"
+
+	FlagReferenceID      = "reference-id"
+	FlagReferenceIDUsage = "Reference ID (memo) the transactions were sent with"
 )
@@ -20,9 +20,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authutils "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth/internal/keeper"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth/internal/types"
@@ -41,6 +44,14 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdAccounts(storeKey, cdc),
 		GetCmdProposedAccounts(storeKey, cdc),
 		GetCmdProposedAccountsToRevoke(storeKey, cdc),
+		GetCmdProposedAccount(storeKey, cdc),
+		GetCmdProposedAccountToRevoke(storeKey, cdc),
+		GetCmdProposedVendorIDUpdates(storeKey, cdc),
+		GetCmdProposedKeyRotations(storeKey, cdc),
+		GetCmdProposedAccountFreezes(storeKey, cdc),
+		GetCmdVendorAccounts(storeKey, cdc),
+		GetCmdAccountTxs(cdc),
+		GetCmdReferenceTxs(cdc),
 	)...)
 
 	return authQueryCmd
@@ -96,6 +107,7 @@ func GetCmdProposedAccounts(queryRoute string, cdc *codec.Codec) *cobra.Command
 
 	cmd.Flags().Int(pagination.FlagSkip, 0, pagination.FlagSkipUsage)
 	cmd.Flags().Int(pagination.FlagTake, 0, pagination.FlagTakeUsage)
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
 
 	return cmd
 }
@@ -115,6 +127,7 @@ func GetCmdAccounts(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of accounts to skip")
 	cmd.Flags().Int(pagination.FlagTake, 0, "amount of accounts to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
 
 	return cmd
 }
@@ -134,6 +147,243 @@ func GetCmdProposedAccountsToRevoke(queryRoute string, cdc *codec.Codec) *cobra.
 
 	cmd.Flags().Int(pagination.FlagSkip, 0, pagination.FlagSkipUsage)
 	cmd.Flags().Int(pagination.FlagTake, 0, pagination.FlagTakeUsage)
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
+
+func GetCmdProposedAccount(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposed-account",
+		Short: "Get proposed but not approved account associated with the address",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			res, height, err := cliCtx.QueryStore(types.GetPendingAccountKey(address), queryRoute)
+			if err != nil || res == nil {
+				return types.ErrPendingAccountDoesNotExist(address)
+			}
+
+			var pendAcc types.PendingAccount
+			cdc.MustUnmarshalBinaryBare(res, &pendAcc)
+
+			return cliCtx.EncodeAndPrintWithHeight(pendAcc, height)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", FlagAddressUsage)
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
+func GetCmdProposedAccountToRevoke(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposed-account-to-revoke",
+		Short: "Get proposed but not approved revocation of account associated with the address",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			res, height, err := cliCtx.QueryStore(types.GetPendingAccountRevocationKey(address), queryRoute)
+			if err != nil || res == nil {
+				return types.ErrPendingAccountRevocationDoesNotExist(address)
+			}
+
+			var revocation types.PendingAccountRevocation
+			cdc.MustUnmarshalBinaryBare(res, &revocation)
+
+			return cliCtx.EncodeAndPrintWithHeight(revocation, height)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", FlagAddressUsage)
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
+func GetCmdProposedVendorIDUpdates(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-proposed-vendor-id-updates",
+		Short: "Get all proposed but not approved Vendor ID bindings of accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QueryAllPendingVendorIDUpdates), params)
+		},
+	}
+
+	cmd.Flags().Int(pagination.FlagSkip, 0, pagination.FlagSkipUsage)
+	cmd.Flags().Int(pagination.FlagTake, 0, pagination.FlagTakeUsage)
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
+
+func GetCmdProposedKeyRotations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-proposed-key-rotations",
+		Short: "Get all proposed but not approved rotations of the keys of Trustee accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QueryAllPendingKeyRotations), params)
+		},
+	}
+
+	cmd.Flags().Int(pagination.FlagSkip, 0, pagination.FlagSkipUsage)
+	cmd.Flags().Int(pagination.FlagTake, 0, pagination.FlagTakeUsage)
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
+
+func GetCmdProposedAccountFreezes(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-proposed-account-freezes",
+		Short: "Get all proposed but not approved freezes and unfreezes of accounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(
+				fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QueryAllPendingAccountFreezes), params)
+		},
+	}
+
+	cmd.Flags().Int(pagination.FlagSkip, 0, pagination.FlagSkipUsage)
+	cmd.Flags().Int(pagination.FlagTake, 0, pagination.FlagTakeUsage)
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
+
+func GetCmdVendorAccounts(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vendor-accounts",
+		Short: "Get addresses of all accounts bound to the Vendor ID",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err_ := conversions.ParseVID(viper.GetString(FlagVID))
+			if err_ != nil {
+				return err_
+			}
+
+			params := types.NewQueryVendorAccountsParams(vid)
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/%s", queryRoute, keeper.QueryVendorAccounts), params)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Vendor ID")
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+
+	return cmd
+}
+
+func GetCmdAccountTxs(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-txs",
+		Short: "Get all transactions signed by the account",
+		Long: "Get all transactions signed by the account (optionally filtered by the message type) " +
+			"from the transactions index of the node. The results are sorted by height.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			events := types.AccountTxsEvents(address, viper.GetString(FlagMsgType))
+
+			txs, err := authutils.QueryTxsByEvents(cliCtx.Context(), events, viper.GetInt(FlagPage), viper.GetInt(FlagLimit))
+			if err != nil {
+				return err
+			}
+
+			out, err := cdc.MarshalJSONIndent(txs, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(out))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", FlagAddressUsage)
+	cmd.Flags().String(FlagMsgType, "", FlagMsgTypeUsage)
+	cmd.Flags().Int(FlagPage, rest.DefaultPage, FlagPageUsage)
+	cmd.Flags().Int(FlagLimit, rest.DefaultLimit, FlagLimitUsage)
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
+func GetCmdReferenceTxs(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reference-txs",
+		Short: "Get all transactions sent with the reference ID",
+		Long: "Get all transactions sent with the reference ID (memo passed with `--memo` flag or `base_req.memo`) " +
+			"from the transactions index of the node. The results are sorted by height.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			events, err := types.ReferenceTxsEvents(viper.GetString(FlagReferenceID))
+			if err != nil {
+				return err
+			}
+
+			page, limit := viper.GetInt(FlagPage), viper.GetInt(FlagLimit)
+
+			txs, err_ := authutils.QueryTxsByEvents(cliCtx.Context(), events, page, limit)
+			if err_ != nil {
+				return err_
+			}
+
+			out, err_ := cdc.MarshalJSONIndent(txs, "", "  ")
+			if err_ != nil {
+				return err_
+			}
+
+			fmt.Println(string(out))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(FlagReferenceID, "", FlagReferenceIDUsage)
+	cmd.Flags().Int(FlagPage, rest.DefaultPage, FlagPageUsage)
+	cmd.Flags().Int(FlagLimit, rest.DefaultLimit, FlagLimitUsage)
+
+	_ = cmd.MarkFlagRequired(FlagReferenceID)
 
 	return cmd
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth/internal/types"
 )
 
@@ -41,6 +42,12 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdApproveAddAccount(cdc),
 		GetCmdProposeRevokeAccount(cdc),
 		GetCmdApproveRevokeAccount(cdc),
+		GetCmdProposeUpdateVendorID(cdc),
+		GetCmdApproveUpdateVendorID(cdc),
+		GetCmdRotateKey(cdc),
+		GetCmdApproveRotateKey(cdc),
+		GetCmdFreezeAccount(cdc),
+		GetCmdUnfreezeAccount(cdc),
 	)...)...)
 
 	return authTxCmd
@@ -72,7 +79,15 @@ func GetCmdProposeAddAccount(cdc *codec.Codec) *cobra.Command {
 				}
 			}
 
-			msg := types.NewMsgProposeAddAccount(address, pubkey, roles, cliCtx.FromAddress())
+			var vid uint16
+			if vidStr := viper.GetString(FlagVID); len(vidStr) > 0 {
+				vid, err = conversions.ParseVID(vidStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgProposeAddAccount(address, pubkey, roles, vid, cliCtx.FromAddress())
 
 			return cliCtx.HandleWriteMessage(msg)
 		},
@@ -83,6 +98,7 @@ func GetCmdProposeAddAccount(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagRoles, "",
 		fmt.Sprintf("The list of roles, comma-separated, assigning to the account (supported roles: %v)",
 			types.Roles))
+	cmd.Flags().String(FlagVID, "", FlagVIDUsage+" (can be set only for accounts with the Vendor role)")
 
 	_ = cmd.MarkFlagRequired(FlagAddress)
 	_ = cmd.MarkFlagRequired(FlagPubKey)
@@ -167,3 +183,170 @@ func GetCmdApproveRevokeAccount(cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdProposeUpdateVendorID(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-update-vendor-id",
+		Short: "Propose binding of the vendor account with the given address to the Vendor ID",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			vid, err_ := conversions.ParseVID(viper.GetString(FlagVID))
+			if err_ != nil {
+				return err_
+			}
+
+			msg := types.NewMsgProposeUpdateVendorID(address, vid, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "Bench32 encoded account address")
+	cmd.Flags().String(FlagVID, "", FlagVIDUsage)
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	_ = cmd.MarkFlagRequired(FlagVID)
+
+	return cmd
+}
+
+func GetCmdApproveUpdateVendorID(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-update-vendor-id",
+		Short: "Approve the proposed Vendor ID binding of the account with the given address",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveUpdateVendorID(address, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "Bench32 encoded account address")
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
+func GetCmdRotateKey(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-key",
+		Short: "Replace the public key of the signer account with the given one",
+		Long: "Replace the public key of the signer account (`--from`) with the given one. " +
+			"The address, roles and history of the account are kept. " +
+			"The key of a Trustee account is replaced once the other trustees approve it.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			pubkey := viper.GetString(FlagPubKey)
+			_, err := sdk.GetAccPubKeyBech32(pubkey)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRotateKey(cliCtx.FromAddress(), pubkey, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagPubKey, "", "Bench32 encoded new public key of the account")
+
+	_ = cmd.MarkFlagRequired(FlagPubKey)
+
+	return cmd
+}
+
+func GetCmdApproveRotateKey(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-rotate-key",
+		Short: "Approve the proposed rotation of the key of the Trustee account with the given address",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveRotateKey(address, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "Bench32 encoded account address")
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
+func GetCmdFreezeAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-account",
+		Short: "Propose or approve freezing of the account with the given address",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFreezeAccount(address, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "Bench32 encoded account address")
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
+func GetCmdUnfreezeAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-account",
+		Short: "Propose or approve unfreezing of the account with the given address",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnfreezeAccount(address, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "Bench32 encoded account address")
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
@@ -17,9 +17,13 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	restTypes "github.com/cosmos/cosmos-sdk/types/rest"
+	authutils "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth/internal/keeper"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth/internal/types"
@@ -73,14 +77,14 @@ func accountHandler(cliCtx context.CLIContext, storeName string) http.HandlerFun
 
 		address, err := sdk.AccAddressFromBech32(accAddr)
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, sdk.ErrInvalidAddress(accAddr).Error())
+			restCtx.WriteError(http.StatusBadRequest, sdk.ErrInvalidAddress(accAddr))
 
 			return
 		}
 
 		res, height, err := cliCtx.QueryStore(types.GetAccountKey(address), storeName)
 		if err != nil || res == nil {
-			restCtx.WriteErrorResponse(http.StatusNotFound, types.ErrAccountDoesNotExist(address).Error())
+			restCtx.WriteError(http.StatusNotFound, types.ErrAccountDoesNotExist(address))
 
 			return
 		}
@@ -91,3 +95,206 @@ func accountHandler(cliCtx context.CLIContext, storeName string) http.HandlerFun
 		restCtx.RespondWithHeight(types.ZBAccount(account), height)
 	}
 }
+
+func accountTxsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		accAddr := restCtx.Variables()[address]
+
+		address, err := sdk.AccAddressFromBech32(accAddr)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, sdk.ErrInvalidAddress(accAddr))
+
+			return
+		}
+
+		page, err := parseIntParam(r, "page", restTypes.DefaultPage)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		limit, err := parseIntParam(r, "limit", restTypes.DefaultLimit)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		events := types.AccountTxsEvents(address, r.FormValue(msgType))
+
+		txs, err := authutils.QueryTxsByEvents(restCtx.Context(), events, page, limit)
+		if err != nil {
+			restCtx.WriteError(http.StatusInternalServerError, err)
+
+			return
+		}
+
+		restCtx.PostProcessResponseBare(txs)
+	}
+}
+
+func referenceTxsHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		events, err_ := types.ReferenceTxsEvents(restCtx.Variables()[referenceID])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		page, err := parseIntParam(r, "page", restTypes.DefaultPage)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		limit, err := parseIntParam(r, "limit", restTypes.DefaultLimit)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		txs, err := authutils.QueryTxsByEvents(restCtx.Context(), events, page, limit)
+		if err != nil {
+			restCtx.WriteError(http.StatusInternalServerError, err)
+
+			return
+		}
+
+		restCtx.PostProcessResponseBare(txs)
+	}
+}
+
+func parseIntParam(r *http.Request, name string, defaultValue int) (int, error) {
+	value := r.FormValue(name)
+	if len(value) == 0 {
+		return defaultValue, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil || parsed <= 0 {
+		return 0, fmt.Errorf("invalid %s: %v must be a positive integer", name, value)
+	}
+
+	return parsed, nil
+}
+
+func proposedAccountHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+		accAddr := vars[address]
+
+		address, err := sdk.AccAddressFromBech32(accAddr)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, sdk.ErrInvalidAddress(accAddr))
+
+			return
+		}
+
+		res, height, err := cliCtx.QueryStore(types.GetPendingAccountKey(address), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrPendingAccountDoesNotExist(address))
+
+			return
+		}
+
+		var pendAcc types.PendingAccount
+
+		cliCtx.Codec.MustUnmarshalBinaryBare(res, &pendAcc)
+		restCtx.EncodeAndRespondWithHeight(pendAcc, height)
+	}
+}
+
+func proposedAccountToRevokeHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+		accAddr := vars[address]
+
+		address, err := sdk.AccAddressFromBech32(accAddr)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, sdk.ErrInvalidAddress(accAddr))
+
+			return
+		}
+
+		res, height, err := cliCtx.QueryStore(types.GetPendingAccountRevocationKey(address), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrPendingAccountRevocationDoesNotExist(address))
+
+			return
+		}
+
+		var revocation types.PendingAccountRevocation
+
+		cliCtx.Codec.MustUnmarshalBinaryBare(res, &revocation)
+		restCtx.EncodeAndRespondWithHeight(revocation, height)
+	}
+}
+
+func proposedVendorIDUpdatesHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		params, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/%s", storeName, keeper.QueryAllPendingVendorIDUpdates), params)
+	}
+}
+
+func proposedKeyRotationsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		params, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/%s", storeName, keeper.QueryAllPendingKeyRotations), params)
+	}
+}
+
+func proposedAccountFreezesHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		params, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/%s", storeName, keeper.QueryAllPendingAccountFreezes), params)
+	}
+}
+
+func vendorAccountsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		vid, err_ := conversions.ParseVID(vars[vendorID])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		params := types.NewQueryVendorAccountsParams(vid)
+		restCtx.QueryList(fmt.Sprintf("custom/%s/%s", storeName, keeper.QueryVendorAccounts), params)
+	}
+}
@@ -22,7 +22,11 @@ import (
 )
 
 const (
-	address = "address"
+	address  = "address"
+	vendorID = "vid"
+	msgType  = "msg_type"
+
+	referenceID = "reference_id"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application.
@@ -47,6 +51,14 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		fmt.Sprintf("/auth/accounts/{%s}", address),
 		accountHandler(cliCtx, storeName),
 	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/auth/accounts/{%s}/txs", address),
+		accountTxsHandler(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/auth/references/{%s}/txs", referenceID),
+		referenceTxsHandler(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/auth/accounts/proposed/revoked",
 		proposeRevokeAccountHandler(cliCtx),
@@ -59,4 +71,53 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		"/auth/accounts/proposed/revoked",
 		proposedAccountsToRevokeHandler(cliCtx, storeName),
 	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/auth/accounts/proposed/revoked/{%s}", address),
+		proposedAccountToRevokeHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		"/auth/accounts/vendor-id/proposed",
+		proposeUpdateVendorIDHandler(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		fmt.Sprintf("/auth/accounts/vendor-id/proposed/{%s}", address),
+		approveUpdateVendorIDHandler(cliCtx),
+	).Methods("PATCH")
+	r.HandleFunc(
+		"/auth/accounts/vendor-id/proposed",
+		proposedVendorIDUpdatesHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		"/auth/accounts/key",
+		rotateKeyHandler(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		fmt.Sprintf("/auth/accounts/key/proposed/{%s}", address),
+		approveRotateKeyHandler(cliCtx),
+	).Methods("PATCH")
+	r.HandleFunc(
+		"/auth/accounts/key/proposed",
+		proposedKeyRotationsHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/auth/accounts/{%s}/freeze", address),
+		freezeAccountHandler(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		fmt.Sprintf("/auth/accounts/{%s}/unfreeze", address),
+		unfreezeAccountHandler(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/auth/accounts/freeze/proposed",
+		proposedAccountFreezesHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/auth/vendors/{%s}/accounts", vendorID),
+		vendorAccountsHandler(cliCtx, storeName),
+	).Methods("GET")
+	// must be registered after the `/auth/accounts/proposed/revoked` GET route
+	r.HandleFunc(
+		fmt.Sprintf("/auth/accounts/proposed/{%s}", address),
+		proposedAccountHandler(cliCtx, storeName),
+	).Methods("GET")
 }
@@ -26,10 +26,11 @@ import (
 )
 
 type ProposeAddAccountRequest struct {
-	BaseReq restTypes.BaseReq  `json:"base_req"`
-	Address sdk.AccAddress     `json:"address"`
-	Pubkey  string             `json:"pubkey"`
-	Roles   types.AccountRoles `json:"roles"`
+	BaseReq  restTypes.BaseReq  `json:"base_req"`
+	Address  sdk.AccAddress     `json:"address"`
+	Pubkey   string             `json:"pubkey"`
+	Roles    types.AccountRoles `json:"roles"`
+	VendorID uint16             `json:"vendor_id,omitempty"`
 }
 
 func proposeAddAccountHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -51,7 +52,7 @@ func proposeAddAccountHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgProposeAddAccount(req.Address, req.Pubkey, req.Roles, restCtx.Signer())
+		msg := types.NewMsgProposeAddAccount(req.Address, req.Pubkey, req.Roles, req.VendorID, restCtx.Signer())
 
 		restCtx.HandleWriteRequest(msg)
 	}
@@ -156,3 +157,204 @@ func approveRevokeAccountHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		restCtx.HandleWriteRequest(msg)
 	}
 }
+
+type ProposeUpdateVendorIDRequest struct {
+	BaseReq  restTypes.BaseReq `json:"base_req"`
+	Address  sdk.AccAddress    `json:"address"`
+	VendorID uint16            `json:"vendor_id"`
+}
+
+func proposeUpdateVendorIDHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req ProposeUpdateVendorIDRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgProposeUpdateVendorID(req.Address, req.VendorID, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func approveUpdateVendorIDHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		var req rest.BasicReq
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		address, err := sdk.AccAddressFromBech32(vars[address])
+		if err != nil {
+			restCtx.WriteErrorResponse(http.StatusBadRequest,
+				fmt.Sprintf("Request Parsing Error: %v. valid address must be cpecified", err))
+
+			return
+		}
+
+		msg := types.NewMsgApproveUpdateVendorID(address, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+type RotateKeyRequest struct {
+	BaseReq restTypes.BaseReq `json:"base_req"`
+	Pubkey  string            `json:"pubkey"`
+}
+
+func rotateKeyHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req RotateKeyRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgRotateKey(restCtx.Signer(), req.Pubkey, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func approveRotateKeyHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		var req rest.BasicReq
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		address, err := sdk.AccAddressFromBech32(vars[address])
+		if err != nil {
+			restCtx.WriteErrorResponse(http.StatusBadRequest,
+				fmt.Sprintf("Request Parsing Error: %v. valid address must be cpecified", err))
+
+			return
+		}
+
+		msg := types.NewMsgApproveRotateKey(address, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func freezeAccountHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		var req rest.BasicReq
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		address, err := sdk.AccAddressFromBech32(vars[address])
+		if err != nil {
+			restCtx.WriteErrorResponse(http.StatusBadRequest,
+				fmt.Sprintf("Request Parsing Error: %v. valid address must be cpecified", err))
+
+			return
+		}
+
+		msg := types.NewMsgFreezeAccount(address, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func unfreezeAccountHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		var req rest.BasicReq
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		address, err := sdk.AccAddressFromBech32(vars[address])
+		if err != nil {
+			restCtx.WriteErrorResponse(http.StatusBadRequest,
+				fmt.Sprintf("Request Parsing Error: %v. valid address must be cpecified", err))
+
+			return
+		}
+
+		msg := types.NewMsgUnfreezeAccount(address, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
@@ -15,6 +15,8 @@
 package auth
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -22,17 +24,34 @@ type GenesisState struct {
 	Accounts                  []Account                  `json:"accounts"`
 	PendingAccounts           []PendingAccount           `json:"pending_accounts"`
 	PendingAccountRevocations []PendingAccountRevocation `json:"pending_account_revocations"`
+	PendingVendorIDUpdates    []PendingVendorIDUpdate    `json:"pending_vendor_id_updates"`
+	PendingKeyRotations       []PendingKeyRotation       `json:"pending_key_rotations"`
+	PendingAccountFreezes     []PendingAccountFreeze     `json:"pending_account_freezes"`
+	NextAccountNumber         uint64                     `json:"next_account_number"`
+	Params                    *Params                    `json:"params,omitempty"` // default params are used if not set
 }
 
 func NewGenesisState() GenesisState {
+	params := DefaultParams()
+
 	return GenesisState{
 		Accounts:                  []Account{},
 		PendingAccounts:           []PendingAccount{},
 		PendingAccountRevocations: []PendingAccountRevocation{},
+		PendingVendorIDUpdates:    []PendingVendorIDUpdate{},
+		PendingKeyRotations:       []PendingKeyRotation{},
+		PendingAccountFreezes:     []PendingAccountFreeze{},
+		Params:                    &params,
 	}
 }
 
 func ValidateGenesis(data GenesisState) error {
+	if data.Params != nil {
+		if err := data.Params.Validate(); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Params: %v", err))
+		}
+	}
+
 	for _, record := range data.Accounts {
 		if err := record.Validate(); err != nil {
 			return err
@@ -51,6 +70,24 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, record := range data.PendingVendorIDUpdates {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range data.PendingKeyRotations {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range data.PendingAccountFreezes {
+		if err := record.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -59,6 +96,10 @@ func DefaultGenesisState() GenesisState {
 }
 
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	if data.Params != nil {
+		keeper.SetParams(ctx, *data.Params)
+	}
+
 	for _, record := range data.Accounts {
 		keeper.SetAccount(ctx, record)
 	}
@@ -70,6 +111,23 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	for _, record := range data.PendingAccountRevocations {
 		keeper.SetPendingAccountRevocation(ctx, record)
 	}
+
+	for _, record := range data.PendingVendorIDUpdates {
+		keeper.SetPendingVendorIDUpdate(ctx, record)
+	}
+
+	for _, record := range data.PendingKeyRotations {
+		keeper.SetPendingKeyRotation(ctx, record)
+	}
+
+	for _, record := range data.PendingAccountFreezes {
+		keeper.SetPendingAccountFreeze(ctx, record)
+	}
+
+	// restore the account number counter, so that exported accounts keep unique numbers after a restart
+	if data.NextAccountNumber > 0 {
+		keeper.SetAccountNumberCounter(ctx, data.NextAccountNumber)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
@@ -77,6 +135,9 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		accounts                  []Account
 		pendingAccounts           []PendingAccount
 		pendingAccountRevocations []PendingAccountRevocation
+		pendingVendorIDUpdates    []PendingVendorIDUpdate
+		pendingKeyRotations       []PendingKeyRotation
+		pendingAccountFreezes     []PendingAccountFreeze
 	)
 
 	k.IterateAccounts(ctx, func(account Account) (stop bool) {
@@ -97,9 +158,34 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		return false
 	})
 
+	k.IteratePendingVendorIDUpdates(ctx, func(pendingVendorIDUpdate PendingVendorIDUpdate) (stop bool) {
+		pendingVendorIDUpdates = append(pendingVendorIDUpdates, pendingVendorIDUpdate)
+
+		return false
+	})
+
+	k.IteratePendingKeyRotations(ctx, func(pendingKeyRotation PendingKeyRotation) (stop bool) {
+		pendingKeyRotations = append(pendingKeyRotations, pendingKeyRotation)
+
+		return false
+	})
+
+	k.IteratePendingAccountFreezes(ctx, func(pendingAccountFreeze PendingAccountFreeze) (stop bool) {
+		pendingAccountFreezes = append(pendingAccountFreezes, pendingAccountFreeze)
+
+		return false
+	})
+
+	params := k.GetParams(ctx)
+
 	return GenesisState{
 		Accounts:                  accounts,
 		PendingAccounts:           pendingAccounts,
 		PendingAccountRevocations: pendingAccountRevocations,
+		PendingVendorIDUpdates:    pendingVendorIDUpdates,
+		PendingKeyRotations:       pendingKeyRotations,
+		PendingAccountFreezes:     pendingAccountFreezes,
+		NextAccountNumber:         k.GetAccountNumberCounter(ctx),
+		Params:                    &params,
 	}
 }
@@ -16,13 +16,26 @@ package auth
 
 import (
 	"fmt"
-	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth/internal/keeper"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth/internal/types"
 )
 
+func init() {
+	types.RegisterMsgRoles(types.MsgProposeAddAccount{}, types.Trustee)
+	types.RegisterMsgRoles(types.MsgApproveAddAccount{}, types.Trustee)
+	types.RegisterMsgRoles(types.MsgProposeRevokeAccount{}, types.Trustee)
+	types.RegisterMsgRoles(types.MsgApproveRevokeAccount{}, types.Trustee)
+	types.RegisterMsgRoles(types.MsgProposeUpdateVendorID{}, types.Trustee)
+	types.RegisterMsgRoles(types.MsgApproveUpdateVendorID{}, types.Trustee)
+	types.RegisterMsgRoles(types.MsgApproveRotateKey{}, types.Trustee)
+	types.RegisterMsgRoles(types.MsgFreezeAccount{}, types.Trustee)
+	types.RegisterMsgRoles(types.MsgUnfreezeAccount{}, types.Trustee)
+}
+
 func NewHandler(keeper keeper.Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		switch msg := msg.(type) {
@@ -34,6 +47,18 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 			return handleMsgProposeRevokeAccount(ctx, keeper, msg)
 		case types.MsgApproveRevokeAccount:
 			return handleMsgApproveRevokeAccount(ctx, keeper, msg)
+		case types.MsgProposeUpdateVendorID:
+			return handleMsgProposeUpdateVendorID(ctx, keeper, msg)
+		case types.MsgApproveUpdateVendorID:
+			return handleMsgApproveUpdateVendorID(ctx, keeper, msg)
+		case types.MsgRotateKey:
+			return handleMsgRotateKey(ctx, keeper, msg)
+		case types.MsgApproveRotateKey:
+			return handleMsgApproveRotateKey(ctx, keeper, msg)
+		case types.MsgFreezeAccount:
+			return handleMsgFreezeAccount(ctx, keeper, msg)
+		case types.MsgUnfreezeAccount:
+			return handleMsgUnfreezeAccount(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized auth Msg type: %v", msg.Type())
 
@@ -44,10 +69,8 @@ func NewHandler(keeper keeper.Keeper) sdk.Handler {
 
 func handleMsgProposeAddAccount(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgProposeAddAccount) sdk.Result {
 	// check if sender has enough rights to propose account.
-	if !keeper.HasRole(ctx, msg.Signer, types.Trustee) {
-		return sdk.ErrUnauthorized(
-			fmt.Sprintf("MsgProposeAddAccount transaction should be signed by an account with the %s role",
-				types.Trustee)).Result()
+	if err := keeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
 	}
 
 	// check if active account already exists.
@@ -70,10 +93,13 @@ func handleMsgProposeAddAccount(ctx sdk.Context, keeper keeper.Keeper, msg types
 	if AccountApprovalsCount(ctx, keeper) > 1 {
 		// create and store pending account.
 		account := types.NewPendingAccount(msg.Address, pubKey, msg.Roles, msg.Signer)
+		account.VendorID = msg.VendorID
+		account.ProposedAt = ctx.BlockHeight()
 		keeper.SetPendingAccount(ctx, account)
 	} else {
 		// create account, assign account number and store it
 		account := types.NewAccount(msg.Address, pubKey, msg.Roles)
+		account.VendorID = msg.VendorID
 		account.AccountNumber = keeper.GetNextAccountNumber(ctx)
 		keeper.SetAccount(ctx, account)
 	}
@@ -83,9 +109,8 @@ func handleMsgProposeAddAccount(ctx sdk.Context, keeper keeper.Keeper, msg types
 
 func handleMsgApproveAddAccount(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgApproveAddAccount) sdk.Result {
 	// check if sender has enough rights to approve account
-	if !keeper.HasRole(ctx, msg.Signer, Trustee) {
-		return sdk.ErrUnauthorized(
-			fmt.Sprintf("MsgApproveAddAccount transaction should be signed by an account with the %s role", Trustee)).Result()
+	if err := keeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
 	}
 
 	// check if pending account exists
@@ -97,19 +122,22 @@ func handleMsgApproveAddAccount(ctx sdk.Context, keeper keeper.Keeper, msg types
 	pendAcc := keeper.GetPendingAccount(ctx, msg.Address)
 
 	// check if pending account already has approval from signer
-	if pendAcc.HasApprovalFrom(msg.Signer) {
+	approved, added := approvals.AddApproval(pendAcc.Approvals, msg.Signer)
+	if !added {
 		return sdk.ErrUnauthorized(
 			fmt.Sprintf("Pending account associated with the address=%v already has approval from=%v",
 				msg.Address, msg.Signer)).Result()
 	}
 
 	// append approval
-	pendAcc.Approvals = append(pendAcc.Approvals, msg.Signer)
+	pendAcc.Approvals = approved
 
 	// check if pending account has enough approvals
-	if len(pendAcc.Approvals) == AccountApprovalsCount(ctx, keeper) {
+	if AccountApprovalPolicy(ctx, keeper).IsReached(len(pendAcc.Approvals),
+		keeper.CountAccountsWithRole(ctx, Trustee)) {
 		// create approved account, assign account number and store it
 		account := types.NewAccount(pendAcc.Address, pendAcc.PubKey, pendAcc.Roles)
+		account.VendorID = pendAcc.VendorID
 		account.AccountNumber = keeper.GetNextAccountNumber(ctx)
 		keeper.SetAccount(ctx, account)
 
@@ -126,10 +154,8 @@ func handleMsgApproveAddAccount(ctx sdk.Context, keeper keeper.Keeper, msg types
 func handleMsgProposeRevokeAccount(ctx sdk.Context, keeper keeper.Keeper,
 	msg types.MsgProposeRevokeAccount) sdk.Result {
 	// check that sender has enough rights to propose account revocation
-	if !keeper.HasRole(ctx, msg.Signer, types.Trustee) {
-		return sdk.ErrUnauthorized(
-			fmt.Sprintf("MsgProposeRevokeAccount transaction should be signed by an account with the %s role",
-				types.Trustee)).Result()
+	if err := keeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
 	}
 
 	// check that account exists
@@ -158,10 +184,8 @@ func handleMsgProposeRevokeAccount(ctx sdk.Context, keeper keeper.Keeper,
 func handleMsgApproveRevokeAccount(ctx sdk.Context, keeper keeper.Keeper,
 	msg types.MsgApproveRevokeAccount) sdk.Result {
 	// check that sender has enough rights to approve account revocation
-	if !keeper.HasRole(ctx, msg.Signer, types.Trustee) {
-		return sdk.ErrUnauthorized(
-			fmt.Sprintf("MsgApproveRevokeAccount transaction should be signed by an account with the %s role",
-				types.Trustee)).Result()
+	if err := keeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
 	}
 
 	// check that pending account revocation exists
@@ -173,17 +197,18 @@ func handleMsgApproveRevokeAccount(ctx sdk.Context, keeper keeper.Keeper,
 	revoc := keeper.GetPendingAccountRevocation(ctx, msg.Address)
 
 	// check if pending account revocation already has approval from signer
-	if revoc.HasApprovalFrom(msg.Signer) {
+	approved, added := approvals.AddApproval(revoc.Approvals, msg.Signer)
+	if !added {
 		return sdk.ErrUnauthorized(
 			fmt.Sprintf("Pending account revocation associated with the address=%v already has approval from=%v",
 				msg.Address, msg.Signer)).Result()
 	}
 
 	// append approval
-	revoc.Approvals = append(revoc.Approvals, msg.Signer)
+	revoc.Approvals = approved
 
 	// check if pending account revocation has enough approvals
-	if len(revoc.Approvals) == AccountApprovalsCount(ctx, keeper) {
+	if AccountApprovalPolicy(ctx, keeper).IsReached(len(revoc.Approvals), keeper.CountAccountsWithRole(ctx, Trustee)) {
 		// delete account record
 		keeper.DeleteAccount(ctx, msg.Address)
 
@@ -197,6 +222,263 @@ func handleMsgApproveRevokeAccount(ctx sdk.Context, keeper keeper.Keeper,
 	return sdk.Result{}
 }
 
+func handleMsgProposeUpdateVendorID(ctx sdk.Context, keeper keeper.Keeper,
+	msg types.MsgProposeUpdateVendorID) sdk.Result {
+	// check that sender has enough rights to propose VendorID update
+	if err := keeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// check that account exists and it is a vendor account
+	if !keeper.IsAccountPresent(ctx, msg.Address) {
+		return types.ErrAccountDoesNotExist(msg.Address).Result()
+	}
+
+	account := keeper.GetAccount(ctx, msg.Address)
+
+	if !account.HasRole(types.Vendor) {
+		return types.ErrAccountIsNotVendor(msg.Address).Result()
+	}
+
+	// check that pending VendorID update does not exist yet
+	if keeper.IsPendingVendorIDUpdatePresent(ctx, msg.Address) {
+		return types.ErrPendingVendorIDUpdateAlreadyExists(msg.Address).Result()
+	}
+
+	// if more than 1 trustee's approval is needed, create pending VendorID update else update the account.
+	if AccountApprovalsCount(ctx, keeper) > 1 {
+		update := types.NewPendingVendorIDUpdate(msg.Address, msg.VendorID, msg.Signer)
+		keeper.SetPendingVendorIDUpdate(ctx, update)
+	} else {
+		account.VendorID = msg.VendorID
+		keeper.SetAccount(ctx, account)
+	}
+
+	return sdk.Result{}
+}
+
+func handleMsgApproveUpdateVendorID(ctx sdk.Context, keeper keeper.Keeper,
+	msg types.MsgApproveUpdateVendorID) sdk.Result {
+	// check that sender has enough rights to approve VendorID update
+	if err := keeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// check that pending VendorID update exists
+	if !keeper.IsPendingVendorIDUpdatePresent(ctx, msg.Address) {
+		return types.ErrPendingVendorIDUpdateDoesNotExist(msg.Address).Result()
+	}
+
+	// get pending VendorID update
+	update := keeper.GetPendingVendorIDUpdate(ctx, msg.Address)
+
+	// check if pending VendorID update already has approval from signer
+	approved, added := approvals.AddApproval(update.Approvals, msg.Signer)
+	if !added {
+		return sdk.ErrUnauthorized(
+			fmt.Sprintf("Pending VendorID update associated with the address=%v already has approval from=%v",
+				msg.Address, msg.Signer)).Result()
+	}
+
+	// append approval
+	update.Approvals = approved
+
+	// check if pending VendorID update has enough approvals
+	if AccountApprovalPolicy(ctx, keeper).IsReached(len(update.Approvals), keeper.CountAccountsWithRole(ctx, Trustee)) {
+		// the account may have been revoked while the update was pending
+		if !keeper.IsAccountPresent(ctx, msg.Address) {
+			return types.ErrAccountDoesNotExist(msg.Address).Result()
+		}
+
+		// bind the account to the new VendorID
+		account := keeper.GetAccount(ctx, msg.Address)
+		account.VendorID = update.VendorID
+		keeper.SetAccount(ctx, account)
+
+		// delete pending VendorID update record
+		keeper.DeletePendingVendorIDUpdate(ctx, msg.Address)
+	} else {
+		// update pending VendorID update record
+		keeper.SetPendingVendorIDUpdate(ctx, update)
+	}
+
+	return sdk.Result{}
+}
+
+func handleMsgRotateKey(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgRotateKey) sdk.Result {
+	// check that account exists
+	if !keeper.IsAccountPresent(ctx, msg.Address) {
+		return types.ErrAccountDoesNotExist(msg.Address).Result()
+	}
+
+	// parse the key.
+	pubKey, err := sdk.GetAccPubKeyBech32(msg.PublicKey)
+	if err != nil {
+		return sdk.ErrInvalidPubKey(err.Error()).Result()
+	}
+
+	// the account could not sign transactions anymore with the key rejected by the ante handler
+	if _, ok := pubKey.(ed25519.PubKeyEd25519); ok {
+		return sdk.ErrInvalidPubKey("ED25519 public keys are unsupported").Result()
+	}
+
+	account := keeper.GetAccount(ctx, msg.Address)
+
+	// the key of a Trustee account is rotated once the other trustees approve it (if more than 1 approval is needed),
+	// so a compromised key of a Trustee can not be used to take over the account.
+	if account.HasRole(types.Trustee) && AccountApprovalsCount(ctx, keeper) > 1 {
+		// check that pending key rotation does not exist yet
+		if keeper.IsPendingKeyRotationPresent(ctx, msg.Address) {
+			return types.ErrPendingKeyRotationAlreadyExists(msg.Address).Result()
+		}
+
+		rotation := types.NewPendingKeyRotation(msg.Address, pubKey, msg.Signer)
+		keeper.SetPendingKeyRotation(ctx, rotation)
+	} else {
+		// the address, roles, account number and sequence are kept
+		account.PubKey = pubKey
+		keeper.SetAccount(ctx, account)
+	}
+
+	return sdk.Result{}
+}
+
+func handleMsgApproveRotateKey(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgApproveRotateKey) sdk.Result {
+	// check that sender has enough rights to approve key rotation
+	if err := keeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// check that pending key rotation exists
+	if !keeper.IsPendingKeyRotationPresent(ctx, msg.Address) {
+		return types.ErrPendingKeyRotationDoesNotExist(msg.Address).Result()
+	}
+
+	// get pending key rotation
+	rotation := keeper.GetPendingKeyRotation(ctx, msg.Address)
+
+	// check if pending key rotation already has approval from signer
+	approved, added := approvals.AddApproval(rotation.Approvals, msg.Signer)
+	if !added {
+		return sdk.ErrUnauthorized(
+			fmt.Sprintf("Pending key rotation associated with the address=%v already has approval from=%v",
+				msg.Address, msg.Signer)).Result()
+	}
+
+	// append approval
+	rotation.Approvals = approved
+
+	// check if pending key rotation has enough approvals
+	if AccountApprovalPolicy(ctx, keeper).IsReached(len(rotation.Approvals),
+		keeper.CountAccountsWithRole(ctx, Trustee)) {
+		// the account may have been revoked while the rotation was pending
+		if !keeper.IsAccountPresent(ctx, msg.Address) {
+			return types.ErrAccountDoesNotExist(msg.Address).Result()
+		}
+
+		// replace the key of the account
+		account := keeper.GetAccount(ctx, msg.Address)
+		account.PubKey = rotation.PubKey
+		keeper.SetAccount(ctx, account)
+
+		// delete pending key rotation record
+		keeper.DeletePendingKeyRotation(ctx, msg.Address)
+	} else {
+		// update pending key rotation record
+		keeper.SetPendingKeyRotation(ctx, rotation)
+	}
+
+	return sdk.Result{}
+}
+
+func handleMsgFreezeAccount(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgFreezeAccount) sdk.Result {
+	// check that sender has enough rights to freeze account
+	if err := keeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// check that account exists and it is not frozen yet
+	if !keeper.IsAccountPresent(ctx, msg.Address) {
+		return types.ErrAccountDoesNotExist(msg.Address).Result()
+	}
+
+	if keeper.GetAccount(ctx, msg.Address).Frozen {
+		return types.ErrAccountFrozen(msg.Address).Result()
+	}
+
+	return approveAccountFreeze(ctx, keeper, msg.Address, true, msg.Signer)
+}
+
+func handleMsgUnfreezeAccount(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgUnfreezeAccount) sdk.Result {
+	// check that sender has enough rights to unfreeze account
+	if err := keeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// check that account exists and it is frozen
+	if !keeper.IsAccountPresent(ctx, msg.Address) {
+		return types.ErrAccountDoesNotExist(msg.Address).Result()
+	}
+
+	if !keeper.GetAccount(ctx, msg.Address).Frozen {
+		return types.ErrAccountNotFrozen(msg.Address).Result()
+	}
+
+	return approveAccountFreeze(ctx, keeper, msg.Address, false, msg.Signer)
+}
+
+// approveAccountFreeze counts the signer's vote for freezing (or unfreezing) the account:
+// the first vote creates a pending record, the following ones approve it.
+// Once enough trustees voted the frozen flag of the account is changed.
+func approveAccountFreeze(ctx sdk.Context, keeper keeper.Keeper,
+	address sdk.AccAddress, freeze bool, signer sdk.AccAddress) sdk.Result {
+	var pending types.PendingAccountFreeze
+
+	// a pending record in the opposite direction is outdated (the account was revoked and added again meanwhile)
+	if keeper.IsPendingAccountFreezePresent(ctx, address) &&
+		keeper.GetPendingAccountFreeze(ctx, address).Freeze == freeze {
+		pending = keeper.GetPendingAccountFreeze(ctx, address)
+
+		// check if pending account freeze already has approval from signer
+		approved, added := approvals.AddApproval(pending.Approvals, signer)
+		if !added {
+			return sdk.ErrUnauthorized(
+				fmt.Sprintf("Pending account freeze associated with the address=%v already has approval from=%v",
+					address, signer)).Result()
+		}
+
+		// append approval
+		pending.Approvals = approved
+	} else {
+		pending = types.NewPendingAccountFreeze(address, freeze, signer)
+	}
+
+	// check if pending account freeze has enough approvals
+	if AccountApprovalPolicy(ctx, keeper).IsReached(len(pending.Approvals),
+		keeper.CountAccountsWithRole(ctx, Trustee)) {
+		// the account keeps all its records, only its transactions are rejected by the ante handler
+		account := keeper.GetAccount(ctx, address)
+		account.Frozen = freeze
+		keeper.SetAccount(ctx, account)
+
+		// delete pending account freeze record
+		if keeper.IsPendingAccountFreezePresent(ctx, address) {
+			keeper.DeletePendingAccountFreeze(ctx, address)
+		}
+	} else {
+		// update pending account freeze record
+		keeper.SetPendingAccountFreeze(ctx, pending)
+	}
+
+	return sdk.Result{}
+}
+
+// AccountApprovalPolicy defines the share of trustees required to approve creating or revoking of an account.
+// The share is the module parameter, so it can be changed by trustees.
+func AccountApprovalPolicy(ctx sdk.Context, keeper keeper.Keeper) approvals.Policy {
+	return keeper.GetParams(ctx).AccountApprovalPolicy()
+}
+
 func AccountApprovalsCount(ctx sdk.Context, keeper keeper.Keeper) int {
-	return int(math.Round(types.AccountApprovalPercent * float64(keeper.CountAccountsWithRole(ctx, Trustee))))
+	return AccountApprovalPolicy(ctx, keeper).RequiredVotes(keeper.CountAccountsWithRole(ctx, Trustee))
 }
@@ -139,6 +139,72 @@ func TestHandler_CreateAccount_ThreeApprovalsAreNeeded(t *testing.T) {
 	require.False(t, setup.Keeper.IsPendingAccountPresent(setup.Ctx, address))
 }
 
+func TestHandler_CreateAccount_ApprovalPercentIsParam(t *testing.T) {
+	setup := Setup()
+
+	// store 3 trustees
+	trustee1 := storeTrustee(setup)
+	trustee2 := storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	// require approvals of all the trustees
+	params := types.DefaultParams()
+	params.AccountApprovalPercent = sdk.OneDec()
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	require.Equal(t, 3, AccountApprovalsCount(setup.Ctx, setup.Keeper))
+
+	// trustee1 propose account
+	result, address, _ := proposeAddAccount(setup, trustee1)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// trustee2 approves account
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveAddAccount(address, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// account is still pending
+	require.True(t, setup.Keeper.IsPendingAccountPresent(setup.Ctx, address))
+	require.False(t, setup.Keeper.IsAccountPresent(setup.Ctx, address))
+
+	// require approval of a single trustee
+	params.AccountApprovalPercent = sdk.NewDecWithPrec(30, 2)
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	require.Equal(t, 1, AccountApprovalsCount(setup.Ctx, setup.Keeper))
+}
+
+func TestHandler_CreateAccount_PendingAccountExpires(t *testing.T) {
+	setup := Setup()
+
+	// store 3 trustees
+	trustee1 := storeTrustee(setup)
+	trustee2 := storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	params := types.DefaultParams()
+	params.PendingAccountExpirationPeriod = 10
+	setup.Keeper.SetParams(setup.Ctx, params)
+
+	// trustee1 propose account at height 5
+	setup.Ctx = setup.Ctx.WithBlockHeight(5)
+	result, address, _ := proposeAddAccount(setup, trustee1)
+	require.Equal(t, sdk.CodeOK, result.Code)
+	require.Equal(t, int64(5), setup.Keeper.GetPendingAccount(setup.Ctx, address).ProposedAt)
+
+	// the account is still pending at the end of the expiration period
+	setup.Keeper.EndBlocker(setup.Ctx.WithBlockHeight(15))
+	require.True(t, setup.Keeper.IsPendingAccountPresent(setup.Ctx, address))
+
+	// the pending account is deleted after the expiration period
+	setup.Keeper.EndBlocker(setup.Ctx.WithBlockHeight(16))
+	require.False(t, setup.Keeper.IsPendingAccountPresent(setup.Ctx, address))
+
+	// so it can't be approved anymore
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveAddAccount(address, trustee2))
+	require.Equal(t, types.CodePendingAccountDoesNotExist, result.Code)
+	require.False(t, setup.Keeper.IsAccountPresent(setup.Ctx, address))
+}
+
 func TestHandler_ProposeAddAccount_ByNotTrustee(t *testing.T) {
 	setup := Setup()
 
@@ -148,7 +214,7 @@ func TestHandler_ProposeAddAccount_ByNotTrustee(t *testing.T) {
 
 		// propose new account
 		result, _, _ := proposeAddAccount(setup, signer)
-		require.Equal(t, sdk.CodeUnauthorized, result.Code)
+		require.Equal(t, types.CodeMissingRole, result.Code)
 	}
 }
 
@@ -171,6 +237,7 @@ func TestHandler_ProposeAddAccount_ForExistingActiveAccount(t *testing.T) {
 		address,
 		sdk.MustBech32ifyAccPub(pubkey),
 		types.AccountRoles{types.Vendor},
+		0,
 		trustee2,
 	)
 	result = setup.Handler(setup.Ctx, proposeAddAccount)
@@ -197,6 +264,7 @@ func TestHandler_ProposeAddAccount_ForExistingPendingAccount(t *testing.T) {
 		address,
 		sdk.MustBech32ifyAccPub(pubkey),
 		types.AccountRoles{types.Vendor},
+		0,
 		trustee2,
 	)
 	result = setup.Handler(setup.Ctx, proposeAddAccount)
@@ -225,7 +293,7 @@ func TestHandler_ApproveAddAccount_ByNotTrustee(t *testing.T) {
 		// try to approve account
 		approveAddAccount := types.NewMsgApproveAddAccount(address, signer)
 		result := setup.Handler(setup.Ctx, approveAddAccount)
-		require.Equal(t, sdk.CodeUnauthorized, result.Code)
+		require.Equal(t, types.CodeMissingRole, result.Code)
 	}
 }
 
@@ -415,7 +483,7 @@ func TestHandler_ProposeRevokeAccount_ByNotTrustee(t *testing.T) {
 		// propose new account
 		proposeRevokeAccount := types.NewMsgProposeRevokeAccount(address, signer)
 		result := setup.Handler(setup.Ctx, proposeRevokeAccount)
-		require.Equal(t, sdk.CodeUnauthorized, result.Code)
+		require.Equal(t, types.CodeMissingRole, result.Code)
 	}
 }
 
@@ -482,7 +550,7 @@ func TestHandler_ApproveRevokeAccount_ByNotTrustee(t *testing.T) {
 		// try to approve account
 		approveRevokeAccount := types.NewMsgApproveRevokeAccount(address, signer)
 		result := setup.Handler(setup.Ctx, approveRevokeAccount)
-		require.Equal(t, sdk.CodeUnauthorized, result.Code)
+		require.Equal(t, types.CodeMissingRole, result.Code)
 	}
 }
 
@@ -538,6 +606,327 @@ func TestHandler_ApproveRevokeAccount_ForDuplicateApproval(t *testing.T) {
 	require.Equal(t, sdk.CodeUnauthorized, result.Code)
 }
 
+func TestHandler_CreateVendorAccountWithVendorID(t *testing.T) {
+	setup := Setup()
+
+	// store 3 trustees
+	trustee1 := storeTrustee(setup)
+	trustee2 := storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	// trustee1 proposes vendor account bound to VendorID
+	address, _, pubkeyStr := testconstants.TestAddress()
+	proposeAddAccount := types.NewMsgProposeAddAccount(
+		address,
+		pubkeyStr,
+		types.AccountRoles{types.Vendor},
+		testconstants.VID,
+		trustee1,
+	)
+	result := setup.Handler(setup.Ctx, proposeAddAccount)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// ensure pending account keeps VendorID
+	pendingAccount := setup.Keeper.GetPendingAccount(setup.Ctx, address)
+	require.Equal(t, testconstants.VID, pendingAccount.VendorID)
+
+	// trustee2 approves account
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveAddAccount(address, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// active account must be bound to VendorID
+	account := setup.Keeper.GetAccount(setup.Ctx, address)
+	require.Equal(t, testconstants.VID, account.VendorID)
+	require.Equal(t, []sdk.AccAddress{address}, setup.Keeper.GetVendorAccounts(setup.Ctx, testconstants.VID))
+}
+
+func TestHandler_UpdateVendorID_TwoApprovalsAreNeeded(t *testing.T) {
+	setup := Setup()
+
+	// store 3 trustees
+	trustee1 := storeTrustee(setup)
+	trustee2 := storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	// store vendor
+	vendor := storeAccount(setup, types.Vendor)
+
+	// trustee1 proposes VendorID update
+	proposeUpdateVendorID := types.NewMsgProposeUpdateVendorID(vendor, testconstants.VID, trustee1)
+	result := setup.Handler(setup.Ctx, proposeUpdateVendorID)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// ensure pending VendorID update created
+	update := setup.Keeper.GetPendingVendorIDUpdate(setup.Ctx, vendor)
+	require.Equal(t, testconstants.VID, update.VendorID)
+	require.Equal(t, []sdk.AccAddress{trustee1}, update.Approvals)
+
+	// ensure account is not bound yet
+	require.Equal(t, uint16(0), setup.Keeper.GetAccount(setup.Ctx, vendor).VendorID)
+
+	// trustee1 tries to approve the same update
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveUpdateVendorID(vendor, trustee1))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// trustee2 approves VendorID update
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveUpdateVendorID(vendor, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// ensure account is bound to VendorID
+	require.Equal(t, testconstants.VID, setup.Keeper.GetAccount(setup.Ctx, vendor).VendorID)
+
+	// ensure pending VendorID update removed
+	require.False(t, setup.Keeper.IsPendingVendorIDUpdatePresent(setup.Ctx, vendor))
+}
+
+func TestHandler_ProposeUpdateVendorID_ForNotVendor(t *testing.T) {
+	setup := Setup()
+
+	trustee := storeTrustee(setup)
+	testHouse := storeAccount(setup, types.TestHouse)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpdateVendorID(testHouse, testconstants.VID, trustee))
+	require.Equal(t, types.CodeAccountIsNotVendor, result.Code)
+}
+
+func TestHandler_ProposeUpdateVendorID_ForUnknownAccount(t *testing.T) {
+	setup := Setup()
+
+	trustee := storeTrustee(setup)
+
+	result := setup.Handler(setup.Ctx,
+		types.NewMsgProposeUpdateVendorID(testconstants.Address1, testconstants.VID, trustee))
+	require.Equal(t, types.CodeAccountDoesNotExist, result.Code)
+}
+
+func TestHandler_ProposeUpdateVendorID_ByNotTrustee(t *testing.T) {
+	setup := Setup()
+
+	_ = storeTrustee(setup)
+	vendor := storeAccount(setup, types.Vendor)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpdateVendorID(vendor, testconstants.VID, vendor))
+	require.Equal(t, types.CodeMissingRole, result.Code)
+}
+
+func TestHandler_ProposeUpdateVendorID_ForExistingPendingUpdate(t *testing.T) {
+	setup := Setup()
+
+	// store 3 trustees
+	trustee1 := storeTrustee(setup)
+	trustee2 := storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	vendor := storeAccount(setup, types.Vendor)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpdateVendorID(vendor, testconstants.VID, trustee1))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, types.NewMsgProposeUpdateVendorID(vendor, testconstants.VID, trustee2))
+	require.Equal(t, types.CodePendingVendorIDUpdateAlreadyExists, result.Code)
+}
+
+func TestHandler_ApproveUpdateVendorID_ForUnknownUpdate(t *testing.T) {
+	setup := Setup()
+
+	trustee := storeTrustee(setup)
+	vendor := storeAccount(setup, types.Vendor)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgApproveUpdateVendorID(vendor, trustee))
+	require.Equal(t, types.CodePendingVendorIDUpdateDoesNotExist, result.Code)
+}
+
+func TestHandler_RotateKey(t *testing.T) {
+	setup := Setup()
+
+	_ = storeTrustee(setup)
+	vendor := storeAccount(setup, types.Vendor)
+	account := setup.Keeper.GetAccount(setup.Ctx, vendor)
+
+	_, newPubKey, newPubKeyStr := testconstants.TestAddress()
+
+	result := setup.Handler(setup.Ctx, types.NewMsgRotateKey(vendor, newPubKeyStr, vendor))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// ensure only the key is replaced
+	rotated := setup.Keeper.GetAccount(setup.Ctx, vendor)
+	require.Equal(t, newPubKey, rotated.PubKey)
+	require.Equal(t, account.Address, rotated.Address)
+	require.Equal(t, account.Roles, rotated.Roles)
+	require.Equal(t, account.AccountNumber, rotated.AccountNumber)
+	require.Equal(t, account.Sequence, rotated.Sequence)
+}
+
+func TestHandler_RotateKey_OfTrustee_TwoApprovalsAreNeeded(t *testing.T) {
+	setup := Setup()
+
+	// store 3 trustees
+	trustee1 := storeTrustee(setup)
+	trustee2 := storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	oldPubKey := setup.Keeper.GetAccount(setup.Ctx, trustee1).PubKey
+	_, newPubKey, newPubKeyStr := testconstants.TestAddress()
+
+	// trustee1 rotates its key
+	result := setup.Handler(setup.Ctx, types.NewMsgRotateKey(trustee1, newPubKeyStr, trustee1))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// ensure pending key rotation created
+	rotation := setup.Keeper.GetPendingKeyRotation(setup.Ctx, trustee1)
+	require.Equal(t, newPubKey, rotation.PubKey)
+	require.Equal(t, []sdk.AccAddress{trustee1}, rotation.Approvals)
+
+	// ensure key is not replaced yet
+	require.Equal(t, oldPubKey, setup.Keeper.GetAccount(setup.Ctx, trustee1).PubKey)
+
+	// trustee1 tries to rotate its key again
+	result = setup.Handler(setup.Ctx, types.NewMsgRotateKey(trustee1, newPubKeyStr, trustee1))
+	require.Equal(t, types.CodePendingKeyRotationAlreadyExists, result.Code)
+
+	// trustee1 tries to approve its own rotation
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveRotateKey(trustee1, trustee1))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// trustee2 approves key rotation
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveRotateKey(trustee1, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// ensure key is replaced
+	require.Equal(t, newPubKey, setup.Keeper.GetAccount(setup.Ctx, trustee1).PubKey)
+
+	// ensure pending key rotation removed
+	require.False(t, setup.Keeper.IsPendingKeyRotationPresent(setup.Ctx, trustee1))
+}
+
+func TestHandler_RotateKey_InvalidKey(t *testing.T) {
+	setup := Setup()
+
+	vendor := storeAccount(setup, types.Vendor)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgRotateKey(vendor, "invalid", vendor))
+	require.Equal(t, sdk.CodeInvalidPubKey, result.Code)
+}
+
+func TestHandler_RotateKey_ForUnknownAccount(t *testing.T) {
+	setup := Setup()
+
+	_, _, pubKeyStr := testconstants.TestAddress()
+
+	result := setup.Handler(setup.Ctx,
+		types.NewMsgRotateKey(testconstants.Address1, pubKeyStr, testconstants.Address1))
+	require.Equal(t, types.CodeAccountDoesNotExist, result.Code)
+}
+
+func TestHandler_ApproveRotateKey_ByNotTrustee(t *testing.T) {
+	setup := Setup()
+
+	trustee := storeTrustee(setup)
+	vendor := storeAccount(setup, types.Vendor)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgApproveRotateKey(trustee, vendor))
+	require.Equal(t, types.CodeMissingRole, result.Code)
+}
+
+func TestHandler_ApproveRotateKey_ForUnknownRotation(t *testing.T) {
+	setup := Setup()
+
+	trustee1 := storeTrustee(setup)
+	trustee2 := storeTrustee(setup)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgApproveRotateKey(trustee1, trustee2))
+	require.Equal(t, types.CodePendingKeyRotationDoesNotExist, result.Code)
+}
+
+func TestHandler_FreezeAccount(t *testing.T) {
+	setup := Setup()
+
+	trustee := storeTrustee(setup)
+	vendor := storeAccount(setup, types.Vendor)
+
+	// freeze account
+	result := setup.Handler(setup.Ctx, types.NewMsgFreezeAccount(vendor, trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+	require.True(t, setup.Keeper.GetAccount(setup.Ctx, vendor).Frozen)
+
+	// freeze it again
+	result = setup.Handler(setup.Ctx, types.NewMsgFreezeAccount(vendor, trustee))
+	require.Equal(t, types.CodeAccountFrozen, result.Code)
+
+	// unfreeze account
+	result = setup.Handler(setup.Ctx, types.NewMsgUnfreezeAccount(vendor, trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+	require.False(t, setup.Keeper.GetAccount(setup.Ctx, vendor).Frozen)
+
+	// unfreeze it again
+	result = setup.Handler(setup.Ctx, types.NewMsgUnfreezeAccount(vendor, trustee))
+	require.Equal(t, types.CodeAccountNotFrozen, result.Code)
+}
+
+func TestHandler_FreezeAccount_TwoApprovalsAreNeeded(t *testing.T) {
+	setup := Setup()
+
+	// store 3 trustees
+	trustee1 := storeTrustee(setup)
+	trustee2 := storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	testHouse := storeAccount(setup, types.TestHouse)
+
+	// trustee1 proposes to freeze account
+	result := setup.Handler(setup.Ctx, types.NewMsgFreezeAccount(testHouse, trustee1))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// ensure pending account freeze created and account is not frozen yet
+	pending := setup.Keeper.GetPendingAccountFreeze(setup.Ctx, testHouse)
+	require.True(t, pending.Freeze)
+	require.Equal(t, []sdk.AccAddress{trustee1}, pending.Approvals)
+	require.False(t, setup.Keeper.GetAccount(setup.Ctx, testHouse).Frozen)
+
+	// trustee1 votes again
+	result = setup.Handler(setup.Ctx, types.NewMsgFreezeAccount(testHouse, trustee1))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// trustee2 approves
+	result = setup.Handler(setup.Ctx, types.NewMsgFreezeAccount(testHouse, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// ensure account is frozen and pending account freeze removed
+	require.True(t, setup.Keeper.GetAccount(setup.Ctx, testHouse).Frozen)
+	require.False(t, setup.Keeper.IsPendingAccountFreezePresent(setup.Ctx, testHouse))
+
+	// unfreezing needs two approvals as well
+	result = setup.Handler(setup.Ctx, types.NewMsgUnfreezeAccount(testHouse, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+	require.True(t, setup.Keeper.GetAccount(setup.Ctx, testHouse).Frozen)
+	require.False(t, setup.Keeper.GetPendingAccountFreeze(setup.Ctx, testHouse).Freeze)
+
+	result = setup.Handler(setup.Ctx, types.NewMsgUnfreezeAccount(testHouse, trustee1))
+	require.Equal(t, sdk.CodeOK, result.Code)
+	require.False(t, setup.Keeper.GetAccount(setup.Ctx, testHouse).Frozen)
+	require.False(t, setup.Keeper.IsPendingAccountFreezePresent(setup.Ctx, testHouse))
+}
+
+func TestHandler_FreezeAccount_ByNotTrustee(t *testing.T) {
+	setup := Setup()
+
+	_ = storeTrustee(setup)
+	vendor := storeAccount(setup, types.Vendor)
+	testHouse := storeAccount(setup, types.TestHouse)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgFreezeAccount(vendor, testHouse))
+	require.Equal(t, types.CodeMissingRole, result.Code)
+}
+
+func TestHandler_FreezeAccount_ForUnknownAccount(t *testing.T) {
+	setup := Setup()
+
+	trustee := storeTrustee(setup)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgFreezeAccount(testconstants.Address1, trustee))
+	require.Equal(t, types.CodeAccountDoesNotExist, result.Code)
+}
+
 func storeTrustee(setup TestSetup) sdk.AccAddress {
 	return storeAccount(setup, types.Trustee)
 }
@@ -557,6 +946,7 @@ func proposeAddAccount(setup TestSetup, signer sdk.AccAddress) (sdk.Result, sdk.
 		address,
 		pubkeyStr,
 		types.AccountRoles{types.Vendor},
+		0,
 		signer,
 	)
 	result := setup.Handler(setup.Ctx, proposeAddAccount)
@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/tendermint/go-amino"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -29,6 +30,7 @@ type TestSetup struct {
 	Cdc     *amino.Codec
 	Ctx     sdk.Context
 	Keeper  Keeper
+	TKey    sdk.StoreKey
 	Handler sdk.Handler
 	Querier sdk.Querier
 }
@@ -47,10 +49,19 @@ func Setup() TestSetup {
 	key := sdk.NewKVStoreKey(StoreKey)
 	dbStore.MountStoreWithDB(key, sdk.StoreTypeIAVL, nil)
 
+	tkey := sdk.NewTransientStoreKey(TStoreKey)
+	dbStore.MountStoreWithDB(tkey, sdk.StoreTypeTransient, nil)
+
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+	dbStore.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+
 	_ = dbStore.LoadLatestVersion()
 
 	// Init Keepers
-	keeper := NewKeeper(key, cdc)
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, paramsTKey, params.DefaultCodespace)
+	keeper := NewKeeper(key, paramsKeeper.Subspace(DefaultParamspace), cdc)
 
 	// Create context
 	ctx := sdk.NewContext(dbStore, abci.Header{ChainID: testconstants.ChainID}, false, log.NewNopLogger())
@@ -63,6 +74,7 @@ func Setup() TestSetup {
 		Cdc:     cdc,
 		Ctx:     ctx,
 		Keeper:  keeper,
+		TKey:    tkey,
 		Handler: handler,
 		Querier: querier,
 	}
@@ -17,6 +17,8 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth/internal/types"
 )
 
@@ -24,12 +26,27 @@ type Keeper struct {
 	// Unexposed key to access store from sdk.Context
 	storeKey sdk.StoreKey
 
+	// The subspace of the module parameters
+	paramSpace params.Subspace
+
 	// The wire codec for binary encoding/decoding
 	cdc *codec.Codec
+
+	// The store of pending accounts indexed by the height they were proposed at
+	pendingAccounts approvals.ProposalStore
 }
 
-func NewKeeper(storeKey sdk.StoreKey, cdc *codec.Codec) Keeper {
-	return Keeper{storeKey: storeKey, cdc: cdc}
+func NewKeeper(storeKey sdk.StoreKey, paramSpace params.Subspace, cdc *codec.Codec) Keeper {
+	pendingAccounts := approvals.NewProposalStore(storeKey, cdc,
+		types.PendingAccountPrefix, types.PendingAccountExpirationPrefix,
+		func() approvals.Proposal { return &types.PendingAccount{} })
+
+	return Keeper{
+		storeKey:        storeKey,
+		paramSpace:      paramSpace.WithKeyTable(types.ParamKeyTable()),
+		cdc:             cdc,
+		pendingAccounts: pendingAccounts,
+	}
 }
 
 /*
@@ -117,11 +134,60 @@ func (k Keeper) HasRole(ctx sdk.Context, addr sdk.AccAddress, roleToCheck types.
 	return false
 }
 
+// Check if account has at least one of the roles.
+func (k Keeper) HasAnyRole(ctx sdk.Context, addr sdk.AccAddress, roles types.AccountRoles) bool {
+	for _, role := range roles {
+		if k.HasRole(ctx, addr, role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Check that all signers of the message have one of the roles registered for the message type.
+func (k Keeper) CheckMsgRoles(ctx sdk.Context, msg sdk.Msg) sdk.Error {
+	roles, ok := types.GetMsgRoles(msg)
+	if !ok {
+		return nil
+	}
+
+	for _, signer := range msg.GetSigners() {
+		if !k.HasAnyRole(ctx, signer, roles) {
+			return types.ErrMissingRole(msg.Type(), signer, roles)
+		}
+	}
+
+	return nil
+}
+
+// Check that all signers of the message are existing accounts which are not frozen.
+// The tx signers are checked by the ante handler, the signers of the messages executed on behalf of them
+// (by grantees) must be checked separately.
+func (k Keeper) CheckSignersNotFrozen(ctx sdk.Context, msg sdk.Msg) sdk.Error {
+	for _, signer := range msg.GetSigners() {
+		if !k.IsAccountPresent(ctx, signer) {
+			return types.ErrAccountDoesNotExist(signer)
+		}
+
+		if k.GetAccount(ctx, signer).Frozen {
+			return types.ErrAccountFrozen(signer)
+		}
+	}
+
+	return nil
+}
+
 // Count account with assigned role.
+// Frozen accounts are not counted: they cannot vote, so they must not raise the number of required approvals.
 func (k Keeper) CountAccountsWithRole(ctx sdk.Context, roleToCount types.AccountRole) int {
 	res := 0
 
 	k.IterateAccounts(ctx, func(account types.Account) (stop bool) {
+		if account.Frozen {
+			return false
+		}
+
 		for _, role := range account.Roles {
 			if role == roleToCount {
 				res++
@@ -151,67 +217,68 @@ func (k Keeper) DeleteAccount(ctx sdk.Context, address sdk.AccAddress) {
 */
 // Gets the Pending Account record associated with an address.
 func (k Keeper) GetPendingAccount(ctx sdk.Context, address sdk.AccAddress) types.PendingAccount {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetPendingAccountKey(address))
+	var pendAcc types.PendingAccount
 
-	if bz == nil {
+	if !k.pendingAccounts.Get(ctx, types.GetPendingAccountKey(address), &pendAcc) {
 		panic("Pending Account does not exist")
 	}
 
-	var pendAcc types.PendingAccount
-
-	k.cdc.MustUnmarshalBinaryBare(bz, &pendAcc)
-
 	return pendAcc
 }
 
 // Sets Pending Account record for an address.
 func (k Keeper) SetPendingAccount(ctx sdk.Context, pendAcc types.PendingAccount) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetPendingAccountKey(pendAcc.Address), k.cdc.MustMarshalBinaryBare(pendAcc))
+	k.pendingAccounts.Set(ctx, types.GetPendingAccountKey(pendAcc.Address), pendAcc)
 }
 
 // Check if the Pending Account record associated with an address is present in the store or not.
 func (k Keeper) IsPendingAccountPresent(ctx sdk.Context, address sdk.AccAddress) bool {
-	store := ctx.KVStore(k.storeKey)
-
-	return store.Has(types.GetPendingAccountKey(address))
+	return k.pendingAccounts.Has(ctx, types.GetPendingAccountKey(address))
 }
 
 // Iterate over all Pending Accounts.
 func (k Keeper) IteratePendingAccounts(ctx sdk.Context, process func(info types.PendingAccount) (stop bool)) {
-	store := ctx.KVStore(k.storeKey)
+	k.pendingAccounts.Iterate(ctx, func(proposal approvals.Proposal) (stop bool) {
+		return process(*proposal.(*types.PendingAccount))
+	})
+}
 
-	iter := sdk.KVStorePrefixIterator(store, types.PendingAccountPrefix)
-	defer iter.Close()
+// Deletes the Pending Account from the store.
+func (k Keeper) DeletePendingAccount(ctx sdk.Context, address sdk.AccAddress) {
+	if !k.IsPendingAccountPresent(ctx, address) {
+		panic("Pending Account does not exist")
+	}
 
-	for {
-		if !iter.Valid() {
-			return
-		}
+	k.pendingAccounts.Delete(ctx, types.GetPendingAccountKey(address))
+}
 
-		val := iter.Value()
+// The maximum number of expired Pending Accounts deleted in a single block,
+// the rest of them are deleted in the following blocks.
+const MaxExpiredPendingAccountsPerBlock = 100
 
-		var pendAcc types.PendingAccount
+// EndBlocker deletes the Pending Accounts not approved within the expiration period.
+// Called in each EndBlock.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.pendingAccounts.DeleteExpired(ctx, k.GetParams(ctx).AccountApprovalPolicy(), MaxExpiredPendingAccountsPerBlock)
+}
 
-		k.cdc.MustUnmarshalBinaryBare(val, &pendAcc)
+// Sets the proposal height of the Pending Accounts stored before it was tracked to the current height,
+// so that they expire after the expiration period from now on.
+func (k Keeper) SetPendingAccountsProposedAt(ctx sdk.Context) {
+	var pendAccs []types.PendingAccount
 
-		if process(pendAcc) {
-			return
+	k.IteratePendingAccounts(ctx, func(pendAcc types.PendingAccount) (stop bool) {
+		if pendAcc.ProposedAt == 0 {
+			pendAccs = append(pendAccs, pendAcc)
 		}
 
-		iter.Next()
-	}
-}
+		return false
+	})
 
-// Deletes the Pending Account from the store.
-func (k Keeper) DeletePendingAccount(ctx sdk.Context, address sdk.AccAddress) {
-	if !k.IsPendingAccountPresent(ctx, address) {
-		panic("Pending Account does not exist")
+	for _, pendAcc := range pendAccs {
+		pendAcc.ProposedAt = ctx.BlockHeight()
+		k.SetPendingAccount(ctx, pendAcc)
 	}
-
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.GetPendingAccountKey(address))
 }
 
 /*
@@ -283,6 +350,247 @@ func (k Keeper) DeletePendingAccountRevocation(ctx sdk.Context, address sdk.AccA
 	store.Delete(types.GetPendingAccountRevocationKey(address))
 }
 
+/*
+	Pending VendorID Update
+*/
+// Gets the Pending VendorID Update record associated with an address.
+func (k Keeper) GetPendingVendorIDUpdate(ctx sdk.Context, address sdk.AccAddress) types.PendingVendorIDUpdate {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingVendorIDUpdateKey(address))
+
+	if bz == nil {
+		panic("Pending VendorID Update does not exist")
+	}
+
+	var update types.PendingVendorIDUpdate
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &update)
+
+	return update
+}
+
+// Sets Pending VendorID Update record for an address.
+func (k Keeper) SetPendingVendorIDUpdate(ctx sdk.Context, update types.PendingVendorIDUpdate) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingVendorIDUpdateKey(update.Address), k.cdc.MustMarshalBinaryBare(update))
+}
+
+// Check if the Pending VendorID Update record associated with an address is present in the store or not.
+func (k Keeper) IsPendingVendorIDUpdatePresent(ctx sdk.Context, address sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetPendingVendorIDUpdateKey(address))
+}
+
+// Iterate over all Pending VendorID Updates.
+func (k Keeper) IteratePendingVendorIDUpdates(ctx sdk.Context,
+	process func(info types.PendingVendorIDUpdate) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.PendingVendorIDUpdatePrefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		val := iter.Value()
+
+		var update types.PendingVendorIDUpdate
+
+		k.cdc.MustUnmarshalBinaryBare(val, &update)
+
+		if process(update) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+// Deletes the Pending VendorID Update from the store.
+func (k Keeper) DeletePendingVendorIDUpdate(ctx sdk.Context, address sdk.AccAddress) {
+	if !k.IsPendingVendorIDUpdatePresent(ctx, address) {
+		panic("Pending VendorID Update does not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingVendorIDUpdateKey(address))
+}
+
+/*
+	Pending Key Rotation
+*/
+// Gets the Pending Key Rotation record associated with an address.
+func (k Keeper) GetPendingKeyRotation(ctx sdk.Context, address sdk.AccAddress) types.PendingKeyRotation {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingKeyRotationKey(address))
+
+	if bz == nil {
+		panic("Pending Key Rotation does not exist")
+	}
+
+	var rotation types.PendingKeyRotation
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &rotation)
+
+	return rotation
+}
+
+// Sets Pending Key Rotation record for an address.
+func (k Keeper) SetPendingKeyRotation(ctx sdk.Context, rotation types.PendingKeyRotation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingKeyRotationKey(rotation.Address), k.cdc.MustMarshalBinaryBare(rotation))
+}
+
+// Check if the Pending Key Rotation record associated with an address is present in the store or not.
+func (k Keeper) IsPendingKeyRotationPresent(ctx sdk.Context, address sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetPendingKeyRotationKey(address))
+}
+
+// Iterate over all Pending Key Rotations.
+func (k Keeper) IteratePendingKeyRotations(ctx sdk.Context,
+	process func(info types.PendingKeyRotation) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.PendingKeyRotationPrefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		val := iter.Value()
+
+		var rotation types.PendingKeyRotation
+
+		k.cdc.MustUnmarshalBinaryBare(val, &rotation)
+
+		if process(rotation) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+// Deletes the Pending Key Rotation from the store.
+func (k Keeper) DeletePendingKeyRotation(ctx sdk.Context, address sdk.AccAddress) {
+	if !k.IsPendingKeyRotationPresent(ctx, address) {
+		panic("Pending Key Rotation does not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingKeyRotationKey(address))
+}
+
+/*
+	Pending Account Freeze
+*/
+// Gets the Pending Account Freeze record associated with an address.
+func (k Keeper) GetPendingAccountFreeze(ctx sdk.Context, address sdk.AccAddress) types.PendingAccountFreeze {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingAccountFreezeKey(address))
+
+	if bz == nil {
+		panic("Pending Account Freeze does not exist")
+	}
+
+	var freeze types.PendingAccountFreeze
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &freeze)
+
+	return freeze
+}
+
+// Sets Pending Account Freeze record for an address.
+func (k Keeper) SetPendingAccountFreeze(ctx sdk.Context, freeze types.PendingAccountFreeze) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingAccountFreezeKey(freeze.Address), k.cdc.MustMarshalBinaryBare(freeze))
+}
+
+// Check if the Pending Account Freeze record associated with an address is present in the store or not.
+func (k Keeper) IsPendingAccountFreezePresent(ctx sdk.Context, address sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetPendingAccountFreezeKey(address))
+}
+
+// Iterate over all Pending Account Freezes.
+func (k Keeper) IteratePendingAccountFreezes(ctx sdk.Context,
+	process func(info types.PendingAccountFreeze) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.PendingAccountFreezePrefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		val := iter.Value()
+
+		var freeze types.PendingAccountFreeze
+
+		k.cdc.MustUnmarshalBinaryBare(val, &freeze)
+
+		if process(freeze) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+// Deletes the Pending Account Freeze from the store.
+func (k Keeper) DeletePendingAccountFreeze(ctx sdk.Context, address sdk.AccAddress) {
+	if !k.IsPendingAccountFreezePresent(ctx, address) {
+		panic("Pending Account Freeze does not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingAccountFreezeKey(address))
+}
+
+/*
+	VendorID binding
+*/
+// Gets addresses of all accounts bound to the VendorID.
+func (k Keeper) GetVendorAccounts(ctx sdk.Context, vendorID uint16) []sdk.AccAddress {
+	addresses := []sdk.AccAddress{}
+
+	k.IterateAccounts(ctx, func(account types.Account) (stop bool) {
+		if account.VendorID == vendorID {
+			addresses = append(addresses, account.Address)
+		}
+
+		return false
+	})
+
+	return addresses
+}
+
+// Check that the account is allowed to operate on models of the VendorID.
+// Accounts which are not bound to any VendorID are not restricted.
+func (k Keeper) CheckVendorID(ctx sdk.Context, address sdk.AccAddress, vendorID uint16) sdk.Error {
+	if !k.IsAccountPresent(ctx, address) {
+		return types.ErrAccountDoesNotExist(address)
+	}
+
+	account := k.GetAccount(ctx, address)
+
+	if account.VendorID != 0 && account.VendorID != vendorID {
+		return types.ErrVendorIDMismatch(address, account.VendorID, vendorID)
+	}
+
+	return nil
+}
+
 /*
 	Account Number Counter
 */
@@ -301,3 +609,40 @@ func (k Keeper) GetNextAccountNumber(ctx sdk.Context) (accNumber uint64) {
 
 	return
 }
+
+// Returns the account number which will be assigned to the next account without incrementing the counter.
+func (k Keeper) GetAccountNumberCounter(ctx sdk.Context) (accNumber uint64) {
+	bz := ctx.KVStore(k.storeKey).Get(types.AccountNumberCounterKey)
+	if bz == nil {
+		return 0
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &accNumber)
+
+	return accNumber
+}
+
+// Sets the account number which will be assigned to the next account.
+func (k Keeper) SetAccountNumberCounter(ctx sdk.Context, accNumber uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AccountNumberCounterKey, k.cdc.MustMarshalBinaryLengthPrefixed(accNumber))
+}
+
+/*
+	Module Params
+*/
+
+// Gets the module params. Default values are returned for the params which have not been set yet.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+// Sets the module params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
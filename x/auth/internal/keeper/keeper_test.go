@@ -61,6 +61,11 @@ func TestKeeper_AccountGetSet(t *testing.T) {
 	require.Equal(t, 1, setup.Keeper.CountAccountsWithRole(setup.Ctx, types.Trustee))
 	require.Equal(t, 0, setup.Keeper.CountAccountsWithRole(setup.Ctx, types.Vendor))
 
+	// frozen accounts are not counted
+	account.Frozen = true
+	setup.Keeper.SetAccount(setup.Ctx, account)
+	require.Equal(t, 0, setup.Keeper.CountAccountsWithRole(setup.Ctx, types.Trustee))
+
 	// delete account
 	setup.Keeper.DeleteAccount(setup.Ctx, testconstants.Address1)
 	require.False(t, setup.Keeper.IsAccountPresent(setup.Ctx, testconstants.Address1))
@@ -149,4 +154,40 @@ func TestKeeper_AccountNumber(t *testing.T) {
 	for i := uint64(0); i < 5; i++ {
 		require.Equal(t, i, setup.Keeper.GetNextAccountNumber(setup.Ctx))
 	}
+
+	// peeking does not increment the counter
+	require.Equal(t, uint64(5), setup.Keeper.GetAccountNumberCounter(setup.Ctx))
+	require.Equal(t, uint64(5), setup.Keeper.GetAccountNumberCounter(setup.Ctx))
+
+	// counter can be restored
+	setup.Keeper.SetAccountNumberCounter(setup.Ctx, 10)
+	require.Equal(t, uint64(10), setup.Keeper.GetNextAccountNumber(setup.Ctx))
+	require.Equal(t, uint64(11), setup.Keeper.GetAccountNumberCounter(setup.Ctx))
+}
+
+func TestKeeper_CheckMsgRoles(t *testing.T) {
+	setup := Setup()
+
+	// store accounts
+	trustee := types.NewAccount(testconstants.Address1, testconstants.PubKey1, types.AccountRoles{types.Trustee})
+	setup.Keeper.SetAccount(setup.Ctx, trustee)
+
+	vendor := types.NewAccount(testconstants.Address2, testconstants.PubKey2, types.AccountRoles{types.Vendor})
+	setup.Keeper.SetAccount(setup.Ctx, vendor)
+
+	types.RegisterMsgRoles(types.MsgApproveAddAccount{}, types.Trustee, types.NodeAdmin)
+
+	// signer has one of the required roles
+	msg := types.NewMsgApproveAddAccount(testconstants.Address3, trustee.Address)
+	require.Nil(t, setup.Keeper.CheckMsgRoles(setup.Ctx, msg))
+
+	// signer does not have any of the required roles
+	msg = types.NewMsgApproveAddAccount(testconstants.Address3, vendor.Address)
+	err := setup.Keeper.CheckMsgRoles(setup.Ctx, msg)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeMissingRole, err.Code())
+
+	// any role is allowed for not registered message types
+	require.Nil(t, setup.Keeper.CheckMsgRoles(setup.Ctx,
+		types.NewMsgProposeRevokeAccount(testconstants.Address3, vendor.Address)))
 }
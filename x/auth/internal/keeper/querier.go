@@ -29,6 +29,12 @@ const (
 	QueryAllAccounts                  = "all_accounts"
 	QueryAllPendingAccounts           = "all_pending_accounts"
 	QueryAllPendingAccountRevocations = "all_pending_account_revocations"
+	QueryPendingAccount               = "pending_account"
+	QueryPendingAccountRevocation     = "pending_account_revocation"
+	QueryAllPendingVendorIDUpdates    = "all_pending_vendor_id_updates"
+	QueryAllPendingKeyRotations       = "all_pending_key_rotations"
+	QueryAllPendingAccountFreezes     = "all_pending_account_freezes"
+	QueryVendorAccounts               = "vendor_accounts"
 )
 
 func NewQuerier(keeper Keeper) sdk.Querier {
@@ -42,6 +48,18 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryAllPendingAccounts(ctx, req, keeper)
 		case QueryAllPendingAccountRevocations:
 			return queryAllPendingAccountRevocations(ctx, req, keeper)
+		case QueryPendingAccount:
+			return queryPendingAccount(ctx, req, keeper)
+		case QueryPendingAccountRevocation:
+			return queryPendingAccountRevocation(ctx, req, keeper)
+		case QueryAllPendingVendorIDUpdates:
+			return queryAllPendingVendorIDUpdates(ctx, req, keeper)
+		case QueryAllPendingKeyRotations:
+			return queryAllPendingKeyRotations(ctx, req, keeper)
+		case QueryAllPendingAccountFreezes:
+			return queryAllPendingAccountFreezes(ctx, req, keeper)
+		case QueryVendorAccounts:
+			return queryVendorAccounts(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -65,6 +83,40 @@ func queryAccount(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte
 	return res, nil
 }
 
+func queryPendingAccount(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAccountParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	if !keeper.IsPendingAccountPresent(ctx, params.Address) {
+		return nil, types.ErrPendingAccountDoesNotExist(params.Address.String())
+	}
+
+	pendAcc := keeper.GetPendingAccount(ctx, params.Address)
+
+	res := codec.MustMarshalJSONIndent(keeper.cdc, pendAcc)
+
+	return res, nil
+}
+
+func queryPendingAccountRevocation(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAccountParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	if !keeper.IsPendingAccountRevocationPresent(ctx, params.Address) {
+		return nil, types.ErrPendingAccountRevocationDoesNotExist(params.Address.String())
+	}
+
+	revocation := keeper.GetPendingAccountRevocation(ctx, params.Address)
+
+	res := codec.MustMarshalJSONIndent(keeper.cdc, revocation)
+
+	return res, nil
+}
+
 // nolint:dupl
 func queryAllAccounts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
 	var params pagination.PaginationParams
@@ -76,26 +128,24 @@ func queryAllAccounts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (re
 		Total: 0,
 		Items: []types.Account{},
 	}
-	skipped := 0
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
 
 	keeper.IterateAccounts(ctx, func(account types.Account) (stop bool) {
 		result.Total++
 
-		if skipped < params.Skip {
-			skipped++
-
-			return false
-		}
-
-		if len(result.Items) < params.Take || params.Take == 0 {
+		if paginator.Add(types.GetAccountKey(account.Address)) {
 			result.Items = append(result.Items, account)
-
-			return false
 		}
 
 		return false
 	})
 
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
 	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
 
 	return res, nil
@@ -112,26 +162,24 @@ func queryAllPendingAccounts(ctx sdk.Context, req abci.RequestQuery, keeper Keep
 		Total: 0,
 		Items: []types.PendingAccount{},
 	}
-	skipped := 0
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
 
 	keeper.IteratePendingAccounts(ctx, func(pendAcc types.PendingAccount) (stop bool) {
 		result.Total++
 
-		if skipped < params.Skip {
-			skipped++
-
-			return false
-		}
-
-		if len(result.Items) < params.Take || params.Take == 0 {
+		if paginator.Add(types.GetPendingAccountKey(pendAcc.Address)) {
 			result.Items = append(result.Items, pendAcc)
-
-			return false
 		}
 
 		return false
 	})
 
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
 	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
 
 	return res, nil
@@ -149,27 +197,146 @@ func queryAllPendingAccountRevocations(ctx sdk.Context,
 		Total: 0,
 		Items: []types.PendingAccountRevocation{},
 	}
-	skipped := 0
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
 
 	keeper.IteratePendingAccountRevocations(ctx, func(revocation types.PendingAccountRevocation) (stop bool) {
 		result.Total++
 
-		if skipped < params.Skip {
-			skipped++
+		if paginator.Add(types.GetPendingAccountRevocationKey(revocation.Address)) {
+			result.Items = append(result.Items, revocation)
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+// nolint:dupl
+func queryAllPendingVendorIDUpdates(ctx sdk.Context,
+	req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	result := types.ListPendingVendorIDUpdates{
+		Total: 0,
+		Items: []types.PendingVendorIDUpdate{},
+	}
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.IteratePendingVendorIDUpdates(ctx, func(update types.PendingVendorIDUpdate) (stop bool) {
+		result.Total++
+
+		if paginator.Add(types.GetPendingVendorIDUpdateKey(update.Address)) {
+			result.Items = append(result.Items, update)
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+// nolint:dupl
+func queryAllPendingKeyRotations(ctx sdk.Context,
+	req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	result := types.ListPendingKeyRotations{
+		Total: 0,
+		Items: []types.PendingKeyRotation{},
+	}
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.IteratePendingKeyRotations(ctx, func(rotation types.PendingKeyRotation) (stop bool) {
+		result.Total++
 
-			return false
+		if paginator.Add(types.GetPendingKeyRotationKey(rotation.Address)) {
+			result.Items = append(result.Items, rotation)
 		}
 
-		if len(result.Items) < params.Take || params.Take == 0 {
-			result.Items = append(result.Items, revocation)
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
 
-			return false
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+// nolint:dupl
+func queryAllPendingAccountFreezes(ctx sdk.Context,
+	req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	result := types.ListPendingAccountFreezes{
+		Total: 0,
+		Items: []types.PendingAccountFreeze{},
+	}
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.IteratePendingAccountFreezes(ctx, func(freeze types.PendingAccountFreeze) (stop bool) {
+		result.Total++
+
+		if paginator.Add(types.GetPendingAccountFreezeKey(freeze.Address)) {
+			result.Items = append(result.Items, freeze)
 		}
 
 		return false
 	})
 
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
 	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
 
 	return res, nil
 }
+
+func queryVendorAccounts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryVendorAccountsParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	result := types.VendorAccounts{
+		VendorID:  params.VendorID,
+		Addresses: keeper.GetVendorAccounts(ctx, params.VendorID),
+	}
+
+	res := codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
@@ -178,6 +178,108 @@ func TestQuerier_QueryAllPendingAccountRevocations(t *testing.T) {
 	require.Equal(t, revocation2, listPendingAccountRevocations.Items[1])
 }
 
+func TestQuerier_QueryPendingAccount(t *testing.T) {
+	setup := Setup()
+
+	// store pending account
+	pendAcc := types.NewPendingAccount(
+		testconstants.Address2,
+		testconstants.PubKey2,
+		types.AccountRoles{types.Vendor},
+		testconstants.Address1,
+	)
+	setup.Keeper.SetPendingAccount(setup.Ctx, pendAcc)
+
+	// query pending account
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryPendingAccount},
+		abci.RequestQuery{Data: queryAccountParams(setup, pendAcc.Address)},
+	)
+	require.Nil(t, err)
+
+	var receivedPendAcc types.PendingAccount
+	_ = setup.Cdc.UnmarshalJSON(result, &receivedPendAcc)
+
+	// check
+	require.Equal(t, pendAcc, receivedPendAcc)
+
+	// query unknown pending account
+	_, err = setup.Querier(
+		setup.Ctx,
+		[]string{QueryPendingAccount},
+		abci.RequestQuery{Data: queryAccountParams(setup, testconstants.Address3)},
+	)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodePendingAccountDoesNotExist, err.Code())
+}
+
+func TestQuerier_QueryPendingAccountRevocation(t *testing.T) {
+	setup := Setup()
+
+	// store pending account revocation
+	revocation := types.NewPendingAccountRevocation(
+		testconstants.Address2,
+		testconstants.Address1,
+	)
+	setup.Keeper.SetPendingAccountRevocation(setup.Ctx, revocation)
+
+	// query pending account revocation
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryPendingAccountRevocation},
+		abci.RequestQuery{Data: queryAccountParams(setup, revocation.Address)},
+	)
+	require.Nil(t, err)
+
+	var receivedRevocation types.PendingAccountRevocation
+	_ = setup.Cdc.UnmarshalJSON(result, &receivedRevocation)
+
+	// check
+	require.Equal(t, revocation, receivedRevocation)
+
+	// query unknown pending account revocation
+	_, err = setup.Querier(
+		setup.Ctx,
+		[]string{QueryPendingAccountRevocation},
+		abci.RequestQuery{Data: queryAccountParams(setup, testconstants.Address3)},
+	)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodePendingAccountRevocationDoesNotExist, err.Code())
+}
+
+func TestQuerier_QueryVendorAccounts(t *testing.T) {
+	setup := Setup()
+
+	// store vendor accounts bound to different VendorIDs
+	vendor1 := types.NewAccount(testconstants.Address1, testconstants.PubKey1, types.AccountRoles{types.Vendor})
+	vendor1.VendorID = testconstants.VID
+	setup.Keeper.SetAccount(setup.Ctx, vendor1)
+
+	vendor2 := types.NewAccount(testconstants.Address2, testconstants.PubKey2, types.AccountRoles{types.Vendor})
+	vendor2.VendorID = testconstants.VID + 1
+	setup.Keeper.SetAccount(setup.Ctx, vendor2)
+
+	vendor3 := types.NewAccount(testconstants.Address3, testconstants.PubKey3, types.AccountRoles{types.Vendor})
+	vendor3.VendorID = testconstants.VID
+	setup.Keeper.SetAccount(setup.Ctx, vendor3)
+
+	// query vendor accounts
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryVendorAccounts},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(types.NewQueryVendorAccountsParams(testconstants.VID))},
+	)
+	require.Nil(t, err)
+
+	var vendorAccounts types.VendorAccounts
+	_ = setup.Cdc.UnmarshalJSON(result, &vendorAccounts)
+
+	// check
+	require.Equal(t, testconstants.VID, vendorAccounts.VendorID)
+	require.ElementsMatch(t, []sdk.AccAddress{vendor1.Address, vendor3.Address}, vendorAccounts.Addresses)
+}
+
 func queryAccountParams(setup TestSetup, address sdk.AccAddress) []byte {
 	params := types.NewQueryAccountParams(address)
 
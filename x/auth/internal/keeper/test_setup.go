@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
@@ -43,10 +44,16 @@ func Setup() TestSetup {
 	dbStore := store.NewCommitMultiStore(db)
 	authKey := sdk.NewKVStoreKey(types.StoreKey)
 	dbStore.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, nil)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+	dbStore.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+
 	_ = dbStore.LoadLatestVersion()
 
 	// Init Keepers
-	authKeeper := NewKeeper(authKey, cdc)
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, paramsTKey, params.DefaultCodespace)
+	authKeeper := NewKeeper(authKey, paramsKeeper.Subspace(types.DefaultParamspace), cdc)
 
 	// Init Querier
 	querier := NewQuerier(authKeeper)
@@ -0,0 +1,46 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+	Roles required to sign messages
+*/
+
+// the roles allowed to sign the messages of the registered types (the key is `<route>/<type>`).
+var msgRoles = map[string]AccountRoles{}
+
+func msgRolesKey(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+// RegisterMsgRoles registers the roles allowed to sign the messages of the given type.
+// The signer must have at least one of the roles.
+func RegisterMsgRoles(msg sdk.Msg, roles ...AccountRole) {
+	msgRoles[msgRolesKey(msg)] = roles
+}
+
+// GetMsgRoles returns the roles allowed to sign the message.
+// The second returned value is false if the message can be signed by an account with any role.
+func GetMsgRoles(msg sdk.Msg) (AccountRoles, bool) {
+	roles, ok := msgRoles[msgRolesKey(msg)]
+
+	return roles, ok
+}
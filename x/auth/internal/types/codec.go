@@ -42,4 +42,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgApproveAddAccount{}, ModuleName+"/ApproveAddAccount", nil)
 	cdc.RegisterConcrete(MsgProposeRevokeAccount{}, ModuleName+"/ProposeRevokeAccount", nil)
 	cdc.RegisterConcrete(MsgApproveRevokeAccount{}, ModuleName+"/ApproveRevokeAccount", nil)
+	cdc.RegisterConcrete(MsgProposeUpdateVendorID{}, ModuleName+"/ProposeUpdateVendorID", nil)
+	cdc.RegisterConcrete(MsgApproveUpdateVendorID{}, ModuleName+"/ApproveUpdateVendorID", nil)
+	cdc.RegisterConcrete(MsgRotateKey{}, ModuleName+"/RotateKey", nil)
+	cdc.RegisterConcrete(MsgApproveRotateKey{}, ModuleName+"/ApproveRotateKey", nil)
+	cdc.RegisterConcrete(MsgFreezeAccount{}, ModuleName+"/FreezeAccount", nil)
+	cdc.RegisterConcrete(MsgUnfreezeAccount{}, ModuleName+"/UnfreezeAccount", nil)
 }
@@ -16,8 +16,10 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
 )
 
 const (
@@ -29,8 +31,43 @@ const (
 	CodePendingAccountDoesNotExist            sdk.CodeType = 104
 	CodePendingAccountRevocationAlreadyExists sdk.CodeType = 105
 	CodePendingAccountRevocationDoesNotExist  sdk.CodeType = 106
+	CodeMissingRole                           sdk.CodeType = 107
+	CodePendingVendorIDUpdateAlreadyExists    sdk.CodeType = 108
+	CodePendingVendorIDUpdateDoesNotExist     sdk.CodeType = 109
+	CodeAccountIsNotVendor                    sdk.CodeType = 110
+	CodeVendorIDMismatch                      sdk.CodeType = 111
+	CodePendingKeyRotationAlreadyExists       sdk.CodeType = 112
+	CodePendingKeyRotationDoesNotExist        sdk.CodeType = 113
+	CodeAccountFrozen                         sdk.CodeType = 114
+	CodeAccountNotFrozen                      sdk.CodeType = 115
+	CodeTxLimitExceeded                       sdk.CodeType = 116
+	CodeTxTimedOut                            sdk.CodeType = 117
 )
 
+func init() {
+	errcodes.Register(DefaultCodespace, CodeAccountAlreadyExists, "account_already_exists")
+	errcodes.Register(DefaultCodespace, CodeAccountDoesNotExist, "account_does_not_exist")
+	errcodes.Register(DefaultCodespace, CodePendingAccountAlreadyExists, "pending_account_already_exists")
+	errcodes.Register(DefaultCodespace, CodePendingAccountDoesNotExist, "pending_account_does_not_exist")
+	errcodes.Register(DefaultCodespace, CodePendingAccountRevocationAlreadyExists,
+		"pending_account_revocation_already_exists")
+	errcodes.Register(DefaultCodespace, CodePendingAccountRevocationDoesNotExist,
+		"pending_account_revocation_does_not_exist")
+	errcodes.Register(DefaultCodespace, CodeMissingRole, "missing_role")
+	errcodes.Register(DefaultCodespace, CodePendingVendorIDUpdateAlreadyExists,
+		"pending_vendor_id_update_already_exists")
+	errcodes.Register(DefaultCodespace, CodePendingVendorIDUpdateDoesNotExist,
+		"pending_vendor_id_update_does_not_exist")
+	errcodes.Register(DefaultCodespace, CodeAccountIsNotVendor, "account_is_not_vendor")
+	errcodes.Register(DefaultCodespace, CodeVendorIDMismatch, "vendor_id_mismatch")
+	errcodes.Register(DefaultCodespace, CodePendingKeyRotationAlreadyExists, "pending_key_rotation_already_exists")
+	errcodes.Register(DefaultCodespace, CodePendingKeyRotationDoesNotExist, "pending_key_rotation_does_not_exist")
+	errcodes.Register(DefaultCodespace, CodeAccountFrozen, "account_frozen")
+	errcodes.Register(DefaultCodespace, CodeAccountNotFrozen, "account_not_frozen")
+	errcodes.Register(DefaultCodespace, CodeTxLimitExceeded, "tx_limit_exceeded")
+	errcodes.Register(DefaultCodespace, CodeTxTimedOut, "tx_timed_out")
+}
+
 func ErrAccountAlreadyExists(address interface{}) sdk.Error {
 	return sdk.NewError(DefaultCodespace, CodeAccountAlreadyExists,
 		fmt.Sprintf("Account associated with the address=%v already exists on the ledger", address))
@@ -60,3 +97,67 @@ func ErrPendingAccountRevocationDoesNotExist(address interface{}) sdk.Error {
 	return sdk.NewError(DefaultCodespace, CodePendingAccountRevocationDoesNotExist,
 		fmt.Sprintf("No pending account revocation associated with the address=%v on the ledger", address))
 }
+
+func ErrMissingRole(msgType string, signer interface{}, roles AccountRoles) sdk.Error {
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, string(role))
+	}
+
+	return sdk.NewError(DefaultCodespace, CodeMissingRole,
+		fmt.Sprintf("%s transaction should be signed by an account with the %s role. Signer=%v does not have it",
+			msgType, strings.Join(names, " or "), signer))
+}
+
+func ErrPendingVendorIDUpdateAlreadyExists(address interface{}) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodePendingVendorIDUpdateAlreadyExists,
+		fmt.Sprintf("Pending VendorID update associated with the address=%v already exists on the ledger", address))
+}
+
+func ErrPendingVendorIDUpdateDoesNotExist(address interface{}) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodePendingVendorIDUpdateDoesNotExist,
+		fmt.Sprintf("No pending VendorID update associated with the address=%v on the ledger", address))
+}
+
+func ErrAccountIsNotVendor(address interface{}) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeAccountIsNotVendor,
+		fmt.Sprintf("Account associated with the address=%v does not have the %s role", address, Vendor))
+}
+
+func ErrVendorIDMismatch(address interface{}, accountVendorID uint16, vendorID uint16) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeVendorIDMismatch,
+		fmt.Sprintf("Account associated with the address=%v is bound to VendorID=%v and cannot operate on VendorID=%v",
+			address, accountVendorID, vendorID))
+}
+
+func ErrPendingKeyRotationAlreadyExists(address interface{}) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodePendingKeyRotationAlreadyExists,
+		fmt.Sprintf("Pending key rotation associated with the address=%v already exists on the ledger", address))
+}
+
+func ErrPendingKeyRotationDoesNotExist(address interface{}) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodePendingKeyRotationDoesNotExist,
+		fmt.Sprintf("No pending key rotation associated with the address=%v on the ledger", address))
+}
+
+func ErrAccountFrozen(address interface{}) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeAccountFrozen,
+		fmt.Sprintf("Account associated with the address=%v is frozen", address))
+}
+
+func ErrAccountNotFrozen(address interface{}) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeAccountNotFrozen,
+		fmt.Sprintf("Account associated with the address=%v is not frozen", address))
+}
+
+func ErrTxLimitExceeded(address interface{}, maxTxs uint64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeTxLimitExceeded,
+		fmt.Sprintf("Account associated with the address=%v cannot sign more than %v transactions in one block",
+			address, maxTxs))
+}
+
+func ErrTxTimedOut(timeoutHeight int64, height int64) sdk.Error {
+	return sdk.NewError(DefaultCodespace, CodeTxTimedOut,
+		fmt.Sprintf("Transaction with timeout height=%v cannot be included in the block at height=%v",
+			timeoutHeight, height))
+}
@@ -0,0 +1,23 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// Events added to the messages of the transactions.
+const (
+	// Reference ID of the transaction: the memo attached by the caller (e.g. the ID of a ticket in an ERP system),
+	// so the transaction can be found by it in the Tendermint transactions index.
+	EventTypeReference      = "reference"
+	AttributeKeyReferenceID = "id"
+)
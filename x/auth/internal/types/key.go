@@ -22,12 +22,20 @@ const (
 
 	// StoreKey to be used when creating the KVStore.
 	StoreKey = "acc" // it differs from ModuleName to be compatible with cosmos transaction builder and handler.
+
+	// TStoreKey to be used when creating the transient store counting the transactions of the accounts in the block.
+	TStoreKey = "transient_acc"
 )
 
 var (
 	PendingAccountPrefix           = []byte{0x01} // prefix for each key to a pending account
 	AccountPrefix                  = []byte{0x02} // prefix for each key to an account
 	PendingAccountRevocationPrefix = []byte{0x03} // prefix for each key to a pending account revocation
+	PendingVendorIDUpdatePrefix    = []byte{0x04} // prefix for each key to a pending VendorID update
+	PendingKeyRotationPrefix       = []byte{0x05} // prefix for each key to a pending key rotation
+	PendingAccountFreezePrefix     = []byte{0x06} // prefix for each key to a pending account freeze or unfreeze
+	TxCountPrefix                  = []byte{0x07} // prefix for each key to a transaction count (transient store)
+	PendingAccountExpirationPrefix = []byte{0x08} // prefix for each key to a pending account by its proposal height
 
 	AccountNumberCounterKey = []byte("globalAccountNumber") // key for account number counter
 )
@@ -46,3 +54,23 @@ func GetAccountKey(addr sdk.AccAddress) []byte {
 func GetPendingAccountRevocationKey(addr sdk.AccAddress) []byte {
 	return append(PendingAccountRevocationPrefix, addr.Bytes()...)
 }
+
+// Key builder for Pending VendorID Update.
+func GetPendingVendorIDUpdateKey(addr sdk.AccAddress) []byte {
+	return append(PendingVendorIDUpdatePrefix, addr.Bytes()...)
+}
+
+// Key builder for Pending Key Rotation.
+func GetPendingKeyRotationKey(addr sdk.AccAddress) []byte {
+	return append(PendingKeyRotationPrefix, addr.Bytes()...)
+}
+
+// Key builder for Pending Account Freeze.
+func GetPendingAccountFreezeKey(addr sdk.AccAddress) []byte {
+	return append(PendingAccountFreezePrefix, addr.Bytes()...)
+}
+
+// Key builder for Transaction Count of an account in the current block.
+func GetTxCountKey(addr sdk.AccAddress) []byte {
+	return append(TxCountPrefix, addr.Bytes()...)
+}
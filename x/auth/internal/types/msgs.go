@@ -26,14 +26,16 @@ type MsgProposeAddAccount struct {
 	PublicKey string         `json:"pub_key"`
 	Roles     AccountRoles   `json:"roles"`
 	Signer    sdk.AccAddress `json:"signer"`
+	VendorID  uint16         `json:"vendor_id,omitempty"`
 }
 
 func NewMsgProposeAddAccount(address sdk.AccAddress, pubKey string,
-	roles AccountRoles, signer sdk.AccAddress) MsgProposeAddAccount {
+	roles AccountRoles, vendorID uint16, signer sdk.AccAddress) MsgProposeAddAccount {
 	return MsgProposeAddAccount{
 		Address:   address,
 		PublicKey: pubKey,
 		Roles:     roles,
+		VendorID:  vendorID,
 		Signer:    signer,
 	}
 }
@@ -59,6 +61,10 @@ func (m MsgProposeAddAccount) ValidateBasic() sdk.Error {
 		return err
 	}
 
+	if err := ValidateVendorID(m.Roles, m.VendorID); err != nil {
+		return err
+	}
+
 	if m.Signer.Empty() {
 		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
 	}
@@ -202,3 +208,278 @@ func (m MsgApproveRevokeAccount) GetSignBytes() []byte {
 func (m MsgApproveRevokeAccount) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Signer}
 }
+
+/*
+	PROPOSE_UPDATE_VENDOR_ID Message
+*/
+type MsgProposeUpdateVendorID struct {
+	Address  sdk.AccAddress `json:"address"`
+	VendorID uint16         `json:"vendor_id"`
+	Signer   sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgProposeUpdateVendorID(address sdk.AccAddress, vendorID uint16,
+	signer sdk.AccAddress) MsgProposeUpdateVendorID {
+	return MsgProposeUpdateVendorID{
+		Address:  address,
+		VendorID: vendorID,
+		Signer:   signer,
+	}
+}
+
+func (m MsgProposeUpdateVendorID) Route() string {
+	return RouterKey
+}
+
+func (m MsgProposeUpdateVendorID) Type() string {
+	return "propose_update_vendor_id"
+}
+
+func (m MsgProposeUpdateVendorID) ValidateBasic() sdk.Error {
+	if m.Address.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Account Address: it cannot be empty")
+	}
+
+	if m.VendorID == 0 {
+		return sdk.ErrUnknownRequest("Invalid VendorID: it must be non-zero 16-bit unsigned integer")
+	}
+
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	return nil
+}
+
+func (m MsgProposeUpdateVendorID) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgProposeUpdateVendorID) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+/*
+	APPROVE_UPDATE_VENDOR_ID Message
+*/
+type MsgApproveUpdateVendorID struct {
+	Address sdk.AccAddress `json:"address"`
+	Signer  sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgApproveUpdateVendorID(address sdk.AccAddress, signer sdk.AccAddress) MsgApproveUpdateVendorID {
+	return MsgApproveUpdateVendorID{
+		Address: address,
+		Signer:  signer,
+	}
+}
+
+func (m MsgApproveUpdateVendorID) Route() string {
+	return RouterKey
+}
+
+func (m MsgApproveUpdateVendorID) Type() string {
+	return "approve_update_vendor_id"
+}
+
+func (m MsgApproveUpdateVendorID) ValidateBasic() sdk.Error {
+	if m.Address.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Account Address: it cannot be empty")
+	}
+
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	return nil
+}
+
+func (m MsgApproveUpdateVendorID) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgApproveUpdateVendorID) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+/*
+	ROTATE_KEY Message
+*/
+type MsgRotateKey struct {
+	Address   sdk.AccAddress `json:"address"`
+	PublicKey string         `json:"pub_key"`
+	Signer    sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgRotateKey(address sdk.AccAddress, pubKey string, signer sdk.AccAddress) MsgRotateKey {
+	return MsgRotateKey{
+		Address:   address,
+		PublicKey: pubKey,
+		Signer:    signer,
+	}
+}
+
+func (m MsgRotateKey) Route() string {
+	return RouterKey
+}
+
+func (m MsgRotateKey) Type() string {
+	return "rotate_key"
+}
+
+func (m MsgRotateKey) ValidateBasic() sdk.Error {
+	if m.Address.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Account Address: it cannot be empty")
+	}
+
+	if len(m.PublicKey) == 0 {
+		return sdk.ErrUnknownRequest("Invalid PublicKey: it cannot be empty")
+	}
+
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if !m.Signer.Equals(m.Address) {
+		return sdk.ErrUnauthorized("Invalid Signer: only the account holder can rotate the key of the account")
+	}
+
+	return nil
+}
+
+func (m MsgRotateKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgRotateKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+/*
+	APPROVE_ROTATE_KEY Message
+*/
+type MsgApproveRotateKey struct {
+	Address sdk.AccAddress `json:"address"`
+	Signer  sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgApproveRotateKey(address sdk.AccAddress, signer sdk.AccAddress) MsgApproveRotateKey {
+	return MsgApproveRotateKey{
+		Address: address,
+		Signer:  signer,
+	}
+}
+
+func (m MsgApproveRotateKey) Route() string {
+	return RouterKey
+}
+
+func (m MsgApproveRotateKey) Type() string {
+	return "approve_rotate_key"
+}
+
+func (m MsgApproveRotateKey) ValidateBasic() sdk.Error {
+	if m.Address.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Account Address: it cannot be empty")
+	}
+
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	return nil
+}
+
+func (m MsgApproveRotateKey) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgApproveRotateKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+/*
+	FREEZE_ACCOUNT Message
+*/
+type MsgFreezeAccount struct {
+	Address sdk.AccAddress `json:"address"`
+	Signer  sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgFreezeAccount(address sdk.AccAddress, signer sdk.AccAddress) MsgFreezeAccount {
+	return MsgFreezeAccount{
+		Address: address,
+		Signer:  signer,
+	}
+}
+
+func (m MsgFreezeAccount) Route() string {
+	return RouterKey
+}
+
+func (m MsgFreezeAccount) Type() string {
+	return "freeze_account"
+}
+
+func (m MsgFreezeAccount) ValidateBasic() sdk.Error {
+	if m.Address.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Account Address: it cannot be empty")
+	}
+
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	return nil
+}
+
+func (m MsgFreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgFreezeAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+/*
+	UNFREEZE_ACCOUNT Message
+*/
+type MsgUnfreezeAccount struct {
+	Address sdk.AccAddress `json:"address"`
+	Signer  sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgUnfreezeAccount(address sdk.AccAddress, signer sdk.AccAddress) MsgUnfreezeAccount {
+	return MsgUnfreezeAccount{
+		Address: address,
+		Signer:  signer,
+	}
+}
+
+func (m MsgUnfreezeAccount) Route() string {
+	return RouterKey
+}
+
+func (m MsgUnfreezeAccount) Type() string {
+	return "unfreeze_account"
+}
+
+func (m MsgUnfreezeAccount) ValidateBasic() sdk.Error {
+	if m.Address.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Account Address: it cannot be empty")
+	}
+
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	return nil
+}
+
+func (m MsgUnfreezeAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgUnfreezeAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
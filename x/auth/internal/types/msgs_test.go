@@ -29,7 +29,7 @@ import (
 
 func TestNewMsgProposeAddAccount(t *testing.T) {
 	msg := NewMsgProposeAddAccount(testconstants.Address1, testconstants.Pubkey1Str,
-		AccountRoles{}, testconstants.Signer)
+		AccountRoles{}, 0, testconstants.Signer)
 
 	require.Equal(t, msg.Route(), RouterKey)
 	require.Equal(t, msg.Type(), "propose_add_account")
@@ -42,17 +42,21 @@ func TestValidateMsgProposeAddAccount(t *testing.T) {
 		msg   MsgProposeAddAccount
 	}{
 		{true, NewMsgProposeAddAccount(testconstants.Address1, testconstants.Pubkey1Str,
-			AccountRoles{}, testconstants.Signer)},
+			AccountRoles{}, 0, testconstants.Signer)},
 		{true, NewMsgProposeAddAccount(testconstants.Address1, testconstants.Pubkey1Str,
-			AccountRoles{Vendor, NodeAdmin}, testconstants.Signer)},
+			AccountRoles{Vendor, NodeAdmin}, 0, testconstants.Signer)},
 		{false, NewMsgProposeAddAccount(nil, testconstants.Pubkey1Str,
-			AccountRoles{}, testconstants.Signer)},
+			AccountRoles{}, 0, testconstants.Signer)},
 		{false, NewMsgProposeAddAccount(testconstants.Address1, "",
-			AccountRoles{}, testconstants.Signer)},
+			AccountRoles{}, 0, testconstants.Signer)},
 		{false, NewMsgProposeAddAccount(testconstants.Address1, testconstants.Pubkey1Str,
-			AccountRoles{"Wrong Role"}, testconstants.Signer)},
+			AccountRoles{"Wrong Role"}, 0, testconstants.Signer)},
 		{false, NewMsgProposeAddAccount(testconstants.Address1, testconstants.Pubkey1Str,
-			AccountRoles{}, nil)},
+			AccountRoles{}, 0, nil)},
+		{true, NewMsgProposeAddAccount(testconstants.Address1, testconstants.Pubkey1Str,
+			AccountRoles{Vendor}, testconstants.VID, testconstants.Signer)},
+		{false, NewMsgProposeAddAccount(testconstants.Address1, testconstants.Pubkey1Str,
+			AccountRoles{TestHouse}, testconstants.VID, testconstants.Signer)},
 	}
 
 	for _, tc := range cases {
@@ -68,7 +72,7 @@ func TestValidateMsgProposeAddAccount(t *testing.T) {
 
 func TestMsgProposeAddAccountGetSignBytes(t *testing.T) {
 	msg := NewMsgProposeAddAccount(testconstants.Address1, testconstants.Pubkey1Str,
-		AccountRoles{}, testconstants.Signer)
+		AccountRoles{}, 0, testconstants.Signer)
 
 	expected := `{"type":"auth/ProposeAddAccount","value":{"address":"cosmos1p72j8mgkf39qjzcmr283w8l8y9qv30qpj056uz",` +
 		`"pub_key":"cosmospub1addwnpepq28rlfval9n8khmgqz55mlfwn4rlh0jk80k9n7fvtu4g4u37qtvry76ww9h","roles":[],` +
@@ -198,3 +202,70 @@ func TestMsgApproveRevokeAccountGetSignBytes(t *testing.T) {
 		`"signer":"cosmos1p72j8mgkf39qjzcmr283w8l8y9qv30qpj056uz"}}`
 	require.Equal(t, expected, string(msg.GetSignBytes()))
 }
+
+/*
+	MsgProposeUpdateVendorID
+*/
+
+func TestNewMsgProposeUpdateVendorID(t *testing.T) {
+	msg := NewMsgProposeUpdateVendorID(testconstants.Address1, testconstants.VID, testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "propose_update_vendor_id")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestValidateMsgProposeUpdateVendorID(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgProposeUpdateVendorID
+	}{
+		{true, NewMsgProposeUpdateVendorID(testconstants.Address1, testconstants.VID, testconstants.Signer)},
+		{false, NewMsgProposeUpdateVendorID(nil, testconstants.VID, testconstants.Signer)},
+		{false, NewMsgProposeUpdateVendorID(testconstants.Address1, 0, testconstants.Signer)},
+		{false, NewMsgProposeUpdateVendorID(testconstants.Address1, testconstants.VID, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+/*
+	MsgApproveUpdateVendorID
+*/
+
+func TestNewMsgApproveUpdateVendorID(t *testing.T) {
+	msg := NewMsgApproveUpdateVendorID(testconstants.Address1, testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "approve_update_vendor_id")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestValidateMsgApproveUpdateVendorID(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgApproveUpdateVendorID
+	}{
+		{true, NewMsgApproveUpdateVendorID(testconstants.Address1, testconstants.Signer)},
+		{false, NewMsgApproveUpdateVendorID(nil, testconstants.Signer)},
+		{false, NewMsgApproveUpdateVendorID(testconstants.Address1, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
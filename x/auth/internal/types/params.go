@@ -0,0 +1,171 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
+)
+
+// DefaultParamspace is the name of the parameter subspace of the module.
+const DefaultParamspace = ModuleName
+
+// Parameter keys of the module subspace.
+var (
+	KeyMaxMemoCharacters              = []byte("MaxMemoCharacters")
+	KeyAccountApprovalPercent         = []byte("AccountApprovalPercent")
+	KeyTxLimits                       = []byte("TxLimits")
+	KeyPendingAccountExpirationPeriod = []byte("PendingAccountExpirationPeriod")
+)
+
+// Share of trustees required to approve an account operation by default.
+var DefaultAccountApprovalPercent = sdk.NewDecWithPrec(66, 2)
+
+/*
+	Parameters of the module stored in the module subspace (can be changed by Trustees)
+*/
+type Params struct {
+	// the maximum length of the transaction memo
+	MaxMemoCharacters uint64 `json:"max_memo_characters"`
+	// the share of trustees required to approve adding, revoking, freezing of accounts and the other account changes
+	AccountApprovalPercent sdk.Dec `json:"account_approval_percent"`
+	// the maximum number of transactions an account can sign in one block by its roles.
+	// Empty means no limits.
+	TxLimits []RoleTxLimit `json:"tx_limits"`
+	// the number of blocks after which the pending accounts not approved by enough trustees are deleted.
+	// 0 means the pending accounts never expire.
+	PendingAccountExpirationPeriod int64 `json:"pending_account_expiration_period"`
+}
+
+// The maximum number of transactions signed by an account with the role in one block.
+// The accounts without roles (observers) are limited by the limit with the empty role.
+type RoleTxLimit struct {
+	Role           AccountRole `json:"role"`
+	MaxTxsPerBlock uint64      `json:"max_txs_per_block"`
+}
+
+func NewParams(maxMemoCharacters uint64, accountApprovalPercent sdk.Dec, txLimits []RoleTxLimit,
+	pendingAccountExpirationPeriod int64) Params {
+	return Params{
+		MaxMemoCharacters:              maxMemoCharacters,
+		AccountApprovalPercent:         accountApprovalPercent,
+		TxLimits:                       txLimits,
+		PendingAccountExpirationPeriod: pendingAccountExpirationPeriod,
+	}
+}
+
+func DefaultParams() Params {
+	return NewParams(DefaultMaxMemoCharacters, DefaultAccountApprovalPercent, nil, approvals.NoExpiration)
+}
+
+// ParamKeyTable returns the key table of the module subspace.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyMaxMemoCharacters, Value: &p.MaxMemoCharacters},
+		{Key: KeyAccountApprovalPercent, Value: &p.AccountApprovalPercent},
+		{Key: KeyTxLimits, Value: &p.TxLimits},
+		{Key: KeyPendingAccountExpirationPeriod, Value: &p.PendingAccountExpirationPeriod},
+	}
+}
+
+func (p Params) Validate() error {
+	if p.MaxMemoCharacters == 0 {
+		return fmt.Errorf("invalid MaxMemoCharacters: it must be positive")
+	}
+
+	if p.AccountApprovalPercent.IsNil() || !p.AccountApprovalPercent.IsPositive() ||
+		p.AccountApprovalPercent.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid AccountApprovalPercent: it must be greater than 0 and at most 1, got %v",
+			p.AccountApprovalPercent)
+	}
+
+	roles := make(map[AccountRole]bool, len(p.TxLimits))
+
+	for _, limit := range p.TxLimits {
+		if len(limit.Role) != 0 && !Roles.Contains(limit.Role) {
+			return fmt.Errorf("invalid TxLimits: unknown role %q, supported roles: %v", limit.Role, Roles)
+		}
+
+		if roles[limit.Role] {
+			return fmt.Errorf("invalid TxLimits: duplicate limit of role %q", limit.Role)
+		}
+
+		roles[limit.Role] = true
+	}
+
+	if p.PendingAccountExpirationPeriod < 0 {
+		return fmt.Errorf("invalid PendingAccountExpirationPeriod: it must be non-negative, got %v",
+			p.PendingAccountExpirationPeriod)
+	}
+
+	return nil
+}
+
+// MaxTxsPerBlock returns the maximum number of transactions the account can sign in one block:
+// the highest limit of its roles. The account is not limited if any of its roles (or the empty role
+// for the accounts without roles) has no limit.
+func (p Params) MaxTxsPerBlock(account Account) (maxTxs uint64, limited bool) {
+	roles := account.Roles
+	if len(roles) == 0 {
+		roles = AccountRoles{""}
+	}
+
+	for _, role := range roles {
+		roleMaxTxs, ok := p.roleMaxTxsPerBlock(role)
+		if !ok {
+			return 0, false
+		}
+
+		if roleMaxTxs > maxTxs {
+			maxTxs = roleMaxTxs
+		}
+	}
+
+	return maxTxs, true
+}
+
+func (p Params) roleMaxTxsPerBlock(role AccountRole) (uint64, bool) {
+	for _, limit := range p.TxLimits {
+		if limit.Role == role {
+			return limit.MaxTxsPerBlock, true
+		}
+	}
+
+	return 0, false
+}
+
+// AccountApprovalPolicy returns the policy used to vote for account proposals.
+// Only the pending accounts expire, the other account proposals stay pending until they are approved.
+func (p Params) AccountApprovalPolicy() approvals.Policy {
+	return approvals.NewPolicy(approvals.DecQuorum(p.AccountApprovalPercent), p.PendingAccountExpirationPeriod)
+}
+
+func (p Params) String() string {
+	bytes, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
@@ -16,6 +16,8 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -33,13 +35,52 @@ func NewQueryAccountParams(addr sdk.AccAddress) QueryAccountParams {
 	return QueryAccountParams{Address: addr}
 }
 
+// QueryVendorAccountsParams defines the params for querying accounts bound to the VendorID.
+type QueryVendorAccountsParams struct {
+	VendorID uint16
+}
+
+// NewQueryVendorAccountsParams creates a new instance of QueryVendorAccountsParams.
+func NewQueryVendorAccountsParams(vendorID uint16) QueryVendorAccountsParams {
+	return QueryVendorAccountsParams{VendorID: vendorID}
+}
+
+// AccountTxsEvents builds the events of the transactions signed by the account (optionally containing
+// the messages of the given type) to search them in the Tendermint transactions index.
+func AccountTxsEvents(address sdk.AccAddress, msgType string) []string {
+	events := []string{fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeySender, address.String())}
+
+	if len(msgType) != 0 {
+		events = append(events, fmt.Sprintf("%s.%s='%s'", sdk.EventTypeMessage, sdk.AttributeKeyAction, msgType))
+	}
+
+	return events
+}
+
+// ReferenceTxsEvents builds the events of the transactions with the reference ID (memo)
+// to search them in the Tendermint transactions index.
+func ReferenceTxsEvents(referenceID string) ([]string, sdk.Error) {
+	if len(referenceID) == 0 {
+		return nil, sdk.ErrUnknownRequest("invalid reference ID: it must not be empty")
+	}
+
+	// the quotes can not be escaped in the queries of the transactions index
+	if strings.Contains(referenceID, "'") {
+		return nil, sdk.ErrUnknownRequest("invalid reference ID: it must not contain quotes")
+	}
+
+	return []string{fmt.Sprintf("%s.%s='%s'", EventTypeReference, AttributeKeyReferenceID, referenceID)}, nil
+}
+
 /*
 	Response Payload
 */
 // Result Payload for accounts list query.
 type ListAccounts struct {
-	Total int       `json:"total"`
-	Items []Account `json:"items"`
+	Total   int       `json:"total"`
+	Items   []Account `json:"items"`
+	NextKey string    `json:"next_key"`
+	PrevKey string    `json:"prev_key"`
 }
 
 // Implement fmt.Stringer.
@@ -54,8 +95,10 @@ func (n ListAccounts) String() string {
 
 // Result Payload for pending accounts list query.
 type ListPendingAccounts struct {
-	Total int              `json:"total"`
-	Items []PendingAccount `json:"items"`
+	Total   int              `json:"total"`
+	Items   []PendingAccount `json:"items"`
+	NextKey string           `json:"next_key"`
+	PrevKey string           `json:"prev_key"`
 }
 
 // Implement fmt.Stringer.
@@ -70,8 +113,10 @@ func (n ListPendingAccounts) String() string {
 
 // Result Payload for pending account revocations list query.
 type ListPendingAccountRevocations struct {
-	Total int                        `json:"total"`
-	Items []PendingAccountRevocation `json:"items"`
+	Total   int                        `json:"total"`
+	Items   []PendingAccountRevocation `json:"items"`
+	NextKey string                     `json:"next_key"`
+	PrevKey string                     `json:"prev_key"`
 }
 
 // Implement fmt.Stringer.
@@ -84,6 +129,76 @@ func (n ListPendingAccountRevocations) String() string {
 	return string(res)
 }
 
+// Result Payload for pending VendorID updates list query.
+type ListPendingVendorIDUpdates struct {
+	Total   int                     `json:"total"`
+	Items   []PendingVendorIDUpdate `json:"items"`
+	NextKey string                  `json:"next_key"`
+	PrevKey string                  `json:"prev_key"`
+}
+
+// Implement fmt.Stringer.
+func (n ListPendingVendorIDUpdates) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
+
+// Result Payload for pending key rotations list query.
+type ListPendingKeyRotations struct {
+	Total   int                  `json:"total"`
+	Items   []PendingKeyRotation `json:"items"`
+	NextKey string               `json:"next_key"`
+	PrevKey string               `json:"prev_key"`
+}
+
+// Implement fmt.Stringer.
+func (n ListPendingKeyRotations) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
+
+// Result Payload for pending account freezes list query.
+type ListPendingAccountFreezes struct {
+	Total   int                    `json:"total"`
+	Items   []PendingAccountFreeze `json:"items"`
+	NextKey string                 `json:"next_key"`
+	PrevKey string                 `json:"prev_key"`
+}
+
+// Implement fmt.Stringer.
+func (n ListPendingAccountFreezes) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
+
+// Result Payload for query of accounts bound to the VendorID.
+type VendorAccounts struct {
+	VendorID  uint16           `json:"vendor_id"`
+	Addresses []sdk.AccAddress `json:"addresses"`
+}
+
+// Implement fmt.Stringer.
+func (n VendorAccounts) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
+
 // nolint:godox
 // Result Payload for single account query.
 // It's a hack trick for Codec so that not inserting top-level `type` filed during serialization.
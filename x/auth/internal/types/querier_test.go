@@ -0,0 +1,34 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferenceTxsEvents(t *testing.T) {
+	events, err := ReferenceTxsEvents("ERP-1234")
+	require.Nil(t, err)
+	require.Equal(t, []string{"reference.id='ERP-1234'"}, events)
+
+	_, err = ReferenceTxsEvents("")
+	require.NotNil(t, err)
+
+	_, err = ReferenceTxsEvents("ERP-1234' OR tx.height>0")
+	require.NotNil(t, err)
+}
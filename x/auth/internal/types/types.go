@@ -21,6 +21,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/crypto"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
 )
 
 /*
@@ -66,6 +67,26 @@ func (roles AccountRoles) Validate() sdk.Error {
 	return nil
 }
 
+func (roles AccountRoles) Contains(targetRole AccountRole) bool {
+	for _, role := range roles {
+		if role == targetRole {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateVendorID checks that the VendorID is only bound to accounts with Vendor role.
+func ValidateVendorID(roles AccountRoles, vendorID uint16) sdk.Error {
+	if vendorID != 0 && !roles.Contains(Vendor) {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid VendorID: it can be set only for accounts with the %s role", Vendor))
+	}
+
+	return nil
+}
+
 /*
 	Pending Account
 */
@@ -74,6 +95,9 @@ type PendingAccount struct {
 	PubKey    crypto.PubKey    `json:"public_key"`
 	Roles     AccountRoles     `json:"roles"`
 	Approvals []sdk.AccAddress `json:"approvals"`
+	VendorID  uint16           `json:"vendor_id,omitempty"`
+	// the height the account was proposed at (the pending account expires after the expiration period)
+	ProposedAt int64 `json:"proposed_at,omitempty"`
 }
 
 // NewPendingAccount creates a new PendingAccount object.
@@ -113,18 +137,26 @@ func (pendAcc PendingAccount) Validate() sdk.Error {
 		return err
 	}
 
+	if err := ValidateVendorID(pendAcc.Roles, pendAcc.VendorID); err != nil {
+		return err
+	}
+
+	if pendAcc.ProposedAt < 0 {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid Pending Account: Value: %d. Error: Negative ProposedAt", pendAcc.ProposedAt))
+	}
+
 	return nil
 }
 
+// Implements approvals.Proposal.
+func (pendAcc PendingAccount) GetProposedAt() int64 {
+	return pendAcc.ProposedAt
+}
+
 //nolint:interfacer
 func (pendAcc PendingAccount) HasApprovalFrom(address sdk.AccAddress) bool {
-	for _, approval := range pendAcc.Approvals {
-		if approval.Equals(address) {
-			return true
-		}
-	}
-
-	return false
+	return approvals.HasApprovalFrom(pendAcc.Approvals, address)
 }
 
 /*
@@ -136,6 +168,8 @@ type Account struct {
 	AccountNumber uint64         `json:"account_number"`
 	Sequence      uint64         `json:"sequence"`
 	Roles         AccountRoles   `json:"roles"`
+	VendorID      uint16         `json:"vendor_id,omitempty"`
+	Frozen        bool           `json:"frozen,omitempty"`
 }
 
 // NewAccount creates a new Account object.
@@ -173,17 +207,15 @@ func (acc Account) Validate() error {
 		return err
 	}
 
+	if err := ValidateVendorID(acc.Roles, acc.VendorID); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func (acc Account) HasRole(targetRole AccountRole) bool {
-	for _, role := range acc.Roles {
-		if role == targetRole {
-			return true
-		}
-	}
-
-	return false
+	return acc.Roles.Contains(targetRole)
 }
 
 func (acc Account) GetAddress() sdk.AccAddress {
@@ -291,11 +323,144 @@ func (revoc PendingAccountRevocation) Validate() sdk.Error {
 
 //nolint:interfacer
 func (revoc PendingAccountRevocation) HasApprovalFrom(address sdk.AccAddress) bool {
-	for _, approval := range revoc.Approvals {
-		if approval.Equals(address) {
-			return true
-		}
+	return approvals.HasApprovalFrom(revoc.Approvals, address)
+}
+
+/*
+	Pending update of the VendorID bound to a Vendor account
+*/
+type PendingVendorIDUpdate struct {
+	Address   sdk.AccAddress   `json:"address"`
+	VendorID  uint16           `json:"vendor_id"`
+	Approvals []sdk.AccAddress `json:"approvals"`
+}
+
+// NewPendingVendorIDUpdate creates a new PendingVendorIDUpdate object.
+func NewPendingVendorIDUpdate(address sdk.AccAddress, vendorID uint16,
+	approval sdk.AccAddress) PendingVendorIDUpdate {
+	return PendingVendorIDUpdate{
+		Address:   address,
+		VendorID:  vendorID,
+		Approvals: []sdk.AccAddress{approval},
 	}
+}
 
-	return false
+// String implements fmt.Stringer.
+func (update PendingVendorIDUpdate) String() string {
+	bytes, err := json.Marshal(update)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+// Validate checks for errors on the pending VendorID update.
+func (update PendingVendorIDUpdate) Validate() sdk.Error {
+	if update.Address == nil {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid Pending VendorID Update: Value: %s. Error: Missing Address", update.Address))
+	}
+
+	if update.VendorID == 0 {
+		return sdk.ErrUnknownRequest("Invalid Pending VendorID Update: Error: VendorID must be non-zero")
+	}
+
+	return nil
+}
+
+//nolint:interfacer
+func (update PendingVendorIDUpdate) HasApprovalFrom(address sdk.AccAddress) bool {
+	return approvals.HasApprovalFrom(update.Approvals, address)
+}
+
+/*
+	Pending rotation of the key of a Trustee account
+*/
+type PendingKeyRotation struct {
+	Address   sdk.AccAddress   `json:"address"`
+	PubKey    crypto.PubKey    `json:"public_key"`
+	Approvals []sdk.AccAddress `json:"approvals"`
+}
+
+// NewPendingKeyRotation creates a new PendingKeyRotation object.
+func NewPendingKeyRotation(address sdk.AccAddress, pubKey crypto.PubKey,
+	approval sdk.AccAddress) PendingKeyRotation {
+	return PendingKeyRotation{
+		Address:   address,
+		PubKey:    pubKey,
+		Approvals: []sdk.AccAddress{approval},
+	}
+}
+
+// String implements fmt.Stringer.
+func (rotation PendingKeyRotation) String() string {
+	bytes, err := json.Marshal(rotation)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+// Validate checks for errors on the pending key rotation.
+func (rotation PendingKeyRotation) Validate() sdk.Error {
+	if rotation.Address == nil {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid Pending Key Rotation: Value: %s. Error: Missing Address", rotation.Address))
+	}
+
+	if rotation.PubKey == nil {
+		return sdk.ErrUnknownRequest("Invalid Pending Key Rotation: Error: Missing PubKey")
+	}
+
+	return nil
+}
+
+//nolint:interfacer
+func (rotation PendingKeyRotation) HasApprovalFrom(address sdk.AccAddress) bool {
+	return approvals.HasApprovalFrom(rotation.Approvals, address)
+}
+
+/*
+	Pending freeze (or unfreeze) of an account
+*/
+type PendingAccountFreeze struct {
+	Address   sdk.AccAddress   `json:"address"`
+	Freeze    bool             `json:"freeze"` // true to freeze the account, false to unfreeze it
+	Approvals []sdk.AccAddress `json:"approvals"`
+}
+
+// NewPendingAccountFreeze creates a new PendingAccountFreeze object.
+func NewPendingAccountFreeze(address sdk.AccAddress, freeze bool, approval sdk.AccAddress) PendingAccountFreeze {
+	return PendingAccountFreeze{
+		Address:   address,
+		Freeze:    freeze,
+		Approvals: []sdk.AccAddress{approval},
+	}
+}
+
+// String implements fmt.Stringer.
+func (freeze PendingAccountFreeze) String() string {
+	bytes, err := json.Marshal(freeze)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+// Validate checks for errors on the pending account freeze.
+func (freeze PendingAccountFreeze) Validate() sdk.Error {
+	if freeze.Address == nil {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid Pending Account Freeze: Value: %s. Error: Missing Address", freeze.Address))
+	}
+
+	return nil
+}
+
+//nolint:interfacer
+func (freeze PendingAccountFreeze) HasApprovalFrom(address sdk.AccAddress) bool {
+	return approvals.HasApprovalFrom(freeze.Approvals, address)
 }
@@ -34,6 +34,11 @@ var (
 	_ module.AppModuleBasic = AppModuleBasic{}
 )
 
+// Consensus version of the module store schema.
+// Version 2 adds the proposal height of pending accounts and the index of pending accounts by it.
+// The module cannot depend on the upgrade module (which depends on it), so its migrations are registered by the app.
+const ConsensusVersion = 2
+
 // app module Basics object.
 type AppModuleBasic struct{}
 
@@ -119,6 +124,12 @@ func (a AppModule) NewQuerierHandler() sdk.Querier {
 
 func (a AppModule) BeginBlock(sdk.Context, abci.RequestBeginBlock) {}
 
-func (a AppModule) EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (a AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	a.keeper.EndBlocker(ctx)
+
 	return []abci.ValidatorUpdate{}
 }
+
+func (a AppModule) ConsensusVersion() uint64 {
+	return ConsensusVersion
+}
@@ -23,27 +23,74 @@ const (
 	ModuleName           = types.ModuleName
 	RouterKey            = types.RouterKey
 	StoreKey             = types.StoreKey
+	DefaultParamspace    = types.DefaultParamspace
 	CodeAlreadyCertifyed = types.CodeAlreadyCertifyed
+
+	CodeComplianceAuthorityGrantDoesNotExist = types.CodeComplianceAuthorityGrantDoesNotExist
+	CodeComplianceInfoDoesNotExist           = types.CodeComplianceInfoDoesNotExist
+	CodeCertificationDeclarationDoesNotExist = types.CodeCertificationDeclarationDoesNotExist
+	CodeComplianceInfoAlreadyExists          = types.CodeComplianceInfoAlreadyExists
+
+	QueryComplianceInfo           = keeper.QueryComplianceInfo
+	QueryAllComplianceInfoRecords = keeper.QueryAllComplianceInfoRecords
+
+	EventTypeExpireCompliance     = types.EventTypeExpireCompliance
+	AttributeKeyVID               = types.AttributeKeyVID
+	AttributeKeyPID               = types.AttributeKeyPID
+	AttributeKeyCertificationType = types.AttributeKeyCertificationType
+	AttributeKeyExpirationDate    = types.AttributeKeyExpirationDate
 )
 
 var (
-	NewKeeper           = keeper.NewKeeper
-	NewQuerier          = keeper.NewQuerier
-	NewMsgCertifyModel  = types.NewMsgCertifyModel
-	NewMsgRevokeModel   = types.NewMsgRevokeModel
-	ModuleCdc           = types.ModuleCdc
-	RegisterCodec       = types.RegisterCodec
-	CertifiedState      = types.Certified
-	RevokedState        = types.Revoked
-	ZbCertificationType = types.ZbCertificationType
+	NewKeeper                         = keeper.NewKeeper
+	NewQuerier                        = keeper.NewQuerier
+	ComplianceInfoPrefix              = types.ComplianceInfoPrefix
+	CertificationDeclarationPrefix    = types.CertificationDeclarationPrefix
+	NewMsgCertifyModel                = types.NewMsgCertifyModel
+	NewMsgRevokeModel                 = types.NewMsgRevokeModel
+	NewMsgProvisionModel              = types.NewMsgProvisionModel
+	NewMsgGrantComplianceAuthority    = types.NewMsgGrantComplianceAuthority
+	NewMsgRevokeComplianceAuthority   = types.NewMsgRevokeComplianceAuthority
+	NewComplianceAuthorityGrant       = types.NewComplianceAuthorityGrant
+	NewMsgAddCertificationDeclaration = types.NewMsgAddCertificationDeclaration
+	NewFirmwareCompliance             = types.NewFirmwareCompliance
+	GetCertificationDeclarationKey    = types.GetCertificationDeclarationKey
+	ModuleCdc                         = types.ModuleCdc
+	RegisterCodec                     = types.RegisterCodec
+	CertifiedState                    = types.Certified
+	RevokedState                      = types.Revoked
+	ExpiredState                      = types.Expired
+	ProvisionalState                  = types.Provisional
+	ZbCertificationType               = types.ZbCertificationType
+	MatterCertificationType           = types.MatterCertificationType
+	ThreadCertificationType           = types.ThreadCertificationType
+	DefaultCertificationTypes         = types.DefaultCertificationTypes
+	NewParams                         = types.NewParams
+	DefaultParams                     = types.DefaultParams
 )
 
 type (
-	Keeper                = keeper.Keeper
-	MsgCertifyModel       = types.MsgCertifyModel
-	MsgRevokeModel        = types.MsgRevokeModel
-	ComplianceInfo        = types.ComplianceInfo
-	ComplianceInfoKey     = types.ComplianceInfoKey
-	ComplianceInfoInState = types.ComplianceInfoInState
-	CertificationType     = types.CertificationType
+	Keeper                         = keeper.Keeper
+	MsgCertifyModel                = types.MsgCertifyModel
+	MsgRevokeModel                 = types.MsgRevokeModel
+	MsgProvisionModel              = types.MsgProvisionModel
+	MsgGrantComplianceAuthority    = types.MsgGrantComplianceAuthority
+	MsgRevokeComplianceAuthority   = types.MsgRevokeComplianceAuthority
+	ComplianceAuthorityGrant       = types.ComplianceAuthorityGrant
+	ListComplianceAuthorityGrants  = types.ListComplianceAuthorityGrants
+	MsgAddCertificationDeclaration = types.MsgAddCertificationDeclaration
+	CertificationDeclaration       = types.CertificationDeclaration
+	ListCertificationDeclarations  = types.ListCertificationDeclarations
+	FirmwareCompliance             = types.FirmwareCompliance
+	FirmwareComplianceItem         = types.FirmwareComplianceItem
+	ComplianceInfo                 = types.ComplianceInfo
+	ComplianceInfoKey              = types.ComplianceInfoKey
+	ComplianceInfoInState          = types.ComplianceInfoInState
+	CertificationType              = types.CertificationType
+	RevocationReasonCode           = types.RevocationReasonCode
+	ComplianceHistory              = types.ComplianceHistory
+	ComplianceState                = types.ComplianceState
+	ListComplianceInfoItems        = types.ListComplianceInfoItems
+	ListQueryParams                = types.ListQueryParams
+	Params                         = types.Params
 )
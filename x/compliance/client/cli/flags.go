@@ -22,6 +22,18 @@ const (
 	FlagCertificationDate         = "certification-date"
 	FlagCertificationDateShortcut = "d"
 	FlagRevocationDate            = "revocation-date"
+	FlagProvisionalDate           = "provisional-date"
 	FlagReason                    = "reason"
 	FlagReasonShortcut            = "r"
+	FlagReasonCode                = "reason-code"
+	FlagGrantee                   = "grantee"
+	FlagExpirationDate            = "expiration-date"
+	FlagExpectedSequence          = "expected-sequence"
+	FlagCDCertificateID           = "cd-certificate-id"
+	FlagSoftwareVersion           = "software-version"
+	FlagContent                   = "content"
+	FlagContentHash               = "content-hash"
+	FlagURL                       = "url"
+	FlagFirmwareDigest            = "firmware-digest"
+	FlagDays                      = "days"
 )
@@ -19,12 +19,15 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance/internal/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
 )
 
 func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
@@ -42,6 +45,16 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdGetAllCertifiedModels(storeKey, cdc),
 		GetCmdGetRevokedModel(storeKey, cdc),
 		GetCmdGetAllRevokedModels(storeKey, cdc),
+		GetCmdGetProvisionalModel(storeKey, cdc),
+		GetCmdGetAllProvisionalModels(storeKey, cdc),
+		GetCmdGetComplianceHistory(storeKey, cdc),
+		GetCmdGetModelComplianceInfos(storeKey, cdc),
+		GetCmdGetComplianceAuthorityGrant(storeKey, cdc),
+		GetCmdGetAllComplianceAuthorityGrants(storeKey, cdc),
+		GetCmdGetCertificationDeclaration(storeKey, cdc),
+		GetCmdGetAllCertificationDeclarations(storeKey, cdc),
+		GetCmdGetComplianceByFirmwareDigest(storeKey, cdc),
+		GetCmdGetAllComplianceInfosExpiring(storeKey, cdc),
 	)...)
 
 	return complianceQueryCmd
@@ -60,7 +73,7 @@ func GetCmdGetComplianceInfo(queryRoute string, cdc *codec.Codec) *cobra.Command
 	cmd.Flags().String(FlagVID, "", "Model vendor ID")
 	cmd.Flags().String(FlagPID, "", "Model product ID")
 	cmd.Flags().StringP(FlagCertificationType, FlagCertificationTypeShortcut, "",
-		"Certification type (zb` is the only supported value now)")
+		"Certification type (`zb`, `matter` or `thread`)")
 	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
 
 	_ = cmd.MarkFlagRequired(FlagVID)
@@ -80,9 +93,11 @@ func GetCmdGetAllComplianceInfos(queryRoute string, cdc *codec.Codec) *cobra.Com
 	}
 
 	cmd.Flags().StringP(FlagCertificationType, FlagCertificationTypeShortcut, "",
-		"Requested certification type. `zb` is the default and the only supported value now")
+		"Requested certification type: `zb` (default), `matter` or `thread`")
 	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of models to skip")
 	cmd.Flags().Int(pagination.FlagTake, 0, "amount of models to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+	pagination.AddSortFlags(cmd, types.ComplianceInfoSortFields...)
 
 	return cmd
 }
@@ -102,7 +117,7 @@ func GetCmdGetCertifiedModel(queryRoute string, cdc *codec.Codec) *cobra.Command
 	cmd.Flags().String(FlagPID, "", "Model product ID")
 
 	cmd.Flags().StringP(FlagCertificationType, FlagCertificationTypeShortcut, "",
-		"Certification type (zb` is the only supported value now)")
+		"Certification type (`zb`, `matter` or `thread`)")
 	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
 
 	_ = cmd.MarkFlagRequired(FlagVID)
@@ -122,9 +137,11 @@ func GetCmdGetAllCertifiedModels(queryRoute string, cdc *codec.Codec) *cobra.Com
 	}
 
 	cmd.Flags().StringP(FlagCertificationType, FlagCertificationTypeShortcut, "",
-		"Requested certification type. `zb` is the default and the only supported value now")
+		"Requested certification type: `zb` (default), `matter` or `thread`")
 	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of models to skip")
 	cmd.Flags().Int(pagination.FlagTake, 0, "amount of models to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+	pagination.AddSortFlags(cmd, types.ComplianceInfoSortFields...)
 
 	return cmd
 }
@@ -142,7 +159,7 @@ func GetCmdGetRevokedModel(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagVID, "", "Model vendor ID")
 	cmd.Flags().String(FlagPID, "", "Model product ID")
 	cmd.Flags().StringP(FlagCertificationType, FlagCertificationTypeShortcut, "",
-		"Certification type (zb` is the only supported value now)")
+		"Certification type (`zb`, `matter` or `thread`)")
 	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
 
 	_ = cmd.MarkFlagRequired(FlagVID)
@@ -162,13 +179,366 @@ func GetCmdGetAllRevokedModels(queryRoute string, cdc *codec.Codec) *cobra.Comma
 	}
 
 	cmd.Flags().StringP(FlagCertificationType, FlagCertificationTypeShortcut, "",
-		"Requested certification type. `zb` is the default and the only supported value now")
+		"Requested certification type: `zb` (default), `matter` or `thread`")
 	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of models to skip")
 	cmd.Flags().Int(pagination.FlagTake, 0, "amount of models to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+	pagination.AddSortFlags(cmd, types.ComplianceInfoSortFields...)
 
 	return cmd
 }
 
+func GetCmdGetProvisionalModel(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "provisional-model",
+		Short: "Gets a boolean if the given Model (identified by the `vid`, `pid` and `certification_type`) " +
+			"is provisionally approved",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return getComplianceInfoInState(queryRoute, cdc, types.Provisional)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().StringP(FlagCertificationType, FlagCertificationTypeShortcut, "",
+		"Certification type (`zb`, `matter` or `thread`)")
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+	_ = cmd.MarkFlagRequired(FlagCertificationType)
+
+	return cmd
+}
+
+func GetCmdGetAllProvisionalModels(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-provisional-models",
+		Short: "Query the list of all provisionally approved models",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return getAllComplianceInfoRecords(cdc, fmt.Sprintf("custom/%s/all_provisional_models", queryRoute))
+		},
+	}
+
+	cmd.Flags().StringP(FlagCertificationType, FlagCertificationTypeShortcut, "",
+		"Requested certification type: `zb` (default), `matter` or `thread`")
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of models to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of models to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+	pagination.AddSortFlags(cmd, types.ComplianceInfoSortFields...)
+
+	return cmd
+}
+
+func GetCmdGetComplianceHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "compliance-history",
+		Short: "Query all state transitions of compliance info for Model " +
+			"(identified by the `vid`, `pid` and `certification_type`)",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err_ := conversions.ParseVID(viper.GetString(FlagVID))
+			if err_ != nil {
+				return err_
+			}
+
+			pid, err_ := conversions.ParsePID(viper.GetString(FlagPID))
+			if err_ != nil {
+				return err_
+			}
+
+			certificationType := types.CertificationType(viper.GetString(FlagCertificationType))
+
+			res, height, err := cliCtx.QueryStore(types.GetComplianceInfoKey(certificationType, vid, pid), queryRoute)
+			if err != nil || res == nil {
+				return types.ErrComplianceInfoDoesNotExist(vid, pid, certificationType)
+			}
+
+			var complianceInfo types.ComplianceInfo
+
+			cdc.MustUnmarshalBinaryBare(res, &complianceInfo)
+
+			return cliCtx.EncodeAndPrintWithHeight(complianceInfo.FullHistory(), height)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().StringP(FlagCertificationType, FlagCertificationTypeShortcut, string(types.ZbCertificationType),
+		"Certification type: `zb` (default), `matter` or `thread`")
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+
+	return cmd
+}
+
+func GetCmdGetModelComplianceInfos(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "model-compliance-info-records",
+		Short: "Query compliance info records of all certification types for Model (identified by the `vid` and `pid`)",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err_ := conversions.ParseVID(viper.GetString(FlagVID))
+			if err_ != nil {
+				return err_
+			}
+
+			pid, err_ := conversions.ParsePID(viper.GetString(FlagPID))
+			if err_ != nil {
+				return err_
+			}
+
+			return cliCtx.QueryList(
+				fmt.Sprintf("custom/%s/model_compliance_info_records/%v/%v", queryRoute, vid, pid), nil)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+
+	return cmd
+}
+
+func GetCmdGetComplianceAuthorityGrant(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compliance-authority-grant",
+		Short: "Query the compliance authority delegated to the account for the given vendor",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err_ := conversions.ParseVID(viper.GetString(FlagVID))
+			if err_ != nil {
+				return err_
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(viper.GetString(FlagGrantee))
+			if err != nil {
+				return err
+			}
+
+			res, height, err := cliCtx.QueryStore(types.GetComplianceAuthorityGrantKey(vid, grantee), queryRoute)
+			if err != nil || res == nil {
+				return types.ErrComplianceAuthorityGrantDoesNotExist(vid, grantee)
+			}
+
+			var grant types.ComplianceAuthorityGrant
+
+			cdc.MustUnmarshalBinaryBare(res, &grant)
+
+			return cliCtx.EncodeAndPrintWithHeight(grant, height)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Vendor ID the authority is delegated for")
+	cmd.Flags().String(FlagGrantee, "", "Bech32 encoded address of the account the authority is delegated to")
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagGrantee)
+
+	return cmd
+}
+
+func GetCmdGetAllComplianceAuthorityGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-compliance-authority-grants",
+		Short: "Query the list of all compliance authority grants",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/all_compliance_authority_grants", queryRoute), params)
+		},
+	}
+
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of grants to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of grants to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
+
+func GetCmdGetAllComplianceInfosExpiring(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "all-compliance-info-records-expiring",
+		Short: "Query the list of the certified compliance info records which expire within the given number " +
+			"of days, in the order of expiration",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/compliance_info_records_expiring_within/%d",
+				queryRoute, viper.GetUint(FlagDays)), params)
+		},
+	}
+
+	cmd.Flags().Uint(FlagDays, 0, "Number of days from now")
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of models to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of models to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	_ = cmd.MarkFlagRequired(FlagDays)
+
+	return cmd
+}
+
+func GetCmdGetCertificationDeclaration(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "certification-declaration",
+		Short: "Query the certification declaration of the model version",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err_ := conversions.ParseVID(viper.GetString(FlagVID))
+			if err_ != nil {
+				return err_
+			}
+
+			pid, err_ := conversions.ParsePID(viper.GetString(FlagPID))
+			if err_ != nil {
+				return err_
+			}
+
+			softwareVersion, err_ := conversions.ParseUInt32FromString(viper.GetString(FlagSoftwareVersion))
+			if err_ != nil {
+				return err_
+			}
+
+			res, height, err := cliCtx.QueryStore(
+				types.GetCertificationDeclarationKey(vid, pid, softwareVersion), queryRoute)
+			if err != nil || res == nil {
+				return types.ErrCertificationDeclarationDoesNotExist(vid, pid, softwareVersion)
+			}
+
+			var declaration types.CertificationDeclaration
+
+			cdc.MustUnmarshalBinaryBare(res, &declaration)
+
+			return cliCtx.EncodeAndPrintWithHeight(declaration, height)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().String(FlagSoftwareVersion, "", "Model software version")
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+	_ = cmd.MarkFlagRequired(FlagSoftwareVersion)
+
+	return cmd
+}
+
+func GetCmdGetAllCertificationDeclarations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-certification-declarations",
+		Short: "Query the list of all certification declarations",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/all_certification_declarations", queryRoute), params)
+		},
+	}
+
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of declarations to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of declarations to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
+
+func GetCmdGetComplianceByFirmwareDigest(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compliance-by-digest",
+		Short: "Query the compliance of all the model versions with the given firmware digest",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			digest := viper.GetString(FlagFirmwareDigest)
+			certificationType := types.CertificationType(viper.GetString(FlagCertificationType))
+
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+				modelinfo.StoreKey, modelinfo.QueryModelVersionsByFirmwareDigest, digest), nil)
+			if err != nil {
+				return err
+			}
+
+			var modelVersions modelinfo.ListModelVersions
+
+			cdc.MustUnmarshalJSON(res, &modelVersions)
+
+			// the compliance infos are read at the height the model versions are read at
+			result := types.NewFirmwareCompliance(digest)
+
+			for _, modelVersion := range modelVersions.Items {
+				complianceInfos, err := queryModelComplianceInfos(cliCtx.WithHeight(height), queryRoute,
+					modelVersion.VID, modelVersion.PID)
+				if err != nil {
+					return err
+				}
+
+				result.Add(types.FirmwareComplianceItem{
+					VID:                   modelVersion.VID,
+					PID:                   modelVersion.PID,
+					SoftwareVersion:       modelVersion.SoftwareVersion,
+					SoftwareVersionString: modelVersion.SoftwareVersionString,
+					ComplianceInfos:       complianceInfos,
+				}, certificationType)
+			}
+
+			return cliCtx.EncodeAndPrintWithHeight(result, height)
+		},
+	}
+
+	cmd.Flags().String(FlagFirmwareDigest, "", "Digest of the firmware image (hex digests are case-insensitive)")
+	cmd.Flags().StringP(FlagCertificationType, FlagCertificationTypeShortcut, "",
+		"Certification type to consider (all the certification types if not set)")
+
+	_ = cmd.MarkFlagRequired(FlagFirmwareDigest)
+
+	return cmd
+}
+
+// Returns the compliance infos of the model (none if the model has no compliance infos).
+func queryModelComplianceInfos(cliCtx cli.CliContext, queryRoute string,
+	vid uint16, pid uint16) ([]types.ComplianceInfo, error) {
+	res, _, err := cliCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/model_compliance_info_records/%v/%v", queryRoute, vid, pid), nil)
+	if err != nil {
+		if code, ok := errcodes.Of(err); ok && code.Codespace == types.Codespace &&
+			code.Code == types.CodeComplianceInfoDoesNotExist {
+			return []types.ComplianceInfo{}, nil
+		}
+
+		return nil, err
+	}
+
+	var complianceInfos types.ListComplianceInfoItems
+
+	cliCtx.Codec().MustUnmarshalJSON(res, &complianceInfos)
+
+	return complianceInfos.Items, nil
+}
+
 func getComplianceInfo(queryRoute string, cdc *codec.Codec) error {
 	cliCtx := cli.NewCLIContext().WithCodec(cdc)
 
@@ -236,6 +606,9 @@ func getAllComplianceInfoRecords(cdc *codec.Codec, path string) error {
 	certificationType := types.CertificationType(viper.GetString(FlagCertificationType))
 
 	params := types.NewListQueryParams(certificationType, paginationParams.Skip, paginationParams.Take)
+	params.Key = paginationParams.Key
+	params.Sort = paginationParams.Sort
+	params.Order = paginationParams.Order
 
 	return cliCtx.QueryList(path, params)
 }
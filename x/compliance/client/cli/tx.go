@@ -15,7 +15,10 @@
 package cli
 
 import (
+	"encoding/base64"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -40,6 +43,10 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	complianceTxCmd.AddCommand(cli.SignedCommands(client.PostCommands(
 		GetCmdCertifyModel(cdc),
 		GetCmdRevokeModel(cdc),
+		GetCmdProvisionModel(cdc),
+		GetCmdGrantComplianceAuthority(cdc),
+		GetCmdRevokeComplianceAuthority(cdc),
+		GetCmdAddCertificationDeclaration(cdc),
 	)...)...)
 
 	return complianceTxCmd
@@ -75,6 +82,16 @@ func GetCmdCertifyModel(cdc *codec.Codec) *cobra.Command {
 			reason := viper.GetString(FlagReason)
 
 			msg := types.NewMsgCertifyModel(vid, pid, certificationDate, certificationType, reason, cliCtx.FromAddress())
+			msg.ExpectedSequence = viper.GetUint64(FlagExpectedSequence)
+			msg.CDCertificateID = viper.GetString(FlagCDCertificateID)
+
+			if expirationDate := viper.GetString(FlagExpirationDate); len(expirationDate) != 0 {
+				msg.ExpirationDate, err_ = time.Parse(time.RFC3339, expirationDate)
+				if err_ != nil {
+					return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ExpirationDate \"%v\": "+
+						"it must be RFC3339 date. Error: %v", expirationDate, err_.Error()))
+				}
+			}
 
 			return cliCtx.HandleWriteMessage(msg)
 		},
@@ -83,11 +100,17 @@ func GetCmdCertifyModel(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagVID, "", "Model vendor ID")
 	cmd.Flags().String(FlagPID, "", "Model product ID")
 	cmd.Flags().StringP(FlagCertificationType, FlagCertificationTypeShortcut, "",
-		"Certification type (zb` is the only supported value now)")
+		"Certification type (`zb`, `matter` or `thread`)")
 	cmd.Flags().StringP(FlagCertificationDate, FlagCertificationDateShortcut, "",
 		"The date of model certification (rfc3339 encoded)")
 	cmd.Flags().StringP(FlagReason, FlagReasonShortcut, "",
 		"Optional comment describing the reason of certification")
+	cmd.Flags().String(FlagCDCertificateID, "",
+		"Optional ID of the Certification Declaration of the Matter devices")
+	cmd.Flags().String(FlagExpirationDate, "",
+		"Optional date the certification expires at (rfc3339 encoded), the model is certified indefinitely if not set")
+	cmd.Flags().Uint64(FlagExpectedSequence, 0,
+		"Sequence of the compliance info the change is based on (ignored if there is no compliance info yet)")
 
 	_ = cmd.MarkFlagRequired(FlagVID)
 	_ = cmd.MarkFlagRequired(FlagPID)
@@ -123,9 +146,12 @@ func GetCmdRevokeModel(cdc *codec.Codec) *cobra.Command {
 					"it must be RFC3339 date. Error: %v", viper.GetString(FlagRevocationDate), err_.Error()))
 			}
 
+			reasonCode := types.RevocationReasonCode(viper.GetString(FlagReasonCode))
 			reason := viper.GetString(FlagReason)
 
-			msg := types.NewMsgRevokeModel(vid, pid, revocationDate, certificationType, reason, cliCtx.FromAddress())
+			msg := types.NewMsgRevokeModel(vid, pid, revocationDate, certificationType,
+				reasonCode, reason, cliCtx.FromAddress())
+			msg.ExpectedSequence = viper.GetUint64(FlagExpectedSequence)
 
 			return cliCtx.HandleWriteMessage(msg)
 		},
@@ -134,16 +160,227 @@ func GetCmdRevokeModel(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagVID, "", "Model vendor ID")
 	cmd.Flags().String(FlagPID, "", "Model product ID")
 	cmd.Flags().StringP(FlagCertificationType, FlagCertificationTypeShortcut, "",
-		"Certification type (zb` is the only supported value now)")
+		"Certification type (`zb`, `matter` or `thread`)")
 	cmd.Flags().StringP(FlagRevocationDate, FlagCertificationDateShortcut, "",
-		"The date of model revocation (rfc3339 encoded)")
+		"The date the model revocation is effective from (rfc3339 encoded)")
+	cmd.Flags().String(FlagReasonCode, "",
+		fmt.Sprintf("Code of the revocation reason. Supported codes: %v", types.RevocationReasonCodes))
 	cmd.Flags().StringP(FlagReason, FlagReasonShortcut, "",
 		"Optional comment describing the reason of revocation")
+	cmd.Flags().Uint64(FlagExpectedSequence, 0,
+		"Sequence of the compliance info the change is based on (ignored if there is no compliance info yet)")
 
 	_ = cmd.MarkFlagRequired(FlagVID)
 	_ = cmd.MarkFlagRequired(FlagPID)
 	_ = cmd.MarkFlagRequired(FlagCertificationType)
 	_ = cmd.MarkFlagRequired(FlagRevocationDate)
+	_ = cmd.MarkFlagRequired(FlagReasonCode)
+
+	return cmd
+}
+
+func GetCmdProvisionModel(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "provision-model",
+		Short: "Provisionally approve an existing model pending the final paperwork. Note that the corresponding " +
+			"model info and test results must be present on ledger",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err := conversions.ParseVID(viper.GetString(FlagVID))
+			if err != nil {
+				return err
+			}
+
+			pid, err := conversions.ParsePID(viper.GetString(FlagPID))
+			if err != nil {
+				return err
+			}
+
+			certificationType := types.CertificationType(viper.GetString(FlagCertificationType))
+
+			provisionalDate, err_ := time.Parse(time.RFC3339, viper.GetString(FlagProvisionalDate))
+			if err_ != nil {
+				return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ProvisionalDate \"%v\": "+
+					"it must be RFC3339 date. Error: %v", viper.GetString(FlagProvisionalDate), err_.Error()))
+			}
+
+			reason := viper.GetString(FlagReason)
+
+			msg := types.NewMsgProvisionModel(vid, pid, provisionalDate, certificationType,
+				reason, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().StringP(FlagCertificationType, FlagCertificationTypeShortcut, "",
+		"Certification type (`zb`, `matter` or `thread`)")
+	cmd.Flags().StringP(FlagProvisionalDate, FlagCertificationDateShortcut, "",
+		"The date of model provisional approval (rfc3339 encoded)")
+	cmd.Flags().StringP(FlagReason, FlagReasonShortcut, "",
+		"Optional comment describing the reason of provisional approval")
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+	_ = cmd.MarkFlagRequired(FlagCertificationType)
+	_ = cmd.MarkFlagRequired(FlagProvisionalDate)
+
+	return cmd
+}
+
+func GetCmdGrantComplianceAuthority(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "grant-compliance-authority",
+		Short: "Delegate the authority to certify and revoke models of the given vendor " +
+			"to another account until the expiration date",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err := conversions.ParseVID(viper.GetString(FlagVID))
+			if err != nil {
+				return err
+			}
+
+			grantee, err_ := sdk.AccAddressFromBech32(viper.GetString(FlagGrantee))
+			if err_ != nil {
+				return err_
+			}
+
+			expirationDate, err_ := time.Parse(time.RFC3339, viper.GetString(FlagExpirationDate))
+			if err_ != nil {
+				return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ExpirationDate \"%v\": "+
+					"it must be RFC3339 date. Error: %v", viper.GetString(FlagExpirationDate), err_.Error()))
+			}
+
+			msg := types.NewMsgGrantComplianceAuthority(vid, grantee, expirationDate, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Vendor ID the authority is delegated for")
+	cmd.Flags().String(FlagGrantee, "", "Bech32 encoded address of the account the authority is delegated to")
+	cmd.Flags().String(FlagExpirationDate, "", "The date the delegated authority expires at (rfc3339 encoded)")
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagGrantee)
+	_ = cmd.MarkFlagRequired(FlagExpirationDate)
+
+	return cmd
+}
+
+func GetCmdRevokeComplianceAuthority(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-compliance-authority",
+		Short: "Revoke the authority delegated to the account for the given vendor",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err := conversions.ParseVID(viper.GetString(FlagVID))
+			if err != nil {
+				return err
+			}
+
+			grantee, err_ := sdk.AccAddressFromBech32(viper.GetString(FlagGrantee))
+			if err_ != nil {
+				return err_
+			}
+
+			msg := types.NewMsgRevokeComplianceAuthority(vid, grantee, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Vendor ID the authority is delegated for")
+	cmd.Flags().String(FlagGrantee, "", "Bech32 encoded address of the account the authority is delegated to")
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagGrantee)
+
+	return cmd
+}
+
+func GetCmdAddCertificationDeclaration(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "add-certification-declaration",
+		Short: "Add the signed certification declaration (or its hash and URL) of a version of a certified model. " +
+			"An existing declaration of the model version is replaced",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err := conversions.ParseVID(viper.GetString(FlagVID))
+			if err != nil {
+				return err
+			}
+
+			pid, err := conversions.ParsePID(viper.GetString(FlagPID))
+			if err != nil {
+				return err
+			}
+
+			softwareVersion, err := conversions.ParseUInt32FromString(viper.GetString(FlagSoftwareVersion))
+			if err != nil {
+				return err
+			}
+
+			certificationType := types.CertificationType(viper.GetString(FlagCertificationType))
+
+			content, err_ := readCertificationDeclaration()
+			if err_ != nil {
+				return err_
+			}
+
+			msg := types.NewMsgAddCertificationDeclaration(vid, pid, softwareVersion, certificationType, content,
+				viper.GetString(FlagContentHash), viper.GetString(FlagURL), cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().String(FlagSoftwareVersion, "", "Model software version")
+	cmd.Flags().StringP(FlagCertificationType, FlagCertificationTypeShortcut, "",
+		"Certification type of the compliance info the declaration belongs to (`zb`, `matter` or `thread`)")
+	cmd.Flags().String(FlagContent, "",
+		"Path to the file containing the DER encoded signed declaration or its base64 encoded content")
+	cmd.Flags().String(FlagContentHash, "",
+		"Hex encoded SHA-256 of the declaration stored off-ledger (required if the content is not passed)")
+	cmd.Flags().String(FlagURL, "",
+		"URL the declaration stored off-ledger can be fetched from (required if the content is not passed)")
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+	_ = cmd.MarkFlagRequired(FlagSoftwareVersion)
+	_ = cmd.MarkFlagRequired(FlagCertificationType)
 
 	return cmd
 }
+
+// Reads the declaration from the file or decodes it from base64 if the flag value is not a path.
+func readCertificationDeclaration() ([]byte, error) {
+	target := viper.GetString(FlagContent)
+	if len(target) == 0 {
+		return nil, nil
+	}
+
+	if _, err := os.Stat(target); err == nil {
+		return ioutil.ReadFile(target)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(target)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Content: it must be a path to the file "+
+			"or base64 encoded declaration. Error: %v", err))
+	}
+
+	return decoded, nil
+}
@@ -19,9 +19,12 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance/internal/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
 )
 
 func getComplianceInfoHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
@@ -32,13 +35,15 @@ func getComplianceInfoHandler(cliCtx context.CLIContext, storeName string) http.
 
 func getComplianceInfosHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		getAllComplianceInfo(cliCtx, w, r, fmt.Sprintf("custom/%s/all_compliance_info_records", storeName))
+		getAllComplianceInfo(cliCtx, w, r, fmt.Sprintf("custom/%s/all_compliance_info_records", storeName),
+			&types.ListComplianceInfoItems{})
 	}
 }
 
 func getCertifiedModelsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		getAllComplianceInfo(cliCtx, w, r, fmt.Sprintf("custom/%s/all_certified_models", storeName))
+		getAllComplianceInfo(cliCtx, w, r, fmt.Sprintf("custom/%s/all_certified_models", storeName),
+			&types.ListComplianceInfoKeyItems{})
 	}
 }
 
@@ -50,7 +55,8 @@ func getCertifiedModelHandler(cliCtx context.CLIContext, storeName string) http.
 
 func getRevokedModelsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		getAllComplianceInfo(cliCtx, w, r, fmt.Sprintf("custom/%s/all_revoked_models", storeName))
+		getAllComplianceInfo(cliCtx, w, r, fmt.Sprintf("custom/%s/all_revoked_models", storeName),
+			&types.ListComplianceInfoKeyItems{})
 	}
 }
 
@@ -60,6 +66,84 @@ func getRevokedModelHandler(cliCtx context.CLIContext, storeName string) http.Ha
 	}
 }
 
+func getProvisionalModelsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		getAllComplianceInfo(cliCtx, w, r, fmt.Sprintf("custom/%s/all_provisional_models", storeName),
+			&types.ListComplianceInfoKeyItems{})
+	}
+}
+
+func getProvisionalModelHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		getComplianceInfoInState(cliCtx, w, r, storeName, types.Provisional)
+	}
+}
+
+func getModelComplianceInfosHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		vid, err_ := conversions.ParseVID(vars[vid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		pid, err_ := conversions.ParsePID(vars[pid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		restCtx.QueryExportableList(fmt.Sprintf("custom/%s/model_compliance_info_records/%v/%v", storeName, vid, pid),
+			nil, &types.ListComplianceInfoItems{})
+	}
+}
+
+func getComplianceHistoryHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		vid, err_ := conversions.ParseVID(vars[vid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		pid, err_ := conversions.ParsePID(vars[pid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		certificationType := types.CertificationType(restCtx.Request().FormValue(certificationType))
+		if len(certificationType) == 0 {
+			certificationType = types.ZbCertificationType
+		}
+
+		res, height, err := restCtx.QueryStore(types.GetComplianceInfoKey(certificationType, vid, pid), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrComplianceInfoDoesNotExist(vid, pid, certificationType))
+
+			return
+		}
+
+		var complianceInfo types.ComplianceInfo
+
+		restCtx.Codec().MustUnmarshalBinaryBare(res, &complianceInfo)
+
+		restCtx.EncodeAndRespondWithHeight(complianceInfo.FullHistory(), height)
+	}
+}
+
 func getComplianceInfoInState(cliCtx context.CLIContext, w http.ResponseWriter, r *http.Request,
 	storeName string, state types.ComplianceState) {
 	restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
@@ -68,14 +152,14 @@ func getComplianceInfoInState(cliCtx context.CLIContext, w http.ResponseWriter,
 
 	vid, err_ := conversions.ParseVID(vars[vid])
 	if err_ != nil {
-		restCtx.WriteErrorResponse(http.StatusBadRequest, err_.Error())
+		restCtx.WriteError(http.StatusBadRequest, err_)
 
 		return
 	}
 
 	pid, err_ := conversions.ParsePID(vars[pid])
 	if err_ != nil {
-		restCtx.WriteErrorResponse(http.StatusBadRequest, err_.Error())
+		restCtx.WriteError(http.StatusBadRequest, err_)
 
 		return
 	}
@@ -94,8 +178,7 @@ func getComplianceInfoInState(cliCtx context.CLIContext, w http.ResponseWriter,
 	}
 
 	if err != nil {
-		restCtx.WriteErrorResponse(http.StatusNotFound,
-			types.ErrComplianceInfoDoesNotExist(vid, pid, certificationType).Error())
+		restCtx.WriteError(http.StatusNotFound, types.ErrComplianceInfoDoesNotExist(vid, pid, certificationType))
 
 		return
 	}
@@ -110,14 +193,14 @@ func getComplianceInfo(cliCtx context.CLIContext, w http.ResponseWriter, r *http
 
 	vid, err_ := conversions.ParseVID(vars[vid])
 	if err_ != nil {
-		restCtx.WriteErrorResponse(http.StatusBadRequest, err_.Error())
+		restCtx.WriteError(http.StatusBadRequest, err_)
 
 		return
 	}
 
 	pid, err_ := conversions.ParsePID(vars[pid])
 	if err_ != nil {
-		restCtx.WriteErrorResponse(http.StatusBadRequest, err_.Error())
+		restCtx.WriteError(http.StatusBadRequest, err_)
 
 		return
 	}
@@ -126,8 +209,7 @@ func getComplianceInfo(cliCtx context.CLIContext, w http.ResponseWriter, r *http
 
 	res, height, err := restCtx.QueryStore(types.GetComplianceInfoKey(certificationType, vid, pid), storeName)
 	if err != nil || res == nil {
-		restCtx.WriteErrorResponse(http.StatusNotFound,
-			types.ErrComplianceInfoDoesNotExist(vid, pid, certificationType).Error())
+		restCtx.WriteError(http.StatusNotFound, types.ErrComplianceInfoDoesNotExist(vid, pid, certificationType))
 
 		return
 	}
@@ -139,7 +221,8 @@ func getComplianceInfo(cliCtx context.CLIContext, w http.ResponseWriter, r *http
 	restCtx.EncodeAndRespondWithHeight(complianceInfo, height)
 }
 
-func getAllComplianceInfo(cliCtx context.CLIContext, w http.ResponseWriter, r *http.Request, path string) {
+func getAllComplianceInfo(cliCtx context.CLIContext, w http.ResponseWriter, r *http.Request, path string,
+	result interface{}) {
 	restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
 
 	paginationParams, err := restCtx.ParsePaginationParams()
@@ -149,6 +232,186 @@ func getAllComplianceInfo(cliCtx context.CLIContext, w http.ResponseWriter, r *h
 
 	certificationType := types.CertificationType(restCtx.Request().FormValue(certificationType))
 	params := types.NewListQueryParams(certificationType, paginationParams.Skip, paginationParams.Take)
+	params.Key = paginationParams.Key
+	params.Sort = paginationParams.Sort
+	params.Order = paginationParams.Order
+
+	restCtx.QueryExportableList(path, params, result)
+}
+
+func getComplianceAuthorityGrantsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		params, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryExportableList(fmt.Sprintf("custom/%s/all_compliance_authority_grants", storeName), params,
+			&types.ListComplianceAuthorityGrants{})
+	}
+}
+
+func getComplianceInfosExpiringHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		days, err_ := conversions.ParseUInt16FromString(r.FormValue(days))
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		params, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryExportableList(
+			fmt.Sprintf("custom/%s/compliance_info_records_expiring_within/%d", storeName, days), params,
+			&types.ListComplianceInfoItems{})
+	}
+}
+
+func getComplianceAuthorityGrantHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		vid, err_ := conversions.ParseVID(vars[vid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		grantee, err := sdk.AccAddressFromBech32(vars[grantee])
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		res, height, err := restCtx.QueryStore(types.GetComplianceAuthorityGrantKey(vid, grantee), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrComplianceAuthorityGrantDoesNotExist(vid, grantee))
+
+			return
+		}
+
+		var grant types.ComplianceAuthorityGrant
+
+		restCtx.Codec().MustUnmarshalBinaryBare(res, &grant)
+
+		restCtx.EncodeAndRespondWithHeight(grant, height)
+	}
+}
+
+func getCertificationDeclarationsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		params, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryExportableList(fmt.Sprintf("custom/%s/all_certification_declarations", storeName), params,
+			&types.ListCertificationDeclarations{})
+	}
+}
+
+func getCertificationDeclarationHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vid, pid, softwareVersion, ok := parseCertificationDeclarationVars(restCtx)
+		if !ok {
+			return
+		}
+
+		res, height, err := restCtx.QueryStore(
+			types.GetCertificationDeclarationKey(vid, pid, softwareVersion), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound,
+				types.ErrCertificationDeclarationDoesNotExist(vid, pid, softwareVersion))
+
+			return
+		}
+
+		var declaration types.CertificationDeclaration
+
+		restCtx.Codec().MustUnmarshalBinaryBare(res, &declaration)
+
+		restCtx.EncodeAndRespondWithHeight(declaration, height)
+	}
+}
+
+// Returns the compliance of all the model versions with the firmware digest.
+// The compliance infos are read at the height the model versions are read at.
+func getComplianceByFirmwareDigestHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		digest := restCtx.Variables()[firmwareDigest]
+		certificationType := types.CertificationType(restCtx.Request().FormValue(certificationType))
+
+		res, height, err := restCtx.QueryWithData(fmt.Sprintf("custom/%s/%s/%s",
+			modelinfo.StoreKey, modelinfo.QueryModelVersionsByFirmwareDigest, digest), nil)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		var modelVersions modelinfo.ListModelVersions
+
+		restCtx.Codec().MustUnmarshalJSON(res, &modelVersions)
+
+		result := types.NewFirmwareCompliance(digest)
+
+		for _, modelVersion := range modelVersions.Items {
+			complianceInfos, err := queryModelComplianceInfos(restCtx.WithHeight(height), storeName,
+				modelVersion.VID, modelVersion.PID)
+			if err != nil {
+				restCtx.WriteError(http.StatusInternalServerError, err)
+
+				return
+			}
+
+			result.Add(types.FirmwareComplianceItem{
+				VID:                   modelVersion.VID,
+				PID:                   modelVersion.PID,
+				SoftwareVersion:       modelVersion.SoftwareVersion,
+				SoftwareVersionString: modelVersion.SoftwareVersionString,
+				ComplianceInfos:       complianceInfos,
+			}, certificationType)
+		}
+
+		restCtx.EncodeAndRespondWithHeight(result, height)
+	}
+}
+
+// Returns the compliance infos of the model (none if the model has no compliance infos).
+func queryModelComplianceInfos(restCtx rest.RestContext, storeName string,
+	vid uint16, pid uint16) ([]types.ComplianceInfo, error) {
+	res, _, err := restCtx.QueryWithData(
+		fmt.Sprintf("custom/%s/model_compliance_info_records/%v/%v", storeName, vid, pid), nil)
+	if err != nil {
+		if code, ok := errcodes.Of(err); ok && code.Codespace == types.Codespace &&
+			code.Code == types.CodeComplianceInfoDoesNotExist {
+			return []types.ComplianceInfo{}, nil
+		}
+
+		return nil, err
+	}
+
+	var complianceInfos types.ListComplianceInfoItems
+
+	restCtx.Codec().MustUnmarshalJSON(res, &complianceInfos)
 
-	restCtx.QueryList(path, params)
+	return complianceInfos.Items, nil
 }
@@ -26,14 +26,68 @@ const (
 	vid               = "vid"
 	pid               = "pid"
 	certificationType = "certification_type"
+	grantee           = "grantee"
+	grants            = "grants"
+	softwareVersion   = "software_version"
+	declarations      = "declarations"
+	firmwareDigest    = "firmware_digest"
+	expiring          = "expiring"
+	days              = "days"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application.
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
+	// must be registered before the compliance info routes as `grants` would match their vid segment
+	r.HandleFunc(
+		fmt.Sprintf("/%s/%s", storeName, grants),
+		getComplianceAuthorityGrantsHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/%s/{%s}/{%s}", storeName, grants, vid, grantee),
+		getComplianceAuthorityGrantHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/%s/{%s}/{%s}", storeName, grants, vid, grantee),
+		grantComplianceAuthorityHandler(cliCtx),
+	).Methods("PUT")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/%s/{%s}/{%s}", storeName, grants, vid, grantee),
+		revokeComplianceAuthorityHandler(cliCtx),
+	).Methods("DELETE")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/%s", storeName, declarations),
+		getCertificationDeclarationsHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/%s/{%s}/{%s}/{%s}", storeName, declarations, vid, pid, softwareVersion),
+		getCertificationDeclarationHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/%s/{%s}/{%s}/{%s}", storeName, declarations, vid, pid, softwareVersion),
+		addCertificationDeclarationHandler(cliCtx),
+	).Methods("PUT")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/%s", storeName, expiring),
+		getComplianceInfosExpiringHandler(cliCtx, storeName),
+	).Methods("GET")
+	// must be registered before the model compliance infos route as `by-digest` would match its vid segment
+	r.HandleFunc(
+		fmt.Sprintf("/%s/by-digest/{%s}", storeName, firmwareDigest),
+		getComplianceByFirmwareDigestHandler(cliCtx, storeName),
+	).Methods("GET")
+	// must be registered before the compliance info route as `history` would match its certification type segment
+	r.HandleFunc(
+		fmt.Sprintf("/%s/{%s}/{%s}/history", storeName, vid, pid),
+		getComplianceHistoryHandler(cliCtx, storeName),
+	).Methods("GET")
 	r.HandleFunc(
 		fmt.Sprintf("/%s/{%s}/{%s}/{%s}", storeName, vid, pid, certificationType),
 		getComplianceInfoHandler(cliCtx, storeName),
 	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/{%s}/{%s}", storeName, vid, pid),
+		getModelComplianceInfosHandler(cliCtx, storeName),
+	).Methods("GET")
 	r.HandleFunc(
 		fmt.Sprintf("/%s", storeName),
 		getComplianceInfosHandler(cliCtx, storeName),
@@ -62,4 +116,16 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		fmt.Sprintf("/%s/%s", storeName, types.Revoked),
 		getRevokedModelsHandler(cliCtx, storeName),
 	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/%s/{%s}/{%s}/{%s}", storeName, types.Provisional, vid, pid, certificationType),
+		provisionModelHandler(cliCtx),
+	).Methods("PUT")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/%s/{%s}/{%s}/{%s}", storeName, types.Provisional, vid, pid, certificationType),
+		getProvisionalModelHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/%s", storeName, types.Provisional),
+		getProvisionalModelsHandler(cliCtx, storeName),
+	).Methods("GET")
 }
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	restTypes "github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
@@ -29,6 +30,9 @@ type CertifyModelRequest struct {
 	BaseReq           restTypes.BaseReq `json:"base_req"`
 	CertificationDate time.Time         `json:"certification_date"` // rfc3339 encoded date
 	Reason            string            `json:"reason,omitempty"`
+	ExpectedSequence  uint64            `json:"expected_sequence"`
+	CDCertificateID   string            `json:"cd_certificate_id,omitempty"`
+	ExpirationDate    time.Time         `json:"expiration_date,omitempty"` // rfc3339 encoded date, optional
 }
 
 // nolint:dupl
@@ -40,14 +44,14 @@ func certifyModelHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		vid, err_ := conversions.ParseVID(vars[vid])
 		if err_ != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err_.Error())
+			restCtx.WriteError(http.StatusBadRequest, err_)
 
 			return
 		}
 
 		pid, err_ := conversions.ParsePID(vars[pid])
 		if err_ != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err_.Error())
+			restCtx.WriteError(http.StatusBadRequest, err_)
 
 			return
 		}
@@ -71,15 +75,20 @@ func certifyModelHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		msg := types.NewMsgCertifyModel(vid, pid, req.CertificationDate,
 			certificationType, req.Reason, restCtx.Signer())
+		msg.ExpectedSequence = req.ExpectedSequence
+		msg.CDCertificateID = req.CDCertificateID
+		msg.ExpirationDate = req.ExpirationDate
 
 		restCtx.HandleWriteRequest(msg)
 	}
 }
 
 type RevokeModelRequest struct {
-	BaseReq        restTypes.BaseReq `json:"base_req"`
-	RevocationDate time.Time         `json:"revocation_date"` // rfc3339 encoded date
-	Reason         string            `json:"reason,omitempty"`
+	BaseReq          restTypes.BaseReq          `json:"base_req"`
+	RevocationDate   time.Time                  `json:"revocation_date"` // rfc3339 encoded date
+	ReasonCode       types.RevocationReasonCode `json:"reason_code"`
+	Reason           string                     `json:"reason,omitempty"`
+	ExpectedSequence uint64                     `json:"expected_sequence"`
 }
 
 // nolint:dupl
@@ -91,14 +100,14 @@ func revokeModelHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		vid, err_ := conversions.ParseVID(vars[vid])
 		if err_ != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err_.Error())
+			restCtx.WriteError(http.StatusBadRequest, err_)
 
 			return
 		}
 
 		pid, err_ := conversions.ParsePID(vars[pid])
 		if err_ != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err_.Error())
+			restCtx.WriteError(http.StatusBadRequest, err_)
 
 			return
 		}
@@ -121,8 +130,215 @@ func revokeModelHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		msg := types.NewMsgRevokeModel(vid, pid, req.RevocationDate,
+			certificationType, req.ReasonCode, req.Reason, restCtx.Signer())
+		msg.ExpectedSequence = req.ExpectedSequence
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+type ProvisionModelRequest struct {
+	BaseReq         restTypes.BaseReq `json:"base_req"`
+	ProvisionalDate time.Time         `json:"provisional_date"` // rfc3339 encoded date
+	Reason          string            `json:"reason,omitempty"`
+}
+
+// nolint:dupl
+func provisionModelHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		vid, err_ := conversions.ParseVID(vars[vid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		pid, err_ := conversions.ParsePID(vars[pid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		certificationType := types.CertificationType(vars[certificationType])
+
+		var req ProvisionModelRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgProvisionModel(vid, pid, req.ProvisionalDate,
 			certificationType, req.Reason, restCtx.Signer())
 
 		restCtx.HandleWriteRequest(msg)
 	}
 }
+
+type GrantComplianceAuthorityRequest struct {
+	BaseReq        restTypes.BaseReq `json:"base_req"`
+	ExpirationDate time.Time         `json:"expiration_date"` // rfc3339 encoded date
+}
+
+func grantComplianceAuthorityHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vid, grantee, ok := parseComplianceAuthorityGrantVars(restCtx)
+		if !ok {
+			return
+		}
+
+		var req GrantComplianceAuthorityRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgGrantComplianceAuthority(vid, grantee, req.ExpirationDate, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+type RevokeComplianceAuthorityRequest struct {
+	BaseReq restTypes.BaseReq `json:"base_req"`
+}
+
+func revokeComplianceAuthorityHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vid, grantee, ok := parseComplianceAuthorityGrantVars(restCtx)
+		if !ok {
+			return
+		}
+
+		var req RevokeComplianceAuthorityRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgRevokeComplianceAuthority(vid, grantee, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func parseComplianceAuthorityGrantVars(restCtx rest.RestContext) (uint16, sdk.AccAddress, bool) {
+	vars := restCtx.Variables()
+
+	vid, err_ := conversions.ParseVID(vars[vid])
+	if err_ != nil {
+		restCtx.WriteError(http.StatusBadRequest, err_)
+
+		return 0, nil, false
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(vars[grantee])
+	if err != nil {
+		restCtx.WriteError(http.StatusBadRequest, err)
+
+		return 0, nil, false
+	}
+
+	return vid, grantee, true
+}
+
+type AddCertificationDeclarationRequest struct {
+	BaseReq           restTypes.BaseReq       `json:"base_req"`
+	CertificationType types.CertificationType `json:"certification_type"`
+	Content           []byte                  `json:"content,omitempty"` // base64 encoded
+	ContentHash       string                  `json:"content_hash,omitempty"`
+	URL               string                  `json:"url,omitempty"`
+}
+
+func addCertificationDeclarationHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vid, pid, softwareVersion, ok := parseCertificationDeclarationVars(restCtx)
+		if !ok {
+			return
+		}
+
+		var req AddCertificationDeclarationRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgAddCertificationDeclaration(vid, pid, softwareVersion, req.CertificationType,
+			req.Content, req.ContentHash, req.URL, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func parseCertificationDeclarationVars(restCtx rest.RestContext) (uint16, uint16, uint32, bool) {
+	vars := restCtx.Variables()
+
+	vid, err := conversions.ParseVID(vars[vid])
+	if err != nil {
+		restCtx.WriteError(http.StatusBadRequest, err)
+
+		return 0, 0, 0, false
+	}
+
+	pid, err := conversions.ParsePID(vars[pid])
+	if err != nil {
+		restCtx.WriteError(http.StatusBadRequest, err)
+
+		return 0, 0, 0, false
+	}
+
+	softwareVersion, err := conversions.ParseUInt32FromString(vars[softwareVersion])
+	if err != nil {
+		restCtx.WriteError(http.StatusBadRequest, err)
+
+		return 0, 0, 0, false
+	}
+
+	return vid, pid, softwareVersion, true
+}
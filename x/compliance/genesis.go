@@ -23,14 +23,34 @@ import (
 )
 
 type GenesisState struct {
-	ComplianceInfoRecords []ComplianceInfo `json:"compliance_model_records"`
+	ComplianceInfoRecords     []ComplianceInfo           `json:"compliance_model_records"`
+	ComplianceAuthorityGrants []ComplianceAuthorityGrant `json:"compliance_authority_grants,omitempty"`
+	CertificationDeclarations []CertificationDeclaration `json:"certification_declarations,omitempty"`
+	Params                    *Params                    `json:"params,omitempty"` // default params are used if not set
 }
 
 func NewGenesisState() GenesisState {
-	return GenesisState{ComplianceInfoRecords: []ComplianceInfo{}}
+	params := DefaultParams()
+
+	return GenesisState{
+		ComplianceInfoRecords:     []ComplianceInfo{},
+		ComplianceAuthorityGrants: []ComplianceAuthorityGrant{},
+		CertificationDeclarations: []CertificationDeclaration{},
+		Params:                    &params,
+	}
 }
 
 func ValidateGenesis(data GenesisState) error {
+	params := DefaultParams()
+
+	if data.Params != nil {
+		if err := data.Params.Validate(); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Params: %v", err))
+		}
+
+		params = *data.Params
+	}
+
 	for _, record := range data.ComplianceInfoRecords {
 		if record.VID == 0 {
 			return sdk.ErrUnknownRequest(
@@ -54,11 +74,49 @@ func ValidateGenesis(data GenesisState) error {
 			return sdk.ErrUnknownRequest("Invalid Date: it cannot be empty")
 		}
 
-		if record.CertificationType != "" && record.CertificationType != types.ZbCertificationType {
+		if record.IsExpiring() && !record.ExpirationDate.After(record.Date) {
+			return sdk.ErrUnknownRequest(
+				fmt.Sprintf("Invalid CertifiedModelRecord: value: %d."+
+					" Error: Invalid ExpirationDate: it must be after Date", record.PID))
+		}
+
+		if record.CertificationType != "" && !params.IsCertificationTypeAllowed(record.CertificationType) {
 			return sdk.ErrUnknownRequest(
 				fmt.Sprintf("Invalid CertifiedModelRecord: value: %v."+
 					" Error: Invalid CertificationType: "+
-					"unknown type; supported types: [%s]", record.CertificationType, types.ZbCertificationType))
+					"unknown type; supported types: %v", record.CertificationType, params.CertificationTypes))
+		}
+	}
+
+	for _, grant := range data.ComplianceAuthorityGrants {
+		if grant.VID == 0 {
+			return sdk.ErrUnknownRequest("Invalid ComplianceAuthorityGrant: Invalid VID: it cannot be 0")
+		}
+
+		if grant.Grantee.Empty() || grant.Granter.Empty() {
+			return sdk.ErrUnknownRequest(
+				fmt.Sprintf("Invalid ComplianceAuthorityGrant: vid: %d. "+
+					"Error: Invalid Grantee or Granter: it cannot be empty", grant.VID))
+		}
+
+		if grant.ExpirationDate.IsZero() {
+			return sdk.ErrUnknownRequest(
+				fmt.Sprintf("Invalid ComplianceAuthorityGrant: vid: %d. "+
+					"Error: Invalid ExpirationDate: it cannot be empty", grant.VID))
+		}
+	}
+
+	for _, declaration := range data.CertificationDeclarations {
+		if declaration.VID == 0 || declaration.PID == 0 {
+			return sdk.ErrUnknownRequest(
+				fmt.Sprintf("Invalid CertificationDeclaration: vid: %d, pid: %d. "+
+					"Error: Invalid VID or PID: it cannot be 0", declaration.VID, declaration.PID))
+		}
+
+		if len(declaration.ContentHash) == 0 {
+			return sdk.ErrUnknownRequest(
+				fmt.Sprintf("Invalid CertificationDeclaration: vid: %d, pid: %d. "+
+					"Error: Invalid ContentHash: it cannot be empty", declaration.VID, declaration.PID))
 		}
 	}
 
@@ -70,10 +128,22 @@ func DefaultGenesisState() GenesisState {
 }
 
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.ValidatorUpdate {
+	if data.Params != nil {
+		keeper.SetParams(ctx, *data.Params)
+	}
+
 	for _, record := range data.ComplianceInfoRecords {
 		keeper.SetComplianceInfo(ctx, record)
 	}
 
+	for _, grant := range data.ComplianceAuthorityGrants {
+		keeper.SetComplianceAuthorityGrant(ctx, grant)
+	}
+
+	for _, declaration := range data.CertificationDeclarations {
+		keeper.SetCertificationDeclaration(ctx, declaration)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -86,5 +156,28 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		return false
 	})
 
-	return GenesisState{ComplianceInfoRecords: records}
+	var grants []ComplianceAuthorityGrant
+
+	k.IterateComplianceAuthorityGrants(ctx, func(grant types.ComplianceAuthorityGrant) (stop bool) {
+		grants = append(grants, grant)
+
+		return false
+	})
+
+	var declarations []CertificationDeclaration
+
+	k.IterateCertificationDeclarations(ctx, func(declaration types.CertificationDeclaration) (stop bool) {
+		declarations = append(declarations, declaration)
+
+		return false
+	})
+
+	params := k.GetParams(ctx)
+
+	return GenesisState{
+		ComplianceInfoRecords:     records,
+		ComplianceAuthorityGrants: grants,
+		CertificationDeclarations: declarations,
+		Params:                    &params,
+	}
 }
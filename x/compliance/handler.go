@@ -26,6 +26,20 @@ import (
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
 )
 
+func init() {
+	// sender must have ZBCertificationCenter role to certify/revoke model of any certification type
+	auth.RegisterMsgRoles(types.MsgCertifyModel{}, auth.ZBCertificationCenter)
+	auth.RegisterMsgRoles(types.MsgRevokeModel{}, auth.ZBCertificationCenter)
+	auth.RegisterMsgRoles(types.MsgProvisionModel{}, auth.ZBCertificationCenter)
+
+	// sender must have ZBCertificationCenter role to delegate compliance authority
+	auth.RegisterMsgRoles(types.MsgGrantComplianceAuthority{}, auth.ZBCertificationCenter)
+	auth.RegisterMsgRoles(types.MsgRevokeComplianceAuthority{}, auth.ZBCertificationCenter)
+
+	// sender must have ZBCertificationCenter role (or a compliance authority grant) to add certification declaration
+	auth.RegisterMsgRoles(types.MsgAddCertificationDeclaration{}, auth.ZBCertificationCenter)
+}
+
 func NewHandler(keeper keeper.Keeper, modelinfoKeeper modelinfo.Keeper,
 	compliancetestKeeper compliancetest.Keeper, authKeeper auth.Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
@@ -34,6 +48,14 @@ func NewHandler(keeper keeper.Keeper, modelinfoKeeper modelinfo.Keeper,
 			return handleMsgCertifyModel(ctx, keeper, modelinfoKeeper, compliancetestKeeper, authKeeper, msg)
 		case types.MsgRevokeModel:
 			return handleMsgRevokeModel(ctx, keeper, modelinfoKeeper, authKeeper, msg)
+		case types.MsgProvisionModel:
+			return handleMsgProvisionModel(ctx, keeper, modelinfoKeeper, compliancetestKeeper, authKeeper, msg)
+		case types.MsgGrantComplianceAuthority:
+			return handleMsgGrantComplianceAuthority(ctx, keeper, authKeeper, msg)
+		case types.MsgRevokeComplianceAuthority:
+			return handleMsgRevokeComplianceAuthority(ctx, keeper, authKeeper, msg)
+		case types.MsgAddCertificationDeclaration:
+			return handleMsgAddCertificationDeclaration(ctx, keeper, modelinfoKeeper, authKeeper, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized nameservice Msg type: %v", msg.Type())
 
@@ -46,14 +68,20 @@ func handleMsgCertifyModel(ctx sdk.Context, keeper keeper.Keeper, modelinfoKeepe
 	compliancetestKeeper compliancetest.Keeper, authKeeper auth.Keeper,
 	msg types.MsgCertifyModel) sdk.Result {
 	// check if sender has enough rights to certify model
-	if err := checkZbCertificationRights(ctx, authKeeper, msg.Signer, msg.CertificationType); err != nil {
+	if err := checkCertificationRights(ctx, keeper, authKeeper, msg, msg.VID, msg.CertificationType); err != nil {
 		return err.Result()
 	}
 
-	if err := checkZbCertificationDone(ctx, keeper, authKeeper, msg.Signer, msg); err != nil {
+	if err := checkCertificationDone(ctx, keeper, authKeeper, msg.Signer, msg); err != nil {
 		return err.Result()
 	}
 
+	if types.IsExpirationDateSet(msg.ExpirationDate) && !msg.ExpirationDate.After(ctx.BlockTime()) {
+		return types.ErrInconsistentDates(
+			fmt.Sprintf("The `expiration_date`:%v must be after the current block time:%v to "+
+				"certify model", msg.ExpirationDate, ctx.BlockTime())).Result()
+	}
+
 	var complianceInfo types.ComplianceInfo
 
 	// nolint:nestif
@@ -63,17 +91,25 @@ func handleMsgCertifyModel(ctx sdk.Context, keeper keeper.Keeper, modelinfoKeepe
 		//The corresponding Model Info and test results are not required to be on the ledger.
 		// 2) Compliance is tracked on ledger. We want to certify revoked compliance.
 		//`Else` branch was passed on first certification. So Model Info and test results are exists on the ledger.
+		// 3) The model is provisionally approved. We want to certify it once the final paperwork is done.
 		complianceInfo = keeper.GetComplianceInfo(ctx, msg.CertificationType, msg.VID, msg.PID)
 
-		// if state changes on `certified` check that certification_date is after revocation_date
-		if complianceInfo.State == types.Revoked {
+		// the compliance info must not have been changed since the sender read it
+		if msg.ExpectedSequence != complianceInfo.Sequence {
+			return types.ErrSequenceConflict(msg.VID, msg.PID, msg.ExpectedSequence, complianceInfo.Sequence).Result()
+		}
+
+		// if state changes on `certified` check that certification_date is after revocation (expiration) date
+		if complianceInfo.State != types.Certified {
 			if msg.CertificationDate.Before(complianceInfo.Date) {
 				return types.ErrInconsistentDates(
 					fmt.Sprintf("The `certification_date`:%v must be after the current `date`:%v to "+
 						"certify model", msg.CertificationDate, complianceInfo.Date)).Result()
 			}
 
-			complianceInfo.UpdateComplianceInfo(msg.CertificationDate, msg.Reason)
+			complianceInfo.UpdateComplianceInfo(msg.CertificationDate, "", msg.Reason)
+			complianceInfo.CDCertificateID = msg.CDCertificateID
+			setExpirationDate(&complianceInfo, msg)
 		}
 	} else {
 		// Compliance is tracked on ledger. There is no compliance record yet.
@@ -94,6 +130,8 @@ func handleMsgCertifyModel(ctx sdk.Context, keeper keeper.Keeper, modelinfoKeepe
 			msg.Reason,
 			msg.Signer,
 		)
+		complianceInfo.CDCertificateID = msg.CDCertificateID
+		setExpirationDate(&complianceInfo, msg)
 	}
 
 	// store compliance info
@@ -102,10 +140,16 @@ func handleMsgCertifyModel(ctx sdk.Context, keeper keeper.Keeper, modelinfoKeepe
 	return sdk.Result{}
 }
 
+func setExpirationDate(complianceInfo *types.ComplianceInfo, msg types.MsgCertifyModel) {
+	if types.IsExpirationDateSet(msg.ExpirationDate) {
+		complianceInfo.ExpirationDate = msg.ExpirationDate
+	}
+}
+
 func handleMsgRevokeModel(ctx sdk.Context, keeper keeper.Keeper, modelinfoKeeper modelinfo.Keeper,
 	authKeeper auth.Keeper, msg types.MsgRevokeModel) sdk.Result {
 	// check if sender has enough rights to revoke model
-	if err := checkZbCertificationRights(ctx, authKeeper, msg.Signer, msg.CertificationType); err != nil {
+	if err := checkCertificationRights(ctx, keeper, authKeeper, msg, msg.VID, msg.CertificationType); err != nil {
 		return err.Result()
 	}
 
@@ -116,15 +160,20 @@ func handleMsgRevokeModel(ctx sdk.Context, keeper keeper.Keeper, modelinfoKeeper
 		// Compliance record already exist.
 		complianceInfo = keeper.GetComplianceInfo(ctx, msg.CertificationType, msg.VID, msg.PID)
 
-		// if state changes on `revoked` check that revocation_date is after certification_date
-		if complianceInfo.State == types.Certified {
+		// the compliance info must not have been changed since the sender read it
+		if msg.ExpectedSequence != complianceInfo.Sequence {
+			return types.ErrSequenceConflict(msg.VID, msg.PID, msg.ExpectedSequence, complianceInfo.Sequence).Result()
+		}
+
+		// if state changes on `revoked` check that revocation_date is after certification (provisional) date
+		if complianceInfo.State == types.Certified || complianceInfo.State == types.Provisional {
 			if msg.RevocationDate.Before(complianceInfo.Date) {
 				return types.ErrInconsistentDates(
-					fmt.Sprintf("The `revocation_date`:%v must be after the `certification_date`:%v to "+
+					fmt.Sprintf("The `revocation_date`:%v must be after the current `date`:%v to "+
 						"revoke model", msg.RevocationDate, complianceInfo.Date)).Result()
 			}
 
-			complianceInfo.UpdateComplianceInfo(msg.RevocationDate, msg.Reason)
+			complianceInfo.ChangeState(types.Revoked, msg.RevocationDate, msg.ReasonCode, msg.Reason)
 		}
 	} else if modelinfoKeeper.IsModelInfoPresent(ctx, msg.VID, msg.PID) {
 		// Only revocation is tracked on the ledger. There is no compliance record yet.
@@ -134,6 +183,7 @@ func handleMsgRevokeModel(ctx sdk.Context, keeper keeper.Keeper, modelinfoKeeper
 			msg.PID,
 			msg.CertificationType,
 			msg.RevocationDate,
+			msg.ReasonCode,
 			msg.Reason,
 			msg.Signer,
 		)
@@ -147,24 +197,142 @@ func handleMsgRevokeModel(ctx sdk.Context, keeper keeper.Keeper, modelinfoKeeper
 	return sdk.Result{}
 }
 
-func checkZbCertificationRights(ctx sdk.Context, authKeeper auth.Keeper, signer sdk.AccAddress,
-	certificationType types.CertificationType) sdk.Error {
-	// rights are depend on certification type
-	if certificationType == types.ZbCertificationType {
-		// sender must have ZBCertificationCenter role to certify/revoke model
-		if !authKeeper.HasRole(ctx, signer, auth.ZBCertificationCenter) {
-			return sdk.ErrUnauthorized(fmt.Sprintf("MsgCertifyModel/MsgRevokeMode transaction should be "+
-				"signed by an account with the %s role", auth.ZBCertificationCenter))
+func handleMsgProvisionModel(ctx sdk.Context, keeper keeper.Keeper, modelinfoKeeper modelinfo.Keeper,
+	compliancetestKeeper compliancetest.Keeper, authKeeper auth.Keeper,
+	msg types.MsgProvisionModel) sdk.Result {
+	// check if sender has enough rights to provision model
+	if err := checkCertificationRights(ctx, keeper, authKeeper, msg, msg.VID, msg.CertificationType); err != nil {
+		return err.Result()
+	}
+
+	// the provisional approval precedes the certification, so there must be no compliance record yet
+	if keeper.IsComplianceInfoPresent(ctx, msg.CertificationType, msg.VID, msg.PID) {
+		return types.ErrComplianceInfoAlreadyExists(msg.VID, msg.PID, msg.CertificationType).Result()
+	}
+
+	// The corresponding Model Info and test results must be present on ledger.
+	if !modelinfoKeeper.IsModelInfoPresent(ctx, msg.VID, msg.PID) {
+		return modelinfo.ErrModelInfoDoesNotExist(msg.VID, msg.PID).Result()
+	}
+
+	if !compliancetestKeeper.IsTestingResultsPresents(ctx, msg.VID, msg.PID) {
+		return compliancetest.ErrTestingResultDoesNotExist(msg.VID, msg.PID).Result()
+	}
+
+	complianceInfo := types.NewProvisionalComplianceInfo(
+		msg.VID,
+		msg.PID,
+		msg.CertificationType,
+		msg.ProvisionalDate,
+		msg.Reason,
+		msg.Signer,
+	)
+
+	// store compliance info
+	keeper.SetComplianceInfo(ctx, complianceInfo)
+
+	return sdk.Result{}
+}
+
+func handleMsgGrantComplianceAuthority(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgGrantComplianceAuthority) sdk.Result {
+	// check if sender has enough rights to delegate compliance authority
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	if !authKeeper.IsAccountPresent(ctx, msg.Grantee) {
+		return auth.ErrAccountDoesNotExist(msg.Grantee).Result()
+	}
+
+	if !msg.ExpirationDate.After(ctx.BlockTime()) {
+		return types.ErrInconsistentDates(
+			fmt.Sprintf("The `expiration_date`:%v must be after the current block time:%v to "+
+				"grant compliance authority", msg.ExpirationDate, ctx.BlockTime())).Result()
+	}
+
+	// an existing grant for the same VID and grantee is replaced
+	grant := types.NewComplianceAuthorityGrant(msg.VID, msg.Grantee, msg.Signer, msg.ExpirationDate)
+
+	keeper.SetComplianceAuthorityGrant(ctx, grant)
+
+	return sdk.Result{}
+}
+
+func handleMsgRevokeComplianceAuthority(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgRevokeComplianceAuthority) sdk.Result {
+	// check if sender has enough rights to revoke compliance authority
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	if !keeper.IsComplianceAuthorityGrantPresent(ctx, msg.VID, msg.Grantee) {
+		return types.ErrComplianceAuthorityGrantDoesNotExist(msg.VID, msg.Grantee).Result()
+	}
+
+	keeper.DeleteComplianceAuthorityGrant(ctx, msg.VID, msg.Grantee)
+
+	return sdk.Result{}
+}
+
+func handleMsgAddCertificationDeclaration(ctx sdk.Context, keeper keeper.Keeper, modelinfoKeeper modelinfo.Keeper,
+	authKeeper auth.Keeper, msg types.MsgAddCertificationDeclaration) sdk.Result {
+	// check if sender has enough rights to add certification declaration
+	if err := checkCertificationRights(ctx, keeper, authKeeper, msg, msg.VID, msg.CertificationType); err != nil {
+		return err.Result()
+	}
+
+	if !modelinfoKeeper.IsModelVersionPresent(ctx, msg.VID, msg.PID, msg.SoftwareVersion) {
+		return modelinfo.ErrModelVersionDoesNotExist(msg.VID, msg.PID, msg.SoftwareVersion).Result()
+	}
+
+	// the declaration is linked to the compliance info of the model which must be certified
+	if !keeper.IsComplianceInfoPresent(ctx, msg.CertificationType, msg.VID, msg.PID) {
+		return types.ErrComplianceInfoDoesNotExist(msg.VID, msg.PID, msg.CertificationType).Result()
+	}
+
+	if keeper.GetComplianceInfo(ctx, msg.CertificationType, msg.VID, msg.PID).State != types.Certified {
+		return types.ErrModelNotCertified(msg.VID, msg.PID, msg.CertificationType).Result()
+	}
+
+	// an existing declaration of the model version is replaced
+	declaration := types.NewCertificationDeclaration(msg.VID, msg.PID, msg.SoftwareVersion, msg.CertificationType,
+		msg.Content, msg.ContentHash, msg.URL, ctx.BlockTime(), msg.Signer)
+
+	keeper.SetCertificationDeclaration(ctx, declaration)
+
+	return sdk.Result{}
+}
+
+func checkCertificationRights(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper, msg sdk.Msg,
+	vid uint16, certificationType types.CertificationType) sdk.Error {
+	if params := keeper.GetParams(ctx); !params.IsCertificationTypeAllowed(certificationType) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Unexpected CertificationType: \"%s\". Supported types: %v",
+			certificationType, params.CertificationTypes))
+	}
+
+	err := authKeeper.CheckMsgRoles(ctx, msg)
+	if err == nil {
+		return nil
+	}
+
+	// a sender without the role can still act on the VID while holding an active grant
+	// issued by an account which still is a certification center
+	for _, signer := range msg.GetSigners() {
+		if !keeper.HasActiveComplianceAuthorityGrant(ctx, vid, signer) {
+			return err
+		}
+
+		grant := keeper.GetComplianceAuthorityGrant(ctx, vid, signer)
+		if !authKeeper.HasRole(ctx, grant.Granter, auth.ZBCertificationCenter) {
+			return err
 		}
-	} else {
-		return sdk.ErrUnknownRequest(fmt.Sprintf("Unexpected CertificationType: \"%s\". Supported types: [%s]",
-			certificationType, ZbCertificationType))
 	}
 
 	return nil
 }
 
-func checkZbCertificationDone(
+func checkCertificationDone(
 	ctx sdk.Context,
 	keeper keeper.Keeper,
 	authKeeper auth.Keeper,
@@ -53,6 +53,23 @@ func TestHandler_CertifyModel(t *testing.T) {
 	require.True(t, certified)
 }
 
+func TestHandler_CertifyModelWithCDCertificateID(t *testing.T) {
+	setup := Setup()
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// certify model with the ID of the Certification Declaration
+	certifyModelMsg := msgCertifyModel(setup.CertificationCenter, vid, pid)
+	certifyModelMsg.CDCertificateID = "ZIG20142ZB330003-24"
+	result := setup.Handler(setup.Ctx, certifyModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedComplianceInfo, _ := queryComplianceInfo(setup, vid, pid)
+	require.Equal(t, certifyModelMsg.CDCertificateID, receivedComplianceInfo.CDCertificateID)
+}
+
 func TestHandler_CertifyModelByDifferentRoles(t *testing.T) {
 	setup := Setup()
 
@@ -73,7 +90,7 @@ func TestHandler_CertifyModelByDifferentRoles(t *testing.T) {
 		// try to certify model
 		certifyModelMsg := msgCertifyModel(address, vid, pid)
 		result := setup.Handler(setup.Ctx, certifyModelMsg)
-		require.Equal(t, sdk.CodeUnauthorized, result.Code)
+		require.Equal(t, auth.CodeMissingRole, result.Code)
 	}
 }
 
@@ -156,7 +173,7 @@ func TestHandler_CertifyModelForEmptyCertificationType(t *testing.T) {
 	require.Equal(t, sdk.CodeUnknownRequest, result.Code)
 }
 
-func TestHandler_CertifyModelForNotZbCertificationType(t *testing.T) {
+func TestHandler_CertifyModelForUnknownCertificationType(t *testing.T) {
 	setup := Setup()
 
 	// add model amd testing result
@@ -243,7 +260,7 @@ func TestHandler_RevokeModelByDifferentRoles(t *testing.T) {
 		// try to certify model
 		revokeModelMsg := msgRevokedModel(address, constants.VID, constants.PID)
 		result := setup.Handler(setup.Ctx, revokeModelMsg)
-		require.Equal(t, sdk.CodeUnauthorized, result.Code)
+		require.Equal(t, auth.CodeMissingRole, result.Code)
 	}
 }
 
@@ -363,6 +380,7 @@ func TestHandler_CertifyRevokedModel(t *testing.T) {
 	// certify model again
 	secondCertifyModelMsg := msgCertifyModel(setup.CertificationCenter, vid, pid)
 	secondCertifyModelMsg.CertificationDate = time.Now().UTC()
+	secondCertifyModelMsg.ExpectedSequence = 1
 	result = setup.Handler(setup.Ctx, secondCertifyModelMsg)
 	require.Equal(t, sdk.CodeOK, result.Code)
 
@@ -384,6 +402,206 @@ func TestHandler_CertifyRevokedModel(t *testing.T) {
 	require.Equal(t, types.CodeComplianceInfoDoesNotExist, err.Code())
 }
 
+func TestHandler_CertifyModelWithExpirationDate(t *testing.T) {
+	setup := Setup()
+	setup.Ctx = setup.Ctx.WithBlockTime(constants.CertificationDate)
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// certify model with the expiration date in the past
+	certifyModelMsg := msgCertifyModel(setup.CertificationCenter, vid, pid)
+	certifyModelMsg.ExpirationDate = constants.CertificationDate
+	result := setup.Handler(setup.Ctx, certifyModelMsg)
+	require.Equal(t, types.CodeInconsistentDates, result.Code)
+
+	// certify model expiring in a year
+	certifyModelMsg.ExpirationDate = constants.CertificationDate.AddDate(1, 0, 0)
+	result = setup.Handler(setup.Ctx, certifyModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedComplianceInfo, _ := queryComplianceInfo(setup, vid, pid)
+	checkCertifiedModel(t, receivedComplianceInfo, certifyModelMsg)
+	require.Equal(t, certifyModelMsg.ExpirationDate, receivedComplianceInfo.ExpirationDate)
+
+	// the certification expires
+	setup.Ctx = setup.Ctx.WithBlockTime(certifyModelMsg.ExpirationDate)
+	setup.CompliancetKeeper.EndBlocker(setup.Ctx)
+
+	receivedComplianceInfo, _ = queryComplianceInfo(setup, vid, pid)
+	require.Equal(t, types.Expired, receivedComplianceInfo.State)
+	require.Equal(t, certifyModelMsg.ExpirationDate, receivedComplianceInfo.Date)
+
+	certified, _ := queryCertifiedModel(setup, vid, pid)
+	require.False(t, certified)
+
+	// the expired model can not be revoked
+	revokedModelMsg := msgRevokedModel(setup.CertificationCenter, vid, pid)
+	revokedModelMsg.RevocationDate = certifyModelMsg.ExpirationDate.Add(time.Hour)
+	result = setup.Handler(setup.Ctx, revokedModelMsg)
+	require.NotEqual(t, sdk.CodeOK, result.Code)
+
+	// certify model again without the expiration date
+	secondCertifyModelMsg := msgCertifyModel(setup.CertificationCenter, vid, pid)
+	secondCertifyModelMsg.CertificationDate = certifyModelMsg.ExpirationDate.Add(time.Hour)
+	secondCertifyModelMsg.ExpectedSequence = 1
+	result = setup.Handler(setup.Ctx, secondCertifyModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedComplianceInfo, _ = queryComplianceInfo(setup, vid, pid)
+	checkCertifiedModel(t, receivedComplianceInfo, secondCertifyModelMsg)
+	require.True(t, receivedComplianceInfo.ExpirationDate.IsZero())
+	require.Equal(t, 2, len(receivedComplianceInfo.History))
+	require.Equal(t, types.Expired, receivedComplianceInfo.History[1].State)
+}
+
+func TestHandler_ProvisionModel(t *testing.T) {
+	setup := Setup()
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// provision model
+	provisionModelMsg := msgProvisionModel(setup.CertificationCenter, vid, pid)
+	result := setup.Handler(setup.Ctx, provisionModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query provisional model
+	receivedComplianceInfo, _ := queryComplianceInfo(setup, vid, pid)
+	require.Equal(t, types.Provisional, receivedComplianceInfo.State)
+	require.Equal(t, provisionModelMsg.ProvisionalDate, receivedComplianceInfo.Date)
+	require.Equal(t, provisionModelMsg.Reason, receivedComplianceInfo.Reason)
+	require.Equal(t, uint64(0), receivedComplianceInfo.Sequence)
+
+	provisional, _ := queryProvisionalModel(setup, vid, pid)
+	require.True(t, provisional)
+
+	_, err := queryCertifiedModel(setup, vid, pid)
+	require.Equal(t, types.CodeComplianceInfoDoesNotExist, err.Code())
+
+	// provision model again
+	result = setup.Handler(setup.Ctx, provisionModelMsg)
+	require.Equal(t, types.CodeComplianceInfoAlreadyExists, result.Code)
+}
+
+func TestHandler_ProvisionModelByDifferentRoles(t *testing.T) {
+	setup := Setup()
+
+	cases := []auth.AccountRole{
+		auth.Vendor,
+		auth.TestHouse,
+	}
+
+	for _, tc := range cases {
+		address := constants.Address2
+		account := auth.NewAccount(address, constants.PubKey1, auth.AccountRoles{tc})
+		setup.authKeeper.SetAccount(setup.Ctx, account)
+
+		// try to provision model
+		provisionModelMsg := msgProvisionModel(address, constants.VID, constants.PID)
+		result := setup.Handler(setup.Ctx, provisionModelMsg)
+		require.Equal(t, auth.CodeMissingRole, result.Code)
+	}
+}
+
+func TestHandler_ProvisionModelForUnknownModel(t *testing.T) {
+	setup := Setup()
+
+	// try to provision unknown model
+	provisionModelMsg := msgProvisionModel(setup.CertificationCenter, constants.VID, constants.PID)
+	result := setup.Handler(setup.Ctx, provisionModelMsg)
+	require.Equal(t, modelinfo.CodeModelInfoDoesNotExist, result.Code)
+
+	// try to provision model without testing results
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+
+	provisionModelMsg = msgProvisionModel(setup.CertificationCenter, vid, pid)
+	result = setup.Handler(setup.Ctx, provisionModelMsg)
+	require.Equal(t, compliancetest.CodeTestingResultDoesNotExist, result.Code)
+}
+
+func TestHandler_ProvisionCertifiedModel(t *testing.T) {
+	setup := Setup()
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// certify model
+	certifyModelMsg := msgCertifyModel(setup.CertificationCenter, vid, pid)
+	result := setup.Handler(setup.Ctx, certifyModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// try to provision certified model
+	provisionModelMsg := msgProvisionModel(setup.CertificationCenter, vid, pid)
+	result = setup.Handler(setup.Ctx, provisionModelMsg)
+	require.Equal(t, types.CodeComplianceInfoAlreadyExists, result.Code)
+}
+
+func TestHandler_CertifyProvisionalModel(t *testing.T) {
+	setup := Setup()
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// provision model
+	provisionModelMsg := msgProvisionModel(setup.CertificationCenter, vid, pid)
+	result := setup.Handler(setup.Ctx, provisionModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// try to certify model before the provisional date
+	certifyModelMsg := msgCertifyModel(setup.CertificationCenter, vid, pid)
+	certifyModelMsg.CertificationDate = provisionModelMsg.ProvisionalDate.Add(-time.Hour)
+	result = setup.Handler(setup.Ctx, certifyModelMsg)
+	require.Equal(t, types.CodeInconsistentDates, result.Code)
+
+	// certify model
+	certifyModelMsg.CertificationDate = provisionModelMsg.ProvisionalDate.Add(time.Hour)
+	result = setup.Handler(setup.Ctx, certifyModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query certified model
+	receivedComplianceInfo, _ := queryComplianceInfo(setup, vid, pid)
+	checkCertifiedModel(t, receivedComplianceInfo, certifyModelMsg)
+	require.Equal(t, uint64(1), receivedComplianceInfo.Sequence)
+	require.Equal(t, 1, len(receivedComplianceInfo.History))
+	require.Equal(t, types.Provisional, receivedComplianceInfo.History[0].State)
+	require.Equal(t, provisionModelMsg.ProvisionalDate, receivedComplianceInfo.History[0].Date)
+
+	_, err := queryProvisionalModel(setup, vid, pid)
+	require.Equal(t, types.CodeComplianceInfoDoesNotExist, err.Code())
+}
+
+func TestHandler_RevokeProvisionalModel(t *testing.T) {
+	setup := Setup()
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// provision model
+	provisionModelMsg := msgProvisionModel(setup.CertificationCenter, vid, pid)
+	result := setup.Handler(setup.Ctx, provisionModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// revoke model
+	revokeModelMsg := msgRevokedModel(setup.CertificationCenter, vid, pid)
+	result = setup.Handler(setup.Ctx, revokeModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query revoked model
+	receivedComplianceInfo, _ := queryComplianceInfo(setup, vid, pid)
+	checkRevokedModel(t, receivedComplianceInfo, revokeModelMsg)
+	require.Equal(t, 1, len(receivedComplianceInfo.History))
+	require.Equal(t, types.Provisional, receivedComplianceInfo.History[0].State)
+
+	revoked, _ := queryRevokedModel(setup, vid, pid)
+	require.True(t, revoked)
+}
+
 func TestHandler_CertifyRevokedModelForTrackRevocationStrategy(t *testing.T) {
 	setup := Setup()
 
@@ -413,7 +631,7 @@ func TestHandler_CertifyRevokedModelForTrackRevocationStrategy(t *testing.T) {
 	require.Equal(t, sdk.CodeOK, result.Code)
 }
 
-func TestHandler_CheckZbCertificationDone(t *testing.T) {
+func TestHandler_CheckCertificationDone(t *testing.T) {
 	setup := Setup()
 
 	// add model amd testing result
@@ -434,6 +652,494 @@ func TestHandler_CheckZbCertificationDone(t *testing.T) {
 	require.Equal(t, types.CodeAlreadyCertifyed, result.Code)
 }
 
+func TestHandler_CertifyRevokedModelWithOutdatedSequence(t *testing.T) {
+	setup := Setup()
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// certify model
+	certifyModelMsg := msgCertifyModel(setup.CertificationCenter, vid, pid)
+	result := setup.Handler(setup.Ctx, certifyModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// revoke model
+	revokedModelMsg := msgRevokedModel(setup.CertificationCenter, vid, pid)
+	revokedModelMsg.RevocationDate = certifyModelMsg.CertificationDate.AddDate(0, 0, 1)
+	result = setup.Handler(setup.Ctx, revokedModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedComplianceInfo, _ := queryComplianceInfo(setup, vid, pid)
+	require.Equal(t, uint64(1), receivedComplianceInfo.Sequence)
+
+	// certify model based on the sequence read before revocation
+	secondCertifyModelMsg := msgCertifyModel(setup.CertificationCenter, vid, pid)
+	secondCertifyModelMsg.CertificationDate = revokedModelMsg.RevocationDate.AddDate(0, 0, 1)
+	result = setup.Handler(setup.Ctx, secondCertifyModelMsg)
+	require.Equal(t, types.CodeSequenceConflict, result.Code)
+
+	// revoke model based on the outdated sequence
+	result = setup.Handler(setup.Ctx, revokedModelMsg)
+	require.Equal(t, types.CodeSequenceConflict, result.Code)
+
+	// certify model based on the actual sequence
+	secondCertifyModelMsg.ExpectedSequence = 1
+	result = setup.Handler(setup.Ctx, secondCertifyModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedComplianceInfo, _ = queryComplianceInfo(setup, vid, pid)
+	require.Equal(t, types.Certified, receivedComplianceInfo.State)
+	require.Equal(t, uint64(2), receivedComplianceInfo.Sequence)
+}
+
+func TestHandler_ComplianceHistory(t *testing.T) {
+	setup := Setup()
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// certify model
+	certifyModelMsg := msgCertifyModel(setup.CertificationCenter, vid, pid)
+	result := setup.Handler(setup.Ctx, certifyModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// revoke model
+	revokedModelMsg := msgRevokedModel(setup.CertificationCenter, vid, pid)
+	revokedModelMsg.RevocationDate = certifyModelMsg.CertificationDate.AddDate(0, 0, 1)
+	result = setup.Handler(setup.Ctx, revokedModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// certify model again
+	secondCertifyModelMsg := msgCertifyModel(setup.CertificationCenter, vid, pid)
+	secondCertifyModelMsg.CertificationDate = revokedModelMsg.RevocationDate.AddDate(0, 0, 1)
+	secondCertifyModelMsg.ExpectedSequence = 1
+	result = setup.Handler(setup.Ctx, secondCertifyModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query history
+	history, _ := queryComplianceHistory(setup, vid, pid)
+
+	// check
+	require.Equal(t, vid, history.VID)
+	require.Equal(t, pid, history.PID)
+	require.Equal(t, 3, len(history.Items))
+
+	require.Equal(t, types.Certified, history.Items[0].State)
+	require.Equal(t, certifyModelMsg.CertificationDate, history.Items[0].Date)
+	require.Empty(t, history.Items[0].ReasonCode)
+
+	require.Equal(t, types.Revoked, history.Items[1].State)
+	require.Equal(t, revokedModelMsg.RevocationDate, history.Items[1].Date)
+	require.Equal(t, revokedModelMsg.ReasonCode, history.Items[1].ReasonCode)
+	require.Equal(t, revokedModelMsg.Reason, history.Items[1].Reason)
+
+	require.Equal(t, types.Certified, history.Items[2].State)
+	require.Equal(t, secondCertifyModelMsg.CertificationDate, history.Items[2].Date)
+	require.Empty(t, history.Items[2].ReasonCode)
+}
+
+func TestHandler_ComplianceHistoryForUnknownModel(t *testing.T) {
+	setup := Setup()
+
+	// query history
+	_, err := queryComplianceHistory(setup, constants.VID, constants.PID)
+	require.Equal(t, types.CodeComplianceInfoDoesNotExist, err.Code())
+}
+
+func TestHandler_CertifyModelForDifferentCertificationTypes(t *testing.T) {
+	setup := Setup()
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// certify model for zb
+	certifyModelMsg := msgCertifyModel(setup.CertificationCenter, vid, pid)
+	result := setup.Handler(setup.Ctx, certifyModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// revoke model for matter
+	revokedModelMsg := msgRevokedModel(setup.CertificationCenter, vid, pid)
+	revokedModelMsg.CertificationType = types.MatterCertificationType
+	result = setup.Handler(setup.Ctx, revokedModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// certify model for thread
+	threadCertifyModelMsg := msgCertifyModel(setup.CertificationCenter, vid, pid)
+	threadCertifyModelMsg.CertificationType = types.ThreadCertificationType
+	result = setup.Handler(setup.Ctx, threadCertifyModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// zb compliance info is not affected by the matter revocation
+	receivedComplianceInfo, _ := queryComplianceInfo(setup, vid, pid)
+	checkCertifiedModel(t, receivedComplianceInfo, certifyModelMsg)
+
+	// query all compliance info records for the model
+	complianceInfos, _ := queryModelComplianceInfos(setup, vid, pid)
+	require.Equal(t, 3, complianceInfos.Total)
+
+	states := make(map[types.CertificationType]types.ComplianceState)
+	for _, complianceInfo := range complianceInfos.Items {
+		states[complianceInfo.CertificationType] = complianceInfo.State
+	}
+
+	require.Equal(t, types.Certified, states[types.ZbCertificationType])
+	require.Equal(t, types.Revoked, states[types.MatterCertificationType])
+	require.Equal(t, types.Certified, states[types.ThreadCertificationType])
+}
+
+func TestHandler_CertifyModelForNotAllowedCertificationType(t *testing.T) {
+	setup := Setup()
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// allow only zb and custom certification types
+	params := types.NewParams([]types.CertificationType{types.ZbCertificationType, "custom"})
+	setup.CompliancetKeeper.SetParams(setup.Ctx, params)
+
+	// certify model for matter
+	certifyModelMsg := msgCertifyModel(setup.CertificationCenter, vid, pid)
+	certifyModelMsg.CertificationType = types.MatterCertificationType
+	result := setup.Handler(setup.Ctx, certifyModelMsg)
+	require.Equal(t, sdk.CodeUnknownRequest, result.Code)
+
+	// certify model for custom
+	certifyModelMsg.CertificationType = "custom"
+	result = setup.Handler(setup.Ctx, certifyModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	complianceInfos, _ := queryModelComplianceInfos(setup, vid, pid)
+	require.Equal(t, 1, complianceInfos.Total)
+	require.Equal(t, types.CertificationType("custom"), complianceInfos.Items[0].CertificationType)
+}
+
+func TestHandler_QueryModelComplianceInfosForUnknownModel(t *testing.T) {
+	setup := Setup()
+
+	// query all compliance info records for the model
+	_, err := queryModelComplianceInfos(setup, constants.VID, constants.PID)
+	require.Equal(t, types.CodeComplianceInfoDoesNotExist, err.Code())
+}
+
+func TestHandler_GrantComplianceAuthority(t *testing.T) {
+	setup := Setup()
+	grantee := addGrantee(setup, auth.Vendor)
+
+	// grant compliance authority
+	grantMsg := msgGrantComplianceAuthority(setup.CertificationCenter, constants.VID, grantee)
+	result := setup.Handler(setup.Ctx, grantMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query grant
+	grant, err := queryComplianceAuthorityGrant(setup, constants.VID, grantee)
+	require.Nil(t, err)
+	require.Equal(t, grantMsg.VID, grant.VID)
+	require.Equal(t, grantMsg.Grantee, grant.Grantee)
+	require.Equal(t, grantMsg.Signer, grant.Granter)
+	require.Equal(t, grantMsg.ExpirationDate, grant.ExpirationDate)
+
+	// grant again with another expiration date replaces the grant
+	grantMsg.ExpirationDate = grantMsg.ExpirationDate.AddDate(1, 0, 0)
+	result = setup.Handler(setup.Ctx, grantMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	grant, _ = queryComplianceAuthorityGrant(setup, constants.VID, grantee)
+	require.Equal(t, grantMsg.ExpirationDate, grant.ExpirationDate)
+	require.Equal(t, 1, setup.CompliancetKeeper.CountTotalComplianceAuthorityGrants(setup.Ctx))
+}
+
+func TestHandler_GrantComplianceAuthorityByNonCertificationCenter(t *testing.T) {
+	setup := Setup()
+	grantee := addGrantee(setup, auth.Vendor)
+
+	for _, role := range []auth.AccountRole{auth.Vendor, auth.TestHouse, auth.Trustee} {
+		account := auth.NewAccount(constants.Address3, constants.PubKey3, auth.AccountRoles{role})
+		setup.authKeeper.SetAccount(setup.Ctx, account)
+
+		// try to grant compliance authority
+		grantMsg := msgGrantComplianceAuthority(constants.Address3, constants.VID, grantee)
+		result := setup.Handler(setup.Ctx, grantMsg)
+		require.Equal(t, auth.CodeMissingRole, result.Code)
+	}
+}
+
+func TestHandler_GrantComplianceAuthorityToUnknownAccount(t *testing.T) {
+	setup := Setup()
+
+	// try to grant compliance authority to account which does not exist
+	grantMsg := msgGrantComplianceAuthority(setup.CertificationCenter, constants.VID, constants.Address2)
+	result := setup.Handler(setup.Ctx, grantMsg)
+	require.Equal(t, auth.CodeAccountDoesNotExist, result.Code)
+}
+
+func TestHandler_GrantComplianceAuthorityWithPastExpirationDate(t *testing.T) {
+	setup := Setup()
+	grantee := addGrantee(setup, auth.Vendor)
+
+	// try to grant compliance authority which is already expired
+	grantMsg := msgGrantComplianceAuthority(setup.CertificationCenter, constants.VID, grantee)
+	ctx := setup.Ctx.WithBlockTime(grantMsg.ExpirationDate)
+	result := setup.Handler(ctx, grantMsg)
+	require.Equal(t, types.CodeInconsistentDates, result.Code)
+}
+
+func TestHandler_CertifyAndRevokeModelByGrantee(t *testing.T) {
+	setup := Setup()
+	grantee := addGrantee(setup, auth.Vendor)
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// grant compliance authority
+	result := setup.Handler(setup.Ctx, msgGrantComplianceAuthority(setup.CertificationCenter, vid, grantee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// certify model by grantee
+	certifyModelMsg := msgCertifyModel(grantee, vid, pid)
+	result = setup.Handler(setup.Ctx, certifyModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedComplianceInfo, _ := queryComplianceInfo(setup, vid, pid)
+	checkCertifiedModel(t, receivedComplianceInfo, certifyModelMsg)
+	require.Equal(t, grantee, receivedComplianceInfo.Owner)
+
+	// revoke model by grantee
+	revokeModelMsg := msgRevokedModel(grantee, vid, pid)
+	result = setup.Handler(setup.Ctx, revokeModelMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedComplianceInfo, _ = queryComplianceInfo(setup, vid, pid)
+	checkRevokedModel(t, receivedComplianceInfo, revokeModelMsg)
+}
+
+func TestHandler_CertifyModelByGranteeForAnotherVID(t *testing.T) {
+	setup := Setup()
+	grantee := addGrantee(setup, auth.Vendor)
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// grant compliance authority for another vendor
+	result := setup.Handler(setup.Ctx, msgGrantComplianceAuthority(setup.CertificationCenter, vid+1, grantee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// try to certify model by grantee
+	result = setup.Handler(setup.Ctx, msgCertifyModel(grantee, vid, pid))
+	require.Equal(t, auth.CodeMissingRole, result.Code)
+}
+
+func TestHandler_CertifyModelByGranteeAfterExpiration(t *testing.T) {
+	setup := Setup()
+	grantee := addGrantee(setup, auth.Vendor)
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// grant compliance authority
+	grantMsg := msgGrantComplianceAuthority(setup.CertificationCenter, vid, grantee)
+	result := setup.Handler(setup.Ctx, grantMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// try to certify model by grantee after the grant is expired
+	ctx := setup.Ctx.WithBlockTime(grantMsg.ExpirationDate)
+	result = setup.Handler(ctx, msgCertifyModel(grantee, vid, pid))
+	require.Equal(t, auth.CodeMissingRole, result.Code)
+}
+
+func TestHandler_CertifyModelByGranteeWhenGranterLostRole(t *testing.T) {
+	setup := Setup()
+	grantee := addGrantee(setup, auth.Vendor)
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// grant compliance authority
+	result := setup.Handler(setup.Ctx, msgGrantComplianceAuthority(setup.CertificationCenter, vid, grantee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// granter is no longer certification center
+	account := setup.authKeeper.GetAccount(setup.Ctx, setup.CertificationCenter)
+	account.Roles = auth.AccountRoles{auth.Vendor}
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	// try to certify model by grantee
+	result = setup.Handler(setup.Ctx, msgCertifyModel(grantee, vid, pid))
+	require.Equal(t, auth.CodeMissingRole, result.Code)
+}
+
+func TestHandler_RevokeComplianceAuthority(t *testing.T) {
+	setup := Setup()
+	grantee := addGrantee(setup, auth.Vendor)
+
+	// add model amd testing result
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	// grant compliance authority
+	result := setup.Handler(setup.Ctx, msgGrantComplianceAuthority(setup.CertificationCenter, vid, grantee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// revoke compliance authority
+	revokeMsg := types.NewMsgRevokeComplianceAuthority(vid, grantee, setup.CertificationCenter)
+	result = setup.Handler(setup.Ctx, revokeMsg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query grant
+	_, err := queryComplianceAuthorityGrant(setup, vid, grantee)
+	require.Equal(t, types.CodeComplianceAuthorityGrantDoesNotExist, err.Code())
+
+	// try to certify model by former grantee
+	result = setup.Handler(setup.Ctx, msgCertifyModel(grantee, vid, pid))
+	require.Equal(t, auth.CodeMissingRole, result.Code)
+
+	// try to revoke compliance authority second time
+	result = setup.Handler(setup.Ctx, revokeMsg)
+	require.Equal(t, types.CodeComplianceAuthorityGrantDoesNotExist, result.Code)
+}
+
+func TestHandler_RevokeComplianceAuthorityByGrantee(t *testing.T) {
+	setup := Setup()
+	grantee := addGrantee(setup, auth.Vendor)
+
+	// grant compliance authority
+	result := setup.Handler(setup.Ctx, msgGrantComplianceAuthority(setup.CertificationCenter, constants.VID, grantee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// try to revoke compliance authority by grantee
+	result = setup.Handler(setup.Ctx, types.NewMsgRevokeComplianceAuthority(constants.VID, grantee, grantee))
+	require.Equal(t, auth.CodeMissingRole, result.Code)
+}
+
+func TestHandler_AddCertificationDeclaration(t *testing.T) {
+	setup := Setup()
+
+	// add certified model version
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+	addModelVersion(setup, vid, pid, constants.SoftwareVersion)
+
+	result := setup.Handler(setup.Ctx, msgCertifyModel(setup.CertificationCenter, vid, pid))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// add certification declaration
+	msg := msgAddCertificationDeclaration(setup.CertificationCenter, vid, pid)
+	result = setup.Handler(setup.Ctx, msg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	declaration, _ := queryCertificationDeclaration(setup, vid, pid, constants.SoftwareVersion)
+	require.Equal(t, msg.Content, declaration.Content)
+	require.Equal(t, types.CertificationDeclarationHash(msg.Content), declaration.ContentHash)
+	require.Equal(t, msg.CertificationType, declaration.CertificationType)
+	require.Equal(t, setup.CertificationCenter, declaration.Owner)
+
+	// replace it by the declaration stored off-ledger
+	msg.Content = nil
+	msg.ContentHash = types.CertificationDeclarationHash([]byte("off-ledger declaration"))
+	msg.URL = "https://example.com/cd.der"
+	result = setup.Handler(setup.Ctx, msg)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	declaration, _ = queryCertificationDeclaration(setup, vid, pid, constants.SoftwareVersion)
+	require.Nil(t, declaration.Content)
+	require.Equal(t, msg.ContentHash, declaration.ContentHash)
+	require.Equal(t, msg.URL, declaration.URL)
+}
+
+func TestHandler_AddCertificationDeclarationByNonCertificationCenter(t *testing.T) {
+	setup := Setup()
+	vendor := addGrantee(setup, auth.Vendor)
+
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+	addModelVersion(setup, vid, pid, constants.SoftwareVersion)
+
+	result := setup.Handler(setup.Ctx, msgCertifyModel(setup.CertificationCenter, vid, pid))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// try to add certification declaration
+	result = setup.Handler(setup.Ctx, msgAddCertificationDeclaration(vendor, vid, pid))
+	require.Equal(t, auth.CodeMissingRole, result.Code)
+}
+
+func TestHandler_AddCertificationDeclarationForUnknownModelVersion(t *testing.T) {
+	setup := Setup()
+
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+
+	result := setup.Handler(setup.Ctx, msgCertifyModel(setup.CertificationCenter, vid, pid))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// try to add certification declaration
+	result = setup.Handler(setup.Ctx, msgAddCertificationDeclaration(setup.CertificationCenter, vid, pid))
+	require.Equal(t, modelinfo.CodeModelVersionDoesNotExist, result.Code)
+}
+
+func TestHandler_AddCertificationDeclarationForNotCertifiedModel(t *testing.T) {
+	setup := Setup()
+
+	vid, pid := addModel(setup, constants.VID, constants.PID)
+	addTestingResult(setup, vid, pid)
+	addModelVersion(setup, vid, pid, constants.SoftwareVersion)
+
+	// try to add certification declaration without compliance info
+	result := setup.Handler(setup.Ctx, msgAddCertificationDeclaration(setup.CertificationCenter, vid, pid))
+	require.Equal(t, types.CodeComplianceInfoDoesNotExist, result.Code)
+
+	// try to add certification declaration for revoked model
+	result = setup.Handler(setup.Ctx, msgRevokedModel(setup.CertificationCenter, vid, pid))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, msgAddCertificationDeclaration(setup.CertificationCenter, vid, pid))
+	require.Equal(t, types.CodeModelNotCertified, result.Code)
+
+	_, err := queryCertificationDeclaration(setup, vid, pid, constants.SoftwareVersion)
+	require.Equal(t, types.CodeCertificationDeclarationDoesNotExist, err.Code())
+}
+
+func queryModelComplianceInfos(setup TestSetup, vid uint16, pid uint16) (types.ListComplianceInfoItems, sdk.Error) {
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{keeper.QueryModelComplianceInfos, fmt.Sprintf("%v", vid), fmt.Sprintf("%v", pid)},
+		abci.RequestQuery{},
+	)
+	if err != nil {
+		return types.ListComplianceInfoItems{}, err
+	}
+
+	var complianceInfos types.ListComplianceInfoItems
+	_ = setup.Cdc.UnmarshalJSON(result, &complianceInfos)
+
+	return complianceInfos, nil
+}
+
+func queryComplianceHistory(setup TestSetup, vid uint16, pid uint16) (types.ComplianceHistory, sdk.Error) {
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{
+			keeper.QueryComplianceHistory, fmt.Sprintf("%v", vid),
+			fmt.Sprintf("%v", pid), fmt.Sprintf("%v", types.ZbCertificationType),
+		},
+		abci.RequestQuery{},
+	)
+	if err != nil {
+		return types.ComplianceHistory{}, err
+	}
+
+	var history types.ComplianceHistory
+	_ = setup.Cdc.UnmarshalJSON(result, &history)
+
+	return history, nil
+}
+
 func queryComplianceInfo(setup TestSetup, vid uint16, pid uint16) (types.ComplianceInfo, sdk.Error) {
 	result, err := setup.Querier(
 		setup.Ctx,
@@ -461,6 +1167,10 @@ func queryRevokedModel(setup TestSetup, vid uint16, pid uint16) (bool, sdk.Error
 	return queryComplianceInfoInState(setup, vid, pid, keeper.QueryRevokedModel)
 }
 
+func queryProvisionalModel(setup TestSetup, vid uint16, pid uint16) (bool, sdk.Error) {
+	return queryComplianceInfoInState(setup, vid, pid, keeper.QueryProvisionalModel)
+}
+
 func queryComplianceInfoInState(setup TestSetup, vid uint16, pid uint16, state string) (bool, sdk.Error) {
 	result, err := setup.Querier(
 		setup.Ctx,
@@ -477,6 +1187,50 @@ func queryComplianceInfoInState(setup TestSetup, vid uint16, pid uint16, state s
 	return model.Value, nil
 }
 
+func queryComplianceAuthorityGrant(setup TestSetup, vid uint16,
+	grantee sdk.AccAddress) (types.ComplianceAuthorityGrant, sdk.Error) {
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{keeper.QueryComplianceAuthorityGrant, fmt.Sprintf("%v", vid), grantee.String()},
+		abci.RequestQuery{},
+	)
+	if err != nil {
+		return types.ComplianceAuthorityGrant{}, err
+	}
+
+	var grant types.ComplianceAuthorityGrant
+	_ = setup.Cdc.UnmarshalJSON(result, &grant)
+
+	return grant, nil
+}
+
+func queryCertificationDeclaration(setup TestSetup, vid uint16, pid uint16,
+	softwareVersion uint32) (types.CertificationDeclaration, sdk.Error) {
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{
+			keeper.QueryCertificationDeclaration, fmt.Sprintf("%v", vid),
+			fmt.Sprintf("%v", pid), fmt.Sprintf("%v", softwareVersion),
+		},
+		abci.RequestQuery{},
+	)
+	if err != nil {
+		return types.CertificationDeclaration{}, err
+	}
+
+	var declaration types.CertificationDeclaration
+	_ = setup.Cdc.UnmarshalJSON(result, &declaration)
+
+	return declaration, nil
+}
+
+func addGrantee(setup TestSetup, role auth.AccountRole) sdk.AccAddress {
+	account := auth.NewAccount(constants.Address2, constants.PubKey2, auth.AccountRoles{role})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	return account.Address
+}
+
 func addModel(setup TestSetup, vid uint16, pid uint16) (uint16, uint16) {
 	modelInfo := modelinfo.ModelInfo{
 		VID:                      vid,
@@ -501,6 +1255,17 @@ func addModel(setup TestSetup, vid uint16, pid uint16) (uint16, uint16) {
 	return vid, pid
 }
 
+func addModelVersion(setup TestSetup, vid uint16, pid uint16, softwareVersion uint32) {
+	modelVersion := modelinfo.ModelVersion{
+		VID:                   vid,
+		PID:                   pid,
+		SoftwareVersion:       softwareVersion,
+		SoftwareVersionString: constants.SoftwareVersionString,
+	}
+
+	setup.ModelinfoKeeper.SetModelVersion(setup.Ctx, modelVersion)
+}
+
 func addTestingResult(setup TestSetup, vid uint16, pid uint16) (uint16, uint16) {
 	testingResult := compliancetest.TestingResult{
 		VID:        vid,
@@ -524,17 +1289,51 @@ func msgCertifyModel(signer sdk.AccAddress, vid uint16, pid uint16) MsgCertifyMo
 	}
 }
 
+func msgProvisionModel(signer sdk.AccAddress, vid uint16, pid uint16) MsgProvisionModel {
+	return MsgProvisionModel{
+		VID:               vid,
+		PID:               pid,
+		ProvisionalDate:   constants.CertificationDate,
+		CertificationType: types.CertificationType(constants.CertificationType),
+		Reason:            constants.Reason,
+		Signer:            signer,
+	}
+}
+
 func msgRevokedModel(signer sdk.AccAddress, vid uint16, pid uint16) MsgRevokeModel {
 	return MsgRevokeModel{
 		VID:               vid,
 		PID:               pid,
 		RevocationDate:    constants.RevocationDate,
+		ReasonCode:        types.RevocationReasonCode(constants.RevocationReasonCode),
 		Reason:            constants.RevocationReason,
 		CertificationType: types.CertificationType(constants.CertificationType),
 		Signer:            signer,
 	}
 }
 
+func msgGrantComplianceAuthority(signer sdk.AccAddress, vid uint16,
+	grantee sdk.AccAddress) MsgGrantComplianceAuthority {
+	return MsgGrantComplianceAuthority{
+		VID:            vid,
+		Grantee:        grantee,
+		ExpirationDate: constants.CertificationDate.AddDate(1, 0, 0),
+		Signer:         signer,
+	}
+}
+
+func msgAddCertificationDeclaration(signer sdk.AccAddress, vid uint16,
+	pid uint16) MsgAddCertificationDeclaration {
+	return MsgAddCertificationDeclaration{
+		VID:               vid,
+		PID:               pid,
+		SoftwareVersion:   constants.SoftwareVersion,
+		CertificationType: types.CertificationType(constants.CertificationType),
+		Content:           []byte("signed declaration"),
+		Signer:            signer,
+	}
+}
+
 func checkCertifiedModel(t *testing.T, receivedComplianceInfo ComplianceInfo, certifyModelMsg MsgCertifyModel) {
 	require.Equal(t, receivedComplianceInfo.VID, certifyModelMsg.VID)
 	require.Equal(t, receivedComplianceInfo.PID, certifyModelMsg.PID)
@@ -548,6 +1347,7 @@ func checkRevokedModel(t *testing.T, receivedComplianceInfo ComplianceInfo, revo
 	require.Equal(t, receivedComplianceInfo.PID, revokeModelMsg.PID)
 	require.Equal(t, receivedComplianceInfo.State, types.Revoked)
 	require.Equal(t, receivedComplianceInfo.Date, revokeModelMsg.RevocationDate)
+	require.Equal(t, receivedComplianceInfo.ReasonCode, revokeModelMsg.ReasonCode)
 	require.Equal(t, receivedComplianceInfo.Reason, revokeModelMsg.Reason)
 	require.Equal(t, receivedComplianceInfo.CertificationType, types.ZbCertificationType)
 }
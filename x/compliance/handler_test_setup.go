@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/tendermint/go-amino"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -63,12 +64,18 @@ func Setup() TestSetup {
 	compliancetestKey := sdk.NewKVStoreKey(compliancetest.StoreKey)
 	dbStore.MountStoreWithDB(compliancetestKey, sdk.StoreTypeIAVL, nil)
 
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+	dbStore.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+
 	_ = dbStore.LoadLatestVersion()
 
 	// Init Keepers
-	compliancetKeeper := NewKeeper(complianceKey, cdc)
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, paramsTKey, params.DefaultCodespace)
+	compliancetKeeper := NewKeeper(complianceKey, paramsKeeper.Subspace(DefaultParamspace), cdc)
 	compliancetestKeeper := compliancetest.NewKeeper(compliancetestKey, cdc)
-	authKeeper := auth.NewKeeper(authKey, cdc)
+	authKeeper := auth.NewKeeper(authKey, paramsKeeper.Subspace(auth.DefaultParamspace), cdc)
 	modelinfoKeeper := modelinfo.NewKeeper(modelinfoKey, cdc)
 
 	// Create context
@@ -0,0 +1,55 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance/internal/types"
+)
+
+// The maximum number of compliance infos expired in a single block,
+// the rest of the expired compliance infos are processed in the following blocks.
+const MaxExpiredComplianceInfosPerBlock = 100
+
+// EndBlocker marks the certified compliance infos which expiration date has come expired
+// and emits an event for every one of them. Called in each EndBlock.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	var expired []types.ComplianceInfo
+
+	k.IterateComplianceExpirations(ctx, ctx.BlockTime(), func(_ []byte, info types.ComplianceInfo) (stop bool) {
+		expired = append(expired, info)
+
+		return len(expired) >= MaxExpiredComplianceInfosPerBlock
+	})
+
+	for _, info := range expired {
+		info.Expire()
+		k.SetComplianceInfo(ctx, info)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExpireCompliance,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyVID, fmt.Sprint(info.VID)),
+				sdk.NewAttribute(types.AttributeKeyPID, fmt.Sprint(info.PID)),
+				sdk.NewAttribute(types.AttributeKeyCertificationType, string(info.CertificationType)),
+				sdk.NewAttribute(types.AttributeKeyExpirationDate, info.ExpirationDate.Format(time.RFC3339)),
+			),
+		)
+	}
+}
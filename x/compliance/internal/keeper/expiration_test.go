@@ -0,0 +1,155 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance/internal/types"
+)
+
+func TestKeeper_EndBlocker_ExpiresComplianceInfos(t *testing.T) {
+	setup := Setup()
+
+	expirationDate := testconstants.CertificationDate.AddDate(1, 0, 0)
+
+	// certified model expiring in a year
+	certifiedModel := DefaultCertifiedModel()
+	certifiedModel.ExpirationDate = expirationDate
+	setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, certifiedModel)
+
+	// nothing is expired before the expiration date
+	setup.CompliancetKeeper.EndBlocker(setup.Ctx.WithBlockTime(expirationDate.Add(-time.Second)))
+
+	complianceInfo := setup.CompliancetKeeper.GetComplianceInfo(setup.Ctx,
+		certifiedModel.CertificationType, certifiedModel.VID, certifiedModel.PID)
+	require.Equal(t, types.Certified, complianceInfo.State)
+	require.Equal(t, 1, countExpiringComplianceInfos(setup, expirationDate))
+
+	// the compliance info expires at the expiration date
+	ctx := setup.Ctx.WithBlockTime(expirationDate).WithEventManager(sdk.NewEventManager())
+	setup.CompliancetKeeper.EndBlocker(ctx)
+
+	complianceInfo = setup.CompliancetKeeper.GetComplianceInfo(setup.Ctx,
+		certifiedModel.CertificationType, certifiedModel.VID, certifiedModel.PID)
+	require.Equal(t, types.Expired, complianceInfo.State)
+	require.Equal(t, expirationDate, complianceInfo.Date)
+	require.Equal(t, uint64(1), complianceInfo.Sequence)
+	require.Equal(t, 1, len(complianceInfo.History))
+	require.Equal(t, types.Certified, complianceInfo.History[0].State)
+	require.Equal(t, certifiedModel.Date, complianceInfo.History[0].Date)
+
+	// the event is emitted
+	events := ctx.EventManager().Events()
+	require.Equal(t, 1, len(events))
+	require.Equal(t, types.EventTypeExpireCompliance, events[0].Type)
+	require.Equal(t, expirationDate.Format(time.RFC3339),
+		string(events[0].Attributes[len(events[0].Attributes)-1].Value))
+
+	// the index entry is removed
+	require.Equal(t, 0, countExpiringComplianceInfos(setup, expirationDate))
+
+	// the expired compliance info is not expired again
+	setup.CompliancetKeeper.EndBlocker(setup.Ctx.WithBlockTime(expirationDate.Add(time.Hour)))
+
+	complianceInfo = setup.CompliancetKeeper.GetComplianceInfo(setup.Ctx,
+		certifiedModel.CertificationType, certifiedModel.VID, certifiedModel.PID)
+	require.Equal(t, uint64(1), complianceInfo.Sequence)
+}
+
+func TestKeeper_EndBlocker_LimitsExpiredComplianceInfosPerBlock(t *testing.T) {
+	setup := Setup()
+
+	expirationDate := testconstants.CertificationDate.AddDate(1, 0, 0)
+	count := MaxExpiredComplianceInfosPerBlock + 10
+
+	certifiedModel := DefaultCertifiedModel()
+	certifiedModel.ExpirationDate = expirationDate
+	PopulateStoreWithModels(setup, 1, count, certifiedModel)
+
+	// the limited number of compliance infos expires in the first block
+	setup.CompliancetKeeper.EndBlocker(setup.Ctx.WithBlockTime(expirationDate))
+	require.Equal(t, count-MaxExpiredComplianceInfosPerBlock, countExpiringComplianceInfos(setup, expirationDate))
+
+	// the rest expires in the following block
+	setup.CompliancetKeeper.EndBlocker(setup.Ctx.WithBlockTime(expirationDate.Add(time.Second)))
+	require.Equal(t, 0, countExpiringComplianceInfos(setup, expirationDate))
+}
+
+func TestKeeper_SetComplianceInfo_UpdatesExpirationIndex(t *testing.T) {
+	setup := Setup()
+
+	expirationDate := testconstants.CertificationDate.AddDate(1, 0, 0)
+
+	certifiedModel := DefaultCertifiedModel()
+	certifiedModel.ExpirationDate = expirationDate
+	setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, certifiedModel)
+	require.Equal(t, 1, countExpiringComplianceInfos(setup, expirationDate))
+
+	// the expiration date is moved
+	certifiedModel.ExpirationDate = expirationDate.AddDate(1, 0, 0)
+	setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, certifiedModel)
+	require.Equal(t, 0, countExpiringComplianceInfos(setup, expirationDate))
+	require.Equal(t, 1, countExpiringComplianceInfos(setup, certifiedModel.ExpirationDate))
+
+	// the revoked model does not expire
+	certifiedModel.UpdateComplianceInfo(testconstants.RevocationDate,
+		types.RevocationReasonCode(testconstants.RevocationReasonCode), testconstants.RevocationReason)
+	setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, certifiedModel)
+	require.Equal(t, 0, countExpiringComplianceInfos(setup, certifiedModel.ExpirationDate.AddDate(1, 0, 0)))
+}
+
+func TestKeeper_ClearUnsetExpirationDates(t *testing.T) {
+	setup := Setup()
+
+	// compliance info stored before the expiration date was introduced
+	certifiedModel := DefaultCertifiedModel()
+	certifiedModel.ExpirationDate = time.Unix(0, 0)
+	setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, certifiedModel)
+
+	// compliance info with the expiration date
+	expiringModel := DefaultCertifiedModel()
+	expiringModel.PID++
+	expiringModel.ExpirationDate = testconstants.CertificationDate.AddDate(1, 0, 0)
+	setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, expiringModel)
+
+	setup.CompliancetKeeper.ClearUnsetExpirationDates(setup.Ctx)
+
+	complianceInfo := setup.CompliancetKeeper.GetComplianceInfo(setup.Ctx,
+		certifiedModel.CertificationType, certifiedModel.VID, certifiedModel.PID)
+	require.True(t, complianceInfo.ExpirationDate.IsZero())
+
+	complianceInfo = setup.CompliancetKeeper.GetComplianceInfo(setup.Ctx,
+		expiringModel.CertificationType, expiringModel.VID, expiringModel.PID)
+	require.Equal(t, expiringModel.ExpirationDate, complianceInfo.ExpirationDate)
+}
+
+func countExpiringComplianceInfos(setup TestSetup, until time.Time) int {
+	count := 0
+
+	setup.CompliancetKeeper.IterateComplianceExpirations(setup.Ctx, until,
+		func(_ []byte, _ types.ComplianceInfo) bool {
+			count++
+
+			return false
+		})
+
+	return count
+}
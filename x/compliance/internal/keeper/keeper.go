@@ -15,8 +15,12 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance/internal/types"
 )
 
@@ -24,12 +28,15 @@ type Keeper struct {
 	// Unexposed key to access store from sdk.Context.
 	storeKey sdk.StoreKey
 
+	// The subspace of the module parameters.
+	paramSpace params.Subspace
+
 	// The wire codec for binary encoding/decoding.
 	cdc *codec.Codec
 }
 
-func NewKeeper(storeKey sdk.StoreKey, cdc *codec.Codec) Keeper {
-	return Keeper{storeKey: storeKey, cdc: cdc}
+func NewKeeper(storeKey sdk.StoreKey, paramSpace params.Subspace, cdc *codec.Codec) Keeper {
+	return Keeper{storeKey: storeKey, paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()), cdc: cdc}
 }
 
 // Gets the entire ComplianceInfo struct for a ComplianceInfoID.
@@ -52,8 +59,40 @@ func (k Keeper) GetComplianceInfo(ctx sdk.Context, certificationType types.Certi
 // Sets the entire ComplianceInfo metadata struct for a ComplianceInfoID.
 func (k Keeper) SetComplianceInfo(ctx sdk.Context, model types.ComplianceInfo) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetComplianceInfoKey(
-		model.CertificationType, model.VID, model.PID), k.cdc.MustMarshalBinaryBare(model))
+
+	// the index entries of the previous date and expiration date are replaced
+	if k.IsComplianceInfoPresent(ctx, model.CertificationType, model.VID, model.PID) {
+		previous := k.GetComplianceInfo(ctx, model.CertificationType, model.VID, model.PID)
+		store.Delete(types.GetComplianceDateIndexKey(previous.Date, model.CertificationType, model.VID, model.PID))
+
+		if previous.IsExpiring() {
+			store.Delete(types.GetComplianceExpirationKey(previous.ExpirationDate,
+				model.CertificationType, model.VID, model.PID))
+		}
+	}
+
+	key := types.GetComplianceInfoKey(model.CertificationType, model.VID, model.PID)
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(model))
+	store.Set(types.GetComplianceDateIndexKey(model.Date, model.CertificationType, model.VID, model.PID), key)
+
+	if model.IsExpiring() {
+		store.Set(types.GetComplianceExpirationKey(model.ExpirationDate,
+			model.CertificationType, model.VID, model.PID), key)
+	}
+}
+
+// Gets ComplianceInfos of all allowed certification types present for the Model.
+func (k Keeper) GetModelComplianceInfos(ctx sdk.Context, vid uint16, pid uint16) []types.ComplianceInfo {
+	var complianceInfos []types.ComplianceInfo
+
+	for _, certificationType := range k.GetParams(ctx).CertificationTypes {
+		if k.IsComplianceInfoPresent(ctx, certificationType, vid, pid) {
+			complianceInfos = append(complianceInfos, k.GetComplianceInfo(ctx, certificationType, vid, pid))
+		}
+	}
+
+	return complianceInfos
 }
 
 // Iterate over all ComplianceInfos.
@@ -83,6 +122,165 @@ func (k Keeper) IterateComplianceInfos(ctx sdk.Context, certificationType types.
 	}
 }
 
+// Iterate over all ComplianceInfos of the certification type (all types if empty) in the requested order
+// (by key if no sort field is requested). The key passed along with the ComplianceInfo is the pagination key
+// of the requested order.
+func (k Keeper) IterateComplianceInfosSorted(ctx sdk.Context, certificationType types.CertificationType,
+	params pagination.PaginationParams, process func(key []byte, info types.ComplianceInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := types.GetCertificationPrefix(certificationType)
+	if params.Sort == types.SortByDate {
+		prefix = types.ComplianceDateIndexPrefix
+	}
+
+	iter := pagination.Iterator(store, prefix, params)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		val := iter.Value()
+
+		// the index entries reference the compliance info keys
+		if len(params.Sort) != 0 {
+			val = store.Get(val)
+		}
+
+		var complianceInfo types.ComplianceInfo
+
+		k.cdc.MustUnmarshalBinaryBare(val, &complianceInfo)
+
+		if len(certificationType) != 0 && complianceInfo.CertificationType != certificationType {
+			continue
+		}
+
+		if process(iter.Key(), complianceInfo) {
+			return
+		}
+	}
+}
+
+// Rebuilds the index sorting ComplianceInfos by date.
+func (k Keeper) RebuildDateIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	var staleKeys [][]byte
+
+	iter := sdk.KVStorePrefixIterator(store, types.ComplianceDateIndexPrefix)
+	for ; iter.Valid(); iter.Next() {
+		staleKeys = append(staleKeys, iter.Key())
+	}
+
+	iter.Close()
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+
+	k.IterateComplianceInfos(ctx, "", func(info types.ComplianceInfo) (stop bool) {
+		store.Set(types.GetComplianceDateIndexKey(info.Date, info.CertificationType, info.VID, info.PID),
+			types.GetComplianceInfoKey(info.CertificationType, info.VID, info.PID))
+
+		return false
+	})
+}
+
+// Iterate over the certified ComplianceInfos expiring not later than the given time in the order of expiration.
+// The key passed along with the ComplianceInfo is the pagination key.
+func (k Keeper) IterateComplianceExpirations(ctx sdk.Context, until time.Time,
+	process func(key []byte, info types.ComplianceInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := store.Iterator(types.ComplianceExpirationPrefix,
+		types.GetComplianceExpirationPrefix(until.Add(time.Nanosecond)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var complianceInfo types.ComplianceInfo
+
+		k.cdc.MustUnmarshalBinaryBare(store.Get(iter.Value()), &complianceInfo)
+
+		if process(iter.Key(), complianceInfo) {
+			return
+		}
+	}
+}
+
+// Clears the expiration date of the ComplianceInfos stored before it was introduced
+// (decoded as the Unix epoch, see types.IsExpirationDateSet).
+func (k Keeper) ClearUnsetExpirationDates(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	var infos []types.ComplianceInfo
+
+	k.IterateComplianceInfos(ctx, "", func(info types.ComplianceInfo) (stop bool) {
+		if !info.ExpirationDate.IsZero() && !types.IsExpirationDateSet(info.ExpirationDate) {
+			infos = append(infos, info)
+		}
+
+		return false
+	})
+
+	for _, info := range infos {
+		info.ExpirationDate = time.Time{}
+		store.Set(types.GetComplianceInfoKey(info.CertificationType, info.VID, info.PID),
+			k.cdc.MustMarshalBinaryBare(info))
+	}
+}
+
+// Moves the ComplianceInfos stored before the certification type was prefixed with its length
+// to their current keys and rebuilds the date and expiration indexes.
+func (k Keeper) MigrateComplianceInfoKeys(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	var (
+		staleKeys [][]byte
+		infos     []types.ComplianceInfo
+	)
+
+	positions := make(map[string]int)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ComplianceInfoPrefix)
+	for ; iter.Valid(); iter.Next() {
+		var info types.ComplianceInfo
+
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &info)
+
+		staleKeys = append(staleKeys, iter.Key())
+
+		key := string(types.GetComplianceInfoKey(info.CertificationType, info.VID, info.PID))
+
+		// a record already stored at the current key (by a previous migration of the same upgrade) is newer
+		position, ok := positions[key]
+
+		switch {
+		case !ok:
+			positions[key] = len(infos)
+			infos = append(infos, info)
+		case string(iter.Key()) == key:
+			infos[position] = info
+		}
+	}
+
+	iter.Close()
+
+	for _, prefix := range [][]byte{types.ComplianceDateIndexPrefix, types.ComplianceExpirationPrefix} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			staleKeys = append(staleKeys, iter.Key())
+		}
+
+		iter.Close()
+	}
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+
+	for _, info := range infos {
+		k.SetComplianceInfo(ctx, info)
+	}
+}
+
 func (k Keeper) CountTotalComplianceInfo(ctx sdk.Context, certificationType types.CertificationType) int {
 	return k.countTotal(ctx, types.GetCertificationPrefix(certificationType))
 }
@@ -93,6 +291,125 @@ func (k Keeper) IsComplianceInfoPresent(ctx sdk.Context,
 	return k.isRecordPresent(ctx, types.GetComplianceInfoKey(certificationType, vid, pid))
 }
 
+// Gets the Compliance Authority Grant for the VID and the grantee.
+func (k Keeper) GetComplianceAuthorityGrant(ctx sdk.Context, vid uint16,
+	grantee sdk.AccAddress) types.ComplianceAuthorityGrant {
+	if !k.IsComplianceAuthorityGrantPresent(ctx, vid, grantee) {
+		panic("ComplianceAuthorityGrant does not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetComplianceAuthorityGrantKey(vid, grantee))
+
+	var grant types.ComplianceAuthorityGrant
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+
+	return grant
+}
+
+// Sets the Compliance Authority Grant (an existing grant for the VID and the grantee is replaced).
+func (k Keeper) SetComplianceAuthorityGrant(ctx sdk.Context, grant types.ComplianceAuthorityGrant) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetComplianceAuthorityGrantKey(grant.VID, grant.Grantee), k.cdc.MustMarshalBinaryBare(grant))
+}
+
+// Deletes the Compliance Authority Grant.
+func (k Keeper) DeleteComplianceAuthorityGrant(ctx sdk.Context, vid uint16, grantee sdk.AccAddress) {
+	if !k.IsComplianceAuthorityGrantPresent(ctx, vid, grantee) {
+		panic("ComplianceAuthorityGrant does not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetComplianceAuthorityGrantKey(vid, grantee))
+}
+
+// Check if the Compliance Authority Grant is present in the store or not.
+func (k Keeper) IsComplianceAuthorityGrantPresent(ctx sdk.Context, vid uint16, grantee sdk.AccAddress) bool {
+	return k.isRecordPresent(ctx, types.GetComplianceAuthorityGrantKey(vid, grantee))
+}
+
+// Check if the account has the Compliance Authority Grant for the VID which is not expired at the block time.
+func (k Keeper) HasActiveComplianceAuthorityGrant(ctx sdk.Context, vid uint16, grantee sdk.AccAddress) bool {
+	if !k.IsComplianceAuthorityGrantPresent(ctx, vid, grantee) {
+		return false
+	}
+
+	return k.GetComplianceAuthorityGrant(ctx, vid, grantee).IsActive(ctx.BlockTime())
+}
+
+// Iterate over all Compliance Authority Grants.
+func (k Keeper) IterateComplianceAuthorityGrants(ctx sdk.Context,
+	process func(grant types.ComplianceAuthorityGrant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ComplianceAuthorityGrantPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.ComplianceAuthorityGrant
+
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+
+		if process(grant) {
+			return
+		}
+	}
+}
+
+func (k Keeper) CountTotalComplianceAuthorityGrants(ctx sdk.Context) int {
+	return k.countTotal(ctx, types.ComplianceAuthorityGrantPrefix)
+}
+
+// Gets the Certification Declaration of the model version.
+func (k Keeper) GetCertificationDeclaration(ctx sdk.Context, vid uint16, pid uint16,
+	softwareVersion uint32) types.CertificationDeclaration {
+	if !k.IsCertificationDeclarationPresent(ctx, vid, pid, softwareVersion) {
+		panic("CertificationDeclaration does not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCertificationDeclarationKey(vid, pid, softwareVersion))
+
+	var declaration types.CertificationDeclaration
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &declaration)
+
+	return declaration
+}
+
+// Sets the Certification Declaration (an existing declaration of the model version is replaced).
+func (k Keeper) SetCertificationDeclaration(ctx sdk.Context, declaration types.CertificationDeclaration) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCertificationDeclarationKey(declaration.VID, declaration.PID, declaration.SoftwareVersion),
+		k.cdc.MustMarshalBinaryBare(declaration))
+}
+
+// Check if the Certification Declaration is present in the store or not.
+func (k Keeper) IsCertificationDeclarationPresent(ctx sdk.Context, vid uint16, pid uint16,
+	softwareVersion uint32) bool {
+	return k.isRecordPresent(ctx, types.GetCertificationDeclarationKey(vid, pid, softwareVersion))
+}
+
+// Iterate over all Certification Declarations.
+func (k Keeper) IterateCertificationDeclarations(ctx sdk.Context,
+	process func(declaration types.CertificationDeclaration) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.CertificationDeclarationPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var declaration types.CertificationDeclaration
+
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &declaration)
+
+		if process(declaration) {
+			return
+		}
+	}
+}
+
 // Check if the record is present in the store or not.
 func (k Keeper) isRecordPresent(ctx sdk.Context, id []byte) bool {
 	store := ctx.KVStore(k.storeKey)
@@ -117,3 +434,22 @@ func (k Keeper) countTotal(ctx sdk.Context, prefix []byte) int {
 
 	return res
 }
+
+/*
+	Module Params
+*/
+
+// Gets the module params. Default values are returned for the params which have not been set yet.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+// Sets the module params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
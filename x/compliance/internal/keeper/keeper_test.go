@@ -17,6 +17,7 @@ package keeper
 
 //nolint:goimports
 import (
+	"encoding/binary"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -98,3 +99,133 @@ func TestKeeper_TwoComplianceInfoWithDifferentType(t *testing.T) {
 		otherCertifiedModel.CertificationType, otherCertifiedModel.VID, otherCertifiedModel.PID)
 	CheckComplianceInfo(t, otherCertifiedModel, receivedComplianceInfo)
 }
+
+func TestKeeper_ComplianceInfoWithTypePrefixedByAnotherType(t *testing.T) {
+	setup := Setup()
+
+	// create zb compliance info
+	zbCertifiedModel := DefaultCertifiedModel()
+	zbCertifiedModel.CertificationType = "zb"
+	setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, zbCertifiedModel)
+
+	// create zb_pro compliance infos
+	zbProCertifiedModel := DefaultCertifiedModel()
+	zbProCertifiedModel.CertificationType = "zb_pro"
+	setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, zbProCertifiedModel)
+	zbProCertifiedModel.PID++
+	setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, zbProCertifiedModel)
+
+	// the types are counted separately
+	require.Equal(t, 1, setup.CompliancetKeeper.CountTotalComplianceInfo(setup.Ctx, "zb"))
+	require.Equal(t, 2, setup.CompliancetKeeper.CountTotalComplianceInfo(setup.Ctx, "zb_pro"))
+	require.Equal(t, 3, setup.CompliancetKeeper.CountTotalComplianceInfo(setup.Ctx, ""))
+
+	// the types are iterated separately
+	setup.CompliancetKeeper.IterateComplianceInfos(setup.Ctx, "zb",
+		func(info types.ComplianceInfo) (stop bool) {
+			require.Equal(t, types.CertificationType("zb"), info.CertificationType)
+
+			return false
+		})
+}
+
+func TestKeeper_MigrateComplianceInfoKeys(t *testing.T) {
+	setup := Setup()
+	store := setup.Ctx.KVStore(setup.CompliancetKeeper.storeKey)
+
+	// compliance infos stored with the keys not prefixed with the length of the type
+	zbCertifiedModel := DefaultCertifiedModel()
+	zbCertifiedModel.CertificationType = "zb"
+	zbCertifiedModel.ExpirationDate = testconstants.CertificationDate.AddDate(1, 0, 0)
+	store.Set(legacyComplianceInfoKey(zbCertifiedModel), setup.Cdc.MustMarshalBinaryBare(zbCertifiedModel))
+
+	zbProCertifiedModel := DefaultCertifiedModel()
+	zbProCertifiedModel.CertificationType = "zb_pro"
+	store.Set(legacyComplianceInfoKey(zbProCertifiedModel), setup.Cdc.MustMarshalBinaryBare(zbProCertifiedModel))
+
+	// the compliance info already updated at the current key by a previous migration
+	updatedModel := zbProCertifiedModel
+	updatedModel.Reason = "updated"
+	store.Set(types.GetComplianceInfoKey(updatedModel.CertificationType, updatedModel.VID, updatedModel.PID),
+		setup.Cdc.MustMarshalBinaryBare(updatedModel))
+
+	setup.CompliancetKeeper.MigrateComplianceInfoKeys(setup.Ctx)
+
+	// the legacy keys are removed
+	require.False(t, store.Has(legacyComplianceInfoKey(zbCertifiedModel)))
+	require.False(t, store.Has(legacyComplianceInfoKey(zbProCertifiedModel)))
+
+	// the compliance infos are available at the current keys
+	receivedComplianceInfo := setup.CompliancetKeeper.GetComplianceInfo(setup.Ctx,
+		zbCertifiedModel.CertificationType, zbCertifiedModel.VID, zbCertifiedModel.PID)
+	CheckComplianceInfo(t, zbCertifiedModel, receivedComplianceInfo)
+
+	receivedComplianceInfo = setup.CompliancetKeeper.GetComplianceInfo(setup.Ctx,
+		updatedModel.CertificationType, updatedModel.VID, updatedModel.PID)
+	require.Equal(t, updatedModel.Reason, receivedComplianceInfo.Reason)
+
+	require.Equal(t, 1, setup.CompliancetKeeper.CountTotalComplianceInfo(setup.Ctx, "zb"))
+	require.Equal(t, 2, setup.CompliancetKeeper.CountTotalComplianceInfo(setup.Ctx, ""))
+
+	// the indexes are rebuilt
+	params := types.NewListQueryParams("", 0, 0)
+	params.Sort = types.SortByDate
+	require.Equal(t, 2, getComplianceInfos(setup, params).Total)
+	require.Equal(t, 1, countExpiringComplianceInfos(setup, zbCertifiedModel.ExpirationDate))
+}
+
+func legacyComplianceInfoKey(info types.ComplianceInfo) []byte {
+	v := make([]byte, 2)
+	binary.LittleEndian.PutUint16(v, info.VID)
+
+	p := make([]byte, 2)
+	binary.LittleEndian.PutUint16(p, info.PID)
+
+	key := append(append([]byte{}, types.ComplianceInfoPrefix...), []byte(info.CertificationType)...)
+
+	return append(key, append(v, p...)...)
+}
+
+func TestKeeper_ComplianceAuthorityGrantGetSetDelete(t *testing.T) {
+	setup := Setup()
+
+	// no grant before its created
+	require.False(t, setup.CompliancetKeeper.IsComplianceAuthorityGrantPresent(setup.Ctx,
+		testconstants.VID, testconstants.Address2))
+	require.False(t, setup.CompliancetKeeper.HasActiveComplianceAuthorityGrant(setup.Ctx,
+		testconstants.VID, testconstants.Address2))
+	require.Panics(t, func() {
+		setup.CompliancetKeeper.GetComplianceAuthorityGrant(setup.Ctx, testconstants.VID, testconstants.Address2)
+	})
+
+	// create grant
+	grant := types.NewComplianceAuthorityGrant(testconstants.VID, testconstants.Address2, testconstants.Address1,
+		testconstants.RevocationDate)
+	setup.CompliancetKeeper.SetComplianceAuthorityGrant(setup.Ctx, grant)
+
+	// get grant
+	require.Equal(t, grant,
+		setup.CompliancetKeeper.GetComplianceAuthorityGrant(setup.Ctx, testconstants.VID, testconstants.Address2))
+
+	// grant is active only before the expiration date
+	ctx := setup.Ctx.WithBlockTime(testconstants.CertificationDate)
+	require.True(t, setup.CompliancetKeeper.HasActiveComplianceAuthorityGrant(ctx,
+		testconstants.VID, testconstants.Address2))
+
+	ctx = setup.Ctx.WithBlockTime(testconstants.RevocationDate)
+	require.False(t, setup.CompliancetKeeper.HasActiveComplianceAuthorityGrant(ctx,
+		testconstants.VID, testconstants.Address2))
+
+	// grant is not shared between vendors
+	require.False(t, setup.CompliancetKeeper.HasActiveComplianceAuthorityGrant(setup.Ctx,
+		testconstants.VID+1, testconstants.Address2))
+
+	// grants are not counted as compliance infos
+	require.Equal(t, 0, setup.CompliancetKeeper.CountTotalComplianceInfo(setup.Ctx, ""))
+	require.Equal(t, 1, setup.CompliancetKeeper.CountTotalComplianceAuthorityGrants(setup.Ctx))
+
+	// delete grant
+	setup.CompliancetKeeper.DeleteComplianceAuthorityGrant(setup.Ctx, testconstants.VID, testconstants.Address2)
+	require.False(t, setup.CompliancetKeeper.IsComplianceAuthorityGrantPresent(setup.Ctx,
+		testconstants.VID, testconstants.Address2))
+}
@@ -16,21 +16,32 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance/internal/types"
 )
 
 const (
-	QueryComplianceInfo           = "compliance_info"
-	QueryAllComplianceInfoRecords = "all_compliance_info_records"
-	QueryCertifiedModel           = "certified_model"
-	QueryAllCertifiedModels       = "all_certified_models"
-	QueryRevokedModel             = "revoked_model"
-	QueryAllRevokedModels         = "all_revoked_models"
+	QueryComplianceInfo               = "compliance_info"
+	QueryAllComplianceInfoRecords     = "all_compliance_info_records"
+	QueryCertifiedModel               = "certified_model"
+	QueryAllCertifiedModels           = "all_certified_models"
+	QueryRevokedModel                 = "revoked_model"
+	QueryAllRevokedModels             = "all_revoked_models"
+	QueryComplianceHistory            = "compliance_history"
+	QueryModelComplianceInfos         = "model_compliance_info_records"
+	QueryComplianceAuthorityGrant     = "compliance_authority_grant"
+	QueryAllComplianceAuthorityGrants = "all_compliance_authority_grants"
+	QueryCertificationDeclaration     = "certification_declaration"
+	QueryAllCertificationDeclarations = "all_certification_declarations"
+	QueryComplianceInfosExpiring      = "compliance_info_records_expiring_within"
+	QueryProvisionalModel             = "provisional_model"
+	QueryAllProvisionalModels         = "all_provisional_models"
 )
 
 func NewQuerier(keeper Keeper) sdk.Querier {
@@ -48,6 +59,24 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryComplianceInfo(ctx, path[1:], keeper, types.Revoked)
 		case QueryAllRevokedModels:
 			return queryAllComplianceInfoInStateRecords(ctx, req, keeper, types.Revoked)
+		case QueryProvisionalModel:
+			return queryComplianceInfo(ctx, path[1:], keeper, types.Provisional)
+		case QueryAllProvisionalModels:
+			return queryAllComplianceInfoInStateRecords(ctx, req, keeper, types.Provisional)
+		case QueryModelComplianceInfos:
+			return queryModelComplianceInfos(ctx, path[1:], keeper)
+		case QueryComplianceHistory:
+			return queryComplianceHistory(ctx, path[1:], keeper)
+		case QueryComplianceAuthorityGrant:
+			return queryComplianceAuthorityGrant(ctx, path[1:], keeper)
+		case QueryAllComplianceAuthorityGrants:
+			return queryAllComplianceAuthorityGrants(ctx, req, keeper)
+		case QueryCertificationDeclaration:
+			return queryCertificationDeclaration(ctx, path[1:], keeper)
+		case QueryAllCertificationDeclarations:
+			return queryAllCertificationDeclarations(ctx, req, keeper)
+		case QueryComplianceInfosExpiring:
+			return queryComplianceInfosExpiring(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown compliance query endpoint")
 		}
@@ -87,6 +116,56 @@ func queryComplianceInfo(ctx sdk.Context, path []string, keeper Keeper,
 	return res, nil
 }
 
+func queryModelComplianceInfos(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	vid, err := conversions.ParseVID(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := conversions.ParsePID(path[1])
+	if err != nil {
+		return nil, err
+	}
+
+	complianceInfos := keeper.GetModelComplianceInfos(ctx, vid, pid)
+	if len(complianceInfos) == 0 {
+		return nil, types.ErrComplianceInfoDoesNotExist(vid, pid, keeper.GetParams(ctx).CertificationTypes)
+	}
+
+	result := types.ListComplianceInfoItems{
+		Total: len(complianceInfos),
+		Items: complianceInfos,
+	}
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func queryComplianceHistory(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	vid, err := conversions.ParseVID(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := conversions.ParsePID(path[1])
+	if err != nil {
+		return nil, err
+	}
+
+	certificationType := types.CertificationType(path[2])
+
+	if !keeper.IsComplianceInfoPresent(ctx, certificationType, vid, pid) {
+		return nil, types.ErrComplianceInfoDoesNotExist(vid, pid, certificationType)
+	}
+
+	complianceInfo := keeper.GetComplianceInfo(ctx, certificationType, vid, pid)
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, complianceInfo.FullHistory())
+
+	return res, nil
+}
+
 func queryAllComplianceInfoRecords(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
 	var params types.ListQueryParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
@@ -97,25 +176,25 @@ func queryAllComplianceInfoRecords(ctx sdk.Context, req abci.RequestQuery, keepe
 		Total: 0,
 		Items: []types.ComplianceInfo{},
 	}
-	skipped := 0
+	paginator, err := pagination.NewPaginator(ctx, params.PaginationParams(), types.ComplianceInfoSortFields...)
+	if err != nil {
+		return nil, err
+	}
 
-	keeper.IterateComplianceInfos(ctx, params.CertificationType, func(complianceInfo types.ComplianceInfo) (stop bool) {
+	process := func(key []byte, complianceInfo types.ComplianceInfo) (stop bool) {
 		result.Total++
 
-		if skipped < params.Skip {
-			skipped++
-
-			return false
-		}
-
-		if len(result.Items) < params.Take || params.Take == 0 {
+		if paginator.Add(key) {
 			result.Items = append(result.Items, complianceInfo)
-
-			return false
 		}
 
 		return false
-	})
+	}
+
+	keeper.IterateComplianceInfosSorted(ctx, params.CertificationType, params.PaginationParams(), process)
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
 
 	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
 
@@ -133,34 +212,198 @@ func queryAllComplianceInfoInStateRecords(ctx sdk.Context, req abci.RequestQuery
 		Total: 0,
 		Items: []types.ComplianceInfoKey{},
 	}
-	skipped := 0
+	paginator, err := pagination.NewPaginator(ctx, params.PaginationParams(), types.ComplianceInfoSortFields...)
+	if err != nil {
+		return nil, err
+	}
 
-	keeper.IterateComplianceInfos(ctx, params.CertificationType, func(complianceInfo types.ComplianceInfo) (stop bool) {
+	process := func(key []byte, complianceInfo types.ComplianceInfo) (stop bool) {
 		if len(requestedState) != 0 && complianceInfo.State != requestedState {
 			return false
 		}
 
 		result.Total++
 
-		if skipped < params.Skip {
-			skipped++
-
-			return false
-		}
-
-		if len(result.Items) < params.Take || params.Take == 0 {
+		if paginator.Add(key) {
 			result.Items = append(result.Items, types.ComplianceInfoKey{
 				VID:               complianceInfo.VID,
 				PID:               complianceInfo.PID,
 				CertificationType: complianceInfo.CertificationType,
 			})
+		}
 
-			return false
+		return false
+	}
+
+	keeper.IterateComplianceInfosSorted(ctx, params.CertificationType, params.PaginationParams(), process)
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func queryComplianceAuthorityGrant(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	vid, err := conversions.ParseVID(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	grantee, err_ := sdk.AccAddressFromBech32(path[1])
+	if err_ != nil {
+		return nil, sdk.ErrInvalidAddress(fmt.Sprintf("Invalid Grantee: %s", err_))
+	}
+
+	if !keeper.IsComplianceAuthorityGrantPresent(ctx, vid, grantee) {
+		return nil, types.ErrComplianceAuthorityGrantDoesNotExist(vid, grantee)
+	}
+
+	grant := keeper.GetComplianceAuthorityGrant(ctx, vid, grantee)
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, grant)
+
+	return res, nil
+}
+
+func queryAllComplianceAuthorityGrants(ctx sdk.Context, req abci.RequestQuery,
+	keeper Keeper) (res []byte, err sdk.Error) {
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	result := types.ListComplianceAuthorityGrants{
+		Total: 0,
+		Items: []types.ComplianceAuthorityGrant{},
+	}
+
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.IterateComplianceAuthorityGrants(ctx, func(grant types.ComplianceAuthorityGrant) (stop bool) {
+		result.Total++
+
+		if paginator.Add(types.GetComplianceAuthorityGrantKey(grant.VID, grant.Grantee)) {
+			result.Items = append(result.Items, grant)
 		}
 
 		return false
 	})
 
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func queryCertificationDeclaration(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	vid, err := conversions.ParseVID(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := conversions.ParsePID(path[1])
+	if err != nil {
+		return nil, err
+	}
+
+	softwareVersion, err := conversions.ParseUInt32FromString(path[2])
+	if err != nil {
+		return nil, err
+	}
+
+	if !keeper.IsCertificationDeclarationPresent(ctx, vid, pid, softwareVersion) {
+		return nil, types.ErrCertificationDeclarationDoesNotExist(vid, pid, softwareVersion)
+	}
+
+	declaration := keeper.GetCertificationDeclaration(ctx, vid, pid, softwareVersion)
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, declaration)
+
+	return res, nil
+}
+
+func queryAllCertificationDeclarations(ctx sdk.Context, req abci.RequestQuery,
+	keeper Keeper) (res []byte, err sdk.Error) {
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	result := types.ListCertificationDeclarations{
+		Total: 0,
+		Items: []types.CertificationDeclaration{},
+	}
+
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.IterateCertificationDeclarations(ctx, func(declaration types.CertificationDeclaration) (stop bool) {
+		result.Total++
+
+		key := types.GetCertificationDeclarationKey(declaration.VID, declaration.PID, declaration.SoftwareVersion)
+		if paginator.Add(key) {
+			result.Items = append(result.Items, declaration)
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+// Returns the certified compliance infos which expire within the given number of days in the order of expiration.
+// The compliance infos which have already expired, but have not been marked expired yet, are included.
+func queryComplianceInfosExpiring(ctx sdk.Context, path []string,
+	req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	days, err := conversions.ParseUInt16FromString(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	result := types.ListComplianceInfoItems{
+		Total: 0,
+		Items: []types.ComplianceInfo{},
+	}
+
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	until := ctx.BlockTime().Add(time.Duration(days) * 24 * time.Hour)
+
+	keeper.IterateComplianceExpirations(ctx, until, func(key []byte, complianceInfo types.ComplianceInfo) (stop bool) {
+		result.Total++
+
+		if paginator.Add(key) {
+			result.Items = append(result.Items, complianceInfo)
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
 	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
 
 	return res, nil
@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance/internal/types"
 )
 
@@ -189,6 +190,38 @@ func TestQuerier_QueryAllModelsInState(t *testing.T) {
 	}
 }
 
+func TestQuerier_QueryProvisionalModels(t *testing.T) {
+	setup := Setup()
+
+	// add 2 certified and 2 revoked models
+	PopulateStoreWithMixedModels(setup, 4)
+
+	// add provisional model
+	provisionalModel := types.NewProvisionalComplianceInfo(5, 5,
+		types.CertificationType(testconstants.CertificationType), testconstants.CertificationDate,
+		testconstants.EmptyString, testconstants.Owner)
+	setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, provisionalModel)
+
+	// query all provisional models
+	receivedInfos := getAllInState(setup, types.NewListQueryParams("", 0, 0), QueryAllProvisionalModels)
+	require.Equal(t, 1, receivedInfos.Total)
+	require.Equal(t, provisionalModel.VID, receivedInfos.Items[0].VID)
+	require.Equal(t, provisionalModel.PID, receivedInfos.Items[0].PID)
+
+	// query provisional model
+	receivedState, err := getSingleInState(setup, provisionalModel.VID, provisionalModel.PID, QueryProvisionalModel)
+	require.Nil(t, err)
+	require.True(t, receivedState.Value)
+
+	// query certified model as provisional
+	_, err = getSingleInState(setup, 1, 1, QueryProvisionalModel)
+	require.Equal(t, types.CodeComplianceInfoDoesNotExist, err.Code())
+
+	// the provisional model is neither certified nor revoked
+	require.Equal(t, 2, getCertifiedModels(setup, types.NewListQueryParams("", 0, 0)).Total)
+	require.Equal(t, 2, getRevokedModels(setup, types.NewListQueryParams("", 0, 0)).Total)
+}
+
 func TestQuerier_QueryAllModelsWithPaginationHeaders(t *testing.T) {
 	setup := Setup()
 	count := 8
@@ -247,6 +280,213 @@ func TestQuerier_QueryAllModelsInStateWithPaginationHeaders(t *testing.T) {
 	}
 }
 
+func TestQuerier_QueryAllModelsSortedByDate(t *testing.T) {
+	setup := Setup()
+
+	// add models which dates are not in the order of their keys
+	complianceInfo := DefaultCertifiedModel()
+	days := []int{3, 1, 2}
+
+	for i, day := range days {
+		complianceInfo.VID = uint16(i + 1)
+		complianceInfo.PID = uint16(i + 1)
+		complianceInfo.Date = testconstants.CertificationDate.AddDate(0, 0, day)
+		setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, complianceInfo)
+	}
+
+	// the index follows the date update: the model is revoked the latest
+	complianceInfo = setup.CompliancetKeeper.GetComplianceInfo(setup.Ctx, complianceInfo.CertificationType, 2, 2)
+	complianceInfo.UpdateComplianceInfo(testconstants.CertificationDate.AddDate(0, 0, 4),
+		types.RevocationReasonCode(testconstants.RevocationReasonCode), testconstants.RevocationReason)
+	setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, complianceInfo)
+
+	// query all models sorted by date
+	params := types.NewListQueryParams("", 0, 0)
+	params.Sort = types.SortByDate
+	receivedInfos := getComplianceInfos(setup, params)
+
+	// check
+	require.Equal(t, 3, receivedInfos.Total)
+	require.Equal(t, []uint16{3, 1, 2}, complianceInfoVIDs(receivedInfos.Items))
+
+	// query the first page in the descending order
+	params = types.NewListQueryParams(complianceInfo.CertificationType, 0, 2)
+	params.Sort = types.SortByDate
+	params.Order = pagination.OrderDesc
+	firstPage := getComplianceInfos(setup, params)
+
+	// check
+	require.Equal(t, []uint16{2, 1}, complianceInfoVIDs(firstPage.Items))
+	require.NotEmpty(t, firstPage.NextKey)
+
+	// query the next page
+	params.Take = 2
+	params.Key = firstPage.NextKey
+	secondPage := getComplianceInfos(setup, params)
+
+	// check
+	require.Equal(t, []uint16{3}, complianceInfoVIDs(secondPage.Items))
+	require.Empty(t, secondPage.NextKey)
+
+	// query certified models sorted by date
+	params = types.NewListQueryParams("", 0, 0)
+	params.Sort = types.SortByDate
+	certifiedModels := getCertifiedModels(setup, params)
+
+	// check
+	require.Equal(t, 2, certifiedModels.Total)
+	require.Equal(t, uint16(3), certifiedModels.Items[0].VID)
+	require.Equal(t, uint16(1), certifiedModels.Items[1].VID)
+}
+
+func TestQuerier_QueryAllModelsWithInvalidSort(t *testing.T) {
+	setup := Setup()
+
+	params := types.NewListQueryParams("", 0, 0)
+	params.Sort = "vid"
+
+	// query with invalid sort
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryAllComplianceInfoRecords},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)},
+	)
+
+	// check
+	require.Nil(t, result)
+	require.NotNil(t, err)
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+}
+
+func TestKeeper_RebuildDateIndex(t *testing.T) {
+	setup := Setup()
+
+	// add models and drop the index as if the models were added before it was introduced
+	PopulateStoreWithMixedModels(setup, 4)
+
+	store := setup.Ctx.KVStore(setup.CompliancetKeeper.storeKey)
+
+	var keys [][]byte
+
+	iter := sdk.KVStorePrefixIterator(store, types.ComplianceDateIndexPrefix)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+
+	iter.Close()
+
+	require.Equal(t, 4, len(keys))
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	setup.CompliancetKeeper.RebuildDateIndex(setup.Ctx)
+
+	// query all models sorted by date
+	params := types.NewListQueryParams("", 0, 0)
+	params.Sort = types.SortByDate
+	receivedInfos := getComplianceInfos(setup, params)
+
+	// check
+	require.Equal(t, 4, receivedInfos.Total)
+	require.Equal(t, 4, len(receivedInfos.Items))
+}
+
+func TestQuerier_QueryComplianceInfosExpiring(t *testing.T) {
+	setup := Setup()
+	setup.Ctx = setup.Ctx.WithBlockTime(testconstants.CertificationDate)
+
+	// models {VID: 1..3} expiring in 3, 2 and 1 days
+	for i := uint16(1); i <= 3; i++ {
+		certifiedModel := DefaultCertifiedModel()
+		certifiedModel.VID = i
+		certifiedModel.ExpirationDate = testconstants.CertificationDate.AddDate(0, 0, 4-int(i))
+		setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, certifiedModel)
+	}
+
+	// the model without expiration date and the revoked model are never returned
+	certifiedModel := DefaultCertifiedModel()
+	certifiedModel.VID = 4
+	setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, certifiedModel)
+
+	revokedModel := DefaultRevokedModel()
+	revokedModel.VID = 5
+	setup.CompliancetKeeper.SetComplianceInfo(setup.Ctx, revokedModel)
+
+	// query the models expiring within 2 days
+	receivedInfos, err := getComplianceInfosExpiring(setup, 2, pagination.NewPaginationParams(0, 0))
+	require.Nil(t, err)
+	require.Equal(t, 2, receivedInfos.Total)
+	require.Equal(t, []uint16{3, 2}, complianceInfoVIDs(receivedInfos.Items))
+
+	// query the models expiring within 3 days with pagination
+	receivedInfos, err = getComplianceInfosExpiring(setup, 3, pagination.NewPaginationParams(1, 1))
+	require.Nil(t, err)
+	require.Equal(t, 3, receivedInfos.Total)
+	require.Equal(t, []uint16{2}, complianceInfoVIDs(receivedInfos.Items))
+
+	// nothing expires within 0 days
+	receivedInfos, err = getComplianceInfosExpiring(setup, 0, pagination.NewPaginationParams(0, 0))
+	require.Nil(t, err)
+	require.Equal(t, 0, receivedInfos.Total)
+}
+
+func TestQuerier_QueryAllComplianceAuthorityGrants(t *testing.T) {
+	setup := Setup()
+
+	// add grants for 3 vendors
+	for vid := uint16(1); vid <= 3; vid++ {
+		setup.CompliancetKeeper.SetComplianceAuthorityGrant(setup.Ctx, types.NewComplianceAuthorityGrant(
+			vid, testconstants.Address2, testconstants.Address1, testconstants.RevocationDate))
+	}
+
+	// query all grants skip=1 take=1
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryAllComplianceAuthorityGrants},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(pagination.PaginationParams{Skip: 1, Take: 1})},
+	)
+	require.Nil(t, err)
+
+	var receivedGrants types.ListComplianceAuthorityGrants
+	_ = setup.Cdc.UnmarshalJSON(result, &receivedGrants)
+
+	// check
+	require.Equal(t, 3, receivedGrants.Total)
+	require.Equal(t, 1, len(receivedGrants.Items))
+	require.Equal(t, uint16(2), receivedGrants.Items[0].VID)
+
+	// query single grant
+	result, err = setup.Querier(
+		setup.Ctx,
+		[]string{QueryComplianceAuthorityGrant, "2", testconstants.Address2.String()},
+		abci.RequestQuery{},
+	)
+	require.Nil(t, err)
+
+	var receivedGrant types.ComplianceAuthorityGrant
+	_ = setup.Cdc.UnmarshalJSON(result, &receivedGrant)
+	require.Equal(t, testconstants.Address1, receivedGrant.Granter)
+
+	// query unknown grant
+	_, err = setup.Querier(
+		setup.Ctx,
+		[]string{QueryComplianceAuthorityGrant, "4", testconstants.Address2.String()},
+		abci.RequestQuery{},
+	)
+	require.Equal(t, types.CodeComplianceAuthorityGrantDoesNotExist, err.Code())
+}
+
+func complianceInfoVIDs(items []types.ComplianceInfo) []uint16 {
+	vids := make([]uint16, 0, len(items))
+	for _, item := range items {
+		vids = append(vids, item.VID)
+	}
+
+	return vids
+}
+
 func getComplianceInfo(setup TestSetup, vid uint16, pid uint16) (types.ComplianceInfo, sdk.Error) {
 	return getSingle(setup, vid, pid, QueryComplianceInfo)
 }
@@ -263,6 +503,23 @@ func getComplianceInfos(setup TestSetup, params types.ListQueryParams) types.Lis
 	return getAll(setup, params, QueryAllComplianceInfoRecords)
 }
 
+func getComplianceInfosExpiring(setup TestSetup, days uint16,
+	params pagination.PaginationParams) (types.ListComplianceInfoItems, sdk.Error) {
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryComplianceInfosExpiring, fmt.Sprintf("%v", days)},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)},
+	)
+	if err != nil {
+		return types.ListComplianceInfoItems{}, err
+	}
+
+	var receivedInfos types.ListComplianceInfoItems
+	_ = setup.Cdc.UnmarshalJSON(result, &receivedInfos)
+
+	return receivedInfos, nil
+}
+
 func getCertifiedModels(setup TestSetup, params types.ListQueryParams) types.ListComplianceInfoKeyItems {
 	return getAllInState(setup, params, QueryAllCertifiedModels)
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -46,10 +47,16 @@ func Setup() TestSetup {
 	dbStore := store.NewCommitMultiStore(db)
 	complianceKey := sdk.NewKVStoreKey(types.StoreKey)
 	dbStore.MountStoreWithDB(complianceKey, sdk.StoreTypeIAVL, nil)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+	dbStore.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+
 	_ = dbStore.LoadLatestVersion()
 
 	// Init Keepers
-	complianceKeeper := NewKeeper(complianceKey, cdc)
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, paramsTKey, params.DefaultCodespace)
+	complianceKeeper := NewKeeper(complianceKey, paramsKeeper.Subspace(types.DefaultParamspace), cdc)
 
 	// Init Querier
 	querier := NewQuerier(complianceKeeper)
@@ -84,6 +91,7 @@ func DefaultRevokedModel() types.ComplianceInfo {
 		testconstants.PID,
 		types.CertificationType(testconstants.CertificationType),
 		testconstants.RevocationDate,
+		types.RevocationReasonCode(testconstants.RevocationReasonCode),
 		testconstants.RevocationReason,
 		testconstants.Owner,
 	)
@@ -29,4 +29,8 @@ func init() {
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCertifyModel{}, ModuleName+"/CertifyModel", nil)
 	cdc.RegisterConcrete(MsgRevokeModel{}, ModuleName+"/RevokeModel", nil)
+	cdc.RegisterConcrete(MsgGrantComplianceAuthority{}, ModuleName+"/GrantComplianceAuthority", nil)
+	cdc.RegisterConcrete(MsgRevokeComplianceAuthority{}, ModuleName+"/RevokeComplianceAuthority", nil)
+	cdc.RegisterConcrete(MsgAddCertificationDeclaration{}, ModuleName+"/AddCertificationDeclaration", nil)
+	cdc.RegisterConcrete(MsgProvisionModel{}, ModuleName+"/ProvisionModel", nil)
 }
@@ -0,0 +1,81 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// The signed Certification Declarations (CMS envelopes) are small; larger ones are stored off-ledger.
+	MaxCertificationDeclarationSize      = 2048
+	MaxCertificationDeclarationURLLength = 256
+)
+
+// Signed Certification Declaration (CD) of a model version issued by a certification center.
+// Either the CD itself or its hash and the URL it can be fetched from are stored on the ledger.
+type CertificationDeclaration struct {
+	VID               uint16            `json:"vid"`
+	PID               uint16            `json:"pid"`
+	SoftwareVersion   uint32            `json:"software_version"`
+	CertificationType CertificationType `json:"certification_type"` // of the compliance info the CD belongs to
+	Content           []byte            `json:"content,omitempty"`  // DER encoded signed CD (base64 in JSON)
+	ContentHash       string            `json:"content_hash"`       // hex encoded SHA-256 of the signed CD
+	URL               string            `json:"url,omitempty"`
+	Date              time.Time         `json:"date"` // rfc3339 encoded date
+	Owner             sdk.AccAddress    `json:"owner"`
+}
+
+func NewCertificationDeclaration(vid uint16, pid uint16, softwareVersion uint32,
+	certificationType CertificationType, content []byte, contentHash string, url string,
+	date time.Time, owner sdk.AccAddress) CertificationDeclaration {
+	// the hash of the stored CD is always computed by the ledger
+	if len(content) != 0 {
+		contentHash = CertificationDeclarationHash(content)
+	}
+
+	return CertificationDeclaration{
+		VID:               vid,
+		PID:               pid,
+		SoftwareVersion:   softwareVersion,
+		CertificationType: certificationType,
+		Content:           content,
+		ContentHash:       contentHash,
+		URL:               url,
+		Date:              date,
+		Owner:             owner,
+	}
+}
+
+// Returns the hex encoded SHA-256 of the signed CD.
+func CertificationDeclarationHash(content []byte) string {
+	hash := sha256.Sum256(content)
+
+	return hex.EncodeToString(hash[:])
+}
+
+func (d CertificationDeclaration) String() string {
+	bytes, err := json.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
 )
 
 const (
@@ -27,8 +28,27 @@ const (
 	CodeInconsistentDates          sdk.CodeType = 302
 	CodeAlreadyCertifyed           sdk.CodeType = 303
 	CodeModelInfoDoesNotExist      sdk.CodeType = 304
+
+	CodeComplianceAuthorityGrantDoesNotExist sdk.CodeType = 305
+	CodeSequenceConflict                     sdk.CodeType = 306
+
+	CodeCertificationDeclarationDoesNotExist sdk.CodeType = 307
+	CodeModelNotCertified                    sdk.CodeType = 308
+	CodeComplianceInfoAlreadyExists          sdk.CodeType = 309
 )
 
+func init() {
+	errcodes.Register(Codespace, CodeComplianceInfoDoesNotExist, "compliance_info_does_not_exist")
+	errcodes.Register(Codespace, CodeInconsistentDates, "inconsistent_dates")
+	errcodes.Register(Codespace, CodeAlreadyCertifyed, "already_certified")
+	errcodes.Register(Codespace, CodeModelInfoDoesNotExist, "model_info_does_not_exist")
+	errcodes.Register(Codespace, CodeComplianceAuthorityGrantDoesNotExist, "compliance_authority_grant_does_not_exist")
+	errcodes.Register(Codespace, CodeSequenceConflict, "sequence_conflict")
+	errcodes.Register(Codespace, CodeCertificationDeclarationDoesNotExist, "certification_declaration_does_not_exist")
+	errcodes.Register(Codespace, CodeModelNotCertified, "model_not_certified")
+	errcodes.Register(Codespace, CodeComplianceInfoAlreadyExists, "compliance_info_already_exists")
+}
+
 func ErrComplianceInfoDoesNotExist(vid interface{}, pid interface{}, certificationType interface{}) sdk.Error {
 	return sdk.NewError(Codespace, CodeComplianceInfoDoesNotExist,
 		fmt.Sprintf("No certification information about the model with vid=%v, pid=%v and "+
@@ -49,3 +69,32 @@ func ErrModelInfoDoesNotExist(vid interface{}, pid interface{}) sdk.Error {
 	return sdk.NewError(Codespace, CodeModelInfoDoesNotExist,
 		fmt.Sprintf("Model with vid=%v, pid=%v does not exist on the ledger", vid, pid))
 }
+
+func ErrComplianceAuthorityGrantDoesNotExist(vid interface{}, grantee interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeComplianceAuthorityGrantDoesNotExist,
+		fmt.Sprintf("No compliance authority grant for vid=%v to account=%v on the ledger", vid, grantee))
+}
+
+func ErrSequenceConflict(vid interface{}, pid interface{}, expected interface{}, actual interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeSequenceConflict,
+		fmt.Sprintf("Compliance info associated with vid=%v and pid=%v has been changed concurrently: "+
+			"expected sequence=%v, but stored sequence=%v", vid, pid, expected, actual))
+}
+
+func ErrCertificationDeclarationDoesNotExist(vid interface{}, pid interface{}, softwareVersion interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeCertificationDeclarationDoesNotExist,
+		fmt.Sprintf("No certification declaration of the model version with vid=%v, pid=%v and "+
+			"software_version=%v on the ledger", vid, pid, softwareVersion))
+}
+
+func ErrModelNotCertified(vid interface{}, pid interface{}, certificationType interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeModelNotCertified,
+		fmt.Sprintf("Model with vid=%v, pid=%v is not certified for certification_type=%v on the ledger",
+			vid, pid, certificationType))
+}
+
+func ErrComplianceInfoAlreadyExists(vid interface{}, pid interface{}, certificationType interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeComplianceInfoAlreadyExists,
+		fmt.Sprintf("Certification information about the model with vid=%v, pid=%v and certification_type=%v "+
+			"already exists on the ledger", vid, pid, certificationType))
+}
@@ -0,0 +1,54 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Compliance authority delegated by a certification center to another account for a VID.
+type ComplianceAuthorityGrant struct {
+	VID            uint16         `json:"vid"`
+	Grantee        sdk.AccAddress `json:"grantee"`
+	Granter        sdk.AccAddress `json:"granter"`
+	ExpirationDate time.Time      `json:"expiration_date"` // rfc3339 encoded date
+}
+
+func NewComplianceAuthorityGrant(vid uint16, grantee sdk.AccAddress, granter sdk.AccAddress,
+	expirationDate time.Time) ComplianceAuthorityGrant {
+	return ComplianceAuthorityGrant{
+		VID:            vid,
+		Grantee:        grantee,
+		Granter:        granter,
+		ExpirationDate: expirationDate,
+	}
+}
+
+// The grant is active until its expiration date.
+func (d ComplianceAuthorityGrant) IsActive(now time.Time) bool {
+	return now.Before(d.ExpirationDate)
+}
+
+func (d ComplianceAuthorityGrant) String() string {
+	bytes, err := json.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
@@ -16,6 +16,9 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -26,7 +29,13 @@ const (
 	StoreKey = ModuleName
 )
 
-var ComplianceInfoPrefix = []byte{0x01} // prefix for each key to a compliance info
+var (
+	ComplianceInfoPrefix           = []byte{0x01} // prefix for each key to a compliance info
+	ComplianceAuthorityGrantPrefix = []byte{0x02} // prefix for each key to a compliance authority grant
+	ComplianceDateIndexPrefix      = []byte{0x03} // prefix for each key of the index sorting compliance infos by date
+	CertificationDeclarationPrefix = []byte{0x04} // prefix for each key to a certification declaration
+	ComplianceExpirationPrefix     = []byte{0x05} // prefix for each key of the index of the expiring compliance infos
+)
 
 // Key builder for Compliance Info.
 func GetComplianceInfoKey(certificationType CertificationType, vid uint16, pid uint16) []byte {
@@ -36,10 +45,74 @@ func GetComplianceInfoKey(certificationType CertificationType, vid uint16, pid u
 	p := make([]byte, 2)
 	binary.LittleEndian.PutUint16(p, pid)
 
-	return append(ComplianceInfoPrefix, append([]byte(certificationType), append(v, p...)...)...)
+	return append(GetCertificationPrefix(certificationType), append(v, p...)...)
 }
 
-// Key builder for Compliance Info.
+// Prefix of the Compliance Info keys of the certification type (of all types if empty).
+// The type is prefixed with its length, so that the prefix of one type (e.g. `zb`)
+// does not match the keys of another type starting with it (e.g. `zb_pro`).
 func GetCertificationPrefix(certificationType CertificationType) []byte {
-	return append(ComplianceInfoPrefix, []byte(certificationType)...)
+	prefix := append([]byte{}, ComplianceInfoPrefix...)
+	if len(certificationType) == 0 {
+		return prefix
+	}
+
+	return append(append(prefix, byte(len(certificationType))), []byte(certificationType)...)
+}
+
+// Key builder for the index of Compliance Info by date. The keys are sorted chronologically.
+func GetComplianceDateIndexKey(date time.Time, certificationType CertificationType, vid uint16, pid uint16) []byte {
+	id := GetComplianceInfoKey(certificationType, vid, pid)[len(ComplianceInfoPrefix):]
+
+	return append(ComplianceDateIndexPrefix, append(encodeDate(date), id...)...)
+}
+
+// Key builder for the index of the certified Compliance Info by expiration date.
+// The entries are iterated in the order of expiration.
+func GetComplianceExpirationKey(expirationDate time.Time,
+	certificationType CertificationType, vid uint16, pid uint16) []byte {
+	id := GetComplianceInfoKey(certificationType, vid, pid)[len(ComplianceInfoPrefix):]
+
+	return append(GetComplianceExpirationPrefix(expirationDate), id...)
+}
+
+// Prefix of the index entries of the Compliance Info expiring at the given date.
+func GetComplianceExpirationPrefix(expirationDate time.Time) []byte {
+	return append(append([]byte{}, ComplianceExpirationPrefix...), encodeDate(expirationDate)...)
+}
+
+// Encodes the date so that the keys are sorted chronologically (the sign bit of the seconds is flipped).
+func encodeDate(date time.Time) []byte {
+	d := make([]byte, 12)
+	binary.BigEndian.PutUint64(d, uint64(date.Unix())^(1<<63))
+	binary.BigEndian.PutUint32(d[8:], uint32(date.Nanosecond()))
+
+	return d
+}
+
+// Key builder for Compliance Authority Grant.
+func GetComplianceAuthorityGrantKey(vid uint16, grantee sdk.AccAddress) []byte {
+	return append(GetComplianceAuthorityGrantsPrefix(vid), grantee.Bytes()...)
+}
+
+// Key builder for Compliance Authority Grants of the VID.
+func GetComplianceAuthorityGrantsPrefix(vid uint16) []byte {
+	v := make([]byte, 2)
+	binary.LittleEndian.PutUint16(v, vid)
+
+	return append(ComplianceAuthorityGrantPrefix, v...)
+}
+
+// Key builder for Certification Declaration.
+func GetCertificationDeclarationKey(vid uint16, pid uint16, softwareVersion uint32) []byte {
+	v := make([]byte, 2)
+	binary.LittleEndian.PutUint16(v, vid)
+
+	p := make([]byte, 2)
+	binary.LittleEndian.PutUint16(p, pid)
+
+	s := make([]byte, 4)
+	binary.BigEndian.PutUint32(s, softwareVersion)
+
+	return append(CertificationDeclarationPrefix, append(v, append(p, s...)...)...)
 }
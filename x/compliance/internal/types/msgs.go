@@ -15,7 +15,10 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"net/url"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -30,6 +33,9 @@ type MsgCertifyModel struct {
 	CertificationType CertificationType `json:"certification_type"`
 	Reason            string            `json:"reason,omitempty"`
 	Signer            sdk.AccAddress    `json:"signer"`
+	ExpectedSequence  uint64            `json:"expected_sequence"` // checked if compliance info is already present
+	CDCertificateID   string            `json:"cd_certificate_id,omitempty"`
+	ExpirationDate    time.Time         `json:"expiration_date,omitempty"` // rfc3339 encoded date, optional
 }
 
 func NewMsgCertifyModel(vid uint16, pid uint16, certificationDate time.Time, certificationType CertificationType,
@@ -69,9 +75,21 @@ func (m MsgCertifyModel) ValidateBasic() sdk.Error {
 		return sdk.ErrUnknownRequest("Invalid CertificationDate: it cannot be empty")
 	}
 
-	if m.CertificationType != ZbCertificationType {
-		return sdk.ErrUnknownRequest(
-			fmt.Sprintf("Invalid CertificationType: \"%s\". Supported types: [%s]", m.CertificationType, ZbCertificationType))
+	if err := m.CertificationType.Validate(); err != nil {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid CertificationType: %v", err))
+	}
+
+	if IsExpirationDateSet(m.ExpirationDate) && !m.ExpirationDate.After(m.CertificationDate) {
+		return sdk.ErrUnknownRequest("Invalid ExpirationDate: it must be after CertificationDate")
+	}
+
+	if len(m.CDCertificateID) > MaxCDCertificateIDLength {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid CDCertificateID: it must be at most %v bytes long",
+			MaxCDCertificateIDLength))
+	}
+
+	if err := validateReason(m.Reason); err != nil {
+		return err
 	}
 
 	return nil
@@ -85,22 +103,90 @@ func (m MsgCertifyModel) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Signer}
 }
 
-type MsgRevokeModel struct {
+type MsgProvisionModel struct {
 	VID               uint16            `json:"vid"`
 	PID               uint16            `json:"pid"`
-	RevocationDate    time.Time         `json:"revocation_date"` // rfc3339 encoded date
+	ProvisionalDate   time.Time         `json:"provisional_date"` // rfc3339 encoded date
 	CertificationType CertificationType `json:"certification_type"`
 	Reason            string            `json:"reason,omitempty"`
 	Signer            sdk.AccAddress    `json:"signer"`
 }
 
+func NewMsgProvisionModel(vid uint16, pid uint16, provisionalDate time.Time, certificationType CertificationType,
+	reason string, signer sdk.AccAddress) MsgProvisionModel {
+	return MsgProvisionModel{
+		VID:               vid,
+		PID:               pid,
+		ProvisionalDate:   provisionalDate,
+		CertificationType: certificationType,
+		Reason:            reason,
+		Signer:            signer,
+	}
+}
+
+func (m MsgProvisionModel) Route() string {
+	return RouterKey
+}
+
+func (m MsgProvisionModel) Type() string {
+	return "provision_model"
+}
+
+func (m MsgProvisionModel) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if m.VID == 0 {
+		return sdk.ErrUnknownRequest("Invalid VID: it must be non zero 16-bit unsigned integer")
+	}
+
+	if m.PID == 0 {
+		return sdk.ErrUnknownRequest("Invalid PID: it must be non zero 16-bit unsigned integer")
+	}
+
+	if m.ProvisionalDate.IsZero() {
+		return sdk.ErrUnknownRequest("Invalid ProvisionalDate: it cannot be empty")
+	}
+
+	if err := m.CertificationType.Validate(); err != nil {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid CertificationType: %v", err))
+	}
+
+	if err := validateReason(m.Reason); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m MsgProvisionModel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgProvisionModel) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+type MsgRevokeModel struct {
+	VID               uint16               `json:"vid"`
+	PID               uint16               `json:"pid"`
+	RevocationDate    time.Time            `json:"revocation_date"` // rfc3339 encoded effective date
+	CertificationType CertificationType    `json:"certification_type"`
+	ReasonCode        RevocationReasonCode `json:"reason_code"`
+	Reason            string               `json:"reason,omitempty"`
+	Signer            sdk.AccAddress       `json:"signer"`
+	ExpectedSequence  uint64               `json:"expected_sequence"` // checked if compliance info is already present
+}
+
 func NewMsgRevokeModel(vid uint16, pid uint16, revocationDate time.Time, certificationType CertificationType,
-	revocationReason string, signer sdk.AccAddress) MsgRevokeModel {
+	reasonCode RevocationReasonCode, revocationReason string, signer sdk.AccAddress) MsgRevokeModel {
 	return MsgRevokeModel{
 		VID:               vid,
 		PID:               pid,
 		RevocationDate:    revocationDate,
 		CertificationType: certificationType,
+		ReasonCode:        reasonCode,
 		Reason:            revocationReason,
 		Signer:            signer,
 	}
@@ -131,9 +217,17 @@ func (m MsgRevokeModel) ValidateBasic() sdk.Error {
 		return sdk.ErrUnknownRequest("Invalid RevocationDate: it cannot be empty")
 	}
 
-	if m.CertificationType != ZbCertificationType {
+	if !m.ReasonCode.IsValid() {
 		return sdk.ErrUnknownRequest(
-			fmt.Sprintf("Invalid CertificationType: \"%s\". Supported types: [%s]", m.CertificationType, ZbCertificationType))
+			fmt.Sprintf("Invalid ReasonCode: \"%s\". Supported codes: %v", m.ReasonCode, RevocationReasonCodes))
+	}
+
+	if err := validateReason(m.Reason); err != nil {
+		return err
+	}
+
+	if err := m.CertificationType.Validate(); err != nil {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid CertificationType: %v", err))
 	}
 
 	return nil
@@ -146,3 +240,210 @@ func (m MsgRevokeModel) GetSignBytes() []byte {
 func (m MsgRevokeModel) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Signer}
 }
+
+type MsgGrantComplianceAuthority struct {
+	VID            uint16         `json:"vid"`
+	Grantee        sdk.AccAddress `json:"grantee"`
+	ExpirationDate time.Time      `json:"expiration_date"` // rfc3339 encoded date
+	Signer         sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgGrantComplianceAuthority(vid uint16, grantee sdk.AccAddress, expirationDate time.Time,
+	signer sdk.AccAddress) MsgGrantComplianceAuthority {
+	return MsgGrantComplianceAuthority{
+		VID:            vid,
+		Grantee:        grantee,
+		ExpirationDate: expirationDate,
+		Signer:         signer,
+	}
+}
+
+func (m MsgGrantComplianceAuthority) Route() string {
+	return RouterKey
+}
+
+func (m MsgGrantComplianceAuthority) Type() string {
+	return "grant_compliance_authority"
+}
+
+func (m MsgGrantComplianceAuthority) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if m.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Grantee: it cannot be empty")
+	}
+
+	if m.Grantee.Equals(m.Signer) {
+		return sdk.ErrUnknownRequest("Invalid Grantee: it cannot be the same as Signer")
+	}
+
+	if m.VID == 0 {
+		return sdk.ErrUnknownRequest("Invalid VID: it must be non zero 16-bit unsigned integer")
+	}
+
+	if m.ExpirationDate.IsZero() {
+		return sdk.ErrUnknownRequest("Invalid ExpirationDate: it cannot be empty")
+	}
+
+	return nil
+}
+
+func (m MsgGrantComplianceAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgGrantComplianceAuthority) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+type MsgRevokeComplianceAuthority struct {
+	VID     uint16         `json:"vid"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	Signer  sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgRevokeComplianceAuthority(vid uint16, grantee sdk.AccAddress,
+	signer sdk.AccAddress) MsgRevokeComplianceAuthority {
+	return MsgRevokeComplianceAuthority{
+		VID:     vid,
+		Grantee: grantee,
+		Signer:  signer,
+	}
+}
+
+func (m MsgRevokeComplianceAuthority) Route() string {
+	return RouterKey
+}
+
+func (m MsgRevokeComplianceAuthority) Type() string {
+	return "revoke_compliance_authority"
+}
+
+func (m MsgRevokeComplianceAuthority) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if m.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Grantee: it cannot be empty")
+	}
+
+	if m.VID == 0 {
+		return sdk.ErrUnknownRequest("Invalid VID: it must be non zero 16-bit unsigned integer")
+	}
+
+	return nil
+}
+
+func (m MsgRevokeComplianceAuthority) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgRevokeComplianceAuthority) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+type MsgAddCertificationDeclaration struct {
+	VID               uint16            `json:"vid"`
+	PID               uint16            `json:"pid"`
+	SoftwareVersion   uint32            `json:"software_version"`
+	CertificationType CertificationType `json:"certification_type"`
+	Content           []byte            `json:"content,omitempty"`      // DER encoded signed CD (base64 in JSON)
+	ContentHash       string            `json:"content_hash,omitempty"` // required if the content is not set
+	URL               string            `json:"url,omitempty"`          // required if the content is not set
+	Signer            sdk.AccAddress    `json:"signer"`
+}
+
+func NewMsgAddCertificationDeclaration(vid uint16, pid uint16, softwareVersion uint32,
+	certificationType CertificationType, content []byte, contentHash string, url string,
+	signer sdk.AccAddress) MsgAddCertificationDeclaration {
+	return MsgAddCertificationDeclaration{
+		VID:               vid,
+		PID:               pid,
+		SoftwareVersion:   softwareVersion,
+		CertificationType: certificationType,
+		Content:           content,
+		ContentHash:       contentHash,
+		URL:               url,
+		Signer:            signer,
+	}
+}
+
+func (m MsgAddCertificationDeclaration) Route() string {
+	return RouterKey
+}
+
+func (m MsgAddCertificationDeclaration) Type() string {
+	return "add_certification_declaration"
+}
+
+func (m MsgAddCertificationDeclaration) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if m.VID == 0 {
+		return sdk.ErrUnknownRequest("Invalid VID: it must be non zero 16-bit unsigned integer")
+	}
+
+	if m.PID == 0 {
+		return sdk.ErrUnknownRequest("Invalid PID: it must be non zero 16-bit unsigned integer")
+	}
+
+	if err := m.CertificationType.Validate(); err != nil {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid CertificationType: %v", err))
+	}
+
+	if len(m.Content) > MaxCertificationDeclarationSize {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Content: it must be at most %v bytes long; "+
+			"store the larger declarations off-ledger and pass their hash and URL", MaxCertificationDeclarationSize))
+	}
+
+	// the declarations stored off-ledger are referenced by the hash and the URL
+	if len(m.Content) == 0 && (len(m.ContentHash) == 0 || len(m.URL) == 0) {
+		return sdk.ErrUnknownRequest("Invalid CertificationDeclaration: either Content " +
+			"or both ContentHash and URL must be set")
+	}
+
+	if len(m.ContentHash) != 0 {
+		if hash, err := hex.DecodeString(m.ContentHash); err != nil || len(hash) != sha256.Size {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ContentHash: \"%s\". "+
+				"It must be hex encoded SHA-256", m.ContentHash))
+		}
+
+		if len(m.Content) != 0 && m.ContentHash != CertificationDeclarationHash(m.Content) {
+			return sdk.ErrUnknownRequest("Invalid ContentHash: it does not match Content")
+		}
+	}
+
+	if len(m.URL) != 0 {
+		if len(m.URL) > MaxCertificationDeclarationURLLength {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid URL: it must be at most %v bytes long",
+				MaxCertificationDeclarationURLLength))
+		}
+
+		if parsedURL, err := url.ParseRequestURI(m.URL); err != nil || len(parsedURL.Host) == 0 {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid URL: \"%s\". It must be an absolute URL", m.URL))
+		}
+	}
+
+	return nil
+}
+
+func (m MsgAddCertificationDeclaration) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgAddCertificationDeclaration) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func validateReason(reason string) sdk.Error {
+	if len(reason) > MaxReasonLength {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Reason: it must be at most %v bytes long", MaxReasonLength))
+	}
+
+	return nil
+}
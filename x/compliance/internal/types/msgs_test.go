@@ -17,6 +17,7 @@ package types
 
 //nolint:goimports
 import (
+	"strings"
 	"testing"
 	"time"
 
@@ -57,6 +58,12 @@ func TestMsgCertifyModelValidation(t *testing.T) {
 		{false, NewMsgCertifyModel(
 			testconstants.VID, testconstants.PID, testconstants.CertificationDate,
 			"Other Type", testconstants.Reason, testconstants.Signer)},
+		{true, NewMsgCertifyModel(
+			testconstants.VID, testconstants.PID, testconstants.CertificationDate,
+			MatterCertificationType, testconstants.Reason, testconstants.Signer)},
+		{true, NewMsgCertifyModel(
+			testconstants.VID, testconstants.PID, testconstants.CertificationDate,
+			ThreadCertificationType, testconstants.Reason, testconstants.Signer)},
 		{true, NewMsgCertifyModel(
 			testconstants.VID, testconstants.PID, testconstants.CertificationDate,
 			CertificationType(testconstants.CertificationType), "", testconstants.Signer)},
@@ -74,6 +81,23 @@ func TestMsgCertifyModelValidation(t *testing.T) {
 			require.NotNil(t, err)
 		}
 	}
+
+	msg := NewMsgCertifyModel(testconstants.VID, testconstants.PID, testconstants.CertificationDate,
+		MatterCertificationType, testconstants.Reason, testconstants.Signer)
+
+	msg.CDCertificateID = strings.Repeat("a", MaxCDCertificateIDLength)
+	require.Nil(t, msg.ValidateBasic())
+
+	msg.CDCertificateID = strings.Repeat("a", MaxCDCertificateIDLength+1)
+	require.NotNil(t, msg.ValidateBasic())
+
+	msg.CDCertificateID = ""
+
+	msg.ExpirationDate = testconstants.CertificationDate.AddDate(1, 0, 0)
+	require.Nil(t, msg.ValidateBasic())
+
+	msg.ExpirationDate = testconstants.CertificationDate
+	require.NotNil(t, msg.ValidateBasic())
 }
 
 func TestMsgCertifyModelGetSignBytes(t *testing.T) {
@@ -81,14 +105,16 @@ func TestMsgCertifyModelGetSignBytes(t *testing.T) {
 		CertificationType(testconstants.CertificationType), testconstants.EmptyString, testconstants.Signer)
 
 	expected := `{"type":"compliance/CertifyModel","value":{"certification_date":"2020-01-01T00:00:00Z",` +
-		`"certification_type":"zb","pid":22,"signer":"cosmos1p72j8mgkf39qjzcmr283w8l8y9qv30qpj056uz","vid":1}}`
+		`"certification_type":"zb","expected_sequence":"0","pid":22,` +
+		`"signer":"cosmos1p72j8mgkf39qjzcmr283w8l8y9qv30qpj056uz","vid":1}}`
 
 	require.Equal(t, expected, string(msg.GetSignBytes()))
 }
 
 func TestNewMsgRevokeModel(t *testing.T) {
 	msg := NewMsgRevokeModel(testconstants.VID, testconstants.PID, testconstants.RevocationDate,
-		CertificationType(testconstants.CertificationType), testconstants.RevocationReason, testconstants.Signer)
+		CertificationType(testconstants.CertificationType), RevocationReasonCode(testconstants.RevocationReasonCode),
+		testconstants.RevocationReason, testconstants.Signer)
 
 	require.Equal(t, msg.Route(), RouterKey)
 	require.Equal(t, msg.Type(), "revoke_model")
@@ -102,25 +128,43 @@ func TestMsgRevokeModelValidation(t *testing.T) {
 	}{
 		{true, NewMsgRevokeModel(
 			testconstants.VID, testconstants.PID, testconstants.RevocationDate,
-			CertificationType(testconstants.CertificationType), testconstants.RevocationReason, testconstants.Signer)},
+			CertificationType(testconstants.CertificationType), RevocationReasonCode(testconstants.RevocationReasonCode),
+			testconstants.RevocationReason, testconstants.Signer)},
 		{false, NewMsgRevokeModel(
 			0, testconstants.PID, testconstants.RevocationDate,
-			CertificationType(testconstants.CertificationType), testconstants.RevocationReason, testconstants.Signer)},
+			CertificationType(testconstants.CertificationType), RevocationReasonCode(testconstants.RevocationReasonCode),
+			testconstants.RevocationReason, testconstants.Signer)},
 		{false, NewMsgRevokeModel(
 			testconstants.VID, 0, testconstants.RevocationDate,
-			CertificationType(testconstants.CertificationType), testconstants.RevocationReason, testconstants.Signer)},
+			CertificationType(testconstants.CertificationType), RevocationReasonCode(testconstants.RevocationReasonCode),
+			testconstants.RevocationReason, testconstants.Signer)},
 		{false, NewMsgRevokeModel(
 			testconstants.VID, testconstants.PID, time.Time{},
-			CertificationType(testconstants.CertificationType), testconstants.RevocationReason, testconstants.Signer)},
+			CertificationType(testconstants.CertificationType), RevocationReasonCode(testconstants.RevocationReasonCode),
+			testconstants.RevocationReason, testconstants.Signer)},
 		{true, NewMsgRevokeModel(
 			testconstants.VID, testconstants.PID, testconstants.RevocationDate,
-			CertificationType(testconstants.CertificationType), "", testconstants.Signer)},
+			CertificationType(testconstants.CertificationType), RevocationReasonCode(testconstants.RevocationReasonCode),
+			"", testconstants.Signer)},
+		{false, NewMsgRevokeModel(
+			testconstants.VID, testconstants.PID, testconstants.RevocationDate,
+			"", RevocationReasonCode(testconstants.RevocationReasonCode),
+			testconstants.RevocationReason, testconstants.Signer)},
+		{false, NewMsgRevokeModel(
+			testconstants.VID, testconstants.PID, testconstants.RevocationDate,
+			CertificationType(testconstants.CertificationType), "", testconstants.RevocationReason, testconstants.Signer)},
 		{false, NewMsgRevokeModel(
 			testconstants.VID, testconstants.PID, testconstants.RevocationDate,
-			"", testconstants.RevocationReason, testconstants.Signer)},
+			CertificationType(testconstants.CertificationType), "unknown", testconstants.RevocationReason,
+			testconstants.Signer)},
 		{false, NewMsgRevokeModel(
 			testconstants.VID, testconstants.PID, testconstants.RevocationDate,
-			CertificationType(testconstants.CertificationType), testconstants.RevocationReason, nil)},
+			CertificationType(testconstants.CertificationType), RevocationReasonCode(testconstants.RevocationReasonCode),
+			testconstants.RevocationReason, nil)},
+		{false, NewMsgRevokeModel(
+			testconstants.VID, testconstants.PID, testconstants.RevocationDate,
+			CertificationType(testconstants.CertificationType), RevocationReasonCode(testconstants.RevocationReasonCode),
+			strings.Repeat("a", MaxReasonLength+1), testconstants.Signer)},
 	}
 
 	for _, tc := range cases {
@@ -136,9 +180,204 @@ func TestMsgRevokeModelValidation(t *testing.T) {
 
 func TestMsRevokeModelGetSignBytes(t *testing.T) {
 	msg := NewMsgRevokeModel(testconstants.VID, testconstants.PID, testconstants.RevocationDate,
-		CertificationType(testconstants.CertificationType), testconstants.RevocationReason, testconstants.Signer)
+		CertificationType(testconstants.CertificationType), RevocationReasonCode(testconstants.RevocationReasonCode),
+		testconstants.RevocationReason, testconstants.Signer)
+
+	expected := `{"type":"compliance/RevokeModel","value":{"certification_type":"zb","expected_sequence":"0",` +
+		`"pid":22,"reason":"Some Reason",` +
+		`"reason_code":"security_vulnerability","revocation_date":"2020-03-03T03:30:00Z",` +
+		`"signer":"cosmos1p72j8mgkf39qjzcmr283w8l8y9qv30qpj056uz","vid":1}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
+
+func TestNewMsgProvisionModel(t *testing.T) {
+	msg := NewMsgProvisionModel(testconstants.VID, testconstants.PID, testconstants.CertificationDate,
+		CertificationType(testconstants.CertificationType), testconstants.Reason, testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "provision_model")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestMsgProvisionModelValidation(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgProvisionModel
+	}{
+		{true, NewMsgProvisionModel(
+			testconstants.VID, testconstants.PID, testconstants.CertificationDate,
+			CertificationType(testconstants.CertificationType), testconstants.Reason, testconstants.Signer)},
+		{true, NewMsgProvisionModel(
+			testconstants.VID, testconstants.PID, testconstants.CertificationDate,
+			MatterCertificationType, "", testconstants.Signer)},
+		{false, NewMsgProvisionModel(
+			0, testconstants.PID, testconstants.CertificationDate,
+			CertificationType(testconstants.CertificationType), testconstants.Reason, testconstants.Signer)},
+		{false, NewMsgProvisionModel(
+			testconstants.VID, 0, testconstants.CertificationDate,
+			CertificationType(testconstants.CertificationType), testconstants.Reason, testconstants.Signer)},
+		{false, NewMsgProvisionModel(
+			testconstants.VID, testconstants.PID, time.Time{},
+			CertificationType(testconstants.CertificationType), testconstants.Reason, testconstants.Signer)},
+		{false, NewMsgProvisionModel(
+			testconstants.VID, testconstants.PID, testconstants.CertificationDate,
+			"", testconstants.Reason, testconstants.Signer)},
+		{false, NewMsgProvisionModel(
+			testconstants.VID, testconstants.PID, testconstants.CertificationDate,
+			CertificationType(testconstants.CertificationType), strings.Repeat("a", MaxReasonLength+1),
+			testconstants.Signer)},
+		{false, NewMsgProvisionModel(
+			testconstants.VID, testconstants.PID, testconstants.CertificationDate,
+			CertificationType(testconstants.CertificationType), testconstants.Reason, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestMsgProvisionModelGetSignBytes(t *testing.T) {
+	msg := NewMsgProvisionModel(testconstants.VID, testconstants.PID, testconstants.CertificationDate,
+		CertificationType(testconstants.CertificationType), testconstants.EmptyString, testconstants.Signer)
+
+	expected := `{"type":"compliance/ProvisionModel","value":{"certification_type":"zb","pid":22,` +
+		`"provisional_date":"2020-01-01T00:00:00Z","signer":"cosmos1p72j8mgkf39qjzcmr283w8l8y9qv30qpj056uz","vid":1}}`
 
-	expected := `{"type":"compliance/RevokeModel","value":{"certification_type":"zb","pid":22,"reason":"Some Reason",` +
-		`"revocation_date":"2020-03-03T03:30:00Z","signer":"cosmos1p72j8mgkf39qjzcmr283w8l8y9qv30qpj056uz","vid":1}}`
 	require.Equal(t, expected, string(msg.GetSignBytes()))
 }
+
+func TestNewMsgGrantComplianceAuthority(t *testing.T) {
+	msg := NewMsgGrantComplianceAuthority(testconstants.VID, testconstants.Address2, testconstants.RevocationDate,
+		testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "grant_compliance_authority")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestMsgGrantComplianceAuthorityValidation(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgGrantComplianceAuthority
+	}{
+		{true, NewMsgGrantComplianceAuthority(
+			testconstants.VID, testconstants.Address2, testconstants.RevocationDate, testconstants.Signer)},
+		{false, NewMsgGrantComplianceAuthority(
+			0, testconstants.Address2, testconstants.RevocationDate, testconstants.Signer)},
+		{false, NewMsgGrantComplianceAuthority(
+			testconstants.VID, nil, testconstants.RevocationDate, testconstants.Signer)},
+		{false, NewMsgGrantComplianceAuthority(
+			testconstants.VID, testconstants.Signer, testconstants.RevocationDate, testconstants.Signer)},
+		{false, NewMsgGrantComplianceAuthority(
+			testconstants.VID, testconstants.Address2, time.Time{}, testconstants.Signer)},
+		{false, NewMsgGrantComplianceAuthority(
+			testconstants.VID, testconstants.Address2, testconstants.RevocationDate, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestNewMsgRevokeComplianceAuthority(t *testing.T) {
+	msg := NewMsgRevokeComplianceAuthority(testconstants.VID, testconstants.Address2, testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "revoke_compliance_authority")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestMsgRevokeComplianceAuthorityValidation(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgRevokeComplianceAuthority
+	}{
+		{true, NewMsgRevokeComplianceAuthority(testconstants.VID, testconstants.Address2, testconstants.Signer)},
+		{false, NewMsgRevokeComplianceAuthority(0, testconstants.Address2, testconstants.Signer)},
+		{false, NewMsgRevokeComplianceAuthority(testconstants.VID, nil, testconstants.Signer)},
+		{false, NewMsgRevokeComplianceAuthority(testconstants.VID, testconstants.Address2, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestNewMsgAddCertificationDeclaration(t *testing.T) {
+	msg := NewMsgAddCertificationDeclaration(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+		MatterCertificationType, []byte("declaration"), "", "", testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "add_certification_declaration")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestMsgAddCertificationDeclarationValidation(t *testing.T) {
+	content := []byte("declaration")
+	hash := CertificationDeclarationHash(content)
+	url := "https://example.com/cd/1.der"
+
+	newMsg := func(vid uint16, pid uint16, certificationType CertificationType, content []byte,
+		contentHash string, url string, signer sdk.AccAddress) MsgAddCertificationDeclaration {
+		return NewMsgAddCertificationDeclaration(vid, pid, testconstants.SoftwareVersion, certificationType,
+			content, contentHash, url, signer)
+	}
+
+	cases := []struct {
+		valid bool
+		msg   MsgAddCertificationDeclaration
+	}{
+		{true, newMsg(testconstants.VID, testconstants.PID, MatterCertificationType, content, "", "",
+			testconstants.Signer)},
+		{true, newMsg(testconstants.VID, testconstants.PID, MatterCertificationType, content, hash, url,
+			testconstants.Signer)},
+		{true, newMsg(testconstants.VID, testconstants.PID, MatterCertificationType, nil, hash, url,
+			testconstants.Signer)},
+		{false, newMsg(0, testconstants.PID, MatterCertificationType, content, "", "", testconstants.Signer)},
+		{false, newMsg(testconstants.VID, 0, MatterCertificationType, content, "", "", testconstants.Signer)},
+		{false, newMsg(testconstants.VID, testconstants.PID, "", content, "", "", testconstants.Signer)},
+		{false, newMsg(testconstants.VID, testconstants.PID, MatterCertificationType, content, "", "", nil)},
+		{false, newMsg(testconstants.VID, testconstants.PID, MatterCertificationType, nil, "", "",
+			testconstants.Signer)},
+		{false, newMsg(testconstants.VID, testconstants.PID, MatterCertificationType, nil, hash, "",
+			testconstants.Signer)},
+		{false, newMsg(testconstants.VID, testconstants.PID, MatterCertificationType, nil, "", url,
+			testconstants.Signer)},
+		{false, newMsg(testconstants.VID, testconstants.PID, MatterCertificationType, nil, "abcd", url,
+			testconstants.Signer)},
+		{false, newMsg(testconstants.VID, testconstants.PID, MatterCertificationType, nil, hash, "cd/1.der",
+			testconstants.Signer)},
+		{false, newMsg(testconstants.VID, testconstants.PID, MatterCertificationType, []byte("other"), hash, "",
+			testconstants.Signer)},
+		{false, newMsg(testconstants.VID, testconstants.PID, MatterCertificationType,
+			make([]byte, MaxCertificationDeclarationSize+1), "", "", testconstants.Signer)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
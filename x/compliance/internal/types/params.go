@@ -0,0 +1,102 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// DefaultParamspace is the name of the parameter subspace of the module.
+const DefaultParamspace = ModuleName
+
+// Parameter keys of the module subspace.
+var (
+	KeyCertificationTypes = []byte("CertificationTypes")
+)
+
+/*
+	Parameters of the module stored in the module subspace (can be changed by Trustees)
+*/
+type Params struct {
+	// the certification types models can be certified (or revoked) for.
+	// Removing a type does not remove the compliance info records of that type, but hides them from the queries
+	// by model.
+	CertificationTypes []CertificationType `json:"certification_types"`
+}
+
+func NewParams(certificationTypes []CertificationType) Params {
+	return Params{
+		CertificationTypes: certificationTypes,
+	}
+}
+
+func DefaultParams() Params {
+	return NewParams(DefaultCertificationTypes)
+}
+
+// ParamKeyTable returns the key table of the module subspace.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyCertificationTypes, Value: &p.CertificationTypes},
+	}
+}
+
+func (p Params) Validate() error {
+	if len(p.CertificationTypes) == 0 {
+		return fmt.Errorf("invalid CertificationTypes: it cannot be empty")
+	}
+
+	for i, certificationType := range p.CertificationTypes {
+		if err := certificationType.Validate(); err != nil {
+			return fmt.Errorf("invalid CertificationTypes: %v", err)
+		}
+
+		for _, other := range p.CertificationTypes[i+1:] {
+			if certificationType == other {
+				return fmt.Errorf("invalid CertificationTypes: \"%s\" must not be duplicated", certificationType)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Check if models can be certified for the certification type.
+func (p Params) IsCertificationTypeAllowed(certificationType CertificationType) bool {
+	for _, allowed := range p.CertificationTypes {
+		if certificationType == allowed {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p Params) String() string {
+	bytes, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
@@ -0,0 +1,51 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidation(t *testing.T) {
+	require.NoError(t, DefaultParams().Validate())
+	require.NoError(t, NewParams([]CertificationType{"zb", "custom_type_1"}).Validate())
+	require.NoError(t, NewParams([]CertificationType{"zb", "zb_v2"}).Validate())
+	require.NoError(t, NewParams([]CertificationType{"thread_extended", "thread"}).Validate())
+
+	negative := [][]CertificationType{
+		nil,
+		{},
+		{""},
+		{"Matter"},
+		{"zb", "zb"},
+		{"a_very_long_certification_type_name"},
+	}
+
+	for _, certificationTypes := range negative {
+		require.Error(t, NewParams(certificationTypes).Validate(), certificationTypes)
+	}
+}
+
+func TestParamsIsCertificationTypeAllowed(t *testing.T) {
+	params := NewParams([]CertificationType{ZbCertificationType, "custom"})
+
+	require.True(t, params.IsCertificationTypeAllowed(ZbCertificationType))
+	require.True(t, params.IsCertificationTypeAllowed("custom"))
+	require.False(t, params.IsCertificationTypeAllowed(MatterCertificationType))
+	require.False(t, params.IsCertificationTypeAllowed("cust"))
+}
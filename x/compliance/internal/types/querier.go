@@ -16,6 +16,8 @@ package types
 
 import (
 	"encoding/json"
+
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
 )
 
 /*
@@ -23,11 +25,19 @@ import (
 */
 
 // Request Payload for QueryAllComplianceInfoRecords/QueryAllCertifiedModels/QueryAllRevokedModels
-//(pagination and filtering) query.
+// (pagination and filtering) query.
+// Fields the compliance info list queries can be sorted by.
+const SortByDate = "date"
+
+var ComplianceInfoSortFields = []string{SortByDate}
+
 type ListQueryParams struct {
 	CertificationType CertificationType
 	Skip              int
 	Take              int
+	Key               string
+	Sort              string
+	Order             string
 }
 
 func NewListQueryParams(certificationType CertificationType, skip int, take int) ListQueryParams {
@@ -38,14 +48,20 @@ func NewListQueryParams(certificationType CertificationType, skip int, take int)
 	}
 }
 
+func (p ListQueryParams) PaginationParams() pagination.PaginationParams {
+	return pagination.PaginationParams{Skip: p.Skip, Take: p.Take, Key: p.Key, Sort: p.Sort, Order: p.Order}
+}
+
 /*
 	Response Payload
 */
 
 // Response Payload for QueryAllComplianceInfoRecords query.
 type ListComplianceInfoItems struct {
-	Total int              `json:"total"`
-	Items []ComplianceInfo `json:"items"`
+	Total   int              `json:"total"`
+	Items   []ComplianceInfo `json:"items"`
+	NextKey string           `json:"next_key"`
+	PrevKey string           `json:"prev_key"`
 }
 
 // Implement fmt.Stringer.
@@ -60,8 +76,10 @@ func (n ListComplianceInfoItems) String() string {
 
 // Response Payload for QueryAllCertifiedModels/QueryAllRevokedModels queries.
 type ListComplianceInfoKeyItems struct {
-	Total int                 `json:"total"`
-	Items []ComplianceInfoKey `json:"items"`
+	Total   int                 `json:"total"`
+	Items   []ComplianceInfoKey `json:"items"`
+	NextKey string              `json:"next_key"`
+	PrevKey string              `json:"prev_key"`
 }
 
 // Implement fmt.Stringer.
@@ -74,6 +92,24 @@ func (n ListComplianceInfoKeyItems) String() string {
 	return string(res)
 }
 
+// Response Payload for QueryComplianceHistory query.
+type ComplianceHistory struct {
+	VID               uint16                  `json:"vid"`
+	PID               uint16                  `json:"pid"`
+	CertificationType CertificationType       `json:"certification_type"`
+	Items             []ComplianceHistoryItem `json:"items"`
+}
+
+// Implement fmt.Stringer.
+func (n ComplianceHistory) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
+
 // Response Payload for QueryCertifiedModel/QueryRevokedModel queries.
 type ComplianceInfoInState struct {
 	Value bool `json:"value"`
@@ -87,3 +123,90 @@ func (n ComplianceInfoInState) String() string {
 
 	return string(res)
 }
+
+// Response Payload for QueryAllComplianceAuthorityGrants query.
+type ListComplianceAuthorityGrants struct {
+	Total   int                        `json:"total"`
+	Items   []ComplianceAuthorityGrant `json:"items"`
+	NextKey string                     `json:"next_key"`
+	PrevKey string                     `json:"prev_key"`
+}
+
+// Implement fmt.Stringer.
+func (n ListComplianceAuthorityGrants) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
+
+// Response Payload for QueryAllCertificationDeclarations query.
+type ListCertificationDeclarations struct {
+	Total   int                        `json:"total"`
+	Items   []CertificationDeclaration `json:"items"`
+	NextKey string                     `json:"next_key"`
+	PrevKey string                     `json:"prev_key"`
+}
+
+// Implement fmt.Stringer.
+func (n ListCertificationDeclarations) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
+
+// Response Payload for the compliance lookup by firmware digest.
+type FirmwareCompliance struct {
+	FirmwareDigest string                   `json:"firmware_digest"`
+	Certified      bool                     `json:"certified"` // whether a model version with the digest is certified
+	Items          []FirmwareComplianceItem `json:"items"`
+}
+
+// Compliance of a model version with the firmware digest.
+type FirmwareComplianceItem struct {
+	VID                   uint16           `json:"vid"`
+	PID                   uint16           `json:"pid"`
+	SoftwareVersion       uint32           `json:"software_version"`
+	SoftwareVersionString string           `json:"software_version_string"`
+	ComplianceInfos       []ComplianceInfo `json:"compliance_info_records"`
+}
+
+func NewFirmwareCompliance(firmwareDigest string) FirmwareCompliance {
+	return FirmwareCompliance{FirmwareDigest: firmwareDigest, Items: []FirmwareComplianceItem{}}
+}
+
+// Adds the compliance of the model version.
+// Only the compliance infos of the certification type are kept if it is set.
+func (n *FirmwareCompliance) Add(item FirmwareComplianceItem, certificationType CertificationType) {
+	complianceInfos := []ComplianceInfo{}
+
+	for _, complianceInfo := range item.ComplianceInfos {
+		if len(certificationType) != 0 && complianceInfo.CertificationType != certificationType {
+			continue
+		}
+
+		if complianceInfo.State == Certified {
+			n.Certified = true
+		}
+
+		complianceInfos = append(complianceInfos, complianceInfo)
+	}
+
+	item.ComplianceInfos = complianceInfos
+	n.Items = append(n.Items, item)
+}
+
+// Implement fmt.Stringer.
+func (n FirmwareCompliance) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
@@ -16,6 +16,8 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
+	"regexp"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,14 +28,74 @@ type ComplianceState string
 const (
 	Certified ComplianceState = "certified"
 	Revoked   ComplianceState = "revoked"
+	Expired   ComplianceState = "expired" // the certification is over at its expiration date
+	// the model is approved pending the final paperwork, it becomes either certified or revoked
+	Provisional ComplianceState = "provisional"
 )
 
 type CertificationType string
 
 const (
-	ZbCertificationType CertificationType = "zb"
+	ZbCertificationType     CertificationType = "zb"
+	MatterCertificationType CertificationType = "matter"
+	ThreadCertificationType CertificationType = "thread"
 )
 
+// Certification types allowed by default (the allowed types are the module parameter).
+var DefaultCertificationTypes = []CertificationType{
+	ZbCertificationType,
+	MatterCertificationType,
+	ThreadCertificationType,
+}
+
+const MaxCertificationTypeLength = 32
+
+const MaxCDCertificateIDLength = 64
+
+const MaxReasonLength = 1024
+
+// Certification types are used in the store keys and REST paths.
+var certificationTypeRegexp = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Checks the format of the certification type. Whether the type is allowed is defined by the module params.
+func (t CertificationType) Validate() error {
+	if len(t) == 0 || len(t) > MaxCertificationTypeLength || !certificationTypeRegexp.MatchString(string(t)) {
+		return fmt.Errorf("\"%s\" must be non-empty string of at most %v lowercase letters, digits and underscores",
+			t, MaxCertificationTypeLength)
+	}
+
+	return nil
+}
+
+// Structured reason of a compliance revocation.
+type RevocationReasonCode string
+
+const (
+	SecurityVulnerability RevocationReasonCode = "security_vulnerability"
+	NonConformity         RevocationReasonCode = "non_conformity"
+	InvalidTestingResults RevocationReasonCode = "invalid_testing_results"
+	WithdrawnByVendor     RevocationReasonCode = "withdrawn_by_vendor"
+	OtherRevocationReason RevocationReasonCode = "other"
+)
+
+var RevocationReasonCodes = []RevocationReasonCode{
+	SecurityVulnerability,
+	NonConformity,
+	InvalidTestingResults,
+	WithdrawnByVendor,
+	OtherRevocationReason,
+}
+
+func (c RevocationReasonCode) IsValid() bool {
+	for _, code := range RevocationReasonCodes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
+
 /*
 	Compliance info stored into KVStore
 */
@@ -43,9 +105,15 @@ type ComplianceInfo struct {
 	State             ComplianceState         `json:"state"`
 	Date              time.Time               `json:"date"` // rfc3339 encoded date
 	CertificationType CertificationType       `json:"certification_type"`
+	ReasonCode        RevocationReasonCode    `json:"reason_code,omitempty"`
 	Reason            string                  `json:"reason,omitempty"`
 	Owner             sdk.AccAddress          `json:"owner"`
 	History           []ComplianceHistoryItem `json:"history,omitempty"`
+	Sequence          uint64                  `json:"sequence"` // incremented on every state transition
+	// the ID of the Certification Declaration of the Matter devices (appended to keep the binary encoding)
+	CDCertificateID string `json:"cd_certificate_id,omitempty"`
+	// the certification expires at the date if it is set (appended to keep the binary encoding)
+	ExpirationDate time.Time `json:"expiration_date,omitempty"`
 }
 
 func NewCertifiedComplianceInfo(vid uint16, pid uint16, certificationType CertificationType,
@@ -62,21 +130,40 @@ func NewCertifiedComplianceInfo(vid uint16, pid uint16, certificationType Certif
 	}
 }
 
-func NewRevokedComplianceInfo(vid uint16, pid uint16, certificationType CertificationType,
+func NewProvisionalComplianceInfo(vid uint16, pid uint16, certificationType CertificationType,
 	date time.Time, reason string, owner sdk.AccAddress) ComplianceInfo {
+	return ComplianceInfo{
+		VID:               vid,
+		PID:               pid,
+		State:             Provisional,
+		Date:              date,
+		CertificationType: certificationType,
+		Reason:            reason,
+		Owner:             owner,
+		History:           []ComplianceHistoryItem{},
+	}
+}
+
+func NewRevokedComplianceInfo(vid uint16, pid uint16, certificationType CertificationType,
+	date time.Time, reasonCode RevocationReasonCode, reason string, owner sdk.AccAddress) ComplianceInfo {
 	return ComplianceInfo{
 		VID:               vid,
 		PID:               pid,
 		State:             Revoked,
 		Date:              date,
 		CertificationType: certificationType,
+		ReasonCode:        reasonCode,
 		Reason:            reason,
 		Owner:             owner,
 		History:           []ComplianceHistoryItem{},
 	}
 }
 
-func (d *ComplianceInfo) UpdateComplianceInfo(date time.Time, reason string) {
+// Toggles the state (revoked, expired or provisional to certified and certified to revoked)
+// and moves the current one to the history.
+// The reason code is only meaningful for revocations and is expected to be empty on certification.
+// The expiration date of the previous certification is cleared.
+func (d *ComplianceInfo) UpdateComplianceInfo(date time.Time, reasonCode RevocationReasonCode, reason string) {
 	// Toggle state
 	var state ComplianceState
 	if d.State == Certified {
@@ -85,10 +172,55 @@ func (d *ComplianceInfo) UpdateComplianceInfo(date time.Time, reason string) {
 		state = Certified
 	}
 
-	d.History = append(d.History, NewComplianceHistoryItem(d.State, d.Date, d.Reason))
+	d.ChangeState(state, date, reasonCode, reason)
+}
+
+// Sets the state and moves the current one to the history.
+// The expiration date of the previous certification is cleared.
+func (d *ComplianceInfo) ChangeState(state ComplianceState, date time.Time,
+	reasonCode RevocationReasonCode, reason string) {
+	d.History = append(d.History, NewComplianceHistoryItem(d.State, d.Date, d.ReasonCode, d.Reason))
 	d.State = state
 	d.Date = date
+	d.ReasonCode = reasonCode
 	d.Reason = reason
+	d.ExpirationDate = time.Time{}
+	d.Sequence++
+}
+
+// Moves the certification to the history and marks the compliance info expired at the expiration date.
+func (d *ComplianceInfo) Expire() {
+	d.History = append(d.History, NewComplianceHistoryItem(d.State, d.Date, d.ReasonCode, d.Reason))
+	d.State = Expired
+	d.Date = d.ExpirationDate
+	d.ReasonCode = ""
+	d.Reason = ""
+	d.Sequence++
+}
+
+// Check if the compliance info is certified until its expiration date.
+func (d ComplianceInfo) IsExpiring() bool {
+	return d.State == Certified && IsExpirationDateSet(d.ExpirationDate)
+}
+
+// Check if the expiration date is set. Amino decodes the time missing in the binary encoding as the Unix epoch,
+// so it is the expiration date of the messages and the compliance infos encoded before the date was introduced.
+func IsExpirationDateSet(date time.Time) bool {
+	return !date.IsZero() && !date.Equal(time.Unix(0, 0))
+}
+
+// Returns all the state transitions of the compliance info including the current state, oldest first.
+func (d ComplianceInfo) FullHistory() ComplianceHistory {
+	items := make([]ComplianceHistoryItem, 0, len(d.History)+1)
+	items = append(items, d.History...)
+	items = append(items, NewComplianceHistoryItem(d.State, d.Date, d.ReasonCode, d.Reason))
+
+	return ComplianceHistory{
+		VID:               d.VID,
+		PID:               d.PID,
+		CertificationType: d.CertificationType,
+		Items:             items,
+	}
 }
 
 func (d ComplianceInfo) String() string {
@@ -104,16 +236,19 @@ func (d ComplianceInfo) String() string {
 	Compliance info state changes
 */
 type ComplianceHistoryItem struct {
-	State  ComplianceState `json:"state"`
-	Date   time.Time       `json:"date"` // rfc3339 encoded date
-	Reason string          `json:"reason,omitempty"`
+	State      ComplianceState      `json:"state"`
+	Date       time.Time            `json:"date"` // rfc3339 encoded date
+	ReasonCode RevocationReasonCode `json:"reason_code,omitempty"`
+	Reason     string               `json:"reason,omitempty"`
 }
 
-func NewComplianceHistoryItem(state ComplianceState, date time.Time, reason string) ComplianceHistoryItem {
+func NewComplianceHistoryItem(state ComplianceState, date time.Time,
+	reasonCode RevocationReasonCode, reason string) ComplianceHistoryItem {
 	return ComplianceHistoryItem{
-		State:  state,
-		Date:   date,
-		Reason: reason,
+		State:      state,
+		Date:       date,
+		ReasonCode: reasonCode,
+		Reason:     reason,
 	}
 }
 
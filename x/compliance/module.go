@@ -29,14 +29,23 @@ import (
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance/client/rest"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliancetest"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade"
 )
 
 // type check to ensure the interface is properly implemented.
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule            = AppModule{}
+	_ module.AppModuleBasic       = AppModuleBasic{}
+	_ upgrade.HasConsensusVersion = AppModule{}
+	_ upgrade.HasMigrations       = AppModule{}
 )
 
+// Consensus version of the module store schema.
+// Version 2 adds the index sorting compliance infos by date.
+// Version 3 adds the expiration date of the compliance infos.
+// Version 4 prefixes the certification type with its length in the compliance info keys.
+const ConsensusVersion = 4
+
 // app module Basics object.
 type AppModuleBasic struct{}
 
@@ -131,6 +140,35 @@ func (a AppModule) NewQuerierHandler() sdk.Querier {
 
 func (a AppModule) BeginBlock(sdk.Context, abci.RequestBeginBlock) {}
 
-func (a AppModule) EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (a AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	a.keeper.EndBlocker(ctx)
+
 	return []abci.ValidatorUpdate{}
 }
+
+func (a AppModule) ConsensusVersion() uint64 {
+	return ConsensusVersion
+}
+
+func (a AppModule) RegisterMigrations(registrar upgrade.MigrationRegistrar) {
+	// version 1 -> 2: build the date index for the compliance infos stored before it was introduced
+	registrar.RegisterMigration(ModuleName, 1, func(ctx sdk.Context) error {
+		a.keeper.RebuildDateIndex(ctx)
+
+		return nil
+	})
+
+	// version 2 -> 3: clear the expiration date of the compliance infos stored before it was introduced
+	registrar.RegisterMigration(ModuleName, 2, func(ctx sdk.Context) error {
+		a.keeper.ClearUnsetExpirationDates(ctx)
+
+		return nil
+	})
+
+	// version 3 -> 4: move the compliance infos to the keys with the length-prefixed certification type
+	registrar.RegisterMigration(ModuleName, 3, func(ctx sdk.Context) error {
+		a.keeper.MigrateComplianceInfoKeys(ctx)
+
+		return nil
+	})
+}
@@ -24,15 +24,20 @@ const (
 	RouterKey                     = types.RouterKey
 	StoreKey                      = types.StoreKey
 	CodeTestingResultDoesNotExist = types.CodeTestingResultsDoNotExist
+
+	TestCasePassed = types.TestCasePassed
+	TestCaseFailed = types.TestCaseFailed
 )
 
 var (
 	NewKeeper                    = keeper.NewKeeper
 	NewQuerier                   = keeper.NewQuerier
+	TestingResultsPrefix         = types.TestingResultsPrefix
 	NewMsgAddTestingResult       = types.NewMsgAddTestingResult
 	ModuleCdc                    = types.ModuleCdc
 	RegisterCodec                = types.RegisterCodec
 	ErrTestingResultDoesNotExist = types.ErrTestingResultDoesNotExist
+	NewTestCaseFilter            = types.NewTestCaseFilter
 )
 
 type (
@@ -40,4 +45,9 @@ type (
 	MsgAddTestingResult = types.MsgAddTestingResult
 	TestingResults      = types.TestingResults
 	TestingResult       = types.TestingResult
+	TestingResultItem   = types.TestingResultItem
+	TestReport          = types.TestReport
+	TestCase            = types.TestCase
+	Attachment          = types.Attachment
+	TestCaseFilter      = types.TestCaseFilter
 )
@@ -21,4 +21,9 @@ const (
 	FlagTestResultShortcut = "r"
 	FlagTestDate           = "test-date"
 	FlagTestDateShortcut   = "d"
+	FlagReport             = "report"
+	FlagTestCaseID         = "test-case-id"
+	FlagTestCaseResult     = "test-case-result"
+	FlagSoftwareVersion    = "software-version"
+	FlagLatest             = "latest"
 )
@@ -15,6 +15,8 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
@@ -57,6 +59,27 @@ func GetCmdTestingResult(queryRoute string, cdc *codec.Codec) *cobra.Command {
 				return err_
 			}
 
+			query := "testresult"
+			if viper.GetBool(FlagLatest) {
+				query = "latest_testresults"
+			}
+
+			path := fmt.Sprintf("custom/%s/%s/%v/%v", queryRoute, query, vid, pid)
+
+			if viper.GetString(FlagSoftwareVersion) != "" {
+				softwareVersion, err_ := conversions.ParseUInt32FromString(viper.GetString(FlagSoftwareVersion))
+				if err_ != nil {
+					return err_
+				}
+
+				path = fmt.Sprintf("%s/%v", path, softwareVersion)
+			}
+
+			filter := types.NewTestCaseFilter(viper.GetString(FlagTestCaseID), viper.GetString(FlagTestCaseResult))
+			if !filter.IsEmpty() || viper.GetBool(FlagLatest) || viper.GetString(FlagSoftwareVersion) != "" {
+				return cliCtx.QueryList(path, filter)
+			}
+
 			res, height, err := cliCtx.QueryStore(types.GetTestingResultsKey(vid, pid), queryRoute)
 			if err != nil || res == nil {
 				return types.ErrTestingResultDoesNotExist(vid, pid)
@@ -71,6 +94,10 @@ func GetCmdTestingResult(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().String(FlagVID, "", "Model vendor ID")
 	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().String(FlagSoftwareVersion, "", "Return only the results for the given software version (optional)")
+	cmd.Flags().Bool(FlagLatest, false, "Return only the latest result of every test house")
+	cmd.Flags().String(FlagTestCaseID, "", "Return only the test cases with the given ID (optional)")
+	cmd.Flags().String(FlagTestCaseResult, "", "Return only the test cases with the given result: pass or fail (optional)")
 	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
 
 	_ = cmd.MarkFlagRequired(FlagVID)
@@ -62,18 +62,41 @@ func GetCmdAddTestingResult(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			var softwareVersion uint32
+
+			if viper.GetString(FlagSoftwareVersion) != "" {
+				softwareVersion, err = conversions.ParseUInt32FromString(viper.GetString(FlagSoftwareVersion))
+				if err != nil {
+					return err
+				}
+			}
+
 			testResult, err_ := cliCtx.ReadFromFile(viper.GetString(FlagTestResult))
 			if err_ != nil {
 				return err_
 			}
 
+			var report *types.TestReport
+
+			if viper.GetString(FlagReport) != "" {
+				reportJSON, err_ := cliCtx.ReadFromFile(viper.GetString(FlagReport))
+				if err_ != nil {
+					return err_
+				}
+
+				report = &types.TestReport{}
+				if err_ := cdc.UnmarshalJSON([]byte(reportJSON), report); err_ != nil {
+					return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Report: it must be JSON encoded: %v", err_))
+				}
+			}
+
 			testDate, err_ := time.Parse(time.RFC3339, viper.GetString(FlagTestDate))
 			if err_ != nil {
 				return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid TestDate \"%v\": "+
 					"it must be RFC3339 encoded date", viper.GetString(FlagTestDate)))
 			}
 
-			msg := types.NewMsgAddTestingResult(vid, pid, testResult, testDate, cliCtx.FromAddress())
+			msg := types.NewMsgAddTestingResult(vid, pid, softwareVersion, testResult, report, testDate, cliCtx.FromAddress())
 
 			return cliCtx.HandleWriteMessage(msg)
 		},
@@ -81,13 +104,17 @@ func GetCmdAddTestingResult(cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().String(FlagVID, "", "Model vendor ID")
 	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().String(FlagSoftwareVersion, "", "Tested software version of the Model (optional)")
 	cmd.Flags().StringP(FlagTestResult, FlagTestResultShortcut, "",
 		"Test result (string or path to file containing data)")
+	cmd.Flags().String(FlagReport, "",
+		"Structured test report (JSON string or path to file containing it): "+
+			"{\"test_plan_id\", \"test_house_id\", \"test_cases\": [{\"id\", \"name\", \"result\": \"pass|fail\"}], "+
+			"\"attachments\": [{\"name\", \"url\", \"hash\"}]}")
 	cmd.Flags().StringP(FlagTestDate, FlagTestDateShortcut, "", "Date of test result (rfc3339 encoded)")
 
 	_ = cmd.MarkFlagRequired(FlagVID)
 	_ = cmd.MarkFlagRequired(FlagPID)
-	_ = cmd.MarkFlagRequired(FlagTestResult)
 	_ = cmd.MarkFlagRequired(FlagTestDate)
 
 	return cmd
@@ -15,6 +15,7 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -31,21 +32,49 @@ func getTestingResultHandler(cliCtx context.CLIContext, storeName string) http.H
 
 		vid, err_ := conversions.ParseVID(vars[vid])
 		if err_ != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err_.Error())
+			restCtx.WriteError(http.StatusBadRequest, err_)
 
 			return
 		}
 
 		pid, err_ := conversions.ParsePID(vars[pid])
 		if err_ != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err_.Error())
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		query := "testresult"
+		latest := r.FormValue(latest) == "true"
+
+		if latest {
+			query = "latest_testresults"
+		}
+
+		path := fmt.Sprintf("custom/%s/%s/%v/%v", storeName, query, vid, pid)
+
+		_, hasSoftwareVersion := vars[softwareVersion]
+		if hasSoftwareVersion {
+			softwareVersion, err_ := conversions.ParseUInt32FromString(vars[softwareVersion])
+			if err_ != nil {
+				restCtx.WriteError(http.StatusBadRequest, err_)
+
+				return
+			}
+
+			path = fmt.Sprintf("%s/%v", path, softwareVersion)
+		}
+
+		filter := types.NewTestCaseFilter(r.FormValue(testCaseID), r.FormValue(testCaseResult))
+		if !filter.IsEmpty() || latest || hasSoftwareVersion {
+			restCtx.QueryList(path, filter)
 
 			return
 		}
 
 		res, height, err := restCtx.QueryStore(types.GetTestingResultsKey(vid, pid), storeName)
 		if err != nil || res == nil {
-			restCtx.WriteErrorResponse(http.StatusNotFound, types.ErrTestingResultDoesNotExist(vid, pid).Error())
+			restCtx.WriteError(http.StatusNotFound, types.ErrTestingResultDoesNotExist(vid, pid))
 
 			return
 		}
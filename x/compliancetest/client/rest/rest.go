@@ -22,8 +22,12 @@ import (
 )
 
 const (
-	vid = "vid"
-	pid = "pid"
+	vid             = "vid"
+	pid             = "pid"
+	testCaseID      = "test_case_id"
+	testCaseResult  = "test_case_result"
+	softwareVersion = "software_version"
+	latest          = "latest"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application.
@@ -36,4 +40,8 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		fmt.Sprintf("/%s/testresults/{%s}/{%s}", storeName, vid, pid),
 		getTestingResultHandler(cliCtx, storeName),
 	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/testresults/{%s}/{%s}/{%s}", storeName, vid, pid, softwareVersion),
+		getTestingResultHandler(cliCtx, storeName),
+	).Methods("GET")
 }
@@ -46,6 +46,17 @@ func ValidateGenesis(data GenesisState) error {
 			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid TestingResultRecord: value: %s. "+
 				"Error: Missing TestResult", record.Results))
 		}
+
+		for _, result := range record.Results {
+			if result.Report == nil {
+				continue
+			}
+
+			if err := result.Report.Validate(); err != nil {
+				return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid TestingResultRecord: value: %v. "+
+					"Error: %v", result, err.Data()))
+			}
+		}
 	}
 
 	return nil
@@ -24,6 +24,11 @@ import (
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
 )
 
+func init() {
+	// sender must have TestHouse role to add testing results
+	auth.RegisterMsgRoles(types.MsgAddTestingResult{}, auth.TestHouse)
+}
+
 func NewHandler(keeper keeper.Keeper, modelinfoKeeper modelinfo.Keeper, authKeeper auth.Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		switch msg := msg.(type) {
@@ -40,7 +45,7 @@ func NewHandler(keeper keeper.Keeper, modelinfoKeeper modelinfo.Keeper, authKeep
 func handleMsgAddTestingResult(ctx sdk.Context, keeper keeper.Keeper, modelinfoKeeper modelinfo.Keeper,
 	authKeeper auth.Keeper, msg types.MsgAddTestingResult) sdk.Result {
 	// check if sender has enough rights to add testing results
-	if err := checkAddTestingResultRights(ctx, authKeeper, msg.Signer); err != nil {
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
 		return err.Result()
 	}
 
@@ -49,11 +54,18 @@ func handleMsgAddTestingResult(ctx sdk.Context, keeper keeper.Keeper, modelinfoK
 		return modelinfo.ErrModelInfoDoesNotExist(msg.VID, msg.PID).Result()
 	}
 
+	// check that the tested software version of the model exists on the ledger
+	if msg.SoftwareVersion != 0 && !modelinfoKeeper.IsModelVersionPresent(ctx, msg.VID, msg.PID, msg.SoftwareVersion) {
+		return modelinfo.ErrModelVersionDoesNotExist(msg.VID, msg.PID, msg.SoftwareVersion).Result()
+	}
+
 	testingResult := types.NewTestingResult(
 		msg.VID,
 		msg.PID,
+		msg.SoftwareVersion,
 		msg.Signer,
 		msg.TestResult,
+		msg.Report,
 		msg.TestDate,
 	)
 
@@ -62,13 +74,3 @@ func handleMsgAddTestingResult(ctx sdk.Context, keeper keeper.Keeper, modelinfoK
 
 	return sdk.Result{}
 }
-
-func checkAddTestingResultRights(ctx sdk.Context, authKeeper auth.Keeper, signer sdk.AccAddress) sdk.Error {
-	// sender must have TestHouse role to add new model
-	if !authKeeper.HasRole(ctx, signer, auth.TestHouse) {
-		return sdk.ErrUnauthorized(fmt.Sprintf(
-			"MsgAddTestingResult transaction should be signed by an account with the %s role", auth.TestHouse))
-	}
-
-	return nil
-}
@@ -50,6 +50,66 @@ func TestHandler_AddTestingResult(t *testing.T) {
 	CheckTestingResult(t, receivedTestingResult.Results[0], testingResult)
 }
 
+func TestHandler_AddTestingResultWithReport(t *testing.T) {
+	setup := Setup()
+
+	// add model
+	vid, pid := addModel(setup, test_constants.VID, test_constants.PID)
+
+	// add new testing result with structured report only
+	testingResult := TestMsgAddTestingResult(setup.TestHouse, vid, pid)
+	testingResult.TestResult = ""
+	testingResult.Report = &TestReport{
+		TestPlanID:  "test-plan",
+		TestHouseID: "test-house",
+		TestCases:   []TestCase{{ID: "TC-1", Result: TestCasePassed}},
+		Attachments: []Attachment{{Hash: "4f2c"}},
+	}
+	result := setup.Handler(setup.Ctx, testingResult)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query testing result
+	receivedTestingResult := queryTestingResult(setup, vid, pid)
+
+	// check
+	require.Equal(t, 1, len(receivedTestingResult.Results))
+	CheckTestingResult(t, receivedTestingResult.Results[0], testingResult)
+}
+
+func TestHandler_AddTestingResultForModelVersion(t *testing.T) {
+	setup := Setup()
+
+	// add model and model version
+	vid, pid := addModel(setup, test_constants.VID, test_constants.PID)
+	softwareVersion := addModelVersion(setup, vid, pid, test_constants.SoftwareVersion)
+
+	// add new testing result for model version
+	testingResult := TestMsgAddTestingResult(setup.TestHouse, vid, pid)
+	testingResult.SoftwareVersion = softwareVersion
+	result := setup.Handler(setup.Ctx, testingResult)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query testing result
+	receivedTestingResult := queryTestingResult(setup, vid, pid)
+
+	// check
+	require.Equal(t, 1, len(receivedTestingResult.Results))
+	CheckTestingResult(t, receivedTestingResult.Results[0], testingResult)
+}
+
+func TestHandler_AddTestingResultForUnknownModelVersion(t *testing.T) {
+	setup := Setup()
+
+	// add model
+	vid, pid := addModel(setup, test_constants.VID, test_constants.PID)
+
+	// add new testing result for unknown model version
+	testingResult := TestMsgAddTestingResult(setup.TestHouse, vid, pid)
+	testingResult.SoftwareVersion = test_constants.SoftwareVersion
+	result := setup.Handler(setup.Ctx, testingResult)
+	require.Equal(t, modelinfo.CodeModelVersionDoesNotExist, result.Code)
+}
+
 func TestHandler_AddTestingResultByNonTestHouse(t *testing.T) {
 	setup := Setup()
 	vid, pid := addModel(setup, test_constants.VID, test_constants.PID)
@@ -62,7 +122,7 @@ func TestHandler_AddTestingResultByNonTestHouse(t *testing.T) {
 		// add new testing result by non TestHouse
 		testingResult := TestMsgAddTestingResult(test_constants.Address3, vid, pid)
 		result := setup.Handler(setup.Ctx, testingResult)
-		require.Equal(t, sdk.CodeUnauthorized, result.Code)
+		require.Equal(t, auth.CodeMissingRole, result.Code)
 	}
 }
 
@@ -184,3 +244,18 @@ func addModel(setup TestSetup, vid uint16, pid uint16) (uint16, uint16) {
 
 	return vid, pid
 }
+
+func addModelVersion(setup TestSetup, vid uint16, pid uint16, softwareVersion uint32) uint32 {
+	modelVersion := modelinfo.ModelVersion{
+		VID:                          vid,
+		PID:                          pid,
+		SoftwareVersion:              softwareVersion,
+		SoftwareVersionString:        test_constants.SoftwareVersionString,
+		MinApplicableSoftwareVersion: test_constants.MinApplicableSoftwareVersion,
+		MaxApplicableSoftwareVersion: test_constants.MaxApplicableSoftwareVersion,
+	}
+
+	setup.ModelinfoKeeper.SetModelVersion(setup.Ctx, modelVersion)
+
+	return softwareVersion
+}
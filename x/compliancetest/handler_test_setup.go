@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/go-amino"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -62,11 +63,17 @@ func Setup() TestSetup {
 	modelinfoKey := sdk.NewKVStoreKey(modelinfo.StoreKey)
 	dbStore.MountStoreWithDB(modelinfoKey, sdk.StoreTypeIAVL, nil)
 
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+	dbStore.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+
 	_ = dbStore.LoadLatestVersion()
 
 	// Init Keepers
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, paramsTKey, params.DefaultCodespace)
 	compliancetestKeeper := NewKeeper(complianceKey, cdc)
-	authKeeper := auth.NewKeeper(authKey, cdc)
+	authKeeper := auth.NewKeeper(authKey, paramsKeeper.Subspace(auth.DefaultParamspace), cdc)
 	modelinfoKeeper := modelinfo.NewKeeper(modelinfoKey, cdc)
 
 	// Create context
@@ -108,5 +115,7 @@ func CheckTestingResult(t *testing.T, receivedTestingResult types.TestingResultI
 	expectedTestingResult types.MsgAddTestingResult) {
 	require.Equal(t, receivedTestingResult.Owner, expectedTestingResult.Signer)
 	require.Equal(t, receivedTestingResult.TestResult, expectedTestingResult.TestResult)
+	require.Equal(t, receivedTestingResult.Report, expectedTestingResult.Report)
 	require.Equal(t, receivedTestingResult.TestDate, expectedTestingResult.TestDate)
+	require.Equal(t, receivedTestingResult.SoftwareVersion, expectedTestingResult.SoftwareVersion)
 }
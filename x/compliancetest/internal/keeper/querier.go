@@ -15,6 +15,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -23,21 +25,29 @@ import (
 )
 
 const (
-	QueryTestingResult = "testresult"
+	QueryTestingResult        = "testresult"
+	QueryLatestTestingResults = "latest_testresults"
 )
 
 func NewQuerier(keeper Keeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
 		switch path[0] {
 		case QueryTestingResult:
-			return queryTestingResult(ctx, path[1:], keeper)
+			return queryTestingResult(ctx, path[1:], req, keeper, false)
+		case QueryLatestTestingResults:
+			return queryTestingResult(ctx, path[1:], req, keeper, true)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown compliancetest query endpoint")
 		}
 	}
 }
 
-func queryTestingResult(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+// Returns the testing results of the model (of the given software version if it is specified in the path).
+// If latestOnly is set, only the latest result of every test house is returned.
+// If the request data contains a test case filter, only the results with the reports containing
+// the matching test cases are returned.
+func queryTestingResult(ctx sdk.Context, path []string, req abci.RequestQuery,
+	keeper Keeper, latestOnly bool) (res []byte, err sdk.Error) {
 	vid, err := conversions.ParseVID(path[0])
 	if err != nil {
 		return nil, err
@@ -54,6 +64,34 @@ func queryTestingResult(ctx sdk.Context, path []string, keeper Keeper) (res []by
 
 	testingResult := keeper.GetTestingResults(ctx, vid, pid)
 
+	if len(path) > 2 {
+		softwareVersion, err := conversions.ParseUInt32FromString(path[2])
+		if err != nil {
+			return nil, err
+		}
+
+		testingResult = testingResult.ForSoftwareVersion(softwareVersion)
+	}
+
+	if latestOnly {
+		testingResult = testingResult.LatestPerTestHouse()
+	}
+
+	if len(req.Data) > 0 {
+		var filter types.TestCaseFilter
+		if err := keeper.cdc.UnmarshalJSON(req.Data, &filter); err != nil {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+		}
+
+		if len(filter.Result) != 0 && !types.IsValidTestCaseResult(filter.Result) {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Invalid test case result filter: %v", filter.Result))
+		}
+
+		if !filter.IsEmpty() {
+			testingResult = testingResult.Filter(filter)
+		}
+	}
+
 	res = codec.MustMarshalJSONIndent(keeper.cdc, testingResult)
 
 	return res, nil
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	test_constants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
@@ -60,3 +61,134 @@ func TestQuerier_QueryTestingResultForUnknown(t *testing.T) {
 	)
 	require.Equal(t, types.CodeTestingResultsDoNotExist, err.Code())
 }
+
+func TestQuerier_QueryTestingResultByTestCase(t *testing.T) {
+	setup := Setup()
+
+	// add testing results with and without report
+	setup.CompliancetestKeeper.AddTestingResult(setup.Ctx, DefaultTestingResult())
+
+	testingResult := DefaultTestingResult()
+	testingResult.Report = DefaultTestReport()
+	setup.CompliancetestKeeper.AddTestingResult(setup.Ctx, testingResult)
+
+	// query passed test cases
+	receivedTestingResult := queryTestingResultByTestCase(setup, types.NewTestCaseFilter("", types.TestCasePassed))
+	require.Equal(t, 1, len(receivedTestingResult.Results))
+	require.Equal(t, []types.TestCase{testingResult.Report.TestCases[0], testingResult.Report.TestCases[2]},
+		receivedTestingResult.Results[0].Report.TestCases)
+	require.Equal(t, testingResult.Report.TestPlanID, receivedTestingResult.Results[0].Report.TestPlanID)
+
+	// query test case by id and result
+	receivedTestingResult = queryTestingResultByTestCase(setup, types.NewTestCaseFilter("TC-2", types.TestCaseFailed))
+	require.Equal(t, 1, len(receivedTestingResult.Results))
+	require.Equal(t, []types.TestCase{testingResult.Report.TestCases[1]},
+		receivedTestingResult.Results[0].Report.TestCases)
+
+	// query test case which does not match
+	receivedTestingResult = queryTestingResultByTestCase(setup, types.NewTestCaseFilter("TC-2", types.TestCasePassed))
+	require.Equal(t, 0, len(receivedTestingResult.Results))
+
+	// empty filter returns all results
+	receivedTestingResult = queryTestingResultByTestCase(setup, types.NewTestCaseFilter("", ""))
+	require.Equal(t, 2, len(receivedTestingResult.Results))
+
+	// invalid result
+	_, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryTestingResult, fmt.Sprintf("%v", testingResult.VID), fmt.Sprintf("%v", testingResult.PID)},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(types.NewTestCaseFilter("", "unknown"))},
+	)
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+}
+
+func TestQuerier_QueryTestingResultBySoftwareVersion(t *testing.T) {
+	setup := Setup()
+
+	// add testing results for different software versions
+	setup.CompliancetestKeeper.AddTestingResult(setup.Ctx, DefaultTestingResult())
+
+	testingResult := DefaultTestingResult()
+	testingResult.SoftwareVersion = test_constants.SoftwareVersion
+	setup.CompliancetestKeeper.AddTestingResult(setup.Ctx, testingResult)
+
+	// query testing results for software version
+	receivedTestingResult := queryTestingResultList(setup, QueryTestingResult,
+		fmt.Sprintf("%v", test_constants.SoftwareVersion))
+	require.Equal(t, 1, len(receivedTestingResult.Results))
+	CheckTestingResult(t, receivedTestingResult.Results[0], testingResult)
+
+	// query testing results for unknown software version
+	receivedTestingResult = queryTestingResultList(setup, QueryTestingResult, "2")
+	require.Equal(t, 0, len(receivedTestingResult.Results))
+
+	// invalid software version
+	_, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryTestingResult, fmt.Sprintf("%v", test_constants.VID), fmt.Sprintf("%v", test_constants.PID), "-1"},
+		abci.RequestQuery{},
+	)
+	require.NotNil(t, err)
+}
+
+func TestQuerier_QueryLatestTestingResults(t *testing.T) {
+	setup := Setup()
+
+	// add two testing results by first test house and one by second
+	firstTestingResult := DefaultTestingResult()
+	setup.CompliancetestKeeper.AddTestingResult(setup.Ctx, firstTestingResult)
+
+	secondTestingResult := DefaultTestingResult()
+	secondTestingResult.Owner = test_constants.Address2
+	setup.CompliancetestKeeper.AddTestingResult(setup.Ctx, secondTestingResult)
+
+	thirdTestingResult := DefaultTestingResult()
+	thirdTestingResult.TestResult = "Third Testing Result"
+	thirdTestingResult.SoftwareVersion = test_constants.SoftwareVersion
+	setup.CompliancetestKeeper.AddTestingResult(setup.Ctx, thirdTestingResult)
+
+	// query latest testing results
+	receivedTestingResult := queryTestingResultList(setup, QueryLatestTestingResults)
+	require.Equal(t, 2, len(receivedTestingResult.Results))
+	CheckTestingResult(t, receivedTestingResult.Results[0], secondTestingResult)
+	CheckTestingResult(t, receivedTestingResult.Results[1], thirdTestingResult)
+
+	// query latest testing results for software version
+	receivedTestingResult = queryTestingResultList(setup, QueryLatestTestingResults,
+		fmt.Sprintf("%v", test_constants.SoftwareVersion))
+	require.Equal(t, 1, len(receivedTestingResult.Results))
+	CheckTestingResult(t, receivedTestingResult.Results[0], thirdTestingResult)
+}
+
+func queryTestingResultList(setup TestSetup, query string, softwareVersion ...string) types.TestingResults {
+	path := append(
+		[]string{query, fmt.Sprintf("%v", test_constants.VID), fmt.Sprintf("%v", test_constants.PID)},
+		softwareVersion...,
+	)
+
+	result, err := setup.Querier(setup.Ctx, path, abci.RequestQuery{})
+	if err != nil {
+		panic(err)
+	}
+
+	var receivedTestingResult types.TestingResults
+	setup.Cdc.MustUnmarshalJSON(result, &receivedTestingResult)
+
+	return receivedTestingResult
+}
+
+func queryTestingResultByTestCase(setup TestSetup, filter types.TestCaseFilter) types.TestingResults {
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryTestingResult, fmt.Sprintf("%v", test_constants.VID), fmt.Sprintf("%v", test_constants.PID)},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(filter)},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	var receivedTestingResult types.TestingResults
+	setup.Cdc.MustUnmarshalJSON(result, &receivedTestingResult)
+
+	return receivedTestingResult
+}
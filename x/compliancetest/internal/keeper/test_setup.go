@@ -77,9 +77,24 @@ func DefaultTestingResult() types.TestingResult {
 	}
 }
 
+func DefaultTestReport() *types.TestReport {
+	return &types.TestReport{
+		TestPlanID:  "test-plan",
+		TestHouseID: "test-house",
+		TestCases: []types.TestCase{
+			{ID: "TC-1", Name: "Commissioning", Result: types.TestCasePassed},
+			{ID: "TC-2", Name: "OTA", Result: types.TestCaseFailed},
+			{ID: "TC-3", Name: "Binding", Result: types.TestCasePassed},
+		},
+		Attachments: []types.Attachment{{Name: "log", URL: testconstants.TestResult}},
+	}
+}
+
 func CheckTestingResult(t *testing.T, receivedTestingResult types.TestingResultItem,
 	expectedTestingResult types.TestingResult) {
 	require.Equal(t, receivedTestingResult.Owner, expectedTestingResult.Owner)
 	require.Equal(t, receivedTestingResult.TestResult, expectedTestingResult.TestResult)
+	require.Equal(t, receivedTestingResult.Report, expectedTestingResult.Report)
 	require.Equal(t, receivedTestingResult.TestDate, expectedTestingResult.TestDate)
+	require.Equal(t, receivedTestingResult.SoftwareVersion, expectedTestingResult.SoftwareVersion)
 }
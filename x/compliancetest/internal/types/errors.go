@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
 )
 
 const (
@@ -26,6 +27,10 @@ const (
 	CodeTestingResultsDoNotExist sdk.CodeType = 201
 )
 
+func init() {
+	errcodes.Register(Codespace, CodeTestingResultsDoNotExist, "testing_results_do_not_exist")
+}
+
 func ErrTestingResultDoesNotExist(vid interface{}, pid interface{}) sdk.Error {
 	return sdk.NewError(Codespace, CodeTestingResultsDoNotExist,
 		fmt.Sprintf("No testing results about the model with vid=%v and pid=%v on the ledger", vid, pid))
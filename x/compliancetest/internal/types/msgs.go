@@ -25,19 +25,24 @@ const RouterKey = ModuleName
 type MsgAddTestingResult struct {
 	VID        uint16         `json:"vid"`
 	PID        uint16         `json:"pid"`
-	TestResult string         `json:"test_result"`
+	TestResult string         `json:"test_result,omitempty"`
 	TestDate   time.Time      `json:"test_date"` // rfc3339 encoded date
 	Signer     sdk.AccAddress `json:"signer"`
+	Report     *TestReport    `json:"report,omitempty"`
+	// 0 if the result is not related to a particular software version of the Model
+	SoftwareVersion uint32 `json:"software_version,omitempty"`
 }
 
-func NewMsgAddTestingResult(vid uint16, pid uint16, testResult string,
+func NewMsgAddTestingResult(vid uint16, pid uint16, softwareVersion uint32, testResult string, report *TestReport,
 	testDate time.Time, signer sdk.AccAddress) MsgAddTestingResult {
 	return MsgAddTestingResult{
-		VID:        vid,
-		PID:        pid,
-		TestResult: testResult,
-		TestDate:   testDate,
-		Signer:     signer,
+		VID:             vid,
+		PID:             pid,
+		SoftwareVersion: softwareVersion,
+		TestResult:      testResult,
+		Report:          report,
+		TestDate:        testDate,
+		Signer:          signer,
 	}
 }
 
@@ -62,8 +67,14 @@ func (m MsgAddTestingResult) ValidateBasic() sdk.Error {
 		return sdk.ErrUnknownRequest("Invalid PID: it must be non zero 16-bit unsigned integer")
 	}
 
-	if len(m.TestResult) == 0 {
-		return sdk.ErrUnknownRequest("Invalid TestResult: it cannot be empty")
+	if len(m.TestResult) == 0 && m.Report == nil {
+		return sdk.ErrUnknownRequest("Invalid TestResult: either TestResult or Report must be specified")
+	}
+
+	if m.Report != nil {
+		if err := m.Report.Validate(); err != nil {
+			return err
+		}
 	}
 
 	if m.TestDate.IsZero() {
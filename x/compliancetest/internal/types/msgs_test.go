@@ -26,7 +26,7 @@ import (
 )
 
 func TestNewMsgAddTestingResult(t *testing.T) {
-	msg := NewMsgAddTestingResult(testconstants.VID, testconstants.PID, testconstants.TestResult,
+	msg := NewMsgAddTestingResult(testconstants.VID, testconstants.PID, 0, testconstants.TestResult, nil,
 		testconstants.TestDate, testconstants.Signer)
 
 	require.Equal(t, msg.Route(), RouterKey)
@@ -40,17 +40,18 @@ func TestMsgAddTestingResultValidation(t *testing.T) {
 		msg   MsgAddTestingResult
 	}{
 		{true, NewMsgAddTestingResult(
-			testconstants.VID, testconstants.PID, testconstants.TestResult, testconstants.TestDate, testconstants.Signer)},
+			testconstants.VID, testconstants.PID, 0, testconstants.TestResult, nil, testconstants.TestDate,
+			testconstants.Signer)},
 		{false, NewMsgAddTestingResult(
-			0, testconstants.PID, testconstants.TestResult, testconstants.TestDate, testconstants.Signer)},
+			0, testconstants.PID, 0, testconstants.TestResult, nil, testconstants.TestDate, testconstants.Signer)},
 		{false, NewMsgAddTestingResult(
-			testconstants.VID, 0, testconstants.TestResult, testconstants.TestDate, testconstants.Signer)},
+			testconstants.VID, 0, 0, testconstants.TestResult, nil, testconstants.TestDate, testconstants.Signer)},
 		{false, NewMsgAddTestingResult(
-			testconstants.VID, testconstants.PID, "", testconstants.TestDate, testconstants.Signer)},
+			testconstants.VID, testconstants.PID, 0, "", nil, testconstants.TestDate, testconstants.Signer)},
 		{false, NewMsgAddTestingResult(
-			testconstants.VID, testconstants.PID, testconstants.TestResult, time.Time{}, testconstants.Signer)},
+			testconstants.VID, testconstants.PID, 0, testconstants.TestResult, nil, time.Time{}, testconstants.Signer)},
 		{false, NewMsgAddTestingResult(
-			testconstants.VID, testconstants.PID, testconstants.TestResult, testconstants.TestDate, nil)},
+			testconstants.VID, testconstants.PID, 0, testconstants.TestResult, nil, testconstants.TestDate, nil)},
 	}
 
 	for _, tc := range cases {
@@ -64,8 +65,61 @@ func TestMsgAddTestingResultValidation(t *testing.T) {
 	}
 }
 
+func TestMsgAddTestingResultReportValidation(t *testing.T) {
+	validReport := func() *TestReport {
+		return &TestReport{
+			TestPlanID:  "test-plan",
+			TestHouseID: "test-house",
+			TestCases:   []TestCase{{ID: "TC-1", Result: TestCasePassed}, {ID: "TC-2", Result: TestCaseFailed}},
+			Attachments: []Attachment{{URL: testconstants.TestResult}, {Hash: "4f2c"}},
+		}
+	}
+
+	withReport := func(update func(report *TestReport)) MsgAddTestingResult {
+		report := validReport()
+		update(report)
+
+		return NewMsgAddTestingResult(testconstants.VID, testconstants.PID, 0, "", report,
+			testconstants.TestDate, testconstants.Signer)
+	}
+
+	cases := []struct {
+		valid bool
+		msg   MsgAddTestingResult
+	}{
+		{true, withReport(func(report *TestReport) {})},
+		{true, withReport(func(report *TestReport) { report.Attachments = nil })},
+		{false, withReport(func(report *TestReport) { report.TestPlanID = "" })},
+		{false, withReport(func(report *TestReport) { report.TestHouseID = "" })},
+		{false, withReport(func(report *TestReport) { report.TestCases = nil })},
+		{false, withReport(func(report *TestReport) { report.TestCases[1].ID = "" })},
+		{false, withReport(func(report *TestReport) { report.TestCases[1].ID = "TC-1" })},
+		{false, withReport(func(report *TestReport) { report.TestCases[1].Result = "skip" })},
+		{false, withReport(func(report *TestReport) { report.Attachments[1].Hash = "" })},
+		{false, withReport(func(report *TestReport) { report.Attachments[0].URL = "not a url" })},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestMsgAddTestingResultSoftwareVersion(t *testing.T) {
+	msg := NewMsgAddTestingResult(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+		testconstants.TestResult, nil, testconstants.TestDate, testconstants.Signer)
+
+	require.Nil(t, msg.ValidateBasic())
+	require.Contains(t, string(msg.GetSignBytes()), `"software_version":1`)
+}
+
 func TestMsgAddTestingResultGetSignBytes(t *testing.T) {
-	msg := NewMsgAddTestingResult(testconstants.VID, testconstants.PID, testconstants.TestResult,
+	msg := NewMsgAddTestingResult(testconstants.VID, testconstants.PID, 0, testconstants.TestResult, nil,
 		testconstants.TestDate, testconstants.Signer)
 
 	expected := `{"type":"compliancetest/AddTestingResult","value":{"pid":22,"signer":` +
@@ -0,0 +1,142 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Results of a test case.
+const (
+	TestCasePassed = "pass"
+	TestCaseFailed = "fail"
+)
+
+// Structured report of the testing performed by a test house according to a test plan.
+type TestReport struct {
+	TestPlanID  string       `json:"test_plan_id"`
+	TestHouseID string       `json:"test_house_id"`
+	TestCases   []TestCase   `json:"test_cases"`
+	Attachments []Attachment `json:"attachments,omitempty"`
+}
+
+type TestCase struct {
+	ID     string `json:"id"`
+	Name   string `json:"name,omitempty"`
+	Result string `json:"result"` // pass or fail
+}
+
+// Document attached to the report (e.g. the test log). It is referenced by URL and/or hash of its content.
+type Attachment struct {
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+	Hash string `json:"hash,omitempty"`
+}
+
+func (r TestReport) String() string {
+	bytes, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+func (r TestReport) Validate() sdk.Error {
+	if len(r.TestPlanID) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Report: TestPlanID cannot be empty")
+	}
+
+	if len(r.TestHouseID) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Report: TestHouseID cannot be empty")
+	}
+
+	if len(r.TestCases) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Report: it must contain at least one test case")
+	}
+
+	ids := make(map[string]bool, len(r.TestCases))
+
+	for _, testCase := range r.TestCases {
+		if len(testCase.ID) == 0 || ids[testCase.ID] {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Report: test case ID \"%v\" is empty or duplicated",
+				testCase.ID))
+		}
+
+		ids[testCase.ID] = true
+
+		if !IsValidTestCaseResult(testCase.Result) {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Report: result of test case \"%v\" must be "+
+				"either %v or %v", testCase.ID, TestCasePassed, TestCaseFailed))
+		}
+	}
+
+	for _, attachment := range r.Attachments {
+		if len(attachment.URL) == 0 && len(attachment.Hash) == 0 {
+			return sdk.ErrUnknownRequest("Invalid Report: attachment must have either URL or Hash")
+		}
+
+		if len(attachment.URL) != 0 {
+			if _, err := url.ParseRequestURI(attachment.URL); err != nil {
+				return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Report: invalid attachment URL \"%v\"",
+					attachment.URL))
+			}
+		}
+	}
+
+	return nil
+}
+
+// Returns the report with the test cases matching the filter only and false if none of them matches.
+func (r TestReport) Filter(filter TestCaseFilter) (TestReport, bool) {
+	testCases := []TestCase{}
+
+	for _, testCase := range r.TestCases {
+		if filter.Matches(testCase) {
+			testCases = append(testCases, testCase)
+		}
+	}
+
+	r.TestCases = testCases
+
+	return r, len(testCases) > 0
+}
+
+func IsValidTestCaseResult(result string) bool {
+	return result == TestCasePassed || result == TestCaseFailed
+}
+
+// Request Payload for a query of testing results filtered by test cases. Empty fields match any test case.
+type TestCaseFilter struct {
+	TestCaseID string `json:"test_case_id"`
+	Result     string `json:"result"`
+}
+
+func NewTestCaseFilter(testCaseID string, result string) TestCaseFilter {
+	return TestCaseFilter{TestCaseID: testCaseID, Result: result}
+}
+
+func (f TestCaseFilter) IsEmpty() bool {
+	return len(f.TestCaseID) == 0 && len(f.Result) == 0
+}
+
+func (f TestCaseFilter) Matches(testCase TestCase) bool {
+	return (len(f.TestCaseID) == 0 || f.TestCaseID == testCase.ID) &&
+		(len(f.Result) == 0 || f.Result == testCase.Result)
+}
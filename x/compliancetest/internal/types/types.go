@@ -27,16 +27,22 @@ type TestingResult struct {
 	Owner      sdk.AccAddress `json:"owner"`
 	TestResult string         `json:"test_result"`
 	TestDate   time.Time      `json:"test_date"` // rfc3339 encoded date
+	// appended to the end to keep the binary encoding of the existing records
+	Report *TestReport `json:"report,omitempty"`
+	// 0 if the result is not related to a particular software version of the Model
+	SoftwareVersion uint32 `json:"software_version,omitempty"`
 }
 
-func NewTestingResult(vid uint16, pid uint16, owner sdk.AccAddress,
-	testResult string, testDate time.Time) TestingResult {
+func NewTestingResult(vid uint16, pid uint16, softwareVersion uint32, owner sdk.AccAddress,
+	testResult string, report *TestReport, testDate time.Time) TestingResult {
 	return TestingResult{
-		VID:        vid,
-		PID:        pid,
-		Owner:      owner,
-		TestResult: testResult,
-		TestDate:   testDate,
+		VID:             vid,
+		PID:             pid,
+		SoftwareVersion: softwareVersion,
+		Owner:           owner,
+		TestResult:      testResult,
+		Report:          report,
+		TestDate:        testDate,
 	}
 }
 
@@ -75,16 +81,71 @@ func (d TestingResults) String() string {
 func (d *TestingResults) AddTestingResult(testingResult TestingResult) {
 	d.Results = append(d.Results,
 		TestingResultItem{
-			TestResult: testingResult.TestResult,
-			Owner:      testingResult.Owner,
-			TestDate:   testingResult.TestDate,
+			TestResult:      testingResult.TestResult,
+			Report:          testingResult.Report,
+			Owner:           testingResult.Owner,
+			TestDate:        testingResult.TestDate,
+			SoftwareVersion: testingResult.SoftwareVersion,
 		})
 }
 
+// Returns the testing results submitted for the given software version of the Model (in the order of submission).
+func (d TestingResults) ForSoftwareVersion(softwareVersion uint32) TestingResults {
+	res := NewTestingResults(d.VID, d.PID)
+
+	for _, item := range d.Results {
+		if item.SoftwareVersion == softwareVersion {
+			res.Results = append(res.Results, item)
+		}
+	}
+
+	return res
+}
+
+// Returns the latest testing result of every test house (in the order of submission).
+func (d TestingResults) LatestPerTestHouse() TestingResults {
+	latest := make(map[string]int, len(d.Results))
+
+	for i, item := range d.Results {
+		latest[item.Owner.String()] = i
+	}
+
+	res := NewTestingResults(d.VID, d.PID)
+
+	for i, item := range d.Results {
+		if latest[item.Owner.String()] == i {
+			res.Results = append(res.Results, item)
+		}
+	}
+
+	return res
+}
+
+// Returns the testing results which reports contain test cases matching the filter (other test cases are omitted).
+func (d TestingResults) Filter(filter TestCaseFilter) TestingResults {
+	res := NewTestingResults(d.VID, d.PID)
+
+	for _, item := range d.Results {
+		if item.Report == nil {
+			continue
+		}
+
+		if report, ok := item.Report.Filter(filter); ok {
+			item.Report = &report
+			res.Results = append(res.Results, item)
+		}
+	}
+
+	return res
+}
+
 type TestingResultItem struct {
 	Owner      sdk.AccAddress `json:"owner"`
 	TestResult string         `json:"test_result"`
 	TestDate   time.Time      `json:"test_date"` // rfc3339 encoded date
+	// appended to the end to keep the binary encoding of the existing records
+	Report          *TestReport `json:"report,omitempty"`
+	SoftwareVersion uint32      `json:"software_version,omitempty"`
 }
 
 func (d TestingResultItem) String() string {
@@ -30,6 +30,7 @@ var (
 	NewInitConfig                = cosmosgenutil.NewInitConfig
 	GenesisStateFromGenDoc       = types.GenesisStateFromGenDoc
 	GenesisStateFromGenFile      = types.GenesisStateFromGenFile
+	GetGenesisStateFromAppState  = types.GetGenesisStateFromAppState
 
 	// variable aliases.
 	ModuleCdc = types.ModuleCdc
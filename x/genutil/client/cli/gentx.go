@@ -37,10 +37,14 @@ import (
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/common"
 	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/genutil"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
 	validator "github.com/zigbee-alliance/distributed-compliance-ledger/x/validator/client/cli"
 )
 
+const FlagX509RootCert = "x509-root-cert"
+
 // GenTxCmd builds the application's gentx command.
 //nolint:gocognit,funlen
 func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager,
@@ -48,7 +52,11 @@ func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager,
 	cmd := &cobra.Command{
 		Use:   "gentx",
 		Short: "Generate a genesis transaction to create a validator",
-		Args:  cobra.NoArgs,
+		Long: `Generate a genesis transaction to create a validator.
+The transaction can also propose the initial PKI root certificates (see --x509-root-cert).
+The subcommands generate genesis transactions proposing and approving accounts (e.g. the initial Trustees
+and Vendors), they are delivered before the transactions creating validators.`,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			config := ctx.Config
 			config.SetRoot(viper.GetString(client.FlagHome))
@@ -104,7 +112,16 @@ func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager,
 				return err
 			}
 
-			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			// the account genesis transactions of the signer are delivered before the validator one
+			gentxDir := filepath.Join(config.RootDir, "config", "gentx")
+
+			accountTxs, err := readAccountGenTxs(cdc, gentxDir, key.GetAddress())
+			if err != nil {
+				return err
+			}
+
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc)).
+				WithSequence(uint64(len(accountTxs)))
 			cliCtx := client.NewCLIContext().WithCodec(cdc)
 
 			// create a 'create-validator' message
@@ -113,6 +130,23 @@ func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager,
 				return errors.Wrap(err, "failed to build create-validator message")
 			}
 
+			msgs := []sdk.Msg{msg}
+
+			// the initial PKI root certificates are proposed along with the validator creation
+			for _, target := range viper.GetStringSlice(FlagX509RootCert) {
+				cert, err := cli.NewCLIContext().ReadFromFile(target)
+				if err != nil {
+					return err
+				}
+
+				rootCertMsg := pki.NewMsgProposeAddX509RootCert(cert, key.GetAddress())
+				if err = rootCertMsg.ValidateBasic(); err != nil {
+					return err
+				}
+
+				msgs = append(msgs, rootCertMsg)
+			}
+
 			info, err := txBldr.Keybase().Get(from)
 			if err != nil {
 				return errors.Wrap(err, "failed to read from tx builder keybase")
@@ -121,14 +155,14 @@ func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager,
 			if info.GetType() == kbkeys.TypeOffline || info.GetType() == kbkeys.TypeMulti {
 				fmt.Println("Offline key passed in. Use `tx sign` command to sign:")
 
-				return utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg})
+				return utils.PrintUnsignedStdTx(txBldr, cliCtx, msgs)
 			}
 
 			// write the unsigned transaction to the buffer
 			w := bytes.NewBuffer([]byte{})
 			cliCtx = cliCtx.WithOutput(w)
 
-			if err = utils.PrintUnsignedStdTx(txBldr, cliCtx, []sdk.Msg{msg}); err != nil {
+			if err = utils.PrintUnsignedStdTx(txBldr, cliCtx, msgs); err != nil {
 				return errors.Wrap(err, "failed to print unsigned std tx")
 			}
 
@@ -169,6 +203,9 @@ func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager,
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "node's home directory")
 	cmd.Flags().String(flagClientHome, defaultCLIHome, "client's home directory")
 	cmd.Flags().String(flags.FlagFrom, "", "Name or address of private key with which to sign the gentx")
+	cmd.Flags().StringSlice(FlagX509RootCert, nil,
+		"PEM encoded root certificate (string or path to file) to propose along with the validator creation, "+
+			"can be repeated")
 	cmd.Flags().String(flags.FlagOutputDocument, "",
 		"write the genesis transaction JSON document to the given file instead of the default location")
 
@@ -176,6 +213,11 @@ func GenTxCmd(ctx *server.Context, cdc *codec.Codec, mbm module.BasicManager,
 
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
+	cmd.AddCommand(
+		GenTxProposeAddAccountCmd(ctx, cdc, defaultNodeHome, defaultCLIHome),
+		GenTxApproveAddAccountCmd(ctx, cdc, defaultNodeHome, defaultCLIHome),
+	)
+
 	return cmd
 }
 
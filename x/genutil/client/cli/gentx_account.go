@@ -0,0 +1,284 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/tendermint/tendermint/libs/common"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/genutil"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/genutil/types"
+)
+
+const FlagVID = "vid"
+
+// GenTxProposeAddAccountCmd builds the gentx command proposing an account (e.g. one of the initial Trustees
+// or Vendors) in genesis.
+func GenTxProposeAddAccountCmd(ctx *server.Context, cdc *codec.Codec,
+	defaultNodeHome, defaultCLIHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-add-account",
+		Short: "Generate a genesis transaction proposing a new account with the given address, public key and roles",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			pubkey := viper.GetString(FlagPubKey)
+			if _, err = sdk.GetAccPubKeyBech32(pubkey); err != nil {
+				return err
+			}
+
+			var roles auth.AccountRoles
+			if rolesStr := viper.GetString(FlagRoles); len(rolesStr) > 0 {
+				for _, role := range strings.Split(rolesStr, ",") {
+					roles = append(roles, auth.AccountRole(role))
+				}
+			}
+
+			var vid uint16
+			if vidStr := viper.GetString(FlagVID); len(vidStr) > 0 {
+				if vid, err = conversions.ParseVID(vidStr); err != nil {
+					return err
+				}
+			}
+
+			return writeAccountGenTx(ctx, cdc, func(signer sdk.AccAddress) sdk.Msg {
+				return auth.NewMsgProposeAddAccount(address, pubkey, roles, vid, signer)
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "Bench32 encoded account address")
+	cmd.Flags().String(FlagPubKey, "", "Bench32 encoded account public key")
+	cmd.Flags().String(FlagRoles, "",
+		fmt.Sprintf("The list of roles, comma-separated, assigning to the account (supported roles: %v)", auth.Roles))
+	cmd.Flags().String(FlagVID, "",
+		"Vendor ID the account is bound to (can be set only for accounts with the Vendor role)")
+	addAccountGenTxFlags(cmd, defaultNodeHome, defaultCLIHome)
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	_ = cmd.MarkFlagRequired(FlagPubKey)
+
+	return cmd
+}
+
+// GenTxApproveAddAccountCmd builds the gentx command approving an account proposed in genesis.
+func GenTxApproveAddAccountCmd(ctx *server.Context, cdc *codec.Codec,
+	defaultNodeHome, defaultCLIHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-add-account",
+		Short: "Generate a genesis transaction approving the account proposed in genesis",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			return writeAccountGenTx(ctx, cdc, func(signer sdk.AccAddress) sdk.Msg {
+				return auth.NewMsgApproveAddAccount(address, signer)
+			})
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "Bench32 encoded account address")
+	addAccountGenTxFlags(cmd, defaultNodeHome, defaultCLIHome)
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
+func addAccountGenTxFlags(cmd *cobra.Command, defaultNodeHome, defaultCLIHome string) {
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagClientHome, defaultCLIHome, "client's home directory")
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of private key with which to sign the gentx")
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+}
+
+// Signs the account message by the genesis account and writes the transaction to the gentx directory.
+// Genesis transactions are signed with zero account number, the sequence is the number of
+// the account genesis transactions generated by the signer before.
+func writeAccountGenTx(ctx *server.Context, cdc *codec.Codec, buildMsg func(signer sdk.AccAddress) sdk.Msg) error {
+	config := ctx.Config
+	config.SetRoot(viper.GetString(client.FlagHome))
+
+	genDoc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return err
+	}
+
+	var genesisState map[string]json.RawMessage
+	if err = cdc.UnmarshalJSON(genDoc.AppState, &genesisState); err != nil {
+		return err
+	}
+
+	kb, err := client.NewKeyBaseFromDir(viper.GetString(flagClientHome))
+	if err != nil {
+		return err
+	}
+
+	from := viper.GetString(client.FlagFrom)
+
+	key, err := kb.Get(from)
+	if err != nil {
+		return err
+	}
+
+	if err = genutil.ValidateAccountInGenesis(genesisState, key.GetAddress(), cdc); err != nil {
+		return err
+	}
+
+	msg := buildMsg(key.GetAddress())
+	if err = msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	gentxDir := filepath.Join(config.RootDir, "config", "gentx")
+	if err = common.EnsureDir(gentxDir, 0o700); err != nil {
+		return errors.Wrap(err, "failed to create gentx directory")
+	}
+
+	previousTxs, err := readAccountGenTxs(cdc, gentxDir, key.GetAddress())
+	if err != nil {
+		return err
+	}
+
+	// the validator genesis transaction is signed with the sequence following the account ones
+	if hasValidatorGenTx(cdc, gentxDir, key.GetAddress()) {
+		return sdk.ErrUnknownRequest(
+			"account genesis transactions must be generated before the validator genesis transaction")
+	}
+
+	// proposals are delivered before approvals, so the sequences must follow the same order
+	if _, ok := msg.(auth.MsgProposeAddAccount); ok {
+		for _, tx := range previousTxs {
+			if _, ok := tx.GetMsgs()[0].(auth.MsgApproveAddAccount); ok {
+				return sdk.ErrUnknownRequest(
+					"accounts must be proposed before approving the accounts proposed by others")
+			}
+		}
+	}
+
+	// Set flags for creating gentx
+	viper.Set(client.FlagHome, viper.GetString(flagClientHome))
+
+	txBldr := authtypes.NewTxBuilderFromCLI().
+		WithTxEncoder(utils.GetTxEncoder(cdc)).
+		WithChainID(genDoc.ChainID).
+		WithAccountNumber(0).
+		WithSequence(uint64(len(previousTxs)))
+	cliCtx := client.NewCLIContext().WithCodec(cdc)
+
+	signMsg, err := txBldr.BuildSignMsg([]sdk.Msg{msg})
+	if err != nil {
+		return errors.Wrap(err, "failed to build genesis transaction")
+	}
+
+	stdTx := authtypes.NewStdTx(signMsg.Msgs, signMsg.Fee, nil, signMsg.Memo)
+
+	signedTx, err := utils.SignStdTx(txBldr, cliCtx, from, stdTx, false, true)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign std tx")
+	}
+
+	outputDocument := filepath.Join(gentxDir,
+		fmt.Sprintf("gentx-account-%s-%03d.json", key.GetAddress(), len(previousTxs)))
+
+	if err := writeSignedGenTx(cdc, outputDocument, signedTx); err != nil {
+		return errors.Wrap(err, "failed to write signed gen tx")
+	}
+
+	fmt.Fprintf(os.Stderr, "Genesis transaction written to %q\n", outputDocument)
+
+	return nil
+}
+
+// Reads the account genesis transactions signed by the given account from the gentx directory.
+func readAccountGenTxs(cdc *codec.Codec, gentxDir string, signer sdk.AccAddress) ([]authtypes.StdTx, error) {
+	files, err := filepath.Glob(filepath.Join(gentxDir, fmt.Sprintf("gentx-account-%s-*.json", signer)))
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]authtypes.StdTx, 0, len(files))
+
+	for _, file := range files {
+		bytes, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		var tx authtypes.StdTx
+		if err = cdc.UnmarshalJSON(bytes, &tx); err != nil {
+			return nil, err
+		}
+
+		if !types.IsAccountGenTx(tx.GetMsgs()) {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("%s is not an account genesis transaction", file))
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+// Checks if the gentx directory contains the validator genesis transaction signed by the given account.
+func hasValidatorGenTx(cdc *codec.Codec, gentxDir string, signer sdk.AccAddress) bool {
+	files, _ := filepath.Glob(filepath.Join(gentxDir, "gentx-*.json"))
+
+	for _, file := range files {
+		bytes, err := ioutil.ReadFile(file)
+		if err != nil {
+			continue
+		}
+
+		var tx authtypes.StdTx
+		if err = cdc.UnmarshalJSON(bytes, &tx); err != nil || types.IsAccountGenTx(tx.GetMsgs()) {
+			continue
+		}
+
+		for _, msgSigner := range tx.GetSigners() {
+			if msgSigner.Equals(signer) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -15,21 +15,26 @@
 package genutil
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	cfg "github.com/tendermint/tendermint/config"
+	"github.com/tendermint/tendermint/p2p"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/genutil/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator"
 )
 
@@ -38,8 +43,7 @@ func GenAppStateFromConfig(cdc *codec.Codec, config *cfg.Config,
 	initCfg InitConfig, genDoc tmtypes.GenesisDoc,
 ) (appState json.RawMessage, err error) {
 	// process genesis transactions, else create default genesis.json.
-	appGenTxs, persistentPeers, err := CollectStdTxs(
-		cdc, config.Moniker, initCfg.GenTxsDir, genDoc)
+	appGenTxs, persistentPeers, err := CollectStdTxs(cdc, initCfg, genDoc)
 	if err != nil {
 		return appState, err
 	}
@@ -77,10 +81,13 @@ func GenAppStateFromConfig(cdc *codec.Codec, config *cfg.Config,
 
 // CollectStdTxs processes and validates application's genesis StdTxs and returns
 // the list of appGenTxs, and persistent peers required to generate genesis.json.
-//nolint:funlen
-func CollectStdTxs(cdc *codec.Codec, name, genTxsDir string,
+// The persistent peers are the deduplicated validator node addresses except the one of this node.
+//nolint:funlen,gocognit
+func CollectStdTxs(cdc *codec.Codec, initCfg InitConfig,
 	genDoc tmtypes.GenesisDoc,
 ) (appGenTxs []authtypes.StdTx, persistentPeers string, err error) {
+	genTxsDir := initCfg.GenTxsDir
+
 	var fos []os.FileInfo
 	fos, err = ioutil.ReadDir(genTxsDir)
 
@@ -105,8 +112,21 @@ func CollectStdTxs(cdc *codec.Codec, name, genTxsDir string,
 		},
 	)
 
-	// addresses and IPs (and port) validator server info.
-	var addressesIPs []string
+	// account transactions must be signed by the accounts of genesis.json
+	genesisAccounts := make(map[string]bool, len(addrMap))
+	for address := range addrMap {
+		genesisAccounts[address] = true
+	}
+
+	// validator node addresses (host and port) by node IDs and genesis transactions by validator addresses.
+	peers := make(map[string]string)
+	validatorGenTxs := make(map[string]string)
+
+	// account transactions are delivered before the validator ones (the validator owners may be created by them),
+	// the proposals are delivered before the approvals.
+	var proposalTxs, approvalTxs, validatorTxs []authtypes.StdTx
+
+	var validatorTxNames []string
 
 	for _, fo := range fos {
 		filename := filepath.Join(genTxsDir, fo.Name())
@@ -127,36 +147,71 @@ func CollectStdTxs(cdc *codec.Codec, name, genTxsDir string,
 			return appGenTxs, persistentPeers, err
 		}
 
-		appGenTxs = append(appGenTxs, genStdTx)
+		msgs := genStdTx.GetMsgs()
+
+		if types.IsAccountGenTx(msgs) {
+			if err = collectAccountGenTx(genStdTx, genesisAccounts, addrMap); err != nil {
+				return appGenTxs, persistentPeers, sdk.ErrUnknownRequest(
+					fmt.Sprintf("invalid genesis transaction %s: %v", fo.Name(), err))
+			}
+
+			if _, ok := msgs[0].(auth.MsgProposeAddAccount); ok {
+				proposalTxs = append(proposalTxs, genStdTx)
+			} else {
+				approvalTxs = append(approvalTxs, genStdTx)
+			}
+
+			continue
+		}
+
+		// validator genesis transactions start with the MsgCreateValidator followed by the companion messages.
+		if err = types.ValidateValidatorGenTxMsgs(msgs); err != nil {
+			return appGenTxs, persistentPeers, sdk.ErrUnknownRequest(
+				fmt.Sprintf("invalid genesis transaction %s: %v", fo.Name(), err))
+		}
+
+		createValidator := msgs[0].(validator.MsgCreateValidator)
+
+		if other, ok := validatorGenTxs[createValidator.Address.String()]; ok {
+			return appGenTxs, persistentPeers, sdk.ErrUnknownRequest(
+				fmt.Sprintf("genesis transactions %s and %s create the same validator %s",
+					other, fo.Name(), createValidator.Address))
+		}
+
+		validatorGenTxs[createValidator.Address.String()] = fo.Name()
 
 		// the memo flag is used to store
 		// the ip and node-id, for example this may be:
 		// "528fd3df22b31f4969b05652bfe8f0fe921321d5@192.168.2.37:26656".
-		nodeAddrIP := genStdTx.GetMemo()
-		if len(nodeAddrIP) == 0 {
+		if err = collectPersistentPeer(peers, genStdTx.GetMemo(), createValidator, initCfg); err != nil {
 			return appGenTxs, persistentPeers, sdk.ErrUnknownRequest(
-				fmt.Sprintf("couldn't find node's address and IP in %s", fo.Name()))
+				fmt.Sprintf("invalid genesis transaction %s: %v", fo.Name(), err))
 		}
 
-		// genesis transactions must be single-message.
-		msgs := genStdTx.GetMsgs()
-		if len(msgs) != 1 {
+		validatorTxs = append(validatorTxs, genStdTx)
+		validatorTxNames = append(validatorTxNames, fo.Name())
+	}
+
+	// the validator owners may be created by the account transactions, so they are checked after all are read
+	for i, genStdTx := range validatorTxs {
+		if err = collectValidatorGenTx(genStdTx, addrMap); err != nil {
 			return appGenTxs, persistentPeers, sdk.ErrUnknownRequest(
-				"each genesis transaction must provide a single genesis message")
+				fmt.Sprintf("invalid genesis transaction %s: %v", validatorTxNames[i], err))
 		}
+	}
 
-		msg := msgs[0].(validator.MsgCreateValidator)
-		account := msg.Signer.String()
+	if validatorTxs, err = orderValidatorGenTxs(validatorTxs, validatorTxNames); err != nil {
+		return appGenTxs, persistentPeers, err
+	}
 
-		_, valOk := addrMap[account]
-		if !valOk {
-			return appGenTxs, persistentPeers, sdk.ErrUnknownRequest(
-				fmt.Sprintf("Error account %v not in genesis.json: %+v", account, addrMap))
-		}
+	appGenTxs = append(append(proposalTxs, approvalTxs...), validatorTxs...)
+
+	// exclude itself from persistent peers.
+	addressesIPs := make([]string, 0, len(peers))
 
-		// exclude itself from persistent peers.
-		if msg.Description.Name != name {
-			addressesIPs = append(addressesIPs, nodeAddrIP)
+	for nodeID, address := range peers {
+		if nodeID != initCfg.NodeID {
+			addressesIPs = append(addressesIPs, fmt.Sprintf("%s@%s", nodeID, address))
 		}
 	}
 
@@ -166,6 +221,256 @@ func CollectStdTxs(cdc *codec.Codec, name, genTxsDir string,
 	return appGenTxs, persistentPeers, nil
 }
 
+// Checks that the account genesis transaction is signed by a genesis account and does not mix proposals
+// and approvals (they are delivered in different order). The proposed accounts are added to the known ones.
+func collectAccountGenTx(genStdTx authtypes.StdTx, genesisAccounts map[string]bool,
+	addrMap map[string]auth.Account) error {
+	msgs := genStdTx.GetMsgs()
+	_, isProposal := msgs[0].(auth.MsgProposeAddAccount)
+
+	for _, msg := range msgs {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		for _, signer := range msg.GetSigners() {
+			if !genesisAccounts[signer.String()] {
+				return fmt.Errorf("signer %s is not a genesis account", signer)
+			}
+		}
+
+		proposal, ok := msg.(auth.MsgProposeAddAccount)
+		if ok != isProposal {
+			return fmt.Errorf("account proposals and approvals must be in different genesis transactions")
+		}
+
+		if ok {
+			pubKey, err := sdk.GetAccPubKeyBech32(proposal.PublicKey)
+			if err != nil {
+				return err
+			}
+
+			addrMap[proposal.Address.String()] = auth.NewAccount(proposal.Address, pubKey, proposal.Roles)
+		}
+	}
+
+	return nil
+}
+
+// Parses the `<node-id>@<host>:<port>` node address of the validator genesis transaction and adds it to the peers.
+// The same node can be listed by several genesis transactions only with the same address.
+// The node ID of this node's validator (identified by the public key) must be the ID of this node.
+func collectPersistentPeer(peers map[string]string, memo string, createValidator validator.MsgCreateValidator,
+	initCfg InitConfig) error {
+	nodeID, address, err := parsePeer(memo)
+	if err != nil {
+		return err
+	}
+
+	if other, ok := peers[nodeID]; ok && other != address {
+		return fmt.Errorf("node %s is already listed with another address %s", nodeID, other)
+	}
+
+	peers[nodeID] = address
+
+	pubKey, err := sdk.GetConsPubKeyBech32(createValidator.PubKey)
+	if err != nil {
+		return err
+	}
+
+	isThisValidator := initCfg.ValPubKey != nil && pubKey.Equals(initCfg.ValPubKey)
+	isThisNode := nodeID == initCfg.NodeID
+
+	if isThisValidator && !isThisNode {
+		return fmt.Errorf("validator of this node is listed with node ID %s instead of %s", nodeID, initCfg.NodeID)
+	}
+
+	if isThisNode && !isThisValidator {
+		return fmt.Errorf("node ID %s of this node is listed with validator public key %s of another node",
+			nodeID, createValidator.PubKey)
+	}
+
+	return nil
+}
+
+func parsePeer(memo string) (nodeID string, address string, err error) {
+	parts := strings.Split(memo, "@")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("node's address %q must be in the format <node-id>@<host>:<port>", memo)
+	}
+
+	nodeID = strings.ToLower(parts[0])
+
+	if id, err := hex.DecodeString(nodeID); err != nil || len(id) != p2p.IDByteLength {
+		return "", "", fmt.Errorf("node ID %q must be %v hex-encoded bytes", parts[0], p2p.IDByteLength)
+	}
+
+	host, port, err := net.SplitHostPort(parts[1])
+	if err != nil {
+		return "", "", err
+	}
+
+	if len(host) == 0 {
+		return "", "", fmt.Errorf("node's address %q must contain a host", memo)
+	}
+
+	if portNumber, err := strconv.ParseUint(port, 10, 16); err != nil || portNumber == 0 {
+		return "", "", fmt.Errorf("node's port %q must be a number between 1 and 65535", port)
+	}
+
+	return nodeID, parts[1], nil
+}
+
+// Checks that the messages of the validator genesis transaction are valid and signed by a genesis account
+// having the roles required for them.
+func collectValidatorGenTx(genStdTx authtypes.StdTx, addrMap map[string]auth.Account) error {
+	for _, msg := range genStdTx.GetMsgs() {
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+
+		for _, signer := range msg.GetSigners() {
+			account, ok := addrMap[signer.String()]
+			if !ok {
+				return fmt.Errorf("account %s not in genesis.json", signer)
+			}
+
+			roles, ok := auth.GetMsgRoles(msg)
+			if ok && !hasAnyRole(account, roles) {
+				return auth.ErrMissingRole(msg.Type(), signer, roles)
+			}
+		}
+	}
+
+	return nil
+}
+
+func hasAnyRole(account auth.Account, roles auth.AccountRoles) bool {
+	for _, role := range roles {
+		if account.HasRole(role) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type rootCertificateKey struct {
+	subject      string
+	subjectKeyID string
+}
+
+// Orders the validator genesis transactions so that the root certificates are proposed before being approved and
+// the leaf certificates are added after all root certificates are approved.
+// It also checks that the approved root certificates are proposed in genesis by other accounts.
+func orderValidatorGenTxs(txs []authtypes.StdTx, names []string) ([]authtypes.StdTx, error) {
+	proposers := make(map[rootCertificateKey]sdk.AccAddress)
+	ordered := make([]authtypes.StdTx, 0, len(txs))
+
+	for len(txs) > 0 {
+		var pendingTxs []authtypes.StdTx
+
+		var pendingNames []string
+
+		for i, tx := range txs {
+			ready, err := applyRootCertificateMsgs(tx, proposers, hasRootCertificateMsgs(txs, i))
+			if err != nil {
+				return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid genesis transaction %s: %v", names[i], err))
+			}
+
+			if ready {
+				ordered = append(ordered, tx)
+			} else {
+				pendingTxs = append(pendingTxs, tx)
+				pendingNames = append(pendingNames, names[i])
+			}
+		}
+
+		if len(pendingTxs) == len(txs) {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid genesis transaction %s: it approves "+
+				"a root certificate not proposed in genesis or adds a certificate along with root certificates "+
+				"pending approval", names[0]))
+		}
+
+		txs, names = pendingTxs, pendingNames
+	}
+
+	return ordered, nil
+}
+
+// Checks if any of the transactions except the one with the given index proposes or approves root certificates.
+func hasRootCertificateMsgs(txs []authtypes.StdTx, except int) bool {
+	for i, tx := range txs {
+		if i == except {
+			continue
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			switch msg.(type) {
+			case pki.MsgProposeAddX509RootCert, pki.MsgApproveAddX509RootCert:
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Registers the root certificates proposed by the transaction if it can be delivered now: all the root
+// certificates it approves are already proposed, and, if it adds leaf certificates, no root certificates
+// are pending approval.
+func applyRootCertificateMsgs(tx authtypes.StdTx, proposers map[rootCertificateKey]sdk.AccAddress,
+	pendingRootCertificates bool) (bool, error) {
+	proposed := make(map[rootCertificateKey]sdk.AccAddress)
+
+	for _, msg := range tx.GetMsgs() {
+		switch msg := msg.(type) {
+		case pki.MsgProposeAddX509RootCert:
+			cert, err := pki.DecodeX509Certificate(msg.Cert)
+			if err != nil {
+				return false, err
+			}
+
+			key := rootCertificateKey{subject: cert.Subject, subjectKeyID: cert.SubjectKeyID}
+			if _, ok := proposers[key]; ok {
+				return false, fmt.Errorf("root certificate with subject=%v and subjectKeyID=%v is proposed twice",
+					cert.Subject, cert.SubjectKeyID)
+			}
+
+			if _, ok := proposed[key]; ok {
+				return false, fmt.Errorf("root certificate with subject=%v and subjectKeyID=%v is proposed twice",
+					cert.Subject, cert.SubjectKeyID)
+			}
+
+			proposed[key] = msg.Signer
+		case pki.MsgApproveAddX509RootCert:
+			key := rootCertificateKey{subject: msg.Subject, subjectKeyID: msg.SubjectKeyID}
+
+			proposer, ok := proposers[key]
+			if !ok {
+				if proposer, ok = proposed[key]; !ok {
+					return false, nil
+				}
+			}
+
+			if proposer.Equals(msg.Signer) {
+				return false, fmt.Errorf("root certificate with subject=%v and subjectKeyID=%v "+
+					"is approved by its proposer", msg.Subject, msg.SubjectKeyID)
+			}
+		case pki.MsgAddX509Cert:
+			if pendingRootCertificates {
+				return false, nil
+			}
+		}
+	}
+
+	for key, proposer := range proposed {
+		proposers[key] = proposer
+	}
+
+	return true, nil
+}
+
 // SetGenTxsInAppGenesisState - sets the genesis transactions in the app genesis state.
 func SetGenTxsInAppGenesisState(cdc *codec.Codec, appGenesisState map[string]json.RawMessage,
 	genTxs []authtypes.StdTx) (map[string]json.RawMessage, error) {
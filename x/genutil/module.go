@@ -99,6 +99,8 @@ func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.Va
 }
 
 // module export genesis.
+// Genesis accounts are exported by the auth module and genesis transactions are already applied,
+// so the exported state is empty.
 func (am AppModule) ExportGenesis(sdk.Context) json.RawMessage {
-	return nil
+	return ModuleCdc.MustMarshalJSON(GenesisState{})
 }
@@ -17,7 +17,8 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator"
 )
 
@@ -27,7 +28,9 @@ var ModuleCdc *codec.Codec
 func init() {
 	ModuleCdc = codec.New()
 	validator.RegisterCodec(ModuleCdc)
-	authtypes.RegisterCodec(ModuleCdc)
+	pki.RegisterCodec(ModuleCdc)
+	// registers StdTx along with the account messages
+	auth.RegisterCodec(ModuleCdc)
 	sdk.RegisterCodec(ModuleCdc)
 	codec.RegisterCrypto(ModuleCdc)
 	ModuleCdc.Seal()
@@ -23,7 +23,6 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/tendermint/tendermint/libs/common"
 	tmtypes "github.com/tendermint/tendermint/types"
-	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator"
 )
 
 // GenesisState defines the genesis account and validators.
@@ -88,7 +87,9 @@ func GenesisStateFromGenFile(cdc *codec.Codec, genFile string,
 }
 
 // ValidateGenesis performs validation of genesis accounts. It
-// ensures that there are no duplicate accounts in the genesis state.
+// ensures that there are no duplicate accounts in the genesis state
+// and that every genesis transaction either creates a validator (along with the companion messages)
+// or proposes (approves) accounts.
 func ValidateGenesis(genesisState GenesisState) error {
 	addrMap := make(map[string]bool, len(genesisState.Accounts))
 
@@ -111,13 +112,12 @@ func ValidateGenesis(genesisState GenesisState) error {
 		}
 
 		msgs := tx.GetMsgs()
-		if len(msgs) != 1 {
-			return sdk.ErrUnknownRequest("must provide genesis StdTx with exactly 1 CreateValidator message")
+		if IsAccountGenTx(msgs) {
+			continue
 		}
 
-		if _, ok := msgs[0].(validator.MsgCreateValidator); !ok {
-			return sdk.ErrUnknownRequest(
-				fmt.Sprintf("genesis transaction %v does not contain a MsgCreateValidator", i))
+		if err := ValidateValidatorGenTxMsgs(msgs); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("invalid genesis transaction %v: %v", i, err))
 		}
 	}
 
@@ -15,8 +15,12 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator"
 )
 
 type GenesisAccounts []auth.Account
@@ -31,3 +35,52 @@ func (gaccs GenesisAccounts) Contains(acc sdk.Address) bool {
 
 	return false
 }
+
+// IsAccountGenTx checks if the messages of a genesis transaction propose or approve accounts
+// (e.g. the initial Trustees and Vendors) rather than create a validator.
+func IsAccountGenTx(msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		switch msg.(type) {
+		case auth.MsgProposeAddAccount, auth.MsgApproveAddAccount:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// ValidateValidatorGenTxMsgs checks that the messages of a validator genesis transaction start with
+// the MsgCreateValidator followed by the companion messages (e.g. the initial PKI root certificates)
+// signed by the same account.
+func ValidateValidatorGenTxMsgs(msgs []sdk.Msg) error {
+	if len(msgs) == 0 {
+		return fmt.Errorf("genesis transaction must provide a MsgCreateValidator")
+	}
+
+	createValidator, ok := msgs[0].(validator.MsgCreateValidator)
+	if !ok {
+		return fmt.Errorf("the first message of genesis transaction must be a MsgCreateValidator")
+	}
+
+	for _, msg := range msgs[1:] {
+		switch msg.(type) {
+		case pki.MsgProposeAddX509RootCert, pki.MsgApproveAddX509RootCert, pki.MsgAddX509Cert:
+		default:
+			return fmt.Errorf("message %v is not allowed in genesis transaction", msg.Type())
+		}
+
+		for _, signer := range msg.GetSigners() {
+			if !signer.Equals(createValidator.Signer) {
+				return fmt.Errorf("message %v must be signed by the validator owner %s", msg.Type(),
+					createValidator.Signer)
+			}
+		}
+	}
+
+	return nil
+}
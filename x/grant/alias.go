@@ -0,0 +1,53 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grant
+
+import (
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/keeper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/types"
+)
+
+const (
+	ModuleName            = types.ModuleName
+	RouterKey             = types.RouterKey
+	StoreKey              = types.StoreKey
+	CodeGrantDoesNotExist = types.CodeGrantDoesNotExist
+	CodeNoAuthorization   = types.CodeNoAuthorization
+	CodeInvalidMsgType    = types.CodeInvalidMsgType
+	CodeInvalidExpiration = types.CodeInvalidExpiration
+)
+
+var (
+	NewKeeper            = keeper.NewKeeper
+	NewQuerier           = keeper.NewQuerier
+	NewGrant             = types.NewGrant
+	NewMsgGrant          = types.NewMsgGrant
+	NewMsgRevoke         = types.NewMsgRevoke
+	NewMsgExec           = types.NewMsgExec
+	MsgTypeOf            = types.MsgTypeOf
+	ModuleCdc            = types.ModuleCdc
+	RegisterCodec        = types.RegisterCodec
+	ErrGrantDoesNotExist = types.ErrGrantDoesNotExist
+	ErrNoAuthorization   = types.ErrNoAuthorization
+)
+
+type (
+	Keeper     = keeper.Keeper
+	MsgGrant   = types.MsgGrant
+	MsgRevoke  = types.MsgRevoke
+	MsgExec    = types.MsgExec
+	Grant      = types.Grant
+	ListGrants = types.ListGrants
+)
@@ -0,0 +1,47 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/keeper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/types"
+)
+
+// NewAnteHandler wraps the given AnteHandler (which verifies the signatures of the tx signers)
+// and additionally rejects MsgExec messages executing messages the grantee is not authorized to execute
+// or messages of frozen accounts.
+func NewAnteHandler(keeper keeper.Keeper, authKeeper auth.Keeper, next sdk.AnteHandler) sdk.AnteHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+		newCtx, res, abort = next(ctx, tx, simulate)
+		if abort {
+			return newCtx, res, abort
+		}
+
+		for _, msg := range tx.GetMsgs() {
+			execMsg, ok := msg.(types.MsgExec)
+			if !ok {
+				continue
+			}
+
+			if err := checkExec(newCtx, keeper, authKeeper, execMsg); err != nil {
+				return newCtx, err.Result(), true
+			}
+		}
+
+		return newCtx, res, false
+	}
+}
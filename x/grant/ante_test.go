@@ -0,0 +1,83 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package grant
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/stretchr/testify/require"
+	dclauth "github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+)
+
+func TestAnteHandler_Exec(t *testing.T) {
+	setup := Setup()
+	anteHandler := NewAnteHandler(setup.GrantKeeper, setup.authKeeper, passingAnteHandler)
+
+	tx := auth.NewStdTx([]sdk.Msg{NewMsgExec(setup.Partner, []sdk.Msg{msgAddModelVersion(setup)})},
+		auth.NewStdFee(1000000, sdk.Coins{}), nil, "")
+
+	// no grant
+	_, result, abort := anteHandler(setup.Ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, CodeNoAuthorization, result.Code)
+
+	// grant issued
+	setup.GrantKeeper.SetGrant(setup.Ctx, NewGrant(setup.Vendor, setup.Partner,
+		MsgTypeOf(msgAddModelVersion(setup)), msgGrant(setup).Expiration))
+
+	_, result, abort = anteHandler(setup.Ctx, tx, false)
+	require.False(t, abort)
+	require.True(t, result.IsOK())
+}
+
+func TestAnteHandler_ExecForFrozenGranter(t *testing.T) {
+	setup := Setup()
+	anteHandler := NewAnteHandler(setup.GrantKeeper, setup.authKeeper, passingAnteHandler)
+
+	setup.GrantKeeper.SetGrant(setup.Ctx, NewGrant(setup.Vendor, setup.Partner,
+		MsgTypeOf(msgAddModelVersion(setup)), msgGrant(setup).Expiration))
+
+	vendor := setup.authKeeper.GetAccount(setup.Ctx, setup.Vendor)
+	vendor.Frozen = true
+	setup.authKeeper.SetAccount(setup.Ctx, vendor)
+
+	tx := auth.NewStdTx([]sdk.Msg{NewMsgExec(setup.Partner, []sdk.Msg{msgAddModelVersion(setup)})},
+		auth.NewStdFee(1000000, sdk.Coins{}), nil, "")
+
+	_, result, abort := anteHandler(setup.Ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, dclauth.CodeAccountFrozen, result.Code)
+}
+
+func TestAnteHandler_NextAborts(t *testing.T) {
+	setup := Setup()
+	anteHandler := NewAnteHandler(setup.GrantKeeper, setup.authKeeper,
+		func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+			return ctx, sdk.ErrUnauthorized("signature verification failed").Result(), true
+		})
+
+	tx := auth.NewStdTx([]sdk.Msg{msgGrant(setup)}, auth.NewStdFee(1000000, sdk.Coins{}), nil, "")
+
+	_, result, abort := anteHandler(setup.Ctx, tx, false)
+	require.True(t, abort)
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+}
+
+func passingAnteHandler(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+	return ctx, sdk.Result{}, false
+}
@@ -0,0 +1,147 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/types"
+)
+
+func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	grantQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the grant module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	grantQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryGrant(storeKey, cdc),
+		GetCmdGranterGrants(storeKey, cdc),
+		GetCmdGranteeGrants(storeKey, cdc),
+	)...)
+
+	return grantQueryCmd
+}
+
+func GetCmdQueryGrant(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant",
+		Short: "Query the permission granted by an account to another account for the given message type",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(viper.GetString(FlagGranter))
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(viper.GetString(FlagGrantee))
+			if err != nil {
+				return err
+			}
+
+			msgType := viper.GetString(FlagMsgType)
+
+			res, height, err := cliCtx.QueryStore(types.GetGrantKey(granter, grantee, msgType), queryRoute)
+			if err != nil || res == nil {
+				return types.ErrGrantDoesNotExist(granter, grantee, msgType)
+			}
+
+			var grant types.Grant
+			cdc.MustUnmarshalBinaryBare(res, &grant)
+
+			return cliCtx.EncodeAndPrintWithHeight(grant, height)
+		},
+	}
+
+	cmd.Flags().String(FlagGranter, "", "Bech32 encoded address of the account issued the grant")
+	cmd.Flags().String(FlagGrantee, "", "Bech32 encoded address of the account the permission is granted to")
+	cmd.Flags().String(FlagMsgType, "", "Type of the messages the permission is granted for (`<route>/<type>`)")
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	_ = cmd.MarkFlagRequired(FlagGranter)
+	_ = cmd.MarkFlagRequired(FlagGrantee)
+	_ = cmd.MarkFlagRequired(FlagMsgType)
+
+	return cmd
+}
+
+func GetCmdGranterGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "granter-grants",
+		Short: "Query the list of permissions granted by the account",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(viper.GetString(FlagGranter))
+			if err != nil {
+				return err
+			}
+
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/granter_grants/%v", queryRoute, granter), params)
+		},
+	}
+
+	cmd.Flags().String(FlagGranter, "", "Bech32 encoded address of the account issued the grants")
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of grants to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of grants to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	_ = cmd.MarkFlagRequired(FlagGranter)
+
+	return cmd
+}
+
+func GetCmdGranteeGrants(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grantee-grants",
+		Short: "Query the list of permissions granted to the account",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(viper.GetString(FlagGrantee))
+			if err != nil {
+				return err
+			}
+
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/grantee_grants/%v", queryRoute, grantee), params)
+		},
+	}
+
+	cmd.Flags().String(FlagGrantee, "", "Bech32 encoded address of the account the grants are issued to")
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of grants to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of grants to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	_ = cmd.MarkFlagRequired(FlagGrantee)
+
+	return cmd
+}
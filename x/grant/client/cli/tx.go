@@ -0,0 +1,134 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authutils "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/types"
+)
+
+func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	grantTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Grant transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	grantTxCmd.AddCommand(cli.SignedCommands(client.PostCommands(
+		GetCmdGrant(cdc),
+		GetCmdRevoke(cdc),
+		GetCmdExec(cdc),
+	)...)...)
+
+	return grantTxCmd
+}
+
+func GetCmdGrant(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant",
+		Short: "Grant an account the permission to execute messages of the given type on behalf of the sender",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(viper.GetString(FlagGrantee))
+			if err != nil {
+				return err
+			}
+
+			expiration, err := time.Parse(time.RFC3339, viper.GetString(FlagExpiration))
+			if err != nil {
+				return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Expiration \"%v\": "+
+					"it must be RFC3339 encoded date", viper.GetString(FlagExpiration)))
+			}
+
+			msg := types.NewMsgGrant(cliCtx.FromAddress(), grantee, viper.GetString(FlagMsgType), expiration)
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagGrantee, "", "Bech32 encoded address of the account to grant the permission to")
+	cmd.Flags().String(FlagMsgType, "",
+		"Type of the messages the grantee can execute (`<route>/<type>`, e.g. modelinfo/add_model_version)")
+	cmd.Flags().String(FlagExpiration, "", "Expiration date of the grant (rfc3339 encoded)")
+
+	_ = cmd.MarkFlagRequired(FlagGrantee)
+	_ = cmd.MarkFlagRequired(FlagMsgType)
+	_ = cmd.MarkFlagRequired(FlagExpiration)
+
+	return cmd
+}
+
+func GetCmdRevoke(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke the permission granted by the sender to an account",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			grantee, err := sdk.AccAddressFromBech32(viper.GetString(FlagGrantee))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevoke(cliCtx.FromAddress(), grantee, viper.GetString(FlagMsgType))
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagGrantee, "", "Bech32 encoded address of the account the permission is granted to")
+	cmd.Flags().String(FlagMsgType, "", "Type of the messages the permission is granted for (`<route>/<type>`)")
+
+	_ = cmd.MarkFlagRequired(FlagGrantee)
+	_ = cmd.MarkFlagRequired(FlagMsgType)
+
+	return cmd
+}
+
+func GetCmdExec(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec [tx-file]",
+		Short: "Execute the messages of the transaction (generated with --generate-only) on behalf of their signers",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			stdTx, err := authutils.ReadStdTxFromFile(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExec(cliCtx.FromAddress(), stdTx.GetMsgs())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,105 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/types"
+)
+
+func getGrantHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		granter, ok := parseAddress(restCtx, vars[granter])
+		if !ok {
+			return
+		}
+
+		grantee, ok := parseAddress(restCtx, vars[grantee])
+		if !ok {
+			return
+		}
+
+		msgType := fmt.Sprintf("%s/%s", vars[msgRoute], vars[msgType])
+
+		res, height, err := restCtx.QueryStore(types.GetGrantKey(granter, grantee, msgType), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrGrantDoesNotExist(granter, grantee, msgType))
+
+			return
+		}
+
+		var grant types.Grant
+
+		cliCtx.Codec.MustUnmarshalBinaryBare(res, &grant)
+
+		restCtx.EncodeAndRespondWithHeight(grant, height)
+	}
+}
+
+func getGranterGrantsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		granter, ok := parseAddress(restCtx, restCtx.Variables()[granter])
+		if !ok {
+			return
+		}
+
+		params, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/granter_grants/%v", storeName, granter), params)
+	}
+}
+
+func getGranteeGrantsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		grantee, ok := parseAddress(restCtx, restCtx.Variables()[grantee])
+		if !ok {
+			return
+		}
+
+		params, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/grantee_grants/%v", storeName, grantee), params)
+	}
+}
+
+func parseAddress(restCtx rest.RestContext, bech32 string) (sdk.AccAddress, bool) {
+	address, err := sdk.AccAddressFromBech32(bech32)
+	if err != nil {
+		restCtx.WriteError(http.StatusBadRequest, err)
+
+		return nil, false
+	}
+
+	return address, true
+}
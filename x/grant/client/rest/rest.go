@@ -0,0 +1,56 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+const (
+	granter  = "granter"
+	grantee  = "grantee"
+	msgRoute = "msg_route"
+	msgType  = "msg_type"
+)
+
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
+	r.HandleFunc(
+		fmt.Sprintf("/%s/grants", storeName),
+		grantHandler(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/grants", storeName),
+		revokeHandler(cliCtx),
+	).Methods("DELETE")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/grants/{%s}/{%s}/{%s}/{%s}", storeName, granter, grantee, msgRoute, msgType),
+		getGrantHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/granters/{%s}", storeName, granter),
+		getGranterGrantsHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/grantees/{%s}", storeName, grantee),
+		getGranteeGrantsHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/exec", storeName),
+		execHandler(cliCtx),
+	).Methods("POST")
+}
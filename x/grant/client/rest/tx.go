@@ -0,0 +1,119 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	restTypes "github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/types"
+)
+
+type GrantRequest struct {
+	BaseReq    restTypes.BaseReq `json:"base_req"`
+	Grantee    sdk.AccAddress    `json:"grantee"`
+	MsgType    string            `json:"msg_type"`
+	Expiration time.Time         `json:"expiration"` // rfc3339 encoded date
+}
+
+type RevokeRequest struct {
+	BaseReq restTypes.BaseReq `json:"base_req"`
+	Grantee sdk.AccAddress    `json:"grantee"`
+	MsgType string            `json:"msg_type"`
+}
+
+type ExecRequest struct {
+	BaseReq restTypes.BaseReq `json:"base_req"`
+	Msgs    []sdk.Msg         `json:"msgs"`
+}
+
+func grantHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req GrantRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgGrant(restCtx.Signer(), req.Grantee, req.MsgType, req.Expiration)
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func revokeHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req RevokeRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgRevoke(restCtx.Signer(), req.Grantee, req.MsgType)
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func execHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req ExecRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgExec(restCtx.Signer(), req.Msgs)
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
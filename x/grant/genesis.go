@@ -0,0 +1,73 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/types"
+)
+
+type GenesisState struct {
+	Grants []Grant `json:"grants"`
+}
+
+func NewGenesisState() GenesisState {
+	return GenesisState{Grants: []Grant{}}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	for _, record := range data.Grants {
+		if record.Granter.Empty() || record.Grantee.Empty() {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Grant: Missed Granter or Grantee. Value: %v", record))
+		}
+
+		if !types.IsValidMsgType(record.MsgType) {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Grant: Invalid MsgType. Value: %v", record))
+		}
+
+		if record.Expiration.IsZero() {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Grant: Missed Expiration. Value: %v", record))
+		}
+	}
+
+	return nil
+}
+
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState()
+}
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.ValidatorUpdate {
+	for _, record := range data.Grants {
+		keeper.SetGrant(ctx, record)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	var records []Grant
+
+	k.IterateGrants(ctx, types.GrantPrefix, func(grant types.Grant) (stop bool) {
+		records = append(records, grant)
+
+		return false
+	})
+
+	return GenesisState{Grants: records}
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/keeper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/types"
+)
+
+// The router is used to check the granted message types and to dispatch the messages executed on behalf.
+func NewHandler(keeper keeper.Keeper, authKeeper auth.Keeper, router sdk.Router) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case types.MsgGrant:
+			return handleMsgGrant(ctx, keeper, authKeeper, router, msg)
+		case types.MsgRevoke:
+			return handleMsgRevoke(ctx, keeper, msg)
+		case types.MsgExec:
+			return handleMsgExec(ctx, keeper, authKeeper, router, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized grant Msg type: %v", msg.Type())
+
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgGrant(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper,
+	router sdk.Router, msg types.MsgGrant) sdk.Result {
+	// check that the message type is handled by the ledger
+	if router.Route(types.RouteOf(msg.MsgType)) == nil {
+		return types.ErrInvalidMsgType(msg.MsgType).Result()
+	}
+
+	// check that grantee account exists
+	if !authKeeper.IsAccountPresent(ctx, msg.Grantee) {
+		return auth.ErrAccountDoesNotExist(msg.Grantee).Result()
+	}
+
+	// the grant must not be expired at the moment of issuing
+	if !msg.Expiration.After(ctx.BlockTime()) {
+		return types.ErrInvalidExpiration(msg.Expiration, ctx.BlockTime()).Result()
+	}
+
+	// an existing grant for the same message type is replaced
+	grant := types.NewGrant(msg.Granter, msg.Grantee, msg.MsgType, msg.Expiration)
+	keeper.SetGrant(ctx, grant)
+
+	return sdk.Result{}
+}
+
+func handleMsgRevoke(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgRevoke) sdk.Result {
+	if !keeper.IsGrantPresent(ctx, msg.Granter, msg.Grantee, msg.MsgType) {
+		return types.ErrGrantDoesNotExist(msg.Granter, msg.Grantee, msg.MsgType).Result()
+	}
+
+	keeper.DeleteGrant(ctx, msg.Granter, msg.Grantee, msg.MsgType)
+
+	return sdk.Result{}
+}
+
+func handleMsgExec(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper,
+	router sdk.Router, msg types.MsgExec) sdk.Result {
+	// already checked by the ante handler, but the grants might have been revoked (or the granters frozen)
+	// by previous messages of the tx
+	if err := checkExec(ctx, keeper, authKeeper, msg); err != nil {
+		return err.Result()
+	}
+
+	var events sdk.Events
+
+	for _, innerMsg := range msg.Msgs {
+		handler := router.Route(innerMsg.Route())
+		if handler == nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("unrecognized Msg route: %v", innerMsg.Route())).Result()
+		}
+
+		res := handler(ctx, innerMsg)
+		if !res.IsOK() {
+			return res
+		}
+
+		events = events.AppendEvents(res.Events)
+	}
+
+	return sdk.Result{Events: events}
+}
+
+// Checks that the grantee is authorized to execute the messages on behalf of their signers
+// and that the signers are not frozen (frozen accounts cannot change the ledger through their grants either).
+func checkExec(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper, msg types.MsgExec) sdk.Error {
+	if err := keeper.CheckExecAuthorization(ctx, msg); err != nil {
+		return err
+	}
+
+	for _, innerMsg := range msg.Msgs {
+		if err := authKeeper.CheckSignersNotFrozen(ctx, innerMsg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package grant
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	constants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+)
+
+func TestHandler_Grant(t *testing.T) {
+	setup := Setup()
+
+	// grant
+	msgGrant := msgGrant(setup)
+	result := setup.Handler(setup.Ctx, msgGrant)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// check
+	grant := setup.GrantKeeper.GetGrant(setup.Ctx, msgGrant.Granter, msgGrant.Grantee, msgGrant.MsgType)
+	require.Equal(t, NewGrant(msgGrant.Granter, msgGrant.Grantee, msgGrant.MsgType, msgGrant.Expiration), grant)
+
+	// grant again with another expiration
+	msgGrant.Expiration = msgGrant.Expiration.AddDate(1, 0, 0)
+	result = setup.Handler(setup.Ctx, msgGrant)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	grant = setup.GrantKeeper.GetGrant(setup.Ctx, msgGrant.Granter, msgGrant.Grantee, msgGrant.MsgType)
+	require.Equal(t, msgGrant.Expiration, grant.Expiration)
+}
+
+func TestHandler_GrantToUnknownAccount(t *testing.T) {
+	setup := Setup()
+
+	msgGrant := msgGrant(setup)
+	msgGrant.Grantee = constants.Address3
+
+	result := setup.Handler(setup.Ctx, msgGrant)
+	require.Equal(t, auth.CodeAccountDoesNotExist, result.Code)
+}
+
+func TestHandler_GrantForUnknownMsgRoute(t *testing.T) {
+	setup := Setup()
+
+	msgGrant := msgGrant(setup)
+	msgGrant.MsgType = "unknown/add_model_version"
+
+	result := setup.Handler(setup.Ctx, msgGrant)
+	require.Equal(t, CodeInvalidMsgType, result.Code)
+}
+
+func TestHandler_GrantWithPastExpiration(t *testing.T) {
+	setup := Setup()
+
+	msgGrant := msgGrant(setup)
+	ctx := setup.Ctx.WithBlockTime(msgGrant.Expiration)
+
+	result := setup.Handler(ctx, msgGrant)
+	require.Equal(t, CodeInvalidExpiration, result.Code)
+}
+
+func TestHandler_Revoke(t *testing.T) {
+	setup := Setup()
+
+	// revoke not existing grant
+	msgRevoke := NewMsgRevoke(setup.Vendor, setup.Partner, constants.GrantMsgType)
+	result := setup.Handler(setup.Ctx, msgRevoke)
+	require.Equal(t, CodeGrantDoesNotExist, result.Code)
+
+	// grant
+	result = setup.Handler(setup.Ctx, msgGrant(setup))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// revoke
+	result = setup.Handler(setup.Ctx, msgRevoke)
+	require.Equal(t, sdk.CodeOK, result.Code)
+	require.False(t, setup.GrantKeeper.IsGrantPresent(setup.Ctx, setup.Vendor, setup.Partner, constants.GrantMsgType))
+}
+
+func TestHandler_ExecAddModelVersionByPartner(t *testing.T) {
+	setup := Setup()
+	addModelInfo(setup)
+
+	// vendor grants partner to publish model versions
+	result := setup.Handler(setup.Ctx, msgGrant(setup))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// partner publishes model version on behalf of vendor
+	msgAddModelVersion := msgAddModelVersion(setup)
+	result = setup.Handler(setup.Ctx, NewMsgExec(setup.Partner, []sdk.Msg{msgAddModelVersion}))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// check
+	require.True(t, setup.ModelinfoKeeper.IsModelVersionPresent(setup.Ctx,
+		msgAddModelVersion.VID, msgAddModelVersion.PID, msgAddModelVersion.SoftwareVersion))
+}
+
+func TestHandler_ExecWithoutGrant(t *testing.T) {
+	setup := Setup()
+	addModelInfo(setup)
+
+	// grant for another message type
+	msgGrant := msgGrant(setup)
+	msgGrant.MsgType = "modelinfo/update_model_version"
+	result := setup.Handler(setup.Ctx, msgGrant)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, NewMsgExec(setup.Partner, []sdk.Msg{msgAddModelVersion(setup)}))
+	require.Equal(t, CodeNoAuthorization, result.Code)
+}
+
+func TestHandler_ExecAfterExpiration(t *testing.T) {
+	setup := Setup()
+	addModelInfo(setup)
+
+	msgGrant := msgGrant(setup)
+	result := setup.Handler(setup.Ctx, msgGrant)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	ctx := setup.Ctx.WithBlockTime(msgGrant.Expiration)
+	result = setup.Handler(ctx, NewMsgExec(setup.Partner, []sdk.Msg{msgAddModelVersion(setup)}))
+	require.Equal(t, CodeNoAuthorization, result.Code)
+}
+
+func TestHandler_ExecAfterRevoke(t *testing.T) {
+	setup := Setup()
+	addModelInfo(setup)
+
+	result := setup.Handler(setup.Ctx, msgGrant(setup))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, NewMsgRevoke(setup.Vendor, setup.Partner, constants.GrantMsgType))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, NewMsgExec(setup.Partner, []sdk.Msg{msgAddModelVersion(setup)}))
+	require.Equal(t, CodeNoAuthorization, result.Code)
+}
+
+func TestHandler_ExecAfterGranterFrozen(t *testing.T) {
+	setup := Setup()
+	addModelInfo(setup)
+
+	result := setup.Handler(setup.Ctx, msgGrant(setup))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// vendor is frozen by trustees
+	vendor := setup.authKeeper.GetAccount(setup.Ctx, setup.Vendor)
+	vendor.Frozen = true
+	setup.authKeeper.SetAccount(setup.Ctx, vendor)
+
+	result = setup.Handler(setup.Ctx, NewMsgExec(setup.Partner, []sdk.Msg{msgAddModelVersion(setup)}))
+	require.Equal(t, auth.CodeAccountFrozen, result.Code)
+
+	// vendor is unfrozen
+	vendor.Frozen = false
+	setup.authKeeper.SetAccount(setup.Ctx, vendor)
+
+	result = setup.Handler(setup.Ctx, NewMsgExec(setup.Partner, []sdk.Msg{msgAddModelVersion(setup)}))
+	require.Equal(t, sdk.CodeOK, result.Code)
+}
+
+func TestHandler_ExecFailedMsg(t *testing.T) {
+	setup := Setup()
+
+	result := setup.Handler(setup.Ctx, msgGrant(setup))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// model does not exist
+	result = setup.Handler(setup.Ctx, NewMsgExec(setup.Partner, []sdk.Msg{msgAddModelVersion(setup)}))
+	require.Equal(t, modelinfo.CodeModelInfoDoesNotExist, result.Code)
+}
+
+func msgGrant(setup TestSetup) MsgGrant {
+	return NewMsgGrant(setup.Vendor, setup.Partner, constants.GrantMsgType, constants.GrantExpiration)
+}
+
+func msgAddModelVersion(setup TestSetup) modelinfo.MsgAddModelVersion {
+	return modelinfo.NewMsgAddModelVersion(constants.VID, constants.PID, constants.SoftwareVersion,
+		constants.SoftwareVersionString, "", "", constants.MinApplicableSoftwareVersion,
+		constants.MaxApplicableSoftwareVersion, setup.Vendor)
+}
+
+func addModelInfo(setup TestSetup) {
+	setup.ModelinfoKeeper.SetModelInfo(setup.Ctx, modelinfo.ModelInfo{
+		VID:             constants.VID,
+		PID:             constants.PID,
+		Name:            constants.Name,
+		Description:     constants.Description,
+		SKU:             constants.SKU,
+		HardwareVersion: constants.HardwareVersion,
+		FirmwareVersion: constants.FirmwareVersion,
+		Owner:           setup.Vendor,
+	})
+}
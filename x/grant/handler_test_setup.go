@@ -0,0 +1,110 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grant
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/tendermint/go-amino"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+)
+
+type TestSetup struct {
+	Cdc             *amino.Codec
+	Ctx             sdk.Context
+	GrantKeeper     Keeper
+	ModelinfoKeeper modelinfo.Keeper
+	authKeeper      auth.Keeper
+	Handler         sdk.Handler
+	Querier         sdk.Querier
+	Vendor          sdk.AccAddress
+	Partner         sdk.AccAddress
+}
+
+func Setup() TestSetup {
+	// Init Codec
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	// Init KVSore
+	db := dbm.NewMemDB()
+
+	dbStore := store.NewCommitMultiStore(db)
+
+	grantKey := sdk.NewKVStoreKey(StoreKey)
+	dbStore.MountStoreWithDB(grantKey, sdk.StoreTypeIAVL, nil)
+
+	modelinfoKey := sdk.NewKVStoreKey(modelinfo.StoreKey)
+	dbStore.MountStoreWithDB(modelinfoKey, sdk.StoreTypeIAVL, nil)
+
+	authKey := sdk.NewKVStoreKey(auth.StoreKey)
+	dbStore.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, nil)
+
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+	dbStore.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+
+	_ = dbStore.LoadLatestVersion()
+
+	// Init Keepers
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, paramsTKey, params.DefaultCodespace)
+	grantKeeper := NewKeeper(grantKey, cdc)
+	modelinfoKeeper := modelinfo.NewKeeper(modelinfoKey, cdc)
+	authKeeper := auth.NewKeeper(authKey, paramsKeeper.Subspace(auth.DefaultParamspace), cdc)
+
+	// Create context
+	ctx := sdk.NewContext(dbStore, abci.Header{ChainID: testconstants.ChainID}, false, log.NewNopLogger())
+
+	// Create Router, Handler and Querier
+	router := baseapp.NewRouter()
+	router.AddRoute(modelinfo.RouterKey, modelinfo.NewHandler(modelinfoKeeper, authKeeper))
+
+	querier := NewQuerier(grantKeeper)
+	handler := NewHandler(grantKeeper, authKeeper, router)
+
+	router.AddRoute(RouterKey, handler)
+
+	vendor := auth.NewAccount(testconstants.Address1, testconstants.PubKey1, auth.AccountRoles{auth.Vendor})
+	vendor.AccountNumber = authKeeper.GetNextAccountNumber(ctx)
+	authKeeper.SetAccount(ctx, vendor)
+
+	partner := auth.NewAccount(testconstants.Address2, testconstants.PubKey2, auth.AccountRoles{})
+	partner.AccountNumber = authKeeper.GetNextAccountNumber(ctx)
+	authKeeper.SetAccount(ctx, partner)
+
+	setup := TestSetup{
+		Cdc:             cdc,
+		Ctx:             ctx,
+		GrantKeeper:     grantKeeper,
+		ModelinfoKeeper: modelinfoKeeper,
+		authKeeper:      authKeeper,
+		Handler:         handler,
+		Querier:         querier,
+		Vendor:          vendor.Address,
+		Partner:         partner.Address,
+	}
+
+	return setup
+}
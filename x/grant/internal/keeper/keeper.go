@@ -0,0 +1,134 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/types"
+)
+
+type Keeper struct {
+	// Unexposed key to access store from sdk.Context.
+	storeKey sdk.StoreKey
+
+	// The wire codec for binary encoding/decoding.
+	cdc *codec.Codec
+}
+
+func NewKeeper(storeKey sdk.StoreKey, cdc *codec.Codec) Keeper {
+	return Keeper{storeKey: storeKey, cdc: cdc}
+}
+
+// Gets the Grant issued by the granter to the grantee for the message type.
+func (k Keeper) GetGrant(ctx sdk.Context, granter sdk.AccAddress,
+	grantee sdk.AccAddress, msgType string) types.Grant {
+	if !k.IsGrantPresent(ctx, granter, grantee, msgType) {
+		panic("Grant does not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetGrantKey(granter, grantee, msgType))
+
+	var grant types.Grant
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &grant)
+
+	return grant
+}
+
+// Sets the Grant (an existing grant for the same granter, grantee and message type is replaced).
+func (k Keeper) SetGrant(ctx sdk.Context, grant types.Grant) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetGrantKey(grant.Granter, grant.Grantee, grant.MsgType), k.cdc.MustMarshalBinaryBare(grant))
+}
+
+// Deletes the Grant.
+func (k Keeper) DeleteGrant(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress, msgType string) {
+	if !k.IsGrantPresent(ctx, granter, grantee, msgType) {
+		panic("Grant does not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetGrantKey(granter, grantee, msgType))
+}
+
+// Check if the Grant is present in the store or not.
+func (k Keeper) IsGrantPresent(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress, msgType string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetGrantKey(granter, grantee, msgType))
+}
+
+// Check if the granter has issued the grant for the message type to the grantee which is not expired at the block time.
+func (k Keeper) HasActiveGrant(ctx sdk.Context, granter sdk.AccAddress, grantee sdk.AccAddress, msgType string) bool {
+	if !k.IsGrantPresent(ctx, granter, grantee, msgType) {
+		return false
+	}
+
+	return k.GetGrant(ctx, granter, grantee, msgType).IsActive(ctx.BlockTime())
+}
+
+// Check that every signer of the messages wrapped into MsgExec is either the grantee itself
+// or has issued an active grant for the message type to the grantee.
+func (k Keeper) CheckExecAuthorization(ctx sdk.Context, msg types.MsgExec) sdk.Error {
+	for _, innerMsg := range msg.Msgs {
+		msgType := types.MsgTypeOf(innerMsg)
+
+		for _, signer := range innerMsg.GetSigners() {
+			if signer.Equals(msg.Grantee) {
+				continue
+			}
+
+			if !k.HasActiveGrant(ctx, signer, msg.Grantee, msgType) {
+				return types.ErrNoAuthorization(signer, msg.Grantee, msgType)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Iterate over Grants with the given key prefix (e.g. all grants issued by a single granter).
+func (k Keeper) IterateGrants(ctx sdk.Context, prefix []byte, process func(grant types.Grant) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var grant types.Grant
+
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &grant)
+
+		if process(grant) {
+			return
+		}
+	}
+}
+
+func (k Keeper) CountTotalGrants(ctx sdk.Context, prefix []byte) int {
+	store := ctx.KVStore(k.storeKey)
+	res := 0
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		res++
+	}
+
+	return res
+}
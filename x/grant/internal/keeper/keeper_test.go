@@ -0,0 +1,137 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+)
+
+func TestKeeper_GrantGetSetDelete(t *testing.T) {
+	setup := Setup()
+	grant := DefaultGrant()
+
+	// check if grant present
+	require.False(t, setup.GrantKeeper.IsGrantPresent(setup.Ctx, grant.Granter, grant.Grantee, grant.MsgType))
+
+	// no grant before its created
+	require.Panics(t, func() {
+		setup.GrantKeeper.GetGrant(setup.Ctx, grant.Granter, grant.Grantee, grant.MsgType)
+	})
+
+	// create grant
+	setup.GrantKeeper.SetGrant(setup.Ctx, grant)
+
+	// check if grant present
+	require.True(t, setup.GrantKeeper.IsGrantPresent(setup.Ctx, grant.Granter, grant.Grantee, grant.MsgType))
+
+	// grant is not present for another message type or in the opposite direction
+	require.False(t, setup.GrantKeeper.IsGrantPresent(setup.Ctx, grant.Granter, grant.Grantee, "modelinfo/add_model"))
+	require.False(t, setup.GrantKeeper.IsGrantPresent(setup.Ctx, grant.Grantee, grant.Granter, grant.MsgType))
+
+	// get grant
+	require.Equal(t, grant, setup.GrantKeeper.GetGrant(setup.Ctx, grant.Granter, grant.Grantee, grant.MsgType))
+
+	// delete grant
+	setup.GrantKeeper.DeleteGrant(setup.Ctx, grant.Granter, grant.Grantee, grant.MsgType)
+	require.False(t, setup.GrantKeeper.IsGrantPresent(setup.Ctx, grant.Granter, grant.Grantee, grant.MsgType))
+
+	require.Panics(t, func() {
+		setup.GrantKeeper.DeleteGrant(setup.Ctx, grant.Granter, grant.Grantee, grant.MsgType)
+	})
+}
+
+func TestKeeper_HasActiveGrant(t *testing.T) {
+	setup := Setup()
+	grant := DefaultGrant()
+
+	require.False(t, setup.GrantKeeper.HasActiveGrant(setup.Ctx, grant.Granter, grant.Grantee, grant.MsgType))
+
+	setup.GrantKeeper.SetGrant(setup.Ctx, grant)
+	require.True(t, setup.GrantKeeper.HasActiveGrant(setup.Ctx, grant.Granter, grant.Grantee, grant.MsgType))
+
+	// grant is not active since its expiration
+	ctx := setup.Ctx.WithBlockTime(grant.Expiration)
+	require.False(t, setup.GrantKeeper.HasActiveGrant(ctx, grant.Granter, grant.Grantee, grant.MsgType))
+}
+
+func TestKeeper_CheckExecAuthorization(t *testing.T) {
+	setup := Setup()
+	grant := DefaultGrant()
+
+	msg := types.NewMsgExec(grant.Grantee, []sdk.Msg{newMsgAddModelVersion(grant.Granter)})
+
+	// no grant
+	err := setup.GrantKeeper.CheckExecAuthorization(setup.Ctx, msg)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeNoAuthorization, err.Code())
+
+	// grant issued
+	setup.GrantKeeper.SetGrant(setup.Ctx, grant)
+	require.Nil(t, setup.GrantKeeper.CheckExecAuthorization(setup.Ctx, msg))
+
+	// messages signed by the grantee itself need no grant
+	msg.Msgs = append(msg.Msgs, newMsgAddModelVersion(grant.Grantee))
+	require.Nil(t, setup.GrantKeeper.CheckExecAuthorization(setup.Ctx, msg))
+
+	// messages signed by another account
+	msg.Msgs = append(msg.Msgs, newMsgAddModelVersion(testconstants.Address3))
+	err = setup.GrantKeeper.CheckExecAuthorization(setup.Ctx, msg)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeNoAuthorization, err.Code())
+}
+
+func TestKeeper_GrantIterator(t *testing.T) {
+	setup := Setup()
+
+	grant := DefaultGrant()
+	setup.GrantKeeper.SetGrant(setup.Ctx, grant)
+
+	grant.MsgType = "modelinfo/update_model_version"
+	setup.GrantKeeper.SetGrant(setup.Ctx, grant)
+
+	grant.Granter = testconstants.Address3
+	setup.GrantKeeper.SetGrant(setup.Ctx, grant)
+
+	// check
+	prefix := types.GetGranterGrantsPrefix(testconstants.Address1)
+	require.Equal(t, 2, setup.GrantKeeper.CountTotalGrants(setup.Ctx, prefix))
+	require.Equal(t, 3, setup.GrantKeeper.CountTotalGrants(setup.Ctx, types.GrantPrefix))
+
+	var msgTypes []string
+
+	setup.GrantKeeper.IterateGrants(setup.Ctx, prefix, func(grant types.Grant) (stop bool) {
+		msgTypes = append(msgTypes, grant.MsgType)
+
+		return false
+	})
+
+	require.Equal(t, []string{"modelinfo/add_model_version", "modelinfo/update_model_version"}, msgTypes)
+}
+
+func newMsgAddModelVersion(signer sdk.AccAddress) modelinfo.MsgAddModelVersion {
+	return modelinfo.MsgAddModelVersion{
+		VID:             testconstants.VID,
+		PID:             testconstants.PID,
+		SoftwareVersion: testconstants.SoftwareVersion,
+		Signer:          signer,
+	}
+}
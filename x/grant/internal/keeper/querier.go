@@ -0,0 +1,148 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/types"
+)
+
+const (
+	QueryGrant         = "grant"
+	QueryGranterGrants = "granter_grants"
+	QueryGranteeGrants = "grantee_grants"
+)
+
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryGrant:
+			return queryGrant(ctx, path[1:], keeper)
+		case QueryGranterGrants:
+			return queryGranterGrants(ctx, path[1:], req, keeper)
+		case QueryGranteeGrants:
+			return queryGranteeGrants(ctx, path[1:], req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown grant query endpoint")
+		}
+	}
+}
+
+// Path: <granter>/<grantee>/<route>/<type>.
+func queryGrant(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	if len(path) < 4 {
+		return nil, sdk.ErrUnknownRequest("expected path: <granter>/<grantee>/<route>/<type>")
+	}
+
+	granter, err_ := sdk.AccAddressFromBech32(path[0])
+	if err_ != nil {
+		return nil, sdk.ErrInvalidAddress(fmt.Sprintf("Invalid Granter address: %v", err_))
+	}
+
+	grantee, err_ := sdk.AccAddressFromBech32(path[1])
+	if err_ != nil {
+		return nil, sdk.ErrInvalidAddress(fmt.Sprintf("Invalid Grantee address: %v", err_))
+	}
+
+	msgType := strings.Join(path[2:], "/")
+
+	if !keeper.IsGrantPresent(ctx, granter, grantee, msgType) {
+		return nil, types.ErrGrantDoesNotExist(granter, grantee, msgType)
+	}
+
+	grant := keeper.GetGrant(ctx, granter, grantee, msgType)
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, grant)
+
+	return res, nil
+}
+
+func queryGranterGrants(ctx sdk.Context, path []string, req abci.RequestQuery,
+	keeper Keeper) (res []byte, err sdk.Error) {
+	granter, err_ := sdk.AccAddressFromBech32(path[0])
+	if err_ != nil {
+		return nil, sdk.ErrInvalidAddress(fmt.Sprintf("Invalid Granter address: %v", err_))
+	}
+
+	prefix := types.GetGranterGrantsPrefix(granter)
+
+	return queryGrants(ctx, req, keeper, prefix, keeper.CountTotalGrants(ctx, prefix),
+		func(grant types.Grant) bool { return true })
+}
+
+func queryGranteeGrants(ctx sdk.Context, path []string, req abci.RequestQuery,
+	keeper Keeper) (res []byte, err sdk.Error) {
+	grantee, err_ := sdk.AccAddressFromBech32(path[0])
+	if err_ != nil {
+		return nil, sdk.ErrInvalidAddress(fmt.Sprintf("Invalid Grantee address: %v", err_))
+	}
+
+	filter := func(grant types.Grant) bool { return grant.Grantee.Equals(grantee) }
+
+	total := 0
+
+	keeper.IterateGrants(ctx, types.GrantPrefix, func(grant types.Grant) (stop bool) {
+		if filter(grant) {
+			total++
+		}
+
+		return false
+	})
+
+	return queryGrants(ctx, req, keeper, types.GrantPrefix, total, filter)
+}
+
+func queryGrants(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, prefix []byte, total int,
+	filter func(grant types.Grant) bool) (res []byte, err sdk.Error) {
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := types.ListGrants{
+		Total: total,
+		Items: []types.Grant{},
+	}
+
+	keeper.IterateGrants(ctx, prefix, func(grant types.Grant) (stop bool) {
+		if !filter(grant) {
+			return false
+		}
+
+		if paginator.Add(types.GetGrantKey(grant.Granter, grant.Grantee, grant.MsgType)) {
+			result.Items = append(result.Items, grant)
+		}
+
+		return paginator.Done()
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
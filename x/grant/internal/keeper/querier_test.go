@@ -0,0 +1,106 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/grant/internal/types"
+)
+
+func TestQuerier_QueryGrant(t *testing.T) {
+	setup := Setup()
+	grant := DefaultGrant()
+
+	path := []string{QueryGrant, grant.Granter.String(), grant.Grantee.String(), "modelinfo", "add_model_version"}
+
+	// no grant
+	_, err := setup.Querier(setup.Ctx, path, abci.RequestQuery{})
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeGrantDoesNotExist, err.Code())
+
+	// add grant
+	setup.GrantKeeper.SetGrant(setup.Ctx, grant)
+
+	result, err := setup.Querier(setup.Ctx, path, abci.RequestQuery{})
+	require.Nil(t, err)
+
+	var receivedGrant types.Grant
+	_ = setup.Cdc.UnmarshalJSON(result, &receivedGrant)
+
+	require.Equal(t, grant, receivedGrant)
+}
+
+func TestQuerier_QueryGranterAndGranteeGrants(t *testing.T) {
+	setup := Setup()
+
+	// Address1 grants to Address2 two message types, Address3 grants to Address2 and Address1
+	grant := DefaultGrant()
+	setup.GrantKeeper.SetGrant(setup.Ctx, grant)
+
+	grant.MsgType = "modelinfo/update_model_version"
+	setup.GrantKeeper.SetGrant(setup.Ctx, grant)
+
+	grant.Granter = testconstants.Address3
+	setup.GrantKeeper.SetGrant(setup.Ctx, grant)
+
+	grant.Grantee = testconstants.Address1
+	setup.GrantKeeper.SetGrant(setup.Ctx, grant)
+
+	// query grants issued by Address1 skip=1 take=1
+	receivedGrants := queryGrantList(setup, QueryGranterGrants, testconstants.Address1.String(),
+		pagination.NewPaginationParams(1, 1))
+
+	require.Equal(t, 2, receivedGrants.Total)
+	require.Equal(t, 1, len(receivedGrants.Items))
+	require.Equal(t, "modelinfo/update_model_version", receivedGrants.Items[0].MsgType)
+
+	// query grants issued to Address2
+	receivedGrants = queryGrantList(setup, QueryGranteeGrants, testconstants.Address2.String(),
+		pagination.NewPaginationParams(0, 0))
+
+	require.Equal(t, 3, receivedGrants.Total)
+	require.Equal(t, 3, len(receivedGrants.Items))
+
+	for _, item := range receivedGrants.Items {
+		require.Equal(t, testconstants.Address2, item.Grantee)
+	}
+
+	// query grants issued to Address3
+	receivedGrants = queryGrantList(setup, QueryGranteeGrants, testconstants.Address3.String(),
+		pagination.NewPaginationParams(0, 0))
+
+	require.Equal(t, 0, receivedGrants.Total)
+	require.Equal(t, 0, len(receivedGrants.Items))
+}
+
+func queryGrantList(setup TestSetup, query string, address string,
+	params pagination.PaginationParams) types.ListGrants {
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{query, address},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)},
+	)
+
+	var receivedGrants types.ListGrants
+	_ = setup.Cdc.UnmarshalJSON(result, &receivedGrants)
+
+	return receivedGrants
+}
@@ -0,0 +1,61 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
+)
+
+const (
+	Codespace sdk.CodespaceType = ModuleName
+
+	CodeGrantDoesNotExist sdk.CodeType = 1001
+	CodeNoAuthorization   sdk.CodeType = 1002
+	CodeInvalidMsgType    sdk.CodeType = 1003
+	CodeInvalidExpiration sdk.CodeType = 1004
+)
+
+func init() {
+	errcodes.Register(Codespace, CodeGrantDoesNotExist, "grant_does_not_exist")
+	errcodes.Register(Codespace, CodeNoAuthorization, "no_authorization")
+	errcodes.Register(Codespace, CodeInvalidMsgType, "invalid_msg_type")
+	errcodes.Register(Codespace, CodeInvalidExpiration, "invalid_expiration")
+}
+
+func ErrGrantDoesNotExist(granter interface{}, grantee interface{}, msgType interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeGrantDoesNotExist,
+		fmt.Sprintf("No grant issued by account=%v to account=%v for messages of type=%v on the ledger",
+			granter, grantee, msgType))
+}
+
+func ErrNoAuthorization(granter interface{}, grantee interface{}, msgType interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeNoAuthorization,
+		fmt.Sprintf("Account=%v is not authorized to execute messages of type=%v on behalf of account=%v: "+
+			"there is no active grant", grantee, msgType, granter))
+}
+
+func ErrInvalidMsgType(msgType interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeInvalidMsgType,
+		fmt.Sprintf("Invalid message type: \"%v\". It must be `<route>/<type>` of a message "+
+			"handled by the ledger", msgType))
+}
+
+func ErrInvalidExpiration(expiration interface{}, blockTime interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeInvalidExpiration,
+		fmt.Sprintf("The expiration:%v must be after the current block time:%v", expiration, blockTime))
+}
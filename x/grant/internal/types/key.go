@@ -0,0 +1,44 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the name of the module.
+	ModuleName = "grant"
+
+	// StoreKey to be used when creating the KVStore.
+	StoreKey = ModuleName
+)
+
+var GrantPrefix = []byte{0x01} // prefix for each key to a grant
+
+// Key builder for Grant.
+func GetGrantKey(granter sdk.AccAddress, grantee sdk.AccAddress, msgType string) []byte {
+	return append(GetGranteeGrantsPrefix(granter, grantee), []byte(msgType)...)
+}
+
+// Key prefix for all Grants issued by the granter.
+func GetGranterGrantsPrefix(granter sdk.AccAddress) []byte {
+	return append(GrantPrefix, granter.Bytes()...)
+}
+
+// Key prefix for all Grants issued by the granter to the grantee.
+func GetGranteeGrantsPrefix(granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	return append(GetGranterGrantsPrefix(granter), grantee.Bytes()...)
+}
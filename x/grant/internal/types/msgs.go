@@ -0,0 +1,207 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const RouterKey = ModuleName
+
+// Maximal number of the messages executed on behalf by one MsgExec.
+const MaxExecMsgs = 16
+
+type MsgGrant struct {
+	Granter    sdk.AccAddress `json:"granter"`
+	Grantee    sdk.AccAddress `json:"grantee"`
+	MsgType    string         `json:"msg_type"`
+	Expiration time.Time      `json:"expiration"` // rfc3339 encoded date
+}
+
+func NewMsgGrant(granter sdk.AccAddress, grantee sdk.AccAddress,
+	msgType string, expiration time.Time) MsgGrant {
+	return MsgGrant{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgType:    msgType,
+		Expiration: expiration,
+	}
+}
+
+func (m MsgGrant) Route() string {
+	return RouterKey
+}
+
+func (m MsgGrant) Type() string {
+	return "grant"
+}
+
+func (m MsgGrant) ValidateBasic() sdk.Error {
+	if m.Granter.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Granter: it cannot be empty")
+	}
+
+	if m.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Grantee: it cannot be empty")
+	}
+
+	if m.Granter.Equals(m.Grantee) {
+		return sdk.ErrUnknownRequest("Invalid Grantee: it must differ from Granter")
+	}
+
+	if !IsValidMsgType(m.MsgType) {
+		return ErrInvalidMsgType(m.MsgType)
+	}
+
+	if RouteOf(m.MsgType) == RouterKey {
+		return ErrInvalidMsgType(m.MsgType)
+	}
+
+	if m.Expiration.IsZero() {
+		return sdk.ErrUnknownRequest("Invalid Expiration: it cannot be empty")
+	}
+
+	return nil
+}
+
+func (m MsgGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Granter}
+}
+
+type MsgRevoke struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	MsgType string         `json:"msg_type"`
+}
+
+func NewMsgRevoke(granter sdk.AccAddress, grantee sdk.AccAddress, msgType string) MsgRevoke {
+	return MsgRevoke{
+		Granter: granter,
+		Grantee: grantee,
+		MsgType: msgType,
+	}
+}
+
+func (m MsgRevoke) Route() string {
+	return RouterKey
+}
+
+func (m MsgRevoke) Type() string {
+	return "revoke"
+}
+
+func (m MsgRevoke) ValidateBasic() sdk.Error {
+	if m.Granter.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Granter: it cannot be empty")
+	}
+
+	if m.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Grantee: it cannot be empty")
+	}
+
+	if !IsValidMsgType(m.MsgType) {
+		return ErrInvalidMsgType(m.MsgType)
+	}
+
+	return nil
+}
+
+func (m MsgRevoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgRevoke) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Granter}
+}
+
+// Executes the messages on behalf of their signers which granted the permission to the grantee.
+// The transaction containing MsgExec is signed by the grantee only.
+type MsgExec struct {
+	Grantee sdk.AccAddress `json:"grantee"`
+	Msgs    []sdk.Msg      `json:"msgs"`
+}
+
+func NewMsgExec(grantee sdk.AccAddress, msgs []sdk.Msg) MsgExec {
+	return MsgExec{
+		Grantee: grantee,
+		Msgs:    msgs,
+	}
+}
+
+func (m MsgExec) Route() string {
+	return RouterKey
+}
+
+func (m MsgExec) Type() string {
+	return "exec"
+}
+
+func (m MsgExec) ValidateBasic() sdk.Error {
+	if m.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Grantee: it cannot be empty")
+	}
+
+	if len(m.Msgs) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Msgs: it cannot be empty")
+	}
+
+	if len(m.Msgs) > MaxExecMsgs {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Msgs: it must contain at most %v messages", MaxExecMsgs))
+	}
+
+	for _, msg := range m.Msgs {
+		if msg.Route() == RouterKey {
+			return sdk.ErrUnknownRequest(
+				fmt.Sprintf("Invalid Msgs: messages of %v module cannot be executed on behalf", RouterKey))
+		}
+
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// The inner messages are not necessarily registered in the module codec,
+// so their own sign bytes are embedded.
+func (m MsgExec) GetSignBytes() []byte {
+	msgs := make([]json.RawMessage, 0, len(m.Msgs))
+	for _, msg := range m.Msgs {
+		msgs = append(msgs, msg.GetSignBytes())
+	}
+
+	bytes, err := json.Marshal(struct {
+		Grantee sdk.AccAddress    `json:"grantee"`
+		Msgs    []json.RawMessage `json:"msgs"`
+	}{m.Grantee, msgs})
+	if err != nil {
+		panic(err)
+	}
+
+	return sdk.MustSortJSON(bytes)
+}
+
+func (m MsgExec) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Grantee}
+}
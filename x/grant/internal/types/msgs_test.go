@@ -0,0 +1,169 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+)
+
+func TestNewMsgGrant(t *testing.T) {
+	msg := NewMsgGrant(testconstants.Address1, testconstants.Address2,
+		testconstants.GrantMsgType, testconstants.GrantExpiration)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "grant")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Address1})
+}
+
+func TestMsgGrantValidation(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgGrant
+	}{
+		{true, NewMsgGrant(testconstants.Address1, testconstants.Address2,
+			testconstants.GrantMsgType, testconstants.GrantExpiration)},
+		{false, NewMsgGrant(nil, testconstants.Address2,
+			testconstants.GrantMsgType, testconstants.GrantExpiration)},
+		{false, NewMsgGrant(testconstants.Address1, nil,
+			testconstants.GrantMsgType, testconstants.GrantExpiration)},
+		{false, NewMsgGrant(testconstants.Address1, testconstants.Address1,
+			testconstants.GrantMsgType, testconstants.GrantExpiration)},
+		{false, NewMsgGrant(testconstants.Address1, testconstants.Address2,
+			"", testconstants.GrantExpiration)},
+		{false, NewMsgGrant(testconstants.Address1, testconstants.Address2,
+			"modelinfo", testconstants.GrantExpiration)},
+		{false, NewMsgGrant(testconstants.Address1, testconstants.Address2,
+			"modelinfo/", testconstants.GrantExpiration)},
+		{false, NewMsgGrant(testconstants.Address1, testconstants.Address2,
+			"grant/exec", testconstants.GrantExpiration)},
+		{false, NewMsgGrant(testconstants.Address1, testconstants.Address2,
+			testconstants.GrantMsgType, time.Time{})},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestNewMsgRevoke(t *testing.T) {
+	msg := NewMsgRevoke(testconstants.Address1, testconstants.Address2, testconstants.GrantMsgType)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "revoke")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Address1})
+}
+
+func TestMsgRevokeValidation(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgRevoke
+	}{
+		{true, NewMsgRevoke(testconstants.Address1, testconstants.Address2, testconstants.GrantMsgType)},
+		{false, NewMsgRevoke(nil, testconstants.Address2, testconstants.GrantMsgType)},
+		{false, NewMsgRevoke(testconstants.Address1, nil, testconstants.GrantMsgType)},
+		{false, NewMsgRevoke(testconstants.Address1, testconstants.Address2, "modelinfo")},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestNewMsgExec(t *testing.T) {
+	msg := NewMsgExec(testconstants.Address2, []sdk.Msg{newMsgAddModelVersion(testconstants.Address1)})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "exec")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Address2})
+	require.NotPanics(t, func() { msg.GetSignBytes() })
+}
+
+func TestMsgExecValidation(t *testing.T) {
+	innerMsg := newMsgAddModelVersion(testconstants.Address1)
+
+	invalidInnerMsg := newMsgAddModelVersion(testconstants.Address1)
+	invalidInnerMsg.VID = 0
+
+	cases := []struct {
+		valid bool
+		msg   MsgExec
+	}{
+		{true, NewMsgExec(testconstants.Address2, []sdk.Msg{innerMsg})},
+		{true, NewMsgExec(testconstants.Address2, []sdk.Msg{innerMsg, innerMsg})},
+		{false, NewMsgExec(nil, []sdk.Msg{innerMsg})},
+		{false, NewMsgExec(testconstants.Address2, []sdk.Msg{})},
+		{false, NewMsgExec(testconstants.Address2, []sdk.Msg{invalidInnerMsg})},
+		{true, NewMsgExec(testconstants.Address2, repeatMsg(innerMsg, MaxExecMsgs))},
+		{false, NewMsgExec(testconstants.Address2, repeatMsg(innerMsg, MaxExecMsgs+1))},
+		{false, NewMsgExec(testconstants.Address2, []sdk.Msg{
+			NewMsgExec(testconstants.Address1, []sdk.Msg{innerMsg}),
+		})},
+		{false, NewMsgExec(testconstants.Address2, []sdk.Msg{
+			NewMsgRevoke(testconstants.Address1, testconstants.Address3, testconstants.GrantMsgType),
+		})},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func repeatMsg(msg sdk.Msg, count int) []sdk.Msg {
+	msgs := make([]sdk.Msg, count)
+	for i := range msgs {
+		msgs[i] = msg
+	}
+
+	return msgs
+}
+
+func TestMsgTypeOf(t *testing.T) {
+	msgType := MsgTypeOf(newMsgAddModelVersion(testconstants.Address1))
+
+	require.Equal(t, testconstants.GrantMsgType, msgType)
+	require.True(t, IsValidMsgType(msgType))
+	require.Equal(t, "modelinfo", RouteOf(msgType))
+}
+
+func newMsgAddModelVersion(signer sdk.AccAddress) modelinfo.MsgAddModelVersion {
+	return modelinfo.NewMsgAddModelVersion(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+		testconstants.SoftwareVersionString, "", "", testconstants.MinApplicableSoftwareVersion,
+		testconstants.MaxApplicableSoftwareVersion, signer)
+}
@@ -0,0 +1,37 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+)
+
+// Response Payload for a list query with pagination.
+type ListGrants struct {
+	Total   int     `json:"total"`
+	Items   []Grant `json:"items"`
+	NextKey string  `json:"next_key"`
+	PrevKey string  `json:"prev_key"`
+}
+
+// Implement fmt.Stringer.
+func (n ListGrants) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
@@ -0,0 +1,72 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Permission of the grantee to execute the messages of the given type on behalf of the granter.
+type Grant struct {
+	Granter    sdk.AccAddress `json:"granter"`
+	Grantee    sdk.AccAddress `json:"grantee"`
+	MsgType    string         `json:"msg_type"`   // `<route>/<type>` of the message, e.g. `modelinfo/add_model_version`
+	Expiration time.Time      `json:"expiration"` // rfc3339 encoded date
+}
+
+func NewGrant(granter sdk.AccAddress, grantee sdk.AccAddress, msgType string, expiration time.Time) Grant {
+	return Grant{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgType:    msgType,
+		Expiration: expiration,
+	}
+}
+
+// The grant is active until its expiration date.
+func (g Grant) IsActive(now time.Time) bool {
+	return now.Before(g.Expiration)
+}
+
+func (g Grant) String() string {
+	bytes, err := json.Marshal(g)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+// Returns the type of the message as it is specified in grants (`<route>/<type>`).
+func MsgTypeOf(msg sdk.Msg) string {
+	return fmt.Sprintf("%s/%s", msg.Route(), msg.Type())
+}
+
+// Returns the route of the message type (`<route>/<type>`).
+func RouteOf(msgType string) string {
+	return strings.SplitN(msgType, "/", 2)[0]
+}
+
+// Check that the message type has `<route>/<type>` form.
+func IsValidMsgType(msgType string) bool {
+	parts := strings.Split(msgType, "/")
+
+	return len(parts) == 2 && len(parts[0]) != 0 && len(parts[1]) != 0
+}
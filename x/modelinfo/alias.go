@@ -20,30 +20,68 @@ import (
 )
 
 const (
-	ModuleName                 = types.ModuleName
-	RouterKey                  = types.RouterKey
-	StoreKey                   = types.StoreKey
-	CodeModelInfoDoesNotExist  = types.CodeModelInfoDoesNotExist
-	CodeModelInfoAlreadyExists = types.CodeModelInfoAlreadyExists
+	ModuleName                       = types.ModuleName
+	RouterKey                        = types.RouterKey
+	StoreKey                         = types.StoreKey
+	CodeModelInfoDoesNotExist        = types.CodeModelInfoDoesNotExist
+	CodeModelInfoAlreadyExists       = types.CodeModelInfoAlreadyExists
+	CodeModelVersionDoesNotExist     = types.CodeModelVersionDoesNotExist
+	CodeModelVersionAlreadyExists    = types.CodeModelVersionAlreadyExists
+	CodeModelInfoArchived            = types.CodeModelInfoArchived
+	CodeModelInfoNotArchived         = types.CodeModelInfoNotArchived
+	CodeSequenceConflict             = types.CodeSequenceConflict
+	CodeCustomDataSchemaDoesNotExist = types.CodeCustomDataSchemaDoesNotExist
+	CodeInvalidCustomData            = types.CodeInvalidCustomData
+	CodeVendorProductsDoNotExist     = types.CodeVendorProductsDoNotExist
+
+	QueryModel                         = keeper.QueryModel
+	QueryAllModels                     = keeper.QueryAllModels
+	QueryModelVersionsByFirmwareDigest = keeper.QueryModelVersionsByFirmwareDigest
 )
 
 var (
-	NewKeeper                = keeper.NewKeeper
-	NewQuerier               = keeper.NewQuerier
-	NewMsgAddModelInfo       = types.NewMsgAddModelInfo
-	NewMsgUpdateModelInfo    = types.NewMsgUpdateModelInfo
-	ModuleCdc                = types.ModuleCdc
-	RegisterCodec            = types.RegisterCodec
-	ErrModelInfoDoesNotExist = types.ErrModelInfoDoesNotExist
+	NewKeeper                          = keeper.NewKeeper
+	NewQuerier                         = keeper.NewQuerier
+	ModelInfoPrefix                    = types.ModelInfoPrefix
+	ModelVersionPrefix                 = types.ModelVersionPrefix
+	NewMsgAddModelInfo                 = types.NewMsgAddModelInfo
+	NewMsgUpdateModelInfo              = types.NewMsgUpdateModelInfo
+	NewMsgAddModelVersion              = types.NewMsgAddModelVersion
+	NewMsgUpdateModelVersion           = types.NewMsgUpdateModelVersion
+	NewMsgArchiveModel                 = types.NewMsgArchiveModel
+	NewMsgUnarchiveModel               = types.NewMsgUnarchiveModel
+	NewMsgSetCustomDataSchema          = types.NewMsgSetCustomDataSchema
+	NewCustomDataSchema                = types.NewCustomDataSchema
+	NewListModelsQueryParams           = types.NewListModelsQueryParams
+	ModuleCdc                          = types.ModuleCdc
+	RegisterCodec                      = types.RegisterCodec
+	ErrModelInfoDoesNotExist           = types.ErrModelInfoDoesNotExist
+	ErrModelVersionDoesNotExist        = types.ErrModelVersionDoesNotExist
+	ErrCustomDataSchemaDoesNotExist    = types.ErrCustomDataSchemaDoesNotExist
+	ValidateApplicableSoftwareVersions = types.ValidateApplicableSoftwareVersions
+	GetModelVersionKey                 = types.GetModelVersionKey
 )
 
 type (
-	Keeper             = keeper.Keeper
-	MsgAddModelInfo    = types.MsgAddModelInfo
-	MsgUpdateModelInfo = types.MsgUpdateModelInfo
-	MsgDeleteModelInfo = types.MsgDeleteModelInfo
-	ModelInfo          = types.ModelInfo
-	VendorProducts     = types.VendorProducts
-	ModelInfoItem      = types.ModelInfoItem
-	VendorItem         = types.VendorItem
+	Keeper                 = keeper.Keeper
+	MsgAddModelInfo        = types.MsgAddModelInfo
+	MsgUpdateModelInfo     = types.MsgUpdateModelInfo
+	MsgDeleteModelInfo     = types.MsgDeleteModelInfo
+	MsgAddModelVersion     = types.MsgAddModelVersion
+	MsgUpdateModelVersion  = types.MsgUpdateModelVersion
+	MsgArchiveModel        = types.MsgArchiveModel
+	MsgUnarchiveModel      = types.MsgUnarchiveModel
+	MsgSetCustomDataSchema = types.MsgSetCustomDataSchema
+	ListModelsQueryParams  = types.ListModelsQueryParams
+	ListModelInfoItems     = types.ListModelInfoItems
+	ModelVersion           = types.ModelVersion
+	ModelInfo              = types.ModelInfo
+	VendorProducts         = types.VendorProducts
+	ModelInfoItem          = types.ModelInfoItem
+	VendorItem             = types.VendorItem
+	CustomDataSchema       = types.CustomDataSchema
+	ListCustomDataSchemas  = types.ListCustomDataSchemas
+	ListModelVersions      = types.ListModelVersions
+	MatterModelInfo        = types.MatterModelInfo
+	ModelAddedHeight       = types.ModelAddedHeight
 )
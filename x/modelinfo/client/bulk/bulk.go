@@ -0,0 +1,378 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bulk implements the upload of many models at once: parsing of JSON/CSV catalogs,
+// validation of every row, submission of the valid rows in batched transactions
+// and the per-row report, shared by the CLI command and the REST endpoint.
+package bulk
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/internal/types"
+)
+
+const (
+	FormatJSON = "json"
+	FormatCSV  = "csv"
+
+	// Default number of models added by a single transaction.
+	DefaultBatchSize = 20
+	// Maximal number of models added by a single transaction.
+	MaxBatchSize = 100
+)
+
+// Model info to add (a row of the uploaded catalog).
+// CSV columns are named after the JSON fields (the Matter fields can be passed in JSON catalogs only).
+type Model struct {
+	VID                      uint16                 `json:"vid"`
+	PID                      uint16                 `json:"pid"`
+	CID                      uint16                 `json:"cid,omitempty"`
+	Version                  string                 `json:"version,omitempty"`
+	Name                     string                 `json:"name"`
+	Description              string                 `json:"description"`
+	SKU                      string                 `json:"sku"`
+	HardwareVersion          string                 `json:"hardware_version"`
+	FirmwareVersion          string                 `json:"firmware_version"`
+	OtaURL                   string                 `json:"ota_url,omitempty"`
+	OtaChecksum              string                 `json:"ota_checksum,omitempty"`
+	OtaChecksumType          string                 `json:"ota_checksum_type,omitempty"`
+	Custom                   string                 `json:"custom,omitempty"`
+	TisOrTrpTestingCompleted bool                   `json:"tis_or_trp_testing_completed"`
+	CustomSchema             string                 `json:"custom_schema,omitempty"`
+	Matter                   *types.MatterModelInfo `json:"matter,omitempty"`
+}
+
+func (m Model) Msg(signer sdk.AccAddress) types.MsgAddModelInfo {
+	msg := types.NewMsgAddModelInfo(m.VID, m.PID, m.CID, m.Version, m.Name, m.Description, m.SKU,
+		m.HardwareVersion, m.FirmwareVersion, m.OtaURL, m.OtaChecksum, m.OtaChecksumType,
+		m.Custom, m.TisOrTrpTestingCompleted, signer)
+	msg.CustomSchema = m.CustomSchema
+	msg.Matter = m.Matter
+
+	return msg
+}
+
+// Parsed row of the catalog. Rows are numbered from 1 (the CSV header is not counted).
+type Row struct {
+	Number int
+	Model  Model
+	Err    error // the row could not be parsed
+}
+
+// Result of a single row.
+type Result struct {
+	Row       int               `json:"row"`
+	VID       uint16            `json:"vid"`
+	PID       uint16            `json:"pid"`
+	TxHash    string            `json:"tx_hash,omitempty"`
+	Codespace sdk.CodespaceType `json:"codespace,omitempty"`
+	Code      sdk.CodeType      `json:"code,omitempty"`
+	Name      string            `json:"name,omitempty"`
+	Error     string            `json:"error,omitempty"`
+}
+
+// Per-row report of the upload.
+type Report struct {
+	Total    int      `json:"total"`
+	Accepted int      `json:"accepted"`
+	Rejected int      `json:"rejected"`
+	Items    []Result `json:"items"`
+}
+
+// Implement fmt.Stringer.
+func (r Report) String() string {
+	res, err := json.Marshal(r)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
+
+// Options of the upload.
+type Options struct {
+	BatchSize int  // number of models added by a single transaction
+	DryRun    bool // only validate the rows
+}
+
+// Checks whether the model is already present on the ledger.
+type ExistsFunc func(vid uint16, pid uint16) (bool, error)
+
+// Signs and broadcasts the messages as a single transaction.
+type SubmitFunc func(msgs []sdk.Msg) (sdk.TxResponse, error)
+
+// Parses the catalog of the given format.
+// JSON catalog is an array of models, CSV catalog has a header row naming the columns.
+func Parse(data []byte, format string) ([]Row, error) {
+	switch format {
+	case FormatJSON:
+		return parseJSON(data)
+	case FormatCSV:
+		return parseCSV(data)
+	default:
+		return nil, sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid format: \"%v\", supported formats are %v and %v", format, FormatJSON, FormatCSV))
+	}
+}
+
+func parseJSON(data []byte) ([]Row, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Invalid JSON catalog: it must be an array of models: %v", err))
+	}
+
+	rows := make([]Row, 0, len(items))
+
+	for i, item := range items {
+		row := Row{Number: i + 1}
+
+		if err := json.Unmarshal(item, &row.Model); err != nil {
+			row.Err = sdk.ErrUnknownRequest(fmt.Sprintf("Invalid model: %v", err))
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func parseCSV(data []byte) ([]Row, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Invalid CSV catalog: failed to read header: %v", err))
+	}
+
+	for i, column := range header {
+		header[i] = strings.TrimSpace(column)
+
+		if _, ok := csvSetters[header[i]]; !ok {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Invalid CSV catalog: unknown column \"%v\"", column))
+		}
+	}
+
+	reader.FieldsPerRecord = len(header)
+
+	var rows []Row
+
+	for number := 1; ; number++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+
+		if err != nil {
+			return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Invalid CSV catalog: %v", err))
+		}
+
+		row := Row{Number: number}
+
+		for i, value := range record {
+			if err := csvSetters[header[i]](&row.Model, strings.TrimSpace(value)); err != nil {
+				row.Err = err
+
+				break
+			}
+		}
+
+		rows = append(rows, row)
+	}
+}
+
+var csvSetters = map[string]func(model *Model, value string) error{
+	"vid": func(model *Model, value string) (err error) {
+		model.VID, err = parseOptionalUInt16(value, conversions.ParseVID)
+
+		return err
+	},
+	"pid": func(model *Model, value string) (err error) {
+		model.PID, err = parseOptionalUInt16(value, conversions.ParsePID)
+
+		return err
+	},
+	"cid": func(model *Model, value string) (err error) {
+		model.CID, err = parseOptionalUInt16(value, conversions.ParseCID)
+
+		return err
+	},
+	"version":           func(model *Model, value string) error { model.Version = value; return nil },
+	"name":              func(model *Model, value string) error { model.Name = value; return nil },
+	"description":       func(model *Model, value string) error { model.Description = value; return nil },
+	"sku":               func(model *Model, value string) error { model.SKU = value; return nil },
+	"hardware_version":  func(model *Model, value string) error { model.HardwareVersion = value; return nil },
+	"firmware_version":  func(model *Model, value string) error { model.FirmwareVersion = value; return nil },
+	"ota_url":           func(model *Model, value string) error { model.OtaURL = value; return nil },
+	"ota_checksum":      func(model *Model, value string) error { model.OtaChecksum = value; return nil },
+	"ota_checksum_type": func(model *Model, value string) error { model.OtaChecksumType = value; return nil },
+	"custom":            func(model *Model, value string) error { model.Custom = value; return nil },
+	"custom_schema":     func(model *Model, value string) error { model.CustomSchema = value; return nil },
+	"tis_or_trp_testing_completed": func(model *Model, value string) error {
+		if len(value) == 0 {
+			return nil
+		}
+
+		completed, err := strconv.ParseBool(value)
+		if err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Tis-or-trp-testing-completed: "+
+				"Parsing Error: \"%v\" must be boolean", value))
+		}
+
+		model.TisOrTrpTestingCompleted = completed
+
+		return nil
+	},
+}
+
+// Empty values are left zero to be reported by the message validation.
+func parseOptionalUInt16(value string, parse func(string) (uint16, sdk.Error)) (uint16, error) {
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	res, err := parse(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return res, nil
+}
+
+// Validates the rows and submits the valid ones in batches of `BatchSize` models.
+// A row is rejected without submission if it could not be parsed, its message is invalid,
+// it repeats a model of a previous row or the model is already present on the ledger.
+// A batch is a single transaction, so if the transaction fails all its rows are rejected with its error.
+func Upload(rows []Row, signer sdk.AccAddress, options Options, exists ExistsFunc, submit SubmitFunc) Report {
+	report := Report{Total: len(rows), Items: make([]Result, len(rows))}
+
+	seen := map[string]bool{}
+
+	var valid []int
+
+	for i, row := range rows {
+		report.Items[i] = Result{Row: row.Number, VID: row.Model.VID, PID: row.Model.PID}
+
+		if err := validate(row, signer, seen, exists); err != nil {
+			report.Items[i].setError(err)
+
+			continue
+		}
+
+		valid = append(valid, i)
+	}
+
+	if !options.DryRun {
+		batchSize := options.BatchSize
+		if batchSize <= 0 {
+			batchSize = DefaultBatchSize
+		}
+
+		for start := 0; start < len(valid); start += batchSize {
+			end := start + batchSize
+			if end > len(valid) {
+				end = len(valid)
+			}
+
+			submitBatch(report.Items, rows, valid[start:end], signer, submit)
+		}
+	}
+
+	for _, item := range report.Items {
+		if len(item.Error) == 0 {
+			report.Accepted++
+		} else {
+			report.Rejected++
+		}
+	}
+
+	return report
+}
+
+func validate(row Row, signer sdk.AccAddress, seen map[string]bool, exists ExistsFunc) error {
+	if row.Err != nil {
+		return row.Err
+	}
+
+	if err := row.Model.Msg(signer).ValidateBasic(); err != nil {
+		return err
+	}
+
+	key := string(types.GetModelInfoKey(row.Model.VID, row.Model.PID))
+	if seen[key] {
+		return sdk.ErrUnknownRequest(fmt.Sprintf(
+			"Model with VID %v and PID %v is repeated in the catalog", row.Model.VID, row.Model.PID))
+	}
+
+	seen[key] = true
+
+	present, err := exists(row.Model.VID, row.Model.PID)
+	if err != nil {
+		return err
+	}
+
+	if present {
+		return types.ErrModelInfoAlreadyExists(row.Model.VID, row.Model.PID)
+	}
+
+	return nil
+}
+
+func submitBatch(items []Result, rows []Row, batch []int, signer sdk.AccAddress, submit SubmitFunc) {
+	msgs := make([]sdk.Msg, 0, len(batch))
+	for _, i := range batch {
+		msgs = append(msgs, rows[i].Model.Msg(signer))
+	}
+
+	res, err := submit(msgs)
+
+	for _, i := range batch {
+		switch {
+		case err != nil:
+			items[i].setError(err)
+		case sdk.CodeType(res.Code) != sdk.CodeOK:
+			items[i].TxHash = res.TxHash
+			items[i].Codespace, items[i].Code = sdk.CodespaceType(res.Codespace), sdk.CodeType(res.Code)
+			items[i].Error = res.RawLog
+
+			if errorCode, ok := errcodes.Lookup(items[i].Codespace, items[i].Code); ok {
+				items[i].Name = errorCode.Name
+			}
+		default:
+			items[i].TxHash = res.TxHash
+		}
+	}
+}
+
+func (r *Result) setError(err error) {
+	r.Error = err.Error()
+
+	if sdkErr, ok := err.(sdk.Error); ok {
+		r.Error = fmt.Sprint(sdkErr.Data())
+	}
+
+	if errorCode, ok := errcodes.Of(err); ok {
+		r.Codespace, r.Code, r.Name = errorCode.Codespace, errorCode.Code, errorCode.Name
+	}
+}
@@ -0,0 +1,169 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package bulk
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/internal/types"
+)
+
+func TestParse_CSV(t *testing.T) {
+	data := "vid, pid, name, description, sku, hardware_version, firmware_version, tis_or_trp_testing_completed\n" +
+		"1, 1, Light, \"Smart light, E27\", SKU1, 1.0, 2.0, true\n" +
+		"1, x, Lock, Door lock, SKU2, 1.0, 2.0, false\n"
+
+	rows, err := Parse([]byte(data), FormatCSV)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(rows))
+
+	require.Equal(t, 1, rows[0].Number)
+	require.NoError(t, rows[0].Err)
+	require.Equal(t, Model{
+		VID: 1, PID: 1, Name: "Light", Description: "Smart light, E27", SKU: "SKU1",
+		HardwareVersion: "1.0", FirmwareVersion: "2.0", TisOrTrpTestingCompleted: true,
+	}, rows[0].Model)
+
+	require.Equal(t, 2, rows[1].Number)
+	require.Error(t, rows[1].Err)
+
+	// unknown column
+	_, err = Parse([]byte("vid,pid,color\n1,1,red\n"), FormatCSV)
+	require.Error(t, err)
+
+	// wrong number of fields
+	_, err = Parse([]byte("vid,pid\n1,1,1\n"), FormatCSV)
+	require.Error(t, err)
+}
+
+func TestParse_JSON(t *testing.T) {
+	data := `[{"vid": 1, "pid": 1, "name": "Light"}, {"vid": "x"}]`
+
+	rows, err := Parse([]byte(data), FormatJSON)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(rows))
+	require.NoError(t, rows[0].Err)
+	require.Equal(t, Model{VID: 1, PID: 1, Name: "Light"}, rows[0].Model)
+	require.Error(t, rows[1].Err)
+
+	_, err = Parse([]byte(`{"vid": 1}`), FormatJSON)
+	require.Error(t, err)
+
+	_, err = Parse([]byte(data), "xml")
+	require.Error(t, err)
+}
+
+func TestUpload(t *testing.T) {
+	rows := []Row{
+		{Number: 1, Model: model(1)},
+		{Number: 2, Model: model(2)},
+		{Number: 3, Model: model(1)},              // repeated
+		{Number: 4, Model: model(3)},              // already present
+		{Number: 5, Model: Model{VID: 1, PID: 5}}, // invalid
+		{Number: 6, Err: sdk.ErrUnknownRequest("Invalid PID")},
+		{Number: 7, Model: model(7)},
+	}
+
+	exists := func(vid uint16, pid uint16) (bool, error) {
+		return pid == 3, nil
+	}
+
+	var batches [][]sdk.Msg
+
+	submit := func(msgs []sdk.Msg) (sdk.TxResponse, error) {
+		batches = append(batches, msgs)
+
+		return sdk.TxResponse{TxHash: fmt.Sprintf("hash%d", len(batches))}, nil
+	}
+
+	report := Upload(rows, testconstants.Address1, Options{BatchSize: 2}, exists, submit)
+
+	require.Equal(t, 7, report.Total)
+	require.Equal(t, 3, report.Accepted)
+	require.Equal(t, 4, report.Rejected)
+
+	require.Equal(t, 2, len(batches))
+	require.Equal(t, 2, len(batches[0]))
+	require.Equal(t, 1, len(batches[1]))
+
+	require.Equal(t, "hash1", report.Items[0].TxHash)
+	require.Equal(t, "hash1", report.Items[1].TxHash)
+	require.Equal(t, "hash2", report.Items[6].TxHash)
+
+	require.NotEmpty(t, report.Items[2].Error)
+	require.Equal(t, types.Codespace, report.Items[3].Codespace)
+	require.Equal(t, types.CodeModelInfoAlreadyExists, report.Items[3].Code)
+	require.Equal(t, "model_info_already_exists", report.Items[3].Name)
+	require.Equal(t, sdk.CodeUnknownRequest, report.Items[4].Code)
+	require.Equal(t, "Invalid PID", report.Items[5].Error)
+}
+
+func TestUpload_DryRun(t *testing.T) {
+	exists := func(vid uint16, pid uint16) (bool, error) {
+		return false, nil
+	}
+
+	submit := func(msgs []sdk.Msg) (sdk.TxResponse, error) {
+		panic("must not be called")
+	}
+
+	report := Upload([]Row{{Number: 1, Model: model(1)}}, testconstants.Address1,
+		Options{DryRun: true}, exists, submit)
+
+	require.Equal(t, 1, report.Accepted)
+	require.Empty(t, report.Items[0].TxHash)
+}
+
+func TestUpload_FailedTransaction(t *testing.T) {
+	exists := func(vid uint16, pid uint16) (bool, error) {
+		return false, nil
+	}
+
+	submit := func(msgs []sdk.Msg) (sdk.TxResponse, error) {
+		return sdk.TxResponse{
+			TxHash: "hash", Codespace: string(types.Codespace),
+			Code: uint32(types.CodeModelInfoAlreadyExists), RawLog: "already exists",
+		}, nil
+	}
+
+	report := Upload([]Row{{Number: 1, Model: model(1)}, {Number: 2, Model: model(2)}}, testconstants.Address1,
+		Options{}, exists, submit)
+
+	require.Equal(t, 0, report.Accepted)
+	require.Equal(t, 2, report.Rejected)
+
+	for _, item := range report.Items {
+		require.Equal(t, "hash", item.TxHash)
+		require.Equal(t, "already exists", item.Error)
+		require.Equal(t, "model_info_already_exists", item.Name)
+	}
+}
+
+func model(pid uint16) Model {
+	return Model{
+		VID:             testconstants.VID,
+		PID:             pid,
+		Name:            testconstants.Name,
+		Description:     testconstants.Description,
+		SKU:             testconstants.SKU,
+		HardwareVersion: testconstants.HardwareVersion,
+		FirmwareVersion: testconstants.FirmwareVersion,
+	}
+}
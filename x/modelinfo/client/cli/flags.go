@@ -36,4 +36,20 @@ const (
 	FlagCustomShortcut                   = "c"
 	FlagTisOrTrpTestingCompleted         = "tis-or-trp-testing-completed"
 	FlagTisOrTrpTestingCompletedShortcut = "t"
+	FlagSoftwareVersion                  = "software-version"
+	FlagSoftwareVersionString            = "software-version-string"
+	FlagFirmwareDigest                   = "firmware-digest"
+	FlagReleaseNotesURL                  = "release-notes-url"
+	FlagMinApplicableSoftwareVersion     = "min-applicable-software-version"
+	FlagMaxApplicableSoftwareVersion     = "max-applicable-software-version"
+	FlagQuery                            = "query"
+	FlagFile                             = "file"
+	FlagFormat                           = "format"
+	FlagBatchSize                        = "batch-size"
+	FlagIncludeArchived                  = "include-archived"
+	FlagUnset                            = "unset"
+	FlagExpectedSequence                 = "expected-sequence"
+	FlagCustomSchema                     = "custom-schema"
+	FlagSchema                           = "schema"
+	FlagMatter                           = "matter"
 )
@@ -38,8 +38,14 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	modelinfoQueryCmd.AddCommand(client.GetCommands(
 		GetCmdModel(storeKey, cdc),
 		GetCmdAllModels(storeKey, cdc),
+		GetCmdSearchModels(storeKey, cdc),
 		GetCmdVendors(storeKey, cdc),
 		GetCmdVendorModels(storeKey, cdc),
+		GetCmdAllVendorModels(storeKey, cdc),
+		GetCmdModelVersion(storeKey, cdc),
+		GetCmdModelVersions(storeKey, cdc),
+		GetCmdCustomDataSchema(storeKey, cdc),
+		GetCmdAllCustomDataSchemas(storeKey, cdc),
 	)...)
 
 	return modelinfoQueryCmd
@@ -92,7 +98,8 @@ func GetCmdAllModels(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := cli.NewCLIContext().WithCodec(cdc)
-			params := pagination.ParsePaginationParamsFromFlags()
+			params := types.NewListModelsQueryParams(pagination.ParsePaginationParamsFromFlags(),
+				viper.GetBool(FlagIncludeArchived))
 
 			return cliCtx.QueryList(fmt.Sprintf("custom/%s/all_models", queryRoute), params)
 		},
@@ -100,6 +107,40 @@ func GetCmdAllModels(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of models to skip")
 	cmd.Flags().Int(pagination.FlagTake, 0, "amount of models to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+	cmd.Flags().Bool(FlagIncludeArchived, false, "include archived models")
+	pagination.AddSortFlags(cmd, types.ModelSortFields...)
+
+	return cmd
+}
+
+func GetCmdSearchModels(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search-models",
+		Short: "Query the list of Models containing all the words of the query in their names, descriptions or SKUs",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			paginationParams := pagination.ParsePaginationParamsFromFlags()
+			query := viper.GetString(FlagQuery)
+
+			params := types.NewSearchQueryParams(query, paginationParams.Skip, paginationParams.Take)
+			params.Key = paginationParams.Key
+			params.IncludeArchived = viper.GetBool(FlagIncludeArchived)
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/search_models", queryRoute), params)
+		},
+	}
+
+	cmd.Flags().String(FlagQuery, "",
+		"Words to search for (case-insensitive, a word matches the words it is a prefix of)")
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of models to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of models to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+	cmd.Flags().Bool(FlagIncludeArchived, false, "include archived models")
+
+	_ = cmd.MarkFlagRequired(FlagQuery)
 
 	return cmd
 }
@@ -119,6 +160,7 @@ func GetCmdVendors(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of vendors to skip")
 	cmd.Flags().Int(pagination.FlagTake, 0, "amount of vendors to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
 
 	return cmd
 }
@@ -155,3 +197,168 @@ func GetCmdVendorModels(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func GetCmdAllVendorModels(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-vendor-models",
+		Short: "Query the list of Models for the given Vendor with pagination",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err_ := conversions.ParseVID(viper.GetString(FlagVID))
+			if err_ != nil {
+				return err_
+			}
+
+			params := types.NewListModelsQueryParams(pagination.ParsePaginationParamsFromFlags(),
+				viper.GetBool(FlagIncludeArchived))
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/all_vendor_models/%v", queryRoute, vid), params)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of models to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of models to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+	cmd.Flags().Bool(FlagIncludeArchived, false, "include archived models")
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+
+	return cmd
+}
+
+func GetCmdModelVersion(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "model-version",
+		Short: "Query Model Version by combination of Vendor ID, Product ID and Software Version",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err_ := conversions.ParseVID(viper.GetString(FlagVID))
+			if err_ != nil {
+				return err_
+			}
+
+			pid, err_ := conversions.ParsePID(viper.GetString(FlagPID))
+			if err_ != nil {
+				return err_
+			}
+
+			softwareVersion, err_ := conversions.ParseUInt32FromString(viper.GetString(FlagSoftwareVersion))
+			if err_ != nil {
+				return err_
+			}
+
+			res, height, err := cliCtx.QueryStore(types.GetModelVersionKey(vid, pid, softwareVersion), queryRoute)
+			if err != nil || res == nil {
+				return types.ErrModelVersionDoesNotExist(vid, pid, softwareVersion)
+			}
+
+			var modelVersion types.ModelVersion
+			cdc.MustUnmarshalBinaryBare(res, &modelVersion)
+
+			return cliCtx.EncodeAndPrintWithHeight(modelVersion, height)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().String(FlagSoftwareVersion, "", "Software version number")
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+	_ = cmd.MarkFlagRequired(FlagSoftwareVersion)
+
+	return cmd
+}
+
+func GetCmdModelVersions(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "model-versions",
+		Short: "Query the list of Software Versions of the given Model",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err_ := conversions.ParseVID(viper.GetString(FlagVID))
+			if err_ != nil {
+				return err_
+			}
+
+			pid, err_ := conversions.ParsePID(viper.GetString(FlagPID))
+			if err_ != nil {
+				return err_
+			}
+
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/model_versions/%v/%v", queryRoute, vid, pid), params)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of versions to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of versions to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+
+	return cmd
+}
+
+func GetCmdCustomDataSchema(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "custom-data-schema",
+		Short: "Query Custom Data Schema by name",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			name := viper.GetString(FlagName)
+
+			res, height, err := cliCtx.QueryStore(types.GetCustomDataSchemaKey(name), queryRoute)
+			if err != nil || res == nil {
+				return types.ErrCustomDataSchemaDoesNotExist(name)
+			}
+
+			var customDataSchema types.CustomDataSchema
+			cdc.MustUnmarshalBinaryBare(res, &customDataSchema)
+
+			return cliCtx.EncodeAndPrintWithHeight(customDataSchema, height)
+		},
+	}
+
+	cmd.Flags().StringP(FlagName, FlagNameShortcut, "", "Custom data schema name")
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	_ = cmd.MarkFlagRequired(FlagName)
+
+	return cmd
+}
+
+func GetCmdAllCustomDataSchemas(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-custom-data-schemas",
+		Short: "Query the list of all Custom Data Schemas",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/all_custom_data_schemas", queryRoute), params)
+		},
+	}
+
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of schemas to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of schemas to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
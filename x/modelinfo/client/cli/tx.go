@@ -16,7 +16,10 @@ package cli
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -25,6 +28,7 @@ import (
 	"github.com/spf13/viper"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/client/bulk"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/internal/types"
 )
 
@@ -39,7 +43,13 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 	modelinfoTxCmd.AddCommand(cli.SignedCommands(client.PostCommands(
 		GetCmdAddModel(cdc),
+		GetCmdAddModels(storeKey, cdc),
 		GetCmdUpdateModel(cdc),
+		GetCmdAddModelVersion(cdc),
+		GetCmdUpdateModelVersion(cdc),
+		GetCmdArchiveModel(cdc),
+		GetCmdUnarchiveModel(cdc),
+		GetCmdSetCustomDataSchema(cdc),
 		// GetCmdDeleteModel(cdc), Disable deletion
 	)...)...)
 
@@ -105,9 +115,16 @@ func GetCmdAddModel(cdc *codec.Codec) *cobra.Command {
 					"Parsing Error: \"%v\" must be boolean", viper.GetString(FlagTisOrTrpTestingCompleted)))
 			}
 
+			matter, err_ := readMatterModelInfo(cliCtx, cdc)
+			if err_ != nil {
+				return err_
+			}
+
 			msg := types.NewMsgAddModelInfo(vid, pid, cid, version, name, description, sku,
 				hardwareVersion, firmwareVersion, otaURL, otaChecksum, otaChecksumType,
 				custom, tisOrTrpTestingCompleted, cliCtx.FromAddress())
+			msg.CustomSchema = viper.GetString(FlagCustomSchema)
+			msg.Matter = matter
 
 			return cliCtx.HandleWriteMessage(msg)
 		},
@@ -131,8 +148,11 @@ func GetCmdAddModel(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagOtaChecksumType, "", "Type of the OTA checksum")
 	cmd.Flags().StringP(FlagCustom, FlagCustomShortcut, "",
 		"Custom information (string or path to file containing data)")
+	cmd.Flags().String(FlagCustomSchema, "",
+		"Name of the custom data schema the custom information must conform to")
 	cmd.Flags().StringP(FlagTisOrTrpTestingCompleted, FlagTisOrTrpTestingCompletedShortcut, "",
 		"Whether model has successfully completed TIS/TRP testing")
+	cmd.Flags().String(FlagMatter, "", matterFlagUsage)
 
 	_ = cmd.MarkFlagRequired(FlagVID)
 	_ = cmd.MarkFlagRequired(FlagPID)
@@ -146,6 +166,76 @@ func GetCmdAddModel(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+//nolint:funlen
+func GetCmdAddModels(storeKey string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-models",
+		Short: "Add many Models from a JSON or CSV catalog in batched transactions and print the per-row report",
+		Long: `Add many Models from a JSON or CSV catalog in batched transactions and print the per-row report.
+Use --dry-run flag to only validate the models without sending transactions.`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			path := viper.GetString(FlagFile)
+
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+
+			format := viper.GetString(FlagFormat)
+			if len(format) == 0 {
+				format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+			}
+
+			rows, err := bulk.Parse(data, format)
+			if err != nil {
+				return err
+			}
+
+			batchSize := viper.GetInt(FlagBatchSize)
+			if batchSize <= 0 || batchSize > bulk.MaxBatchSize {
+				return sdk.ErrUnknownRequest(
+					fmt.Sprintf("Invalid batch-size: it must be between 1 and %v", bulk.MaxBatchSize))
+			}
+
+			options := bulk.Options{BatchSize: batchSize, DryRun: viper.GetBool(client.FlagDryRun)}
+
+			var broadcaster *cli.Broadcaster
+
+			if !options.DryRun {
+				broadcaster, err = cliCtx.NewBroadcaster()
+				if err != nil {
+					return err
+				}
+			}
+
+			exists := func(vid uint16, pid uint16) (bool, error) {
+				res, _, err := cliCtx.QueryStore(types.GetModelInfoKey(vid, pid), storeKey)
+
+				return res != nil, err
+			}
+
+			submit := func(msgs []sdk.Msg) (sdk.TxResponse, error) {
+				return broadcaster.Broadcast(msgs)
+			}
+
+			return cliCtx.PrintOutput(bulk.Upload(rows, cliCtx.FromAddress(), options, exists, submit))
+		},
+	}
+
+	cmd.Flags().String(FlagFile, "", "Path to the catalog: JSON array of models or CSV file with the header row "+
+		"(columns are named after the JSON fields, e.g. vid,pid,name,description,sku,...)")
+	cmd.Flags().String(FlagFormat, "",
+		fmt.Sprintf("Format of the catalog: %v or %v (the file extension by default)", bulk.FormatJSON, bulk.FormatCSV))
+	cmd.Flags().Int(FlagBatchSize, bulk.DefaultBatchSize, "Number of models added by a single transaction")
+
+	_ = cmd.MarkFlagRequired(FlagFile)
+
+	return cmd
+}
+
 //nolint:funlen
 func GetCmdUpdateModel(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -192,14 +282,37 @@ func GetCmdUpdateModel(cdc *codec.Codec) *cobra.Command {
 				}
 			}
 
-			tisOrTrpTestingCompleted, err_ := strconv.ParseBool(viper.GetString(FlagTisOrTrpTestingCompleted))
+			// only the specified fields are updated
+			var tisOrTrpTestingCompleted *bool
+			if tisStr := viper.GetString(FlagTisOrTrpTestingCompleted); len(tisStr) != 0 {
+				value, err_ := strconv.ParseBool(tisStr)
+				if err_ != nil {
+					return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid tis-or-trp-testing-completed: "+
+						"Parsing Error: \"%v\" must be boolean", tisStr))
+				}
+
+				tisOrTrpTestingCompleted = &value
+			}
+
+			matter, err_ := readMatterModelInfo(cliCtx, cdc)
 			if err_ != nil {
-				return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid tis-or-trp-testing-completed: "+
-					"Parsing Error: \"%v\" must be boolean", viper.GetString(FlagTisOrTrpTestingCompleted)))
+				return err_
 			}
 
-			msg := types.NewMsgUpdateModelInfo(vid, pid, cid, description,
-				otaURL, custom, tisOrTrpTestingCompleted, cliCtx.FromAddress())
+			msg := types.MsgUpdateModelInfo{
+				VID:                      vid,
+				PID:                      pid,
+				CID:                      cid,
+				Description:              description,
+				OtaURL:                   otaURL,
+				Custom:                   custom,
+				TisOrTrpTestingCompleted: tisOrTrpTestingCompleted,
+				Signer:                   cliCtx.FromAddress(),
+				Unset:                    viper.GetStringSlice(FlagUnset),
+				ExpectedSequence:         viper.GetUint64(FlagExpectedSequence),
+				CustomSchema:             viper.GetString(FlagCustomSchema),
+				Matter:                   matter,
+			}
 
 			return cliCtx.HandleWriteMessage(msg)
 		},
@@ -212,12 +325,264 @@ func GetCmdUpdateModel(cdc *codec.Codec) *cobra.Command {
 		"Model description (string or path to file containing data)")
 	cmd.Flags().StringP(FlagCustom, FlagCustomShortcut, "",
 		"Custom information (string or path to file containing data)")
+	cmd.Flags().String(FlagCustomSchema, "",
+		"Name of the custom data schema the custom information must conform to")
 	cmd.Flags().StringP(FlagTisOrTrpTestingCompleted, FlagTisOrTrpTestingCompletedShortcut, "",
 		"Whether model has successfully completed TIS/TRP testing")
+	cmd.Flags().String(FlagMatter, "", matterFlagUsage+" (replaces all the Matter fields of the model)")
+	cmd.Flags().StringSlice(FlagUnset, []string{},
+		fmt.Sprintf("Fields to unset (%s, %s, %s, %s)", types.ModelInfoFieldCID, types.ModelInfoFieldCustom,
+			types.ModelInfoFieldCustomSchema, types.ModelInfoFieldMatter))
+	cmd.Flags().Uint64(FlagExpectedSequence, 0,
+		"Sequence of the model the update is based on (the update is rejected if the model has been changed since)")
 
 	_ = cmd.MarkFlagRequired(FlagVID)
 	_ = cmd.MarkFlagRequired(FlagPID)
-	_ = cmd.MarkFlagRequired(FlagTisOrTrpTestingCompleted)
+
+	return cmd
+}
+
+const matterFlagUsage = "Fields of the Matter DCL schema (JSON string or path to file containing it): " +
+	"{\"device_type_id\", \"product_label\", \"part_number\", \"commissioning_custom_flow\": 0|1|2, " +
+	"\"commissioning_custom_flow_url\", \"commissioning_mode_initial_steps_hint\", " +
+	"\"commissioning_mode_initial_steps_instruction\", \"commissioning_mode_secondary_steps_hint\", " +
+	"\"commissioning_mode_secondary_steps_instruction\", \"user_manual_url\", \"support_url\", \"product_url\"}"
+
+// Returns the Matter fields passed by the flag or nil if the flag is not specified.
+func readMatterModelInfo(cliCtx cli.CliContext, cdc *codec.Codec) (*types.MatterModelInfo, error) {
+	if viper.GetString(FlagMatter) == "" {
+		return nil, nil
+	}
+
+	matterJSON, err := cliCtx.ReadFromFile(viper.GetString(FlagMatter))
+	if err != nil {
+		return nil, err
+	}
+
+	matter := &types.MatterModelInfo{}
+	if err := cdc.UnmarshalJSON([]byte(matterJSON), matter); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Matter fields: they must be JSON encoded: %v", err))
+	}
+
+	return matter, nil
+}
+
+func GetCmdAddModelVersion(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-model-version",
+		Short: "Add new Software Version of an existing Model",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err := conversions.ParseVID(viper.GetString(FlagVID))
+			if err != nil {
+				return err
+			}
+
+			pid, err := conversions.ParsePID(viper.GetString(FlagPID))
+			if err != nil {
+				return err
+			}
+
+			softwareVersion, err := conversions.ParseUInt32FromString(viper.GetString(FlagSoftwareVersion))
+			if err != nil {
+				return err
+			}
+
+			minApplicableSoftwareVersion, maxApplicableSoftwareVersion, err := parseApplicableSoftwareVersions()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddModelVersion(vid, pid, softwareVersion,
+				viper.GetString(FlagSoftwareVersionString), viper.GetString(FlagFirmwareDigest),
+				viper.GetString(FlagReleaseNotesURL), minApplicableSoftwareVersion, maxApplicableSoftwareVersion,
+				cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().String(FlagSoftwareVersion, "", "Software version number (32 bit unsigned integer)")
+	cmd.Flags().String(FlagSoftwareVersionString, "", "Human readable software version")
+	cmd.Flags().String(FlagFirmwareDigest, "", "Digest of the firmware image")
+	cmd.Flags().String(FlagReleaseNotesURL, "", "URL of the release notes")
+	cmd.Flags().String(FlagMinApplicableSoftwareVersion, "",
+		"Minimal software version the model version can be applied to")
+	cmd.Flags().String(FlagMaxApplicableSoftwareVersion, "",
+		"Maximal software version the model version can be applied to")
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+	_ = cmd.MarkFlagRequired(FlagSoftwareVersion)
+	_ = cmd.MarkFlagRequired(FlagSoftwareVersionString)
+
+	return cmd
+}
+
+func GetCmdUpdateModelVersion(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-model-version",
+		Short: "Update existing Software Version of a Model",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err := conversions.ParseVID(viper.GetString(FlagVID))
+			if err != nil {
+				return err
+			}
+
+			pid, err := conversions.ParsePID(viper.GetString(FlagPID))
+			if err != nil {
+				return err
+			}
+
+			softwareVersion, err := conversions.ParseUInt32FromString(viper.GetString(FlagSoftwareVersion))
+			if err != nil {
+				return err
+			}
+
+			minApplicableSoftwareVersion, maxApplicableSoftwareVersion, err := parseApplicableSoftwareVersions()
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateModelVersion(vid, pid, softwareVersion, viper.GetString(FlagReleaseNotesURL),
+				minApplicableSoftwareVersion, maxApplicableSoftwareVersion, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().String(FlagSoftwareVersion, "", "Software version number (32 bit unsigned integer)")
+	cmd.Flags().String(FlagReleaseNotesURL, "", "URL of the release notes")
+	cmd.Flags().String(FlagMinApplicableSoftwareVersion, "",
+		"Minimal software version the model version can be applied to")
+	cmd.Flags().String(FlagMaxApplicableSoftwareVersion, "",
+		"Maximal software version the model version can be applied to")
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+	_ = cmd.MarkFlagRequired(FlagSoftwareVersion)
+
+	return cmd
+}
+
+// Parses optional min/max applicable software version flags (zero if not specified).
+func parseApplicableSoftwareVersions() (min uint32, max uint32, err sdk.Error) {
+	if minStr := viper.GetString(FlagMinApplicableSoftwareVersion); len(minStr) != 0 {
+		min, err = conversions.ParseUInt32FromString(minStr)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if maxStr := viper.GetString(FlagMaxApplicableSoftwareVersion); len(maxStr) != 0 {
+		max, err = conversions.ParseUInt32FromString(maxStr)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return min, max, nil
+}
+
+func GetCmdArchiveModel(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive-model",
+		Short: "Archive existing Model so that it is not returned by the list queries by default",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err := conversions.ParseVID(viper.GetString(FlagVID))
+			if err != nil {
+				return err
+			}
+
+			pid, err := conversions.ParsePID(viper.GetString(FlagPID))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgArchiveModel(vid, pid, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+
+	return cmd
+}
+
+func GetCmdUnarchiveModel(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unarchive-model",
+		Short: "Unarchive archived Model",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err := conversions.ParseVID(viper.GetString(FlagVID))
+			if err != nil {
+				return err
+			}
+
+			pid, err := conversions.ParsePID(viper.GetString(FlagPID))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnarchiveModel(vid, pid, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+
+	return cmd
+}
+
+func GetCmdSetCustomDataSchema(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-custom-data-schema",
+		Short: "Add new or replace existing JSON schema for the custom information of Models",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			schema, err := cliCtx.ReadFromFile(viper.GetString(FlagSchema))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCustomDataSchema(viper.GetString(FlagName), schema, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().StringP(FlagName, FlagNameShortcut, "", "Custom data schema name")
+	cmd.Flags().String(FlagSchema, "", "JSON schema (string or path to file containing data)")
+
+	_ = cmd.MarkFlagRequired(FlagName)
+	_ = cmd.MarkFlagRequired(FlagSchema)
 
 	return cmd
 }
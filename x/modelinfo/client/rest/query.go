@@ -19,6 +19,7 @@ import (
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/internal/types"
@@ -28,12 +29,40 @@ func getModelsHandler(cliCtx context.CLIContext, storeName string) http.HandlerF
 	return func(w http.ResponseWriter, r *http.Request) {
 		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
 
-		params, err := restCtx.ParsePaginationParams()
+		paginationParams, err := restCtx.ParsePaginationParams()
 		if err != nil {
 			return
 		}
 
-		restCtx.QueryList(fmt.Sprintf("custom/%s/all_models", storeName), params)
+		params := types.NewListModelsQueryParams(paginationParams, r.FormValue(includeArchived) == "true")
+
+		restCtx.QueryExportableList(fmt.Sprintf("custom/%s/all_models", storeName), params, &types.ListModelInfoItems{})
+	}
+}
+
+func searchModelsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		paginationParams, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		text := restCtx.Request().FormValue(query)
+		if len(types.Tokenize(text)) == 0 {
+			restCtx.WriteError(http.StatusBadRequest,
+				sdk.ErrUnknownRequest("Invalid Query: it must contain at least one word"))
+
+			return
+		}
+
+		params := types.NewSearchQueryParams(text, paginationParams.Skip, paginationParams.Take)
+		params.Key = paginationParams.Key
+		params.IncludeArchived = r.FormValue(includeArchived) == "true"
+
+		restCtx.QueryExportableList(fmt.Sprintf("custom/%s/search_models", storeName), params,
+			&types.ListModelInfoItems{})
 	}
 }
 
@@ -45,21 +74,21 @@ func getModelHandler(cliCtx context.CLIContext, storeName string) http.HandlerFu
 
 		vid, err_ := conversions.ParseVID(vars[vid])
 		if err_ != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err_.Error())
+			restCtx.WriteError(http.StatusBadRequest, err_)
 
 			return
 		}
 
 		pid, err_ := conversions.ParsePID(vars[pid])
 		if err_ != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err_.Error())
+			restCtx.WriteError(http.StatusBadRequest, err_)
 
 			return
 		}
 
 		res, height, err := restCtx.QueryStore(types.GetModelInfoKey(vid, pid), storeName)
 		if err != nil || res == nil {
-			restCtx.WriteErrorResponse(http.StatusNotFound, types.ErrModelInfoDoesNotExist(vid, pid).Error())
+			restCtx.WriteError(http.StatusNotFound, types.ErrModelInfoDoesNotExist(vid, pid))
 
 			return
 		}
@@ -81,7 +110,32 @@ func getVendorsHandler(cliCtx context.CLIContext, storeName string) http.Handler
 			return
 		}
 
-		restCtx.QueryList(fmt.Sprintf("custom/%s/vendors", storeName), params)
+		restCtx.QueryExportableList(fmt.Sprintf("custom/%s/vendors", storeName), params, &types.ListVendorItems{})
+	}
+}
+
+func getAllVendorModelsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		vid, err_ := conversions.ParseVID(vars[vid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		paginationParams, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		params := types.NewListModelsQueryParams(paginationParams, r.FormValue(includeArchived) == "true")
+
+		restCtx.QueryExportableList(fmt.Sprintf("custom/%s/all_vendor_models/%v", storeName, vid), params,
+			&types.ListModelInfoItems{})
 	}
 }
 
@@ -93,14 +147,14 @@ func getVendorModelsHandler(cliCtx context.CLIContext, storeName string) http.Ha
 
 		vid, err_ := conversions.ParseVID(vars[vid])
 		if err_ != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err_.Error())
+			restCtx.WriteError(http.StatusBadRequest, err_)
 
 			return
 		}
 
 		res, height, err := restCtx.QueryStore(types.GetVendorProductsKey(vid), storeName)
 		if err != nil || res == nil {
-			restCtx.WriteErrorResponse(http.StatusNotFound, types.ErrVendorProductsDoNotExist(vid).Error())
+			restCtx.WriteError(http.StatusNotFound, types.ErrVendorProductsDoNotExist(vid))
 
 			return
 		}
@@ -112,3 +166,110 @@ func getVendorModelsHandler(cliCtx context.CLIContext, storeName string) http.Ha
 		restCtx.EncodeAndRespondWithHeight(vendorProducts, height)
 	}
 }
+
+func getModelVersionsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		vid, err_ := conversions.ParseVID(vars[vid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		pid, err_ := conversions.ParsePID(vars[pid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		params, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryExportableList(fmt.Sprintf("custom/%s/model_versions/%v/%v", storeName, vid, pid), params,
+			&types.ListModelVersions{})
+	}
+}
+
+func getModelVersionHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		vid, err_ := conversions.ParseVID(vars[vid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		pid, err_ := conversions.ParsePID(vars[pid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		softwareVersion, err_ := conversions.ParseUInt32FromString(vars[softwareVersion])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		res, height, err := restCtx.QueryStore(types.GetModelVersionKey(vid, pid, softwareVersion), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrModelVersionDoesNotExist(vid, pid, softwareVersion))
+
+			return
+		}
+
+		var modelVersion types.ModelVersion
+
+		cliCtx.Codec.MustUnmarshalBinaryBare(res, &modelVersion)
+
+		restCtx.EncodeAndRespondWithHeight(modelVersion, height)
+	}
+}
+
+func getCustomDataSchemasHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		params, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryExportableList(fmt.Sprintf("custom/%s/all_custom_data_schemas", storeName), params,
+			&types.ListCustomDataSchemas{})
+	}
+}
+
+func getCustomDataSchemaHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		name := restCtx.Variables()[name]
+
+		res, height, err := restCtx.QueryStore(types.GetCustomDataSchemaKey(name), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrCustomDataSchemaDoesNotExist(name))
+
+			return
+		}
+
+		var customDataSchema types.CustomDataSchema
+
+		cliCtx.Codec.MustUnmarshalBinaryBare(res, &customDataSchema)
+
+		restCtx.EncodeAndRespondWithHeight(customDataSchema, height)
+	}
+}
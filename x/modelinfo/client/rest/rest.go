@@ -22,8 +22,14 @@ import (
 )
 
 const (
-	vid = "vid"
-	pid = "pid"
+	vid                   = "vid"
+	pid                   = "pid"
+	softwareVersion       = "software_version"
+	query                 = "query"
+	includeArchived       = "include_archived"
+	baseReqField          = "base_req"
+	expectedSequenceField = "expected_sequence"
+	name                  = "name"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application.
@@ -32,6 +38,14 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		fmt.Sprintf("/%s/models", storeName),
 		addModelHandler(cliCtx),
 	).Methods("POST")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/models/batch", storeName),
+		addModelBatchHandler(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/models/bulk", storeName),
+		addModelBulkHandler(cliCtx, storeName),
+	).Methods("POST")
 	r.HandleFunc(
 		fmt.Sprintf("/%s/models", storeName),
 		updateModelHandler(cliCtx),
@@ -40,6 +54,10 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		fmt.Sprintf("/%s/models", storeName),
 		getModelsHandler(cliCtx, storeName),
 	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/models/search", storeName),
+		searchModelsHandler(cliCtx, storeName),
+	).Methods("GET")
 	r.HandleFunc(
 		fmt.Sprintf("/%s/models/{%s}", storeName, vid),
 		getVendorModelsHandler(cliCtx, storeName),
@@ -48,8 +66,52 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		fmt.Sprintf("/%s/models/{%s}/{%s}", storeName, vid, pid),
 		getModelHandler(cliCtx, storeName),
 	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/models/{%s}/{%s}", storeName, vid, pid),
+		patchModelHandler(cliCtx),
+	).Methods("PATCH")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/models/{%s}/{%s}/archive", storeName, vid, pid),
+		archiveModelHandler(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/models/{%s}/{%s}/unarchive", storeName, vid, pid),
+		unarchiveModelHandler(cliCtx),
+	).Methods("POST")
 	r.HandleFunc(
 		fmt.Sprintf("/%s/vendors", storeName),
 		getVendorsHandler(cliCtx, storeName),
 	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/vendors/{%s}/models", storeName, vid),
+		getAllVendorModelsHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/versions", storeName),
+		addModelVersionHandler(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/versions", storeName),
+		updateModelVersionHandler(cliCtx),
+	).Methods("PUT")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/versions/{%s}/{%s}", storeName, vid, pid),
+		getModelVersionsHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/versions/{%s}/{%s}/{%s}", storeName, vid, pid, softwareVersion),
+		getModelVersionHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/custom_data_schemas", storeName),
+		getCustomDataSchemasHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/custom_data_schemas/{%s}", storeName, name),
+		getCustomDataSchemaHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/custom_data_schemas/{%s}", storeName, name),
+		setCustomDataSchemaHandler(cliCtx),
+	).Methods("PUT")
 }
@@ -15,43 +15,100 @@
 package rest
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"net/http"
+	"sort"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	restTypes "github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/client/bulk"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/internal/types"
 )
 
 //nolint:maligned
 type AddModelInfoRequest struct {
-	BaseReq                  restTypes.BaseReq `json:"base_req"`
-	VID                      uint16            `json:"vid"`
-	PID                      uint16            `json:"pid"`
-	CID                      uint16            `json:"cid,omitempty"`
-	Version                  string            `json:"version,omitempty"`
-	Name                     string            `json:"name"`
-	Description              string            `json:"description"`
-	SKU                      string            `json:"sku"`
-	HardwareVersion          string            `json:"hardware_version"`
-	FirmwareVersion          string            `json:"firmware_version"`
-	OtaURL                   string            `json:"ota_url,omitempty"`
-	OtaChecksum              string            `json:"ota_checksum,omitempty"`
-	OtaChecksumType          string            `json:"ota_checksum_type,omitempty"`
-	Custom                   string            `json:"custom,omitempty"`
-	TisOrTrpTestingCompleted bool              `json:"tis_or_trp_testing_completed"`
+	BaseReq                  restTypes.BaseReq      `json:"base_req"`
+	VID                      uint16                 `json:"vid"`
+	PID                      uint16                 `json:"pid"`
+	CID                      uint16                 `json:"cid,omitempty"`
+	Version                  string                 `json:"version,omitempty"`
+	Name                     string                 `json:"name"`
+	Description              string                 `json:"description"`
+	SKU                      string                 `json:"sku"`
+	HardwareVersion          string                 `json:"hardware_version"`
+	FirmwareVersion          string                 `json:"firmware_version"`
+	OtaURL                   string                 `json:"ota_url,omitempty"`
+	OtaChecksum              string                 `json:"ota_checksum,omitempty"`
+	OtaChecksumType          string                 `json:"ota_checksum_type,omitempty"`
+	Custom                   string                 `json:"custom,omitempty"`
+	TisOrTrpTestingCompleted bool                   `json:"tis_or_trp_testing_completed"`
+	CustomSchema             string                 `json:"custom_schema,omitempty"`
+	Matter                   *types.MatterModelInfo `json:"matter,omitempty"`
+}
+
+// Request to add several models in one transaction (`base_req` of the items is ignored).
+type AddModelInfoBatchRequest struct {
+	BaseReq restTypes.BaseReq     `json:"base_req"`
+	Models  []AddModelInfoRequest `json:"models"`
+}
+
+// Request to add many models in batched transactions with the per-row report.
+// The catalog is passed either as the list of `models` or as `csv` text with the header row.
+type AddModelInfoBulkRequest struct {
+	BaseReq   restTypes.BaseReq `json:"base_req"`
+	Models    []bulk.Model      `json:"models,omitempty"`
+	CSV       string            `json:"csv,omitempty"`
+	BatchSize uint16            `json:"batch_size,omitempty"`
+	DryRun    bool              `json:"dry_run,omitempty"`
 }
 
 //nolint:maligned
 type UpdateModelInfoRequest struct {
-	BaseReq                  restTypes.BaseReq `json:"base_req"`
-	VID                      uint16            `json:"vid"`
-	PID                      uint16            `json:"pid"`
-	CID                      uint16            `json:"cid,omitempty"`
-	Description              string            `json:"description,omitempty"`
-	OtaURL                   string            `json:"ota_url,omitempty"`
-	Custom                   string            `json:"custom,omitempty"`
-	TisOrTrpTestingCompleted bool              `json:"tis_or_trp_testing_completed"`
+	BaseReq                  restTypes.BaseReq      `json:"base_req"`
+	VID                      uint16                 `json:"vid"`
+	PID                      uint16                 `json:"pid"`
+	CID                      uint16                 `json:"cid,omitempty"`
+	Description              string                 `json:"description,omitempty"`
+	OtaURL                   string                 `json:"ota_url,omitempty"`
+	Custom                   string                 `json:"custom,omitempty"`
+	TisOrTrpTestingCompleted *bool                  `json:"tis_or_trp_testing_completed,omitempty"`
+	Unset                    []string               `json:"unset,omitempty"`
+	ExpectedSequence         uint64                 `json:"expected_sequence"`
+	CustomSchema             string                 `json:"custom_schema,omitempty"`
+	Matter                   *types.MatterModelInfo `json:"matter,omitempty"`
+}
+
+type SetCustomDataSchemaRequest struct {
+	BaseReq restTypes.BaseReq `json:"base_req"`
+	Schema  string            `json:"schema"`
+}
+
+type AddModelVersionRequest struct {
+	BaseReq                      restTypes.BaseReq `json:"base_req"`
+	VID                          uint16            `json:"vid"`
+	PID                          uint16            `json:"pid"`
+	SoftwareVersion              uint32            `json:"software_version"`
+	SoftwareVersionString        string            `json:"software_version_string"`
+	FirmwareDigest               string            `json:"firmware_digest,omitempty"`
+	ReleaseNotesURL              string            `json:"release_notes_url,omitempty"`
+	MinApplicableSoftwareVersion uint32            `json:"min_applicable_software_version"`
+	MaxApplicableSoftwareVersion uint32            `json:"max_applicable_software_version"`
+}
+
+type UpdateModelVersionRequest struct {
+	BaseReq                      restTypes.BaseReq `json:"base_req"`
+	VID                          uint16            `json:"vid"`
+	PID                          uint16            `json:"pid"`
+	SoftwareVersion              uint32            `json:"software_version"`
+	ReleaseNotesURL              string            `json:"release_notes_url,omitempty"`
+	MinApplicableSoftwareVersion uint32            `json:"min_applicable_software_version,omitempty"`
+	MaxApplicableSoftwareVersion uint32            `json:"max_applicable_software_version,omitempty"`
 }
 
 func addModelHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -77,11 +134,132 @@ func addModelHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			req.Name, req.Description, req.SKU, req.HardwareVersion,
 			req.FirmwareVersion, req.OtaURL, req.OtaChecksum, req.OtaChecksumType,
 			req.Custom, req.TisOrTrpTestingCompleted, restCtx.Signer())
+		msg.CustomSchema = req.CustomSchema
+		msg.Matter = req.Matter
 
 		restCtx.HandleWriteRequest(msg)
 	}
 }
 
+func addModelBatchHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req AddModelInfoBatchRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msgs := make([]sdk.Msg, 0, len(req.Models))
+
+		for _, model := range req.Models {
+			msg := types.NewMsgAddModelInfo(model.VID, model.PID, model.CID, model.Version,
+				model.Name, model.Description, model.SKU, model.HardwareVersion,
+				model.FirmwareVersion, model.OtaURL, model.OtaChecksum, model.OtaChecksumType,
+				model.Custom, model.TisOrTrpTestingCompleted, restCtx.Signer())
+			msg.CustomSchema = model.CustomSchema
+			msg.Matter = model.Matter
+
+			msgs = append(msgs, msg)
+		}
+
+		restCtx.HandleWriteRequest(msgs...)
+	}
+}
+
+func addModelBulkHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req AddModelInfoBulkRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		rows, err := parseBulkRequest(req)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		if req.BatchSize > bulk.MaxBatchSize {
+			restCtx.WriteError(http.StatusBadRequest, sdk.ErrUnknownRequest(
+				fmt.Sprintf("Invalid batch_size: it must not be greater than %v", bulk.MaxBatchSize)))
+
+			return
+		}
+
+		account, passphrase, ok := restCtx.BasicAuth()
+		if !ok && !req.DryRun {
+			restCtx.WriteError(http.StatusUnauthorized, sdk.ErrUnauthorized(
+				"Credentials are required to sign the transactions (unless dry_run is set)"))
+
+			return
+		}
+
+		restCtx, err = restCtx.WithBroadcastMode()
+		if err != nil {
+			return
+		}
+
+		exists := func(vid uint16, pid uint16) (bool, error) {
+			res, _, err := restCtx.QueryStore(types.GetModelInfoKey(vid, pid), storeName)
+
+			return res != nil, err
+		}
+
+		submit := func(msgs []sdk.Msg) (sdk.TxResponse, error) {
+			return restCtx.SignAndBroadcast(account, passphrase, msgs)
+		}
+
+		options := bulk.Options{BatchSize: int(req.BatchSize), DryRun: req.DryRun}
+
+		restCtx.PostProcessResponseBare(bulk.Upload(rows, restCtx.Signer(), options, exists, submit))
+	}
+}
+
+func parseBulkRequest(req AddModelInfoBulkRequest) ([]bulk.Row, error) {
+	if len(req.CSV) != 0 {
+		if len(req.Models) != 0 {
+			return nil, sdk.ErrUnknownRequest("Invalid request: either models or csv must be passed")
+		}
+
+		return bulk.Parse([]byte(req.CSV), bulk.FormatCSV)
+	}
+
+	if len(req.Models) == 0 {
+		return nil, sdk.ErrUnknownRequest("Invalid request: it must contain at least one model")
+	}
+
+	rows := make([]bulk.Row, 0, len(req.Models))
+	for i, model := range req.Models {
+		rows = append(rows, bulk.Row{Number: i + 1, Model: model})
+	}
+
+	return rows, nil
+}
+
 func updateModelHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
@@ -101,8 +279,284 @@ func updateModelHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		msg := types.NewMsgUpdateModelInfo(req.VID, req.PID, req.CID, req.Description,
-			req.OtaURL, req.Custom, req.TisOrTrpTestingCompleted, restCtx.Signer())
+		msg := types.MsgUpdateModelInfo{
+			VID:                      req.VID,
+			PID:                      req.PID,
+			CID:                      req.CID,
+			Description:              req.Description,
+			OtaURL:                   req.OtaURL,
+			Custom:                   req.Custom,
+			TisOrTrpTestingCompleted: req.TisOrTrpTestingCompleted,
+			Signer:                   restCtx.Signer(),
+			Unset:                    req.Unset,
+			ExpectedSequence:         req.ExpectedSequence,
+			CustomSchema:             req.CustomSchema,
+			Matter:                   req.Matter,
+		}
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+// Updates the model by a JSON merge patch (RFC 7396) containing `base_req`, `expected_sequence`
+// and only the fields to be changed, so that concurrent updates of different fields do not overwrite each other.
+func patchModelHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		vid, err_ := conversions.ParseVID(vars[vid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		pid, err_ := conversions.ParsePID(vars[pid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		body, ioErr := ioutil.ReadAll(r.Body)
+		if ioErr != nil {
+			restCtx.WriteErrorResponse(http.StatusBadRequest, ioErr.Error())
+
+			return
+		}
+
+		var patch map[string]json.RawMessage
+		if err_ := json.Unmarshal(body, &patch); err_ != nil {
+			restCtx.WriteErrorResponse(http.StatusBadRequest,
+				fmt.Sprintf("Request Parsing Error: %v. JSON merge patch object must be specified", err_))
+
+			return
+		}
+
+		var baseReq restTypes.BaseReq
+		if err_ := cliCtx.Codec.UnmarshalJSON(patch[baseReqField], &baseReq); err_ != nil {
+			restCtx.WriteErrorResponse(http.StatusBadRequest, fmt.Sprintf("Invalid %s: %v", baseReqField, err_))
+
+			return
+		}
+
+		delete(patch, baseReqField)
+
+		msg := types.MsgUpdateModelInfo{VID: vid, PID: pid}
+
+		// amino encodes uint64 as a string, but a number is accepted as well
+		if value, ok := patch[expectedSequenceField]; ok {
+			sequence, err_ := conversions.ParseUInt64FromString(strings.Trim(string(value), `"`))
+			if err_ != nil {
+				restCtx.WriteError(http.StatusBadRequest, err_)
+
+				return
+			}
+
+			msg.ExpectedSequence = sequence
+
+			delete(patch, expectedSequenceField)
+		}
+
+		if err_ := applyModelInfoMergePatch(&msg, patch); err_ != nil {
+			restCtx.WriteErrorResponse(http.StatusBadRequest, err_.Error())
+
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(baseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg.Signer = restCtx.Signer()
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+// Sets the fields of the message present in the patch; the fields set to null are unset.
+func applyModelInfoMergePatch(msg *types.MsgUpdateModelInfo, patch map[string]json.RawMessage) error {
+	fields := make([]string, 0, len(patch))
+	for field := range patch {
+		fields = append(fields, field)
+	}
+
+	// the order of the unset fields must not depend on the map iteration order
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		value := patch[field]
+
+		if string(value) == "null" {
+			msg.Unset = append(msg.Unset, field)
+
+			continue
+		}
+
+		var target interface{}
+
+		switch field {
+		case types.ModelInfoFieldCID:
+			target = &msg.CID
+		case "description":
+			target = &msg.Description
+		case "ota_url":
+			target = &msg.OtaURL
+		case types.ModelInfoFieldCustom:
+			target = &msg.Custom
+		case types.ModelInfoFieldCustomSchema:
+			target = &msg.CustomSchema
+		case types.ModelInfoFieldMatter:
+			target = &msg.Matter
+		case "tis_or_trp_testing_completed":
+			target = &msg.TisOrTrpTestingCompleted
+		default:
+			return fmt.Errorf("Invalid patch: field %v cannot be changed", field)
+		}
+
+		if err := json.Unmarshal(value, target); err != nil {
+			return fmt.Errorf("Invalid %v: %v", field, err)
+		}
+	}
+
+	return nil
+}
+
+func addModelVersionHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req AddModelVersionRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgAddModelVersion(req.VID, req.PID, req.SoftwareVersion, req.SoftwareVersionString,
+			req.FirmwareDigest, req.ReleaseNotesURL, req.MinApplicableSoftwareVersion,
+			req.MaxApplicableSoftwareVersion, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func updateModelVersionHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req UpdateModelVersionRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgUpdateModelVersion(req.VID, req.PID, req.SoftwareVersion, req.ReleaseNotesURL,
+			req.MinApplicableSoftwareVersion, req.MaxApplicableSoftwareVersion, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func archiveModelHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return modelArchivalHandler(cliCtx, func(vid uint16, pid uint16, signer sdk.AccAddress) sdk.Msg {
+		return types.NewMsgArchiveModel(vid, pid, signer)
+	})
+}
+
+func unarchiveModelHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return modelArchivalHandler(cliCtx, func(vid uint16, pid uint16, signer sdk.AccAddress) sdk.Msg {
+		return types.NewMsgUnarchiveModel(vid, pid, signer)
+	})
+}
+
+func modelArchivalHandler(cliCtx context.CLIContext,
+	newMsg func(vid uint16, pid uint16, signer sdk.AccAddress) sdk.Msg) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		vid, err_ := conversions.ParseVID(vars[vid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		pid, err_ := conversions.ParsePID(vars[pid])
+		if err_ != nil {
+			restCtx.WriteError(http.StatusBadRequest, err_)
+
+			return
+		}
+
+		var req rest.BasicReq
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		restCtx.HandleWriteRequest(newMsg(vid, pid, restCtx.Signer()))
+	}
+}
+
+func setCustomDataSchemaHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		name := restCtx.Variables()[name]
+
+		var req SetCustomDataSchemaRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgSetCustomDataSchema(name, req.Schema, restCtx.Signer())
 
 		restCtx.HandleWriteRequest(msg)
 	}
@@ -23,16 +23,28 @@ import (
 )
 
 type GenesisState struct {
-	ModelInfoRecords []ModelInfo `json:"model_info_records"`
+	ModelInfoRecords        []ModelInfo        `json:"model_info_records"`
+	ModelVersionRecords     []ModelVersion     `json:"model_version_records"`
+	CustomDataSchemaRecords []CustomDataSchema `json:"custom_data_schema_records"`
+	ModelAddedHeightRecords []ModelAddedHeight `json:"model_added_height_records"`
 }
 
 func NewGenesisState() GenesisState {
-	return GenesisState{ModelInfoRecords: []ModelInfo{}}
+	return GenesisState{
+		ModelInfoRecords:        []ModelInfo{},
+		ModelVersionRecords:     []ModelVersion{},
+		CustomDataSchemaRecords: []CustomDataSchema{},
+		ModelAddedHeightRecords: []ModelAddedHeight{},
+	}
 }
 
 //nolint:gocognit
 func ValidateGenesis(data GenesisState) error {
+	models := make(map[string]bool)
+
 	for _, record := range data.ModelInfoRecords {
+		models[string(types.GetModelInfoKey(record.VID, record.PID))] = true
+
 		if record.VID == 0 {
 			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ModelInfo: Invalid VID. Value: %v", record))
 		}
@@ -73,6 +85,50 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, record := range data.ModelVersionRecords {
+		if record.VID == 0 {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ModelVersion: Invalid VID. Value: %v", record))
+		}
+
+		if record.PID == 0 {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ModelVersion: Invalid PID. Value: %v", record))
+		}
+
+		if record.SoftwareVersionString == "" {
+			return sdk.ErrUnknownRequest(
+				fmt.Sprintf("Invalid ModelVersion: Missed SoftwareVersionString. Value: %v", record))
+		}
+
+		if err := types.ValidateApplicableSoftwareVersions(record.MinApplicableSoftwareVersion,
+			record.MaxApplicableSoftwareVersion); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ModelVersion: %v. Value: %v", err.Data(), record))
+		}
+	}
+
+	for _, record := range data.CustomDataSchemaRecords {
+		if err := types.ValidateCustomDataSchemaName(record.Name); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid CustomDataSchema: %v. Value: %v", err.Data(), record))
+		}
+
+		if err := types.ValidateCustomDataSchema(record.Schema); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid CustomDataSchema: %v. Value: %v", err.Data(), record))
+		}
+
+		if record.Owner.Empty() {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid CustomDataSchema: Missed Owner. Value: %v", record))
+		}
+	}
+
+	for _, record := range data.ModelAddedHeightRecords {
+		if !models[string(types.GetModelInfoKey(record.VID, record.PID))] {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ModelAddedHeight: No ModelInfo. Value: %v", record))
+		}
+
+		if record.Height < 0 {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ModelAddedHeight: Invalid Height. Value: %v", record))
+		}
+	}
+
 	return nil
 }
 
@@ -85,17 +141,59 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.Valid
 		keeper.SetModelInfo(ctx, record)
 	}
 
+	for _, record := range data.ModelVersionRecords {
+		keeper.SetModelVersion(ctx, record)
+	}
+
+	for _, record := range data.CustomDataSchemaRecords {
+		keeper.SetCustomDataSchema(ctx, record)
+	}
+
+	// the models are recorded as added at the current height unless the height they were added at is exported
+	for _, record := range data.ModelAddedHeightRecords {
+		keeper.SetModelAddedHeight(ctx, record.VID, record.PID, record.Height)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
-	var records []ModelInfo
+	var (
+		records            []ModelInfo
+		addedHeightRecords []ModelAddedHeight
+	)
 
 	k.IterateModelInfos(ctx, func(modelInfo types.ModelInfo) (stop bool) {
 		records = append(records, modelInfo)
+		addedHeightRecords = append(addedHeightRecords, ModelAddedHeight{
+			VID:    modelInfo.VID,
+			PID:    modelInfo.PID,
+			Height: k.GetModelAddedHeight(ctx, modelInfo.VID, modelInfo.PID),
+		})
 
 		return false
 	})
 
-	return GenesisState{ModelInfoRecords: records}
+	var versionRecords []ModelVersion
+
+	k.IterateModelVersions(ctx, types.ModelVersionPrefix, func(modelVersion types.ModelVersion) (stop bool) {
+		versionRecords = append(versionRecords, modelVersion)
+
+		return false
+	})
+
+	var customDataSchemaRecords []CustomDataSchema
+
+	k.IterateCustomDataSchemas(ctx, func(customDataSchema types.CustomDataSchema) (stop bool) {
+		customDataSchemaRecords = append(customDataSchemaRecords, customDataSchema)
+
+		return false
+	})
+
+	return GenesisState{
+		ModelInfoRecords:        records,
+		ModelVersionRecords:     versionRecords,
+		CustomDataSchemaRecords: customDataSchemaRecords,
+		ModelAddedHeightRecords: addedHeightRecords,
+	}
 }
@@ -0,0 +1,74 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package modelinfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/internal/keeper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/internal/types"
+)
+
+func TestGenesis_ExportImportKeepsModelsSortedByDateAdded(t *testing.T) {
+	setup := Setup()
+
+	// add models at the heights which are not in the order of their keys
+	modelInfo := keeper.DefaultModelInfo()
+
+	for i, height := range []int64{3, 1, 2} {
+		modelInfo.VID = uint16(i + 1)
+		modelInfo.PID = uint16(i + 1)
+		setup.ModelinfoKeeper.SetModelInfo(setup.Ctx.WithBlockHeight(height), modelInfo)
+	}
+
+	require.Equal(t, []uint16{2, 3, 1}, modelVIDsSortedByDateAdded(t, setup))
+
+	// export the genesis and import it into a new chain
+	genesis := ExportGenesis(setup.Ctx, setup.ModelinfoKeeper)
+	require.NoError(t, ValidateGenesis(genesis))
+
+	imported := Setup()
+	imported.Ctx = imported.Ctx.WithBlockHeight(100)
+	InitGenesis(imported.Ctx, imported.ModelinfoKeeper, genesis)
+
+	// the models keep the heights they were added at
+	require.Equal(t, []uint16{2, 3, 1}, modelVIDsSortedByDateAdded(t, imported))
+	require.Equal(t, int64(3), imported.ModelinfoKeeper.GetModelAddedHeight(imported.Ctx, 1, 1))
+	require.Equal(t, genesis, ExportGenesis(imported.Ctx, imported.ModelinfoKeeper))
+}
+
+func modelVIDsSortedByDateAdded(t *testing.T, setup TestSetup) []uint16 {
+	params := pagination.NewPaginationParams(0, 0)
+	params.Sort = types.SortByDateAdded
+
+	result, err := setup.Querier(setup.Ctx, []string{keeper.QueryAllModels},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)})
+	require.Nil(t, err)
+
+	var models types.ListModelInfoItems
+
+	setup.Cdc.MustUnmarshalJSON(result, &models)
+
+	vids := make([]uint16, 0, len(models.Items))
+	for _, item := range models.Items {
+		vids = append(vids, item.VID)
+	}
+
+	return vids
+}
@@ -23,6 +23,13 @@ import (
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/internal/types"
 )
 
+func init() {
+	// sender must have Vendor role to add new model
+	auth.RegisterMsgRoles(types.MsgAddModelInfo{}, auth.Vendor)
+	// sender must have Trustee role to manage custom data schemas
+	auth.RegisterMsgRoles(types.MsgSetCustomDataSchema{}, auth.Trustee)
+}
+
 func NewHandler(keeper keeper.Keeper, authKeeper auth.Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		switch msg := msg.(type) {
@@ -30,6 +37,16 @@ func NewHandler(keeper keeper.Keeper, authKeeper auth.Keeper) sdk.Handler {
 			return handleMsgAddModelInfo(ctx, keeper, authKeeper, msg)
 		case types.MsgUpdateModelInfo:
 			return handleMsgUpdateModelInfo(ctx, keeper, authKeeper, msg)
+		case types.MsgAddModelVersion:
+			return handleMsgAddModelVersion(ctx, keeper, authKeeper, msg)
+		case types.MsgUpdateModelVersion:
+			return handleMsgUpdateModelVersion(ctx, keeper, authKeeper, msg)
+		case types.MsgArchiveModel:
+			return handleMsgArchiveModel(ctx, keeper, authKeeper, msg)
+		case types.MsgUnarchiveModel:
+			return handleMsgUnarchiveModel(ctx, keeper, authKeeper, msg)
+		case types.MsgSetCustomDataSchema:
+			return handleMsgSetCustomDataSchema(ctx, keeper, authKeeper, msg)
 			/*		case type.MsgDeleteModelInfo:
 					return handleMsgDeleteModelInfo(ctx, keeper, authKeeper, msg)*/
 		default:
@@ -48,7 +65,12 @@ func handleMsgAddModelInfo(ctx sdk.Context, keeper keeper.Keeper, authKeeper aut
 	}
 
 	// check sender has enough rights to add model
-	if err := checkAddModelRights(ctx, authKeeper, msg.Signer); err != nil {
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// vendor can add models only for the VendorID it is bound to
+	if err := authKeeper.CheckVendorID(ctx, msg.Signer, msg.VID); err != nil {
 		return err.Result()
 	}
 
@@ -69,6 +91,13 @@ func handleMsgAddModelInfo(ctx sdk.Context, keeper keeper.Keeper, authKeeper aut
 		msg.TisOrTrpTestingCompleted,
 		msg.Signer,
 	)
+	modelInfo.CustomSchema = msg.CustomSchema
+	modelInfo.Matter = msg.Matter
+
+	// custom data must conform to the custom data schema the model references
+	if err := checkCustomData(ctx, keeper, modelInfo); err != nil {
+		return err.Result()
+	}
 
 	// store new model
 	keeper.SetModelInfo(ctx, modelInfo)
@@ -90,6 +119,16 @@ func handleMsgUpdateModelInfo(ctx sdk.Context, keeper keeper.Keeper, authKeeper
 		return err.Result()
 	}
 
+	// the model must not have been changed since the sender read it
+	if msg.ExpectedSequence != modelInfo.Sequence {
+		return types.ErrSequenceConflict(msg.VID, msg.PID, msg.ExpectedSequence, modelInfo.Sequence).Result()
+	}
+
+	// vendor can update models only for the VendorID it is bound to
+	if err := authKeeper.CheckVendorID(ctx, msg.Signer, msg.VID); err != nil {
+		return err.Result()
+	}
+
 	if msg.OtaURL != "" && modelInfo.OtaURL == "" {
 		return types.ErrOtaURLCannotBeSet(msg.VID, msg.PID).Result()
 	}
@@ -112,7 +151,37 @@ func handleMsgUpdateModelInfo(ctx sdk.Context, keeper keeper.Keeper, authKeeper
 		modelInfo.Custom = msg.Custom
 	}
 
-	modelInfo.TisOrTrpTestingCompleted = msg.TisOrTrpTestingCompleted
+	if msg.TisOrTrpTestingCompleted != nil {
+		modelInfo.TisOrTrpTestingCompleted = *msg.TisOrTrpTestingCompleted
+	}
+
+	if msg.CustomSchema != "" {
+		modelInfo.CustomSchema = msg.CustomSchema
+	}
+
+	if msg.Matter != nil {
+		modelInfo.Matter = msg.Matter
+	}
+
+	for _, field := range msg.Unset {
+		switch field {
+		case types.ModelInfoFieldCID:
+			modelInfo.CID = 0
+		case types.ModelInfoFieldCustom:
+			modelInfo.Custom = ""
+		case types.ModelInfoFieldCustomSchema:
+			modelInfo.CustomSchema = ""
+		case types.ModelInfoFieldMatter:
+			modelInfo.Matter = nil
+		}
+	}
+
+	// the resulting custom data must conform to the resulting custom data schema
+	if err := checkCustomData(ctx, keeper, modelInfo); err != nil {
+		return err.Result()
+	}
+
+	modelInfo.Sequence++
 
 	// store updated model
 	keeper.SetModelInfo(ctx, modelInfo)
@@ -141,11 +210,169 @@ func handleMsgDeleteModelInfo(ctx sdk.Context, keeper keeper.Keeper, authKeeper
 	return sdk.Result{}
 }
 
-func checkAddModelRights(ctx sdk.Context, authKeeper auth.Keeper, signer sdk.AccAddress) sdk.Error {
-	// sender must have Vendor role to add new model
-	if !authKeeper.HasRole(ctx, signer, auth.Vendor) {
-		return sdk.ErrUnauthorized(fmt.Sprintf("MsgAddModelInfo transaction should be "+
-			"signed by an account with the %s role", auth.Vendor))
+func handleMsgArchiveModel(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgArchiveModel) sdk.Result {
+	// check if model exists
+	if !keeper.IsModelInfoPresent(ctx, msg.VID, msg.PID) {
+		return types.ErrModelInfoDoesNotExist(msg.VID, msg.PID).Result()
+	}
+
+	modelInfo := keeper.GetModelInfo(ctx, msg.VID, msg.PID)
+
+	if modelInfo.Archived {
+		return types.ErrModelInfoArchived(msg.VID, msg.PID).Result()
+	}
+
+	// check if sender has enough rights to archive model
+	if err := checkModelRights(ctx, authKeeper, modelInfo, msg.Signer, "MsgArchiveModel"); err != nil {
+		return err.Result()
+	}
+
+	// archived model is kept in the store (together with its versions and compliance history)
+	// but is not returned by the list queries by default
+	modelInfo.Archived = true
+	modelInfo.Sequence++
+	keeper.SetModelInfo(ctx, modelInfo)
+
+	return sdk.Result{}
+}
+
+func handleMsgUnarchiveModel(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgUnarchiveModel) sdk.Result {
+	// check if model exists
+	if !keeper.IsModelInfoPresent(ctx, msg.VID, msg.PID) {
+		return types.ErrModelInfoDoesNotExist(msg.VID, msg.PID).Result()
+	}
+
+	modelInfo := keeper.GetModelInfo(ctx, msg.VID, msg.PID)
+
+	if !modelInfo.Archived {
+		return types.ErrModelInfoNotArchived(msg.VID, msg.PID).Result()
+	}
+
+	// check if sender has enough rights to unarchive model
+	if err := checkModelRights(ctx, authKeeper, modelInfo, msg.Signer, "MsgUnarchiveModel"); err != nil {
+		return err.Result()
+	}
+
+	modelInfo.Archived = false
+	modelInfo.Sequence++
+	keeper.SetModelInfo(ctx, modelInfo)
+
+	return sdk.Result{}
+}
+
+func handleMsgAddModelVersion(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgAddModelVersion) sdk.Result {
+	// check if model exists
+	if !keeper.IsModelInfoPresent(ctx, msg.VID, msg.PID) {
+		return types.ErrModelInfoDoesNotExist(msg.VID, msg.PID).Result()
+	}
+
+	// check if model version already exists
+	if keeper.IsModelVersionPresent(ctx, msg.VID, msg.PID, msg.SoftwareVersion) {
+		return types.ErrModelVersionAlreadyExists(msg.VID, msg.PID, msg.SoftwareVersion).Result()
+	}
+
+	modelInfo := keeper.GetModelInfo(ctx, msg.VID, msg.PID)
+
+	// check if sender has enough rights to add model version
+	if err := checkModelRights(ctx, authKeeper, modelInfo, msg.Signer, "MsgAddModelVersion"); err != nil {
+		return err.Result()
+	}
+
+	modelVersion := types.NewModelVersion(
+		msg.VID,
+		msg.PID,
+		msg.SoftwareVersion,
+		msg.SoftwareVersionString,
+		msg.FirmwareDigest,
+		msg.ReleaseNotesURL,
+		msg.MinApplicableSoftwareVersion,
+		msg.MaxApplicableSoftwareVersion,
+	)
+
+	// store new model version
+	keeper.SetModelVersion(ctx, modelVersion)
+
+	return sdk.Result{}
+}
+
+func handleMsgUpdateModelVersion(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgUpdateModelVersion) sdk.Result {
+	// check if model version exists
+	if !keeper.IsModelVersionPresent(ctx, msg.VID, msg.PID, msg.SoftwareVersion) {
+		return types.ErrModelVersionDoesNotExist(msg.VID, msg.PID, msg.SoftwareVersion).Result()
+	}
+
+	modelInfo := keeper.GetModelInfo(ctx, msg.VID, msg.PID)
+
+	// check if sender has enough rights to update model version
+	if err := checkModelRights(ctx, authKeeper, modelInfo, msg.Signer, "MsgUpdateModelVersion"); err != nil {
+		return err.Result()
+	}
+
+	modelVersion := keeper.GetModelVersion(ctx, msg.VID, msg.PID, msg.SoftwareVersion)
+
+	// updates existing model version value only if corresponding value in MsgUpdate is not empty
+
+	if msg.ReleaseNotesURL != "" {
+		modelVersion.ReleaseNotesURL = msg.ReleaseNotesURL
+	}
+
+	if msg.MinApplicableSoftwareVersion != 0 {
+		modelVersion.MinApplicableSoftwareVersion = msg.MinApplicableSoftwareVersion
+	}
+
+	if msg.MaxApplicableSoftwareVersion != 0 {
+		modelVersion.MaxApplicableSoftwareVersion = msg.MaxApplicableSoftwareVersion
+	}
+
+	// the range may become inverted if only one of its bounds is updated
+	if err := types.ValidateApplicableSoftwareVersions(modelVersion.MinApplicableSoftwareVersion,
+		modelVersion.MaxApplicableSoftwareVersion); err != nil {
+		return err.Result()
+	}
+
+	// store updated model version
+	keeper.SetModelVersion(ctx, modelVersion)
+
+	return sdk.Result{}
+}
+
+func handleMsgSetCustomDataSchema(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgSetCustomDataSchema) sdk.Result {
+	// check if sender has enough rights to set custom data schema
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	customDataSchema := types.NewCustomDataSchema(msg.Name, msg.Schema, msg.Signer)
+
+	// store new or replace existing custom data schema
+	keeper.SetCustomDataSchema(ctx, customDataSchema)
+
+	return sdk.Result{}
+}
+
+func checkCustomData(ctx sdk.Context, keeper keeper.Keeper, modelInfo types.ModelInfo) sdk.Error {
+	if modelInfo.CustomSchema == "" {
+		return nil
+	}
+
+	if !keeper.IsCustomDataSchemaPresent(ctx, modelInfo.CustomSchema) {
+		return types.ErrCustomDataSchemaDoesNotExist(modelInfo.CustomSchema)
+	}
+
+	// models without custom data are not validated: the schema describes the custom data if it is present
+	if modelInfo.Custom == "" {
+		return nil
+	}
+
+	customDataSchema := keeper.GetCustomDataSchema(ctx, modelInfo.CustomSchema)
+
+	if err := customDataSchema.ValidateCustomData(modelInfo.Custom); err != nil {
+		return types.ErrInvalidCustomData(modelInfo.VID, modelInfo.PID, modelInfo.CustomSchema, err)
 	}
 
 	return nil
@@ -159,3 +386,14 @@ func checkUpdateModelRights(owner sdk.AccAddress, signer sdk.AccAddress) sdk.Err
 
 	return nil
 }
+
+func checkModelRights(ctx sdk.Context, authKeeper auth.Keeper, modelInfo types.ModelInfo,
+	signer sdk.AccAddress, msgName string) sdk.Error {
+	// sender must be equal to owner of the model
+	if !signer.Equals(modelInfo.Owner) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s tx should be signed by owner of the model", msgName))
+	}
+
+	// vendor can manage models only for the VendorID it is bound to
+	return authKeeper.CheckVendorID(ctx, signer, modelInfo.VID)
+}
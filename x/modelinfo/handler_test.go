@@ -28,6 +28,11 @@ import (
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/internal/types"
 )
 
+const (
+	customDataSchemaName = "color-extension"
+	customDataSchema     = `{"type": "object", "properties": {"color": {"enum": ["white", "black"]}}}`
+)
+
 func TestHandler_AddModel(t *testing.T) {
 	setup := Setup()
 
@@ -91,7 +96,7 @@ func TestHandler_UpdateModel(t *testing.T) {
 	require.Equal(t, receivedModelInfo.OtaChecksum, msgAddModelInfo.OtaChecksum)
 	require.Equal(t, receivedModelInfo.OtaChecksumType, msgAddModelInfo.OtaChecksumType)
 	require.Equal(t, receivedModelInfo.Custom, msgUpdateModelInfo.Custom)
-	require.Equal(t, receivedModelInfo.TisOrTrpTestingCompleted, msgUpdateModelInfo.TisOrTrpTestingCompleted)
+	require.Equal(t, receivedModelInfo.TisOrTrpTestingCompleted, *msgUpdateModelInfo.TisOrTrpTestingCompleted)
 	require.Equal(t, receivedModelInfo.Owner, msgAddModelInfo.Signer)
 }
 
@@ -158,10 +163,53 @@ func TestHandler_AddModelByNonVendor(t *testing.T) {
 		// add new model
 		modelInfo := TestMsgAddModelInfo(testconstants.Address3)
 		result := setup.Handler(setup.Ctx, modelInfo)
-		require.Equal(t, sdk.CodeUnauthorized, result.Code)
+		require.Equal(t, auth.CodeMissingRole, result.Code)
 	}
 }
 
+func TestHandler_AddModelForAnotherVendorID(t *testing.T) {
+	setup := Setup()
+
+	// vendor is bound to testconstants.VID
+	modelInfo := TestMsgAddModelInfo(setup.Vendor)
+	modelInfo.VID = testconstants.VID + 1
+
+	result := setup.Handler(setup.Ctx, modelInfo)
+	require.Equal(t, auth.CodeVendorIDMismatch, result.Code)
+	require.False(t, setup.ModelinfoKeeper.IsModelInfoPresent(setup.Ctx, modelInfo.VID, modelInfo.PID))
+}
+
+func TestHandler_AddModelByVendorNotBoundToVendorID(t *testing.T) {
+	setup := Setup()
+
+	// store vendor account without VendorID binding
+	account := auth.NewAccount(testconstants.Address3, testconstants.PubKey3, auth.AccountRoles{auth.Vendor})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	modelInfo := TestMsgAddModelInfo(testconstants.Address3)
+	modelInfo.VID = testconstants.VID + 1
+
+	result := setup.Handler(setup.Ctx, modelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+}
+
+func TestHandler_UpdateModelAfterVendorIDChange(t *testing.T) {
+	setup := Setup()
+
+	// add new model
+	result := setup.Handler(setup.Ctx, TestMsgAddModelInfo(setup.Vendor))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// bind vendor to another VendorID
+	account := setup.authKeeper.GetAccount(setup.Ctx, setup.Vendor)
+	account.VendorID = testconstants.VID + 1
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	// update model
+	result = setup.Handler(setup.Ctx, TestMsgUpdateModelInfo(setup.Vendor))
+	require.Equal(t, auth.CodeVendorIDMismatch, result.Code)
+}
+
 func TestHandler_PartiallyUpdateModel(t *testing.T) {
 	setup := Setup()
 
@@ -175,7 +223,7 @@ func TestHandler_PartiallyUpdateModel(t *testing.T) {
 	msgUpdateModelInfo.Description = "New Description"
 	msgUpdateModelInfo.OtaURL = ""
 	msgUpdateModelInfo.Custom = ""
-	msgUpdateModelInfo.TisOrTrpTestingCompleted = !testconstants.TisOrTrpTestingCompleted
+	msgUpdateModelInfo.TisOrTrpTestingCompleted = nil
 	result = setup.Handler(setup.Ctx, msgUpdateModelInfo)
 	require.Equal(t, sdk.CodeOK, result.Code)
 
@@ -187,7 +235,432 @@ func TestHandler_PartiallyUpdateModel(t *testing.T) {
 	require.Equal(t, receivedModelInfo.Description, msgUpdateModelInfo.Description)
 	require.Equal(t, receivedModelInfo.OtaURL, msgAddModelInfo.OtaURL)
 	require.Equal(t, receivedModelInfo.Custom, msgAddModelInfo.Custom)
-	require.Equal(t, receivedModelInfo.TisOrTrpTestingCompleted, msgUpdateModelInfo.TisOrTrpTestingCompleted)
+	require.Equal(t, receivedModelInfo.TisOrTrpTestingCompleted, msgAddModelInfo.TisOrTrpTestingCompleted)
+}
+
+func TestHandler_UpdateModelWithUnsetFields(t *testing.T) {
+	setup := Setup()
+
+	// add new model
+	msgAddModelInfo := TestMsgAddModelInfo(setup.Vendor)
+	result := setup.Handler(setup.Ctx, msgAddModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// owner unsets CID and Custom of existing model
+	msgUpdateModelInfo := types.MsgUpdateModelInfo{
+		VID:    msgAddModelInfo.VID,
+		PID:    msgAddModelInfo.PID,
+		Unset:  []string{types.ModelInfoFieldCID, types.ModelInfoFieldCustom},
+		Signer: setup.Vendor,
+	}
+	result = setup.Handler(setup.Ctx, msgUpdateModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query model
+	receivedModelInfo := queryModelInfo(setup, msgUpdateModelInfo.VID, msgUpdateModelInfo.PID)
+
+	// check
+	require.Equal(t, uint16(0), receivedModelInfo.CID)
+	require.Equal(t, "", receivedModelInfo.Custom)
+	require.Equal(t, msgAddModelInfo.Description, receivedModelInfo.Description)
+	require.Equal(t, msgAddModelInfo.TisOrTrpTestingCompleted, receivedModelInfo.TisOrTrpTestingCompleted)
+}
+
+func TestHandler_AddAndUpdateModelWithMatterFields(t *testing.T) {
+	setup := Setup()
+
+	// add new model with the Matter fields
+	msgAddModelInfo := TestMsgAddModelInfo(setup.Vendor)
+	msgAddModelInfo.Matter = &types.MatterModelInfo{
+		DeviceTypeID:               0x0100,
+		ProductLabel:               "Lamp",
+		CommissioningCustomFlow:    types.CommissioningFlowCustom,
+		CommissioningCustomFlowURL: "https://example.com/commissioning",
+	}
+	result := setup.Handler(setup.Ctx, msgAddModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedModelInfo := queryModelInfo(setup, msgAddModelInfo.VID, msgAddModelInfo.PID)
+	require.Equal(t, msgAddModelInfo.Matter, receivedModelInfo.Matter)
+
+	// the other fields do not change the Matter fields
+	msgUpdateModelInfo := TestMsgUpdateModelInfo(setup.Vendor)
+	result = setup.Handler(setup.Ctx, msgUpdateModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedModelInfo = queryModelInfo(setup, msgAddModelInfo.VID, msgAddModelInfo.PID)
+	require.Equal(t, msgAddModelInfo.Matter, receivedModelInfo.Matter)
+
+	// the Matter fields are replaced as a whole
+	msgUpdateModelInfo = types.MsgUpdateModelInfo{
+		VID:              msgAddModelInfo.VID,
+		PID:              msgAddModelInfo.PID,
+		Matter:           &types.MatterModelInfo{DeviceTypeID: 0x010C, PartNumber: "RT-2"},
+		Signer:           setup.Vendor,
+		ExpectedSequence: 1,
+	}
+	result = setup.Handler(setup.Ctx, msgUpdateModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedModelInfo = queryModelInfo(setup, msgAddModelInfo.VID, msgAddModelInfo.PID)
+	require.Equal(t, msgUpdateModelInfo.Matter, receivedModelInfo.Matter)
+
+	// unset the Matter fields
+	msgUpdateModelInfo = types.MsgUpdateModelInfo{
+		VID:              msgAddModelInfo.VID,
+		PID:              msgAddModelInfo.PID,
+		Unset:            []string{types.ModelInfoFieldMatter},
+		Signer:           setup.Vendor,
+		ExpectedSequence: 2,
+	}
+	result = setup.Handler(setup.Ctx, msgUpdateModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedModelInfo = queryModelInfo(setup, msgAddModelInfo.VID, msgAddModelInfo.PID)
+	require.Nil(t, receivedModelInfo.Matter)
+}
+
+func TestHandler_UpdateModelWithOutdatedSequence(t *testing.T) {
+	setup := Setup()
+
+	// add new model
+	msgAddModelInfo := TestMsgAddModelInfo(setup.Vendor)
+	result := setup.Handler(setup.Ctx, msgAddModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+	require.Equal(t, uint64(0), queryModelInfo(setup, msgAddModelInfo.VID, msgAddModelInfo.PID).Sequence)
+
+	// update model based on the current sequence
+	msgUpdateModelInfo := TestMsgUpdateModelInfo(setup.Vendor)
+	result = setup.Handler(setup.Ctx, msgUpdateModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+	require.Equal(t, uint64(1), queryModelInfo(setup, msgAddModelInfo.VID, msgAddModelInfo.PID).Sequence)
+
+	// concurrent update based on the same (now outdated) sequence
+	msgUpdateModelInfo.Description = "Concurrent Description"
+	result = setup.Handler(setup.Ctx, msgUpdateModelInfo)
+	require.Equal(t, types.CodeSequenceConflict, result.Code)
+
+	// update based on the actual sequence
+	msgUpdateModelInfo.ExpectedSequence = 1
+	result = setup.Handler(setup.Ctx, msgUpdateModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedModelInfo := queryModelInfo(setup, msgAddModelInfo.VID, msgAddModelInfo.PID)
+	require.Equal(t, msgUpdateModelInfo.Description, receivedModelInfo.Description)
+	require.Equal(t, uint64(2), receivedModelInfo.Sequence)
+}
+
+func TestHandler_ArchiveModel(t *testing.T) {
+	setup := Setup()
+
+	// try archive not present model
+	msgArchiveModel := types.NewMsgArchiveModel(testconstants.VID, testconstants.PID, setup.Vendor)
+	result := setup.Handler(setup.Ctx, msgArchiveModel)
+	require.Equal(t, types.CodeModelInfoDoesNotExist, result.Code)
+
+	// add new model
+	msgAddModelInfo := TestMsgAddModelInfo(setup.Vendor)
+	result = setup.Handler(setup.Ctx, msgAddModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// try unarchive not archived model
+	msgUnarchiveModel := types.NewMsgUnarchiveModel(testconstants.VID, testconstants.PID, setup.Vendor)
+	result = setup.Handler(setup.Ctx, msgUnarchiveModel)
+	require.Equal(t, types.CodeModelInfoNotArchived, result.Code)
+
+	// archive model
+	result = setup.Handler(setup.Ctx, msgArchiveModel)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedModelInfo := queryModelInfo(setup, msgAddModelInfo.VID, msgAddModelInfo.PID)
+	require.True(t, receivedModelInfo.Archived)
+	require.Equal(t, msgAddModelInfo.Name, receivedModelInfo.Name)
+
+	vendorProducts := setup.ModelinfoKeeper.GetVendorProducts(setup.Ctx, msgAddModelInfo.VID)
+	require.Equal(t, 1, len(vendorProducts.Products))
+	require.True(t, vendorProducts.Products[0].Archived)
+
+	// try archive already archived model
+	result = setup.Handler(setup.Ctx, msgArchiveModel)
+	require.Equal(t, types.CodeModelInfoArchived, result.Code)
+
+	// unarchive model
+	result = setup.Handler(setup.Ctx, msgUnarchiveModel)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedModelInfo = queryModelInfo(setup, msgAddModelInfo.VID, msgAddModelInfo.PID)
+	require.False(t, receivedModelInfo.Archived)
+}
+
+func TestHandler_OnlyOwnerCanArchiveModel(t *testing.T) {
+	setup := Setup()
+
+	// add new model
+	msgAddModelInfo := TestMsgAddModelInfo(setup.Vendor)
+	result := setup.Handler(setup.Ctx, msgAddModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// store another vendor account
+	account := auth.NewAccount(testconstants.Address3, testconstants.PubKey3, auth.AccountRoles{auth.Vendor})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	// archive existing model by not owner
+	result = setup.Handler(setup.Ctx, types.NewMsgArchiveModel(testconstants.VID, testconstants.PID, account.Address))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// owner archives existing model
+	result = setup.Handler(setup.Ctx, types.NewMsgArchiveModel(testconstants.VID, testconstants.PID, setup.Vendor))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// unarchive existing model by not owner
+	result = setup.Handler(setup.Ctx, types.NewMsgUnarchiveModel(testconstants.VID, testconstants.PID, account.Address))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+}
+
+func TestHandler_AddModelVersion(t *testing.T) {
+	setup := Setup()
+
+	// try add version to not present model
+	msgAddModelVersion := TestMsgAddModelVersion(setup.Vendor)
+	result := setup.Handler(setup.Ctx, msgAddModelVersion)
+	require.Equal(t, types.CodeModelInfoDoesNotExist, result.Code)
+
+	// add new model
+	result = setup.Handler(setup.Ctx, TestMsgAddModelInfo(setup.Vendor))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// add new model version
+	result = setup.Handler(setup.Ctx, msgAddModelVersion)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query model version
+	receivedModelVersion := queryModelVersion(setup, msgAddModelVersion.VID,
+		msgAddModelVersion.PID, msgAddModelVersion.SoftwareVersion)
+
+	// check
+	require.Equal(t, msgAddModelVersion.VID, receivedModelVersion.VID)
+	require.Equal(t, msgAddModelVersion.PID, receivedModelVersion.PID)
+	require.Equal(t, msgAddModelVersion.SoftwareVersion, receivedModelVersion.SoftwareVersion)
+	require.Equal(t, msgAddModelVersion.SoftwareVersionString, receivedModelVersion.SoftwareVersionString)
+	require.Equal(t, msgAddModelVersion.FirmwareDigest, receivedModelVersion.FirmwareDigest)
+	require.Equal(t, msgAddModelVersion.ReleaseNotesURL, receivedModelVersion.ReleaseNotesURL)
+	require.Equal(t, msgAddModelVersion.MinApplicableSoftwareVersion, receivedModelVersion.MinApplicableSoftwareVersion)
+	require.Equal(t, msgAddModelVersion.MaxApplicableSoftwareVersion, receivedModelVersion.MaxApplicableSoftwareVersion)
+
+	// add the same model version second time
+	result = setup.Handler(setup.Ctx, msgAddModelVersion)
+	require.Equal(t, types.CodeModelVersionAlreadyExists, result.Code)
+}
+
+func TestHandler_OnlyOwnerCanAddModelVersion(t *testing.T) {
+	setup := Setup()
+
+	// add new model
+	result := setup.Handler(setup.Ctx, TestMsgAddModelInfo(setup.Vendor))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	for _, role := range []auth.AccountRole{auth.Trustee, auth.TestHouse, auth.Vendor} {
+		// store account
+		account := auth.NewAccount(testconstants.Address3, testconstants.PubKey3, auth.AccountRoles{role})
+		setup.authKeeper.SetAccount(setup.Ctx, account)
+
+		// add model version by not owner
+		result = setup.Handler(setup.Ctx, TestMsgAddModelVersion(testconstants.Address3))
+		require.Equal(t, sdk.CodeUnauthorized, result.Code)
+	}
+}
+
+func TestHandler_UpdateModelVersion(t *testing.T) {
+	setup := Setup()
+
+	// try update not present model version
+	msgUpdateModelVersion := TestMsgUpdateModelVersion(setup.Vendor)
+	result := setup.Handler(setup.Ctx, msgUpdateModelVersion)
+	require.Equal(t, types.CodeModelVersionDoesNotExist, result.Code)
+
+	// add new model and its version
+	result = setup.Handler(setup.Ctx, TestMsgAddModelInfo(setup.Vendor))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	msgAddModelVersion := TestMsgAddModelVersion(setup.Vendor)
+	result = setup.Handler(setup.Ctx, msgAddModelVersion)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// update model version by not owner
+	result = setup.Handler(setup.Ctx, TestMsgUpdateModelVersion(testconstants.Address3))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// owner update existing model version
+	result = setup.Handler(setup.Ctx, msgUpdateModelVersion)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query updated model version
+	receivedModelVersion := queryModelVersion(setup, msgUpdateModelVersion.VID,
+		msgUpdateModelVersion.PID, msgUpdateModelVersion.SoftwareVersion)
+
+	// check
+	require.Equal(t, msgAddModelVersion.SoftwareVersionString, receivedModelVersion.SoftwareVersionString)
+	require.Equal(t, msgAddModelVersion.FirmwareDigest, receivedModelVersion.FirmwareDigest)
+	require.Equal(t, msgUpdateModelVersion.ReleaseNotesURL, receivedModelVersion.ReleaseNotesURL)
+	require.Equal(t, msgUpdateModelVersion.MinApplicableSoftwareVersion,
+		receivedModelVersion.MinApplicableSoftwareVersion)
+	require.Equal(t, msgUpdateModelVersion.MaxApplicableSoftwareVersion,
+		receivedModelVersion.MaxApplicableSoftwareVersion)
+}
+
+func TestHandler_ModelVersionAfterVendorIDChange(t *testing.T) {
+	setup := Setup()
+
+	// add new model and its version
+	result := setup.Handler(setup.Ctx, TestMsgAddModelInfo(setup.Vendor))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, TestMsgAddModelVersion(setup.Vendor))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// bind vendor to another VendorID
+	account := setup.authKeeper.GetAccount(setup.Ctx, setup.Vendor)
+	account.VendorID = testconstants.VID + 1
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	// update model version
+	result = setup.Handler(setup.Ctx, TestMsgUpdateModelVersion(setup.Vendor))
+	require.Equal(t, auth.CodeVendorIDMismatch, result.Code)
+
+	// add another model version
+	msgAddModelVersion := TestMsgAddModelVersion(setup.Vendor)
+	msgAddModelVersion.SoftwareVersion++
+	result = setup.Handler(setup.Ctx, msgAddModelVersion)
+	require.Equal(t, auth.CodeVendorIDMismatch, result.Code)
+	require.False(t, setup.ModelinfoKeeper.IsModelVersionPresent(setup.Ctx,
+		msgAddModelVersion.VID, msgAddModelVersion.PID, msgAddModelVersion.SoftwareVersion))
+}
+
+func TestHandler_UpdateModelVersionWithInvertedApplicableVersions(t *testing.T) {
+	setup := Setup()
+
+	// add new model and its version
+	result := setup.Handler(setup.Ctx, TestMsgAddModelInfo(setup.Vendor))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	msgAddModelVersion := TestMsgAddModelVersion(setup.Vendor)
+	result = setup.Handler(setup.Ctx, msgAddModelVersion)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// update only min applicable version so that it becomes greater than stored max applicable version
+	msgUpdateModelVersion := TestMsgUpdateModelVersion(setup.Vendor)
+	msgUpdateModelVersion.MinApplicableSoftwareVersion = msgAddModelVersion.MaxApplicableSoftwareVersion + 1
+	msgUpdateModelVersion.MaxApplicableSoftwareVersion = 0
+	result = setup.Handler(setup.Ctx, msgUpdateModelVersion)
+	require.Equal(t, sdk.CodeUnknownRequest, result.Code)
+}
+
+func TestHandler_SetCustomDataSchema(t *testing.T) {
+	setup := Setup()
+
+	// store trustee
+	account := auth.NewAccount(testconstants.Address2, testconstants.PubKey2, auth.AccountRoles{auth.Trustee})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	// set custom data schema by non trustee
+	msgSetCustomDataSchema := types.NewMsgSetCustomDataSchema(customDataSchemaName, customDataSchema, setup.Vendor)
+	result := setup.Handler(setup.Ctx, msgSetCustomDataSchema)
+	require.Equal(t, auth.CodeMissingRole, result.Code)
+	require.False(t, setup.ModelinfoKeeper.IsCustomDataSchemaPresent(setup.Ctx, customDataSchemaName))
+
+	// set custom data schema by trustee
+	msgSetCustomDataSchema.Signer = testconstants.Address2
+	result = setup.Handler(setup.Ctx, msgSetCustomDataSchema)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedCustomDataSchema := setup.ModelinfoKeeper.GetCustomDataSchema(setup.Ctx, customDataSchemaName)
+	require.Equal(t, msgSetCustomDataSchema.Schema, receivedCustomDataSchema.Schema)
+	require.Equal(t, testconstants.Address2, receivedCustomDataSchema.Owner)
+
+	// replace custom data schema
+	msgSetCustomDataSchema.Schema = `{"type": "object"}`
+	result = setup.Handler(setup.Ctx, msgSetCustomDataSchema)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedCustomDataSchema = setup.ModelinfoKeeper.GetCustomDataSchema(setup.Ctx, customDataSchemaName)
+	require.Equal(t, msgSetCustomDataSchema.Schema, receivedCustomDataSchema.Schema)
+}
+
+func TestHandler_AddModelWithCustomSchema(t *testing.T) {
+	setup := Setup()
+
+	msgAddModelInfo := TestMsgAddModelInfo(setup.Vendor)
+	msgAddModelInfo.Custom = `{"color": "white"}`
+	msgAddModelInfo.CustomSchema = customDataSchemaName
+
+	// add model referencing not present custom data schema
+	result := setup.Handler(setup.Ctx, msgAddModelInfo)
+	require.Equal(t, types.CodeCustomDataSchemaDoesNotExist, result.Code)
+
+	setup.ModelinfoKeeper.SetCustomDataSchema(setup.Ctx,
+		types.NewCustomDataSchema(customDataSchemaName, customDataSchema, testconstants.Address2))
+
+	// add model with custom data not conforming to the schema
+	msgAddModelInfo.Custom = `{"color": "red"}`
+	result = setup.Handler(setup.Ctx, msgAddModelInfo)
+	require.Equal(t, types.CodeInvalidCustomData, result.Code)
+	require.False(t, setup.ModelinfoKeeper.IsModelInfoPresent(setup.Ctx, msgAddModelInfo.VID, msgAddModelInfo.PID))
+
+	// add model with custom data conforming to the schema
+	msgAddModelInfo.Custom = `{"color": "white"}`
+	result = setup.Handler(setup.Ctx, msgAddModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedModelInfo := queryModelInfo(setup, msgAddModelInfo.VID, msgAddModelInfo.PID)
+	require.Equal(t, msgAddModelInfo.Custom, receivedModelInfo.Custom)
+	require.Equal(t, customDataSchemaName, receivedModelInfo.CustomSchema)
+}
+
+func TestHandler_UpdateModelWithCustomSchema(t *testing.T) {
+	setup := Setup()
+
+	setup.ModelinfoKeeper.SetCustomDataSchema(setup.Ctx,
+		types.NewCustomDataSchema(customDataSchemaName, customDataSchema, testconstants.Address2))
+
+	// add model without custom data schema
+	msgAddModelInfo := TestMsgAddModelInfo(setup.Vendor)
+	result := setup.Handler(setup.Ctx, msgAddModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// reference the schema the existing custom data does not conform to
+	msgUpdateModelInfo := types.MsgUpdateModelInfo{
+		VID:          msgAddModelInfo.VID,
+		PID:          msgAddModelInfo.PID,
+		CustomSchema: customDataSchemaName,
+		Signer:       setup.Vendor,
+	}
+	result = setup.Handler(setup.Ctx, msgUpdateModelInfo)
+	require.Equal(t, types.CodeInvalidCustomData, result.Code)
+
+	// reference the schema together with the conforming custom data
+	msgUpdateModelInfo.Custom = `{"color": "black"}`
+	result = setup.Handler(setup.Ctx, msgUpdateModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// update custom data only: it is validated against the referenced schema
+	msgUpdateModelInfo = types.MsgUpdateModelInfo{
+		VID:              msgAddModelInfo.VID,
+		PID:              msgAddModelInfo.PID,
+		Custom:           `{"color": "red"}`,
+		Signer:           setup.Vendor,
+		ExpectedSequence: 1,
+	}
+	result = setup.Handler(setup.Ctx, msgUpdateModelInfo)
+	require.Equal(t, types.CodeInvalidCustomData, result.Code)
+
+	// unset the schema together with the update of the custom data
+	msgUpdateModelInfo.Unset = []string{types.ModelInfoFieldCustomSchema}
+	result = setup.Handler(setup.Ctx, msgUpdateModelInfo)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	receivedModelInfo := queryModelInfo(setup, msgAddModelInfo.VID, msgAddModelInfo.PID)
+	require.Equal(t, msgUpdateModelInfo.Custom, receivedModelInfo.Custom)
+	require.Equal(t, "", receivedModelInfo.CustomSchema)
 }
 
 func queryModelInfo(setup TestSetup, vid uint16, pid uint16) types.ModelInfo {
@@ -202,3 +675,7 @@ func queryModelInfo(setup TestSetup, vid uint16, pid uint16) types.ModelInfo {
 
 	return receivedModelInfo
 }
+
+func queryModelVersion(setup TestSetup, vid uint16, pid uint16, softwareVersion uint32) types.ModelVersion {
+	return setup.ModelinfoKeeper.GetModelVersion(setup.Ctx, vid, pid, softwareVersion)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/tendermint/go-amino"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -53,11 +54,17 @@ func Setup() TestSetup {
 	authKey := sdk.NewKVStoreKey(auth.StoreKey)
 	dbStore.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, nil)
 
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+	dbStore.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+
 	_ = dbStore.LoadLatestVersion()
 
 	// Init Keepers
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, paramsTKey, params.DefaultCodespace)
 	modelinfoKeeper := NewKeeper(modelinfoKey, cdc)
-	authKeeper := auth.NewKeeper(authKey, cdc)
+	authKeeper := auth.NewKeeper(authKey, paramsKeeper.Subspace(auth.DefaultParamspace), cdc)
 
 	// Create context
 	ctx := sdk.NewContext(dbStore, abci.Header{ChainID: testconstants.ChainID}, false, log.NewNopLogger())
@@ -67,6 +74,7 @@ func Setup() TestSetup {
 	handler := NewHandler(modelinfoKeeper, authKeeper)
 
 	account := auth.NewAccount(testconstants.Address1, testconstants.PubKey1, auth.AccountRoles{auth.Vendor})
+	account.VendorID = testconstants.VID
 	account.AccountNumber = authKeeper.GetNextAccountNumber(ctx)
 	authKeeper.SetAccount(ctx, account)
 
@@ -104,6 +112,8 @@ func TestMsgAddModelInfo(signer sdk.AccAddress) MsgAddModelInfo {
 }
 
 func TestMsgUpdateModelInfo(signer sdk.AccAddress) MsgUpdateModelInfo {
+	tisOrTrpTestingCompleted := !testconstants.TisOrTrpTestingCompleted
+
 	return MsgUpdateModelInfo{
 		VID:                      testconstants.VID,
 		PID:                      testconstants.PID,
@@ -111,7 +121,33 @@ func TestMsgUpdateModelInfo(signer sdk.AccAddress) MsgUpdateModelInfo {
 		Description:              "New Description",
 		OtaURL:                   "http://ota.firmware.com/new",
 		Custom:                   "New Custom Data",
-		TisOrTrpTestingCompleted: !testconstants.TisOrTrpTestingCompleted,
+		TisOrTrpTestingCompleted: &tisOrTrpTestingCompleted,
 		Signer:                   signer,
 	}
 }
+
+func TestMsgAddModelVersion(signer sdk.AccAddress) MsgAddModelVersion {
+	return MsgAddModelVersion{
+		VID:                          testconstants.VID,
+		PID:                          testconstants.PID,
+		SoftwareVersion:              testconstants.SoftwareVersion,
+		SoftwareVersionString:        testconstants.SoftwareVersionString,
+		FirmwareDigest:               testconstants.FirmwareDigest,
+		ReleaseNotesURL:              testconstants.ReleaseNotesURL,
+		MinApplicableSoftwareVersion: testconstants.MinApplicableSoftwareVersion,
+		MaxApplicableSoftwareVersion: testconstants.MaxApplicableSoftwareVersion,
+		Signer:                       signer,
+	}
+}
+
+func TestMsgUpdateModelVersion(signer sdk.AccAddress) MsgUpdateModelVersion {
+	return MsgUpdateModelVersion{
+		VID:                          testconstants.VID,
+		PID:                          testconstants.PID,
+		SoftwareVersion:              testconstants.SoftwareVersion,
+		ReleaseNotesURL:              "http://release.notes.com/new",
+		MinApplicableSoftwareVersion: testconstants.MinApplicableSoftwareVersion + 1,
+		MaxApplicableSoftwareVersion: testconstants.MaxApplicableSoftwareVersion + 1,
+		Signer:                       signer,
+	}
+}
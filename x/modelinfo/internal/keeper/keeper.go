@@ -15,8 +15,11 @@
 package keeper
 
 import (
+	"sort"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/internal/types"
 )
 
@@ -51,14 +54,29 @@ func (k Keeper) GetModelInfo(ctx sdk.Context, vid uint16, pid uint16) types.Mode
 // Sets the entire ModelInfo metadata struct for a ModelInfoID.
 func (k Keeper) SetModelInfo(ctx sdk.Context, model types.ModelInfo) {
 	store := ctx.KVStore(k.storeKey)
+
+	// Update the search index: the words of the previous model info must not be found anymore.
+	if k.IsModelInfoPresent(ctx, model.VID, model.PID) {
+		previous := k.GetModelInfo(ctx, model.VID, model.PID)
+
+		k.removeFromSearchIndex(ctx, previous)
+		store.Delete(types.GetModelNameIndexKey(previous.Name, previous.VID, previous.PID))
+	} else {
+		k.setModelAddedHeight(ctx, model.VID, model.PID, ctx.BlockHeight())
+	}
+
+	k.addToSearchIndex(ctx, model)
+	store.Set(types.GetModelNameIndexKey(model.Name, model.VID, model.PID), types.GetModelInfoKey(model.VID, model.PID))
+
 	store.Set(types.GetModelInfoKey(model.VID, model.PID), k.cdc.MustMarshalBinaryBare(model))
 
 	// Update the list of products associated with vendor.
 	product := types.Product{
-		PID:   model.PID,
-		Name:  model.Name,
-		SKU:   model.SKU,
-		Owner: model.Owner,
+		PID:      model.PID,
+		Name:     model.Name,
+		SKU:      model.SKU,
+		Owner:    model.Owner,
+		Archived: model.Archived,
 	}
 	k.AppendVendorProduct(ctx, model.VID, product)
 }
@@ -69,8 +87,17 @@ func (k Keeper) DeleteModelInfo(ctx sdk.Context, vid uint16, pid uint16) {
 		panic("ModelInfo does not exist")
 	}
 
+	model := k.GetModelInfo(ctx, vid, pid)
+	k.removeFromSearchIndex(ctx, model)
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetModelInfoKey(vid, pid))
+	store.Delete(types.GetModelNameIndexKey(model.Name, vid, pid))
+
+	if bz := store.Get(types.GetModelAddedHeightKey(vid, pid)); bz != nil {
+		store.Delete(types.GetModelAddedIndexKey(types.DecodeHeight(bz), vid, pid))
+		store.Delete(types.GetModelAddedHeightKey(vid, pid))
+	}
 
 	// Update the list of devices associated with vendor.
 	k.RemoveVendorProduct(ctx, vid, pid)
@@ -102,6 +129,42 @@ func (k Keeper) IterateModelInfos(ctx sdk.Context, process func(info types.Model
 	}
 }
 
+// Iterate over all ModelInfos in the requested order (by key if no sort field is requested).
+// The key passed along with the ModelInfo is the pagination key of the requested order.
+func (k Keeper) IterateModelInfosSorted(ctx sdk.Context, params pagination.PaginationParams,
+	process func(key []byte, info types.ModelInfo) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	prefix := types.ModelInfoPrefix
+
+	switch params.Sort {
+	case types.SortByName:
+		prefix = types.ModelNameIndexPrefix
+	case types.SortByDateAdded:
+		prefix = types.ModelAddedIndexPrefix
+	}
+
+	iter := pagination.Iterator(store, prefix, params)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		val := iter.Value()
+
+		// the index entries reference the model info keys
+		if len(params.Sort) != 0 {
+			val = store.Get(val)
+		}
+
+		var modelInfo types.ModelInfo
+
+		k.cdc.MustUnmarshalBinaryBare(val, &modelInfo)
+
+		if process(iter.Key(), modelInfo) {
+			return
+		}
+	}
+}
+
 func (k Keeper) CountTotalModelInfos(ctx sdk.Context) int {
 	return k.countTotal(ctx, types.ModelInfoPrefix)
 }
@@ -190,6 +253,349 @@ func (k Keeper) CountTotalVendorProducts(ctx sdk.Context) int {
 	return k.countTotal(ctx, types.VendorProductsPrefix)
 }
 
+// Gets the entire ModelVersion struct for a software version of a Model.
+func (k Keeper) GetModelVersion(ctx sdk.Context, vid uint16, pid uint16, softwareVersion uint32) types.ModelVersion {
+	if !k.IsModelVersionPresent(ctx, vid, pid, softwareVersion) {
+		panic("ModelVersion does not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetModelVersionKey(vid, pid, softwareVersion))
+
+	var modelVersion types.ModelVersion
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &modelVersion)
+
+	return modelVersion
+}
+
+// Sets the entire ModelVersion struct for a software version of a Model.
+func (k Keeper) SetModelVersion(ctx sdk.Context, modelVersion types.ModelVersion) {
+	store := ctx.KVStore(k.storeKey)
+
+	// the index entry of the previous digest is replaced
+	if k.IsModelVersionPresent(ctx, modelVersion.VID, modelVersion.PID, modelVersion.SoftwareVersion) {
+		previous := k.GetModelVersion(ctx, modelVersion.VID, modelVersion.PID, modelVersion.SoftwareVersion)
+		k.removeFromFirmwareDigestIndex(ctx, previous)
+	}
+
+	key := types.GetModelVersionKey(modelVersion.VID, modelVersion.PID, modelVersion.SoftwareVersion)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(modelVersion))
+
+	k.addToFirmwareDigestIndex(ctx, modelVersion)
+}
+
+// Check if the ModelVersion is present in the store or not.
+func (k Keeper) IsModelVersionPresent(ctx sdk.Context, vid uint16, pid uint16, softwareVersion uint32) bool {
+	return k.isRecordPresent(ctx, types.GetModelVersionKey(vid, pid, softwareVersion))
+}
+
+// Iterate over ModelVersions with the given key prefix (e.g. all versions of a single Model).
+func (k Keeper) IterateModelVersions(ctx sdk.Context, prefix []byte,
+	process func(modelVersion types.ModelVersion) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		val := iter.Value()
+
+		var modelVersion types.ModelVersion
+
+		k.cdc.MustUnmarshalBinaryBare(val, &modelVersion)
+
+		if process(modelVersion) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+// Iterate over ModelVersions with the given firmware digest.
+func (k Keeper) IterateModelVersionsByFirmwareDigest(ctx sdk.Context, digest string,
+	process func(modelVersion types.ModelVersion) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetFirmwareDigestIndexPrefix(digest))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var modelVersion types.ModelVersion
+
+		// the index entries reference the model version keys
+		k.cdc.MustUnmarshalBinaryBare(store.Get(iter.Value()), &modelVersion)
+
+		if process(modelVersion) {
+			return
+		}
+	}
+}
+
+// Gets the entire CustomDataSchema struct for a name.
+func (k Keeper) GetCustomDataSchema(ctx sdk.Context, name string) types.CustomDataSchema {
+	if !k.IsCustomDataSchemaPresent(ctx, name) {
+		panic("CustomDataSchema does not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCustomDataSchemaKey(name))
+
+	var customDataSchema types.CustomDataSchema
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &customDataSchema)
+
+	return customDataSchema
+}
+
+// Sets the entire CustomDataSchema struct for a name.
+func (k Keeper) SetCustomDataSchema(ctx sdk.Context, customDataSchema types.CustomDataSchema) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCustomDataSchemaKey(customDataSchema.Name), k.cdc.MustMarshalBinaryBare(customDataSchema))
+}
+
+// Check if the CustomDataSchema is present in the store or not.
+func (k Keeper) IsCustomDataSchemaPresent(ctx sdk.Context, name string) bool {
+	return k.isRecordPresent(ctx, types.GetCustomDataSchemaKey(name))
+}
+
+// Iterate over all CustomDataSchemas.
+func (k Keeper) IterateCustomDataSchemas(ctx sdk.Context,
+	process func(customDataSchema types.CustomDataSchema) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.CustomDataSchemaPrefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		val := iter.Value()
+
+		var customDataSchema types.CustomDataSchema
+
+		k.cdc.MustUnmarshalBinaryBare(val, &customDataSchema)
+
+		if process(customDataSchema) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+func (k Keeper) CountTotalCustomDataSchemas(ctx sdk.Context) int {
+	return k.countTotal(ctx, types.CustomDataSchemaPrefix)
+}
+
+// Gets the keys of ModelInfos containing all the words of the query (as words or word prefixes)
+// in their names, descriptions or SKUs. Keys are sorted in ascending order.
+func (k Keeper) SearchModelInfos(ctx sdk.Context, query string) [][]byte {
+	tokens := types.Tokenize(query)
+	if len(tokens) == 0 {
+		return [][]byte{}
+	}
+
+	var found map[string]bool
+
+	for _, token := range tokens {
+		matched := k.searchToken(ctx, token)
+
+		if found != nil {
+			for key := range found {
+				if !matched[key] {
+					delete(found, key)
+				}
+			}
+		} else {
+			found = matched
+		}
+
+		if len(found) == 0 {
+			return [][]byte{}
+		}
+	}
+
+	keys := make([]string, 0, len(found))
+	for key := range found {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	res := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, []byte(key))
+	}
+
+	return res
+}
+
+// Rebuilds the search index from all the stored ModelInfos.
+func (k Keeper) RebuildSearchIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	var staleKeys [][]byte
+
+	iter := sdk.KVStorePrefixIterator(store, types.SearchIndexPrefix)
+	for ; iter.Valid(); iter.Next() {
+		staleKeys = append(staleKeys, iter.Key())
+	}
+
+	iter.Close()
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+
+	k.IterateModelInfos(ctx, func(modelInfo types.ModelInfo) (stop bool) {
+		k.addToSearchIndex(ctx, modelInfo)
+
+		return false
+	})
+}
+
+// Rebuilds the indexes sorting ModelInfos by name and by added height.
+// The ModelInfos added before the indexes were introduced are considered added at zero height.
+func (k Keeper) RebuildSortIndexes(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	var staleKeys [][]byte
+
+	iter := sdk.KVStorePrefixIterator(store, types.ModelNameIndexPrefix)
+	for ; iter.Valid(); iter.Next() {
+		staleKeys = append(staleKeys, iter.Key())
+	}
+
+	iter.Close()
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+
+	k.IterateModelInfos(ctx, func(modelInfo types.ModelInfo) (stop bool) {
+		store.Set(types.GetModelNameIndexKey(modelInfo.Name, modelInfo.VID, modelInfo.PID),
+			types.GetModelInfoKey(modelInfo.VID, modelInfo.PID))
+
+		if !store.Has(types.GetModelAddedHeightKey(modelInfo.VID, modelInfo.PID)) {
+			k.setModelAddedHeight(ctx, modelInfo.VID, modelInfo.PID, 0)
+		}
+
+		return false
+	})
+}
+
+// Rebuilds the index of ModelVersions by firmware digest.
+func (k Keeper) RebuildFirmwareDigestIndex(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	var staleKeys [][]byte
+
+	iter := sdk.KVStorePrefixIterator(store, types.FirmwareDigestPrefix)
+	for ; iter.Valid(); iter.Next() {
+		staleKeys = append(staleKeys, iter.Key())
+	}
+
+	iter.Close()
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+
+	k.IterateModelVersions(ctx, types.ModelVersionPrefix, func(modelVersion types.ModelVersion) (stop bool) {
+		k.addToFirmwareDigestIndex(ctx, modelVersion)
+
+		return false
+	})
+}
+
+func (k Keeper) addToFirmwareDigestIndex(ctx sdk.Context, modelVersion types.ModelVersion) {
+	if len(modelVersion.FirmwareDigest) == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFirmwareDigestIndexKey(modelVersion.FirmwareDigest,
+		modelVersion.VID, modelVersion.PID, modelVersion.SoftwareVersion),
+		types.GetModelVersionKey(modelVersion.VID, modelVersion.PID, modelVersion.SoftwareVersion))
+}
+
+func (k Keeper) removeFromFirmwareDigestIndex(ctx sdk.Context, modelVersion types.ModelVersion) {
+	if len(modelVersion.FirmwareDigest) == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFirmwareDigestIndexKey(modelVersion.FirmwareDigest,
+		modelVersion.VID, modelVersion.PID, modelVersion.SoftwareVersion))
+}
+
+// Gets the height the ModelInfo was added at (0 if it is not recorded).
+func (k Keeper) GetModelAddedHeight(ctx sdk.Context, vid uint16, pid uint16) int64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetModelAddedHeightKey(vid, pid))
+	if bz == nil {
+		return 0
+	}
+
+	return types.DecodeHeight(bz)
+}
+
+// Sets the height the ModelInfo was added at replacing the recorded one (e.g. restored from genesis).
+func (k Keeper) SetModelAddedHeight(ctx sdk.Context, vid uint16, pid uint16, height int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	if bz := store.Get(types.GetModelAddedHeightKey(vid, pid)); bz != nil {
+		store.Delete(types.GetModelAddedIndexKey(types.DecodeHeight(bz), vid, pid))
+	}
+
+	k.setModelAddedHeight(ctx, vid, pid, height)
+}
+
+func (k Keeper) setModelAddedHeight(ctx sdk.Context, vid uint16, pid uint16, height int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	store.Set(types.GetModelAddedHeightKey(vid, pid), types.EncodeHeight(height))
+	store.Set(types.GetModelAddedIndexKey(height, vid, pid), types.GetModelInfoKey(vid, pid))
+}
+
+// Gets the keys of ModelInfos having a word starting with the token.
+func (k Keeper) searchToken(ctx sdk.Context, token string) map[string]bool {
+	store := ctx.KVStore(k.storeKey)
+	res := map[string]bool{}
+
+	iter := sdk.KVStorePrefixIterator(store, types.GetSearchIndexPrefix(token))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		res[string(types.GetModelInfoKeyFromSearchIndexKey(iter.Key()))] = true
+	}
+
+	return res
+}
+
+func (k Keeper) addToSearchIndex(ctx sdk.Context, model types.ModelInfo) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, token := range types.SearchTokens(model) {
+		store.Set(types.GetSearchIndexKey(token, model.VID, model.PID), []byte{1})
+	}
+}
+
+func (k Keeper) removeFromSearchIndex(ctx sdk.Context, model types.ModelInfo) {
+	store := ctx.KVStore(k.storeKey)
+
+	for _, token := range types.SearchTokens(model) {
+		store.Delete(types.GetSearchIndexKey(token, model.VID, model.PID))
+	}
+}
+
 // Check if the record is present in the store or not.
 func (k Keeper) isRecordPresent(ctx sdk.Context, id []byte) bool {
 	store := ctx.KVStore(k.storeKey)
@@ -205,3 +205,57 @@ func TestKeeper_VendorProductsIteratorOverDifferentVendors(t *testing.T) {
 	})
 	require.Equal(t, count, len(expectedRecords))
 }
+
+func TestKeeper_SearchIndexUpdatesWithModelInfo(t *testing.T) {
+	setup := Setup()
+
+	// add model
+	modelInfo := DefaultModelInfo()
+	modelInfo.Name = "Smart Light Bulb"
+	setup.ModelinfoKeeper.SetModelInfo(setup.Ctx, modelInfo)
+
+	key := types.GetModelInfoKey(modelInfo.VID, modelInfo.PID)
+
+	// search by name, description and SKU words and word prefixes
+	require.Equal(t, [][]byte{key}, setup.ModelinfoKeeper.SearchModelInfos(setup.Ctx, "light"))
+	require.Equal(t, [][]byte{key}, setup.ModelinfoKeeper.SearchModelInfos(setup.Ctx, "BULB smart"))
+	require.Equal(t, [][]byte{key}, setup.ModelinfoKeeper.SearchModelInfos(setup.Ctx, "descr"))
+	require.Equal(t, [][]byte{key}, setup.ModelinfoKeeper.SearchModelInfos(setup.Ctx, "rcu2205"))
+	require.Empty(t, setup.ModelinfoKeeper.SearchModelInfos(setup.Ctx, "light switch"))
+	require.Empty(t, setup.ModelinfoKeeper.SearchModelInfos(setup.Ctx, "ulb"))
+
+	// update model
+	modelInfo.Name = "Smart Switch"
+	setup.ModelinfoKeeper.SetModelInfo(setup.Ctx, modelInfo)
+
+	require.Empty(t, setup.ModelinfoKeeper.SearchModelInfos(setup.Ctx, "light"))
+	require.Equal(t, [][]byte{key}, setup.ModelinfoKeeper.SearchModelInfos(setup.Ctx, "switch"))
+
+	// delete model
+	setup.ModelinfoKeeper.DeleteModelInfo(setup.Ctx, modelInfo.VID, modelInfo.PID)
+
+	require.Empty(t, setup.ModelinfoKeeper.SearchModelInfos(setup.Ctx, "smart"))
+	require.Equal(t, 0, setup.ModelinfoKeeper.countTotal(setup.Ctx, types.SearchIndexPrefix))
+}
+
+func TestKeeper_RebuildSearchIndex(t *testing.T) {
+	setup := Setup()
+
+	// add models and drop their search index (as if they were added before it was introduced)
+	count := 5
+	PopulateStoreWithModelsHavingDifferentVendor(setup, count)
+
+	store := setup.Ctx.KVStore(setup.ModelinfoKeeper.storeKey)
+	for i := 1; i <= count; i++ {
+		for _, token := range types.SearchTokens(DefaultModelInfo()) {
+			store.Delete(types.GetSearchIndexKey(token, uint16(i), uint16(i)))
+		}
+	}
+
+	require.Empty(t, setup.ModelinfoKeeper.SearchModelInfos(setup.Ctx, testconstants.Name))
+
+	// rebuild
+	setup.ModelinfoKeeper.RebuildSearchIndex(setup.Ctx)
+
+	require.Equal(t, count, len(setup.ModelinfoKeeper.SearchModelInfos(setup.Ctx, testconstants.Name)))
+}
@@ -15,7 +15,9 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -26,10 +28,18 @@ import (
 )
 
 const (
-	QueryModel        = "model"
-	QueryAllModels    = "all_models"
-	QueryVendors      = "vendors"
-	QueryVendorModels = "vendor_models"
+	QueryModel           = "model"
+	QueryAllModels       = "all_models"
+	QueryVendors         = "vendors"
+	QueryVendorModels    = "vendor_models"
+	QueryAllVendorModels = "all_vendor_models"
+	QueryModelVersions   = "model_versions"
+	QuerySearchModels    = "search_models"
+
+	QueryModelVersionsByFirmwareDigest = "model_versions_by_firmware_digest"
+
+	QueryCustomDataSchema     = "custom_data_schema"
+	QueryAllCustomDataSchemas = "all_custom_data_schemas"
 )
 
 func NewQuerier(keeper Keeper) sdk.Querier {
@@ -43,6 +53,18 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryVendors(ctx, req, keeper)
 		case QueryVendorModels:
 			return queryVendorModels(ctx, path[1:], keeper)
+		case QueryAllVendorModels:
+			return queryAllVendorModels(ctx, path[1:], req, keeper)
+		case QueryModelVersions:
+			return queryModelVersions(ctx, path[1:], req, keeper)
+		case QuerySearchModels:
+			return querySearchModels(ctx, req, keeper)
+		case QueryModelVersionsByFirmwareDigest:
+			return queryModelVersionsByFirmwareDigest(ctx, path[1:], keeper)
+		case QueryCustomDataSchema:
+			return queryCustomDataSchema(ctx, path[1:], keeper)
+		case QueryAllCustomDataSchemas:
+			return queryAllCustomDataSchemas(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown modelinfo query endpoint")
 		}
@@ -72,40 +94,45 @@ func queryModel(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 }
 
 func queryAllModels(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
-	var params pagination.PaginationParams
+	var params types.ListModelsQueryParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
 	}
 
+	paginator, err := pagination.NewPaginator(ctx, params.PaginationParams(), types.ModelSortFields...)
+	if err != nil {
+		return nil, err
+	}
+
 	result := types.ListModelInfoItems{
-		Total: keeper.CountTotalModelInfos(ctx),
 		Items: []types.ModelInfoItem{},
 	}
 
-	skipped := 0
-
-	keeper.IterateModelInfos(ctx, func(modelInfo types.ModelInfo) (stop bool) {
-		if skipped < params.Skip {
-			skipped++
+	if params.IncludeArchived {
+		result.Total = keeper.CountTotalModelInfos(ctx)
+	}
 
-			return false
-		}
-		if len(result.Items) < params.Take || params.Take == 0 {
-			item := types.ModelInfoItem{
-				VID:   modelInfo.VID,
-				PID:   modelInfo.PID,
-				Name:  modelInfo.Name,
-				SKU:   modelInfo.SKU,
-				Owner: modelInfo.Owner,
+	process := func(key []byte, modelInfo types.ModelInfo) (stop bool) {
+		// archived models are skipped by default, so all of them have to be iterated to count the total
+		if !params.IncludeArchived {
+			if modelInfo.Archived {
+				return false
 			}
 
-			result.Items = append(result.Items, item)
+			result.Total++
+		}
 
-			return false
+		if paginator.Add(key) {
+			result.Items = append(result.Items, newModelInfoItem(modelInfo))
 		}
 
-		return true
-	})
+		return params.IncludeArchived && paginator.Done()
+	}
+
+	keeper.IterateModelInfosSorted(ctx, params.PaginationParams(), process)
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
 
 	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
 
@@ -118,33 +145,31 @@ func queryVendors(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
 	}
 
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
 	result := types.ListVendorItems{
 		Total: keeper.CountTotalVendorProducts(ctx),
 		Items: []types.VendorItem{},
 	}
 
-	skipped := 0
-
 	keeper.IterateVendorProducts(ctx, func(vendorProducts types.VendorProducts) (stop bool) {
-		if skipped < params.Skip {
-			skipped++
-
-			return false
-		}
-
-		if len(result.Items) < params.Take || params.Take == 0 {
+		if paginator.Add(types.GetVendorProductsKey(vendorProducts.VID)) {
 			item := types.VendorItem{
 				VID: vendorProducts.VID,
 			}
 
 			result.Items = append(result.Items, item)
-
-			return false
 		}
 
-		return true
+		return paginator.Done()
 	})
 
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
 	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
 
 	return res, nil
@@ -166,3 +191,254 @@ func queryVendorModels(ctx sdk.Context, path []string, keeper Keeper) (res []byt
 
 	return res, nil
 }
+
+func queryAllVendorModels(ctx sdk.Context, path []string, req abci.RequestQuery,
+	keeper Keeper) (res []byte, err sdk.Error) {
+	vid, err := conversions.ParseVID(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var params types.ListModelsQueryParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	paginator, err := pagination.NewPaginator(ctx, params.PaginationParams())
+	if err != nil {
+		return nil, err
+	}
+
+	if !keeper.IsVendorProductsPresent(ctx, vid) {
+		return nil, types.ErrVendorProductsDoNotExist(vid)
+	}
+
+	// vendor products index contains all the data needed for the list items so model infos are not read
+	vendorProducts := keeper.GetVendorProducts(ctx, vid)
+
+	// products are stored in the order they were added, but pagination keys must be iterated in ascending order
+	products := make([]types.Product, 0, len(vendorProducts.Products))
+
+	for _, product := range vendorProducts.Products {
+		if params.IncludeArchived || !product.Archived {
+			products = append(products, product)
+		}
+	}
+
+	sort.Slice(products, func(i, j int) bool {
+		left := types.GetModelInfoKey(vid, products[i].PID)
+		right := types.GetModelInfoKey(vid, products[j].PID)
+
+		return bytes.Compare(left, right) < 0
+	})
+
+	result := types.ListModelInfoItems{
+		Total: len(products),
+		Items: []types.ModelInfoItem{},
+	}
+
+	for _, product := range products {
+		if paginator.Add(types.GetModelInfoKey(vid, product.PID)) {
+			item := types.ModelInfoItem{
+				VID:      vid,
+				PID:      product.PID,
+				Name:     product.Name,
+				SKU:      product.SKU,
+				Owner:    product.Owner,
+				Archived: product.Archived,
+			}
+
+			result.Items = append(result.Items, item)
+		}
+
+		if paginator.Done() {
+			break
+		}
+	}
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func queryModelVersions(ctx sdk.Context, path []string, req abci.RequestQuery,
+	keeper Keeper) (res []byte, err sdk.Error) {
+	vid, err := conversions.ParseVID(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := conversions.ParsePID(path[1])
+	if err != nil {
+		return nil, err
+	}
+
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	if !keeper.IsModelInfoPresent(ctx, vid, pid) {
+		return nil, types.ErrModelInfoDoesNotExist(vid, pid)
+	}
+
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := types.GetModelVersionsPrefix(vid, pid)
+
+	result := types.ListModelVersions{
+		Total: keeper.countTotal(ctx, prefix),
+		Items: []types.ModelVersion{},
+	}
+
+	keeper.IterateModelVersions(ctx, prefix, func(modelVersion types.ModelVersion) (stop bool) {
+		if paginator.Add(types.GetModelVersionKey(vid, pid, modelVersion.SoftwareVersion)) {
+			result.Items = append(result.Items, modelVersion)
+		}
+
+		return paginator.Done()
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+// Returns all the model versions with the firmware digest (an empty list if there are no such versions).
+func queryModelVersionsByFirmwareDigest(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	if len(path) == 0 || len(path[0]) == 0 {
+		return nil, sdk.ErrUnknownRequest("Invalid FirmwareDigest: it cannot be empty")
+	}
+
+	result := types.ListModelVersions{
+		Total: 0,
+		Items: []types.ModelVersion{},
+	}
+
+	keeper.IterateModelVersionsByFirmwareDigest(ctx, path[0], func(modelVersion types.ModelVersion) (stop bool) {
+		result.Total++
+		result.Items = append(result.Items, modelVersion)
+
+		return false
+	})
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func queryCustomDataSchema(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	name := path[0]
+
+	if !keeper.IsCustomDataSchemaPresent(ctx, name) {
+		return nil, types.ErrCustomDataSchemaDoesNotExist(name)
+	}
+
+	customDataSchema := keeper.GetCustomDataSchema(ctx, name)
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, customDataSchema)
+
+	return res, nil
+}
+
+func queryAllCustomDataSchemas(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	result := types.ListCustomDataSchemas{
+		Total: keeper.CountTotalCustomDataSchemas(ctx),
+		Items: []types.CustomDataSchema{},
+	}
+
+	keeper.IterateCustomDataSchemas(ctx, func(customDataSchema types.CustomDataSchema) (stop bool) {
+		if paginator.Add(types.GetCustomDataSchemaKey(customDataSchema.Name)) {
+			result.Items = append(result.Items, customDataSchema)
+		}
+
+		return paginator.Done()
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func querySearchModels(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params types.SearchQueryParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	tokens := types.Tokenize(params.Query)
+	if len(tokens) == 0 {
+		return nil, sdk.ErrUnknownRequest("Invalid Query: it must contain at least one word")
+	}
+
+	if len(tokens) > types.MaxSearchQueryTokens {
+		return nil, sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid Query: it must contain at most %v words", types.MaxSearchQueryTokens))
+	}
+
+	paginator, err := pagination.NewPaginator(ctx, params.PaginationParams())
+	if err != nil {
+		return nil, err
+	}
+
+	keys := keeper.SearchModelInfos(ctx, params.Query)
+
+	result := types.ListModelInfoItems{
+		Items: []types.ModelInfoItem{},
+	}
+
+	for _, key := range keys {
+		vid, pid := types.ParseModelInfoKey(key)
+		modelInfo := keeper.GetModelInfo(ctx, vid, pid)
+
+		if modelInfo.Archived && !params.IncludeArchived {
+			continue
+		}
+
+		result.Total++
+
+		if paginator.Add(key) {
+			result.Items = append(result.Items, newModelInfoItem(modelInfo))
+		}
+	}
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func newModelInfoItem(modelInfo types.ModelInfo) types.ModelInfoItem {
+	return types.ModelInfoItem{
+		VID:      modelInfo.VID,
+		PID:      modelInfo.PID,
+		Name:     modelInfo.Name,
+		SKU:      modelInfo.SKU,
+		Owner:    modelInfo.Owner,
+		Archived: modelInfo.Archived,
+	}
+}
@@ -17,8 +17,10 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
@@ -120,6 +122,230 @@ func TestQuerier_QueryAllModelsWithPaginationHeaders(t *testing.T) {
 	}
 }
 
+func TestQuerier_QueryAllModelsWithPaginationKeys(t *testing.T) {
+	setup := Setup()
+	count := 5
+
+	// add 5 models
+	firstID := PopulateStoreWithModelsHavingDifferentVendor(setup, count)
+
+	// query first page take=2
+	take := 2
+	firstPage := getModels(setup, pagination.NewPaginationParams(0, take))
+
+	// check
+	require.Equal(t, count, firstPage.Total)
+	require.Equal(t, take, len(firstPage.Items))
+	require.Equal(t, firstID, firstPage.Items[0].VID)
+	require.NotEmpty(t, firstPage.NextKey)
+	require.Empty(t, firstPage.PrevKey)
+
+	// add model preceding the page to ensure that pages are stable
+	modelInfo := DefaultModelInfo()
+	modelInfo.VID = firstID - 1
+	modelInfo.PID = firstID - 1
+	setup.ModelinfoKeeper.SetModelInfo(setup.Ctx, modelInfo)
+
+	// query next page
+	secondPage := getModels(setup, pagination.NewCursorPaginationParams(firstPage.NextKey, take))
+
+	// check
+	require.Equal(t, count+1, secondPage.Total)
+	require.Equal(t, take, len(secondPage.Items))
+
+	for i, item := range secondPage.Items {
+		require.Equal(t, uint16(take)+uint16(i)+firstID, item.VID)
+	}
+
+	require.NotEmpty(t, secondPage.NextKey)
+	require.NotEmpty(t, secondPage.PrevKey)
+
+	// query previous page
+	prevPage := getModels(setup, pagination.NewCursorPaginationParams(secondPage.PrevKey, take))
+
+	// check
+	require.Equal(t, take, len(prevPage.Items))
+	require.Equal(t, firstID, prevPage.Items[0].VID)
+	require.Equal(t, firstID+1, prevPage.Items[1].VID)
+
+	// model added before the first page is available through the previous page cursor
+	require.NotEmpty(t, prevPage.PrevKey)
+
+	// query last page
+	lastPage := getModels(setup, pagination.NewCursorPaginationParams(secondPage.NextKey, take))
+
+	// check
+	require.Equal(t, 1, len(lastPage.Items))
+	require.Equal(t, uint16(count), lastPage.Items[0].VID)
+	require.Empty(t, lastPage.NextKey)
+}
+
+func TestQuerier_QueryAllModelsWithInvalidPaginationKey(t *testing.T) {
+	setup := Setup()
+
+	// query with invalid key
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryAllModels},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(pagination.NewCursorPaginationParams("invalid", 1))},
+	)
+
+	// check
+	require.Nil(t, result)
+	require.NotNil(t, err)
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+}
+
+func TestQuerier_QueryAllModelsSortedByName(t *testing.T) {
+	setup := Setup()
+
+	// add models which names are not in the order of their keys
+	names := []string{"Charlie", "alpha", "Bravo", "delta"}
+	modelInfo := DefaultModelInfo()
+
+	for i, name := range names {
+		modelInfo.VID = uint16(i + 1)
+		modelInfo.PID = uint16(i + 1)
+		modelInfo.Name = name
+		setup.ModelinfoKeeper.SetModelInfo(setup.Ctx, modelInfo)
+	}
+
+	// the index follows the name update
+	modelInfo = setup.ModelinfoKeeper.GetModelInfo(setup.Ctx, 4, 4)
+	modelInfo.Name = "Echo"
+	setup.ModelinfoKeeper.SetModelInfo(setup.Ctx, modelInfo)
+
+	// query all models sorted by name (case-insensitive)
+	params := pagination.NewPaginationParams(0, 0)
+	params.Sort = types.SortByName
+	receiveModelInfos := getModels(setup, params)
+
+	// check
+	require.Equal(t, len(names), receiveModelInfos.Total)
+	require.Equal(t, []string{"alpha", "Bravo", "Charlie", "Echo"}, modelNames(receiveModelInfos))
+
+	// query the first page in the descending order
+	params = pagination.NewPaginationParams(0, 2)
+	params.Sort = types.SortByName
+	params.Order = pagination.OrderDesc
+	firstPage := getModels(setup, params)
+
+	// check
+	require.Equal(t, []string{"Echo", "Charlie"}, modelNames(firstPage))
+	require.NotEmpty(t, firstPage.NextKey)
+
+	// query the next page
+	params = pagination.NewCursorPaginationParams(firstPage.NextKey, 2)
+	params.Sort = types.SortByName
+	params.Order = pagination.OrderDesc
+	secondPage := getModels(setup, params)
+
+	// check
+	require.Equal(t, []string{"Bravo", "alpha"}, modelNames(secondPage))
+	require.Empty(t, secondPage.NextKey)
+}
+
+func TestQuerier_QueryAllModelsSortedByDateAdded(t *testing.T) {
+	setup := Setup()
+
+	// add models at the heights which are not in the order of their keys
+	heights := []int64{3, 1, 2}
+	modelInfo := DefaultModelInfo()
+
+	for i, height := range heights {
+		modelInfo.VID = uint16(i + 1)
+		modelInfo.PID = uint16(i + 1)
+		setup.ModelinfoKeeper.SetModelInfo(setup.Ctx.WithBlockHeight(height), modelInfo)
+	}
+
+	// the update does not change the date the model was added
+	modelInfo = setup.ModelinfoKeeper.GetModelInfo(setup.Ctx, 2, 2)
+	setup.ModelinfoKeeper.SetModelInfo(setup.Ctx.WithBlockHeight(4), modelInfo)
+
+	// query all models sorted by date added
+	params := pagination.NewPaginationParams(0, 0)
+	params.Sort = types.SortByDateAdded
+	receiveModelInfos := getModels(setup, params)
+
+	// check
+	require.Equal(t, []uint16{2, 3, 1}, modelVIDs(receiveModelInfos))
+
+	// query the latest models
+	params.Order = pagination.OrderDesc
+	receiveModelInfos = getModels(setup, params)
+
+	// check
+	require.Equal(t, []uint16{1, 3, 2}, modelVIDs(receiveModelInfos))
+
+	// the deleted model is removed from the index
+	setup.ModelinfoKeeper.DeleteModelInfo(setup.Ctx, 3, 3)
+	receiveModelInfos = getModels(setup, params)
+
+	// check
+	require.Equal(t, []uint16{1, 2}, modelVIDs(receiveModelInfos))
+}
+
+func TestQuerier_QueryAllModelsWithInvalidSort(t *testing.T) {
+	setup := Setup()
+
+	for _, params := range []pagination.PaginationParams{
+		{Sort: "unknown"},
+		{Sort: types.SortByName, Order: "random"},
+	} {
+		// query with invalid sort
+		result, err := setup.Querier(
+			setup.Ctx,
+			[]string{QueryAllModels},
+			abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)},
+		)
+
+		// check
+		require.Nil(t, result)
+		require.NotNil(t, err)
+		require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+	}
+}
+
+func TestKeeper_RebuildSortIndexes(t *testing.T) {
+	setup := Setup()
+
+	// add models and drop the indexes as if the models were added before the indexes were introduced
+	PopulateStoreWithModelsHavingDifferentVendor(setup, 3)
+
+	store := setup.Ctx.KVStore(setup.ModelinfoKeeper.storeKey)
+
+	for _, prefix := range [][]byte{types.ModelNameIndexPrefix, types.ModelAddedIndexPrefix,
+		types.ModelAddedHeightPrefix} {
+		var keys [][]byte
+
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+
+		iter.Close()
+
+		require.Equal(t, 3, len(keys))
+
+		for _, key := range keys {
+			store.Delete(key)
+		}
+	}
+
+	setup.ModelinfoKeeper.RebuildSortIndexes(setup.Ctx)
+
+	// query all models sorted by name and by date added
+	for _, sort := range types.ModelSortFields {
+		params := pagination.NewPaginationParams(0, 0)
+		params.Sort = sort
+		params.Order = pagination.OrderDesc
+		receiveModelInfos := getModels(setup, params)
+
+		// check
+		require.Equal(t, []uint16{3, 2, 1}, modelVIDs(receiveModelInfos))
+	}
+}
+
 func TestQuerier_QueryVendorsForModelsHaveDifferentVendors(t *testing.T) {
 	setup := Setup()
 
@@ -202,6 +428,276 @@ func TestQuerier_QueryVendorModels(t *testing.T) {
 	}
 }
 
+func TestQuerier_QueryAllVendorModels(t *testing.T) {
+	setup := Setup()
+	count := 5
+
+	// add 5 models with same vendor
+	firstID := PopulateStoreWithModelsHavingSameVendor(setup, count)
+
+	// add model of another vendor
+	modelInfo := DefaultModelInfo()
+	modelInfo.VID = firstID + 1
+	modelInfo.PID = firstID
+	setup.ModelinfoKeeper.SetModelInfo(setup.Ctx, modelInfo)
+
+	// query vendor models skip=1 take=2
+	skip := 1
+	take := 2
+	receivedModelInfos := getAllVendorModels(setup, firstID, pagination.NewPaginationParams(skip, take))
+
+	// check
+	require.Equal(t, count, receivedModelInfos.Total)
+	require.Equal(t, take, len(receivedModelInfos.Items))
+
+	for i, item := range receivedModelInfos.Items {
+		require.Equal(t, firstID, item.VID)
+		require.Equal(t, uint16(skip)+uint16(i)+firstID, item.PID)
+	}
+
+	// query the next page
+	nextPage := getAllVendorModels(setup, firstID, pagination.NewCursorPaginationParams(receivedModelInfos.NextKey, take))
+
+	// check
+	require.Equal(t, take, len(nextPage.Items))
+	require.Equal(t, uint16(skip+take)+firstID, nextPage.Items[0].PID)
+}
+
+func TestQuerier_QueryAllVendorModelsForUnknown(t *testing.T) {
+	setup := Setup()
+
+	// query vendor models
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryAllVendorModels, fmt.Sprintf("%v", testconstants.VID)},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(pagination.NewPaginationParams(0, 0))},
+	)
+
+	// check
+	require.Nil(t, result)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeVendorProductsDoNotExist, err.Code())
+}
+
+func TestQuerier_QueryModelVersions(t *testing.T) {
+	setup := Setup()
+
+	// model versions cannot be queried for unknown model
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryModelVersions, fmt.Sprintf("%v", testconstants.VID), fmt.Sprintf("%v", testconstants.PID)},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(pagination.NewPaginationParams(0, 0))},
+	)
+	require.Nil(t, result)
+	require.Equal(t, types.CodeModelInfoDoesNotExist, err.Code())
+
+	// add model and its versions in reverse order
+	modelInfo := DefaultModelInfo()
+	setup.ModelinfoKeeper.SetModelInfo(setup.Ctx, modelInfo)
+
+	count := 5
+	for i := count; i > 0; i-- {
+		setup.ModelinfoKeeper.SetModelVersion(setup.Ctx, types.NewModelVersion(modelInfo.VID, modelInfo.PID,
+			uint32(i), fmt.Sprintf("%v.0", i), "", "", 0, 0))
+	}
+
+	// add version of another model
+	setup.ModelinfoKeeper.SetModelVersion(setup.Ctx, types.NewModelVersion(modelInfo.VID, modelInfo.PID+1,
+		1, "1.0", "", "", 0, 0))
+
+	// query model versions skip=1 take=2
+	skip := 1
+	take := 2
+	receivedVersions := getModelVersions(setup, modelInfo.VID, modelInfo.PID, pagination.NewPaginationParams(skip, take))
+
+	// check versions are returned in ascending order
+	require.Equal(t, count, receivedVersions.Total)
+	require.Equal(t, take, len(receivedVersions.Items))
+
+	for i, item := range receivedVersions.Items {
+		require.Equal(t, modelInfo.PID, item.PID)
+		require.Equal(t, uint32(skip+i+1), item.SoftwareVersion)
+	}
+}
+
+func TestQuerier_QueryModelVersionsByFirmwareDigest(t *testing.T) {
+	setup := Setup()
+
+	// add versions of two models with the same firmware and a version with another firmware
+	setup.ModelinfoKeeper.SetModelVersion(setup.Ctx, types.NewModelVersion(testconstants.VID, testconstants.PID,
+		1, "1.0", testconstants.FirmwareDigest, "", 0, 0))
+	setup.ModelinfoKeeper.SetModelVersion(setup.Ctx, types.NewModelVersion(testconstants.VID, testconstants.PID+1,
+		1, "1.0", strings.ToUpper(testconstants.FirmwareDigest), "", 0, 0))
+	setup.ModelinfoKeeper.SetModelVersion(setup.Ctx, types.NewModelVersion(testconstants.VID, testconstants.PID,
+		2, "2.0", "another", "", 0, 0))
+
+	// hex digests are found regardless of the case
+	receivedVersions := getModelVersionsByFirmwareDigest(setup, strings.ToUpper(testconstants.FirmwareDigest))
+	require.Equal(t, 2, receivedVersions.Total)
+	require.Equal(t, testconstants.PID, receivedVersions.Items[0].PID)
+	require.Equal(t, testconstants.PID+1, receivedVersions.Items[1].PID)
+
+	// other digests are case-sensitive
+	require.Equal(t, 1, getModelVersionsByFirmwareDigest(setup, "another").Total)
+	require.Equal(t, 0, getModelVersionsByFirmwareDigest(setup, "ANOTHER").Total)
+
+	// the digest of the updated version is re-indexed
+	setup.ModelinfoKeeper.SetModelVersion(setup.Ctx, types.NewModelVersion(testconstants.VID, testconstants.PID,
+		1, "1.0", "another", "", 0, 0))
+	require.Equal(t, 1, getModelVersionsByFirmwareDigest(setup, testconstants.FirmwareDigest).Total)
+	require.Equal(t, 2, getModelVersionsByFirmwareDigest(setup, "another").Total)
+
+	// the index is rebuilt from the model versions
+	setup.ModelinfoKeeper.RebuildFirmwareDigestIndex(setup.Ctx)
+	require.Equal(t, 1, getModelVersionsByFirmwareDigest(setup, testconstants.FirmwareDigest).Total)
+	require.Equal(t, 2, getModelVersionsByFirmwareDigest(setup, "another").Total)
+
+	// unknown digest
+	receivedVersions = getModelVersionsByFirmwareDigest(setup, "unknown")
+	require.Equal(t, 0, receivedVersions.Total)
+	require.Empty(t, receivedVersions.Items)
+
+	// empty digest
+	result, err := setup.Querier(setup.Ctx, []string{QueryModelVersionsByFirmwareDigest, ""}, abci.RequestQuery{})
+	require.Nil(t, result)
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+}
+
+func TestQuerier_QuerySearchModels(t *testing.T) {
+	setup := Setup()
+
+	// add models: {VID: 1..count, PID: 1..count} having default name and one more with a different name
+	count := 5
+	PopulateStoreWithModelsHavingDifferentVendor(setup, count)
+
+	modelInfo := DefaultModelInfo()
+	modelInfo.Name = "Door Lock"
+	setup.ModelinfoKeeper.SetModelInfo(setup.Ctx, modelInfo)
+
+	// search
+	receivedModelInfos := searchModels(setup, types.NewSearchQueryParams("name", 0, 0))
+	require.Equal(t, count, receivedModelInfos.Total)
+	require.Equal(t, count, len(receivedModelInfos.Items))
+
+	for i, item := range receivedModelInfos.Items {
+		require.Equal(t, uint16(i+1), item.VID)
+		require.Equal(t, testconstants.Name, item.Name)
+	}
+
+	receivedModelInfos = searchModels(setup, types.NewSearchQueryParams("lock DEV", 0, 0))
+	require.Equal(t, 1, receivedModelInfos.Total)
+	require.Equal(t, modelInfo.Name, receivedModelInfos.Items[0].Name)
+
+	receivedModelInfos = searchModels(setup, types.NewSearchQueryParams("unknown", 0, 0))
+	require.Equal(t, 0, receivedModelInfos.Total)
+	require.Empty(t, receivedModelInfos.Items)
+
+	// search with pagination
+	skip, take := 1, 2
+	receivedModelInfos = searchModels(setup, types.NewSearchQueryParams("name", skip, take))
+	require.Equal(t, count, receivedModelInfos.Total)
+	require.Equal(t, take, len(receivedModelInfos.Items))
+	require.Equal(t, uint16(skip+1), receivedModelInfos.Items[0].VID)
+
+	params := types.NewSearchQueryParams("name", 0, take)
+	params.Key = receivedModelInfos.NextKey
+	receivedModelInfos = searchModels(setup, params)
+	require.Equal(t, take, len(receivedModelInfos.Items))
+	require.Equal(t, uint16(skip+take+1), receivedModelInfos.Items[0].VID)
+}
+
+func TestQuerier_QuerySearchModelsForEmptyQuery(t *testing.T) {
+	setup := Setup()
+
+	setup.ModelinfoKeeper.SetModelInfo(setup.Ctx, DefaultModelInfo())
+
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QuerySearchModels},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(types.NewSearchQueryParams(" - ", 0, 0))},
+	)
+
+	require.Nil(t, result)
+	require.NotNil(t, err)
+	require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+}
+
+func TestQuerier_QueryModelsForArchivedModels(t *testing.T) {
+	setup := Setup()
+
+	// add models: {VID: 1, PID: 1..count} and archive the second one
+	count := 3
+	firstID := PopulateStoreWithModelsHavingSameVendor(setup, count)
+
+	archivedModelInfo := setup.ModelinfoKeeper.GetModelInfo(setup.Ctx, firstID, firstID+1)
+	archivedModelInfo.Archived = true
+	setup.ModelinfoKeeper.SetModelInfo(setup.Ctx, archivedModelInfo)
+
+	queryList := func(path []string, params interface{}) types.ListModelInfoItems {
+		result, err := setup.Querier(setup.Ctx, path, abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)})
+		require.Nil(t, err)
+
+		var receivedModelInfos types.ListModelInfoItems
+		_ = setup.Cdc.UnmarshalJSON(result, &receivedModelInfos)
+
+		return receivedModelInfos
+	}
+
+	paths := [][]string{
+		{QueryAllModels},
+		{QueryAllVendorModels, fmt.Sprintf("%v", firstID)},
+	}
+
+	all := pagination.NewPaginationParams(0, 0)
+
+	for _, path := range paths {
+		// archived model is excluded by default
+		receivedModelInfos := queryList(path, types.NewListModelsQueryParams(all, false))
+		require.Equal(t, count-1, receivedModelInfos.Total)
+		require.Equal(t, count-1, len(receivedModelInfos.Items))
+		require.Equal(t, firstID, receivedModelInfos.Items[0].PID)
+		require.Equal(t, firstID+2, receivedModelInfos.Items[1].PID)
+
+		// archived model is included on demand
+		receivedModelInfos = queryList(path, types.NewListModelsQueryParams(all, true))
+		require.Equal(t, count, receivedModelInfos.Total)
+		require.Equal(t, count, len(receivedModelInfos.Items))
+		require.True(t, receivedModelInfos.Items[1].Archived)
+	}
+
+	// search
+	params := types.NewSearchQueryParams("name", 0, 0)
+	receivedModelInfos := queryList([]string{QuerySearchModels}, params)
+	require.Equal(t, count-1, receivedModelInfos.Total)
+	require.Equal(t, count-1, len(receivedModelInfos.Items))
+
+	params.IncludeArchived = true
+	receivedModelInfos = queryList([]string{QuerySearchModels}, params)
+	require.Equal(t, count, receivedModelInfos.Total)
+	require.Equal(t, count, len(receivedModelInfos.Items))
+
+	// archived model itself is still returned
+	require.True(t, setup.ModelinfoKeeper.GetModelInfo(setup.Ctx, firstID, firstID+1).Archived)
+}
+
+func modelNames(models types.ListModelInfoItems) []string {
+	names := make([]string, 0, len(models.Items))
+	for _, item := range models.Items {
+		names = append(names, item.Name)
+	}
+
+	return names
+}
+
+func modelVIDs(models types.ListModelInfoItems) []uint16 {
+	vids := make([]uint16, 0, len(models.Items))
+	for _, item := range models.Items {
+		vids = append(vids, item.VID)
+	}
+
+	return vids
+}
+
 func getModels(setup TestSetup, params pagination.PaginationParams) types.ListModelInfoItems {
 	result, _ := setup.Querier(
 		setup.Ctx,
@@ -215,6 +711,19 @@ func getModels(setup TestSetup, params pagination.PaginationParams) types.ListMo
 	return receiveModelInfos
 }
 
+func searchModels(setup TestSetup, params types.SearchQueryParams) types.ListModelInfoItems {
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{QuerySearchModels},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)},
+	)
+
+	var receiveModelInfos types.ListModelInfoItems
+	_ = setup.Cdc.UnmarshalJSON(result, &receiveModelInfos)
+
+	return receiveModelInfos
+}
+
 func getVendors(setup TestSetup, params pagination.PaginationParams) types.ListVendorItems {
 	result, _ := setup.Querier(
 		setup.Ctx,
@@ -228,6 +737,19 @@ func getVendors(setup TestSetup, params pagination.PaginationParams) types.ListV
 	return receiveModelInfos
 }
 
+func getAllVendorModels(setup TestSetup, vid uint16, params pagination.PaginationParams) types.ListModelInfoItems {
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{QueryAllVendorModels, fmt.Sprintf("%v", vid)},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)},
+	)
+
+	var receivedModelInfos types.ListModelInfoItems
+	_ = setup.Cdc.UnmarshalJSON(result, &receivedModelInfos)
+
+	return receivedModelInfos
+}
+
 func getVendorModels(setup TestSetup, vid uint16) types.VendorProducts {
 	result, _ := setup.Querier(
 		setup.Ctx,
@@ -240,3 +762,30 @@ func getVendorModels(setup TestSetup, vid uint16) types.VendorProducts {
 
 	return receivedVendorModels
 }
+
+func getModelVersions(setup TestSetup, vid uint16, pid uint16,
+	params pagination.PaginationParams) types.ListModelVersions {
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{QueryModelVersions, fmt.Sprintf("%v", vid), fmt.Sprintf("%v", pid)},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)},
+	)
+
+	var receivedVersions types.ListModelVersions
+	_ = setup.Cdc.UnmarshalJSON(result, &receivedVersions)
+
+	return receivedVersions
+}
+
+func getModelVersionsByFirmwareDigest(setup TestSetup, digest string) types.ListModelVersions {
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{QueryModelVersionsByFirmwareDigest, digest},
+		abci.RequestQuery{},
+	)
+
+	var receivedVersions types.ListModelVersions
+	_ = setup.Cdc.UnmarshalJSON(result, &receivedVersions)
+
+	return receivedVersions
+}
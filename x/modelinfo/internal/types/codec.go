@@ -30,4 +30,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgAddModelInfo{}, ModuleName+"/AddModelInfo", nil)
 	cdc.RegisterConcrete(MsgUpdateModelInfo{}, ModuleName+"/UpdateModelInfo", nil)
 	cdc.RegisterConcrete(MsgDeleteModelInfo{}, ModuleName+"/DeleteModelInfo", nil)
+	cdc.RegisterConcrete(MsgArchiveModel{}, ModuleName+"/ArchiveModel", nil)
+	cdc.RegisterConcrete(MsgUnarchiveModel{}, ModuleName+"/UnarchiveModel", nil)
+	cdc.RegisterConcrete(MsgAddModelVersion{}, ModuleName+"/AddModelVersion", nil)
+	cdc.RegisterConcrete(MsgUpdateModelVersion{}, ModuleName+"/UpdateModelVersion", nil)
+	cdc.RegisterConcrete(MsgSetCustomDataSchema{}, ModuleName+"/SetCustomDataSchema", nil)
 }
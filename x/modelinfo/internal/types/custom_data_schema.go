@@ -0,0 +1,88 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/jsonschema"
+)
+
+const (
+	MaxCustomDataSchemaNameLength = 64
+	MaxCustomDataSchemaSize       = 16384
+)
+
+// Names are used in the store keys and REST paths.
+var customDataSchemaNameRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// Custom Data Schema stored in KVStore: the JSON schema the custom data of the models referencing it
+// (by `custom_schema` field) must conform to.
+type CustomDataSchema struct {
+	Name   string         `json:"name"`
+	Schema string         `json:"schema"` // JSON schema (see utils/jsonschema for the supported keywords)
+	Owner  sdk.AccAddress `json:"owner"`  // the trustee who has set the current version of the schema
+}
+
+func NewCustomDataSchema(name string, schema string, owner sdk.AccAddress) CustomDataSchema {
+	return CustomDataSchema{
+		Name:   name,
+		Schema: schema,
+		Owner:  owner,
+	}
+}
+
+func (d CustomDataSchema) String() string {
+	bytes, err := json.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+// Validates the custom data of a model against the schema.
+func (d CustomDataSchema) ValidateCustomData(custom string) error {
+	schema, err := jsonschema.Parse([]byte(d.Schema))
+	if err != nil {
+		return err
+	}
+
+	return schema.Validate([]byte(custom))
+}
+
+func ValidateCustomDataSchemaName(name string) sdk.Error {
+	if len(name) == 0 || len(name) > MaxCustomDataSchemaNameLength || !customDataSchemaNameRegexp.MatchString(name) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid CustomSchema: \"%v\" must be non-empty string "+
+			"of at most %v letters, digits, dots, underscores and hyphens", name, MaxCustomDataSchemaNameLength))
+	}
+
+	return nil
+}
+
+func ValidateCustomDataSchema(schema string) sdk.Error {
+	if err := validateMaxLength("Schema", schema, MaxCustomDataSchemaSize); err != nil {
+		return err
+	}
+
+	if _, err := jsonschema.Parse([]byte(schema)); err != nil {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Schema: %v", err))
+	}
+
+	return nil
+}
@@ -18,17 +18,39 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
 )
 
 const (
 	Codespace sdk.CodespaceType = ModuleName
 
-	CodeModelInfoAlreadyExists   sdk.CodeType = 501
-	CodeModelInfoDoesNotExist    sdk.CodeType = 502
-	CodeOtaURLCannotBeSet        sdk.CodeType = 503
-	CodeVendorProductsDoNotExist sdk.CodeType = 504
+	CodeModelInfoAlreadyExists       sdk.CodeType = 501
+	CodeModelInfoDoesNotExist        sdk.CodeType = 502
+	CodeOtaURLCannotBeSet            sdk.CodeType = 503
+	CodeVendorProductsDoNotExist     sdk.CodeType = 504
+	CodeModelVersionAlreadyExists    sdk.CodeType = 505
+	CodeModelVersionDoesNotExist     sdk.CodeType = 506
+	CodeModelInfoArchived            sdk.CodeType = 507
+	CodeModelInfoNotArchived         sdk.CodeType = 508
+	CodeSequenceConflict             sdk.CodeType = 509
+	CodeCustomDataSchemaDoesNotExist sdk.CodeType = 510
+	CodeInvalidCustomData            sdk.CodeType = 511
 )
 
+func init() {
+	errcodes.Register(Codespace, CodeModelInfoAlreadyExists, "model_info_already_exists")
+	errcodes.Register(Codespace, CodeModelInfoDoesNotExist, "model_info_does_not_exist")
+	errcodes.Register(Codespace, CodeOtaURLCannotBeSet, "ota_url_cannot_be_set")
+	errcodes.Register(Codespace, CodeVendorProductsDoNotExist, "vendor_products_do_not_exist")
+	errcodes.Register(Codespace, CodeModelVersionAlreadyExists, "model_version_already_exists")
+	errcodes.Register(Codespace, CodeModelVersionDoesNotExist, "model_version_does_not_exist")
+	errcodes.Register(Codespace, CodeModelInfoArchived, "model_info_archived")
+	errcodes.Register(Codespace, CodeModelInfoNotArchived, "model_info_not_archived")
+	errcodes.Register(Codespace, CodeSequenceConflict, "sequence_conflict")
+	errcodes.Register(Codespace, CodeCustomDataSchemaDoesNotExist, "custom_data_schema_does_not_exist")
+	errcodes.Register(Codespace, CodeInvalidCustomData, "invalid_custom_data")
+}
+
 func ErrModelInfoAlreadyExists(vid interface{}, pid interface{}) sdk.Error {
 	return sdk.NewError(Codespace, CodeModelInfoAlreadyExists,
 		fmt.Sprintf("Model info associated with vid=%v and pid=%v already exists on the ledger", vid, pid))
@@ -49,3 +71,42 @@ func ErrVendorProductsDoNotExist(vid interface{}) sdk.Error {
 	return sdk.NewError(Codespace, CodeVendorProductsDoNotExist,
 		fmt.Sprintf("No vendor products associated with vid=%v exist on the ledger", vid))
 }
+
+func ErrModelVersionAlreadyExists(vid interface{}, pid interface{}, softwareVersion interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeModelVersionAlreadyExists,
+		fmt.Sprintf("Model version associated with vid=%v, pid=%v and softwareVersion=%v "+
+			"already exists on the ledger", vid, pid, softwareVersion))
+}
+
+func ErrModelVersionDoesNotExist(vid interface{}, pid interface{}, softwareVersion interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeModelVersionDoesNotExist,
+		fmt.Sprintf("No model version associated with vid=%v, pid=%v and softwareVersion=%v "+
+			"exist on the ledger", vid, pid, softwareVersion))
+}
+
+func ErrModelInfoArchived(vid interface{}, pid interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeModelInfoArchived,
+		fmt.Sprintf("Model info associated with vid=%v and pid=%v is already archived", vid, pid))
+}
+
+func ErrModelInfoNotArchived(vid interface{}, pid interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeModelInfoNotArchived,
+		fmt.Sprintf("Model info associated with vid=%v and pid=%v is not archived", vid, pid))
+}
+
+func ErrSequenceConflict(vid interface{}, pid interface{}, expected interface{}, actual interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeSequenceConflict,
+		fmt.Sprintf("Model info associated with vid=%v and pid=%v has been changed concurrently: "+
+			"expected sequence=%v, but stored sequence=%v", vid, pid, expected, actual))
+}
+
+func ErrCustomDataSchemaDoesNotExist(name interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeCustomDataSchemaDoesNotExist,
+		fmt.Sprintf("No custom data schema with name=%v exists on the ledger", name))
+}
+
+func ErrInvalidCustomData(vid interface{}, pid interface{}, schema interface{}, error interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeInvalidCustomData,
+		fmt.Sprintf("Custom data of model info associated with vid=%v and pid=%v does not conform "+
+			"to custom data schema %v: %v", vid, pid, schema, error))
+}
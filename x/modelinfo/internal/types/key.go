@@ -16,6 +16,7 @@ package types
 
 import (
 	"encoding/binary"
+	"strings"
 )
 
 const (
@@ -27,8 +28,15 @@ const (
 )
 
 var (
-	ModelInfoPrefix      = []byte{0x01} // prefix for each key to a model info
-	VendorProductsPrefix = []byte{0x02} // prefix for each key to a vendor products
+	ModelInfoPrefix        = []byte{0x01} // prefix for each key to a model info
+	VendorProductsPrefix   = []byte{0x02} // prefix for each key to a vendor products
+	ModelVersionPrefix     = []byte{0x03} // prefix for each key to a model version
+	SearchIndexPrefix      = []byte{0x04} // prefix for each key to a search index entry
+	CustomDataSchemaPrefix = []byte{0x05} // prefix for each key to a custom data schema
+	ModelNameIndexPrefix   = []byte{0x06} // prefix for each key to a model info index entry ordered by name
+	ModelAddedIndexPrefix  = []byte{0x07} // prefix for each key to a model info index entry ordered by added height
+	ModelAddedHeightPrefix = []byte{0x08} // prefix for each key to the height a model info was added at
+	FirmwareDigestPrefix   = []byte{0x09} // prefix for each key to a model version index entry by firmware digest
 )
 
 // Key builder for Model Info.
@@ -42,6 +50,13 @@ func GetModelInfoKey(vid uint16, pid uint16) []byte {
 	return append(ModelInfoPrefix, append(v, p...)...)
 }
 
+// Parses Vendor ID and Product ID of Model Info key.
+func ParseModelInfoKey(key []byte) (vid uint16, pid uint16) {
+	key = key[len(ModelInfoPrefix):]
+
+	return binary.LittleEndian.Uint16(key[:2]), binary.LittleEndian.Uint16(key[2:4])
+}
+
 // Key builder for Vendor Products.
 func GetVendorProductsKey(vid uint16) []byte {
 	b := make([]byte, 2)
@@ -49,3 +64,92 @@ func GetVendorProductsKey(vid uint16) []byte {
 
 	return append(VendorProductsPrefix, b...)
 }
+
+// Key builder for Model Version.
+// Software version is encoded in big endian so that versions of a model are iterated in ascending order.
+func GetModelVersionKey(vid uint16, pid uint16, softwareVersion uint32) []byte {
+	s := make([]byte, 4)
+	binary.BigEndian.PutUint32(s, softwareVersion)
+
+	return append(GetModelVersionsPrefix(vid, pid), s...)
+}
+
+// Key prefix for all Versions of a Model.
+func GetModelVersionsPrefix(vid uint16, pid uint16) []byte {
+	v := make([]byte, 2)
+	binary.LittleEndian.PutUint16(v, vid)
+
+	p := make([]byte, 2)
+	binary.LittleEndian.PutUint16(p, pid)
+
+	return append(ModelVersionPrefix, append(v, p...)...)
+}
+
+// Key builder for Search Index entry: the token followed by zero byte and the model info key (without prefix).
+func GetSearchIndexKey(token string, vid uint16, pid uint16) []byte {
+	key := append(GetSearchIndexPrefix(token), 0x00)
+
+	return append(key, GetModelInfoKey(vid, pid)[len(ModelInfoPrefix):]...)
+}
+
+// Key prefix for Search Index entries of all the tokens starting with the given one.
+func GetSearchIndexPrefix(token string) []byte {
+	return append(SearchIndexPrefix, []byte(token)...)
+}
+
+// Model info key of Search Index entry.
+func GetModelInfoKeyFromSearchIndexKey(key []byte) []byte {
+	return append(ModelInfoPrefix, key[len(key)-4:]...)
+}
+
+// Key builder for Custom Data Schema.
+func GetCustomDataSchemaKey(name string) []byte {
+	return append(CustomDataSchemaPrefix, []byte(name)...)
+}
+
+// Key builder for Model Name Index entry: the lower-cased name followed by zero byte and the model info key
+// (without prefix), so the entries are iterated in the order of the names.
+func GetModelNameIndexKey(name string, vid uint16, pid uint16) []byte {
+	key := append(append([]byte{}, ModelNameIndexPrefix...), []byte(strings.ToLower(name))...)
+	key = append(key, 0x00)
+
+	return append(key, GetModelInfoKey(vid, pid)[len(ModelInfoPrefix):]...)
+}
+
+// Key builder for Model Added Index entry: the height the model info was added at (big-endian encoded)
+// followed by the model info key (without prefix), so the entries are iterated in the order of addition.
+func GetModelAddedIndexKey(height int64, vid uint16, pid uint16) []byte {
+	key := append(append([]byte{}, ModelAddedIndexPrefix...), EncodeHeight(height)...)
+
+	return append(key, GetModelInfoKey(vid, pid)[len(ModelInfoPrefix):]...)
+}
+
+// Key builder for the height a Model Info was added at.
+func GetModelAddedHeightKey(vid uint16, pid uint16) []byte {
+	return append(append([]byte{}, ModelAddedHeightPrefix...), GetModelInfoKey(vid, pid)[len(ModelInfoPrefix):]...)
+}
+
+// Key builder for Firmware Digest Index entry: the normalized digest followed by zero byte and
+// the model version key (without prefix).
+func GetFirmwareDigestIndexKey(digest string, vid uint16, pid uint16, softwareVersion uint32) []byte {
+	return append(GetFirmwareDigestIndexPrefix(digest),
+		GetModelVersionKey(vid, pid, softwareVersion)[len(ModelVersionPrefix):]...)
+}
+
+// Key prefix for Firmware Digest Index entries of all the model versions with the digest.
+func GetFirmwareDigestIndexPrefix(digest string) []byte {
+	key := append(append([]byte{}, FirmwareDigestPrefix...), []byte(NormalizeFirmwareDigest(digest))...)
+
+	return append(key, 0x00)
+}
+
+func EncodeHeight(height int64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+
+	return bz
+}
+
+func DecodeHeight(bz []byte) int64 {
+	return int64(binary.BigEndian.Uint64(bz))
+}
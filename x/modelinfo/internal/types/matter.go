@@ -0,0 +1,125 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+	"net/url"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The commissioning flows of Matter devices.
+const (
+	CommissioningFlowStandard           uint8 = 0
+	CommissioningFlowUserActionRequired uint8 = 1
+	CommissioningFlowCustom             uint8 = 2
+)
+
+// The limits of the Matter DCL schema.
+const (
+	MaxProductLabelLength                  = 256
+	MaxPartNumberLength                    = 32
+	MaxCommissioningStepsInstructionLength = 1024
+	MaxMatterURLLength                     = 256
+)
+
+// Fields of the model defined by the Matter DCL schema (absent for the models of non-Matter devices).
+type MatterModelInfo struct {
+	DeviceTypeID                               uint32 `json:"device_type_id"`
+	ProductLabel                               string `json:"product_label,omitempty"`
+	PartNumber                                 string `json:"part_number,omitempty"`
+	CommissioningCustomFlow                    uint8  `json:"commissioning_custom_flow"`
+	CommissioningCustomFlowURL                 string `json:"commissioning_custom_flow_url,omitempty"`
+	CommissioningModeInitialStepsHint          uint32 `json:"commissioning_mode_initial_steps_hint,omitempty"`
+	CommissioningModeInitialStepsInstruction   string `json:"commissioning_mode_initial_steps_instruction,omitempty"`
+	CommissioningModeSecondaryStepsHint        uint32 `json:"commissioning_mode_secondary_steps_hint,omitempty"`
+	CommissioningModeSecondaryStepsInstruction string `json:"commissioning_mode_secondary_steps_instruction,omitempty"`
+	UserManualURL                              string `json:"user_manual_url,omitempty"`
+	SupportURL                                 string `json:"support_url,omitempty"`
+	ProductURL                                 string `json:"product_url,omitempty"`
+}
+
+func (m MatterModelInfo) Validate() sdk.Error {
+	if m.CommissioningCustomFlow > CommissioningFlowCustom {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid CommissioningCustomFlow: %v. It must be one of "+
+			"%v (standard), %v (user action required) or %v (custom)", m.CommissioningCustomFlow,
+			CommissioningFlowStandard, CommissioningFlowUserActionRequired, CommissioningFlowCustom))
+	}
+
+	// the custom flow is described on the page of the vendor
+	if m.CommissioningCustomFlow == CommissioningFlowCustom && len(m.CommissioningCustomFlowURL) == 0 {
+		return sdk.ErrUnknownRequest("Invalid CommissioningCustomFlowURL: it cannot be empty " +
+			"if the commissioning flow is custom")
+	}
+
+	if err := validateMaxLength("ProductLabel", m.ProductLabel, MaxProductLabelLength); err != nil {
+		return err
+	}
+
+	if err := validateMaxLength("PartNumber", m.PartNumber, MaxPartNumberLength); err != nil {
+		return err
+	}
+
+	if err := validateMaxLength("CommissioningModeInitialStepsInstruction",
+		m.CommissioningModeInitialStepsInstruction, MaxCommissioningStepsInstructionLength); err != nil {
+		return err
+	}
+
+	if err := validateMaxLength("CommissioningModeSecondaryStepsInstruction",
+		m.CommissioningModeSecondaryStepsInstruction, MaxCommissioningStepsInstructionLength); err != nil {
+		return err
+	}
+
+	for _, field := range [][2]string{
+		{"CommissioningCustomFlowURL", m.CommissioningCustomFlowURL},
+		{"UserManualURL", m.UserManualURL},
+		{"SupportURL", m.SupportURL},
+		{"ProductURL", m.ProductURL},
+	} {
+		if err := validateMatterURL(field[0], field[1]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateMaxLength(name string, value string, maxLength int) sdk.Error {
+	if len(value) > maxLength {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid %v: it must be at most %v bytes long", name, maxLength))
+	}
+
+	return nil
+}
+
+// The URLs of the Matter DCL schema are optional absolute https URLs.
+func validateMatterURL(name string, rawURL string) sdk.Error {
+	if len(rawURL) == 0 {
+		return nil
+	}
+
+	if err := validateMaxLength(name, rawURL, MaxMatterURLLength); err != nil {
+		return err
+	}
+
+	parsedURL, err := url.ParseRequestURI(rawURL)
+	if err != nil || parsedURL.Scheme != "https" || len(parsedURL.Host) == 0 {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid %v: \"%s\". It must be an absolute https URL",
+			name, rawURL))
+	}
+
+	return nil
+}
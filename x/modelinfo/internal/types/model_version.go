@@ -0,0 +1,88 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Model Version stored in KVStore.
+type ModelVersion struct {
+	VID                          uint16 `json:"vid"`
+	PID                          uint16 `json:"pid"`
+	SoftwareVersion              uint32 `json:"software_version"`
+	SoftwareVersionString        string `json:"software_version_string"`
+	FirmwareDigest               string `json:"firmware_digest,omitempty"`
+	ReleaseNotesURL              string `json:"release_notes_url,omitempty"`
+	MinApplicableSoftwareVersion uint32 `json:"min_applicable_software_version"`
+	MaxApplicableSoftwareVersion uint32 `json:"max_applicable_software_version"`
+}
+
+func NewModelVersion(
+	vid uint16,
+	pid uint16,
+	softwareVersion uint32,
+	softwareVersionString string,
+	firmwareDigest string,
+	releaseNotesURL string,
+	minApplicableSoftwareVersion uint32,
+	maxApplicableSoftwareVersion uint32,
+) ModelVersion {
+	return ModelVersion{
+		VID:                          vid,
+		PID:                          pid,
+		SoftwareVersion:              softwareVersion,
+		SoftwareVersionString:        softwareVersionString,
+		FirmwareDigest:               firmwareDigest,
+		ReleaseNotesURL:              releaseNotesURL,
+		MinApplicableSoftwareVersion: minApplicableSoftwareVersion,
+		MaxApplicableSoftwareVersion: maxApplicableSoftwareVersion,
+	}
+}
+
+func (d ModelVersion) String() string {
+	bytes, err := json.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+// Checks that the range of software versions the model version can be applied to is not inverted.
+// Zero maximum means that the range is not limited from above.
+func ValidateApplicableSoftwareVersions(min uint32, max uint32) sdk.Error {
+	if max != 0 && min > max {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid applicable software versions: "+
+			"MinApplicableSoftwareVersion (%v) must not be greater than MaxApplicableSoftwareVersion (%v)", min, max))
+	}
+
+	return nil
+}
+
+// Returns the firmware digest in the form it is indexed in: the hex encoded digests are lower-cased
+// so that they are found regardless of the case, other encodings (e.g. base64) are case-sensitive.
+func NormalizeFirmwareDigest(digest string) string {
+	if _, err := hex.DecodeString(digest); err == nil {
+		return strings.ToLower(digest)
+	}
+
+	return digest
+}
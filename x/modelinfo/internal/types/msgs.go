@@ -15,28 +15,46 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const RouterKey = ModuleName
 
+// The limits of the model fields (in bytes) protecting the nodes from oversized transactions.
+const (
+	MaxNameLength            = 128
+	MaxDescriptionLength     = 1024
+	MaxSKULength             = 64
+	MaxVersionLength         = 64 // of the model, hardware, firmware and software versions
+	MaxOtaURLLength          = 256
+	MaxOtaChecksumLength     = 128
+	MaxOtaChecksumTypeLength = 32
+	MaxCustomLength          = 4096
+	MaxFirmwareDigestLength  = 128
+	MaxReleaseNotesURLLength = 256
+)
+
 //nolint:maligned
 type MsgAddModelInfo struct {
-	VID                      uint16         `json:"vid"`
-	PID                      uint16         `json:"pid"`
-	CID                      uint16         `json:"cid,omitempty"`
-	Version                  string         `json:"version,omitempty"`
-	Name                     string         `json:"name"`
-	Description              string         `json:"description"`
-	SKU                      string         `json:"sku"`
-	HardwareVersion          string         `json:"hardware_version"`
-	FirmwareVersion          string         `json:"firmware_version"`
-	OtaURL                   string         `json:"ota_url,omitempty"`
-	OtaChecksum              string         `json:"ota_checksum,omitempty"`
-	OtaChecksumType          string         `json:"ota_checksum_type,omitempty"`
-	Custom                   string         `json:"custom,omitempty"`
-	TisOrTrpTestingCompleted bool           `json:"tis_or_trp_testing_completed"`
-	Signer                   sdk.AccAddress `json:"signer"`
+	VID                      uint16           `json:"vid"`
+	PID                      uint16           `json:"pid"`
+	CID                      uint16           `json:"cid,omitempty"`
+	Version                  string           `json:"version,omitempty"`
+	Name                     string           `json:"name"`
+	Description              string           `json:"description"`
+	SKU                      string           `json:"sku"`
+	HardwareVersion          string           `json:"hardware_version"`
+	FirmwareVersion          string           `json:"firmware_version"`
+	OtaURL                   string           `json:"ota_url,omitempty"`
+	OtaChecksum              string           `json:"ota_checksum,omitempty"`
+	OtaChecksumType          string           `json:"ota_checksum_type,omitempty"`
+	Custom                   string           `json:"custom,omitempty"`
+	TisOrTrpTestingCompleted bool             `json:"tis_or_trp_testing_completed"`
+	Signer                   sdk.AccAddress   `json:"signer"`
+	CustomSchema             string           `json:"custom_schema,omitempty"`
+	Matter                   *MatterModelInfo `json:"matter,omitempty"`
 }
 
 func NewMsgAddModelInfo(
@@ -116,6 +134,21 @@ func (m MsgAddModelInfo) ValidateBasic() sdk.Error {
 		return sdk.ErrUnknownRequest("Invalid FirmwareVersion: it cannot be empty")
 	}
 
+	if err := validateMaxLengths([]limitedField{
+		{"Version", m.Version, MaxVersionLength},
+		{"Name", m.Name, MaxNameLength},
+		{"Description", m.Description, MaxDescriptionLength},
+		{"SKU", m.SKU, MaxSKULength},
+		{"HardwareVersion", m.HardwareVersion, MaxVersionLength},
+		{"FirmwareVersion", m.FirmwareVersion, MaxVersionLength},
+		{"OtaURL", m.OtaURL, MaxOtaURLLength},
+		{"OtaChecksum", m.OtaChecksum, MaxOtaChecksumLength},
+		{"OtaChecksumType", m.OtaChecksumType, MaxOtaChecksumTypeLength},
+		{"Custom", m.Custom, MaxCustomLength},
+	}); err != nil {
+		return err
+	}
+
 	if m.OtaURL != "" || m.OtaChecksum != "" || m.OtaChecksumType != "" {
 		if m.OtaURL == "" || m.OtaChecksum == "" || m.OtaChecksumType == "" {
 			return sdk.ErrUnknownRequest("Invalid MsgAddModelInfo: the fields OtaURL, OtaChecksum and " +
@@ -123,6 +156,18 @@ func (m MsgAddModelInfo) ValidateBasic() sdk.Error {
 		}
 	}
 
+	if m.CustomSchema != "" {
+		if err := ValidateCustomDataSchemaName(m.CustomSchema); err != nil {
+			return err
+		}
+	}
+
+	if m.Matter != nil {
+		if err := m.Matter.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -134,16 +179,36 @@ func (m MsgAddModelInfo) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Signer}
 }
 
+// Optional fields of ModelInfo which can be unset by MsgUpdateModelInfo.
+const (
+	ModelInfoFieldCID          = "cid"
+	ModelInfoFieldCustom       = "custom"
+	ModelInfoFieldCustomSchema = "custom_schema"
+	ModelInfoFieldMatter       = "matter"
+)
+
+var unsettableModelInfoFields = []string{
+	ModelInfoFieldCID, ModelInfoFieldCustom, ModelInfoFieldCustomSchema, ModelInfoFieldMatter,
+}
+
+// MsgUpdateModelInfo contains only the fields to be changed: empty (nil) fields remain the same,
+// and the fields listed in Unset are cleared.
+// It is rejected if the sequence of the stored model info differs from ExpectedSequence.
+//
 //nolint:maligned
 type MsgUpdateModelInfo struct {
-	VID                      uint16         `json:"vid"`
-	PID                      uint16         `json:"pid"`
-	CID                      uint16         `json:"cid,omitempty"`
-	Description              string         `json:"description,omitempty"`
-	OtaURL                   string         `json:"ota_url,omitempty"`
-	Custom                   string         `json:"custom,omitempty"`
-	TisOrTrpTestingCompleted bool           `json:"tis_or_trp_testing_completed"`
-	Signer                   sdk.AccAddress `json:"signer"`
+	VID                      uint16           `json:"vid"`
+	PID                      uint16           `json:"pid"`
+	CID                      uint16           `json:"cid,omitempty"`
+	Description              string           `json:"description,omitempty"`
+	OtaURL                   string           `json:"ota_url,omitempty"`
+	Custom                   string           `json:"custom,omitempty"`
+	TisOrTrpTestingCompleted *bool            `json:"tis_or_trp_testing_completed,omitempty"`
+	Signer                   sdk.AccAddress   `json:"signer"`
+	Unset                    []string         `json:"unset,omitempty"`
+	ExpectedSequence         uint64           `json:"expected_sequence"`
+	CustomSchema             string           `json:"custom_schema,omitempty"`
+	Matter                   *MatterModelInfo `json:"matter,omitempty"` // replaces all the Matter fields if set
 }
 
 func NewMsgUpdateModelInfo(
@@ -163,7 +228,7 @@ func NewMsgUpdateModelInfo(
 		Description:              description,
 		OtaURL:                   otaURL,
 		Custom:                   custom,
-		TisOrTrpTestingCompleted: tisOrTrpTestingCompleted,
+		TisOrTrpTestingCompleted: &tisOrTrpTestingCompleted,
 		Signer:                   signer,
 	}
 }
@@ -189,6 +254,52 @@ func (m MsgUpdateModelInfo) ValidateBasic() sdk.Error {
 		return sdk.ErrUnknownRequest("Invalid PID: it must be non-zero 16-bit unsigned integer")
 	}
 
+	if err := validateMaxLengths([]limitedField{
+		{"Description", m.Description, MaxDescriptionLength},
+		{"OtaURL", m.OtaURL, MaxOtaURLLength},
+		{"Custom", m.Custom, MaxCustomLength},
+	}); err != nil {
+		return err
+	}
+
+	if m.CustomSchema != "" {
+		if err := ValidateCustomDataSchemaName(m.CustomSchema); err != nil {
+			return err
+		}
+	}
+
+	if m.Matter != nil {
+		if err := m.Matter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if len(m.Unset) > len(unsettableModelInfoFields) {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Unset: it must contain at most %v fields",
+			len(unsettableModelInfoFields)))
+	}
+
+	for _, field := range m.Unset {
+		var isSet bool
+
+		switch field {
+		case ModelInfoFieldCID:
+			isSet = m.CID != 0
+		case ModelInfoFieldCustom:
+			isSet = m.Custom != ""
+		case ModelInfoFieldCustomSchema:
+			isSet = m.CustomSchema != ""
+		case ModelInfoFieldMatter:
+			isSet = m.Matter != nil
+		default:
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Unset: field %v cannot be unset", field))
+		}
+
+		if isSet {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Unset: field %v cannot be both set and unset", field))
+		}
+	}
+
 	return nil
 }
 
@@ -245,3 +356,295 @@ func (m MsgDeleteModelInfo) GetSignBytes() []byte {
 func (m MsgDeleteModelInfo) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Signer}
 }
+
+type MsgArchiveModel struct {
+	VID    uint16         `json:"vid"`
+	PID    uint16         `json:"pid"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgArchiveModel(vid uint16, pid uint16, signer sdk.AccAddress) MsgArchiveModel {
+	return MsgArchiveModel{
+		VID:    vid,
+		PID:    pid,
+		Signer: signer,
+	}
+}
+
+func (m MsgArchiveModel) Route() string {
+	return RouterKey
+}
+
+func (m MsgArchiveModel) Type() string {
+	return "archive_model"
+}
+
+func (m MsgArchiveModel) ValidateBasic() sdk.Error {
+	return validateModelArchivalMsg(m.VID, m.PID, m.Signer)
+}
+
+func (m MsgArchiveModel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgArchiveModel) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+type MsgUnarchiveModel struct {
+	VID    uint16         `json:"vid"`
+	PID    uint16         `json:"pid"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgUnarchiveModel(vid uint16, pid uint16, signer sdk.AccAddress) MsgUnarchiveModel {
+	return MsgUnarchiveModel{
+		VID:    vid,
+		PID:    pid,
+		Signer: signer,
+	}
+}
+
+func (m MsgUnarchiveModel) Route() string {
+	return RouterKey
+}
+
+func (m MsgUnarchiveModel) Type() string {
+	return "unarchive_model"
+}
+
+func (m MsgUnarchiveModel) ValidateBasic() sdk.Error {
+	return validateModelArchivalMsg(m.VID, m.PID, m.Signer)
+}
+
+func (m MsgUnarchiveModel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgUnarchiveModel) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func validateModelArchivalMsg(vid uint16, pid uint16, signer sdk.AccAddress) sdk.Error {
+	if signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if vid == 0 {
+		return sdk.ErrUnknownRequest("Invalid VID: it must be non-zero 16-bit unsigned integer")
+	}
+
+	if pid == 0 {
+		return sdk.ErrUnknownRequest("Invalid PID: it must be non-zero 16-bit unsigned integer")
+	}
+
+	return nil
+}
+
+type MsgAddModelVersion struct {
+	VID                          uint16         `json:"vid"`
+	PID                          uint16         `json:"pid"`
+	SoftwareVersion              uint32         `json:"software_version"`
+	SoftwareVersionString        string         `json:"software_version_string"`
+	FirmwareDigest               string         `json:"firmware_digest,omitempty"`
+	ReleaseNotesURL              string         `json:"release_notes_url,omitempty"`
+	MinApplicableSoftwareVersion uint32         `json:"min_applicable_software_version"`
+	MaxApplicableSoftwareVersion uint32         `json:"max_applicable_software_version"`
+	Signer                       sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgAddModelVersion(
+	vid uint16,
+	pid uint16,
+	softwareVersion uint32,
+	softwareVersionString string,
+	firmwareDigest string,
+	releaseNotesURL string,
+	minApplicableSoftwareVersion uint32,
+	maxApplicableSoftwareVersion uint32,
+	signer sdk.AccAddress,
+) MsgAddModelVersion {
+	return MsgAddModelVersion{
+		VID:                          vid,
+		PID:                          pid,
+		SoftwareVersion:              softwareVersion,
+		SoftwareVersionString:        softwareVersionString,
+		FirmwareDigest:               firmwareDigest,
+		ReleaseNotesURL:              releaseNotesURL,
+		MinApplicableSoftwareVersion: minApplicableSoftwareVersion,
+		MaxApplicableSoftwareVersion: maxApplicableSoftwareVersion,
+		Signer:                       signer,
+	}
+}
+
+func (m MsgAddModelVersion) Route() string {
+	return RouterKey
+}
+
+func (m MsgAddModelVersion) Type() string {
+	return "add_model_version"
+}
+
+func (m MsgAddModelVersion) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if m.VID == 0 {
+		return sdk.ErrUnknownRequest("Invalid VID: it must be non-zero 16-bit unsigned integer")
+	}
+
+	if m.PID == 0 {
+		return sdk.ErrUnknownRequest("Invalid PID: it must be non-zero 16-bit unsigned integer")
+	}
+
+	if len(m.SoftwareVersionString) == 0 {
+		return sdk.ErrUnknownRequest("Invalid SoftwareVersionString: it cannot be empty")
+	}
+
+	if err := validateMaxLengths([]limitedField{
+		{"SoftwareVersionString", m.SoftwareVersionString, MaxVersionLength},
+		{"FirmwareDigest", m.FirmwareDigest, MaxFirmwareDigestLength},
+		{"ReleaseNotesURL", m.ReleaseNotesURL, MaxReleaseNotesURLLength},
+	}); err != nil {
+		return err
+	}
+
+	return ValidateApplicableSoftwareVersions(m.MinApplicableSoftwareVersion, m.MaxApplicableSoftwareVersion)
+}
+
+func (m MsgAddModelVersion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgAddModelVersion) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+type MsgUpdateModelVersion struct {
+	VID                          uint16         `json:"vid"`
+	PID                          uint16         `json:"pid"`
+	SoftwareVersion              uint32         `json:"software_version"`
+	ReleaseNotesURL              string         `json:"release_notes_url,omitempty"`
+	MinApplicableSoftwareVersion uint32         `json:"min_applicable_software_version,omitempty"`
+	MaxApplicableSoftwareVersion uint32         `json:"max_applicable_software_version,omitempty"`
+	Signer                       sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgUpdateModelVersion(
+	vid uint16,
+	pid uint16,
+	softwareVersion uint32,
+	releaseNotesURL string,
+	minApplicableSoftwareVersion uint32,
+	maxApplicableSoftwareVersion uint32,
+	signer sdk.AccAddress,
+) MsgUpdateModelVersion {
+	return MsgUpdateModelVersion{
+		VID:                          vid,
+		PID:                          pid,
+		SoftwareVersion:              softwareVersion,
+		ReleaseNotesURL:              releaseNotesURL,
+		MinApplicableSoftwareVersion: minApplicableSoftwareVersion,
+		MaxApplicableSoftwareVersion: maxApplicableSoftwareVersion,
+		Signer:                       signer,
+	}
+}
+
+func (m MsgUpdateModelVersion) Route() string {
+	return RouterKey
+}
+
+func (m MsgUpdateModelVersion) Type() string {
+	return "update_model_version"
+}
+
+func (m MsgUpdateModelVersion) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if m.VID == 0 {
+		return sdk.ErrUnknownRequest("Invalid VID: it must be non-zero 16-bit unsigned integer")
+	}
+
+	if m.PID == 0 {
+		return sdk.ErrUnknownRequest("Invalid PID: it must be non-zero 16-bit unsigned integer")
+	}
+
+	if err := validateMaxLength("ReleaseNotesURL", m.ReleaseNotesURL, MaxReleaseNotesURLLength); err != nil {
+		return err
+	}
+
+	return ValidateApplicableSoftwareVersions(m.MinApplicableSoftwareVersion, m.MaxApplicableSoftwareVersion)
+}
+
+func (m MsgUpdateModelVersion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgUpdateModelVersion) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+// MsgSetCustomDataSchema adds the custom data schema or replaces the existing one with the same name.
+// The models which reference the schema are not revalidated: their custom data
+// is validated against the current version of the schema when they are edited next time.
+type MsgSetCustomDataSchema struct {
+	Name   string         `json:"name"`
+	Schema string         `json:"schema"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgSetCustomDataSchema(name string, schema string, signer sdk.AccAddress) MsgSetCustomDataSchema {
+	return MsgSetCustomDataSchema{
+		Name:   name,
+		Schema: schema,
+		Signer: signer,
+	}
+}
+
+func (m MsgSetCustomDataSchema) Route() string {
+	return RouterKey
+}
+
+func (m MsgSetCustomDataSchema) Type() string {
+	return "set_custom_data_schema"
+}
+
+func (m MsgSetCustomDataSchema) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if err := ValidateCustomDataSchemaName(m.Name); err != nil {
+		return err
+	}
+
+	return ValidateCustomDataSchema(m.Schema)
+}
+
+func (m MsgSetCustomDataSchema) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgSetCustomDataSchema) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+// String field of a message along with its maximal length.
+type limitedField struct {
+	name      string
+	value     string
+	maxLength int
+}
+
+func validateMaxLengths(fields []limitedField) sdk.Error {
+	for _, field := range fields {
+		if err := validateMaxLength(field.name, field.value, field.maxLength); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
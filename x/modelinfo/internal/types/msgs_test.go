@@ -16,6 +16,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -153,6 +154,34 @@ func TestMsgAddModelInfoValidation(t *testing.T) {
 	}
 }
 
+func TestMsgAddModelInfoLengthValidation(t *testing.T) {
+	newMsg := func() MsgAddModelInfo {
+		return NewMsgAddModelInfo(testconstants.VID, testconstants.PID, testconstants.CID, testconstants.Version,
+			testconstants.Name, testconstants.Description, testconstants.SKU, testconstants.HardwareVersion,
+			testconstants.FirmwareVersion, testconstants.OtaURL, testconstants.OtaChecksum,
+			testconstants.OtaChecksumType, testconstants.Custom, testconstants.TisOrTrpTestingCompleted,
+			testconstants.Signer)
+	}
+
+	msg := newMsg()
+	msg.Description = strings.Repeat("a", MaxDescriptionLength)
+	require.Nil(t, msg.ValidateBasic())
+
+	for _, setField := range []func(msg *MsgAddModelInfo){
+		func(msg *MsgAddModelInfo) { msg.Name = strings.Repeat("a", MaxNameLength+1) },
+		func(msg *MsgAddModelInfo) { msg.Description = strings.Repeat("a", MaxDescriptionLength+1) },
+		func(msg *MsgAddModelInfo) { msg.SKU = strings.Repeat("a", MaxSKULength+1) },
+		func(msg *MsgAddModelInfo) { msg.FirmwareVersion = strings.Repeat("a", MaxVersionLength+1) },
+		func(msg *MsgAddModelInfo) { msg.OtaURL = strings.Repeat("a", MaxOtaURLLength+1) },
+		func(msg *MsgAddModelInfo) { msg.Custom = strings.Repeat("a", MaxCustomLength+1) },
+	} {
+		msg := newMsg()
+		setField(&msg)
+
+		require.NotNil(t, msg.ValidateBasic())
+	}
+}
+
 func TestMsgAddModelInfoGetSignBytes(t *testing.T) {
 	msg := NewMsgAddModelInfo(testconstants.VID, testconstants.PID, testconstants.CID, testconstants.Version,
 		testconstants.Name, testconstants.Description, testconstants.SKU, testconstants.HardwareVersion,
@@ -221,6 +250,18 @@ func TestMsgUpdateModelInfoValidation(t *testing.T) {
 			testconstants.VID, testconstants.PID, testconstants.CID,
 			testconstants.Description, testconstants.OtaURL, testconstants.Custom,
 			testconstants.TisOrTrpTestingCompleted, []byte{})},
+		{true, MsgUpdateModelInfo{VID: testconstants.VID, PID: testconstants.PID, Signer: testconstants.Signer}},
+		{true, MsgUpdateModelInfo{VID: testconstants.VID, PID: testconstants.PID, Signer: testconstants.Signer,
+			Unset: []string{ModelInfoFieldCID, ModelInfoFieldCustom}}},
+		{false, MsgUpdateModelInfo{VID: testconstants.VID, PID: testconstants.PID, Signer: testconstants.Signer,
+			Unset: []string{"description"}}},
+		{false, MsgUpdateModelInfo{VID: testconstants.VID, PID: testconstants.PID, Signer: testconstants.Signer,
+			CID: testconstants.CID, Unset: []string{ModelInfoFieldCID}}},
+		{false, MsgUpdateModelInfo{VID: testconstants.VID, PID: testconstants.PID, Signer: testconstants.Signer,
+			Description: strings.Repeat("a", MaxDescriptionLength+1)}},
+		{false, MsgUpdateModelInfo{VID: testconstants.VID, PID: testconstants.PID, Signer: testconstants.Signer,
+			Unset: []string{ModelInfoFieldCID, ModelInfoFieldCID, ModelInfoFieldCID, ModelInfoFieldCID,
+				ModelInfoFieldCID}}},
 	}
 
 	for _, tc := range cases {
@@ -240,10 +281,211 @@ func TestMsgUpdateModelInfoGetSignBytes(t *testing.T) {
 		testconstants.TisOrTrpTestingCompleted, testconstants.Signer)
 
 	expected := `{"type":"modelinfo/UpdateModelInfo","value":{` +
-		`"cid":12345,"custom":"Custom data","description":"Device Description",` +
+		`"cid":12345,"custom":"Custom data","description":"Device Description","expected_sequence":"0",` +
 		`"ota_url":"http://ota.firmware.com","pid":22,` +
 		`"signer":"cosmos1p72j8mgkf39qjzcmr283w8l8y9qv30qpj056uz",` +
 		`"tis_or_trp_testing_completed":true,"vid":1}}`
 
 	require.Equal(t, expected, string(msg.GetSignBytes()))
 }
+
+func TestNewMsgAddModelVersion(t *testing.T) {
+	msg := NewMsgAddModelVersion(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+		testconstants.SoftwareVersionString, testconstants.FirmwareDigest, testconstants.ReleaseNotesURL,
+		testconstants.MinApplicableSoftwareVersion, testconstants.MaxApplicableSoftwareVersion, testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "add_model_version")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestMsgAddModelVersionValidation(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgAddModelVersion
+	}{
+		{true, NewMsgAddModelVersion(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			testconstants.SoftwareVersionString, testconstants.FirmwareDigest, testconstants.ReleaseNotesURL,
+			testconstants.MinApplicableSoftwareVersion, testconstants.MaxApplicableSoftwareVersion, testconstants.Signer)},
+		{false, NewMsgAddModelVersion(0, testconstants.PID, testconstants.SoftwareVersion,
+			testconstants.SoftwareVersionString, testconstants.FirmwareDigest, testconstants.ReleaseNotesURL,
+			testconstants.MinApplicableSoftwareVersion, testconstants.MaxApplicableSoftwareVersion, testconstants.Signer)},
+		{false, NewMsgAddModelVersion(testconstants.VID, 0, testconstants.SoftwareVersion,
+			testconstants.SoftwareVersionString, testconstants.FirmwareDigest, testconstants.ReleaseNotesURL,
+			testconstants.MinApplicableSoftwareVersion, testconstants.MaxApplicableSoftwareVersion, testconstants.Signer)},
+		{false, NewMsgAddModelVersion(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			"", testconstants.FirmwareDigest, testconstants.ReleaseNotesURL,
+			testconstants.MinApplicableSoftwareVersion, testconstants.MaxApplicableSoftwareVersion, testconstants.Signer)},
+		{true, NewMsgAddModelVersion(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			testconstants.SoftwareVersionString, "", "",
+			testconstants.MinApplicableSoftwareVersion, testconstants.MaxApplicableSoftwareVersion, testconstants.Signer)},
+		{true, NewMsgAddModelVersion(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			testconstants.SoftwareVersionString, testconstants.FirmwareDigest, testconstants.ReleaseNotesURL,
+			5, 0, testconstants.Signer)},
+		{false, NewMsgAddModelVersion(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			testconstants.SoftwareVersionString, testconstants.FirmwareDigest, testconstants.ReleaseNotesURL,
+			5, 4, testconstants.Signer)},
+		{false, NewMsgAddModelVersion(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			testconstants.SoftwareVersionString, testconstants.FirmwareDigest, testconstants.ReleaseNotesURL,
+			testconstants.MinApplicableSoftwareVersion, testconstants.MaxApplicableSoftwareVersion, nil)},
+		{false, NewMsgAddModelVersion(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			strings.Repeat("a", MaxVersionLength+1), testconstants.FirmwareDigest, testconstants.ReleaseNotesURL,
+			testconstants.MinApplicableSoftwareVersion, testconstants.MaxApplicableSoftwareVersion, testconstants.Signer)},
+		{false, NewMsgAddModelVersion(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			testconstants.SoftwareVersionString, testconstants.FirmwareDigest,
+			strings.Repeat("a", MaxReleaseNotesURLLength+1),
+			testconstants.MinApplicableSoftwareVersion, testconstants.MaxApplicableSoftwareVersion, testconstants.Signer)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestMsgUpdateModelVersionValidation(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgUpdateModelVersion
+	}{
+		{true, NewMsgUpdateModelVersion(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			testconstants.ReleaseNotesURL, testconstants.MinApplicableSoftwareVersion,
+			testconstants.MaxApplicableSoftwareVersion, testconstants.Signer)},
+		{false, NewMsgUpdateModelVersion(0, testconstants.PID, testconstants.SoftwareVersion,
+			testconstants.ReleaseNotesURL, testconstants.MinApplicableSoftwareVersion,
+			testconstants.MaxApplicableSoftwareVersion, testconstants.Signer)},
+		{false, NewMsgUpdateModelVersion(testconstants.VID, 0, testconstants.SoftwareVersion,
+			testconstants.ReleaseNotesURL, testconstants.MinApplicableSoftwareVersion,
+			testconstants.MaxApplicableSoftwareVersion, testconstants.Signer)},
+		{true, NewMsgUpdateModelVersion(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			"", 0, 0, testconstants.Signer)},
+		{false, NewMsgUpdateModelVersion(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			testconstants.ReleaseNotesURL, 5, 4, testconstants.Signer)},
+		{false, NewMsgUpdateModelVersion(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			testconstants.ReleaseNotesURL, testconstants.MinApplicableSoftwareVersion,
+			testconstants.MaxApplicableSoftwareVersion, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestMsgArchiveModelValidation(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   sdk.Msg
+	}{
+		{true, NewMsgArchiveModel(testconstants.VID, testconstants.PID, testconstants.Signer)},
+		{false, NewMsgArchiveModel(0, testconstants.PID, testconstants.Signer)},
+		{false, NewMsgArchiveModel(testconstants.VID, 0, testconstants.Signer)},
+		{false, NewMsgArchiveModel(testconstants.VID, testconstants.PID, nil)},
+		{true, NewMsgUnarchiveModel(testconstants.VID, testconstants.PID, testconstants.Signer)},
+		{false, NewMsgUnarchiveModel(0, testconstants.PID, testconstants.Signer)},
+		{false, NewMsgUnarchiveModel(testconstants.VID, 0, testconstants.Signer)},
+		{false, NewMsgUnarchiveModel(testconstants.VID, testconstants.PID, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestMsgAddModelInfoMatterValidation(t *testing.T) {
+	cases := []struct {
+		valid  bool
+		matter MatterModelInfo
+	}{
+		{true, MatterModelInfo{DeviceTypeID: 0x0100, ProductLabel: "Lamp", PartNumber: "RT-1"}},
+		{true, MatterModelInfo{CommissioningCustomFlow: CommissioningFlowUserActionRequired,
+			CommissioningModeInitialStepsHint: 1, CommissioningModeInitialStepsInstruction: "Press the button"}},
+		{true, MatterModelInfo{CommissioningCustomFlow: CommissioningFlowCustom,
+			CommissioningCustomFlowURL: "https://example.com/commissioning"}},
+		{false, MatterModelInfo{CommissioningCustomFlow: CommissioningFlowCustom}},
+		{false, MatterModelInfo{CommissioningCustomFlow: 3}},
+		{false, MatterModelInfo{PartNumber: strings.Repeat("a", MaxPartNumberLength+1)}},
+		{false, MatterModelInfo{ProductLabel: strings.Repeat("a", MaxProductLabelLength+1)}},
+		{false, MatterModelInfo{CommissioningModeSecondaryStepsInstruction: strings.Repeat("a",
+			MaxCommissioningStepsInstructionLength+1)}},
+		{false, MatterModelInfo{UserManualURL: "http://example.com/manual"}},
+		{false, MatterModelInfo{SupportURL: "example.com/support"}},
+		{false, MatterModelInfo{ProductURL: "https://example.com/" + strings.Repeat("a", MaxMatterURLLength)}},
+	}
+
+	for _, tc := range cases {
+		matter := tc.matter
+		msg := NewMsgAddModelInfo(testconstants.VID, testconstants.PID, testconstants.CID, testconstants.Version,
+			testconstants.Name, testconstants.Description, testconstants.SKU, testconstants.HardwareVersion,
+			testconstants.FirmwareVersion, testconstants.OtaURL, testconstants.OtaChecksum,
+			testconstants.OtaChecksumType, testconstants.Custom, testconstants.TisOrTrpTestingCompleted,
+			testconstants.Signer)
+		msg.Matter = &matter
+
+		err := msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+
+	// the Matter fields are replaced or unset as a whole
+	msg := MsgUpdateModelInfo{VID: testconstants.VID, PID: testconstants.PID, Signer: testconstants.Signer,
+		Matter: &MatterModelInfo{CommissioningCustomFlow: 3}}
+	require.NotNil(t, msg.ValidateBasic())
+
+	msg.Matter = &MatterModelInfo{DeviceTypeID: 0x0100}
+	require.Nil(t, msg.ValidateBasic())
+
+	msg.Unset = []string{ModelInfoFieldMatter}
+	require.NotNil(t, msg.ValidateBasic())
+
+	msg.Matter = nil
+	require.Nil(t, msg.ValidateBasic())
+}
+
+func TestMsgSetCustomDataSchemaValidation(t *testing.T) {
+	schema := `{"type": "object"}`
+
+	cases := []struct {
+		valid bool
+		msg   MsgSetCustomDataSchema
+	}{
+		{true, NewMsgSetCustomDataSchema("color-extension_1.0", schema, testconstants.Signer)},
+		{false, NewMsgSetCustomDataSchema("", schema, testconstants.Signer)},
+		{false, NewMsgSetCustomDataSchema("color/extension", schema, testconstants.Signer)},
+		{false, NewMsgSetCustomDataSchema(strings.Repeat("a", MaxCustomDataSchemaNameLength+1), schema,
+			testconstants.Signer)},
+		{false, NewMsgSetCustomDataSchema("color-extension", "", testconstants.Signer)},
+		{false, NewMsgSetCustomDataSchema("color-extension", `{"$ref": "#"}`, testconstants.Signer)},
+		{false, NewMsgSetCustomDataSchema("color-extension", schema, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
@@ -18,12 +18,69 @@ import (
 	"encoding/json"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
 )
 
+// Fields QueryAllModels can be sorted by.
+const (
+	SortByName      = "name"
+	SortByDateAdded = "date_added" // the height the model was added at
+)
+
+var ModelSortFields = []string{SortByName, SortByDateAdded}
+
+// Request Payload for QueryAllModels / QueryAllVendorModels (pagination, sorting and archived models filter) queries.
+type ListModelsQueryParams struct {
+	Skip            int
+	Take            int
+	Key             string
+	Sort            string
+	Order           string
+	IncludeArchived bool
+}
+
+func NewListModelsQueryParams(pagination pagination.PaginationParams, includeArchived bool) ListModelsQueryParams {
+	return ListModelsQueryParams{
+		Skip:            pagination.Skip,
+		Take:            pagination.Take,
+		Key:             pagination.Key,
+		Sort:            pagination.Sort,
+		Order:           pagination.Order,
+		IncludeArchived: includeArchived,
+	}
+}
+
+func (p ListModelsQueryParams) PaginationParams() pagination.PaginationParams {
+	return pagination.PaginationParams{Skip: p.Skip, Take: p.Take, Key: p.Key, Sort: p.Sort, Order: p.Order}
+}
+
+// Request Payload for QuerySearchModels (search text and pagination) query.
+type SearchQueryParams struct {
+	Query           string
+	Skip            int
+	Take            int
+	Key             string
+	IncludeArchived bool
+}
+
+func NewSearchQueryParams(query string, skip int, take int) SearchQueryParams {
+	return SearchQueryParams{
+		Query: query,
+		Skip:  skip,
+		Take:  take,
+	}
+}
+
+func (p SearchQueryParams) PaginationParams() pagination.PaginationParams {
+	return pagination.PaginationParams{Skip: p.Skip, Take: p.Take, Key: p.Key}
+}
+
 // Response Payload for a list query with pagination.
 type ListModelInfoItems struct {
-	Total int             `json:"total"`
-	Items []ModelInfoItem `json:"items"`
+	Total   int             `json:"total"`
+	Items   []ModelInfoItem `json:"items"`
+	NextKey string          `json:"next_key"`
+	PrevKey string          `json:"prev_key"`
 }
 
 // Implement fmt.Stringer.
@@ -37,17 +94,20 @@ func (n ListModelInfoItems) String() string {
 }
 
 type ModelInfoItem struct {
-	VID   uint16         `json:"vid"`
-	PID   uint16         `json:"pid"`
-	Name  string         `json:"name"`
-	SKU   string         `json:"sku"`
-	Owner sdk.AccAddress `json:"owner"`
+	VID      uint16         `json:"vid"`
+	PID      uint16         `json:"pid"`
+	Name     string         `json:"name"`
+	SKU      string         `json:"sku"`
+	Owner    sdk.AccAddress `json:"owner"`
+	Archived bool           `json:"archived,omitempty"`
 }
 
 // Response Payload for a list query with pagination.
 type ListVendorItems struct {
-	Total int          `json:"total"`
-	Items []VendorItem `json:"items"`
+	Total   int          `json:"total"`
+	Items   []VendorItem `json:"items"`
+	NextKey string       `json:"next_key"`
+	PrevKey string       `json:"prev_key"`
 }
 
 // Implement fmt.Stringer.
@@ -63,3 +123,39 @@ func (n ListVendorItems) String() string {
 type VendorItem struct {
 	VID uint16 `json:"vid"`
 }
+
+// Response Payload for a list query of model versions with pagination.
+type ListModelVersions struct {
+	Total   int            `json:"total"`
+	Items   []ModelVersion `json:"items"`
+	NextKey string         `json:"next_key"`
+	PrevKey string         `json:"prev_key"`
+}
+
+// Implement fmt.Stringer.
+func (n ListModelVersions) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
+
+// Response Payload for a list query of custom data schemas with pagination.
+type ListCustomDataSchemas struct {
+	Total   int                `json:"total"`
+	Items   []CustomDataSchema `json:"items"`
+	NextKey string             `json:"next_key"`
+	PrevKey string             `json:"prev_key"`
+}
+
+// Implement fmt.Stringer.
+func (n ListCustomDataSchemas) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
@@ -0,0 +1,70 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"strings"
+	"unicode"
+)
+
+const (
+	// Longer words are indexed (and searched) by their first MaxSearchTokenLength bytes.
+	MaxSearchTokenLength = 32
+	// Maximal number of words in a search query.
+	MaxSearchQueryTokens = 8
+)
+
+// Tokenize splits the text into lower-case words (sequences of letters and digits) without duplicates.
+func Tokenize(text string) []string {
+	var tokens []string
+
+	seen := map[string]bool{}
+
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		token := truncateToken(word)
+		if !seen[token] {
+			seen[token] = true
+			tokens = append(tokens, token)
+		}
+	}
+
+	return tokens
+}
+
+// SearchTokens returns the words the model can be found by: the words of its name, description and SKU.
+func SearchTokens(model ModelInfo) []string {
+	return Tokenize(strings.Join([]string{model.Name, model.Description, model.SKU}, " "))
+}
+
+// Truncates the word to MaxSearchTokenLength bytes keeping it a valid UTF-8 string.
+func truncateToken(word string) string {
+	if len(word) <= MaxSearchTokenLength {
+		return word
+	}
+
+	end := 0
+
+	for i := range word {
+		if i > MaxSearchTokenLength {
+			break
+		}
+
+		end = i
+	}
+
+	return word[:end]
+}
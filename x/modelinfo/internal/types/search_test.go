@@ -0,0 +1,36 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	require.Empty(t, Tokenize(""))
+	require.Empty(t, Tokenize(" -/. "))
+	require.Equal(t, []string{"smart", "bulb", "e27", "über"}, Tokenize("Smart bulb (E27), smart-BULB Über"))
+
+	long := strings.Repeat("a", MaxSearchTokenLength+10)
+	require.Equal(t, []string{long[:MaxSearchTokenLength]}, Tokenize(long))
+
+	// multi-byte characters are not split
+	long = strings.Repeat("a", MaxSearchTokenLength-1) + "ü"
+	require.Equal(t, []string{long[:MaxSearchTokenLength-1]}, Tokenize(long))
+}
@@ -40,6 +40,11 @@ type ModelInfo struct {
 	Custom                   string         `json:"custom,omitempty"`
 	TisOrTrpTestingCompleted bool           `json:"tis_or_trp_testing_completed"`
 	Owner                    sdk.AccAddress `json:"owner"`
+	Archived                 bool           `json:"archived,omitempty"`
+	Sequence                 uint64         `json:"sequence"` // incremented on every change of the model info
+	CustomSchema             string         `json:"custom_schema,omitempty"`
+	// appended to the end to keep the binary encoding of the existing records
+	Matter *MatterModelInfo `json:"matter,omitempty"`
 }
 
 func NewModelInfo(
@@ -78,6 +83,13 @@ func NewModelInfo(
 	}
 }
 
+// Height of the block a Model Info was added at (orders the models added earlier first by `date_added` sort).
+type ModelAddedHeight struct {
+	VID    uint16 `json:"vid"`
+	PID    uint16 `json:"pid"`
+	Height int64  `json:"height"`
+}
+
 func (d ModelInfo) String() string {
 	bytes, err := json.Marshal(d)
 	if err != nil {
@@ -102,7 +114,16 @@ func NewVendorProducts(vid uint16) VendorProducts {
 	}
 }
 
+// Adds the product or replaces the existing one with the same PID.
 func (d *VendorProducts) AddVendorProduct(pid Product) {
+	for i, value := range d.Products {
+		if pid.PID == value.PID {
+			d.Products[i] = pid
+
+			return
+		}
+	}
+
 	d.Products = append(d.Products, pid)
 }
 
@@ -131,8 +152,9 @@ func (d VendorProducts) String() string {
 
 // Single Vendor Product.
 type Product struct {
-	PID   uint16         `json:"pid"`
-	Name  string         `json:"name"`
-	SKU   string         `json:"sku"`
-	Owner sdk.AccAddress `json:"owner"`
+	PID      uint16         `json:"pid"`
+	Name     string         `json:"name"`
+	SKU      string         `json:"sku"`
+	Owner    sdk.AccAddress `json:"owner"`
+	Archived bool           `json:"archived,omitempty"`
 }
@@ -27,14 +27,21 @@ import (
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/client/cli"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo/client/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade"
 )
 
 // type check to ensure the interface is properly implemented.
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule            = AppModule{}
+	_ module.AppModuleBasic       = AppModuleBasic{}
+	_ upgrade.HasConsensusVersion = AppModule{}
+	_ upgrade.HasMigrations       = AppModule{}
 )
 
+// Consensus version of the module store schema.
+// Version 2 adds the search index of models, version 3 adds the indexes sorting models by name and date added.
+const ConsensusVersion = 4
+
 // app module Basics object.
 type AppModuleBasic struct{}
 
@@ -123,3 +130,30 @@ func (a AppModule) BeginBlock(sdk.Context, abci.RequestBeginBlock) {}
 func (a AppModule) EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate {
 	return []abci.ValidatorUpdate{}
 }
+
+func (a AppModule) ConsensusVersion() uint64 {
+	return ConsensusVersion
+}
+
+func (a AppModule) RegisterMigrations(registrar upgrade.MigrationRegistrar) {
+	// version 1 -> 2: build the search index for the models added before it was introduced
+	registrar.RegisterMigration(ModuleName, 1, func(ctx sdk.Context) error {
+		a.keeper.RebuildSearchIndex(ctx)
+
+		return nil
+	})
+
+	// version 2 -> 3: build the indexes sorting the models added before they were introduced
+	registrar.RegisterMigration(ModuleName, 2, func(ctx sdk.Context) error {
+		a.keeper.RebuildSortIndexes(ctx)
+
+		return nil
+	})
+
+	// version 3 -> 4: build the firmware digest index for the model versions added before it was introduced
+	registrar.RegisterMigration(ModuleName, 3, func(ctx sdk.Context) error {
+		a.keeper.RebuildFirmwareDigestIndex(ctx)
+
+		return nil
+	})
+}
@@ -0,0 +1,29 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+const (
+	FlagVID                          = "vid"
+	FlagPID                          = "pid"
+	FlagSoftwareVersion              = "software-version"
+	FlagURL                          = "url"
+	FlagChecksum                     = "checksum"
+	FlagChecksumType                 = "checksum-type"
+	FlagSize                         = "size"
+	FlagMinApplicableSoftwareVersion = "min-applicable-software-version"
+	FlagMaxApplicableSoftwareVersion = "max-applicable-software-version"
+	FlagReleaseDate                  = "release-date"
+	FlagCurrentSoftwareVersion       = "current-software-version"
+)
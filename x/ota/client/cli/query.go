@@ -0,0 +1,167 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota/internal/types"
+)
+
+func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	otaQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the ota module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	otaQueryCmd.AddCommand(client.GetCommands(
+		GetCmdFirmwareImage(storeKey, cdc),
+		GetCmdFirmwareImages(storeKey, cdc),
+		GetCmdAvailableUpdates(storeKey, cdc),
+	)...)
+
+	return otaQueryCmd
+}
+
+func GetCmdFirmwareImage(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "firmware-image",
+		Short: "Query Firmware Image by combination of Vendor ID, Product ID and Software Version",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err_ := conversions.ParseVID(viper.GetString(FlagVID))
+			if err_ != nil {
+				return err_
+			}
+
+			pid, err_ := conversions.ParsePID(viper.GetString(FlagPID))
+			if err_ != nil {
+				return err_
+			}
+
+			softwareVersion, err_ := conversions.ParseUInt32FromString(viper.GetString(FlagSoftwareVersion))
+			if err_ != nil {
+				return err_
+			}
+
+			res, height, err := cliCtx.QueryStore(types.GetFirmwareImageKey(vid, pid, softwareVersion), queryRoute)
+			if err != nil || res == nil {
+				return types.ErrFirmwareImageDoesNotExist(vid, pid, softwareVersion)
+			}
+
+			var image types.FirmwareImage
+			cdc.MustUnmarshalBinaryBare(res, &image)
+
+			return cliCtx.EncodeAndPrintWithHeight(image, height)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().String(FlagSoftwareVersion, "", "Software version of the image")
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+	_ = cmd.MarkFlagRequired(FlagSoftwareVersion)
+
+	return cmd
+}
+
+func GetCmdFirmwareImages(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "firmware-images",
+		Short: "Query the list of Firmware Images published for the given Model",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err_ := conversions.ParseVID(viper.GetString(FlagVID))
+			if err_ != nil {
+				return err_
+			}
+
+			pid, err_ := conversions.ParsePID(viper.GetString(FlagPID))
+			if err_ != nil {
+				return err_
+			}
+
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/firmware_images/%v/%v", queryRoute, vid, pid), params)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of images to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of images to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+
+	return cmd
+}
+
+func GetCmdAvailableUpdates(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "available-updates",
+		Short: "Query Firmware Images a device running the given Software Version can be updated with",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err_ := conversions.ParseVID(viper.GetString(FlagVID))
+			if err_ != nil {
+				return err_
+			}
+
+			pid, err_ := conversions.ParsePID(viper.GetString(FlagPID))
+			if err_ != nil {
+				return err_
+			}
+
+			currentSoftwareVersion, err_ := conversions.ParseUInt32FromString(viper.GetString(FlagCurrentSoftwareVersion))
+			if err_ != nil {
+				return err_
+			}
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/available_updates/%v/%v/%v",
+				queryRoute, vid, pid, currentSoftwareVersion), nil)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().String(FlagCurrentSoftwareVersion, "", "Software version the device is currently running")
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+	_ = cmd.MarkFlagRequired(FlagCurrentSoftwareVersion)
+
+	return cmd
+}
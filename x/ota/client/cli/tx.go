@@ -0,0 +1,175 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota/internal/types"
+)
+
+func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	otaTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "OTA transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	otaTxCmd.AddCommand(cli.SignedCommands(client.PostCommands(
+		GetCmdAddFirmwareImage(cdc),
+		GetCmdUpdateFirmwareImage(cdc),
+	)...)...)
+
+	return otaTxCmd
+}
+
+//nolint:funlen
+func GetCmdAddFirmwareImage(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-firmware-image",
+		Short: "Publish metadata of the firmware image for a certified Model Version",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err := conversions.ParseVID(viper.GetString(FlagVID))
+			if err != nil {
+				return err
+			}
+
+			pid, err := conversions.ParsePID(viper.GetString(FlagPID))
+			if err != nil {
+				return err
+			}
+
+			softwareVersion, err := conversions.ParseUInt32FromString(viper.GetString(FlagSoftwareVersion))
+			if err != nil {
+				return err
+			}
+
+			size, err_ := strconv.ParseUint(viper.GetString(FlagSize), 10, 64)
+			if err_ != nil {
+				return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Size: "+
+					"Parsing Error: \"%v\" must be 64 bit unsigned integer", viper.GetString(FlagSize)))
+			}
+
+			var minApplicableSoftwareVersion uint32
+			if minStr := viper.GetString(FlagMinApplicableSoftwareVersion); len(minStr) != 0 {
+				minApplicableSoftwareVersion, err = conversions.ParseUInt32FromString(minStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			var maxApplicableSoftwareVersion uint32
+			if maxStr := viper.GetString(FlagMaxApplicableSoftwareVersion); len(maxStr) != 0 {
+				maxApplicableSoftwareVersion, err = conversions.ParseUInt32FromString(maxStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			releaseDate, err_ := time.Parse(time.RFC3339, viper.GetString(FlagReleaseDate))
+			if err_ != nil {
+				return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ReleaseDate \"%v\": "+
+					"it must be RFC3339 encoded date", viper.GetString(FlagReleaseDate)))
+			}
+
+			msg := types.NewMsgAddFirmwareImage(vid, pid, softwareVersion, viper.GetString(FlagURL),
+				viper.GetString(FlagChecksum), viper.GetString(FlagChecksumType), size,
+				minApplicableSoftwareVersion, maxApplicableSoftwareVersion, releaseDate, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().String(FlagSoftwareVersion, "", "Software version of the image")
+	cmd.Flags().String(FlagURL, "", "URL the image can be downloaded from")
+	cmd.Flags().String(FlagChecksum, "", "Checksum of the image")
+	cmd.Flags().String(FlagChecksumType, "", "Type of the image checksum")
+	cmd.Flags().String(FlagSize, "", "Size of the image in bytes")
+	cmd.Flags().String(FlagMinApplicableSoftwareVersion, "",
+		"Minimal software version the image can be applied to")
+	cmd.Flags().String(FlagMaxApplicableSoftwareVersion, "",
+		"Maximal software version the image can be applied to")
+	cmd.Flags().String(FlagReleaseDate, "", "Release date of the image (rfc3339 encoded)")
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+	_ = cmd.MarkFlagRequired(FlagSoftwareVersion)
+	_ = cmd.MarkFlagRequired(FlagURL)
+	_ = cmd.MarkFlagRequired(FlagChecksum)
+	_ = cmd.MarkFlagRequired(FlagChecksumType)
+	_ = cmd.MarkFlagRequired(FlagSize)
+	_ = cmd.MarkFlagRequired(FlagReleaseDate)
+
+	return cmd
+}
+
+func GetCmdUpdateFirmwareImage(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-firmware-image",
+		Short: "Update URL of the published firmware image",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			vid, err := conversions.ParseVID(viper.GetString(FlagVID))
+			if err != nil {
+				return err
+			}
+
+			pid, err := conversions.ParsePID(viper.GetString(FlagPID))
+			if err != nil {
+				return err
+			}
+
+			softwareVersion, err := conversions.ParseUInt32FromString(viper.GetString(FlagSoftwareVersion))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateFirmwareImage(vid, pid, softwareVersion,
+				viper.GetString(FlagURL), cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagVID, "", "Model vendor ID")
+	cmd.Flags().String(FlagPID, "", "Model product ID")
+	cmd.Flags().String(FlagSoftwareVersion, "", "Software version of the image")
+	cmd.Flags().String(FlagURL, "", "New URL the image can be downloaded from")
+
+	_ = cmd.MarkFlagRequired(FlagVID)
+	_ = cmd.MarkFlagRequired(FlagPID)
+	_ = cmd.MarkFlagRequired(FlagSoftwareVersion)
+	_ = cmd.MarkFlagRequired(FlagURL)
+
+	return cmd
+}
@@ -0,0 +1,52 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+const (
+	vid                    = "vid"
+	pid                    = "pid"
+	softwareVersion        = "software_version"
+	currentSoftwareVersion = "current_software_version"
+)
+
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
+	r.HandleFunc(
+		fmt.Sprintf("/%s/images", storeName),
+		addFirmwareImageHandler(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/images", storeName),
+		updateFirmwareImageHandler(cliCtx),
+	).Methods("PUT")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/images/{%s}/{%s}", storeName, vid, pid),
+		getFirmwareImagesHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/images/{%s}/{%s}/{%s}", storeName, vid, pid, softwareVersion),
+		getFirmwareImageHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/updates/{%s}/{%s}/{%s}", storeName, vid, pid, currentSoftwareVersion),
+		getAvailableUpdatesHandler(cliCtx, storeName),
+	).Methods("GET")
+}
@@ -0,0 +1,99 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	restTypes "github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota/internal/types"
+)
+
+type AddFirmwareImageRequest struct {
+	BaseReq                      restTypes.BaseReq `json:"base_req"`
+	VID                          uint16            `json:"vid"`
+	PID                          uint16            `json:"pid"`
+	SoftwareVersion              uint32            `json:"software_version"`
+	URL                          string            `json:"url"`
+	Checksum                     string            `json:"checksum"`
+	ChecksumType                 string            `json:"checksum_type"`
+	Size                         uint64            `json:"size"`
+	MinApplicableSoftwareVersion uint32            `json:"min_applicable_software_version"`
+	MaxApplicableSoftwareVersion uint32            `json:"max_applicable_software_version"`
+	ReleaseDate                  time.Time         `json:"release_date"` // rfc3339 encoded date
+}
+
+type UpdateFirmwareImageRequest struct {
+	BaseReq         restTypes.BaseReq `json:"base_req"`
+	VID             uint16            `json:"vid"`
+	PID             uint16            `json:"pid"`
+	SoftwareVersion uint32            `json:"software_version"`
+	URL             string            `json:"url"`
+}
+
+func addFirmwareImageHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req AddFirmwareImageRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgAddFirmwareImage(req.VID, req.PID, req.SoftwareVersion, req.URL,
+			req.Checksum, req.ChecksumType, req.Size, req.MinApplicableSoftwareVersion,
+			req.MaxApplicableSoftwareVersion, req.ReleaseDate, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func updateFirmwareImageHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req UpdateFirmwareImageRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgUpdateFirmwareImage(req.VID, req.PID, req.SoftwareVersion, req.URL, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ota
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota/internal/types"
+)
+
+type GenesisState struct {
+	FirmwareImageRecords []FirmwareImage `json:"firmware_image_records"`
+}
+
+func NewGenesisState() GenesisState {
+	return GenesisState{FirmwareImageRecords: []FirmwareImage{}}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	for _, record := range data.FirmwareImageRecords {
+		if record.VID == 0 {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid FirmwareImage: Invalid VID. Value: %v", record))
+		}
+
+		if record.PID == 0 {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid FirmwareImage: Invalid PID. Value: %v", record))
+		}
+
+		if record.URL == "" || record.Checksum == "" || record.ChecksumType == "" {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid FirmwareImage: The fields URL, Checksum and "+
+				"ChecksumType must be specified. Value: %v", record))
+		}
+
+		if record.Owner.Empty() {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid FirmwareImage: Missed Owner. Value: %v", record))
+		}
+
+		if err := modelinfo.ValidateApplicableSoftwareVersions(record.MinApplicableSoftwareVersion,
+			record.MaxApplicableSoftwareVersion); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid FirmwareImage: %v. Value: %v", err.Data(), record))
+		}
+	}
+
+	return nil
+}
+
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState()
+}
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.ValidatorUpdate {
+	for _, record := range data.FirmwareImageRecords {
+		keeper.SetFirmwareImage(ctx, record)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	var records []FirmwareImage
+
+	k.IterateFirmwareImages(ctx, types.FirmwareImagePrefix, func(image types.FirmwareImage) (stop bool) {
+		records = append(records, image)
+
+		return false
+	})
+
+	return GenesisState{FirmwareImageRecords: records}
+}
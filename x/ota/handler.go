@@ -0,0 +1,154 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ota
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota/internal/keeper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota/internal/types"
+)
+
+func init() {
+	// sender must have Vendor role to publish firmware images
+	auth.RegisterMsgRoles(types.MsgAddFirmwareImage{}, auth.Vendor)
+	auth.RegisterMsgRoles(types.MsgUpdateFirmwareImage{}, auth.Vendor)
+}
+
+func NewHandler(keeper keeper.Keeper, modelinfoKeeper modelinfo.Keeper, complianceKeeper compliance.Keeper,
+	authKeeper auth.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case types.MsgAddFirmwareImage:
+			return handleMsgAddFirmwareImage(ctx, keeper, modelinfoKeeper, complianceKeeper, authKeeper, msg)
+		case types.MsgUpdateFirmwareImage:
+			return handleMsgUpdateFirmwareImage(ctx, keeper, authKeeper, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized ota Msg type: %v", msg.Type())
+
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgAddFirmwareImage(ctx sdk.Context, keeper keeper.Keeper, modelinfoKeeper modelinfo.Keeper,
+	complianceKeeper compliance.Keeper, authKeeper auth.Keeper, msg types.MsgAddFirmwareImage) sdk.Result {
+	// check if sender has enough rights to publish firmware images
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// check that corresponding model version exists on the ledger
+	if !modelinfoKeeper.IsModelVersionPresent(ctx, msg.VID, msg.PID, msg.SoftwareVersion) {
+		return modelinfo.ErrModelVersionDoesNotExist(msg.VID, msg.PID, msg.SoftwareVersion).Result()
+	}
+
+	// images can be published only by the owner of the model
+	modelInfo := modelinfoKeeper.GetModelInfo(ctx, msg.VID, msg.PID)
+	if !msg.Signer.Equals(modelInfo.Owner) {
+		return sdk.ErrUnauthorized("MsgAddFirmwareImage tx should be signed by owner of the model").Result()
+	}
+
+	// vendor can publish images only for the VendorID it is bound to
+	if err := authKeeper.CheckVendorID(ctx, msg.Signer, msg.VID); err != nil {
+		return err.Result()
+	}
+
+	// images can be published only for certified model versions
+	if !isModelVersionCertified(ctx, complianceKeeper, msg.VID, msg.PID, msg.SoftwareVersion) {
+		return types.ErrModelVersionIsNotCertified(msg.VID, msg.PID, msg.SoftwareVersion).Result()
+	}
+
+	// check if firmware image already exists
+	if keeper.IsFirmwareImagePresent(ctx, msg.VID, msg.PID, msg.SoftwareVersion) {
+		return types.ErrFirmwareImageAlreadyExists(msg.VID, msg.PID, msg.SoftwareVersion).Result()
+	}
+
+	image := types.NewFirmwareImage(
+		msg.VID,
+		msg.PID,
+		msg.SoftwareVersion,
+		msg.URL,
+		msg.Checksum,
+		msg.ChecksumType,
+		msg.Size,
+		msg.MinApplicableSoftwareVersion,
+		msg.MaxApplicableSoftwareVersion,
+		msg.ReleaseDate,
+		msg.Signer,
+	)
+
+	// store new firmware image
+	keeper.SetFirmwareImage(ctx, image)
+
+	return sdk.Result{}
+}
+
+func handleMsgUpdateFirmwareImage(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgUpdateFirmwareImage) sdk.Result {
+	// check if sender has enough rights to update firmware images
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// check if firmware image exists
+	if !keeper.IsFirmwareImagePresent(ctx, msg.VID, msg.PID, msg.SoftwareVersion) {
+		return types.ErrFirmwareImageDoesNotExist(msg.VID, msg.PID, msg.SoftwareVersion).Result()
+	}
+
+	image := keeper.GetFirmwareImage(ctx, msg.VID, msg.PID, msg.SoftwareVersion)
+
+	// sender must be equal to owner to edit firmware image
+	if !msg.Signer.Equals(image.Owner) {
+		return sdk.ErrUnauthorized("MsgUpdateFirmwareImage tx should be signed by owner").Result()
+	}
+
+	// vendor can update images only for the VendorID it is bound to
+	if err := authKeeper.CheckVendorID(ctx, msg.Signer, msg.VID); err != nil {
+		return err.Result()
+	}
+
+	// only the location of the image can be changed, its content is fixed by the checksum
+	image.URL = msg.URL
+
+	// store updated firmware image
+	keeper.SetFirmwareImage(ctx, image)
+
+	return sdk.Result{}
+}
+
+// The model version is certified if it has a Certification Declaration of an allowed certification type
+// and the model is still certified for that type (the compliance info is kept per model, not per version).
+func isModelVersionCertified(ctx sdk.Context, complianceKeeper compliance.Keeper,
+	vid uint16, pid uint16, softwareVersion uint32) bool {
+	if !complianceKeeper.IsCertificationDeclarationPresent(ctx, vid, pid, softwareVersion) {
+		return false
+	}
+
+	declaration := complianceKeeper.GetCertificationDeclaration(ctx, vid, pid, softwareVersion)
+
+	if !complianceKeeper.GetParams(ctx).IsCertificationTypeAllowed(declaration.CertificationType) ||
+		!complianceKeeper.IsComplianceInfoPresent(ctx, declaration.CertificationType, vid, pid) {
+		return false
+	}
+
+	complianceInfo := complianceKeeper.GetComplianceInfo(ctx, declaration.CertificationType, vid, pid)
+
+	return complianceInfo.State == compliance.CertifiedState
+}
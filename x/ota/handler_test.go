@@ -0,0 +1,372 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package ota
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	constants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/compliance"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota/internal/keeper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota/internal/types"
+)
+
+func TestHandler_AddFirmwareImage(t *testing.T) {
+	setup := Setup()
+
+	// add certified model version
+	addCertifiedModelVersion(setup, constants.VID, constants.PID, constants.SoftwareVersion)
+
+	// add firmware image
+	msgAddFirmwareImage := msgAddFirmwareImage(setup.Vendor)
+	result := setup.Handler(setup.Ctx, msgAddFirmwareImage)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query firmware image
+	receivedImage := setup.OtaKeeper.GetFirmwareImage(setup.Ctx,
+		msgAddFirmwareImage.VID, msgAddFirmwareImage.PID, msgAddFirmwareImage.SoftwareVersion)
+
+	// check
+	checkFirmwareImage(t, receivedImage, msgAddFirmwareImage)
+}
+
+func TestHandler_AddFirmwareImageByNonVendor(t *testing.T) {
+	setup := Setup()
+
+	// add certified model version
+	addCertifiedModelVersion(setup, constants.VID, constants.PID, constants.SoftwareVersion)
+
+	for _, role := range []auth.AccountRole{auth.TestHouse, auth.ZBCertificationCenter, auth.Trustee} {
+		account := auth.NewAccount(constants.Address3, constants.PubKey3, auth.AccountRoles{role})
+		setup.authKeeper.SetAccount(setup.Ctx, account)
+
+		// try to add firmware image
+		result := setup.Handler(setup.Ctx, msgAddFirmwareImage(constants.Address3))
+		require.Equal(t, auth.CodeMissingRole, result.Code)
+	}
+}
+
+func TestHandler_AddFirmwareImageByNotOwner(t *testing.T) {
+	setup := Setup()
+
+	// add certified model version
+	addCertifiedModelVersion(setup, constants.VID, constants.PID, constants.SoftwareVersion)
+
+	// create another vendor account
+	account := auth.NewAccount(constants.Address3, constants.PubKey3, auth.AccountRoles{auth.Vendor})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	// try to add firmware image
+	result := setup.Handler(setup.Ctx, msgAddFirmwareImage(account.Address))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+}
+
+func TestHandler_FirmwareImageAfterVendorIDChange(t *testing.T) {
+	setup := Setup()
+
+	// add certified model version and its firmware image
+	addCertifiedModelVersion(setup, constants.VID, constants.PID, constants.SoftwareVersion)
+	addCertifiedModelVersion(setup, constants.VID, constants.PID, constants.SoftwareVersion+1)
+
+	result := setup.Handler(setup.Ctx, msgAddFirmwareImage(setup.Vendor))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// bind vendor to another VendorID
+	account := setup.authKeeper.GetAccount(setup.Ctx, setup.Vendor)
+	account.VendorID = constants.VID + 1
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	// try to update firmware image
+	result = setup.Handler(setup.Ctx, NewMsgUpdateFirmwareImage(
+		constants.VID, constants.PID, constants.SoftwareVersion, constants.OtaURL+"/new", setup.Vendor))
+	require.Equal(t, auth.CodeVendorIDMismatch, result.Code)
+
+	// try to add firmware image of another version
+	msg := msgAddFirmwareImage(setup.Vendor)
+	msg.SoftwareVersion++
+	result = setup.Handler(setup.Ctx, msg)
+	require.Equal(t, auth.CodeVendorIDMismatch, result.Code)
+}
+
+func TestHandler_AddFirmwareImageForUnknownModelVersion(t *testing.T) {
+	setup := Setup()
+
+	// try to add firmware image
+	result := setup.Handler(setup.Ctx, msgAddFirmwareImage(setup.Vendor))
+	require.Equal(t, modelinfo.CodeModelVersionDoesNotExist, result.Code)
+}
+
+func TestHandler_AddFirmwareImageForNotCertifiedModel(t *testing.T) {
+	setup := Setup()
+
+	// add model version without certification
+	addModelVersion(setup, constants.VID, constants.PID, constants.SoftwareVersion)
+
+	// try to add firmware image
+	result := setup.Handler(setup.Ctx, msgAddFirmwareImage(setup.Vendor))
+	require.Equal(t, types.CodeModelVersionIsNotCertified, result.Code)
+
+	// declare the model version
+	addCertificationDeclaration(setup, constants.VID, constants.PID, constants.SoftwareVersion)
+
+	// revoke model
+	setup.ComplianceKeeper.SetComplianceInfo(setup.Ctx, compliance.ComplianceInfo{
+		VID:               constants.VID,
+		PID:               constants.PID,
+		State:             compliance.RevokedState,
+		Date:              constants.RevocationDate,
+		CertificationType: compliance.ZbCertificationType,
+		Owner:             constants.Address2,
+	})
+
+	// try to add firmware image for revoked model
+	result = setup.Handler(setup.Ctx, msgAddFirmwareImage(setup.Vendor))
+	require.Equal(t, types.CodeModelVersionIsNotCertified, result.Code)
+}
+
+func TestHandler_AddFirmwareImageForNotDeclaredModelVersion(t *testing.T) {
+	setup := Setup()
+
+	// add certified model version and another version of the model without certification declaration
+	addCertifiedModelVersion(setup, constants.VID, constants.PID, constants.SoftwareVersion+1)
+	addModelVersion(setup, constants.VID, constants.PID, constants.SoftwareVersion)
+
+	// try to add firmware image for not declared version
+	result := setup.Handler(setup.Ctx, msgAddFirmwareImage(setup.Vendor))
+	require.Equal(t, types.CodeModelVersionIsNotCertified, result.Code)
+
+	// declare the version
+	addCertificationDeclaration(setup, constants.VID, constants.PID, constants.SoftwareVersion)
+
+	// add firmware image
+	result = setup.Handler(setup.Ctx, msgAddFirmwareImage(setup.Vendor))
+	require.Equal(t, sdk.CodeOK, result.Code)
+}
+
+func TestHandler_AddFirmwareImageForNotAllowedCertificationType(t *testing.T) {
+	setup := Setup()
+
+	// add certified model version
+	addCertifiedModelVersion(setup, constants.VID, constants.PID, constants.SoftwareVersion)
+
+	// disallow the certification type of the model
+	setup.ComplianceKeeper.SetParams(setup.Ctx,
+		compliance.NewParams([]compliance.CertificationType{compliance.MatterCertificationType}))
+
+	// try to add firmware image
+	result := setup.Handler(setup.Ctx, msgAddFirmwareImage(setup.Vendor))
+	require.Equal(t, types.CodeModelVersionIsNotCertified, result.Code)
+}
+
+func TestHandler_AddFirmwareImageTwice(t *testing.T) {
+	setup := Setup()
+
+	// add certified model version
+	addCertifiedModelVersion(setup, constants.VID, constants.PID, constants.SoftwareVersion)
+
+	// add firmware image
+	result := setup.Handler(setup.Ctx, msgAddFirmwareImage(setup.Vendor))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// add the same firmware image second time
+	result = setup.Handler(setup.Ctx, msgAddFirmwareImage(setup.Vendor))
+	require.Equal(t, types.CodeFirmwareImageAlreadyExists, result.Code)
+}
+
+func TestHandler_UpdateFirmwareImage(t *testing.T) {
+	setup := Setup()
+
+	// add certified model version and firmware image
+	addCertifiedModelVersion(setup, constants.VID, constants.PID, constants.SoftwareVersion)
+
+	msgAddFirmwareImage := msgAddFirmwareImage(setup.Vendor)
+	result := setup.Handler(setup.Ctx, msgAddFirmwareImage)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// update firmware image
+	msgUpdateFirmwareImage := NewMsgUpdateFirmwareImage(constants.VID, constants.PID, constants.SoftwareVersion,
+		"https://new.url", setup.Vendor)
+	result = setup.Handler(setup.Ctx, msgUpdateFirmwareImage)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query firmware image
+	receivedImage := setup.OtaKeeper.GetFirmwareImage(setup.Ctx,
+		constants.VID, constants.PID, constants.SoftwareVersion)
+
+	// check
+	require.Equal(t, msgUpdateFirmwareImage.URL, receivedImage.URL)
+	require.Equal(t, msgAddFirmwareImage.Checksum, receivedImage.Checksum)
+	require.Equal(t, msgAddFirmwareImage.Size, receivedImage.Size)
+}
+
+func TestHandler_UpdateFirmwareImageByNotOwner(t *testing.T) {
+	setup := Setup()
+
+	// add certified model version and firmware image
+	addCertifiedModelVersion(setup, constants.VID, constants.PID, constants.SoftwareVersion)
+
+	result := setup.Handler(setup.Ctx, msgAddFirmwareImage(setup.Vendor))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// create another vendor account
+	account := auth.NewAccount(constants.Address3, constants.PubKey3, auth.AccountRoles{auth.Vendor})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	// try to update firmware image
+	msgUpdateFirmwareImage := NewMsgUpdateFirmwareImage(constants.VID, constants.PID, constants.SoftwareVersion,
+		"https://new.url", account.Address)
+	result = setup.Handler(setup.Ctx, msgUpdateFirmwareImage)
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+}
+
+func TestHandler_UpdateUnknownFirmwareImage(t *testing.T) {
+	setup := Setup()
+
+	// try to update firmware image
+	msgUpdateFirmwareImage := NewMsgUpdateFirmwareImage(constants.VID, constants.PID, constants.SoftwareVersion,
+		"https://new.url", setup.Vendor)
+	result := setup.Handler(setup.Ctx, msgUpdateFirmwareImage)
+	require.Equal(t, types.CodeFirmwareImageDoesNotExist, result.Code)
+}
+
+func TestHandler_QueryAvailableUpdates(t *testing.T) {
+	setup := Setup()
+
+	// add certified model versions and firmware images
+	for _, softwareVersion := range []uint32{2, 3, 4} {
+		addCertifiedModelVersion(setup, constants.VID, constants.PID, softwareVersion)
+
+		msg := msgAddFirmwareImage(setup.Vendor)
+		msg.SoftwareVersion = softwareVersion
+		msg.MinApplicableSoftwareVersion = 1
+		msg.MaxApplicableSoftwareVersion = 2
+
+		result := setup.Handler(setup.Ctx, msg)
+		require.Equal(t, sdk.CodeOK, result.Code)
+	}
+
+	// query updates available for a device running version 2
+	updates := queryAvailableUpdates(setup, constants.VID, constants.PID, 2)
+
+	// check
+	require.Equal(t, 2, updates.Total)
+	require.Equal(t, uint32(3), updates.Items[0].SoftwareVersion)
+	require.Equal(t, uint32(4), updates.Items[1].SoftwareVersion)
+
+	// query updates available for a device running version 3
+	updates = queryAvailableUpdates(setup, constants.VID, constants.PID, 3)
+	require.Equal(t, 0, updates.Total)
+}
+
+func queryAvailableUpdates(setup TestSetup, vid uint16, pid uint16,
+	currentSoftwareVersion uint32) types.ListFirmwareImages {
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{
+			keeper.QueryAvailableUpdates, fmt.Sprintf("%v", vid),
+			fmt.Sprintf("%v", pid), fmt.Sprintf("%v", currentSoftwareVersion),
+		},
+		abci.RequestQuery{},
+	)
+
+	var updates types.ListFirmwareImages
+	_ = setup.Cdc.UnmarshalJSON(result, &updates)
+
+	return updates
+}
+
+func addModelVersion(setup TestSetup, vid uint16, pid uint16, softwareVersion uint32) {
+	if !setup.ModelinfoKeeper.IsModelInfoPresent(setup.Ctx, vid, pid) {
+		setup.ModelinfoKeeper.SetModelInfo(setup.Ctx, modelinfo.ModelInfo{
+			VID:             vid,
+			PID:             pid,
+			Name:            constants.Name,
+			Description:     constants.Description,
+			SKU:             constants.SKU,
+			HardwareVersion: constants.HardwareVersion,
+			FirmwareVersion: constants.FirmwareVersion,
+			Owner:           setup.Vendor,
+		})
+	}
+
+	setup.ModelinfoKeeper.SetModelVersion(setup.Ctx, modelinfo.ModelVersion{
+		VID:                   vid,
+		PID:                   pid,
+		SoftwareVersion:       softwareVersion,
+		SoftwareVersionString: constants.SoftwareVersionString,
+	})
+}
+
+func addCertifiedModelVersion(setup TestSetup, vid uint16, pid uint16, softwareVersion uint32) {
+	addModelVersion(setup, vid, pid, softwareVersion)
+
+	setup.ComplianceKeeper.SetComplianceInfo(setup.Ctx, compliance.ComplianceInfo{
+		VID:               vid,
+		PID:               pid,
+		State:             compliance.CertifiedState,
+		Date:              constants.CertificationDate,
+		CertificationType: compliance.ZbCertificationType,
+		Owner:             constants.Address2,
+	})
+
+	addCertificationDeclaration(setup, vid, pid, softwareVersion)
+}
+
+func addCertificationDeclaration(setup TestSetup, vid uint16, pid uint16, softwareVersion uint32) {
+	setup.ComplianceKeeper.SetCertificationDeclaration(setup.Ctx, compliance.CertificationDeclaration{
+		VID:               vid,
+		PID:               pid,
+		SoftwareVersion:   softwareVersion,
+		CertificationType: compliance.ZbCertificationType,
+		ContentHash:       constants.OtaChecksum,
+		Date:              constants.CertificationDate,
+		Owner:             constants.Address2,
+	})
+}
+
+func msgAddFirmwareImage(signer sdk.AccAddress) MsgAddFirmwareImage {
+	return NewMsgAddFirmwareImage(
+		constants.VID,
+		constants.PID,
+		constants.SoftwareVersion,
+		constants.OtaURL,
+		constants.OtaChecksum,
+		constants.OtaChecksumType,
+		constants.FirmwareImageSize,
+		constants.MinApplicableSoftwareVersion,
+		constants.MaxApplicableSoftwareVersion,
+		constants.ReleaseDate,
+		signer,
+	)
+}
+
+func checkFirmwareImage(t *testing.T, receivedImage types.FirmwareImage, expectedImage MsgAddFirmwareImage) {
+	require.Equal(t, expectedImage.VID, receivedImage.VID)
+	require.Equal(t, expectedImage.PID, receivedImage.PID)
+	require.Equal(t, expectedImage.SoftwareVersion, receivedImage.SoftwareVersion)
+	require.Equal(t, expectedImage.URL, receivedImage.URL)
+	require.Equal(t, expectedImage.Checksum, receivedImage.Checksum)
+	require.Equal(t, expectedImage.ChecksumType, receivedImage.ChecksumType)
+	require.Equal(t, expectedImage.Size, receivedImage.Size)
+	require.Equal(t, expectedImage.ReleaseDate, receivedImage.ReleaseDate)
+	require.Equal(t, expectedImage.Signer, receivedImage.Owner)
+}
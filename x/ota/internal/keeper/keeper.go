@@ -0,0 +1,104 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota/internal/types"
+)
+
+type Keeper struct {
+	// Unexposed key to access store from sdk.Context.
+	storeKey sdk.StoreKey
+
+	// The wire codec for binary encoding/decoding.
+	cdc *codec.Codec
+}
+
+func NewKeeper(storeKey sdk.StoreKey, cdc *codec.Codec) Keeper {
+	return Keeper{storeKey: storeKey, cdc: cdc}
+}
+
+// Gets the entire FirmwareImage struct for a software version of a Model.
+func (k Keeper) GetFirmwareImage(ctx sdk.Context, vid uint16, pid uint16, softwareVersion uint32) types.FirmwareImage {
+	if !k.IsFirmwareImagePresent(ctx, vid, pid, softwareVersion) {
+		panic("FirmwareImage does not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFirmwareImageKey(vid, pid, softwareVersion))
+
+	var image types.FirmwareImage
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &image)
+
+	return image
+}
+
+// Sets the entire FirmwareImage struct for a software version of a Model.
+func (k Keeper) SetFirmwareImage(ctx sdk.Context, image types.FirmwareImage) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetFirmwareImageKey(image.VID, image.PID, image.SoftwareVersion),
+		k.cdc.MustMarshalBinaryBare(image))
+}
+
+// Check if the FirmwareImage is present in the store or not.
+func (k Keeper) IsFirmwareImagePresent(ctx sdk.Context, vid uint16, pid uint16, softwareVersion uint32) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetFirmwareImageKey(vid, pid, softwareVersion))
+}
+
+// Iterate over FirmwareImages with the given key prefix (e.g. all images of a single Model).
+func (k Keeper) IterateFirmwareImages(ctx sdk.Context, prefix []byte,
+	process func(image types.FirmwareImage) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		val := iter.Value()
+
+		var image types.FirmwareImage
+
+		k.cdc.MustUnmarshalBinaryBare(val, &image)
+
+		if process(image) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+func (k Keeper) CountTotalFirmwareImages(ctx sdk.Context, prefix []byte) int {
+	store := ctx.KVStore(k.storeKey)
+	res := 0
+
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		res++
+	}
+
+	return res
+}
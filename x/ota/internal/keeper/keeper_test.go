@@ -0,0 +1,78 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota/internal/types"
+)
+
+func TestKeeper_FirmwareImageGetSet(t *testing.T) {
+	setup := Setup()
+
+	// check if firmware image present
+	require.False(t, setup.OtaKeeper.IsFirmwareImagePresent(
+		setup.Ctx, testconstants.VID, testconstants.PID, testconstants.SoftwareVersion))
+
+	// no firmware image before its created
+	require.Panics(t, func() {
+		setup.OtaKeeper.GetFirmwareImage(setup.Ctx, testconstants.VID, testconstants.PID, testconstants.SoftwareVersion)
+	})
+
+	// create firmware image
+	setup.OtaKeeper.SetFirmwareImage(setup.Ctx, DefaultFirmwareImage())
+
+	// check if firmware image present
+	require.True(t, setup.OtaKeeper.IsFirmwareImagePresent(
+		setup.Ctx, testconstants.VID, testconstants.PID, testconstants.SoftwareVersion))
+
+	// get firmware image
+	image := setup.OtaKeeper.GetFirmwareImage(
+		setup.Ctx, testconstants.VID, testconstants.PID, testconstants.SoftwareVersion)
+	require.Equal(t, DefaultFirmwareImage(), image)
+}
+
+func TestKeeper_FirmwareImageIterator(t *testing.T) {
+	setup := Setup()
+
+	// add images of two models
+	image := DefaultFirmwareImage()
+	for i := uint32(1); i <= 3; i++ {
+		image.SoftwareVersion = i
+		setup.OtaKeeper.SetFirmwareImage(setup.Ctx, image)
+	}
+
+	image.PID++
+	setup.OtaKeeper.SetFirmwareImage(setup.Ctx, image)
+
+	// check
+	prefix := types.GetFirmwareImagesPrefix(testconstants.VID, testconstants.PID)
+	require.Equal(t, 3, setup.OtaKeeper.CountTotalFirmwareImages(setup.Ctx, prefix))
+	require.Equal(t, 4, setup.OtaKeeper.CountTotalFirmwareImages(setup.Ctx, types.FirmwareImagePrefix))
+
+	var versions []uint32
+
+	setup.OtaKeeper.IterateFirmwareImages(setup.Ctx, prefix, func(image types.FirmwareImage) (stop bool) {
+		versions = append(versions, image.SoftwareVersion)
+
+		return false
+	})
+
+	require.Equal(t, []uint32{1, 2, 3}, versions)
+}
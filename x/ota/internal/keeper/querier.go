@@ -0,0 +1,126 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota/internal/types"
+)
+
+const (
+	QueryFirmwareImages   = "firmware_images"
+	QueryAvailableUpdates = "available_updates"
+)
+
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryFirmwareImages:
+			return queryFirmwareImages(ctx, path[1:], req, keeper)
+		case QueryAvailableUpdates:
+			return queryAvailableUpdates(ctx, path[1:], keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown ota query endpoint")
+		}
+	}
+}
+
+func queryFirmwareImages(ctx sdk.Context, path []string, req abci.RequestQuery,
+	keeper Keeper) (res []byte, err sdk.Error) {
+	vid, err := conversions.ParseVID(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := conversions.ParsePID(path[1])
+	if err != nil {
+		return nil, err
+	}
+
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("failed to parse request params: %s", err))
+	}
+
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := types.GetFirmwareImagesPrefix(vid, pid)
+
+	result := types.ListFirmwareImages{
+		Total: keeper.CountTotalFirmwareImages(ctx, prefix),
+		Items: []types.FirmwareImage{},
+	}
+
+	keeper.IterateFirmwareImages(ctx, prefix, func(image types.FirmwareImage) (stop bool) {
+		if paginator.Add(types.GetFirmwareImageKey(vid, pid, image.SoftwareVersion)) {
+			result.Items = append(result.Items, image)
+		}
+
+		return paginator.Done()
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+// Returns firmware images a device running the given software version can be updated with (ascending by version).
+func queryAvailableUpdates(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	vid, err := conversions.ParseVID(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	pid, err := conversions.ParsePID(path[1])
+	if err != nil {
+		return nil, err
+	}
+
+	currentSoftwareVersion, err := conversions.ParseUInt32FromString(path[2])
+	if err != nil {
+		return nil, err
+	}
+
+	result := types.ListFirmwareImages{
+		Total: 0,
+		Items: []types.FirmwareImage{},
+	}
+
+	keeper.IterateFirmwareImages(ctx, types.GetFirmwareImagesPrefix(vid, pid),
+		func(image types.FirmwareImage) (stop bool) {
+			if image.IsApplicableTo(currentSoftwareVersion) {
+				result.Items = append(result.Items, image)
+				result.Total++
+			}
+
+			return false
+		})
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota/internal/types"
+)
+
+func TestQuerier_QueryFirmwareImages(t *testing.T) {
+	setup := Setup()
+
+	// add 5 images
+	count := 5
+	image := DefaultFirmwareImage()
+
+	for i := 1; i <= count; i++ {
+		image.SoftwareVersion = uint32(i)
+		setup.OtaKeeper.SetFirmwareImage(setup.Ctx, image)
+	}
+
+	// query images skip=1 take=2
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{QueryFirmwareImages, fmt.Sprintf("%v", testconstants.VID), fmt.Sprintf("%v", testconstants.PID)},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(pagination.NewPaginationParams(1, 2))},
+	)
+
+	var receivedImages types.ListFirmwareImages
+	_ = setup.Cdc.UnmarshalJSON(result, &receivedImages)
+
+	// check
+	require.Equal(t, count, receivedImages.Total)
+	require.Equal(t, 2, len(receivedImages.Items))
+	require.Equal(t, uint32(2), receivedImages.Items[0].SoftwareVersion)
+	require.Equal(t, uint32(3), receivedImages.Items[1].SoftwareVersion)
+}
+
+func TestQuerier_QueryAvailableUpdates(t *testing.T) {
+	setup := Setup()
+
+	// add images: v2 applicable to any lower version, v3 applicable to v2 only, v4 applicable to v1..v3
+	image := DefaultFirmwareImage()
+
+	image.SoftwareVersion, image.MinApplicableSoftwareVersion, image.MaxApplicableSoftwareVersion = 2, 0, 0
+	setup.OtaKeeper.SetFirmwareImage(setup.Ctx, image)
+
+	image.SoftwareVersion, image.MinApplicableSoftwareVersion, image.MaxApplicableSoftwareVersion = 3, 2, 2
+	setup.OtaKeeper.SetFirmwareImage(setup.Ctx, image)
+
+	image.SoftwareVersion, image.MinApplicableSoftwareVersion, image.MaxApplicableSoftwareVersion = 4, 1, 3
+	setup.OtaKeeper.SetFirmwareImage(setup.Ctx, image)
+
+	// check
+	require.Equal(t, []uint32{2, 4}, queryAvailableUpdateVersions(setup, 1))
+	require.Equal(t, []uint32{3, 4}, queryAvailableUpdateVersions(setup, 2))
+	require.Equal(t, []uint32{4}, queryAvailableUpdateVersions(setup, 3))
+	require.Equal(t, []uint32(nil), queryAvailableUpdateVersions(setup, 4))
+}
+
+func queryAvailableUpdateVersions(setup TestSetup, currentSoftwareVersion uint32) []uint32 {
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{
+			QueryAvailableUpdates, fmt.Sprintf("%v", testconstants.VID),
+			fmt.Sprintf("%v", testconstants.PID), fmt.Sprintf("%v", currentSoftwareVersion),
+		},
+		abci.RequestQuery{},
+	)
+
+	var receivedImages types.ListFirmwareImages
+	_ = setup.Cdc.UnmarshalJSON(result, &receivedImages)
+
+	var versions []uint32
+	for _, image := range receivedImages.Items {
+		versions = append(versions, image.SoftwareVersion)
+	}
+
+	return versions
+}
@@ -0,0 +1,80 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/ota/internal/types"
+)
+
+type TestSetup struct {
+	Cdc       *codec.Codec
+	Ctx       sdk.Context
+	OtaKeeper Keeper
+	Querier   sdk.Querier
+}
+
+func Setup() TestSetup {
+	// Init Codec
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+
+	// Init KVSore
+	db := dbm.NewMemDB()
+	dbStore := store.NewCommitMultiStore(db)
+	otaKey := sdk.NewKVStoreKey(types.StoreKey)
+	dbStore.MountStoreWithDB(otaKey, sdk.StoreTypeIAVL, nil)
+	_ = dbStore.LoadLatestVersion()
+
+	// Init Keepers
+	otaKeeper := NewKeeper(otaKey, cdc)
+
+	// Init Querier
+	querier := NewQuerier(otaKeeper)
+
+	// Create context
+	ctx := sdk.NewContext(dbStore, abci.Header{ChainID: testconstants.ChainID}, false, log.NewNopLogger())
+
+	setup := TestSetup{
+		Cdc:       cdc,
+		Ctx:       ctx,
+		OtaKeeper: otaKeeper,
+		Querier:   querier,
+	}
+
+	return setup
+}
+
+func DefaultFirmwareImage() types.FirmwareImage {
+	return types.FirmwareImage{
+		VID:                          testconstants.VID,
+		PID:                          testconstants.PID,
+		SoftwareVersion:              testconstants.SoftwareVersion,
+		URL:                          testconstants.OtaURL,
+		Checksum:                     testconstants.OtaChecksum,
+		ChecksumType:                 testconstants.OtaChecksumType,
+		Size:                         testconstants.FirmwareImageSize,
+		MinApplicableSoftwareVersion: testconstants.MinApplicableSoftwareVersion,
+		MaxApplicableSoftwareVersion: testconstants.MaxApplicableSoftwareVersion,
+		ReleaseDate:                  testconstants.ReleaseDate,
+		Owner:                        testconstants.Owner,
+	}
+}
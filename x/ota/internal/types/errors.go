@@ -0,0 +1,55 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
+)
+
+const (
+	Codespace sdk.CodespaceType = ModuleName
+
+	CodeFirmwareImageAlreadyExists sdk.CodeType = 701
+	CodeFirmwareImageDoesNotExist  sdk.CodeType = 702
+	CodeModelVersionIsNotCertified sdk.CodeType = 703
+)
+
+func init() {
+	errcodes.Register(Codespace, CodeFirmwareImageAlreadyExists, "firmware_image_already_exists")
+	errcodes.Register(Codespace, CodeFirmwareImageDoesNotExist, "firmware_image_does_not_exist")
+	errcodes.Register(Codespace, CodeModelVersionIsNotCertified, "model_version_is_not_certified")
+}
+
+func ErrFirmwareImageAlreadyExists(vid interface{}, pid interface{}, softwareVersion interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeFirmwareImageAlreadyExists,
+		fmt.Sprintf("Firmware image associated with vid=%v, pid=%v and softwareVersion=%v "+
+			"already exists on the ledger", vid, pid, softwareVersion))
+}
+
+func ErrFirmwareImageDoesNotExist(vid interface{}, pid interface{}, softwareVersion interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeFirmwareImageDoesNotExist,
+		fmt.Sprintf("No firmware image associated with vid=%v, pid=%v and softwareVersion=%v "+
+			"exist on the ledger", vid, pid, softwareVersion))
+}
+
+func ErrModelVersionIsNotCertified(vid interface{}, pid interface{}, softwareVersion interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeModelVersionIsNotCertified,
+		fmt.Sprintf("Model version associated with vid=%v, pid=%v and softwareVersion=%v is not certified "+
+			"(it has no Certification Declaration of a certified model), so firmware image cannot be published",
+			vid, pid, softwareVersion))
+}
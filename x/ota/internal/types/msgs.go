@@ -0,0 +1,173 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/modelinfo"
+)
+
+const RouterKey = ModuleName
+
+type MsgAddFirmwareImage struct {
+	VID                          uint16         `json:"vid"`
+	PID                          uint16         `json:"pid"`
+	SoftwareVersion              uint32         `json:"software_version"`
+	URL                          string         `json:"url"`
+	Checksum                     string         `json:"checksum"`
+	ChecksumType                 string         `json:"checksum_type"`
+	Size                         uint64         `json:"size"`
+	MinApplicableSoftwareVersion uint32         `json:"min_applicable_software_version"`
+	MaxApplicableSoftwareVersion uint32         `json:"max_applicable_software_version"`
+	ReleaseDate                  time.Time      `json:"release_date"` // rfc3339 encoded date
+	Signer                       sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgAddFirmwareImage(
+	vid uint16,
+	pid uint16,
+	softwareVersion uint32,
+	url string,
+	checksum string,
+	checksumType string,
+	size uint64,
+	minApplicableSoftwareVersion uint32,
+	maxApplicableSoftwareVersion uint32,
+	releaseDate time.Time,
+	signer sdk.AccAddress,
+) MsgAddFirmwareImage {
+	return MsgAddFirmwareImage{
+		VID:                          vid,
+		PID:                          pid,
+		SoftwareVersion:              softwareVersion,
+		URL:                          url,
+		Checksum:                     checksum,
+		ChecksumType:                 checksumType,
+		Size:                         size,
+		MinApplicableSoftwareVersion: minApplicableSoftwareVersion,
+		MaxApplicableSoftwareVersion: maxApplicableSoftwareVersion,
+		ReleaseDate:                  releaseDate,
+		Signer:                       signer,
+	}
+}
+
+func (m MsgAddFirmwareImage) Route() string {
+	return RouterKey
+}
+
+func (m MsgAddFirmwareImage) Type() string {
+	return "add_firmware_image"
+}
+
+func (m MsgAddFirmwareImage) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if m.VID == 0 {
+		return sdk.ErrUnknownRequest("Invalid VID: it must be non zero 16-bit unsigned integer")
+	}
+
+	if m.PID == 0 {
+		return sdk.ErrUnknownRequest("Invalid PID: it must be non zero 16-bit unsigned integer")
+	}
+
+	if len(m.URL) == 0 {
+		return sdk.ErrUnknownRequest("Invalid URL: it cannot be empty")
+	}
+
+	if len(m.Checksum) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Checksum: it cannot be empty")
+	}
+
+	if len(m.ChecksumType) == 0 {
+		return sdk.ErrUnknownRequest("Invalid ChecksumType: it cannot be empty")
+	}
+
+	if m.Size == 0 {
+		return sdk.ErrUnknownRequest("Invalid Size: it must be non zero")
+	}
+
+	if m.ReleaseDate.IsZero() {
+		return sdk.ErrUnknownRequest("Invalid ReleaseDate: it cannot be empty")
+	}
+
+	return modelinfo.ValidateApplicableSoftwareVersions(m.MinApplicableSoftwareVersion, m.MaxApplicableSoftwareVersion)
+}
+
+func (m MsgAddFirmwareImage) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgAddFirmwareImage) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+type MsgUpdateFirmwareImage struct {
+	VID             uint16         `json:"vid"`
+	PID             uint16         `json:"pid"`
+	SoftwareVersion uint32         `json:"software_version"`
+	URL             string         `json:"url"`
+	Signer          sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgUpdateFirmwareImage(vid uint16, pid uint16, softwareVersion uint32,
+	url string, signer sdk.AccAddress) MsgUpdateFirmwareImage {
+	return MsgUpdateFirmwareImage{
+		VID:             vid,
+		PID:             pid,
+		SoftwareVersion: softwareVersion,
+		URL:             url,
+		Signer:          signer,
+	}
+}
+
+func (m MsgUpdateFirmwareImage) Route() string {
+	return RouterKey
+}
+
+func (m MsgUpdateFirmwareImage) Type() string {
+	return "update_firmware_image"
+}
+
+func (m MsgUpdateFirmwareImage) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if m.VID == 0 {
+		return sdk.ErrUnknownRequest("Invalid VID: it must be non zero 16-bit unsigned integer")
+	}
+
+	if m.PID == 0 {
+		return sdk.ErrUnknownRequest("Invalid PID: it must be non zero 16-bit unsigned integer")
+	}
+
+	if len(m.URL) == 0 {
+		return sdk.ErrUnknownRequest("Invalid URL: it cannot be empty")
+	}
+
+	return nil
+}
+
+func (m MsgUpdateFirmwareImage) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgUpdateFirmwareImage) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+)
+
+func TestNewMsgAddFirmwareImage(t *testing.T) {
+	msg := newMsgAddFirmwareImage(testconstants.VID, testconstants.PID, testconstants.OtaURL,
+		testconstants.OtaChecksum, testconstants.FirmwareImageSize, testconstants.MinApplicableSoftwareVersion,
+		testconstants.MaxApplicableSoftwareVersion, testconstants.ReleaseDate, testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "add_firmware_image")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestMsgAddFirmwareImageValidation(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgAddFirmwareImage
+	}{
+		{true, newMsgAddFirmwareImage(testconstants.VID, testconstants.PID, testconstants.OtaURL,
+			testconstants.OtaChecksum, testconstants.FirmwareImageSize, testconstants.MinApplicableSoftwareVersion,
+			testconstants.MaxApplicableSoftwareVersion, testconstants.ReleaseDate, testconstants.Signer)},
+		{false, newMsgAddFirmwareImage(0, testconstants.PID, testconstants.OtaURL,
+			testconstants.OtaChecksum, testconstants.FirmwareImageSize, testconstants.MinApplicableSoftwareVersion,
+			testconstants.MaxApplicableSoftwareVersion, testconstants.ReleaseDate, testconstants.Signer)},
+		{false, newMsgAddFirmwareImage(testconstants.VID, 0, testconstants.OtaURL,
+			testconstants.OtaChecksum, testconstants.FirmwareImageSize, testconstants.MinApplicableSoftwareVersion,
+			testconstants.MaxApplicableSoftwareVersion, testconstants.ReleaseDate, testconstants.Signer)},
+		{false, newMsgAddFirmwareImage(testconstants.VID, testconstants.PID, "",
+			testconstants.OtaChecksum, testconstants.FirmwareImageSize, testconstants.MinApplicableSoftwareVersion,
+			testconstants.MaxApplicableSoftwareVersion, testconstants.ReleaseDate, testconstants.Signer)},
+		{false, newMsgAddFirmwareImage(testconstants.VID, testconstants.PID, testconstants.OtaURL,
+			"", testconstants.FirmwareImageSize, testconstants.MinApplicableSoftwareVersion,
+			testconstants.MaxApplicableSoftwareVersion, testconstants.ReleaseDate, testconstants.Signer)},
+		{false, newMsgAddFirmwareImage(testconstants.VID, testconstants.PID, testconstants.OtaURL,
+			testconstants.OtaChecksum, 0, testconstants.MinApplicableSoftwareVersion,
+			testconstants.MaxApplicableSoftwareVersion, testconstants.ReleaseDate, testconstants.Signer)},
+		{true, newMsgAddFirmwareImage(testconstants.VID, testconstants.PID, testconstants.OtaURL,
+			testconstants.OtaChecksum, testconstants.FirmwareImageSize, 5, 0,
+			testconstants.ReleaseDate, testconstants.Signer)},
+		{false, newMsgAddFirmwareImage(testconstants.VID, testconstants.PID, testconstants.OtaURL,
+			testconstants.OtaChecksum, testconstants.FirmwareImageSize, 5, 4,
+			testconstants.ReleaseDate, testconstants.Signer)},
+		{false, newMsgAddFirmwareImage(testconstants.VID, testconstants.PID, testconstants.OtaURL,
+			testconstants.OtaChecksum, testconstants.FirmwareImageSize, testconstants.MinApplicableSoftwareVersion,
+			testconstants.MaxApplicableSoftwareVersion, time.Time{}, testconstants.Signer)},
+		{false, newMsgAddFirmwareImage(testconstants.VID, testconstants.PID, testconstants.OtaURL,
+			testconstants.OtaChecksum, testconstants.FirmwareImageSize, testconstants.MinApplicableSoftwareVersion,
+			testconstants.MaxApplicableSoftwareVersion, testconstants.ReleaseDate, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestMsgUpdateFirmwareImageValidation(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgUpdateFirmwareImage
+	}{
+		{true, NewMsgUpdateFirmwareImage(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			testconstants.OtaURL, testconstants.Signer)},
+		{false, NewMsgUpdateFirmwareImage(0, testconstants.PID, testconstants.SoftwareVersion,
+			testconstants.OtaURL, testconstants.Signer)},
+		{false, NewMsgUpdateFirmwareImage(testconstants.VID, 0, testconstants.SoftwareVersion,
+			testconstants.OtaURL, testconstants.Signer)},
+		{false, NewMsgUpdateFirmwareImage(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			"", testconstants.Signer)},
+		{false, NewMsgUpdateFirmwareImage(testconstants.VID, testconstants.PID, testconstants.SoftwareVersion,
+			testconstants.OtaURL, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func newMsgAddFirmwareImage(vid uint16, pid uint16, url string, checksum string, size uint64,
+	minApplicableSoftwareVersion uint32, maxApplicableSoftwareVersion uint32,
+	releaseDate time.Time, signer sdk.AccAddress) MsgAddFirmwareImage {
+	return NewMsgAddFirmwareImage(vid, pid, testconstants.SoftwareVersion, url, checksum,
+		testconstants.OtaChecksumType, size, minApplicableSoftwareVersion, maxApplicableSoftwareVersion,
+		releaseDate, signer)
+}
@@ -0,0 +1,87 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Metadata of a firmware image published for a software version of a Model.
+type FirmwareImage struct {
+	VID                          uint16         `json:"vid"`
+	PID                          uint16         `json:"pid"`
+	SoftwareVersion              uint32         `json:"software_version"`
+	URL                          string         `json:"url"`
+	Checksum                     string         `json:"checksum"`
+	ChecksumType                 string         `json:"checksum_type"`
+	Size                         uint64         `json:"size"`
+	MinApplicableSoftwareVersion uint32         `json:"min_applicable_software_version"`
+	MaxApplicableSoftwareVersion uint32         `json:"max_applicable_software_version"`
+	ReleaseDate                  time.Time      `json:"release_date"` // rfc3339 encoded date
+	Owner                        sdk.AccAddress `json:"owner"`
+}
+
+func NewFirmwareImage(
+	vid uint16,
+	pid uint16,
+	softwareVersion uint32,
+	url string,
+	checksum string,
+	checksumType string,
+	size uint64,
+	minApplicableSoftwareVersion uint32,
+	maxApplicableSoftwareVersion uint32,
+	releaseDate time.Time,
+	owner sdk.AccAddress,
+) FirmwareImage {
+	return FirmwareImage{
+		VID:                          vid,
+		PID:                          pid,
+		SoftwareVersion:              softwareVersion,
+		URL:                          url,
+		Checksum:                     checksum,
+		ChecksumType:                 checksumType,
+		Size:                         size,
+		MinApplicableSoftwareVersion: minApplicableSoftwareVersion,
+		MaxApplicableSoftwareVersion: maxApplicableSoftwareVersion,
+		ReleaseDate:                  releaseDate,
+		Owner:                        owner,
+	}
+}
+
+// Checks whether a device running the given software version can be updated with the image.
+func (d FirmwareImage) IsApplicableTo(currentSoftwareVersion uint32) bool {
+	if currentSoftwareVersion >= d.SoftwareVersion {
+		return false
+	}
+
+	if currentSoftwareVersion < d.MinApplicableSoftwareVersion {
+		return false
+	}
+
+	return d.MaxApplicableSoftwareVersion == 0 || currentSoftwareVersion <= d.MaxApplicableSoftwareVersion
+}
+
+func (d FirmwareImage) String() string {
+	bytes, err := json.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
@@ -0,0 +1,57 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package params
+
+import (
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params/internal/keeper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params/internal/types"
+)
+
+const (
+	ModuleName                           = types.ModuleName
+	RouterKey                            = types.RouterKey
+	StoreKey                             = types.StoreKey
+	TStoreKey                            = types.TStoreKey
+	DefaultParamspace                    = types.DefaultParamspace
+	CodeProposedParamChangeAlreadyExists = types.CodeProposedParamChangeAlreadyExists
+	CodeProposedParamChangeDoesNotExist  = types.CodeProposedParamChangeDoesNotExist
+	CodeUnknownParamSubspace             = types.CodeUnknownParamSubspace
+	CodeUnknownParamKey                  = types.CodeUnknownParamKey
+	CodeInvalidParamValue                = types.CodeInvalidParamValue
+)
+
+var (
+	NewKeeper                = keeper.NewKeeper
+	NewQuerier               = keeper.NewQuerier
+	NewParams                = types.NewParams
+	DefaultParams            = types.DefaultParams
+	NewParamChange           = types.NewParamChange
+	NewMsgProposeParamChange = types.NewMsgProposeParamChange
+	NewMsgApproveParamChange = types.NewMsgApproveParamChange
+	NewMsgRejectParamChange  = types.NewMsgRejectParamChange
+	ModuleCdc                = types.ModuleCdc
+	RegisterCodec            = types.RegisterCodec
+)
+
+type (
+	Keeper                = keeper.Keeper
+	Params                = types.Params
+	ParamSet              = types.ParamSet
+	ParamChange           = types.ParamChange
+	ProposedParamChange   = types.ProposedParamChange
+	MsgProposeParamChange = types.MsgProposeParamChange
+	MsgApproveParamChange = types.MsgApproveParamChange
+	MsgRejectParamChange  = types.MsgRejectParamChange
+)
@@ -0,0 +1,120 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	paramsQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the params module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	paramsQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdQueryProposedParamChange(queryRoute, cdc),
+		GetCmdQueryProposedParamChanges(queryRoute, cdc))...)
+
+	return paramsQueryCmd
+}
+
+// GetCmdQueryParams implements the subspace parameters query command.
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current parameters of the subspace",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/params/%s", queryRoute, viper.GetString(FlagSubspace)), nil)
+		},
+	}
+
+	cmd.Flags().String(FlagSubspace, "", "The parameter subspace (usually the name of the module)")
+
+	_ = cmd.MarkFlagRequired(FlagSubspace)
+
+	return cmd
+}
+
+// GetCmdQueryProposedParamChange implements the proposed parameter change query command.
+func GetCmdQueryProposedParamChange(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposed-param-change",
+		Short: "Query a proposed but not approved parameter change",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			subspace := viper.GetString(FlagSubspace)
+			key := viper.GetString(FlagKey)
+
+			res, height, err := cliCtx.QueryStore(types.GetProposedParamChangeKey(subspace, key), storeName)
+			if err != nil || res == nil {
+				return types.ErrProposedParamChangeDoesNotExist(subspace, key)
+			}
+
+			var proposedChange types.ProposedParamChange
+			cdc.MustUnmarshalBinaryBare(res, &proposedChange)
+
+			return cliCtx.EncodeAndPrintWithHeight(proposedChange, height)
+		},
+	}
+
+	cmd.Flags().String(FlagSubspace, "", "The parameter subspace")
+	cmd.Flags().String(FlagKey, "", "The parameter key")
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	_ = cmd.MarkFlagRequired(FlagSubspace)
+	_ = cmd.MarkFlagRequired(FlagKey)
+
+	return cmd
+}
+
+// GetCmdQueryProposedParamChanges implements the query all proposed parameter changes command.
+func GetCmdQueryProposedParamChanges(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-proposed-param-changes",
+		Short: "Query for all proposed but not approved parameter changes",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/proposed_changes", storeName), params)
+		},
+	}
+
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of changes to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of changes to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
@@ -0,0 +1,121 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params/internal/types"
+)
+
+func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	paramsTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Params transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	paramsTxCmd.AddCommand(cli.SignedCommands(client.PostCommands(
+		GetCmdProposeParamChange(cdc),
+		GetCmdApproveParamChange(cdc),
+		GetCmdRejectParamChange(cdc),
+	)...)...)
+
+	return paramsTxCmd
+}
+
+// GetCmdProposeParamChange implements the propose parameter change command handler.
+func GetCmdProposeParamChange(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-param-change",
+		Short: "Proposes a parameter change to be approved by Trustees",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			change := types.NewParamChange(viper.GetString(FlagSubspace), viper.GetString(FlagKey),
+				viper.GetString(FlagValue))
+
+			msg := types.NewMsgProposeParamChange(change, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSubspace, "", "The parameter subspace (usually the name of the module)")
+	cmd.Flags().String(FlagKey, "", "The parameter key")
+	cmd.Flags().String(FlagValue, "", "JSON encoded value of the parameter (integers must be quoted, e.g. '\"100\"')")
+
+	_ = cmd.MarkFlagRequired(FlagSubspace)
+	_ = cmd.MarkFlagRequired(FlagKey)
+	_ = cmd.MarkFlagRequired(FlagValue)
+
+	return cmd
+}
+
+// GetCmdApproveParamChange implements the approve parameter change command handler.
+func GetCmdApproveParamChange(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-param-change",
+		Short: "Approves the proposed parameter change",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgApproveParamChange(viper.GetString(FlagSubspace), viper.GetString(FlagKey),
+				cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSubspace, "", "The parameter subspace")
+	cmd.Flags().String(FlagKey, "", "The parameter key")
+
+	_ = cmd.MarkFlagRequired(FlagSubspace)
+	_ = cmd.MarkFlagRequired(FlagKey)
+
+	return cmd
+}
+
+// GetCmdRejectParamChange implements the reject parameter change command handler.
+func GetCmdRejectParamChange(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-param-change",
+		Short: "Rejects the proposed parameter change (the creator of the proposal withdraws it at once)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgRejectParamChange(viper.GetString(FlagSubspace), viper.GetString(FlagKey),
+				cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagSubspace, "", "The parameter subspace")
+	cmd.Flags().String(FlagKey, "", "The parameter key")
+
+	_ = cmd.MarkFlagRequired(FlagSubspace)
+	_ = cmd.MarkFlagRequired(FlagKey)
+
+	return cmd
+}
@@ -0,0 +1,72 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params/internal/types"
+)
+
+// HTTP request handler to query the current parameters of the subspace.
+func getParamsHandlerFn(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/params/%s", storeName, vars[subspace]), nil)
+	}
+}
+
+// HTTP request handler to query list of proposed parameter changes.
+func getProposedParamChangesHandlerFn(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		paginationParams, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/proposed_changes", storeName), paginationParams)
+	}
+}
+
+// HTTP request handler to query the proposed change of the parameter.
+func getProposedParamChangeHandlerFn(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+		paramSubspace, paramKey := vars[subspace], vars[key]
+
+		res, height, err := restCtx.QueryStore(types.GetProposedParamChangeKey(paramSubspace, paramKey), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrProposedParamChangeDoesNotExist(paramSubspace, paramKey))
+
+			return
+		}
+
+		var proposedChange types.ProposedParamChange
+
+		restCtx.Codec().MustUnmarshalBinaryBare(res, &proposedChange)
+
+		restCtx.EncodeAndRespondWithHeight(proposedChange, height)
+	}
+}
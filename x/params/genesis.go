@@ -0,0 +1,85 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package params
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params/internal/types"
+)
+
+// The parameters of the other modules are exported with the genesis states of these modules.
+type GenesisState struct {
+	Params               types.Params                `json:"params"`
+	ProposedParamChanges []types.ProposedParamChange `json:"proposed_param_changes"`
+}
+
+func NewGenesisState() GenesisState {
+	return GenesisState{
+		Params:               types.DefaultParams(),
+		ProposedParamChanges: []types.ProposedParamChange{},
+	}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	if err := withDefaultParams(data.Params).Validate(); err != nil {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Params: %v", err))
+	}
+
+	for _, record := range data.ProposedParamChanges {
+		if err := record.Change.ValidateBasic(); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ProposedParamChange: %v. Value: %v", err.Data(), record))
+		}
+
+		if len(record.Approvals) == 0 {
+			return sdk.ErrUnknownRequest(
+				fmt.Sprintf("Invalid ProposedParamChange: Missed Approvals. Value: %v", record))
+		}
+	}
+
+	return nil
+}
+
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState()
+}
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.ValidatorUpdate {
+	keeper.SetParams(ctx, withDefaultParams(data.Params))
+
+	for _, record := range data.ProposedParamChanges {
+		keeper.SetProposedParamChange(ctx, record)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+// The genesis files exported before the approval share became a parameter do not contain it.
+func withDefaultParams(params types.Params) types.Params {
+	if params.ParamChangeApprovalPercent.IsNil() {
+		params.ParamChangeApprovalPercent = types.DefaultParamChangeApprovalPercent
+	}
+
+	return params
+}
+
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	return GenesisState{
+		Params:               k.GetParams(ctx),
+		ProposedParamChanges: k.GetAllProposedParamChanges(ctx),
+	}
+}
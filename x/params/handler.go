@@ -0,0 +1,233 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package params
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params/internal/keeper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params/internal/types"
+)
+
+func init() {
+	// sender must have Trustee role to propose, approve and reject parameter changes
+	auth.RegisterMsgRoles(types.MsgProposeParamChange{}, auth.Trustee)
+	auth.RegisterMsgRoles(types.MsgApproveParamChange{}, auth.Trustee)
+	auth.RegisterMsgRoles(types.MsgRejectParamChange{}, auth.Trustee)
+}
+
+// ParamChangeApprovalPolicy defines the share of trustees required to approve a parameter change.
+// The share is a parameter of the module, so it can be changed by trustees as well.
+func ParamChangeApprovalPolicy(ctx sdk.Context, k keeper.Keeper) approvals.Policy {
+	return k.GetParams(ctx).ParamChangeApprovalPolicy()
+}
+
+func NewHandler(k keeper.Keeper, authKeeper auth.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgProposeParamChange:
+			return handleMsgProposeParamChange(ctx, k, authKeeper, msg)
+		case types.MsgApproveParamChange:
+			return handleMsgApproveParamChange(ctx, k, authKeeper, msg)
+		case types.MsgRejectParamChange:
+			return handleMsgRejectParamChange(ctx, k, authKeeper, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized params Msg type: %v", msg.Type())
+
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgProposeParamChange(ctx sdk.Context, k keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgProposeParamChange) sdk.Result {
+	// check if sender has enough rights to propose a parameter change
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// check if a change of the parameter is already proposed
+	if k.IsProposedParamChangePresent(ctx, msg.Change.Subspace, msg.Change.Key) {
+		return types.ErrProposedParamChangeAlreadyExists(msg.Change.Subspace, msg.Change.Key).Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposeParamChange,
+			sdk.NewAttribute(types.AttributeKeySubspace, msg.Change.Subspace),
+			sdk.NewAttribute(types.AttributeKeyKey, msg.Change.Key),
+			sdk.NewAttribute(types.AttributeKeyValue, msg.Change.Value),
+		),
+	)
+
+	// if more than 1 trustee's approval is needed, store proposed change (if it is valid) else apply it.
+	if ParamChangeApprovalPolicy(ctx, k).IsSingleVoteEnough(authKeeper.CountAccountsWithRole(ctx, auth.Trustee)) {
+		if err := changeParam(ctx, k, msg.Change); err != nil {
+			return err.Result()
+		}
+	} else {
+		if err := k.CheckParamChange(ctx, msg.Change); err != nil {
+			return err.Result()
+		}
+
+		k.SetProposedParamChange(ctx, types.NewProposedParamChange(msg.Change, msg.Signer))
+	}
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgApproveParamChange(ctx sdk.Context, k keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgApproveParamChange) sdk.Result {
+	// check if sender has enough rights to approve a parameter change
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// check if proposed change exists
+	if !k.IsProposedParamChangePresent(ctx, msg.Subspace, msg.Key) {
+		return types.ErrProposedParamChangeDoesNotExist(msg.Subspace, msg.Key).Result()
+	}
+
+	proposedChange := k.GetProposedParamChange(ctx, msg.Subspace, msg.Key)
+
+	// check if proposed change already has approval from signer
+	approved, added := approvals.AddApproval(proposedChange.Approvals, msg.Signer)
+	if !added {
+		return sdk.ErrUnauthorized(
+			fmt.Sprintf("Proposed change of the parameter %v/%v already has approval from=%v",
+				msg.Subspace, msg.Key, msg.Signer)).Result()
+	}
+
+	// check if proposed change already has rejection from signer
+	if proposedChange.HasRejectionFrom(msg.Signer) {
+		return sdk.ErrUnauthorized(
+			fmt.Sprintf("Proposed change of the parameter %v/%v already has rejection from=%v",
+				msg.Subspace, msg.Key, msg.Signer)).Result()
+	}
+
+	// append approval
+	proposedChange.Approvals = approved
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeApproveParamChange,
+			sdk.NewAttribute(types.AttributeKeySubspace, msg.Subspace),
+			sdk.NewAttribute(types.AttributeKeyKey, msg.Key),
+		),
+	)
+
+	// check if proposed change has enough approvals
+	if !ParamChangeApprovalPolicy(ctx, k).IsReached(len(proposedChange.Approvals),
+		authKeeper.CountAccountsWithRole(ctx, auth.Trustee)) {
+		// update proposed change record
+		k.SetProposedParamChange(ctx, proposedChange)
+
+		return sdk.Result{Events: ctx.EventManager().Events()}
+	}
+
+	// the other parameters of the subspace may have been changed while the change was pending,
+	// such a change can never be applied, so it is deleted to release the parameter
+	if err := changeParam(ctx, k, proposedChange.Change); err != nil {
+		k.DeleteProposedParamChange(ctx, msg.Subspace, msg.Key)
+		emitRejectParamChangeEvent(ctx, proposedChange.Change)
+
+		return sdk.Result{Events: ctx.EventManager().Events(), Log: err.Error()}
+	}
+
+	// delete proposed change record
+	k.DeleteProposedParamChange(ctx, msg.Subspace, msg.Key)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRejectParamChange(ctx sdk.Context, k keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgRejectParamChange) sdk.Result {
+	// check if sender has enough rights to reject a parameter change
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// check if proposed change exists
+	if !k.IsProposedParamChangePresent(ctx, msg.Subspace, msg.Key) {
+		return types.ErrProposedParamChangeDoesNotExist(msg.Subspace, msg.Key).Result()
+	}
+
+	proposedChange := k.GetProposedParamChange(ctx, msg.Subspace, msg.Key)
+
+	// the creator withdraws the own proposal at once
+	if !proposedChange.Creator.Equals(msg.Signer) {
+		// check if proposed change already has approval or rejection from signer
+		rejected, added := approvals.AddApproval(proposedChange.Rejections, msg.Signer)
+		if !added || proposedChange.HasApprovalFrom(msg.Signer) {
+			return sdk.ErrUnauthorized(
+				fmt.Sprintf("Proposed change of the parameter %v/%v already has approval or rejection from=%v",
+					msg.Subspace, msg.Key, msg.Signer)).Result()
+		}
+
+		// append rejection
+		proposedChange.Rejections = rejected
+
+		// check if proposed change has enough rejections
+		if !ParamChangeApprovalPolicy(ctx, k).IsReached(len(proposedChange.Rejections),
+			authKeeper.CountAccountsWithRole(ctx, auth.Trustee)) {
+			// update proposed change record
+			k.SetProposedParamChange(ctx, proposedChange)
+
+			return sdk.Result{}
+		}
+	}
+
+	// delete proposed change record, so the parameter change can be proposed again
+	k.DeleteProposedParamChange(ctx, msg.Subspace, msg.Key)
+	emitRejectParamChangeEvent(ctx, proposedChange.Change)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func emitRejectParamChangeEvent(ctx sdk.Context, change types.ParamChange) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRejectParamChange,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySubspace, change.Subspace),
+			sdk.NewAttribute(types.AttributeKeyKey, change.Key),
+			sdk.NewAttribute(types.AttributeKeyValue, change.Value),
+		),
+	)
+}
+
+// applies the approved parameter change.
+func changeParam(ctx sdk.Context, k keeper.Keeper, change types.ParamChange) sdk.Error {
+	if err := k.ApplyParamChange(ctx, change); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeParam,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySubspace, change.Subspace),
+			sdk.NewAttribute(types.AttributeKeyKey, change.Key),
+			sdk.NewAttribute(types.AttributeKeyValue, change.Value),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,316 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package params
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params/internal/keeper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params/internal/types"
+)
+
+func TestHandler_ProposeParamChange_OneApprovalIsNeeded(t *testing.T) {
+	setup := Setup()
+
+	// propose change by the only trustee
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeParamChange(keeper.DefaultParamChange(), setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// change is applied at once
+	require.Equal(t, types.NewParams(100, types.DefaultParamChangeApprovalPercent),
+		setup.ParamsKeeper.GetParams(setup.Ctx))
+	require.False(t, setup.ParamsKeeper.IsProposedParamChangePresent(setup.Ctx,
+		types.DefaultParamspace, string(types.KeyMaxPageSize)))
+}
+
+func TestHandler_ApproveParamChange_ApprovalPercentIsParam(t *testing.T) {
+	setup := Setup()
+	trustee2 := storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	// require approvals of all the trustees
+	params := types.DefaultParams()
+	params.ParamChangeApprovalPercent = sdk.OneDec()
+	setup.ParamsKeeper.SetParams(setup.Ctx, params)
+
+	change := keeper.DefaultParamChange()
+
+	// propose change
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeParamChange(change, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// approve by second trustee
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveParamChange(change.Subspace, change.Key, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// change is still pending
+	require.Equal(t, params, setup.ParamsKeeper.GetParams(setup.Ctx))
+	require.True(t, setup.ParamsKeeper.IsProposedParamChangePresent(setup.Ctx, change.Subspace, change.Key))
+}
+
+func TestHandler_ProposeApproveParamChange(t *testing.T) {
+	setup := Setup()
+	trustee2 := storeTrustee(setup)
+	trustee3 := storeTrustee(setup)
+
+	change := keeper.DefaultParamChange()
+
+	// propose change
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeParamChange(change, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	events := result.Events.ToABCIEvents()
+	require.Equal(t, types.EventTypeProposeParamChange, events[0].Type)
+
+	// change is not applied yet
+	require.Equal(t, types.DefaultParams(), setup.ParamsKeeper.GetParams(setup.Ctx))
+
+	proposedChange, _ := queryProposedParamChange(setup, change.Subspace, change.Key)
+	require.Equal(t, change, proposedChange.Change)
+	require.Equal(t, []sdk.AccAddress{setup.Trustee}, proposedChange.Approvals)
+
+	// approve by the same trustee
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveParamChange(change.Subspace, change.Key, setup.Trustee))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// approve by second trustee
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveParamChange(change.Subspace, change.Key, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// change is applied
+	require.Equal(t, types.NewParams(100, types.DefaultParamChangeApprovalPercent),
+		setup.ParamsKeeper.GetParams(setup.Ctx))
+
+	// proposed change is removed
+	_, err := queryProposedParamChange(setup, change.Subspace, change.Key)
+	require.Equal(t, types.CodeProposedParamChangeDoesNotExist, err.Code())
+
+	// approve by third trustee
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveParamChange(change.Subspace, change.Key, trustee3))
+	require.Equal(t, types.CodeProposedParamChangeDoesNotExist, result.Code)
+}
+
+func TestHandler_ProposeParamChange_OfAnotherModule(t *testing.T) {
+	setup := Setup()
+
+	change := types.NewParamChange(auth.DefaultParamspace, "AccountApprovalPercent", `"1.000000000000000000"`)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeParamChange(change, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	require.Equal(t, sdk.OneDec(), setup.authKeeper.GetParams(setup.Ctx).AccountApprovalPercent)
+}
+
+func TestHandler_ProposeParamChange_Invalid(t *testing.T) {
+	setup := Setup()
+	_ = storeTrustee(setup)
+
+	cases := []struct {
+		change types.ParamChange
+		code   sdk.CodeType
+	}{
+		{types.NewParamChange("unknown", "MaxPageSize", `"100"`), types.CodeUnknownParamSubspace},
+		{types.NewParamChange(types.DefaultParamspace, "Unknown", `"100"`), types.CodeUnknownParamKey},
+		{types.NewParamChange(types.DefaultParamspace, "MaxPageSize", `"-1"`), types.CodeInvalidParamValue},
+		{types.NewParamChange(auth.DefaultParamspace, "AccountApprovalPercent", `"1.5"`), types.CodeInvalidParamValue},
+	}
+
+	for _, tc := range cases {
+		// invalid changes are rejected when proposed, not when approved
+		result := setup.Handler(setup.Ctx, types.NewMsgProposeParamChange(tc.change, setup.Trustee))
+		require.Equal(t, tc.code, result.Code, tc.change)
+
+		require.False(t, setup.ParamsKeeper.IsProposedParamChangePresent(setup.Ctx, tc.change.Subspace, tc.change.Key))
+	}
+}
+
+func TestHandler_ProposeParamChange_ByNotTrustee(t *testing.T) {
+	setup := Setup()
+
+	for _, role := range []auth.AccountRole{auth.Vendor, auth.TestHouse, auth.ZBCertificationCenter, auth.NodeAdmin} {
+		// create signer account
+		account := auth.NewAccount(testconstants.Address2, testconstants.PubKey2, auth.AccountRoles{role})
+		setup.authKeeper.SetAccount(setup.Ctx, account)
+
+		// propose change
+		result := setup.Handler(setup.Ctx,
+			types.NewMsgProposeParamChange(keeper.DefaultParamChange(), testconstants.Address2))
+		require.Equal(t, auth.CodeMissingRole, result.Code)
+	}
+}
+
+func TestHandler_ProposeParamChange_Twice(t *testing.T) {
+	setup := Setup()
+	_ = storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeParamChange(keeper.DefaultParamChange(), setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, types.NewMsgProposeParamChange(keeper.DefaultParamChange(), setup.Trustee))
+	require.Equal(t, types.CodeProposedParamChangeAlreadyExists, result.Code)
+}
+
+func TestHandler_ApproveParamChange_ByNotTrustee(t *testing.T) {
+	setup := Setup()
+	_ = storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	change := keeper.DefaultParamChange()
+
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeParamChange(change, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	account := auth.NewAccount(testconstants.Address2, testconstants.PubKey2, auth.AccountRoles{auth.NodeAdmin})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	result = setup.Handler(setup.Ctx,
+		types.NewMsgApproveParamChange(change.Subspace, change.Key, testconstants.Address2))
+	require.Equal(t, auth.CodeMissingRole, result.Code)
+}
+
+func TestHandler_ApproveParamChange_WhenChangeCannotBeApplied(t *testing.T) {
+	setup := Setup()
+	trustee2 := storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	// the change has become invalid while it was pending
+	change := types.NewParamChange(types.DefaultParamspace, string(types.KeyMaxPageSize), `"-1"`)
+	setup.ParamsKeeper.SetProposedParamChange(setup.Ctx, types.NewProposedParamChange(change, setup.Trustee))
+
+	// the last approval deletes the proposed change
+	result := setup.Handler(setup.Ctx, types.NewMsgApproveParamChange(change.Subspace, change.Key, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+	require.Contains(t, result.Log, "MaxPageSize")
+
+	events := result.Events.ToABCIEvents()
+	require.Equal(t, types.EventTypeRejectParamChange, events[len(events)-1].Type)
+
+	require.Equal(t, types.DefaultParams(), setup.ParamsKeeper.GetParams(setup.Ctx))
+
+	_, err := queryProposedParamChange(setup, change.Subspace, change.Key)
+	require.Equal(t, types.CodeProposedParamChangeDoesNotExist, err.Code())
+}
+
+func TestHandler_RejectParamChange(t *testing.T) {
+	setup := Setup()
+	trustee2 := storeTrustee(setup)
+	trustee3 := storeTrustee(setup)
+	trustee4 := storeTrustee(setup)
+
+	change := keeper.DefaultParamChange()
+
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeParamChange(change, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveParamChange(change.Subspace, change.Key, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// a trustee who approved the change cannot reject it
+	result = setup.Handler(setup.Ctx, types.NewMsgRejectParamChange(change.Subspace, change.Key, trustee2))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// first rejection is not enough
+	result = setup.Handler(setup.Ctx, types.NewMsgRejectParamChange(change.Subspace, change.Key, trustee3))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	proposedChange, _ := queryProposedParamChange(setup, change.Subspace, change.Key)
+	require.Equal(t, []sdk.AccAddress{trustee3}, proposedChange.Rejections)
+
+	// a trustee who rejected the change cannot approve it
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveParamChange(change.Subspace, change.Key, trustee3))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// second rejection is not enough
+	result = setup.Handler(setup.Ctx, types.NewMsgRejectParamChange(change.Subspace, change.Key, trustee4))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	proposedChange, _ = queryProposedParamChange(setup, change.Subspace, change.Key)
+	require.Equal(t, []sdk.AccAddress{trustee3, trustee4}, proposedChange.Rejections)
+
+	// the creator withdraws the proposal at once
+	result = setup.Handler(setup.Ctx, types.NewMsgRejectParamChange(change.Subspace, change.Key, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	events := result.Events.ToABCIEvents()
+	require.Equal(t, types.EventTypeRejectParamChange, events[0].Type)
+
+	_, err := queryProposedParamChange(setup, change.Subspace, change.Key)
+	require.Equal(t, types.CodeProposedParamChangeDoesNotExist, err.Code())
+
+	require.Equal(t, types.DefaultParams(), setup.ParamsKeeper.GetParams(setup.Ctx))
+
+	// the change can be proposed again
+	result = setup.Handler(setup.Ctx, types.NewMsgProposeParamChange(change, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+}
+
+func TestHandler_RejectParamChange_ByTrustees(t *testing.T) {
+	setup := Setup()
+	trustee2 := storeTrustee(setup)
+	trustee3 := storeTrustee(setup)
+
+	change := keeper.DefaultParamChange()
+
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeParamChange(change, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	for _, trustee := range []sdk.AccAddress{trustee2, trustee3} {
+		result = setup.Handler(setup.Ctx, types.NewMsgRejectParamChange(change.Subspace, change.Key, trustee))
+		require.Equal(t, sdk.CodeOK, result.Code)
+	}
+
+	_, err := queryProposedParamChange(setup, change.Subspace, change.Key)
+	require.Equal(t, types.CodeProposedParamChangeDoesNotExist, err.Code())
+}
+
+func TestHandler_RejectParamChange_ForUnknownChange(t *testing.T) {
+	setup := Setup()
+
+	result := setup.Handler(setup.Ctx, types.NewMsgRejectParamChange(types.DefaultParamspace,
+		string(types.KeyMaxPageSize), setup.Trustee))
+	require.Equal(t, types.CodeProposedParamChangeDoesNotExist, result.Code)
+}
+
+func storeTrustee(setup TestSetup) sdk.AccAddress {
+	address, pubkey, _ := testconstants.TestAddress()
+	account := auth.NewAccount(address, pubkey, auth.AccountRoles{auth.Trustee})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	return address
+}
+
+func queryProposedParamChange(setup TestSetup, subspace string, key string) (*types.ProposedParamChange, sdk.Error) {
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{keeper.QueryProposedParamChange, subspace, key},
+		abci.RequestQuery{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposedChange types.ProposedParamChange
+
+	setup.Cdc.MustUnmarshalJSON(result, &proposedChange)
+
+	return &proposedChange, nil
+}
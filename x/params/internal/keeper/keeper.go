@@ -0,0 +1,253 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkparams "github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params/internal/types"
+)
+
+type Keeper struct {
+	// Unexposed key to access store from sdk.Context.
+	storeKey sdk.StoreKey
+
+	// The wire codec for binary encoding/decoding.
+	cdc *codec.Codec
+
+	// The keeper of the parameter subspaces (sharing the store of the module).
+	paramsKeeper sdkparams.Keeper
+
+	// The subspace of the module own parameters.
+	paramSpace sdkparams.Subspace
+
+	// Default parameters of the registered subspaces (by subspace name).
+	defaults map[string]types.ParamSet
+}
+
+func NewKeeper(storeKey *sdk.KVStoreKey, tStoreKey *sdk.TransientStoreKey, cdc *codec.Codec) Keeper {
+	k := Keeper{
+		storeKey:     storeKey,
+		cdc:          cdc,
+		paramsKeeper: sdkparams.NewKeeper(cdc, storeKey, tStoreKey, sdkparams.DefaultCodespace),
+		defaults:     map[string]types.ParamSet{},
+	}
+
+	defaults := types.DefaultParams()
+	k.paramSpace = k.Subspace(types.DefaultParamspace, &defaults).WithKeyTable(types.ParamKeyTable())
+
+	return k
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+/*
+	Parameter Subspaces
+*/
+
+// Allocates the parameter subspace for a module. The module keeper must set the key table of its parameters
+// to the subspace. The defaults are used for the parameters which have never been set.
+func (k Keeper) Subspace(name string, defaults types.ParamSet) sdkparams.Subspace {
+	if reflect.TypeOf(defaults).Kind() != reflect.Ptr {
+		panic(fmt.Sprintf("default parameters of the subspace %v must be passed by pointer", name))
+	}
+
+	space := k.paramsKeeper.Subspace(name)
+	k.defaults[name] = defaults
+
+	return space
+}
+
+// Check if the subspace with the given name is registered.
+func (k Keeper) HasSubspace(name string) bool {
+	_, ok := k.defaults[name]
+
+	return ok
+}
+
+// Gets all the parameters of the subspace (the defaults are used for the parameters which have never been set).
+func (k Keeper) GetParamSet(ctx sdk.Context, subspace string) types.ParamSet {
+	defaults, ok := k.defaults[subspace]
+	if !ok {
+		panic(fmt.Sprintf("parameter subspace not found: %v\n", subspace))
+	}
+
+	space, _ := k.paramsKeeper.GetSubspace(subspace)
+
+	paramSet := reflect.New(reflect.TypeOf(defaults).Elem())
+	paramSet.Elem().Set(reflect.ValueOf(defaults).Elem())
+
+	params := paramSet.Interface().(types.ParamSet)
+	for _, pair := range params.ParamSetPairs() {
+		space.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+// Changes the parameter if the resulting parameters of the subspace are valid.
+func (k Keeper) ApplyParamChange(ctx sdk.Context, change types.ParamChange) sdk.Error {
+	cacheCtx, write := ctx.CacheContext()
+
+	if err := k.applyParamChange(cacheCtx, change); err != nil {
+		return err
+	}
+
+	write()
+
+	return nil
+}
+
+// Checks that the change can be applied to the current parameters without applying it.
+func (k Keeper) CheckParamChange(ctx sdk.Context, change types.ParamChange) sdk.Error {
+	cacheCtx, _ := ctx.CacheContext()
+
+	return k.applyParamChange(cacheCtx, change)
+}
+
+func (k Keeper) applyParamChange(ctx sdk.Context, change types.ParamChange) sdk.Error {
+	if !k.HasSubspace(change.Subspace) {
+		return types.ErrUnknownParamSubspace(change.Subspace)
+	}
+
+	if !k.hasParamKey(change.Subspace, change.Key) {
+		return types.ErrUnknownParamKey(change.Subspace, change.Key)
+	}
+
+	space, _ := k.paramsKeeper.GetSubspace(change.Subspace)
+
+	if err := space.Update(ctx, []byte(change.Key), []byte(change.Value)); err != nil {
+		return types.ErrInvalidParamValue(change.Subspace, change.Key, err)
+	}
+
+	if err := k.GetParamSet(ctx, change.Subspace).Validate(); err != nil {
+		return types.ErrInvalidParamValue(change.Subspace, change.Key, err)
+	}
+
+	return nil
+}
+
+func (k Keeper) hasParamKey(subspace string, key string) bool {
+	for _, pair := range k.defaults[subspace].ParamSetPairs() {
+		if bytes.Equal(pair.Key, []byte(key)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/*
+	Module Params
+*/
+
+// Gets the module params. Default values are returned for the params which have not been set yet.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+// Sets the module params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+/*
+	Proposed Parameter Change by Subspace and Key
+*/
+
+// Gets the entire Proposed Parameter Change record associated with a parameter.
+func (k Keeper) GetProposedParamChange(ctx sdk.Context,
+	subspace string, key string) (change types.ProposedParamChange) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetProposedParamChangeKey(subspace, key))
+
+	if value == nil {
+		panic(fmt.Sprintf("proposed parameter change record not found for parameter: %v/%v\n", subspace, key))
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &change)
+
+	return change
+}
+
+// Sets the entire Proposed Parameter Change record for a parameter.
+func (k Keeper) SetProposedParamChange(ctx sdk.Context, change types.ProposedParamChange) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetProposedParamChangeKey(change.Change.Subspace, change.Change.Key),
+		k.cdc.MustMarshalBinaryBare(change))
+}
+
+// Check if the Proposed Parameter Change record associated with a parameter is present in the store or not.
+func (k Keeper) IsProposedParamChangePresent(ctx sdk.Context, subspace string, key string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetProposedParamChangeKey(subspace, key))
+}
+
+// Deletes the Proposed Parameter Change record associated with a parameter.
+func (k Keeper) DeleteProposedParamChange(ctx sdk.Context, subspace string, key string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetProposedParamChangeKey(subspace, key))
+}
+
+// get the set of all proposed parameter changes.
+func (k Keeper) GetAllProposedParamChanges(ctx sdk.Context) (changes []types.ProposedParamChange) {
+	k.IterateProposedParamChanges(ctx, func(change types.ProposedParamChange) (stop bool) {
+		changes = append(changes, change)
+
+		return false
+	})
+
+	return changes
+}
+
+// iterate over proposed parameter changes and apply function.
+func (k Keeper) IterateProposedParamChanges(ctx sdk.Context,
+	process func(change types.ProposedParamChange) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ProposedParamChangePrefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		var change types.ProposedParamChange
+
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &change)
+
+		if process(change) {
+			return
+		}
+
+		iter.Next()
+	}
+}
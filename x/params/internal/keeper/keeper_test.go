@@ -0,0 +1,177 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkparams "github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params/internal/types"
+)
+
+// parameters of a module using the subspace.
+type testParams struct {
+	Percent sdk.Dec  `json:"percent"`
+	Names   []string `json:"names"`
+}
+
+func (p *testParams) ParamSetPairs() sdkparams.ParamSetPairs {
+	return sdkparams.ParamSetPairs{
+		{Key: []byte("Percent"), Value: &p.Percent},
+		{Key: []byte("Names"), Value: &p.Names},
+	}
+}
+
+func (p testParams) Validate() error {
+	if len(p.Names) == 0 {
+		return errors.New("names cannot be empty")
+	}
+
+	return nil
+}
+
+func setupTestSubspace(setup TestSetup) sdkparams.Subspace {
+	defaults := testParams{Percent: sdk.NewDecWithPrec(66, 2), Names: []string{"a", "b"}}
+
+	return setup.ParamsKeeper.Subspace("test", &defaults).WithKeyTable(
+		sdkparams.NewKeyTable().RegisterParamSet(&testParams{}))
+}
+
+func TestKeeper_ParamsGetSet(t *testing.T) {
+	setup := Setup()
+
+	// default params are returned before they are set
+	require.Equal(t, types.DefaultParams(), setup.ParamsKeeper.GetParams(setup.Ctx))
+
+	params := types.NewParams(50, types.DefaultParamChangeApprovalPercent)
+	setup.ParamsKeeper.SetParams(setup.Ctx, params)
+
+	require.Equal(t, params, setup.ParamsKeeper.GetParams(setup.Ctx))
+}
+
+func TestKeeper_GetParamSet(t *testing.T) {
+	setup := Setup()
+	space := setupTestSubspace(setup)
+
+	require.True(t, setup.ParamsKeeper.HasSubspace("test"))
+	require.True(t, setup.ParamsKeeper.HasSubspace(types.DefaultParamspace))
+	require.False(t, setup.ParamsKeeper.HasSubspace("unknown"))
+
+	// defaults are used for the parameters which have never been set
+	params := setup.ParamsKeeper.GetParamSet(setup.Ctx, "test")
+	require.Equal(t, &testParams{Percent: sdk.NewDecWithPrec(66, 2), Names: []string{"a", "b"}}, params)
+
+	space.Set(setup.Ctx, []byte("Names"), []string{"c"})
+
+	params = setup.ParamsKeeper.GetParamSet(setup.Ctx, "test")
+	require.Equal(t, &testParams{Percent: sdk.NewDecWithPrec(66, 2), Names: []string{"c"}}, params)
+
+	require.Panics(t, func() {
+		setup.ParamsKeeper.GetParamSet(setup.Ctx, "unknown")
+	})
+}
+
+func TestKeeper_ApplyParamChange(t *testing.T) {
+	setup := Setup()
+	setupTestSubspace(setup)
+
+	// change own parameter
+	err := setup.ParamsKeeper.ApplyParamChange(setup.Ctx, DefaultParamChange())
+	require.Nil(t, err)
+	require.Equal(t, types.NewParams(100, types.DefaultParamChangeApprovalPercent),
+		setup.ParamsKeeper.GetParams(setup.Ctx))
+
+	// change parameter of another subspace
+	err = setup.ParamsKeeper.ApplyParamChange(setup.Ctx, types.NewParamChange("test", "Percent", `"0.75"`))
+	require.Nil(t, err)
+	require.Equal(t, &testParams{Percent: sdk.NewDecWithPrec(75, 2), Names: []string{"a", "b"}},
+		setup.ParamsKeeper.GetParamSet(setup.Ctx, "test"))
+}
+
+func TestKeeper_ApplyParamChange_Invalid(t *testing.T) {
+	setup := Setup()
+	setupTestSubspace(setup)
+
+	cases := []struct {
+		change types.ParamChange
+		code   sdk.CodeType
+	}{
+		{types.NewParamChange("unknown", "MaxPageSize", `"100"`), types.CodeUnknownParamSubspace},
+		{types.NewParamChange(types.DefaultParamspace, "Unknown", `"100"`), types.CodeUnknownParamKey},
+		{types.NewParamChange(types.DefaultParamspace, "MaxPageSize", `100`), types.CodeInvalidParamValue},
+		{types.NewParamChange(types.DefaultParamspace, "MaxPageSize", `"-1"`), types.CodeInvalidParamValue},
+		{types.NewParamChange("test", "Names", `[]`), types.CodeInvalidParamValue},
+	}
+
+	for _, tc := range cases {
+		err := setup.ParamsKeeper.ApplyParamChange(setup.Ctx, tc.change)
+		require.NotNil(t, err, tc.change)
+		require.Equal(t, tc.code, err.Code(), tc.change)
+
+		err = setup.ParamsKeeper.CheckParamChange(setup.Ctx, tc.change)
+		require.NotNil(t, err, tc.change)
+		require.Equal(t, tc.code, err.Code(), tc.change)
+	}
+
+	// rejected changes are not applied
+	require.Equal(t, types.DefaultParams(), setup.ParamsKeeper.GetParams(setup.Ctx))
+	require.Equal(t, []string{"a", "b"}, setup.ParamsKeeper.GetParamSet(setup.Ctx, "test").(*testParams).Names)
+}
+
+func TestKeeper_CheckParamChange(t *testing.T) {
+	setup := Setup()
+
+	// the change is checked but not applied
+	err := setup.ParamsKeeper.CheckParamChange(setup.Ctx, DefaultParamChange())
+	require.Nil(t, err)
+	require.Equal(t, types.DefaultParams(), setup.ParamsKeeper.GetParams(setup.Ctx))
+}
+
+func TestKeeper_ProposedParamChangeGetSet(t *testing.T) {
+	setup := Setup()
+	change := DefaultParamChange()
+
+	// check if proposed change present
+	require.False(t, setup.ParamsKeeper.IsProposedParamChangePresent(setup.Ctx, change.Subspace, change.Key))
+
+	// no proposed change before it is created
+	require.Panics(t, func() {
+		setup.ParamsKeeper.GetProposedParamChange(setup.Ctx, change.Subspace, change.Key)
+	})
+
+	// create proposed change
+	proposedChange := types.NewProposedParamChange(change, testconstants.Address1)
+	setup.ParamsKeeper.SetProposedParamChange(setup.Ctx, proposedChange)
+
+	// check if proposed change present
+	require.True(t, setup.ParamsKeeper.IsProposedParamChangePresent(setup.Ctx, change.Subspace, change.Key))
+
+	// get proposed change
+	receivedChange := setup.ParamsKeeper.GetProposedParamChange(setup.Ctx, change.Subspace, change.Key)
+	require.Equal(t, proposedChange, receivedChange)
+
+	// get all proposed changes
+	require.Equal(t, []types.ProposedParamChange{proposedChange},
+		setup.ParamsKeeper.GetAllProposedParamChanges(setup.Ctx))
+
+	// delete proposed change
+	setup.ParamsKeeper.DeleteProposedParamChange(setup.Ctx, change.Subspace, change.Key)
+	require.False(t, setup.ParamsKeeper.IsProposedParamChangePresent(setup.Ctx, change.Subspace, change.Key))
+}
@@ -0,0 +1,107 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params/internal/types"
+)
+
+// query endpoints supported by the params Querier.
+const (
+	QueryParams               = "params"
+	QueryProposedParamChanges = "proposed_changes"
+	QueryProposedParamChange  = "proposed_change"
+)
+
+// creates a querier for params module.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryParams:
+			return queryParams(ctx, path[1:], k)
+		case QueryProposedParamChanges:
+			return queryProposedParamChanges(ctx, req, k)
+		case QueryProposedParamChange:
+			return queryProposedParamChange(ctx, path[1:], k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown params query endpoint")
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	subspace := path[0]
+
+	if !k.HasSubspace(subspace) {
+		return nil, types.ErrUnknownParamSubspace(subspace)
+	}
+
+	params := k.GetParamSet(ctx, subspace)
+
+	res := codec.MustMarshalJSONIndent(k.cdc, params)
+
+	return res, nil
+}
+
+func queryProposedParamChanges(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Failed to parse request params: %s", err))
+	}
+
+	result := types.NewListProposedParamChanges()
+
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.IterateProposedParamChanges(ctx, func(change types.ProposedParamChange) (stop bool) {
+		result.Total++
+
+		if paginator.Add(types.GetProposedParamChangeKey(change.Change.Subspace, change.Change.Key)) {
+			result.Items = append(result.Items, change)
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func queryProposedParamChange(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	subspace, key := path[0], path[1]
+
+	if !k.IsProposedParamChangePresent(ctx, subspace, key) {
+		return nil, types.ErrProposedParamChangeDoesNotExist(subspace, key)
+	}
+
+	change := k.GetProposedParamChange(ctx, subspace, key)
+
+	res := codec.MustMarshalJSONIndent(types.ModuleCdc, change)
+
+	return res, nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/params/internal/types"
+)
+
+func TestQuerier_QueryParams(t *testing.T) {
+	setup := Setup()
+
+	// set params
+	params := types.NewParams(50, types.DefaultParamChangeApprovalPercent)
+	setup.ParamsKeeper.SetParams(setup.Ctx, params)
+
+	// query params
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{QueryParams, types.DefaultParamspace},
+		abci.RequestQuery{},
+	)
+
+	var receivedParams types.Params
+
+	setup.Cdc.MustUnmarshalJSON(result, &receivedParams)
+
+	// check
+	require.Equal(t, params, receivedParams)
+
+	// query params of unknown subspace
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryParams, "unknown"},
+		abci.RequestQuery{},
+	)
+
+	// check
+	require.Nil(t, result)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeUnknownParamSubspace, err.Code())
+}
+
+func TestQuerier_QueryProposedParamChange(t *testing.T) {
+	setup := Setup()
+
+	// add proposed change
+	change := types.NewProposedParamChange(DefaultParamChange(), testconstants.Address1)
+	setup.ParamsKeeper.SetProposedParamChange(setup.Ctx, change)
+
+	// query proposed change
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{QueryProposedParamChange, change.Change.Subspace, change.Change.Key},
+		abci.RequestQuery{},
+	)
+
+	var receivedChange types.ProposedParamChange
+
+	setup.Cdc.MustUnmarshalJSON(result, &receivedChange)
+
+	// check
+	require.Equal(t, change, receivedChange)
+
+	// query unknown proposed change
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryProposedParamChange, change.Change.Subspace, "unknown"},
+		abci.RequestQuery{},
+	)
+
+	// check
+	require.Nil(t, result)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeProposedParamChangeDoesNotExist, err.Code())
+}
+
+func TestQuerier_QueryProposedParamChanges(t *testing.T) {
+	setup := Setup()
+
+	// add 2 proposed changes
+	change1 := types.NewProposedParamChange(
+		types.NewParamChange("auth", "MaxMemoCharacters", `"512"`), testconstants.Address1)
+	setup.ParamsKeeper.SetProposedParamChange(setup.Ctx, change1)
+
+	change2 := types.NewProposedParamChange(DefaultParamChange(), testconstants.Address2)
+	setup.ParamsKeeper.SetProposedParamChange(setup.Ctx, change2)
+
+	// query all proposed changes
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{QueryProposedParamChanges},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(pagination.NewPaginationParams(0, 0))},
+	)
+
+	var listChanges types.ListProposedParamChanges
+
+	setup.Cdc.MustUnmarshalJSON(result, &listChanges)
+
+	// check
+	require.Equal(t, 2, listChanges.Total)
+	require.Equal(t, []types.ProposedParamChange{change1, change2}, listChanges.Items)
+
+	// query with the maximum page size limiting the page
+	result, _ = setup.Querier(
+		pagination.WithMaxPageSize(setup.Ctx, 1),
+		[]string{QueryProposedParamChanges},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(pagination.NewPaginationParams(0, 0))},
+	)
+
+	listChanges = types.ListProposedParamChanges{}
+	setup.Cdc.MustUnmarshalJSON(result, &listChanges)
+
+	// check
+	require.Equal(t, 2, listChanges.Total)
+	require.Equal(t, []types.ProposedParamChange{change1}, listChanges.Items)
+	require.NotEmpty(t, listChanges.NextKey)
+}
@@ -0,0 +1,65 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
+)
+
+const (
+	Codespace sdk.CodespaceType = ModuleName
+
+	CodeProposedParamChangeAlreadyExists sdk.CodeType = 1101
+	CodeProposedParamChangeDoesNotExist  sdk.CodeType = 1102
+	CodeUnknownParamSubspace             sdk.CodeType = 1103
+	CodeUnknownParamKey                  sdk.CodeType = 1104
+	CodeInvalidParamValue                sdk.CodeType = 1105
+)
+
+func init() {
+	errcodes.Register(Codespace, CodeProposedParamChangeAlreadyExists, "proposed_param_change_already_exists")
+	errcodes.Register(Codespace, CodeProposedParamChangeDoesNotExist, "proposed_param_change_does_not_exist")
+	errcodes.Register(Codespace, CodeUnknownParamSubspace, "unknown_param_subspace")
+	errcodes.Register(Codespace, CodeUnknownParamKey, "unknown_param_key")
+	errcodes.Register(Codespace, CodeInvalidParamValue, "invalid_param_value")
+}
+
+func ErrProposedParamChangeAlreadyExists(subspace interface{}, key interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeProposedParamChangeAlreadyExists,
+		fmt.Sprintf("Proposed change of the parameter %v/%v already exists on the ledger", subspace, key))
+}
+
+func ErrProposedParamChangeDoesNotExist(subspace interface{}, key interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeProposedParamChangeDoesNotExist,
+		fmt.Sprintf("No proposed change of the parameter %v/%v on the ledger", subspace, key))
+}
+
+func ErrUnknownParamSubspace(subspace interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeUnknownParamSubspace,
+		fmt.Sprintf("No parameter subspace associated with the name=%v", subspace))
+}
+
+func ErrUnknownParamKey(subspace interface{}, key interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeUnknownParamKey,
+		fmt.Sprintf("No parameter associated with the key=%v in the subspace=%v", key, subspace))
+}
+
+func ErrInvalidParamValue(subspace interface{}, key interface{}, err interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeInvalidParamValue,
+		fmt.Sprintf("Invalid value of the parameter %v/%v: %v", subspace, key, err))
+}
@@ -0,0 +1,42 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	sdkparams "github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	// ModuleName is the name of the module.
+	ModuleName = "params"
+
+	// StoreKey to be used when creating the KVStore.
+	// The store is shared with the parameter subspaces of the modules (keyed by "<subspace>/" prefixes).
+	StoreKey = sdkparams.StoreKey
+
+	// TStoreKey to be used when creating the transient store recording the parameters changed in the block.
+	TStoreKey = sdkparams.TStoreKey
+
+	// DefaultParamspace is the name of the parameter subspace of the module.
+	DefaultParamspace = ModuleName
+)
+
+var (
+	ProposedParamChangePrefix = []byte{0x01} // prefix for each key to a proposed parameter change
+)
+
+func GetProposedParamChangeKey(subspace string, key string) []byte {
+	return append(ProposedParamChangePrefix, []byte(subspace+"/"+key)...)
+}
@@ -0,0 +1,146 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const RouterKey = ModuleName
+
+/*
+	Propose a parameter change to be approved by Trustees
+*/
+type MsgProposeParamChange struct {
+	Change ParamChange    `json:"change"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgProposeParamChange(change ParamChange, signer sdk.AccAddress) MsgProposeParamChange {
+	return MsgProposeParamChange{
+		Change: change,
+		Signer: signer,
+	}
+}
+
+func (m MsgProposeParamChange) Route() string { return RouterKey }
+
+func (m MsgProposeParamChange) Type() string { return EventTypeProposeParamChange }
+
+func (m MsgProposeParamChange) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	return m.Change.ValidateBasic()
+}
+
+func (m MsgProposeParamChange) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func (m MsgProposeParamChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+/*
+	Approve the proposed parameter change
+*/
+type MsgApproveParamChange struct {
+	Subspace string         `json:"subspace"`
+	Key      string         `json:"key"`
+	Signer   sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgApproveParamChange(subspace string, key string, signer sdk.AccAddress) MsgApproveParamChange {
+	return MsgApproveParamChange{
+		Subspace: subspace,
+		Key:      key,
+		Signer:   signer,
+	}
+}
+
+func (m MsgApproveParamChange) Route() string { return RouterKey }
+
+func (m MsgApproveParamChange) Type() string { return EventTypeApproveParamChange }
+
+func (m MsgApproveParamChange) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if len(m.Subspace) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Subspace: it cannot be empty")
+	}
+
+	if len(m.Key) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Key: it cannot be empty")
+	}
+
+	return nil
+}
+
+func (m MsgApproveParamChange) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func (m MsgApproveParamChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+/*
+	Reject the proposed parameter change (or withdraw the own proposal)
+*/
+type MsgRejectParamChange struct {
+	Subspace string         `json:"subspace"`
+	Key      string         `json:"key"`
+	Signer   sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgRejectParamChange(subspace string, key string, signer sdk.AccAddress) MsgRejectParamChange {
+	return MsgRejectParamChange{
+		Subspace: subspace,
+		Key:      key,
+		Signer:   signer,
+	}
+}
+
+func (m MsgRejectParamChange) Route() string { return RouterKey }
+
+func (m MsgRejectParamChange) Type() string { return EventTypeRejectParamChange }
+
+func (m MsgRejectParamChange) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if len(m.Subspace) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Subspace: it cannot be empty")
+	}
+
+	if len(m.Key) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Key: it cannot be empty")
+	}
+
+	return nil
+}
+
+func (m MsgRejectParamChange) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func (m MsgRejectParamChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
@@ -0,0 +1,129 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+)
+
+/*
+	MsgProposeParamChange
+*/
+
+func TestNewMsgProposeParamChange(t *testing.T) {
+	msg := NewMsgProposeParamChange(NewParamChange(DefaultParamspace, string(KeyMaxPageSize), `"100"`),
+		testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "propose_param_change")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestValidateMsgProposeParamChange(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgProposeParamChange
+	}{
+		{true, NewMsgProposeParamChange(NewParamChange("params", "MaxPageSize", `"100"`), testconstants.Signer)},
+		{true, NewMsgProposeParamChange(NewParamChange("compliance", "CertificationTypes", `["zb"]`),
+			testconstants.Signer)},
+		{false, NewMsgProposeParamChange(NewParamChange("", "MaxPageSize", `"100"`), testconstants.Signer)},
+		{false, NewMsgProposeParamChange(NewParamChange("params", "", `"100"`), testconstants.Signer)},
+		{false, NewMsgProposeParamChange(NewParamChange("params", "MaxPageSize", ""), testconstants.Signer)},
+		{false, NewMsgProposeParamChange(NewParamChange("params", "MaxPageSize", `"100`), testconstants.Signer)},
+		{false, NewMsgProposeParamChange(NewParamChange("params", "MaxPageSize",
+			`"`+string(make([]byte, MaxParamValueLength))+`"`), testconstants.Signer)},
+		{false, NewMsgProposeParamChange(NewParamChange("params", "MaxPageSize", `"100"`), nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+/*
+	MsgApproveParamChange
+*/
+
+func TestNewMsgApproveParamChange(t *testing.T) {
+	msg := NewMsgApproveParamChange(DefaultParamspace, string(KeyMaxPageSize), testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "approve_param_change")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestNewMsgRejectParamChange(t *testing.T) {
+	msg := NewMsgRejectParamChange(DefaultParamspace, string(KeyMaxPageSize), testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "reject_param_change")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestValidateMsgRejectParamChange(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgRejectParamChange
+	}{
+		{true, NewMsgRejectParamChange("params", "MaxPageSize", testconstants.Signer)},
+		{false, NewMsgRejectParamChange("", "MaxPageSize", testconstants.Signer)},
+		{false, NewMsgRejectParamChange("params", "", testconstants.Signer)},
+		{false, NewMsgRejectParamChange("params", "MaxPageSize", nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestValidateMsgApproveParamChange(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgApproveParamChange
+	}{
+		{true, NewMsgApproveParamChange("params", "MaxPageSize", testconstants.Signer)},
+		{false, NewMsgApproveParamChange("", "MaxPageSize", testconstants.Signer)},
+		{false, NewMsgApproveParamChange("params", "", testconstants.Signer)},
+		{false, NewMsgApproveParamChange("params", "MaxPageSize", nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkparams "github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
+)
+
+// Parameter keys of the module subspace.
+var (
+	KeyMaxPageSize                = []byte("MaxPageSize")
+	KeyParamChangeApprovalPercent = []byte("ParamChangeApprovalPercent")
+)
+
+// Share of trustees required to approve a parameter change by default.
+var DefaultParamChangeApprovalPercent = sdk.NewDecWithPrec(66, 2)
+
+/*
+	Parameters of the module stored in the module subspace (can be changed by Trustees)
+*/
+type Params struct {
+	// the maximum number of items returned by a list query, larger (and unlimited) pages are truncated.
+	// Zero means no limit.
+	MaxPageSize int `json:"max_page_size"`
+	// the share of trustees required to approve a parameter change (including the change of this share).
+	// It is at most 1, so the trustees cannot lock themselves out of the parameter changes.
+	ParamChangeApprovalPercent sdk.Dec `json:"param_change_approval_percent"`
+}
+
+func NewParams(maxPageSize int, paramChangeApprovalPercent sdk.Dec) Params {
+	return Params{
+		MaxPageSize:                maxPageSize,
+		ParamChangeApprovalPercent: paramChangeApprovalPercent,
+	}
+}
+
+func DefaultParams() Params {
+	return NewParams(DefaultMaxPageSize, DefaultParamChangeApprovalPercent)
+}
+
+// ParamKeyTable returns the key table of the module subspace.
+func ParamKeyTable() sdkparams.KeyTable {
+	return sdkparams.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() sdkparams.ParamSetPairs {
+	return sdkparams.ParamSetPairs{
+		{Key: KeyMaxPageSize, Value: &p.MaxPageSize},
+		{Key: KeyParamChangeApprovalPercent, Value: &p.ParamChangeApprovalPercent},
+	}
+}
+
+func (p Params) Validate() error {
+	if p.MaxPageSize < 0 {
+		return fmt.Errorf("invalid MaxPageSize: it must be non-negative, got %v", p.MaxPageSize)
+	}
+
+	if p.ParamChangeApprovalPercent.IsNil() || !p.ParamChangeApprovalPercent.IsPositive() ||
+		p.ParamChangeApprovalPercent.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid ParamChangeApprovalPercent: it must be greater than 0 and at most 1, got %v",
+			p.ParamChangeApprovalPercent)
+	}
+
+	return nil
+}
+
+// ParamChangeApprovalPolicy returns the policy used to vote for parameter change proposals.
+func (p Params) ParamChangeApprovalPolicy() approvals.Policy {
+	return approvals.NewPolicy(approvals.DecQuorum(p.ParamChangeApprovalPercent), approvals.NoExpiration)
+}
+
+func (p Params) String() string {
+	bytes, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
@@ -0,0 +1,119 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkparams "github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
+)
+
+// ParamSet is implemented by the parameters of the modules registering their subspaces.
+// ParamSetPairs must be defined on the pointer receiver, so the pairs refer to the fields of the set.
+type ParamSet interface {
+	sdkparams.ParamSet
+
+	// Validates the whole set, so that a change breaking the invariants of the module is rejected.
+	Validate() error
+}
+
+/*
+	Change of a single parameter
+*/
+type ParamChange struct {
+	Subspace string `json:"subspace"`
+	Key      string `json:"key"`
+	Value    string `json:"value"` // JSON (amino) encoded value of the parameter, e.g. "\"100\"" for integers
+}
+
+func NewParamChange(subspace string, key string, value string) ParamChange {
+	return ParamChange{
+		Subspace: subspace,
+		Key:      key,
+		Value:    value,
+	}
+}
+
+func (c ParamChange) ValidateBasic() sdk.Error {
+	if len(c.Subspace) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Subspace: it cannot be empty")
+	}
+
+	if len(c.Key) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Key: it cannot be empty")
+	}
+
+	if len(c.Value) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Value: it cannot be empty")
+	}
+
+	if len(c.Value) > MaxParamValueLength {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Value: received string of length %v, max is %v",
+			len(c.Value), MaxParamValueLength))
+	}
+
+	if !json.Valid([]byte(c.Value)) {
+		return sdk.ErrUnknownRequest("Invalid Value: it must be JSON encoded")
+	}
+
+	return nil
+}
+
+func (c ParamChange) String() string {
+	bytes, err := json.Marshal(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+/*
+	Proposed (but not Approved yet) Parameter Change
+*/
+type ProposedParamChange struct {
+	Change    ParamChange      `json:"change"`
+	Creator   sdk.AccAddress   `json:"creator"`
+	Approvals  []sdk.AccAddress `json:"approvals"`  // trustees approved the change
+	Rejections []sdk.AccAddress `json:"rejections"` // trustees rejected the change
+}
+
+func NewProposedParamChange(change ParamChange, creator sdk.AccAddress) ProposedParamChange {
+	return ProposedParamChange{
+		Change:    change,
+		Creator:   creator,
+		Approvals: []sdk.AccAddress{creator},
+	}
+}
+
+func (c ProposedParamChange) HasApprovalFrom(address sdk.AccAddress) bool {
+	return approvals.HasApprovalFrom(c.Approvals, address)
+}
+
+func (c ProposedParamChange) HasRejectionFrom(address sdk.AccAddress) bool {
+	return approvals.HasApprovalFrom(c.Rejections, address)
+}
+
+func (c ProposedParamChange) String() string {
+	bytes, err := json.Marshal(c)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
@@ -0,0 +1,41 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package params
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+)
+
+// paramsQueryRouter applies the module parameters (the maximum page size) to the queries of the registered queriers.
+type paramsQueryRouter struct {
+	sdk.QueryRouter
+	keeper Keeper
+}
+
+func NewQueryRouter(router sdk.QueryRouter, keeper Keeper) sdk.QueryRouter {
+	return paramsQueryRouter{QueryRouter: router, keeper: keeper}
+}
+
+func (r paramsQueryRouter) AddRoute(path string, querier sdk.Querier) sdk.QueryRouter {
+	r.QueryRouter.AddRoute(path, func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		ctx = pagination.WithMaxPageSize(ctx, r.keeper.GetParams(ctx).MaxPageSize)
+
+		return querier(ctx, path, req)
+	})
+
+	return r
+}
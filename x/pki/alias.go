@@ -17,31 +17,78 @@ package pki
 import (
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/keeper"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/x509"
 )
 
 const (
 	ModuleName = types.ModuleName
 	RouterKey  = types.RouterKey
 	StoreKey   = types.StoreKey
+
+	CodeCertificateDoesNotExist = types.CodeCertificateDoesNotExist
+	CodeSequenceConflict        = types.CodeSequenceConflict
+
+	MaxExpiredCertificatesPerBlock = keeper.MaxExpiredCertificatesPerBlock
+
+	QueryX509Cert         = keeper.QueryX509Cert
+	QueryAllX509RootCerts = keeper.QueryAllX509RootCerts
+	QueryX509CertStatus   = keeper.QueryX509CertStatus
+
+	CertificateStatusGood    = types.CertificateStatusGood
+	CertificateStatusRevoked = types.CertificateStatusRevoked
+	CertificateStatusExpired = types.CertificateStatusExpired
+	CertificateStatusUnknown = types.CertificateStatusUnknown
+
+	AttestationCertificateClass = types.AttestationCertificateClass
+	NocCertificateClass         = types.NocCertificateClass
+	DefaultCertificateClass     = types.DefaultCertificateClass
 )
 
 var (
-	NewKeeper     = keeper.NewKeeper
-	NewQuerier    = keeper.NewQuerier
-	ModuleCdc     = types.ModuleCdc
-	RegisterCodec = types.RegisterCodec
+	NewKeeper                 = keeper.NewKeeper
+	NewQuerier                = keeper.NewQuerier
+	ProposedCertificatePrefix = types.ProposedCertificatePrefix
+	ApprovedCertificatePrefix = types.ApprovedCertificatePrefix
+	RevokedCertificatePrefix  = types.RevokedCertificatePrefix
+	ModuleCdc                 = types.ModuleCdc
+	RegisterCodec             = types.RegisterCodec
+
+	NewMsgProposeAddX509RootCert = types.NewMsgProposeAddX509RootCert
+
+	NewPkiQueryParams = types.NewPkiQueryParams
+
+	DecodeX509Certificate = x509.DecodeX509Certificate
+	BytesToHex            = x509.BytesToHex
+
+	GetApprovedCertificateKey      = types.GetApprovedCertificateKey
+	GetSubjectKeyIDCertificatesKey = types.GetSubjectKeyIDCertificatesKey
 )
 
 type (
-	Keeper                        = keeper.Keeper
-	MsgProposeAddX509RootCert     = types.MsgProposeAddX509RootCert
-	MsgApproveAddX509RootCert     = types.MsgApproveAddX509RootCert
-	MsgAddX509Cert                = types.MsgAddX509Cert
-	MsgProposeRevokeX509RootCert  = types.MsgProposeRevokeX509RootCert
-	MsgApproveRevokeX509RootCert  = types.MsgApproveRevokeX509RootCert
-	MsgRevokeX509Cert             = types.MsgRevokeX509Cert
-	Certificate                   = types.Certificate
-	Certificates                  = types.Certificates
-	ProposedCertificate           = types.ProposedCertificate
-	ProposedCertificateRevocation = types.ProposedCertificateRevocation
+	Keeper                             = keeper.Keeper
+	MsgProposeAddX509RootCert          = types.MsgProposeAddX509RootCert
+	MsgApproveAddX509RootCert          = types.MsgApproveAddX509RootCert
+	MsgRejectAddX509RootCert           = types.MsgRejectAddX509RootCert
+	MsgAddX509Cert                     = types.MsgAddX509Cert
+	MsgProposeRevokeX509RootCert       = types.MsgProposeRevokeX509RootCert
+	MsgApproveRevokeX509RootCert       = types.MsgApproveRevokeX509RootCert
+	MsgRevokeX509Cert                  = types.MsgRevokeX509Cert
+	MsgAddCrlDistributionPoint         = types.MsgAddCrlDistributionPoint
+	MsgRemoveCrlDistributionPoint      = types.MsgRemoveCrlDistributionPoint
+	Certificate                        = types.Certificate
+	Certificates                       = types.Certificates
+	CertificateExpiration              = types.CertificateExpiration
+	CertificateStatus                  = types.CertificateStatus
+	CertificateClass                   = types.CertificateClass
+	SubjectKeyIDCertificates           = types.SubjectKeyIDCertificates
+	ProposedCertificate                = types.ProposedCertificate
+	ProposedCertificateRevocation      = types.ProposedCertificateRevocation
+	CrlDistributionPoints              = types.CrlDistributionPoints
+	ListCertificates                   = types.ListCertificates
+	ListProposedCertificates           = types.ListProposedCertificates
+	ListProposedCertificateRevocations = types.ListProposedCertificateRevocations
+	Rejection                          = types.Rejection
+	PkiQueryParams                     = types.PkiQueryParams
+	Params                             = types.Params
+	X509Certificate                    = x509.X509Certificate
 )
@@ -25,4 +25,11 @@ const (
 	FlagRootSubjectShortcut      = "r"
 	FlagRootSubjectKeyID         = "root-subject-key-id"
 	FlagRootSubjectKeyIDShortcut = "i"
+	FlagIssuer                   = "issuer"
+	FlagSerialNumber             = "serial-number"
+	FlagURL                      = "url"
+	FlagReason                   = "reason"
+	FlagDays                     = "days"
+	FlagClass                    = "class"
+	FlagExpectedSequence         = "expected-sequence"
 )
@@ -38,9 +38,14 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	complianceQueryCmd.AddCommand(client.GetCommands(
 		GetCmdGetAllProposedX509RootCerts(storeKey, cdc),
 		GetCmdGetProposedX509RootCert(storeKey, cdc),
+		GetCmdGetAllRejectedX509RootCerts(storeKey, cdc),
+		GetCmdGetRejectedX509RootCert(storeKey, cdc),
+		GetCmdGetParams(storeKey, cdc),
 		GetCmdGetAllX509RootCerts(storeKey, cdc),
 		GetCmdGetX509Cert(storeKey, cdc),
 		GetCmdGetX509CertChain(storeKey, cdc),
+		GetCmdGetX509CertsBySubjectKeyID(storeKey, cdc),
+		GetCmdGetX509CertBySerialNumber(storeKey, cdc),
 		GetCmdGetAllX509Certs(storeKey, cdc),
 		GetCmdGetAllSubjectX509Certs(storeKey, cdc),
 		GetCmdGetAllProposedX509RootCertsToRevoke(storeKey, cdc),
@@ -48,6 +53,12 @@ func GetQueryCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 		GetCmdGetRevokedX509Cert(storeKey, cdc),
 		GetCmdGetAllRevokedX509RootCerts(storeKey, cdc),
 		GetCmdGetAllRevokedX509Certs(storeKey, cdc),
+		GetCmdGetAllX509CertsRevokedBy(storeKey, cdc),
+		GetCmdGetAllX509CertsExpiringWithin(storeKey, cdc),
+		GetCmdGetExpiredX509Cert(storeKey, cdc),
+		GetCmdGetAllExpiredX509Certs(storeKey, cdc),
+		GetCmdGetCrlDistributionPoints(storeKey, cdc),
+		GetCmdGetAllCrlDistributionPoints(storeKey, cdc),
 	)...)
 
 	return complianceQueryCmd
@@ -65,6 +76,7 @@ func GetCmdGetAllProposedX509RootCerts(queryRoute string, cdc *codec.Codec) *cob
 
 	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of certificates to skip")
 	cmd.Flags().Int(pagination.FlagTake, 0, "amount of certificates to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
 
 	return cmd
 }
@@ -101,18 +113,91 @@ func GetCmdGetProposedX509RootCert(queryRoute string, cdc *codec.Codec) *cobra.C
 	return cmd
 }
 
+func GetCmdGetAllRejectedX509RootCerts(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-rejected-x509-root-certs",
+		Short: "Gets all proposed root certificates rejected by trustees",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return performPkiQuery(cdc, fmt.Sprintf("custom/%s/all_rejected_x509_root_certs", queryRoute))
+		},
+	}
+
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of certificates to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of certificates to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
+
+// nolint:dupl
+func GetCmdGetRejectedX509RootCert(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rejected-x509-root-cert",
+		Short: "Gets a rejected root certificate with the given combination of subject and subject-key-id",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			subject := viper.GetString(FlagSubject)
+			subjectKeyID := viper.GetString(FlagSubjectKeyID)
+
+			res, height, err := cliCtx.QueryStore(types.GetRejectedCertificateKey(subject, subjectKeyID), queryRoute)
+			if err != nil || res == nil {
+				return types.ErrRejectedCertificateDoesNotExist(subject, subjectKeyID)
+			}
+
+			var rejectedCertificate types.ProposedCertificate
+			cdc.MustUnmarshalBinaryBare(res, &rejectedCertificate)
+
+			return cliCtx.EncodeAndPrintWithHeight(rejectedCertificate, height)
+		},
+	}
+
+	cmd.Flags().StringP(FlagSubject, FlagSubjectShortcut, "", "Certificate's subject")
+	cmd.Flags().StringP(FlagSubjectKeyID, FlagSubjectKeyIDShortcut, "", "Certificate's subject key id (hex)")
+
+	_ = cmd.MarkFlagRequired(FlagSubject)
+	_ = cmd.MarkFlagRequired(FlagSubjectKeyID)
+
+	return cmd
+}
+
+func GetCmdGetParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Gets the parameters of the pki module (e.g. the number of approvals required for root certificates)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/params", queryRoute), nil)
+		},
+	}
+
+	return cmd
+}
+
 func GetCmdGetAllX509RootCerts(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "all-x509-root-certs",
 		Short: "Gets all approved root certificates",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if class := viper.GetString(FlagClass); len(class) > 0 {
+				return performPkiQuery(cdc, fmt.Sprintf("custom/%s/all_x509_root_certs_of_class/%s", queryRoute, class))
+			}
+
 			return performPkiQuery(cdc, fmt.Sprintf("custom/%s/all_x509_root_certs", queryRoute))
 		},
 	}
 
+	cmd.Flags().String(FlagClass, "",
+		"return only the root certificates of the given class: attestation (all PAA certificates) or noc")
 	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of certificates to skip")
 	cmd.Flags().Int(pagination.FlagTake, 0, "amount of certificates to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+	pagination.AddSortFlags(cmd, types.CertificateSortFields...)
 
 	return cmd
 }
@@ -183,16 +268,77 @@ func GetCmdGetX509CertChain(queryRoute string, cdc *codec.Codec) *cobra.Command
 	return cmd
 }
 
+func GetCmdGetX509CertsBySubjectKeyID(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "x509-certs-by-subject-key-id",
+		Short: "Gets approved certificates (either root, intermediate or leaf) by the given subject-key-id",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			subjectKeyID := viper.GetString(FlagSubjectKeyID)
+
+			certificates, height, err := subjectKeyIDCertificates(cliCtx, queryRoute, subjectKeyID)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.EncodeAndPrintWithHeight(certificates, height)
+		},
+	}
+
+	cmd.Flags().StringP(FlagSubjectKeyID, FlagSubjectKeyIDShortcut, "", "Certificate's subject key id (hex)")
+
+	_ = cmd.MarkFlagRequired(FlagSubjectKeyID)
+
+	return cmd
+}
+
+func GetCmdGetX509CertBySerialNumber(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "x509-cert-by-serial-number",
+		Short: "Gets approved certificate (either root, intermediate or leaf) by the given issuer and serial-number",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			issuer := viper.GetString(FlagIssuer)
+			serialNumber := viper.GetString(FlagSerialNumber)
+
+			certificate, height, err := serialNumberCertificate(cliCtx, queryRoute, issuer, serialNumber)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.EncodeAndPrintWithHeight(certificate, height)
+		},
+	}
+
+	cmd.Flags().String(FlagIssuer, "", "Certificate's issuer (the subject for root certificates)")
+	cmd.Flags().String(FlagSerialNumber, "", "Certificate's serial number")
+
+	_ = cmd.MarkFlagRequired(FlagIssuer)
+	_ = cmd.MarkFlagRequired(FlagSerialNumber)
+
+	return cmd
+}
+
 func GetCmdGetAllX509Certs(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "all-x509-certs",
 		Short: "Gets all certificates (root, intermediate and leaf)",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if class := viper.GetString(FlagClass); len(class) > 0 {
+				return performPkiQuery(cdc, fmt.Sprintf("custom/%s/all_x509_certs_of_class/%s", queryRoute, class))
+			}
+
 			return performPkiQuery(cdc, fmt.Sprintf("custom/%s/all_x509_certs", queryRoute))
 		},
 	}
 
+	cmd.Flags().String(FlagClass, "", "return only the certificates of the given class: attestation or noc")
+
 	cmd.Flags().StringP(FlagRootSubject, FlagRootSubjectShortcut, "",
 		"filter certificates by `Subject` of root certificate "+
 			"(only the certificates originated from the given root certificate are returned)")
@@ -201,6 +347,8 @@ func GetCmdGetAllX509Certs(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			"(only the certificates originated from the given root certificate are returned)")
 	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of certificates to skip")
 	cmd.Flags().Int(pagination.FlagTake, 0, "amount of certificates to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+	pagination.AddSortFlags(cmd, types.CertificateSortFields...)
 
 	return cmd
 }
@@ -226,6 +374,8 @@ func GetCmdGetAllSubjectX509Certs(queryRoute string, cdc *codec.Codec) *cobra.Co
 			"(only the certificates originated from the given root certificate are returned)")
 	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of certificates to skip")
 	cmd.Flags().Int(pagination.FlagTake, 0, "amount of certificates to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+	pagination.AddSortFlags(cmd, types.CertificateSortFields...)
 
 	_ = cmd.MarkFlagRequired(FlagSubject)
 
@@ -244,6 +394,7 @@ func GetCmdGetAllProposedX509RootCertsToRevoke(queryRoute string, cdc *codec.Cod
 
 	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of certificates to skip")
 	cmd.Flags().Int(pagination.FlagTake, 0, "amount of certificates to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
 
 	return cmd
 }
@@ -328,6 +479,7 @@ func GetCmdGetAllRevokedX509RootCerts(queryRoute string, cdc *codec.Codec) *cobr
 
 	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of certificates to skip")
 	cmd.Flags().Int(pagination.FlagTake, 0, "amount of certificates to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
 
 	return cmd
 }
@@ -350,6 +502,171 @@ func GetCmdGetAllRevokedX509Certs(queryRoute string, cdc *codec.Codec) *cobra.Co
 			"(only the certificates originated from the given root certificate are returned)")
 	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of certificates to skip")
 	cmd.Flags().Int(pagination.FlagTake, 0, "amount of certificates to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
+
+func GetCmdGetAllX509CertsRevokedBy(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "all-x509-certs-revoked-by",
+		Short: "Gets all certificates invalidated by the revocation of the certificate with " +
+			"the given combination of subject and subject-key-id " +
+			"(the revoked certificate itself and all the certificates in the subtree signed by it)",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			subject := viper.GetString(FlagSubject)
+			subjectKeyID := viper.GetString(FlagSubjectKeyID)
+
+			return performPkiQuery(cdc, fmt.Sprintf("custom/%s/all_x509_certs_revoked_by/%s/%s",
+				queryRoute, subject, subjectKeyID))
+		},
+	}
+
+	cmd.Flags().StringP(FlagSubject, FlagSubjectShortcut, "", "Revoked certificate's subject")
+	cmd.Flags().StringP(FlagSubjectKeyID, FlagSubjectKeyIDShortcut, "", "Revoked certificate's subject key id (hex)")
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of certificates to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of certificates to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	_ = cmd.MarkFlagRequired(FlagSubject)
+	_ = cmd.MarkFlagRequired(FlagSubjectKeyID)
+
+	return cmd
+}
+
+func GetCmdGetAllX509CertsExpiringWithin(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "all-x509-certs-expiring",
+		Short: "Gets all approved certificates (root, intermediate and leaf) " +
+			"which validity period ends within the given number of days, in the order of expiration",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return performPkiQuery(cdc, fmt.Sprintf("custom/%s/all_x509_certs_expiring_within/%d",
+				queryRoute, viper.GetUint(FlagDays)))
+		},
+	}
+
+	cmd.Flags().Uint(FlagDays, 0, "Number of days from now")
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of certificates to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of certificates to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	_ = cmd.MarkFlagRequired(FlagDays)
+
+	return cmd
+}
+
+// nolint:dupl
+func GetCmdGetExpiredX509Cert(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "expired-x509-cert",
+		Short: "Gets expired certificates (either root, intermediate or leaf) " +
+			"by the given combination of subject and subject-key-id",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			subject := viper.GetString(FlagSubject)
+			subjectKeyID := viper.GetString(FlagSubjectKeyID)
+
+			res, height, err := cliCtx.QueryStore(types.GetExpiredCertificateKey(subject, subjectKeyID), queryRoute)
+			if err != nil || res == nil {
+				return types.ErrExpiredCertificateDoesNotExist(subject, subjectKeyID)
+			}
+
+			var certificates types.Certificates
+			cdc.MustUnmarshalBinaryBare(res, &certificates)
+
+			return cliCtx.EncodeAndPrintWithHeight(certificates, height)
+		},
+	}
+
+	cmd.Flags().StringP(FlagSubject, FlagSubjectShortcut, "", "Certificate's subject")
+	cmd.Flags().StringP(FlagSubjectKeyID, FlagSubjectKeyIDShortcut, "", "Certificate's subject key id (hex)")
+
+	_ = cmd.MarkFlagRequired(FlagSubject)
+	_ = cmd.MarkFlagRequired(FlagSubjectKeyID)
+
+	return cmd
+}
+
+func GetCmdGetAllExpiredX509Certs(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-expired-x509-certs",
+		Short: "Gets all expired certificates (root, intermediate and leaf)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return performPkiQuery(cdc, fmt.Sprintf("custom/%s/all_expired_x509_certs", queryRoute))
+		},
+	}
+
+	cmd.Flags().StringP(FlagRootSubject, FlagRootSubjectShortcut, "",
+		"filter certificates by `Subject` of root certificate "+
+			"(only the certificates originated from the given root certificate are returned)")
+	cmd.Flags().StringP(FlagRootSubjectKeyID, FlagRootSubjectKeyIDShortcut, "",
+		"filter certificates by `Subject Key Id` of root certificate "+
+			"(only the certificates originated from the given root certificate are returned)")
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of certificates to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of certificates to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
+
+// nolint:dupl
+func GetCmdGetCrlDistributionPoints(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "crl-distribution-points",
+		Short: "Gets CRL distribution points published for the certificate (either root or intermediate) " +
+			"with the given combination of subject and subject-key-id",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			subject := viper.GetString(FlagSubject)
+			subjectKeyID := viper.GetString(FlagSubjectKeyID)
+
+			res, height, err := cliCtx.QueryStore(types.GetCrlDistributionPointsKey(subject, subjectKeyID), queryRoute)
+			if err != nil || res == nil {
+				return types.ErrCrlDistributionPointsDoNotExist(subject, subjectKeyID)
+			}
+
+			var crlDistributionPoints types.CrlDistributionPoints
+			cdc.MustUnmarshalBinaryBare(res, &crlDistributionPoints)
+
+			return cliCtx.EncodeAndPrintWithHeight(crlDistributionPoints, height)
+		},
+	}
+
+	cmd.Flags().StringP(FlagSubject, FlagSubjectShortcut, "", "Certificate's subject")
+	cmd.Flags().StringP(FlagSubjectKeyID, FlagSubjectKeyIDShortcut, "", "Certificate's subject key id (hex)")
+
+	_ = cmd.MarkFlagRequired(FlagSubject)
+	_ = cmd.MarkFlagRequired(FlagSubjectKeyID)
+
+	return cmd
+}
+
+func GetCmdGetAllCrlDistributionPoints(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-crl-distribution-points",
+		Short: "Gets CRL distribution points published for all certificates",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return performPkiQuery(cdc, fmt.Sprintf("custom/%s/all_crl_distribution_points", queryRoute))
+		},
+	}
+
+	cmd.Flags().StringP(FlagRootSubject, FlagRootSubjectShortcut, "",
+		"filter CRL distribution points by `Subject` of root certificate "+
+			"(only the ones of certificates originated from the given root certificate are returned)")
+	cmd.Flags().StringP(FlagRootSubjectKeyID, FlagRootSubjectKeyIDShortcut, "",
+		"filter CRL distribution points by `Subject Key Id` of root certificate "+
+			"(only the ones of certificates originated from the given root certificate are returned)")
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of records to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of records to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
 
 	return cmd
 }
@@ -375,6 +692,67 @@ func chainCertificates(cliCtx cli.CliContext, queryRoute string,
 	return height, nil
 }
 
+func subjectKeyIDCertificates(cliCtx cli.CliContext, queryRoute string,
+	subjectKeyID string) (types.Certificates, int64, sdk.Error) {
+	certificates := types.NewCertificates([]types.Certificate{})
+
+	res, height, err := cliCtx.QueryStore(types.GetSubjectKeyIDCertificatesKey(subjectKeyID), queryRoute)
+	if err != nil || res == nil {
+		return certificates, height, types.ErrCertificateBySubjectKeyIDDoesNotExist(subjectKeyID)
+	}
+
+	var subjectKeyIDCertificates types.SubjectKeyIDCertificates
+
+	cliCtx.Codec().MustUnmarshalBinaryBare(res, &subjectKeyIDCertificates)
+
+	for _, identifier := range subjectKeyIDCertificates.CertIdentifiers {
+		res, height, err = cliCtx.QueryStore(
+			types.GetApprovedCertificateKey(identifier.Subject, identifier.SubjectKeyID), queryRoute)
+		if err != nil || res == nil {
+			return certificates, height, types.ErrCertificateDoesNotExist(identifier.Subject, identifier.SubjectKeyID)
+		}
+
+		var record types.Certificates
+
+		cliCtx.Codec().MustUnmarshalBinaryBare(res, &record)
+
+		certificates.Items = append(certificates.Items, record.Items...)
+	}
+
+	return certificates, height, nil
+}
+
+func serialNumberCertificate(cliCtx cli.CliContext, queryRoute string,
+	issuer string, serialNumber string) (types.Certificate, int64, sdk.Error) {
+	res, height, err := cliCtx.QueryStore(types.GetSerialNumberCertificateKey(issuer, serialNumber), queryRoute)
+	if err != nil || res == nil {
+		return types.Certificate{}, height, types.ErrCertificateBySerialNumberDoesNotExist(issuer, serialNumber)
+	}
+
+	var identifier types.CertificateIdentifier
+
+	cliCtx.Codec().MustUnmarshalBinaryBare(res, &identifier)
+
+	res, height, err = cliCtx.QueryStore(
+		types.GetApprovedCertificateKey(identifier.Subject, identifier.SubjectKeyID), queryRoute)
+	// the referenced certificate can be revoked or expired
+	if err != nil || res == nil {
+		return types.Certificate{}, height, types.ErrCertificateBySerialNumberDoesNotExist(issuer, serialNumber)
+	}
+
+	var certificates types.Certificates
+
+	cliCtx.Codec().MustUnmarshalBinaryBare(res, &certificates)
+
+	for _, certificate := range certificates.Items {
+		if certificate.SerialNumber == serialNumber && certificate.GetIssuer() == issuer {
+			return certificate, height, nil
+		}
+	}
+
+	return types.Certificate{}, height, types.ErrCertificateBySerialNumberDoesNotExist(issuer, serialNumber)
+}
+
 func performPkiQuery(cdc *codec.Codec, route string) error {
 	cliCtx := cli.NewCLIContext().WithCodec(cdc)
 
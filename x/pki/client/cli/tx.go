@@ -21,6 +21,7 @@ import (
 	"github.com/spf13/viper"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/x509"
 )
 
 func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
@@ -35,10 +36,13 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 	complianceTxCmd.AddCommand(cli.SignedCommands(client.PostCommands(
 		GetCmdProposeAddX509RootCertificate(cdc),
 		GetCmdApproveAddX509RootCertificate(cdc),
+		GetCmdRejectAddX509RootCertificate(cdc),
 		GetCmdAddX509Certificate(cdc),
 		GetCmdProposeRevokeX509RootCertificate(cdc),
 		GetCmdApproveRevokeX509RootCertificate(cdc),
 		GetCmdRevokeX509Certificate(cdc),
+		GetCmdAddCrlDistributionPoint(cdc),
+		GetCmdRemoveCrlDistributionPoint(cdc),
 	)...)...)
 
 	return complianceTxCmd
@@ -58,6 +62,7 @@ func GetCmdProposeAddX509RootCertificate(cdc *codec.Codec) *cobra.Command {
 			}
 
 			msg := types.NewMsgProposeAddX509RootCert(cert, cliCtx.FromAddress())
+			msg.Class = types.CertificateClass(viper.GetString(FlagClass))
 
 			return cliCtx.HandleWriteMessage(msg)
 		},
@@ -65,6 +70,8 @@ func GetCmdProposeAddX509RootCertificate(cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().StringP(FlagCertificate, FlagCertificateShortcut, "",
 		"PEM encoded certificate (string or path to file containing data)")
+	cmd.Flags().String(FlagClass, string(types.DefaultCertificateClass),
+		"class of the root certificate and the certificates originated from it: attestation (PAA) or noc")
 
 	_ = cmd.MarkFlagRequired(FlagCertificate)
 
@@ -97,11 +104,40 @@ func GetCmdApproveAddX509RootCertificate(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func GetCmdRejectAddX509RootCertificate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-add-x509-root-cert",
+		Short: "Rejects the proposed root certificate correspondent to combination of subject and subject-key-id",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			subject := viper.GetString(FlagSubject)
+			subjectKeyID := viper.GetString(FlagSubjectKeyID)
+			reason := viper.GetString(FlagReason)
+
+			msg := types.NewMsgRejectAddX509RootCert(subject, subjectKeyID, reason, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().StringP(FlagSubject, FlagSubjectShortcut, "", "Certificate's subject")
+	cmd.Flags().StringP(FlagSubjectKeyID, FlagSubjectKeyIDShortcut, "", "Certificate's subject key id (hex)")
+	cmd.Flags().String(FlagReason, "", "The reason of the rejection")
+
+	_ = cmd.MarkFlagRequired(FlagSubject)
+	_ = cmd.MarkFlagRequired(FlagSubjectKeyID)
+	_ = cmd.MarkFlagRequired(FlagReason)
+
+	return cmd
+}
+
 func GetCmdAddX509Certificate(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use: "add-x509-cert",
-		Short: "Adds an intermediate or leaf certificate signed by a chain " +
-			"of certificates which must be already present on the ledger",
+		Short: "Adds intermediate or leaf certificates signed by a chain " +
+			"of certificates which must be already present on the ledger (or in the same bundle)",
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := cli.NewCLIContext().WithCodec(cdc)
@@ -111,14 +147,15 @@ func GetCmdAddX509Certificate(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgAddX509Cert(cert, cliCtx.FromAddress())
+			msg := types.NewMsgAddX509Cert(x509.CertificatesDataFromFile([]byte(cert)), cliCtx.FromAddress())
 
 			return cliCtx.HandleWriteMessage(msg)
 		},
 	}
 
 	cmd.Flags().StringP(FlagCertificate, FlagCertificateShortcut, "",
-		"PEM encoded certificate (string or path to file containing data)")
+		"PEM encoded certificate or bundle of certificates, or DER encoded certificate "+
+			"(string or path to file containing data)")
 
 	_ = cmd.MarkFlagRequired(FlagCertificate)
 
@@ -138,6 +175,7 @@ func GetCmdProposeRevokeX509RootCertificate(cdc *codec.Codec) *cobra.Command {
 			subjectKeyID := viper.GetString(FlagSubjectKeyID)
 
 			msg := types.NewMsgProposeRevokeX509RootCert(subject, subjectKeyID, cliCtx.FromAddress())
+			msg.ExpectedSequence = viper.GetUint64(FlagExpectedSequence)
 
 			return cliCtx.HandleWriteMessage(msg)
 		},
@@ -145,6 +183,8 @@ func GetCmdProposeRevokeX509RootCertificate(cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().StringP(FlagSubject, FlagSubjectShortcut, "", "Certificate's subject")
 	cmd.Flags().StringP(FlagSubjectKeyID, FlagSubjectKeyIDShortcut, "", "Certificate's subject key id (hex)")
+	cmd.Flags().Uint64(FlagExpectedSequence, 0,
+		"Sequence of the certificates the revocation is based on (rejected if they have been changed since)")
 
 	_ = cmd.MarkFlagRequired(FlagSubject)
 	_ = cmd.MarkFlagRequired(FlagSubjectKeyID)
@@ -192,6 +232,65 @@ func GetCmdRevokeX509Certificate(cdc *codec.Codec) *cobra.Command {
 			subjectKeyID := viper.GetString(FlagSubjectKeyID)
 
 			msg := types.NewMsgRevokeX509Cert(subject, subjectKeyID, cliCtx.FromAddress())
+			msg.ExpectedSequence = viper.GetUint64(FlagExpectedSequence)
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().StringP(FlagSubject, FlagSubjectShortcut, "", "Certificate's subject")
+	cmd.Flags().StringP(FlagSubjectKeyID, FlagSubjectKeyIDShortcut, "", "Certificate's subject key id (hex)")
+	cmd.Flags().Uint64(FlagExpectedSequence, 0,
+		"Sequence of the certificates the revocation is based on (rejected if they have been changed since)")
+
+	_ = cmd.MarkFlagRequired(FlagSubject)
+	_ = cmd.MarkFlagRequired(FlagSubjectKeyID)
+
+	return cmd
+}
+
+func GetCmdAddCrlDistributionPoint(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-crl-distribution-point",
+		Short: "Publishes a CRL distribution point URL for the given root or intermediate certificate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			subject := viper.GetString(FlagSubject)
+			subjectKeyID := viper.GetString(FlagSubjectKeyID)
+			url := viper.GetString(FlagURL)
+
+			msg := types.NewMsgAddCrlDistributionPoint(subject, subjectKeyID, url, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().StringP(FlagSubject, FlagSubjectShortcut, "", "Certificate's subject")
+	cmd.Flags().StringP(FlagSubjectKeyID, FlagSubjectKeyIDShortcut, "", "Certificate's subject key id (hex)")
+	cmd.Flags().String(FlagURL, "", "CRL distribution point URL (http or https)")
+
+	_ = cmd.MarkFlagRequired(FlagSubject)
+	_ = cmd.MarkFlagRequired(FlagSubjectKeyID)
+	_ = cmd.MarkFlagRequired(FlagURL)
+
+	return cmd
+}
+
+func GetCmdRemoveCrlDistributionPoint(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-crl-distribution-point",
+		Short: "Removes a CRL distribution point URL published for the given root or intermediate certificate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			subject := viper.GetString(FlagSubject)
+			subjectKeyID := viper.GetString(FlagSubjectKeyID)
+			url := viper.GetString(FlagURL)
+
+			msg := types.NewMsgRemoveCrlDistributionPoint(subject, subjectKeyID, url, cliCtx.FromAddress())
 
 			return cliCtx.HandleWriteMessage(msg)
 		},
@@ -199,9 +298,11 @@ func GetCmdRevokeX509Certificate(cdc *codec.Codec) *cobra.Command {
 
 	cmd.Flags().StringP(FlagSubject, FlagSubjectShortcut, "", "Certificate's subject")
 	cmd.Flags().StringP(FlagSubjectKeyID, FlagSubjectKeyIDShortcut, "", "Certificate's subject key id (hex)")
+	cmd.Flags().String(FlagURL, "", "CRL distribution point URL")
 
 	_ = cmd.MarkFlagRequired(FlagSubject)
 	_ = cmd.MarkFlagRequired(FlagSubjectKeyID)
+	_ = cmd.MarkFlagRequired(FlagURL)
 
 	return cmd
 }
@@ -20,6 +20,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/types"
 )
@@ -28,7 +29,17 @@ func getAllX509RootCertsHandler(cliCtx context.CLIContext, storeName string) htt
 	return func(w http.ResponseWriter, r *http.Request) {
 		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
 		performPkiQuery(restCtx,
-			fmt.Sprintf("custom/%s/all_x509_root_certs", storeName), "", "")
+			fmt.Sprintf("custom/%s/all_x509_root_certs", storeName), "", "", &types.ListCertificates{})
+	}
+}
+
+func getAllX509RootCertsOfClassHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+		vars := restCtx.Variables()
+		performPkiQuery(restCtx,
+			fmt.Sprintf("custom/%s/all_x509_root_certs_of_class/%s", storeName, vars[class]), "", "",
+			&types.ListCertificates{})
 	}
 }
 
@@ -36,7 +47,45 @@ func getAllProposedX509RootCertsHandler(cliCtx context.CLIContext, storeName str
 	return func(w http.ResponseWriter, r *http.Request) {
 		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
 		performPkiQuery(restCtx,
-			fmt.Sprintf("custom/%s/all_proposed_x509_root_certs", storeName), "", "")
+			fmt.Sprintf("custom/%s/all_proposed_x509_root_certs", storeName), "", "", &types.ListProposedCertificates{})
+	}
+}
+
+func getAllRejectedX509RootCertsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+		performPkiQuery(restCtx, fmt.Sprintf("custom/%s/all_rejected_x509_root_certs", storeName), "", "",
+			&types.ListProposedCertificates{})
+	}
+}
+
+func getRejectedX509RootCertHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+		subject := vars[subject]
+		subjectKeyID := vars[subjectKeyID]
+
+		res, height, err := restCtx.QueryStore(types.GetRejectedCertificateKey(subject, subjectKeyID), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrRejectedCertificateDoesNotExist(subject, subjectKeyID))
+
+			return
+		}
+
+		var rejectedCertificate types.ProposedCertificate
+
+		cliCtx.Codec.MustUnmarshalBinaryBare(res, &rejectedCertificate)
+
+		restCtx.EncodeAndRespondWithHeight(rejectedCertificate, height)
+	}
+}
+
+func getParamsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+		restCtx.QueryList(fmt.Sprintf("custom/%s/params", storeName), nil)
 	}
 }
 
@@ -45,7 +94,19 @@ func getAllX509CertsHandler(cliCtx context.CLIContext, storeName string) http.Ha
 		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
 		rootSubject := r.FormValue(rootSubject)
 		rootSubjectKeyID := r.FormValue(rootSubjectKeyID)
-		performPkiQuery(restCtx, fmt.Sprintf("custom/%s/all_x509_certs", storeName), rootSubject, rootSubjectKeyID)
+		performPkiQuery(restCtx, fmt.Sprintf("custom/%s/all_x509_certs", storeName), rootSubject, rootSubjectKeyID,
+			&types.ListCertificates{})
+	}
+}
+
+func getAllX509CertsOfClassHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+		vars := restCtx.Variables()
+		rootSubject := r.FormValue(rootSubject)
+		rootSubjectKeyID := r.FormValue(rootSubjectKeyID)
+		performPkiQuery(restCtx, fmt.Sprintf("custom/%s/all_x509_certs_of_class/%s", storeName, vars[class]),
+			rootSubject, rootSubjectKeyID, &types.ListCertificates{})
 	}
 }
 
@@ -57,7 +118,7 @@ func getAllSubjectX509CertsHandler(cliCtx context.CLIContext, storeName string)
 		rootSubject := r.FormValue(rootSubject)
 		rootSubjectKeyID := r.FormValue(rootSubjectKeyID)
 		performPkiQuery(restCtx, fmt.Sprintf("custom/%s/all_subject_x509_certs/%s",
-			storeName, subject), rootSubject, rootSubjectKeyID)
+			storeName, subject), rootSubject, rootSubjectKeyID, &types.ListCertificates{})
 	}
 }
 
@@ -71,8 +132,7 @@ func getProposedX509RootCertHandler(cliCtx context.CLIContext, storeName string)
 
 		res, height, err := restCtx.QueryStore(types.GetProposedCertificateKey(subject, subjectKeyID), storeName)
 		if err != nil || res == nil {
-			restCtx.WriteErrorResponse(http.StatusNotFound,
-				types.ErrProposedCertificateDoesNotExist(subject, subjectKeyID).Error())
+			restCtx.WriteError(http.StatusNotFound, types.ErrProposedCertificateDoesNotExist(subject, subjectKeyID))
 
 			return
 		}
@@ -95,8 +155,7 @@ func getX509CertHandler(cliCtx context.CLIContext, storeName string) http.Handle
 
 		res, height, err := restCtx.QueryStore(types.GetApprovedCertificateKey(subject, subjectKeyID), storeName)
 		if err != nil || res == nil {
-			restCtx.WriteErrorResponse(http.StatusNotFound,
-				types.ErrCertificateDoesNotExist(subject, subjectKeyID).Error())
+			restCtx.WriteError(http.StatusNotFound, types.ErrCertificateDoesNotExist(subject, subjectKeyID))
 
 			return
 		}
@@ -109,6 +168,43 @@ func getX509CertHandler(cliCtx context.CLIContext, storeName string) http.Handle
 	}
 }
 
+func getX509CertsBySubjectKeyIDHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+		subjectKeyID := vars[subjectKeyID]
+
+		certificates, height, err := subjectKeyIDCertificates(restCtx, storeName, subjectKeyID)
+		if err != nil {
+			restCtx.WriteError(http.StatusNotFound, err)
+
+			return
+		}
+
+		restCtx.EncodeAndRespondWithHeight(certificates, height)
+	}
+}
+
+func getX509CertBySerialNumberHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+		issuer := vars[issuer]
+		serialNumber := vars[serialNumber]
+
+		certificate, height, err := serialNumberCertificate(restCtx, storeName, issuer, serialNumber)
+		if err != nil {
+			restCtx.WriteError(http.StatusNotFound, err)
+
+			return
+		}
+
+		restCtx.EncodeAndRespondWithHeight(certificate, height)
+	}
+}
+
 func getX509CertChainHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
@@ -121,8 +217,7 @@ func getX509CertChainHandler(cliCtx context.CLIContext, storeName string) http.H
 
 		height, err := chainCertificates(restCtx, storeName, subject, subjectKeyID, &chain)
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusNotFound,
-				types.ErrCertificateDoesNotExist(subject, subjectKeyID).Error())
+			restCtx.WriteError(http.StatusNotFound, types.ErrCertificateDoesNotExist(subject, subjectKeyID))
 
 			return
 		}
@@ -135,7 +230,8 @@ func getAllProposedX509RootCertsToRevokeHandler(cliCtx context.CLIContext, store
 	return func(w http.ResponseWriter, r *http.Request) {
 		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
 		performPkiQuery(restCtx,
-			fmt.Sprintf("custom/%s/all_proposed_x509_root_cert_revocations", storeName), "", "")
+			fmt.Sprintf("custom/%s/all_proposed_x509_root_cert_revocations", storeName), "", "",
+			&types.ListProposedCertificateRevocations{})
 	}
 }
 
@@ -149,8 +245,8 @@ func getProposedX509RootCertToRevokeHandler(cliCtx context.CLIContext, storeName
 
 		res, height, err := restCtx.QueryStore(types.GetProposedCertificateRevocationKey(subject, subjectKeyID), storeName)
 		if err != nil || res == nil {
-			restCtx.WriteErrorResponse(http.StatusNotFound,
-				types.ErrProposedCertificateRevocationDoesNotExist(subject, subjectKeyID).Error())
+			restCtx.WriteError(http.StatusNotFound,
+				types.ErrProposedCertificateRevocationDoesNotExist(subject, subjectKeyID))
 
 			return
 		}
@@ -169,14 +265,15 @@ func getAllRevokedX509CertsHandler(cliCtx context.CLIContext, storeName string)
 		rootSubject := r.FormValue(rootSubject)
 		rootSubjectKeyID := r.FormValue(rootSubjectKeyID)
 		performPkiQuery(restCtx, fmt.Sprintf("custom/%s/all_revoked_x509_certs", storeName),
-			rootSubject, rootSubjectKeyID)
+			rootSubject, rootSubjectKeyID, &types.ListCertificates{})
 	}
 }
 
 func getAllRevokedX509RootCertsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
-		performPkiQuery(restCtx, fmt.Sprintf("custom/%s/all_revoked_x509_root_certs", storeName), "", "")
+		performPkiQuery(restCtx, fmt.Sprintf("custom/%s/all_revoked_x509_root_certs", storeName), "", "",
+			&types.ListCertificates{})
 	}
 }
 
@@ -190,8 +287,68 @@ func getRevokedX509CertHandler(cliCtx context.CLIContext, storeName string) http
 
 		res, height, err := restCtx.QueryStore(types.GetRevokedCertificateKey(subject, subjectKeyID), storeName)
 		if err != nil || res == nil {
-			restCtx.WriteErrorResponse(http.StatusNotFound,
-				types.ErrRevokedCertificateDoesNotExist(subject, subjectKeyID).Error())
+			restCtx.WriteError(http.StatusNotFound, types.ErrRevokedCertificateDoesNotExist(subject, subjectKeyID))
+
+			return
+		}
+
+		var certificates types.Certificates
+
+		cliCtx.Codec.MustUnmarshalBinaryBare(res, &certificates)
+
+		restCtx.EncodeAndRespondWithHeight(certificates, height)
+	}
+}
+
+func getAllX509CertsRevokedByHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+		vars := restCtx.Variables()
+		subject := vars[subject]
+		subjectKeyID := vars[subjectKeyID]
+		performPkiQuery(restCtx, fmt.Sprintf("custom/%s/all_x509_certs_revoked_by/%s/%s",
+			storeName, subject, subjectKeyID), "", "", &types.ListCertificates{})
+	}
+}
+
+func getAllX509CertsExpiringWithinHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		days, err := conversions.ParseUInt16FromString(r.FormValue(days))
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		performPkiQuery(restCtx, fmt.Sprintf("custom/%s/all_x509_certs_expiring_within/%d", storeName, days),
+			"", "", &types.ListCertificates{})
+	}
+}
+
+func getAllExpiredX509CertsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+		rootSubject := r.FormValue(rootSubject)
+		rootSubjectKeyID := r.FormValue(rootSubjectKeyID)
+		performPkiQuery(restCtx, fmt.Sprintf("custom/%s/all_expired_x509_certs", storeName),
+			rootSubject, rootSubjectKeyID, &types.ListCertificates{})
+	}
+}
+
+// nolint:dupl
+func getExpiredX509CertHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+		subject := vars[subject]
+		subjectKeyID := vars[subjectKeyID]
+
+		res, height, err := restCtx.QueryStore(types.GetExpiredCertificateKey(subject, subjectKeyID), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrExpiredCertificateDoesNotExist(subject, subjectKeyID))
 
 			return
 		}
@@ -204,6 +361,39 @@ func getRevokedX509CertHandler(cliCtx context.CLIContext, storeName string) http
 	}
 }
 
+func getCrlDistributionPointsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+		subject := vars[subject]
+		subjectKeyID := vars[subjectKeyID]
+
+		res, height, err := restCtx.QueryStore(types.GetCrlDistributionPointsKey(subject, subjectKeyID), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrCrlDistributionPointsDoNotExist(subject, subjectKeyID))
+
+			return
+		}
+
+		var crlDistributionPoints types.CrlDistributionPoints
+
+		cliCtx.Codec.MustUnmarshalBinaryBare(res, &crlDistributionPoints)
+
+		restCtx.EncodeAndRespondWithHeight(crlDistributionPoints, height)
+	}
+}
+
+func getAllCrlDistributionPointsHandler(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+		rootSubject := r.FormValue(rootSubject)
+		rootSubjectKeyID := r.FormValue(rootSubjectKeyID)
+		performPkiQuery(restCtx, fmt.Sprintf("custom/%s/all_crl_distribution_points", storeName),
+			rootSubject, rootSubjectKeyID, &types.ListCrlDistributionPoints{})
+	}
+}
+
 func chainCertificates(restCtx rest.RestContext, storeName string,
 	subject string, subjectKeyID string, chain *types.Certificates) (int64, sdk.Error) {
 	res, height, err := restCtx.QueryStore(types.GetApprovedCertificateKey(subject, subjectKeyID), storeName)
@@ -225,12 +415,75 @@ func chainCertificates(restCtx rest.RestContext, storeName string,
 	return height, nil
 }
 
-func performPkiQuery(restCtx rest.RestContext, path string, rootSubject string, rootSubjectKeyID string) {
+func subjectKeyIDCertificates(restCtx rest.RestContext, storeName string,
+	subjectKeyID string) (types.Certificates, int64, sdk.Error) {
+	certificates := types.NewCertificates([]types.Certificate{})
+
+	res, height, err := restCtx.QueryStore(types.GetSubjectKeyIDCertificatesKey(subjectKeyID), storeName)
+	if err != nil || res == nil {
+		return certificates, height, types.ErrCertificateBySubjectKeyIDDoesNotExist(subjectKeyID)
+	}
+
+	var subjectKeyIDCertificates types.SubjectKeyIDCertificates
+
+	restCtx.Codec().MustUnmarshalBinaryBare(res, &subjectKeyIDCertificates)
+
+	for _, identifier := range subjectKeyIDCertificates.CertIdentifiers {
+		res, height, err = restCtx.QueryStore(
+			types.GetApprovedCertificateKey(identifier.Subject, identifier.SubjectKeyID), storeName)
+		if err != nil || res == nil {
+			return certificates, height, types.ErrCertificateDoesNotExist(identifier.Subject, identifier.SubjectKeyID)
+		}
+
+		var record types.Certificates
+
+		restCtx.Codec().MustUnmarshalBinaryBare(res, &record)
+
+		certificates.Items = append(certificates.Items, record.Items...)
+	}
+
+	return certificates, height, nil
+}
+
+func serialNumberCertificate(restCtx rest.RestContext, storeName string,
+	issuer string, serialNumber string) (types.Certificate, int64, sdk.Error) {
+	res, height, err := restCtx.QueryStore(types.GetSerialNumberCertificateKey(issuer, serialNumber), storeName)
+	if err != nil || res == nil {
+		return types.Certificate{}, height, types.ErrCertificateBySerialNumberDoesNotExist(issuer, serialNumber)
+	}
+
+	var identifier types.CertificateIdentifier
+
+	restCtx.Codec().MustUnmarshalBinaryBare(res, &identifier)
+
+	res, height, err = restCtx.QueryStore(
+		types.GetApprovedCertificateKey(identifier.Subject, identifier.SubjectKeyID), storeName)
+	// the referenced certificate can be revoked or expired
+	if err != nil || res == nil {
+		return types.Certificate{}, height, types.ErrCertificateBySerialNumberDoesNotExist(issuer, serialNumber)
+	}
+
+	var certificates types.Certificates
+
+	restCtx.Codec().MustUnmarshalBinaryBare(res, &certificates)
+
+	for _, certificate := range certificates.Items {
+		if certificate.SerialNumber == serialNumber && certificate.GetIssuer() == issuer {
+			return certificate, height, nil
+		}
+	}
+
+	return types.Certificate{}, height, types.ErrCertificateBySerialNumberDoesNotExist(issuer, serialNumber)
+}
+
+// `result` is the list response type of the query (see `QueryExportableList`).
+func performPkiQuery(restCtx rest.RestContext, path string, rootSubject string, rootSubjectKeyID string,
+	result interface{}) {
 	paginationParams, err := restCtx.ParsePaginationParams()
 	if err != nil {
 		return
 	}
 
 	params := types.NewPkiQueryParams(paginationParams, rootSubject, rootSubjectKeyID)
-	restCtx.QueryList(path, params)
+	restCtx.QueryExportableList(path, params, result)
 }
@@ -26,6 +26,10 @@ const (
 	subjectKeyID     = "subject_key_id"
 	rootSubject      = "root_subject"
 	rootSubjectKeyID = "root_subject_key_id"
+	issuer           = "issuer"
+	serialNumber     = "serial_number"
+	days             = "days"
+	class            = "class"
 )
 
 // nolint:funlen
@@ -39,6 +43,10 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		fmt.Sprintf("/%s/certs/proposed/root/{%s}/{%s}", storeName, subject, subjectKeyID),
 		approveAddX509RootCertHandler(cliCtx),
 	).Methods("PATCH")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/certs/proposed/root/{%s}/{%s}", storeName, subject, subjectKeyID),
+		rejectAddX509RootCertHandler(cliCtx),
+	).Methods("DELETE")
 	r.HandleFunc(
 		fmt.Sprintf("/%s/certs", storeName),
 		addX509CertHandler(cliCtx),
@@ -67,10 +75,38 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		fmt.Sprintf("/%s/certs/proposed/root", storeName),
 		getAllProposedX509RootCertsHandler(cliCtx, storeName),
 	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/certs/rejected/root/{%s}/{%s}", storeName, subject, subjectKeyID),
+		getRejectedX509RootCertHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/certs/rejected/root", storeName),
+		getAllRejectedX509RootCertsHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/params", storeName),
+		getParamsHandler(cliCtx, storeName),
+	).Methods("GET")
 	r.HandleFunc(
 		fmt.Sprintf("/%s/certs/chain/{%s}/{%s}", storeName, subject, subjectKeyID),
 		getX509CertChainHandler(cliCtx, storeName),
 	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/certs/subject-key-id/{%s}", storeName, subjectKeyID),
+		getX509CertsBySubjectKeyIDHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/certs/serial-number/{%s}/{%s}", storeName, issuer, serialNumber),
+		getX509CertBySerialNumberHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/certs/class/{%s}/root", storeName, class),
+		getAllX509RootCertsOfClassHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/certs/class/{%s}", storeName, class),
+		getAllX509CertsOfClassHandler(cliCtx, storeName),
+	).Methods("GET")
 	r.HandleFunc(
 		fmt.Sprintf("/%s/certs", storeName),
 		getAllX509CertsHandler(cliCtx, storeName),
@@ -87,6 +123,10 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		fmt.Sprintf("/%s/certs/revoked/{%s}/{%s}", storeName, subject, subjectKeyID),
 		getRevokedX509CertHandler(cliCtx, storeName),
 	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/certs/revoked/{%s}/{%s}/affected", storeName, subject, subjectKeyID),
+		getAllX509CertsRevokedByHandler(cliCtx, storeName),
+	).Methods("GET")
 	r.HandleFunc(
 		fmt.Sprintf("/%s/certs/revoked/root", storeName),
 		getAllRevokedX509RootCertsHandler(cliCtx, storeName),
@@ -95,8 +135,38 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		fmt.Sprintf("/%s/certs/revoked", storeName),
 		getAllRevokedX509CertsHandler(cliCtx, storeName),
 	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/certs/expiring", storeName),
+		getAllX509CertsExpiringWithinHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/certs/expired/{%s}/{%s}", storeName, subject, subjectKeyID),
+		getExpiredX509CertHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/certs/expired", storeName),
+		getAllExpiredX509CertsHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/crl-distribution-points", storeName),
+		addCrlDistributionPointHandler(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/crl-distribution-points", storeName),
+		removeCrlDistributionPointHandler(cliCtx),
+	).Methods("DELETE")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/crl-distribution-points", storeName),
+		getAllCrlDistributionPointsHandler(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/%s/crl-distribution-points/{%s}/{%s}", storeName, subject, subjectKeyID),
+		getCrlDistributionPointsHandler(cliCtx, storeName),
+	).Methods("GET")
 	// The following endpoint must be registered
-	// after GET /pki/certs/revoked and
+	// after GET /pki/certs/revoked,
+	// after GET /pki/certs/expired,
+	// after GET /pki/certs/expiring and
 	// after GET /pki/certs/root
 	// to avoid wrong matches
 	r.HandleFunc(
@@ -104,8 +174,11 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		getAllSubjectX509CertsHandler(cliCtx, storeName),
 	).Methods("GET")
 	// The following endpoint must be registered
-	// after GET /pki/certs/proposed/root and
-	// after GET /pki/certs/revoked/root
+	// after GET /pki/certs/proposed/root,
+	// after GET /pki/certs/rejected/root,
+	// after GET /pki/certs/revoked/root,
+	// after GET /pki/certs/subject-key-id/{subject_key_id} and
+	// after GET /pki/certs/class/{class}
 	// to avoid wrong matches
 	r.HandleFunc(
 		fmt.Sprintf("/%s/certs/{%s}/{%s}", storeName, subject, subjectKeyID),
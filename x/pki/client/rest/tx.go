@@ -24,8 +24,14 @@ import (
 )
 
 type ProposeAddRootCertificateRequest struct {
+	BaseReq restTypes.BaseReq      `json:"base_req"`
+	Cert    string                 `json:"cert"`
+	Class   types.CertificateClass `json:"class,omitempty"`
+}
+
+type RejectAddRootCertificateRequest struct {
 	BaseReq restTypes.BaseReq `json:"base_req"`
-	Cert    string            `json:"cert"`
+	Reason  string            `json:"reason"`
 }
 
 type AddCertificateRequest struct {
@@ -34,9 +40,22 @@ type AddCertificateRequest struct {
 }
 
 type ProposeRevokeRootCertificateRequest struct {
+	BaseReq          restTypes.BaseReq `json:"base_req"`
+	Subject          string            `json:"subject"`
+	SubjectKeyID     string            `json:"subject_key_id"`
+	ExpectedSequence uint64            `json:"expected_sequence"`
+}
+
+type RevokeCertificateRequest struct {
+	BaseReq          restTypes.BaseReq `json:"base_req"`
+	ExpectedSequence uint64            `json:"expected_sequence"`
+}
+
+type CrlDistributionPointRequest struct {
 	BaseReq      restTypes.BaseReq `json:"base_req"`
 	Subject      string            `json:"subject"`
 	SubjectKeyID string            `json:"subject_key_id"`
+	URL          string            `json:"url"`
 }
 
 func proposeAddX509RootCertHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -59,6 +78,7 @@ func proposeAddX509RootCertHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		msg := types.NewMsgProposeAddX509RootCert(req.Cert, restCtx.Signer())
+		msg.Class = req.Class
 
 		restCtx.HandleWriteRequest(msg)
 	}
@@ -91,6 +111,33 @@ func approveAddX509RootCertHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func rejectAddX509RootCertHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		var req RejectAddRootCertificateRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgRejectAddX509RootCert(vars[subject], vars[subjectKeyID], req.Reason, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
 func addX509CertHandler(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
@@ -136,6 +183,7 @@ func proposeRevokeX509RootCertHandler(cliCtx context.CLIContext) http.HandlerFun
 		}
 
 		msg := types.NewMsgProposeRevokeX509RootCert(req.Subject, req.SubjectKeyID, restCtx.Signer())
+		msg.ExpectedSequence = req.ExpectedSequence
 
 		restCtx.HandleWriteRequest(msg)
 	}
@@ -174,7 +222,7 @@ func revokeX509CertHandler(cliCtx context.CLIContext) http.HandlerFunc {
 
 		vars := restCtx.Variables()
 
-		var req rest.BasicReq
+		var req RevokeCertificateRequest
 		if !restCtx.ReadRESTReq(&req) {
 			return
 		}
@@ -190,6 +238,57 @@ func revokeX509CertHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		msg := types.NewMsgRevokeX509Cert(vars[subject], vars[subjectKeyID], restCtx.Signer())
+		msg.ExpectedSequence = req.ExpectedSequence
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func addCrlDistributionPointHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req CrlDistributionPointRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgAddCrlDistributionPoint(req.Subject, req.SubjectKeyID, req.URL, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func removeCrlDistributionPointHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req CrlDistributionPointRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgRemoveCrlDistributionPoint(req.Subject, req.SubjectKeyID, req.URL, restCtx.Signer())
 
 		restCtx.HandleWriteRequest(msg)
 	}
@@ -15,10 +15,13 @@
 package pki
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/x509"
 )
 
 type GenesisState struct {
@@ -26,7 +29,11 @@ type GenesisState struct {
 	ApprovedCertificatesRecords    []types.Certificates                  `json:"approved_certificates_records"`
 	ProposedCertificateRevocations []types.ProposedCertificateRevocation `json:"proposed_certificate_revocations"`
 	RevokedCertificatesRecords     []types.Certificates                  `json:"revoked_certificates_records"`
+	ExpiredCertificatesRecords     []types.Certificates                  `json:"expired_certificates_records"`
 	ChildCertificatesRecords       []types.ChildCertificates             `json:"child_certificates_records"`
+	CrlDistributionPointsRecords   []types.CrlDistributionPoints         `json:"crl_distribution_points_records"`
+	RejectedCertificates           []types.ProposedCertificate           `json:"rejected_certificates"`
+	Params                         types.Params                          `json:"params"`
 }
 
 func NewGenesisState() GenesisState {
@@ -35,7 +42,11 @@ func NewGenesisState() GenesisState {
 		ApprovedCertificatesRecords:    []types.Certificates{},
 		ProposedCertificateRevocations: []types.ProposedCertificateRevocation{},
 		RevokedCertificatesRecords:     []types.Certificates{},
+		ExpiredCertificatesRecords:     []types.Certificates{},
 		ChildCertificatesRecords:       []types.ChildCertificates{},
+		CrlDistributionPointsRecords:   []types.CrlDistributionPoints{},
+		RejectedCertificates:           []types.ProposedCertificate{},
+		Params:                         types.DefaultParams(),
 	}
 }
 
@@ -64,15 +75,113 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	for _, record := range data.ExpiredCertificatesRecords {
+		if err := validateCertificates(record); err != nil {
+			return err
+		}
+	}
+
 	for _, record := range data.ChildCertificatesRecords {
 		if err := validateChildCertificates(record); err != nil {
 			return err
 		}
 	}
 
+	for _, record := range data.CrlDistributionPointsRecords {
+		if err := validateCrlDistributionPoints(record); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range data.RejectedCertificates {
+		if err := validateProposedCertificate(record); err != nil {
+			return err
+		}
+	}
+
+	// params may be omitted in genesis, default ones are used in this case
+	if data.Params != (types.Params{}) {
+		if err := data.Params.Validate(); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Params: %v", err))
+		}
+	}
+
 	return nil
 }
 
+// Verifies every approved certificate against its issuer among the approved certificates
+// (root certificates against themselves). Each link is verified at the latest start of the validity periods
+// of the linked certificates, so expiration is not treated as a violation. All found violations are returned.
+func ValidateGenesisCertificateChains(data GenesisState) []error {
+	var violations []error
+
+	approved := make(map[string][]types.Certificate)
+
+	for _, record := range data.ApprovedCertificatesRecords {
+		for _, certificate := range record.Items {
+			key := certificate.Subject + "/" + certificate.SubjectKeyID
+			approved[key] = append(approved[key], certificate)
+		}
+	}
+
+	for _, record := range data.ApprovedCertificatesRecords {
+		for _, certificate := range record.Items {
+			if err := verifyGenesisCertificate(certificate, approved); err != nil {
+				violations = append(violations, fmt.Errorf("invalid approved certificate with subject=%v "+
+					"and subjectKeyID=%v: %v", certificate.Subject, certificate.SubjectKeyID, err))
+			}
+		}
+	}
+
+	return violations
+}
+
+func verifyGenesisCertificate(certificate types.Certificate, approved map[string][]types.Certificate) error {
+	x509Certificate, err := x509.DecodeX509Certificate(certificate.PemCert)
+	if err != nil {
+		return fmt.Errorf("%v", err.Data())
+	}
+
+	if certificate.IsRoot {
+		if !x509Certificate.IsSelfSigned() {
+			return fmt.Errorf("root certificate is not self-signed")
+		}
+
+		if err := x509Certificate.Verify(x509Certificate, x509Certificate.Certificate.NotBefore, 0); err != nil {
+			return fmt.Errorf("%v", err.Data())
+		}
+
+		return nil
+	}
+
+	parents := approved[x509Certificate.Issuer+"/"+x509Certificate.AuthorityKeyID]
+	if len(parents) == 0 {
+		return fmt.Errorf("no approved issuer certificate found")
+	}
+
+	var lastErr sdk.Error
+
+	for _, parent := range parents {
+		parentX509Certificate, err := x509.DecodeX509Certificate(parent.PemCert)
+		if err != nil {
+			lastErr = err
+
+			continue
+		}
+
+		verificationTime := x509Certificate.Certificate.NotBefore
+		if parentX509Certificate.Certificate.NotBefore.After(verificationTime) {
+			verificationTime = parentX509Certificate.Certificate.NotBefore
+		}
+
+		if lastErr = x509Certificate.Verify(parentX509Certificate, verificationTime, 0); lastErr == nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%v", lastErr.Data())
+}
+
 func validateProposedCertificate(record types.ProposedCertificate) error {
 	if len(record.PemCert) == 0 {
 		return sdk.ErrUnknownRequest(
@@ -99,6 +208,10 @@ func validateProposedCertificate(record types.ProposedCertificate) error {
 			fmt.Sprintf("Invalid ProposedCertificate: Empty Owner. Value: %v", record))
 	}
 
+	if len(record.Class) != 0 && !record.Class.IsValid() {
+		return types.ErrInvalidCertificateClass(record.Class)
+	}
+
 	if record.Approvals == nil {
 		return sdk.ErrUnknownRequest(
 			fmt.Sprintf("Invalid ProposedCertificate: Approvals is nil. Value: %v", record))
@@ -141,6 +254,10 @@ func validateCertificates(record types.Certificates) error {
 				fmt.Sprintf("Invalid Certificate: Empty SerialNumber. Value: %v", certificate))
 		}
 
+		if len(certificate.Class) != 0 && !certificate.Class.IsValid() {
+			return types.ErrInvalidCertificateClass(certificate.Class)
+		}
+
 		if !certificate.IsRoot {
 			if len(certificate.Issuer) == 0 {
 				return sdk.ErrUnknownRequest(
@@ -229,6 +346,35 @@ func validateChildCertificates(record types.ChildCertificates) error {
 	return nil
 }
 
+func validateCrlDistributionPoints(record types.CrlDistributionPoints) error {
+	if len(record.Subject) == 0 {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid CrlDistributionPoints: Empty Subject. Value: %v", record))
+	}
+
+	if len(record.SubjectKeyID) == 0 {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid CrlDistributionPoints: Empty SubjectKeyID. Value: %v", record))
+	}
+
+	if len(record.RootSubject) == 0 {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid CrlDistributionPoints: Empty RootSubject. Value: %v", record))
+	}
+
+	if len(record.RootSubjectKeyID) == 0 {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid CrlDistributionPoints: Empty RootSubjectKeyID. Value: %v", record))
+	}
+
+	if len(record.URLs) == 0 {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid CrlDistributionPoints: Empty URLs. Value: %v", record))
+	}
+
+	return nil
+}
+
 func DefaultGenesisState() GenesisState {
 	return NewGenesisState()
 }
@@ -236,12 +382,17 @@ func DefaultGenesisState() GenesisState {
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 	for _, record := range data.ProposedCertificates {
 		keeper.SetProposedCertificate(ctx, record)
+		setUniqueCertificateKey(ctx, keeper, record.PemCert)
 	}
 
 	for _, record := range data.ApprovedCertificatesRecords {
 		if len(record.Items) > 0 {
 			keeper.SetApprovedCertificates(ctx, record.Items[0].Subject, record.Items[0].SubjectKeyID, record)
 		}
+
+		for _, certificate := range record.Items {
+			setUniqueCertificateKey(ctx, keeper, certificate.PemCert)
+		}
 	}
 
 	for _, record := range data.ProposedCertificateRevocations {
@@ -252,11 +403,50 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 		if len(record.Items) > 0 {
 			keeper.SetRevokedCertificates(ctx, record.Items[0].Subject, record.Items[0].SubjectKeyID, record)
 		}
+
+		for _, certificate := range record.Items {
+			setUniqueCertificateKey(ctx, keeper, certificate.PemCert)
+		}
+	}
+
+	for _, record := range data.ExpiredCertificatesRecords {
+		if len(record.Items) > 0 {
+			keeper.SetExpiredCertificates(ctx, record.Items[0].Subject, record.Items[0].SubjectKeyID, record)
+		}
+
+		for _, certificate := range record.Items {
+			setUniqueCertificateKey(ctx, keeper, certificate.PemCert)
+		}
 	}
 
 	for _, record := range data.ChildCertificatesRecords {
 		keeper.SetChildCertificates(ctx, record)
 	}
+
+	for _, record := range data.CrlDistributionPointsRecords {
+		keeper.SetCrlDistributionPoints(ctx, record)
+	}
+
+	for _, record := range data.RejectedCertificates {
+		keeper.SetRejectedCertificate(ctx, record)
+	}
+
+	// the certificates without a class (the class is optional in the genesis) are attestation ones
+	keeper.SetCertificatesClass(ctx, types.DefaultCertificateClass)
+
+	if data.Params != (types.Params{}) {
+		keeper.SetParams(ctx, data.Params)
+	}
+}
+
+// Unique certificate keys are not exported, so they are restored from the stored certificates.
+func setUniqueCertificateKey(ctx sdk.Context, keeper Keeper, pemCert string) {
+	x509Certificate, err := x509.DecodeX509Certificate(pemCert)
+	if err != nil {
+		panic(err)
+	}
+
+	keeper.SetUniqueCertificateKey(ctx, x509Certificate.Issuer, x509Certificate.SerialNumber)
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
@@ -265,7 +455,10 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		approvedCertificatesRecords    []types.Certificates
 		proposedCertificateRevocations []types.ProposedCertificateRevocation
 		revokedCertificatesRecords     []types.Certificates
+		expiredCertificatesRecords     []types.Certificates
 		childCertificatesRecords       []types.ChildCertificates
+		crlDistributionPointsRecords   []types.CrlDistributionPoints
+		rejectedCertificates           []types.ProposedCertificate
 	)
 
 	k.IterateProposedCertificates(ctx, func(value types.ProposedCertificate) (stop bool) {
@@ -292,17 +485,87 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		return false
 	})
 
+	k.IterateExpiredCertificatesRecords(ctx, "", func(value types.Certificates) (stop bool) {
+		expiredCertificatesRecords = append(expiredCertificatesRecords, value)
+
+		return false
+	})
+
 	k.IterateChildCertificatesRecords(ctx, func(value types.ChildCertificates) (stop bool) {
 		childCertificatesRecords = append(childCertificatesRecords, value)
 
 		return false
 	})
 
+	k.IterateCrlDistributionPoints(ctx, func(value types.CrlDistributionPoints) (stop bool) {
+		crlDistributionPointsRecords = append(crlDistributionPointsRecords, value)
+
+		return false
+	})
+
+	k.IterateRejectedCertificates(ctx, func(value types.ProposedCertificate) (stop bool) {
+		rejectedCertificates = append(rejectedCertificates, value)
+
+		return false
+	})
+
 	return GenesisState{
 		ProposedCertificates:           proposedCertificates,
 		ApprovedCertificatesRecords:    approvedCertificatesRecords,
 		ProposedCertificateRevocations: proposedCertificateRevocations,
 		RevokedCertificatesRecords:     revokedCertificatesRecords,
+		ExpiredCertificatesRecords:     expiredCertificatesRecords,
 		ChildCertificatesRecords:       childCertificatesRecords,
+		CrlDistributionPointsRecords:   crlDistributionPointsRecords,
+		RejectedCertificates:           rejectedCertificates,
+		Params:                         k.GetParams(ctx),
 	}
 }
+
+// MigrateGenesisCertificatesClass sets the class of the certificates exported without a class
+// (before the classes were introduced). The state is processed as generic JSON, so it does not need
+// to be decodable by the genesis types of this binary.
+func MigrateGenesisCertificatesClass(state json.RawMessage, class types.CertificateClass) (json.RawMessage, error) {
+	var genesis map[string]interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(state))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&genesis); err != nil {
+		return nil, err
+	}
+
+	setClass := func(certificate interface{}) {
+		fields, ok := certificate.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		if value, _ := fields["class"].(string); len(value) == 0 {
+			fields["class"] = string(class)
+		}
+	}
+
+	for _, key := range []string{"proposed_certificates", "rejected_certificates"} {
+		records, _ := genesis[key].([]interface{})
+		for _, record := range records {
+			setClass(record)
+		}
+	}
+
+	for _, key := range []string{
+		"approved_certificates_records", "revoked_certificates_records", "expired_certificates_records",
+	} {
+		records, _ := genesis[key].([]interface{})
+		for _, record := range records {
+			fields, _ := record.(map[string]interface{})
+			items, _ := fields["items"].([]interface{})
+
+			for _, item := range items {
+				setClass(item)
+			}
+		}
+	}
+
+	return json.Marshal(genesis)
+}
@@ -18,19 +18,31 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/keeper"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/types"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/x509"
 )
 
+func init() {
+	auth.RegisterMsgRoles(types.MsgApproveAddX509RootCert{}, types.RootCertificateApprovalRole)
+	auth.RegisterMsgRoles(types.MsgRejectAddX509RootCert{}, types.RootCertificateApprovalRole)
+	auth.RegisterMsgRoles(types.MsgProposeRevokeX509RootCert{}, types.RootCertificateApprovalRole)
+	auth.RegisterMsgRoles(types.MsgApproveRevokeX509RootCert{}, types.RootCertificateApprovalRole)
+}
+
 func NewHandler(keeper keeper.Keeper, authKeeper auth.Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
 		switch msg := msg.(type) {
 		case types.MsgProposeAddX509RootCert:
 			return handleMsgProposeAddX509RootCert(ctx, keeper, authKeeper, msg)
 		case types.MsgApproveAddX509RootCert:
 			return handleMsgApproveAddX509RootCert(ctx, keeper, authKeeper, msg)
+		case types.MsgRejectAddX509RootCert:
+			return handleMsgRejectAddX509RootCert(ctx, keeper, authKeeper, msg)
 		case types.MsgProposeRevokeX509RootCert:
 			return handleMsgProposeRevokeX509RootCert(ctx, keeper, authKeeper, msg)
 		case types.MsgApproveRevokeX509RootCert:
@@ -39,6 +51,10 @@ func NewHandler(keeper keeper.Keeper, authKeeper auth.Keeper) sdk.Handler {
 			return handleMsgAddX509Cert(ctx, keeper, msg)
 		case types.MsgRevokeX509Cert:
 			return handleMsgRevokeX509Cert(ctx, keeper, msg)
+		case types.MsgAddCrlDistributionPoint:
+			return handleMsgAddCrlDistributionPoint(ctx, keeper, msg)
+		case types.MsgRemoveCrlDistributionPoint:
+			return handleMsgRemoveCrlDistributionPoint(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("unrecognized pki Msg type: %v", msg.Type())
 
@@ -63,6 +79,14 @@ func handleMsgProposeAddX509RootCert(ctx sdk.Context, keeper keeper.Keeper, auth
 				"so it cannot be used as a root certificate.").Result()
 	}
 
+	// attestation root certificates (PAA) must be able to issue PAI certificates
+	class := msg.CertificateClass()
+	if class == types.AttestationCertificateClass && !x509Certificate.IsCA() {
+		return types.ErrInappropriateCertificateType(
+			"Inappropriate Certificate Type: Passed certificate is not a CA certificate, " +
+				"so it cannot be used as an attestation root certificate (PAA).").Result()
+	}
+
 	// check if `Proposed` certificate with the same Subject/SubjectKeyId combination already exists
 	if keeper.IsProposedCertificatePresent(ctx, x509Certificate.Subject, x509Certificate.SubjectKeyID) {
 		return types.ErrProposedCertificateAlreadyExists(x509Certificate.Subject, x509Certificate.SubjectKeyID).Result()
@@ -100,6 +124,15 @@ func handleMsgProposeAddX509RootCert(ctx sdk.Context, keeper keeper.Keeper, auth
 					"can add new certificate with the same subject and subjectKeyID",
 					x509Certificate.Subject, x509Certificate.SubjectKeyID)).Result()
 		}
+
+		// the certificates with the same subject and subjectKeyID originate the same chains
+		if existingCertificates.Items[0].Class != class {
+			return types.ErrInappropriateCertificateType(
+				fmt.Sprintf("Class of new certificate with subject=%v and subjectKeyID=%v must be the same as "+
+					"class=%v of existing certificates with the same subject and subjectKeyID",
+					x509Certificate.Subject, x509Certificate.SubjectKeyID,
+					existingCertificates.Items[0].Class)).Result()
+		}
 	}
 
 	// create new proposed certificate with empty approvals list
@@ -110,6 +143,8 @@ func handleMsgProposeAddX509RootCert(ctx sdk.Context, keeper keeper.Keeper, auth
 		x509Certificate.SerialNumber,
 		msg.Signer,
 	)
+	proposedCertificate.Class = class
+	proposedCertificate.ProposedAt = ctx.BlockHeight()
 
 	// if signer has `RootCertificateApprovalRole` append approval
 	if authKeeper.HasRole(ctx, msg.Signer, types.RootCertificateApprovalRole) {
@@ -128,10 +163,8 @@ func handleMsgProposeAddX509RootCert(ctx sdk.Context, keeper keeper.Keeper, auth
 func handleMsgApproveAddX509RootCert(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper,
 	msg types.MsgApproveAddX509RootCert) sdk.Result {
 	// check if signer has root certificate approval role
-	if !authKeeper.HasRole(ctx, msg.Signer, types.RootCertificateApprovalRole) {
-		return sdk.ErrUnauthorized(
-			fmt.Sprintf("MsgApproveAddX509RootCert transaction should be signed by "+
-				"an account with the \"%s\" role", types.RootCertificateApprovalRole)).Result()
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
 	}
 
 	// check if corresponding proposed certificate exists
@@ -143,17 +176,30 @@ func handleMsgApproveAddX509RootCert(ctx sdk.Context, keeper keeper.Keeper, auth
 	proposedCertificate := keeper.GetProposedCertificate(ctx, msg.Subject, msg.SubjectKeyID)
 
 	// check if proposed certificate already has approval form signer
-	if proposedCertificate.HasApprovalFrom(msg.Signer) {
+	approved, added := approvals.AddApproval(proposedCertificate.Approvals, msg.Signer)
+	if !added {
 		return sdk.ErrUnauthorized(
 			fmt.Sprintf("Certificate associated with subject=%v and subjectKeyID=%v combination "+
 				"already has approval from=%v", msg.Subject, msg.SubjectKeyID, msg.Signer)).Result()
 	}
 
+	// check if proposed certificate already has rejection from signer
+	if proposedCertificate.HasRejectionFrom(msg.Signer) {
+		return sdk.ErrUnauthorized(
+			fmt.Sprintf("Certificate associated with subject=%v and subjectKeyID=%v combination "+
+				"already has rejection from=%v", msg.Subject, msg.SubjectKeyID, msg.Signer)).Result()
+	}
+
 	// append approval
-	proposedCertificate.Approvals = append(proposedCertificate.Approvals, msg.Signer)
+	proposedCertificate.Approvals = approved
 
 	// check if proposed certificate has enough approvals
-	if len(proposedCertificate.Approvals) == types.RootCertificateApprovals {
+	if isRootCertificateQuorumReached(ctx, keeper, authKeeper, len(proposedCertificate.Approvals)) {
+		x509Certificate, err := x509.DecodeX509Certificate(proposedCertificate.PemCert)
+		if err != nil {
+			return err.Result()
+		}
+
 		// create approved certificate
 		rootCertificate := types.NewRootCertificate(
 			proposedCertificate.PemCert,
@@ -162,12 +208,62 @@ func handleMsgApproveAddX509RootCert(ctx sdk.Context, keeper keeper.Keeper, auth
 			proposedCertificate.SerialNumber,
 			proposedCertificate.Owner,
 		)
+		rootCertificate.Class = proposedCertificate.Class
+		rootCertificate.NotAfter = x509Certificate.Certificate.NotAfter
 
 		// add approved certificate to stored list of certificates with the same Subject/SubjectKeyId combination
 		keeper.AddApprovedCertificate(ctx, rootCertificate)
 
 		// delete proposed certificate
 		keeper.DeleteProposedCertificate(ctx, msg.Subject, msg.SubjectKeyID)
+
+		emitRootCertificateEvent(ctx, types.EventTypeAddX509RootCert, msg.Subject, msg.SubjectKeyID)
+	} else {
+		// update proposed certificate
+		keeper.SetProposedCertificate(ctx, proposedCertificate)
+	}
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRejectAddX509RootCert(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgRejectAddX509RootCert) sdk.Result {
+	// check if signer has root certificate approval role
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// check if corresponding proposed certificate exists
+	if !keeper.IsProposedCertificatePresent(ctx, msg.Subject, msg.SubjectKeyID) {
+		return types.ErrProposedCertificateDoesNotExist(msg.Subject, msg.SubjectKeyID).Result()
+	}
+
+	// get proposed certificate
+	proposedCertificate := keeper.GetProposedCertificate(ctx, msg.Subject, msg.SubjectKeyID)
+
+	// check if proposed certificate already has approval or rejection from signer
+	if proposedCertificate.HasApprovalFrom(msg.Signer) || proposedCertificate.HasRejectionFrom(msg.Signer) {
+		return sdk.ErrUnauthorized(
+			fmt.Sprintf("Certificate associated with subject=%v and subjectKeyID=%v combination "+
+				"already has approval or rejection from=%v", msg.Subject, msg.SubjectKeyID, msg.Signer)).Result()
+	}
+
+	// append rejection
+	proposedCertificate.Rejections = append(proposedCertificate.Rejections, types.NewRejection(msg.Signer, msg.Reason))
+
+	// check if proposed certificate has enough rejections
+	if isRootCertificateQuorumReached(ctx, keeper, authKeeper, len(proposedCertificate.Rejections)) {
+		x509Certificate, err := x509.DecodeX509Certificate(proposedCertificate.PemCert)
+		if err != nil {
+			return err.Result()
+		}
+
+		// move proposed certificate to rejected ones
+		keeper.SetRejectedCertificate(ctx, proposedCertificate)
+		keeper.DeleteProposedCertificate(ctx, msg.Subject, msg.SubjectKeyID)
+
+		// release the unique certificate key, so the certificate can be proposed again
+		keeper.DeleteUniqueCertificateKey(ctx, x509Certificate.Issuer, x509Certificate.SerialNumber)
 	} else {
 		// update proposed certificate
 		keeper.SetProposedCertificate(ctx, proposedCertificate)
@@ -176,25 +272,67 @@ func handleMsgApproveAddX509RootCert(ctx sdk.Context, keeper keeper.Keeper, auth
 	return sdk.Result{}
 }
 
-// nolint:funlen
+// Adds the intermediate or leaf certificates passed either as a single PEM / DER certificate
+// or as a bundle of PEM certificates (a chain exported from a CA tooling).
 func handleMsgAddX509Cert(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgAddX509Cert) sdk.Result {
-	// decode pem certificate
-	x509Certificate, err := x509.DecodeX509Certificate(msg.Cert)
+	pemCertificates, err := x509.SplitCertificates(msg.Cert)
 	if err != nil {
 		return err.Result()
 	}
 
+	x509Certificates := make([]*x509.X509Certificate, 0, len(pemCertificates))
+
+	for _, pemCertificate := range pemCertificates {
+		x509Certificate, err := x509.DecodeX509Certificate(pemCertificate)
+		if err != nil {
+			return err.Result()
+		}
+
+		x509Certificates = append(x509Certificates, x509Certificate)
+	}
+
+	if len(x509Certificates) == 1 {
+		if err := addX509Cert(ctx, keeper, pemCertificates[0], x509Certificates[0], msg.Signer); err != nil {
+			return err.Result()
+		}
+
+		return sdk.Result{}
+	}
+
+	// add the certificates of the bundle so that every certificate is added after its issuer
+	order, err := orderCertificateChain(x509Certificates)
+	if err != nil {
+		return err.Result()
+	}
+
+	for _, i := range order {
+		// root certificates can be added only via proposal, but bundles may contain already approved ones
+		if x509Certificates[i].IsSelfSigned() && isApprovedRootCertificate(ctx, keeper, x509Certificates[i]) {
+			continue
+		}
+
+		if err := addX509Cert(ctx, keeper, pemCertificates[i], x509Certificates[i], msg.Signer); err != nil {
+			return err.Result()
+		}
+	}
+
+	return sdk.Result{}
+}
+
+// nolint:funlen
+func addX509Cert(ctx sdk.Context, keeper keeper.Keeper, pemCertificate string,
+	x509Certificate *x509.X509Certificate, signer sdk.AccAddress) sdk.Error {
 	// fail if certificate is self-signed
 	if x509Certificate.IsSelfSigned() {
 		return types.ErrInappropriateCertificateType(
 			"Inappropriate Certificate Type: Passed certificate is self-signed, " +
 				"so it cannot be added to the system as a non-root certificate. " +
-				"To propose adding a root certificate please use `PROPOSE_ADD_X509_ROOT_CERT` transaction.").Result()
+				"To propose adding a root certificate please use `PROPOSE_ADD_X509_ROOT_CERT` transaction.")
 	}
 
 	// check if certificate with Issuer/Serial Number combination already exists
 	if keeper.IsUniqueCertificateKeyPresent(ctx, x509Certificate.Issuer, x509Certificate.SerialNumber) {
-		return types.ErrCertificateAlreadyExists(x509Certificate.Issuer, x509Certificate.SerialNumber).Result()
+		return types.ErrCertificateAlreadyExists(x509Certificate.Issuer, x509Certificate.SerialNumber)
 	}
 
 	// Get list of certificates for Subject / Subject Key Id combination
@@ -209,15 +347,15 @@ func handleMsgAddX509Cert(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgAd
 			return sdk.ErrUnauthorized(
 				fmt.Sprintf("Issuer and authorityKeyID of new certificate with subject=%v and subjectKeyID=%v "+
 					"must be the same as ones of existing certificates with the same subject and subjectKeyID",
-					x509Certificate.Subject, x509Certificate.SubjectKeyID)).Result()
+					x509Certificate.Subject, x509Certificate.SubjectKeyID))
 		}
 
 		// signer must be same as owner of existing certificates
-		if !msg.Signer.Equals(certificates.Items[0].Owner) {
+		if !signer.Equals(certificates.Items[0].Owner) {
 			return sdk.ErrUnauthorized(
 				fmt.Sprintf("Only owner of existing certificates with subject=%v and subjectKeyID=%v "+
 					"can add new certificate with the same subject and subjectKeyID",
-					x509Certificate.Subject, x509Certificate.SubjectKeyID)).Result()
+					x509Certificate.Subject, x509Certificate.SubjectKeyID))
 		}
 	}
 
@@ -225,13 +363,20 @@ func handleMsgAddX509Cert(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgAd
 	rootCertificateSubject, rootCertificateSubjectKeyID, err := verifyCertificate(ctx, keeper, x509Certificate)
 	if err != nil {
 		return types.ErrCodeInvalidCertificate(
-			fmt.Sprintf("Cannot build valid certificate chain for certificate with subject=%v and subjectKeyID=%v",
-				x509Certificate.Subject, x509Certificate.SubjectKeyID)).Result()
+			fmt.Sprintf("Cannot build valid certificate chain for certificate with subject=%v and subjectKeyID=%v: %v",
+				x509Certificate.Subject, x509Certificate.SubjectKeyID, err.Data()))
+	}
+
+	// the certificate belongs to the class of its root certificate
+	class := keeper.GetApprovedCertificates(ctx, rootCertificateSubject, rootCertificateSubjectKeyID).Items[0].Class
+	if err := verifyCertificateClass(x509Certificate, class,
+		rootCertificateSubject, rootCertificateSubjectKeyID); err != nil {
+		return err
 	}
 
 	// create new certificate
 	certificate := types.NewNonRootCertificate(
-		msg.Cert,
+		pemCertificate,
 		x509Certificate.Subject,
 		x509Certificate.SubjectKeyID,
 		x509Certificate.SerialNumber,
@@ -239,10 +384,16 @@ func handleMsgAddX509Cert(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgAd
 		x509Certificate.AuthorityKeyID,
 		rootCertificateSubject,
 		rootCertificateSubjectKeyID,
-		msg.Signer,
+		signer,
 	)
+	certificate.Class = class
+	certificate.NotAfter = x509Certificate.Certificate.NotAfter
 
 	// append new certificate to list of certificates with the same Subject/SubjectKeyId combination and store updated list
+	if len(certificates.Items) > 0 {
+		certificates.Sequence++
+	}
+
 	certificates.Items = append(certificates.Items, certificate)
 	keeper.SetApprovedCertificates(ctx, certificate.Subject, certificate.SubjectKeyID, certificates)
 
@@ -253,16 +404,94 @@ func handleMsgAddX509Cert(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgAd
 	// register the unique certificate key
 	keeper.SetUniqueCertificateKey(ctx, x509Certificate.Issuer, x509Certificate.SerialNumber)
 
-	return sdk.Result{}
+	return nil
+}
+
+// Checks the class-specific rules for the non-root certificate originated from the given root certificate.
+// Attestation chains are PAA -> PAI -> DAC: the certificates issued by PAA must be CA ones (PAI)
+// and the certificates issued by PAI must not (DAC). NOC chains are not restricted.
+func verifyCertificateClass(x509Certificate *x509.X509Certificate, class types.CertificateClass,
+	rootSubject string, rootSubjectKeyID string) sdk.Error {
+	if class != types.AttestationCertificateClass {
+		return nil
+	}
+
+	issuedByRoot := x509Certificate.Issuer == rootSubject && x509Certificate.AuthorityKeyID == rootSubjectKeyID
+
+	if issuedByRoot && !x509Certificate.IsCA() {
+		return types.ErrInappropriateCertificateType(
+			fmt.Sprintf("Inappropriate Certificate Type: attestation certificate with subject=%v and subjectKeyID=%v "+
+				"is issued by PAA, so it must be a CA certificate (PAI)",
+				x509Certificate.Subject, x509Certificate.SubjectKeyID))
+	}
+
+	if !issuedByRoot && x509Certificate.IsCA() {
+		return types.ErrInappropriateCertificateType(
+			fmt.Sprintf("Inappropriate Certificate Type: attestation certificate with subject=%v and subjectKeyID=%v "+
+				"is issued by PAI, so it must not be a CA certificate (DAC)",
+				x509Certificate.Subject, x509Certificate.SubjectKeyID))
+	}
+
+	return nil
+}
+
+// Returns the indexes of the certificates of a bundle in the order in which every certificate
+// follows its issuer if the issuer is in the bundle too.
+func orderCertificateChain(x509Certificates []*x509.X509Certificate) ([]int, sdk.Error) {
+	order := make([]int, 0, len(x509Certificates))
+	added := make([]bool, len(x509Certificates))
+
+	isIssuedByPending := func(certificate *x509.X509Certificate) bool {
+		if certificate.IsSelfSigned() {
+			return false
+		}
+
+		for i, issuer := range x509Certificates {
+			if !added[i] && issuer != certificate &&
+				issuer.Subject == certificate.Issuer && issuer.SubjectKeyID == certificate.AuthorityKeyID {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for len(order) < len(x509Certificates) {
+		progress := false
+
+		for i, certificate := range x509Certificates {
+			if !added[i] && !isIssuedByPending(certificate) {
+				order = append(order, i)
+				added[i] = true
+				progress = true
+			}
+		}
+
+		if !progress {
+			return nil, types.ErrCodeInvalidCertificate(
+				"Cannot order the certificates of the bundle: they are issued by each other")
+		}
+	}
+
+	return order, nil
+}
+
+func isApprovedRootCertificate(ctx sdk.Context, keeper keeper.Keeper, x509Certificate *x509.X509Certificate) bool {
+	for _, certificate := range keeper.GetApprovedCertificates(
+		ctx, x509Certificate.Subject, x509Certificate.SubjectKeyID).Items {
+		if certificate.IsRoot && certificate.SerialNumber == x509Certificate.SerialNumber {
+			return true
+		}
+	}
+
+	return false
 }
 
 func handleMsgProposeRevokeX509RootCert(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper,
 	msg types.MsgProposeRevokeX509RootCert) sdk.Result {
 	// check if signer has root certificate approval role
-	if !authKeeper.HasRole(ctx, msg.Signer, types.RootCertificateApprovalRole) {
-		return sdk.ErrUnauthorized(
-			fmt.Sprintf("MsgProposeRevokeX509RootCert transaction should be signed by "+
-				"an account with the \"%s\" role", types.RootCertificateApprovalRole)).Result()
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
 	}
 
 	// check if corresponding approved certificates exist
@@ -285,6 +514,12 @@ func handleMsgProposeRevokeX509RootCert(ctx sdk.Context, keeper keeper.Keeper, a
 				"is not a root certificate.", msg.Subject, msg.SubjectKeyID)).Result()
 	}
 
+	// the certificates must not have been changed since the signer read them
+	if msg.ExpectedSequence != certificates.Sequence {
+		return types.ErrSequenceConflict(msg.Subject, msg.SubjectKeyID, msg.ExpectedSequence,
+			certificates.Sequence).Result()
+	}
+
 	// create new proposed certificate revocation with approval from signer
 	revocation := types.NewProposedCertificateRevocation(
 		msg.Subject,
@@ -301,10 +536,8 @@ func handleMsgProposeRevokeX509RootCert(ctx sdk.Context, keeper keeper.Keeper, a
 func handleMsgApproveRevokeX509RootCert(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper,
 	msg types.MsgApproveRevokeX509RootCert) sdk.Result {
 	// check if signer has root certificate approval role
-	if !authKeeper.HasRole(ctx, msg.Signer, types.RootCertificateApprovalRole) {
-		return sdk.ErrUnauthorized(
-			fmt.Sprintf("MsgApproveRevokeX509RootCert transaction should be signed by "+
-				"an account with the \"%s\" role", types.RootCertificateApprovalRole)).Result()
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
 	}
 
 	// check if corresponding proposed certificate revocation exists
@@ -316,30 +549,43 @@ func handleMsgApproveRevokeX509RootCert(ctx sdk.Context, keeper keeper.Keeper, a
 	revocation := keeper.GetProposedCertificateRevocation(ctx, msg.Subject, msg.SubjectKeyID)
 
 	// check if proposed certificate revocation already has approval form signer
-	if revocation.HasApprovalFrom(msg.Signer) {
+	approved, added := approvals.AddApproval(revocation.Approvals, msg.Signer)
+	if !added {
 		return sdk.ErrUnauthorized(
 			fmt.Sprintf("Certificate revocation associated with subject=%v and subjectKeyID=%v combination "+
 				"already has approval from=%v", msg.Subject, msg.SubjectKeyID, msg.Signer)).Result()
 	}
 
 	// append approval
-	revocation.Approvals = append(revocation.Approvals, msg.Signer)
+	revocation.Approvals = approved
 
 	// check if proposed certificate revocation has enough approvals
-	if len(revocation.Approvals) == types.RootCertificateApprovals {
-		certificates := keeper.GetApprovedCertificates(ctx, msg.Subject, msg.SubjectKeyID)
-
-		keeper.AddRevokedCertificates(ctx, msg.Subject, msg.SubjectKeyID, certificates)
-		keeper.DeleteApprovedCertificates(ctx, msg.Subject, msg.SubjectKeyID)
+	if isRootCertificateQuorumReached(ctx, keeper, authKeeper, len(revocation.Approvals)) {
+		revokedBy := types.NewCertificateIdentifier(msg.Subject, msg.SubjectKeyID)
 
-		revokeChildCertificates(ctx, keeper, msg.Subject, msg.SubjectKeyID)
+		revokeCertificates(ctx, keeper, msg.Subject, msg.SubjectKeyID, revokedBy)
+		revokeChildCertificates(ctx, keeper, msg.Subject, msg.SubjectKeyID, revokedBy)
 
 		keeper.DeleteProposedCertificateRevocation(ctx, msg.Subject, msg.SubjectKeyID)
+
+		emitRootCertificateEvent(ctx, types.EventTypeRevokeX509RootCert, msg.Subject, msg.SubjectKeyID)
 	} else {
 		keeper.SetProposedCertificateRevocation(ctx, revocation)
 	}
 
-	return sdk.Result{}
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// emits an event about the root certificate change approved by the required number of trustees.
+func emitRootCertificateEvent(ctx sdk.Context, eventType string, subject string, subjectKeyID string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySubject, subject),
+			sdk.NewAttribute(types.AttributeKeySubjectKeyID, subjectKeyID),
+		),
+	)
 }
 
 func handleMsgRevokeX509Cert(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgRevokeX509Cert) sdk.Result {
@@ -360,35 +606,157 @@ func handleMsgRevokeX509Cert(ctx sdk.Context, keeper keeper.Keeper, msg types.Ms
 		return sdk.ErrUnauthorized("Only owner can revoke certificate using `REVOKE_X509_CERT`").Result()
 	}
 
+	// the certificates must not have been changed since the signer read them
+	if msg.ExpectedSequence != certificates.Sequence {
+		return types.ErrSequenceConflict(msg.Subject, msg.SubjectKeyID, msg.ExpectedSequence,
+			certificates.Sequence).Result()
+	}
+
 	issuer := certificates.Items[0].Issuer
 	authorityKeyID := certificates.Items[0].AuthorityKeyID
 
+	certIdentifier := types.NewCertificateIdentifier(msg.Subject, msg.SubjectKeyID)
+
 	// Revoke certificates with given subject/subjectKeyID
-	keeper.AddRevokedCertificates(ctx, msg.Subject, msg.SubjectKeyID, certificates)
-	keeper.DeleteApprovedCertificates(ctx, msg.Subject, msg.SubjectKeyID)
+	revokeCertificates(ctx, keeper, msg.Subject, msg.SubjectKeyID, certIdentifier)
 
 	// Remove certificate identifier from issuer's ChildCertificates record
-	certIdentifier := types.NewCertificateIdentifier(msg.Subject, msg.SubjectKeyID)
 	removeChildCertificateEntry(ctx, keeper, issuer, authorityKeyID, certIdentifier)
 
-	revokeChildCertificates(ctx, keeper, msg.Subject, msg.SubjectKeyID)
+	revokeChildCertificates(ctx, keeper, msg.Subject, msg.SubjectKeyID, certIdentifier)
+
+	return sdk.Result{}
+}
+
+func handleMsgAddCrlDistributionPoint(ctx sdk.Context, keeper keeper.Keeper,
+	msg types.MsgAddCrlDistributionPoint) sdk.Result {
+	certificate, err := getCrlIssuerCertificate(ctx, keeper, msg.Subject, msg.SubjectKeyID, msg.Signer)
+	if err != nil {
+		return err.Result()
+	}
+
+	crlDistributionPoints := types.NewCrlDistributionPoints(msg.Subject, msg.SubjectKeyID,
+		certificate.RootSubject, certificate.RootSubjectKeyID)
+
+	if certificate.IsRoot {
+		crlDistributionPoints.RootSubject = certificate.Subject
+		crlDistributionPoints.RootSubjectKeyID = certificate.SubjectKeyID
+	}
+
+	if keeper.IsCrlDistributionPointsPresent(ctx, msg.Subject, msg.SubjectKeyID) {
+		crlDistributionPoints = keeper.GetCrlDistributionPoints(ctx, msg.Subject, msg.SubjectKeyID)
+	}
+
+	if crlDistributionPoints.HasURL(msg.URL) {
+		return types.ErrCrlDistributionPointAlreadyExists(msg.Subject, msg.SubjectKeyID, msg.URL).Result()
+	}
+
+	crlDistributionPoints.URLs = append(crlDistributionPoints.URLs, msg.URL)
+	keeper.SetCrlDistributionPoints(ctx, crlDistributionPoints)
+
+	return sdk.Result{}
+}
+
+func handleMsgRemoveCrlDistributionPoint(ctx sdk.Context, keeper keeper.Keeper,
+	msg types.MsgRemoveCrlDistributionPoint) sdk.Result {
+	if _, err := getCrlIssuerCertificate(ctx, keeper, msg.Subject, msg.SubjectKeyID, msg.Signer); err != nil {
+		return err.Result()
+	}
+
+	if !keeper.IsCrlDistributionPointsPresent(ctx, msg.Subject, msg.SubjectKeyID) {
+		return types.ErrCrlDistributionPointDoesNotExist(msg.Subject, msg.SubjectKeyID, msg.URL).Result()
+	}
+
+	crlDistributionPoints := keeper.GetCrlDistributionPoints(ctx, msg.Subject, msg.SubjectKeyID)
+
+	if !crlDistributionPoints.HasURL(msg.URL) {
+		return types.ErrCrlDistributionPointDoesNotExist(msg.Subject, msg.SubjectKeyID, msg.URL).Result()
+	}
+
+	urls := make([]string, 0, len(crlDistributionPoints.URLs)-1)
+
+	for _, url := range crlDistributionPoints.URLs {
+		if url != msg.URL {
+			urls = append(urls, url)
+		}
+	}
+
+	crlDistributionPoints.URLs = urls
+
+	if len(crlDistributionPoints.URLs) > 0 {
+		keeper.SetCrlDistributionPoints(ctx, crlDistributionPoints)
+	} else {
+		keeper.DeleteCrlDistributionPoints(ctx, msg.Subject, msg.SubjectKeyID)
+	}
 
 	return sdk.Result{}
 }
 
-func revokeChildCertificates(ctx sdk.Context, keeper keeper.Keeper, issuer string, authorityKeyID string) {
+// Returns the approved certificate which CRL distribution points can be managed by the signer:
+// it must be a CA (root or intermediate) certificate owned by the signer.
+func getCrlIssuerCertificate(ctx sdk.Context, keeper keeper.Keeper, subject string, subjectKeyID string,
+	signer sdk.AccAddress) (types.Certificate, sdk.Error) {
+	if !keeper.IsApprovedCertificatesPresent(ctx, subject, subjectKeyID) {
+		return types.Certificate{}, types.ErrCertificateDoesNotExist(subject, subjectKeyID)
+	}
+
+	certificate := keeper.GetApprovedCertificates(ctx, subject, subjectKeyID).Items[0]
+
+	if !signer.Equals(certificate.Owner) {
+		return types.Certificate{}, sdk.ErrUnauthorized(
+			fmt.Sprintf("Only owner of certificates with subject=%v and subjectKeyID=%v "+
+				"can manage their CRL distribution points", subject, subjectKeyID))
+	}
+
+	x509Certificate, err := x509.DecodeX509Certificate(certificate.PemCert)
+	if err != nil {
+		return types.Certificate{}, err
+	}
+
+	if !x509Certificate.IsCA() {
+		return types.Certificate{}, types.ErrInappropriateCertificateType(
+			fmt.Sprintf("Inappropriate Certificate Type: Certificate with subject=%v and subjectKeyID=%v "+
+				"is not a CA certificate, so it cannot issue CRLs.", subject, subjectKeyID))
+	}
+
+	return certificate, nil
+}
+
+// Moves approved certificates with the given subject/subjectKeyID to revoked ones
+// marking them as revoked because of the revocation of `revokedBy` certificate.
+func revokeCertificates(ctx sdk.Context, keeper keeper.Keeper, subject string, subjectKeyID string,
+	revokedBy types.CertificateIdentifier) {
+	// the certificates may have already expired
+	if !keeper.IsApprovedCertificatesPresent(ctx, subject, subjectKeyID) {
+		return
+	}
+
+	certificates := keeper.GetApprovedCertificates(ctx, subject, subjectKeyID)
+	revokedAt := ctx.BlockTime()
+
+	for i := range certificates.Items {
+		certificates.Items[i].RevokedBy = &revokedBy
+		certificates.Items[i].RevokedAt = &revokedAt
+	}
+
+	// the approved certificates are deleted first, so that the Serial Number index references the revoked ones
+	keeper.DeleteApprovedCertificates(ctx, subject, subjectKeyID)
+	keeper.DeleteCrlDistributionPoints(ctx, subject, subjectKeyID)
+	keeper.AddRevokedCertificates(ctx, subject, subjectKeyID, certificates)
+}
+
+func revokeChildCertificates(ctx sdk.Context, keeper keeper.Keeper, issuer string, authorityKeyID string,
+	revokedBy types.CertificateIdentifier) {
 	// Get issuer's ChildCertificates record
 	childCertificates := keeper.GetChildCertificates(ctx, issuer, authorityKeyID)
 
 	// For each child certificate subject/subjectKeyID combination
 	for _, certIdentifier := range childCertificates.CertIdentifiers {
 		// Revoke certificates with this subject/subjectKeyID combination
-		certificates := keeper.GetApprovedCertificates(ctx, certIdentifier.Subject, certIdentifier.SubjectKeyID)
-		keeper.AddRevokedCertificates(ctx, certIdentifier.Subject, certIdentifier.SubjectKeyID, certificates)
-		keeper.DeleteApprovedCertificates(ctx, certIdentifier.Subject, certIdentifier.SubjectKeyID)
+		revokeCertificates(ctx, keeper, certIdentifier.Subject, certIdentifier.SubjectKeyID, revokedBy)
 
 		// Process child certificates recursively
-		revokeChildCertificates(ctx, keeper, certIdentifier.Subject, certIdentifier.SubjectKeyID)
+		revokeChildCertificates(ctx, keeper, certIdentifier.Subject, certIdentifier.SubjectKeyID, revokedBy)
 	}
 
 	// Delete entire ChildCertificates record of issuer
@@ -441,10 +809,20 @@ func removeChildCertificateEntry(ctx sdk.Context, keeper keeper.Keeper, issuer s
 // Returns the RootSubject/RootSubjectKeyID combination or an error in case no valid certificate chain can be built.
 func verifyCertificate(ctx sdk.Context, keeper keeper.Keeper,
 	x509Certificate *x509.X509Certificate) (string, string, sdk.Error) {
+	return verifyCertificateChain(ctx, keeper, x509Certificate, 0)
+}
+
+// Builds the chain up to an approved root certificate. Every link is verified at the block time,
+// `intermediates` is the number of certificates between the given certificate and the one being added.
+func verifyCertificateChain(ctx sdk.Context, keeper keeper.Keeper,
+	x509Certificate *x509.X509Certificate, intermediates int) (string, string, sdk.Error) {
+	var lastErr sdk.Error
+
 	// nolint:nestif
 	if x509Certificate.IsSelfSigned() {
 		// in this system a certificate is self-signed if and only if it is a root certificate
-		if err := x509Certificate.Verify(x509Certificate); err == nil {
+		// (its path length constraint has already been checked against the certificates following it)
+		if lastErr = x509Certificate.Verify(x509Certificate, ctx.BlockTime(), 0); lastErr == nil {
 			return x509Certificate.Subject, x509Certificate.SubjectKeyID, nil
 		}
 	} else {
@@ -453,22 +831,39 @@ func verifyCertificate(ctx sdk.Context, keeper keeper.Keeper,
 		for _, cert := range parentCertificates.Items {
 			parentX509Certificate, err := x509.DecodeX509Certificate(cert.PemCert)
 			if err != nil {
+				lastErr = err
+
 				continue
 			}
 
 			// verify certificate against parent
-			if err := x509Certificate.Verify(parentX509Certificate); err != nil {
+			if err := x509Certificate.Verify(parentX509Certificate, ctx.BlockTime(), intermediates); err != nil {
+				lastErr = err
+
 				continue
 			}
 
 			// verify parent certificate
-			if subject, subjectKeyID, err := verifyCertificate(ctx, keeper, parentX509Certificate); err == nil {
+			subject, subjectKeyID, err := verifyCertificateChain(ctx, keeper, parentX509Certificate, intermediates+1)
+			if err == nil {
 				return subject, subjectKeyID, nil
 			}
+
+			lastErr = err
 		}
 	}
 
+	if lastErr != nil {
+		return "", "", lastErr
+	}
+
 	return "", "", types.ErrCodeInvalidCertificate(
-		fmt.Sprintf("Certificate verification failed for certificate with subject=%v and subjectKeyID=%v",
-			x509Certificate.Subject, x509Certificate.SubjectKeyID))
+		fmt.Sprintf("Certificate verification failed for certificate with subject=%v and subjectKeyID=%v. "+
+			"Error: no approved issuer certificate found", x509Certificate.Subject, x509Certificate.SubjectKeyID))
+}
+
+func isRootCertificateQuorumReached(ctx sdk.Context, keeper keeper.Keeper, authKeeper auth.Keeper, votes int) bool {
+	voters := authKeeper.CountAccountsWithRole(ctx, types.RootCertificateApprovalRole)
+
+	return keeper.GetParams(ctx).RootCertificateApprovalPolicy().IsReached(votes, voters)
 }
@@ -16,7 +16,9 @@
 package pki
 
 import (
+	"encoding/base64"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -28,7 +30,13 @@ import (
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/types"
 )
 
-const SerialNumber = "12345678"
+const (
+	SerialNumber = "12345678"
+	testCrlURL   = "http://example.com/crl/root.crl"
+	testCrlURL2  = "https://example.org/crl/root.crl"
+
+	testRejectionReason = "The certificate does not meet the requirements"
+)
 
 func TestHandler_ProposeAddX509RootCert_ByNotTrustee(t *testing.T) {
 	setup := Setup()
@@ -188,6 +196,22 @@ func TestHandler_ProposeAddX509RootCert_ForDifferentSerialNumberDifferentSigner(
 	require.Equal(t, sdk.CodeUnauthorized, result.Code)
 }
 
+func TestHandler_ProposeAddX509RootCert_ForDifferentSerialNumberDifferentClass(t *testing.T) {
+	setup := Setup()
+
+	// store attestation root certificate with different serial number
+	rootCertificate := rootCertificate(setup.Trustee)
+	rootCertificate.SerialNumber = SerialNumber
+	setup.PkiKeeper.SetUniqueCertificateKey(setup.Ctx, rootCertificate.Subject, rootCertificate.SerialNumber)
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, rootCertificate)
+
+	// propose second root certificate of NOC class
+	proposeAddX509RootCert := types.NewMsgProposeAddX509RootCert(constants.RootCertPem, setup.Trustee)
+	proposeAddX509RootCert.Class = types.NocCertificateClass
+	result := setup.Handler(setup.Ctx, proposeAddX509RootCert)
+	require.Equal(t, types.CodeInappropriateCertificateType, result.Code)
+}
+
 func TestHandler_ApproveAddX509RootCert_ForNotEnoughApprovals(t *testing.T) {
 	setup := Setup()
 
@@ -206,6 +230,9 @@ func TestHandler_ApproveAddX509RootCert_ForNotEnoughApprovals(t *testing.T) {
 	result = setup.Handler(setup.Ctx, approveAddX509RootCert)
 	require.Equal(t, sdk.CodeOK, result.Code)
 
+	// check that no event about the approved certificate is emitted
+	require.Empty(t, result.Events)
+
 	// query certificate
 	proposedCertificate, _ := queryProposedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
 	require.Equal(t, proposeAddX509RootCert.Cert, proposedCertificate.PemCert)
@@ -234,6 +261,9 @@ func TestHandler_ApproveAddX509RootCert_ForEnoughApprovals(t *testing.T) {
 	result = setup.Handler(setup.Ctx, approveAddX509RootCert)
 	require.Equal(t, sdk.CodeOK, result.Code)
 
+	// check the event about the approved certificate
+	checkRootCertificateEvent(t, result, types.EventTypeAddX509RootCert)
+
 	// query proposed certificate
 	_, err := queryProposedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
 	require.Equal(t, types.CodeProposedCertificateDoesNotExist, err.Code())
@@ -256,6 +286,45 @@ func TestHandler_ApproveAddX509RootCert_ForEnoughApprovals(t *testing.T) {
 		constants.RootIssuer, constants.RootSerialNumber))
 }
 
+func TestHandler_ApproveAddX509RootCert_ForNocClass(t *testing.T) {
+	setup := Setup()
+
+	// propose add NOC x509 root certificate by trustee
+	proposeAddX509RootCert := types.NewMsgProposeAddX509RootCert(constants.RootCertPem, setup.Trustee)
+	proposeAddX509RootCert.Class = types.NocCertificateClass
+	result := setup.Handler(setup.Ctx, proposeAddX509RootCert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	proposedCertificate, _ := queryProposedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, types.NocCertificateClass, proposedCertificate.Class)
+
+	// approve by second trustee
+	account := auth.NewAccount(constants.Address1, constants.PubKey1, auth.AccountRoles{auth.Trustee})
+	setup.AuthKeeper.SetAccount(setup.Ctx, account)
+
+	approveAddX509RootCert := types.NewMsgApproveAddX509RootCert(
+		constants.RootSubject, constants.RootSubjectKeyID, constants.Address1)
+	result = setup.Handler(setup.Ctx, approveAddX509RootCert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// add intermediate x509 certificate
+	addX509Cert := types.NewMsgAddX509Cert(constants.IntermediateCertPem, setup.Trustee)
+	result = setup.Handler(setup.Ctx, addX509Cert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// check that both certificates are of NOC class
+	approvedCertificate, _ := querySingleApprovedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, types.NocCertificateClass, approvedCertificate.Class)
+
+	intermediateCertificate, _ := querySingleApprovedCertificate(&setup,
+		constants.IntermediateSubject, constants.IntermediateSubjectKeyID)
+	require.Equal(t, types.NocCertificateClass, intermediateCertificate.Class)
+
+	// query the root certificates of each class
+	require.Equal(t, 0, queryAllRootCertificatesOfClass(&setup, types.AttestationCertificateClass).Total)
+	require.Equal(t, 1, queryAllRootCertificatesOfClass(&setup, types.NocCertificateClass).Total)
+}
+
 func TestHandler_ApproveAddX509RootCert_ForUnknownProposedCertificate(t *testing.T) {
 	setup := Setup()
 
@@ -283,7 +352,7 @@ func TestHandler_ApproveAddX509RootCert_ByNotTrustee(t *testing.T) {
 		approveAddX509RootCert := types.NewMsgApproveAddX509RootCert(
 			constants.RootSubject, constants.RootSubjectKeyID, constants.Address1)
 		result = setup.Handler(setup.Ctx, approveAddX509RootCert)
-		require.Equal(t, sdk.CodeUnauthorized, result.Code)
+		require.Equal(t, auth.CodeMissingRole, result.Code)
 	}
 }
 
@@ -310,6 +379,243 @@ func TestHandler_ApproveAddX509RootCert_Twice(t *testing.T) {
 	require.Equal(t, sdk.CodeUnauthorized, result.Code)
 }
 
+func TestHandler_ApproveAddX509RootCert_ForConfiguredNumberOfApprovals(t *testing.T) {
+	setup := Setup()
+
+	// increase the number of approvals required for root certificates control to three
+	setup.PkiKeeper.SetParams(setup.Ctx, types.NewParams(3))
+
+	// propose add x509 root certificate by trustee
+	proposeAddX509RootCert := types.NewMsgProposeAddX509RootCert(constants.RootCertPem, setup.Trustee)
+	result := setup.Handler(setup.Ctx, proposeAddX509RootCert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	for _, account := range []auth.Account{
+		auth.NewAccount(constants.Address1, constants.PubKey1, auth.AccountRoles{auth.Trustee}),
+		auth.NewAccount(constants.Address3, constants.PubKey3, auth.AccountRoles{auth.Trustee}),
+	} {
+		// store another trustee
+		setup.AuthKeeper.SetAccount(setup.Ctx, account)
+
+		// check that certificate is not approved yet
+		_, err := querySingleApprovedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+		require.Equal(t, types.CodeCertificateDoesNotExist, err.Code())
+
+		// approve by another trustee
+		approveAddX509RootCert := types.NewMsgApproveAddX509RootCert(
+			constants.RootSubject, constants.RootSubjectKeyID, account.Address)
+		result = setup.Handler(setup.Ctx, approveAddX509RootCert)
+		require.Equal(t, sdk.CodeOK, result.Code)
+	}
+
+	// check that certificate has been approved
+	approvedCertificate, _ := querySingleApprovedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, proposeAddX509RootCert.Cert, approvedCertificate.PemCert)
+}
+
+func TestHandler_ApproveAddX509RootCert_AfterRejection(t *testing.T) {
+	setup := Setup()
+
+	// store account
+	account := auth.NewAccount(constants.Address1, constants.PubKey1, auth.AccountRoles{})
+	setup.AuthKeeper.SetAccount(setup.Ctx, account)
+
+	// propose add x509 root certificate by account without trustee role
+	proposeAddX509RootCert := types.NewMsgProposeAddX509RootCert(constants.RootCertPem, constants.Address1)
+	result := setup.Handler(setup.Ctx, proposeAddX509RootCert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// reject
+	rejectAddX509RootCert := types.NewMsgRejectAddX509RootCert(
+		constants.RootSubject, constants.RootSubjectKeyID, testRejectionReason, setup.Trustee)
+	result = setup.Handler(setup.Ctx, rejectAddX509RootCert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// approve by the same trustee
+	approveAddX509RootCert := types.NewMsgApproveAddX509RootCert(
+		constants.RootSubject, constants.RootSubjectKeyID, setup.Trustee)
+	result = setup.Handler(setup.Ctx, approveAddX509RootCert)
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+}
+
+func TestHandler_RejectAddX509RootCert_ForNotEnoughRejections(t *testing.T) {
+	setup := Setup()
+
+	// store account
+	account := auth.NewAccount(constants.Address1, constants.PubKey1, auth.AccountRoles{})
+	setup.AuthKeeper.SetAccount(setup.Ctx, account)
+
+	// propose add x509 root certificate by account without trustee role
+	proposeAddX509RootCert := types.NewMsgProposeAddX509RootCert(constants.RootCertPem, constants.Address1)
+	result := setup.Handler(setup.Ctx, proposeAddX509RootCert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// reject
+	rejectAddX509RootCert := types.NewMsgRejectAddX509RootCert(
+		constants.RootSubject, constants.RootSubjectKeyID, testRejectionReason, setup.Trustee)
+	result = setup.Handler(setup.Ctx, rejectAddX509RootCert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query proposed certificate
+	proposedCertificate, _ := queryProposedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, 0, len(proposedCertificate.Approvals))
+	require.Equal(t, []types.Rejection{types.NewRejection(setup.Trustee, testRejectionReason)},
+		proposedCertificate.Rejections)
+
+	// query rejected certificate
+	_, err := queryRejectedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, types.CodeRejectedCertificateDoesNotExist, err.Code())
+}
+
+func TestHandler_RejectAddX509RootCert_ForEnoughRejections(t *testing.T) {
+	setup := Setup()
+
+	// store account
+	account := auth.NewAccount(constants.Address1, constants.PubKey1, auth.AccountRoles{})
+	setup.AuthKeeper.SetAccount(setup.Ctx, account)
+
+	// propose add x509 root certificate by account without trustee role
+	proposeAddX509RootCert := types.NewMsgProposeAddX509RootCert(constants.RootCertPem, constants.Address1)
+	result := setup.Handler(setup.Ctx, proposeAddX509RootCert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// store second trustee
+	account = auth.NewAccount(constants.Address3, constants.PubKey3, auth.AccountRoles{auth.Trustee})
+	setup.AuthKeeper.SetAccount(setup.Ctx, account)
+
+	// reject by both trustees
+	for _, address := range []sdk.AccAddress{setup.Trustee, constants.Address3} {
+		rejectAddX509RootCert := types.NewMsgRejectAddX509RootCert(
+			constants.RootSubject, constants.RootSubjectKeyID, testRejectionReason, address)
+		result = setup.Handler(setup.Ctx, rejectAddX509RootCert)
+		require.Equal(t, sdk.CodeOK, result.Code)
+	}
+
+	// query proposed certificate
+	_, err := queryProposedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, types.CodeProposedCertificateDoesNotExist, err.Code())
+
+	// query approved certificate
+	_, err = querySingleApprovedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, types.CodeCertificateDoesNotExist, err.Code())
+
+	// query rejected certificate
+	rejectedCertificate, _ := queryRejectedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, proposeAddX509RootCert.Cert, rejectedCertificate.PemCert)
+	require.Equal(t, constants.Address1, rejectedCertificate.Owner)
+	require.Equal(t, []types.Rejection{
+		types.NewRejection(setup.Trustee, testRejectionReason),
+		types.NewRejection(constants.Address3, testRejectionReason),
+	}, rejectedCertificate.Rejections)
+
+	// check that unique certificate key is released
+	require.False(t, setup.PkiKeeper.IsUniqueCertificateKeyPresent(setup.Ctx,
+		constants.RootIssuer, constants.RootSerialNumber))
+
+	// check that the certificate can be proposed again
+	result = setup.Handler(setup.Ctx, proposeAddX509RootCert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+}
+
+func TestHandler_ProposeAddX509RootCert_ProposalExpires(t *testing.T) {
+	setup := Setup()
+
+	params := types.DefaultParams()
+	params.RootCertificateProposalExpirationPeriod = 10
+	setup.PkiKeeper.SetParams(setup.Ctx, params)
+
+	// store account
+	account := auth.NewAccount(constants.Address1, constants.PubKey1, auth.AccountRoles{})
+	setup.AuthKeeper.SetAccount(setup.Ctx, account)
+
+	// propose add x509 root certificate at height 5
+	proposeAddX509RootCert := types.NewMsgProposeAddX509RootCert(constants.RootCertPem, constants.Address1)
+	result := setup.Handler(setup.Ctx.WithBlockHeight(5), proposeAddX509RootCert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	proposedCertificate, _ := queryProposedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, int64(5), proposedCertificate.ProposedAt)
+
+	// the certificate is still proposed at the end of the expiration period
+	setup.PkiKeeper.EndBlocker(setup.Ctx.WithBlockHeight(15))
+	require.True(t, setup.PkiKeeper.IsProposedCertificatePresent(setup.Ctx,
+		constants.RootSubject, constants.RootSubjectKeyID))
+
+	// the proposed certificate is deleted after the expiration period
+	setup.PkiKeeper.EndBlocker(setup.Ctx.WithBlockHeight(16))
+
+	_, err := queryProposedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, types.CodeProposedCertificateDoesNotExist, err.Code())
+
+	// check that unique certificate key is released
+	require.False(t, setup.PkiKeeper.IsUniqueCertificateKeyPresent(setup.Ctx,
+		constants.RootIssuer, constants.RootSerialNumber))
+
+	// check that the certificate can be proposed again
+	result = setup.Handler(setup.Ctx.WithBlockHeight(16), proposeAddX509RootCert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+}
+
+func TestHandler_RejectAddX509RootCert_ForUnknownProposedCertificate(t *testing.T) {
+	setup := Setup()
+
+	// reject
+	rejectAddX509RootCert := types.NewMsgRejectAddX509RootCert(
+		constants.RootSubject, constants.RootSubjectKeyID, testRejectionReason, setup.Trustee)
+	result := setup.Handler(setup.Ctx, rejectAddX509RootCert)
+	require.Equal(t, types.CodeProposedCertificateDoesNotExist, result.Code)
+}
+
+func TestHandler_RejectAddX509RootCert_ByNotTrustee(t *testing.T) {
+	setup := Setup()
+
+	// propose add x509 root certificate
+	proposeAddX509RootCert := types.NewMsgProposeAddX509RootCert(constants.RootCertPem, setup.Trustee)
+	result := setup.Handler(setup.Ctx, proposeAddX509RootCert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	for _, role := range []auth.AccountRole{auth.TestHouse, auth.ZBCertificationCenter, auth.Vendor} {
+		// assign role
+		account := auth.NewAccount(constants.Address1, constants.PubKey1, auth.AccountRoles{role})
+		setup.AuthKeeper.SetAccount(setup.Ctx, account)
+
+		// reject
+		rejectAddX509RootCert := types.NewMsgRejectAddX509RootCert(
+			constants.RootSubject, constants.RootSubjectKeyID, testRejectionReason, constants.Address1)
+		result = setup.Handler(setup.Ctx, rejectAddX509RootCert)
+		require.Equal(t, auth.CodeMissingRole, result.Code)
+	}
+}
+
+func TestHandler_RejectAddX509RootCert_ByApprover(t *testing.T) {
+	setup := Setup()
+
+	// propose add x509 root certificate by trustee (approval is added automatically)
+	proposeAddX509RootCert := types.NewMsgProposeAddX509RootCert(constants.RootCertPem, setup.Trustee)
+	result := setup.Handler(setup.Ctx, proposeAddX509RootCert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// reject by the same trustee
+	rejectAddX509RootCert := types.NewMsgRejectAddX509RootCert(
+		constants.RootSubject, constants.RootSubjectKeyID, testRejectionReason, setup.Trustee)
+	result = setup.Handler(setup.Ctx, rejectAddX509RootCert)
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+}
+
+func TestHandler_QueryParams(t *testing.T) {
+	setup := Setup()
+
+	// query default params
+	params := queryParams(&setup)
+	require.Equal(t, types.DefaultParams(), params)
+
+	// set and query params
+	setup.PkiKeeper.SetParams(setup.Ctx, types.NewParams(5))
+
+	params = queryParams(&setup)
+	require.Equal(t, 5, params.RootCertificateApprovals)
+}
+
 func TestHandler_AddX509Cert(t *testing.T) {
 	setup := Setup()
 
@@ -370,6 +676,81 @@ func TestHandler_AddX509Cert(t *testing.T) {
 	}
 }
 
+func TestHandler_AddX509Cert_ForDERCertificate(t *testing.T) {
+	setup := Setup()
+
+	// store root certificate
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, rootCertificate(setup.Trustee))
+
+	// add base64 encoded DER intermediate certificate
+	intermediate, _ := DecodeX509Certificate(constants.IntermediateCertPem)
+	der := base64.StdEncoding.EncodeToString(intermediate.Certificate.Raw)
+	result := setup.Handler(setup.Ctx, types.NewMsgAddX509Cert(der, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// check that the certificate is stored PEM encoded
+	certificate, _ := querySingleApprovedCertificate(&setup,
+		constants.IntermediateSubject, constants.IntermediateSubjectKeyID)
+	require.Equal(t, constants.IntermediateSerialNumber, certificate.SerialNumber)
+
+	storedCertificate, err := DecodeX509Certificate(certificate.PemCert)
+	require.Nil(t, err)
+	require.Equal(t, intermediate.Certificate.Raw, storedCertificate.Certificate.Raw)
+}
+
+func TestHandler_AddX509Cert_ForBundle(t *testing.T) {
+	setup := Setup()
+
+	// add root x509 certificate
+	proposeAndApproveRootCertificate(t, &setup, setup.Trustee)
+
+	// add bundle of leaf, intermediate and (already approved) root certificates in the order of export
+	bundle := constants.LeafCertPem + "\n" + constants.IntermediateCertPem + "\n" + constants.RootCertPem
+	result := setup.Handler(setup.Ctx, types.NewMsgAddX509Cert(bundle, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// check that intermediate and leaf certificates have been added with correct parent links
+	allCertificates, _ := queryAllApprovedCertificates(&setup)
+	require.Equal(t, 3, len(allCertificates.Items))
+
+	leafCertificate, _ := querySingleApprovedCertificate(&setup, constants.LeafSubject, constants.LeafSubjectKeyID)
+	require.Equal(t, constants.IntermediateSubject, leafCertificate.Issuer)
+	require.Equal(t, constants.RootSubject, leafCertificate.RootSubject)
+
+	intermediateChildren := setup.PkiKeeper.GetChildCertificates(setup.Ctx,
+		constants.IntermediateSubject, constants.IntermediateSubjectKeyID)
+	require.Equal(t, 1, len(intermediateChildren.CertIdentifiers))
+	require.Equal(t, types.NewCertificateIdentifier(constants.LeafSubject, constants.LeafSubjectKeyID),
+		intermediateChildren.CertIdentifiers[0])
+
+	rootChildren := setup.PkiKeeper.GetChildCertificates(setup.Ctx, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, 1, len(rootChildren.CertIdentifiers))
+}
+
+func TestHandler_AddX509Cert_ForBundleWithInvalidCertificate(t *testing.T) {
+	setup := Setup()
+
+	// add root x509 certificate
+	proposeAndApproveRootCertificate(t, &setup, setup.Trustee)
+
+	// add bundle which certificates are already present
+	result := setup.Handler(setup.Ctx, types.NewMsgAddX509Cert(constants.IntermediateCertPem, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, types.NewMsgAddX509Cert(constants.LeafCertPem, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	bundle := constants.LeafCertPem + "\n" + constants.IntermediateCertPem
+	result = setup.Handler(setup.Ctx, types.NewMsgAddX509Cert(bundle, setup.Trustee))
+	require.Equal(t, types.CodeCertificateAlreadyExists, result.Code)
+
+	// a bundle with a not approved root certificate is rejected
+	bundle = constants.RootCertPem + "\n" + constants.LeafCertPem
+	setup.PkiKeeper.DeleteApprovedCertificates(setup.Ctx, constants.RootSubject, constants.RootSubjectKeyID)
+	result = setup.Handler(setup.Ctx, types.NewMsgAddX509Cert(bundle, setup.Trustee))
+	require.Equal(t, types.CodeInappropriateCertificateType, result.Code)
+}
+
 func TestHandler_AddX509Cert_ForInvalidCertificate(t *testing.T) {
 	setup := Setup()
 
@@ -495,8 +876,93 @@ func TestHandler_AddX509Cert_ForFailedCertificateVerification(t *testing.T) {
 	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, invalidRootCertificate)
 
 	// add intermediate x509 certificate
-	addX509Cert := types.NewMsgAddX509Cert(constants.IntermediateCertPem, setup.Trustee)
+	addX509Cert := types.NewMsgAddX509Cert(constants.IntermediateCertPem, setup.Trustee)
+	result := setup.Handler(setup.Ctx, addX509Cert)
+	require.Equal(t, types.CodeInvalidCertificate, result.Code)
+}
+
+func TestHandler_AddX509Cert_ForNotApprovedRootCert(t *testing.T) {
+	setup := Setup()
+
+	// propose x509 root certificate
+	proposeAddX509RootCert := types.NewMsgProposeAddX509RootCert(constants.RootCertPem, setup.Trustee)
+	result := setup.Handler(setup.Ctx, proposeAddX509RootCert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// add intermediate x509 certificate
+	addX509Cert := types.NewMsgAddX509Cert(constants.IntermediateCertPem, setup.Trustee)
+	result = setup.Handler(setup.Ctx, addX509Cert)
+	require.Equal(t, types.CodeInvalidCertificate, result.Code)
+}
+
+func TestHandler_AddX509Cert_ForExpiredCertificate(t *testing.T) {
+	setup := Setup()
+
+	// store root certificate
+	rootCertificate := rootCertificate(setup.Trustee)
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, rootCertificate)
+
+	// add intermediate x509 certificate after its validity period
+	ctx := setup.Ctx.WithBlockTime(time.Date(4800, 1, 1, 0, 0, 0, 0, time.UTC))
+	addX509Cert := types.NewMsgAddX509Cert(constants.IntermediateCertPem, setup.Trustee)
+	result := setup.Handler(ctx, addX509Cert)
+	require.Equal(t, types.CodeInvalidCertificate, result.Code)
+
+	// check that intermediate certificate has not been added
+	_, err := querySingleApprovedCertificate(&setup, constants.IntermediateSubject, constants.IntermediateSubjectKeyID)
+	require.Equal(t, types.CodeCertificateDoesNotExist, err.Code())
+}
+
+func TestHandler_AddX509Cert_ForNonCAParentCert(t *testing.T) {
+	setup := Setup()
+
+	// store NOC root certificate (attestation ones do not allow leaf certificates issued by root)
+	rootCertificate := types.NewRootCertificate(constants.PathLenRootCertPem,
+		constants.PathLenRootSubject, constants.PathLenRootSubjectKeyID, constants.PathLenRootSerialNumber, setup.Trustee)
+	rootCertificate.Class = types.NocCertificateClass
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, rootCertificate)
+
+	// add non-CA x509 certificate issued by root
+	addX509Cert := types.NewMsgAddX509Cert(constants.NonCACertPem, setup.Trustee)
+	result := setup.Handler(setup.Ctx, addX509Cert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// add x509 certificate issued by non-CA certificate
+	addX509Cert = types.NewMsgAddX509Cert(constants.NonCALeafCertPem, setup.Trustee)
+	result = setup.Handler(setup.Ctx, addX509Cert)
+	require.Equal(t, types.CodeInvalidCertificate, result.Code)
+}
+
+func TestHandler_AddX509Cert_ForAttestationLeafCertIssuedByRoot(t *testing.T) {
+	setup := Setup()
+
+	// store attestation root certificate (PAA)
+	rootCertificate := types.NewRootCertificate(constants.PathLenRootCertPem,
+		constants.PathLenRootSubject, constants.PathLenRootSubjectKeyID, constants.PathLenRootSerialNumber, setup.Trustee)
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, rootCertificate)
+
+	// add non-CA x509 certificate issued by PAA
+	addX509Cert := types.NewMsgAddX509Cert(constants.NonCACertPem, setup.Trustee)
+	result := setup.Handler(setup.Ctx, addX509Cert)
+	require.Equal(t, types.CodeInappropriateCertificateType, result.Code)
+}
+
+func TestHandler_AddX509Cert_ForExceededPathLength(t *testing.T) {
+	setup := Setup()
+
+	// store root certificate allowing no intermediate certificates
+	rootCertificate := types.NewRootCertificate(constants.PathLenRootCertPem,
+		constants.PathLenRootSubject, constants.PathLenRootSubjectKeyID, constants.PathLenRootSerialNumber, setup.Trustee)
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, rootCertificate)
+
+	// add intermediate x509 certificate
+	addX509Cert := types.NewMsgAddX509Cert(constants.PathLenIntermediateCertPem, setup.Trustee)
 	result := setup.Handler(setup.Ctx, addX509Cert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// add leaf x509 certificate
+	addX509Cert = types.NewMsgAddX509Cert(constants.PathLenLeafCertPem, setup.Trustee)
+	result = setup.Handler(setup.Ctx, addX509Cert)
 	require.Equal(t, types.CodeInvalidCertificate, result.Code)
 }
 
@@ -714,7 +1180,7 @@ func TestHandler_ProposeRevokeX509RootCert_ByNotTrustee(t *testing.T) {
 		proposeRevokeX509RootCert := types.NewMsgProposeRevokeX509RootCert(
 			constants.RootSubject, constants.RootSubjectKeyID, constants.Address1)
 		result := setup.Handler(setup.Ctx, proposeRevokeX509RootCert)
-		require.Equal(t, sdk.CodeUnauthorized, result.Code)
+		require.Equal(t, auth.CodeMissingRole, result.Code)
 	}
 }
 
@@ -796,13 +1262,7 @@ func TestHandler_ApproveRevokeX509RootCert_ForNotEnoughApprovals(t *testing.T) {
 	proposeAndApproveRootCertificate(t, &setup, setup.Trustee)
 
 	// increase the number of approvals required for root certificates control to three
-	oldRootCertificateApprovals := types.RootCertificateApprovals
-
-	defer func() {
-		types.RootCertificateApprovals = oldRootCertificateApprovals
-	}()
-
-	types.RootCertificateApprovals = 3
+	setup.PkiKeeper.SetParams(setup.Ctx, types.NewParams(3))
 
 	// propose revocation of x509 root certificate
 	proposeRevokeX509RootCert := types.NewMsgProposeRevokeX509RootCert(
@@ -820,6 +1280,9 @@ func TestHandler_ApproveRevokeX509RootCert_ForNotEnoughApprovals(t *testing.T) {
 	result = setup.Handler(setup.Ctx, approveRevokeX509RootCert)
 	require.Equal(t, sdk.CodeOK, result.Code)
 
+	// check that no event about the revoked certificate is emitted
+	require.Empty(t, result.Events)
+
 	// query and check proposed certificate revocation
 	proposedRevocation, _ := queryProposedCertificateRevocation(&setup, constants.RootSubject, constants.RootSubjectKeyID)
 	require.Equal(t, constants.RootSubject, proposedRevocation.Subject)
@@ -861,11 +1324,15 @@ func TestHandler_ApproveRevokeX509RootCert_ForEnoughApprovals(t *testing.T) {
 	setup.AuthKeeper.SetAccount(setup.Ctx, account)
 
 	// approve
+	revokedAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
 	approveRevokeX509RootCert := types.NewMsgApproveRevokeX509RootCert(
 		constants.RootSubject, constants.RootSubjectKeyID, constants.Address1)
-	result = setup.Handler(setup.Ctx, approveRevokeX509RootCert)
+	result = setup.Handler(setup.Ctx.WithBlockTime(revokedAt), approveRevokeX509RootCert)
 	require.Equal(t, sdk.CodeOK, result.Code)
 
+	// check the event about the revoked certificate
+	checkRootCertificateEvent(t, result, types.EventTypeRevokeX509RootCert)
+
 	// check that proposed certificate revocation does not exist anymore
 	_, err := queryProposedCertificateRevocation(&setup, constants.RootSubject, constants.RootSubjectKeyID)
 	require.Equal(t, types.CodeProposedCertificateRevocationDoesNotExist, err.Code())
@@ -876,6 +1343,10 @@ func TestHandler_ApproveRevokeX509RootCert_ForEnoughApprovals(t *testing.T) {
 
 	// query and check revoked certificate
 	revokedCertificate, _ := querySingleRevokedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	certificateBeforeRevocation.RevokedBy = &types.CertificateIdentifier{
+		Subject: constants.RootSubject, SubjectKeyID: constants.RootSubjectKeyID,
+	}
+	certificateBeforeRevocation.RevokedAt = &revokedAt
 	require.Equal(t, certificateBeforeRevocation, revokedCertificate)
 
 	// check that unique certificate key stays registered
@@ -904,7 +1375,7 @@ func TestHandler_ApproveRevokeX509RootCert_ByNotTrustee(t *testing.T) {
 		approveRevokeX509RootCert := types.NewMsgApproveRevokeX509RootCert(
 			constants.RootSubject, constants.RootSubjectKeyID, constants.Address1)
 		result = setup.Handler(setup.Ctx, approveRevokeX509RootCert)
-		require.Equal(t, sdk.CodeUnauthorized, result.Code)
+		require.Equal(t, auth.CodeMissingRole, result.Code)
 	}
 }
 
@@ -986,6 +1457,11 @@ func TestHandler_ApproveRevokeX509RootCert_ForTree(t *testing.T) {
 	require.Equal(t, constants.RootSubjectKeyID, allRevokedCertificates.Items[2].SubjectKeyID)
 	require.Equal(t, constants.RootCertPem, allRevokedCertificates.Items[2].PemCert)
 
+	// check that all the certificates are marked as revoked by root certificate
+	revokedByRootCertificates, _ := queryAllCertificatesRevokedBy(&setup,
+		constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, allRevokedCertificates, revokedByRootCertificates)
+
 	// check that no certificates stays approved
 	allApprovedCertificates, _ := queryAllApprovedCertificates(&setup)
 	require.Equal(t, 0, len(allApprovedCertificates.Items))
@@ -1033,9 +1509,10 @@ func TestHandler_RevokeX509Cert(t *testing.T) {
 		require.NotNil(t, certificateBeforeRevocation)
 
 		// revoke x509 certificate
+		revokedAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
 		revokeX509Cert := types.NewMsgRevokeX509Cert(
 			constants.IntermediateSubject, constants.IntermediateSubjectKeyID, constants.Address1)
-		result = setup.Handler(setup.Ctx, revokeX509Cert)
+		result = setup.Handler(setup.Ctx.WithBlockTime(revokedAt), revokeX509Cert)
 		require.Equal(t, sdk.CodeOK, result.Code)
 
 		// check that intermediate certificate has been revoked
@@ -1043,6 +1520,10 @@ func TestHandler_RevokeX509Cert(t *testing.T) {
 		require.Equal(t, 1, len(allRevokedCertificates.Items))
 		require.Equal(t, constants.IntermediateSubject, allRevokedCertificates.Items[0].Subject)
 		require.Equal(t, constants.IntermediateSubjectKeyID, allRevokedCertificates.Items[0].SubjectKeyID)
+		certificateBeforeRevocation.RevokedBy = &types.CertificateIdentifier{
+			Subject: constants.IntermediateSubject, SubjectKeyID: constants.IntermediateSubjectKeyID,
+		}
+		certificateBeforeRevocation.RevokedAt = &revokedAt
 		require.Equal(t, certificateBeforeRevocation, &allRevokedCertificates.Items[0])
 
 		// check that root certificate stays approved
@@ -1117,6 +1598,44 @@ func TestHandler_RevokeX509Cert_ByNotOwner(t *testing.T) {
 	require.Equal(t, sdk.CodeUnauthorized, result.Code)
 }
 
+func TestHandler_RevokeX509Cert_WithOutdatedSequence(t *testing.T) {
+	setup := Setup()
+
+	// store root certificate
+	rootCertificate := rootCertificate(setup.Trustee)
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, rootCertificate)
+
+	// store intermediate certificate with different serial number
+	intermediateCertificate := intermediateCertificate(setup.Trustee)
+	intermediateCertificate.SerialNumber = SerialNumber
+	setup.PkiKeeper.SetUniqueCertificateKey(setup.Ctx,
+		intermediateCertificate.Issuer, intermediateCertificate.SerialNumber)
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, intermediateCertificate)
+
+	// store intermediate certificate second time under the same subject/subject key id
+	addX509Cert := types.NewMsgAddX509Cert(constants.IntermediateCertPem, setup.Trustee)
+	result := setup.Handler(setup.Ctx, addX509Cert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	certificates, _ := queryApprovedCertificates(&setup,
+		constants.IntermediateSubject, constants.IntermediateSubjectKeyID)
+	require.Equal(t, uint64(1), certificates.Sequence)
+
+	// revoke x509 certificate based on the sequence read before the second certificate was added
+	revokeX509Cert := types.NewMsgRevokeX509Cert(
+		constants.IntermediateSubject, constants.IntermediateSubjectKeyID, setup.Trustee)
+	result = setup.Handler(setup.Ctx, revokeX509Cert)
+	require.Equal(t, types.CodeSequenceConflict, result.Code)
+
+	// revoke x509 certificate based on the actual sequence
+	revokeX509Cert.ExpectedSequence = certificates.Sequence
+	result = setup.Handler(setup.Ctx, revokeX509Cert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	require.False(t, setup.PkiKeeper.IsApprovedCertificatesPresent(setup.Ctx,
+		constants.IntermediateSubject, constants.IntermediateSubjectKeyID))
+}
+
 func TestHandler_RevokeX509Cert_ForTree(t *testing.T) {
 	setup := Setup()
 
@@ -1175,6 +1694,305 @@ func TestHandler_RevokeX509Cert_ForTree(t *testing.T) {
 	require.Equal(t, 0, len(leafCertChildren.CertIdentifiers))
 }
 
+func TestHandler_RevokeX509Cert_QueryCertsRevokedBy(t *testing.T) {
+	setup := Setup()
+
+	// add root, intermediate and leaf x509 certificates
+	proposeAndApproveRootCertificate(t, &setup, setup.Trustee)
+
+	for _, pemCert := range []string{constants.IntermediateCertPem, constants.LeafCertPem} {
+		addX509Cert := types.NewMsgAddX509Cert(pemCert, setup.Trustee)
+		result := setup.Handler(setup.Ctx, addX509Cert)
+		require.Equal(t, sdk.CodeOK, result.Code)
+	}
+
+	// query certificates revoked by not revoked certificate
+	_, err := queryAllCertificatesRevokedBy(&setup, constants.IntermediateSubject, constants.IntermediateSubjectKeyID)
+	require.Equal(t, types.CodeRevokedCertificateDoesNotExist, err.Code())
+
+	// revoke intermediate x509 certificate
+	revokeX509Cert := types.NewMsgRevokeX509Cert(
+		constants.IntermediateSubject, constants.IntermediateSubjectKeyID, setup.Trustee)
+	result := setup.Handler(setup.Ctx, revokeX509Cert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// check that both intermediate and leaf certificates are marked as revoked by intermediate certificate
+	expectedRevokedBy := types.NewCertificateIdentifier(constants.IntermediateSubject, constants.IntermediateSubjectKeyID)
+
+	revokedCertificates, _ := queryAllCertificatesRevokedBy(&setup,
+		constants.IntermediateSubject, constants.IntermediateSubjectKeyID)
+	require.Equal(t, 2, revokedCertificates.Total)
+	require.Equal(t, constants.IntermediateSubject, revokedCertificates.Items[0].Subject)
+	require.Equal(t, constants.IntermediateSubjectKeyID, revokedCertificates.Items[0].SubjectKeyID)
+	require.Equal(t, expectedRevokedBy, *revokedCertificates.Items[0].RevokedBy)
+	require.Equal(t, constants.LeafSubject, revokedCertificates.Items[1].Subject)
+	require.Equal(t, constants.LeafSubjectKeyID, revokedCertificates.Items[1].SubjectKeyID)
+	require.Equal(t, expectedRevokedBy, *revokedCertificates.Items[1].RevokedBy)
+
+	// check that leaf certificate revocation did not invalidate any other certificates
+	revokedCertificates, _ = queryAllCertificatesRevokedBy(&setup, constants.LeafSubject, constants.LeafSubjectKeyID)
+	require.Equal(t, 0, revokedCertificates.Total)
+}
+
+func TestHandler_AddCrlDistributionPoint_ForRootCert(t *testing.T) {
+	setup := Setup()
+
+	// add root x509 certificate
+	proposeAndApproveRootCertificate(t, &setup, setup.Trustee)
+
+	// add CRL distribution point
+	addCrlDistributionPoint := types.NewMsgAddCrlDistributionPoint(
+		constants.RootSubject, constants.RootSubjectKeyID, testCrlURL, setup.Trustee)
+	result := setup.Handler(setup.Ctx, addCrlDistributionPoint)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query CRL distribution points
+	crlDistributionPoints, _ := queryCrlDistributionPoints(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, constants.RootSubject, crlDistributionPoints.Subject)
+	require.Equal(t, constants.RootSubjectKeyID, crlDistributionPoints.SubjectKeyID)
+	require.Equal(t, constants.RootSubject, crlDistributionPoints.RootSubject)
+	require.Equal(t, constants.RootSubjectKeyID, crlDistributionPoints.RootSubjectKeyID)
+	require.Equal(t, []string{testCrlURL}, crlDistributionPoints.URLs)
+
+	// add one more CRL distribution point
+	addCrlDistributionPoint = types.NewMsgAddCrlDistributionPoint(
+		constants.RootSubject, constants.RootSubjectKeyID, testCrlURL2, setup.Trustee)
+	result = setup.Handler(setup.Ctx, addCrlDistributionPoint)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	crlDistributionPoints, _ = queryCrlDistributionPoints(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, []string{testCrlURL, testCrlURL2}, crlDistributionPoints.URLs)
+}
+
+func TestHandler_AddCrlDistributionPoint_ForIntermediateCert(t *testing.T) {
+	setup := Setup()
+
+	// add root and intermediate x509 certificates
+	proposeAndApproveRootCertificate(t, &setup, setup.Trustee)
+
+	addX509Cert := types.NewMsgAddX509Cert(constants.IntermediateCertPem, setup.Trustee)
+	result := setup.Handler(setup.Ctx, addX509Cert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// add CRL distribution point
+	addCrlDistributionPoint := types.NewMsgAddCrlDistributionPoint(
+		constants.IntermediateSubject, constants.IntermediateSubjectKeyID, testCrlURL, setup.Trustee)
+	result = setup.Handler(setup.Ctx, addCrlDistributionPoint)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// query CRL distribution points
+	crlDistributionPoints, _ := queryCrlDistributionPoints(&setup,
+		constants.IntermediateSubject, constants.IntermediateSubjectKeyID)
+	require.Equal(t, constants.IntermediateSubject, crlDistributionPoints.Subject)
+	require.Equal(t, constants.IntermediateSubjectKeyID, crlDistributionPoints.SubjectKeyID)
+	require.Equal(t, constants.RootSubject, crlDistributionPoints.RootSubject)
+	require.Equal(t, constants.RootSubjectKeyID, crlDistributionPoints.RootSubjectKeyID)
+	require.Equal(t, []string{testCrlURL}, crlDistributionPoints.URLs)
+
+	// query all CRL distribution points filtered by root certificate
+	allCrlDistributionPoints, _ := queryAllCrlDistributionPoints(&setup,
+		constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, 1, allCrlDistributionPoints.Total)
+	require.Equal(t, crlDistributionPoints, &allCrlDistributionPoints.Items[0])
+
+	// query all CRL distribution points filtered by another root certificate
+	allCrlDistributionPoints, _ = queryAllCrlDistributionPoints(&setup,
+		constants.PathLenRootSubject, constants.PathLenRootSubjectKeyID)
+	require.Equal(t, 0, allCrlDistributionPoints.Total)
+}
+
+func TestHandler_AddCrlDistributionPoint_ForLeafCert(t *testing.T) {
+	setup := Setup()
+
+	// add root, intermediate and leaf x509 certificates
+	proposeAndApproveRootCertificate(t, &setup, setup.Trustee)
+
+	for _, pemCert := range []string{constants.IntermediateCertPem, constants.LeafCertPem} {
+		addX509Cert := types.NewMsgAddX509Cert(pemCert, setup.Trustee)
+		result := setup.Handler(setup.Ctx, addX509Cert)
+		require.Equal(t, sdk.CodeOK, result.Code)
+	}
+
+	// add CRL distribution point for leaf certificate
+	addCrlDistributionPoint := types.NewMsgAddCrlDistributionPoint(
+		constants.LeafSubject, constants.LeafSubjectKeyID, testCrlURL, setup.Trustee)
+	result := setup.Handler(setup.Ctx, addCrlDistributionPoint)
+	require.Equal(t, types.CodeInappropriateCertificateType, result.Code)
+}
+
+func TestHandler_AddCrlDistributionPoint_ByNotOwner(t *testing.T) {
+	setup := Setup()
+
+	// add root x509 certificate
+	proposeAndApproveRootCertificate(t, &setup, setup.Trustee)
+
+	// add CRL distribution point by another trustee
+	addCrlDistributionPoint := types.NewMsgAddCrlDistributionPoint(
+		constants.RootSubject, constants.RootSubjectKeyID, testCrlURL, constants.Address3)
+	result := setup.Handler(setup.Ctx, addCrlDistributionPoint)
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+}
+
+func TestHandler_AddCrlDistributionPoint_CertificateDoesNotExist(t *testing.T) {
+	setup := Setup()
+
+	addCrlDistributionPoint := types.NewMsgAddCrlDistributionPoint(
+		constants.RootSubject, constants.RootSubjectKeyID, testCrlURL, setup.Trustee)
+	result := setup.Handler(setup.Ctx, addCrlDistributionPoint)
+	require.Equal(t, types.CodeCertificateDoesNotExist, result.Code)
+}
+
+func TestHandler_AddCrlDistributionPoint_Twice(t *testing.T) {
+	setup := Setup()
+
+	// add root x509 certificate
+	proposeAndApproveRootCertificate(t, &setup, setup.Trustee)
+
+	// add CRL distribution point
+	addCrlDistributionPoint := types.NewMsgAddCrlDistributionPoint(
+		constants.RootSubject, constants.RootSubjectKeyID, testCrlURL, setup.Trustee)
+	result := setup.Handler(setup.Ctx, addCrlDistributionPoint)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// add the same CRL distribution point second time
+	result = setup.Handler(setup.Ctx, addCrlDistributionPoint)
+	require.Equal(t, types.CodeCrlDistributionPointAlreadyExists, result.Code)
+}
+
+func TestHandler_RemoveCrlDistributionPoint(t *testing.T) {
+	setup := Setup()
+
+	// add root x509 certificate
+	proposeAndApproveRootCertificate(t, &setup, setup.Trustee)
+
+	// add two CRL distribution points
+	for _, url := range []string{testCrlURL, testCrlURL2} {
+		addCrlDistributionPoint := types.NewMsgAddCrlDistributionPoint(
+			constants.RootSubject, constants.RootSubjectKeyID, url, setup.Trustee)
+		result := setup.Handler(setup.Ctx, addCrlDistributionPoint)
+		require.Equal(t, sdk.CodeOK, result.Code)
+	}
+
+	// remove first CRL distribution point
+	removeCrlDistributionPoint := types.NewMsgRemoveCrlDistributionPoint(
+		constants.RootSubject, constants.RootSubjectKeyID, testCrlURL, setup.Trustee)
+	result := setup.Handler(setup.Ctx, removeCrlDistributionPoint)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	crlDistributionPoints, _ := queryCrlDistributionPoints(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, []string{testCrlURL2}, crlDistributionPoints.URLs)
+
+	// remove the same CRL distribution point second time
+	result = setup.Handler(setup.Ctx, removeCrlDistributionPoint)
+	require.Equal(t, types.CodeCrlDistributionPointDoesNotExist, result.Code)
+
+	// remove second CRL distribution point
+	removeCrlDistributionPoint = types.NewMsgRemoveCrlDistributionPoint(
+		constants.RootSubject, constants.RootSubjectKeyID, testCrlURL2, setup.Trustee)
+	result = setup.Handler(setup.Ctx, removeCrlDistributionPoint)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// check that the record has been removed
+	_, err := queryCrlDistributionPoints(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.Equal(t, types.CodeCrlDistributionPointDoesNotExist, err.Code())
+}
+
+func TestHandler_RemoveCrlDistributionPoint_ByNotOwner(t *testing.T) {
+	setup := Setup()
+
+	// add root x509 certificate
+	proposeAndApproveRootCertificate(t, &setup, setup.Trustee)
+
+	// add CRL distribution point
+	addCrlDistributionPoint := types.NewMsgAddCrlDistributionPoint(
+		constants.RootSubject, constants.RootSubjectKeyID, testCrlURL, setup.Trustee)
+	result := setup.Handler(setup.Ctx, addCrlDistributionPoint)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// remove CRL distribution point by another trustee
+	removeCrlDistributionPoint := types.NewMsgRemoveCrlDistributionPoint(
+		constants.RootSubject, constants.RootSubjectKeyID, testCrlURL, constants.Address3)
+	result = setup.Handler(setup.Ctx, removeCrlDistributionPoint)
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+}
+
+func TestHandler_RevokeX509Cert_RemovesCrlDistributionPoints(t *testing.T) {
+	setup := Setup()
+
+	// add root and intermediate x509 certificates
+	proposeAndApproveRootCertificate(t, &setup, setup.Trustee)
+
+	addX509Cert := types.NewMsgAddX509Cert(constants.IntermediateCertPem, setup.Trustee)
+	result := setup.Handler(setup.Ctx, addX509Cert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// add CRL distribution point for intermediate certificate
+	addCrlDistributionPoint := types.NewMsgAddCrlDistributionPoint(
+		constants.IntermediateSubject, constants.IntermediateSubjectKeyID, testCrlURL, setup.Trustee)
+	result = setup.Handler(setup.Ctx, addCrlDistributionPoint)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// revoke intermediate certificate
+	revokeX509Cert := types.NewMsgRevokeX509Cert(
+		constants.IntermediateSubject, constants.IntermediateSubjectKeyID, setup.Trustee)
+	result = setup.Handler(setup.Ctx, revokeX509Cert)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// check that CRL distribution points have been removed
+	require.False(t, setup.PkiKeeper.IsCrlDistributionPointsPresent(setup.Ctx,
+		constants.IntermediateSubject, constants.IntermediateSubjectKeyID))
+}
+
+func TestHandler_ExpiredCertificates(t *testing.T) {
+	setup := Setup()
+
+	// add root, intermediate and leaf x509 certificates
+	proposeAndApproveRootCertificate(t, &setup, setup.Trustee)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgAddX509Cert(constants.IntermediateCertPem, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, types.NewMsgAddX509Cert(constants.LeafCertPem, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// check that the expiration time is taken from the certificates
+	leafCertificate, _ := querySingleApprovedCertificate(&setup, constants.LeafSubject, constants.LeafSubjectKeyID)
+	require.True(t, time.Date(4758, 8, 8, 9, 40, 38, 0, time.UTC).Equal(leafCertificate.NotAfter))
+
+	rootCert, _ := querySingleApprovedCertificate(&setup, constants.RootSubject, constants.RootSubjectKeyID)
+	require.True(t, time.Date(4758, 8, 8, 9, 40, 38, 0, time.UTC).Equal(rootCert.NotAfter))
+
+	// make the leaf certificate expire earlier than the others
+	leafCertificate.NotAfter = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	setup.PkiKeeper.DeleteApprovedCertificates(setup.Ctx, constants.LeafSubject, constants.LeafSubjectKeyID)
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, *leafCertificate)
+
+	ctx := setup.Ctx.WithBlockTime(time.Date(2031, 1, 1, 0, 0, 0, 0, time.UTC))
+	NewAppModule(setup.PkiKeeper, setup.AuthKeeper).EndBlock(ctx, abci.RequestEndBlock{})
+
+	// check that the leaf certificate has been moved to the expired ones
+	_, err := querySingleApprovedCertificate(&setup, constants.LeafSubject, constants.LeafSubjectKeyID)
+	require.Equal(t, types.CodeCertificateDoesNotExist, err.Code())
+
+	expiredCertificates := setup.PkiKeeper.GetExpiredCertificates(
+		setup.Ctx, constants.LeafSubject, constants.LeafSubjectKeyID)
+	require.Equal(t, 1, len(expiredCertificates.Items))
+	require.Equal(t, constants.LeafCertPem, expiredCertificates.Items[0].PemCert)
+
+	// revoke intermediate x509 certificate
+	result = setup.Handler(ctx, types.NewMsgRevokeX509Cert(
+		constants.IntermediateSubject, constants.IntermediateSubjectKeyID, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// check that only intermediate certificate has been revoked, the expired leaf one stays expired
+	allRevokedCertificates, _ := queryAllRevokedCertificates(&setup)
+	require.Equal(t, 1, len(allRevokedCertificates.Items))
+	require.Equal(t, constants.IntermediateSubject, allRevokedCertificates.Items[0].Subject)
+
+	require.True(t, setup.PkiKeeper.IsExpiredCertificatesPresent(
+		setup.Ctx, constants.LeafSubject, constants.LeafSubjectKeyID))
+}
+
 func proposeAndApproveRootCertificate(t *testing.T, setup *TestSetup, ownerTrustee sdk.AccAddress) {
 	// ensure that `ownerTrustee` is trustee to eventually have enough approvals
 	require.True(t, setup.AuthKeeper.HasRole(setup.Ctx, ownerTrustee, types.RootCertificateApprovalRole))
@@ -1199,6 +2017,18 @@ func proposeAndApproveRootCertificate(t *testing.T, setup *TestSetup, ownerTrust
 	require.NotNil(t, approvedCertificate)
 }
 
+func checkRootCertificateEvent(t *testing.T, result sdk.Result, eventType string) {
+	require.Equal(t, 1, len(result.Events))
+	require.Equal(t, eventType, result.Events[0].Type)
+
+	attributes := result.Events[0].Attributes
+	require.Equal(t, 3, len(attributes))
+	require.Equal(t, sdk.AttributeKeyModule, string(attributes[0].Key))
+	require.Equal(t, types.ModuleName, string(attributes[0].Value))
+	require.Equal(t, constants.RootSubject, string(attributes[1].Value))
+	require.Equal(t, constants.RootSubjectKeyID, string(attributes[2].Value))
+}
+
 func queryProposedCertificate(setup *TestSetup, subject string,
 	subjectKeyID string) (*types.ProposedCertificate, sdk.Error) {
 	// query proposed certificate
@@ -1217,6 +2047,37 @@ func queryProposedCertificate(setup *TestSetup, subject string,
 	return &proposedCertificate, nil
 }
 
+func queryRejectedCertificate(setup *TestSetup, subject string,
+	subjectKeyID string) (*types.ProposedCertificate, sdk.Error) {
+	// query rejected certificate
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{keeper.QueryRejectedX509RootCert, subject, subjectKeyID},
+		abci.RequestQuery{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var rejectedCertificate types.ProposedCertificate
+	_ = setup.Cdc.UnmarshalJSON(result, &rejectedCertificate)
+
+	return &rejectedCertificate, nil
+}
+
+func queryParams(setup *TestSetup) types.Params {
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{keeper.QueryParams},
+		abci.RequestQuery{},
+	)
+
+	var params types.Params
+	_ = setup.Cdc.UnmarshalJSON(result, &params)
+
+	return params
+}
+
 func queryAllApprovedCertificates(setup *TestSetup) (*types.ListCertificates, sdk.Error) {
 	// query all certificates
 	result, err := setup.Querier(
@@ -1234,6 +2095,22 @@ func queryAllApprovedCertificates(setup *TestSetup) (*types.ListCertificates, sd
 	return &certificates, nil
 }
 
+func queryAllRootCertificatesOfClass(setup *TestSetup, class types.CertificateClass) *types.ListCertificates {
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{keeper.QueryAllX509RootCertsOfClass, string(class)},
+		abci.RequestQuery{Data: emptyParams(setup)},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	var certificates types.ListCertificates
+	_ = setup.Cdc.UnmarshalJSON(result, &certificates)
+
+	return &certificates
+}
+
 func querySingleApprovedCertificate(setup *TestSetup,
 	subject string, subjectKeyID string) (*types.Certificate, sdk.Error) {
 	certificates, err := queryApprovedCertificates(setup, subject, subjectKeyID)
@@ -1348,6 +2225,63 @@ func queryRevokedCertificates(setup *TestSetup, subject string, subjectKeyID str
 	return &certificates, nil
 }
 
+func queryAllCertificatesRevokedBy(setup *TestSetup,
+	subject string, subjectKeyID string) (*types.ListCertificates, sdk.Error) {
+	// query all certificates revoked by the given one
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{keeper.QueryAllX509CertsRevokedBy, subject, subjectKeyID},
+		abci.RequestQuery{Data: emptyParams(setup)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var certificates types.ListCertificates
+	_ = setup.Cdc.UnmarshalJSON(result, &certificates)
+
+	return &certificates, nil
+}
+
+func queryCrlDistributionPoints(setup *TestSetup,
+	subject string, subjectKeyID string) (*types.CrlDistributionPoints, sdk.Error) {
+	// query CRL distribution points
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{keeper.QueryCrlDistributionPoints, subject, subjectKeyID},
+		abci.RequestQuery{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var crlDistributionPoints types.CrlDistributionPoints
+	_ = setup.Cdc.UnmarshalJSON(result, &crlDistributionPoints)
+
+	return &crlDistributionPoints, nil
+}
+
+func queryAllCrlDistributionPoints(setup *TestSetup,
+	rootSubject string, rootSubjectKeyID string) (*types.ListCrlDistributionPoints, sdk.Error) {
+	paginationParams := pagination.NewPaginationParams(0, 0)
+	params := types.NewPkiQueryParams(paginationParams, rootSubject, rootSubjectKeyID)
+
+	// query all CRL distribution points
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{keeper.QueryAllCrlDistributionPoints},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var crlDistributionPoints types.ListCrlDistributionPoints
+	_ = setup.Cdc.UnmarshalJSON(result, &crlDistributionPoints)
+
+	return &crlDistributionPoints, nil
+}
+
 func rootCertificate(address sdk.AccAddress) types.Certificate {
 	return types.NewRootCertificate(
 		constants.RootCertPem,
@@ -0,0 +1,163 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/x509"
+)
+
+// The maximum number of certificates moved to the expired ones in a single block,
+// the rest of the expired certificates are processed in the following blocks.
+const MaxExpiredCertificatesPerBlock = 100
+
+// The maximum number of expired proposed root certificates deleted in a single block,
+// the rest of them are deleted in the following blocks.
+const MaxExpiredProposedCertificatesPerBlock = 100
+
+// EndBlocker moves the approved certificates which validity period is over to the expired ones
+// and deletes the proposed root certificates not approved within the expiration period.
+// Called in each EndBlock.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	var expirations []types.CertificateExpiration
+
+	// a certificate is valid up to its NotAfter time inclusive
+	k.IterateCertificateExpirations(ctx, ctx.BlockTime().Add(-time.Second),
+		func(expiration types.CertificateExpiration) (stop bool) {
+			expirations = append(expirations, expiration)
+
+			return len(expirations) >= MaxExpiredCertificatesPerBlock
+		})
+
+	for _, expiration := range expirations {
+		k.expireCertificate(ctx, expiration)
+	}
+
+	k.deleteExpiredProposedCertificates(ctx)
+}
+
+// Deletes the expired proposed root certificates and releases their unique certificate keys,
+// so the certificates can be proposed again.
+func (k Keeper) deleteExpiredProposedCertificates(ctx sdk.Context) {
+	expired := k.proposedCertificates.DeleteExpired(ctx, k.GetParams(ctx).RootCertificateApprovalPolicy(),
+		MaxExpiredProposedCertificatesPerBlock)
+
+	for _, proposal := range expired {
+		certificate := proposal.(*types.ProposedCertificate)
+
+		// the proposed certificates are decoded before they are stored
+		x509Certificate, err := x509.DecodeX509Certificate(certificate.PemCert)
+		if err != nil {
+			continue
+		}
+
+		if k.IsUniqueCertificateKeyPresent(ctx, x509Certificate.Issuer, x509Certificate.SerialNumber) {
+			k.DeleteUniqueCertificateKey(ctx, x509Certificate.Issuer, x509Certificate.SerialNumber)
+		}
+	}
+}
+
+// Sets the proposal height of the proposed root certificates stored before it was tracked to the current height,
+// so that they expire after the expiration period from now on.
+func (k Keeper) SetProposedCertificatesProposedAt(ctx sdk.Context) {
+	var proposed []types.ProposedCertificate
+
+	k.IterateProposedCertificates(ctx, func(certificate types.ProposedCertificate) (stop bool) {
+		if certificate.ProposedAt == 0 {
+			proposed = append(proposed, certificate)
+		}
+
+		return false
+	})
+
+	for _, certificate := range proposed {
+		certificate.ProposedAt = ctx.BlockHeight()
+		k.SetProposedCertificate(ctx, certificate)
+	}
+}
+
+// Moves the approved certificate referenced by the Expiration index entry to the Expired Certificates record.
+func (k Keeper) expireCertificate(ctx sdk.Context, expiration types.CertificateExpiration) {
+	certificates := k.GetApprovedCertificates(ctx, expiration.Subject, expiration.SubjectKeyID)
+
+	remaining := make([]types.Certificate, 0, len(certificates.Items))
+	expired := make([]types.Certificate, 0, 1)
+
+	for _, certificate := range certificates.Items {
+		if certificate.GetIssuer() == expiration.Issuer && certificate.SerialNumber == expiration.SerialNumber {
+			expired = append(expired, certificate)
+		} else {
+			remaining = append(remaining, certificate)
+		}
+	}
+
+	if len(expired) == 0 {
+		// stale index entry
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetCertificateExpirationKey(expiration.NotAfter, expiration.Issuer, expiration.SerialNumber))
+
+		return
+	}
+
+	// the approved certificates are deleted first, so that the Serial Number index references the expired ones
+	if len(remaining) == 0 {
+		k.DeleteApprovedCertificates(ctx, expiration.Subject, expiration.SubjectKeyID)
+		k.DeleteCrlDistributionPoints(ctx, expiration.Subject, expiration.SubjectKeyID)
+	} else {
+		for _, certificate := range expired {
+			k.DeleteSerialNumberCertificate(ctx, certificate.GetIssuer(), certificate.SerialNumber)
+			k.deleteCertificateExpiration(ctx, certificate)
+		}
+
+		certificates.Items = remaining
+		certificates.Sequence++
+		k.SetApprovedCertificates(ctx, expiration.Subject, expiration.SubjectKeyID, certificates)
+	}
+
+	k.AddExpiredCertificates(ctx, expiration.Subject, expiration.SubjectKeyID, types.NewCertificates(expired))
+}
+
+// Sets the expiration time of the approved certificates stored without it, decoding it from their PEM.
+func (k Keeper) SetCertificatesExpiration(ctx sdk.Context) error {
+	var records []types.Certificates
+
+	k.IterateApprovedCertificatesRecords(ctx, "", func(certificates types.Certificates) (stop bool) {
+		records = append(records, certificates)
+
+		return false
+	})
+
+	for _, certificates := range records {
+		for i, certificate := range certificates.Items {
+			if !certificate.NotAfter.IsZero() {
+				continue
+			}
+
+			x509Certificate, err := x509.DecodeX509Certificate(certificate.PemCert)
+			if err != nil {
+				return err
+			}
+
+			certificates.Items[i].NotAfter = x509Certificate.Certificate.NotAfter
+		}
+
+		k.SetApprovedCertificates(ctx, certificates.Items[0].Subject, certificates.Items[0].SubjectKeyID, certificates)
+	}
+
+	return nil
+}
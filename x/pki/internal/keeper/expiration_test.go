@@ -0,0 +1,195 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/x509"
+)
+
+func TestKeeper_EndBlocker_MovesExpiredCertificates(t *testing.T) {
+	setup := Setup()
+
+	notAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// two certificates with the same subject/subjectKeyID expiring at different time
+	first := DefaultNonRootCertificate()
+	first.NotAfter = notAfter
+
+	second := DefaultNonRootCertificate()
+	second.SerialNumber = "2"
+	second.NotAfter = notAfter.Add(24 * time.Hour)
+
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, first)
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, second)
+
+	// nothing is expired yet (a certificate is valid at its NotAfter time)
+	setup.PkiKeeper.EndBlocker(setup.Ctx.WithBlockTime(notAfter))
+
+	require.Equal(t, 2, len(setup.PkiKeeper.GetApprovedCertificates(
+		setup.Ctx, testconstants.LeafSubject, testconstants.LeafSubjectKeyID).Items))
+	require.False(t, setup.PkiKeeper.IsExpiredCertificatesPresent(
+		setup.Ctx, testconstants.LeafSubject, testconstants.LeafSubjectKeyID))
+
+	// the first certificate expires
+	setup.PkiKeeper.EndBlocker(setup.Ctx.WithBlockTime(notAfter.Add(time.Second)))
+
+	approvedCertificates := setup.PkiKeeper.GetApprovedCertificates(
+		setup.Ctx, testconstants.LeafSubject, testconstants.LeafSubjectKeyID)
+	require.Equal(t, 1, len(approvedCertificates.Items))
+	require.Equal(t, second.SerialNumber, approvedCertificates.Items[0].SerialNumber)
+	require.Equal(t, uint64(2), approvedCertificates.Sequence)
+
+	expiredCertificates := setup.PkiKeeper.GetExpiredCertificates(
+		setup.Ctx, testconstants.LeafSubject, testconstants.LeafSubjectKeyID)
+	require.Equal(t, 1, len(expiredCertificates.Items))
+	require.Equal(t, first.SerialNumber, expiredCertificates.Items[0].SerialNumber)
+
+	// the Serial Number index keeps referencing the expired certificate
+	require.True(t, setup.PkiKeeper.IsSerialNumberCertificatePresent(
+		setup.Ctx, testconstants.LeafIssuer, first.SerialNumber))
+	require.True(t, setup.PkiKeeper.IsSerialNumberCertificatePresent(
+		setup.Ctx, testconstants.LeafIssuer, second.SerialNumber))
+	require.Equal(t, types.CertificateStatusExpired, setup.PkiKeeper.GetCertificateStatus(
+		setup.Ctx, testconstants.LeafIssuer, first.SerialNumber).Status)
+	require.Equal(t, types.CertificateStatusGood, setup.PkiKeeper.GetCertificateStatus(
+		setup.Ctx, testconstants.LeafIssuer, second.SerialNumber).Status)
+
+	// the second certificate expires
+	setup.PkiKeeper.EndBlocker(setup.Ctx.WithBlockTime(second.NotAfter.Add(time.Hour)))
+
+	require.False(t, setup.PkiKeeper.IsApprovedCertificatesPresent(
+		setup.Ctx, testconstants.LeafSubject, testconstants.LeafSubjectKeyID))
+	require.False(t, setup.PkiKeeper.IsSubjectKeyIDCertificatesPresent(setup.Ctx, testconstants.LeafSubjectKeyID))
+	require.Equal(t, 2, len(setup.PkiKeeper.GetExpiredCertificates(
+		setup.Ctx, testconstants.LeafSubject, testconstants.LeafSubjectKeyID).Items))
+
+	// no entries are left in the Expiration index
+	count := 0
+
+	setup.PkiKeeper.IterateCertificateExpirations(setup.Ctx, second.NotAfter.Add(time.Hour),
+		func(expiration types.CertificateExpiration) (stop bool) {
+			count++
+
+			return false
+		})
+	require.Equal(t, 0, count)
+}
+
+func TestKeeper_EndBlocker_LimitsNumberOfExpiredCertificatesPerBlock(t *testing.T) {
+	setup := Setup()
+
+	notAfter := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for i := 1; i <= MaxExpiredCertificatesPerBlock+1; i++ {
+		certificate := createRootCertificate(Index{Subject: i})
+		certificate.NotAfter = notAfter
+		setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, certificate)
+	}
+
+	ctx := setup.Ctx.WithBlockTime(notAfter.Add(time.Hour))
+
+	countApproved := func() int {
+		count := 0
+
+		setup.PkiKeeper.IterateApprovedCertificatesRecords(ctx, "", func(types.Certificates) (stop bool) {
+			count++
+
+			return false
+		})
+
+		return count
+	}
+
+	setup.PkiKeeper.EndBlocker(ctx)
+	require.Equal(t, 1, countApproved())
+
+	setup.PkiKeeper.EndBlocker(ctx)
+	require.Equal(t, 0, countApproved())
+}
+
+func TestKeeper_SetCertificatesExpiration(t *testing.T) {
+	setup := Setup()
+
+	// add certificate stored without expiration time (as if it was added before it was tracked)
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, DefaultRootCertificate())
+
+	require.NoError(t, setup.PkiKeeper.SetCertificatesExpiration(setup.Ctx))
+
+	x509Certificate, err := x509.DecodeX509Certificate(testconstants.RootCertPem)
+	require.Nil(t, err)
+
+	certificates := setup.PkiKeeper.GetApprovedCertificates(
+		setup.Ctx, testconstants.RootSubject, testconstants.RootSubjectKeyID)
+	require.True(t, x509Certificate.Certificate.NotAfter.Equal(certificates.Items[0].NotAfter))
+
+	// the certificate is added to the Expiration index
+	var expirations []types.CertificateExpiration
+
+	setup.PkiKeeper.IterateCertificateExpirations(setup.Ctx, x509Certificate.Certificate.NotAfter,
+		func(expiration types.CertificateExpiration) (stop bool) {
+			expirations = append(expirations, expiration)
+
+			return false
+		})
+	require.Equal(t, 1, len(expirations))
+	require.Equal(t, testconstants.RootSubject, expirations[0].Subject)
+	require.Equal(t, testconstants.RootSerialNumber, expirations[0].SerialNumber)
+}
+
+func TestKeeper_SetProposedCertificatesProposedAt(t *testing.T) {
+	setup := Setup()
+
+	// store the certificate proposed before the proposal height was tracked and the one proposed at height 5
+	legacyCertificate := DefaultProposedRootCertificate()
+	setup.PkiKeeper.SetProposedCertificate(setup.Ctx, legacyCertificate)
+
+	proposedCertificate := DefaultProposedRootCertificate()
+	proposedCertificate.Subject = DN + "2"
+	proposedCertificate.ProposedAt = 5
+	setup.PkiKeeper.SetProposedCertificate(setup.Ctx, proposedCertificate)
+
+	ctx := setup.Ctx.WithBlockHeight(100)
+	setup.PkiKeeper.SetProposedCertificatesProposedAt(ctx)
+
+	legacyCertificate = setup.PkiKeeper.GetProposedCertificate(ctx,
+		legacyCertificate.Subject, legacyCertificate.SubjectKeyID)
+	require.Equal(t, int64(100), legacyCertificate.ProposedAt)
+
+	proposedCertificate = setup.PkiKeeper.GetProposedCertificate(ctx,
+		proposedCertificate.Subject, proposedCertificate.SubjectKeyID)
+	require.Equal(t, int64(5), proposedCertificate.ProposedAt)
+
+	// the migrated certificate expires after the expiration period from the migration height
+	params := types.DefaultParams()
+	params.RootCertificateProposalExpirationPeriod = 10
+	setup.PkiKeeper.SetParams(ctx, params)
+
+	setup.PkiKeeper.EndBlocker(ctx.WithBlockHeight(110))
+	require.True(t, setup.PkiKeeper.IsProposedCertificatePresent(ctx,
+		legacyCertificate.Subject, legacyCertificate.SubjectKeyID))
+	require.False(t, setup.PkiKeeper.IsProposedCertificatePresent(ctx,
+		proposedCertificate.Subject, proposedCertificate.SubjectKeyID))
+
+	setup.PkiKeeper.EndBlocker(ctx.WithBlockHeight(111))
+	require.False(t, setup.PkiKeeper.IsProposedCertificatePresent(ctx,
+		legacyCertificate.Subject, legacyCertificate.SubjectKeyID))
+}
@@ -15,8 +15,12 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/types"
 )
 
@@ -26,10 +30,17 @@ type Keeper struct {
 
 	// The wire codec for binary encoding/decoding
 	cdc *codec.Codec
+
+	// The store of proposed root certificates indexed by the height they were proposed at
+	proposedCertificates approvals.ProposalStore
 }
 
 func NewKeeper(storeKey sdk.StoreKey, cdc *codec.Codec) Keeper {
-	return Keeper{storeKey: storeKey, cdc: cdc}
+	proposedCertificates := approvals.NewProposalStore(storeKey, cdc,
+		types.ProposedCertificatePrefix, types.ProposedCertificateExpirationPrefix,
+		func() approvals.Proposal { return &types.ProposedCertificate{} })
+
+	return Keeper{storeKey: storeKey, cdc: cdc, proposedCertificates: proposedCertificates}
 }
 
 /*
@@ -61,11 +72,18 @@ func (k Keeper) SetApprovedCertificates(ctx sdk.Context, subject string, subject
 
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetApprovedCertificateKey(subject, subjectKeyID), k.cdc.MustMarshalBinaryBare(certificates))
+
+	// keep the helper indexes in sync
+	k.indexApprovedCertificates(ctx, subject, subjectKeyID, certificates)
 }
 
 // Add the Certificate to the Approved Certificates record with the corresponding Subject/SubjectKeyID combination.
 func (k Keeper) AddApprovedCertificate(ctx sdk.Context, certificate types.Certificate) {
 	certificates := k.GetApprovedCertificates(ctx, certificate.Subject, certificate.SubjectKeyID)
+	if len(certificates.Items) > 0 {
+		certificates.Sequence++
+	}
+
 	certificates.Items = append(certificates.Items, certificate)
 	k.SetApprovedCertificates(ctx, certificate.Subject, certificate.SubjectKeyID, certificates)
 }
@@ -106,53 +124,313 @@ func (k Keeper) IterateApprovedCertificatesRecords(ctx sdk.Context, prefix strin
 }
 
 // Deletes the entire Approved Certificates record associated with a Subject/SubjectKeyID combination.
+// The certificates must be deleted before they are added to the Revoked (Expired) Certificates record,
+// so that the Serial Number index keeps referencing them.
 func (k Keeper) DeleteApprovedCertificates(ctx sdk.Context, subject string, subjectKeyID string) {
+	// keep the helper indexes in sync
+	for _, certificate := range k.GetApprovedCertificates(ctx, subject, subjectKeyID).Items {
+		k.DeleteSerialNumberCertificate(ctx, certificate.GetIssuer(), certificate.SerialNumber)
+		k.deleteCertificateExpiration(ctx, certificate)
+	}
+
+	k.removeSubjectKeyIDCertificate(ctx, subject, subjectKeyID)
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetApprovedCertificateKey(subject, subjectKeyID))
 }
 
 /*
-	Proposed Root Certificate
+	Record containing the list of approved certificates referenced by a SubjectKeyID.
+	Helper index maintained along with the Approved Certificates records.
 */
 
-// Gets the entire Proposed Certificate record associated with a Subject/SubjectKeyID combination.
-func (k Keeper) GetProposedCertificate(ctx sdk.Context,
-	subject string, subjectKeyID string) types.ProposedCertificate {
-	if !k.IsProposedCertificatePresent(ctx, subject, subjectKeyID) {
-		panic("Proposed Certificate does not exist")
+// Gets the Subject Key ID Certificates record associated with a SubjectKeyID.
+func (k Keeper) GetSubjectKeyIDCertificates(ctx sdk.Context, subjectKeyID string) types.SubjectKeyIDCertificates {
+	if !k.IsSubjectKeyIDCertificatesPresent(ctx, subjectKeyID) {
+		return types.NewSubjectKeyIDCertificates(subjectKeyID)
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetProposedCertificateKey(subject, subjectKeyID))
+	bz := store.Get(types.GetSubjectKeyIDCertificatesKey(subjectKeyID))
 
-	var cert types.ProposedCertificate
+	var subjectKeyIDCertificates types.SubjectKeyIDCertificates
 
-	k.cdc.MustUnmarshalBinaryBare(bz, &cert)
+	k.cdc.MustUnmarshalBinaryBare(bz, &subjectKeyIDCertificates)
 
-	return cert
+	return subjectKeyIDCertificates
 }
 
-// Sets the entire Proposed Certificate record for a Subject/SubjectKeyID combination.
-func (k Keeper) SetProposedCertificate(ctx sdk.Context, certificate types.ProposedCertificate) {
+// Check if the Subject Key ID Certificates record associated with a SubjectKeyID is present in the store or not.
+func (k Keeper) IsSubjectKeyIDCertificatesPresent(ctx sdk.Context, subjectKeyID string) bool {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetProposedCertificateKey(
-		certificate.Subject, certificate.SubjectKeyID), k.cdc.MustMarshalBinaryBare(certificate))
+
+	return store.Has(types.GetSubjectKeyIDCertificatesKey(subjectKeyID))
 }
 
-// Check if the Proposed Certificate record associated with a
-// Subject/SubjectKeyID combination is present in the store or not.
-func (k Keeper) IsProposedCertificatePresent(ctx sdk.Context, subject string, subjectKeyID string) bool {
+// Adds the Subject/SubjectKeyID combination to the Subject Key ID Certificates record.
+func (k Keeper) addSubjectKeyIDCertificate(ctx sdk.Context, subject string, subjectKeyID string) {
+	subjectKeyIDCertificates := k.GetSubjectKeyIDCertificates(ctx, subjectKeyID)
+	identifier := types.NewCertificateIdentifier(subject, subjectKeyID)
+
+	for _, certIdentifier := range subjectKeyIDCertificates.CertIdentifiers {
+		if certIdentifier == identifier {
+			return
+		}
+	}
+
+	subjectKeyIDCertificates.CertIdentifiers = append(subjectKeyIDCertificates.CertIdentifiers, identifier)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSubjectKeyIDCertificatesKey(subjectKeyID), k.cdc.MustMarshalBinaryBare(subjectKeyIDCertificates))
+}
+
+// Removes the Subject/SubjectKeyID combination from the Subject Key ID Certificates record.
+func (k Keeper) removeSubjectKeyIDCertificate(ctx sdk.Context, subject string, subjectKeyID string) {
+	subjectKeyIDCertificates := k.GetSubjectKeyIDCertificates(ctx, subjectKeyID)
+	identifier := types.NewCertificateIdentifier(subject, subjectKeyID)
+
+	certIdentifiers := make([]types.CertificateIdentifier, 0, len(subjectKeyIDCertificates.CertIdentifiers))
+
+	for _, certIdentifier := range subjectKeyIDCertificates.CertIdentifiers {
+		if certIdentifier != identifier {
+			certIdentifiers = append(certIdentifiers, certIdentifier)
+		}
+	}
+
 	store := ctx.KVStore(k.storeKey)
 
-	return store.Has(types.GetProposedCertificateKey(subject, subjectKeyID))
+	if len(certIdentifiers) == 0 {
+		store.Delete(types.GetSubjectKeyIDCertificatesKey(subjectKeyID))
+
+		return
+	}
+
+	subjectKeyIDCertificates.CertIdentifiers = certIdentifiers
+	store.Set(types.GetSubjectKeyIDCertificatesKey(subjectKeyID), k.cdc.MustMarshalBinaryBare(subjectKeyIDCertificates))
 }
 
-// Iterate over all Proposed Certificates.
-func (k Keeper) IterateProposedCertificates(ctx sdk.Context,
-	process func(info types.ProposedCertificate) (stop bool)) {
+// Adds the Approved Certificates record to the Subject Key ID, Serial Number and Expiration helper indexes.
+func (k Keeper) indexApprovedCertificates(ctx sdk.Context, subject string, subjectKeyID string,
+	certificates types.Certificates) {
+	k.addSubjectKeyIDCertificate(ctx, subject, subjectKeyID)
+	k.indexSerialNumberCertificates(ctx, subject, subjectKeyID, certificates)
+
+	for _, certificate := range certificates.Items {
+		k.setCertificateExpiration(ctx, certificate)
+	}
+}
+
+// Rebuilds the Subject Key ID and Expiration helper indexes from all the Approved Certificates records
+// and the Serial Number index from all the Approved, Revoked and Expired Certificates records.
+func (k Keeper) RebuildCertificateIndexes(ctx sdk.Context) {
 	store := ctx.KVStore(k.storeKey)
 
-	iter := sdk.KVStorePrefixIterator(store, types.ProposedCertificatePrefix)
+	var staleKeys [][]byte
+
+	for _, prefix := range [][]byte{types.SubjectKeyIDCertificatesPrefix, types.SerialNumberCertificatePrefix,
+		types.CertificateExpirationPrefix} {
+		iter := sdk.KVStorePrefixIterator(store, prefix)
+		for ; iter.Valid(); iter.Next() {
+			staleKeys = append(staleKeys, iter.Key())
+		}
+
+		iter.Close()
+	}
+
+	for _, key := range staleKeys {
+		store.Delete(key)
+	}
+
+	k.IterateApprovedCertificatesRecords(ctx, "", func(certificates types.Certificates) (stop bool) {
+		k.indexApprovedCertificates(ctx, certificates.Items[0].Subject, certificates.Items[0].SubjectKeyID, certificates)
+
+		return false
+	})
+
+	indexSerialNumbers := func(certificates types.Certificates) (stop bool) {
+		k.indexSerialNumberCertificates(ctx, certificates.Items[0].Subject, certificates.Items[0].SubjectKeyID,
+			certificates)
+
+		return false
+	}
+
+	k.IterateRevokedCertificatesRecords(ctx, "", indexSerialNumbers)
+	k.IterateExpiredCertificatesRecords(ctx, "", indexSerialNumbers)
+}
+
+// Sets the given class to all the certificates (proposed, approved, revoked and expired) stored without a class.
+func (k Keeper) SetCertificatesClass(ctx sdk.Context, class types.CertificateClass) {
+	var proposed []types.ProposedCertificate
+
+	k.IterateProposedCertificates(ctx, func(certificate types.ProposedCertificate) (stop bool) {
+		if len(certificate.Class) == 0 {
+			proposed = append(proposed, certificate)
+		}
+
+		return false
+	})
+
+	for _, certificate := range proposed {
+		certificate.Class = class
+		k.SetProposedCertificate(ctx, certificate)
+	}
+
+	setClass := func(iterate func(sdk.Context, string, func(types.Certificates) bool),
+		set func(sdk.Context, string, string, types.Certificates)) {
+		var records []types.Certificates
+
+		iterate(ctx, "", func(certificates types.Certificates) (stop bool) {
+			records = append(records, certificates)
+
+			return false
+		})
+
+		for _, certificates := range records {
+			for i := range certificates.Items {
+				if len(certificates.Items[i].Class) == 0 {
+					certificates.Items[i].Class = class
+				}
+			}
+
+			set(ctx, certificates.Items[0].Subject, certificates.Items[0].SubjectKeyID, certificates)
+		}
+	}
+
+	setClass(k.IterateApprovedCertificatesRecords, k.SetApprovedCertificates)
+	setClass(k.IterateRevokedCertificatesRecords, k.SetRevokedCertificates)
+	setClass(k.IterateExpiredCertificatesRecords, k.SetExpiredCertificates)
+}
+
+/*
+	Reference to an approved, revoked or expired certificate by its Issuer/SerialNumber combination.
+	Helper index maintained along with the Approved, Revoked and Expired Certificates records.
+*/
+
+// Gets the Subject/SubjectKeyID combination of the certificate with the given Issuer/SerialNumber.
+func (k Keeper) GetSerialNumberCertificate(ctx sdk.Context,
+	issuer string, serialNumber string) types.CertificateIdentifier {
+	if !k.IsSerialNumberCertificatePresent(ctx, issuer, serialNumber) {
+		panic("Serial Number Certificate does not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSerialNumberCertificateKey(issuer, serialNumber))
+
+	var identifier types.CertificateIdentifier
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &identifier)
+
+	return identifier
+}
+
+// Sets the reference to the certificate with the given Issuer/SerialNumber.
+func (k Keeper) SetSerialNumberCertificate(ctx sdk.Context,
+	issuer string, serialNumber string, identifier types.CertificateIdentifier) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSerialNumberCertificateKey(issuer, serialNumber), k.cdc.MustMarshalBinaryBare(identifier))
+}
+
+// Check if the reference to the certificate with the given Issuer/SerialNumber is present in the store or not.
+func (k Keeper) IsSerialNumberCertificatePresent(ctx sdk.Context, issuer string, serialNumber string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetSerialNumberCertificateKey(issuer, serialNumber))
+}
+
+// Deletes the reference to the certificate with the given Issuer/SerialNumber.
+func (k Keeper) DeleteSerialNumberCertificate(ctx sdk.Context, issuer string, serialNumber string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetSerialNumberCertificateKey(issuer, serialNumber))
+}
+
+// Sets the references to the certificates of the record with the given Subject/SubjectKeyID.
+func (k Keeper) indexSerialNumberCertificates(ctx sdk.Context, subject string, subjectKeyID string,
+	certificates types.Certificates) {
+	for _, certificate := range certificates.Items {
+		k.SetSerialNumberCertificate(ctx, certificate.GetIssuer(), certificate.SerialNumber,
+			types.NewCertificateIdentifier(subject, subjectKeyID))
+	}
+}
+
+// Deletes the references to the certificates of the record.
+func (k Keeper) unindexSerialNumberCertificates(ctx sdk.Context, certificates types.Certificates) {
+	for _, certificate := range certificates.Items {
+		k.DeleteSerialNumberCertificate(ctx, certificate.GetIssuer(), certificate.SerialNumber)
+	}
+}
+
+// Returns the status of the certificate with the given Issuer/SerialNumber: good if it is approved,
+// revoked or expired if it is found among the corresponding records, unknown otherwise.
+func (k Keeper) GetCertificateStatus(ctx sdk.Context, issuer string, serialNumber string) types.CertificateStatus {
+	if !k.IsSerialNumberCertificatePresent(ctx, issuer, serialNumber) {
+		return types.NewCertificateStatus(issuer, serialNumber, types.CertificateStatusUnknown, nil)
+	}
+
+	identifier := k.GetSerialNumberCertificate(ctx, issuer, serialNumber)
+
+	for _, record := range []struct {
+		status       string
+		certificates func(sdk.Context, string, string) types.Certificates
+	}{
+		{types.CertificateStatusGood, k.GetApprovedCertificates},
+		{types.CertificateStatusRevoked, k.GetRevokedCertificates},
+		{types.CertificateStatusExpired, k.GetExpiredCertificates},
+	} {
+		certificates := record.certificates(ctx, identifier.Subject, identifier.SubjectKeyID)
+
+		if certificate := findCertificate(certificates, issuer, serialNumber); certificate != nil {
+			return types.NewCertificateStatus(issuer, serialNumber, record.status, certificate)
+		}
+	}
+
+	return types.NewCertificateStatus(issuer, serialNumber, types.CertificateStatusUnknown, nil)
+}
+
+func findCertificate(certificates types.Certificates, issuer string, serialNumber string) *types.Certificate {
+	for i := range certificates.Items {
+		if certificates.Items[i].GetIssuer() == issuer && certificates.Items[i].SerialNumber == serialNumber {
+			return &certificates.Items[i]
+		}
+	}
+
+	return nil
+}
+
+/*
+	Approved certificates ordered by their expiration time.
+	Helper index maintained along with the Approved Certificates records.
+*/
+
+// Adds the approved certificate to the Expiration index (certificates with unknown expiration time are skipped).
+func (k Keeper) setCertificateExpiration(ctx sdk.Context, certificate types.Certificate) {
+	if certificate.NotAfter.IsZero() {
+		return
+	}
+
+	key := types.GetCertificateExpirationKey(certificate.NotAfter, certificate.GetIssuer(), certificate.SerialNumber)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, k.cdc.MustMarshalBinaryBare(types.NewCertificateExpiration(certificate)))
+}
+
+// Removes the approved certificate from the Expiration index.
+func (k Keeper) deleteCertificateExpiration(ctx sdk.Context, certificate types.Certificate) {
+	if certificate.NotAfter.IsZero() {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCertificateExpirationKey(certificate.NotAfter, certificate.GetIssuer(),
+		certificate.SerialNumber))
+}
+
+// Iterate over the approved certificates expiring not later than the given time in the order of their expiration.
+func (k Keeper) IterateCertificateExpirations(ctx sdk.Context, until time.Time,
+	process func(expiration types.CertificateExpiration) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := store.Iterator(types.CertificateExpirationPrefix,
+		types.GetCertificateExpirationPrefix(until.Add(time.Second)))
 	defer iter.Close()
 
 	for {
@@ -162,11 +440,11 @@ func (k Keeper) IterateProposedCertificates(ctx sdk.Context,
 
 		val := iter.Value()
 
-		var proposedCertificate types.ProposedCertificate
+		var expiration types.CertificateExpiration
 
-		k.cdc.MustUnmarshalBinaryBare(val, &proposedCertificate)
+		k.cdc.MustUnmarshalBinaryBare(val, &expiration)
 
-		if process(proposedCertificate) {
+		if process(expiration) {
 			return
 		}
 
@@ -174,14 +452,69 @@ func (k Keeper) IterateProposedCertificates(ctx sdk.Context,
 	}
 }
 
+// Iterate over all the approved certificates in the order of their expiration (or the reverse one if requested).
+// The key passed along with the index entry is the pagination key.
+func (k Keeper) IterateCertificateExpirationsSorted(ctx sdk.Context, params pagination.PaginationParams,
+	process func(key []byte, expiration types.CertificateExpiration) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := pagination.Iterator(store, types.CertificateExpirationPrefix, params)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var expiration types.CertificateExpiration
+
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &expiration)
+
+		if process(iter.Key(), expiration) {
+			return
+		}
+	}
+}
+
+/*
+	Proposed Root Certificate
+*/
+
+// Gets the entire Proposed Certificate record associated with a Subject/SubjectKeyID combination.
+func (k Keeper) GetProposedCertificate(ctx sdk.Context,
+	subject string, subjectKeyID string) types.ProposedCertificate {
+	var cert types.ProposedCertificate
+
+	if !k.proposedCertificates.Get(ctx, types.GetProposedCertificateKey(subject, subjectKeyID), &cert) {
+		panic("Proposed Certificate does not exist")
+	}
+
+	return cert
+}
+
+// Sets the entire Proposed Certificate record for a Subject/SubjectKeyID combination.
+func (k Keeper) SetProposedCertificate(ctx sdk.Context, certificate types.ProposedCertificate) {
+	k.proposedCertificates.Set(ctx,
+		types.GetProposedCertificateKey(certificate.Subject, certificate.SubjectKeyID), certificate)
+}
+
+// Check if the Proposed Certificate record associated with a
+// Subject/SubjectKeyID combination is present in the store or not.
+func (k Keeper) IsProposedCertificatePresent(ctx sdk.Context, subject string, subjectKeyID string) bool {
+	return k.proposedCertificates.Has(ctx, types.GetProposedCertificateKey(subject, subjectKeyID))
+}
+
+// Iterate over all Proposed Certificates.
+func (k Keeper) IterateProposedCertificates(ctx sdk.Context,
+	process func(info types.ProposedCertificate) (stop bool)) {
+	k.proposedCertificates.Iterate(ctx, func(proposal approvals.Proposal) (stop bool) {
+		return process(*proposal.(*types.ProposedCertificate))
+	})
+}
+
 // Deletes the Proposed Certificate from the store.
 func (k Keeper) DeleteProposedCertificate(ctx sdk.Context, subject string, subjectKeyID string) {
 	if !k.IsProposedCertificatePresent(ctx, subject, subjectKeyID) {
 		panic("Proposed Certificate does not exist")
 	}
 
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.GetProposedCertificateKey(subject, subjectKeyID))
+	k.proposedCertificates.Delete(ctx, types.GetProposedCertificateKey(subject, subjectKeyID))
 }
 
 /*
@@ -385,6 +718,9 @@ func (k Keeper) SetRevokedCertificates(ctx sdk.Context, subject string, subjectK
 
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetRevokedCertificateKey(subject, subjectKeyID), k.cdc.MustMarshalBinaryBare(certificates))
+
+	// keep the helper index in sync
+	k.indexSerialNumberCertificates(ctx, subject, subjectKeyID, certificates)
 }
 
 // Add certificates to the Revoked Certificates record for a Subject/SubjectKeyID combination.
@@ -431,6 +767,262 @@ func (k Keeper) IterateRevokedCertificatesRecords(ctx sdk.Context, prefix string
 
 // Deletes the entire Revoked Certificates record associated with a Subject/SubjectKeyID combination.
 func (k Keeper) DeleteRevokedCertificates(ctx sdk.Context, subject string, subjectKeyID string) {
+	// keep the helper index in sync
+	k.unindexSerialNumberCertificates(ctx, k.GetRevokedCertificates(ctx, subject, subjectKeyID))
+
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetRevokedCertificateKey(subject, subjectKeyID))
 }
+
+/*
+	Expired Certificate (root or non-root)
+*/
+
+// Gets the entire Expired Certificates record associated with a Subject/SubjectKeyID combination.
+func (k Keeper) GetExpiredCertificates(ctx sdk.Context, subject string, subjectKeyID string) types.Certificates {
+	if !k.IsExpiredCertificatesPresent(ctx, subject, subjectKeyID) {
+		return types.NewCertificates([]types.Certificate{})
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetExpiredCertificateKey(subject, subjectKeyID))
+
+	var cert types.Certificates
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &cert)
+
+	return cert
+}
+
+// Sets the entire Expired Certificates record for a Subject/SubjectKeyID combination.
+func (k Keeper) SetExpiredCertificates(ctx sdk.Context, subject string, subjectKeyID string,
+	certificates types.Certificates) {
+	if len(certificates.Items) == 0 {
+		panic("Cannot set expired Certificates record with no items")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetExpiredCertificateKey(subject, subjectKeyID), k.cdc.MustMarshalBinaryBare(certificates))
+
+	// keep the helper index in sync
+	k.indexSerialNumberCertificates(ctx, subject, subjectKeyID, certificates)
+}
+
+// Add certificates to the Expired Certificates record for a Subject/SubjectKeyID combination.
+func (k Keeper) AddExpiredCertificates(ctx sdk.Context, subject string, subjectKeyID string,
+	certificates types.Certificates) {
+	expiredCertificates := k.GetExpiredCertificates(ctx, subject, subjectKeyID)
+	expiredCertificates.Items = append(expiredCertificates.Items, certificates.Items...)
+	k.SetExpiredCertificates(ctx, subject, subjectKeyID, expiredCertificates)
+}
+
+// Check if the Expired Certificates record associated with a Subject/SubjectKeyID combination
+// is present in the store or not.
+func (k Keeper) IsExpiredCertificatesPresent(ctx sdk.Context, subject string, subjectKeyID string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetExpiredCertificateKey(subject, subjectKeyID))
+}
+
+func (k Keeper) IterateExpiredCertificatesRecords(ctx sdk.Context, prefix string,
+	process func(info types.Certificates) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, append(types.ExpiredCertificatePrefix, []byte(prefix)...))
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		val := iter.Value()
+
+		var certificates types.Certificates
+
+		k.cdc.MustUnmarshalBinaryBare(val, &certificates)
+
+		if process(certificates) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+// Deletes the entire Expired Certificates record associated with a Subject/SubjectKeyID combination.
+func (k Keeper) DeleteExpiredCertificates(ctx sdk.Context, subject string, subjectKeyID string) {
+	// keep the helper index in sync
+	k.unindexSerialNumberCertificates(ctx, k.GetExpiredCertificates(ctx, subject, subjectKeyID))
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetExpiredCertificateKey(subject, subjectKeyID))
+}
+
+/*
+	CRL Distribution Points published for an approved Root / Intermediate certificate
+*/
+
+// Gets the CRL Distribution Points record associated with a Subject/SubjectKeyID combination.
+func (k Keeper) GetCrlDistributionPoints(ctx sdk.Context,
+	subject string, subjectKeyID string) types.CrlDistributionPoints {
+	if !k.IsCrlDistributionPointsPresent(ctx, subject, subjectKeyID) {
+		panic("CRL Distribution Points do not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCrlDistributionPointsKey(subject, subjectKeyID))
+
+	var crlDistributionPoints types.CrlDistributionPoints
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &crlDistributionPoints)
+
+	return crlDistributionPoints
+}
+
+// Sets the CRL Distribution Points record for a Subject/SubjectKeyID combination.
+func (k Keeper) SetCrlDistributionPoints(ctx sdk.Context, crlDistributionPoints types.CrlDistributionPoints) {
+	if len(crlDistributionPoints.URLs) == 0 {
+		panic("Cannot set CRL Distribution Points record with no URLs")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCrlDistributionPointsKey(crlDistributionPoints.Subject, crlDistributionPoints.SubjectKeyID),
+		k.cdc.MustMarshalBinaryBare(crlDistributionPoints))
+}
+
+// Check if the CRL Distribution Points record associated with a
+// Subject/SubjectKeyID combination is present in the store or not.
+func (k Keeper) IsCrlDistributionPointsPresent(ctx sdk.Context, subject string, subjectKeyID string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetCrlDistributionPointsKey(subject, subjectKeyID))
+}
+
+// Iterate over all CRL Distribution Points records.
+func (k Keeper) IterateCrlDistributionPoints(ctx sdk.Context,
+	process func(info types.CrlDistributionPoints) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.CrlDistributionPointsPrefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		val := iter.Value()
+
+		var crlDistributionPoints types.CrlDistributionPoints
+
+		k.cdc.MustUnmarshalBinaryBare(val, &crlDistributionPoints)
+
+		if process(crlDistributionPoints) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+// Deletes the CRL Distribution Points record associated with a Subject/SubjectKeyID combination.
+func (k Keeper) DeleteCrlDistributionPoints(ctx sdk.Context, subject string, subjectKeyID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetCrlDistributionPointsKey(subject, subjectKeyID))
+}
+
+/*
+	Rejected Root Certificate
+*/
+
+// Gets the Rejected Certificate record associated with a Subject/SubjectKeyID combination.
+func (k Keeper) GetRejectedCertificate(ctx sdk.Context,
+	subject string, subjectKeyID string) types.ProposedCertificate {
+	if !k.IsRejectedCertificatePresent(ctx, subject, subjectKeyID) {
+		panic("Rejected Certificate does not exist")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRejectedCertificateKey(subject, subjectKeyID))
+
+	var cert types.ProposedCertificate
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &cert)
+
+	return cert
+}
+
+// Sets the Rejected Certificate record for a Subject/SubjectKeyID combination.
+func (k Keeper) SetRejectedCertificate(ctx sdk.Context, certificate types.ProposedCertificate) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRejectedCertificateKey(
+		certificate.Subject, certificate.SubjectKeyID), k.cdc.MustMarshalBinaryBare(certificate))
+}
+
+// Check if the Rejected Certificate record associated with a
+// Subject/SubjectKeyID combination is present in the store or not.
+func (k Keeper) IsRejectedCertificatePresent(ctx sdk.Context, subject string, subjectKeyID string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetRejectedCertificateKey(subject, subjectKeyID))
+}
+
+// Iterate over all Rejected Certificates.
+func (k Keeper) IterateRejectedCertificates(ctx sdk.Context,
+	process func(info types.ProposedCertificate) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.RejectedCertificatePrefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		val := iter.Value()
+
+		var rejectedCertificate types.ProposedCertificate
+
+		k.cdc.MustUnmarshalBinaryBare(val, &rejectedCertificate)
+
+		if process(rejectedCertificate) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+// Deletes the Rejected Certificate record associated with a Subject/SubjectKeyID combination.
+func (k Keeper) DeleteRejectedCertificate(ctx sdk.Context, subject string, subjectKeyID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRejectedCertificateKey(subject, subjectKeyID))
+}
+
+/*
+	Module Params
+*/
+
+// Gets the module params. Default params are returned if they have not been set yet.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &params)
+
+	return params
+}
+
+// Sets the module params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshalBinaryBare(params))
+}
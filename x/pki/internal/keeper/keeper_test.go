@@ -256,6 +256,103 @@ func TestKeeper_UniqueCertificateKeyGetSet(t *testing.T) {
 		testconstants.IntermediateIssuer, testconstants.IntermediateSerialNumber))
 }
 
+func TestKeeper_SubjectKeyIDAndSerialNumberIndexes(t *testing.T) {
+	setup := Setup()
+
+	rootCertificate := DefaultRootCertificate()
+	leafCertificate := DefaultNonRootCertificate()
+
+	// no index records before certificates are stored
+	require.False(t, setup.PkiKeeper.IsSubjectKeyIDCertificatesPresent(setup.Ctx, testconstants.RootSubjectKeyID))
+	require.False(t, setup.PkiKeeper.IsSerialNumberCertificatePresent(setup.Ctx,
+		testconstants.RootIssuer, testconstants.RootSerialNumber))
+
+	// store certificates
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, rootCertificate)
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, leafCertificate)
+
+	// certificates are referenced by the subject key id
+	subjectKeyIDCertificates := setup.PkiKeeper.GetSubjectKeyIDCertificates(setup.Ctx, testconstants.RootSubjectKeyID)
+	require.Equal(t,
+		[]types.CertificateIdentifier{
+			types.NewCertificateIdentifier(testconstants.RootSubject, testconstants.RootSubjectKeyID),
+		},
+		subjectKeyIDCertificates.CertIdentifiers)
+
+	// certificates are referenced by the issuer and serial number (root certificate is self-signed)
+	require.Equal(t,
+		types.NewCertificateIdentifier(testconstants.RootSubject, testconstants.RootSubjectKeyID),
+		setup.PkiKeeper.GetSerialNumberCertificate(setup.Ctx,
+			testconstants.RootIssuer, testconstants.RootSerialNumber))
+	require.Equal(t,
+		types.NewCertificateIdentifier(testconstants.LeafSubject, testconstants.LeafSubjectKeyID),
+		setup.PkiKeeper.GetSerialNumberCertificate(setup.Ctx,
+			testconstants.LeafIssuer, testconstants.LeafSerialNumber))
+
+	// the same certificate record is referenced once
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, rootCertificate)
+	subjectKeyIDCertificates = setup.PkiKeeper.GetSubjectKeyIDCertificates(setup.Ctx, testconstants.RootSubjectKeyID)
+	require.Equal(t, 1, len(subjectKeyIDCertificates.CertIdentifiers))
+
+	// index records are removed along with the certificates
+	setup.PkiKeeper.DeleteApprovedCertificates(setup.Ctx, testconstants.RootSubject, testconstants.RootSubjectKeyID)
+
+	require.False(t, setup.PkiKeeper.IsSubjectKeyIDCertificatesPresent(setup.Ctx, testconstants.RootSubjectKeyID))
+	require.False(t, setup.PkiKeeper.IsSerialNumberCertificatePresent(setup.Ctx,
+		testconstants.RootIssuer, testconstants.RootSerialNumber))
+	require.True(t, setup.PkiKeeper.IsSubjectKeyIDCertificatesPresent(setup.Ctx, testconstants.LeafSubjectKeyID))
+	require.True(t, setup.PkiKeeper.IsSerialNumberCertificatePresent(setup.Ctx,
+		testconstants.LeafIssuer, testconstants.LeafSerialNumber))
+
+	// revoked certificates are referenced by the issuer and serial number as well
+	setup.PkiKeeper.AddRevokedCertificates(setup.Ctx, testconstants.RootSubject, testconstants.RootSubjectKeyID,
+		types.NewCertificates([]types.Certificate{rootCertificate}))
+
+	require.False(t, setup.PkiKeeper.IsSubjectKeyIDCertificatesPresent(setup.Ctx, testconstants.RootSubjectKeyID))
+	require.Equal(t,
+		types.NewCertificateIdentifier(testconstants.RootSubject, testconstants.RootSubjectKeyID),
+		setup.PkiKeeper.GetSerialNumberCertificate(setup.Ctx,
+			testconstants.RootIssuer, testconstants.RootSerialNumber))
+	require.Equal(t, types.CertificateStatusRevoked, setup.PkiKeeper.GetCertificateStatus(setup.Ctx,
+		testconstants.RootIssuer, testconstants.RootSerialNumber).Status)
+
+	setup.PkiKeeper.DeleteRevokedCertificates(setup.Ctx, testconstants.RootSubject, testconstants.RootSubjectKeyID)
+
+	require.False(t, setup.PkiKeeper.IsSerialNumberCertificatePresent(setup.Ctx,
+		testconstants.RootIssuer, testconstants.RootSerialNumber))
+	require.Equal(t, types.CertificateStatusUnknown, setup.PkiKeeper.GetCertificateStatus(setup.Ctx,
+		testconstants.RootIssuer, testconstants.RootSerialNumber).Status)
+}
+
+func TestKeeper_RebuildCertificateIndexes(t *testing.T) {
+	setup := Setup()
+
+	// add certificate and drop its index records (as if it was added before they were introduced)
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, DefaultNonRootCertificate())
+
+	store := setup.Ctx.KVStore(setup.PkiKeeper.storeKey)
+	store.Delete(types.GetSubjectKeyIDCertificatesKey(testconstants.LeafSubjectKeyID))
+	store.Delete(types.GetSerialNumberCertificateKey(testconstants.LeafIssuer, testconstants.LeafSerialNumber))
+
+	require.False(t, setup.PkiKeeper.IsSubjectKeyIDCertificatesPresent(setup.Ctx, testconstants.LeafSubjectKeyID))
+
+	// add revoked certificate and drop its index record (as if it was revoked before it was indexed)
+	setup.PkiKeeper.AddRevokedCertificates(setup.Ctx, testconstants.RootSubject, testconstants.RootSubjectKeyID,
+		types.NewCertificates([]types.Certificate{DefaultRootCertificate()}))
+	store.Delete(types.GetSerialNumberCertificateKey(testconstants.RootIssuer, testconstants.RootSerialNumber))
+
+	// rebuild
+	setup.PkiKeeper.RebuildCertificateIndexes(setup.Ctx)
+
+	require.Equal(t, 1,
+		len(setup.PkiKeeper.GetSubjectKeyIDCertificates(setup.Ctx, testconstants.LeafSubjectKeyID).CertIdentifiers))
+	require.True(t, setup.PkiKeeper.IsSerialNumberCertificatePresent(setup.Ctx,
+		testconstants.LeafIssuer, testconstants.LeafSerialNumber))
+	require.True(t, setup.PkiKeeper.IsSerialNumberCertificatePresent(setup.Ctx,
+		testconstants.RootIssuer, testconstants.RootSerialNumber))
+	require.False(t, setup.PkiKeeper.IsSubjectKeyIDCertificatesPresent(setup.Ctx, testconstants.RootSubjectKeyID))
+}
+
 // nolint:dupl
 func TestKeeper_ApprovedCertificatesIterator(t *testing.T) {
 	setup := Setup()
@@ -413,3 +510,45 @@ func TestKeeper_ChildCertificatesIterator(t *testing.T) {
 	require.Equal(t, childCertificates1, iteratedChildCertificatesRecords[0])
 	require.Equal(t, childCertificates2, iteratedChildCertificatesRecords[1])
 }
+
+func TestKeeper_SetCertificatesClass(t *testing.T) {
+	setup := Setup()
+
+	// store certificates without a class and NOC certificate
+	rootCertificate := DefaultRootCertificate()
+	rootCertificate.Class = ""
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, rootCertificate)
+
+	nocCertificate := createRootCertificate(Index{Subject: 1})
+	nocCertificate.Class = types.NocCertificateClass
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, nocCertificate)
+
+	leafCertificate := DefaultNonRootCertificate()
+	leafCertificate.Class = ""
+	setup.PkiKeeper.SetRevokedCertificates(setup.Ctx, leafCertificate.Subject, leafCertificate.SubjectKeyID,
+		types.NewCertificates([]types.Certificate{leafCertificate}))
+
+	proposedCertificate := DefaultProposedRootCertificate()
+	proposedCertificate.Subject = DN + "2"
+	proposedCertificate.Class = ""
+	setup.PkiKeeper.SetProposedCertificate(setup.Ctx, proposedCertificate)
+
+	setup.PkiKeeper.SetCertificatesClass(setup.Ctx, types.AttestationCertificateClass)
+
+	// check that the class is set only for the certificates without a class
+	certificates := setup.PkiKeeper.GetApprovedCertificates(setup.Ctx,
+		rootCertificate.Subject, rootCertificate.SubjectKeyID)
+	require.Equal(t, types.AttestationCertificateClass, certificates.Items[0].Class)
+
+	certificates = setup.PkiKeeper.GetApprovedCertificates(setup.Ctx,
+		nocCertificate.Subject, nocCertificate.SubjectKeyID)
+	require.Equal(t, types.NocCertificateClass, certificates.Items[0].Class)
+
+	certificates = setup.PkiKeeper.GetRevokedCertificates(setup.Ctx,
+		leafCertificate.Subject, leafCertificate.SubjectKeyID)
+	require.Equal(t, types.AttestationCertificateClass, certificates.Items[0].Class)
+
+	proposedCertificate = setup.PkiKeeper.GetProposedCertificate(setup.Ctx,
+		proposedCertificate.Subject, proposedCertificate.SubjectKeyID)
+	require.Equal(t, types.AttestationCertificateClass, proposedCertificate.Class)
+}
@@ -16,10 +16,14 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/conversions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/types"
 )
 
@@ -35,6 +39,27 @@ const (
 	QueryAllRevokedX509Certs                = "all_revoked_x509_certs"
 	QueryAllRevokedX509RootCerts            = "all_revoked_x509_root_certs"
 	QueryRevokedX509Cert                    = "revoked_x509_cert"
+	QueryAllX509CertsRevokedBy              = "all_x509_certs_revoked_by"
+	QueryAllRejectedX509RootCerts           = "all_rejected_x509_root_certs"
+	QueryRejectedX509RootCert               = "rejected_x509_root_cert"
+	QueryParams                             = "params"
+	QueryCrlDistributionPoints              = "crl_distribution_points"
+	QueryAllCrlDistributionPoints           = "all_crl_distribution_points"
+	QueryAllX509CertsExpiringWithin         = "all_x509_certs_expiring_within"
+	QueryAllExpiredX509Certs                = "all_expired_x509_certs"
+	QueryExpiredX509Cert                    = "expired_x509_cert"
+	QueryX509CertStatus                     = "x509_cert_status"
+	QueryAllX509RootCertsOfClass            = "all_x509_root_certs_of_class"
+	QueryAllX509CertsOfClass                = "all_x509_certs_of_class"
+)
+
+// Kind of the certificates records iterated by list queries.
+type certificatesRecords int
+
+const (
+	approvedCertificatesRecords certificatesRecords = iota
+	revokedCertificatesRecords
+	expiredCertificatesRecords
 )
 
 func NewQuerier(keeper Keeper) sdk.Querier {
@@ -50,6 +75,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryAllX509RootCerts(ctx, req, keeper)
 		case QueryAllX509Certs:
 			return queryAllX509Certs(ctx, req, keeper)
+		case QueryAllX509RootCertsOfClass:
+			return queryAllX509RootCertsOfClass(ctx, path[1:], req, keeper)
+		case QueryAllX509CertsOfClass:
+			return queryAllX509CertsOfClass(ctx, path[1:], req, keeper)
 		case QueryAllSubjectX509Certs:
 			return queryAllSubjectX509Certs(ctx, path[1:], req, keeper)
 		case QueryAllProposedX509RootCertRevocations:
@@ -62,6 +91,26 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryAllRevokedX509RootCerts(ctx, req, keeper)
 		case QueryRevokedX509Cert:
 			return queryRevokedX509Cert(ctx, path[1:], keeper)
+		case QueryAllX509CertsRevokedBy:
+			return queryAllX509CertsRevokedBy(ctx, path[1:], req, keeper)
+		case QueryAllRejectedX509RootCerts:
+			return queryAllRejectedX509RootCerts(ctx, req, keeper)
+		case QueryRejectedX509RootCert:
+			return queryRejectedX509RootCert(ctx, path[1:], keeper)
+		case QueryParams:
+			return queryParams(ctx, keeper)
+		case QueryCrlDistributionPoints:
+			return queryCrlDistributionPoints(ctx, path[1:], keeper)
+		case QueryAllCrlDistributionPoints:
+			return queryAllCrlDistributionPoints(ctx, req, keeper)
+		case QueryAllX509CertsExpiringWithin:
+			return queryAllX509CertsExpiringWithin(ctx, path[1:], req, keeper)
+		case QueryAllExpiredX509Certs:
+			return queryAllExpiredX509Certs(ctx, req, keeper)
+		case QueryExpiredX509Cert:
+			return queryExpiredX509Cert(ctx, path[1:], keeper)
+		case QueryX509CertStatus:
+			return queryX509CertStatus(ctx, path[1:], keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown pki query endpoint")
 		}
@@ -77,26 +126,24 @@ func queryAllProposedX509RootCerts(ctx sdk.Context, req abci.RequestQuery, keepe
 
 	result := types.NewListProposedCertificates()
 
-	skipped := 0
+	paginator, err := pagination.NewPaginator(ctx, params.PaginationParams())
+	if err != nil {
+		return nil, err
+	}
 
 	keeper.IterateProposedCertificates(ctx, func(certificate types.ProposedCertificate) (stop bool) {
 		result.Total++
 
-		if skipped < params.Skip {
-			skipped++
-
-			return false
-		}
-
-		if len(result.Items) < params.Take || params.Take == 0 {
+		if paginator.Add(types.GetProposedCertificateKey(certificate.Subject, certificate.SubjectKeyID)) {
 			result.Items = append(result.Items, certificate)
-
-			return false
 		}
 
 		return false
 	})
 
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
 	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
 
 	return res, nil
@@ -117,6 +164,59 @@ func queryProposedX509RootCert(ctx sdk.Context, path []string, keeper Keeper) (r
 	return res, nil
 }
 
+// nolint:dupl
+func queryAllRejectedX509RootCerts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params types.PkiQueryParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Failed to parse request params: %s", err))
+	}
+
+	result := types.NewListProposedCertificates()
+
+	paginator, err := pagination.NewPaginator(ctx, params.PaginationParams())
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.IterateRejectedCertificates(ctx, func(certificate types.ProposedCertificate) (stop bool) {
+		result.Total++
+
+		if paginator.Add(types.GetRejectedCertificateKey(certificate.Subject, certificate.SubjectKeyID)) {
+			result.Items = append(result.Items, certificate)
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func queryRejectedX509RootCert(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	subject := path[0]
+	subjectKeyID := path[1]
+
+	if !keeper.IsRejectedCertificatePresent(ctx, subject, subjectKeyID) {
+		return nil, types.ErrRejectedCertificateDoesNotExist(subject, subjectKeyID)
+	}
+
+	certificate := keeper.GetRejectedCertificate(ctx, subject, subjectKeyID)
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, certificate)
+
+	return res, nil
+}
+
+func queryParams(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
+	res = codec.MustMarshalJSONIndent(keeper.cdc, keeper.GetParams(ctx))
+
+	return res, nil
+}
+
 func queryX509Cert(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
 	subject := path[0]
 	subjectKeyID := path[1]
@@ -133,23 +233,44 @@ func queryX509Cert(ctx sdk.Context, path []string, keeper Keeper) (res []byte, e
 }
 
 func queryAllX509RootCerts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	return queryX509Certs(ctx, req, keeper, true, false, "")
+	return queryX509Certs(ctx, req, keeper, true, "", approvedCertificatesRecords, "")
 }
 
 func queryAllX509Certs(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	return queryX509Certs(ctx, req, keeper, false, false, "")
+	return queryX509Certs(ctx, req, keeper, false, "", approvedCertificatesRecords, "")
+}
+
+// Returns the root certificates of the given class (e.g. all PAA certificates for the attestation class).
+func queryAllX509RootCertsOfClass(ctx sdk.Context, path []string,
+	req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	class := types.CertificateClass(path[0])
+	if !class.IsValid() {
+		return nil, types.ErrInvalidCertificateClass(class)
+	}
+
+	return queryX509Certs(ctx, req, keeper, true, class, approvedCertificatesRecords, "")
+}
+
+func queryAllX509CertsOfClass(ctx sdk.Context, path []string,
+	req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	class := types.CertificateClass(path[0])
+	if !class.IsValid() {
+		return nil, types.ErrInvalidCertificateClass(class)
+	}
+
+	return queryX509Certs(ctx, req, keeper, false, class, approvedCertificatesRecords, "")
 }
 
 func queryAllSubjectX509Certs(ctx sdk.Context, path []string,
 	req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	subject := path[0]
 
-	return queryX509Certs(ctx, req, keeper, false, false, subject)
+	return queryX509Certs(ctx, req, keeper, false, "", approvedCertificatesRecords, subject)
 }
 
 // nolint:gocognit
-func queryX509Certs(ctx sdk.Context, req abci.RequestQuery, keeper Keeper,
-	onlyRoot bool, revoked bool, iteratorPrefix string) (res []byte, err sdk.Error) {
+func queryX509Certs(ctx sdk.Context, req abci.RequestQuery, keeper Keeper, onlyRoot bool,
+	class types.CertificateClass, records certificatesRecords, iteratorPrefix string) (res []byte, err sdk.Error) {
 	var params types.PkiQueryParams
 	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
 		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Failed to parse request params: %s", err))
@@ -157,60 +278,142 @@ func queryX509Certs(ctx sdk.Context, req abci.RequestQuery, keeper Keeper,
 
 	result := types.NewListCertificates()
 
-	skipped := 0
+	// only the approved certificates are indexed by their expiration
+	var sortFields []string
+	if records == approvedCertificatesRecords {
+		sortFields = types.CertificateSortFields
+	}
 
-	process := func(certificates types.Certificates) (stop bool) {
-		for _, certificate := range certificates.Items {
-			// filter by certificate type (Root/Any)
-			if onlyRoot && !certificate.IsRoot {
+	paginator, err := pagination.NewPaginator(ctx, params.PaginationParams(), sortFields...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(params.Sort) == 0 && len(params.Order) != 0 {
+		return nil, sdk.ErrUnknownRequest("Invalid query parameter `order`: the certificates can be ordered " +
+			"only if sorted by a field")
+	}
+
+	matches := func(certificate types.Certificate) bool {
+		// filter by certificate type (Root/Any)
+		if onlyRoot && !certificate.IsRoot {
+			return false
+		}
+
+		// filter by class
+		if len(class) > 0 && certificate.Class != class {
+			return false
+		}
+
+		// filter by root subject
+		if len(params.RootSubject) > 0 {
+			if !certificate.IsRoot && certificate.RootSubject != params.RootSubject ||
+				certificate.IsRoot && certificate.Subject != params.RootSubject {
+				return false
+			}
+		}
+
+		// filter by root subject key id
+		if len(params.RootSubjectKeyID) > 0 {
+			if !certificate.IsRoot && certificate.RootSubjectKeyID != params.RootSubjectKeyID ||
+				certificate.IsRoot && certificate.SubjectKeyID != params.RootSubjectKeyID {
 				return false
 			}
+		}
 
-			// filter by root subject
-			if len(params.RootSubject) > 0 {
-				if !certificate.IsRoot && certificate.RootSubject != params.RootSubject ||
-					certificate.IsRoot && certificate.Subject != params.RootSubject {
+		return true
+	}
+
+	if params.Sort == types.SortByExpiry {
+		keeper.IterateCertificateExpirationsSorted(ctx, params.PaginationParams(),
+			func(key []byte, expiration types.CertificateExpiration) (stop bool) {
+				if !strings.HasPrefix(expiration.Subject+expiration.SubjectKeyID, iteratorPrefix) {
 					return false
 				}
-			}
 
-			// filter by root subject key id
-			if len(params.RootSubjectKeyID) > 0 {
-				if !certificate.IsRoot && certificate.RootSubjectKeyID != params.RootSubjectKeyID ||
-					certificate.IsRoot && certificate.SubjectKeyID != params.RootSubjectKeyID {
+				certificate, found := expiringCertificate(ctx, keeper, expiration)
+				if !found || !matches(certificate) {
 					return false
 				}
-			}
 
-			result.Total++
+				result.Total++
 
-			if skipped < params.Skip {
-				skipped++
+				if paginator.Add(key) {
+					result.Items = append(result.Items, certificate)
+				}
 
 				return false
-			}
+			})
 
-			if len(result.Items) < params.Take || params.Take == 0 {
-				result.Items = append(result.Items, certificate)
+		result.NextKey = paginator.NextKey()
+		result.PrevKey = paginator.PrevKey()
 
+		return codec.MustMarshalJSONIndent(keeper.cdc, result), nil
+	}
+
+	process := func(certificates types.Certificates) (stop bool) {
+		subject, subjectKeyID := certificates.Items[0].Subject, certificates.Items[0].SubjectKeyID
+
+		var recordKey []byte
+
+		switch records {
+		case revokedCertificatesRecords:
+			recordKey = types.GetRevokedCertificateKey(subject, subjectKeyID)
+		case expiredCertificatesRecords:
+			recordKey = types.GetExpiredCertificateKey(subject, subjectKeyID)
+		default:
+			recordKey = types.GetApprovedCertificateKey(subject, subjectKeyID)
+		}
+
+		for i, certificate := range certificates.Items {
+			if !matches(certificate) {
 				return false
 			}
+
+			result.Total++
+
+			if paginator.Add(certificateKey(recordKey, i)) {
+				result.Items = append(result.Items, certificate)
+			}
 		}
 
 		return false
 	}
 
-	if revoked {
+	switch records {
+	case revokedCertificatesRecords:
 		keeper.IterateRevokedCertificatesRecords(ctx, iteratorPrefix, process)
-	} else {
+	case expiredCertificatesRecords:
+		keeper.IterateExpiredCertificatesRecords(ctx, iteratorPrefix, process)
+	default:
 		keeper.IterateApprovedCertificatesRecords(ctx, iteratorPrefix, process)
 	}
 
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
 	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
 
 	return res, nil
 }
 
+// Finds the approved certificate referenced by the Expiration index entry.
+func expiringCertificate(ctx sdk.Context, keeper Keeper,
+	expiration types.CertificateExpiration) (types.Certificate, bool) {
+	for _, certificate := range keeper.GetApprovedCertificates(ctx, expiration.Subject, expiration.SubjectKeyID).Items {
+		if certificate.GetIssuer() == expiration.Issuer && certificate.SerialNumber == expiration.SerialNumber {
+			return certificate, true
+		}
+	}
+
+	return types.Certificate{}, false
+}
+
+// Builds pagination key of a certificate: the key of the record containing the certificate and its index in the record.
+func certificateKey(recordKey []byte, index int) []byte {
+	return append(append([]byte{}, recordKey...), byte(index>>8), byte(index))
+}
+
 // nolint:dupl
 func queryAllProposedX509RootCertRevocations(ctx sdk.Context, req abci.RequestQuery,
 	keeper Keeper) (res []byte, err sdk.Error) {
@@ -221,26 +424,24 @@ func queryAllProposedX509RootCertRevocations(ctx sdk.Context, req abci.RequestQu
 
 	result := types.NewListProposedCertificateRevocations()
 
-	skipped := 0
+	paginator, err := pagination.NewPaginator(ctx, params.PaginationParams())
+	if err != nil {
+		return nil, err
+	}
 
 	keeper.IterateProposedCertificateRevocations(ctx, func(revocation types.ProposedCertificateRevocation) (stop bool) {
 		result.Total++
 
-		if skipped < params.Skip {
-			skipped++
-
-			return false
-		}
-
-		if len(result.Items) < params.Take || params.Take == 0 {
+		if paginator.Add(types.GetProposedCertificateRevocationKey(revocation.Subject, revocation.SubjectKeyID)) {
 			result.Items = append(result.Items, revocation)
-
-			return false
 		}
 
 		return false
 	})
 
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
 	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
 
 	return res, nil
@@ -262,11 +463,11 @@ func queryProposedX509RootCertRevocation(ctx sdk.Context, path []string, keeper
 }
 
 func queryAllRevokedX509Certs(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	return queryX509Certs(ctx, req, keeper, false, true, "")
+	return queryX509Certs(ctx, req, keeper, false, "", revokedCertificatesRecords, "")
 }
 
 func queryAllRevokedX509RootCerts(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	return queryX509Certs(ctx, req, keeper, true, true, "")
+	return queryX509Certs(ctx, req, keeper, true, "", revokedCertificatesRecords, "")
 }
 
 func queryRevokedX509Cert(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
@@ -283,3 +484,189 @@ func queryRevokedX509Cert(ctx sdk.Context, path []string, keeper Keeper) (res []
 
 	return res, nil
 }
+
+// Returns all the certificates invalidated by the revocation of the certificate with the given subject/subjectKeyID:
+// the certificate itself and all the certificates in the subtree signed by it.
+func queryAllX509CertsRevokedBy(ctx sdk.Context, path []string,
+	req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	subject := path[0]
+	subjectKeyID := path[1]
+
+	if !keeper.IsRevokedCertificatesPresent(ctx, subject, subjectKeyID) {
+		return nil, types.ErrRevokedCertificateDoesNotExist(subject, subjectKeyID)
+	}
+
+	var params types.PkiQueryParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Failed to parse request params: %s", err))
+	}
+
+	result := types.NewListCertificates()
+
+	paginator, err := pagination.NewPaginator(ctx, params.PaginationParams())
+	if err != nil {
+		return nil, err
+	}
+
+	revokedBy := types.NewCertificateIdentifier(subject, subjectKeyID)
+
+	keeper.IterateRevokedCertificatesRecords(ctx, "", func(certificates types.Certificates) (stop bool) {
+		recordKey := types.GetRevokedCertificateKey(certificates.Items[0].Subject, certificates.Items[0].SubjectKeyID)
+
+		for i, certificate := range certificates.Items {
+			if certificate.RevokedBy == nil || *certificate.RevokedBy != revokedBy {
+				continue
+			}
+
+			result.Total++
+
+			if paginator.Add(certificateKey(recordKey, i)) {
+				result.Items = append(result.Items, certificate)
+			}
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func queryCrlDistributionPoints(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	subject := path[0]
+	subjectKeyID := path[1]
+
+	if !keeper.IsCrlDistributionPointsPresent(ctx, subject, subjectKeyID) {
+		return nil, types.ErrCrlDistributionPointsDoNotExist(subject, subjectKeyID)
+	}
+
+	crlDistributionPoints := keeper.GetCrlDistributionPoints(ctx, subject, subjectKeyID)
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, crlDistributionPoints)
+
+	return res, nil
+}
+
+func queryAllCrlDistributionPoints(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params types.PkiQueryParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Failed to parse request params: %s", err))
+	}
+
+	result := types.NewListCrlDistributionPoints()
+
+	paginator, err := pagination.NewPaginator(ctx, params.PaginationParams())
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.IterateCrlDistributionPoints(ctx, func(crlDistributionPoints types.CrlDistributionPoints) (stop bool) {
+		// filter by root subject
+		if len(params.RootSubject) > 0 && crlDistributionPoints.RootSubject != params.RootSubject {
+			return false
+		}
+
+		// filter by root subject key id
+		if len(params.RootSubjectKeyID) > 0 && crlDistributionPoints.RootSubjectKeyID != params.RootSubjectKeyID {
+			return false
+		}
+
+		result.Total++
+
+		if paginator.Add(types.GetCrlDistributionPointsKey(
+			crlDistributionPoints.Subject, crlDistributionPoints.SubjectKeyID)) {
+			result.Items = append(result.Items, crlDistributionPoints)
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+// Returns the approved certificates which validity period ends within the given number of days
+// in the order of their expiration. The certificates which have already expired,
+// but have not been moved to the expired ones yet, are included.
+func queryAllX509CertsExpiringWithin(ctx sdk.Context, path []string,
+	req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	days, err := conversions.ParseUInt16FromString(path[0])
+	if err != nil {
+		return nil, err
+	}
+
+	var params types.PkiQueryParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Failed to parse request params: %s", err))
+	}
+
+	result := types.NewListCertificates()
+
+	paginator, err := pagination.NewPaginator(ctx, params.PaginationParams())
+	if err != nil {
+		return nil, err
+	}
+
+	until := ctx.BlockTime().Add(time.Duration(days) * 24 * time.Hour)
+
+	keeper.IterateCertificateExpirations(ctx, until, func(expiration types.CertificateExpiration) (stop bool) {
+		certificate, found := expiringCertificate(ctx, keeper, expiration)
+		if !found {
+			return false
+		}
+
+		result.Total++
+
+		if paginator.Add(types.GetCertificateExpirationKey(
+			expiration.NotAfter, expiration.Issuer, expiration.SerialNumber)) {
+			result.Items = append(result.Items, certificate)
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func queryAllExpiredX509Certs(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	return queryX509Certs(ctx, req, keeper, false, "", expiredCertificatesRecords, "")
+}
+
+func queryExpiredX509Cert(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	subject := path[0]
+	subjectKeyID := path[1]
+
+	if !keeper.IsExpiredCertificatesPresent(ctx, subject, subjectKeyID) {
+		return nil, types.ErrExpiredCertificateDoesNotExist(subject, subjectKeyID)
+	}
+
+	certificates := keeper.GetExpiredCertificates(ctx, subject, subjectKeyID)
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, certificates)
+
+	return res, nil
+}
+
+func queryX509CertStatus(ctx sdk.Context, path []string, keeper Keeper) (res []byte, err sdk.Error) {
+	issuer := path[0]
+	serialNumber := path[1]
+
+	status := keeper.GetCertificateStatus(ctx, issuer, serialNumber)
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, status)
+
+	return res, nil
+}
@@ -17,7 +17,9 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
@@ -603,6 +605,263 @@ func TestQuerier_QueryAllRevokedX509Certs_Filter(t *testing.T) {
 	require.Equal(t, "KeyID108", listCertificates.Items[1].SubjectKeyID)
 }
 
+func TestQuerier_QueryAllX509CertsExpiringWithin(t *testing.T) {
+	setup := Setup()
+
+	blockTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := setup.Ctx.WithBlockTime(blockTime)
+
+	// store certificates expiring in 10, 2 and 5 days
+	for i, days := range []int{10, 2, 5} {
+		certificate := createRootCertificate(Index{Subject: i + 1})
+		certificate.NotAfter = blockTime.Add(time.Duration(days) * 24 * time.Hour)
+		setup.PkiKeeper.AddApprovedCertificate(ctx, certificate)
+	}
+
+	// query certificates expiring within 7 days
+	result, err := setup.Querier(
+		ctx,
+		[]string{QueryAllX509CertsExpiringWithin, "7"},
+		abci.RequestQuery{Data: emptyParams(setup)},
+	)
+	require.Nil(t, err)
+
+	var listCertificates types.ListCertificates
+	_ = setup.Cdc.UnmarshalJSON(result, &listCertificates)
+
+	// check that they are returned in the order of expiration
+	require.Equal(t, 2, listCertificates.Total)
+	require.Equal(t, DN+"2", listCertificates.Items[0].Subject)
+	require.Equal(t, DN+"3", listCertificates.Items[1].Subject)
+
+	// invalid number of days
+	_, err = setup.Querier(
+		ctx,
+		[]string{QueryAllX509CertsExpiringWithin, "-1"},
+		abci.RequestQuery{Data: emptyParams(setup)},
+	)
+	require.NotNil(t, err)
+}
+
+func TestQuerier_QueryAllX509CertsSortedByExpiry(t *testing.T) {
+	setup := Setup()
+
+	blockTime := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := setup.Ctx.WithBlockTime(blockTime)
+
+	// store certificates expiring in 10, 2 and 5 days
+	for i, days := range []int{10, 2, 5} {
+		certificate := createRootCertificate(Index{Subject: i + 1})
+		certificate.NotAfter = blockTime.Add(time.Duration(days) * 24 * time.Hour)
+		setup.PkiKeeper.AddApprovedCertificate(ctx, certificate)
+	}
+
+	// query certificates sorted by expiry in the descending order
+	params := types.NewPkiQueryParams(pagination.NewPaginationParams(0, 2), "", "")
+	params.Sort = types.SortByExpiry
+	params.Order = pagination.OrderDesc
+
+	result, err := setup.Querier(ctx, []string{QueryAllX509Certs},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)})
+	require.Nil(t, err)
+
+	var firstPage types.ListCertificates
+	_ = setup.Cdc.UnmarshalJSON(result, &firstPage)
+
+	// check
+	require.Equal(t, 3, firstPage.Total)
+	require.Equal(t, 2, len(firstPage.Items))
+	require.Equal(t, DN+"1", firstPage.Items[0].Subject)
+	require.Equal(t, DN+"3", firstPage.Items[1].Subject)
+	require.NotEmpty(t, firstPage.NextKey)
+
+	// query the next page
+	params.Key = firstPage.NextKey
+
+	result, err = setup.Querier(ctx, []string{QueryAllX509Certs},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)})
+	require.Nil(t, err)
+
+	var secondPage types.ListCertificates
+	_ = setup.Cdc.UnmarshalJSON(result, &secondPage)
+
+	// check
+	require.Equal(t, 1, len(secondPage.Items))
+	require.Equal(t, DN+"2", secondPage.Items[0].Subject)
+	require.Empty(t, secondPage.NextKey)
+
+	// query certificates of the subject sorted by expiry
+	params = types.NewPkiQueryParams(pagination.NewPaginationParams(0, 0), "", "")
+	params.Sort = types.SortByExpiry
+
+	result, err = setup.Querier(ctx, []string{QueryAllSubjectX509Certs, DN + "3"},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)})
+	require.Nil(t, err)
+
+	var subjectCertificates types.ListCertificates
+	_ = setup.Cdc.UnmarshalJSON(result, &subjectCertificates)
+
+	// check
+	require.Equal(t, 1, subjectCertificates.Total)
+	require.Equal(t, DN+"3", subjectCertificates.Items[0].Subject)
+
+	// the revoked certificates can not be sorted, the order without the sort field can not be changed
+	for _, query := range []struct {
+		path  string
+		sort  string
+		order string
+	}{
+		{QueryAllRevokedX509Certs, types.SortByExpiry, ""},
+		{QueryAllX509Certs, "", pagination.OrderDesc},
+	} {
+		params.Sort = query.sort
+		params.Order = query.order
+
+		_, err = setup.Querier(ctx, []string{query.path}, abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(params)})
+		require.NotNil(t, err)
+		require.Equal(t, sdk.CodeUnknownRequest, err.Code())
+	}
+}
+
+func TestQuerier_QueryExpiredX509Certs(t *testing.T) {
+	setup := Setup()
+
+	// store expired certificate
+	certificate := DefaultNonRootCertificate()
+	setup.PkiKeeper.SetExpiredCertificates(setup.Ctx, certificate.Subject, certificate.SubjectKeyID,
+		types.NewCertificates([]types.Certificate{certificate}))
+
+	// query expired certificate
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryExpiredX509Cert, testconstants.LeafSubject, testconstants.LeafSubjectKeyID},
+		abci.RequestQuery{},
+	)
+	require.Nil(t, err)
+
+	var certificates types.Certificates
+	_ = setup.Cdc.UnmarshalJSON(result, &certificates)
+	require.Equal(t, 1, len(certificates.Items))
+	require.Equal(t, certificate.SerialNumber, certificates.Items[0].SerialNumber)
+
+	// query all expired certificates
+	result, err = setup.Querier(
+		setup.Ctx,
+		[]string{QueryAllExpiredX509Certs},
+		abci.RequestQuery{Data: emptyParams(setup)},
+	)
+	require.Nil(t, err)
+
+	var listCertificates types.ListCertificates
+	_ = setup.Cdc.UnmarshalJSON(result, &listCertificates)
+	require.Equal(t, 1, listCertificates.Total)
+	require.Equal(t, certificate.Subject, listCertificates.Items[0].Subject)
+
+	// query not existing expired certificate
+	_, err = setup.Querier(
+		setup.Ctx,
+		[]string{QueryExpiredX509Cert, testconstants.RootSubject, testconstants.RootSubjectKeyID},
+		abci.RequestQuery{},
+	)
+	require.Equal(t, types.CodeExpiredCertificateDoesNotExist, err.Code())
+}
+
+func TestQuerier_QueryX509CertStatus(t *testing.T) {
+	setup := Setup()
+
+	queryStatus := func(issuer string, serialNumber string) types.CertificateStatus {
+		result, err := setup.Querier(
+			setup.Ctx,
+			[]string{QueryX509CertStatus, issuer, serialNumber},
+			abci.RequestQuery{},
+		)
+		require.Nil(t, err)
+
+		var status types.CertificateStatus
+		_ = setup.Cdc.UnmarshalJSON(result, &status)
+
+		return status
+	}
+
+	// store approved root certificate
+	rootCert := DefaultRootCertificate()
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, rootCert)
+
+	status := queryStatus(testconstants.RootSubject, testconstants.RootSerialNumber)
+	require.Equal(t, types.CertificateStatusGood, status.Status)
+	require.Equal(t, testconstants.RootSubjectKeyID, status.Certificate.SubjectKeyID)
+
+	// store revoked leaf certificate
+	revokedAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	leafCert := DefaultNonRootCertificate()
+	leafCert.RevokedAt = &revokedAt
+	setup.PkiKeeper.AddRevokedCertificates(setup.Ctx, leafCert.Subject, leafCert.SubjectKeyID,
+		types.NewCertificates([]types.Certificate{leafCert}))
+
+	status = queryStatus(testconstants.LeafIssuer, testconstants.LeafSerialNumber)
+	require.Equal(t, types.CertificateStatusRevoked, status.Status)
+	require.Equal(t, revokedAt, *status.Certificate.RevokedAt)
+
+	// store expired certificate
+	expiredCert := createRootCertificate(Index{Subject: 1})
+	setup.PkiKeeper.SetExpiredCertificates(setup.Ctx, expiredCert.Subject, expiredCert.SubjectKeyID,
+		types.NewCertificates([]types.Certificate{expiredCert}))
+
+	status = queryStatus(expiredCert.Subject, expiredCert.SerialNumber)
+	require.Equal(t, types.CertificateStatusExpired, status.Status)
+
+	// query not existing certificate
+	status = queryStatus(testconstants.RootSubject, "1234")
+	require.Equal(t, types.CertificateStatusUnknown, status.Status)
+	require.Nil(t, status.Certificate)
+}
+
+func TestQuerier_QueryAllX509CertsOfClass(t *testing.T) {
+	setup := Setup()
+
+	// store attestation and NOC root certificates and NOC intermediate certificate
+	attestationCertificate := createRootCertificate(Index{Subject: 1})
+	attestationCertificate.Class = types.AttestationCertificateClass
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, attestationCertificate)
+
+	nocCertificate := createRootCertificate(Index{Subject: 2})
+	nocCertificate.Class = types.NocCertificateClass
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, nocCertificate)
+
+	nocIntermediateCertificate := createNonRootCertificate(Indexes{Subject: 3, Issuer: 2, Root: 2})
+	nocIntermediateCertificate.Class = types.NocCertificateClass
+	setup.PkiKeeper.AddApprovedCertificate(setup.Ctx, nocIntermediateCertificate)
+
+	queryList := func(path ...string) types.ListCertificates {
+		result, err := setup.Querier(setup.Ctx, path, abci.RequestQuery{Data: emptyParams(setup)})
+		require.Nil(t, err)
+
+		var listCertificates types.ListCertificates
+		_ = setup.Cdc.UnmarshalJSON(result, &listCertificates)
+
+		return listCertificates
+	}
+
+	// query root certificates of attestation class
+	listCertificates := queryList(QueryAllX509RootCertsOfClass, string(types.AttestationCertificateClass))
+	require.Equal(t, 1, listCertificates.Total)
+	require.Equal(t, DN+"1", listCertificates.Items[0].Subject)
+
+	// query root certificates of NOC class
+	listCertificates = queryList(QueryAllX509RootCertsOfClass, string(types.NocCertificateClass))
+	require.Equal(t, 1, listCertificates.Total)
+	require.Equal(t, DN+"2", listCertificates.Items[0].Subject)
+
+	// query all certificates of NOC class
+	listCertificates = queryList(QueryAllX509CertsOfClass, string(types.NocCertificateClass))
+	require.Equal(t, 2, listCertificates.Total)
+
+	// query certificates of unknown class
+	_, err := setup.Querier(setup.Ctx, []string{QueryAllX509CertsOfClass, "operational"},
+		abci.RequestQuery{Data: emptyParams(setup)})
+	require.Equal(t, types.CodeInvalidCertificateClass, err.Code())
+}
+
 func emptyParams(setup TestSetup) []byte {
 	return paging(setup, 0, 0)
 }
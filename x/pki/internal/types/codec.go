@@ -29,8 +29,11 @@ func init() {
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgProposeAddX509RootCert{}, ModuleName+"/ProposeAddX509RootCert", nil)
 	cdc.RegisterConcrete(MsgApproveAddX509RootCert{}, ModuleName+"/ApproveAddX509RootCert", nil)
+	cdc.RegisterConcrete(MsgRejectAddX509RootCert{}, ModuleName+"/RejectAddX509RootCert", nil)
 	cdc.RegisterConcrete(MsgAddX509Cert{}, ModuleName+"/AddX509Cert", nil)
 	cdc.RegisterConcrete(MsgProposeRevokeX509RootCert{}, ModuleName+"/ProposeRevokeX509RootCert", nil)
 	cdc.RegisterConcrete(MsgApproveRevokeX509RootCert{}, ModuleName+"/ApproveRevokeX509RootCert", nil)
 	cdc.RegisterConcrete(MsgRevokeX509Cert{}, ModuleName+"/RevokeX509Cert", nil)
+	cdc.RegisterConcrete(MsgAddCrlDistributionPoint{}, ModuleName+"/AddCrlDistributionPoint", nil)
+	cdc.RegisterConcrete(MsgRemoveCrlDistributionPoint{}, ModuleName+"/RemoveCrlDistributionPoint", nil)
 }
@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
 )
 
 const (
@@ -32,8 +33,34 @@ const (
 	CodeRevokedCertificateDoesNotExist             sdk.CodeType = 407
 	CodeInappropriateCertificateType               sdk.CodeType = 408
 	CodeInvalidCertificate                         sdk.CodeType = 409
+	CodeCrlDistributionPointAlreadyExists          sdk.CodeType = 410
+	CodeCrlDistributionPointDoesNotExist           sdk.CodeType = 411
+	CodeRejectedCertificateDoesNotExist            sdk.CodeType = 412
+	CodeExpiredCertificateDoesNotExist             sdk.CodeType = 413
+	CodeInvalidCertificateClass                    sdk.CodeType = 414
+	CodeSequenceConflict                           sdk.CodeType = 415
 )
 
+func init() {
+	errcodes.Register(Codespace, CodeProposedCertificateAlreadyExists, "proposed_certificate_already_exists")
+	errcodes.Register(Codespace, CodeProposedCertificateDoesNotExist, "proposed_certificate_does_not_exist")
+	errcodes.Register(Codespace, CodeCertificateAlreadyExists, "certificate_already_exists")
+	errcodes.Register(Codespace, CodeCertificateDoesNotExist, "certificate_does_not_exist")
+	errcodes.Register(Codespace, CodeProposedCertificateRevocationAlreadyExists,
+		"proposed_certificate_revocation_already_exists")
+	errcodes.Register(Codespace, CodeProposedCertificateRevocationDoesNotExist,
+		"proposed_certificate_revocation_does_not_exist")
+	errcodes.Register(Codespace, CodeRevokedCertificateDoesNotExist, "revoked_certificate_does_not_exist")
+	errcodes.Register(Codespace, CodeInappropriateCertificateType, "inappropriate_certificate_type")
+	errcodes.Register(Codespace, CodeInvalidCertificate, "invalid_certificate")
+	errcodes.Register(Codespace, CodeCrlDistributionPointAlreadyExists, "crl_distribution_point_already_exists")
+	errcodes.Register(Codespace, CodeCrlDistributionPointDoesNotExist, "crl_distribution_point_does_not_exist")
+	errcodes.Register(Codespace, CodeRejectedCertificateDoesNotExist, "rejected_certificate_does_not_exist")
+	errcodes.Register(Codespace, CodeExpiredCertificateDoesNotExist, "expired_certificate_does_not_exist")
+	errcodes.Register(Codespace, CodeInvalidCertificateClass, "invalid_certificate_class")
+	errcodes.Register(Codespace, CodeSequenceConflict, "sequence_conflict")
+}
+
 func ErrProposedCertificateAlreadyExists(subject string, subjectKeyID string) sdk.Error {
 	return sdk.NewError(Codespace, CodeProposedCertificateAlreadyExists,
 		fmt.Sprintf("Proposed X509 root certificate associated with the combination "+
@@ -59,6 +86,17 @@ func ErrCertificateDoesNotExist(subject string, subjectKeyID string) sdk.Error {
 			"combination of subject=%v and subjectKeyID=%v on the ledger", subject, subjectKeyID))
 }
 
+func ErrCertificateBySubjectKeyIDDoesNotExist(subjectKeyID string) sdk.Error {
+	return sdk.NewError(Codespace, CodeCertificateDoesNotExist,
+		fmt.Sprintf("No X509 certificate associated with subjectKeyID=%v on the ledger", subjectKeyID))
+}
+
+func ErrCertificateBySerialNumberDoesNotExist(issuer string, serialNumber string) sdk.Error {
+	return sdk.NewError(Codespace, CodeCertificateDoesNotExist,
+		fmt.Sprintf("No X509 certificate associated with the "+
+			"combination of issuer=%v and serialNumber=%v on the ledger", issuer, serialNumber))
+}
+
 func ErrProposedCertificateRevocationAlreadyExists(subject string, subjectKeyID string) sdk.Error {
 	return sdk.NewError(Codespace, CodeProposedCertificateRevocationAlreadyExists,
 		fmt.Sprintf("Proposed X509 root certificate revocation associated with the combination "+
@@ -84,3 +122,46 @@ func ErrInappropriateCertificateType(error interface{}) sdk.Error {
 func ErrCodeInvalidCertificate(error interface{}) sdk.Error {
 	return sdk.NewError(Codespace, CodeInvalidCertificate, fmt.Sprintf("%v", error))
 }
+
+func ErrCrlDistributionPointAlreadyExists(subject string, subjectKeyID string, url string) sdk.Error {
+	return sdk.NewError(Codespace, CodeCrlDistributionPointAlreadyExists,
+		fmt.Sprintf("CRL distribution point url=%v is already published for X509 certificate associated "+
+			"with the combination of subject=%v and subjectKeyID=%v", url, subject, subjectKeyID))
+}
+
+func ErrCrlDistributionPointDoesNotExist(subject string, subjectKeyID string, url string) sdk.Error {
+	return sdk.NewError(Codespace, CodeCrlDistributionPointDoesNotExist,
+		fmt.Sprintf("No CRL distribution point url=%v published for X509 certificate associated "+
+			"with the combination of subject=%v and subjectKeyID=%v", url, subject, subjectKeyID))
+}
+
+func ErrCrlDistributionPointsDoNotExist(subject string, subjectKeyID string) sdk.Error {
+	return sdk.NewError(Codespace, CodeCrlDistributionPointDoesNotExist,
+		fmt.Sprintf("No CRL distribution points published for X509 certificate associated "+
+			"with the combination of subject=%v and subjectKeyID=%v", subject, subjectKeyID))
+}
+
+func ErrRejectedCertificateDoesNotExist(subject string, subjectKeyID string) sdk.Error {
+	return sdk.NewError(Codespace, CodeRejectedCertificateDoesNotExist,
+		fmt.Sprintf("No rejected X509 root certificate associated with the "+
+			"combination of subject=%v and subjectKeyID=%v on the ledger", subject, subjectKeyID))
+}
+
+func ErrExpiredCertificateDoesNotExist(subject string, subjectKeyID string) sdk.Error {
+	return sdk.NewError(Codespace, CodeExpiredCertificateDoesNotExist,
+		fmt.Sprintf("No expired X509 certificate associated with the "+
+			"combination of subject=%v and subjectKeyID=%v on the ledger", subject, subjectKeyID))
+}
+
+func ErrInvalidCertificateClass(class CertificateClass) sdk.Error {
+	return sdk.NewError(Codespace, CodeInvalidCertificateClass,
+		fmt.Sprintf("Invalid certificate class=%v: it must be either %v or %v",
+			class, AttestationCertificateClass, NocCertificateClass))
+}
+
+func ErrSequenceConflict(subject string, subjectKeyID string, expected uint64, actual uint64) sdk.Error {
+	return sdk.NewError(Codespace, CodeSequenceConflict,
+		fmt.Sprintf("X509 certificates associated with the combination of subject=%v and subjectKeyID=%v "+
+			"have been changed concurrently: expected sequence=%v, but stored sequence=%v",
+			subject, subjectKeyID, expected, actual))
+}
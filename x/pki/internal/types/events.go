@@ -0,0 +1,27 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+// pki module event types.
+const (
+	// a root certificate is approved by the required number of trustees and added to the approved ones
+	EventTypeAddX509RootCert = "add_x509_root_cert"
+	// a revocation of a root certificate is approved by the required number of trustees and applied
+	EventTypeRevokeX509RootCert = "revoke_x509_root_cert"
+
+	AttributeKeySubject      = "subject"
+	AttributeKeySubjectKeyID = "subject_key_id"
+	AttributeValueCategory   = ModuleName
+)
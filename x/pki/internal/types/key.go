@@ -14,6 +14,11 @@
 
 package types
 
+import (
+	"encoding/binary"
+	"time"
+)
+
 const (
 	// ModuleName is the name of the module.
 	ModuleName = "pki"
@@ -35,6 +40,22 @@ var (
 	RevokedCertificatePrefix = []byte{0x05}
 	// prefix for each key to a certificate existence flag.
 	UniqueCertificateKeyPrefix = []byte{0x06}
+	// prefix for each key to a list of CRL distribution points.
+	CrlDistributionPointsPrefix = []byte{0x07}
+	// key to the module parameters.
+	ParamsKey = []byte{0x08}
+	// prefix for each key to a rejected certificate.
+	RejectedCertificatePrefix = []byte{0x09}
+	// prefix for a helper index containing the list of approved certificates with the same subject key id.
+	SubjectKeyIDCertificatesPrefix = []byte{0x0A}
+	// prefix for a helper index referencing an approved certificate by its issuer and serial number.
+	SerialNumberCertificatePrefix = []byte{0x0B}
+	// prefix for a helper index of approved certificates ordered by their expiration time.
+	CertificateExpirationPrefix = []byte{0x0C}
+	// prefix for each key to an expired certificate.
+	ExpiredCertificatePrefix = []byte{0x0D}
+	// prefix for a helper index of proposed certificates ordered by the height they were proposed at.
+	ProposedCertificateExpirationPrefix = []byte{0x0E}
 )
 
 // Key builder for Proposed Certificate.
@@ -66,3 +87,42 @@ func GetRevokedCertificateKey(subject string, subjectKeyID string) []byte {
 func GetUniqueCertificateKey(issuer string, serialNumber string) []byte {
 	return append(UniqueCertificateKeyPrefix, append([]byte(issuer), []byte(serialNumber)...)...)
 }
+
+// Key builder for CRL Distribution Points.
+func GetCrlDistributionPointsKey(subject string, subjectKeyID string) []byte {
+	return append(CrlDistributionPointsPrefix, append([]byte(subject), []byte(subjectKeyID)...)...)
+}
+
+// Key builder for Rejected Certificate.
+func GetRejectedCertificateKey(subject string, subjectKeyID string) []byte {
+	return append(RejectedCertificatePrefix, append([]byte(subject), []byte(subjectKeyID)...)...)
+}
+
+// Key builder for the list of Certificates with the same SubjectKeyID.
+func GetSubjectKeyIDCertificatesKey(subjectKeyID string) []byte {
+	return append(SubjectKeyIDCertificatesPrefix, []byte(subjectKeyID)...)
+}
+
+// Key builder for the reference to a Certificate by its Issuer/SerialNumber combination.
+func GetSerialNumberCertificateKey(issuer string, serialNumber string) []byte {
+	return append(SerialNumberCertificatePrefix, append([]byte(issuer), []byte(serialNumber)...)...)
+}
+
+// Key builder for the Certificate Expiration index entry.
+// The expiration time goes first (big-endian encoded), so the entries are iterated in the order of expiration.
+func GetCertificateExpirationKey(notAfter time.Time, issuer string, serialNumber string) []byte {
+	return append(GetCertificateExpirationPrefix(notAfter), append([]byte(issuer), []byte(serialNumber)...)...)
+}
+
+// Prefix of the Certificate Expiration index entries expiring at the given time.
+func GetCertificateExpirationPrefix(notAfter time.Time) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(notAfter.Unix()))
+
+	return append(append([]byte{}, CertificateExpirationPrefix...), bz...)
+}
+
+// Key builder for Expired Certificate.
+func GetExpiredCertificateKey(subject string, subjectKeyID string) []byte {
+	return append(ExpiredCertificatePrefix, append([]byte(subject), []byte(subjectKeyID)...)...)
+}
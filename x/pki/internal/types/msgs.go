@@ -15,18 +15,29 @@
 package types
 
 import (
+	"fmt"
+	"net/url"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const RouterKey = ModuleName
 
+// The limits (in bytes) protecting the nodes from oversized transactions.
+const (
+	MaxCertificateSize = 10240 // of the PEM encoded certificate
+	MaxReasonLength    = 1024
+)
+
 /*
 	PROPOSE_ADD_X509_ROOT_CERT
 */
 
 type MsgProposeAddX509RootCert struct {
-	Cert   string         `json:"cert"`
-	Signer sdk.AccAddress `json:"signer"`
+	Cert string `json:"cert"`
+	// the class of the root certificate and the certificates originated from it (DefaultCertificateClass if empty)
+	Class  CertificateClass `json:"class,omitempty"`
+	Signer sdk.AccAddress   `json:"signer"`
 }
 
 func NewMsgProposeAddX509RootCert(cert string, signer sdk.AccAddress) MsgProposeAddX509RootCert {
@@ -53,9 +64,27 @@ func (m MsgProposeAddX509RootCert) ValidateBasic() sdk.Error {
 		return sdk.ErrUnknownRequest("Invalid x509Cert: it cannot be empty")
 	}
 
+	if len(m.Cert) > MaxCertificateSize {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid x509Cert: it must be at most %v bytes long", MaxCertificateSize))
+	}
+
+	if len(m.Class) != 0 && !m.Class.IsValid() {
+		return ErrInvalidCertificateClass(m.Class)
+	}
+
 	return nil
 }
 
+// Returns the class of the proposed root certificate.
+func (m MsgProposeAddX509RootCert) CertificateClass() CertificateClass {
+	if len(m.Class) == 0 {
+		return DefaultCertificateClass
+	}
+
+	return m.Class
+}
+
 func (m MsgProposeAddX509RootCert) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
 }
@@ -115,6 +144,67 @@ func (m MsgApproveAddX509RootCert) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Signer}
 }
 
+/*
+	REJECT_ADD_X509_ROOT_CERT
+*/
+
+type MsgRejectAddX509RootCert struct {
+	Subject      string         `json:"subject"`
+	SubjectKeyID string         `json:"subject_key_id"`
+	Reason       string         `json:"reason"`
+	Signer       sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgRejectAddX509RootCert(subject string, subjectKeyID string, reason string,
+	signer sdk.AccAddress) MsgRejectAddX509RootCert {
+	return MsgRejectAddX509RootCert{
+		Subject:      subject,
+		SubjectKeyID: subjectKeyID,
+		Reason:       reason,
+		Signer:       signer,
+	}
+}
+
+func (m MsgRejectAddX509RootCert) Route() string {
+	return RouterKey
+}
+
+func (m MsgRejectAddX509RootCert) Type() string {
+	return "reject_add_x509_root_cert"
+}
+
+func (m MsgRejectAddX509RootCert) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if len(m.Subject) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Subject: it cannot be empty")
+	}
+
+	if len(m.SubjectKeyID) == 0 {
+		return sdk.ErrUnknownRequest("Invalid SubjectKeyID: it cannot be empty")
+	}
+
+	if len(m.Reason) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Reason: it cannot be empty")
+	}
+
+	if len(m.Reason) > MaxReasonLength {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Reason: it must be at most %v bytes long", MaxReasonLength))
+	}
+
+	return nil
+}
+
+func (m MsgRejectAddX509RootCert) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgRejectAddX509RootCert) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
 /*
 	ADD_X509_CERT
 */
@@ -148,6 +238,11 @@ func (m MsgAddX509Cert) ValidateBasic() sdk.Error {
 		return sdk.ErrUnknownRequest("Invalid x509Cert: it cannot be empty")
 	}
 
+	if len(m.Cert) > MaxCertificateSize {
+		return sdk.ErrUnknownRequest(
+			fmt.Sprintf("Invalid x509Cert: it must be at most %v bytes long", MaxCertificateSize))
+	}
+
 	return nil
 }
 
@@ -164,9 +259,10 @@ func (m MsgAddX509Cert) GetSigners() []sdk.AccAddress {
 */
 
 type MsgProposeRevokeX509RootCert struct {
-	Subject      string         `json:"subject"`
-	SubjectKeyID string         `json:"subject_key_id"`
-	Signer       sdk.AccAddress `json:"signer"`
+	Subject          string         `json:"subject"`
+	SubjectKeyID     string         `json:"subject_key_id"`
+	Signer           sdk.AccAddress `json:"signer"`
+	ExpectedSequence uint64         `json:"expected_sequence"`
 }
 
 func NewMsgProposeRevokeX509RootCert(subject string, subjectKeyID string,
@@ -266,9 +362,10 @@ func (m MsgApproveRevokeX509RootCert) GetSigners() []sdk.AccAddress {
 */
 
 type MsgRevokeX509Cert struct {
-	Subject      string         `json:"subject"`
-	SubjectKeyID string         `json:"subject_key_id"`
-	Signer       sdk.AccAddress `json:"signer"`
+	Subject          string         `json:"subject"`
+	SubjectKeyID     string         `json:"subject_key_id"`
+	Signer           sdk.AccAddress `json:"signer"`
+	ExpectedSequence uint64         `json:"expected_sequence"`
 }
 
 func NewMsgRevokeX509Cert(subject string, subjectKeyID string, signer sdk.AccAddress) MsgRevokeX509Cert {
@@ -310,3 +407,123 @@ func (m MsgRevokeX509Cert) GetSignBytes() []byte {
 func (m MsgRevokeX509Cert) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Signer}
 }
+
+/*
+	ADD_CRL_DISTRIBUTION_POINT
+*/
+
+type MsgAddCrlDistributionPoint struct {
+	Subject      string         `json:"subject"`
+	SubjectKeyID string         `json:"subject_key_id"`
+	URL          string         `json:"url"`
+	Signer       sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgAddCrlDistributionPoint(subject string, subjectKeyID string, crlURL string,
+	signer sdk.AccAddress) MsgAddCrlDistributionPoint {
+	return MsgAddCrlDistributionPoint{
+		Subject:      subject,
+		SubjectKeyID: subjectKeyID,
+		URL:          crlURL,
+		Signer:       signer,
+	}
+}
+
+func (m MsgAddCrlDistributionPoint) Route() string {
+	return RouterKey
+}
+
+func (m MsgAddCrlDistributionPoint) Type() string {
+	return "add_crl_distribution_point"
+}
+
+func (m MsgAddCrlDistributionPoint) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if len(m.Subject) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Subject: it cannot be empty")
+	}
+
+	if len(m.SubjectKeyID) == 0 {
+		return sdk.ErrUnknownRequest("Invalid SubjectKeyID: it cannot be empty")
+	}
+
+	return validateCrlDistributionPointURL(m.URL)
+}
+
+func (m MsgAddCrlDistributionPoint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgAddCrlDistributionPoint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+/*
+	REMOVE_CRL_DISTRIBUTION_POINT
+*/
+
+type MsgRemoveCrlDistributionPoint struct {
+	Subject      string         `json:"subject"`
+	SubjectKeyID string         `json:"subject_key_id"`
+	URL          string         `json:"url"`
+	Signer       sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgRemoveCrlDistributionPoint(subject string, subjectKeyID string, crlURL string,
+	signer sdk.AccAddress) MsgRemoveCrlDistributionPoint {
+	return MsgRemoveCrlDistributionPoint{
+		Subject:      subject,
+		SubjectKeyID: subjectKeyID,
+		URL:          crlURL,
+		Signer:       signer,
+	}
+}
+
+func (m MsgRemoveCrlDistributionPoint) Route() string {
+	return RouterKey
+}
+
+func (m MsgRemoveCrlDistributionPoint) Type() string {
+	return "remove_crl_distribution_point"
+}
+
+func (m MsgRemoveCrlDistributionPoint) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if len(m.Subject) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Subject: it cannot be empty")
+	}
+
+	if len(m.SubjectKeyID) == 0 {
+		return sdk.ErrUnknownRequest("Invalid SubjectKeyID: it cannot be empty")
+	}
+
+	return validateCrlDistributionPointURL(m.URL)
+}
+
+func (m MsgRemoveCrlDistributionPoint) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgRemoveCrlDistributionPoint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+// CRL distribution point must be an absolute http(s) URL.
+func validateCrlDistributionPointURL(rawURL string) sdk.Error {
+	if len(rawURL) == 0 {
+		return sdk.ErrUnknownRequest("Invalid URL: it cannot be empty")
+	}
+
+	parsedURL, err := url.ParseRequestURI(rawURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || len(parsedURL.Host) == 0 {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid URL: \"%s\". It must be an absolute http(s) URL", rawURL))
+	}
+
+	return nil
+}
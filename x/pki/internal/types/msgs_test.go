@@ -16,6 +16,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -23,6 +24,11 @@ import (
 	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
 )
 
+const (
+	testCrlURL          = "http://example.com/crl/root.crl"
+	testRejectionReason = "Invalid certificate"
+)
+
 /*
 	MsgProposeAddX509RootCert
 */
@@ -59,6 +65,18 @@ func TestValidateMsgProposeAddX509RootCert(t *testing.T) {
 	}
 }
 
+func TestValidateMsgProposeAddX509RootCert_Class(t *testing.T) {
+	msg := NewMsgProposeAddX509RootCert(testconstants.RootCertPem, testconstants.Signer)
+	require.Equal(t, DefaultCertificateClass, msg.CertificateClass())
+
+	msg.Class = NocCertificateClass
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, NocCertificateClass, msg.CertificateClass())
+
+	msg.Class = "operational"
+	require.Equal(t, CodeInvalidCertificateClass, msg.ValidateBasic().Code())
+}
+
 func TestMsgProposeAddX509RootCertGetSignBytes(t *testing.T) {
 	msg := NewMsgProposeAddX509RootCert(testconstants.StubCertPem, testconstants.Signer)
 	res := msg.GetSignBytes()
@@ -119,6 +137,59 @@ func TestMsgApproveAddX509RootCertGetSignBytes(t *testing.T) {
 	require.Equal(t, expected, string(msg.GetSignBytes()))
 }
 
+/*
+	MsgRejectAddX509RootCert
+*/
+
+func TestNewMsgRejectAddX509RootCert(t *testing.T) {
+	msg := NewMsgRejectAddX509RootCert(testconstants.RootSubject,
+		testconstants.RootSubjectKeyID, testRejectionReason, testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "reject_add_x509_root_cert")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestValidateMsgRejectAddX509RootCert(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgRejectAddX509RootCert
+	}{
+		{true, NewMsgRejectAddX509RootCert(
+			testconstants.RootSubject, testconstants.RootSubjectKeyID, testRejectionReason, testconstants.Signer)},
+		{false, NewMsgRejectAddX509RootCert(
+			"", testconstants.RootSubjectKeyID, testRejectionReason, testconstants.Signer)},
+		{false, NewMsgRejectAddX509RootCert(
+			testconstants.RootSubject, "", testRejectionReason, testconstants.Signer)},
+		{false, NewMsgRejectAddX509RootCert(
+			testconstants.RootSubject, testconstants.RootSubjectKeyID, "", testconstants.Signer)},
+		{false, NewMsgRejectAddX509RootCert(
+			testconstants.RootSubject, testconstants.RootSubjectKeyID, testRejectionReason, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestMsgRejectAddX509RootCertGetSignBytes(t *testing.T) {
+	msg := NewMsgRejectAddX509RootCert(testconstants.RootSubject,
+		testconstants.RootSubjectKeyID, testRejectionReason, testconstants.Signer)
+
+	expected := `{"type":"pki/RejectAddX509RootCert","value":{` +
+		`"reason":"` + testRejectionReason + `",` +
+		`"signer":"cosmos1p72j8mgkf39qjzcmr283w8l8y9qv30qpj056uz",` +
+		`"subject":"` + testconstants.RootSubject + `",` +
+		`"subject_key_id":"` + testconstants.RootSubjectKeyID + `"}}`
+	require.Equal(t, expected, string(msg.GetSignBytes()))
+}
+
 /*
 	MsgAddX509Cert
 */
@@ -142,6 +213,8 @@ func TestValidateMsgAddX509Cert(t *testing.T) {
 			"", testconstants.Signer)},
 		{false, NewMsgAddX509Cert(
 			testconstants.LeafCertPem, nil)},
+		{false, NewMsgAddX509Cert(
+			strings.Repeat("a", MaxCertificateSize+1), testconstants.Signer)},
 	}
 
 	for _, tc := range cases {
@@ -208,7 +281,7 @@ func TestMsgProposeRevokeX509RootCertGetSignBytes(t *testing.T) {
 	msg := NewMsgProposeRevokeX509RootCert(testconstants.RootSubject,
 		testconstants.RootSubjectKeyID, testconstants.Signer)
 
-	expected := `{"type":"pki/ProposeRevokeX509RootCert","value":{` +
+	expected := `{"type":"pki/ProposeRevokeX509RootCert","value":{"expected_sequence":"0",` +
 		`"signer":"cosmos1p72j8mgkf39qjzcmr283w8l8y9qv30qpj056uz",` +
 		`"subject":"` + testconstants.RootSubject + `",` +
 		`"subject_key_id":"` + testconstants.RootSubjectKeyID + `"}}`
@@ -303,9 +376,110 @@ func TestMsgRevokeX509CertGetSignBytes(t *testing.T) {
 	msg := NewMsgRevokeX509Cert(testconstants.LeafSubject, testconstants.LeafSubjectKeyID, testconstants.Signer)
 	res := msg.GetSignBytes()
 
-	expected := `{"type":"pki/RevokeX509Cert","value":{` +
+	expected := `{"type":"pki/RevokeX509Cert","value":{"expected_sequence":"0",` +
 		`"signer":"cosmos1p72j8mgkf39qjzcmr283w8l8y9qv30qpj056uz",` +
 		`"subject":"` + testconstants.LeafSubject + `",` +
 		`"subject_key_id":"` + testconstants.LeafSubjectKeyID + `"}}`
 	require.Equal(t, expected, string(res))
 }
+
+/*
+	MsgAddCrlDistributionPoint
+*/
+
+func TestNewMsgAddCrlDistributionPoint(t *testing.T) {
+	msg := NewMsgAddCrlDistributionPoint(testconstants.RootSubject, testconstants.RootSubjectKeyID,
+		testCrlURL, testconstants.Signer)
+
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, "add_crl_distribution_point", msg.Type())
+	require.Equal(t, []sdk.AccAddress{testconstants.Signer}, msg.GetSigners())
+}
+
+func TestValidateMsgAddCrlDistributionPoint(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgAddCrlDistributionPoint
+	}{
+		{true, NewMsgAddCrlDistributionPoint(testconstants.RootSubject, testconstants.RootSubjectKeyID,
+			testCrlURL, testconstants.Signer)},
+		{true, NewMsgAddCrlDistributionPoint(testconstants.RootSubject, testconstants.RootSubjectKeyID,
+			"https://example.com/crl", testconstants.Signer)},
+		{false, NewMsgAddCrlDistributionPoint("", testconstants.RootSubjectKeyID,
+			testCrlURL, testconstants.Signer)},
+		{false, NewMsgAddCrlDistributionPoint(testconstants.RootSubject, "",
+			testCrlURL, testconstants.Signer)},
+		{false, NewMsgAddCrlDistributionPoint(testconstants.RootSubject, testconstants.RootSubjectKeyID,
+			"", testconstants.Signer)},
+		{false, NewMsgAddCrlDistributionPoint(testconstants.RootSubject, testconstants.RootSubjectKeyID,
+			"ftp://example.com/root.crl", testconstants.Signer)},
+		{false, NewMsgAddCrlDistributionPoint(testconstants.RootSubject, testconstants.RootSubjectKeyID,
+			"/crl/root.crl", testconstants.Signer)},
+		{false, NewMsgAddCrlDistributionPoint(testconstants.RootSubject, testconstants.RootSubjectKeyID,
+			testCrlURL, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestMsgAddCrlDistributionPointGetSignBytes(t *testing.T) {
+	msg := NewMsgAddCrlDistributionPoint(testconstants.RootSubject, testconstants.RootSubjectKeyID,
+		testCrlURL, testconstants.Signer)
+	res := msg.GetSignBytes()
+
+	expected := `{"type":"pki/AddCrlDistributionPoint","value":{` +
+		`"signer":"cosmos1p72j8mgkf39qjzcmr283w8l8y9qv30qpj056uz",` +
+		`"subject":"` + testconstants.RootSubject + `",` +
+		`"subject_key_id":"` + testconstants.RootSubjectKeyID + `",` +
+		`"url":"` + testCrlURL + `"}}`
+	require.Equal(t, expected, string(res))
+}
+
+/*
+	MsgRemoveCrlDistributionPoint
+*/
+
+func TestNewMsgRemoveCrlDistributionPoint(t *testing.T) {
+	msg := NewMsgRemoveCrlDistributionPoint(testconstants.RootSubject, testconstants.RootSubjectKeyID,
+		testCrlURL, testconstants.Signer)
+
+	require.Equal(t, RouterKey, msg.Route())
+	require.Equal(t, "remove_crl_distribution_point", msg.Type())
+	require.Equal(t, []sdk.AccAddress{testconstants.Signer}, msg.GetSigners())
+}
+
+func TestValidateMsgRemoveCrlDistributionPoint(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgRemoveCrlDistributionPoint
+	}{
+		{true, NewMsgRemoveCrlDistributionPoint(testconstants.RootSubject, testconstants.RootSubjectKeyID,
+			testCrlURL, testconstants.Signer)},
+		{false, NewMsgRemoveCrlDistributionPoint("", testconstants.RootSubjectKeyID,
+			testCrlURL, testconstants.Signer)},
+		{false, NewMsgRemoveCrlDistributionPoint(testconstants.RootSubject, "",
+			testCrlURL, testconstants.Signer)},
+		{false, NewMsgRemoveCrlDistributionPoint(testconstants.RootSubject, testconstants.RootSubjectKeyID,
+			"", testconstants.Signer)},
+		{false, NewMsgRemoveCrlDistributionPoint(testconstants.RootSubject, testconstants.RootSubjectKeyID,
+			testCrlURL, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
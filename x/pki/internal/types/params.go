@@ -0,0 +1,74 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
+)
+
+/*
+	Parameters of the module stored in KVStore (can be set via genesis)
+*/
+type Params struct {
+	// the number of approvals from accounts with `RootCertificateApprovalRole` required
+	// to add (or to reject adding) a root certificate and to revoke a root certificate
+	RootCertificateApprovals int `json:"root_certificate_approvals"`
+	// the number of blocks after which the proposed root certificates not approved (or rejected)
+	// by enough accounts are deleted. 0 means the proposed root certificates never expire.
+	RootCertificateProposalExpirationPeriod int64 `json:"root_certificate_proposal_expiration_period"`
+}
+
+func NewParams(rootCertificateApprovals int) Params {
+	return Params{
+		RootCertificateApprovals:                rootCertificateApprovals,
+		RootCertificateProposalExpirationPeriod: approvals.NoExpiration,
+	}
+}
+
+func DefaultParams() Params {
+	return NewParams(DefaultRootCertificateApprovals)
+}
+
+func (p Params) Validate() error {
+	if p.RootCertificateApprovals <= 0 {
+		return fmt.Errorf("invalid RootCertificateApprovals: it must be positive, got %v", p.RootCertificateApprovals)
+	}
+
+	if p.RootCertificateProposalExpirationPeriod < 0 {
+		return fmt.Errorf("invalid RootCertificateProposalExpirationPeriod: it must be non-negative, got %v",
+			p.RootCertificateProposalExpirationPeriod)
+	}
+
+	return nil
+}
+
+// RootCertificateApprovalPolicy returns the policy used to vote for root certificate proposals.
+// Only the proposed root certificates expire, the proposed revocations stay pending until they are approved.
+func (p Params) RootCertificateApprovalPolicy() approvals.Policy {
+	return approvals.NewPolicy(approvals.FixedQuorum(p.RootCertificateApprovals),
+		p.RootCertificateProposalExpirationPeriod)
+}
+
+func (p Params) String() string {
+	bytes, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
@@ -25,9 +25,17 @@ import (
 */
 
 // Request Payload for PKI queries (pagination and filters).
+// Fields the approved certificates list queries can be sorted by.
+const SortByExpiry = "expiry"
+
+var CertificateSortFields = []string{SortByExpiry}
+
 type PkiQueryParams struct {
 	Skip             int
 	Take             int
+	Key              string
+	Sort             string
+	Order            string
 	RootSubject      string
 	RootSubjectKeyID string
 }
@@ -37,11 +45,18 @@ func NewPkiQueryParams(pagination pagination.PaginationParams,
 	return PkiQueryParams{
 		Skip:             pagination.Skip,
 		Take:             pagination.Take,
+		Key:              pagination.Key,
+		Sort:             pagination.Sort,
+		Order:            pagination.Order,
 		RootSubject:      rootSubject,
 		RootSubjectKeyID: rootSubjectKeyID,
 	}
 }
 
+func (p PkiQueryParams) PaginationParams() pagination.PaginationParams {
+	return pagination.PaginationParams{Skip: p.Skip, Take: p.Take, Key: p.Key, Sort: p.Sort, Order: p.Order}
+}
+
 /*
 	Result Payload
 */
@@ -49,8 +64,10 @@ func NewPkiQueryParams(pagination pagination.PaginationParams,
 // Result Payload for QueryAllX509Certs / QueryAllX509RootCerts / QueryAllSubjectX509Certs /
 // QueryAllRevokedX509Certs / QueryAllRevokedX509RootCerts queries.
 type ListCertificates struct {
-	Total int           `json:"total"`
-	Items []Certificate `json:"items"`
+	Total   int           `json:"total"`
+	Items   []Certificate `json:"items"`
+	NextKey string        `json:"next_key"`
+	PrevKey string        `json:"prev_key"`
 }
 
 func NewListCertificates() ListCertificates {
@@ -72,8 +89,10 @@ func (n ListCertificates) String() string {
 
 // Result Payload for QueryAllProposedX509RootCerts query.
 type ListProposedCertificates struct {
-	Total int                   `json:"total"`
-	Items []ProposedCertificate `json:"items"`
+	Total   int                   `json:"total"`
+	Items   []ProposedCertificate `json:"items"`
+	NextKey string                `json:"next_key"`
+	PrevKey string                `json:"prev_key"`
 }
 
 func NewListProposedCertificates() ListProposedCertificates {
@@ -95,8 +114,10 @@ func (n ListProposedCertificates) String() string {
 
 // Result Payload for QueryAllProposedX509RootCertRevocations query.
 type ListProposedCertificateRevocations struct {
-	Total int                             `json:"total"`
-	Items []ProposedCertificateRevocation `json:"items"`
+	Total   int                             `json:"total"`
+	Items   []ProposedCertificateRevocation `json:"items"`
+	NextKey string                          `json:"next_key"`
+	PrevKey string                          `json:"prev_key"`
 }
 
 func NewListProposedCertificateRevocations() ListProposedCertificateRevocations {
@@ -115,3 +136,28 @@ func (n ListProposedCertificateRevocations) String() string {
 
 	return string(res)
 }
+
+// Result Payload for QueryAllCrlDistributionPoints query.
+type ListCrlDistributionPoints struct {
+	Total   int                     `json:"total"`
+	Items   []CrlDistributionPoints `json:"items"`
+	NextKey string                  `json:"next_key"`
+	PrevKey string                  `json:"prev_key"`
+}
+
+func NewListCrlDistributionPoints() ListCrlDistributionPoints {
+	return ListCrlDistributionPoints{
+		Total: 0,
+		Items: []CrlDistributionPoints{},
+	}
+}
+
+// Implement fmt.Stringer.
+func (n ListCrlDistributionPoints) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
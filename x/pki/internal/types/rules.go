@@ -17,8 +17,9 @@ package types
 import "github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
 
 // nolint:godox
-// TODO: 1. Move it to separate module  	2. Make it configurable		3. Save into store.
+// TODO: Move it to separate module.
 var (
-	RootCertificateApprovals    = 2
-	RootCertificateApprovalRole = auth.Trustee
+	// the actual value is stored in the module Params (see `Keeper.GetParams`).
+	DefaultRootCertificateApprovals = 2
+	RootCertificateApprovalRole     = auth.Trustee
 )
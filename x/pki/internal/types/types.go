@@ -16,17 +16,37 @@ package types
 
 import (
 	"encoding/json"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
 )
 
 type CertificateType string
 
+// Class of the certificates originated from a root certificate, it is chosen when the root certificate is proposed.
+// Attestation certificates form PAA (root) -> PAI (intermediate) -> DAC (leaf) chains,
+// the other (e.g. operational) certificates are NOC ones.
+type CertificateClass string
+
+const (
+	AttestationCertificateClass CertificateClass = "attestation"
+	NocCertificateClass         CertificateClass = "noc"
+
+	// the class of root certificates proposed without a class and the certificates stored before classes were added
+	DefaultCertificateClass = AttestationCertificateClass
+)
+
+func (c CertificateClass) IsValid() bool {
+	return c == AttestationCertificateClass || c == NocCertificateClass
+}
+
 /*
 	Approved Root / Intermediate / Leaf certificates stored in KVStore and matching to the same key
 */
 type Certificates struct {
-	Items []Certificate `json:"items"`
+	Items    []Certificate `json:"items"`
+	Sequence uint64        `json:"sequence"` // incremented on every change of an existing record
 }
 
 func NewCertificates(items []Certificate) Certificates {
@@ -58,6 +78,15 @@ type Certificate struct {
 	RootSubjectKeyID string         `json:"root_subject_key_id,omitempty"`
 	IsRoot           bool           `json:"is_root"`
 	Owner            sdk.AccAddress `json:"owner"`
+	// the class of the root certificate the certificate originated from
+	Class CertificateClass `json:"class"`
+	// the end of the certificate validity period, the certificate is moved to the expired ones after it.
+	NotAfter time.Time `json:"not_after"`
+	// set for revoked certificates only: the certificate which revocation caused this certificate to be revoked
+	// (either the certificate itself or one of its ancestors in the chain).
+	RevokedBy *CertificateIdentifier `json:"revoked_by,omitempty"`
+	// set for revoked certificates only: the time of the block in which the certificate was revoked.
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
 }
 
 func NewRootCertificate(pemCert string, subject string, subjectKeyID string,
@@ -68,6 +97,7 @@ func NewRootCertificate(pemCert string, subject string, subjectKeyID string,
 		SubjectKeyID: subjectKeyID,
 		SerialNumber: serialNumber,
 		IsRoot:       true,
+		Class:        DefaultCertificateClass,
 		Owner:        owner,
 	}
 }
@@ -87,6 +117,7 @@ func NewNonRootCertificate(pemCert string, subject string, subjectKeyID string,
 		RootSubjectKeyID: rootSubjectKeyID,
 		IsRoot:           false,
 		Owner:            owner,
+		Class:            DefaultCertificateClass,
 	}
 }
 
@@ -99,6 +130,15 @@ func (d Certificate) String() string {
 	return string(bytes)
 }
 
+// Returns the issuer of the certificate. Root certificates are self-signed, so their issuer is the subject.
+func (d Certificate) GetIssuer() string {
+	if d.IsRoot {
+		return d.Subject
+	}
+
+	return d.Issuer
+}
+
 /*
 	Proposed (but not Approved yet) Root certificate stored in KVStore
 */
@@ -107,8 +147,12 @@ type ProposedCertificate struct {
 	Subject      string           `json:"subject"`
 	SubjectKeyID string           `json:"subject_key_id"`
 	SerialNumber string           `json:"serial_number"`
+	Class        CertificateClass `json:"class"`
 	Owner        sdk.AccAddress   `json:"owner"`
 	Approvals    []sdk.AccAddress `json:"approvals"`
+	Rejections   []Rejection      `json:"rejections"`
+	// the height the certificate was proposed at (the proposal expires after the expiration period)
+	ProposedAt int64 `json:"proposed_at,omitempty"`
 }
 
 func NewProposedCertificate(pemCert string, subject string, subjectKeyID string,
@@ -118,8 +162,10 @@ func NewProposedCertificate(pemCert string, subject string, subjectKeyID string,
 		Subject:      subject,
 		SubjectKeyID: subjectKeyID,
 		SerialNumber: serialNumber,
+		Class:        DefaultCertificateClass,
 		Owner:        owner,
 		Approvals:    []sdk.AccAddress{},
+		Rejections:   []Rejection{},
 	}
 }
 
@@ -132,9 +178,18 @@ func (d ProposedCertificate) String() string {
 	return string(bytes)
 }
 
+// Implements approvals.Proposal.
+func (d ProposedCertificate) GetProposedAt() int64 {
+	return d.ProposedAt
+}
+
 func (d ProposedCertificate) HasApprovalFrom(address sdk.Address) bool {
-	for _, approval := range d.Approvals {
-		if approval.Equals(address) {
+	return approvals.HasApprovalFrom(d.Approvals, address)
+}
+
+func (d ProposedCertificate) HasRejectionFrom(address sdk.Address) bool {
+	for _, rejection := range d.Rejections {
+		if rejection.Address.Equals(address) {
 			return true
 		}
 	}
@@ -142,6 +197,21 @@ func (d ProposedCertificate) HasApprovalFrom(address sdk.Address) bool {
 	return false
 }
 
+/*
+	Rejection of Proposed Root certificate given by an account with `RootCertificateApprovalRole`
+*/
+type Rejection struct {
+	Address sdk.AccAddress `json:"address"`
+	Reason  string         `json:"reason"`
+}
+
+func NewRejection(address sdk.AccAddress, reason string) Rejection {
+	return Rejection{
+		Address: address,
+		Reason:  reason,
+	}
+}
+
 /*
 	The list of certificates issued by a given issuer
 */
@@ -168,6 +238,97 @@ func (d ChildCertificates) String() string {
 	return string(bytes)
 }
 
+/*
+	The list of approved certificates with a given subject key id
+*/
+type SubjectKeyIDCertificates struct {
+	SubjectKeyID    string                  `json:"subject_key_id"`
+	CertIdentifiers []CertificateIdentifier `json:"cert_identifiers"`
+}
+
+func NewSubjectKeyIDCertificates(subjectKeyID string) SubjectKeyIDCertificates {
+	return SubjectKeyIDCertificates{
+		SubjectKeyID:    subjectKeyID,
+		CertIdentifiers: []CertificateIdentifier{},
+	}
+}
+
+func (d SubjectKeyIDCertificates) String() string {
+	bytes, err := json.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+/*
+	Entry of the helper index of approved certificates ordered by their expiration time
+*/
+type CertificateExpiration struct {
+	Subject      string    `json:"subject"`
+	SubjectKeyID string    `json:"subject_key_id"`
+	Issuer       string    `json:"issuer"`
+	SerialNumber string    `json:"serial_number"`
+	NotAfter     time.Time `json:"not_after"`
+}
+
+func NewCertificateExpiration(certificate Certificate) CertificateExpiration {
+	return CertificateExpiration{
+		Subject:      certificate.Subject,
+		SubjectKeyID: certificate.SubjectKeyID,
+		Issuer:       certificate.GetIssuer(),
+		SerialNumber: certificate.SerialNumber,
+		NotAfter:     certificate.NotAfter,
+	}
+}
+
+func (d CertificateExpiration) String() string {
+	bytes, err := json.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+/*
+	Status of the certificate with a given Issuer/SerialNumber combination
+*/
+const (
+	CertificateStatusGood    = "good"
+	CertificateStatusRevoked = "revoked"
+	CertificateStatusExpired = "expired"
+	CertificateStatusUnknown = "unknown"
+)
+
+type CertificateStatus struct {
+	Issuer       string `json:"issuer"`
+	SerialNumber string `json:"serial_number"`
+	Status       string `json:"status"`
+	// the certificate record, set unless the status is unknown
+	Certificate *Certificate `json:"certificate,omitempty"`
+}
+
+func NewCertificateStatus(issuer string, serialNumber string, status string,
+	certificate *Certificate) CertificateStatus {
+	return CertificateStatus{
+		Issuer:       issuer,
+		SerialNumber: serialNumber,
+		Status:       status,
+		Certificate:  certificate,
+	}
+}
+
+func (d CertificateStatus) String() string {
+	bytes, err := json.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
 /*
 	Composed identifier for certificates
 */
@@ -212,8 +373,43 @@ func (d ProposedCertificateRevocation) String() string {
 }
 
 func (d ProposedCertificateRevocation) HasApprovalFrom(address sdk.Address) bool {
-	for _, approval := range d.Approvals {
-		if approval.Equals(address) {
+	return approvals.HasApprovalFrom(d.Approvals, address)
+}
+
+/*
+	CRL Distribution Points published for an approved Root / Intermediate certificate
+*/
+type CrlDistributionPoints struct {
+	Subject          string   `json:"subject"`
+	SubjectKeyID     string   `json:"subject_key_id"`
+	RootSubject      string   `json:"root_subject"`
+	RootSubjectKeyID string   `json:"root_subject_key_id"`
+	URLs             []string `json:"urls"`
+}
+
+func NewCrlDistributionPoints(subject string, subjectKeyID string,
+	rootSubject string, rootSubjectKeyID string) CrlDistributionPoints {
+	return CrlDistributionPoints{
+		Subject:          subject,
+		SubjectKeyID:     subjectKeyID,
+		RootSubject:      rootSubject,
+		RootSubjectKeyID: rootSubjectKeyID,
+		URLs:             []string{},
+	}
+}
+
+func (d CrlDistributionPoints) String() string {
+	bytes, err := json.Marshal(d)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+func (d CrlDistributionPoints) HasURL(url string) bool {
+	for _, existingURL := range d.URLs {
+		if existingURL == url {
 			return true
 		}
 	}
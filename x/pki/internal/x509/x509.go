@@ -16,14 +16,21 @@ package x509
 
 import (
 	"crypto/x509"
+	"encoding/base64"
 	"encoding/pem"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/internal/types"
 )
 
+const (
+	pemBegin           = "-----BEGIN"
+	pemCertificateType = "CERTIFICATE"
+)
+
 type X509Certificate struct {
 	Issuer         string
 	SerialNumber   string
@@ -56,6 +63,66 @@ func DecodeX509Certificate(pemCertificate string) (*X509Certificate, sdk.Error)
 	return &certificate, nil
 }
 
+// Splits the certificates data into PEM encoded certificates. The data is either a bundle of one or more
+// PEM encoded certificates (e.g. a chain exported from a CA tooling) or a base64 encoded DER certificate.
+func SplitCertificates(data string) ([]string, sdk.Error) {
+	if !strings.Contains(data, pemBegin) {
+		der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(data), ""))
+		if err != nil {
+			return nil, types.ErrCodeInvalidCertificate(
+				"Could not decode certificate: it must be either PEM encoded or base64 encoded DER")
+		}
+
+		return []string{string(pem.EncodeToMemory(&pem.Block{Type: pemCertificateType, Bytes: der}))}, nil
+	}
+
+	var blocks []*pem.Block
+
+	for rest := []byte(data); len(strings.TrimSpace(string(rest))) > 0; {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, types.ErrCodeInvalidCertificate("Could not decode pem certificate")
+		}
+
+		if block.Type != pemCertificateType {
+			return nil, types.ErrCodeInvalidCertificate(
+				fmt.Sprintf("Could not decode pem certificate: unexpected block type %v", block.Type))
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	// a single certificate is kept as is
+	if len(blocks) == 1 {
+		return []string{data}, nil
+	}
+
+	certificates := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		certificates = append(certificates, string(pem.EncodeToMemory(block)))
+	}
+
+	return certificates, nil
+}
+
+// Converts the content of a certificate file to the certificates data accepted by SplitCertificates:
+// PEM encoded and base64 encoded content is returned as is, binary DER content is base64 encoded.
+func CertificatesDataFromFile(content []byte) string {
+	text := string(content)
+
+	if strings.Contains(text, pemBegin) {
+		return text
+	}
+
+	if _, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(text), "")); err == nil {
+		return text
+	}
+
+	return base64.StdEncoding.EncodeToString(content)
+}
+
 func BytesToHex(bytes []byte) string {
 	if bytes == nil {
 		return ""
@@ -69,11 +136,33 @@ func BytesToHex(bytes []byte) string {
 	return strings.Join(bytesHex, ":")
 }
 
-func (c X509Certificate) Verify(parent *X509Certificate) sdk.Error {
+// Verifies the certificate against its parent (issuer) certificate at the given time: the signature, the validity
+// periods of both certificates and the Basic Constraints, Key Usage and path length constraint of the parent.
+// `intermediates` is the number of intermediate certificates which follow the parent in the chain being built.
+func (c X509Certificate) Verify(parent *X509Certificate, currentTime time.Time, intermediates int) sdk.Error {
+	if !parent.Certificate.BasicConstraintsValid || !parent.Certificate.IsCA {
+		return types.ErrCodeInvalidCertificate(fmt.Sprintf("Certificate verification failed. Error: "+
+			"issuer certificate with subject=%v is not a CA certificate", parent.Subject))
+	}
+
+	if parent.Certificate.KeyUsage != 0 && parent.Certificate.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return types.ErrCodeInvalidCertificate(fmt.Sprintf("Certificate verification failed. Error: "+
+			"issuer certificate with subject=%v is not allowed to sign certificates", parent.Subject))
+	}
+
+	if parent.Certificate.MaxPathLen >= 0 && intermediates > parent.Certificate.MaxPathLen {
+		return types.ErrCodeInvalidCertificate(fmt.Sprintf("Certificate verification failed. Error: "+
+			"path length constraint of issuer certificate with subject=%v is exceeded", parent.Subject))
+	}
+
 	roots := x509.NewCertPool()
 	roots.AddCert(parent.Certificate)
 
-	opts := x509.VerifyOptions{Roots: roots}
+	opts := x509.VerifyOptions{
+		Roots:       roots,
+		CurrentTime: currentTime,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
 
 	if _, err := c.Certificate.Verify(opts); err != nil {
 		return types.ErrCodeInvalidCertificate(fmt.Sprintf("Certificate verification failed. Error: %v", err))
@@ -82,6 +171,10 @@ func (c X509Certificate) Verify(parent *X509Certificate) sdk.Error {
 	return nil
 }
 
+func (c X509Certificate) IsCA() bool {
+	return c.Certificate.BasicConstraintsValid && c.Certificate.IsCA
+}
+
 func (c X509Certificate) IsSelfSigned() bool {
 	if len(c.AuthorityKeyID) > 0 {
 		return c.Issuer == c.Subject && c.AuthorityKeyID == c.SubjectKeyID
@@ -27,14 +27,25 @@ import (
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/client/cli"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/pki/client/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade"
 )
 
 // type check to ensure the interface is properly implemented.
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule             = AppModule{}
+	_ module.AppModuleBasic        = AppModuleBasic{}
+	_ upgrade.HasConsensusVersion  = AppModule{}
+	_ upgrade.HasMigrations        = AppModule{}
+	_ upgrade.HasGenesisMigrations = AppModule{}
 )
 
+// Consensus version of the module store schema.
+// Version 2 adds the Subject Key ID and Serial Number indexes of approved certificates.
+// Version 3 adds the expiration time of certificates and the Expiration index of approved certificates.
+// Version 4 adds the class of certificates.
+// Version 6 adds the proposal height of proposed root certificates and the index of them by it.
+const ConsensusVersion = 6
+
 // app module Basics object.
 type AppModuleBasic struct{}
 
@@ -121,6 +132,55 @@ func (a AppModule) NewQuerierHandler() sdk.Querier {
 
 func (a AppModule) BeginBlock(sdk.Context, abci.RequestBeginBlock) {}
 
-func (a AppModule) EndBlock(sdk.Context, abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (a AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	a.keeper.EndBlocker(ctx)
+
 	return []abci.ValidatorUpdate{}
 }
+
+func (a AppModule) ConsensusVersion() uint64 {
+	return ConsensusVersion
+}
+
+func (a AppModule) RegisterMigrations(registrar upgrade.MigrationRegistrar) {
+	// version 1 -> 2: build the indexes for the certificates approved before they were introduced
+	registrar.RegisterMigration(ModuleName, 1, func(ctx sdk.Context) error {
+		a.keeper.RebuildCertificateIndexes(ctx)
+
+		return nil
+	})
+
+	// version 2 -> 3: fill the expiration time of the certificates approved before it was tracked
+	registrar.RegisterMigration(ModuleName, 2, func(ctx sdk.Context) error {
+		return a.keeper.SetCertificatesExpiration(ctx)
+	})
+
+	// version 3 -> 4: all the certificates stored before the classes were introduced are attestation ones
+	registrar.RegisterMigration(ModuleName, 3, func(ctx sdk.Context) error {
+		a.keeper.SetCertificatesClass(ctx, DefaultCertificateClass)
+
+		return nil
+	})
+
+	// version 4 -> 5: index the revoked and expired certificates by Issuer/SerialNumber
+	registrar.RegisterMigration(ModuleName, 4, func(ctx sdk.Context) error {
+		a.keeper.RebuildCertificateIndexes(ctx)
+
+		return nil
+	})
+
+	// version 5 -> 6: the root certificates proposed before their proposal height was tracked
+	// are considered proposed at the migration height
+	registrar.RegisterMigration(ModuleName, 5, func(ctx sdk.Context) error {
+		a.keeper.SetProposedCertificatesProposedAt(ctx)
+
+		return nil
+	})
+}
+
+func (a AppModule) RegisterGenesisMigrations(registrar upgrade.GenesisMigrationRegistrar) {
+	// version 3 -> 4: all the certificates exported before the classes were introduced are attestation ones
+	registrar.RegisterGenesisMigration(ModuleName, 3, func(state json.RawMessage) (json.RawMessage, error) {
+		return MigrateGenesisCertificatesClass(state, DefaultCertificateClass)
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/keeper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+const (
+	ModuleName                       = types.ModuleName
+	RouterKey                        = types.RouterKey
+	StoreKey                         = types.StoreKey
+	CodeProposedUpgradeAlreadyExists = types.CodeProposedUpgradeAlreadyExists
+	CodeProposedUpgradeDoesNotExist  = types.CodeProposedUpgradeDoesNotExist
+	CodeUpgradeAlreadyApplied        = types.CodeUpgradeAlreadyApplied
+	CodeInvalidUpgradeHeight         = types.CodeInvalidUpgradeHeight
+	CodeUpgradeAlreadyScheduled      = types.CodeUpgradeAlreadyScheduled
+	DefaultModuleVersion             = types.DefaultModuleVersion
+	DefaultParamspace                = types.DefaultParamspace
+)
+
+var (
+	NewKeeper            = keeper.NewKeeper
+	NewQuerier           = keeper.NewQuerier
+	NewPlan              = types.NewPlan
+	NewModuleVersion     = types.NewModuleVersion
+	NewMsgProposeUpgrade = types.NewMsgProposeUpgrade
+	NewMsgApproveUpgrade = types.NewMsgApproveUpgrade
+	NewMsgCancelUpgrade  = types.NewMsgCancelUpgrade
+	NewParams            = types.NewParams
+	DefaultParams        = types.DefaultParams
+	ModuleCdc            = types.ModuleCdc
+	RegisterCodec        = types.RegisterCodec
+)
+
+type (
+	Keeper                    = keeper.Keeper
+	Plan                      = types.Plan
+	ProposedUpgrade           = types.ProposedUpgrade
+	AppliedUpgrade            = types.AppliedUpgrade
+	UpgradeHandler            = types.UpgradeHandler
+	Migration                 = types.Migration
+	MigrationRegistrar        = types.MigrationRegistrar
+	HasConsensusVersion       = types.HasConsensusVersion
+	HasMigrations             = types.HasMigrations
+	GenesisMigration          = types.GenesisMigration
+	HasGenesisMigrations      = types.HasGenesisMigrations
+	GenesisMigrationRegistrar = types.GenesisMigrationRegistrar
+	ModuleVersion             = types.ModuleVersion
+	MsgProposeUpgrade         = types.MsgProposeUpgrade
+	MsgApproveUpgrade         = types.MsgApproveUpgrade
+	MsgCancelUpgrade          = types.MsgCancelUpgrade
+	Params                    = types.Params
+)
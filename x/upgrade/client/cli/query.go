@@ -0,0 +1,180 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	upgradeQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the upgrade module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	upgradeQueryCmd.AddCommand(client.GetCommands(
+		GetCmdQueryUpgradePlan(queryRoute, cdc),
+		GetCmdQueryProposedUpgrade(queryRoute, cdc),
+		GetCmdQueryProposedUpgrades(queryRoute, cdc),
+		GetCmdQueryAppliedUpgrades(queryRoute, cdc),
+		GetCmdQueryModuleVersion(queryRoute, cdc))...)
+
+	return upgradeQueryCmd
+}
+
+// GetCmdQueryUpgradePlan implements the upgrade plan query command.
+func GetCmdQueryUpgradePlan(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan",
+		Short: "Query the scheduled upgrade plan",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			res, height, err := cliCtx.QueryStore(types.UpgradePlanKey, storeName)
+			if err != nil || res == nil {
+				return types.ErrUpgradePlanDoesNotExist()
+			}
+
+			var plan types.Plan
+			cdc.MustUnmarshalBinaryBare(res, &plan)
+
+			return cliCtx.EncodeAndPrintWithHeight(plan, height)
+		},
+	}
+
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	return cmd
+}
+
+// GetCmdQueryProposedUpgrade implements the proposed upgrade query command.
+func GetCmdQueryProposedUpgrade(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposed-upgrade",
+		Short: "Query a proposed but not approved upgrade",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			name := viper.GetString(FlagName)
+
+			res, height, err := cliCtx.QueryStore(types.GetProposedUpgradeKey(name), storeName)
+			if err != nil || res == nil {
+				return types.ErrProposedUpgradeDoesNotExist(name)
+			}
+
+			var proposedUpgrade types.ProposedUpgrade
+			cdc.MustUnmarshalBinaryBare(res, &proposedUpgrade)
+
+			return cliCtx.EncodeAndPrintWithHeight(proposedUpgrade, height)
+		},
+	}
+
+	cmd.Flags().String(FlagName, "", "The name of the upgrade")
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	_ = cmd.MarkFlagRequired(FlagName)
+
+	return cmd
+}
+
+// GetCmdQueryProposedUpgrades implements the query all proposed upgrades command.
+func GetCmdQueryProposedUpgrades(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-proposed-upgrades",
+		Short: "Query for all proposed but not approved upgrades",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/proposed_upgrades", storeName), params)
+		},
+	}
+
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of upgrades to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of upgrades to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
+
+// GetCmdQueryAppliedUpgrades implements the query all applied upgrades command.
+func GetCmdQueryAppliedUpgrades(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-applied-upgrades",
+		Short: "Query for all upgrades applied on the ledger",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/applied_upgrades", storeName), params)
+		},
+	}
+
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of upgrades to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of upgrades to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
+
+// GetCmdQueryModuleVersion implements the module store version query command.
+func GetCmdQueryModuleVersion(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-version",
+		Short: "Query the consensus version of the module store",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			module := viper.GetString(FlagModule)
+
+			res, height, err := cliCtx.QueryStore(types.GetModuleVersionKey(module), storeName)
+			if err != nil {
+				return err
+			}
+
+			version := types.NewModuleVersion(module, types.DefaultModuleVersion)
+			if res != nil {
+				cdc.MustUnmarshalBinaryBare(res, &version.Version)
+			}
+
+			return cliCtx.EncodeAndPrintWithHeight(version, height)
+		},
+	}
+
+	cmd.Flags().String(FlagModule, "", "The name of the module")
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	_ = cmd.MarkFlagRequired(FlagModule)
+
+	return cmd
+}
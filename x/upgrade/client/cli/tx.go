@@ -0,0 +1,113 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/cli"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	upgradeTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Upgrade transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	upgradeTxCmd.AddCommand(cli.SignedCommands(client.PostCommands(
+		GetCmdProposeUpgrade(cdc),
+		GetCmdApproveUpgrade(cdc),
+		GetCmdCancelUpgrade(cdc),
+	)...)...)
+
+	return upgradeTxCmd
+}
+
+// GetCmdProposeUpgrade implements the propose upgrade command handler.
+func GetCmdProposeUpgrade(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-upgrade",
+		Short: "Proposes an upgrade plan to be approved by Trustees",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			plan := types.NewPlan(viper.GetString(FlagName), viper.GetInt64(FlagHeight), viper.GetString(FlagInfo))
+
+			msg := types.NewMsgProposeUpgrade(plan, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagName, "", "The name of the upgrade (the new binary registers its upgrade handler by it)")
+	cmd.Flags().Int64(FlagHeight, 0, "The block height at which the upgrade must be performed")
+	cmd.Flags().String(FlagInfo, "", "Optional info about the upgrade (e.g. the location of the new binary)")
+
+	_ = cmd.MarkFlagRequired(FlagName)
+	_ = cmd.MarkFlagRequired(FlagHeight)
+
+	return cmd
+}
+
+// GetCmdApproveUpgrade implements the approve upgrade command handler.
+func GetCmdApproveUpgrade(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-upgrade",
+		Short: "Approves the proposed upgrade plan",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgApproveUpgrade(viper.GetString(FlagName), cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagName, "", "The name of the upgrade")
+
+	_ = cmd.MarkFlagRequired(FlagName)
+
+	return cmd
+}
+
+// GetCmdCancelUpgrade implements the cancel upgrade command handler.
+func GetCmdCancelUpgrade(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-upgrade",
+		Short: "Cancels the proposed or the scheduled upgrade plan",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			msg := types.NewMsgCancelUpgrade(viper.GetString(FlagName), cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagName, "", "The name of the upgrade")
+
+	_ = cmd.MarkFlagRequired(FlagName)
+
+	return cmd
+}
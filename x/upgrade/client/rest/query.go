@@ -0,0 +1,119 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+// HTTP request handler to query the scheduled upgrade plan.
+func getUpgradePlanHandlerFn(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		res, height, err := restCtx.QueryStore(types.UpgradePlanKey, storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrUpgradePlanDoesNotExist())
+
+			return
+		}
+
+		var plan types.Plan
+
+		restCtx.Codec().MustUnmarshalBinaryBare(res, &plan)
+
+		restCtx.EncodeAndRespondWithHeight(plan, height)
+	}
+}
+
+// HTTP request handler to query list of proposed upgrades.
+func getProposedUpgradesHandlerFn(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		paginationParams, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/proposed_upgrades", storeName), paginationParams)
+	}
+}
+
+// HTTP request handler to query the proposed upgrade by its name.
+func getProposedUpgradeHandlerFn(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+		upgradeName := vars[name]
+
+		res, height, err := restCtx.QueryStore(types.GetProposedUpgradeKey(upgradeName), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrProposedUpgradeDoesNotExist(upgradeName))
+
+			return
+		}
+
+		var proposedUpgrade types.ProposedUpgrade
+
+		restCtx.Codec().MustUnmarshalBinaryBare(res, &proposedUpgrade)
+
+		restCtx.EncodeAndRespondWithHeight(proposedUpgrade, height)
+	}
+}
+
+// HTTP request handler to query list of applied upgrades.
+func getAppliedUpgradesHandlerFn(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		paginationParams, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/applied_upgrades", storeName), paginationParams)
+	}
+}
+
+// HTTP request handler to query the consensus version of the module store.
+func getModuleVersionHandlerFn(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+		moduleName := vars[module]
+
+		res, height, err := restCtx.QueryStore(types.GetModuleVersionKey(moduleName), storeName)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		version := types.NewModuleVersion(moduleName, types.DefaultModuleVersion)
+		if res != nil {
+			restCtx.Codec().MustUnmarshalBinaryBare(res, &version.Version)
+		}
+
+		restCtx.EncodeAndRespondWithHeight(version, height)
+	}
+}
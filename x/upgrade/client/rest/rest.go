@@ -0,0 +1,67 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/gorilla/mux"
+)
+
+const (
+	name   = "name"
+	module = "module"
+)
+
+// RegisterRoutes - Central function to define routes that get registered by the main application.
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string) {
+	r.HandleFunc(
+		"/upgrades/plan",
+		getUpgradePlanHandlerFn(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/upgrades/plan/{%s}", name),
+		cancelUpgradeHandlerFn(cliCtx),
+	).Methods("DELETE")
+	r.HandleFunc(
+		"/upgrades/proposed",
+		proposeUpgradeHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/upgrades/proposed",
+		getProposedUpgradesHandlerFn(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/upgrades/proposed/{%s}", name),
+		approveUpgradeHandlerFn(cliCtx),
+	).Methods("PATCH")
+	r.HandleFunc(
+		fmt.Sprintf("/upgrades/proposed/{%s}", name),
+		cancelUpgradeHandlerFn(cliCtx),
+	).Methods("DELETE")
+	r.HandleFunc(
+		fmt.Sprintf("/upgrades/proposed/{%s}", name),
+		getProposedUpgradeHandlerFn(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		"/upgrades/applied",
+		getAppliedUpgradesHandlerFn(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/upgrades/module-versions/{%s}", module),
+		getModuleVersionHandlerFn(cliCtx, storeName),
+	).Methods("GET")
+}
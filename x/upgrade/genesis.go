@@ -0,0 +1,143 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+type GenesisState struct {
+	Plan              *types.Plan             `json:"plan,omitempty"`
+	PlanCancellations []sdk.AccAddress        `json:"plan_cancellations,omitempty"`
+	ProposedUpgrades  []types.ProposedUpgrade `json:"proposed_upgrades"`
+	AppliedUpgrades   []types.AppliedUpgrade  `json:"applied_upgrades"`
+	ModuleVersions    []types.ModuleVersion   `json:"module_versions"`
+	Params            *types.Params           `json:"params,omitempty"` // default params are used if not set
+}
+
+func NewGenesisState() GenesisState {
+	params := types.DefaultParams()
+
+	return GenesisState{
+		ProposedUpgrades: []types.ProposedUpgrade{},
+		AppliedUpgrades:  []types.AppliedUpgrade{},
+		ModuleVersions:   []types.ModuleVersion{},
+		Params:           &params,
+	}
+}
+
+func ValidateGenesis(data GenesisState) error {
+	if data.Params != nil {
+		if err := data.Params.Validate(); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Params: %v", err))
+		}
+	}
+
+	if data.Plan != nil {
+		if err := data.Plan.ValidateBasic(); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Plan: %v. Value: %v", err.Data(), data.Plan))
+		}
+	} else if len(data.PlanCancellations) != 0 {
+		return sdk.ErrUnknownRequest("Invalid PlanCancellations: no plan is scheduled")
+	}
+
+	for _, record := range data.ProposedUpgrades {
+		if err := record.Plan.ValidateBasic(); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ProposedUpgrade: %v. Value: %v", err.Data(), record))
+		}
+
+		if len(record.Approvals) == 0 {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ProposedUpgrade: Missed Approvals. Value: %v", record))
+		}
+	}
+
+	for _, record := range data.AppliedUpgrades {
+		if err := record.Plan.ValidateBasic(); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid AppliedUpgrade: %v. Value: %v", err.Data(), record))
+		}
+	}
+
+	for _, record := range data.ModuleVersions {
+		if len(record.Module) == 0 {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ModuleVersion: Missed Module. Value: %v", record))
+		}
+
+		if record.Version == 0 {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid ModuleVersion: Version must be positive. Value: %v", record))
+		}
+	}
+
+	return nil
+}
+
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState()
+}
+
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) []abci.ValidatorUpdate {
+	if data.Plan != nil {
+		keeper.SetUpgradePlan(ctx, *data.Plan)
+
+		if len(data.PlanCancellations) != 0 {
+			keeper.SetPlanCancellations(ctx, data.PlanCancellations)
+		}
+	}
+
+	for _, record := range data.ProposedUpgrades {
+		keeper.SetProposedUpgrade(ctx, record)
+	}
+
+	for _, record := range data.AppliedUpgrades {
+		keeper.SetAppliedUpgrade(ctx, record)
+	}
+
+	for _, record := range data.ModuleVersions {
+		keeper.SetModuleVersion(ctx, record.Module, record.Version)
+	}
+
+	if data.Params != nil {
+		keeper.SetParams(ctx, *data.Params)
+	}
+
+	return []abci.ValidatorUpdate{}
+}
+
+func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
+	var (
+		plan              *types.Plan
+		planCancellations []sdk.AccAddress
+	)
+
+	if k.IsUpgradePlanPresent(ctx) {
+		scheduledPlan := k.GetUpgradePlan(ctx)
+		plan = &scheduledPlan
+		planCancellations = k.GetPlanCancellations(ctx)
+	}
+
+	params := k.GetParams(ctx)
+
+	return GenesisState{
+		Plan:              plan,
+		PlanCancellations: planCancellations,
+		ProposedUpgrades:  k.GetAllProposedUpgrades(ctx),
+		AppliedUpgrades:   k.GetAllAppliedUpgrades(ctx),
+		ModuleVersions:    k.GetAllModuleVersions(ctx),
+		Params:            &params,
+	}
+}
@@ -0,0 +1,268 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package upgrade
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/keeper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+func init() {
+	// sender must have Trustee role to propose, approve and cancel upgrades
+	auth.RegisterMsgRoles(types.MsgProposeUpgrade{}, auth.Trustee)
+	auth.RegisterMsgRoles(types.MsgApproveUpgrade{}, auth.Trustee)
+	auth.RegisterMsgRoles(types.MsgCancelUpgrade{}, auth.Trustee)
+}
+
+// UpgradeApprovalPolicy defines the share of trustees required to approve an upgrade plan.
+// The share is a parameter of the module, so it can be changed by trustees.
+func UpgradeApprovalPolicy(ctx sdk.Context, k keeper.Keeper) approvals.Policy {
+	return k.GetParams(ctx).UpgradeApprovalPolicy()
+}
+
+func NewHandler(k keeper.Keeper, authKeeper auth.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgProposeUpgrade:
+			return handleMsgProposeUpgrade(ctx, k, authKeeper, msg)
+		case types.MsgApproveUpgrade:
+			return handleMsgApproveUpgrade(ctx, k, authKeeper, msg)
+		case types.MsgCancelUpgrade:
+			return handleMsgCancelUpgrade(ctx, k, authKeeper, msg)
+		default:
+			errMsg := fmt.Sprintf("unrecognized upgrade Msg type: %v", msg.Type())
+
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgProposeUpgrade(ctx sdk.Context, k keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgProposeUpgrade) sdk.Result {
+	// check if sender has enough rights to propose an upgrade
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	if err := checkUpgradePlan(ctx, k, msg.Plan); err != nil {
+		return err.Result()
+	}
+
+	// check if an upgrade with a given name is already proposed
+	if k.IsProposedUpgradePresent(ctx, msg.Plan.Name) {
+		return types.ErrProposedUpgradeAlreadyExists(msg.Plan.Name).Result()
+	}
+
+	// check if an upgrade with a given name is already scheduled
+	if k.IsUpgradePlanPresent(ctx) && k.GetUpgradePlan(ctx).Name == msg.Plan.Name {
+		return types.ErrUpgradeAlreadyScheduled(msg.Plan.Name).Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposeUpgrade,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", msg.Plan.Height)),
+		),
+	)
+
+	// if more than 1 trustee's approval is needed, store proposed upgrade else schedule the plan.
+	if UpgradeApprovalPolicy(ctx, k).IsSingleVoteEnough(authKeeper.CountAccountsWithRole(ctx, auth.Trustee)) {
+		if err := scheduleUpgrade(ctx, k, msg.Plan); err != nil {
+			return err.Result()
+		}
+	} else {
+		k.SetProposedUpgrade(ctx, types.NewProposedUpgrade(msg.Plan, msg.Signer))
+	}
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgApproveUpgrade(ctx sdk.Context, k keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgApproveUpgrade) sdk.Result {
+	// check if sender has enough rights to approve an upgrade
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// check if proposed upgrade exists
+	if !k.IsProposedUpgradePresent(ctx, msg.Name) {
+		return types.ErrProposedUpgradeDoesNotExist(msg.Name).Result()
+	}
+
+	proposedUpgrade := k.GetProposedUpgrade(ctx, msg.Name)
+
+	// the upgrade height may have been passed while the upgrade was pending,
+	// such a proposal can never be scheduled, so it is deleted to release its name
+	if err := checkUpgradePlan(ctx, k, proposedUpgrade.Plan); err != nil {
+		k.DeleteProposedUpgrade(ctx, msg.Name)
+		emitCancelUpgradeEvent(ctx, proposedUpgrade.Plan)
+
+		return sdk.Result{Events: ctx.EventManager().Events(), Log: err.Error()}
+	}
+
+	// check if proposed upgrade already has approval from signer
+	approved, added := approvals.AddApproval(proposedUpgrade.Approvals, msg.Signer)
+	if !added {
+		return sdk.ErrUnauthorized(
+			fmt.Sprintf("Proposed upgrade associated with the name=%v already has approval from=%v",
+				msg.Name, msg.Signer)).Result()
+	}
+
+	// append approval
+	proposedUpgrade.Approvals = approved
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeApproveUpgrade,
+			sdk.NewAttribute(types.AttributeKeyName, msg.Name),
+		),
+	)
+
+	// check if proposed upgrade has enough approvals
+	if !UpgradeApprovalPolicy(ctx, k).IsReached(len(proposedUpgrade.Approvals),
+		authKeeper.CountAccountsWithRole(ctx, auth.Trustee)) {
+		// update proposed upgrade record
+		k.SetProposedUpgrade(ctx, proposedUpgrade)
+
+		return sdk.Result{Events: ctx.EventManager().Events()}
+	}
+
+	if err := scheduleUpgrade(ctx, k, proposedUpgrade.Plan); err != nil {
+		return err.Result()
+	}
+
+	// delete proposed upgrade record
+	k.DeleteProposedUpgrade(ctx, msg.Name)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// Cancels the proposed upgrade or the scheduled plan with the given name once enough trustees cancel it.
+func handleMsgCancelUpgrade(ctx sdk.Context, k keeper.Keeper, authKeeper auth.Keeper,
+	msg types.MsgCancelUpgrade) sdk.Result {
+	// check if sender has enough rights to cancel an upgrade
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	trusteesCount := authKeeper.CountAccountsWithRole(ctx, auth.Trustee)
+
+	if k.IsProposedUpgradePresent(ctx, msg.Name) {
+		proposedUpgrade := k.GetProposedUpgrade(ctx, msg.Name)
+
+		// check if proposed upgrade already has cancellation from signer
+		cancellations, added := approvals.AddApproval(proposedUpgrade.Cancellations, msg.Signer)
+		if !added {
+			return sdk.ErrUnauthorized(
+				fmt.Sprintf("Proposed upgrade associated with the name=%v already has cancellation from=%v",
+					msg.Name, msg.Signer)).Result()
+		}
+
+		// check if proposed upgrade has enough cancellations
+		if !UpgradeApprovalPolicy(ctx, k).IsReached(len(cancellations), trusteesCount) {
+			// update proposed upgrade record
+			proposedUpgrade.Cancellations = cancellations
+			k.SetProposedUpgrade(ctx, proposedUpgrade)
+
+			return sdk.Result{}
+		}
+
+		k.DeleteProposedUpgrade(ctx, msg.Name)
+		emitCancelUpgradeEvent(ctx, proposedUpgrade.Plan)
+
+		return sdk.Result{Events: ctx.EventManager().Events()}
+	}
+
+	// check if the plan with a given name is scheduled
+	if !k.IsUpgradePlanPresent(ctx) || k.GetUpgradePlan(ctx).Name != msg.Name {
+		return types.ErrProposedUpgradeDoesNotExist(msg.Name).Result()
+	}
+
+	plan := k.GetUpgradePlan(ctx)
+
+	// check if scheduled plan already has cancellation from signer
+	cancellations, added := approvals.AddApproval(k.GetPlanCancellations(ctx), msg.Signer)
+	if !added {
+		return sdk.ErrUnauthorized(
+			fmt.Sprintf("Scheduled upgrade plan associated with the name=%v already has cancellation from=%v",
+				msg.Name, msg.Signer)).Result()
+	}
+
+	// check if scheduled plan has enough cancellations
+	if !UpgradeApprovalPolicy(ctx, k).IsReached(len(cancellations), trusteesCount) {
+		k.SetPlanCancellations(ctx, cancellations)
+
+		return sdk.Result{}
+	}
+
+	k.DeleteUpgradePlan(ctx)
+	emitCancelUpgradeEvent(ctx, plan)
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func checkUpgradePlan(ctx sdk.Context, k keeper.Keeper, plan types.Plan) sdk.Error {
+	// upgrade can be scheduled only for a future block
+	if plan.Height <= ctx.BlockHeight() {
+		return types.ErrInvalidUpgradeHeight(plan.Height, ctx.BlockHeight())
+	}
+
+	// check if the upgrade has already been applied
+	if k.IsUpgradeApplied(ctx, plan.Name) {
+		return types.ErrUpgradeAlreadyApplied(plan.Name)
+	}
+
+	return nil
+}
+
+// schedules the approved plan. Only one plan can be scheduled at a time,
+// so the previously scheduled plan must be applied or cancelled first.
+func scheduleUpgrade(ctx sdk.Context, k keeper.Keeper, plan types.Plan) sdk.Error {
+	if k.IsUpgradePlanPresent(ctx) {
+		return types.ErrUpgradeAlreadyScheduled(k.GetUpgradePlan(ctx).Name)
+	}
+
+	k.SetUpgradePlan(ctx, plan)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeScheduleUpgrade,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", plan.Height)),
+		),
+	)
+
+	return nil
+}
+
+func emitCancelUpgradeEvent(ctx sdk.Context, plan types.Plan) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelUpgrade,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", plan.Height)),
+		),
+	)
+}
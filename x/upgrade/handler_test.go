@@ -0,0 +1,389 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package upgrade
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/keeper"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+func TestHandler_ProposeUpgrade_OneApprovalIsNeeded(t *testing.T) {
+	setup := Setup()
+
+	// propose upgrade by the only trustee
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// plan is scheduled at once
+	plan, err := queryUpgradePlan(setup)
+	require.Nil(t, err)
+	require.Equal(t, defaultPlan(), *plan)
+
+	require.False(t, setup.UpgradeKeeper.IsProposedUpgradePresent(setup.Ctx, testconstants.UpgradePlanName))
+}
+
+func TestHandler_ProposeApproveUpgrade(t *testing.T) {
+	setup := Setup()
+	trustee2 := storeTrustee(setup)
+	trustee3 := storeTrustee(setup)
+
+	// propose upgrade
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	events := result.Events.ToABCIEvents()
+	require.Equal(t, types.EventTypeProposeUpgrade, events[0].Type)
+
+	// plan is not scheduled yet
+	_, err := queryUpgradePlan(setup)
+	require.Equal(t, types.CodeUpgradePlanDoesNotExist, err.Code())
+
+	proposedUpgrade, _ := queryProposedUpgrade(setup, testconstants.UpgradePlanName)
+	require.Equal(t, defaultPlan(), proposedUpgrade.Plan)
+	require.Equal(t, []sdk.AccAddress{setup.Trustee}, proposedUpgrade.Approvals)
+
+	// approve by the same trustee
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveUpgrade(testconstants.UpgradePlanName, setup.Trustee))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// approve by second trustee
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveUpgrade(testconstants.UpgradePlanName, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// plan is scheduled
+	plan, err := queryUpgradePlan(setup)
+	require.Nil(t, err)
+	require.Equal(t, defaultPlan(), *plan)
+
+	// proposed upgrade is removed
+	_, err = queryProposedUpgrade(setup, testconstants.UpgradePlanName)
+	require.Equal(t, types.CodeProposedUpgradeDoesNotExist, err.Code())
+
+	// approve by third trustee
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveUpgrade(testconstants.UpgradePlanName, trustee3))
+	require.Equal(t, types.CodeProposedUpgradeDoesNotExist, result.Code)
+}
+
+func TestHandler_ApproveUpgrade_ApprovalPercentIsParam(t *testing.T) {
+	setup := Setup()
+	trustee2 := storeTrustee(setup)
+	trustee3 := storeTrustee(setup)
+
+	// require approvals of all the trustees
+	params := types.DefaultParams()
+	params.UpgradeApprovalPercent = sdk.OneDec()
+	setup.UpgradeKeeper.SetParams(setup.Ctx, params)
+
+	// propose upgrade
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// approve by second trustee
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveUpgrade(testconstants.UpgradePlanName, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// plan is not scheduled yet
+	require.False(t, setup.UpgradeKeeper.IsUpgradePlanPresent(setup.Ctx))
+
+	// approve by third trustee
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveUpgrade(testconstants.UpgradePlanName, trustee3))
+	require.Equal(t, sdk.CodeOK, result.Code)
+	require.True(t, setup.UpgradeKeeper.IsUpgradePlanPresent(setup.Ctx))
+}
+
+func TestHandler_ProposeUpgrade_ByNotTrustee(t *testing.T) {
+	setup := Setup()
+
+	for _, role := range []auth.AccountRole{auth.Vendor, auth.TestHouse, auth.ZBCertificationCenter, auth.NodeAdmin} {
+		// create signer account
+		account := auth.NewAccount(testconstants.Address2, testconstants.PubKey2, auth.AccountRoles{role})
+		setup.authKeeper.SetAccount(setup.Ctx, account)
+
+		// propose upgrade
+		result := setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), testconstants.Address2))
+		require.Equal(t, auth.CodeMissingRole, result.Code)
+	}
+}
+
+func TestHandler_ProposeUpgrade_ForPastHeight(t *testing.T) {
+	setup := Setup()
+	ctx := setup.Ctx.WithBlockHeight(testconstants.UpgradePlanHeight)
+
+	result := setup.Handler(ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, types.CodeInvalidUpgradeHeight, result.Code)
+}
+
+func TestHandler_ProposeUpgrade_Twice(t *testing.T) {
+	setup := Setup()
+	_ = storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, types.CodeProposedUpgradeAlreadyExists, result.Code)
+}
+
+func TestHandler_ProposeUpgrade_ForAppliedUpgrade(t *testing.T) {
+	setup := Setup()
+
+	plan := defaultPlan()
+	setup.UpgradeKeeper.SetAppliedUpgrade(setup.Ctx, types.NewAppliedUpgrade(plan, plan.Height))
+
+	plan.Height += 100
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(plan, setup.Trustee))
+	require.Equal(t, types.CodeUpgradeAlreadyApplied, result.Code)
+}
+
+func TestHandler_ApproveUpgrade_ByNotTrustee(t *testing.T) {
+	setup := Setup()
+	_ = storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	account := auth.NewAccount(testconstants.Address2, testconstants.PubKey2, auth.AccountRoles{auth.NodeAdmin})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveUpgrade(testconstants.UpgradePlanName, testconstants.Address2))
+	require.Equal(t, auth.CodeMissingRole, result.Code)
+}
+
+func TestHandler_ApproveUpgrade_AfterUpgradeHeight(t *testing.T) {
+	setup := Setup()
+	trustee2 := storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// upgrade height has been passed while the upgrade was pending
+	ctx := setup.Ctx.WithBlockHeight(testconstants.UpgradePlanHeight + 1)
+
+	// the stale proposal is deleted
+	result = setup.Handler(ctx, types.NewMsgApproveUpgrade(testconstants.UpgradePlanName, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+	require.Contains(t, result.Log, "Upgrade height")
+
+	events := result.Events.ToABCIEvents()
+	require.Equal(t, types.EventTypeCancelUpgrade, events[0].Type)
+
+	_, err := queryUpgradePlan(setup)
+	require.Equal(t, types.CodeUpgradePlanDoesNotExist, err.Code())
+
+	_, err = queryProposedUpgrade(setup, testconstants.UpgradePlanName)
+	require.Equal(t, types.CodeProposedUpgradeDoesNotExist, err.Code())
+
+	// the name can be proposed again
+	plan := types.NewPlan(testconstants.UpgradePlanName, testconstants.UpgradePlanHeight+100, "")
+	result = setup.Handler(ctx, types.NewMsgProposeUpgrade(plan, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+}
+
+func TestHandler_ProposeUpgrade_WhenPlanIsScheduled(t *testing.T) {
+	setup := Setup()
+
+	// schedule first plan
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// the scheduled plan is not overridden
+	newPlan := types.NewPlan("v0.3.0", testconstants.UpgradePlanHeight+100, "")
+	result = setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(newPlan, setup.Trustee))
+	require.Equal(t, types.CodeUpgradeAlreadyScheduled, result.Code)
+
+	plan, _ := queryUpgradePlan(setup)
+	require.Equal(t, defaultPlan(), *plan)
+
+	// the same name cannot be proposed while it is scheduled
+	_ = storeTrustee(setup)
+	result = setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, types.CodeUpgradeAlreadyScheduled, result.Code)
+}
+
+func TestHandler_ApproveUpgrade_WhenPlanIsScheduled(t *testing.T) {
+	setup := Setup()
+	trustee2 := storeTrustee(setup)
+	_ = storeTrustee(setup)
+
+	// schedule first plan
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveUpgrade(testconstants.UpgradePlanName, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// propose second plan
+	newPlan := types.NewPlan("v0.3.0", testconstants.UpgradePlanHeight+100, "")
+	result = setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(newPlan, setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// it cannot be scheduled until the first plan is cancelled
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveUpgrade(newPlan.Name, trustee2))
+	require.Equal(t, types.CodeUpgradeAlreadyScheduled, result.Code)
+
+	for _, trustee := range []sdk.AccAddress{setup.Trustee, trustee2} {
+		result = setup.Handler(setup.Ctx, types.NewMsgCancelUpgrade(testconstants.UpgradePlanName, trustee))
+		require.Equal(t, sdk.CodeOK, result.Code)
+	}
+
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveUpgrade(newPlan.Name, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	plan, _ := queryUpgradePlan(setup)
+	require.Equal(t, newPlan, *plan)
+}
+
+func TestHandler_CancelProposedUpgrade(t *testing.T) {
+	setup := Setup()
+	trustee2 := storeTrustee(setup)
+	trustee3 := storeTrustee(setup)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// first cancellation is not enough
+	result = setup.Handler(setup.Ctx, types.NewMsgCancelUpgrade(testconstants.UpgradePlanName, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	proposedUpgrade, _ := queryProposedUpgrade(setup, testconstants.UpgradePlanName)
+	require.Equal(t, []sdk.AccAddress{trustee2}, proposedUpgrade.Cancellations)
+
+	// second cancellation from the same trustee is rejected
+	result = setup.Handler(setup.Ctx, types.NewMsgCancelUpgrade(testconstants.UpgradePlanName, trustee2))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// second cancellation deletes the proposed upgrade
+	result = setup.Handler(setup.Ctx, types.NewMsgCancelUpgrade(testconstants.UpgradePlanName, trustee3))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	events := result.Events.ToABCIEvents()
+	require.Equal(t, types.EventTypeCancelUpgrade, events[0].Type)
+
+	_, err := queryProposedUpgrade(setup, testconstants.UpgradePlanName)
+	require.Equal(t, types.CodeProposedUpgradeDoesNotExist, err.Code())
+
+	_, err = queryUpgradePlan(setup)
+	require.Equal(t, types.CodeUpgradePlanDoesNotExist, err.Code())
+}
+
+func TestHandler_CancelScheduledUpgrade(t *testing.T) {
+	setup := Setup()
+
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	trustee2 := storeTrustee(setup)
+	trustee3 := storeTrustee(setup)
+
+	// first cancellation is not enough
+	result = setup.Handler(setup.Ctx, types.NewMsgCancelUpgrade(testconstants.UpgradePlanName, trustee2))
+	require.Equal(t, sdk.CodeOK, result.Code)
+	require.Equal(t, []sdk.AccAddress{trustee2}, setup.UpgradeKeeper.GetPlanCancellations(setup.Ctx))
+
+	result = setup.Handler(setup.Ctx, types.NewMsgCancelUpgrade(testconstants.UpgradePlanName, trustee2))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// second cancellation deletes the plan
+	result = setup.Handler(setup.Ctx, types.NewMsgCancelUpgrade(testconstants.UpgradePlanName, trustee3))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	_, err := queryUpgradePlan(setup)
+	require.Equal(t, types.CodeUpgradePlanDoesNotExist, err.Code())
+	require.Empty(t, setup.UpgradeKeeper.GetPlanCancellations(setup.Ctx))
+}
+
+func TestHandler_CancelUpgrade_ForUnknownUpgrade(t *testing.T) {
+	setup := Setup()
+
+	result := setup.Handler(setup.Ctx, types.NewMsgCancelUpgrade(testconstants.UpgradePlanName, setup.Trustee))
+	require.Equal(t, types.CodeProposedUpgradeDoesNotExist, result.Code)
+
+	// the scheduled plan has another name
+	result = setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, types.NewMsgCancelUpgrade("v0.3.0", setup.Trustee))
+	require.Equal(t, types.CodeProposedUpgradeDoesNotExist, result.Code)
+}
+
+func TestHandler_CancelUpgrade_ByNotTrustee(t *testing.T) {
+	setup := Setup()
+
+	result := setup.Handler(setup.Ctx, types.NewMsgProposeUpgrade(defaultPlan(), setup.Trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	account := auth.NewAccount(testconstants.Address2, testconstants.PubKey2, auth.AccountRoles{auth.NodeAdmin})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	result = setup.Handler(setup.Ctx, types.NewMsgCancelUpgrade(testconstants.UpgradePlanName, testconstants.Address2))
+	require.Equal(t, auth.CodeMissingRole, result.Code)
+}
+
+func defaultPlan() types.Plan {
+	return types.NewPlan(testconstants.UpgradePlanName, testconstants.UpgradePlanHeight,
+		testconstants.UpgradePlanInfo)
+}
+
+func storeTrustee(setup TestSetup) sdk.AccAddress {
+	address, pubkey, _ := testconstants.TestAddress()
+	account := auth.NewAccount(address, pubkey, auth.AccountRoles{auth.Trustee})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	return address
+}
+
+func queryUpgradePlan(setup TestSetup) (*types.Plan, sdk.Error) {
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{keeper.QueryUpgradePlan},
+		abci.RequestQuery{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan types.Plan
+
+	setup.Cdc.MustUnmarshalJSON(result, &plan)
+
+	return &plan, nil
+}
+
+func queryProposedUpgrade(setup TestSetup, name string) (*types.ProposedUpgrade, sdk.Error) {
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{keeper.QueryProposedUpgrade, name},
+		abci.RequestQuery{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposedUpgrade types.ProposedUpgrade
+
+	setup.Cdc.MustUnmarshalJSON(result, &proposedUpgrade)
+
+	return &proposedUpgrade, nil
+}
@@ -0,0 +1,361 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+type Keeper struct {
+	// Unexposed key to access store from sdk.Context.
+	storeKey sdk.StoreKey
+
+	// The parameter subspace of the module.
+	paramSpace params.Subspace
+
+	// The wire codec for binary encoding/decoding.
+	cdc *codec.Codec
+
+	// Upgrade handlers registered by the running binary (by upgrade name).
+	upgradeHandlers map[string]types.UpgradeHandler
+
+	// Store migrations registered by the modules (by module name and version to migrate from).
+	migrations map[string]map[uint64]types.Migration
+}
+
+func NewKeeper(storeKey sdk.StoreKey, paramSpace params.Subspace, cdc *codec.Codec) Keeper {
+	return Keeper{
+		storeKey:        storeKey,
+		paramSpace:      paramSpace.WithKeyTable(types.ParamKeyTable()),
+		cdc:             cdc,
+		upgradeHandlers: map[string]types.UpgradeHandler{},
+		migrations:      map[string]map[uint64]types.Migration{},
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+/*
+	Upgrade Handlers
+*/
+
+// Registers the handler for the upgrade with the given name.
+// The binary supporting the upgrade must register it before the upgrade height is reached.
+func (k Keeper) SetUpgradeHandler(name string, handler types.UpgradeHandler) {
+	k.upgradeHandlers[name] = handler
+}
+
+// Check if the running binary has the handler for the upgrade with the given name.
+func (k Keeper) HasUpgradeHandler(name string) bool {
+	_, ok := k.upgradeHandlers[name]
+
+	return ok
+}
+
+/*
+	Scheduled Upgrade Plan
+*/
+
+// Gets the scheduled upgrade plan.
+func (k Keeper) GetUpgradePlan(ctx sdk.Context) (plan types.Plan) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.UpgradePlanKey)
+
+	if value == nil {
+		panic("upgrade plan is not scheduled")
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &plan)
+
+	return plan
+}
+
+// Schedules the upgrade plan. The previously scheduled plan (if any) is overridden,
+// the handlers must check that no plan is scheduled.
+func (k Keeper) SetUpgradePlan(ctx sdk.Context, plan types.Plan) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.UpgradePlanKey, k.cdc.MustMarshalBinaryBare(plan))
+}
+
+// Check if an upgrade plan is scheduled.
+func (k Keeper) IsUpgradePlanPresent(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.UpgradePlanKey)
+}
+
+// Deletes the scheduled upgrade plan along with its cancellations.
+func (k Keeper) DeleteUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.UpgradePlanKey)
+	store.Delete(types.PlanCancellationsKey)
+}
+
+// Gets the trustees cancelled the scheduled upgrade plan.
+func (k Keeper) GetPlanCancellations(ctx sdk.Context) (cancellations []sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.PlanCancellationsKey)
+
+	if value == nil {
+		return []sdk.AccAddress{}
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &cancellations)
+
+	return cancellations
+}
+
+// Sets the trustees cancelled the scheduled upgrade plan.
+func (k Keeper) SetPlanCancellations(ctx sdk.Context, cancellations []sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PlanCancellationsKey, k.cdc.MustMarshalBinaryBare(cancellations))
+}
+
+/*
+	Proposed Upgrade by Name
+*/
+
+// Gets the entire Proposed Upgrade record associated with an upgrade name.
+func (k Keeper) GetProposedUpgrade(ctx sdk.Context, name string) (upgrade types.ProposedUpgrade) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetProposedUpgradeKey(name))
+
+	if value == nil {
+		panic(fmt.Sprintf("proposed upgrade record not found for name: %v\n", name))
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &upgrade)
+
+	return upgrade
+}
+
+// Sets the entire Proposed Upgrade record for an upgrade name.
+func (k Keeper) SetProposedUpgrade(ctx sdk.Context, upgrade types.ProposedUpgrade) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetProposedUpgradeKey(upgrade.Plan.Name), k.cdc.MustMarshalBinaryBare(upgrade))
+}
+
+// Check if the Proposed Upgrade record associated with an upgrade name is present in the store or not.
+func (k Keeper) IsProposedUpgradePresent(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetProposedUpgradeKey(name))
+}
+
+// Deletes the Proposed Upgrade record associated with an upgrade name.
+func (k Keeper) DeleteProposedUpgrade(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetProposedUpgradeKey(name))
+}
+
+// get the set of all proposed upgrades.
+func (k Keeper) GetAllProposedUpgrades(ctx sdk.Context) (upgrades []types.ProposedUpgrade) {
+	k.IterateProposedUpgrades(ctx, func(upgrade types.ProposedUpgrade) (stop bool) {
+		upgrades = append(upgrades, upgrade)
+
+		return false
+	})
+
+	return upgrades
+}
+
+// iterate over proposed upgrades and apply function.
+func (k Keeper) IterateProposedUpgrades(ctx sdk.Context, process func(upgrade types.ProposedUpgrade) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ProposedUpgradePrefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		var upgrade types.ProposedUpgrade
+
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &upgrade)
+
+		if process(upgrade) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+/*
+	Applied Upgrade by Name
+*/
+
+// Gets the entire Applied Upgrade record associated with an upgrade name.
+func (k Keeper) GetAppliedUpgrade(ctx sdk.Context, name string) (upgrade types.AppliedUpgrade) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetAppliedUpgradeKey(name))
+
+	if value == nil {
+		panic(fmt.Sprintf("applied upgrade record not found for name: %v\n", name))
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &upgrade)
+
+	return upgrade
+}
+
+// Sets the entire Applied Upgrade record for an upgrade name.
+func (k Keeper) SetAppliedUpgrade(ctx sdk.Context, upgrade types.AppliedUpgrade) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAppliedUpgradeKey(upgrade.Plan.Name), k.cdc.MustMarshalBinaryBare(upgrade))
+}
+
+// Check if the upgrade with the given name has already been applied.
+func (k Keeper) IsUpgradeApplied(ctx sdk.Context, name string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetAppliedUpgradeKey(name))
+}
+
+// get the set of all applied upgrades.
+func (k Keeper) GetAllAppliedUpgrades(ctx sdk.Context) (upgrades []types.AppliedUpgrade) {
+	k.IterateAppliedUpgrades(ctx, func(upgrade types.AppliedUpgrade) (stop bool) {
+		upgrades = append(upgrades, upgrade)
+
+		return false
+	})
+
+	return upgrades
+}
+
+// iterate over applied upgrades and apply function.
+func (k Keeper) IterateAppliedUpgrades(ctx sdk.Context, process func(upgrade types.AppliedUpgrade) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.AppliedUpgradePrefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		var upgrade types.AppliedUpgrade
+
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &upgrade)
+
+		if process(upgrade) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+/*
+	Module Version by Module Name
+*/
+
+// Gets the consensus version of the module store.
+// DefaultModuleVersion is returned if the version has never been recorded.
+func (k Keeper) GetModuleVersion(ctx sdk.Context, module string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetModuleVersionKey(module))
+
+	if value == nil {
+		return types.DefaultModuleVersion
+	}
+
+	var version uint64
+
+	k.cdc.MustUnmarshalBinaryBare(value, &version)
+
+	return version
+}
+
+// Sets the consensus version of the module store.
+func (k Keeper) SetModuleVersion(ctx sdk.Context, module string, version uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetModuleVersionKey(module), k.cdc.MustMarshalBinaryBare(version))
+}
+
+// Check if the consensus version of the module store is recorded.
+func (k Keeper) IsModuleVersionPresent(ctx sdk.Context, module string) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetModuleVersionKey(module))
+}
+
+// get the set of all recorded module versions.
+func (k Keeper) GetAllModuleVersions(ctx sdk.Context) (versions []types.ModuleVersion) {
+	k.IterateModuleVersions(ctx, func(version types.ModuleVersion) (stop bool) {
+		versions = append(versions, version)
+
+		return false
+	})
+
+	return versions
+}
+
+// iterate over recorded module versions and apply function.
+func (k Keeper) IterateModuleVersions(ctx sdk.Context, process func(version types.ModuleVersion) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.ModuleVersionPrefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		var version uint64
+
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &version)
+
+		module := string(iter.Key()[len(types.ModuleVersionPrefix):])
+
+		if process(types.NewModuleVersion(module, version)) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+/*
+	Module Params
+*/
+
+// Gets the module params. Default values are returned for the params which have not been set yet.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+// Sets the module params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
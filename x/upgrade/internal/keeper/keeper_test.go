@@ -0,0 +1,152 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+func TestKeeper_UpgradePlanGetSet(t *testing.T) {
+	setup := Setup()
+
+	// no plan before it is scheduled
+	require.False(t, setup.UpgradeKeeper.IsUpgradePlanPresent(setup.Ctx))
+	require.Panics(t, func() {
+		setup.UpgradeKeeper.GetUpgradePlan(setup.Ctx)
+	})
+
+	// schedule plan
+	plan := DefaultPlan()
+	setup.UpgradeKeeper.SetUpgradePlan(setup.Ctx, plan)
+
+	require.True(t, setup.UpgradeKeeper.IsUpgradePlanPresent(setup.Ctx))
+	require.Equal(t, plan, setup.UpgradeKeeper.GetUpgradePlan(setup.Ctx))
+
+	// new plan overrides the scheduled one
+	newPlan := types.NewPlan("v0.3.0", plan.Height+100, "")
+	setup.UpgradeKeeper.SetUpgradePlan(setup.Ctx, newPlan)
+	require.Equal(t, newPlan, setup.UpgradeKeeper.GetUpgradePlan(setup.Ctx))
+
+	// delete plan
+	setup.UpgradeKeeper.DeleteUpgradePlan(setup.Ctx)
+	require.False(t, setup.UpgradeKeeper.IsUpgradePlanPresent(setup.Ctx))
+}
+
+func TestKeeper_ProposedUpgradeGetSet(t *testing.T) {
+	setup := Setup()
+
+	// check if proposed upgrade present
+	require.False(t, setup.UpgradeKeeper.IsProposedUpgradePresent(setup.Ctx, testconstants.UpgradePlanName))
+
+	// no proposed upgrade before it is created
+	require.Panics(t, func() {
+		setup.UpgradeKeeper.GetProposedUpgrade(setup.Ctx, testconstants.UpgradePlanName)
+	})
+
+	// store proposed upgrade
+	upgrade := types.NewProposedUpgrade(DefaultPlan(), testconstants.Address1)
+	setup.UpgradeKeeper.SetProposedUpgrade(setup.Ctx, upgrade)
+
+	// check if proposed upgrade present
+	require.True(t, setup.UpgradeKeeper.IsProposedUpgradePresent(setup.Ctx, testconstants.UpgradePlanName))
+
+	// get proposed upgrade
+	receivedUpgrade := setup.UpgradeKeeper.GetProposedUpgrade(setup.Ctx, testconstants.UpgradePlanName)
+	require.Equal(t, upgrade, receivedUpgrade)
+
+	// get all proposed upgrades
+	require.Equal(t, []types.ProposedUpgrade{upgrade}, setup.UpgradeKeeper.GetAllProposedUpgrades(setup.Ctx))
+
+	// delete proposed upgrade
+	setup.UpgradeKeeper.DeleteProposedUpgrade(setup.Ctx, testconstants.UpgradePlanName)
+	require.False(t, setup.UpgradeKeeper.IsProposedUpgradePresent(setup.Ctx, testconstants.UpgradePlanName))
+}
+
+func TestKeeper_BeginBlocker_ForNoPlan(t *testing.T) {
+	setup := Setup()
+
+	require.NotPanics(t, func() {
+		setup.UpgradeKeeper.BeginBlocker(setup.Ctx.WithBlockHeight(testconstants.UpgradePlanHeight))
+	})
+}
+
+func TestKeeper_BeginBlocker_BeforeUpgradeHeight(t *testing.T) {
+	setup := Setup()
+
+	plan := DefaultPlan()
+	setup.UpgradeKeeper.SetUpgradePlan(setup.Ctx, plan)
+
+	// old binary keeps working until the upgrade height
+	require.NotPanics(t, func() {
+		setup.UpgradeKeeper.BeginBlocker(setup.Ctx.WithBlockHeight(plan.Height - 1))
+	})
+
+	// new binary must not be started before the upgrade height
+	setup.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, plan types.Plan) {})
+	require.Panics(t, func() {
+		setup.UpgradeKeeper.BeginBlocker(setup.Ctx.WithBlockHeight(plan.Height - 1))
+	})
+}
+
+func TestKeeper_BeginBlocker_HaltsWithoutUpgradeHandler(t *testing.T) {
+	setup := Setup()
+
+	plan := DefaultPlan()
+	setup.UpgradeKeeper.SetUpgradePlan(setup.Ctx, plan)
+
+	require.Panics(t, func() {
+		setup.UpgradeKeeper.BeginBlocker(setup.Ctx.WithBlockHeight(plan.Height))
+	})
+
+	// plan is still scheduled
+	require.True(t, setup.UpgradeKeeper.IsUpgradePlanPresent(setup.Ctx))
+	require.False(t, setup.UpgradeKeeper.IsUpgradeApplied(setup.Ctx, plan.Name))
+}
+
+func TestKeeper_BeginBlocker_AppliesUpgrade(t *testing.T) {
+	setup := Setup()
+
+	plan := DefaultPlan()
+	setup.UpgradeKeeper.SetUpgradePlan(setup.Ctx, plan)
+
+	migrated := false
+
+	setup.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, plan types.Plan) {
+		migrated = true
+	})
+
+	ctx := setup.Ctx.WithBlockHeight(plan.Height)
+	setup.UpgradeKeeper.BeginBlocker(ctx)
+
+	// upgrade handler is executed
+	require.True(t, migrated)
+
+	// plan is moved to the applied ones
+	require.False(t, setup.UpgradeKeeper.IsUpgradePlanPresent(setup.Ctx))
+	require.True(t, setup.UpgradeKeeper.IsUpgradeApplied(setup.Ctx, plan.Name))
+	require.Equal(t, types.NewAppliedUpgrade(plan, plan.Height),
+		setup.UpgradeKeeper.GetAppliedUpgrade(setup.Ctx, plan.Name))
+
+	// next blocks are processed by the new binary
+	require.NotPanics(t, func() {
+		setup.UpgradeKeeper.BeginBlocker(ctx.WithBlockHeight(plan.Height + 1))
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+// Registers the migration of the module store from the given consensus version to the next one.
+// Migrations must be registered when the application is created, before any of them is run.
+func (k Keeper) RegisterMigration(module string, fromVersion uint64, migration types.Migration) {
+	if _, ok := k.migrations[module]; !ok {
+		k.migrations[module] = map[uint64]types.Migration{}
+	}
+
+	if _, ok := k.migrations[module][fromVersion]; ok {
+		panic(fmt.Sprintf("migration of module %v from version %v is already registered", module, fromVersion))
+	}
+
+	k.migrations[module][fromVersion] = migration
+}
+
+// Check if the migration of the module store from the given consensus version is registered.
+func (k Keeper) HasMigration(module string, fromVersion uint64) bool {
+	_, ok := k.migrations[module][fromVersion]
+
+	return ok
+}
+
+// Migrates the stores of the modules to the given consensus versions.
+// For every module the registered migrations are run one by one starting from the version recorded
+// in the store, after that the target version is recorded. The modules are processed in name order
+// so that all nodes perform the migrations identically.
+func (k Keeper) RunMigrations(ctx sdk.Context, versions map[string]uint64) error {
+	modules := make([]string, 0, len(versions))
+	for module := range versions {
+		modules = append(modules, module)
+	}
+
+	sort.Strings(modules)
+
+	for _, module := range modules {
+		fromVersion := k.GetModuleVersion(ctx, module)
+		toVersion := versions[module]
+
+		if fromVersion > toVersion {
+			return fmt.Errorf("store of module %v has version %v which is newer than the supported version %v",
+				module, fromVersion, toVersion)
+		}
+
+		for version := fromVersion; version < toVersion; version++ {
+			migration, ok := k.migrations[module][version]
+			if !ok {
+				return fmt.Errorf("no migration of module %v from version %v is registered", module, version)
+			}
+
+			k.Logger(ctx).Info(fmt.Sprintf("migrating module %v from version %v to %v", module, version, version+1))
+
+			if err := migration(ctx); err != nil {
+				return fmt.Errorf("failed to migrate module %v from version %v: %w", module, version, err)
+			}
+		}
+
+		k.SetModuleVersion(ctx, module, toVersion)
+	}
+
+	return nil
+}
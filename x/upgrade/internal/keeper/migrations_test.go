@@ -0,0 +1,134 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+const (
+	testModule      = "modelinfo"
+	testOtherModule = "compliance"
+)
+
+func TestKeeper_ModuleVersionGetSet(t *testing.T) {
+	setup := Setup()
+
+	// default version before it is recorded
+	require.False(t, setup.UpgradeKeeper.IsModuleVersionPresent(setup.Ctx, testModule))
+	require.Equal(t, types.DefaultModuleVersion, setup.UpgradeKeeper.GetModuleVersion(setup.Ctx, testModule))
+
+	// record versions
+	setup.UpgradeKeeper.SetModuleVersion(setup.Ctx, testModule, 3)
+	setup.UpgradeKeeper.SetModuleVersion(setup.Ctx, testOtherModule, 2)
+
+	require.True(t, setup.UpgradeKeeper.IsModuleVersionPresent(setup.Ctx, testModule))
+	require.Equal(t, uint64(3), setup.UpgradeKeeper.GetModuleVersion(setup.Ctx, testModule))
+
+	// get all versions
+	require.Equal(t, []types.ModuleVersion{
+		types.NewModuleVersion(testOtherModule, 2),
+		types.NewModuleVersion(testModule, 3),
+	}, setup.UpgradeKeeper.GetAllModuleVersions(setup.Ctx))
+}
+
+func TestKeeper_RegisterMigration_Twice(t *testing.T) {
+	setup := Setup()
+
+	migration := func(ctx sdk.Context) error { return nil }
+
+	setup.UpgradeKeeper.RegisterMigration(testModule, 1, migration)
+	require.True(t, setup.UpgradeKeeper.HasMigration(testModule, 1))
+	require.False(t, setup.UpgradeKeeper.HasMigration(testModule, 2))
+
+	require.Panics(t, func() {
+		setup.UpgradeKeeper.RegisterMigration(testModule, 1, migration)
+	})
+}
+
+func TestKeeper_RunMigrations(t *testing.T) {
+	setup := Setup()
+
+	var executed []string
+
+	setup.UpgradeKeeper.RegisterMigration(testModule, 1, func(ctx sdk.Context) error {
+		executed = append(executed, "modelinfo v1->v2")
+
+		return nil
+	})
+	setup.UpgradeKeeper.RegisterMigration(testModule, 2, func(ctx sdk.Context) error {
+		executed = append(executed, "modelinfo v2->v3")
+
+		return nil
+	})
+	setup.UpgradeKeeper.RegisterMigration(testOtherModule, 1, func(ctx sdk.Context) error {
+		executed = append(executed, "compliance v1->v2")
+
+		return nil
+	})
+
+	// compliance store is already of version 2
+	setup.UpgradeKeeper.SetModuleVersion(setup.Ctx, testOtherModule, 2)
+
+	err := setup.UpgradeKeeper.RunMigrations(setup.Ctx, map[string]uint64{testModule: 3, testOtherModule: 2})
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"modelinfo v1->v2", "modelinfo v2->v3"}, executed)
+	require.Equal(t, uint64(3), setup.UpgradeKeeper.GetModuleVersion(setup.Ctx, testModule))
+	require.Equal(t, uint64(2), setup.UpgradeKeeper.GetModuleVersion(setup.Ctx, testOtherModule))
+
+	// second run does nothing
+	executed = nil
+
+	err = setup.UpgradeKeeper.RunMigrations(setup.Ctx, map[string]uint64{testModule: 3, testOtherModule: 2})
+	require.NoError(t, err)
+	require.Empty(t, executed)
+}
+
+func TestKeeper_RunMigrations_ForMissedMigration(t *testing.T) {
+	setup := Setup()
+
+	setup.UpgradeKeeper.RegisterMigration(testModule, 1, func(ctx sdk.Context) error { return nil })
+
+	err := setup.UpgradeKeeper.RunMigrations(setup.Ctx, map[string]uint64{testModule: 3})
+	require.Error(t, err)
+}
+
+func TestKeeper_RunMigrations_ForNewerStore(t *testing.T) {
+	setup := Setup()
+
+	setup.UpgradeKeeper.SetModuleVersion(setup.Ctx, testModule, 2)
+
+	err := setup.UpgradeKeeper.RunMigrations(setup.Ctx, map[string]uint64{testModule: 1})
+	require.Error(t, err)
+}
+
+func TestKeeper_RunMigrations_ForFailedMigration(t *testing.T) {
+	setup := Setup()
+
+	setup.UpgradeKeeper.RegisterMigration(testModule, 1, func(ctx sdk.Context) error {
+		return errors.New("broken record")
+	})
+
+	err := setup.UpgradeKeeper.RunMigrations(setup.Ctx, map[string]uint64{testModule: 2})
+	require.Error(t, err)
+	require.Equal(t, types.DefaultModuleVersion, setup.UpgradeKeeper.GetModuleVersion(setup.Ctx, testModule))
+}
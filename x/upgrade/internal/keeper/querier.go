@@ -0,0 +1,152 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+// query endpoints supported by the upgrade Querier.
+const (
+	QueryUpgradePlan      = "plan"
+	QueryProposedUpgrades = "proposed_upgrades"
+	QueryProposedUpgrade  = "proposed_upgrade"
+	QueryAppliedUpgrades  = "applied_upgrades"
+	QueryModuleVersion    = "module_version"
+)
+
+// creates a querier for upgrade module.
+func NewQuerier(k Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryUpgradePlan:
+			return queryUpgradePlan(ctx, k)
+		case QueryProposedUpgrades:
+			return queryProposedUpgrades(ctx, req, k)
+		case QueryProposedUpgrade:
+			return queryProposedUpgrade(ctx, path[1:], k)
+		case QueryAppliedUpgrades:
+			return queryAppliedUpgrades(ctx, req, k)
+		case QueryModuleVersion:
+			return queryModuleVersion(ctx, path[1:], k)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown upgrade query endpoint")
+		}
+	}
+}
+
+func queryUpgradePlan(ctx sdk.Context, k Keeper) ([]byte, sdk.Error) {
+	if !k.IsUpgradePlanPresent(ctx) {
+		return nil, types.ErrUpgradePlanDoesNotExist()
+	}
+
+	plan := k.GetUpgradePlan(ctx)
+
+	res := codec.MustMarshalJSONIndent(types.ModuleCdc, plan)
+
+	return res, nil
+}
+
+func queryProposedUpgrades(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Failed to parse request params: %s", err))
+	}
+
+	result := types.NewListProposedUpgrades()
+
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.IterateProposedUpgrades(ctx, func(upgrade types.ProposedUpgrade) (stop bool) {
+		result.Total++
+
+		if paginator.Add(types.GetProposedUpgradeKey(upgrade.Plan.Name)) {
+			result.Items = append(result.Items, upgrade)
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func queryProposedUpgrade(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	name := path[0]
+
+	if !k.IsProposedUpgradePresent(ctx, name) {
+		return nil, types.ErrProposedUpgradeDoesNotExist(name)
+	}
+
+	upgrade := k.GetProposedUpgrade(ctx, name)
+
+	res := codec.MustMarshalJSONIndent(types.ModuleCdc, upgrade)
+
+	return res, nil
+}
+
+func queryAppliedUpgrades(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Failed to parse request params: %s", err))
+	}
+
+	result := types.NewListAppliedUpgrades()
+
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.IterateAppliedUpgrades(ctx, func(upgrade types.AppliedUpgrade) (stop bool) {
+		result.Total++
+
+		if paginator.Add(types.GetAppliedUpgradeKey(upgrade.Plan.Name)) {
+			result.Items = append(result.Items, upgrade)
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func queryModuleVersion(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	module := path[0]
+
+	version := types.NewModuleVersion(module, k.GetModuleVersion(ctx, module))
+
+	res := codec.MustMarshalJSONIndent(types.ModuleCdc, version)
+
+	return res, nil
+}
@@ -0,0 +1,192 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+func TestQuerier_QueryUpgradePlan(t *testing.T) {
+	setup := Setup()
+
+	// schedule plan
+	plan := DefaultPlan()
+	setup.UpgradeKeeper.SetUpgradePlan(setup.Ctx, plan)
+
+	// query plan
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{QueryUpgradePlan},
+		abci.RequestQuery{},
+	)
+
+	var receivedPlan types.Plan
+
+	setup.Cdc.MustUnmarshalJSON(result, &receivedPlan)
+
+	// check
+	require.Equal(t, plan, receivedPlan)
+}
+
+func TestQuerier_QueryUpgradePlan_ForNotScheduled(t *testing.T) {
+	setup := Setup()
+
+	// query plan
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryUpgradePlan},
+		abci.RequestQuery{},
+	)
+
+	// check
+	require.Nil(t, result)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeUpgradePlanDoesNotExist, err.Code())
+}
+
+func TestQuerier_QueryProposedUpgrade(t *testing.T) {
+	setup := Setup()
+
+	// add proposed upgrade
+	upgrade := types.NewProposedUpgrade(DefaultPlan(), testconstants.Address1)
+	setup.UpgradeKeeper.SetProposedUpgrade(setup.Ctx, upgrade)
+
+	// query proposed upgrade
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{QueryProposedUpgrade, upgrade.Plan.Name},
+		abci.RequestQuery{},
+	)
+
+	var receivedUpgrade types.ProposedUpgrade
+
+	setup.Cdc.MustUnmarshalJSON(result, &receivedUpgrade)
+
+	// check
+	require.Equal(t, upgrade, receivedUpgrade)
+
+	// query unknown proposed upgrade
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{QueryProposedUpgrade, "unknown"},
+		abci.RequestQuery{},
+	)
+
+	// check
+	require.Nil(t, result)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeProposedUpgradeDoesNotExist, err.Code())
+}
+
+func TestQuerier_QueryProposedUpgrades(t *testing.T) {
+	setup := Setup()
+
+	// add 2 proposed upgrades
+	upgrade1 := types.NewProposedUpgrade(DefaultPlan(), testconstants.Address1)
+	setup.UpgradeKeeper.SetProposedUpgrade(setup.Ctx, upgrade1)
+
+	upgrade2 := types.NewProposedUpgrade(types.NewPlan("v0.3.0", 200, ""), testconstants.Address2)
+	setup.UpgradeKeeper.SetProposedUpgrade(setup.Ctx, upgrade2)
+
+	// query all proposed upgrades
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{QueryProposedUpgrades},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(pagination.NewPaginationParams(0, 0))},
+	)
+
+	var listUpgrades types.ListProposedUpgrades
+
+	setup.Cdc.MustUnmarshalJSON(result, &listUpgrades)
+
+	// check
+	require.Equal(t, 2, listUpgrades.Total)
+	require.Equal(t, []types.ProposedUpgrade{upgrade1, upgrade2}, listUpgrades.Items)
+
+	// query with pagination
+	result, _ = setup.Querier(
+		setup.Ctx,
+		[]string{QueryProposedUpgrades},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(pagination.NewPaginationParams(1, 1))},
+	)
+
+	setup.Cdc.MustUnmarshalJSON(result, &listUpgrades)
+
+	// check
+	require.Equal(t, 2, listUpgrades.Total)
+	require.Equal(t, []types.ProposedUpgrade{upgrade2}, listUpgrades.Items)
+}
+
+func TestQuerier_QueryAppliedUpgrades(t *testing.T) {
+	setup := Setup()
+
+	// apply upgrade
+	plan := DefaultPlan()
+	setup.UpgradeKeeper.SetUpgradePlan(setup.Ctx, plan)
+	setup.UpgradeKeeper.SetUpgradeHandler(plan.Name, func(ctx sdk.Context, plan types.Plan) {})
+	setup.UpgradeKeeper.BeginBlocker(setup.Ctx.WithBlockHeight(plan.Height))
+
+	// query applied upgrades
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{QueryAppliedUpgrades},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(pagination.NewPaginationParams(0, 0))},
+	)
+
+	var listUpgrades types.ListAppliedUpgrades
+
+	setup.Cdc.MustUnmarshalJSON(result, &listUpgrades)
+
+	// check
+	require.Equal(t, 1, listUpgrades.Total)
+	require.Equal(t, []types.AppliedUpgrade{types.NewAppliedUpgrade(plan, plan.Height)}, listUpgrades.Items)
+}
+
+func TestQuerier_QueryModuleVersion(t *testing.T) {
+	setup := Setup()
+
+	// query version which has never been recorded
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{QueryModuleVersion, testModule},
+		abci.RequestQuery{},
+	)
+
+	var receivedVersion types.ModuleVersion
+
+	setup.Cdc.MustUnmarshalJSON(result, &receivedVersion)
+	require.Equal(t, types.NewModuleVersion(testModule, types.DefaultModuleVersion), receivedVersion)
+
+	// record version
+	setup.UpgradeKeeper.SetModuleVersion(setup.Ctx, testModule, 2)
+
+	// query version
+	result, _ = setup.Querier(
+		setup.Ctx,
+		[]string{QueryModuleVersion, testModule},
+		abci.RequestQuery{},
+	)
+
+	setup.Cdc.MustUnmarshalJSON(result, &receivedVersion)
+	require.Equal(t, types.NewModuleVersion(testModule, 2), receivedVersion)
+}
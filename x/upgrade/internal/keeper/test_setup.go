@@ -0,0 +1,76 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+type TestSetup struct {
+	Cdc           *codec.Codec
+	Ctx           sdk.Context
+	UpgradeKeeper Keeper
+	Querier       sdk.Querier
+}
+
+func Setup() TestSetup {
+	// Init Codec
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+
+	// Init KVSore
+	db := dbm.NewMemDB()
+	dbStore := store.NewCommitMultiStore(db)
+	upgradeKey := sdk.NewKVStoreKey(types.StoreKey)
+	dbStore.MountStoreWithDB(upgradeKey, sdk.StoreTypeIAVL, nil)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+	dbStore.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+
+	_ = dbStore.LoadLatestVersion()
+
+	// Init Keepers
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, paramsTKey, params.DefaultCodespace)
+	upgradeKeeper := NewKeeper(upgradeKey, paramsKeeper.Subspace(types.DefaultParamspace), cdc)
+
+	// Init Querier
+	querier := NewQuerier(upgradeKeeper)
+
+	// Create context
+	ctx := sdk.NewContext(dbStore, abci.Header{ChainID: testconstants.ChainID}, false, log.NewNopLogger())
+
+	setup := TestSetup{
+		Cdc:           cdc,
+		Ctx:           ctx,
+		UpgradeKeeper: upgradeKeeper,
+		Querier:       querier,
+	}
+
+	return setup
+}
+
+func DefaultPlan() types.Plan {
+	return types.NewPlan(testconstants.UpgradePlanName, testconstants.UpgradePlanHeight,
+		testconstants.UpgradePlanInfo)
+}
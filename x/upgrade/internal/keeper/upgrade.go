@@ -0,0 +1,70 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade/internal/types"
+)
+
+// BeginBlocker applies the scheduled upgrade plan once its height is reached.
+// If the running binary does not support the upgrade, the node is halted, so it can be replaced with the new one.
+func (k Keeper) BeginBlocker(ctx sdk.Context) {
+	if !k.IsUpgradePlanPresent(ctx) {
+		return
+	}
+
+	plan := k.GetUpgradePlan(ctx)
+
+	if !plan.ShouldExecute(ctx) {
+		// the new binary must not be started before the upgrade height
+		if k.HasUpgradeHandler(plan.Name) {
+			panic(fmt.Sprintf("BINARY UPDATED BEFORE TRIGGER! UPGRADE \"%s\" - in binary but not executed on chain",
+				plan.Name))
+		}
+
+		return
+	}
+
+	if !k.HasUpgradeHandler(plan.Name) {
+		msg := fmt.Sprintf("UPGRADE \"%s\" NEEDED at height %d: %s", plan.Name, plan.Height, plan.Info)
+		k.Logger(ctx).Error(msg)
+
+		panic(msg)
+	}
+
+	k.ApplyUpgrade(ctx, plan)
+}
+
+// ApplyUpgrade runs the store migrations of the upgrade and marks it as applied.
+func (k Keeper) ApplyUpgrade(ctx sdk.Context, plan types.Plan) {
+	k.Logger(ctx).Info(fmt.Sprintf("applying upgrade \"%s\" at height %d", plan.Name, ctx.BlockHeight()))
+
+	k.upgradeHandlers[plan.Name](ctx, plan)
+
+	k.DeleteUpgradePlan(ctx)
+	k.SetAppliedUpgrade(ctx, types.NewAppliedUpgrade(plan, ctx.BlockHeight()))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeApplyUpgrade,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyName, plan.Name),
+			sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", ctx.BlockHeight())),
+		),
+	)
+}
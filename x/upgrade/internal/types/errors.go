@@ -0,0 +1,72 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
+)
+
+const (
+	Codespace sdk.CodespaceType = ModuleName
+
+	CodeProposedUpgradeAlreadyExists sdk.CodeType = 801
+	CodeProposedUpgradeDoesNotExist  sdk.CodeType = 802
+	CodeUpgradeAlreadyApplied        sdk.CodeType = 803
+	CodeInvalidUpgradeHeight         sdk.CodeType = 804
+	CodeUpgradePlanDoesNotExist      sdk.CodeType = 805
+	CodeUpgradeAlreadyScheduled      sdk.CodeType = 806
+)
+
+func init() {
+	errcodes.Register(Codespace, CodeProposedUpgradeAlreadyExists, "proposed_upgrade_already_exists")
+	errcodes.Register(Codespace, CodeProposedUpgradeDoesNotExist, "proposed_upgrade_does_not_exist")
+	errcodes.Register(Codespace, CodeUpgradeAlreadyApplied, "upgrade_already_applied")
+	errcodes.Register(Codespace, CodeInvalidUpgradeHeight, "invalid_upgrade_height")
+	errcodes.Register(Codespace, CodeUpgradePlanDoesNotExist, "upgrade_plan_does_not_exist")
+	errcodes.Register(Codespace, CodeUpgradeAlreadyScheduled, "upgrade_already_scheduled")
+}
+
+func ErrProposedUpgradeAlreadyExists(name interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeProposedUpgradeAlreadyExists,
+		fmt.Sprintf("Proposed upgrade associated with the name=%v already exists on the ledger", name))
+}
+
+func ErrProposedUpgradeDoesNotExist(name interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeProposedUpgradeDoesNotExist,
+		fmt.Sprintf("No proposed upgrade associated with the name=%v on the ledger", name))
+}
+
+func ErrUpgradeAlreadyApplied(name interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeUpgradeAlreadyApplied,
+		fmt.Sprintf("Upgrade associated with the name=%v has already been applied", name))
+}
+
+func ErrInvalidUpgradeHeight(height interface{}, currentHeight interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeInvalidUpgradeHeight,
+		fmt.Sprintf("Upgrade height=%v must be greater than the current block height=%v", height, currentHeight))
+}
+
+func ErrUpgradePlanDoesNotExist() sdk.Error {
+	return sdk.NewError(Codespace, CodeUpgradePlanDoesNotExist, "No upgrade plan is scheduled on the ledger")
+}
+
+func ErrUpgradeAlreadyScheduled(name interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeUpgradeAlreadyScheduled,
+		fmt.Sprintf("Upgrade plan associated with the name=%v is already scheduled; "+
+			"it must be applied or cancelled first", name))
+}
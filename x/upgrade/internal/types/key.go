@@ -0,0 +1,46 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+const (
+	// ModuleName is the name of the module.
+	ModuleName = "upgrade"
+
+	// StoreKey to be used when creating the KVStore.
+	StoreKey = ModuleName
+)
+
+var (
+	ProposedUpgradePrefix = []byte{0x01} // prefix for each key to a proposed upgrade
+	UpgradePlanKey        = []byte{0x02} // key for the scheduled upgrade plan
+	AppliedUpgradePrefix  = []byte{0x03} // prefix for each key to an applied upgrade
+	ModuleVersionPrefix   = []byte{0x04} // prefix for each key to a module version
+	PlanCancellationsKey  = []byte{0x05} // key for the cancellations of the scheduled upgrade plan
+)
+
+// Key builder for Proposed Upgrade.
+func GetProposedUpgradeKey(name string) []byte {
+	return append(ProposedUpgradePrefix, []byte(name)...)
+}
+
+// Key builder for Applied Upgrade.
+func GetAppliedUpgradeKey(name string) []byte {
+	return append(AppliedUpgradePrefix, []byte(name)...)
+}
+
+// Key builder for Module Version.
+func GetModuleVersionKey(module string) []byte {
+	return append(ModuleVersionPrefix, []byte(module)...)
+}
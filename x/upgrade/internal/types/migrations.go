@@ -0,0 +1,93 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+/*
+	Store Migrations
+*/
+
+// Migration converts the store of a module from one consensus version to the next one
+// (e.g. moves records to a new key layout or fills newly added fields).
+type Migration func(ctx sdk.Context) error
+
+// MigrationRegistrar collects the store migrations provided by the modules.
+type MigrationRegistrar interface {
+	// Registers the migration of the module store from the given version to the next one.
+	RegisterMigration(module string, fromVersion uint64, migration Migration)
+}
+
+// HasConsensusVersion is implemented by the modules which store schema is versioned.
+// The modules not implementing it are considered to be of DefaultModuleVersion.
+type HasConsensusVersion interface {
+	ConsensusVersion() uint64
+}
+
+// HasMigrations is implemented by the modules which provide store migrations.
+type HasMigrations interface {
+	RegisterMigrations(registrar MigrationRegistrar)
+}
+
+/*
+	Genesis Migrations
+*/
+
+// GenesisMigration converts the exported genesis state of a module from one consensus version to the next one
+// (e.g. fills newly added fields), so the state exported by a previous version of the binary can be decoded
+// by the genesis types of this binary. The state is migrated before it is loaded, the store migrations are run
+// after that.
+type GenesisMigration func(state json.RawMessage) (json.RawMessage, error)
+
+// GenesisMigrationRegistrar collects the genesis migrations provided by the modules.
+type GenesisMigrationRegistrar interface {
+	// Registers the migration of the module genesis state from the given version to the next one.
+	RegisterGenesisMigration(module string, fromVersion uint64, migration GenesisMigration)
+}
+
+// HasGenesisMigrations is implemented by the modules which genesis state schema changed between the versions.
+type HasGenesisMigrations interface {
+	RegisterGenesisMigrations(registrar GenesisMigrationRegistrar)
+}
+
+/*
+	Module Version
+*/
+type ModuleVersion struct {
+	// the name of the module.
+	Module string `json:"module"`
+	// the consensus version of the module store schema.
+	Version uint64 `json:"version"`
+}
+
+func NewModuleVersion(module string, version uint64) ModuleVersion {
+	return ModuleVersion{
+		Module:  module,
+		Version: version,
+	}
+}
+
+func (v ModuleVersion) String() string {
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
@@ -0,0 +1,134 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const RouterKey = ModuleName
+
+/*
+	Propose an upgrade plan to be approved by Trustees
+*/
+type MsgProposeUpgrade struct {
+	Plan   Plan           `json:"plan"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgProposeUpgrade(plan Plan, signer sdk.AccAddress) MsgProposeUpgrade {
+	return MsgProposeUpgrade{
+		Plan:   plan,
+		Signer: signer,
+	}
+}
+
+func (m MsgProposeUpgrade) Route() string { return RouterKey }
+
+func (m MsgProposeUpgrade) Type() string { return EventTypeProposeUpgrade }
+
+func (m MsgProposeUpgrade) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	return m.Plan.ValidateBasic()
+}
+
+func (m MsgProposeUpgrade) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func (m MsgProposeUpgrade) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+/*
+	Approve the proposed upgrade plan
+*/
+type MsgApproveUpgrade struct {
+	Name   string         `json:"name"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgApproveUpgrade(name string, signer sdk.AccAddress) MsgApproveUpgrade {
+	return MsgApproveUpgrade{
+		Name:   name,
+		Signer: signer,
+	}
+}
+
+func (m MsgApproveUpgrade) Route() string { return RouterKey }
+
+func (m MsgApproveUpgrade) Type() string { return EventTypeApproveUpgrade }
+
+func (m MsgApproveUpgrade) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if len(m.Name) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Name: it cannot be empty")
+	}
+
+	return nil
+}
+
+func (m MsgApproveUpgrade) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func (m MsgApproveUpgrade) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+/*
+	Cancel the proposed or the scheduled upgrade plan
+*/
+type MsgCancelUpgrade struct {
+	Name   string         `json:"name"`
+	Signer sdk.AccAddress `json:"signer"`
+}
+
+func NewMsgCancelUpgrade(name string, signer sdk.AccAddress) MsgCancelUpgrade {
+	return MsgCancelUpgrade{
+		Name:   name,
+		Signer: signer,
+	}
+}
+
+func (m MsgCancelUpgrade) Route() string { return RouterKey }
+
+func (m MsgCancelUpgrade) Type() string { return EventTypeCancelUpgrade }
+
+func (m MsgCancelUpgrade) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if len(m.Name) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Name: it cannot be empty")
+	}
+
+	return nil
+}
+
+func (m MsgCancelUpgrade) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func (m MsgCancelUpgrade) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
@@ -0,0 +1,133 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//nolint:testpackage
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	testconstants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+)
+
+/*
+	MsgProposeUpgrade
+*/
+
+func TestNewMsgProposeUpgrade(t *testing.T) {
+	msg := NewMsgProposeUpgrade(NewPlan(testconstants.UpgradePlanName, testconstants.UpgradePlanHeight,
+		testconstants.UpgradePlanInfo), testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "propose_upgrade")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestValidateMsgProposeUpgrade(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgProposeUpgrade
+	}{
+		{true, NewMsgProposeUpgrade(NewPlan(testconstants.UpgradePlanName, testconstants.UpgradePlanHeight,
+			testconstants.UpgradePlanInfo), testconstants.Signer)},
+		{true, NewMsgProposeUpgrade(NewPlan(testconstants.UpgradePlanName, testconstants.UpgradePlanHeight,
+			""), testconstants.Signer)},
+		{false, NewMsgProposeUpgrade(NewPlan("", testconstants.UpgradePlanHeight,
+			testconstants.UpgradePlanInfo), testconstants.Signer)},
+		{false, NewMsgProposeUpgrade(NewPlan(string(make([]byte, MaxPlanNameLength+1)),
+			testconstants.UpgradePlanHeight, testconstants.UpgradePlanInfo), testconstants.Signer)},
+		{false, NewMsgProposeUpgrade(NewPlan(testconstants.UpgradePlanName, 0,
+			testconstants.UpgradePlanInfo), testconstants.Signer)},
+		{false, NewMsgProposeUpgrade(NewPlan(testconstants.UpgradePlanName, -1,
+			testconstants.UpgradePlanInfo), testconstants.Signer)},
+		{false, NewMsgProposeUpgrade(NewPlan(testconstants.UpgradePlanName, testconstants.UpgradePlanHeight,
+			string(make([]byte, MaxPlanInfoLength+1))), testconstants.Signer)},
+		{false, NewMsgProposeUpgrade(NewPlan(testconstants.UpgradePlanName, testconstants.UpgradePlanHeight,
+			testconstants.UpgradePlanInfo), nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+/*
+	MsgApproveUpgrade
+*/
+
+func TestNewMsgApproveUpgrade(t *testing.T) {
+	msg := NewMsgApproveUpgrade(testconstants.UpgradePlanName, testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "approve_upgrade")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestNewMsgCancelUpgrade(t *testing.T) {
+	msg := NewMsgCancelUpgrade(testconstants.UpgradePlanName, testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "cancel_upgrade")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{testconstants.Signer})
+}
+
+func TestValidateMsgCancelUpgrade(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgCancelUpgrade
+	}{
+		{true, NewMsgCancelUpgrade(testconstants.UpgradePlanName, testconstants.Signer)},
+		{false, NewMsgCancelUpgrade("", testconstants.Signer)},
+		{false, NewMsgCancelUpgrade(testconstants.UpgradePlanName, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+func TestValidateMsgApproveUpgrade(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgApproveUpgrade
+	}{
+		{true, NewMsgApproveUpgrade(testconstants.UpgradePlanName, testconstants.Signer)},
+		{false, NewMsgApproveUpgrade("", testconstants.Signer)},
+		{false, NewMsgApproveUpgrade(testconstants.UpgradePlanName, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
+)
+
+// DefaultParamspace is the name of the parameter subspace of the module.
+const DefaultParamspace = ModuleName
+
+// Parameter keys of the module subspace.
+var (
+	KeyUpgradeApprovalPercent = []byte("UpgradeApprovalPercent")
+)
+
+// Share of trustees required to approve an upgrade plan by default.
+var DefaultUpgradeApprovalPercent = sdk.NewDecWithPrec(66, 2)
+
+/*
+	Parameters of the module stored in the module subspace (can be changed by Trustees)
+*/
+type Params struct {
+	// the share of trustees required to approve (or cancel) an upgrade plan
+	UpgradeApprovalPercent sdk.Dec `json:"upgrade_approval_percent"`
+}
+
+func NewParams(upgradeApprovalPercent sdk.Dec) Params {
+	return Params{
+		UpgradeApprovalPercent: upgradeApprovalPercent,
+	}
+}
+
+func DefaultParams() Params {
+	return NewParams(DefaultUpgradeApprovalPercent)
+}
+
+// ParamKeyTable returns the key table of the module subspace.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyUpgradeApprovalPercent, Value: &p.UpgradeApprovalPercent},
+	}
+}
+
+func (p Params) Validate() error {
+	if p.UpgradeApprovalPercent.IsNil() || !p.UpgradeApprovalPercent.IsPositive() ||
+		p.UpgradeApprovalPercent.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid UpgradeApprovalPercent: it must be greater than 0 and at most 1, got %v",
+			p.UpgradeApprovalPercent)
+	}
+
+	return nil
+}
+
+// UpgradeApprovalPolicy returns the policy used to vote for upgrade proposals.
+func (p Params) UpgradeApprovalPolicy() approvals.Policy {
+	return approvals.NewPolicy(approvals.DecQuorum(p.UpgradeApprovalPercent), approvals.NoExpiration)
+}
+
+func (p Params) String() string {
+	bytes, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
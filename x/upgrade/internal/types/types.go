@@ -0,0 +1,138 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
+)
+
+/*
+	Upgrade Plan
+*/
+type Plan struct {
+	// the name of the upgrade, the new binary registers its upgrade handler under this name.
+	Name string `json:"name"`
+	// the block height at which the upgrade must be performed.
+	Height int64 `json:"height"`
+	// any application specific upgrade info (e.g. the location of the new binary).
+	Info string `json:"info,omitempty"`
+}
+
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+func (p Plan) ValidateBasic() sdk.Error {
+	if len(p.Name) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Name: it cannot be empty")
+	}
+
+	if len(p.Name) > MaxPlanNameLength {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Name: received string of length %v, max is %v",
+			len(p.Name), MaxPlanNameLength))
+	}
+
+	if p.Height <= 0 {
+		return sdk.ErrUnknownRequest("Invalid Height: it must be positive")
+	}
+
+	if len(p.Info) > MaxPlanInfoLength {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Info: received string of length %v, max is %v",
+			len(p.Info), MaxPlanInfoLength))
+	}
+
+	return nil
+}
+
+// Check if the upgrade must be performed at the current block.
+func (p Plan) ShouldExecute(ctx sdk.Context) bool {
+	return p.Height <= ctx.BlockHeight()
+}
+
+func (p Plan) String() string {
+	bytes, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+/*
+	Proposed (but not Approved yet) Upgrade
+*/
+type ProposedUpgrade struct {
+	Plan      Plan             `json:"plan"`
+	Creator   sdk.AccAddress   `json:"creator"`
+	Approvals     []sdk.AccAddress `json:"approvals"`     // trustees approved the upgrade
+	Cancellations []sdk.AccAddress `json:"cancellations"` // trustees cancelled the upgrade
+}
+
+func NewProposedUpgrade(plan Plan, creator sdk.AccAddress) ProposedUpgrade {
+	return ProposedUpgrade{
+		Plan:      plan,
+		Creator:   creator,
+		Approvals: []sdk.AccAddress{creator},
+	}
+}
+
+func (u ProposedUpgrade) HasApprovalFrom(address sdk.AccAddress) bool {
+	return approvals.HasApprovalFrom(u.Approvals, address)
+}
+
+func (u ProposedUpgrade) String() string {
+	bytes, err := json.Marshal(u)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+/*
+	Applied Upgrade
+*/
+type AppliedUpgrade struct {
+	Plan          Plan  `json:"plan"`
+	AppliedHeight int64 `json:"applied_height"` // the block height the upgrade was applied at
+}
+
+func NewAppliedUpgrade(plan Plan, appliedHeight int64) AppliedUpgrade {
+	return AppliedUpgrade{
+		Plan:          plan,
+		AppliedHeight: appliedHeight,
+	}
+}
+
+func (u AppliedUpgrade) String() string {
+	bytes, err := json.Marshal(u)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+// UpgradeHandler is registered by a new binary for the upgrade it supports.
+// It is executed once at the upgrade height and performs store migrations of the modules changed by the upgrade.
+type UpgradeHandler func(ctx sdk.Context, plan Plan)
@@ -23,20 +23,50 @@ const (
 	ModuleName = types.ModuleName
 	StoreKey   = types.StoreKey
 	RouterKey  = types.RouterKey
+
+	DefaultParamspace = types.DefaultParamspace
+
+	QueryValidator  = keeper.QueryValidator
+	QueryValidators = keeper.QueryValidators
 )
 
 var (
-	NewKeeper  = keeper.NewKeeper
-	NewQuerier = keeper.NewQuerier
+	NewKeeper              = keeper.NewKeeper
+	NewQuerier             = keeper.NewQuerier
+	ValidatorPrefix        = types.ValidatorPrefix
+	PendingValidatorPrefix = types.PendingValidatorPrefix
 
-	NewValidator  = types.NewValidator
-	RegisterCodec = types.RegisterCodec
-	ModuleCdc     = types.ModuleCdc
+	NewValidator              = types.NewValidator
+	NewValidatorSigningInfo   = types.NewValidatorSigningInfo
+	NewDescription            = types.NewDescription
+	NewMsgCreateValidator     = types.NewMsgCreateValidator
+	NewMsgProposeAddValidator = types.NewMsgProposeAddValidator
+	NewMsgApproveAddValidator = types.NewMsgApproveAddValidator
+	NewMsgRejectAddValidator  = types.NewMsgRejectAddValidator
+	NewMsgDisableValidator    = types.NewMsgDisableValidator
+	NewMsgEnableValidator     = types.NewMsgEnableValidator
+	NewParams                 = types.NewParams
+	DefaultParams             = types.DefaultParams
+	RegisterCodec             = types.RegisterCodec
+	ModuleCdc                 = types.ModuleCdc
 )
 
 type (
 	Keeper = keeper.Keeper
 
-	Validator          = types.Validator
-	MsgCreateValidator = types.MsgCreateValidator
+	Validator                 = types.Validator
+	PendingValidator          = types.PendingValidator
+	ListPendingValidatorItems = types.ListPendingValidatorItems
+	ListValidatorItems        = types.ListValidatorItems
+	ListValidatorsParams      = types.ListValidatorsParams
+	MsgCreateValidator        = types.MsgCreateValidator
+	MsgProposeAddValidator    = types.MsgProposeAddValidator
+	MsgApproveAddValidator    = types.MsgApproveAddValidator
+	MsgRejectAddValidator     = types.MsgRejectAddValidator
+	DisabledValidator         = types.DisabledValidator
+	SlashedValidator          = types.SlashedValidator
+	ValidatorSigningInfo      = types.ValidatorSigningInfo
+	MsgDisableValidator       = types.MsgDisableValidator
+	MsgEnableValidator        = types.MsgEnableValidator
+	Params                    = types.Params
 )
@@ -25,4 +25,5 @@ const (
 	FlagNodeID   = "node-id"
 	FlagIP       = "ip"
 	FlagState    = "state"
+	FlagReason   = "reason"
 )
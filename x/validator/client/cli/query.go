@@ -38,7 +38,11 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 	validatorQueryCmd.AddCommand(client.GetCommands(
 		GetCmdQueryValidator(queryRoute, cdc),
-		GetCmdQueryValidators(queryRoute, cdc))...)
+		GetCmdQueryValidators(queryRoute, cdc),
+		GetCmdQueryPendingValidator(queryRoute, cdc),
+		GetCmdQueryPendingValidators(queryRoute, cdc),
+		GetCmdQueryDisabledValidators(queryRoute, cdc),
+		GetCmdQuerySlashedValidators(queryRoute, cdc))...)
 
 	return validatorQueryCmd
 }
@@ -98,6 +102,107 @@ func GetCmdQueryValidators(storeName string, cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagState, "", "state of a validator (active/jailed)")
 	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of validators to skip")
 	cmd.Flags().Int(pagination.FlagTake, 0, "amount of validators to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
+
+// GetCmdQueryPendingValidator implements the proposed node query command.
+func GetCmdQueryPendingValidator(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposed-node",
+		Short: "Query a proposed but not approved validator node",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.ConsAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			res, height, err := cliCtx.QueryStore(types.GetPendingValidatorKey(addr), storeName)
+			if err != nil || res == nil {
+				return types.ErrPendingValidatorDoesNotExist(addr)
+			}
+
+			var pendingValidator types.PendingValidator
+			cdc.MustUnmarshalBinaryBare(res, &pendingValidator)
+
+			return cliCtx.EncodeAndPrintWithHeight(pendingValidator, height)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "The Bech32 encoded Address of the validator")
+	cmd.Flags().Bool(cli.FlagPreviousHeight, false, cli.FlagPreviousHeightUsage)
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
+// GetCmdQueryPendingValidators implements the query all proposed nodes command.
+func GetCmdQueryPendingValidators(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-proposed-nodes",
+		Short: "Query for all proposed but not approved validators",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/pending_validators", storeName), params)
+		},
+	}
+
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of validators to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of validators to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
+
+// GetCmdQueryDisabledValidators implements the query all disabled nodes command.
+func GetCmdQueryDisabledValidators(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-disabled-nodes",
+		Short: "Query for all disabled validators",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/disabled_validators", storeName), params)
+		},
+	}
+
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of validators to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of validators to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
+
+	return cmd
+}
+
+// GetCmdQuerySlashedValidators implements the query all slashed nodes command.
+func GetCmdQuerySlashedValidators(storeName string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-slashed-nodes",
+		Short: "Query for all validators slashed because of misbehaviour evidence",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			params := pagination.ParsePaginationParamsFromFlags()
+
+			return cliCtx.QueryList(fmt.Sprintf("custom/%s/slashed_validators", storeName), params)
+		},
+	}
+
+	cmd.Flags().Int(pagination.FlagSkip, 0, "amount of validators to skip")
+	cmd.Flags().Int(pagination.FlagTake, 0, "amount of validators to take")
+	cmd.Flags().String(pagination.FlagKey, "", pagination.FlagKeyUsage)
 
 	return cmd
 }
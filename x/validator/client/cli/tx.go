@@ -45,6 +45,11 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 	validatorTxCmd.AddCommand(flags.PostCommands(
 		GetCmdCreateValidator(cdc),
+		GetCmdProposeAddValidator(cdc),
+		GetCmdApproveAddValidator(cdc),
+		GetCmdRejectAddValidator(cdc),
+		GetCmdDisableValidator(cdc),
+		GetCmdEnableValidator(cdc),
 	)...)
 
 	return validatorTxCmd
@@ -80,6 +85,153 @@ func GetCmdCreateValidator(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdProposeAddValidator implements the propose validator command handler.
+func GetCmdProposeAddValidator(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-add-node",
+		Short: "Proposes a new validator node to be approved by Trustees",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.ConsAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			description := types.NewDescription(
+				viper.GetString(FlagName),
+				viper.GetString(FlagIdentity),
+				viper.GetString(FlagWebsite),
+				viper.GetString(FlagDetails),
+			)
+
+			msg := types.NewMsgProposeAddValidator(address, viper.GetString(FlagPubKey),
+				description, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(InitValidatorFlags())
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+	_ = cmd.MarkFlagRequired(FlagPubKey)
+	_ = cmd.MarkFlagRequired(FlagName)
+
+	return cmd
+}
+
+// GetCmdApproveAddValidator implements the approve validator command handler.
+func GetCmdApproveAddValidator(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-add-node",
+		Short: "Approves the proposed validator node",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.ConsAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgApproveAddValidator(address, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "The Bech32 encoded Address of the validator")
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
+// GetCmdRejectAddValidator implements the reject validator command handler.
+func GetCmdRejectAddValidator(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reject-add-node",
+		Short: "Rejects the proposed validator node (the owner of the proposal withdraws it at once)",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.ConsAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRejectAddValidator(address, viper.GetString(FlagReason), cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "The Bech32 encoded Address of the validator")
+	cmd.Flags().String(FlagReason, "", "The (optional) reason of rejection")
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
+// GetCmdDisableValidator implements the disable validator command handler.
+func GetCmdDisableValidator(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disable-node",
+		Short: "Disables the validator node, so it is removed from the validator set without slashing",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.ConsAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDisableValidator(address, viper.GetString(FlagReason), cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "The Bech32 encoded Address of the validator")
+	cmd.Flags().String(FlagReason, "", "The (optional) reason of disabling")
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
+// GetCmdEnableValidator implements the enable validator command handler.
+func GetCmdEnableValidator(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enable-node",
+		Short: "Enables the disabled validator node, so it is returned to the validator set",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := cli.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.ConsAddressFromBech32(viper.GetString(FlagAddress))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgEnableValidator(address, cliCtx.FromAddress())
+
+			return cliCtx.HandleWriteMessage(msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "The Bech32 encoded Address of the validator")
+
+	_ = cmd.MarkFlagRequired(FlagAddress)
+
+	return cmd
+}
+
 // Return the flagset for create validator command.
 func InitValidatorFlags() (fs *flag.FlagSet) {
 	fsCreateValidator := flag.NewFlagSet("", flag.ContinueOnError)
@@ -20,7 +20,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/rest"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator/internal/types"
 )
@@ -31,7 +30,11 @@ func getValidatorsHandlerFn(cliCtx context.CLIContext, storeName string) http.Ha
 		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
 
 		state := r.FormValue(state)
-		paginationParams := pagination.ParsePaginationParamsFromFlags()
+		paginationParams, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
 		params := types.NewListValidatorsParams(paginationParams, types.ValidatorState(state))
 
 		restCtx.QueryList(fmt.Sprintf("custom/%s/validators", storeName), params)
@@ -48,14 +51,14 @@ func getValidatorHandlerFn(cliCtx context.CLIContext, storeName string) http.Han
 
 		validatorAddr, err := sdk.ConsAddressFromBech32(bech32validatorAddr)
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
 
 		res, height, err := restCtx.QueryStore(types.GetValidatorKey(validatorAddr), storeName)
 		if err != nil || res == nil {
-			restCtx.WriteErrorResponse(http.StatusNotFound, types.ErrValidatorDoesNotExist(validatorAddr).Error())
+			restCtx.WriteError(http.StatusNotFound, types.ErrValidatorDoesNotExist(validatorAddr))
 
 			return
 		}
@@ -65,3 +68,75 @@ func getValidatorHandlerFn(cliCtx context.CLIContext, storeName string) http.Han
 		restCtx.EncodeAndRespondWithHeight(validator, height)
 	}
 }
+
+// HTTP request handler to query list of proposed validators.
+func getPendingValidatorsHandlerFn(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		paginationParams, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/pending_validators", storeName), paginationParams)
+	}
+}
+
+// HTTP request handler to query the proposed validator information from a given validator address.
+func getPendingValidatorHandlerFn(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+		bech32validatorAddr := vars[validatorAddr]
+
+		validatorAddr, err := sdk.ConsAddressFromBech32(bech32validatorAddr)
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		res, height, err := restCtx.QueryStore(types.GetPendingValidatorKey(validatorAddr), storeName)
+		if err != nil || res == nil {
+			restCtx.WriteError(http.StatusNotFound, types.ErrPendingValidatorDoesNotExist(validatorAddr))
+
+			return
+		}
+
+		var pendingValidator types.PendingValidator
+
+		restCtx.Codec().MustUnmarshalBinaryBare(res, &pendingValidator)
+
+		restCtx.EncodeAndRespondWithHeight(pendingValidator, height)
+	}
+}
+
+// HTTP request handler to query list of disabled validators.
+func getDisabledValidatorsHandlerFn(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		paginationParams, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/disabled_validators", storeName), paginationParams)
+	}
+}
+
+// HTTP request handler to query list of slashed validators.
+func getSlashedValidatorsHandlerFn(cliCtx context.CLIContext, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		paginationParams, err := restCtx.ParsePaginationParams()
+		if err != nil {
+			return
+		}
+
+		restCtx.QueryList(fmt.Sprintf("custom/%s/slashed_validators", storeName), paginationParams)
+	}
+}
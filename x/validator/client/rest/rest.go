@@ -36,6 +36,42 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, storeName string)
 		"/validators",
 		getValidatorsHandlerFn(cliCtx, storeName),
 	).Methods("GET")
+	r.HandleFunc(
+		"/validators/proposed",
+		proposeValidatorHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/validators/proposed",
+		getPendingValidatorsHandlerFn(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/validators/proposed/{%s}", validatorAddr),
+		approveValidatorHandlerFn(cliCtx),
+	).Methods("PATCH")
+	r.HandleFunc(
+		fmt.Sprintf("/validators/proposed/{%s}", validatorAddr),
+		rejectValidatorHandlerFn(cliCtx),
+	).Methods("DELETE")
+	r.HandleFunc(
+		fmt.Sprintf("/validators/proposed/{%s}", validatorAddr),
+		getPendingValidatorHandlerFn(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		"/validators/disabled",
+		disableValidatorHandlerFn(cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/validators/disabled",
+		getDisabledValidatorsHandlerFn(cliCtx, storeName),
+	).Methods("GET")
+	r.HandleFunc(
+		fmt.Sprintf("/validators/disabled/{%s}", validatorAddr),
+		enableValidatorHandlerFn(cliCtx),
+	).Methods("DELETE")
+	r.HandleFunc(
+		"/validators/slashed",
+		getSlashedValidatorsHandlerFn(cliCtx, storeName),
+	).Methods("GET")
 	r.HandleFunc(
 		fmt.Sprintf("/validators/{%s}", validatorAddr),
 		getValidatorHandlerFn(cliCtx, storeName),
@@ -31,11 +31,50 @@ type CreateValidatorRequest struct {
 	Description types.Description `json:"description"`
 }
 
+type DisableValidatorRequest struct {
+	BaseReq restTypes.BaseReq `json:"base_req"`
+	Address sdk.ConsAddress   `json:"validator_address"`
+	Reason  string            `json:"reason,omitempty"`
+}
+
+type RejectValidatorRequest struct {
+	BaseReq restTypes.BaseReq `json:"base_req"`
+	Reason  string            `json:"reason,omitempty"`
+}
+
 func createValidatorHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx, req, ok := readValidatorRequest(w, r, cliCtx)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgCreateValidator(req.Address, req.Pubkey, req.Description, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func proposeValidatorHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx, req, ok := readValidatorRequest(w, r, cliCtx)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgProposeAddValidator(req.Address, req.Pubkey, req.Description, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func approveValidatorHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
 
-		var req CreateValidatorRequest
+		vars := restCtx.Variables()
+
+		var req rest.BasicReq
 		if !restCtx.ReadRESTReq(&req) {
 			return
 		}
@@ -50,15 +89,137 @@ func createValidatorHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		_, err = sdk.GetConsPubKeyBech32(req.Pubkey)
+		address, err := sdk.ConsAddressFromBech32(vars[validatorAddr])
 		if err != nil {
-			restCtx.WriteErrorResponse(http.StatusBadRequest, err.Error())
+			restCtx.WriteError(http.StatusBadRequest, err)
 
 			return
 		}
 
-		msg := types.NewMsgCreateValidator(req.Address, req.Pubkey, req.Description, restCtx.Signer())
+		msg := types.NewMsgApproveAddValidator(address, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func rejectValidatorHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		var req RejectValidatorRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		address, err := sdk.ConsAddressFromBech32(vars[validatorAddr])
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		msg := types.NewMsgRejectAddValidator(address, req.Reason, restCtx.Signer())
 
 		restCtx.HandleWriteRequest(msg)
 	}
 }
+
+func disableValidatorHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		var req DisableValidatorRequest
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		msg := types.NewMsgDisableValidator(req.Address, req.Reason, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func enableValidatorHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+		vars := restCtx.Variables()
+
+		var req rest.BasicReq
+		if !restCtx.ReadRESTReq(&req) {
+			return
+		}
+
+		restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+		if err != nil {
+			return
+		}
+
+		restCtx, err = restCtx.WithSigner()
+		if err != nil {
+			return
+		}
+
+		address, err := sdk.ConsAddressFromBech32(vars[validatorAddr])
+		if err != nil {
+			restCtx.WriteError(http.StatusBadRequest, err)
+
+			return
+		}
+
+		msg := types.NewMsgEnableValidator(address, restCtx.Signer())
+
+		restCtx.HandleWriteRequest(msg)
+	}
+}
+
+func readValidatorRequest(w http.ResponseWriter, r *http.Request,
+	cliCtx context.CLIContext) (rest.RestContext, CreateValidatorRequest, bool) {
+	restCtx := rest.NewRestContext(w, r).WithCodec(cliCtx.Codec)
+
+	var req CreateValidatorRequest
+	if !restCtx.ReadRESTReq(&req) {
+		return restCtx, req, false
+	}
+
+	restCtx, err := restCtx.WithBaseRequest(req.BaseReq)
+	if err != nil {
+		return restCtx, req, false
+	}
+
+	restCtx, err = restCtx.WithSigner()
+	if err != nil {
+		return restCtx, req, false
+	}
+
+	_, err = sdk.GetConsPubKeyBech32(req.Pubkey)
+	if err != nil {
+		restCtx.WriteError(http.StatusBadRequest, err)
+
+		return restCtx, req, false
+	}
+
+	return restCtx, req, true
+}
@@ -24,10 +24,15 @@ import (
 )
 
 type GenesisState struct {
-	Validators     []Validator                           `json:"validators"`
-	LastValidators []types.LastValidatorPower            `json:"last_validators"`
-	SigningInfos   map[string]types.ValidatorSigningInfo `json:"signing_infos"`
-	MissedBlocks   map[string][]MissedBlock              `json:"missed_blocks"`
+	Validators         []Validator                           `json:"validators"`
+	LastValidators     []types.LastValidatorPower            `json:"last_validators"`
+	SigningInfos       map[string]types.ValidatorSigningInfo `json:"signing_infos"`
+	MissedBlocks       map[string][]MissedBlock              `json:"missed_blocks"`
+	PendingValidators  []types.PendingValidator              `json:"pending_validators"`
+	DisabledValidators []types.DisabledValidator             `json:"disabled_validators"`
+	SlashedValidators  []types.SlashedValidator              `json:"slashed_validators"`
+	// default params are used if not set
+	Params *types.Params `json:"params,omitempty"`
 }
 
 type MissedBlock struct {
@@ -36,11 +41,17 @@ type MissedBlock struct {
 }
 
 func DefaultGenesisState() GenesisState {
+	params := types.DefaultParams()
+
 	return GenesisState{
-		Validators:     []Validator{},
-		LastValidators: []types.LastValidatorPower{},
-		SigningInfos:   make(map[string]types.ValidatorSigningInfo),
-		MissedBlocks:   make(map[string][]MissedBlock),
+		Validators:         []Validator{},
+		LastValidators:     []types.LastValidatorPower{},
+		SigningInfos:       make(map[string]types.ValidatorSigningInfo),
+		MissedBlocks:       make(map[string][]MissedBlock),
+		PendingValidators:  []types.PendingValidator{},
+		DisabledValidators: []types.DisabledValidator{},
+		SlashedValidators:  []types.SlashedValidator{},
+		Params:             &params,
 	}
 }
 
@@ -76,6 +87,22 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) (res []abci.
 		}
 	}
 
+	for _, pendingValidator := range data.PendingValidators {
+		keeper.SetPendingValidator(ctx, pendingValidator)
+	}
+
+	for _, disabledValidator := range data.DisabledValidators {
+		keeper.SetDisabledValidator(ctx, disabledValidator)
+	}
+
+	for _, slashedValidator := range data.SlashedValidators {
+		keeper.SetSlashedValidator(ctx, slashedValidator)
+	}
+
+	if data.Params != nil {
+		keeper.SetParams(ctx, *data.Params)
+	}
+
 	res = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
 
 	return res
@@ -85,6 +112,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) (res []abci.
 func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	validators := keeper.GetAllValidators(ctx)
 	lastValidators := keeper.GetLastValidatorPowers(ctx)
+	pendingValidators := keeper.GetAllPendingValidators(ctx)
+	disabledValidators := keeper.GetAllDisabledValidators(ctx)
+	slashedValidators := keeper.GetAllSlashedValidators(ctx)
+	params := keeper.GetParams(ctx)
 
 	signingInfos := make(map[string]types.ValidatorSigningInfo)
 	missedBlocks := make(map[string][]MissedBlock)
@@ -105,16 +136,26 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	})
 
 	return GenesisState{
-		Validators:     validators,
-		LastValidators: lastValidators,
-		SigningInfos:   signingInfos,
-		MissedBlocks:   missedBlocks,
+		Validators:         validators,
+		LastValidators:     lastValidators,
+		SigningInfos:       signingInfos,
+		MissedBlocks:       missedBlocks,
+		PendingValidators:  pendingValidators,
+		DisabledValidators: disabledValidators,
+		SlashedValidators:  slashedValidators,
+		Params:             &params,
 	}
 }
 
 // ValidateGenesis validates the provided validator genesis state to ensure the
 // expected invariants holds.
 func ValidateGenesis(data GenesisState) error {
+	if data.Params != nil {
+		if err := data.Params.Validate(); err != nil {
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Params: %v", err))
+		}
+	}
+
 	err := validateGenesisStateValidators(data.Validators)
 	if err != nil {
 		return err
@@ -19,12 +19,29 @@ import (
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/functions"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator/internal/types"
 )
 
+func init() {
+	auth.RegisterMsgRoles(types.MsgCreateValidator{}, auth.NodeAdmin)
+	auth.RegisterMsgRoles(types.MsgProposeAddValidator{}, auth.NodeAdmin)
+	auth.RegisterMsgRoles(types.MsgApproveAddValidator{}, auth.Trustee)
+	auth.RegisterMsgRoles(types.MsgRejectAddValidator{}, auth.Trustee, auth.NodeAdmin)
+	auth.RegisterMsgRoles(types.MsgDisableValidator{}, auth.Trustee, auth.NodeAdmin)
+	auth.RegisterMsgRoles(types.MsgEnableValidator{}, auth.Trustee, auth.NodeAdmin)
+}
+
+// ValidatorApprovalPolicy defines the share of trustees required to approve adding of a validator after genesis.
+// The share is a parameter of the module, so it can be changed by trustees.
+func ValidatorApprovalPolicy(ctx sdk.Context, k Keeper) approvals.Policy {
+	return k.GetParams(ctx).ValidatorApprovalPolicy()
+}
+
 func NewHandler(k Keeper, authKeeper auth.Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
@@ -32,6 +49,16 @@ func NewHandler(k Keeper, authKeeper auth.Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgCreateValidator:
 			return handleMsgCreateValidator(ctx, msg, k, authKeeper)
+		case MsgProposeAddValidator:
+			return handleMsgProposeAddValidator(ctx, msg, k, authKeeper)
+		case MsgApproveAddValidator:
+			return handleMsgApproveAddValidator(ctx, msg, k, authKeeper)
+		case MsgRejectAddValidator:
+			return handleMsgRejectAddValidator(ctx, msg, k, authKeeper)
+		case MsgDisableValidator:
+			return handleMsgDisableValidator(ctx, msg, k, authKeeper)
+		case MsgEnableValidator:
+			return handleMsgEnableValidator(ctx, msg, k, authKeeper)
 		default:
 			errMsg := fmt.Sprintf("unrecognized validator Msg type: %v", msg.Type())
 
@@ -43,44 +70,224 @@ func NewHandler(k Keeper, authKeeper auth.Keeper) sdk.Handler {
 func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator,
 	k Keeper, authKeeper auth.Keeper) sdk.Result {
 	// check if sender has enough rights to create a validator node
-	if !authKeeper.HasRole(ctx, msg.Signer, auth.NodeAdmin) {
-		return sdk.ErrUnauthorized(fmt.Sprintf("CreateValidator transaction should be "+
-			"signed by an account with the \"%s\" role", auth.NodeAdmin)).Result()
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
 	}
 
-	if k.AccountHasValidator(ctx, msg.Signer) {
-		return types.ErrAccountAlreadyHasNode(msg.Signer).Result()
+	// after genesis validators can be added only by approved proposals
+	if ctx.BlockHeight() > 0 {
+		return types.ErrValidatorApprovalRequired().Result()
 	}
 
-	// check if we has not reached the limit of nodes
-	if k.CountLastValidators(ctx) == types.MaxNodes {
-		return types.ErrPoolIsFull().Result()
+	if err := checkValidatorCanBeAdded(ctx, k, msg.Signer, msg.Address, msg.GetPubKey()); err != nil {
+		return err.Result()
 	}
 
-	// check if a validator with a given address already exists
-	if k.IsValidatorPresent(ctx, msg.Address) {
-		return types.ErrValidatorExists(msg.Address).Result()
+	// create and store validator
+	addValidator(ctx, k, NewValidator(msg.Address, msg.PubKey, msg.Description, msg.Signer))
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateValidator,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgProposeAddValidator(ctx sdk.Context, msg types.MsgProposeAddValidator,
+	k Keeper, authKeeper auth.Keeper) sdk.Result {
+	// check if sender has enough rights to propose a validator node
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
 	}
 
-	// check key type
-	if ctx.ConsensusParams() != nil {
-		tmPubKey := tmtypes.TM2PB.PubKey(msg.GetPubKey())
-		if !functions.StringInSlice(tmPubKey.Type, ctx.ConsensusParams().Validator.PubKeyTypes) {
-			return sdk.ErrUnknownRequest(
-				fmt.Sprintf("Validator pubkey type \"%s\" is not supported. Supported types: [%s]",
-					tmPubKey.Type, strings.Join(ctx.ConsensusParams().Validator.PubKeyTypes, ","))).Result()
-		}
+	if err := checkValidatorCanBeAdded(ctx, k, msg.Signer, msg.Address, msg.GetPubKey()); err != nil {
+		return err.Result()
+	}
+
+	// check if a validator with a given address is already proposed
+	if k.IsPendingValidatorPresent(ctx, msg.Address) {
+		return types.ErrPendingValidatorAlreadyExists(msg.Address).Result()
+	}
+
+	// store pending validator until it is approved by Trustees
+	pendingValidator := types.NewPendingValidator(msg.Address, msg.PubKey, msg.Description, msg.Signer)
+	pendingValidator.ProposedAt = ctx.BlockHeight()
+	k.SetPendingValidator(ctx, pendingValidator)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProposeAddValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgApproveAddValidator(ctx sdk.Context, msg types.MsgApproveAddValidator,
+	k Keeper, authKeeper auth.Keeper) sdk.Result {
+	// check if sender has enough rights to approve a validator node
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// check if pending validator exists
+	if !k.IsPendingValidatorPresent(ctx, msg.Address) {
+		return types.ErrPendingValidatorDoesNotExist(msg.Address).Result()
+	}
+
+	pendingValidator := k.GetPendingValidator(ctx, msg.Address)
+
+	// check if pending validator already has approval from signer
+	approved, added := approvals.AddApproval(pendingValidator.Approvals, msg.Signer)
+	if !added {
+		return sdk.ErrUnauthorized(
+			fmt.Sprintf("Pending validator associated with the validator_address=%v already has approval from=%v",
+				msg.Address, msg.Signer)).Result()
+	}
+
+	// check if pending validator already has rejection from signer
+	if pendingValidator.HasRejectionFrom(msg.Signer) {
+		return sdk.ErrUnauthorized(
+			fmt.Sprintf("Pending validator associated with the validator_address=%v already has rejection from=%v",
+				msg.Address, msg.Signer)).Result()
+	}
+
+	// append approval
+	pendingValidator.Approvals = approved
+
+	// check if pending validator has enough approvals
+	if !ValidatorApprovalPolicy(ctx, k).IsReached(len(pendingValidator.Approvals),
+		authKeeper.CountAccountsWithRole(ctx, auth.Trustee)) {
+		// update pending validator record
+		k.SetPendingValidator(ctx, pendingValidator)
+
+		return sdk.Result{}
+	}
+
+	// the ledger state may have changed while the validator was pending,
+	// such a proposal can only be rejected by trustees or withdrawn by its owner
+	validator := pendingValidator.Validator()
+	if err := checkValidatorCanBeAdded(ctx, k, validator.Owner, validator.Address,
+		validator.GetConsPubKey()); err != nil {
+		return err.Result()
 	}
 
 	// create and store validator
-	validator := NewValidator(msg.Address, msg.PubKey, msg.Description, msg.Signer)
+	addValidator(ctx, k, validator)
 
-	k.SetValidator(ctx, validator)
-	k.SetValidatorOwner(ctx, msg.Signer, msg.Address)
+	// delete pending validator record
+	k.DeletePendingValidator(ctx, msg.Address)
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCreateValidator,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRejectAddValidator(ctx sdk.Context, msg types.MsgRejectAddValidator,
+	k Keeper, authKeeper auth.Keeper) sdk.Result {
+	// check if sender has enough rights to reject a validator node
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err.Result()
+	}
+
+	// check if pending validator exists
+	if !k.IsPendingValidatorPresent(ctx, msg.Address) {
+		return types.ErrPendingValidatorDoesNotExist(msg.Address).Result()
+	}
+
+	pendingValidator := k.GetPendingValidator(ctx, msg.Address)
+
+	// the owner withdraws the own proposal at once, other node admins cannot reject it
+	if !pendingValidator.Owner.Equals(msg.Signer) {
+		if !authKeeper.HasRole(ctx, msg.Signer, auth.Trustee) {
+			return sdk.ErrUnauthorized(
+				fmt.Sprintf("%s transaction should be signed by a Trustee or the validator owner", msg.Type())).Result()
+		}
+
+		// check if pending validator already has approval or rejection from signer
+		rejected, added := approvals.AddApproval(pendingValidator.Rejections, msg.Signer)
+		if !added || pendingValidator.HasApprovalFrom(msg.Signer) {
+			return sdk.ErrUnauthorized(
+				fmt.Sprintf("Pending validator associated with the validator_address=%v "+
+					"already has approval or rejection from=%v", msg.Address, msg.Signer)).Result()
+		}
+
+		// append rejection
+		pendingValidator.Rejections = rejected
+
+		// check if pending validator has enough rejections
+		if !ValidatorApprovalPolicy(ctx, k).IsReached(len(pendingValidator.Rejections),
+			authKeeper.CountAccountsWithRole(ctx, auth.Trustee)) {
+			// update pending validator record
+			k.SetPendingValidator(ctx, pendingValidator)
+
+			return sdk.Result{}
+		}
+	}
+
+	// delete pending validator record, so the address can be proposed again
+	k.DeletePendingValidator(ctx, msg.Address)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRejectAddValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgDisableValidator(ctx sdk.Context, msg types.MsgDisableValidator,
+	k Keeper, authKeeper auth.Keeper) sdk.Result {
+	// check if sender has enough rights to disable a validator node
+	if err := checkValidatorStateChangeRights(ctx, k, authKeeper, msg, msg.Address, msg.Signer); err != nil {
+		return err.Result()
+	}
+
+	if k.IsValidatorDisabled(ctx, msg.Address) {
+		return types.ErrValidatorAlreadyDisabled(msg.Address).Result()
+	}
+
+	// the ledger must not be left without validators
+	if k.GetValidator(ctx, msg.Address).GetPower() > 0 && k.CountValidatorsWithPower(ctx) == 1 {
+		return types.ErrLastActiveValidator(msg.Address).Result()
+	}
+
+	disabledValidator := types.NewDisabledValidator(msg.Address, msg.Reason, msg.Signer,
+		ctx.BlockHeader().Time, ctx.BlockHeight())
+	k.Disable(ctx, disabledValidator)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDisableValidator,
 			sdk.NewAttribute(types.AttributeKeyValidator, msg.Address.String()),
 		),
 		sdk.NewEvent(
@@ -91,3 +298,87 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator,
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+func handleMsgEnableValidator(ctx sdk.Context, msg types.MsgEnableValidator,
+	k Keeper, authKeeper auth.Keeper) sdk.Result {
+	// check if sender has enough rights to enable a validator node
+	if err := checkValidatorStateChangeRights(ctx, k, authKeeper, msg, msg.Address, msg.Signer); err != nil {
+		return err.Result()
+	}
+
+	if !k.IsValidatorDisabled(ctx, msg.Address) {
+		return types.ErrValidatorNotDisabled(msg.Address).Result()
+	}
+
+	k.Enable(ctx, msg.Address)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEnableValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Address.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// Trustees can change the state of any validator, node admins only the state of their own ones.
+func checkValidatorStateChangeRights(ctx sdk.Context, k Keeper, authKeeper auth.Keeper,
+	msg sdk.Msg, address sdk.ConsAddress, signer sdk.AccAddress) sdk.Error {
+	if err := authKeeper.CheckMsgRoles(ctx, msg); err != nil {
+		return err
+	}
+
+	if !k.IsValidatorPresent(ctx, address) {
+		return types.ErrValidatorDoesNotExist(address)
+	}
+
+	if authKeeper.HasRole(ctx, signer, auth.Trustee) {
+		return nil
+	}
+
+	if !k.GetValidator(ctx, address).Owner.Equals(signer) {
+		return sdk.ErrUnauthorized(
+			fmt.Sprintf("%s transaction should be signed by a Trustee or the validator owner", msg.Type()))
+	}
+
+	return nil
+}
+
+func checkValidatorCanBeAdded(ctx sdk.Context, k Keeper,
+	owner sdk.AccAddress, address sdk.ConsAddress, pubKey crypto.PubKey) sdk.Error {
+	if k.AccountHasValidator(ctx, owner) {
+		return types.ErrAccountAlreadyHasNode(owner)
+	}
+
+	// check if we has not reached the limit of nodes
+	if k.CountLastValidators(ctx) == types.MaxNodes {
+		return types.ErrPoolIsFull()
+	}
+
+	// check if a validator with a given address already exists
+	if k.IsValidatorPresent(ctx, address) {
+		return types.ErrValidatorExists(address)
+	}
+
+	// check key type
+	if ctx.ConsensusParams() != nil {
+		tmPubKey := tmtypes.TM2PB.PubKey(pubKey)
+		if !functions.StringInSlice(tmPubKey.Type, ctx.ConsensusParams().Validator.PubKeyTypes) {
+			return sdk.ErrUnknownRequest(
+				fmt.Sprintf("Validator pubkey type \"%s\" is not supported. Supported types: [%s]",
+					tmPubKey.Type, strings.Join(ctx.ConsensusParams().Validator.PubKeyTypes, ",")))
+		}
+	}
+
+	return nil
+}
+
+func addValidator(ctx sdk.Context, k Keeper, validator types.Validator) {
+	k.SetValidator(ctx, validator)
+	k.SetValidatorOwner(ctx, validator.Owner, validator.Address)
+}
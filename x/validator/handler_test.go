@@ -22,6 +22,7 @@ import (
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	constants "github.com/zigbee-alliance/distributed-compliance-ledger/integration_tests/constants"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator/internal/keeper"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator/internal/types"
@@ -67,7 +68,7 @@ func TestHandler_CreateValidator_ByNotNodeAdmin(t *testing.T) {
 
 		// try to create validator
 		result := setup.Handler(setup.Ctx, msgCreateValidator)
-		require.Equal(t, sdk.CodeUnauthorized, result.Code)
+		require.Equal(t, auth.CodeMissingRole, result.Code)
 	}
 }
 
@@ -106,6 +107,425 @@ func TestHandler_CreateValidator_TwiceForSameValidatorOwner(t *testing.T) {
 	require.Equal(t, types.CodeAccountAlreadyHasNode, result.Code)
 }
 
+func TestHandler_CreateValidator_AfterGenesis(t *testing.T) {
+	setup := Setup()
+
+	msgCreateValidator := types.NewMsgCreateValidator(constants.ValidatorAddress1, constants.ValidatorPubKey1,
+		types.Description{Name: constants.Name}, constants.Address1)
+	result := setup.Handler(setup.Ctx.WithBlockHeight(1), msgCreateValidator)
+	require.Equal(t, types.CodeValidatorApprovalRequired, result.Code)
+
+	require.False(t, setup.ValidatorKeeper.IsValidatorPresent(setup.Ctx, msgCreateValidator.Address))
+}
+
+func TestHandler_ProposeAddValidator_ApprovedByTrustees(t *testing.T) {
+	setup := Setup()
+	ctx := setup.Ctx.WithBlockHeight(1)
+	trustees := []sdk.AccAddress{storeTrustee(setup), storeTrustee(setup), storeTrustee(setup)}
+
+	// propose validator
+	msgProposeAddValidator := types.NewMsgProposeAddValidator(constants.ValidatorAddress1,
+		constants.ValidatorPubKey1, types.Description{Name: constants.Name}, constants.Address1)
+	result := setup.Handler(ctx, msgProposeAddValidator)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// validator is pending
+	require.False(t, setup.ValidatorKeeper.IsValidatorPresent(ctx, msgProposeAddValidator.Address))
+
+	pendingValidator, _ := queryPendingValidator(setup, msgProposeAddValidator.Address)
+	require.Equal(t, msgProposeAddValidator.PubKey, pendingValidator.PubKey)
+	require.Equal(t, msgProposeAddValidator.Signer, pendingValidator.Owner)
+	require.Empty(t, pendingValidator.Approvals)
+
+	// first approval is not enough
+	result = setup.Handler(ctx, types.NewMsgApproveAddValidator(msgProposeAddValidator.Address, trustees[0]))
+	require.Equal(t, sdk.CodeOK, result.Code)
+	require.False(t, setup.ValidatorKeeper.IsValidatorPresent(ctx, msgProposeAddValidator.Address))
+
+	// second approval from the same trustee is rejected
+	result = setup.Handler(ctx, types.NewMsgApproveAddValidator(msgProposeAddValidator.Address, trustees[0]))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// second approval adds validator
+	result = setup.Handler(ctx, types.NewMsgApproveAddValidator(msgProposeAddValidator.Address, trustees[1]))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	events := result.Events.ToABCIEvents()
+	require.Equal(t, types.EventTypeCreateValidator, events[0].Type)
+
+	validator, _ := queryValidator(setup, msgProposeAddValidator.Address)
+	require.Equal(t, msgProposeAddValidator.PubKey, validator.PubKey)
+	require.Equal(t, msgProposeAddValidator.Signer, validator.Owner)
+	require.True(t, setup.ValidatorKeeper.AccountHasValidator(ctx, msgProposeAddValidator.Signer))
+
+	_, err := queryPendingValidator(setup, msgProposeAddValidator.Address)
+	require.Equal(t, types.CodePendingValidatorDoesNotExist, err.Code())
+}
+
+func TestHandler_ApproveAddValidator_ApprovalPercentIsParam(t *testing.T) {
+	setup := Setup()
+	ctx := setup.Ctx.WithBlockHeight(1)
+	trustees := []sdk.AccAddress{storeTrustee(setup), storeTrustee(setup), storeTrustee(setup)}
+
+	// require approvals of all the trustees
+	params := types.DefaultParams()
+	params.ValidatorApprovalPercent = sdk.OneDec()
+	setup.ValidatorKeeper.SetParams(ctx, params)
+
+	// propose validator
+	msgProposeAddValidator := types.NewMsgProposeAddValidator(constants.ValidatorAddress1,
+		constants.ValidatorPubKey1, types.Description{Name: constants.Name}, constants.Address1)
+	result := setup.Handler(ctx, msgProposeAddValidator)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// two approvals are not enough
+	for _, trustee := range trustees[:2] {
+		result = setup.Handler(ctx, types.NewMsgApproveAddValidator(msgProposeAddValidator.Address, trustee))
+		require.Equal(t, sdk.CodeOK, result.Code)
+	}
+
+	require.False(t, setup.ValidatorKeeper.IsValidatorPresent(ctx, msgProposeAddValidator.Address))
+
+	// third approval adds validator
+	result = setup.Handler(ctx, types.NewMsgApproveAddValidator(msgProposeAddValidator.Address, trustees[2]))
+	require.Equal(t, sdk.CodeOK, result.Code)
+	require.True(t, setup.ValidatorKeeper.IsValidatorPresent(ctx, msgProposeAddValidator.Address))
+}
+
+func TestHandler_ProposeAddValidator_PendingValidatorExpires(t *testing.T) {
+	setup := Setup()
+	ctx := setup.Ctx.WithBlockHeight(5)
+	trustees := []sdk.AccAddress{storeTrustee(setup), storeTrustee(setup), storeTrustee(setup)}
+
+	params := types.DefaultParams()
+	params.PendingValidatorExpirationPeriod = 10
+	setup.ValidatorKeeper.SetParams(ctx, params)
+
+	// propose validator at height 5
+	msgProposeAddValidator := types.NewMsgProposeAddValidator(constants.ValidatorAddress1,
+		constants.ValidatorPubKey1, types.Description{Name: constants.Name}, constants.Address1)
+	result := setup.Handler(ctx, msgProposeAddValidator)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	pendingValidator, _ := queryPendingValidator(setup, msgProposeAddValidator.Address)
+	require.Equal(t, int64(5), pendingValidator.ProposedAt)
+
+	// the validator is still pending at the end of the expiration period
+	setup.ValidatorKeeper.DeleteExpiredPendingValidators(ctx.WithBlockHeight(15))
+	require.True(t, setup.ValidatorKeeper.IsPendingValidatorPresent(ctx, msgProposeAddValidator.Address))
+
+	// the pending validator is deleted after the expiration period
+	setup.ValidatorKeeper.DeleteExpiredPendingValidators(ctx.WithBlockHeight(16))
+
+	_, err := queryPendingValidator(setup, msgProposeAddValidator.Address)
+	require.Equal(t, types.CodePendingValidatorDoesNotExist, err.Code())
+
+	// so it can't be approved anymore
+	result = setup.Handler(ctx, types.NewMsgApproveAddValidator(msgProposeAddValidator.Address, trustees[0]))
+	require.Equal(t, types.CodePendingValidatorDoesNotExist, result.Code)
+}
+
+func TestHandler_ProposeAddValidator_ByNotNodeAdmin(t *testing.T) {
+	setup := Setup()
+
+	msgProposeAddValidator := types.NewMsgProposeAddValidator(constants.ValidatorAddress1,
+		constants.ValidatorPubKey1, types.Description{Name: constants.Name}, constants.Address1)
+
+	for _, role := range []auth.AccountRole{auth.TestHouse, auth.ZBCertificationCenter, auth.Vendor, auth.Trustee} {
+		account := auth.NewAccount(constants.Address1, constants.PubKey1, auth.AccountRoles{role})
+		setup.authKeeper.SetAccount(setup.Ctx, account)
+
+		result := setup.Handler(setup.Ctx, msgProposeAddValidator)
+		require.Equal(t, auth.CodeMissingRole, result.Code)
+	}
+}
+
+func TestHandler_ProposeAddValidator_Twice(t *testing.T) {
+	setup := Setup()
+
+	msgProposeAddValidator := types.NewMsgProposeAddValidator(constants.ValidatorAddress1,
+		constants.ValidatorPubKey1, types.Description{Name: constants.Name}, constants.Address1)
+	result := setup.Handler(setup.Ctx, msgProposeAddValidator)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, msgProposeAddValidator)
+	require.Equal(t, types.CodePendingValidatorAlreadyExists, result.Code)
+}
+
+func TestHandler_ProposeAddValidator_ForExistingValidator(t *testing.T) {
+	setup := Setup()
+
+	msgCreateValidator := types.NewMsgCreateValidator(constants.ValidatorAddress1, constants.ValidatorPubKey1,
+		types.Description{Name: constants.Name}, constants.Address1)
+	result := setup.Handler(setup.Ctx, msgCreateValidator)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	account := auth.NewAccount(constants.Address2, constants.PubKey2, auth.AccountRoles{auth.NodeAdmin})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	msgProposeAddValidator := types.NewMsgProposeAddValidator(constants.ValidatorAddress1,
+		constants.ValidatorPubKey1, types.Description{Name: constants.Name}, constants.Address2)
+	result = setup.Handler(setup.Ctx, msgProposeAddValidator)
+	require.Equal(t, types.CodeValidatorAlreadyExist, result.Code)
+}
+
+func TestHandler_ApproveAddValidator_ByNotTrustee(t *testing.T) {
+	setup := Setup()
+
+	msgProposeAddValidator := types.NewMsgProposeAddValidator(constants.ValidatorAddress1,
+		constants.ValidatorPubKey1, types.Description{Name: constants.Name}, constants.Address1)
+	result := setup.Handler(setup.Ctx, msgProposeAddValidator)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, types.NewMsgApproveAddValidator(constants.ValidatorAddress1, constants.Address1))
+	require.Equal(t, auth.CodeMissingRole, result.Code)
+}
+
+func TestHandler_ApproveAddValidator_ForUnknownValidator(t *testing.T) {
+	setup := Setup()
+	trustee := storeTrustee(setup)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgApproveAddValidator(constants.ValidatorAddress1, trustee))
+	require.Equal(t, types.CodePendingValidatorDoesNotExist, result.Code)
+}
+
+func TestHandler_RejectAddValidator_ByTrustees(t *testing.T) {
+	setup := Setup()
+	ctx := setup.Ctx.WithBlockHeight(1)
+	trustees := []sdk.AccAddress{storeTrustee(setup), storeTrustee(setup), storeTrustee(setup)}
+
+	msgProposeAddValidator := types.NewMsgProposeAddValidator(constants.ValidatorAddress1,
+		constants.ValidatorPubKey1, types.Description{Name: constants.Name}, constants.Address1)
+	result := setup.Handler(ctx, msgProposeAddValidator)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// the owner gets another node while the validator is pending
+	msgCreateValidator := types.NewMsgCreateValidator(constants.ValidatorAddress2, constants.ValidatorPubKey2,
+		types.Description{Name: constants.Name}, constants.Address1)
+	result = setup.Handler(setup.Ctx, msgCreateValidator)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// so the final approval fails
+	result = setup.Handler(ctx, types.NewMsgApproveAddValidator(msgProposeAddValidator.Address, trustees[0]))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(ctx, types.NewMsgApproveAddValidator(msgProposeAddValidator.Address, trustees[1]))
+	require.Equal(t, types.CodeAccountAlreadyHasNode, result.Code)
+
+	// a trustee who approved the validator cannot reject it
+	msgRejectAddValidator := types.NewMsgRejectAddValidator(msgProposeAddValidator.Address, "reason", trustees[0])
+	result = setup.Handler(ctx, msgRejectAddValidator)
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// first rejection is not enough
+	result = setup.Handler(ctx, types.NewMsgRejectAddValidator(msgProposeAddValidator.Address, "reason", trustees[1]))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	pendingValidator, _ := queryPendingValidator(setup, msgProposeAddValidator.Address)
+	require.Equal(t, []sdk.AccAddress{trustees[1]}, pendingValidator.Rejections)
+
+	// second rejection from the same trustee is rejected
+	result = setup.Handler(ctx, types.NewMsgRejectAddValidator(msgProposeAddValidator.Address, "reason", trustees[1]))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// second rejection deletes the pending validator
+	result = setup.Handler(ctx, types.NewMsgRejectAddValidator(msgProposeAddValidator.Address, "reason", trustees[2]))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	events := result.Events.ToABCIEvents()
+	require.Equal(t, types.EventTypeRejectAddValidator, events[0].Type)
+
+	_, err := queryPendingValidator(setup, msgProposeAddValidator.Address)
+	require.Equal(t, types.CodePendingValidatorDoesNotExist, err.Code())
+
+	require.False(t, setup.ValidatorKeeper.IsValidatorPresent(ctx, msgProposeAddValidator.Address))
+}
+
+func TestHandler_RejectAddValidator_WithdrawnByOwner(t *testing.T) {
+	setup := Setup()
+
+	msgProposeAddValidator := types.NewMsgProposeAddValidator(constants.ValidatorAddress1,
+		constants.ValidatorPubKey1, types.Description{Name: constants.Name}, constants.Address1)
+	result := setup.Handler(setup.Ctx, msgProposeAddValidator)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// other node admins cannot reject the validator
+	account := auth.NewAccount(constants.Address2, constants.PubKey2, auth.AccountRoles{auth.NodeAdmin})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	msgRejectAddValidator := types.NewMsgRejectAddValidator(constants.ValidatorAddress1, "", constants.Address2)
+	result = setup.Handler(setup.Ctx, msgRejectAddValidator)
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// the owner withdraws the proposal at once
+	msgRejectAddValidator = types.NewMsgRejectAddValidator(constants.ValidatorAddress1, "", constants.Address1)
+	result = setup.Handler(setup.Ctx, msgRejectAddValidator)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	_, err := queryPendingValidator(setup, constants.ValidatorAddress1)
+	require.Equal(t, types.CodePendingValidatorDoesNotExist, err.Code())
+
+	// the address can be proposed again
+	result = setup.Handler(setup.Ctx, msgProposeAddValidator)
+	require.Equal(t, sdk.CodeOK, result.Code)
+}
+
+func TestHandler_RejectAddValidator_ForUnknownValidator(t *testing.T) {
+	setup := Setup()
+	trustee := storeTrustee(setup)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgRejectAddValidator(constants.ValidatorAddress1, "", trustee))
+	require.Equal(t, types.CodePendingValidatorDoesNotExist, result.Code)
+}
+
+func TestHandler_DisableEnableValidator_ByOwner(t *testing.T) {
+	setup := Setup()
+	createValidator(t, setup, constants.ValidatorAddress1, constants.ValidatorPubKey1, constants.Address1)
+	createValidator(t, setup, constants.ValidatorAddress2, constants.ValidatorPubKey2, constants.Address2)
+	setup.ValidatorKeeper.ApplyAndReturnValidatorSetUpdates(setup.Ctx)
+
+	// disable validator
+	msgDisableValidator := types.NewMsgDisableValidator(constants.ValidatorAddress1, "maintenance", constants.Address1)
+	result := setup.Handler(setup.Ctx, msgDisableValidator)
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	events := result.Events.ToABCIEvents()
+	require.Equal(t, types.EventTypeDisableValidator, events[0].Type)
+
+	// voting power is removed at the end of the block
+	validator, _ := queryValidator(setup, constants.ValidatorAddress1)
+	require.Equal(t, types.ZeroPower, validator.Power)
+
+	updates := setup.ValidatorKeeper.ApplyAndReturnValidatorSetUpdates(setup.Ctx)
+	require.Equal(t, 1, len(updates))
+	require.Equal(t, types.ZeroPower, updates[0].Power)
+	require.False(t, setup.ValidatorKeeper.IsLastValidatorPowerPresent(setup.Ctx, constants.ValidatorAddress1))
+
+	// query disabled validators
+	disabledValidators := queryDisabledValidators(setup)
+	require.Equal(t, 1, disabledValidators.Total)
+	require.Equal(t, constants.ValidatorAddress1, disabledValidators.Items[0].Address)
+	require.Equal(t, "maintenance", disabledValidators.Items[0].Reason)
+	require.Equal(t, constants.Address1, disabledValidators.Items[0].DisabledBy)
+
+	// disable second time
+	result = setup.Handler(setup.Ctx, msgDisableValidator)
+	require.Equal(t, types.CodeValidatorAlreadyDisabled, result.Code)
+
+	// enable validator
+	result = setup.Handler(setup.Ctx, types.NewMsgEnableValidator(constants.ValidatorAddress1, constants.Address1))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	// voting power is restored at the end of the block
+	updates = setup.ValidatorKeeper.ApplyAndReturnValidatorSetUpdates(setup.Ctx)
+	require.Equal(t, 1, len(updates))
+	require.Equal(t, types.Power, updates[0].Power)
+	require.True(t, setup.ValidatorKeeper.IsLastValidatorPowerPresent(setup.Ctx, constants.ValidatorAddress1))
+
+	require.Equal(t, 0, queryDisabledValidators(setup).Total)
+
+	// enable second time
+	result = setup.Handler(setup.Ctx, types.NewMsgEnableValidator(constants.ValidatorAddress1, constants.Address1))
+	require.Equal(t, types.CodeValidatorNotDisabled, result.Code)
+}
+
+func TestHandler_DisableValidator_ByTrustee(t *testing.T) {
+	setup := Setup()
+	createValidator(t, setup, constants.ValidatorAddress1, constants.ValidatorPubKey1, constants.Address1)
+	createValidator(t, setup, constants.ValidatorAddress2, constants.ValidatorPubKey2, constants.Address2)
+	trustee := storeTrustee(setup)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgDisableValidator(constants.ValidatorAddress1, "", trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+
+	result = setup.Handler(setup.Ctx, types.NewMsgEnableValidator(constants.ValidatorAddress1, trustee))
+	require.Equal(t, sdk.CodeOK, result.Code)
+}
+
+func TestHandler_DisableValidator_ByNotOwner(t *testing.T) {
+	setup := Setup()
+	createValidator(t, setup, constants.ValidatorAddress1, constants.ValidatorPubKey1, constants.Address1)
+	createValidator(t, setup, constants.ValidatorAddress2, constants.ValidatorPubKey2, constants.Address2)
+
+	// node admin of another validator
+	result := setup.Handler(setup.Ctx, types.NewMsgDisableValidator(constants.ValidatorAddress1, "", constants.Address2))
+	require.Equal(t, sdk.CodeUnauthorized, result.Code)
+
+	// account without required roles
+	account := auth.NewAccount(constants.Address3, constants.PubKey3, auth.AccountRoles{auth.Vendor})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	result = setup.Handler(setup.Ctx, types.NewMsgDisableValidator(constants.ValidatorAddress1, "", constants.Address3))
+	require.Equal(t, auth.CodeMissingRole, result.Code)
+}
+
+func TestHandler_DisableValidator_LastActive(t *testing.T) {
+	setup := Setup()
+	createValidator(t, setup, constants.ValidatorAddress1, constants.ValidatorPubKey1, constants.Address1)
+
+	result := setup.Handler(setup.Ctx, types.NewMsgDisableValidator(constants.ValidatorAddress1, "", constants.Address1))
+	require.Equal(t, types.CodeLastActiveValidator, result.Code)
+}
+
+func TestHandler_DisableValidator_ForUnknownValidator(t *testing.T) {
+	setup := Setup()
+
+	result := setup.Handler(setup.Ctx, types.NewMsgDisableValidator(constants.ValidatorAddress1, "", constants.Address1))
+	require.Equal(t, types.CodeValidatorDoesNotExist, result.Code)
+}
+
+func createValidator(t *testing.T, setup TestSetup,
+	address sdk.ConsAddress, pubKey string, owner sdk.AccAddress) {
+	account := auth.NewAccount(owner, nil, auth.AccountRoles{auth.NodeAdmin})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	msgCreateValidator := types.NewMsgCreateValidator(address, pubKey, types.Description{Name: constants.Name}, owner)
+	result := setup.Handler(setup.Ctx, msgCreateValidator)
+	require.Equal(t, sdk.CodeOK, result.Code)
+}
+
+func queryDisabledValidators(setup TestSetup) types.ListDisabledValidatorItems {
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{keeper.QueryDisabledValidators},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(pagination.PaginationParams{})},
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	var validators types.ListDisabledValidatorItems
+
+	setup.Cdc.MustUnmarshalJSON(result, &validators)
+
+	return validators
+}
+
+func storeTrustee(setup TestSetup) sdk.AccAddress {
+	address, pubkey, _ := constants.TestAddress()
+	account := auth.NewAccount(address, pubkey, auth.AccountRoles{auth.Trustee})
+	setup.authKeeper.SetAccount(setup.Ctx, account)
+
+	return address
+}
+
+func queryPendingValidator(setup TestSetup, address sdk.ConsAddress) (*types.PendingValidator, sdk.Error) {
+	result, err := setup.Querier(
+		setup.Ctx,
+		[]string{keeper.QueryPendingValidator, address.String()},
+		abci.RequestQuery{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var validator types.PendingValidator
+
+	setup.Cdc.MustUnmarshalJSON(result, &validator)
+
+	return &validator, nil
+}
+
 func queryValidator(setup TestSetup, address sdk.ConsAddress) (*types.Validator, sdk.Error) {
 	// query validator
 	result, err := setup.Querier(
@@ -19,7 +19,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/tendermint/tendermint/libs/log"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator/internal/types"
 )
 
@@ -28,14 +30,26 @@ type Keeper struct {
 	// Unexposed key to access store from sdk.Context.
 	storeKey sdk.StoreKey
 
+	// The parameter subspace of the module.
+	paramSpace params.Subspace
+
 	// The wire codec for binary encoding/decoding.
 	cdc *codec.Codec
+
+	// The store of pending validators indexed by the height they were proposed at.
+	pendingValidators approvals.ProposalStore
 }
 
-func NewKeeper(key sdk.StoreKey, cdc *codec.Codec) Keeper {
+func NewKeeper(key sdk.StoreKey, paramSpace params.Subspace, cdc *codec.Codec) Keeper {
+	pendingValidators := approvals.NewProposalStore(key, cdc,
+		types.PendingValidatorPrefix, types.PendingValidatorExpirationPrefix,
+		func() approvals.Proposal { return &types.PendingValidator{} })
+
 	return Keeper{
-		storeKey: key,
-		cdc:      cdc,
+		storeKey:          key,
+		paramSpace:        paramSpace.WithKeyTable(types.ParamKeyTable()),
+		cdc:               cdc,
+		pendingValidators: pendingValidators,
 	}
 }
 
@@ -259,6 +273,246 @@ func (k Keeper) IterateLastValidators(ctx sdk.Context, process func(validator ty
 	}
 }
 
+/*
+	Pending Validator by Validator Address
+*/
+
+// Gets the entire Pending Validator record associated with a validator address.
+func (k Keeper) GetPendingValidator(ctx sdk.Context, addr sdk.ConsAddress) (validator types.PendingValidator) {
+	if !k.pendingValidators.Get(ctx, types.GetPendingValidatorKey(addr), &validator) {
+		panic(fmt.Sprintf("pending validator record not found for address: %X\n", addr))
+	}
+
+	return validator
+}
+
+// Sets the entire Pending Validator record for a validator address.
+func (k Keeper) SetPendingValidator(ctx sdk.Context, validator types.PendingValidator) {
+	k.pendingValidators.Set(ctx, types.GetPendingValidatorKey(validator.Address), validator)
+}
+
+// Check if the Pending Validator record associated with a validator address is present in the store or not.
+func (k Keeper) IsPendingValidatorPresent(ctx sdk.Context, addr sdk.ConsAddress) bool {
+	return k.pendingValidators.Has(ctx, types.GetPendingValidatorKey(addr))
+}
+
+// Deletes the Pending Validator record associated with a validator address.
+func (k Keeper) DeletePendingValidator(ctx sdk.Context, addr sdk.ConsAddress) {
+	k.pendingValidators.Delete(ctx, types.GetPendingValidatorKey(addr))
+}
+
+// get the set of all pending validators.
+func (k Keeper) GetAllPendingValidators(ctx sdk.Context) (validators []types.PendingValidator) {
+	k.IteratePendingValidators(ctx, func(validator types.PendingValidator) (stop bool) {
+		validators = append(validators, validator)
+
+		return false
+	})
+
+	return validators
+}
+
+// iterate over pending validators and apply function.
+func (k Keeper) IteratePendingValidators(ctx sdk.Context,
+	process func(validator types.PendingValidator) (stop bool)) {
+	k.pendingValidators.Iterate(ctx, func(proposal approvals.Proposal) (stop bool) {
+		return process(*proposal.(*types.PendingValidator))
+	})
+}
+
+// The maximum number of expired pending validators deleted in a single block,
+// the rest of them are deleted in the following blocks.
+const MaxExpiredPendingValidatorsPerBlock = 100
+
+// Deletes the pending validators not approved (or rejected) within the expiration period.
+// Called in each EndBlock.
+func (k Keeper) DeleteExpiredPendingValidators(ctx sdk.Context) {
+	k.pendingValidators.DeleteExpired(ctx, k.GetParams(ctx).ValidatorApprovalPolicy(),
+		MaxExpiredPendingValidatorsPerBlock)
+}
+
+// Sets the proposal height of the pending validators stored before it was tracked to the current height,
+// so that they expire after the expiration period from now on.
+func (k Keeper) SetPendingValidatorsProposedAt(ctx sdk.Context) {
+	var validators []types.PendingValidator
+
+	k.IteratePendingValidators(ctx, func(validator types.PendingValidator) (stop bool) {
+		if validator.ProposedAt == 0 {
+			validators = append(validators, validator)
+		}
+
+		return false
+	})
+
+	for _, validator := range validators {
+		validator.ProposedAt = ctx.BlockHeight()
+		k.SetPendingValidator(ctx, validator)
+	}
+}
+
+/*
+	Disabled Validator by Validator Address
+*/
+
+// Gets the Disabled Validator record associated with a validator address.
+func (k Keeper) GetDisabledValidator(ctx sdk.Context, addr sdk.ConsAddress) (validator types.DisabledValidator) {
+	store := ctx.KVStore(k.storeKey)
+	value := store.Get(types.GetDisabledValidatorKey(addr))
+
+	if value == nil {
+		panic(fmt.Sprintf("disabled validator record not found for address: %X\n", addr))
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(value, &validator)
+
+	return validator
+}
+
+// Sets the Disabled Validator record for a validator address.
+func (k Keeper) SetDisabledValidator(ctx sdk.Context, validator types.DisabledValidator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDisabledValidatorKey(validator.Address), k.cdc.MustMarshalBinaryBare(validator))
+}
+
+// Check if the validator associated with a validator address is disabled.
+func (k Keeper) IsValidatorDisabled(ctx sdk.Context, addr sdk.ConsAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetDisabledValidatorKey(addr))
+}
+
+// Deletes the Disabled Validator record associated with a validator address.
+func (k Keeper) DeleteDisabledValidator(ctx sdk.Context, addr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetDisabledValidatorKey(addr))
+}
+
+// get the set of all disabled validators.
+func (k Keeper) GetAllDisabledValidators(ctx sdk.Context) (validators []types.DisabledValidator) {
+	k.IterateDisabledValidators(ctx, func(validator types.DisabledValidator) (stop bool) {
+		validators = append(validators, validator)
+
+		return false
+	})
+
+	return validators
+}
+
+// iterate over disabled validators and apply function.
+func (k Keeper) IterateDisabledValidators(ctx sdk.Context,
+	process func(validator types.DisabledValidator) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.DisabledValidatorPrefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		var validator types.DisabledValidator
+
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &validator)
+
+		if process(validator) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
+// disable a validator. So it will be removed from Tendermint validator set at the end of the block.
+func (k Keeper) Disable(ctx sdk.Context, disabledValidator types.DisabledValidator) {
+	validator := k.GetValidator(ctx, disabledValidator.Address)
+
+	// Zeroing validator's weight
+	validator.Power = types.ZeroPower
+	k.SetValidator(ctx, validator)
+
+	k.SetDisabledValidator(ctx, disabledValidator)
+}
+
+// enable a disabled validator. So it will be returned to Tendermint validator set at the end of the block
+// unless it is jailed.
+func (k Keeper) Enable(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	validator := k.GetValidator(ctx, consAddr)
+
+	if !validator.IsJailed() {
+		validator.Power = types.Power
+		k.SetValidator(ctx, validator)
+	}
+
+	k.DeleteDisabledValidator(ctx, consAddr)
+}
+
+// count validators which have voting power at the moment.
+func (k Keeper) CountValidatorsWithPower(ctx sdk.Context) (count int) {
+	k.IterateValidators(ctx, func(validator types.Validator) (stop bool) {
+		if validator.GetPower() > 0 {
+			count++
+		}
+
+		return false
+	})
+
+	return count
+}
+
+/*
+	Slashed Validator by Validator Address
+*/
+
+// Sets the Slashed Validator record for a validator address.
+func (k Keeper) SetSlashedValidator(ctx sdk.Context, validator types.SlashedValidator) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSlashedValidatorKey(validator.Address), k.cdc.MustMarshalBinaryBare(validator))
+}
+
+// Check if the validator associated with a validator address has been slashed.
+func (k Keeper) IsValidatorSlashed(ctx sdk.Context, addr sdk.ConsAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+
+	return store.Has(types.GetSlashedValidatorKey(addr))
+}
+
+// get the set of all slashed validators.
+func (k Keeper) GetAllSlashedValidators(ctx sdk.Context) (validators []types.SlashedValidator) {
+	k.IterateSlashedValidators(ctx, func(validator types.SlashedValidator) (stop bool) {
+		validators = append(validators, validator)
+
+		return false
+	})
+
+	return validators
+}
+
+// iterate over slashed validators and apply function.
+func (k Keeper) IterateSlashedValidators(ctx sdk.Context,
+	process func(validator types.SlashedValidator) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, types.SlashedValidatorPrefix)
+	defer iter.Close()
+
+	for {
+		if !iter.Valid() {
+			return
+		}
+
+		var validator types.SlashedValidator
+
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &validator)
+
+		if process(validator) {
+			return
+		}
+
+		iter.Next()
+	}
+}
+
 /*
 	Helper Index to track that Account has only one node
 */
@@ -275,3 +529,22 @@ func (k Keeper) AccountHasValidator(ctx sdk.Context, addr sdk.AccAddress) bool {
 
 	return store.Has(types.GetValidatorOwnerKey(addr))
 }
+
+/*
+	Module Params
+*/
+
+// Gets the module params. Default values are returned for the params which have not been set yet.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	for _, pair := range params.ParamSetPairs() {
+		k.paramSpace.GetIfExists(ctx, pair.Key, pair.Value)
+	}
+
+	return params
+}
+
+// Sets the module params.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
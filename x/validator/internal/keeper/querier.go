@@ -20,13 +20,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/pagination"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator/internal/types"
 )
 
 // query endpoints supported by the validator Querier.
 const (
-	QueryValidators = "validators"
-	QueryValidator  = "validator"
+	QueryValidators         = "validators"
+	QueryValidator          = "validator"
+	QueryPendingValidators  = "pending_validators"
+	QueryPendingValidator   = "pending_validator"
+	QueryDisabledValidators = "disabled_validators"
+	QuerySlashedValidators  = "slashed_validators"
 )
 
 // creates a querier for validator module.
@@ -37,6 +42,14 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryValidators(ctx, req, k)
 		case QueryValidator:
 			return queryValidator(ctx, path[1:], k)
+		case QueryPendingValidators:
+			return queryPendingValidators(ctx, req, k)
+		case QueryPendingValidator:
+			return queryPendingValidator(ctx, path[1:], k)
+		case QueryDisabledValidators:
+			return queryDisabledValidators(ctx, req, k)
+		case QuerySlashedValidators:
+			return querySlashedValidators(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown pki query endpoint")
 		}
@@ -51,14 +64,17 @@ func queryValidators(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res
 
 	result := types.NewListValidatorItems()
 
-	skipped := 0
+	paginator, err := pagination.NewPaginator(ctx, params.PaginationParams())
+	if err != nil {
+		return nil, err
+	}
 
 	keeper.IterateValidators(ctx, func(validator types.Validator) (stop bool) {
 		// filter by validator state
 		// nolint:exhaustive
 		switch params.State {
 		case types.Active:
-			if validator.IsJailed() {
+			if validator.IsJailed() || keeper.IsValidatorDisabled(ctx, validator.Address) {
 				return false
 			}
 		case types.Jailed:
@@ -69,21 +85,16 @@ func queryValidators(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res
 
 		result.Total++
 
-		if skipped < params.Skip {
-			skipped++
-
-			return false
-		}
-
-		if len(result.Items) < params.Take || params.Take == 0 {
+		if paginator.Add(types.GetValidatorKey(validator.Address)) {
 			result.Items = append(result.Items, validator)
-
-			return false
 		}
 
 		return false
 	})
 
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
 	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
 
 	return res, nil
@@ -105,3 +116,113 @@ func queryValidator(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error
 
 	return res, nil
 }
+
+func queryPendingValidators(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Failed to parse request params: %s", err))
+	}
+
+	result := types.NewListPendingValidatorItems()
+
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.IteratePendingValidators(ctx, func(validator types.PendingValidator) (stop bool) {
+		result.Total++
+
+		if paginator.Add(types.GetPendingValidatorKey(validator.Address)) {
+			result.Items = append(result.Items, validator)
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func queryPendingValidator(ctx sdk.Context, path []string, k Keeper) ([]byte, sdk.Error) {
+	validatorAddr, err := sdk.ConsAddressFromBech32(path[0])
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(err.Error())
+	}
+
+	if !k.IsPendingValidatorPresent(ctx, validatorAddr) {
+		return nil, types.ErrPendingValidatorDoesNotExist(validatorAddr)
+	}
+
+	validator := k.GetPendingValidator(ctx, validatorAddr)
+
+	res := codec.MustMarshalJSONIndent(types.ModuleCdc, validator)
+
+	return res, nil
+}
+
+func queryDisabledValidators(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Failed to parse request params: %s", err))
+	}
+
+	result := types.NewListDisabledValidatorItems()
+
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.IterateDisabledValidators(ctx, func(validator types.DisabledValidator) (stop bool) {
+		result.Total++
+
+		if paginator.Add(types.GetDisabledValidatorKey(validator.Address)) {
+			result.Items = append(result.Items, validator)
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
+
+func querySlashedValidators(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params pagination.PaginationParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("Failed to parse request params: %s", err))
+	}
+
+	result := types.NewListSlashedValidatorItems()
+
+	paginator, err := pagination.NewPaginator(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	keeper.IterateSlashedValidators(ctx, func(validator types.SlashedValidator) (stop bool) {
+		result.Total++
+
+		if paginator.Add(types.GetSlashedValidatorKey(validator.Address)) {
+			result.Items = append(result.Items, validator)
+		}
+
+		return false
+	})
+
+	result.NextKey = paginator.NextKey()
+	result.PrevKey = paginator.PrevKey()
+
+	res = codec.MustMarshalJSONIndent(keeper.cdc, result)
+
+	return res, nil
+}
@@ -95,6 +95,31 @@ func TestQuerier_QueryValidators(t *testing.T) {
 	require.Equal(t, 0, len(jailedValidators.Items))
 }
 
+func TestQuerier_QuerySlashedValidators(t *testing.T) {
+	setup := Setup()
+
+	// add 2 validators and slash the first one
+	validator1, _ := StoreTwoValidators(setup)
+	setup.ValidatorKeeper.HandleDoubleSign(setup.Ctx, validator1.GetConsPubKey().Address(),
+		5, setup.Ctx.BlockHeader().Time, types.Power)
+
+	// query slashed validators
+	result, _ := setup.Querier(
+		setup.Ctx,
+		[]string{QuerySlashedValidators},
+		abci.RequestQuery{Data: setup.Cdc.MustMarshalJSON(pagination.NewPaginationParams(0, 0))},
+	)
+
+	var listValidators types.ListSlashedValidatorItems
+
+	setup.Cdc.MustUnmarshalJSON(result, &listValidators)
+
+	// check
+	require.Equal(t, 1, listValidators.Total)
+	require.Equal(t, validator1.Address, listValidators.Items[0].Address)
+	require.Equal(t, int64(5), listValidators.Items[0].InfractionHeight)
+}
+
 func getValidators(setup TestSetup, state types.ValidatorState) types.ListValidatorItems {
 	paginationParams := pagination.NewPaginationParams(0, 0)
 	params := setup.Cdc.MustMarshalJSON(types.NewListValidatorsParams(paginationParams, state))
@@ -19,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/log"
 	dbm "github.com/tendermint/tm-db"
@@ -43,10 +44,16 @@ func Setup() TestSetup {
 	dbStore := store.NewCommitMultiStore(db)
 	validatorKey := sdk.NewKVStoreKey(types.StoreKey)
 	dbStore.MountStoreWithDB(validatorKey, sdk.StoreTypeIAVL, nil)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+	dbStore.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, nil)
+	dbStore.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, nil)
+
 	_ = dbStore.LoadLatestVersion()
 
 	// Init Keepers
-	validatorKeeper := NewKeeper(validatorKey, cdc)
+	paramsKeeper := params.NewKeeper(cdc, paramsKey, paramsTKey, params.DefaultCodespace)
+	validatorKeeper := NewKeeper(validatorKey, paramsKeeper.Subspace(types.DefaultParamspace), cdc)
 
 	// Init Querier
 	querier := NewQuerier(validatorKeeper)
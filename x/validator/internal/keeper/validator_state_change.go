@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator/internal/types"
 )
 
@@ -39,16 +40,14 @@ func (k Keeper) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) {
 
 	// Iterate through any newly discovered evidence of infraction
 	// Slash all validators who contributed to infractions.
-	// nolint: godox
-	// TODO: Jail is disabled because unjail functionality is not implemented yet.
-	//for _, evidence := range req.ByzantineValidators {
-	//	switch evidence.Type {
-	//	case tmtypes.ABCIEvidenceTypeDuplicateVote:
-	//		k.HandleDoubleSign(ctx, evidence.Validator.Address, evidence.Height, evidence.Time, evidence.Validator.Power)
-	//	default:
-	//		k.Logger(ctx).Error(fmt.Sprintf("ignored unknown evidence type: %s", evidence.Type))
-	//	}
-	//}
+	for _, evidence := range req.ByzantineValidators {
+		switch evidence.Type {
+		case tmtypes.ABCIEvidenceTypeDuplicateVote:
+			k.HandleDoubleSign(ctx, evidence.Validator.Address, evidence.Height, evidence.Time, evidence.Validator.Power)
+		default:
+			k.Logger(ctx).Error(fmt.Sprintf("ignored unknown evidence type: %s", evidence.Type))
+		}
+	}
 }
 
 // Calculate the ValidatorUpdates for the current block
@@ -182,6 +181,14 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, addr crypto.Address,
 		return
 	}
 
+	// Validator already jailed, don't slash again.
+	if k.GetValidator(ctx, consAddr).IsJailed() {
+		logger.Info(fmt.Sprintf("Ignored double sign from %s at height %d, validator is already jailed",
+			consAddr, infractionHeight))
+
+		return
+	}
+
 	// double sign confirmed.
 	reason := fmt.Sprintf("Confirmed double sign from %s at height %d, age of %d", consAddr, infractionHeight, age)
 	logger.Info(reason)
@@ -198,6 +205,18 @@ func (k Keeper) HandleDoubleSign(ctx sdk.Context, addr crypto.Address,
 	)
 	k.Slash(ctx, consAddr)
 	k.Jail(ctx, consAddr, reason)
+
+	// keep the evidence details.
+	k.SetSlashedValidator(ctx, types.SlashedValidator{
+		Address:          consAddr,
+		Reason:           reason,
+		EvidenceType:     tmtypes.ABCIEvidenceTypeDuplicateVote,
+		InfractionHeight: infractionHeight,
+		InfractionTime:   timestamp,
+		Power:            power,
+		SlashedAt:        ctx.BlockHeader().Time,
+		SlashedHeight:    ctx.BlockHeight(),
+	})
 }
 
 // Apply and return accumulated updates to the bonded validator set.
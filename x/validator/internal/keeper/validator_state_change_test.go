@@ -22,6 +22,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator/internal/types"
 )
 
@@ -103,6 +104,66 @@ func TestValidatorStateChange_HandleDoubleSign(t *testing.T) {
 	events := setup.Ctx.EventManager().Events().ToABCIEvents()
 	require.Equal(t, 1, len(events))
 	require.Equal(t, slashing.EventTypeSlash, events[0].Type)
+
+	// check evidence details are recorded
+	slashedValidators := setup.ValidatorKeeper.GetAllSlashedValidators(setup.Ctx)
+	require.Equal(t, 1, len(slashedValidators))
+	require.Equal(t, validator.Address, slashedValidators[0].Address)
+	require.Equal(t, tmtypes.ABCIEvidenceTypeDuplicateVote, slashedValidators[0].EvidenceType)
+	require.Equal(t, int64(5), slashedValidators[0].InfractionHeight)
+	require.Equal(t, timestamp, slashedValidators[0].InfractionTime)
+	require.Equal(t, receivedValidator.JailedReason, slashedValidators[0].Reason)
+}
+
+func TestValidatorStateChange_HandleDoubleSign_ForJailed(t *testing.T) {
+	setup := Setup()
+
+	timestamp := time.Now().UTC()
+
+	// create jailed validator
+	validator := DefaultValidator()
+	setup.ValidatorKeeper.SetValidator(setup.Ctx, validator)
+	setup.ValidatorKeeper.Jail(setup.Ctx, validator.Address, "reason")
+
+	// imitate double sign for validator
+	setup.Ctx = setup.Ctx.WithBlockHeader(abci.Header{
+		Time: timestamp.Add(time.Second * time.Duration(5)),
+	})
+	setup.ValidatorKeeper.HandleDoubleSign(setup.Ctx, validator.GetConsPubKey().Address(), 5, timestamp, types.Power)
+
+	// check evidence is ignored
+	require.False(t, setup.ValidatorKeeper.IsValidatorSlashed(setup.Ctx, validator.Address))
+	require.Equal(t, 0, len(setup.Ctx.EventManager().Events()))
+}
+
+func TestValidatorStateChange_BeginBlocker_ForDuplicateVoteEvidence(t *testing.T) {
+	setup := Setup()
+
+	timestamp := time.Now().UTC()
+
+	// create validators
+	validator1, validator2 := StoreTwoValidators(setup)
+
+	// imitate evidence of double sign for the first validator
+	setup.Ctx = setup.Ctx.WithBlockHeader(abci.Header{
+		Time: timestamp.Add(time.Second * time.Duration(5)),
+	})
+	setup.ValidatorKeeper.BeginBlocker(setup.Ctx, abci.RequestBeginBlock{
+		ByzantineValidators: []abci.Evidence{
+			{
+				Type:      tmtypes.ABCIEvidenceTypeDuplicateVote,
+				Validator: abci.Validator{Address: validator1.GetConsPubKey().Address(), Power: types.Power},
+				Height:    5,
+				Time:      timestamp,
+			},
+		},
+	})
+
+	// check only the first validator is slashed
+	require.True(t, setup.ValidatorKeeper.GetValidator(setup.Ctx, validator1.Address).IsJailed())
+	require.True(t, setup.ValidatorKeeper.IsValidatorSlashed(setup.Ctx, validator1.Address))
+	require.False(t, setup.ValidatorKeeper.GetValidator(setup.Ctx, validator2.Address).IsJailed())
+	require.False(t, setup.ValidatorKeeper.IsValidatorSlashed(setup.Ctx, validator2.Address))
 }
 
 func TestValidatorStateChange_HandleDoubleSign_ForOutdated(t *testing.T) {
@@ -28,4 +28,9 @@ func init() {
 // RegisterCodec registers concrete type on the Amino codec.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateValidator{}, ModuleName+"/CreateValidator", nil)
+	cdc.RegisterConcrete(MsgProposeAddValidator{}, ModuleName+"/ProposeAddValidator", nil)
+	cdc.RegisterConcrete(MsgApproveAddValidator{}, ModuleName+"/ApproveAddValidator", nil)
+	cdc.RegisterConcrete(MsgRejectAddValidator{}, ModuleName+"/RejectAddValidator", nil)
+	cdc.RegisterConcrete(MsgDisableValidator{}, ModuleName+"/DisableValidator", nil)
+	cdc.RegisterConcrete(MsgEnableValidator{}, ModuleName+"/EnableValidator", nil)
 }
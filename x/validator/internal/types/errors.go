@@ -18,6 +18,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/utils/errcodes"
 )
 
 const (
@@ -27,8 +28,28 @@ const (
 	CodeValidatorDoesNotExist sdk.CodeType = 602
 	CodePoolIsFull            sdk.CodeType = 603
 	CodeAccountAlreadyHasNode sdk.CodeType = 604
+
+	CodePendingValidatorAlreadyExists sdk.CodeType = 605
+	CodePendingValidatorDoesNotExist  sdk.CodeType = 606
+	CodeValidatorApprovalRequired     sdk.CodeType = 607
+	CodeValidatorAlreadyDisabled      sdk.CodeType = 608
+	CodeValidatorNotDisabled          sdk.CodeType = 609
+	CodeLastActiveValidator           sdk.CodeType = 610
 )
 
+func init() {
+	errcodes.Register(Codespace, CodeValidatorAlreadyExist, "validator_already_exist")
+	errcodes.Register(Codespace, CodeValidatorDoesNotExist, "validator_does_not_exist")
+	errcodes.Register(Codespace, CodePoolIsFull, "pool_is_full")
+	errcodes.Register(Codespace, CodeAccountAlreadyHasNode, "account_already_has_node")
+	errcodes.Register(Codespace, CodePendingValidatorAlreadyExists, "pending_validator_already_exists")
+	errcodes.Register(Codespace, CodePendingValidatorDoesNotExist, "pending_validator_does_not_exist")
+	errcodes.Register(Codespace, CodeValidatorApprovalRequired, "validator_approval_required")
+	errcodes.Register(Codespace, CodeValidatorAlreadyDisabled, "validator_already_disabled")
+	errcodes.Register(Codespace, CodeValidatorNotDisabled, "validator_not_disabled")
+	errcodes.Register(Codespace, CodeLastActiveValidator, "last_active_validator")
+}
+
 func ErrValidatorExists(address interface{}) sdk.Error {
 	return sdk.NewError(Codespace, CodeValidatorAlreadyExist,
 		fmt.Sprintf("Validator associated with the validator_address=%v already exists on the ledger", address))
@@ -49,3 +70,34 @@ func ErrAccountAlreadyHasNode(address interface{}) sdk.Error {
 		fmt.Sprintf("There is already node stored on the ledger managed by an account"+
 			" associated with the address=\"%v\"", address))
 }
+
+func ErrPendingValidatorAlreadyExists(address interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodePendingValidatorAlreadyExists,
+		fmt.Sprintf("Pending validator associated with the validator_address=%v already exists on the ledger", address))
+}
+
+func ErrPendingValidatorDoesNotExist(address interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodePendingValidatorDoesNotExist,
+		fmt.Sprintf("No pending validator associated with the validator_address=%v on the ledger", address))
+}
+
+func ErrValidatorApprovalRequired() sdk.Error {
+	return sdk.NewError(Codespace, CodeValidatorApprovalRequired,
+		"Validator can be added after genesis only by proposal approved by Trustees")
+}
+
+func ErrValidatorAlreadyDisabled(address interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeValidatorAlreadyDisabled,
+		fmt.Sprintf("Validator associated with the validator_address=%v is already disabled", address))
+}
+
+func ErrValidatorNotDisabled(address interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeValidatorNotDisabled,
+		fmt.Sprintf("Validator associated with the validator_address=%v is not disabled", address))
+}
+
+func ErrLastActiveValidator(address interface{}) sdk.Error {
+	return sdk.NewError(Codespace, CodeLastActiveValidator,
+		fmt.Sprintf("Validator associated with the validator_address=%v is the last active validator "+
+			"and cannot be disabled", address))
+}
@@ -16,7 +16,12 @@ package types
 
 // validator module event types.
 const (
-	EventTypeCreateValidator = "create_validator"
+	EventTypeCreateValidator     = "create_validator"
+	EventTypeProposeAddValidator = "propose_add_validator"
+	EventTypeApproveAddValidator = "approve_add_validator"
+	EventTypeRejectAddValidator  = "reject_add_validator"
+	EventTypeDisableValidator    = "disable_validator"
+	EventTypeEnableValidator     = "enable_validator"
 
 	AttributeKeyValidator  = "validator"
 	AttributeValueCategory = ModuleName
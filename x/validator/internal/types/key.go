@@ -37,10 +37,14 @@ const (
 var (
 	ValidatorPrefix          = []byte{0x01} // prefix for each key to a validator
 	ValidatorLastPowerPrefix = []byte{0x02} // prefix for each key to a validator index, by last power
+	PendingValidatorPrefix   = []byte{0x03} // prefix for each key to a pending validator
+	DisabledValidatorPrefix  = []byte{0x04} // prefix for each key to a disabled validator
 
 	ValidatorOwnerPrefix               = []byte{0x05} // prefix for validator owner
 	ValidatorSigningInfoPrefix         = []byte{0x06} // prefix for validator signing info
 	ValidatorMissedBlockBitArrayPrefix = []byte{0x07} // prefix for validator missed blocks
+	SlashedValidatorPrefix             = []byte{0x08} // prefix for each key to a slashed validator
+	PendingValidatorExpirationPrefix   = []byte{0x09} // prefix for each key to a pending validator by proposal height
 
 )
 
@@ -54,6 +58,16 @@ func GetValidatorLastPowerKey(addr sdk.ConsAddress) []byte {
 	return append(ValidatorLastPowerPrefix, addr.Bytes()...)
 }
 
+// Key builder for Pending Validator record.
+func GetPendingValidatorKey(addr sdk.ConsAddress) []byte {
+	return append(PendingValidatorPrefix, addr.Bytes()...)
+}
+
+// Key builder for Disabled Validator record.
+func GetDisabledValidatorKey(addr sdk.ConsAddress) []byte {
+	return append(DisabledValidatorPrefix, addr.Bytes()...)
+}
+
 // Key builder for Validator signing info record.
 func GetValidatorSigningInfoKey(addr sdk.ConsAddress) []byte {
 	return append(ValidatorSigningInfoPrefix, addr.Bytes()...)
@@ -64,6 +78,11 @@ func GetValidatorOwnerKey(addr sdk.AccAddress) []byte {
 	return append(ValidatorOwnerPrefix, addr.Bytes()...)
 }
 
+// Key builder for Slashed Validator record.
+func GetSlashedValidatorKey(addr sdk.ConsAddress) []byte {
+	return append(SlashedValidatorPrefix, addr.Bytes()...)
+}
+
 func GetValidatorMissedBlockBitArrayPrefixKey(v sdk.ConsAddress) []byte {
 	return append(ValidatorMissedBlockBitArrayPrefix, v.Bytes()...)
 }
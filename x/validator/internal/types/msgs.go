@@ -43,6 +43,81 @@ func (m MsgCreateValidator) Route() string { return RouterKey }
 func (m MsgCreateValidator) Type() string { return EventTypeCreateValidator }
 
 func (m MsgCreateValidator) ValidateBasic() sdk.Error {
+	return validateValidatorInfo(m.Address, m.PubKey, m.Description, m.Signer)
+}
+
+func (m MsgCreateValidator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func (m MsgCreateValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgCreateValidator) GetPubKey() crypto.PubKey {
+	return sdk.MustGetConsPubKeyBech32(m.PubKey)
+}
+
+/*
+	Propose adding of a validator node after genesis
+*/
+type MsgProposeAddValidator struct {
+	Address     sdk.ConsAddress `json:"validator_address"`
+	PubKey      string          `json:"validator_pubkey"`
+	Description Description     `json:"description"`
+	Signer      sdk.AccAddress  `json:"signer"`
+}
+
+func NewMsgProposeAddValidator(address sdk.ConsAddress, pubKey string,
+	description Description, signer sdk.AccAddress) MsgProposeAddValidator {
+	return MsgProposeAddValidator{
+		Address:     address,
+		PubKey:      pubKey,
+		Description: description,
+		Signer:      signer,
+	}
+}
+
+func (m MsgProposeAddValidator) Route() string { return RouterKey }
+
+func (m MsgProposeAddValidator) Type() string { return EventTypeProposeAddValidator }
+
+func (m MsgProposeAddValidator) ValidateBasic() sdk.Error {
+	return validateValidatorInfo(m.Address, m.PubKey, m.Description, m.Signer)
+}
+
+func (m MsgProposeAddValidator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func (m MsgProposeAddValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgProposeAddValidator) GetPubKey() crypto.PubKey {
+	return sdk.MustGetConsPubKeyBech32(m.PubKey)
+}
+
+/*
+	Approve adding of a proposed validator node
+*/
+type MsgApproveAddValidator struct {
+	Address sdk.ConsAddress `json:"validator_address"`
+	Signer  sdk.AccAddress  `json:"signer"`
+}
+
+func NewMsgApproveAddValidator(address sdk.ConsAddress, signer sdk.AccAddress) MsgApproveAddValidator {
+	return MsgApproveAddValidator{
+		Address: address,
+		Signer:  signer,
+	}
+}
+
+func (m MsgApproveAddValidator) Route() string { return RouterKey }
+
+func (m MsgApproveAddValidator) Type() string { return EventTypeApproveAddValidator }
+
+func (m MsgApproveAddValidator) ValidateBasic() sdk.Error {
 	if m.Signer.Empty() {
 		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
 	}
@@ -51,34 +126,174 @@ func (m MsgCreateValidator) ValidateBasic() sdk.Error {
 		return sdk.ErrUnknownRequest("Invalid Validator Address: it cannot be empty")
 	}
 
-	pubkey, err := sdk.GetConsPubKeyBech32(m.PubKey)
-	if err != nil {
-		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Validator Public Key: %v", err))
+	return nil
+}
+
+func (m MsgApproveAddValidator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func (m MsgApproveAddValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+/*
+	Reject adding of a proposed validator node (or withdraw the own proposal)
+*/
+type MsgRejectAddValidator struct {
+	Address sdk.ConsAddress `json:"validator_address"`
+	Reason  string          `json:"reason,omitempty"`
+	Signer  sdk.AccAddress  `json:"signer"`
+}
+
+func NewMsgRejectAddValidator(address sdk.ConsAddress, reason string, signer sdk.AccAddress) MsgRejectAddValidator {
+	return MsgRejectAddValidator{
+		Address: address,
+		Reason:  reason,
+		Signer:  signer,
 	}
+}
 
-	if !m.Address.Equals(sdk.ConsAddress(pubkey.Address())) {
-		return sdk.ErrUnknownRequest("Validator Pubkey does not match to Validator Address")
+func (m MsgRejectAddValidator) Route() string { return RouterKey }
+
+func (m MsgRejectAddValidator) Type() string { return EventTypeRejectAddValidator }
+
+func (m MsgRejectAddValidator) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
 	}
 
-	if len(m.Description.Name) == 0 {
-		return sdk.ErrUnknownRequest("Invalid Validator Name: it cannot be empty")
+	if m.Address.Empty() {
+		return sdk.ErrUnknownRequest("Invalid Validator Address: it cannot be empty")
 	}
 
-	if err := m.Description.Validate(); err != nil {
-		return err
+	if len(m.Reason) > MaxDetailsLength {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Reason: "+
+			"received string of length %v, max is %v", len(m.Reason), MaxDetailsLength))
 	}
 
 	return nil
 }
 
-func (m MsgCreateValidator) GetSigners() []sdk.AccAddress {
+func (m MsgRejectAddValidator) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{m.Signer}
 }
 
-func (m MsgCreateValidator) GetSignBytes() []byte {
+func (m MsgRejectAddValidator) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
 }
 
-func (m MsgCreateValidator) GetPubKey() crypto.PubKey {
-	return sdk.MustGetConsPubKeyBech32(m.PubKey)
+/*
+	Disable a validator node without slashing
+*/
+type MsgDisableValidator struct {
+	Address sdk.ConsAddress `json:"validator_address"`
+	Reason  string          `json:"reason,omitempty"`
+	Signer  sdk.AccAddress  `json:"signer"`
+}
+
+func NewMsgDisableValidator(address sdk.ConsAddress, reason string, signer sdk.AccAddress) MsgDisableValidator {
+	return MsgDisableValidator{
+		Address: address,
+		Reason:  reason,
+		Signer:  signer,
+	}
+}
+
+func (m MsgDisableValidator) Route() string { return RouterKey }
+
+func (m MsgDisableValidator) Type() string { return EventTypeDisableValidator }
+
+func (m MsgDisableValidator) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if m.Address.Empty() {
+		return sdk.ErrUnknownRequest("Invalid Validator Address: it cannot be empty")
+	}
+
+	if len(m.Reason) > MaxDetailsLength {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Reason: "+
+			"received string of length %v, max is %v", len(m.Reason), MaxDetailsLength))
+	}
+
+	return nil
+}
+
+func (m MsgDisableValidator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func (m MsgDisableValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+/*
+	Enable a disabled validator node
+*/
+type MsgEnableValidator struct {
+	Address sdk.ConsAddress `json:"validator_address"`
+	Signer  sdk.AccAddress  `json:"signer"`
+}
+
+func NewMsgEnableValidator(address sdk.ConsAddress, signer sdk.AccAddress) MsgEnableValidator {
+	return MsgEnableValidator{
+		Address: address,
+		Signer:  signer,
+	}
+}
+
+func (m MsgEnableValidator) Route() string { return RouterKey }
+
+func (m MsgEnableValidator) Type() string { return EventTypeEnableValidator }
+
+func (m MsgEnableValidator) ValidateBasic() sdk.Error {
+	if m.Signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if m.Address.Empty() {
+		return sdk.ErrUnknownRequest("Invalid Validator Address: it cannot be empty")
+	}
+
+	return nil
+}
+
+func (m MsgEnableValidator) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{m.Signer}
+}
+
+func (m MsgEnableValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func validateValidatorInfo(address sdk.ConsAddress, pubKey string,
+	description Description, signer sdk.AccAddress) sdk.Error {
+	if signer.Empty() {
+		return sdk.ErrInvalidAddress("Invalid Signer: it cannot be empty")
+	}
+
+	if address.Empty() {
+		return sdk.ErrUnknownRequest("Invalid Validator Address: it cannot be empty")
+	}
+
+	pubkey, err := sdk.GetConsPubKeyBech32(pubKey)
+	if err != nil {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("Invalid Validator Public Key: %v", err))
+	}
+
+	if !address.Equals(sdk.ConsAddress(pubkey.Address())) {
+		return sdk.ErrUnknownRequest("Validator Pubkey does not match to Validator Address")
+	}
+
+	if len(description.Name) == 0 {
+		return sdk.ErrUnknownRequest("Invalid Validator Name: it cannot be empty")
+	}
+
+	if err := description.Validate(); err != nil {
+		return err
+	}
+
+	return nil
 }
@@ -75,3 +75,158 @@ func TestMsgCreateValidatorGetSignBytes(t *testing.T) {
 		`"validator_pubkey":"cosmosvalconspub1zcjduepqdmmjdfyvh2mrwl8p8wkwp23kh8lvjrd9u45snxqz6te6y6lwk6gqts45r3"}}`
 	require.Equal(t, expected, string(msg.GetSignBytes()))
 }
+
+/*
+	MsgProposeAddValidator
+*/
+
+func TestNewMsgProposeAddValidator(t *testing.T) {
+	msg := NewMsgProposeAddValidator(testconstants.ValidatorAddress1, testconstants.ValidatorPubKey1,
+		Description{Name: testconstants.Name}, testconstants.Owner)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "propose_add_validator")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{msg.Signer})
+}
+
+func TestValidateMsgProposeAddValidator(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgProposeAddValidator
+	}{
+		{true, NewMsgProposeAddValidator(testconstants.ValidatorAddress1, testconstants.ValidatorPubKey1,
+			Description{Name: testconstants.Name}, testconstants.Owner)},
+		{false, NewMsgProposeAddValidator(nil, testconstants.ValidatorPubKey1,
+			Description{Name: testconstants.Name}, testconstants.Owner)},
+		{false, NewMsgProposeAddValidator(testconstants.ValidatorAddress1, testconstants.ValidatorPubKey2,
+			Description{Name: testconstants.Name}, testconstants.Owner)},
+		{false, NewMsgProposeAddValidator(testconstants.ValidatorAddress1, testconstants.ValidatorPubKey1,
+			Description{}, testconstants.Owner)},
+		{false, NewMsgProposeAddValidator(testconstants.ValidatorAddress1, testconstants.ValidatorPubKey1,
+			Description{Name: testconstants.Name}, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+/*
+	MsgApproveAddValidator
+*/
+
+func TestNewMsgApproveAddValidator(t *testing.T) {
+	msg := NewMsgApproveAddValidator(testconstants.ValidatorAddress1, testconstants.Signer)
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "approve_add_validator")
+	require.Equal(t, msg.GetSigners(), []sdk.AccAddress{msg.Signer})
+}
+
+func TestValidateMsgApproveAddValidator(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgApproveAddValidator
+	}{
+		{true, NewMsgApproveAddValidator(testconstants.ValidatorAddress1, testconstants.Signer)},
+		{false, NewMsgApproveAddValidator(nil, testconstants.Signer)},
+		{false, NewMsgApproveAddValidator(testconstants.ValidatorAddress1, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+/*
+	MsgRejectAddValidator
+*/
+
+func TestValidateMsgRejectAddValidator(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgRejectAddValidator
+	}{
+		{true, NewMsgRejectAddValidator(testconstants.ValidatorAddress1, "", testconstants.Signer)},
+		{true, NewMsgRejectAddValidator(testconstants.ValidatorAddress1, "pool is full", testconstants.Signer)},
+		{false, NewMsgRejectAddValidator(nil, "", testconstants.Signer)},
+		{false, NewMsgRejectAddValidator(testconstants.ValidatorAddress1, "", nil)},
+		{false, NewMsgRejectAddValidator(testconstants.ValidatorAddress1,
+			string(make([]byte, MaxDetailsLength+1)), testconstants.Signer)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+/*
+	MsgDisableValidator
+*/
+
+func TestValidateMsgDisableValidator(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgDisableValidator
+	}{
+		{true, NewMsgDisableValidator(testconstants.ValidatorAddress1, "", testconstants.Signer)},
+		{true, NewMsgDisableValidator(testconstants.ValidatorAddress1, "maintenance", testconstants.Signer)},
+		{false, NewMsgDisableValidator(nil, "", testconstants.Signer)},
+		{false, NewMsgDisableValidator(testconstants.ValidatorAddress1, "", nil)},
+		{false, NewMsgDisableValidator(testconstants.ValidatorAddress1,
+			string(make([]byte, MaxDetailsLength+1)), testconstants.Signer)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
+
+/*
+	MsgEnableValidator
+*/
+
+func TestValidateMsgEnableValidator(t *testing.T) {
+	cases := []struct {
+		valid bool
+		msg   MsgEnableValidator
+	}{
+		{true, NewMsgEnableValidator(testconstants.ValidatorAddress1, testconstants.Signer)},
+		{false, NewMsgEnableValidator(nil, testconstants.Signer)},
+		{false, NewMsgEnableValidator(testconstants.ValidatorAddress1, nil)},
+	}
+
+	for _, tc := range cases {
+		err := tc.msg.ValidateBasic()
+
+		if tc.valid {
+			require.Nil(t, err)
+		} else {
+			require.NotNil(t, err)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2020 DSR Corporation
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
+)
+
+// DefaultParamspace is the name of the parameter subspace of the module.
+const DefaultParamspace = ModuleName
+
+// Parameter keys of the module subspace.
+var (
+	KeyValidatorApprovalPercent         = []byte("ValidatorApprovalPercent")
+	KeyPendingValidatorExpirationPeriod = []byte("PendingValidatorExpirationPeriod")
+)
+
+// Share of trustees required to approve adding of a validator by default.
+var DefaultValidatorApprovalPercent = sdk.NewDecWithPrec(66, 2)
+
+/*
+	Parameters of the module stored in the module subspace (can be changed by Trustees)
+*/
+type Params struct {
+	// the share of trustees required to approve adding of a validator after genesis
+	ValidatorApprovalPercent sdk.Dec `json:"validator_approval_percent"`
+	// the number of blocks after which the pending validators not approved (or rejected) by enough trustees
+	// are deleted. 0 means the pending validators never expire.
+	PendingValidatorExpirationPeriod int64 `json:"pending_validator_expiration_period"`
+}
+
+func NewParams(validatorApprovalPercent sdk.Dec) Params {
+	return Params{
+		ValidatorApprovalPercent:         validatorApprovalPercent,
+		PendingValidatorExpirationPeriod: approvals.NoExpiration,
+	}
+}
+
+func DefaultParams() Params {
+	return NewParams(DefaultValidatorApprovalPercent)
+}
+
+// ParamKeyTable returns the key table of the module subspace.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyValidatorApprovalPercent, Value: &p.ValidatorApprovalPercent},
+		{Key: KeyPendingValidatorExpirationPeriod, Value: &p.PendingValidatorExpirationPeriod},
+	}
+}
+
+func (p Params) Validate() error {
+	if p.ValidatorApprovalPercent.IsNil() || !p.ValidatorApprovalPercent.IsPositive() ||
+		p.ValidatorApprovalPercent.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid ValidatorApprovalPercent: it must be greater than 0 and at most 1, got %v",
+			p.ValidatorApprovalPercent)
+	}
+
+	if p.PendingValidatorExpirationPeriod < 0 {
+		return fmt.Errorf("invalid PendingValidatorExpirationPeriod: it must be non-negative, got %v",
+			p.PendingValidatorExpirationPeriod)
+	}
+
+	return nil
+}
+
+// ValidatorApprovalPolicy returns the policy used to vote for proposals to add validators.
+func (p Params) ValidatorApprovalPolicy() approvals.Policy {
+	return approvals.NewPolicy(approvals.DecQuorum(p.ValidatorApprovalPercent), p.PendingValidatorExpirationPeriod)
+}
+
+func (p Params) String() string {
+	bytes, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
@@ -36,6 +36,7 @@ const (
 type ListValidatorsParams struct {
 	Skip  int
 	Take  int
+	Key   string
 	State ValidatorState
 }
 
@@ -43,18 +44,25 @@ func NewListValidatorsParams(pagination pagination.PaginationParams, status Vali
 	return ListValidatorsParams{
 		Skip:  pagination.Skip,
 		Take:  pagination.Take,
+		Key:   pagination.Key,
 		State: status,
 	}
 }
 
+func (p ListValidatorsParams) PaginationParams() pagination.PaginationParams {
+	return pagination.PaginationParams{Skip: p.Skip, Take: p.Take, Key: p.Key}
+}
+
 /*
 	Result Payload
 */
 
 // Response Payload for QueryValidators query.
 type ListValidatorItems struct {
-	Total int         `json:"total"`
-	Items []Validator `json:"items"`
+	Total   int         `json:"total"`
+	Items   []Validator `json:"items"`
+	NextKey string      `json:"next_key"`
+	PrevKey string      `json:"prev_key"`
 }
 
 func NewListValidatorItems() ListValidatorItems {
@@ -73,3 +81,72 @@ func (n ListValidatorItems) String() string {
 
 	return string(res)
 }
+
+type ListPendingValidatorItems struct {
+	Total   int                `json:"total"`
+	Items   []PendingValidator `json:"items"`
+	NextKey string             `json:"next_key"`
+	PrevKey string             `json:"prev_key"`
+}
+
+func NewListPendingValidatorItems() ListPendingValidatorItems {
+	return ListPendingValidatorItems{
+		Total: 0,
+		Items: []PendingValidator{},
+	}
+}
+
+func (n ListPendingValidatorItems) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
+
+type ListDisabledValidatorItems struct {
+	Total   int                 `json:"total"`
+	Items   []DisabledValidator `json:"items"`
+	NextKey string              `json:"next_key"`
+	PrevKey string              `json:"prev_key"`
+}
+
+func NewListDisabledValidatorItems() ListDisabledValidatorItems {
+	return ListDisabledValidatorItems{
+		Total: 0,
+		Items: []DisabledValidator{},
+	}
+}
+
+func (n ListDisabledValidatorItems) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
+
+type ListSlashedValidatorItems struct {
+	Total   int                `json:"total"`
+	Items   []SlashedValidator `json:"items"`
+	NextKey string             `json:"next_key"`
+	PrevKey string             `json:"prev_key"`
+}
+
+func NewListSlashedValidatorItems() ListSlashedValidatorItems {
+	return ListSlashedValidatorItems{
+		Total: 0,
+		Items: []SlashedValidator{},
+	}
+}
+
+func (n ListSlashedValidatorItems) String() string {
+	res, err := json.Marshal(n)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(res)
+}
@@ -17,12 +17,14 @@ package types
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/approvals"
 )
 
 /*
@@ -109,6 +111,112 @@ func UnmarshalBinaryBareValidator(cdc *codec.Codec, value []byte) (v Validator,
 	return v, err
 }
 
+/*
+	Pending Validator (proposed to be added after genesis, but not approved by Trustees yet)
+*/
+type PendingValidator struct {
+	Description Description      `json:"description"`           // description of the validator
+	Address     sdk.ConsAddress  `json:"validator_address"`     // the consensus address of the tendermint validator
+	PubKey      string           `json:"validator_pubkey"`      // the consensus public key of the tendermint validator
+	Owner       sdk.AccAddress   `json:"owner"`                 // the account address of validator owner
+	Approvals   []sdk.AccAddress `json:"approvals"`             // trustees approved the validator
+	Rejections  []sdk.AccAddress `json:"rejections"`            // trustees rejected the validator
+	ProposedAt  int64            `json:"proposed_at,omitempty"` // the height the validator was proposed at
+}
+
+func NewPendingValidator(address sdk.ConsAddress, pubKey string,
+	description Description, owner sdk.AccAddress) PendingValidator {
+	return PendingValidator{
+		Description: description,
+		Address:     address,
+		PubKey:      pubKey,
+		Owner:       owner,
+		Approvals:   []sdk.AccAddress{},
+		Rejections:  []sdk.AccAddress{},
+	}
+}
+
+// Converts the approved pending validator into the active one.
+func (v PendingValidator) Validator() Validator {
+	return NewValidator(v.Address, v.PubKey, v.Description, v.Owner)
+}
+
+// Implements approvals.Proposal.
+func (v PendingValidator) GetProposedAt() int64 {
+	return v.ProposedAt
+}
+
+func (v PendingValidator) HasApprovalFrom(address sdk.AccAddress) bool {
+	return approvals.HasApprovalFrom(v.Approvals, address)
+}
+
+func (v PendingValidator) HasRejectionFrom(address sdk.AccAddress) bool {
+	return approvals.HasApprovalFrom(v.Rejections, address)
+}
+
+func (v PendingValidator) String() string {
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+/*
+	Disabled Validator (removed from validator set by Trustee or owner without slashing)
+*/
+type DisabledValidator struct {
+	Address        sdk.ConsAddress `json:"validator_address"` // the consensus address of the tendermint validator
+	Reason         string          `json:"reason,omitempty"`  // the reason of validator disabling
+	DisabledBy     sdk.AccAddress  `json:"disabled_by"`       // the account address disabled the validator
+	DisabledAt     time.Time       `json:"disabled_at"`       // the block time the validator was disabled at
+	DisabledHeight int64           `json:"disabled_height"`   // the block height the validator was disabled at
+}
+
+func NewDisabledValidator(address sdk.ConsAddress, reason string,
+	disabledBy sdk.AccAddress, disabledAt time.Time, disabledHeight int64) DisabledValidator {
+	return DisabledValidator{
+		Address:        address,
+		Reason:         reason,
+		DisabledBy:     disabledBy,
+		DisabledAt:     disabledAt,
+		DisabledHeight: disabledHeight,
+	}
+}
+
+func (v DisabledValidator) String() string {
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
+/*
+	Slashed Validator (removed from validator set because of confirmed misbehaviour evidence)
+*/
+type SlashedValidator struct {
+	Address          sdk.ConsAddress `json:"validator_address"` // the consensus address of the tendermint validator
+	Reason           string          `json:"reason"`            // the description of the infraction
+	EvidenceType     string          `json:"evidence_type"`     // the type of the tendermint evidence
+	InfractionHeight int64           `json:"infraction_height"` // the block height the infraction happened at
+	InfractionTime   time.Time       `json:"infraction_time"`   // the time the infraction happened at
+	Power            int64           `json:"power"`             // validator power at the infraction height
+	SlashedAt        time.Time       `json:"slashed_at"`        // the block time the validator was slashed at
+	SlashedHeight    int64           `json:"slashed_height"`    // the block height the validator was slashed at
+}
+
+func (v SlashedValidator) String() string {
+	bytes, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bytes)
+}
+
 /*
 	Last Validator power. needed for taking validator set updates
 */
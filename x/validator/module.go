@@ -25,16 +25,23 @@ import (
 	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/auth"
+	"github.com/zigbee-alliance/distributed-compliance-ledger/x/upgrade"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator/client/cli"
 	"github.com/zigbee-alliance/distributed-compliance-ledger/x/validator/client/rest"
 )
 
 // type check to ensure the interface is properly implemented.
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule            = AppModule{}
+	_ module.AppModuleBasic       = AppModuleBasic{}
+	_ upgrade.HasConsensusVersion = AppModule{}
+	_ upgrade.HasMigrations       = AppModule{}
 )
 
+// Consensus version of the module store schema.
+// Version 2 adds the proposal height of pending validators and the index of pending validators by it.
+const ConsensusVersion = 2
+
 // app module Basics object.
 type AppModuleBasic struct{}
 
@@ -143,7 +150,24 @@ func (am AppModule) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	am.keeper.BeginBlocker(ctx, req)
 }
 
-// EndBlock returns the end blocker for the module. It returns no validator updates.
+// EndBlock returns the end blocker for the module. It deletes the expired pending validators
+// and returns the validator updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	am.keeper.DeleteExpiredPendingValidators(ctx)
+
 	return am.keeper.BlockValidatorUpdates(ctx)
 }
+
+func (am AppModule) ConsensusVersion() uint64 {
+	return ConsensusVersion
+}
+
+func (am AppModule) RegisterMigrations(registrar upgrade.MigrationRegistrar) {
+	// version 1 -> 2: the validators proposed before their proposal height was tracked
+	// are considered proposed at the migration height
+	registrar.RegisterMigration(ModuleName, 1, func(ctx sdk.Context) error {
+		am.keeper.SetPendingValidatorsProposedAt(ctx)
+
+		return nil
+	})
+}